@@ -5,15 +5,25 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
 	"os"
 	"os/signal"
 	"path/filepath"
+	"strings"
 	"syscall"
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/rodruizronald/ticos-in-tech/internal/actor"
+	"github.com/rodruizronald/ticos-in-tech/internal/assets"
 	"github.com/rodruizronald/ticos-in-tech/internal/company"
+	"github.com/rodruizronald/ticos-in-tech/internal/companyalias"
+	"github.com/rodruizronald/ticos-in-tech/internal/config"
 	"github.com/rodruizronald/ticos-in-tech/internal/database"
+	"github.com/rodruizronald/ticos-in-tech/internal/exitcode"
+	"github.com/rodruizronald/ticos-in-tech/internal/logging"
 )
 
 // Company represents a company entity as stored in the JSON configuration file.
@@ -21,21 +31,30 @@ import (
 type Company struct {
 	Name    string `json:"name"`
 	LogoURL string `json:"logo_url"`
+	// WebsiteURL is the website of the company, used to tell duplicates apart. Optional.
+	WebsiteURL string `json:"website_url"`
+	// Aliases are former or alternative names the job data may use for the company. Optional.
+	Aliases []string `json:"aliases"`
 }
 
 func main() {
 	var err error
-	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	// The changes are recorded as made by the user running the command
+	ctx := actor.With(context.Background(), actor.CLI("db_company_populator"))
+	ctx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
 	defer func() {
 		stop()
 		if err != nil {
-			os.Exit(1)
+			os.Exit(exitcode.Of(err))
 		}
 	}()
-	err = run(ctx)
+
+	strict := flag.Bool("strict", false, "Stop at the first company that fails instead of going on with the others")
+	flag.Parse()
+	err = run(ctx, *strict)
 }
 
-func run(ctx context.Context) error {
+func run(ctx context.Context, strict bool) error {
 	// Initialize logger
 	log := logrus.New()
 	log.SetFormatter(&logrus.TextFormatter{
@@ -50,41 +69,96 @@ func run(ctx context.Context) error {
 	}
 	log.Infof("Loaded %d companies from JSON file", len(companies))
 
-	// Get database config
-	dbConfig := database.DefaultConfig()
+	// Load configuration from the environment
+	cfg, err := config.Load()
+	if err != nil {
+		log.Errorf("Invalid configuration: %v", err)
+		return exitcode.Wrap(exitcode.Config, err)
+	}
+
+	// Write the logs in the configured format, copied to the log file if any
+	logFile := logging.Configure(log, cfg.Logging)
+	defer logFile.Close()
 
 	// Connect to the database
-	dbpool, err := database.Connect(ctx, &dbConfig)
+	dbpool, err := database.Connect(ctx, &cfg.Database)
 	if err != nil {
 		log.Errorf("Unable to connect to database: %v", err)
-		return err
+		return exitcode.Wrap(exitcode.Database, err)
 	}
 	defer dbpool.Close()
 
-	// Create a company repository
-	repo := company.NewRepository(dbpool)
+	// Store the logos in object storage when configured, otherwise they link to their source
+	var logoStore company.LogoStore
+	if cfg.Assets.Enabled() {
+		var logoService *assets.LogoService
+		if logoService, err = assets.NewLogoServiceFromConfig(&cfg.Assets); err != nil {
+			log.Errorf("Invalid asset storage configuration: %v", err)
+			return exitcode.Wrap(exitcode.Config, err)
+		}
+		logoStore = logoService
+	}
+
+	// Create the company service
+	companyService := company.NewCompanyService(
+		company.NewRepository(dbpool),
+		companyalias.NewRepository(dbpool),
+		logoStore,
+	)
 
-	// Store each company in the database
+	// Store each company in the database. The companies that fail are skipped, failing the run once
+	// the others are stored. The ones that may duplicate are left for an admin and don't fail it.
+	failures := exitcode.NewCollector(strict)
 	for _, c := range companies {
 		cm := &company.Company{
-			Name:     c.Name,
-			LogoURL:  c.LogoURL,
-			IsActive: true,
+			Name:       c.Name,
+			LogoURL:    c.LogoURL,
+			WebsiteURL: c.WebsiteURL,
+			IsActive:   true,
 		}
 
-		err = repo.Create(ctx, cm)
-		if err != nil {
-			if company.IsDuplicate(err) {
-				log.Infof("Company already exists: %s", cm.Name)
+		err = companyService.Create(ctx, cm)
+		var similarErr *company.SimilarError
+		switch {
+		case company.IsDuplicate(err):
+			log.Infof("Company already exists: %s", cm.Name)
+
+			// Reuse the existing company so aliases can still be attached
+			existing, getErr := companyService.GetByName(ctx, cm.Name)
+			if getErr != nil {
+				log.Warnf("Error finding company %s: %v", cm.Name, getErr)
+				if err = failures.Add(exitcode.Partial, fmt.Errorf("company %s: %w", cm.Name, getErr)); err != nil {
+					return err
+				}
 				continue
 			}
+			cm = existing
+		case errors.As(err, &similarErr):
+			// Left for an admin to create or merge, so the aliases aren't attached to the wrong company
+			log.Warnf("Company %s not created, it may duplicate: %s", cm.Name, strings.Join(similarErr.Details(), "; "))
+			continue
+		case err != nil:
 			log.Warnf("Error creating company %s: %v", c.Name, err)
+			if err = failures.Add(exitcode.Partial, fmt.Errorf("company %s: %w", c.Name, err)); err != nil {
+				return err
+			}
 			continue
+		default:
+			log.Infof("Successfully added company: %s (ID: %d)", cm.Name, cm.ID)
 		}
 
-		log.Infof("Successfully added company: %s (ID: %d)", cm.Name, cm.ID)
+		if err = companyService.AddAliases(ctx, cm.ID, c.Aliases); err != nil {
+			log.Warnf("Error creating aliases for company %s: %v", cm.Name, err)
+			if err = failures.Add(exitcode.Partial, fmt.Errorf("aliases of company %s: %w", cm.Name, err)); err != nil {
+				return err
+			}
+		}
 	}
 
+	if err = failures.Err(); err != nil {
+		log.Errorf("Company population completed with %d failed companies", failures.Len())
+		return err
+	}
 	log.Info("Company population completed")
 	return nil
 }
@@ -6,10 +6,14 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
 	"os"
 	"os/signal"
 	"path/filepath"
+	"slices"
+	"sort"
 	"strings"
 	"syscall"
 	"time"
@@ -17,32 +21,100 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/sirupsen/logrus"
 
+	"github.com/rodruizronald/ticos-in-tech/internal/actor"
+	"github.com/rodruizronald/ticos-in-tech/internal/benefit"
 	"github.com/rodruizronald/ticos-in-tech/internal/company"
+	"github.com/rodruizronald/ticos-in-tech/internal/companyalias"
+	"github.com/rodruizronald/ticos-in-tech/internal/config"
 	"github.com/rodruizronald/ticos-in-tech/internal/database"
+	"github.com/rodruizronald/ticos-in-tech/internal/exitcode"
+	"github.com/rodruizronald/ticos-in-tech/internal/ingest"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobfunction"
 	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
 	"github.com/rodruizronald/ticos-in-tech/internal/jobtech"
+	"github.com/rodruizronald/ticos-in-tech/internal/location"
+	"github.com/rodruizronald/ticos-in-tech/internal/logging"
+	"github.com/rodruizronald/ticos-in-tech/internal/notifier"
+	"github.com/rodruizronald/ticos-in-tech/internal/opensearch"
+	"github.com/rodruizronald/ticos-in-tech/internal/pendingtech"
+	"github.com/rodruizronald/ticos-in-tech/internal/reference"
 	"github.com/rodruizronald/ticos-in-tech/internal/techalias"
+	"github.com/rodruizronald/ticos-in-tech/internal/techdetect"
 	"github.com/rodruizronald/ticos-in-tech/internal/technology"
 )
 
 // Job define a type to represent a single job
 type jobData struct {
-	Company         string `json:"company"`
-	Title           string `json:"title"`
-	Description     string `json:"description"`
-	ApplicationURL  string `json:"application_url"`
-	Location        string `json:"location"`
-	WorkMode        string `json:"work_mode"`
-	ExperienceLevel string `json:"experience_level"`
-	EmploymentType  string `json:"employment_type"`
-	Technologies    []struct {
+	Company         string   `json:"company"`
+	Title           string   `json:"title"`
+	Description     string   `json:"description"`
+	ApplicationURL  string   `json:"application_url"`
+	Location        string   `json:"location"`
+	WorkMode        string   `json:"work_mode"`
+	ExperienceLevel string   `json:"experience_level"`
+	EmploymentType  string   `json:"employment_type"`
+	Functions       []string `json:"functions"`
+	Benefits        []string `json:"benefits"`
+	// Technologies marked primary are the ones the job is built around, they are required too
+	Technologies []struct {
 		Name     string `json:"name"`
 		Category string `json:"category"`
+		Primary  bool   `json:"primary"`
 		Required bool   `json:"required"`
 	} `json:"technologies"`
 	Signature string `json:"signature"`
+	// Language is "en" or "es", detected from the title and description when missing
+	Language string `json:"language"`
+	// RemoteEligibility is "CR only", "LATAM only" or "Worldwide", and the UTC offsets bound the
+	// working timezones in hours. All of them are optional.
+	RemoteEligibility string `json:"remote_eligibility"`
+	UTCOffsetMin      *int   `json:"utc_offset_min"`
+	UTCOffsetMax      *int   `json:"utc_offset_max"`
+	// Source is where the job was scraped from: "linkedin", "greenhouse" or "company_site", and
+	// SourceJobID the ID of the job there. Both are optional, the ID is ignored without a source.
+	Source      string `json:"source"`
+	SourceJobID string `json:"source_job_id"`
+	// ScrapedAt is when the job was scraped, used to skip the jobs already imported. Optional.
+	ScrapedAt *time.Time `json:"scraped_at"`
+
+	// experienceLevelInferred is set when the experience level is inferred from the title and description
+	experienceLevelInferred bool
+}
+
+// techMatchOptions configures how technology names from the job data are matched.
+// Names similar enough to a known technology are associated with it, less similar
+// ones are recorded as pending technologies with the candidate as a suggestion.
+var techMatchOptions = technology.MatchOptions{
+	Similarity:    true,
+	MinConfidence: 0.6,
+	MinCandidate:  0.3,
+}
+
+// quarantinedJob is a job skipped because of attribute values matching no known value, kept for
+// review instead of being stored verbatim
+type quarantinedJob struct {
+	Company        string `json:"company"`
+	Title          string `json:"title"`
+	ApplicationURL string `json:"application_url"`
+	// Fields are the unknown values by attribute, as found in the job data
+	Fields map[string]string `json:"fields"`
 }
 
+// jobOutcome is what processing a job did, logged with the job so runs can be audited
+type jobOutcome string
+
+const (
+	outcomeCreated     jobOutcome = "created"
+	outcomeUpdated     jobOutcome = "updated"
+	outcomeUnchanged   jobOutcome = "unchanged"
+	outcomeQuarantined jobOutcome = "quarantined"
+	outcomeInvalid     jobOutcome = "invalid"
+	outcomeFailed      jobOutcome = "failed"
+)
+
+// ingestSource is the source of the ingest runs recorded by the populator
+const ingestSource = "db_job_populator"
+
 // Update the jobs struct to use the Job type
 type internalJobs struct {
 	Jobs []jobData `json:"jobs"`
@@ -50,92 +122,283 @@ type internalJobs struct {
 
 func main() {
 	var err error
-	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	// The changes are recorded as made by the user running the command
+	ctx := actor.With(context.Background(), actor.CLI("db_job_populator"))
+	ctx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
 	defer func() {
 		stop()
 		if err != nil {
-			os.Exit(1)
+			os.Exit(exitcode.Of(err))
 		}
 	}()
-	err = run(ctx)
+
+	var opts options
+	flag.BoolVar(&opts.strict, "strict", false, "Stop at the first job that fails instead of going on with the others")
+	flag.BoolVar(&opts.sinceLastRun, "since-last-run", false,
+		"Only process the jobs scraped after the ones imported by the last successful runs of their source")
+	flag.Parse()
+	err = run(ctx, opts)
 }
 
-func run(ctx context.Context) error {
+// options are the command line options of the populator
+type options struct {
+	// strict stops at the first job that fails
+	strict bool
+	// sinceLastRun skips the jobs scraped up to the latest scrape time imported from their source
+	sinceLastRun bool
+}
+
+func run(ctx context.Context, opts options) error {
 	// Initialize logger
 	log := logrus.New()
 	log.SetFormatter(&logrus.TextFormatter{
 		FullTimestamp: true,
 	})
 
+	// Load configuration from the environment
+	cfg, err := config.Load()
+	if err != nil {
+		log.Errorf("Invalid configuration: %v", err)
+		return exitcode.Wrap(exitcode.Config, err)
+	}
+
+	// Write the logs in the configured format, copied to the log file if any
+	logFile := logging.Configure(log, cfg.Logging)
+	defer logFile.Close()
+
 	// Setup database and repositories
-	dbpool, repos, err := setupDatabase(ctx, log)
+	dbpool, repos, err := setupDatabase(ctx, cfg, log)
 	if err != nil {
 		return err
 	}
 	defer dbpool.Close()
 
+	// The scraped attributes are normalized to the reference values of the database
+	referenceService := reference.NewReferenceService(reference.NewRepository(dbpool), reference.Default())
+	if err = referenceService.Load(ctx); err != nil {
+		log.Warnf("Unable to load the reference values, normalizing to the seeded ones: %v", err)
+	}
+
 	// Get file paths
 	today := time.Now().Format("20060102")
 	inputDir := filepath.Join("data", today)
 	inputFile := filepath.Join(inputDir, "jobs.json")
 	missingTechFile := filepath.Join(inputDir, "missing_technologies.json")
+	quarantineFile := filepath.Join(inputDir, "quarantined_jobs.json")
+	nearDuplicatesFile := filepath.Join(inputDir, "near_duplicates.json")
+	volumeAnomaliesFile := filepath.Join(inputDir, "volume_anomalies.json")
+
+	// The jobs that fail are skipped, failing the run once the others are processed
+	failures := exitcode.NewCollector(opts.strict)
 
 	// Read and parse job data
-	jobData, err := readJobData(inputFile, log)
+	startedAt := time.Now()
+	jobData, err := readJobData(inputFile, failures, log)
 	if err != nil {
 		return err
 	}
 
+	// The whole input is kept for the run records, the volumes of the companies and the scrape times
+	// of the sources, the jobs skipped included
+	scraped := &internalJobs{Jobs: slices.Clone(jobData.Jobs)}
+	if opts.sinceLastRun {
+		if err = skipImportedJobs(ctx, jobData, repos.ingest, log); err != nil {
+			return err
+		}
+	}
+
+	// Resolve the companies and technologies of the jobs up front, instead of querying them for every job
+	lookup, err := preload(ctx, scraped, repos, log)
+	if err != nil {
+		return err
+	}
+
+	// Jobs from sources that need review wait in the moderation queue instead of being published
+	jobStatus := jobs.StatusPublished
+	if cfg.ReviewIngestedJobs {
+		jobStatus = jobs.StatusPending
+		log.Info("New jobs are created as pending and must be approved by an admin")
+	}
+
 	// Process jobs and collect missing technologies
-	missingTechnologies, err := processJobs(ctx, jobData, repos, log)
+	missingTechnologies, quarantined, jobIDs, err := processJobs(ctx, jobData, repos, lookup, jobStatus, failures, log)
 	if err != nil {
 		return err
 	}
 
+	// Write the jobs with unknown attribute values to file if any
+	if err := writeQuarantinedJobs(quarantined, quarantineFile, log); err != nil {
+		return err
+	}
+
 	// Write missing technologies to file if any
 	if err := writeMissingTechnologies(missingTechnologies, missingTechFile, log); err != nil {
 		return err
 	}
 
+	// Report processed jobs that look like reposts of other jobs
+	if err := reportNearDuplicates(ctx, jobIDs, repos.duplicates, nearDuplicatesFile, log); err != nil {
+		return err
+	}
+
+	// Report companies with an anomalous number of jobs, usually a broken scraper selector. The jobs
+	// skipped as already imported are still listed by the companies, so they are counted too.
+	if err := reportIngestVolumes(ctx, scraped, repos, lookup.companies, volumeAnomaliesFile, log); err != nil {
+		return err
+	}
+
+	// Make the new jobs searchable
+	if err := repos.job.RefreshSearchView(ctx); err != nil {
+		log.Errorf("Failed to refresh job search view: %v", err)
+		return err
+	}
+
+	if repos.indexer != nil {
+		if err := repos.indexer.IndexJobs(ctx, jobIDs); err != nil {
+			log.Errorf("Failed to index jobs into OpenSearch: %v", err)
+			return err
+		}
+		log.Infof("Indexed %d jobs into OpenSearch", len(jobIDs))
+	}
+
+	// Announce the jobs published by this import on the chat channels
+	if cfg.Notifier.Enabled() {
+		n := notifier.NewNotifierFromConfig(notifier.NewRepository(dbpool), &cfg.Notifier)
+		announced, err := n.AnnounceNew(ctx, startedAt)
+		if err != nil {
+			log.Warnf("Failed to announce new jobs: %v", err)
+		}
+		log.Infof("Announced %d new jobs", announced)
+	}
+
+	if err = failures.Err(); err != nil {
+		log.Errorf("Job population completed with %d failed jobs", failures.Len())
+		return err
+	}
+
+	// Only the runs without failures are recorded, so the next incremental run retries the failed jobs
+	if err = recordPopulatorRuns(ctx, scraped, jobData, startedAt, repos.ingest, log); err != nil {
+		return err
+	}
 	log.Info("Job population completed")
 	return nil
 }
 
-// setupDatabase initializes the database connection and repositories
-func setupDatabase(ctx context.Context, log *logrus.Logger) (*pgxpool.Pool, *repositories, error) {
-	// Get database config
-	dbConfig := database.DefaultConfig()
+// skipImportedJobs removes the jobs scraped up to the latest scrape time imported from their source.
+// Jobs without a scrape time or from a source without one are kept.
+func skipImportedJobs(ctx context.Context, jobData *internalJobs, ingestService *ingest.IngestService,
+	log *logrus.Logger) error {
+	lastScrapedAt, err := ingestService.LastScrapedAt(ctx)
+	if err != nil {
+		log.Errorf("Failed to get the last populator runs: %v", err)
+		return err
+	}
 
+	kept := jobData.Jobs[:0]
+	for _, j := range jobData.Jobs {
+		imported, ok := lastScrapedAt[strings.TrimSpace(j.Source)]
+		if ok && j.ScrapedAt != nil && !j.ScrapedAt.After(imported) {
+			continue
+		}
+		kept = append(kept, j)
+	}
+	skipped := len(jobData.Jobs) - len(kept)
+	jobData.Jobs = kept
+
+	log.Infof("Skipped %d jobs scraped before the ones imported from their source, %d left", skipped, len(kept))
+	return nil
+}
+
+// recordPopulatorRuns records the run as the last successful one of every source of the scraped jobs,
+// even the ones whose jobs were all skipped, with the number of jobs of each source processed and
+// the latest scrape time of its jobs
+func recordPopulatorRuns(ctx context.Context, scraped, processed *internalJobs, startedAt time.Time,
+	ingestService *ingest.IngestService, log *logrus.Logger) error {
+	runs := make(map[string]*ingest.PopulatorRun)
+	for i := range scraped.Jobs {
+		j := &scraped.Jobs[i]
+		source := strings.TrimSpace(j.Source)
+		run, ok := runs[source]
+		if !ok {
+			run = &ingest.PopulatorRun{Source: source, StartedAt: startedAt}
+			runs[source] = run
+		}
+		if j.ScrapedAt != nil && (run.LastScrapedAt == nil || j.ScrapedAt.After(*run.LastScrapedAt)) {
+			run.LastScrapedAt = j.ScrapedAt
+		}
+	}
+	for i := range processed.Jobs {
+		runs[strings.TrimSpace(processed.Jobs[i].Source)].JobsProcessed++
+	}
+
+	for source, run := range runs {
+		if err := ingestService.RecordPopulatorRun(ctx, run); err != nil {
+			log.Errorf("Failed to record the populator run of source %q: %v", source, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// setupDatabase initializes the database connection and repositories
+func setupDatabase(ctx context.Context, cfg *config.Config, log *logrus.Logger) (*pgxpool.Pool, *repositories, error) {
 	// Connect to the database
-	dbpool, err := database.Connect(ctx, &dbConfig)
+	dbpool, err := database.Connect(ctx, &cfg.Database)
 	if err != nil {
 		log.Errorf("Unable to connect to database: %v", err)
-		return nil, nil, err
+		return nil, nil, exitcode.Wrap(exitcode.Database, err)
 	}
 
-	// Create repositories
+	// Create repositories and services
+	techService := technology.NewTechnologyService(
+		technology.NewRepository(dbpool),
+		techalias.NewRepository(dbpool),
+		nil,
+	)
+	jobRepo := jobs.NewRepository(dbpool)
 	repos := &repositories{
-		job:     jobs.NewRepository(dbpool),
-		company: company.NewRepository(dbpool),
-		jobtech: jobtech.NewRepository(dbpool),
-		tech:    technology.NewRepository(dbpool),
-		alias:   techalias.NewRepository(dbpool),
+		job:         jobRepo,
+		duplicates:  jobs.NewDuplicateDetector(jobRepo),
+		canonical:   jobs.NewCanonicalMatcher(jobRepo),
+		jobtech:     jobtech.NewRepository(dbpool),
+		jobfunction: jobfunction.NewRepository(dbpool),
+		benefit:     benefit.NewRepository(dbpool),
+		location:    location.NewRepository(dbpool),
+		company:     company.NewCompanyService(company.NewRepository(dbpool), companyalias.NewRepository(dbpool), nil),
+		tech:        techService,
+		pending:     pendingtech.NewPendingTechnologyService(pendingtech.NewRepository(dbpool), techService),
+		detect:      techdetect.NewDetectionService(techdetect.NewRepository(dbpool), techdetect.DefaultAutoAcceptConfidence),
+		ingest:      ingest.NewIngestService(ingest.NewRepository(dbpool)),
+	}
+
+	// Index the jobs into OpenSearch when it serves the job search
+	if cfg.SearchBackend == config.SearchBackendOpenSearch {
+		repos.indexer = opensearch.NewIndexer(opensearch.NewClient(cfg.OpenSearch), jobRepo)
 	}
 
 	return dbpool, repos, nil
 }
 
-// repositories holds all the database repositories needed
+// repositories holds all the database repositories and services needed
 type repositories struct {
-	job     *jobs.Repository
-	company *company.Repository
-	jobtech *jobtech.Repository
-	tech    *technology.Repository
-	alias   *techalias.Repository
+	job         *jobs.Repository
+	duplicates  *jobs.DuplicateDetector
+	canonical   *jobs.CanonicalMatcher
+	jobtech     *jobtech.Repository
+	jobfunction *jobfunction.Repository
+	benefit     *benefit.Repository
+	location    *location.Repository
+	company     *company.CompanyService
+	tech        *technology.TechnologyService
+	pending     *pendingtech.PendingTechnologyService
+	detect      *techdetect.DetectionService
+	indexer     *opensearch.Indexer // nil unless OpenSearch serves the job search
+	ingest      *ingest.IngestService
 }
 
-// readJobData reads and parses the job data from the input file
-func readJobData(inputFile string, log *logrus.Logger) (*internalJobs, error) {
+// readJobData reads and parses the job data from the input file. The jobs not matching the schema
+// are skipped and added to failures.
+func readJobData(inputFile string, failures *exitcode.Collector, log *logrus.Logger) (*internalJobs, error) {
 	log.Infof("Reading job data from %s", inputFile)
 
 	// Read job data from file
@@ -145,34 +408,162 @@ func readJobData(inputFile string, log *logrus.Logger) (*internalJobs, error) {
 		return nil, err
 	}
 
-	// Parse job data
-	var jobData internalJobs
-	if err := json.Unmarshal(data, &jobData); err != nil {
+	// Check the job data against the schema published to the scrapers, skipping the invalid jobs
+	invalid := make(map[int]bool)
+	if _, err = ingest.ValidateJobs(data); err != nil {
+		var schemaErr *ingest.SchemaError
+		if !errors.As(err, &schemaErr) {
+			log.Errorf("Failed to parse job data: %v", err)
+			return nil, exitcode.Wrap(exitcode.Validation, err)
+		}
+		for _, violation := range schemaErr.Violations {
+			if violation.Job < 0 {
+				log.Errorf("Job data doesn't match the schema: %s", violation)
+				return nil, exitcode.Wrap(exitcode.Validation, schemaErr)
+			}
+			log.WithFields(logrus.Fields{"job": violation.Job, "pointer": violation.Pointer, "outcome": outcomeInvalid}).
+				Warnf("Skipping invalid job: %s", violation.Message)
+			if invalid[violation.Job] {
+				continue
+			}
+			invalid[violation.Job] = true
+			if err = failures.Add(exitcode.Validation, fmt.Errorf("invalid job %s", violation)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Parse job data, one job at a time since the invalid ones may not fit the job type
+	var rawJobs struct {
+		Jobs []json.RawMessage `json:"jobs"`
+	}
+	if err = json.Unmarshal(data, &rawJobs); err != nil {
 		log.Errorf("Failed to parse job data: %v", err)
 		return nil, err
 	}
+	parsed := internalJobs{Jobs: make([]jobData, 0, len(rawJobs.Jobs)-len(invalid))}
+	for i, raw := range rawJobs.Jobs {
+		if invalid[i] {
+			continue
+		}
+		var job jobData
+		if err = json.Unmarshal(raw, &job); err != nil {
+			log.Errorf("Failed to parse job %d: %v", i, err)
+			return nil, err
+		}
+		parsed.Jobs = append(parsed.Jobs, job)
+	}
+
+	if len(invalid) > 0 {
+		log.Warnf("Skipped %d jobs not matching the schema", len(invalid))
+	}
+	log.Infof("Found %d jobs to process", len(parsed.Jobs))
+	return &parsed, nil
+}
+
+// lookups holds what the jobs are resolved against, loaded once per run instead of once per job
+type lookups struct {
+	// companies are the companies named by the job data, by trimmed name as written in it
+	companies    map[string]*company.Company
+	technologies *technology.Dictionary
+}
+
+// preload loads the companies named by the job data and the technology dictionary
+func preload(ctx context.Context, jobData *internalJobs, repos *repositories, log *logrus.Logger) (*lookups, error) {
+	companies, err := preloadCompanies(ctx, jobData, repos.company, log)
+	if err != nil {
+		return nil, err
+	}
+
+	technologies, err := repos.tech.LoadDictionary(ctx)
+	if err != nil {
+		log.Errorf("Failed to load the technology dictionary: %v", err)
+		return nil, err
+	}
+	log.Infof("Loaded %d technologies with their aliases", technologies.Len())
 
-	log.Infof("Found %d jobs to process", len(jobData.Jobs))
-	return &jobData, nil
+	return &lookups{companies: companies, technologies: technologies}, nil
 }
 
-// processJobs processes each job and returns a map of missing technologies
-func processJobs(ctx context.Context, jobData *internalJobs, repos *repositories,
-	log *logrus.Logger) (map[string][]string, error) {
+// preloadCompanies resolves the companies named by the job data, by trimmed name as written in it.
+// They are looked up by name in a single query, then by alias for the few names of no company, e.g.
+// the former names of renamed companies. Names of unknown companies are missing from the map.
+func preloadCompanies(ctx context.Context, jobData *internalJobs, companies *company.CompanyService,
+	log *logrus.Logger) (map[string]*company.Company, error) {
+	names := make([]string, 0, len(jobData.Jobs))
+	for i := range jobData.Jobs {
+		names = append(names, jobData.Jobs[i].Company)
+	}
+
+	resolved, err := companies.GetByNames(ctx, names)
+	if err != nil {
+		log.Errorf("Failed to get the companies of the jobs: %v", err)
+		return nil, err
+	}
+	unknown := make(map[string]bool)
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if _, ok := resolved[name]; ok || name == "" || unknown[name] {
+			continue
+		}
+		c, err := companies.FindByNameOrAlias(ctx, name)
+		if err != nil {
+			if company.IsNotFound(err) {
+				unknown[name] = true
+				continue
+			}
+			log.Errorf("Failed to find company %s: %v", name, err)
+			return nil, err
+		}
+		resolved[name] = c
+	}
+
+	log.Infof("Resolved %d companies named by the jobs", len(resolved))
+	return resolved, nil
+}
+
+// processJobs processes each job and returns a map of missing technologies, the quarantined jobs and
+// the IDs of the processed jobs. New jobs are created with the given status, resolved against lookup.
+// The jobs that fail are added to failures.
+func processJobs(ctx context.Context, jobData *internalJobs, repos *repositories, lookup *lookups,
+	status jobs.Status, failures *exitcode.Collector, log *logrus.Logger) (map[string][]string, []quarantinedJob, []int,
+	error) {
 	// Create a map to track missing technologies
 	missingTechnologies := make(map[string][]string) // company -> list of missing tech names
+	var quarantined []quarantinedJob
+	var jobIDs []int
 
 	// Process each job
 	for i := range jobData.Jobs {
 		j := &jobData.Jobs[i] // Use a pointer to the job instead of copying it
+		// Every job is logged with its outcome, so the runs can be audited from the log file
+		entry := log.WithFields(logrus.Fields{"company": j.Company, "title": j.Title})
+
+		// Jobs with attribute values matching no known value are left for review
+		if unknown := normalizeJobData(j); len(unknown) > 0 {
+			entry.WithField("outcome", outcomeQuarantined).Warnf("Quarantining job, unknown values: %v", unknown)
+			quarantined = append(quarantined, quarantinedJob{
+				Company:        j.Company,
+				Title:          j.Title,
+				ApplicationURL: j.ApplicationURL,
+				Fields:         unknown,
+			})
+			continue
+		}
 
 		// Process job and its technologies
-		jobMissingTechs, err := processJob(ctx, j, repos, log)
+		jobID, outcome, jobMissingTechs, err := processJob(ctx, j, repos, lookup, status, log)
+		entry = entry.WithFields(logrus.Fields{"signature": j.Signature, "outcome": outcome})
 		if err != nil {
-			// Log error but continue with next job
-			log.Warnf("Error processing job %s: %v", j.Title, err)
+			// Log error but continue with next job, unless failing on the first one
+			entry.Warnf("Error processing job: %v", err)
+			if err = failures.Add(exitcode.Partial, fmt.Errorf("job %s at %s: %w", j.Title, j.Company, err)); err != nil {
+				return nil, nil, nil, err
+			}
 			continue
 		}
+		entry.WithField("job_id", jobID).Info("Processed job")
+		jobIDs = append(jobIDs, jobID)
 
 		// Add any missing technologies to the map
 		if len(jobMissingTechs) > 0 {
@@ -180,91 +571,337 @@ func processJobs(ctx context.Context, jobData *internalJobs, repos *repositories
 		}
 	}
 
-	return missingTechnologies, nil
+	return missingTechnologies, quarantined, jobIDs, nil
 }
 
-// Update the processJob function signature
-func processJob(ctx context.Context, j *jobData, repos *repositories, log *logrus.Logger) ([]string, error) {
-	// Find company by name
-	jobCompany, err := repos.company.GetByName(ctx, j.Company)
-	if err != nil {
+// normalizeJobData replaces the experience level, employment type and work mode of the job data
+// with their canonical values, inferring the experience level when it is missing or unknown. It
+// returns the values matching none by attribute.
+func normalizeJobData(j *jobData) map[string]string {
+	unknown := make(map[string]string)
+	for _, attr := range []struct {
+		field     string
+		value     *string
+		normalize func(string) (string, bool)
+	}{
+		{"experience_level", &j.ExperienceLevel, jobs.NormalizeExperienceLevel},
+		{"employment_type", &j.EmploymentType, jobs.NormalizeEmploymentType},
+		{"work_mode", &j.WorkMode, jobs.NormalizeWorkMode},
+	} {
+		value, ok := attr.normalize(*attr.value)
+		if !ok {
+			unknown[attr.field] = *attr.value
+			continue
+		}
+		*attr.value = value
+	}
+
+	// Jobs with a missing or unknown experience level get the one their title or description gives away
+	if _, ok := unknown["experience_level"]; ok {
+		if level, inferred := jobs.InferExperienceLevel(j.Title, j.Description); inferred {
+			j.ExperienceLevel = level
+			j.experienceLevelInferred = true
+			delete(unknown, "experience_level")
+		}
+	}
+	return unknown
+}
+
+// processJob stores a job and its technologies, returning its ID, what was done to it and the
+// names of its technologies matching no known technology
+func processJob(ctx context.Context, j *jobData, repos *repositories, lookup *lookups, status jobs.Status,
+	log *logrus.Logger) (int, jobOutcome, []string, error) {
+	// The company was found by name, or by a former name of renamed companies
+	jobCompany, ok := lookup.companies[strings.TrimSpace(j.Company)]
+	if !ok {
+		err := &company.NotFoundError{Name: j.Company}
 		log.Warnf("Error finding company %s: %v", j.Company, err)
-		return nil, err
+		return 0, outcomeFailed, nil, err
+	}
+
+	// Archived companies don't get new jobs
+	if !jobCompany.IsActive {
+		return 0, outcomeFailed, nil, fmt.Errorf("company %s is archived", jobCompany.Name)
 	}
 
 	companyID := jobCompany.ID
 
+	// Compute the signature when the job data doesn't provide one
+	if strings.TrimSpace(j.Signature) == "" {
+		j.Signature = jobs.ComputeSignature(jobCompany.Name, j.Title, j.ApplicationURL)
+	}
+
+	// Jobs located in places outside of the board's regions are rejected
+	jobLocation, err := repos.location.Resolve(ctx, j.Location)
+	if err != nil {
+		log.Warnf("Error resolving location %q of job %s: %v", j.Location, j.Title, err)
+		return 0, outcomeFailed, nil, err
+	}
+
+	language := jobs.Language(strings.TrimSpace(j.Language))
+	if !language.IsValid() {
+		if language != "" {
+			log.Warnf("Unknown language %q of job %s, detecting it", j.Language, j.Title)
+		}
+		language = jobs.DetectLanguage(j.Title, j.Description)
+	}
+
+	remoteEligibility, utcOffsetMin, utcOffsetMax := parseRemoteDetails(j, log)
+	source, sourceJobID := parseSource(j, log)
+
 	// Create job model
 	jobModel := &jobs.Job{
-		CompanyID:       companyID,
-		Title:           j.Title,
-		Description:     j.Description,
-		ExperienceLevel: j.ExperienceLevel,
-		EmploymentType:  j.EmploymentType,
-		Location:        j.Location,
-		WorkMode:        j.WorkMode,
-		ApplicationURL:  j.ApplicationURL,
-		IsActive:        true,
-		Signature:       j.Signature,
-	}
-	fmt.Print("Processing job: ", jobModel.Title, " at ", j.Company, "\n")
+		CompanyID:               companyID,
+		Title:                   j.Title,
+		RawDescription:          j.Description, // Sanitized when the job is stored
+		ExperienceLevel:         j.ExperienceLevel,
+		ExperienceLevelInferred: j.experienceLevelInferred,
+		EmploymentType:          j.EmploymentType,
+		Location:                jobLocation.Region,
+		LocationID:              &jobLocation.ID,
+		WorkMode:                j.WorkMode,
+		ApplicationURL:          j.ApplicationURL,
+		IsActive:                status == jobs.StatusPublished, // Only published jobs can be active
+		Signature:               j.Signature,
+		Language:                language,
+		Status:                  status,
+		RemoteEligibility:       remoteEligibility,
+		UTCOffsetMin:            utcOffsetMin,
+		UTCOffsetMax:            utcOffsetMax,
+		Source:                  source,
+		SourceJobID:             sourceJobID,
+	}
 
 	// Insert or retrieve job
-	if err := createOrRetrieveJob(ctx, jobModel, j, repos.job, log); err != nil {
-		return nil, err
+	outcome, err := createOrRetrieveJob(ctx, jobModel, j, repos, log)
+	if err != nil {
+		return 0, outcomeFailed, nil, err
 	}
 
-	log.Infof("Successfully added job: %s at %s (ID: %d)",
-		jobModel.Title, j.Company, jobModel.ID)
+	// Assign job functions for this job
+	assignJobFunctions(ctx, j, jobModel, repos.jobfunction, log)
+
+	// Assign benefits for this job
+	assignBenefits(ctx, j, jobModel, repos.benefit, log)
 
 	// Process technologies for this job
-	return processTechnologies(ctx, j, jobModel, repos, log)
+	missingTechs, err := processTechnologies(ctx, j, jobModel, repos, lookup.technologies, log)
+	if err != nil {
+		return jobModel.ID, outcome, missingTechs, err
+	}
+
+	// Detect the technologies missing from the job data in its description
+	detectTechnologies(ctx, jobModel, repos.detect, log)
+	return jobModel.ID, outcome, missingTechs, nil
 }
 
-// createOrRetrieveJob creates a new job or retrieves an existing one
-func createOrRetrieveJob(ctx context.Context, jobModel *jobs.Job, j *jobData, jobRepo *jobs.Repository,
-	log *logrus.Logger) error {
-	err := jobRepo.Create(ctx, jobModel)
+// parseRemoteDetails returns the remote eligibility and working timezones of the job data.
+// Unknown eligibilities and incomplete or out of range timezones are dropped with a warning.
+func parseRemoteDetails(j *jobData, log *logrus.Logger) (*jobs.RemoteEligibility, *int, *int) {
+	var remoteEligibility *jobs.RemoteEligibility
+	if value := strings.TrimSpace(j.RemoteEligibility); value != "" {
+		eligibility := jobs.RemoteEligibility(value)
+		if eligibility.IsValid() {
+			remoteEligibility = &eligibility
+		} else {
+			log.Warnf("Unknown remote eligibility %q of job %s, ignoring it", j.RemoteEligibility, j.Title)
+		}
+	}
+
+	if j.UTCOffsetMin == nil && j.UTCOffsetMax == nil {
+		return remoteEligibility, nil, nil
+	}
+	if j.UTCOffsetMin == nil || j.UTCOffsetMax == nil ||
+		*j.UTCOffsetMin < jobs.MinUTCOffset || *j.UTCOffsetMax > jobs.MaxUTCOffset || *j.UTCOffsetMin > *j.UTCOffsetMax {
+		log.Warnf("Invalid UTC offset range of job %s, ignoring it", j.Title)
+		return remoteEligibility, nil, nil
+	}
+	return remoteEligibility, j.UTCOffsetMin, j.UTCOffsetMax
+}
+
+// parseSource returns the source of the job data and the ID of the job there. Unknown sources are
+// dropped with a warning, along with the ID.
+func parseSource(j *jobData, log *logrus.Logger) (*jobs.Source, *string) {
+	value := strings.TrimSpace(j.Source)
+	if value == "" {
+		return nil, nil
+	}
+	source := jobs.Source(value)
+	if !source.IsValid() {
+		log.Warnf("Unknown source %q of job %s, ignoring it", j.Source, j.Title)
+		return nil, nil
+	}
+	if sourceJobID := strings.TrimSpace(j.SourceJobID); sourceJobID != "" {
+		return &source, &sourceJobID
+	}
+	return &source, nil
+}
+
+// createOrRetrieveJob creates a new job or retrieves an existing one. New jobs found at another source
+// too are created as alternates of the job stored first. The content of an existing published job is
+// updated when it changed. The database records the job events of both in the outbox. It returns
+// what was done to the job.
+func createOrRetrieveJob(ctx context.Context, jobModel *jobs.Job, j *jobData, repos *repositories,
+	log *logrus.Logger) (jobOutcome, error) {
+	if err := repos.canonical.Match(ctx, jobModel); err != nil {
+		log.Warnf("Failed to match job %s with the jobs of other sources: %v", j.Title, err)
+	} else if jobModel.CanonicalJobID != nil {
+		log.Infof("Job %s at %s is an alternate of job ID %d", j.Title, j.Company, *jobModel.CanonicalJobID)
+	}
+
+	err := repos.job.Create(ctx, jobModel)
 	if err != nil {
 		if jobs.IsDuplicate(err) {
 			log.Infof("Job already exists: %s at %s", j.Title, j.Company)
 
-			// Get the existing job by signature to retrieve its ID
-			existingJob, findErr := jobRepo.GetBySignature(ctx, j.Signature)
+			// Get the existing job by its ID at the source, or by signature, to retrieve its ID
+			existingJob, findErr := findExistingJob(ctx, err, j, repos)
 			if findErr != nil {
 				log.Warnf("Failed to retrieve existing job %s: %v", j.Title, findErr)
-				return findErr
+				return outcomeFailed, findErr
 			}
 
 			// Use the existing job's ID for technology associations
 			jobModel.ID = existingJob.ID
 			log.Infof("Using existing job ID: %d", jobModel.ID)
-			return nil
+			return updateJobContent(ctx, existingJob, jobModel, repos, log)
 		}
 		log.Warnf("Failed to insert job %s: %v", j.Title, err)
-		return err
+		return outcomeFailed, err
+	}
+	return outcomeCreated, nil
+}
+
+// findExistingJob returns the job the duplicate error is about. A job with the same ID at its
+// source is the same job even when its title or application URL changed since the last scrape.
+func findExistingJob(ctx context.Context, err error, j *jobData, repos *repositories) (*jobs.Job, error) {
+	var duplicateErr *jobs.DuplicateError
+	if errors.As(err, &duplicateErr) && duplicateErr.SourceJobID != "" {
+		return repos.job.GetBySourceJobID(ctx, duplicateErr.Source, duplicateErr.SourceJobID)
+	}
+	return repos.job.GetBySignature(ctx, j.Signature)
+}
+
+// updateJobContent updates an existing published job with the content of the job data
+// when it changed, and records the source of jobs stored before it was known. Pending and rejected
+// jobs are left for the moderation queue. It returns whether the job was updated.
+func updateJobContent(ctx context.Context, existingJob, jobModel *jobs.Job, repos *repositories,
+	log *logrus.Logger) (jobOutcome, error) {
+	if existingJob.Status != jobs.StatusPublished ||
+		(existingJob.RawDescription == jobModel.RawDescription &&
+			existingJob.ExperienceLevel == jobModel.ExperienceLevel &&
+			existingJob.ExperienceLevelInferred == jobModel.ExperienceLevelInferred &&
+			existingJob.EmploymentType == jobModel.EmploymentType &&
+			existingJob.Location == jobModel.Location &&
+			samePtr(existingJob.LocationID, jobModel.LocationID) &&
+			existingJob.WorkMode == jobModel.WorkMode &&
+			existingJob.Language == jobModel.Language &&
+			samePtr(existingJob.RemoteEligibility, jobModel.RemoteEligibility) &&
+			samePtr(existingJob.UTCOffsetMin, jobModel.UTCOffsetMin) &&
+			samePtr(existingJob.UTCOffsetMax, jobModel.UTCOffsetMax) &&
+			(existingJob.Source != nil || jobModel.Source == nil)) {
+		return outcomeUnchanged, nil
+	}
+
+	existingJob.RawDescription = jobModel.RawDescription
+	existingJob.ExperienceLevel = jobModel.ExperienceLevel
+	existingJob.ExperienceLevelInferred = jobModel.ExperienceLevelInferred
+	existingJob.EmploymentType = jobModel.EmploymentType
+	existingJob.Location = jobModel.Location
+	existingJob.LocationID = jobModel.LocationID
+	existingJob.WorkMode = jobModel.WorkMode
+	existingJob.Language = jobModel.Language
+	existingJob.RemoteEligibility = jobModel.RemoteEligibility
+	existingJob.UTCOffsetMin = jobModel.UTCOffsetMin
+	existingJob.UTCOffsetMax = jobModel.UTCOffsetMax
+	if existingJob.Source == nil {
+		existingJob.Source = jobModel.Source
+		existingJob.SourceJobID = jobModel.SourceJobID
+	}
+	if err := repos.job.Update(ctx, existingJob); err != nil {
+		log.Warnf("Failed to update job ID %d: %v", existingJob.ID, err)
+		return outcomeFailed, err
+	}
+	log.Infof("Updated content of job ID %d", existingJob.ID)
+	return outcomeUpdated, nil
+}
+
+// samePtr reports whether a and b are both nil or point to equal values
+func samePtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// assignJobFunctions associates a job with its job functions. Unknown functions are skipped.
+func assignJobFunctions(ctx context.Context, j *jobData, jobModel *jobs.Job,
+	jobfunctionRepo *jobfunction.Repository, log *logrus.Logger) {
+	for _, name := range j.Functions {
+		function, err := jobfunctionRepo.GetByName(ctx, strings.TrimSpace(name))
+		if err != nil {
+			log.Warnf("Skipping job function %s for job ID %d: %v", name, jobModel.ID, err)
+			continue
+		}
+
+		if err = jobfunctionRepo.AssignToJob(ctx, jobModel.ID, function.ID); err != nil {
+			log.Warnf("Failed to assign job function %s to job ID %d: %v", function.Name, jobModel.ID, err)
+			continue
+		}
+		log.Debugf("Assigned job function %s to job ID %d", function.Name, jobModel.ID)
+	}
+}
+
+// assignBenefits associates a job with the benefits it offers. Unknown benefits are skipped.
+func assignBenefits(ctx context.Context, j *jobData, jobModel *jobs.Job, benefitRepo *benefit.Repository,
+	log *logrus.Logger) {
+	for _, name := range j.Benefits {
+		jobBenefit, err := benefitRepo.GetByName(ctx, name)
+		if err != nil {
+			log.Warnf("Skipping benefit %s for job ID %d: %v", name, jobModel.ID, err)
+			continue
+		}
+
+		if err = benefitRepo.AssignToJob(ctx, jobModel.ID, jobBenefit.ID); err != nil {
+			log.Warnf("Failed to assign benefit %s to job ID %d: %v", jobBenefit.Name, jobModel.ID, err)
+			continue
+		}
+		log.Debugf("Assigned benefit %s to job ID %d", jobBenefit.Name, jobModel.ID)
 	}
-	return nil
 }
 
 // processTechnologies processes all technologies for a job
 func processTechnologies(ctx context.Context, j *jobData, jobModel *jobs.Job, repos *repositories,
-	log *logrus.Logger) ([]string, error) {
+	dict *technology.Dictionary, log *logrus.Logger) ([]string, error) {
 	var missingTechs []string
 
 	for _, tech := range j.Technologies {
 		techName := strings.ToLower(tech.Name)
 
-		// Find technology by name or alias
-		techModel, err := findTechnology(ctx, techName, repos, log)
+		// Find technology by name, alias or similarity
+		match, err := findTechnology(ctx, techName, repos, dict, log)
 		if err != nil {
 			missingTechs = append(missingTechs, techName)
+			if technology.IsNotFound(err) {
+				recordPendingTechnology(ctx, techName, nil, repos.pending, log)
+			}
+			continue
+		}
+
+		// Low confidence matches are left for review instead of being associated
+		if !match.Confident {
+			recordPendingTechnology(ctx, techName, match, repos.pending, log)
 			continue
 		}
 
 		// Create job technology association
-		if err := createJobTechnology(ctx, jobModel.ID, techModel.ID,
-			tech.Required, techName, repos.jobtech, log); err != nil {
+		jobTech := &jobtech.JobTechnology{
+			JobID:        jobModel.ID,
+			TechnologyID: match.Technology.ID,
+			IsPrimary:    tech.Primary,
+			IsRequired:   tech.Required || tech.Primary,
+		}
+		if err := createJobTechnology(ctx, jobTech, techName, repos.jobtech, log); err != nil {
 			continue
 		}
 	}
@@ -272,54 +909,75 @@ func processTechnologies(ctx context.Context, j *jobData, jobModel *jobs.Job, re
 	return missingTechs, nil
 }
 
-// findTechnology tries to find a technology by name or alias
-func findTechnology(ctx context.Context, techName string, repos *repositories,
-	log *logrus.Logger) (*technology.Technology, error) {
-	// Find technology by name
-	techModel, err := repos.tech.GetByName(ctx, techName)
-	if err == nil {
-		return techModel, nil
+// findTechnology tries to find a technology by name or alias in the dictionary, falling back to fuzzy
+// matching in the database
+func findTechnology(ctx context.Context, techName string, repos *repositories, dict *technology.Dictionary,
+	log *logrus.Logger) (*technology.Match, error) {
+	match, err := repos.tech.FindMatchIn(ctx, dict, techName, techMatchOptions)
+	if err != nil {
+		log.Warnf("Technology not found by name or alias: %s: %v", techName, err)
+		return nil, err
 	}
 
-	// If not found by exact name, try to find by alias
-	alias, aliasErr := repos.alias.GetByAlias(ctx, techName)
-	if aliasErr != nil {
-		log.Warnf("Technology not found by name or alias: %s: %v", techName, err)
-		return nil, aliasErr
+	switch {
+	case !match.Confident:
+		log.Infof("Technology %s looks like %s (score %.2f), recording it for review",
+			techName, match.Technology.Name, match.Score)
+	case match.Technology.Name != techName:
+		log.Infof("Found technology %s via %s match of %s", match.Technology.Name, match.Method, techName)
 	}
+	return match, nil
+}
 
-	// Get the technology using the alias's technology ID
-	techModel, err = repos.tech.GetByID(ctx, alias.TechnologyID)
+// detectTechnologies records the known technologies found in the description of a job that it isn't
+// associated with. Confident detections are added to the job, the others wait for review.
+func detectTechnologies(ctx context.Context, jobModel *jobs.Job, detectService *techdetect.DetectionService,
+	log *logrus.Logger) {
+	detections, err := detectService.Detect(ctx, &techdetect.JobSource{
+		JobID:       jobModel.ID,
+		Description: jobModel.RawDescription,
+	})
 	if err != nil {
-		log.Warnf("Error finding technology by alias ID %d: %v", alias.TechnologyID, err)
-		return nil, err
+		log.Warnf("Failed to detect technologies of job ID %d: %v", jobModel.ID, err)
+		return
 	}
 
-	log.Infof("Found technology %s via alias %s", techModel.Name, techName)
-	return techModel, nil
+	for _, detection := range detections {
+		if detection.Status == techdetect.StatusAccepted {
+			log.Infof("Added technology %s detected in the description of job ID %d", detection.Term, jobModel.ID)
+		} else {
+			log.Infof("Technology %s detected in the description of job ID %d, recorded for review (confidence %.2f)",
+				detection.Term, jobModel.ID, detection.Confidence)
+		}
+	}
 }
 
-// createJobTechnology creates a job-technology association
-func createJobTechnology(ctx context.Context, jobID, techID int, isRequired bool, techName string,
-	jobtechRepo *jobtech.Repository, log *logrus.Logger) error {
-	jobTechModel := &jobtech.JobTechnology{
-		JobID:        jobID,
-		TechnologyID: techID,
-		IsRequired:   isRequired,
+// recordPendingTechnology records an unknown technology so it can be reviewed by an admin
+func recordPendingTechnology(ctx context.Context, techName string, suggestion *technology.Match,
+	pendingService *pendingtech.PendingTechnologyService, log *logrus.Logger) {
+	pending, err := pendingService.Record(ctx, techName, suggestion)
+	if err != nil {
+		log.Warnf("Failed to record pending technology %s: %v", techName, err)
+		return
 	}
+	log.Debugf("Recorded pending technology %s (%d occurrences)", pending.Name, pending.Occurrences)
+}
 
+// createJobTechnology creates a job-technology association
+func createJobTechnology(ctx context.Context, jobTech *jobtech.JobTechnology, techName string,
+	jobtechRepo *jobtech.Repository, log *logrus.Logger) error {
 	// Insert job technology into database
-	err := jobtechRepo.Create(ctx, jobTechModel)
+	err := jobtechRepo.Create(ctx, jobTech)
 	if err != nil {
 		if jobtech.IsDuplicate(err) {
-			log.Debugf("Job technology association already exists: %s for job ID %d", techName, jobID)
+			log.Debugf("Job technology association already exists: %s for job ID %d", techName, jobTech.JobID)
 			return nil
 		}
 		log.Warnf("Failed to insert job technology %s: %v", techName, err)
 		return err
 	}
 
-	log.Infof("Added technology %s to job ID %d", techName, jobID)
+	log.Infof("Added technology %s to job ID %d", techName, jobTech.JobID)
 	return nil
 }
 
@@ -345,3 +1003,161 @@ func writeMissingTechnologies(missingTechnologies map[string][]string,
 	log.Infof("Missing technologies saved to %s", missingTechFile)
 	return nil
 }
+
+// writeQuarantinedJobs writes the quarantined jobs to a file so their values can be reviewed and
+// added as variants
+func writeQuarantinedJobs(quarantined []quarantinedJob, quarantineFile string, log *logrus.Logger) error {
+	if len(quarantined) == 0 {
+		return nil
+	}
+
+	quarantineData, err := json.MarshalIndent(quarantined, "", "  ")
+	if err != nil {
+		log.Errorf("Failed to marshal quarantined jobs: %v", err)
+		return err
+	}
+
+	err = os.WriteFile(quarantineFile, quarantineData, 0o644)
+	if err != nil {
+		log.Errorf("Failed to write quarantined jobs file: %v", err)
+		return err
+	}
+
+	log.Infof("%d quarantined jobs saved to %s", len(quarantined), quarantineFile)
+	return nil
+}
+
+// reportNearDuplicates writes the near-duplicates of the processed jobs to a file so reposted
+// jobs can be reviewed
+func reportNearDuplicates(ctx context.Context, jobIDs []int, detector *jobs.DuplicateDetector,
+	nearDuplicatesFile string, log *logrus.Logger) error {
+	duplicates, err := detector.FindForJobs(ctx, jobIDs)
+	if err != nil {
+		log.Errorf("Failed to find near-duplicate jobs: %v", err)
+		return err
+	}
+	if len(duplicates) == 0 {
+		return nil
+	}
+
+	for _, duplicate := range duplicates {
+		log.Warnf("Possible duplicate jobs at %s: %d %q and %d %q (title %.2f, url %.2f)",
+			duplicate.CompanyName, duplicate.First.JobID, duplicate.First.Title,
+			duplicate.Second.JobID, duplicate.Second.Title,
+			duplicate.TitleSimilarity, duplicate.URLSimilarity)
+	}
+
+	nearDuplicatesData, err := json.MarshalIndent(jobs.MapNearDuplicatesToResponse(duplicates), "", "  ")
+	if err != nil {
+		log.Errorf("Failed to marshal near-duplicate jobs: %v", err)
+		return err
+	}
+
+	err = os.WriteFile(nearDuplicatesFile, nearDuplicatesData, 0o644)
+	if err != nil {
+		log.Errorf("Failed to write near-duplicate jobs file: %v", err)
+		return err
+	}
+
+	log.Infof("%d near-duplicate job pairs saved to %s", len(duplicates), nearDuplicatesFile)
+	return nil
+}
+
+// reportIngestVolumes records the import as an ingest run with the jobs found for every company, none
+// for the active companies missing from the job data, and writes the companies whose number of jobs
+// is anomalous compared with the previous imports to a file
+func reportIngestVolumes(ctx context.Context, jobData *internalJobs, repos *repositories,
+	companies map[string]*company.Company, volumeAnomaliesFile string, log *logrus.Logger) error {
+	jobsFound, err := countJobsByCompany(ctx, jobData, companies, repos.company)
+	if err != nil {
+		log.Errorf("Failed to count jobs by company: %v", err)
+		return err
+	}
+
+	run, err := repos.ingest.StartRun(ctx, ingestSource)
+	if err != nil {
+		log.Errorf("Failed to start ingest run: %v", err)
+		return err
+	}
+
+	names := make([]string, 0, len(jobsFound))
+	for name := range jobsFound {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		report := &ingest.CompanyReport{
+			RunID:       run.ID,
+			CompanyName: name,
+			Status:      ingest.StatusSucceeded,
+			JobsFound:   jobsFound[name],
+		}
+		if err = repos.ingest.ReportCompany(ctx, report); err != nil {
+			log.Errorf("Failed to report jobs found for %s: %v", name, err)
+			if _, closeErr := repos.ingest.CloseRun(ctx, run.ID, ingest.StatusFailed, err.Error()); closeErr != nil {
+				log.Warnf("Failed to close ingest run %d: %v", run.ID, closeErr)
+			}
+			return err
+		}
+	}
+
+	if _, err = repos.ingest.CloseRun(ctx, run.ID, ingest.StatusSucceeded, ""); err != nil {
+		log.Errorf("Failed to close ingest run %d: %v", run.ID, err)
+		return err
+	}
+
+	anomalies, err := repos.ingest.Anomalies(ctx, run.ID)
+	if err != nil {
+		log.Errorf("Failed to detect volume anomalies: %v", err)
+		return err
+	}
+	if len(anomalies) == 0 {
+		return nil
+	}
+
+	for _, anomaly := range anomalies {
+		log.Warnf("Anomalous number of jobs at %s (%s): %d jobs, %.1f on average over %d imports",
+			anomaly.CompanyName, anomaly.Kind, anomaly.JobsFound, anomaly.Average, anomaly.BaselineRuns)
+	}
+
+	volumeAnomaliesData, err := json.MarshalIndent(ingest.MapAnomaliesToResponse(anomalies), "", "  ")
+	if err != nil {
+		log.Errorf("Failed to marshal volume anomalies: %v", err)
+		return err
+	}
+
+	err = os.WriteFile(volumeAnomaliesFile, volumeAnomaliesData, 0o644)
+	if err != nil {
+		log.Errorf("Failed to write volume anomalies file: %v", err)
+		return err
+	}
+
+	log.Infof("%d volume anomalies saved to %s", len(anomalies), volumeAnomaliesFile)
+	return nil
+}
+
+// countJobsByCompany counts the jobs of the job data by company name, starting from zero for every
+// active company. Jobs of unknown companies are skipped, processJobs already reports them.
+func countJobsByCompany(ctx context.Context, jobData *internalJobs, resolved map[string]*company.Company,
+	companies *company.CompanyService) (map[string]int, error) {
+	all, err := companies.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	jobsFound := make(map[string]int) // company name -> jobs found
+	for _, c := range all {
+		if c.IsActive {
+			jobsFound[c.Name] = 0
+		}
+	}
+
+	for i := range jobData.Jobs {
+		if c, ok := resolved[strings.TrimSpace(jobData.Jobs[i].Company)]; ok {
+			jobsFound[c.Name]++
+		}
+	}
+
+	return jobsFound, nil
+}
@@ -5,15 +5,20 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"flag"
+	"fmt"
 	"os"
 	"os/signal"
 	"path/filepath"
-	"strings"
 	"syscall"
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/rodruizronald/ticos-in-tech/internal/actor"
+	"github.com/rodruizronald/ticos-in-tech/internal/config"
 	"github.com/rodruizronald/ticos-in-tech/internal/database"
+	"github.com/rodruizronald/ticos-in-tech/internal/exitcode"
+	"github.com/rodruizronald/ticos-in-tech/internal/logging"
 	"github.com/rodruizronald/ticos-in-tech/internal/techalias"
 	"github.com/rodruizronald/ticos-in-tech/internal/technology"
 )
@@ -29,127 +34,150 @@ type Technology struct {
 
 func main() {
 	var err error
-	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	// The changes are recorded as made by the user running the command
+	ctx := actor.With(context.Background(), actor.CLI("db_tech_populator"))
+	ctx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
 	defer func() {
 		stop()
 		if err != nil {
-			os.Exit(1)
+			os.Exit(exitcode.Of(err))
 		}
 	}()
-	err = run(ctx)
+
+	strict := flag.Bool("strict", false, "Stop at the first technology that fails instead of going on with the others")
+	flag.Parse()
+	err = run(ctx, *strict)
 }
 
-func run(ctx context.Context) error {
+func run(ctx context.Context, strict bool) error {
 	// Configure logger
 	log := logrus.New()
 	log.SetFormatter(&logrus.TextFormatter{
 		FullTimestamp: true,
 	})
 
-	// Get database config
-	dbConfig := database.DefaultConfig()
+	// Load configuration from the environment
+	cfg, err := config.Load()
+	if err != nil {
+		log.Errorf("Invalid configuration: %v", err)
+		return exitcode.Wrap(exitcode.Config, err)
+	}
 
-	log.Infof("Connecting to database %s at %s:%d", dbConfig.DBName, dbConfig.Host, dbConfig.Port)
+	// Write the logs in the configured format, copied to the log file if any
+	logFile := logging.Configure(log, cfg.Logging)
+	defer logFile.Close()
+
+	log.Infof("Connecting to database %s at %s:%d", cfg.Database.DBName, cfg.Database.Host, cfg.Database.Port)
 
 	// Connect to the database
-	dbpool, err := database.Connect(ctx, &dbConfig)
+	dbpool, err := database.Connect(ctx, &cfg.Database)
 	if err != nil {
 		log.Errorf("Unable to connect to database: %v", err)
+		return exitcode.Wrap(exitcode.Database, err)
 	}
 	defer dbpool.Close()
 
-	// Create repositories
-	techRepo := technology.NewRepository(dbpool)
-	aliasRepo := techalias.NewRepository(dbpool)
-
-	// Process technologies
-	processTechnologies(ctx, log, techRepo, aliasRepo)
+	// Create the technology service
+	techService := technology.NewTechnologyService(
+		technology.NewRepository(dbpool),
+		techalias.NewRepository(dbpool),
+		nil,
+	)
+
+	// Process technologies. The ones that fail are skipped, failing the run once the others are imported.
+	failures := exitcode.NewCollector(strict)
+	if err = processTechnologies(ctx, log, techService, failures); err != nil {
+		return err
+	}
 
+	if err = failures.Err(); err != nil {
+		log.Errorf("Technology import completed with %d failures", failures.Len())
+		return err
+	}
 	log.Info("Technology import completed")
 	return nil
 }
 
-// processTechnologies handles the two-pass technology import process
-func processTechnologies(ctx context.Context, log *logrus.Logger, techRepo *technology.Repository,
-	aliasRepo *techalias.Repository) {
+// processTechnologies handles the two-pass technology import process. The technologies that fail
+// are added to failures.
+func processTechnologies(ctx context.Context, log *logrus.Logger, techService *technology.TechnologyService,
+	failures *exitcode.Collector) error {
 	// Create a map to store all technologies by name for lookup
 	techMap := make(map[string]*technology.Technology)
 
 	// Process and insert all technologies
-	technologies := readTechnologiesFromJSON()
+	technologies, err := readTechnologiesFromJSON()
+	if err != nil {
+		log.Errorf("Failed to read technologies from JSON: %v", err)
+		return err
+	}
 	log.Infof("Loaded %d technologies from JSON file", len(technologies))
 
 	// First pass: create technologies without parent references
 	log.Info("Starting first pass: creating technologies without parent references")
-	createTechnologies(ctx, log, techRepo, aliasRepo, technologies, techMap)
+	if err = createTechnologies(ctx, log, techService, technologies, techMap, failures); err != nil {
+		return err
+	}
 
 	// Second pass: update technologies with parent references
 	log.Info("Starting second pass: updating technologies with parent references")
-	updateTechnologyParents(ctx, log, techRepo, technologies, techMap)
+	return updateTechnologyParents(ctx, log, techService, technologies, techMap, failures)
 }
 
-// createTechnologies handles the first pass of creating technologies
-func createTechnologies(ctx context.Context, log *logrus.Logger, techRepo *technology.Repository,
-	aliasRepo *techalias.Repository, technologies []Technology, techMap map[string]*technology.Technology) {
+// createTechnologies handles the first pass of creating technologies and their aliases
+func createTechnologies(ctx context.Context, log *logrus.Logger, techService *technology.TechnologyService,
+	technologies []Technology, techMap map[string]*technology.Technology, failures *exitcode.Collector) error {
 
 	for _, tech := range technologies {
-		// Convert name to lowercase
-		techName := strings.ToLower(tech.Name)
-
 		// Create the technology model
 		newTech := &technology.Technology{
-			Name:     techName,
+			Name:     tech.Name,
 			Category: tech.Category,
 			// Parent ID will be set in the second pass
 		}
 
-		var existingTech *technology.Technology
-
-		// Insert into database
-		err := techRepo.Create(ctx, newTech)
-		if err != nil {
-			// Skip if it's a duplicate
-			if technology.IsDuplicate(err) {
-				log.Infof("Technology already exists: %s", techName)
-
-				// Fetch the existing technology to use for parent mapping
-				existingTech, err = techRepo.GetByName(ctx, techName)
-				if err != nil {
-					log.Warnf("Error fetching existing technology %s: %v", techName, err)
-					continue
-				}
-				techMap[techName] = existingTech
-
-				// Add aliases for existing technology
-				addAliases(ctx, log, aliasRepo, existingTech.ID, tech.Alias)
-				continue
+		created, err := techService.CreateWithAliases(ctx, newTech, tech.Alias)
+		if err != nil && newTech.ID == 0 {
+			// The technology itself could not be created or loaded
+			log.Warnf("Error creating technology %s: %v", tech.Name, err)
+			if err = failures.Add(exitcode.Partial, fmt.Errorf("technology %s: %w", tech.Name, err)); err != nil {
+				return err
 			}
-			log.Warnf("Error creating technology %s: %v", techName, err)
 			continue
 		}
+		techMap[newTech.Name] = newTech
 
-		log.Infof("Created technology: %s (ID: %d)", techName, newTech.ID)
-		techMap[techName] = newTech
+		if created {
+			log.Infof("Created technology: %s (ID: %d)", newTech.Name, newTech.ID)
+		} else {
+			log.Infof("Technology already exists: %s", newTech.Name)
+		}
 
-		// Add aliases for new technology
-		addAliases(ctx, log, aliasRepo, newTech.ID, tech.Alias)
+		if err != nil {
+			log.Warnf("Error creating aliases for technology %s: %v", newTech.Name, err)
+			if err = failures.Add(exitcode.Partial, fmt.Errorf("aliases of technology %s: %w", newTech.Name, err)); err != nil {
+				return err
+			}
+		}
 	}
+	return nil
 }
 
 // updateTechnologyParents handles the second pass of updating parent references
-func updateTechnologyParents(ctx context.Context, log *logrus.Logger, techRepo *technology.Repository,
-	technologies []Technology, techMap map[string]*technology.Technology) {
+func updateTechnologyParents(ctx context.Context, log *logrus.Logger, techService *technology.TechnologyService,
+	technologies []Technology, techMap map[string]*technology.Technology, failures *exitcode.Collector) error {
 
 	for _, tech := range technologies {
 		if tech.Parent == "" {
 			continue // Skip technologies without parents
 		}
-		techName := strings.ToLower(tech.Name)
-		parentName := strings.ToLower(tech.Parent)
+		techName := technology.NormalizeName(tech.Name)
+		parentName := technology.NormalizeName(tech.Parent)
 
 		// Look up the current technology
 		currentTech, exists := techMap[techName]
 		if !exists {
+			// Not created in the first pass, already counted as failed
 			log.Warnf("Cannot find technology: %s", techName)
 			continue
 		}
@@ -158,62 +186,35 @@ func updateTechnologyParents(ctx context.Context, log *logrus.Logger, techRepo *
 		parentTech, exists := techMap[parentName]
 		if !exists {
 			log.Warnf("Cannot find parent technology: %s for %s", parentName, techName)
+			err := fmt.Errorf("parent %s of technology %s not found", parentName, techName)
+			if err = failures.Add(exitcode.Validation, err); err != nil {
+				return err
+			}
 			continue
 		}
 
 		// Update the parent ID
-		currentTech.ParentID = &parentTech.ID
-		err := techRepo.Update(ctx, currentTech)
+		err := techService.SetParent(ctx, currentTech.ID, &parentTech.ID)
 		if err != nil {
 			log.Warnf("Error updating parent for %s: %v", currentTech.Name, err)
+			if err = failures.Add(exitcode.Partial, fmt.Errorf("parent of technology %s: %w", currentTech.Name, err)); err != nil {
+				return err
+			}
 			continue
 		}
 
 		log.Infof("Updated technology %s with parent %s (ID: %d)",
 			currentTech.Name, parentTech.Name, parentTech.ID)
 	}
-}
-
-// addAliases adds aliases for a technology
-func addAliases(ctx context.Context, log *logrus.Logger, aliasRepo *techalias.Repository,
-	techID int, aliases []string) {
-	for _, aliasName := range aliases {
-		if aliasName == "" {
-			continue
-		}
-
-		// Convert alias to lowercase
-		lowerAlias := strings.ToLower(aliasName)
-
-		// Create alias model
-		newAlias := &techalias.TechnologyAlias{
-			TechnologyID: techID,
-			Alias:        lowerAlias,
-		}
-
-		// Insert into database
-		err := aliasRepo.Create(ctx, newAlias)
-		if err != nil {
-			// Skip if it's a duplicate
-			if techalias.IsDuplicate(err) {
-				log.Infof("Alias already exists: %s", lowerAlias)
-				continue
-			}
-			log.Warnf("Error creating alias %s for technology ID %d: %v", lowerAlias, techID, err)
-			continue
-		}
-
-		log.Infof("Created alias: %s (ID: %d) for technology ID %d", lowerAlias, newAlias.ID, techID)
-	}
+	return nil
 }
 
 // readTechnologiesFromJSON reads technology data from a JSON file
-func readTechnologiesFromJSON() []Technology {
+func readTechnologiesFromJSON() ([]Technology, error) {
 	// Get the directory of the current executable
 	execDir, err := filepath.Abs(filepath.Dir(os.Args[0]))
 	if err != nil {
-		logrus.Errorf("Failed to get executable directory: %v", err)
-		return []Technology{}
+		return nil, err
 	}
 
 	// Path to the JSON file
@@ -228,16 +229,14 @@ func readTechnologiesFromJSON() []Technology {
 	// Read the JSON file
 	data, err := os.ReadFile(jsonPath)
 	if err != nil {
-		logrus.Errorf("Failed to read technologies file: %v", err)
-		return []Technology{}
+		return nil, err
 	}
 
 	// Parse the JSON data
 	var technologies []Technology
 	if err = json.Unmarshal(data, &technologies); err != nil {
-		logrus.Errorf("Failed to parse technologies JSON: %v", err)
-		return []Technology{}
+		return nil, err
 	}
 
-	return technologies
+	return technologies, nil
 }
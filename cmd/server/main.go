@@ -5,9 +5,21 @@
 // @contact.email support@example.com
 // @host localhost:8080
 // @BasePath /api/v1
+// @securityDefinitions.apikey AdminAPIKey
+// @in header
+// @name X-API-Key
+// @securityDefinitions.apikey IngestAPIKey
+// @in header
+// @name X-API-Key
+// @description Scraper API key (INGEST_API_KEY)
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description Session token from /auth/login, as "Bearer <token>"
 package main
 
 import (
+	"compress/gzip"
 	"context"
 	"net/http"
 	"os"
@@ -17,15 +29,56 @@ import (
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/multitracer"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/sirupsen/logrus"
-	swaggerFiles "github.com/swaggo/files"
-	ginSwagger "github.com/swaggo/gin-swagger"
 	"golang.org/x/sync/errgroup"
 
-	_ "github.com/rodruizronald/ticos-in-tech/docs"
+	"github.com/rodruizronald/ticos-in-tech/docs"
+	"github.com/rodruizronald/ticos-in-tech/internal/alerts"
+	"github.com/rodruizronald/ticos-in-tech/internal/apidocs"
+	"github.com/rodruizronald/ticos-in-tech/internal/assets"
+	"github.com/rodruizronald/ticos-in-tech/internal/benefit"
+	"github.com/rodruizronald/ticos-in-tech/internal/botguard"
+	"github.com/rodruizronald/ticos-in-tech/internal/cache"
+	"github.com/rodruizronald/ticos-in-tech/internal/company"
+	"github.com/rodruizronald/ticos-in-tech/internal/companyalias"
+	"github.com/rodruizronald/ticos-in-tech/internal/config"
 	"github.com/rodruizronald/ticos-in-tech/internal/database"
+	"github.com/rodruizronald/ticos-in-tech/internal/employer"
+	"github.com/rodruizronald/ticos-in-tech/internal/events"
+	"github.com/rodruizronald/ticos-in-tech/internal/fxrate"
+	"github.com/rodruizronald/ticos-in-tech/internal/health"
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+	"github.com/rodruizronald/ticos-in-tech/internal/ingest"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobevent"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobfunction"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobrevision"
 	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
 	"github.com/rodruizronald/ticos-in-tech/internal/jobtech"
+	"github.com/rodruizronald/ticos-in-tech/internal/linkcheck"
+	"github.com/rodruizronald/ticos-in-tech/internal/location"
+	"github.com/rodruizronald/ticos-in-tech/internal/mailer"
+	"github.com/rodruizronald/ticos-in-tech/internal/maintenance"
+	"github.com/rodruizronald/ticos-in-tech/internal/metrics"
+	"github.com/rodruizronald/ticos-in-tech/internal/opensearch"
+	"github.com/rodruizronald/ticos-in-tech/internal/outbox"
+	"github.com/rodruizronald/ticos-in-tech/internal/pendingtech"
+	"github.com/rodruizronald/ticos-in-tech/internal/purge"
+	"github.com/rodruizronald/ticos-in-tech/internal/quality"
+	"github.com/rodruizronald/ticos-in-tech/internal/queue"
+	"github.com/rodruizronald/ticos-in-tech/internal/reference"
+	"github.com/rodruizronald/ticos-in-tech/internal/scheduler"
+	"github.com/rodruizronald/ticos-in-tech/internal/searchterm"
+	"github.com/rodruizronald/ticos-in-tech/internal/settings"
+	"github.com/rodruizronald/ticos-in-tech/internal/stats"
+	"github.com/rodruizronald/ticos-in-tech/internal/suggest"
+	"github.com/rodruizronald/ticos-in-tech/internal/techalias"
+	"github.com/rodruizronald/ticos-in-tech/internal/techdetect"
+	"github.com/rodruizronald/ticos-in-tech/internal/technology"
+	"github.com/rodruizronald/ticos-in-tech/internal/users"
+	"github.com/rodruizronald/ticos-in-tech/internal/webhooks"
+	"github.com/rodruizronald/ticos-in-tech/internal/widget"
 )
 
 func main() {
@@ -47,47 +100,467 @@ func run(ctx context.Context) error {
 		FullTimestamp: true,
 	})
 
-	// Get database config
-	dbConfig := database.DefaultConfig()
+	// Load configuration from the environment
+	cfg, err := config.Load()
+	if err != nil {
+		log.Errorf("Invalid configuration: %v", err)
+		return err
+	}
+	logLevel, err := logrus.ParseLevel(cfg.LogLevel)
+	if err != nil {
+		return err
+	}
+	log.SetLevel(logLevel)
 
-	// Connect to the database
-	dbpool, err := database.Connect(ctx, &dbConfig)
+	// Count the queries by operation and table, and log every query at debug level with the ID of
+	// the request it was run for
+	queryCounter := database.NewQueryCounter()
+	cfg.Database.Tracer = queryCounter
+	if cfg.Database.LogQueries {
+		queryTracer := database.NewQueryTracer(func(ctx context.Context, query *database.QueryLog) {
+			entry := log.WithFields(logrus.Fields{"duration": query.Duration, "rows": query.Rows})
+			if id := httpservice.RequestIDFrom(ctx); id != "" {
+				entry = entry.WithField("request_id", id)
+			}
+			if query.Args != nil {
+				entry = entry.WithField("args", query.Args)
+			}
+			if query.Err != nil {
+				entry = entry.WithError(query.Err)
+			}
+			entry.Debug(query.SQL)
+		}, cfg.Database.LogQueryArgs)
+		cfg.Database.Tracer = multitracer.New(queryCounter, queryTracer)
+	}
+
+	// Connect to the database and its read replica, waiting for them when they are still starting
+	cfg.Database.OnStartupRetry = func(attempt int, delay time.Duration, err error) {
+		log.Warnf("Database unavailable (attempt %d), retrying in %s: %v", attempt, delay, err)
+	}
+	pools, err := database.ConnectPools(ctx, &cfg.Database)
 	if err != nil {
 		log.Errorf("Unable to connect to database: %v", err)
 		return err
 	}
-	defer dbpool.Close()
+	defer pools.Close()
+	// Repositories go through db, which retries transient failures and fails fast while the
+	// database is unreachable. The public list queries go through replicaDB.
+	db := database.NewResilientDB(pools.Primary, cfg.Database.Retry, cfg.Database.Breaker)
+	replicaDB := db
+	if pools.HasReplica() {
+		log.Info("Serving job search and listings from the read replica")
+		replicaDB = database.NewResilientDB(pools.Replica, cfg.Database.Retry, cfg.Database.Breaker)
+	}
 
-	// Initialize Gin
-	r := gin.Default()
+	// The job attributes are validated against the reference values of the database, the seeded
+	// ones until they are loaded
+	referenceService := reference.NewReferenceService(reference.NewRepository(db), reference.Default())
+	if err = referenceService.Load(ctx); err != nil {
+		log.Warnf("Unable to load the reference values, validating against the seeded ones: %v", err)
+	}
+	referenceHandler := reference.NewHandler(referenceService)
 
-	gin.SetMode(gin.DebugMode)
+	// The public job search is guarded against the scrapers: the clients of the blocked networks
+	// are rejected and the ones making bursts of requests or following honeypots are banned
+	blocklist := botguard.NewBlocklist()
+	blocklistService := botguard.NewBlocklistService(botguard.NewRepository(db), blocklist)
+	if err = blocklistService.Load(ctx); err != nil {
+		log.Warnf("Unable to load the blocked networks, blocking none until reloaded: %v", err)
+	}
+	blocklistHandler := botguard.NewHandler(blocklistService)
+	botGuard := botguard.NewGuard(cfg.BotGuard, blocklist, func(ip, reason string) {
+		log.WithFields(logrus.Fields{"client_ip": ip, "reason": reason}).Warn("Banned client of the job search")
+	})
+
+	// Initialize Gin, the panics are answered with the internal error response instead of the
+	// empty one of the default recovery
+	onPanic, err := newPanicHandler(cfg, log)
+	if err != nil {
+		return err
+	}
+	gin.SetMode(cfg.GinMode)
+	r := gin.New()
+	// Only the proxies in front of the server set the client IP, through X-Forwarded-For, otherwise
+	// any client could pick the IP its rate limits, bans and blocks apply to
+	if err = r.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		log.Errorf("Invalid trusted proxies: %v", err)
+		return err
+	}
+	r.Use(gin.Logger(), httpservice.Recovery(onPanic))
+	r.Use(httpservice.RequestID())
 
 	// Add CORS middleware
 	r.Use(cors.New(cors.Config{
-		AllowOrigins:     []string{"http://localhost:3000"}, // React app URL
-		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization"},
-		ExposeHeaders:    []string{"Content-Length"},
+		AllowOrigins: []string{"http://localhost:3000"}, // React app URL
+		// The widgets are embedded in the careers pages of the companies, whatever their origin
+		AllowOriginWithContextFunc: func(c *gin.Context, _ string) bool {
+			return widget.IsEmbedRoute(c.FullPath())
+		},
+		AllowMethods: []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowHeaders: []string{"Origin", "Content-Type", "Accept", "Authorization", httpservice.APIKeyHeader,
+			httpservice.RequestIDHeader, httpservice.CountryHeader},
+		ExposeHeaders:    []string{"Content-Length", httpservice.LinkHeader, httpservice.RequestIDHeader},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	}))
 
-	// Swagger endpoint
-	if gin.Mode() != gin.ReleaseMode {
-		r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+	// Compress responses for the clients accepting it, search results shrink a lot
+	r.Use(httpservice.Gzip(gzip.DefaultCompression))
+
+	// Metrics endpoint, scraped by Prometheus. It exposes the internals of the server, so it is
+	// served on its own address, kept off the internet, or behind the admin API key.
+	metricsRegistry := metrics.NewRegistry()
+	metricsRegistry.Register(database.PoolCollector(pools.Primary, "primary"))
+	if pools.HasReplica() {
+		metricsRegistry.Register(database.PoolCollector(pools.Replica, "replica"))
+	}
+	metricsRegistry.Register(queryCounter)
+	metricsHandler := metrics.NewHandler(metricsRegistry)
+	var metricsSrv *http.Server
+	switch {
+	case cfg.MetricsAddr != "":
+		metricsRouter := gin.New()
+		metricsRouter.Use(httpservice.Recovery(onPanic))
+		metricsHandler.RegisterRoutes(metricsRouter)
+		metricsSrv = &http.Server{Addr: cfg.MetricsAddr, Handler: metricsRouter}
+	case cfg.AdminAPIKey != "":
+		metricsHandler.RegisterRoutes(r.Group("", httpservice.ErrorHandler(), httpservice.RequireAPIKey(cfg.AdminAPIKey)))
+	default:
+		log.Warn("Neither METRICS_ADDR nor ADMIN_API_KEY set, metrics are disabled")
+	}
+
+	// Liveness and readiness probes, the server isn't ready while a database connection is lost
+	newHealthMonitor := func(pool *pgxpool.Pool, name string) *database.HealthMonitor {
+		return database.NewHealthMonitor(pool, database.HealthConfig{
+			Interval: cfg.Database.HealthCheckInterval,
+			Timeout:  cfg.Database.PingTimeout,
+			OnChange: func(state database.Health) {
+				if state.Healthy {
+					log.Infof("Connection to the %s restored", name)
+					return
+				}
+				log.Errorf("Connection to the %s lost: %v", name, state.Err)
+			},
+		})
+	}
+	dbHealth := map[string]*database.HealthMonitor{"database": newHealthMonitor(pools.Primary, "database")}
+	if pools.HasReplica() {
+		dbHealth["replica"] = newHealthMonitor(pools.Replica, "replica")
+	}
+	healthCheckers := make(map[string]health.Checker, len(dbHealth))
+	for name, monitor := range dbHealth {
+		healthCheckers[name] = monitor
+	}
+	health.NewHandler(healthCheckers).RegisterRoutes(r)
+
+	jobRepo := jobs.NewRepositoryWithReplica(db, replicaDB)
+	jobRepo.SetRanking(cfg.SearchRanking)
+	if cfg.SlowSearchThreshold > 0 {
+		slowSearches := jobs.DefaultSlowSearchConfig()
+		slowSearches.Threshold = cfg.SlowSearchThreshold
+		slowSearches.OnPlan = func(plan *jobs.SlowSearchPlan) {
+			log.WithFields(logrus.Fields{"elapsed": plan.Elapsed, "args": plan.Args}).
+				Warnf("Slow job search, its plan:\n%s", plan.Plan)
+		}
+		slowSearches.OnError = func(err error) {
+			log.Warnf("Failed to explain slow job search: %v", err)
+		}
+		jobRepo.ExplainSlowSearches(slowSearches)
+	}
+	jobtechRepo := jobtech.NewRepositoryWithReplica(db, replicaDB)
+	var jobRepos jobs.DataRepository = jobs.NewRepositories(jobRepo, jobtechRepo)
+	var searchIndexer jobs.SearchIndexer
+	if cfg.SearchBackend == config.SearchBackendOpenSearch {
+		log.Infof("Serving job search from OpenSearch index %s", cfg.OpenSearch.Index)
+		client := opensearch.NewClient(cfg.OpenSearch)
+		jobRepos = opensearch.NewSearchRepository(client, jobtechRepo)
+		searchIndexer = opensearch.NewIndexer(client, jobRepo)
+	}
+	// Cache the job search pages in memory, in front of Redis when configured so the instances share
+	// them. They keep being cached in memory while Redis is unreachable.
+	var searchCache *cache.Tiered
+	if cfg.SearchCacheTTL > 0 {
+		var remote cache.Remote
+		if cfg.RedisURL != "" {
+			var redis *cache.Redis
+			if redis, err = cache.NewRedis(cfg.RedisURL); err != nil {
+				log.Errorf("Invalid Redis configuration: %v", err)
+				return err
+			}
+			defer redis.Close()
+			remote = redis
+		}
+		searchCache = cache.NewTiered(remote, cache.TieredConfig{
+			TTL:        cfg.SearchCacheTTL,
+			VersionKey: jobs.SearchCacheVersionKey,
+			OnRemoteError: func(err error) {
+				log.Warnf("Redis unavailable, caching job searches in memory only for %s: %v", cache.DefaultRetryRemote, err)
+			},
+		})
+		jobRepos = jobs.NewCachedSearchRepository(jobRepos, searchCache)
+		metricsRegistry.Register(cache.StatsCollector("job_search", searchCache.Stats))
 	}
+	jobHandler := jobs.NewHandler(jobRepos, searchterm.NewRepository(replicaDB))
 
-	// API routes
-	v1 := r.Group("/api/v1")
+	// The admins change some settings while the server runs, overriding the environment. The stored
+	// values are applied now, when the database notifies a change and on SIGHUP.
+	live := newLiveSettings(cfg, log, jobRepo, searchCache)
+	settingsService := settings.NewSettingsService(settings.NewRepository(db), live.settings(cfg)...)
+	applySettings(ctx, settingsService, log)
+	settingsHandler := settings.NewHandler(settingsService)
+	recommendationHandler := jobs.NewRecommendationHandler(
+		jobs.NewRecommendationService(jobs.NewRepositories(jobRepo, jobtechRepo)))
+	// Exports stream from the database whatever the search backend, rate limited per client
+	exportHandler := jobs.NewExportHandler(
+		jobs.NewExportService(jobRepo, searchterm.NewRepository(replicaDB), cfg.ExportMaxRows))
+	exportRateLimit := httpservice.RateLimitFunc(live.ExportRateLimit, time.Minute)
+	// Domain events bus, the subscribers are registered below. The events are recorded by the database
+	// in the outbox along with the changes, so the services don't publish them, the outbox relay does.
+	bus := events.NewBus()
+	outboxRepo := outbox.NewRepository(db)
+	outboxRelay := outbox.NewRelay(outboxRepo, bus, outbox.DefaultRelayConfig())
+	webhookRepo := webhooks.NewRepository(db)
+	moderationService := jobs.NewModerationService(jobRepo, searchIndexer, nil)
+	jobAdminHandler := jobs.NewAdminHandler(jobs.NewDuplicateDetector(jobRepo), moderationService)
+	revisionHandler := jobrevision.NewHandler(jobrevision.NewRevisionService(jobrevision.NewRepository(db), jobRepo))
+	webhookHandler := webhooks.NewHandler(webhooks.NewWebhookService(webhookRepo))
+	webhookWorkerConfig := webhooks.DefaultWorkerConfig()
+	webhookWorker := webhooks.NewWorker(webhookRepo, webhookWorkerConfig)
 
-	jobRepo := jobs.NewRepository(dbpool)
-	jobtechRepo := jobtech.NewRepository(dbpool)
-	jobRepos := jobs.NewRepositories(jobRepo, jobtechRepo)
-	jobHandler := jobs.NewHandler(jobRepos)
-	jobHandler.RegisterRoutes(v1)
+	linkCheckRepo := linkcheck.NewRepository(db)
+	linkCheckHandler := linkcheck.NewHandler(linkcheck.NewLinkCheckService(linkCheckRepo))
+	linkCheckWorkerConfig := linkcheck.DefaultWorkerConfig()
+	linkCheckWorkerConfig.RecheckInterval = cfg.LinkCheckInterval
+	linkCheckWorkerConfig.OnDeactivate = func(jobID int, url string) {
+		log.Infof("Deactivated job %d, its application link %s is gone", jobID, url)
+	}
+	linkCheckWorker := linkcheck.NewWorker(linkCheckRepo, moderationService, linkCheckWorkerConfig)
+
+	eventRecorderConfig := jobevent.DefaultRecorderConfig()
+	eventRecorderConfig.OnFlushError = func(err error, dropped int) {
+		log.Warnf("Failed to write %d job events: %v", dropped, err)
+	}
+	eventRepo := jobevent.NewRepository(db)
+	eventRecorder := jobevent.NewRecorder(eventRepo, eventRecorderConfig)
+	eventHandler := jobevent.NewHandler(jobevent.NewEventService(eventRepo, eventRecorder, cfg.ApplyUTMParams))
+
+	// Store company logos in object storage when configured, otherwise they link to their source
+	var logoStore company.LogoStore
+	if cfg.Assets.Enabled() {
+		var logoService *assets.LogoService
+		if logoService, err = assets.NewLogoServiceFromConfig(&cfg.Assets); err != nil {
+			log.Errorf("Invalid asset storage configuration: %v", err)
+			return err
+		}
+		logoStore = logoService
+	}
+	companyRepo := company.NewRepositoryWithReplica(db, replicaDB)
+	companyService := company.NewCompanyService(
+		companyRepo,
+		companyalias.NewRepository(db),
+		logoStore,
+	)
+	companyHandler := company.NewHandler(companyService)
+	companyAdminHandler := company.NewAdminHandler(companyService,
+		company.NewArchiveService(companyRepo, jobRepo, searchIndexer, nil),
+		company.NewMergeService(companyRepo, jobRepo, searchIndexer))
+
+	jobFunctionHandler := jobfunction.NewHandler(jobfunction.NewRepository(db))
+	benefitHandler := benefit.NewHandler(benefit.NewRepository(db))
+	suggestHandler := suggest.NewHandler(suggest.NewSuggestService(suggest.NewRepository(replicaDB)))
+
+	userRepo := users.NewRepository(db)
+	userService := users.NewUserService(userRepo, jobtechRepo)
+	userHandler := users.NewHandler(userService)
+	oauthHandler := users.NewOAuthHandler(
+		users.NewOAuthService(userRepo, userService, cfg.OAuth.Providers()...), cfg.OAuth.RedirectURL)
+
+	statsService := stats.NewStatsService(stats.NewRepository(db), cfg.Countries...)
+	statsHandler := stats.NewHandler(statsService)
+	widgetService := widget.NewWidgetService(widget.NewRepository(replicaDB))
+	widgetHandler := widget.NewHandler(widgetService)
+
+	// The API is scoped to the country of the X-Country header, the default one without it
+	countries := httpservice.Countries{Default: cfg.DefaultCountry, Supported: cfg.Countries}
+
+	// Rendered responses of the read-heavy routes, refreshed in the background once stale so
+	// traffic spikes don't reach the database
+	responseCache := httpservice.NewResponseCache(r, httpservice.ResponseCacheConfig{
+		Rules: map[string]httpservice.CacheRule{
+			stats.TechnologyStatsRoute:      {TTL: time.Minute, StaleTTL: 10 * time.Minute},
+			stats.OverviewRoute:             {TTL: time.Minute, StaleTTL: 10 * time.Minute},
+			stats.HistoryRoute:              {TTL: 10 * time.Minute, StaleTTL: time.Hour},
+			company.CompanyTechnologiesPath: {TTL: time.Minute, StaleTTL: 10 * time.Minute},
+			jobfunction.JobFunctionsRoute:   {TTL: 10 * time.Minute, StaleTTL: time.Hour},
+			benefit.BenefitsRoute:           {TTL: 10 * time.Minute, StaleTTL: time.Hour},
+			suggest.SuggestRoute:            {TTL: time.Minute, StaleTTL: 10 * time.Minute},
+		},
+	})
+	metricsRegistry.Register(cache.StatsCollector("response", responseCache.Stats))
+	metricsRegistry.Register(cache.StatsCollector("stats_overview", statsService.CacheStats))
+	metricsRegistry.Register(cache.StatsCollector("widget_openings", widgetService.CacheStats))
+	maintenanceHandler := maintenance.NewHandler(
+		maintenance.NewMaintenanceService(jobRepo, statsService, statsService, widgetService, responseCache))
+
+	techService := technology.NewTechnologyService(technology.NewRepository(db), techalias.NewRepository(db), nil)
+	techHandler := technology.NewHandler(techService)
+	pendingService := pendingtech.NewPendingTechnologyService(pendingtech.NewRepository(db), techService)
+	pendingHandler := pendingtech.NewHandler(pendingService)
+	detectionHandler := techdetect.NewHandler(
+		techdetect.NewDetectionService(techdetect.NewRepository(db), techdetect.DefaultAutoAcceptConfidence))
+	qualityHandler := quality.NewHandler(quality.NewQualityService(quality.NewRepository(db)))
+
+	// Company claims email a verification code, they are disabled without an SMTP server
+	var claimMailer employer.Mailer
+	if cfg.Mailer.Enabled() {
+		claimMailer = mailer.NewMailer(&cfg.Mailer)
+	} else {
+		log.Warn("SMTP_HOST or SMTP_FROM not set, company claims are disabled")
+	}
+	employerRepo := employer.NewRepository(db)
+	employerHandler := employer.NewHandler(
+		employer.NewClaimService(employerRepo, claimMailer),
+		employer.NewPortalService(employerRepo, jobRepo, moderationService, location.NewRepository(db), techService),
+		userService,
+	)
+
+	// Job alerts are emailed with a link to unsubscribe, they aren't sent without both
+	alertRepo := alerts.NewRepository(db)
+	alertHandler := alerts.NewHandler(alerts.NewAlertService(alertRepo), userService)
+	var alertDigests *alerts.DigestSender
+	if cfg.Mailer.Enabled() && cfg.AlertsUnsubscribeURL != "" {
+		alertDigests = alerts.NewDigestSender(alertRepo, mailer.NewMailer(&cfg.Mailer), cfg.AlertsUnsubscribeURL)
+	} else {
+		log.Warn("SMTP_HOST, SMTP_FROM or ALERTS_UNSUBSCRIBE_URL not set, job alerts are not sent")
+	}
 
-	port := "8080"
+	// Subscribers of the domain events, each one independent of the others
+	bus.Subscribe("webhooks", events.JobHandler(webhooks.NewPublisher(webhookRepo)), events.JobEvents...)
+	bus.Subscribe("stats-cache", func(context.Context, *events.Event) error {
+		statsService.InvalidateCache()
+		return nil
+	}, events.JobEvents...)
+	bus.Subscribe("widget-cache", func(context.Context, *events.Event) error {
+		widgetService.InvalidateCache()
+		return nil
+	}, events.JobEvents...)
+	bus.Subscribe("technology-log", func(_ context.Context, event *events.Event) error {
+		log.Infof("Technology %s added to the %s category", event.Technology.Name, event.Technology.Category)
+		return nil
+	}, events.TechnologyAdded)
+	// Publish the job events to the message broker of the downstream pipelines
+	if cfg.Queue.Enabled() {
+		var broker queue.Broker
+		if broker, err = queue.NewBrokerFromConfig(&cfg.Queue); err != nil {
+			log.Errorf("Invalid message queue configuration: %v", err)
+			return err
+		}
+		defer broker.Close()
+		bus.Subscribe("message-queue", queue.NewPublisher(broker).HandleEvent, events.JobEvents...)
+	}
+
+	ingestService := ingest.NewIngestService(ingest.NewRepository(db))
+	ingestHandler := ingest.NewHandler(ingestService)
+	anomalyMonitor := ingest.NewAnomalyMonitor(ingestService)
+	metricsRegistry.Register(anomalyMonitor)
+
+	schedulerRepo := scheduler.NewRepository(db)
+	taskScheduler := newScheduler(cfg, &backgroundTasks{
+		jobRepo:         jobRepo,
+		webhookWorker:   webhookWorker,
+		linkCheckWorker: linkCheckWorker,
+		userRepo:        userRepo,
+		schedulerRepo:   schedulerRepo,
+		anomalyMonitor:  anomalyMonitor,
+		outboxRepo:      outboxRepo,
+		outboxRelay:     outboxRelay,
+		rateService:     fxrate.NewRateService(fxrate.NewRepository(db), fxrate.NewClient(cfg.FXRatesURL)),
+		techService:     techService,
+		alertDigests:    alertDigests,
+		statsService:    statsService,
+		purgeService:    purge.NewPurgeService(purge.NewRepository(db)),
+	}, log)
+	schedulerHandler := scheduler.NewHandler(taskScheduler, schedulerRepo)
+
+	if cfg.IngestAPIKey == "" {
+		log.Warn("INGEST_API_KEY not set, ingest routes are disabled")
+	}
+	if cfg.AdminAPIKey == "" {
+		log.Warn("ADMIN_API_KEY not set, admin routes are disabled")
+	}
+
+	// API routes, served by every API version. /api/v2 can change the shape of the responses,
+	// handlers check httpservice.VersionOf, while /api/v1 keeps working until its sunset.
+	apiVersions := []httpservice.APIVersion{
+		{Name: "v1", Deprecation: cfg.APIV1Deprecation, Sunset: cfg.APIV1Sunset, Successor: "/api/v2"},
+		{Name: "v2"},
+	}
+
+	// API descriptor and raw OpenAPI spec, served in every mode so clients can discover the API and
+	// generate their code from it. The Swagger UI is only served in release mode with PUBLIC_API_DOCS,
+	// restricted to the read operations of the public routes.
+	releaseMode := gin.Mode() == gin.ReleaseMode
+	apiDocsHandler, err := apidocs.NewHandler(apidocs.Config{
+		Spec:              docs.SwaggerInfo.ReadDoc(),
+		Versions:          apiVersions,
+		SwaggerUI:         !releaseMode || cfg.PublicAPIDocs,
+		ReadOnly:          releaseMode,
+		TermsOfServiceURL: cfg.TermsOfServiceURL,
+	})
+	if err != nil {
+		log.Errorf("Invalid API docs: %v", err)
+		return err
+	}
+	apiDocsHandler.RegisterRoutes(r)
+
+	httpservice.RegisterVersions(r, apiVersions, func(api *gin.RouterGroup, _ *httpservice.APIVersion) {
+		jobHandler.RegisterRoutes(api.Group("", botGuard.Middleware()))
+		recommendationHandler.RegisterRoutes(api)
+		exportHandler.RegisterRoutes(api.Group("", botGuard.Middleware(), exportRateLimit))
+		eventHandler.RegisterRoutes(api)
+		companyHandler.RegisterRoutes(api)
+		jobFunctionHandler.RegisterRoutes(api)
+		benefitHandler.RegisterRoutes(api)
+		suggestHandler.RegisterRoutes(api)
+		userHandler.RegisterRoutes(api)
+		oauthHandler.RegisterRoutes(api)
+		employerHandler.RegisterRoutes(api)
+		alertHandler.RegisterRoutes(api)
+		statsHandler.RegisterRoutes(api)
+		widgetHandler.RegisterRoutes(api)
+		ingestHandler.RegisterRoutes(api)
+
+		// Scraper routes, only available when an ingest API key is configured
+		if cfg.IngestAPIKey != "" {
+			ingestHandler.RegisterIngestRoutes(api.Group("", httpservice.RequireAPIKey(cfg.IngestAPIKey)))
+		}
+
+		// Admin routes, only available when an admin API key is configured
+		if cfg.AdminAPIKey != "" {
+			admin := api.Group("/admin", httpservice.RequireAPIKey(cfg.AdminAPIKey))
+			jobAdminHandler.RegisterAdminRoutes(admin)
+			revisionHandler.RegisterAdminRoutes(admin)
+			companyAdminHandler.RegisterAdminRoutes(admin)
+			employerHandler.RegisterAdminRoutes(admin)
+			eventHandler.RegisterAdminRoutes(admin)
+			techHandler.RegisterAdminRoutes(admin)
+			pendingHandler.RegisterAdminRoutes(admin)
+			detectionHandler.RegisterAdminRoutes(admin)
+			webhookHandler.RegisterAdminRoutes(admin)
+			ingestHandler.RegisterAdminRoutes(admin)
+			linkCheckHandler.RegisterAdminRoutes(admin)
+			maintenanceHandler.RegisterAdminRoutes(admin)
+			qualityHandler.RegisterAdminRoutes(admin)
+			referenceHandler.RegisterAdminRoutes(admin)
+			blocklistHandler.RegisterAdminRoutes(admin)
+			schedulerHandler.RegisterAdminRoutes(admin)
+			settingsHandler.RegisterAdminRoutes(admin)
+		}
+	}, httpservice.ErrorHandler(), countries.Middleware(), httpservice.Language(),
+		httpservice.RouteTimeouts(live.RequestTimeout, map[string]time.Duration{jobs.ExportJobsRoute: cfg.ExportTimeout}),
+		responseCache.Middleware())
+
+	port := cfg.Port
 	srv := &http.Server{
 		Addr:    ":" + port,
 		Handler: r,
@@ -99,7 +572,9 @@ func run(ctx context.Context) error {
 	// Start HTTP server in goroutine
 	g.Go(func() error {
 		log.Printf("Server starting on port %s", port)
-		log.Printf("Swagger UI available at: http://localhost:%s/swagger/index.html", port)
+		if !releaseMode || cfg.PublicAPIDocs {
+			log.Printf("Swagger UI available at: http://localhost:%s/swagger/index.html", port)
+		}
 
 		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 			log.Errorf("Server failed to start: %v", err)
@@ -108,6 +583,94 @@ func run(ctx context.Context) error {
 		return nil
 	})
 
+	// Serve the metrics on their own address
+	if metricsSrv != nil {
+		g.Go(func() error {
+			log.Printf("Metrics available at %s%s", cfg.MetricsAddr, metrics.MetricsRoute)
+			if err := metricsSrv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Errorf("Metrics server failed to start: %v", err)
+				return err
+			}
+			return nil
+		})
+	}
+
+	// Write tracked job events in batches until shutdown
+	g.Go(func() error {
+		return eventRecorder.Run(gCtx)
+	})
+
+	// Drop the cached results when jobs change, whichever server instance or tool changed them
+	listener := database.NewListener(pools.Primary, database.ListenerConfig{
+		OnError: func(err error) {
+			log.Warnf("Job change notifications interrupted: %v", err)
+		},
+	})
+	listener.Handle(jobs.ChangesChannel, func(string) {
+		statsService.InvalidateCache()
+		widgetService.InvalidateCache()
+		if searchCache != nil {
+			searchCache.Clear()
+			// Drop the pages shared in Redis too, in the background as handlers must return quickly
+			go func() {
+				if err := searchCache.BumpVersion(gCtx); err != nil {
+					log.Warnf("Unable to drop the job search pages cached in Redis: %v", err)
+				}
+			}()
+		}
+	})
+	// Reload the reference values when they change, in the background as handlers must return quickly
+	listener.Handle(reference.ChangesChannel, func(string) {
+		go func() {
+			if err := referenceService.Load(gCtx); err != nil {
+				log.Warnf("Unable to reload the reference values: %v", err)
+			}
+		}()
+	})
+	// Reload the blocked networks when they change, in the background as handlers must return quickly
+	listener.Handle(botguard.ChangesChannel, func(string) {
+		go func() {
+			if err := blocklistService.Load(gCtx); err != nil {
+				log.Warnf("Unable to reload the blocked networks: %v", err)
+			}
+		}()
+	})
+	// Apply the settings changed by the admins, on this instance or another one
+	listener.Handle(settings.ChangesChannel, func(string) {
+		go applySettings(gCtx, settingsService, log)
+	})
+	g.Go(func() error {
+		return listener.Run(gCtx)
+	})
+
+	// Apply the stored settings again on SIGHUP, e.g. after they were changed in the database
+	reload := make(chan os.Signal, 1)
+	signal.Notify(reload, syscall.SIGHUP)
+	defer signal.Stop(reload)
+	g.Go(func() error {
+		for {
+			select {
+			case <-gCtx.Done():
+				return nil
+			case <-reload:
+				log.Info("Reloading the settings")
+				applySettings(gCtx, settingsService, log)
+			}
+		}
+	})
+
+	// Track the health of the database connections until shutdown
+	for _, monitor := range dbHealth {
+		g.Go(func() error {
+			return monitor.Run(gCtx)
+		})
+	}
+
+	// Run the scheduled background tasks until shutdown
+	g.Go(func() error {
+		return taskScheduler.Run(gCtx)
+	})
+
 	// Handle graceful shutdown in another goroutine
 	g.Go(func() error {
 		<-gCtx.Done() // Wait for context cancellation (SIGINT/SIGTERM)
@@ -122,6 +685,12 @@ func run(ctx context.Context) error {
 			log.Errorf("Server forced to shutdown: %v", err)
 			return err
 		}
+		if metricsSrv != nil {
+			if err := metricsSrv.Shutdown(shutdownCtx); err != nil {
+				log.Errorf("Metrics server forced to shutdown: %v", err)
+				return err
+			}
+		}
 
 		log.Println("Server exited gracefully")
 		return nil
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/config"
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+	"github.com/rodruizronald/ticos-in-tech/internal/sentry"
+)
+
+// panicReportTimeout bounds the report of a panic to Sentry, made after the response is sent
+const panicReportTimeout = 10 * time.Second
+
+// newPanicHandler returns what is done with the panics recovered while serving requests: they are
+// logged with their stack trace and the request, and reported to Sentry when SENTRY_DSN is set
+func newPanicHandler(cfg *config.Config, log *logrus.Logger) (func(c *gin.Context, p *httpservice.Panic), error) {
+	var reporter *sentry.Client
+	if cfg.SentryDSN != "" {
+		dsn, err := sentry.ParseDSN(cfg.SentryDSN)
+		if err != nil {
+			return nil, err
+		}
+		reporter = sentry.NewClient(dsn, cfg.GinMode)
+		log.Info("Reporting panics to Sentry")
+	}
+
+	return func(c *gin.Context, p *httpservice.Panic) {
+		requestID := httpservice.RequestIDFrom(c.Request.Context())
+		log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"method":     c.Request.Method,
+			"path":       c.Request.URL.Path,
+			"route":      c.FullPath(),
+			"client_ip":  c.ClientIP(),
+		}).Errorf("Panic serving request: %v\n%s", p.Value, p.Stack)
+
+		if reporter == nil {
+			return
+		}
+		// The request is reused once served, the report gets a copy of it
+		event := &sentry.Event{
+			Value:   p.Value,
+			Frames:  p.Frames,
+			Request: c.Request.Clone(context.Background()),
+			Tags:    map[string]string{"request_id": requestID, "route": c.FullPath()},
+		}
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), panicReportTimeout)
+			defer cancel()
+			if _, err := reporter.Capture(ctx, event); err != nil {
+				log.WithField("request_id", requestID).Warnf("Failed to report panic to Sentry: %v", err)
+			}
+		}()
+	}, nil
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/cache"
+	"github.com/rodruizronald/ticos-in-tech/internal/config"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+	"github.com/rodruizronald/ticos-in-tech/internal/settings"
+)
+
+// Names of the settings the admins change while the server runs, the environment variables they
+// override in lowercase
+const (
+	settingLogLevel            = "log_level"
+	settingRequestTimeout      = "request_timeout"
+	settingExportRateLimit     = "export_rate_limit"
+	settingSearchCacheTTL      = "search_cache_ttl"
+	settingRankTextWeight      = "search_rank_text_weight"
+	settingRankRecencyWeight   = "search_rank_recency_weight"
+	settingRankFeaturedWeight  = "search_rank_featured_weight"
+	settingRankPrimaryTech     = "search_rank_primary_tech_weight"
+	settingRankRecencyHalfLife = "search_rank_recency_half_life"
+)
+
+// liveSettings holds what the settings changed while the server runs apply to
+type liveSettings struct {
+	log     *logrus.Logger
+	jobRepo *jobs.Repository
+	// searchCache is nil when the job search pages aren't cached
+	searchCache *cache.Tiered
+	// requestTimeout and exportRateLimit are read by their middleware on every request
+	requestTimeout  atomic.Int64
+	exportRateLimit atomic.Int64
+}
+
+// newLiveSettings creates the live settings with the values of cfg
+func newLiveSettings(cfg *config.Config, log *logrus.Logger, jobRepo *jobs.Repository,
+	searchCache *cache.Tiered) *liveSettings {
+	live := &liveSettings{log: log, jobRepo: jobRepo, searchCache: searchCache}
+	live.requestTimeout.Store(int64(cfg.RequestTimeout))
+	live.exportRateLimit.Store(int64(cfg.ExportRateLimit))
+	return live
+}
+
+// RequestTimeout returns the current timeout of the API requests
+func (l *liveSettings) RequestTimeout() time.Duration {
+	return time.Duration(l.requestTimeout.Load())
+}
+
+// ExportRateLimit returns the current number of job search exports a client can request per minute
+func (l *liveSettings) ExportRateLimit() int {
+	return int(l.exportRateLimit.Load())
+}
+
+// settings returns the settings changed while the server runs, their defaults the values of cfg
+func (l *liveSettings) settings(cfg *config.Config) []settings.Setting {
+	logLevels := []string{config.LogLevelDebug, config.LogLevelInfo, config.LogLevelWarn, config.LogLevelError}
+	list := []settings.Setting{
+		settings.Enum(settingLogLevel, "Least severe level logged", cfg.LogLevel, logLevels, func(value string) {
+			if level, err := logrus.ParseLevel(value); err == nil {
+				l.log.SetLevel(level)
+			}
+		}),
+		settings.Duration(settingRequestTimeout, "Time bound of the API requests, 0s disables it",
+			cfg.RequestTimeout, 0, func(value time.Duration) {
+				l.requestTimeout.Store(int64(value))
+			}),
+		settings.Int(settingExportRateLimit, "Job search exports a client can request per minute, 0 disables the limit",
+			cfg.ExportRateLimit, 0, func(value int) {
+				l.exportRateLimit.Store(int64(value))
+			}),
+		l.rankWeight(settingRankTextWeight, "Weight of the text relevance in the search ranking",
+			cfg.SearchRanking.TextWeight, func(ranking *jobs.Ranking, value float64) { ranking.TextWeight = value }),
+		l.rankWeight(settingRankRecencyWeight, "Weight of the recency in the search ranking",
+			cfg.SearchRanking.RecencyWeight, func(ranking *jobs.Ranking, value float64) { ranking.RecencyWeight = value }),
+		l.rankWeight(settingRankFeaturedWeight, "Weight of the featured jobs in the search ranking",
+			cfg.SearchRanking.FeaturedWeight, func(ranking *jobs.Ranking, value float64) { ranking.FeaturedWeight = value }),
+		l.rankWeight(settingRankPrimaryTech, "Weight of the searched primary technologies in the search ranking",
+			cfg.SearchRanking.PrimaryTechWeight,
+			func(ranking *jobs.Ranking, value float64) { ranking.PrimaryTechWeight = value }),
+		settings.Duration(settingRankRecencyHalfLife, "Age at which the recency score of a job is halved",
+			cfg.SearchRanking.RecencyHalfLife, time.Hour, func(value time.Duration) {
+				ranking := l.jobRepo.Ranking()
+				ranking.RecencyHalfLife = value
+				l.jobRepo.SetRanking(ranking)
+			}),
+	}
+	// The search cache can only be enabled on startup
+	if l.searchCache != nil {
+		list = append(list, settings.Duration(settingSearchCacheTTL, "How long a job search page is cached",
+			cfg.SearchCacheTTL, time.Second, l.searchCache.SetTTL))
+	}
+	return list
+}
+
+// rankWeight returns the setting of a weight of the search ranking, set in the ranking with set
+func (l *liveSettings) rankWeight(name, description string, value float64,
+	set func(ranking *jobs.Ranking, value float64)) settings.Setting {
+	return settings.Float(name, description, value, 0, func(value float64) {
+		ranking := l.jobRepo.Ranking()
+		set(&ranking, value)
+		l.jobRepo.SetRanking(ranking)
+	})
+}
+
+// applySettings applies the stored settings, logging the ones that can't be
+func applySettings(ctx context.Context, service *settings.SettingsService, log *logrus.Logger) {
+	if err := service.Load(ctx); err != nil {
+		log.Warnf("Unable to apply the stored settings: %v", err)
+	}
+}
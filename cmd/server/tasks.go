@@ -0,0 +1,229 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/alerts"
+	"github.com/rodruizronald/ticos-in-tech/internal/config"
+	"github.com/rodruizronald/ticos-in-tech/internal/fxrate"
+	"github.com/rodruizronald/ticos-in-tech/internal/ingest"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+	"github.com/rodruizronald/ticos-in-tech/internal/linkcheck"
+	"github.com/rodruizronald/ticos-in-tech/internal/outbox"
+	"github.com/rodruizronald/ticos-in-tech/internal/purge"
+	"github.com/rodruizronald/ticos-in-tech/internal/scheduler"
+	"github.com/rodruizronald/ticos-in-tech/internal/stats"
+	"github.com/rodruizronald/ticos-in-tech/internal/technology"
+	"github.com/rodruizronald/ticos-in-tech/internal/users"
+	"github.com/rodruizronald/ticos-in-tech/internal/webhooks"
+)
+
+// Names of the scheduled tasks, used by SCHEDULER_DISABLED_TASKS and the run history
+const (
+	taskSearchViewRefresh = "search-view-refresh"
+	taskWebhookRetries    = "webhook-retries"
+	taskLinkChecks        = "link-checks"
+	taskSessionPurge      = "session-purge"
+	taskRunHistoryPurge   = "run-history-purge"
+	taskIngestAnomalies   = "ingest-anomalies"
+	taskOutboxRelay       = "outbox-relay"
+	taskOutboxPurge       = "outbox-purge"
+	taskFXRates           = "fx-rates"
+	taskTechArchive       = "tech-archive"
+	taskJobAlerts         = "job-alerts"
+	taskStatsSnapshot     = "stats-snapshot"
+	taskDataPurge         = "data-purge"
+)
+
+// backgroundTasks holds what the scheduled tasks run
+type backgroundTasks struct {
+	jobRepo         *jobs.Repository
+	webhookWorker   *webhooks.Worker
+	linkCheckWorker *linkcheck.Worker
+	userRepo        *users.Repository
+	schedulerRepo   *scheduler.Repository
+	anomalyMonitor  *ingest.AnomalyMonitor
+	outboxRepo      *outbox.Repository
+	outboxRelay     *outbox.Relay
+	rateService     *fxrate.RateService
+	techService     *technology.TechnologyService
+	// alertDigests is nil when the job alerts can't be emailed
+	alertDigests *alerts.DigestSender
+	statsService *stats.StatsService
+	purgeService *purge.PurgeService
+}
+
+// newScheduler creates the scheduler of the background tasks of the server
+func newScheduler(cfg *config.Config, bg *backgroundTasks, log *logrus.Logger) *scheduler.Scheduler {
+	enabled := func(name string) bool {
+		return !slices.Contains(cfg.SchedulerDisabledTasks, name)
+	}
+	// Frequent tasks get a shorter jitter, so they keep running about as often as scheduled
+	jitter := func(interval time.Duration) time.Duration {
+		return min(cfg.SchedulerJitter, interval/2)
+	}
+
+	tasks := []*scheduler.Task{
+		// Keep the job search view up to date with job changes made outside of the ingest
+		{
+			Name:     taskSearchViewRefresh,
+			Schedule: scheduler.Every(cfg.SearchViewRefreshInterval),
+			Enabled:  enabled(taskSearchViewRefresh) && cfg.SearchViewRefreshInterval > 0,
+			Jitter:   jitter(cfg.SearchViewRefreshInterval),
+			Run:      bg.jobRepo.RefreshSearchView,
+		},
+		// Publish the domain events recorded in the outbox to their subscribers
+		{
+			Name:     taskOutboxRelay,
+			Schedule: scheduler.Every(outbox.DefaultPollInterval),
+			Enabled:  enabled(taskOutboxRelay),
+			Jitter:   jitter(outbox.DefaultPollInterval),
+			Run: func(ctx context.Context) error {
+				_, err := bg.outboxRelay.ProcessDue(ctx)
+				return err
+			},
+		},
+		// Send the job events to the webhook subscribers, retrying the failed deliveries
+		{
+			Name:     taskWebhookRetries,
+			Schedule: scheduler.Every(webhooks.DefaultPollInterval),
+			Enabled:  enabled(taskWebhookRetries),
+			Jitter:   jitter(webhooks.DefaultPollInterval),
+			Run:      bg.webhookWorker.ProcessDue,
+		},
+		// Check the job application links and company logos
+		{
+			Name:     taskLinkChecks,
+			Schedule: scheduler.Every(linkcheck.DefaultPollInterval),
+			Enabled:  enabled(taskLinkChecks) && cfg.LinkCheckInterval > 0,
+			Jitter:   jitter(linkcheck.DefaultPollInterval),
+			Run: func(ctx context.Context) error {
+				_, err := bg.linkCheckWorker.ProcessDue(ctx)
+				return err
+			},
+		},
+		// Remove the expired user sessions
+		{
+			Name:     taskSessionPurge,
+			Schedule: mustParseSchedule("@hourly"),
+			Enabled:  enabled(taskSessionPurge),
+			Jitter:   cfg.SchedulerJitter,
+			Run: func(ctx context.Context) error {
+				purged, err := bg.userRepo.PurgeExpiredSessions(ctx)
+				if err == nil && purged > 0 {
+					log.Infof("Purged %d expired user sessions", purged)
+				}
+				return err
+			},
+		},
+		// Detect the volume anomalies of the last scraper runs, exposed as metrics
+		{
+			Name:     taskIngestAnomalies,
+			Schedule: scheduler.Every(ingest.DefaultAnomalyRefreshInterval),
+			Enabled:  enabled(taskIngestAnomalies),
+			Jitter:   jitter(ingest.DefaultAnomalyRefreshInterval),
+			Run:      bg.anomalyMonitor.Refresh,
+		},
+		// Remove the outbox messages published a while ago
+		{
+			Name:     taskOutboxPurge,
+			Schedule: mustParseSchedule("@daily"),
+			Enabled:  enabled(taskOutboxPurge),
+			Jitter:   cfg.SchedulerJitter,
+			Run: func(ctx context.Context) error {
+				_, err := bg.outboxRepo.PurgePublished(ctx, time.Now().Add(-outbox.DefaultRetention))
+				return err
+			},
+		},
+		// Fetch the daily exchange rates of the salary currencies
+		{
+			Name:     taskFXRates,
+			Schedule: mustParseSchedule("@daily"),
+			Enabled:  enabled(taskFXRates),
+			Jitter:   cfg.SchedulerJitter,
+			Run:      bg.rateService.Refresh,
+		},
+		// Archive the technologies no recent active job asks for, restoring the ones asked for again
+		{
+			Name:     taskTechArchive,
+			Schedule: mustParseSchedule("@daily"),
+			Enabled:  enabled(taskTechArchive) && cfg.TechArchiveMonths > 0,
+			Jitter:   cfg.SchedulerJitter,
+			Run: func(ctx context.Context) error {
+				result, err := bg.techService.ArchiveUnused(ctx, cfg.TechArchiveMonths)
+				if err == nil && (result.Archived > 0 || result.Restored > 0) {
+					log.Infof("Archived %d unused technologies, restored %d", result.Archived, result.Restored)
+				}
+				return err
+			},
+		},
+		// Email the new jobs of the job alerts once due, see alerts.Alert.Due
+		{
+			Name:     taskJobAlerts,
+			Schedule: scheduler.Every(alerts.DefaultPollInterval),
+			Enabled:  enabled(taskJobAlerts) && bg.alertDigests != nil,
+			Jitter:   jitter(alerts.DefaultPollInterval),
+			Run: func(ctx context.Context) error {
+				sent, err := bg.alertDigests.SendDue(ctx)
+				if sent > 0 {
+					log.Infof("Emailed job alerts to %d users", sent)
+				}
+				return err
+			},
+		},
+		// Archive the daily statistics read by the history of the metrics
+		{
+			Name:     taskStatsSnapshot,
+			Schedule: mustParseSchedule("@daily"),
+			Enabled:  enabled(taskStatsSnapshot),
+			Jitter:   cfg.SchedulerJitter,
+			Run: func(ctx context.Context) error {
+				_, err := bg.statsService.SnapshotDaily(ctx)
+				return err
+			},
+		},
+		// Permanently remove the jobs and companies deactivated more than PURGE_RETENTION_DAYS days ago
+		{
+			Name:     taskDataPurge,
+			Schedule: mustParseSchedule("@daily"),
+			Enabled:  enabled(taskDataPurge) && cfg.PurgeRetentionDays > 0,
+			Jitter:   cfg.SchedulerJitter,
+			Run: func(ctx context.Context) error {
+				result, err := bg.purgeService.Purge(ctx, cfg.PurgeRetentionDays, false)
+				if err == nil && (result.Jobs > 0 || result.Companies > 0) {
+					log.Infof("Purged %d deactivated jobs and %d companies", result.Jobs, result.Companies)
+				}
+				return err
+			},
+		},
+		// Keep the run history bounded
+		{
+			Name:     taskRunHistoryPurge,
+			Schedule: mustParseSchedule("@daily"),
+			Enabled:  enabled(taskRunHistoryPurge),
+			Jitter:   cfg.SchedulerJitter,
+			Run: func(ctx context.Context) error {
+				_, err := bg.schedulerRepo.PurgeRuns(ctx, time.Now().Add(-scheduler.DefaultRunRetention))
+				return err
+			},
+		},
+	}
+	return scheduler.NewScheduler(bg.schedulerRepo, scheduler.Config{
+		OnError: func(task string, err error) {
+			log.Warnf("Scheduled task %s failed: %v", task, err)
+		},
+	}, tasks...)
+}
+
+// mustParseSchedule parses a schedule known to be valid
+func mustParseSchedule(spec string) scheduler.Schedule {
+	schedule, err := scheduler.ParseSchedule(spec)
+	if err != nil {
+		panic(err)
+	}
+	return schedule
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/assets"
+	"github.com/rodruizronald/ticos-in-tech/internal/company"
+)
+
+// companiesCommands holds the company maintenance commands
+var companiesCommands = map[string]command{
+	"store-logos": {
+		usage: "Copy the company logos still linked from their source to the asset storage",
+		run:   runCompaniesStoreLogos,
+	},
+}
+
+// runCompaniesStoreLogos stores the logos of the companies created before the asset storage was
+// configured. Logos that can't be used are reported and replaced by the placeholder.
+func runCompaniesStoreLogos(ctx context.Context, a *app, _ []string) error {
+	if !a.cfg.Assets.Enabled() {
+		return errors.New("asset storage not configured, set ASSETS_S3_ENDPOINT and ASSETS_S3_BUCKET")
+	}
+
+	logoService, err := assets.NewLogoServiceFromConfig(&a.cfg.Assets)
+	if err != nil {
+		return err
+	}
+
+	repo := company.NewRepository(a.dbpool)
+	companies, err := repo.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	stored, placeholders := 0, 0
+	for _, c := range companies {
+		if logoService.IsStored(c.LogoURL) {
+			continue
+		}
+
+		logoURL, storeErr := logoService.StoreLogo(ctx, c.LogoURL)
+		if logoURL == "" {
+			return storeErr
+		}
+		if storeErr != nil {
+			placeholders++
+			a.log.Warnf("Logo of %s replaced by the placeholder: %v", c.Name, storeErr)
+		}
+
+		c.LogoURL = logoURL
+		if err = repo.Update(ctx, c); err != nil {
+			return err
+		}
+		stored++
+	}
+
+	a.log.Infof("Stored %d company logos (%d replaced by the placeholder)", stored, placeholders)
+	return nil
+}
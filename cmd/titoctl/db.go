@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/backup"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+	"github.com/rodruizronald/ticos-in-tech/internal/purge"
+	"github.com/rodruizronald/ticos-in-tech/internal/seed"
+)
+
+// dbCommands holds the database commands
+var dbCommands = map[string]command{
+	"seed": {
+		usage: "Load a sample dataset of companies, technologies and jobs into a local database",
+		run:   runDBSeed,
+	},
+	"purge": {
+		usage: "Permanently remove the jobs and companies deactivated a while ago ([-days N] [-dry-run])",
+		run:   runDBPurge,
+	},
+	"backup": {
+		usage: "Dump the database with pg_dump to a file and a manifest of its row counts (-out FILE)",
+		run:   runDBBackup,
+	},
+	"restore": {
+		usage: "Replace the database with a dump of db backup and verify its row counts (-in FILE)",
+		run:   runDBRestore,
+	},
+}
+
+// runDBSeed loads the dataset of the seed package and refreshes the job search view. Rows already
+// in the database are kept, so it can run again after new migrations.
+func runDBSeed(ctx context.Context, a *app, _ []string) error {
+	dataset, err := seed.Default()
+	if err != nil {
+		return err
+	}
+
+	summary, err := seed.Load(ctx, a.dbpool, dataset, time.Now())
+	if err != nil {
+		return err
+	}
+	if err = jobs.NewRepository(a.dbpool).RefreshSearchView(ctx); err != nil {
+		return err
+	}
+
+	a.log.Infof("Seeded %d companies, %d technologies, %d aliases and %d jobs",
+		summary.Companies, summary.Technologies, summary.Aliases, summary.Jobs)
+	return nil
+}
+
+// runDBPurge permanently removes the jobs and companies deactivated more than -days days ago,
+// PURGE_RETENTION_DAYS by default, with their technologies, events and revisions. A dry run
+// reports what would be removed.
+func runDBPurge(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("db purge", flag.ContinueOnError)
+	days := fs.Int("days", a.cfg.PurgeRetentionDays, "Days the deactivated jobs and companies are kept")
+	dryRun := fs.Bool("dry-run", false, "Report what would be removed without removing it")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%w: %w", errUsage, err)
+	}
+
+	result, err := purge.NewPurgeService(purge.NewRepository(a.dbpool)).Purge(ctx, *days, *dryRun)
+	if err != nil {
+		return err
+	}
+
+	verb := "Purged"
+	if *dryRun {
+		verb = "Dry run, would purge"
+	}
+	a.log.Infof("%s %d jobs (%d technologies, %d events, %d revisions) and %d companies",
+		verb, result.Jobs, result.JobTechnologies, result.JobEvents, result.JobRevisions, result.Companies)
+	return nil
+}
+
+// newBackupService creates the backup service of the configured database
+func newBackupService(a *app) *backup.BackupService {
+	return backup.NewBackupService(backup.NewRepository(a.dbpool), a.cfg.Database.ConnectionString())
+}
+
+// runDBBackup dumps the database to the file given by -out, writing the row counts of the core
+// tables to its manifest. pg_dump must be installed.
+func runDBBackup(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("db backup", flag.ContinueOnError)
+	out := fs.String("out", "", "File the dump is written to, its manifest next to it")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%w: %w", errUsage, err)
+	}
+	if *out == "" {
+		return fmt.Errorf("%w: -out is required", errUsage)
+	}
+
+	manifest, err := newBackupService(a).Backup(ctx, *out)
+	if err != nil {
+		return err
+	}
+
+	a.log.Infof("Backed up the database to %s (%d jobs, %d companies)",
+		*out, manifest.RowCounts["jobs"], manifest.RowCounts["companies"])
+	return nil
+}
+
+// runDBRestore replaces the content of the database with the dump given by -in and checks the
+// restored row counts against its manifest. pg_restore must be installed.
+func runDBRestore(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("db restore", flag.ContinueOnError)
+	in := fs.String("in", "", "Dump written by db backup, its manifest next to it")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%w: %w", errUsage, err)
+	}
+	if *in == "" {
+		return fmt.Errorf("%w: -in is required", errUsage)
+	}
+
+	manifest, err := newBackupService(a).Restore(ctx, *in)
+	if err != nil {
+		return err
+	}
+
+	a.log.Infof("Restored the backup of %s from %s, row counts of %d tables verified",
+		manifest.CreatedAt.Format(time.RFC3339), *in, len(manifest.RowCounts))
+	return nil
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+	"github.com/rodruizronald/ticos-in-tech/internal/opensearch"
+)
+
+// jobsCommands holds the job maintenance commands
+var jobsCommands = map[string]command{
+	"backfill-signatures": {
+		usage: "Compute the signature of jobs stored without one",
+		run:   runJobsBackfillSignatures,
+	},
+	"refresh-search": {
+		usage: "Refresh the job search view so recent job changes become searchable",
+		run:   runJobsRefreshSearch,
+	},
+	"sanitize-descriptions": {
+		usage: "Sanitize the job descriptions again from the raw ones, e.g. after the rules change",
+		run:   runJobsSanitizeDescriptions,
+	},
+	"reindex": {
+		usage: "Rebuild the OpenSearch job index from the job search view",
+		run:   runJobsReindex,
+	},
+}
+
+// runJobsBackfillSignatures computes and stores the canonical signature of every job without one.
+// Jobs whose signature collides with an existing job are reported and left untouched.
+func runJobsBackfillSignatures(ctx context.Context, a *app, _ []string) error {
+	repo := jobs.NewRepository(a.dbpool)
+
+	sources, err := repo.ListJobsWithoutSignature(ctx)
+	if err != nil {
+		return err
+	}
+
+	updated, duplicates := 0, 0
+	for _, source := range sources {
+		err = repo.UpdateSignature(ctx, source.JobID, source.Signature())
+		switch {
+		case err == nil:
+			updated++
+		case jobs.IsDuplicate(err):
+			duplicates++
+			a.log.Warnf("Job %d (%s at %s) duplicates an existing job, skipping",
+				source.JobID, source.Title, source.CompanyName)
+		default:
+			return err
+		}
+	}
+
+	a.log.Infof("Backfilled %d job signatures (%d duplicates skipped)", updated, duplicates)
+	return nil
+}
+
+// runJobsSanitizeDescriptions sanitizes every job description from its raw version, storing the
+// ones that changed. Jobs stored before the raw descriptions were kept get theirs from the description.
+func runJobsSanitizeDescriptions(ctx context.Context, a *app, _ []string) error {
+	repo := jobs.NewRepository(a.dbpool)
+
+	sources, err := repo.ListDescriptions(ctx)
+	if err != nil {
+		return err
+	}
+
+	updated := 0
+	for _, source := range sources {
+		sanitized := source.Sanitized()
+		if sanitized == source.Description {
+			continue
+		}
+		if err = repo.UpdateDescription(ctx, source.JobID, sanitized, source.RawDescription); err != nil {
+			return err
+		}
+		updated++
+	}
+
+	a.log.Infof("Sanitized %d of %d job descriptions", updated, len(sources))
+	return nil
+}
+
+// runJobsRefreshSearch refreshes the materialized view read by the job search
+func runJobsRefreshSearch(ctx context.Context, a *app, _ []string) error {
+	if err := jobs.NewRepository(a.dbpool).RefreshSearchView(ctx); err != nil {
+		return err
+	}
+
+	a.log.Info("Job search view refreshed")
+	return nil
+}
+
+// runJobsReindex refreshes the job search view and rebuilds the OpenSearch index from it
+func runJobsReindex(ctx context.Context, a *app, _ []string) error {
+	repo := jobs.NewRepository(a.dbpool)
+	if err := repo.RefreshSearchView(ctx); err != nil {
+		return err
+	}
+
+	indexer := opensearch.NewIndexer(opensearch.NewClient(a.cfg.OpenSearch), repo)
+	indexed, err := indexer.Reindex(ctx)
+	if err != nil {
+		return err
+	}
+
+	a.log.Infof("Indexed %d jobs into OpenSearch index %s", indexed, a.cfg.OpenSearch.Index)
+	return nil
+}
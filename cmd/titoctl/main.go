@@ -0,0 +1,147 @@
+// Package main provides titoctl, the command line tool used to operate the job board.
+// It groups administrative tasks that work directly against the database, such as
+// technology maintenance, so they don't require the HTTP admin API to be exposed.
+//
+// Usage:
+//
+//	titoctl <group> <command> [flags]
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"syscall"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/actor"
+	"github.com/rodruizronald/ticos-in-tech/internal/config"
+	"github.com/rodruizronald/ticos-in-tech/internal/database"
+	"github.com/rodruizronald/ticos-in-tech/internal/exitcode"
+)
+
+// errUsage is returned when the command line arguments are invalid
+var errUsage = errors.New("invalid usage")
+
+// app holds the dependencies shared by all commands
+type app struct {
+	log    *logrus.Logger
+	cfg    *config.Config
+	dbpool *pgxpool.Pool
+}
+
+// command is a single titoctl subcommand
+type command struct {
+	usage string
+	run   func(ctx context.Context, a *app, args []string) error
+}
+
+// commandGroups maps a group name to its commands
+var commandGroups = map[string]map[string]command{
+	"companies": companiesCommands,
+	"db":        dbCommands,
+	"jobs":      jobsCommands,
+	"notify":    notifyCommands,
+	"tech":      techCommands,
+}
+
+func main() {
+	var err error
+	// The changes are recorded as made by the user running the command
+	ctx := actor.With(context.Background(), actor.CLI("titoctl"))
+	ctx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer func() {
+		stop()
+		if err != nil {
+			os.Exit(exitcode.Of(err))
+		}
+	}()
+	err = run(ctx, os.Args[1:])
+}
+
+func run(ctx context.Context, args []string) error {
+	// Initialize logger
+	log := logrus.New()
+	log.SetFormatter(&logrus.TextFormatter{
+		FullTimestamp: true,
+	})
+
+	cmd, err := findCommand(args)
+	if err != nil {
+		printUsage()
+		return exitcode.Wrap(exitcode.Usage, err)
+	}
+
+	// Load configuration from the environment
+	cfg, err := config.Load()
+	if err != nil {
+		log.Errorf("Invalid configuration: %v", err)
+		return exitcode.Wrap(exitcode.Config, err)
+	}
+
+	// Connect to the database
+	dbpool, err := database.Connect(ctx, &cfg.Database)
+	if err != nil {
+		log.Errorf("Unable to connect to database: %v", err)
+		return exitcode.Wrap(exitcode.Database, err)
+	}
+	defer dbpool.Close()
+
+	if err = cmd.run(ctx, &app{log: log, cfg: cfg, dbpool: dbpool}, args[2:]); err != nil {
+		log.Errorf("Command failed: %v", err)
+		if errors.Is(err, errUsage) {
+			return exitcode.Wrap(exitcode.Usage, err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// findCommand resolves the command for the given group and command names
+func findCommand(args []string) (command, error) {
+	if len(args) < 2 {
+		return command{}, errUsage
+	}
+
+	group, ok := commandGroups[args[0]]
+	if !ok {
+		return command{}, fmt.Errorf("%w: unknown command group %q", errUsage, args[0])
+	}
+
+	cmd, ok := group[args[1]]
+	if !ok {
+		return command{}, fmt.Errorf("%w: unknown command %q %q", errUsage, args[0], args[1])
+	}
+
+	return cmd, nil
+}
+
+// printUsage prints the available commands to stderr
+func printUsage() {
+	fmt.Fprintln(os.Stderr, "Usage: titoctl <group> <command> [flags]")
+	fmt.Fprintln(os.Stderr, "\nCommands:")
+
+	groups := make([]string, 0, len(commandGroups))
+	for name := range commandGroups {
+		groups = append(groups, name)
+	}
+	sort.Strings(groups)
+
+	for _, groupName := range groups {
+		names := make([]string, 0, len(commandGroups[groupName]))
+		for name := range commandGroups[groupName] {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			fmt.Fprintf(os.Stderr, "  %s %s\t%s\n", groupName, name, commandGroups[groupName][name].usage)
+		}
+	}
+}
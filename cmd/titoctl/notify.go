@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/notifier"
+)
+
+// notifyCommands holds the chat announcement commands
+var notifyCommands = map[string]command{
+	"digest": {
+		usage: "Post the jobs published in the last 24 hours to the configured Slack and Telegram channels",
+		run:   runNotifyDigest,
+	},
+}
+
+// runNotifyDigest posts the daily digest of published jobs. It is meant to be scheduled once a day.
+func runNotifyDigest(ctx context.Context, a *app, _ []string) error {
+	if !a.cfg.Notifier.Enabled() {
+		return errors.New("no notification channel configured, set NOTIFY_SLACK_WEBHOOK_URLS " +
+			"or NOTIFY_TELEGRAM_BOT_TOKEN and NOTIFY_TELEGRAM_CHAT_IDS")
+	}
+
+	n := notifier.NewNotifierFromConfig(notifier.NewRepository(a.dbpool), &a.cfg.Notifier)
+	announced, err := n.SendDigest(ctx)
+	if err != nil {
+		return err
+	}
+
+	a.log.Infof("Posted the digest of %d jobs", announced)
+	return nil
+}
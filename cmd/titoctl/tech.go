@@ -0,0 +1,167 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/pendingtech"
+	"github.com/rodruizronald/ticos-in-tech/internal/techalias"
+	"github.com/rodruizronald/ticos-in-tech/internal/techdetect"
+	"github.com/rodruizronald/ticos-in-tech/internal/technology"
+)
+
+// techCommands holds the technology maintenance commands
+var techCommands = map[string]command{
+	"merge": {
+		usage: "Merge a duplicate technology into a canonical one (-from ID -into ID)",
+		run:   runTechMerge,
+	},
+	"pending": {
+		usage: "List technologies found in jobs that are not known yet",
+		run:   runTechPending,
+	},
+	"approve": {
+		usage: "Approve a pending technology (-id ID [-category NAME | -alias-of ID])",
+		run:   runTechApprove,
+	},
+	"reject": {
+		usage: "Reject a pending technology (-id ID)",
+		run:   runTechReject,
+	},
+	"detect": {
+		usage: "Detect the technologies of the active jobs in their descriptions ([-auto-accept CONFIDENCE])",
+		run:   runTechDetect,
+	},
+}
+
+// newTechnologyService creates the technology service backed by the database
+func newTechnologyService(a *app) *technology.TechnologyService {
+	return technology.NewTechnologyService(
+		technology.NewRepository(a.dbpool),
+		techalias.NewRepository(a.dbpool),
+		nil,
+	)
+}
+
+// newPendingTechnologyService creates the pending technology service backed by the database
+func newPendingTechnologyService(a *app) *pendingtech.PendingTechnologyService {
+	return pendingtech.NewPendingTechnologyService(pendingtech.NewRepository(a.dbpool), newTechnologyService(a))
+}
+
+// runTechMerge merges the technology given by -from into the one given by -into
+func runTechMerge(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("tech merge", flag.ContinueOnError)
+	fromID := fs.Int("from", 0, "ID of the duplicate technology to remove")
+	intoID := fs.Int("into", 0, "ID of the canonical technology to keep")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%w: %w", errUsage, err)
+	}
+	if *fromID <= 0 || *intoID <= 0 {
+		return fmt.Errorf("%w: both -from and -into are required", errUsage)
+	}
+
+	tech, err := newTechnologyService(a).Merge(ctx, *fromID, *intoID)
+	if err != nil {
+		return err
+	}
+
+	a.log.Infof("Merged technology %d into %s (ID: %d)", *fromID, tech.Name, tech.ID)
+	return nil
+}
+
+// runTechPending prints the pending technologies, most frequent first
+func runTechPending(ctx context.Context, a *app, _ []string) error {
+	pendingTechs, err := newPendingTechnologyService(a).List(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(pendingTechs) == 0 {
+		a.log.Info("No pending technologies")
+		return nil
+	}
+
+	for _, pending := range pendingTechs {
+		suggestion := ""
+		if pending.SuggestedTechnologyID != nil && pending.SuggestionScore != nil {
+			suggestion = fmt.Sprintf("\tsimilar to technology %d (score %.2f)",
+				*pending.SuggestedTechnologyID, *pending.SuggestionScore)
+		}
+		fmt.Printf("%d\t%s\t%d occurrences\tlast seen %s%s\n",
+			pending.ID, pending.Name, pending.Occurrences, pending.LastSeenAt.Format(time.DateOnly), suggestion)
+	}
+	return nil
+}
+
+// runTechApprove approves the pending technology given by -id, either as a new technology
+// in -category or as an alias of the technology given by -alias-of
+func runTechApprove(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("tech approve", flag.ContinueOnError)
+	id := fs.Int("id", 0, "ID of the pending technology")
+	category := fs.String("category", "", "Category of the new technology")
+	aliasOf := fs.Int("alias-of", 0, "ID of the technology the name is an alias of")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%w: %w", errUsage, err)
+	}
+	if *id <= 0 {
+		return fmt.Errorf("%w: -id is required", errUsage)
+	}
+
+	params := pendingtech.ApproveParams{Category: *category}
+	if *aliasOf > 0 {
+		params.AliasOf = aliasOf
+	}
+
+	tech, err := newPendingTechnologyService(a).Approve(ctx, *id, params)
+	if err != nil {
+		return err
+	}
+
+	a.log.Infof("Approved pending technology %d as %s (ID: %d)", *id, tech.Name, tech.ID)
+	return nil
+}
+
+// runTechReject discards the pending technology given by -id
+func runTechReject(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("tech reject", flag.ContinueOnError)
+	id := fs.Int("id", 0, "ID of the pending technology")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%w: %w", errUsage, err)
+	}
+	if *id <= 0 {
+		return fmt.Errorf("%w: -id is required", errUsage)
+	}
+
+	if err := newPendingTechnologyService(a).Reject(ctx, *id); err != nil {
+		return err
+	}
+
+	a.log.Infof("Rejected pending technology %d", *id)
+	return nil
+}
+
+// runTechDetect looks for the known technologies in the descriptions of the active jobs, accepting
+// the detections of -auto-accept confidence or more and leaving the others for review
+func runTechDetect(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("tech detect", flag.ContinueOnError)
+	autoAccept := fs.Float64("auto-accept", techdetect.DefaultAutoAcceptConfidence,
+		"Confidence from which detections are accepted without review")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%w: %w", errUsage, err)
+	}
+	if *autoAccept <= 0 || *autoAccept > 1 {
+		return fmt.Errorf("%w: -auto-accept must be between 0 and 1", errUsage)
+	}
+
+	service := techdetect.NewDetectionService(techdetect.NewRepository(a.dbpool), *autoAccept)
+	summary, err := service.DetectAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	a.log.Infof("Detected technologies in %d jobs: %d accepted, %d pending review",
+		summary.Jobs, summary.Accepted, summary.Pending)
+	return nil
+}
@@ -18,9 +18,49 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/jobs": {
+        "/admin/blocklist": {
             "get": {
-                "description": "Search for jobs with optional filters and pagination",
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Returns the networks blocked from the public job search, the newest first, including\nthe expired ones",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "List blocked networks",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/botguard.BlockedNetworkListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Blocks the clients of a network, or of a single address, from the public job search\nuntil it expires, answering their requests with 403 Forbidden. Every server instance\nblocks it once it reloads its blocklist.",
                 "consumes": [
                     "application/json"
                 ],
@@ -28,148 +68,8603 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "jobs"
+                    "admin"
                 ],
-                "summary": "Search for jobs",
+                "summary": "Block a network",
                 "parameters": [
                     {
-                        "type": "string",
-                        "example": "\"golang developer\"",
-                        "description": "Search query",
-                        "name": "q",
-                        "in": "query",
-                        "required": true
+                        "description": "Blocked network",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/botguard.BlockRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/botguard.BlockedNetworkResponse"
+                        }
                     },
-                    {
-                        "type": "integer",
-                        "default": 20,
-                        "example": 20,
-                        "description": "Number of results to return (max 100)",
-                        "name": "limit",
-                        "in": "query"
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/blocklist/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Removes a network from the blocklist",
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Unblock a network",
+                "parameters": [
                     {
                         "type": "integer",
-                        "default": 0,
-                        "example": 0,
-                        "description": "Number of results to skip",
-                        "name": "offset",
-                        "in": "query"
+                        "description": "Blocked network ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
                     },
-                    {
-                        "type": "string",
-                        "description": "Experience level filter",
-                        "name": "experience_level",
-                        "in": "query"
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
                     },
-                    {
-                        "type": "string",
-                        "description": "Employment type filter",
-                        "name": "employment_type",
-                        "in": "query"
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
                     },
-                    {
-                        "enum": [
-                            "Costa Rica",
-                            "LATAM"
-                        ],
-                        "type": "string",
-                        "example": "\"Costa Rica\"",
-                        "description": "Location filter",
-                        "name": "location",
-                        "in": "query"
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/companies": {
+            "post": {
+                "security": [
                     {
-                        "enum": [
-                            "Remote",
-                            "Hybrid",
-                            "Onsite"
-                        ],
-                        "type": "string",
-                        "example": "\"Remote\"",
-                        "description": "Work mode filter",
-                        "name": "work_mode",
-                        "in": "query"
-                    },
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Creates a company. Companies that may duplicate existing ones, by a name differing only in\ncase, accents or a legal or country suffix (\"Equifax CR\" and \"EquiFax\") or by the domain of\ntheir website, are rejected with 409 listing them, unless ignore_similar is set.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Create a company",
+                "parameters": [
                     {
-                        "type": "string",
-                        "example": "\"Tech Corp\"",
-                        "description": "Company name filter (partial match)",
-                        "name": "company",
-                        "in": "query"
+                        "description": "Company to create",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/company.CompanyCreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/company.CompanyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/companies/{slug}": {
+            "patch": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Changes the fields of a company present in the request, e.g. to fix a typo, leaving the\nothers as they are. The slug is kept, and a renamed company keeps its former name as an alias.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Change some fields of a company",
+                "parameters": [
                     {
                         "type": "string",
-                        "example": "\"2024-01-01\"",
-                        "description": "Start date filter (YYYY-MM-DD)",
-                        "name": "date_from",
-                        "in": "query"
+                        "example": "\"tech-corp\"",
+                        "description": "Company slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "string",
-                        "example": "\"2024-12-31\"",
-                        "description": "End date filter (YYYY-MM-DD)",
-                        "name": "date_to",
-                        "in": "query"
+                        "description": "Fields to change",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/company.CompanyPatchRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/jobs.SearchResponse"
+                            "$ref": "#/definitions/company.CompanyResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/jobs.ErrorResponse"
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/jobs.ErrorResponse"
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/companies/{slug}/deactivate": {
+            "post": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Archives a company that shut down or left the market, taking down all its active jobs\nand rejecting its pending ones.\nThe company is hidden from the public API afterwards.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Deactivate a company",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "\"tech-corp\"",
+                        "description": "Company slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/company.DeactivateResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "jobs.ErrorDetails": {
+        },
+        "/admin/companies/{slug}/email-domain": {
+            "put": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Sets the domain of the addresses users claim the company with, e.g. techcorp.com. An\nempty domain makes the company unclaimable, its members are kept.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Set the email domain of a company",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "\"tech-corp\"",
+                        "description": "Company slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Email domain",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/employer.EmailDomainRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/employer.CompanyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/companies/{slug}/merge": {
+            "post": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Moves the jobs, aliases, claims and members of a company created twice to the company\nit duplicates, keeping its name as an alias of that company, and deletes it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Merge a duplicate company into another one",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "\"equifax-cr\"",
+                        "description": "Slug of the duplicate company",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Company to merge into",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/company.MergeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/company.MergeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/ingest/runs": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Lists the most recent scraper runs with the number of companies scraped and jobs found",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "List scraper runs",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "example": 20,
+                        "description": "Number of runs to return (max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ingest.RunListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/ingest/runs/{id}/anomalies": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Lists the companies of a run with an anomalous number of jobs found compared with their\nprevious scrapes by the same source: ten times their average or none for an active company.\nThese usually mean a broken scraper selector.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "List the volume anomalies of a scraper run",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Run ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ingest.AnomalyListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/ingest/stale": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Lists the active companies without a successful scrape within the max age, the ones\nnever scraped first, with their last reported status",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "List stale companies",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 48,
+                        "example": 48,
+                        "description": "Hours since the last successful scrape (max 2160)",
+                        "name": "max_age_hours",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ingest.StaleListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/job-events/stats": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Returns the views and application clicks per job and per company for a date range,\nthe last 30 days by default",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Get job event statistics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "\"2024-01-01\"",
+                        "description": "Start date (YYYY-MM-DD)",
+                        "name": "date_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"2024-01-31\"",
+                        "description": "End date, inclusive (YYYY-MM-DD)",
+                        "name": "date_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "example": 20,
+                        "description": "Number of jobs and companies to return (max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/jobevent.StatsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/jobs": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Lists the jobs with a moderation status, oldest first. Pending jobs, the default,\nare waiting for an admin to approve or reject them and are not published. The jobs\ncan be filtered by source, company, active flag and creation dates.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "List jobs by moderation status",
+                "parameters": [
+                    {
+                        "enum": [
+                            "pending",
+                            "published",
+                            "rejected"
+                        ],
+                        "type": "string",
+                        "default": "pending",
+                        "description": "Moderation status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "linkedin",
+                            "greenhouse",
+                            "company_site",
+                            "employer_portal"
+                        ],
+                        "type": "string",
+                        "example": "linkedin",
+                        "description": "Source the jobs were scraped from",
+                        "name": "source",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "example": 2,
+                        "description": "Company ID",
+                        "name": "company_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "example": true,
+                        "description": "Whether the jobs are active",
+                        "name": "is_active",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2024-01-01",
+                        "description": "First creation date of the jobs (YYYY-MM-DD)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2024-01-31",
+                        "description": "Last creation date of the jobs (YYYY-MM-DD)",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "example": 20,
+                        "description": "Number of jobs to return (max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "example": 0,
+                        "description": "Number of jobs to skip",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/jobs.ModerationListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/jobs/near-duplicates": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Lists pairs of active jobs of the same company posted within a time window whose titles\nor application URLs are very similar, so reposted jobs can be reviewed",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "List near-duplicate jobs",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 30,
+                        "example": 30,
+                        "description": "Maximum days between both postings (max 365)",
+                        "name": "window_days",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "default": 0.8,
+                        "example": 0.8,
+                        "description": "Title similarity threshold (0-1]",
+                        "name": "min_title_similarity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "default": 0.9,
+                        "description": "Application URL similarity threshold (0-1]",
+                        "name": "min_url_similarity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "example": 100,
+                        "description": "Number of pairs to return (max 500)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/jobs.NearDuplicateListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/jobs/{id}": {
+            "patch": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Changes the fields of a job present in the request, e.g. to fix a typo, leaving the others\nas they are. The UTC offsets are changed together.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Change some fields of a job",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/jobs.JobPatchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/jobs/{id}/approve": {
+            "post": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Publishes a pending job, making it active and searchable",
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Approve a pending job",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/jobs/{id}/deactivate": {
+            "post": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Takes down an active job, e.g. once the position is filled",
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Deactivate a job",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/jobs/{id}/reject": {
+            "post": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Rejects a pending job with the reason it won't be published",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Reject a pending job",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Rejection reason",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/jobs.RejectRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/jobs/{id}/revisions": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Lists the previous versions of the content of a job, newest first. Each revision lists\nthe fields changed by the version that replaced it.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "List the revisions of a job",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/jobrevision.RevisionListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/link-checks": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Lists the last check of the job application links and company logos, most recent first.\nApplication links answering 404 or 410 on consecutive checks get their job deactivated.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "List link checks",
+                "parameters": [
+                    {
+                        "enum": [
+                            "application_url",
+                            "logo_url"
+                        ],
+                        "type": "string",
+                        "description": "Link kind",
+                        "name": "kind",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only the links that failed their last check",
+                        "name": "failing",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "example": 50,
+                        "description": "Number of checks to return (max 500)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/linkcheck.CheckListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/maintenance/refresh": {
+            "post": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Refreshes the job search view, recomputes the cached statistics and purges the expired\ncache entries, so recent job changes such as a bulk import show up right away.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Refresh derived data",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/maintenance.RefreshResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/pending-technologies": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Lists technology names found in job postings that don't match any known technology,\nmost frequent first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "List pending technologies",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pendingtech.ListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/pending-technologies/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Removes a pending technology without creating anything",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Reject a pending technology",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Pending technology ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/pending-technologies/{id}/approve": {
+            "post": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Creates a technology from a pending name, or registers it as an alias of an existing\ntechnology when alias_of is set, and removes it from the pending list",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Approve a pending technology",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Pending technology ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Approval options",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pendingtech.ApproveRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technology.TechnologyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/quality": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Counts the active jobs missing technologies, with unknown experience levels, employment\ntypes or work modes, or with an inferred experience level, the active companies without\na logo and the technologies and technology detections waiting for review. The job\ncounts are also broken down by the source the jobs were scraped from.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Get the data quality summary",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/quality.SummaryResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/quality/companies-without-logos": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Lists the active companies without a logo URL or whose logo failed its last check,\nthe ones with the most active jobs first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "List the companies without logos",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "example": 20,
+                        "description": "Number of companies to return (max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/quality.CompanyIssueListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/quality/jobs-missing-technologies": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Lists the active jobs not associated with any technology, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "List the jobs missing technologies",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "example": 20,
+                        "description": "Number of jobs to return (max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/quality.JobIssueListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/quality/jobs-unknown-values": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Lists the active jobs whose experience level, employment type or work mode isn't one of\nthe canonical values, newest first, with the fields at fault",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "List the jobs with unknown values",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "example": 20,
+                        "description": "Number of jobs to return (max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/quality.JobIssueListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/reference-values": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Returns the values of the job attributes the requests are validated against, by kind\nand position",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "List reference values",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/reference.ValueListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Adds a value to a job attribute, e.g. the \"Nicaragua\" location, after its existing\nvalues. Every server instance accepts it once it reloads its reference values.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Add a reference value",
+                "parameters": [
+                    {
+                        "description": "Reference value",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/reference.CreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/reference.ValueResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/scheduler/runs": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Lists the background tasks of the server, whether they are enabled, and their runs, most\nrecent first. Runs skipped because another instance held the task lock are not listed.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "List scheduled task runs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "search-view-refresh",
+                        "description": "Task name",
+                        "name": "task",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "example": 50,
+                        "description": "Number of runs to return (max 500)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/scheduler.RunListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/settings": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Returns the settings that can be changed while the server runs, with their current value\nand the one of the environment",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "List settings",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/settings.SettingListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/settings/changes": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Returns who changed which setting, from and to which value, the newest changes first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "List setting changes",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "example": 50,
+                        "description": "Number of changes to return (max 500)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/settings.ChangeListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/settings/{name}": {
+            "put": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Overrides the value of a setting from the environment without a restart. Every server\ninstance applies it once the database notifies the change, and the change is audited.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Change a setting",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "log_level",
+                        "description": "Setting name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New value",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/settings.UpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/settings.SettingResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Removes the override of a setting, bringing back the value of the environment. The\nchange is audited.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Reset a setting",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "log_level",
+                        "description": "Setting name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/settings.SettingResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/technologies/aliases": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Lists every technology with its aliases, by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Export the technology aliases",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technology.AliasExportResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/technologies/aliases.csv": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Lists every technology with its aliases, by name, as a CSV file to edit in a spreadsheet.\nEach technology is a row with its aliases separated by semicolons.",
+                "produces": [
+                    "text/csv"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Export the technology aliases as CSV",
+                "responses": {
+                    "200": {
+                        "description": "id,name,category,aliases",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/technologies/unused": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Lists the technologies no active job posted in the last months asks for, the least recently\nused first, with when they were archived. Technologies added in the meantime are left out.\nThey are archived daily, which leaves them out of the search suggestions.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "List the unused technologies",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 6,
+                        "example": 6,
+                        "description": "Months without active jobs (max 120)",
+                        "name": "months",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technology.UnusedTechnologiesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/technologies/{id}/aliases/batch": {
+            "post": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Gives a technology the aliases of a list, e.g. maintained in a spreadsheet. Aliases naming\nanother technology or aliasing it are reported as conflicts and left as they are. With\nreplace, the aliases of the technology missing from the list are deleted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Import the aliases of a technology",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Technology ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Aliases of the technology",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/technology.AliasBatchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technology.AliasBatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/technologies/{id}/merge": {
+            "post": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Re-points job associations, aliases and children of a duplicate technology to the\ncanonical one, registers the old name as an alias and deletes the duplicate",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Merge a duplicate technology",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Duplicate technology ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Canonical technology",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/technology.MergeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/technology.TechnologyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/technology-detections": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Lists technologies found in job descriptions that the jobs aren't associated with and\nweren't confident enough to be accepted right away, most confident first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "List pending technology detections",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/techdetect.ListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/technology-detections/{id}/accept": {
+            "post": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Associates the detected technology with the job, flagged as auto-detected",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Accept a technology detection",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Technology detection ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/techdetect.DetectionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/technology-detections/{id}/reject": {
+            "post": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Discards a technology detection, so the technology isn't proposed for the job again",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Reject a technology detection",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Technology detection ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/webhooks": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Returns all the webhook subscriptions, without their secrets",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "List webhook subscriptions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/webhooks.SubscriptionListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Subscribes an endpoint to job events, all of them when no event types are given.\nDeliveries are signed with the returned secret, which isn't shown again: the\nX-Webhook-Signature header is \"sha256=\" followed by the hex HMAC-SHA256 of\n\"\u003cX-Webhook-Timestamp\u003e.\u003cbody\u003e\".",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Create a webhook subscription",
+                "parameters": [
+                    {
+                        "description": "Subscription",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/webhooks.SubscribeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/webhooks.SubscribeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/webhooks/dead-letters": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Returns the most recent deliveries that failed every attempt, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "List dead webhook deliveries",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "example": 50,
+                        "description": "Number of deliveries to return (max 500)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/webhooks.DeadLetterListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/webhooks/dead-letters/{id}/retry": {
+            "post": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Queues a dead delivery again with a fresh set of attempts",
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Retry a dead webhook delivery",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Delivery ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/webhooks/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    }
+                ],
+                "description": "Deletes a subscription with its pending and dead deliveries",
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Delete a webhook subscription",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Subscription ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/alerts/unsubscribe": {
+            "post": {
+                "description": "Stops the alert of the token of the unsubscribe link of its emails, without logging in.\nUnsubscribing again succeeds too. Editing the alert subscribes it again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "alerts"
+                ],
+                "summary": "Unsubscribe from a job alert",
+                "parameters": [
+                    {
+                        "description": "Token of the unsubscribe link",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/alerts.UnsubscribeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/login": {
+            "post": {
+                "description": "Checks the credentials of a user and returns a session token valid for 30 days",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Log in",
+                "parameters": [
+                    {
+                        "description": "User credentials",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/users.CredentialsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/users.SessionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/logout": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Closes the session of the token used in the request",
+                "tags": [
+                    "users"
+                ],
+                "summary": "Log out",
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/oauth/exchange": {
+            "post": {
+                "description": "Opens a session of the user of the one-time code the OAuth callback redirected to the\nfrontend with, like a login with a password. A code is only exchanged once.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Exchange the code of an OAuth login for a session",
+                "parameters": [
+                    {
+                        "description": "One-time code of the login",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/users.LoginCodeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/users.SessionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/oauth/{provider}": {
+            "get": {
+                "description": "Redirects to the consent page of Google or GitHub, which redirects back to the callback.\nThe state of the login is kept for 10 minutes in the oauth_state cookie.",
+                "tags": [
+                    "users"
+                ],
+                "summary": "Log in with an OAuth provider",
+                "parameters": [
+                    {
+                        "enum": [
+                            "google",
+                            "github"
+                        ],
+                        "type": "string",
+                        "description": "OAuth provider",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "Redirect to the consent page of the provider",
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "Consent page of the provider"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/oauth/{provider}/callback": {
+            "get": {
+                "description": "Called by the provider once the user consents, with the state of the login started in\nthe same browser. Redirects to the page of the frontend with a one-time code in its code\nquery parameter, exchanged for a session at /auth/oauth/exchange within a minute, or with\nthe code of the error in its error one, e.g. UNAUTHORIZED.\nAn account logging in for the first time is linked to the user of its email, created\nwithout a password when not registered, as long as the provider verified the email.",
+                "tags": [
+                    "users"
+                ],
+                "summary": "Complete a login with an OAuth provider",
+                "parameters": [
+                    {
+                        "enum": [
+                            "google",
+                            "github"
+                        ],
+                        "type": "string",
+                        "description": "OAuth provider",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "4/0AfJohXn",
+                        "description": "Authorization code",
+                        "name": "code",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "Jx2f0kq3",
+                        "description": "State of the login",
+                        "name": "state",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Error of the provider, e.g. access_denied when the user didn't consent",
+                        "name": "error",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "Redirect to the frontend",
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "Page of the frontend with the code or the error of the login"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/register": {
+            "post": {
+                "description": "Creates a user account with an email and a password of 8 to 72 characters",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Register a user",
+                "parameters": [
+                    {
+                        "description": "User credentials",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/users.CredentialsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/users.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/benefits": {
+            "get": {
+                "description": "Lists the benefits whose slugs can be used with the benefits filter of the job search",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "jobs"
+                ],
+                "summary": "List benefits",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/benefit.ListResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/companies/{slug}": {
+            "get": {
+                "description": "Get the public profile of a company by its URL slug",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "companies"
+                ],
+                "summary": "Get a company",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "\"tech-corp\"",
+                        "description": "Company slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/company.CompanyResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/companies/{slug}/claims": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Emails a verification code to an address of the email domain of the company, or of\none of its subdomains. The code is valid for 30 minutes, a user can claim 5 times an hour.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "company portal"
+                ],
+                "summary": "Claim a company",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "\"tech-corp\"",
+                        "description": "Company slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Address to verify",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/employer.ClaimRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/employer.ClaimResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/companies/{slug}/claims/{id}/verify": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Checks the emailed code of a claim and makes the user a member of the company. The first\nmember gets the jobs and members scopes, the next ones only the jobs scope.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "company portal"
+                ],
+                "summary": "Verify a company claim",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "\"tech-corp\"",
+                        "description": "Company slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Claim ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Emailed code",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/employer.VerifyClaimRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/employer.MembershipResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/companies/{slug}/technologies": {
+            "get": {
+                "description": "Lists the distinct technologies of the active jobs of a company, most used first,\nwith the number of jobs using and requiring each of them",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "companies"
+                ],
+                "summary": "Get the technology stack of a company",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "\"tech-corp\"",
+                        "description": "Company slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/company.TechnologyStackResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/embed/jobs": {
+            "get": {
+                "description": "Lists the newest active jobs of a company for its careers page, to any origin. With\nformat=html the openings are a pre-rendered HTML snippet to insert in the page as is.\nResults are cached for 5 minutes.",
+                "produces": [
+                    "application/json",
+                    "text/html"
+                ],
+                "tags": [
+                    "embed"
+                ],
+                "summary": "Get the openings of a company to embed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "\"tech-corp\"",
+                        "description": "Company slug",
+                        "name": "company",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "html"
+                        ],
+                        "type": "string",
+                        "default": "json",
+                        "description": "Response format",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "example": 10,
+                        "description": "Number of jobs to return (max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/widget.OpeningsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/ingest/jobs/validate": {
+            "post": {
+                "security": [
+                    {
+                        "IngestAPIKey": []
+                    }
+                ],
+                "description": "Checks a batch of jobs against the JSON Schema served at /schemas/jobs.json without\nimporting it. Every value that doesn't match is reported in the details of the error,\nprefixed by its JSON pointer, e.g. \"/jobs/3: missing property 'title'\".",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ingest"
+                ],
+                "summary": "Validate a batch of scraped jobs",
+                "parameters": [
+                    {
+                        "description": "Batch of jobs",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ingest.JobsValidationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/ingest/runs": {
+            "post": {
+                "security": [
+                    {
+                        "IngestAPIKey": []
+                    }
+                ],
+                "description": "Registers a running scraper run. Scrapers report the status of every company\nthey scrape to the run and close it when done.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ingest"
+                ],
+                "summary": "Register a scraper run",
+                "parameters": [
+                    {
+                        "description": "Scraper source",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ingest.StartRunRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/ingest.RunResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/ingest/runs/{id}/close": {
+            "post": {
+                "security": [
+                    {
+                        "IngestAPIKey": []
+                    }
+                ],
+                "description": "Sets the final status of a running run, with the error that made it fail if any",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ingest"
+                ],
+                "summary": "Close a scraper run",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Run ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Final status",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ingest.CloseRunRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ingest.RunResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/ingest/runs/{id}/companies": {
+            "put": {
+                "security": [
+                    {
+                        "IngestAPIKey": []
+                    }
+                ],
+                "description": "Stores whether the scrape of a company succeeded during a running run and how many\njobs it found. Reporting the same company again replaces its report.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ingest"
+                ],
+                "summary": "Report the scrape status of a company",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Run ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Company scrape status",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ingest.CompanyReportRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ingest.CompanyReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/job-functions": {
+            "get": {
+                "description": "Lists the job functions that can be used with the function filter of the job search",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "jobs"
+                ],
+                "summary": "List job functions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/jobfunction.ListResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/jobs": {
+            "get": {
+                "description": "Search for jobs with optional filters and pagination. Jobs matching the query with its\nterms replaced by their synonyms are found too, e.g. \"quality assurance\" jobs for \"qa\".\nSearches finding nothing suggest a corrected query in did_you_mean.\nJobs are ranked by relevance, which weighs how well they match the query, how recent\nthey are and whether they are featured, or listed newest first with sort=date.\nThe Link header holds the URLs of the next and previous pages.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "jobs"
+                ],
+                "summary": "Search for jobs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "\"golang developer\"",
+                        "description": "Search query",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "example": 20,
+                        "description": "Number of results to return (max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "example": 0,
+                        "description": "Number of results to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Experience level filter",
+                        "name": "experience_level",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Employment type filter",
+                        "name": "employment_type",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "Costa Rica",
+                            "LATAM"
+                        ],
+                        "type": "string",
+                        "example": "\"Costa Rica\"",
+                        "description": "Location filter",
+                        "name": "location",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Province of Costa Rica filter, accents optional",
+                        "name": "province",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "Remote",
+                            "Hybrid",
+                            "Onsite"
+                        ],
+                        "type": "string",
+                        "example": "\"Remote\"",
+                        "description": "Work mode filter",
+                        "name": "work_mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"Tech Corp\"",
+                        "description": "Company name filter (partial match)",
+                        "name": "company",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"Backend\"",
+                        "description": "Job function filter (see /job-functions)",
+                        "name": "function",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "en",
+                            "es"
+                        ],
+                        "type": "string",
+                        "example": "\"es\"",
+                        "description": "Posting language filter",
+                        "name": "language",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Where remote applicants can be based",
+                        "name": "remote_eligibility",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "UTC offset in hours the working timezones must include",
+                        "name": "utc_offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated benefit slugs the jobs must all offer (see /benefits)",
+                        "name": "benefits",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"2024-01-01\"",
+                        "description": "Start date filter (YYYY-MM-DD)",
+                        "name": "date_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"2024-12-31\"",
+                        "description": "End date filter (YYYY-MM-DD)",
+                        "name": "date_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated job fields to return, all when empty",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Add description snippets with the matched terms between \u003cmark\u003e tags",
+                        "name": "highlight",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "relevance",
+                            "date"
+                        ],
+                        "type": "string",
+                        "default": "relevance",
+                        "description": "Order of the jobs",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/jobs.SearchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/jobs.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/jobs.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/jobs.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/jobs.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/jobs/export.csv": {
+            "get": {
+                "description": "Streams the jobs found by a search as a CSV file, with the same filters and order as\nthe job search and up to a configured number of jobs. Benefits are separated by\nsemicolons. Cells starting with =, +, -, @, a tab or a carriage return are prefixed\nwith a quote so spreadsheets don't run them as formulas. Exports are rate limited per client.",
+                "produces": [
+                    "text/csv",
+                    "application/json"
+                ],
+                "tags": [
+                    "jobs"
+                ],
+                "summary": "Export a job search as CSV",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "\"golang developer\"",
+                        "description": "Search query",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Experience level filter",
+                        "name": "experience_level",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Employment type filter",
+                        "name": "employment_type",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "Costa Rica",
+                            "LATAM"
+                        ],
+                        "type": "string",
+                        "example": "\"Costa Rica\"",
+                        "description": "Location filter",
+                        "name": "location",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Province of Costa Rica filter, accents optional",
+                        "name": "province",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "Remote",
+                            "Hybrid",
+                            "Onsite"
+                        ],
+                        "type": "string",
+                        "example": "\"Remote\"",
+                        "description": "Work mode filter",
+                        "name": "work_mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"Tech Corp\"",
+                        "description": "Company name filter (partial match)",
+                        "name": "company",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"Backend\"",
+                        "description": "Job function filter (see /job-functions)",
+                        "name": "function",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "en",
+                            "es"
+                        ],
+                        "type": "string",
+                        "example": "\"es\"",
+                        "description": "Posting language filter",
+                        "name": "language",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Where remote applicants can be based",
+                        "name": "remote_eligibility",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "UTC offset in hours the working timezones must include",
+                        "name": "utc_offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated benefit slugs the jobs must all offer (see /benefits)",
+                        "name": "benefits",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"2024-01-01\"",
+                        "description": "Start date filter (YYYY-MM-DD)",
+                        "name": "date_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"2024-12-31\"",
+                        "description": "End date filter (YYYY-MM-DD)",
+                        "name": "date_to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "relevance",
+                            "date"
+                        ],
+                        "type": "string",
+                        "default": "relevance",
+                        "description": "Order of the jobs",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "job_id,title,company_name,experience_level,employment_type,location,...",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/jobs/{id}/applied": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Records that the current user applied to a job. Marking it again updates the status\nand notes of the application.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Mark a job as applied",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Application status, applied by default, and notes",
+                        "name": "request",
+                        "in": "body",
+                        "required": false,
+                        "schema": {
+                            "$ref": "#/definitions/users.MarkAppliedRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/users.ApplicationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/jobs/{id}/apply": {
+            "get": {
+                "description": "Records a click on the application link of a published job and redirects to the link,\nwith the UTM parameters of the board set (utm_source=ticosintech\u0026utm_medium=job_board by default)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "jobs"
+                ],
+                "summary": "Apply to a job",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "Redirect to the application link",
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "Application link with the UTM parameters"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/jobs/{id}/events": {
+            "post": {
+                "description": "Records a view of a job or a click on its application link. Events are stored asynchronously.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "jobs"
+                ],
+                "summary": "Track a job event",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Event to track",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/jobevent.TrackRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/jobs/{id}/similar": {
+            "get": {
+                "description": "Returns active jobs with the same experience level that share the most required technologies\nwith the given job",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "jobs"
+                ],
+                "summary": "Get similar jobs",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 5,
+                        "example": 5,
+                        "description": "Number of jobs to return (max 20)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Exclude jobs of the same company",
+                        "name": "exclude_same_company",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated job fields to return, all when empty",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/jobs.SimilarJobListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/me": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the user of the session token",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get the current user",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/users.UserResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/alerts": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the job alerts of the current user, oldest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "alerts"
+                ],
+                "summary": "List my job alerts",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/alerts.AlertListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Saves a search whose new jobs are emailed to the current user, instantly or in a daily\nor weekly digest sent from 8:00 (on Mondays for the weekly one) in the timezone of the\nalert. Nothing is sent during its quiet hours. A user can have 20 alerts.\nOnly the jobs of the country of the X-Country header are sent.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "alerts"
+                ],
+                "summary": "Create a job alert",
+                "parameters": [
+                    {
+                        "description": "Search and preferences of the alert",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/alerts.AlertRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/alerts.AlertResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/alerts/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the search and the preferences of an alert of the current user. An alert\nunsubscribed from an email is subscribed again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "alerts"
+                ],
+                "summary": "Edit a job alert",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Alert ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Search and preferences of the alert",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/alerts.AlertRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/alerts.AlertResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes an alert of the current user",
+                "tags": [
+                    "alerts"
+                ],
+                "summary": "Delete a job alert",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Alert ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/applications": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the jobs the current user applied to with the status of each application,\nmost recently updated first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "List applications",
+                "parameters": [
+                    {
+                        "enum": [
+                            "applied",
+                            "interviewing",
+                            "rejected",
+                            "offer"
+                        ],
+                        "type": "string",
+                        "description": "Application status filter",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "example": 20,
+                        "description": "Number of applications to return (max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "example": 0,
+                        "description": "Number of applications to skip",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/users.ApplicationListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/bookmarks": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the jobs saved by the current user, most recently saved first.\nSaved jobs are kept when they are deactivated.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "List saved jobs",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "example": 20,
+                        "description": "Number of jobs to return (max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "example": 0,
+                        "description": "Number of jobs to skip",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/users.SavedJobListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/bookmarks/{job_id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Bookmarks a job for the current user. Saving a job twice has no effect.",
+                "tags": [
+                    "users"
+                ],
+                "summary": "Save a job",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Job ID",
+                        "name": "job_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes a job from the jobs saved by the current user",
+                "tags": [
+                    "users"
+                ],
+                "summary": "Unsave a job",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Job ID",
+                        "name": "job_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/companies": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the companies the current user is a member of, with their scopes",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "company portal"
+                ],
+                "summary": "List my companies",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/employer.MembershipListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/companies/{slug}/jobs": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the jobs of a company whatever their status, newest first. Needs the jobs scope.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "company portal"
+                ],
+                "summary": "List the jobs of my company",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "\"tech-corp\"",
+                        "description": "Company slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "published",
+                            "rejected"
+                        ],
+                        "type": "string",
+                        "description": "Moderation status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "example": 20,
+                        "description": "Number of jobs to return (max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "example": 0,
+                        "description": "Number of jobs to skip",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/employer.PostingListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a job of the company, pending until an admin approves it in the moderation\nqueue. Its technologies are given by name or alias. Needs the jobs scope.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "company portal"
+                ],
+                "summary": "Post a job for my company",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "\"tech-corp\"",
+                        "description": "Company slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Job to post",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/employer.PostingRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/employer.PostingResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/companies/{slug}/jobs/{id}": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Changes the fields of a pending or rejected job present in the request. Rejected jobs\ngo back to the moderation queue, published jobs can't be changed. Needs the jobs scope.\nOnly admins can set is_featured.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "company portal"
+                ],
+                "summary": "Change a job of my company",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "\"tech-corp\"",
+                        "description": "Company slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/jobs.JobPatchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/employer.PostingResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/companies/{slug}/jobs/{id}/close": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Takes down a published job of the company, e.g. once the position is filled. Needs the\njobs scope.",
+                "tags": [
+                    "company portal"
+                ],
+                "summary": "Close a job of my company",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "\"tech-corp\"",
+                        "description": "Company slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/companies/{slug}/members": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the members of a company with their scopes, oldest first. Needs the members scope.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "company portal"
+                ],
+                "summary": "List the members of my company",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "\"tech-corp\"",
+                        "description": "Company slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/employer.MemberListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/companies/{slug}/members/{user_id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the scopes of a member: jobs to manage the jobs of the company, members to\nmanage its members. A company keeps a member with the members scope. Needs the members scope.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "company portal"
+                ],
+                "summary": "Set the scopes of a member of my company",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "\"tech-corp\"",
+                        "description": "Company slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "User ID of the member",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Scopes of the member",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/employer.ScopesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/employer.MemberResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes a member from the company, which needs the members scope, or leaves it when the\nmember is the current user. A company keeps a member with the members scope.",
+                "tags": [
+                    "company portal"
+                ],
+                "summary": "Remove a member of my company",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "\"tech-corp\"",
+                        "description": "Company slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "User ID of the member",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/schemas/jobs.json": {
+            "get": {
+                "description": "Returns the JSON Schema of the batches of jobs the scrapers produce, the {\"jobs\": [...]}\nfiles imported by the job populator. Scrapers check their output against it, or with\nthe validate ingest route.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ingest"
+                ],
+                "summary": "Get the JSON Schema of the scraped jobs",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                }
+            }
+        },
+        "/stats/history": {
+            "get": {
+                "description": "Returns the daily values of a metric snapshotted once a day, the last 90 days by default,\nto draw trend charts. technology_jobs needs the technology name as key and company_jobs the\ncompany slug. Days without a snapshot are missing.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stats"
+                ],
+                "summary": "Get the history of a metric",
+                "parameters": [
+                    {
+                        "enum": [
+                            "active_jobs",
+                            "technology_jobs",
+                            "company_jobs"
+                        ],
+                        "type": "string",
+                        "description": "Metric",
+                        "name": "metric",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"go\"",
+                        "description": "Technology name or company slug of the keyed metrics",
+                        "name": "key",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"2024-01-01\"",
+                        "description": "Start date (YYYY-MM-DD)",
+                        "name": "date_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"2024-03-31\"",
+                        "description": "End date, inclusive (YYYY-MM-DD)",
+                        "name": "date_to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/stats.HistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/stats/overview": {
+            "get": {
+                "description": "Returns the number of active jobs, the jobs posted this week, the active jobs by work mode\nand experience level and the top hiring companies. Results are cached for a minute.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stats"
+                ],
+                "summary": "Get the market overview",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/stats.OverviewResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/stats/technologies": {
+            "get": {
+                "description": "Returns the number of jobs posted per technology for a date range, the last 30 days\nby default, with the counts of the last two weeks of the range to show the trend",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stats"
+                ],
+                "summary": "Get trending technologies",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "\"2024-01-01\"",
+                        "description": "Start date (YYYY-MM-DD)",
+                        "name": "date_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"2024-01-31\"",
+                        "description": "End date, inclusive (YYYY-MM-DD)",
+                        "name": "date_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Only count jobs where the technology is required",
+                        "name": "required_only",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "example": 20,
+                        "description": "Number of technologies to return (max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/stats.TechnologyStatsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/suggest": {
+            "get": {
+                "description": "Completes the text typed in the search box with technologies, companies and job titles, in\nthis order, the ones with the most active jobs first. The type of each suggestion tells where\nit leads: companies link to their page with company_slug, the others search the jobs.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "suggest"
+                ],
+                "summary": "Suggest search box completions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "go",
+                        "description": "Text typed in the search box",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 5,
+                        "example": 5,
+                        "description": "Number of suggestions of each type (max 10)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/suggest.SuggestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httpservice.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "alerts.AlertListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/alerts.AlertResponse"
+                    }
+                }
+            }
+        },
+        "alerts.AlertRequest": {
+            "type": "object",
+            "required": [
+                "query"
+            ],
+            "properties": {
+                "employment_type": {
+                    "type": "string",
+                    "example": "Full-time"
+                },
+                "experience_level": {
+                    "type": "string",
+                    "example": "Senior"
+                },
+                "frequency": {
+                    "description": "Frequency is how often the new jobs are emailed, daily by default",
+                    "type": "string",
+                    "enum": [
+                        "instant",
+                        "daily",
+                        "weekly"
+                    ],
+                    "example": "daily"
+                },
+                "location": {
+                    "type": "string",
+                    "example": "Costa Rica"
+                },
+                "query": {
+                    "description": "Query and the filters select the jobs like the job search does",
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "golang developer"
+                },
+                "quiet_hours_end": {
+                    "type": "integer",
+                    "maximum": 23,
+                    "minimum": 0,
+                    "example": 7
+                },
+                "quiet_hours_start": {
+                    "description": "QuietHoursStart and QuietHoursEnd are the local hours between which nothing is sent, set together",
+                    "type": "integer",
+                    "maximum": 23,
+                    "minimum": 0,
+                    "example": 22
+                },
+                "timezone": {
+                    "description": "Timezone is the IANA timezone of the digest hour and the quiet hours, America/Costa_Rica by default",
+                    "type": "string",
+                    "maxLength": 64,
+                    "example": "America/Costa_Rica"
+                },
+                "work_mode": {
+                    "type": "string",
+                    "example": "Remote"
+                }
+            }
+        },
+        "alerts.AlertResponse": {
+            "type": "object",
+            "properties": {
+                "country": {
+                    "description": "Country is the country whose jobs are sent, the one of the request creating the alert",
+                    "type": "string",
+                    "example": "CR"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T06:00:00Z"
+                },
+                "employment_type": {
+                    "type": "string",
+                    "example": "Full-time"
+                },
+                "experience_level": {
+                    "type": "string",
+                    "example": "Senior"
+                },
+                "frequency": {
+                    "type": "string",
+                    "example": "daily"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "last_sent_at": {
+                    "description": "LastSentAt is when the alert was last sent, the jobs published after it being the new ones",
+                    "type": "string",
+                    "example": "2024-01-15T14:00:00Z"
+                },
+                "location": {
+                    "type": "string",
+                    "example": "Costa Rica"
+                },
+                "query": {
+                    "type": "string",
+                    "example": "golang developer"
+                },
+                "quiet_hours_end": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "quiet_hours_start": {
+                    "type": "integer",
+                    "example": 22
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "America/Costa_Rica"
+                },
+                "unsubscribed_at": {
+                    "description": "UnsubscribedAt is when the alert was stopped from an email, omitted while it is sent",
+                    "type": "string",
+                    "example": "2024-01-20T09:00:00Z"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-01-15T06:00:00Z"
+                },
+                "work_mode": {
+                    "type": "string",
+                    "example": "Remote"
+                }
+            }
+        },
+        "alerts.UnsubscribeRequest": {
+            "type": "object",
+            "required": [
+                "token"
+            ],
+            "properties": {
+                "token": {
+                    "type": "string",
+                    "example": "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+                }
+            }
+        },
+        "benefit.BenefitResponse": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "Health insurance"
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "health-insurance"
+                }
+            }
+        },
+        "benefit.ListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/benefit.BenefitResponse"
+                    }
+                }
+            }
+        },
+        "botguard.BlockRequest": {
+            "type": "object",
+            "required": [
+                "cidr"
+            ],
+            "properties": {
+                "cidr": {
+                    "description": "CIDR is a network in CIDR notation or a single address",
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "203.0.113.0/24"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2024-02-15T00:00:00Z"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Scraping the job search"
+                }
+            }
+        },
+        "botguard.BlockedNetworkListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/botguard.BlockedNetworkResponse"
+                    }
+                }
+            }
+        },
+        "botguard.BlockedNetworkResponse": {
+            "type": "object",
+            "properties": {
+                "cidr": {
+                    "type": "string",
+                    "example": "203.0.113.0/24"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "created_by": {
+                    "type": "string",
+                    "example": "admin"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2024-02-15T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Scraping the job search"
+                }
+            }
+        },
+        "company.CompanyCreateRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "logo_url"
+            ],
+            "properties": {
+                "country": {
+                    "description": "Country is the ISO 3166-1 alpha-2 code of the country where the company hires, CR when omitted",
+                    "type": "string",
+                    "example": "CR"
+                },
+                "ignore_similar": {
+                    "description": "IgnoreSimilar creates the company even when similar companies exist",
+                    "type": "boolean",
+                    "example": false
+                },
+                "logo_url": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "https://techcorp.com/logo.png"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Tech Corp"
+                },
+                "website_url": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "https://techcorp.com"
+                }
+            }
+        },
+        "company.CompanyPatchRequest": {
+            "type": "object",
+            "properties": {
+                "logo_url": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "https://techcorp.com/logo.png"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Tech Corp"
+                },
+                "website_url": {
+                    "description": "WebsiteURL is removed when empty",
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "https://techcorp.com"
+                }
+            }
+        },
+        "company.CompanyResponse": {
+            "type": "object",
+            "properties": {
+                "country": {
+                    "description": "Country is the ISO 3166-1 alpha-2 code of the country where the company hires",
+                    "type": "string",
+                    "example": "CR"
+                },
+                "logo_url": {
+                    "type": "string",
+                    "example": "https://techcorp.com/logo.png"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Tech Corp"
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "tech-corp"
+                },
+                "website_url": {
+                    "description": "WebsiteURL is the website of the company, omitted when unknown",
+                    "type": "string",
+                    "example": "https://techcorp.com"
+                }
+            }
+        },
+        "company.DeactivateResponse": {
+            "type": "object",
+            "properties": {
+                "deactivated_jobs": {
+                    "description": "DeactivatedJobs is the number of active jobs of the company taken down",
+                    "type": "integer",
+                    "example": 3
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "tech-corp"
+                }
+            }
+        },
+        "company.MergeRequest": {
+            "type": "object",
+            "required": [
+                "into"
+            ],
+            "properties": {
+                "into": {
+                    "description": "Into is the slug of the company the duplicate is merged into",
+                    "type": "string",
+                    "example": "equifax"
+                }
+            }
+        },
+        "company.MergeResponse": {
+            "type": "object",
+            "properties": {
+                "company": {
+                    "$ref": "#/definitions/company.CompanyResponse"
+                },
+                "merged_slug": {
+                    "description": "MergedSlug is the slug of the duplicate company, deleted",
+                    "type": "string",
+                    "example": "equifax-cr"
+                },
+                "moved_jobs": {
+                    "description": "MovedJobs is the number of jobs of the duplicate moved to the company",
+                    "type": "integer",
+                    "example": 4
+                }
+            }
+        },
+        "company.TechnologyCountResponse": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string",
+                    "example": "programming"
+                },
+                "jobs": {
+                    "description": "Jobs is the number of active jobs of the company using the technology",
+                    "type": "integer",
+                    "example": 4
+                },
+                "name": {
+                    "type": "string",
+                    "example": "go"
+                },
+                "required_jobs": {
+                    "description": "RequiredJobs is the number of those jobs requiring it",
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "company.TechnologyStackResponse": {
+            "type": "object",
+            "properties": {
+                "company": {
+                    "$ref": "#/definitions/company.CompanyResponse"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/company.TechnologyCountResponse"
+                    }
+                }
+            }
+        },
+        "employer.ClaimRequest": {
+            "type": "object",
+            "required": [
+                "email"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "ana@techcorp.com"
+                }
+            }
+        },
+        "employer.ClaimResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T06:00:00Z"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "ana@techcorp.com"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2024-01-15T06:30:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 7
+                }
+            }
+        },
+        "employer.CompanyResponse": {
+            "type": "object",
+            "properties": {
+                "email_domain": {
+                    "type": "string",
+                    "example": "techcorp.com"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Tech Corp"
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "tech-corp"
+                }
+            }
+        },
+        "employer.EmailDomainRequest": {
+            "type": "object",
+            "properties": {
+                "email_domain": {
+                    "description": "EmailDomain is the domain claims are verified with, an empty one makes the company unclaimable",
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "techcorp.com"
+                }
+            }
+        },
+        "employer.MemberListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/employer.MemberResponse"
+                    }
+                }
+            }
+        },
+        "employer.MemberResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T06:00:00Z"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "ana@techcorp.com"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "jobs"
+                    ]
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-01-15T06:00:00Z"
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "employer.MembershipListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/employer.MembershipResponse"
+                    }
+                }
+            }
+        },
+        "employer.MembershipResponse": {
+            "type": "object",
+            "properties": {
+                "company_id": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "company_name": {
+                    "type": "string",
+                    "example": "Tech Corp"
+                },
+                "company_slug": {
+                    "type": "string",
+                    "example": "tech-corp"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T06:00:00Z"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "ana@techcorp.com"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "jobs",
+                        "members"
+                    ]
+                }
+            }
+        },
+        "employer.PostingListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/employer.PostingResponse"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/httpservice.PaginationDetails"
+                }
+            }
+        },
+        "employer.PostingRequest": {
+            "type": "object",
+            "required": [
+                "title",
+                "description",
+                "experience_level",
+                "employment_type",
+                "location",
+                "work_mode",
+                "application_url",
+                "technologies"
+            ],
+            "properties": {
+                "application_url": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "https://techcorp.com/careers/go"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "We are looking for a Go developer..."
+                },
+                "employment_type": {
+                    "type": "string",
+                    "example": "Full-time"
+                },
+                "experience_level": {
+                    "type": "string",
+                    "example": "Senior"
+                },
+                "language": {
+                    "type": "string",
+                    "example": "en"
+                },
+                "location": {
+                    "description": "Location is where the job is located, e.g. \"San José, Costa Rica\"",
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "San José, Costa Rica"
+                },
+                "remote_eligibility": {
+                    "type": "string",
+                    "example": "LATAM only"
+                },
+                "technologies": {
+                    "description": "Technologies are the names or aliases of the technologies the job requires",
+                    "type": "array",
+                    "maxItems": 30,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Go"
+                    ]
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Senior Go Developer"
+                },
+                "utc_offset_max": {
+                    "type": "integer",
+                    "maximum": 14,
+                    "minimum": -12,
+                    "example": -3
+                },
+                "utc_offset_min": {
+                    "description": "UTCOffsetMin and UTCOffsetMax are set together",
+                    "type": "integer",
+                    "maximum": 14,
+                    "minimum": -12,
+                    "example": -6
+                },
+                "work_mode": {
+                    "type": "string",
+                    "example": "Hybrid"
+                }
+            }
+        },
+        "employer.PostingResponse": {
+            "type": "object",
+            "properties": {
+                "application_url": {
+                    "type": "string",
+                    "example": "https://techcorp.com/careers/go"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T06:00:00Z"
+                },
+                "employment_type": {
+                    "type": "string",
+                    "example": "Full-time"
+                },
+                "experience_level": {
+                    "type": "string",
+                    "example": "Senior"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "is_active": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "location": {
+                    "type": "string",
+                    "example": "Costa Rica"
+                },
+                "rejection_reason": {
+                    "description": "RejectionReason is why an admin rejected the job, omitted unless rejected",
+                    "type": "string",
+                    "example": "The application link is broken"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "pending"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Senior Go Developer"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-01-15T06:00:00Z"
+                },
+                "work_mode": {
+                    "type": "string",
+                    "example": "Hybrid"
+                }
+            }
+        },
+        "employer.ScopesRequest": {
+            "type": "object",
+            "required": [
+                "scopes"
+            ],
+            "properties": {
+                "scopes": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "jobs"
+                    ]
+                }
+            }
+        },
+        "employer.VerifyClaimRequest": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "042137"
+                }
+            }
+        },
+        "httpservice.ErrorDetails": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "Code is one of the ErrCode constants, clients should branch on it rather than on the message",
+                    "type": "string",
+                    "example": "VALIDATION_ERROR"
+                },
+                "details": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "limit must be between 1 and 100"
+                    ]
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Invalid search parameters"
+                }
+            }
+        },
+        "httpservice.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "$ref": "#/definitions/httpservice.ErrorDetails"
+                }
+            }
+        },
+        "httpservice.PaginationDetails": {
+            "type": "object",
+            "properties": {
+                "current_page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "has_more": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "next": {
+                    "description": "Next and Prev are the URLs of the next and previous pages, omitted on the last and first pages",
+                    "type": "string",
+                    "example": "/api/v1/jobs?limit=20\u0026offset=20\u0026q=golang"
+                },
+                "offset": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "prev": {
+                    "type": "string",
+                    "example": "/api/v1/jobs?limit=20\u0026offset=0\u0026q=golang"
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "total_pages": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "ingest.AnomalyListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ingest.AnomalyResponse"
+                    }
+                }
+            }
+        },
+        "ingest.AnomalyResponse": {
+            "type": "object",
+            "properties": {
+                "average": {
+                    "type": "number",
+                    "example": 14.5
+                },
+                "baseline_runs": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "company_id": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "company_name": {
+                    "type": "string",
+                    "example": "Tech Corp"
+                },
+                "jobs_found": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "zero"
+                }
+            }
+        },
+        "ingest.CloseRunRequest": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "careers site timed out"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "succeeded",
+                        "failed"
+                    ],
+                    "example": "failed"
+                }
+            }
+        },
+        "ingest.CompanyReportRequest": {
+            "type": "object",
+            "required": [
+                "company",
+                "status"
+            ],
+            "properties": {
+                "company": {
+                    "type": "string",
+                    "example": "Tech Corp"
+                },
+                "error": {
+                    "type": "string",
+                    "example": ""
+                },
+                "jobs_found": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "succeeded",
+                        "failed"
+                    ],
+                    "example": "succeeded"
+                }
+            }
+        },
+        "ingest.CompanyReportResponse": {
+            "type": "object",
+            "properties": {
+                "company_id": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "company_name": {
+                    "type": "string",
+                    "example": "Tech Corp"
+                },
+                "error": {
+                    "type": "string",
+                    "example": ""
+                },
+                "jobs_found": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "reported_at": {
+                    "type": "string",
+                    "example": "2024-01-15T06:05:00Z"
+                },
+                "run_id": {
+                    "type": "integer",
+                    "example": 15
+                },
+                "status": {
+                    "type": "string",
+                    "example": "succeeded"
+                }
+            }
+        },
+        "ingest.JobsValidationResponse": {
+            "type": "object",
+            "properties": {
+                "jobs": {
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
+        "ingest.RunListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ingest.RunSummaryResponse"
+                    }
+                }
+            }
+        },
+        "ingest.RunResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": ""
+                },
+                "finished_at": {
+                    "type": "string",
+                    "example": "2024-01-15T06:20:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 15
+                },
+                "source": {
+                    "type": "string",
+                    "example": "linkedin-scraper"
+                },
+                "started_at": {
+                    "type": "string",
+                    "example": "2024-01-15T06:00:00Z"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "running"
+                }
+            }
+        },
+        "ingest.RunSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "companies_failed": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "companies_succeeded": {
+                    "type": "integer",
+                    "example": 40
+                },
+                "error": {
+                    "type": "string",
+                    "example": ""
+                },
+                "finished_at": {
+                    "type": "string",
+                    "example": "2024-01-15T06:20:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 15
+                },
+                "jobs_found": {
+                    "type": "integer",
+                    "example": 315
+                },
+                "source": {
+                    "type": "string",
+                    "example": "linkedin-scraper"
+                },
+                "started_at": {
+                    "type": "string",
+                    "example": "2024-01-15T06:00:00Z"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "running"
+                }
+            }
+        },
+        "ingest.StaleCompanyResponse": {
+            "type": "object",
+            "properties": {
+                "company_id": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "company_name": {
+                    "type": "string",
+                    "example": "Tech Corp"
+                },
+                "last_error": {
+                    "type": "string",
+                    "example": "careers page returned 403",
+                    "x-nullable": true
+                },
+                "last_reported_at": {
+                    "type": "string",
+                    "example": "2024-01-15T06:05:00Z",
+                    "x-nullable": true
+                },
+                "last_status": {
+                    "type": "string",
+                    "example": "failed",
+                    "x-nullable": true
+                },
+                "last_success_at": {
+                    "type": "string",
+                    "example": "2024-01-10T06:05:00Z",
+                    "x-nullable": true
+                }
+            }
+        },
+        "ingest.StaleListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ingest.StaleCompanyResponse"
+                    }
+                }
+            }
+        },
+        "ingest.StartRunRequest": {
+            "type": "object",
+            "required": [
+                "source"
+            ],
+            "properties": {
+                "source": {
+                    "type": "string",
+                    "example": "linkedin-scraper"
+                }
+            }
+        },
+        "jobevent.CompanyStatsResponse": {
+            "type": "object",
+            "properties": {
+                "apply_clicks": {
+                    "type": "integer",
+                    "example": 87
+                },
+                "company_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "company_name": {
+                    "type": "string",
+                    "example": "Tech Corp"
+                },
+                "views": {
+                    "type": "integer",
+                    "example": 1200
+                }
+            }
+        },
+        "jobevent.JobStatsResponse": {
+            "type": "object",
+            "properties": {
+                "apply_clicks": {
+                    "type": "integer",
+                    "example": 25
+                },
+                "company_name": {
+                    "type": "string",
+                    "example": "Tech Corp"
+                },
+                "job_id": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Senior Go Developer"
+                },
+                "views": {
+                    "type": "integer",
+                    "example": 340
+                }
+            }
+        },
+        "jobevent.StatsResponse": {
+            "type": "object",
+            "properties": {
+                "companies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jobevent.CompanyStatsResponse"
+                    }
+                },
+                "date_from": {
+                    "type": "string",
+                    "example": "2024-01-01"
+                },
+                "date_to": {
+                    "type": "string",
+                    "example": "2024-01-31"
+                },
+                "jobs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jobevent.JobStatsResponse"
+                    }
+                }
+            }
+        },
+        "jobevent.TrackRequest": {
+            "type": "object",
+            "required": [
+                "type"
+            ],
+            "properties": {
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "view",
+                        "apply_click"
+                    ],
+                    "example": "view"
+                }
+            }
+        },
+        "jobfunction.JobFunctionResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Backend"
+                }
+            }
+        },
+        "jobfunction.ListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jobfunction.JobFunctionResponse"
+                    }
+                }
+            }
+        },
+        "jobrevision.RevisionListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jobrevision.RevisionResponse"
+                    }
+                },
+                "job_id": {
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "jobrevision.RevisionResponse": {
+            "type": "object",
+            "properties": {
+                "application_url": {
+                    "type": "string",
+                    "example": "https://techcorp.com/careers/go-developer"
+                },
+                "changed_fields": {
+                    "description": "ChangedFields are the fields the version replacing this one changed",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "title",
+                        "description"
+                    ]
+                },
+                "description": {
+                    "type": "string",
+                    "example": "We are looking for a Go developer..."
+                },
+                "employment_type": {
+                    "type": "string",
+                    "example": "Full-time"
+                },
+                "experience_level": {
+                    "type": "string",
+                    "example": "Mid-level"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 31
+                },
+                "location": {
+                    "type": "string",
+                    "example": "Costa Rica"
+                },
+                "remote_eligibility": {
+                    "type": "string",
+                    "example": "LATAM only",
+                    "x-nullable": true
+                },
+                "replaced_at": {
+                    "type": "string",
+                    "example": "2024-02-01T08:00:00Z"
+                },
+                "replaced_by": {
+                    "type": "string",
+                    "example": "user:42"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Go Developer"
+                },
+                "utc_offset_max": {
+                    "type": "integer",
+                    "example": -3,
+                    "x-nullable": true
+                },
+                "utc_offset_min": {
+                    "type": "integer",
+                    "example": -6,
+                    "x-nullable": true
+                },
+                "valid_from": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "work_mode": {
+                    "type": "string",
+                    "example": "Remote"
+                },
+                "written_by": {
+                    "description": "WrittenBy is who wrote the version and ReplacedBy who replaced it as kind:id, omitted when unknown",
+                    "type": "string",
+                    "example": "cli:ana@db_job_populator"
+                }
+            }
+        },
+        "jobs.DuplicateJobResponse": {
+            "type": "object",
+            "properties": {
+                "application_url": {
+                    "type": "string",
+                    "example": "https://techcorp.com/jobs/12"
+                },
+                "job_id": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "posted_at": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string",
+                    "example": "greenhouse"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Senior Go Developer"
+                }
+            }
+        },
+        "jobs.ErrorDetails": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "details": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "jobs.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "$ref": "#/definitions/jobs.ErrorDetails"
+                }
+            }
+        },
+        "jobs.JobPatchRequest": {
+            "type": "object",
+            "properties": {
+                "application_url": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "https://techcorp.com/careers/go-developer"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "We are looking for a Go developer..."
+                },
+                "employment_type": {
+                    "type": "string",
+                    "example": "Full-time"
+                },
+                "experience_level": {
+                    "type": "string",
+                    "example": "Senior"
+                },
+                "is_featured": {
+                    "description": "IsFeatured promotes the job in the relevance ranking of the search",
+                    "type": "boolean",
+                    "example": true
+                },
+                "language": {
+                    "type": "string",
+                    "example": "en"
+                },
+                "remote_eligibility": {
+                    "type": "string",
+                    "example": "LATAM only"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Senior Go Developer"
+                },
+                "utc_offset_max": {
+                    "type": "integer",
+                    "maximum": 14,
+                    "minimum": -12,
+                    "example": -3
+                },
+                "utc_offset_min": {
+                    "description": "UTCOffsetMin and UTCOffsetMax are changed together",
+                    "type": "integer",
+                    "maximum": 14,
+                    "minimum": -12,
+                    "example": -6
+                },
+                "work_mode": {
+                    "type": "string",
+                    "example": "Remote"
+                }
+            }
+        },
+        "jobs.JobResponse": {
+            "type": "object",
+            "properties": {
+                "application_url": {
+                    "type": "string"
+                },
+                "benefits": {
+                    "description": "Benefits are the slugs of the benefits offered with the job, see /benefits",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "health-insurance",
+                        "stock-options"
+                    ]
+                },
+                "company_logo_url": {
+                    "type": "string"
+                },
+                "company_name": {
+                    "type": "string"
+                },
+                "company_slug": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "employment_type": {
+                    "type": "string",
+                    "example": "Full-time"
+                },
+                "employment_type_label": {
+                    "type": "string",
+                    "example": "Tiempo completo"
+                },
+                "experience_level": {
+                    "description": "ExperienceLevel, EmploymentType and WorkMode are canonical values, their labels are in the\nlanguage of the Accept-Language header, English or Spanish",
+                    "type": "string",
+                    "example": "Mid-level"
+                },
+                "experience_level_label": {
+                    "type": "string",
+                    "example": "Intermedio"
+                },
+                "highlight": {
+                    "description": "Highlight holds snippets of the description with the matched terms between \u003cmark\u003e tags,\nonly returned by searches with highlight=true",
+                    "type": "string",
+                    "example": "Backend \u003cmark\u003eGo\u003c/mark\u003e developer ... \u003cmark\u003egolang\u003c/mark\u003e services"
+                },
+                "job_id": {
+                    "type": "integer"
+                },
+                "language": {
+                    "type": "string",
+                    "example": "es"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "posted_at": {
+                    "type": "string"
+                },
+                "remote_eligibility": {
+                    "description": "RemoteEligibility and the UTC offsets are omitted when the posting doesn't say",
+                    "type": "string",
+                    "example": "LATAM only"
+                },
+                "technologies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jobs.TechnologyResponse"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "utc_offset_max": {
+                    "type": "integer",
+                    "example": -3
+                },
+                "utc_offset_min": {
+                    "type": "integer",
+                    "example": -6
+                },
+                "work_mode": {
+                    "type": "string",
+                    "example": "Onsite"
+                },
+                "work_mode_label": {
+                    "type": "string",
+                    "example": "Presencial"
+                }
+            }
+        },
+        "jobs.LinkStatusResponse": {
+            "type": "object",
+            "properties": {
+                "checked_at": {
+                    "type": "string",
+                    "example": "2024-01-15T06:00:00Z"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "responded with status 404"
+                },
+                "ok": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "status_code": {
+                    "type": "integer",
+                    "example": 404
+                }
+            }
+        },
+        "jobs.ModerationJobResponse": {
+            "type": "object",
+            "properties": {
+                "application_url": {
+                    "type": "string"
+                },
+                "application_url_check": {
+                    "description": "ApplicationURLCheck and CompanyLogoCheck are omitted until the links are checked",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/jobs.LinkStatusResponse"
+                        }
+                    ]
+                },
+                "benefits": {
+                    "description": "Benefits are the slugs of the benefits offered with the job, see /benefits",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "health-insurance",
+                        "stock-options"
+                    ]
+                },
+                "canonical_job_id": {
+                    "description": "CanonicalJobID is the job this one is an alternate of, the same opening found at another source.\nAlternates are left out of the public search, and canonical jobs omit it.",
+                    "type": "integer",
+                    "example": 42
+                },
+                "company_logo_check": {
+                    "$ref": "#/definitions/jobs.LinkStatusResponse"
+                },
+                "company_logo_url": {
+                    "type": "string"
+                },
+                "company_name": {
+                    "type": "string"
+                },
+                "company_slug": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "employment_type": {
+                    "type": "string",
+                    "example": "Full-time"
+                },
+                "employment_type_label": {
+                    "type": "string",
+                    "example": "Tiempo completo"
+                },
+                "experience_level": {
+                    "description": "ExperienceLevel, EmploymentType and WorkMode are canonical values, their labels are in the\nlanguage of the Accept-Language header, English or Spanish",
+                    "type": "string",
+                    "example": "Mid-level"
+                },
+                "experience_level_label": {
+                    "type": "string",
+                    "example": "Intermedio"
+                },
+                "highlight": {
+                    "description": "Highlight holds snippets of the description with the matched terms between \u003cmark\u003e tags,\nonly returned by searches with highlight=true",
+                    "type": "string",
+                    "example": "Backend \u003cmark\u003eGo\u003c/mark\u003e developer ... \u003cmark\u003egolang\u003c/mark\u003e services"
+                },
+                "job_id": {
+                    "type": "integer"
+                },
+                "language": {
+                    "type": "string",
+                    "example": "es"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "posted_at": {
+                    "type": "string"
+                },
+                "rejection_reason": {
+                    "type": "string",
+                    "example": "Posting is a recruiting agency ad"
+                },
+                "remote_eligibility": {
+                    "description": "RemoteEligibility and the UTC offsets are omitted when the posting doesn't say",
+                    "type": "string",
+                    "example": "LATAM only"
+                },
+                "reviewed_at": {
+                    "type": "string"
+                },
+                "reviewed_by": {
+                    "description": "ReviewedBy is who last reviewed the job as kind:id, omitted when unknown",
+                    "type": "string",
+                    "example": "user:42"
+                },
+                "source": {
+                    "description": "Source is where the job was scraped from and SourceJobID its ID there, omitted when unknown",
+                    "type": "string",
+                    "example": "linkedin"
+                },
+                "source_job_id": {
+                    "type": "string",
+                    "example": "3912345678"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "pending"
+                },
+                "technologies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jobs.TechnologyResponse"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "utc_offset_max": {
+                    "type": "integer",
+                    "example": -3
+                },
+                "utc_offset_min": {
+                    "type": "integer",
+                    "example": -6
+                },
+                "work_mode": {
+                    "type": "string",
+                    "example": "Onsite"
+                },
+                "work_mode_label": {
+                    "type": "string",
+                    "example": "Presencial"
+                }
+            }
+        },
+        "jobs.ModerationListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jobs.ModerationJobResponse"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/jobs.PaginationDetails"
+                }
+            }
+        },
+        "jobs.NearDuplicateListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jobs.NearDuplicateResponse"
+                    }
+                }
+            }
+        },
+        "jobs.NearDuplicateResponse": {
+            "type": "object",
+            "properties": {
+                "company_name": {
+                    "type": "string",
+                    "example": "Tech Corp"
+                },
+                "jobs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jobs.DuplicateJobResponse"
+                    }
+                },
+                "title_similarity": {
+                    "type": "number",
+                    "example": 0.86
+                },
+                "url_similarity": {
+                    "type": "number",
+                    "example": 0.42
+                }
+            }
+        },
+        "jobs.PaginationDetails": {
+            "type": "object",
+            "properties": {
+                "current_page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "has_more": {
+                    "type": "boolean"
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "next": {
+                    "description": "Next and Prev are the URLs of the next and previous pages, omitted on the last and first pages",
+                    "type": "string",
+                    "example": "/api/v1/jobs?limit=20\u0026offset=20\u0026q=golang"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "prev": {
+                    "type": "string",
+                    "example": "/api/v1/jobs?limit=20\u0026offset=0\u0026q=golang"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "jobs.RejectRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "example": "Posting is a recruiting agency ad"
+                }
+            }
+        },
+        "jobs.SearchResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jobs.JobResponse"
+                    }
+                },
+                "did_you_mean": {
+                    "description": "DidYouMean is a corrected query suggested when the search finds nothing",
+                    "type": "string",
+                    "example": "kubernetes engineer"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/jobs.PaginationDetails"
+                }
+            }
+        },
+        "jobs.SimilarJobListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jobs.SimilarJobResponse"
+                    }
+                }
+            }
+        },
+        "jobs.SimilarJobResponse": {
+            "type": "object",
+            "properties": {
+                "application_url": {
+                    "type": "string"
+                },
+                "benefits": {
+                    "description": "Benefits are the slugs of the benefits offered with the job, see /benefits",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "health-insurance",
+                        "stock-options"
+                    ]
+                },
+                "company_logo_url": {
+                    "type": "string"
+                },
+                "company_name": {
+                    "type": "string"
+                },
+                "company_slug": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "employment_type": {
+                    "type": "string",
+                    "example": "Full-time"
+                },
+                "employment_type_label": {
+                    "type": "string",
+                    "example": "Tiempo completo"
+                },
+                "experience_level": {
+                    "description": "ExperienceLevel, EmploymentType and WorkMode are canonical values, their labels are in the\nlanguage of the Accept-Language header, English or Spanish",
+                    "type": "string",
+                    "example": "Mid-level"
+                },
+                "experience_level_label": {
+                    "type": "string",
+                    "example": "Intermedio"
+                },
+                "highlight": {
+                    "description": "Highlight holds snippets of the description with the matched terms between \u003cmark\u003e tags,\nonly returned by searches with highlight=true",
+                    "type": "string",
+                    "example": "Backend \u003cmark\u003eGo\u003c/mark\u003e developer ... \u003cmark\u003egolang\u003c/mark\u003e services"
+                },
+                "job_id": {
+                    "type": "integer"
+                },
+                "language": {
+                    "type": "string",
+                    "example": "es"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "posted_at": {
+                    "type": "string"
+                },
+                "remote_eligibility": {
+                    "description": "RemoteEligibility and the UTC offsets are omitted when the posting doesn't say",
+                    "type": "string",
+                    "example": "LATAM only"
+                },
+                "shared_technologies": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "technologies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jobs.TechnologyResponse"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "utc_offset_max": {
+                    "type": "integer",
+                    "example": -3
+                },
+                "utc_offset_min": {
+                    "type": "integer",
+                    "example": -6
+                },
+                "work_mode": {
+                    "type": "string",
+                    "example": "Onsite"
+                },
+                "work_mode_label": {
+                    "type": "string",
+                    "example": "Presencial"
+                }
+            }
+        },
+        "jobs.TechnologyResponse": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "primary": {
+                    "description": "Primary is set for the technologies the job is built around, e.g. Go for a Go developer",
+                    "type": "boolean"
+                },
+                "required": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "linkcheck.CheckListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/linkcheck.CheckResponse"
+                    }
+                }
+            }
+        },
+        "linkcheck.CheckResponse": {
+            "type": "object",
+            "properties": {
+                "checked_at": {
+                    "type": "string",
+                    "example": "2024-01-15T06:00:00Z"
+                },
+                "company_id": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "company_name": {
+                    "type": "string",
+                    "example": "Tech Corp"
+                },
+                "content_type": {
+                    "type": "string",
+                    "example": "text/html"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "responded with status 404"
+                },
+                "job_id": {
+                    "description": "JobID is set for application URLs",
+                    "type": "integer",
+                    "example": 42
+                },
+                "job_title": {
+                    "type": "string",
+                    "example": "Senior Go Developer"
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "application_url"
+                },
+                "not_found_count": {
+                    "description": "NotFoundCount is the number of consecutive checks that answered 404 or 410",
+                    "type": "integer",
+                    "example": 2
+                },
+                "ok": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "status_code": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://techcorp.com/jobs/42"
+                }
+            }
+        },
+        "maintenance.RefreshResponse": {
+            "type": "object",
+            "properties": {
+                "duration_ms": {
+                    "type": "integer",
+                    "example": 1250
+                },
+                "purged_cache_entries": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "started_at": {
+                    "type": "string",
+                    "example": "2024-01-15T06:00:00Z"
+                }
+            }
+        },
+        "pendingtech.ApproveRequest": {
+            "type": "object",
+            "properties": {
+                "alias_of": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "category": {
+                    "type": "string",
+                    "example": "programming"
+                }
+            }
+        },
+        "pendingtech.ListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pendingtech.PendingTechnologyResponse"
+                    }
+                }
+            }
+        },
+        "pendingtech.PendingTechnologyResponse": {
+            "type": "object",
+            "properties": {
+                "first_seen_at": {
+                    "type": "string",
+                    "example": "2024-01-10T06:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "last_seen_at": {
+                    "type": "string",
+                    "example": "2024-01-15T06:00:00Z"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "htmx"
+                },
+                "occurrences": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "suggested_technology_id": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "suggestion_score": {
+                    "type": "number",
+                    "example": 0.45
+                }
+            }
+        },
+        "quality.CompanyIssueListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/quality.CompanyIssueResponse"
+                    }
+                }
+            }
+        },
+        "quality.CompanyIssueResponse": {
+            "type": "object",
+            "properties": {
+                "active_jobs": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "company_id": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "logo_error": {
+                    "type": "string",
+                    "example": "responded with status 404"
+                },
+                "logo_status_code": {
+                    "description": "LogoStatusCode and LogoError are those of the last check of the logo, omitted when unchecked",
+                    "type": "integer",
+                    "example": 404
+                },
+                "logo_url": {
+                    "type": "string",
+                    "example": "https://techcorp.com/logo.png"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Tech Corp"
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "tech-corp"
+                }
+            }
+        },
+        "quality.JobIssueListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/quality.JobIssueResponse"
+                    }
+                }
+            }
+        },
+        "quality.JobIssueResponse": {
+            "type": "object",
+            "properties": {
+                "company_name": {
+                    "type": "string",
+                    "example": "Tech Corp"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T06:00:00Z"
+                },
+                "employment_type": {
+                    "type": "string",
+                    "example": "Full-time"
+                },
+                "experience_level": {
+                    "type": "string",
+                    "example": "Mid-level"
+                },
+                "job_id": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Backend Developer"
+                },
+                "unknown_fields": {
+                    "description": "UnknownFields are the fields whose value isn't a canonical one, listed for the jobs with unknown values",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "work_mode"
+                    ]
+                },
+                "work_mode": {
+                    "type": "string",
+                    "example": "Remote"
+                }
+            }
+        },
+        "quality.SourceSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "active_jobs": {
+                    "type": "integer",
+                    "example": 420
+                },
+                "jobs_missing_technologies": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "jobs_with_inferred_experience_level": {
+                    "type": "integer",
+                    "example": 25
+                },
+                "jobs_with_unknown_values": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "source": {
+                    "description": "Source is empty for the jobs whose source isn't known",
+                    "type": "string",
+                    "example": "linkedin"
+                }
+            }
+        },
+        "quality.SummaryResponse": {
+            "type": "object",
+            "properties": {
+                "active_jobs": {
+                    "type": "integer",
+                    "example": 850
+                },
+                "companies_without_logos": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "jobs_missing_technologies": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "jobs_with_inferred_experience_level": {
+                    "type": "integer",
+                    "example": 40
+                },
+                "jobs_with_unknown_values": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "pending_technologies": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "pending_technology_detections": {
+                    "type": "integer",
+                    "example": 25
+                },
+                "sources": {
+                    "description": "Sources breaks the job counts down by the source the jobs were scraped from",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/quality.SourceSummaryResponse"
+                    }
+                }
+            }
+        },
+        "reference.CreateRequest": {
+            "type": "object",
+            "required": [
+                "kind",
+                "value"
+            ],
+            "properties": {
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "experience_level",
+                        "employment_type",
+                        "location",
+                        "work_mode"
+                    ],
+                    "example": "location"
+                },
+                "value": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Nicaragua"
+                }
+            }
+        },
+        "reference.ValueListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/reference.ValueResponse"
+                    }
+                }
+            }
+        },
+        "reference.ValueResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "location"
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "value": {
+                    "type": "string",
+                    "example": "Nicaragua"
+                }
+            }
+        },
+        "scheduler.RunListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/scheduler.RunResponse"
+                    }
+                },
+                "tasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/scheduler.TaskResponse"
+                    }
+                }
+            }
+        },
+        "scheduler.RunResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": ""
+                },
+                "finished_at": {
+                    "type": "string",
+                    "example": "2024-01-15T06:00:02Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "started_at": {
+                    "type": "string",
+                    "example": "2024-01-15T06:00:00Z"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "succeeded",
+                        "failed"
+                    ],
+                    "example": "succeeded"
+                },
+                "task": {
+                    "type": "string",
+                    "example": "search-view-refresh"
+                }
+            }
+        },
+        "scheduler.TaskResponse": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "type": "string",
+                    "example": "search-view-refresh"
+                }
+            }
+        },
+        "settings.ChangeListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/settings.ChangeResponse"
+                    }
+                }
+            }
+        },
+        "settings.ChangeResponse": {
+            "type": "object",
+            "properties": {
+                "changed_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "changed_by": {
+                    "description": "ChangedBy is the actor who changed the setting, e.g. \"api_key:3f2a9c1b7d4e\"",
+                    "type": "string",
+                    "example": "api_key:3f2a9c1b7d4e"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "name": {
+                    "type": "string",
+                    "example": "log_level"
+                },
+                "new_value": {
+                    "type": "string",
+                    "example": "debug"
+                },
+                "old_value": {
+                    "type": "string",
+                    "example": "info"
+                }
+            }
+        },
+        "settings.SettingListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/settings.SettingResponse"
+                    }
+                }
+            }
+        },
+        "settings.SettingResponse": {
+            "type": "object",
+            "properties": {
+                "default": {
+                    "description": "Default is the value of the environment, used when the setting isn't overridden",
+                    "type": "string",
+                    "example": "info"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Least severe level logged"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "log_level"
+                },
+                "overridden": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "value": {
+                    "type": "string",
+                    "example": "debug"
+                }
+            }
+        },
+        "settings.UpdateRequest": {
+            "type": "object",
+            "required": [
+                "value"
+            ],
+            "properties": {
+                "value": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "debug"
+                }
+            }
+        },
+        "stats.BucketResponse": {
+            "type": "object",
+            "properties": {
+                "jobs": {
+                    "type": "integer",
+                    "example": 120
+                },
+                "value": {
+                    "type": "string",
+                    "example": "Remote"
+                }
+            }
+        },
+        "stats.CompanyCountResponse": {
+            "type": "object",
+            "properties": {
+                "jobs": {
+                    "type": "integer",
+                    "example": 35
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Tech Corp"
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "tech-corp"
+                }
+            }
+        },
+        "stats.HistoryPointResponse": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "2024-01-15"
+                },
+                "value": {
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
+        "stats.HistoryResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "Data holds the days with a snapshot, oldest first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/stats.HistoryPointResponse"
+                    }
+                },
+                "date_from": {
+                    "type": "string",
+                    "example": "2024-01-01"
+                },
+                "date_to": {
+                    "type": "string",
+                    "example": "2024-03-31"
+                },
+                "key": {
+                    "type": "string",
+                    "example": "go"
+                },
+                "metric": {
+                    "type": "string",
+                    "example": "technology_jobs"
+                }
+            }
+        },
+        "stats.OverviewResponse": {
+            "type": "object",
+            "properties": {
+                "active_jobs": {
+                    "type": "integer",
+                    "example": 850
+                },
+                "by_experience_level": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/stats.BucketResponse"
+                    }
+                },
+                "by_work_mode": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/stats.BucketResponse"
+                    }
+                },
+                "generated_at": {
+                    "type": "string"
+                },
+                "new_jobs_this_week": {
+                    "type": "integer",
+                    "example": 64
+                },
+                "top_companies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/stats.CompanyCountResponse"
+                    }
+                }
+            }
+        },
+        "stats.TechnologyCountResponse": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string",
+                    "example": "programming"
+                },
+                "jobs": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "last_week": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "name": {
+                    "type": "string",
+                    "example": "go"
+                },
+                "this_week": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "week_over_week_change": {
+                    "description": "WeekOverWeekChange is the percentage change from last week, omitted when last_week is 0",
+                    "type": "number",
+                    "example": 50
+                },
+                "week_over_week_delta": {
+                    "description": "WeekOverWeekDelta is this_week - last_week",
+                    "type": "integer",
+                    "example": 4
+                }
+            }
+        },
+        "stats.TechnologyStatsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/stats.TechnologyCountResponse"
+                    }
+                },
+                "date_from": {
+                    "type": "string",
+                    "example": "2024-01-01"
+                },
+                "date_to": {
+                    "type": "string",
+                    "example": "2024-01-31"
+                },
+                "required_only": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "suggest.SuggestResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/suggest.SuggestionResponse"
+                    }
+                }
+            }
+        },
+        "suggest.SuggestionResponse": {
+            "type": "object",
+            "properties": {
+                "active_jobs": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "company_slug": {
+                    "description": "CompanySlug and LogoURL are only set for companies, to link to the company page",
+                    "type": "string",
+                    "example": "gorilla-logic"
+                },
+                "logo_url": {
+                    "type": "string",
+                    "example": "https://example.com/logo.png"
+                },
+                "text": {
+                    "type": "string",
+                    "example": "Gorilla Logic"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "technology",
+                        "company",
+                        "title"
+                    ],
+                    "example": "company"
+                }
+            }
+        },
+        "techdetect.DetectionResponse": {
+            "type": "object",
+            "properties": {
+                "confidence": {
+                    "type": "number",
+                    "example": 0.65
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T06:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "job_id": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "job_title": {
+                    "type": "string",
+                    "example": "Backend Developer"
+                },
+                "occurrences": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "reviewed_at": {
+                    "type": "string",
+                    "example": "2024-01-15T09:30:00Z"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "pending"
+                },
+                "technology_id": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "technology_name": {
+                    "type": "string",
+                    "example": "go"
+                },
+                "term": {
+                    "type": "string",
+                    "example": "golang"
+                }
+            }
+        },
+        "techdetect.ListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/techdetect.DetectionResponse"
+                    }
+                }
+            }
+        },
+        "technology.AliasBatchRequest": {
+            "type": "object",
+            "properties": {
+                "aliases": {
+                    "type": "array",
+                    "maxItems": 500,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "golang",
+                        "go-lang"
+                    ]
+                },
+                "replace": {
+                    "description": "Replace deletes the aliases of the technology missing from the list",
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "technology.AliasBatchResponse": {
+            "type": "object",
+            "properties": {
+                "conflicts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/technology.AliasConflictResponse"
+                    }
+                },
+                "created": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "golang"
+                    ]
+                },
+                "removed": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "go language"
+                    ]
+                },
+                "unchanged": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "go-lang"
+                    ]
+                }
+            }
+        },
+        "technology.AliasConflictResponse": {
+            "type": "object",
+            "properties": {
+                "alias": {
+                    "type": "string",
+                    "example": "js"
+                },
+                "technology_id": {
+                    "type": "integer",
+                    "example": 7
+                }
+            }
+        },
+        "technology.AliasExportResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/technology.TechnologyAliasesResponse"
+                    }
+                }
+            }
+        },
+        "technology.MergeRequest": {
+            "type": "object",
+            "required": [
+                "into_id"
+            ],
+            "properties": {
+                "into_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "technology.TechnologyAliasesResponse": {
+            "type": "object",
+            "properties": {
+                "aliases": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "golang",
+                        "go-lang"
+                    ]
+                },
+                "category": {
+                    "type": "string",
+                    "example": "programming"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "go"
+                }
+            }
+        },
+        "technology.TechnologyResponse": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string",
+                    "example": "programming"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "go"
+                },
+                "parent_id": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "technology.UnusedTechnologiesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/technology.UnusedTechnologyResponse"
+                    }
+                },
+                "months": {
+                    "type": "integer",
+                    "example": 6
+                }
+            }
+        },
+        "technology.UnusedTechnologyResponse": {
             "type": "object",
             "properties": {
-                "code": {
+                "archived_at": {
+                    "type": "string",
+                    "example": "2024-01-15T03:00:00Z"
+                },
+                "category": {
+                    "type": "string",
+                    "example": "programming"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "last_job_at": {
+                    "type": "string",
+                    "example": "2023-04-02T15:04:05Z"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "coffeescript"
+                }
+            }
+        },
+        "users.ApplicationListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/users.AppliedJobResponse"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/httpservice.PaginationDetails"
+                }
+            }
+        },
+        "users.ApplicationResponse": {
+            "type": "object",
+            "properties": {
+                "applied_at": {
+                    "type": "string",
+                    "example": "2024-01-15T06:00:00Z"
+                },
+                "job_id": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "notes": {
+                    "type": "string",
+                    "example": "Referred by a friend"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "applied"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-01-20T06:00:00Z"
+                }
+            }
+        },
+        "users.AppliedJobResponse": {
+            "type": "object",
+            "properties": {
+                "application": {
+                    "$ref": "#/definitions/users.ApplicationResponse"
+                },
+                "application_url": {
                     "type": "string"
                 },
-                "details": {
+                "benefits": {
+                    "description": "Benefits are the slugs of the benefits offered with the job, see /benefits",
                     "type": "array",
                     "items": {
                         "type": "string"
+                    },
+                    "example": [
+                        "health-insurance",
+                        "stock-options"
+                    ]
+                },
+                "company_logo_url": {
+                    "type": "string"
+                },
+                "company_name": {
+                    "type": "string"
+                },
+                "company_slug": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "employment_type": {
+                    "type": "string",
+                    "example": "Full-time"
+                },
+                "employment_type_label": {
+                    "type": "string",
+                    "example": "Tiempo completo"
+                },
+                "experience_level": {
+                    "description": "ExperienceLevel, EmploymentType and WorkMode are canonical values, their labels are in the\nlanguage of the Accept-Language header, English or Spanish",
+                    "type": "string",
+                    "example": "Mid-level"
+                },
+                "experience_level_label": {
+                    "type": "string",
+                    "example": "Intermedio"
+                },
+                "highlight": {
+                    "description": "Highlight holds snippets of the description with the matched terms between \u003cmark\u003e tags,\nonly returned by searches with highlight=true",
+                    "type": "string",
+                    "example": "Backend \u003cmark\u003eGo\u003c/mark\u003e developer ... \u003cmark\u003egolang\u003c/mark\u003e services"
+                },
+                "is_active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "job_id": {
+                    "type": "integer"
+                },
+                "language": {
+                    "type": "string",
+                    "example": "es"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "posted_at": {
+                    "type": "string"
+                },
+                "remote_eligibility": {
+                    "description": "RemoteEligibility and the UTC offsets are omitted when the posting doesn't say",
+                    "type": "string",
+                    "example": "LATAM only"
+                },
+                "technologies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jobs.TechnologyResponse"
                     }
                 },
-                "message": {
+                "title": {
                     "type": "string"
+                },
+                "utc_offset_max": {
+                    "type": "integer",
+                    "example": -3
+                },
+                "utc_offset_min": {
+                    "type": "integer",
+                    "example": -6
+                },
+                "work_mode": {
+                    "type": "string",
+                    "example": "Onsite"
+                },
+                "work_mode_label": {
+                    "type": "string",
+                    "example": "Presencial"
                 }
             }
         },
-        "jobs.ErrorResponse": {
+        "users.CredentialsRequest": {
             "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
             "properties": {
-                "error": {
-                    "$ref": "#/definitions/jobs.ErrorDetails"
+                "email": {
+                    "type": "string",
+                    "example": "ana@example.com"
+                },
+                "password": {
+                    "type": "string",
+                    "example": "correct-horse-battery"
                 }
             }
         },
-        "jobs.JobResponse": {
+        "users.LoginCodeRequest": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "Qm9rZ3Vz"
+                }
+            }
+        },
+        "users.MarkAppliedRequest": {
+            "type": "object",
+            "properties": {
+                "notes": {
+                    "type": "string",
+                    "example": "Referred by a friend"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "applied",
+                        "interviewing",
+                        "rejected",
+                        "offer"
+                    ],
+                    "example": "applied"
+                }
+            }
+        },
+        "users.SavedJobListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/users.SavedJobResponse"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/httpservice.PaginationDetails"
+                }
+            }
+        },
+        "users.SavedJobResponse": {
             "type": "object",
             "properties": {
                 "application_url": {
                     "type": "string"
                 },
-                "company_id": {
-                    "type": "integer"
+                "benefits": {
+                    "description": "Benefits are the slugs of the benefits offered with the job, see /benefits",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "health-insurance",
+                        "stock-options"
+                    ]
                 },
                 "company_logo_url": {
                     "type": "string"
@@ -177,24 +8672,60 @@ const docTemplate = `{
                 "company_name": {
                     "type": "string"
                 },
+                "company_slug": {
+                    "type": "string"
+                },
                 "description": {
                     "type": "string"
                 },
                 "employment_type": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "Full-time"
+                },
+                "employment_type_label": {
+                    "type": "string",
+                    "example": "Tiempo completo"
                 },
                 "experience_level": {
-                    "type": "string"
+                    "description": "ExperienceLevel, EmploymentType and WorkMode are canonical values, their labels are in the\nlanguage of the Accept-Language header, English or Spanish",
+                    "type": "string",
+                    "example": "Mid-level"
+                },
+                "experience_level_label": {
+                    "type": "string",
+                    "example": "Intermedio"
+                },
+                "highlight": {
+                    "description": "Highlight holds snippets of the description with the matched terms between \u003cmark\u003e tags,\nonly returned by searches with highlight=true",
+                    "type": "string",
+                    "example": "Backend \u003cmark\u003eGo\u003c/mark\u003e developer ... \u003cmark\u003egolang\u003c/mark\u003e services"
+                },
+                "is_active": {
+                    "type": "boolean",
+                    "example": true
                 },
                 "job_id": {
                     "type": "integer"
                 },
+                "language": {
+                    "type": "string",
+                    "example": "es"
+                },
                 "location": {
                     "type": "string"
                 },
                 "posted_at": {
                     "type": "string"
                 },
+                "remote_eligibility": {
+                    "description": "RemoteEligibility and the UTC offsets are omitted when the posting doesn't say",
+                    "type": "string",
+                    "example": "LATAM only"
+                },
+                "saved_at": {
+                    "type": "string",
+                    "example": "2024-01-15T06:00:00Z"
+                },
                 "technologies": {
                     "type": "array",
                     "items": {
@@ -204,56 +8735,286 @@ const docTemplate = `{
                 "title": {
                     "type": "string"
                 },
+                "utc_offset_max": {
+                    "type": "integer",
+                    "example": -3
+                },
+                "utc_offset_min": {
+                    "type": "integer",
+                    "example": -6
+                },
                 "work_mode": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "Onsite"
+                },
+                "work_mode_label": {
+                    "type": "string",
+                    "example": "Presencial"
                 }
             }
         },
-        "jobs.PaginationDetails": {
+        "users.SessionResponse": {
             "type": "object",
             "properties": {
-                "has_more": {
-                    "type": "boolean"
+                "expires_at": {
+                    "type": "string",
+                    "example": "2024-02-14T06:00:00Z"
                 },
-                "limit": {
-                    "type": "integer"
+                "token": {
+                    "type": "string",
+                    "example": "Jx2f0kq3..."
                 },
-                "offset": {
-                    "type": "integer"
+                "user": {
+                    "$ref": "#/definitions/users.UserResponse"
+                }
+            }
+        },
+        "users.UserResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T06:00:00Z"
                 },
-                "total": {
-                    "type": "integer"
+                "email": {
+                    "type": "string",
+                    "example": "ana@example.com"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
-        "jobs.SearchResponse": {
+        "webhooks.DeadLetterListResponse": {
             "type": "object",
             "properties": {
                 "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/jobs.JobResponse"
+                        "$ref": "#/definitions/webhooks.DeadLetterResponse"
                     }
+                }
+            }
+        },
+        "webhooks.DeadLetterResponse": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer",
+                    "example": 8
                 },
-                "pagination": {
-                    "$ref": "#/definitions/jobs.PaginationDetails"
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "event_type": {
+                    "type": "string",
+                    "example": "job.created"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "last_error": {
+                    "type": "string",
+                    "example": "webhook endpoint responded with status 503"
+                },
+                "payload": {
+                    "type": "object"
+                },
+                "subscription_id": {
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
-        "jobs.TechnologyResponse": {
+        "webhooks.SubscribeRequest": {
             "type": "object",
+            "required": [
+                "url"
+            ],
             "properties": {
-                "category": {
-                    "type": "string"
+                "event_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "job.created",
+                        "job.deactivated"
+                    ]
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://partner.example.com/hooks/jobs"
+                }
+            }
+        },
+        "webhooks.SubscribeResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "event_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "job.created",
+                        "job.deactivated"
+                    ]
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "is_active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "secret": {
+                    "type": "string",
+                    "example": "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://partner.example.com/hooks/jobs"
+                }
+            }
+        },
+        "webhooks.SubscriptionListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/webhooks.SubscriptionResponse"
+                    }
+                }
+            }
+        },
+        "webhooks.SubscriptionResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "event_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "job.created",
+                        "job.deactivated"
+                    ]
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "is_active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://partner.example.com/hooks/jobs"
+                }
+            }
+        },
+        "widget.CompanyResponse": {
+            "type": "object",
+            "properties": {
+                "logo_url": {
+                    "type": "string",
+                    "example": "https://techcorp.com/logo.png"
                 },
                 "name": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "Tech Corp"
                 },
-                "required": {
-                    "type": "boolean"
+                "slug": {
+                    "type": "string",
+                    "example": "tech-corp"
+                }
+            }
+        },
+        "widget.JobResponse": {
+            "type": "object",
+            "properties": {
+                "application_url": {
+                    "type": "string",
+                    "example": "https://techcorp.com/careers/go"
+                },
+                "employment_type": {
+                    "type": "string",
+                    "example": "Full-time"
+                },
+                "experience_level": {
+                    "type": "string",
+                    "example": "Senior"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "location": {
+                    "type": "string",
+                    "example": "San José"
+                },
+                "posted_at": {
+                    "type": "string",
+                    "example": "2024-01-15T06:00:00Z"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Senior Go Developer"
+                },
+                "work_mode": {
+                    "type": "string",
+                    "example": "Hybrid"
+                }
+            }
+        },
+        "widget.OpeningsResponse": {
+            "type": "object",
+            "properties": {
+                "company": {
+                    "$ref": "#/definitions/widget.CompanyResponse"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/widget.JobResponse"
+                    }
                 }
             }
         }
+    },
+    "securityDefinitions": {
+        "AdminAPIKey": {
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
+        },
+        "BearerAuth": {
+            "description": "Session token from /auth/login, as \"Bearer \u003ctoken\u003e\"",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
+        },
+        "IngestAPIKey": {
+            "description": "Scraper API key (INGEST_API_KEY)",
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
+        }
     }
 }`
 
@@ -0,0 +1,85 @@
+// Package actor identifies who performs a change, a signed in user, an API key, a command line tool
+// or a scheduled task, so the changes can be audited. The middleware and the commands put the actor
+// in the context, and the repositories record it with their writes.
+package actor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os/user"
+	"strconv"
+)
+
+// Kind is the kind of an actor
+type Kind string
+
+// Actor kinds
+const (
+	KindUser   Kind = "user"
+	KindAPIKey Kind = "api_key"
+	KindCLI    Kind = "cli"
+	KindTask   Kind = "task"
+)
+
+// apiKeyIDLength is the number of hex characters of the hash of an API key identifying it
+const apiKeyIDLength = 12
+
+// Actor is who performs a change. The zero Actor is an unknown one.
+type Actor struct {
+	Kind Kind
+	ID   string
+}
+
+// contextKey holds the actor of a context
+type contextKey struct{}
+
+// User returns the actor of the user with the given ID
+func User(id int) Actor {
+	return Actor{Kind: KindUser, ID: strconv.Itoa(id)}
+}
+
+// APIKey returns the actor of an API key, identified by the start of its SHA-256 hash so the key
+// itself is never stored
+func APIKey(key string) Actor {
+	sum := sha256.Sum256([]byte(key))
+	return Actor{Kind: KindAPIKey, ID: hex.EncodeToString(sum[:])[:apiKeyIDLength]}
+}
+
+// CLI returns the actor of a command line tool run by the current OS user, as user@program
+func CLI(program string) Actor {
+	name := "unknown"
+	if current, err := user.Current(); err == nil && current.Username != "" {
+		name = current.Username
+	}
+	return Actor{Kind: KindCLI, ID: name + "@" + program}
+}
+
+// Task returns the actor of the scheduled task with the given name
+func Task(name string) Actor {
+	return Actor{Kind: KindTask, ID: name}
+}
+
+// IsZero reports whether a is unknown
+func (a Actor) IsZero() bool {
+	return a == Actor{}
+}
+
+// String returns the actor as kind:id, as it is recorded. The unknown actor is empty.
+func (a Actor) String() string {
+	if a.IsZero() {
+		return ""
+	}
+	return string(a.Kind) + ":" + a.ID
+}
+
+// With returns a copy of ctx carrying the actor a
+func With(ctx context.Context, a Actor) context.Context {
+	return context.WithValue(ctx, contextKey{}, a)
+}
+
+// From returns the actor of ctx, the zero Actor when there is none
+func From(ctx context.Context) Actor {
+	a, _ := ctx.Value(contextKey{}).(Actor)
+	return a
+}
@@ -0,0 +1,42 @@
+package actor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActor_String(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		actor    Actor
+		expected string
+	}{
+		{name: "user", actor: User(42), expected: "user:42"},
+		{name: "API key hashed", actor: APIKey("secret"), expected: "api_key:2bb80d537b1d"},
+		{name: "task", actor: Task("job-expiry"), expected: "task:job-expiry"},
+		{name: "unknown actor empty", actor: Actor{}, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, tt.actor.String())
+		})
+	}
+
+	assert.Regexp(t, `^cli:.+@titoctl$`, CLI("titoctl").String())
+}
+
+func TestFrom(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, From(context.Background()).IsZero())
+
+	ctx := With(context.Background(), User(7))
+	assert.Equal(t, User(7), From(ctx))
+	assert.Equal(t, APIKey("key"), From(With(ctx, APIKey("key"))))
+}
@@ -0,0 +1,142 @@
+package alerts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DigestRepository interface to fetch the alerts to send and their new jobs.
+type DigestRepository interface {
+	ListSubscribed(ctx context.Context) ([]*Alert, error)
+	ListNewJobs(ctx context.Context, alert *Alert, until time.Time, limit int) ([]*Job, error)
+	MarkSent(ctx context.Context, ids []int, at time.Time) error
+}
+
+// Mailer interface to email the new jobs of the alerts.
+type Mailer interface {
+	Send(ctx context.Context, to, subject, body string) error
+}
+
+// DigestSender emails the new jobs of the due alerts, a single email per user grouping all of
+// their due alerts.
+type DigestSender struct {
+	repo           DigestRepository
+	mailer         Mailer
+	unsubscribeURL string
+	now            func() time.Time
+}
+
+// NewDigestSender creates a new instance of DigestSender. The emails link to unsubscribeURL with
+// the unsubscribe token of each alert in its token query parameter, the page posting it back.
+func NewDigestSender(repo DigestRepository, mailer Mailer, unsubscribeURL string) *DigestSender {
+	return &DigestSender{repo: repo, mailer: mailer, unsubscribeURL: unsubscribeURL, now: time.Now}
+}
+
+// SendDue emails the jobs published since the due alerts were last sent and records them as sent,
+// see Alert.Due. Users whose due alerts have no new jobs get no email. A failing user doesn't stop
+// the others, their alerts stay due and the errors are joined. It returns the number of emails sent.
+func (s *DigestSender) SendDue(ctx context.Context) (int, error) {
+	// Timestamps are stored without timezone, in UTC
+	now := s.now().UTC()
+	alerts, err := s.repo.ListSubscribed(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var sent int
+	var errs []error
+	for _, userAlerts := range groupByUser(alerts) {
+		var due []*Alert
+		for _, alert := range userAlerts {
+			if alert.Due(now) {
+				due = append(due, alert)
+			}
+		}
+		if len(due) == 0 {
+			continue
+		}
+
+		emailed, err := s.send(ctx, due, now)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to send job alerts of user %d: %w", due[0].UserID, err))
+			continue
+		}
+		if emailed {
+			sent++
+		}
+	}
+
+	return sent, errors.Join(errs...)
+}
+
+// send emails the new jobs of the due alerts of a user, if any, and records the alerts as sent
+func (s *DigestSender) send(ctx context.Context, due []*Alert, now time.Time) (bool, error) {
+	sections := make([]string, 0, len(due))
+	ids := make([]int, 0, len(due))
+	var total int
+	for _, alert := range due {
+		jobs, err := s.repo.ListNewJobs(ctx, alert, now, MaxJobsPerAlert)
+		if err != nil {
+			return false, err
+		}
+		ids = append(ids, alert.ID)
+		if len(jobs) > 0 {
+			sections = append(sections, s.section(alert, jobs))
+			total += len(jobs)
+		}
+	}
+
+	if total > 0 {
+		subject := fmt.Sprintf("%d new jobs for your alerts on Ticos in Tech", total)
+		if total == 1 {
+			subject = "1 new job for your alerts on Ticos in Tech"
+		}
+		if err := s.mailer.Send(ctx, due[0].Email, subject, strings.Join(sections, "\n")); err != nil {
+			return false, err
+		}
+	}
+
+	return total > 0, s.repo.MarkSent(ctx, ids, now)
+}
+
+// section formats the new jobs of an alert with its unsubscribe link
+func (s *DigestSender) section(alert *Alert, jobs []*Job) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "New jobs for %q (%s):\n\n", alert.Query, alert.Frequency)
+	for _, job := range jobs {
+		fmt.Fprintf(&b, "- %s at %s (%s, %s)\n  %s\n", job.Title, job.CompanyName, job.WorkMode, job.Location,
+			job.ApplicationURL)
+	}
+	fmt.Fprintf(&b, "\nUnsubscribe from this alert: %s\n", s.unsubscribeLink(alert.UnsubscribeToken))
+	return b.String()
+}
+
+// unsubscribeLink returns the unsubscribe URL with the token in its query, keeping the query it has
+func (s *DigestSender) unsubscribeLink(token string) string {
+	link, err := url.Parse(s.unsubscribeURL)
+	if err != nil {
+		return s.unsubscribeURL
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+	return link.String()
+}
+
+// groupByUser splits the alerts, sorted by user, in the alerts of each user
+func groupByUser(alerts []*Alert) [][]*Alert {
+	var groups [][]*Alert
+	for i := 0; i < len(alerts); {
+		j := i + 1
+		for j < len(alerts) && alerts[j].UserID == alerts[i].UserID {
+			j++
+		}
+		groups = append(groups, alerts[i:j])
+		i = j
+	}
+	return groups
+}
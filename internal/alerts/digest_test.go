@@ -0,0 +1,124 @@
+package alerts
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDigestSender_SendDue(t *testing.T) {
+	t.Parallel()
+	sendError := errors.New("send error")
+	job := &Job{ID: 1, Title: "Go Developer", CompanyName: "Tech Corp", Location: "San José", WorkMode: "Remote",
+		ApplicationURL: "https://techcorp.com/jobs/1"}
+
+	// Alerts of user 1, the weekly one isn't due, and of user 2
+	newAlerts := func() []*Alert {
+		return []*Alert{
+			{ID: 1, UserID: 1, Email: "ana@example.com", Query: "golang", Frequency: FrequencyDaily,
+				Timezone: DefaultTimezone, UnsubscribeToken: "token1", LastSentAt: testNow.Add(-24 * time.Hour)},
+			{ID: 2, UserID: 1, Email: "ana@example.com", Query: "rust", Frequency: FrequencyInstant,
+				Timezone: DefaultTimezone, UnsubscribeToken: "token2", LastSentAt: testNow.Add(-time.Hour)},
+			{ID: 3, UserID: 1, Email: "ana@example.com", Query: "java", Frequency: FrequencyWeekly,
+				Timezone: DefaultTimezone, UnsubscribeToken: "token3", LastSentAt: testNow.Add(-time.Minute)},
+			{ID: 4, UserID: 2, Email: "luis@example.com", Query: "python", Frequency: FrequencyInstant,
+				Timezone: DefaultTimezone, UnsubscribeToken: "token4", LastSentAt: testNow.Add(-time.Hour)},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mockRepo *MockDigestRepository, mockMailer *MockMailer)
+		checkResults func(t *testing.T, sent int, err error)
+	}{
+		{
+			name: "one email per user grouping their due alerts",
+			mockSetup: func(mockRepo *MockDigestRepository, mockMailer *MockMailer) {
+				t.Helper()
+				alerts := newAlerts()
+				mockRepo.EXPECT().ListSubscribed(context.Background()).Return(alerts, nil).Once()
+				mockRepo.EXPECT().ListNewJobs(context.Background(), alerts[0], testNow, MaxJobsPerAlert).
+					Return([]*Job{job}, nil).Once()
+				mockRepo.EXPECT().ListNewJobs(context.Background(), alerts[1], testNow, MaxJobsPerAlert).
+					Return([]*Job{job}, nil).Once()
+				mockRepo.EXPECT().ListNewJobs(context.Background(), alerts[3], testNow, MaxJobsPerAlert).
+					Return(nil, nil).Once()
+				mockMailer.EXPECT().Send(context.Background(), "ana@example.com",
+					"2 new jobs for your alerts on Ticos in Tech", mock.Anything).
+					Run(func(_ context.Context, _, _, body string) {
+						assert.Contains(t, body, "New jobs for \"golang\" (daily):\n\n"+
+							"- Go Developer at Tech Corp (Remote, San José)\n  https://techcorp.com/jobs/1\n")
+						assert.Contains(t, body, "New jobs for \"rust\" (instant)")
+						assert.Contains(t, body, "https://ticosintech.com/unsubscribe?lang=es&token=token1")
+						assert.Contains(t, body, "https://ticosintech.com/unsubscribe?lang=es&token=token2")
+						assert.NotContains(t, body, "java")
+					}).Return(nil).Once()
+				mockRepo.EXPECT().MarkSent(context.Background(), []int{1, 2}, testNow).Return(nil).Once()
+				// Due without new jobs, sent without an email
+				mockRepo.EXPECT().MarkSent(context.Background(), []int{4}, testNow).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, sent int, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 1, sent)
+			},
+		},
+		{
+			name: "failing email keeps the alerts of the user due",
+			mockSetup: func(mockRepo *MockDigestRepository, mockMailer *MockMailer) {
+				t.Helper()
+				alerts := newAlerts()
+				mockRepo.EXPECT().ListSubscribed(context.Background()).Return(alerts, nil).Once()
+				mockRepo.EXPECT().ListNewJobs(context.Background(), mock.Anything, testNow, MaxJobsPerAlert).
+					Return([]*Job{job}, nil).Times(3)
+				mockMailer.EXPECT().Send(context.Background(), "ana@example.com", mock.Anything, mock.Anything).
+					Return(sendError).Once()
+				mockMailer.EXPECT().Send(context.Background(), "luis@example.com",
+					"1 new job for your alerts on Ticos in Tech", mock.Anything).Return(nil).Once()
+				mockRepo.EXPECT().MarkSent(context.Background(), []int{4}, testNow).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, sent int, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, sendError)
+				assert.ErrorContains(t, err, "user 1")
+				assert.Equal(t, 1, sent)
+			},
+		},
+		{
+			name: "alerts in their quiet hours skipped",
+			mockSetup: func(mockRepo *MockDigestRepository, _ *MockMailer) {
+				t.Helper()
+				alerts := newAlerts()
+				for _, alert := range alerts {
+					alert.QuietHoursStart, alert.QuietHoursEnd = hour(22), hour(9)
+				}
+				mockRepo.EXPECT().ListSubscribed(context.Background()).Return(alerts, nil).Once()
+			},
+			checkResults: func(t *testing.T, sent int, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Zero(t, sent)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDigestRepository(t)
+			mockMailer := NewMockMailer(t)
+			tt.mockSetup(mockRepo, mockMailer)
+
+			sender := NewDigestSender(mockRepo, mockMailer, "https://ticosintech.com/unsubscribe?lang=es")
+			sender.now = func() time.Time { return testNow }
+
+			sent, err := sender.SendDue(context.Background())
+			tt.checkResults(t, sent, err)
+		})
+	}
+}
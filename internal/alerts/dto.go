@@ -0,0 +1,111 @@
+package alerts
+
+import (
+	"strings"
+	"time"
+)
+
+// Data Transfer Objects (DTOs) for the alerts API layer.
+
+// AlertRequest represents the request body to create or edit an alert
+type AlertRequest struct {
+	// Query and the filters select the jobs like the job search does
+	Query           string `json:"query" binding:"required,search_query,max=255" example:"golang developer"`
+	ExperienceLevel string `json:"experience_level" binding:"omitempty,experience_level" example:"Senior"`
+	EmploymentType  string `json:"employment_type" binding:"omitempty,employment_type" example:"Full-time"`
+	Location        string `json:"location" binding:"omitempty,location" example:"Costa Rica"`
+	WorkMode        string `json:"work_mode" binding:"omitempty,work_mode" example:"Remote"`
+	// Frequency is how often the new jobs are emailed, daily by default
+	Frequency string `json:"frequency" binding:"omitempty,oneof=instant daily weekly" example:"daily"`
+	// Timezone is the IANA timezone of the digest hour and the quiet hours, America/Costa_Rica by default
+	Timezone string `json:"timezone" binding:"omitempty,max=64" example:"America/Costa_Rica"`
+	// QuietHoursStart and QuietHoursEnd are the local hours between which nothing is sent, set together
+	QuietHoursStart *int `json:"quiet_hours_start" binding:"omitempty,min=0,max=23" example:"22"`
+	QuietHoursEnd   *int `json:"quiet_hours_end" binding:"omitempty,min=0,max=23" example:"7"`
+}
+
+// ToAlert converts an AlertRequest to an Alert of a user, trimming the query
+func (req *AlertRequest) ToAlert(userID int) *Alert {
+	return &Alert{
+		UserID:          userID,
+		Query:           strings.TrimSpace(req.Query),
+		ExperienceLevel: optional(req.ExperienceLevel),
+		EmploymentType:  optional(req.EmploymentType),
+		Location:        optional(req.Location),
+		WorkMode:        optional(req.WorkMode),
+		Frequency:       Frequency(req.Frequency),
+		Timezone:        strings.TrimSpace(req.Timezone),
+		QuietHoursStart: req.QuietHoursStart,
+		QuietHoursEnd:   req.QuietHoursEnd,
+	}
+}
+
+// UnsubscribeRequest represents the request body to unsubscribe from an alert with the token of its emails
+type UnsubscribeRequest struct {
+	Token string `json:"token" binding:"required" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+}
+
+// AlertResponse represents the API response for an alert of the user
+type AlertResponse struct {
+	ID              int     `json:"id" example:"5"`
+	Query           string  `json:"query" example:"golang developer"`
+	ExperienceLevel *string `json:"experience_level,omitempty" example:"Senior"`
+	EmploymentType  *string `json:"employment_type,omitempty" example:"Full-time"`
+	Location        *string `json:"location,omitempty" example:"Costa Rica"`
+	WorkMode        *string `json:"work_mode,omitempty" example:"Remote"`
+	Frequency       string  `json:"frequency" example:"daily"`
+	Timezone        string  `json:"timezone" example:"America/Costa_Rica"`
+	QuietHoursStart *int    `json:"quiet_hours_start,omitempty" example:"22"`
+	QuietHoursEnd   *int    `json:"quiet_hours_end,omitempty" example:"7"`
+	// Country is the country whose jobs are sent, the one of the request creating the alert
+	Country string `json:"country" example:"CR"`
+	// LastSentAt is when the alert was last sent, the jobs published after it being the new ones
+	LastSentAt time.Time `json:"last_sent_at" example:"2024-01-15T14:00:00Z"`
+	// UnsubscribedAt is when the alert was stopped from an email, omitted while it is sent
+	UnsubscribedAt *time.Time `json:"unsubscribed_at,omitempty" example:"2024-01-20T09:00:00Z"`
+	CreatedAt      time.Time  `json:"created_at" example:"2024-01-15T06:00:00Z"`
+	UpdatedAt      time.Time  `json:"updated_at" example:"2024-01-15T06:00:00Z"`
+}
+
+// AlertListResponse represents the API response listing the alerts of the user
+type AlertListResponse struct {
+	Data []*AlertResponse `json:"data"`
+}
+
+// MapAlertToResponse converts an alert to its API response format
+func MapAlertToResponse(alert *Alert) *AlertResponse {
+	return &AlertResponse{
+		ID:              alert.ID,
+		Query:           alert.Query,
+		ExperienceLevel: alert.ExperienceLevel,
+		EmploymentType:  alert.EmploymentType,
+		Location:        alert.Location,
+		WorkMode:        alert.WorkMode,
+		Frequency:       string(alert.Frequency),
+		Timezone:        alert.Timezone,
+		QuietHoursStart: alert.QuietHoursStart,
+		QuietHoursEnd:   alert.QuietHoursEnd,
+		Country:         alert.Country,
+		LastSentAt:      alert.LastSentAt,
+		UnsubscribedAt:  alert.UnsubscribedAt,
+		CreatedAt:       alert.CreatedAt,
+		UpdatedAt:       alert.UpdatedAt,
+	}
+}
+
+// MapAlertsToResponse converts the alerts of a user to the list API response format
+func MapAlertsToResponse(alerts []*Alert) *AlertListResponse {
+	data := make([]*AlertResponse, 0, len(alerts))
+	for _, alert := range alerts {
+		data = append(data, MapAlertToResponse(alert))
+	}
+	return &AlertListResponse{Data: data}
+}
+
+// optional returns nil for an empty filter
+func optional(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
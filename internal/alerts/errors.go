@@ -0,0 +1,33 @@
+// Package alerts emails users the new jobs of their saved searches. Each alert is sent instantly,
+// or grouped in a daily or weekly digest, outside of its quiet hours in the timezone of the user.
+// The emails link to an unsubscribe token, verified by the server, that stops the alert.
+package alerts
+
+import (
+	"fmt"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// Sentinel errors returned by the alert services
+var (
+	// ErrInvalidUnsubscribeToken is returned when unsubscribing with a token of no alert
+	ErrInvalidUnsubscribeToken = httpservice.NewError(httpservice.ErrInvalid, "invalid unsubscribe token")
+	// ErrTooManyAlerts is returned when a user creates more than MaxAlertsPerUser alerts
+	ErrTooManyAlerts = httpservice.NewError(httpservice.ErrConflict,
+		fmt.Sprintf("a user can have at most %d alerts", MaxAlertsPerUser))
+)
+
+// AlertNotFoundError represents an alert not found error. Alerts of other users aren't found either.
+type AlertNotFoundError struct {
+	ID int
+}
+
+func (e AlertNotFoundError) Error() string {
+	return fmt.Sprintf("alert with ID %d not found", e.ID)
+}
+
+// Is matches httpservice.ErrNotFound
+func (e AlertNotFoundError) Is(target error) bool {
+	return target == httpservice.ErrNotFound
+}
@@ -0,0 +1,198 @@
+package alerts
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+	"github.com/rodruizronald/ticos-in-tech/internal/users"
+)
+
+// Constants for alert routes and endpoints
+const (
+	AlertsRoute = "/me/alerts"
+	AlertPath   = AlertsRoute + "/:id"
+
+	// UnsubscribeRoute is public, the token of the emails authenticates it
+	UnsubscribeRoute = "/alerts/unsubscribe"
+)
+
+// Handler handles HTTP requests for job alerts
+type Handler struct {
+	service *AlertService
+	auth    users.Authenticator
+}
+
+// NewHandler creates a new alert handler, authenticating the users with auth
+func NewHandler(service *AlertService, auth users.Authenticator) *Handler {
+	return &Handler{service: service, auth: auth}
+}
+
+// RegisterRoutes registers the alert routes with the given router group. All of them require a
+// session token but the unsubscribe one.
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.POST(UnsubscribeRoute, h.Unsubscribe)
+
+	authenticated := rg.Group("", users.RequireUser(h.auth))
+	authenticated.GET(AlertsRoute, h.ListAlerts)
+	authenticated.POST(AlertsRoute, h.CreateAlert)
+	authenticated.PUT(AlertPath, h.UpdateAlert)
+	authenticated.DELETE(AlertPath, h.DeleteAlert)
+}
+
+// ListAlerts godoc
+// @Summary List my job alerts
+// @Description Lists the job alerts of the current user, oldest first
+// @Tags alerts
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} AlertListResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /me/alerts [get]
+func (h *Handler) ListAlerts(c *gin.Context) {
+	alerts, err := h.service.List(c.Request.Context(), users.CurrentUser(c).ID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapAlertsToResponse(alerts))
+}
+
+// CreateAlert godoc
+// @Summary Create a job alert
+// @Description Saves a search whose new jobs are emailed to the current user, instantly or in a daily
+// @Description or weekly digest sent from 8:00 (on Mondays for the weekly one) in the timezone of the
+// @Description alert. Nothing is sent during its quiet hours. A user can have 20 alerts.
+// @Description Only the jobs of the country of the X-Country header are sent.
+// @Tags alerts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body AlertRequest true "Search and preferences of the alert"
+// @Success 201 {object} AlertResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 409 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /me/alerts [post]
+func (h *Handler) CreateAlert(c *gin.Context) {
+	var req AlertRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	alert := req.ToAlert(users.CurrentUser(c).ID)
+	alert.Country = httpservice.CountryOf(c)
+	if err := h.service.Create(c.Request.Context(), alert); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, MapAlertToResponse(alert))
+}
+
+// UpdateAlert godoc
+// @Summary Edit a job alert
+// @Description Replaces the search and the preferences of an alert of the current user. An alert
+// @Description unsubscribed from an email is subscribed again.
+// @Tags alerts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Alert ID"
+// @Param request body AlertRequest true "Search and preferences of the alert"
+// @Success 200 {object} AlertResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /me/alerts/{id} [put]
+func (h *Handler) UpdateAlert(c *gin.Context) {
+	id, ok := parseID(c)
+	if !ok {
+		return
+	}
+
+	var req AlertRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	alert := req.ToAlert(users.CurrentUser(c).ID)
+	alert.ID = id
+	if err := h.service.Update(c.Request.Context(), alert); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapAlertToResponse(alert))
+}
+
+// DeleteAlert godoc
+// @Summary Delete a job alert
+// @Description Deletes an alert of the current user
+// @Tags alerts
+// @Security BearerAuth
+// @Param id path int true "Alert ID"
+// @Success 204
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /me/alerts/{id} [delete]
+func (h *Handler) DeleteAlert(c *gin.Context) {
+	id, ok := parseID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), users.CurrentUser(c).ID, id); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Unsubscribe godoc
+// @Summary Unsubscribe from a job alert
+// @Description Stops the alert of the token of the unsubscribe link of its emails, without logging in.
+// @Description Unsubscribing again succeeds too. Editing the alert subscribes it again.
+// @Tags alerts
+// @Accept json
+// @Produce json
+// @Param request body UnsubscribeRequest true "Token of the unsubscribe link"
+// @Success 204
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /alerts/unsubscribe [post]
+func (h *Handler) Unsubscribe(c *gin.Context) {
+	var req UnsubscribeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	if err := h.service.Unsubscribe(c.Request.Context(), req.Token); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// parseID parses the positive ID path parameter of an alert, reporting a validation error otherwise
+func parseID(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		_ = c.Error(&httpservice.ValidationError{Errors: []string{"invalid alert id"}})
+		return 0, false
+	}
+	return id, true
+}
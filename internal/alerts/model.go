@@ -0,0 +1,138 @@
+package alerts
+
+import (
+	"time"
+	// Embedded so the timezones of the alerts are known without the tz database of the system
+	_ "time/tzdata"
+)
+
+// Alert settings
+const (
+	// DefaultTimezone is the timezone of the alerts created without one
+	DefaultTimezone = "America/Costa_Rica"
+	// DigestHour is the local hour from which the daily and weekly digests are sent
+	DigestHour = 8
+	// DigestWeekday is the local day the weekly digests are sent
+	DigestWeekday = time.Monday
+	// MaxJobsPerAlert bounds the jobs of an alert listed in an email, the newest ones
+	MaxJobsPerAlert = 20
+	// MaxAlertsPerUser bounds the alerts of a user
+	MaxAlertsPerUser = 20
+	// DefaultPollInterval is how often the due alerts are looked for, so how late instant ones can be
+	DefaultPollInterval = 15 * time.Minute
+)
+
+// Frequency is how often the new jobs of an alert are emailed
+type Frequency string
+
+// Alert frequencies
+const (
+	// FrequencyInstant emails the new jobs as soon as they are found
+	FrequencyInstant Frequency = "instant"
+	// FrequencyDaily emails the new jobs of the day once a day
+	FrequencyDaily Frequency = "daily"
+	// FrequencyWeekly emails the new jobs of the week once a week
+	FrequencyWeekly Frequency = "weekly"
+)
+
+// IsValid reports whether f is a known frequency
+func (f Frequency) IsValid() bool {
+	switch f {
+	case FrequencyInstant, FrequencyDaily, FrequencyWeekly:
+		return true
+	}
+	return false
+}
+
+// Alert represents a saved search of a user whose new jobs are emailed to them
+type Alert struct {
+	ID     int `db:"id"`
+	UserID int `db:"user_id"`
+	// Email is the address of the user, only set for the alerts listed to be sent
+	Email string `db:"email"`
+	// Query and the optional filters select the jobs like the job search does
+	Query           string    `db:"query"`
+	ExperienceLevel *string   `db:"experience_level"`
+	EmploymentType  *string   `db:"employment_type"`
+	Location        *string   `db:"location"`
+	WorkMode        *string   `db:"work_mode"`
+	Frequency       Frequency `db:"frequency"`
+	// Timezone is the IANA name of the timezone of the digest hour and the quiet hours
+	Timezone string `db:"timezone"`
+	// Country is the ISO 3166-1 alpha-2 code of the country the alert was created for, only its jobs are sent
+	Country string `db:"country"`
+	// QuietHoursStart and QuietHoursEnd are the local hours between which nothing is sent, the
+	// end excluded. They wrap around midnight when the start is later, both nil for no quiet hours.
+	QuietHoursStart *int `db:"quiet_hours_start"`
+	QuietHoursEnd   *int `db:"quiet_hours_end"`
+	// UnsubscribeToken is the secret of the unsubscribe link of the emails
+	UnsubscribeToken string `db:"unsubscribe_token"`
+	// LastSentAt is when the alert was last processed, the jobs published after it are new
+	LastSentAt time.Time `db:"last_sent_at"`
+	// UnsubscribedAt is when the alert was stopped from an email, nil while it is sent
+	UnsubscribedAt *time.Time `db:"unsubscribed_at"`
+	CreatedAt      time.Time  `db:"created_at"`
+	UpdatedAt      time.Time  `db:"updated_at"`
+}
+
+// Due reports whether the new jobs of the alert are sent at now: it wasn't unsubscribed, now is
+// outside of its quiet hours and, unless it is instant, it wasn't sent since its current daily or
+// weekly period started.
+func (a *Alert) Due(now time.Time) bool {
+	if a.UnsubscribedAt != nil || a.InQuietHours(now) {
+		return false
+	}
+	if a.Frequency == FrequencyInstant {
+		return true
+	}
+	return a.LastSentAt.Before(a.periodStart(now))
+}
+
+// InQuietHours reports whether now is within the quiet hours of the alert, in its timezone
+func (a *Alert) InQuietHours(now time.Time) bool {
+	if a.QuietHoursStart == nil || a.QuietHoursEnd == nil {
+		return false
+	}
+
+	hour := now.In(a.location()).Hour()
+	start, end := *a.QuietHoursStart, *a.QuietHoursEnd
+	if start <= end {
+		return hour >= start && hour < end
+	}
+	return hour >= start || hour < end
+}
+
+// periodStart returns when the current period of a daily or weekly alert started at now, the last
+// DigestHour, of a DigestWeekday for the weekly ones, in the timezone of the alert
+func (a *Alert) periodStart(now time.Time) time.Time {
+	local := now.In(a.location())
+	start := time.Date(local.Year(), local.Month(), local.Day(), DigestHour, 0, 0, 0, local.Location())
+	if start.After(local) {
+		start = start.AddDate(0, 0, -1)
+	}
+	if a.Frequency == FrequencyWeekly {
+		start = start.AddDate(0, 0, -((int(start.Weekday()) - int(DigestWeekday) + 7) % 7))
+	}
+	return start
+}
+
+// location returns the timezone of the alert, UTC when unknown
+func (a *Alert) location() *time.Location {
+	location, err := time.LoadLocation(a.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+// Job represents a new job of an alert
+type Job struct {
+	ID              int       `db:"id"`
+	Title           string    `db:"title"`
+	CompanyName     string    `db:"company_name"`
+	Location        string    `db:"location"`
+	WorkMode        string    `db:"work_mode"`
+	ExperienceLevel string    `db:"experience_level"`
+	ApplicationURL  string    `db:"application_url"`
+	PublishedAt     time.Time `db:"published_at"`
+}
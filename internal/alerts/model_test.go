@@ -0,0 +1,119 @@
+package alerts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testNow is Monday 2024-01-15 at 8:30 in Costa Rica, UTC-6
+var testNow = time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC)
+
+func hour(h int) *int {
+	return &h
+}
+
+func TestAlert_Due(t *testing.T) {
+	t.Parallel()
+	unsubscribedAt := testNow.Add(-time.Hour)
+
+	tests := []struct {
+		name  string
+		alert Alert
+		want  bool
+	}{
+		{
+			name:  "instant alert",
+			alert: Alert{Frequency: FrequencyInstant, LastSentAt: testNow.Add(-time.Minute)},
+			want:  true,
+		},
+		{
+			name: "unsubscribed alert",
+			alert: Alert{Frequency: FrequencyInstant, LastSentAt: testNow.Add(-time.Hour),
+				UnsubscribedAt: &unsubscribedAt},
+			want: false,
+		},
+		{
+			name:  "daily alert sent before today's digest hour",
+			alert: Alert{Frequency: FrequencyDaily, LastSentAt: testNow.Add(-time.Hour)},
+			want:  true,
+		},
+		{
+			name:  "daily alert sent since today's digest hour",
+			alert: Alert{Frequency: FrequencyDaily, LastSentAt: testNow.Add(-10 * time.Minute)},
+			want:  false,
+		},
+		{
+			name: "daily alert before the digest hour of its timezone",
+			alert: Alert{Frequency: FrequencyDaily, Timezone: "America/Denver",
+				LastSentAt: testNow.Add(-20 * time.Hour)},
+			want: false,
+		},
+		{
+			name:  "daily alert in the digest hour of UTC",
+			alert: Alert{Frequency: FrequencyDaily, Timezone: "UTC", LastSentAt: testNow.Add(-20 * time.Hour)},
+			want:  true,
+		},
+		{
+			name:  "weekly alert sent last week",
+			alert: Alert{Frequency: FrequencyWeekly, LastSentAt: testNow.Add(-6 * 24 * time.Hour)},
+			want:  true,
+		},
+		{
+			name:  "weekly alert sent since Monday's digest hour",
+			alert: Alert{Frequency: FrequencyWeekly, Timezone: "UTC", LastSentAt: testNow.Add(-5 * time.Hour)},
+			want:  false,
+		},
+		{
+			name: "weekly alert before Monday's digest hour of its timezone",
+			alert: Alert{Frequency: FrequencyWeekly, Timezone: "America/Los_Angeles",
+				LastSentAt: testNow.Add(-3 * 24 * time.Hour)},
+			want: false,
+		},
+		{
+			name: "alert in its quiet hours",
+			alert: Alert{Frequency: FrequencyInstant, QuietHoursStart: hour(8), QuietHoursEnd: hour(9),
+				LastSentAt: testNow.Add(-time.Hour)},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if tt.alert.Timezone == "" {
+				tt.alert.Timezone = DefaultTimezone
+			}
+			assert.Equal(t, tt.want, tt.alert.Due(testNow))
+		})
+	}
+}
+
+func TestAlert_InQuietHours(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		timezone   string
+		start, end *int
+		want       bool
+	}{
+		{name: "no quiet hours", timezone: DefaultTimezone, want: false},
+		{name: "within local quiet hours", timezone: DefaultTimezone, start: hour(8), end: hour(12), want: true},
+		{name: "end excluded", timezone: DefaultTimezone, start: hour(6), end: hour(8), want: false},
+		{name: "quiet hours in another timezone", timezone: "UTC", start: hour(8), end: hour(12), want: false},
+		{name: "wrapping midnight, after the start", timezone: "UTC", start: hour(14), end: hour(2), want: true},
+		{name: "wrapping midnight, before the end", timezone: DefaultTimezone, start: hour(22), end: hour(9),
+			want: true},
+		{name: "wrapping midnight, outside", timezone: DefaultTimezone, start: hour(22), end: hour(7), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			alert := &Alert{Timezone: tt.timezone, QuietHoursStart: tt.start, QuietHoursEnd: tt.end}
+			assert.Equal(t, tt.want, alert.InQuietHours(testNow))
+		})
+	}
+}
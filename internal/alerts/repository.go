@@ -0,0 +1,290 @@
+package alerts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// SQL query constants
+const (
+	alertColumns = `id, user_id, query, experience_level, employment_type, location, work_mode, country,
+               frequency, timezone, quiet_hours_start, quiet_hours_end, unsubscribe_token, last_sent_at, unsubscribed_at,
+               created_at, updated_at`
+
+	createAlertQuery = `
+        INSERT INTO job_alerts (user_id, query, experience_level, employment_type, location, work_mode,
+                                frequency, timezone, quiet_hours_start, quiet_hours_end, unsubscribe_token, country)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+        RETURNING ` + alertColumns
+
+	countAlertsQuery = `SELECT COUNT(*) FROM job_alerts WHERE user_id = $1`
+
+	listAlertsQuery = `SELECT ` + alertColumns + ` FROM job_alerts WHERE user_id = $1 ORDER BY created_at, id`
+
+	// Editing an alert subscribes it again, its country is kept
+	updateAlertQuery = `
+        UPDATE job_alerts
+        SET query = $3, experience_level = $4, employment_type = $5, location = $6, work_mode = $7,
+            frequency = $8, timezone = $9, quiet_hours_start = $10, quiet_hours_end = $11,
+            unsubscribed_at = NULL, updated_at = NOW()
+        WHERE id = $1 AND user_id = $2
+        RETURNING ` + alertColumns
+
+	deleteAlertQuery = `DELETE FROM job_alerts WHERE id = $1 AND user_id = $2`
+
+	// Unsubscribing again keeps the first unsubscription time
+	unsubscribeQuery = `
+        UPDATE job_alerts
+        SET unsubscribed_at = COALESCE(unsubscribed_at, NOW()), updated_at = NOW()
+        WHERE unsubscribe_token = $1
+    `
+
+	listSubscribedQuery = `
+        SELECT u.email, a.id, a.user_id, a.query, a.experience_level, a.employment_type, a.location, a.work_mode,
+               a.country, a.frequency, a.timezone, a.quiet_hours_start, a.quiet_hours_end, a.unsubscribe_token,
+               a.last_sent_at, a.unsubscribed_at, a.created_at, a.updated_at
+        FROM job_alerts a
+        JOIN users u ON a.user_id = u.id
+        WHERE a.unsubscribed_at IS NULL
+        ORDER BY a.user_id, a.id
+    `
+
+	// Jobs are published when created as published or when approved, whichever is later. They match
+	// the query ($1) like the job search does, in the language of the job, and the filters set, in the
+	// country of the alert ($9). Only the jobs published between the last time the alert was sent ($2)
+	// and $3 are new, newest first.
+	listNewJobsQuery = `
+        SELECT j.id, j.title, c.name, j.location, j.work_mode, j.experience_level, j.application_url,
+               COALESCE(j.reviewed_at, j.created_at) AS published_at
+        FROM jobs j
+        JOIN companies c ON j.company_id = c.id
+        WHERE j.is_active = true AND j.status = 'published' AND j.canonical_job_id IS NULL
+          AND COALESCE(j.reviewed_at, j.created_at) > $2 AND COALESCE(j.reviewed_at, j.created_at) <= $3
+          AND ((j.language = 'es' AND j.search_vector @@ plainto_tsquery('spanish', $1))
+               OR (j.language <> 'es' AND j.search_vector @@ plainto_tsquery('english', $1)))
+          AND ($4::TEXT IS NULL OR j.experience_level = $4)
+          AND ($5::TEXT IS NULL OR j.employment_type = $5)
+          AND ($6::TEXT IS NULL OR j.location = $6)
+          AND ($7::TEXT IS NULL OR j.work_mode = $7)
+          AND j.country = $9
+        ORDER BY published_at DESC, j.id DESC
+        LIMIT $8
+    `
+
+	markSentQuery = `UPDATE job_alerts SET last_sent_at = $2 WHERE id = ANY($1)`
+)
+
+// Database interface to support pgxpool and mocks
+type Database interface {
+	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
+	Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+}
+
+// Repository handles database operations for job alerts.
+type Repository struct {
+	db Database
+}
+
+// NewRepository creates a new Repository instance.
+func NewRepository(db Database) *Repository {
+	return &Repository{db: db}
+}
+
+// Create inserts a new alert into the database, setting the fields generated by it.
+func (r *Repository) Create(ctx context.Context, alert *Alert) error {
+	created, err := scanAlert(r.db.QueryRow(ctx, createAlertQuery,
+		alert.UserID, alert.Query, alert.ExperienceLevel, alert.EmploymentType, alert.Location, alert.WorkMode,
+		alert.Frequency, alert.Timezone, alert.QuietHoursStart, alert.QuietHoursEnd, alert.UnsubscribeToken,
+		alert.Country,
+	))
+	if err != nil {
+		return fmt.Errorf("failed to create job alert: %w", err)
+	}
+
+	*alert = *created
+	return nil
+}
+
+// CountByUser counts the alerts of a user.
+func (r *Repository) CountByUser(ctx context.Context, userID int) (int, error) {
+	var count int
+	if err := r.db.QueryRow(ctx, countAlertsQuery, userID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count job alerts: %w", err)
+	}
+	return count, nil
+}
+
+// ListByUser retrieves the alerts of a user, oldest first.
+func (r *Repository) ListByUser(ctx context.Context, userID int) ([]*Alert, error) {
+	rows, err := r.db.Query(ctx, listAlertsQuery, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list job alerts: %w", err)
+	}
+	defer rows.Close()
+
+	var alerts []*Alert
+	for rows.Next() {
+		alert, err := scanAlert(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan job alert: %w", err)
+		}
+		alerts = append(alerts, alert)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating job alerts: %w", err)
+	}
+
+	return alerts, nil
+}
+
+// Update replaces the search and the preferences of an alert of its user, subscribing it again.
+// An AlertNotFoundError is returned when the user has no such alert.
+func (r *Repository) Update(ctx context.Context, alert *Alert) error {
+	updated, err := scanAlert(r.db.QueryRow(ctx, updateAlertQuery,
+		alert.ID, alert.UserID, alert.Query, alert.ExperienceLevel, alert.EmploymentType, alert.Location,
+		alert.WorkMode, alert.Frequency, alert.Timezone, alert.QuietHoursStart, alert.QuietHoursEnd,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &AlertNotFoundError{ID: alert.ID}
+		}
+		return fmt.Errorf("failed to update job alert: %w", err)
+	}
+
+	*alert = *updated
+	return nil
+}
+
+// Delete removes an alert of a user. An AlertNotFoundError is returned when the user has no such alert.
+func (r *Repository) Delete(ctx context.Context, userID, id int) error {
+	commandTag, err := r.db.Exec(ctx, deleteAlertQuery, id, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete job alert: %w", err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return &AlertNotFoundError{ID: id}
+	}
+	return nil
+}
+
+// Unsubscribe stops the alert of an unsubscribe token. ErrInvalidUnsubscribeToken is returned
+// when no alert has the token.
+func (r *Repository) Unsubscribe(ctx context.Context, token string) error {
+	commandTag, err := r.db.Exec(ctx, unsubscribeQuery, token)
+	if err != nil {
+		return fmt.Errorf("failed to unsubscribe job alert: %w", err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return ErrInvalidUnsubscribeToken
+	}
+	return nil
+}
+
+// ListSubscribed retrieves the alerts that weren't unsubscribed with the email of their user,
+// grouped by user.
+func (r *Repository) ListSubscribed(ctx context.Context) ([]*Alert, error) {
+	rows, err := r.db.Query(ctx, listSubscribedQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list subscribed job alerts: %w", err)
+	}
+	defer rows.Close()
+
+	var alerts []*Alert
+	for rows.Next() {
+		alert := &Alert{}
+		dest := append([]any{&alert.Email}, alertDest(alert)...)
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf("failed to scan subscribed job alert: %w", err)
+		}
+		alerts = append(alerts, alert)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating subscribed job alerts: %w", err)
+	}
+
+	return alerts, nil
+}
+
+// ListNewJobs retrieves up to limit jobs of an alert published after it was last sent and until
+// the given time, newest first.
+func (r *Repository) ListNewJobs(ctx context.Context, alert *Alert, until time.Time, limit int) ([]*Job, error) {
+	rows, err := r.db.Query(ctx, listNewJobsQuery, alert.Query, alert.LastSentAt, until,
+		alert.ExperienceLevel, alert.EmploymentType, alert.Location, alert.WorkMode, limit, alert.Country)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list new jobs of job alert: %w", err)
+	}
+	defer rows.Close()
+
+	var jobs []*Job
+	for rows.Next() {
+		job := &Job{}
+		err = rows.Scan(
+			&job.ID,
+			&job.Title,
+			&job.CompanyName,
+			&job.Location,
+			&job.WorkMode,
+			&job.ExperienceLevel,
+			&job.ApplicationURL,
+			&job.PublishedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan new job row: %w", err)
+		}
+		jobs = append(jobs, job)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating new job rows: %w", err)
+	}
+
+	return jobs, nil
+}
+
+// MarkSent records the alerts as sent at the given time, the jobs published later being the new ones.
+func (r *Repository) MarkSent(ctx context.Context, ids []int, at time.Time) error {
+	if _, err := r.db.Exec(ctx, markSentQuery, ids, at); err != nil {
+		return fmt.Errorf("failed to mark job alerts as sent: %w", err)
+	}
+	return nil
+}
+
+// scanAlert scans an alert from a row of alertColumns
+func scanAlert(row pgx.Row) (*Alert, error) {
+	alert := &Alert{}
+	if err := row.Scan(alertDest(alert)...); err != nil {
+		return nil, err
+	}
+	return alert, nil
+}
+
+// alertDest returns the scan destinations of alertColumns
+func alertDest(alert *Alert) []any {
+	return []any{
+		&alert.ID,
+		&alert.UserID,
+		&alert.Query,
+		&alert.ExperienceLevel,
+		&alert.EmploymentType,
+		&alert.Location,
+		&alert.WorkMode,
+		&alert.Country,
+		&alert.Frequency,
+		&alert.Timezone,
+		&alert.QuietHoursStart,
+		&alert.QuietHoursEnd,
+		&alert.UnsubscribeToken,
+		&alert.LastSentAt,
+		&alert.UnsubscribedAt,
+		&alert.CreatedAt,
+		&alert.UpdatedAt,
+	}
+}
@@ -0,0 +1,178 @@
+package alerts
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+var alertRowColumns = []string{"id", "user_id", "query", "experience_level", "employment_type", "location",
+	"work_mode", "country", "frequency", "timezone", "quiet_hours_start", "quiet_hours_end", "unsubscribe_token",
+	"last_sent_at", "unsubscribed_at", "created_at", "updated_at"}
+
+func TestRepository_Update(t *testing.T) {
+	t.Parallel()
+	senior := "Senior"
+	alert := &Alert{ID: 5, UserID: 12, Query: "golang", ExperienceLevel: &senior, Frequency: FrequencyWeekly,
+		Timezone: DefaultTimezone, QuietHoursStart: hour(22), QuietHoursEnd: hour(7)}
+	args := []any{5, 12, "golang", &senior, (*string)(nil), (*string)(nil), (*string)(nil), FrequencyWeekly,
+		DefaultTimezone, alert.QuietHoursStart, alert.QuietHoursEnd}
+
+	tests := []struct {
+		name      string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		checkErr  func(t *testing.T, err error)
+	}{
+		{
+			name: "alert of the user",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(regexp.QuoteMeta(updateAlertQuery)).WithArgs(args...).
+					WillReturnRows(pgxmock.NewRows(alertRowColumns).AddRow(5, 12, "golang", &senior, nil, nil, nil,
+						"CR", FrequencyWeekly, DefaultTimezone, hour(22), hour(7), "token", testNow, nil, testNow, testNow))
+			},
+			checkErr: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "alert of another user",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(regexp.QuoteMeta(updateAlertQuery)).WithArgs(args...).
+					WillReturnRows(pgxmock.NewRows(alertRowColumns))
+			},
+			checkErr: func(t *testing.T, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, httpservice.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+			tt.mockSetup(mockDB)
+
+			updated := *alert
+			tt.checkErr(t, NewRepository(mockDB).Update(context.Background(), &updated))
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_Unsubscribe(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		wantErr   error
+	}{
+		{
+			name: "token of an alert",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(regexp.QuoteMeta(unsubscribeQuery)).WithArgs("token").
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+		},
+		{
+			name: "token of no alert",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(regexp.QuoteMeta(unsubscribeQuery)).WithArgs("token").
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+			wantErr: ErrInvalidUnsubscribeToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+			tt.mockSetup(mockDB)
+
+			err = NewRepository(mockDB).Unsubscribe(context.Background(), "token")
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_ListNewJobs(t *testing.T) {
+	t.Parallel()
+	remote := "Remote"
+	alert := &Alert{Query: "golang", WorkMode: &remote, Country: "PA", LastSentAt: testNow.Add(-24 * time.Hour)}
+	dbError := errors.New("database error")
+	columns := []string{"id", "title", "company_name", "location", "work_mode", "experience_level",
+		"application_url", "published_at"}
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, jobs []*Job, err error)
+	}{
+		{
+			name: "jobs published since the alert was last sent",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(regexp.QuoteMeta(listNewJobsQuery)).
+					WithArgs("golang", alert.LastSentAt, testNow, (*string)(nil), (*string)(nil), (*string)(nil),
+						&remote, MaxJobsPerAlert, "PA").
+					WillReturnRows(pgxmock.NewRows(columns).AddRow(1, "Go Developer", "Tech Corp", "San José",
+						"Remote", "Senior", "https://techcorp.com/jobs/1", testNow))
+			},
+			checkResults: func(t *testing.T, jobs []*Job, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, jobs, 1)
+				assert.Equal(t, "Tech Corp", jobs[0].CompanyName)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(regexp.QuoteMeta(listNewJobsQuery)).
+					WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
+						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ []*Job, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+			tt.mockSetup(mockDB)
+
+			jobs, err := NewRepository(mockDB).ListNewJobs(context.Background(), alert, testNow, MaxJobsPerAlert)
+			tt.checkResults(t, jobs, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package alerts
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// unsubscribeTokenBytes is the random bytes of an unsubscribe token, hex encoded
+const unsubscribeTokenBytes = 32
+
+// DataRepository interface to make database operations for alerts.
+type DataRepository interface {
+	Create(ctx context.Context, alert *Alert) error
+	CountByUser(ctx context.Context, userID int) (int, error)
+	ListByUser(ctx context.Context, userID int) ([]*Alert, error)
+	Update(ctx context.Context, alert *Alert) error
+	Delete(ctx context.Context, userID, id int) error
+	Unsubscribe(ctx context.Context, token string) error
+}
+
+// AlertService holds the business logic for the alerts of users.
+type AlertService struct {
+	repo DataRepository
+}
+
+// NewAlertService creates a new instance of AlertService.
+func NewAlertService(repo DataRepository) *AlertService {
+	return &AlertService{repo: repo}
+}
+
+// List retrieves the alerts of a user, oldest first.
+func (s *AlertService) List(ctx context.Context, userID int) ([]*Alert, error) {
+	return s.repo.ListByUser(ctx, userID)
+}
+
+// Create saves a new alert of its user, daily, in DefaultTimezone and for httpservice.DefaultCountry
+// unless set. ErrTooManyAlerts is returned when the user already has MaxAlertsPerUser alerts.
+func (s *AlertService) Create(ctx context.Context, alert *Alert) error {
+	if alert.Frequency == "" {
+		alert.Frequency = FrequencyDaily
+	}
+	if alert.Timezone == "" {
+		alert.Timezone = DefaultTimezone
+	}
+	if alert.Country == "" {
+		alert.Country = httpservice.DefaultCountry
+	}
+	if err := validateAlert(alert); err != nil {
+		return err
+	}
+
+	count, err := s.repo.CountByUser(ctx, alert.UserID)
+	if err != nil {
+		return err
+	}
+	if count >= MaxAlertsPerUser {
+		return ErrTooManyAlerts
+	}
+
+	token, err := newUnsubscribeToken()
+	if err != nil {
+		return err
+	}
+	alert.UnsubscribeToken = token
+
+	return s.repo.Create(ctx, alert)
+}
+
+// Update replaces the search and the preferences of an alert of its user, like Create does, and
+// subscribes it again if it was unsubscribed.
+func (s *AlertService) Update(ctx context.Context, alert *Alert) error {
+	if alert.Frequency == "" {
+		alert.Frequency = FrequencyDaily
+	}
+	if alert.Timezone == "" {
+		alert.Timezone = DefaultTimezone
+	}
+	if err := validateAlert(alert); err != nil {
+		return err
+	}
+
+	return s.repo.Update(ctx, alert)
+}
+
+// Delete removes an alert of a user.
+func (s *AlertService) Delete(ctx context.Context, userID, id int) error {
+	return s.repo.Delete(ctx, userID, id)
+}
+
+// Unsubscribe stops the alert of the token of an unsubscribe link, without being logged in.
+// ErrInvalidUnsubscribeToken is returned when no alert has the token.
+func (s *AlertService) Unsubscribe(ctx context.Context, token string) error {
+	if len(token) != 2*unsubscribeTokenBytes {
+		return ErrInvalidUnsubscribeToken
+	}
+	if _, err := hex.DecodeString(token); err != nil {
+		return ErrInvalidUnsubscribeToken
+	}
+
+	return s.repo.Unsubscribe(ctx, strings.ToLower(token))
+}
+
+// validateAlert checks the preferences of an alert the request binding can't
+func validateAlert(alert *Alert) error {
+	var errs []string
+
+	if !alert.Frequency.IsValid() {
+		errs = append(errs, "frequency must be one of instant, daily or weekly")
+	}
+	if _, err := time.LoadLocation(alert.Timezone); err != nil || alert.Timezone == "Local" {
+		errs = append(errs, "unknown timezone: "+alert.Timezone)
+	}
+
+	start, end := alert.QuietHoursStart, alert.QuietHoursEnd
+	switch {
+	case (start == nil) != (end == nil):
+		errs = append(errs, "quiet_hours_start and quiet_hours_end must be set together")
+	case start != nil && *start == *end:
+		errs = append(errs, "quiet_hours_start and quiet_hours_end must differ")
+	}
+
+	if len(errs) > 0 {
+		return &httpservice.ValidationError{Errors: errs}
+	}
+
+	return nil
+}
+
+// newUnsubscribeToken generates a random hex unsubscribe token
+func newUnsubscribeToken() (string, error) {
+	b := make([]byte, unsubscribeTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate unsubscribe token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
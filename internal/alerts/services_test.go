@@ -0,0 +1,205 @@
+package alerts
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+func TestAlertService_Create(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		alert        *Alert
+		mockSetup    func(mockRepo *MockDataRepository)
+		checkResults func(t *testing.T, alert *Alert, err error)
+	}{
+		{
+			name:  "daily alert in the default timezone and country with an unsubscribe token",
+			alert: &Alert{UserID: 12, Query: "golang"},
+			mockSetup: func(mockRepo *MockDataRepository) {
+				mockRepo.EXPECT().CountByUser(context.Background(), 12).Return(MaxAlertsPerUser-1, nil).Once()
+				mockRepo.EXPECT().Create(context.Background(), mock.MatchedBy(func(alert *Alert) bool {
+					return alert.Frequency == FrequencyDaily && alert.Timezone == DefaultTimezone &&
+						alert.Country == httpservice.DefaultCountry && len(alert.UnsubscribeToken) == 64
+				})).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, _ *Alert, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "instant alert with quiet hours wrapping midnight",
+			alert: &Alert{UserID: 12, Query: "golang", Frequency: FrequencyInstant, Timezone: "Europe/Madrid",
+				QuietHoursStart: hour(22), QuietHoursEnd: hour(7)},
+			mockSetup: func(mockRepo *MockDataRepository) {
+				mockRepo.EXPECT().CountByUser(context.Background(), 12).Return(0, nil).Once()
+				mockRepo.EXPECT().Create(context.Background(), mock.Anything).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, alert *Alert, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, FrequencyInstant, alert.Frequency)
+				assert.Equal(t, "Europe/Madrid", alert.Timezone)
+			},
+		},
+		{
+			name: "invalid preferences",
+			alert: &Alert{UserID: 12, Query: "golang", Frequency: "hourly", Timezone: "Mars/Olympus_Mons",
+				QuietHoursStart: hour(22)},
+			mockSetup: func(_ *MockDataRepository) {},
+			checkResults: func(t *testing.T, _ *Alert, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Equal(t, []string{
+					"frequency must be one of instant, daily or weekly",
+					"unknown timezone: Mars/Olympus_Mons",
+					"quiet_hours_start and quiet_hours_end must be set together",
+				}, validationErr.Errors)
+			},
+		},
+		{
+			name:      "empty quiet hours",
+			alert:     &Alert{UserID: 12, Query: "golang", QuietHoursStart: hour(7), QuietHoursEnd: hour(7)},
+			mockSetup: func(_ *MockDataRepository) {},
+			checkResults: func(t *testing.T, _ *Alert, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Equal(t, []string{"quiet_hours_start and quiet_hours_end must differ"}, validationErr.Errors)
+			},
+		},
+		{
+			name:  "too many alerts",
+			alert: &Alert{UserID: 12, Query: "golang"},
+			mockSetup: func(mockRepo *MockDataRepository) {
+				mockRepo.EXPECT().CountByUser(context.Background(), 12).Return(MaxAlertsPerUser, nil).Once()
+			},
+			checkResults: func(t *testing.T, _ *Alert, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, ErrTooManyAlerts)
+				require.ErrorIs(t, err, httpservice.ErrConflict)
+			},
+		},
+		{
+			name:  "database error",
+			alert: &Alert{UserID: 12, Query: "golang"},
+			mockSetup: func(mockRepo *MockDataRepository) {
+				mockRepo.EXPECT().CountByUser(context.Background(), 12).Return(0, dbError).Once()
+			},
+			checkResults: func(t *testing.T, _ *Alert, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			tt.mockSetup(mockRepo)
+
+			err := NewAlertService(mockRepo).Create(context.Background(), tt.alert)
+			tt.checkResults(t, tt.alert, err)
+		})
+	}
+}
+
+func TestAlertService_Update(t *testing.T) {
+	t.Parallel()
+
+	t.Run("preferences defaulted and validated", func(t *testing.T) {
+		t.Parallel()
+		mockRepo := NewMockDataRepository(t)
+		mockRepo.EXPECT().Update(context.Background(), mock.MatchedBy(func(alert *Alert) bool {
+			return alert.ID == 5 && alert.Frequency == FrequencyDaily && alert.Timezone == DefaultTimezone
+		})).Return(nil).Once()
+
+		require.NoError(t, NewAlertService(mockRepo).Update(context.Background(), &Alert{ID: 5, UserID: 12}))
+	})
+
+	t.Run("alert of another user", func(t *testing.T) {
+		t.Parallel()
+		mockRepo := NewMockDataRepository(t)
+		mockRepo.EXPECT().Update(context.Background(), mock.Anything).Return(&AlertNotFoundError{ID: 5}).Once()
+
+		err := NewAlertService(mockRepo).Update(context.Background(), &Alert{ID: 5, UserID: 12})
+		require.ErrorIs(t, err, httpservice.ErrNotFound)
+	})
+
+	t.Run("invalid timezone", func(t *testing.T) {
+		t.Parallel()
+		err := NewAlertService(NewMockDataRepository(t)).Update(context.Background(),
+			&Alert{ID: 5, UserID: 12, Timezone: "Local"})
+		var validationErr *httpservice.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+}
+
+func TestAlertService_Unsubscribe(t *testing.T) {
+	t.Parallel()
+	token := strings.Repeat("ab", unsubscribeTokenBytes)
+
+	tests := []struct {
+		name      string
+		token     string
+		mockSetup func(mockRepo *MockDataRepository)
+		wantErr   error
+	}{
+		{
+			name:  "token of an alert, lowercased",
+			token: strings.ToUpper(token),
+			mockSetup: func(mockRepo *MockDataRepository) {
+				mockRepo.EXPECT().Unsubscribe(context.Background(), token).Return(nil).Once()
+			},
+		},
+		{
+			name:  "token of no alert",
+			token: token,
+			mockSetup: func(mockRepo *MockDataRepository) {
+				mockRepo.EXPECT().Unsubscribe(context.Background(), token).Return(ErrInvalidUnsubscribeToken).Once()
+			},
+			wantErr: ErrInvalidUnsubscribeToken,
+		},
+		{
+			name:      "malformed token not looked up",
+			token:     strings.Repeat("zz", unsubscribeTokenBytes),
+			mockSetup: func(_ *MockDataRepository) {},
+			wantErr:   ErrInvalidUnsubscribeToken,
+		},
+		{
+			name:      "short token not looked up",
+			token:     "abcdef",
+			mockSetup: func(_ *MockDataRepository) {},
+			wantErr:   ErrInvalidUnsubscribeToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			tt.mockSetup(mockRepo)
+
+			err := NewAlertService(mockRepo).Unsubscribe(context.Background(), tt.token)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.ErrorIs(t, err, httpservice.ErrInvalid)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
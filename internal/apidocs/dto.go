@@ -0,0 +1,36 @@
+package apidocs
+
+import (
+	"time"
+)
+
+// DescriptorResponse is the machine-readable descriptor of the API served at /.well-known/api,
+// linking its OpenAPI spec, documentation and versions
+type DescriptorResponse struct {
+	Name        string `json:"name" example:"Job Board API"`
+	Description string `json:"description" example:"A job board API for managing job postings"`
+	Version     string `json:"version" example:"1.0"`
+	// OpenAPIURL is the OpenAPI (Swagger 2.0) spec of the API
+	OpenAPIURL string `json:"openapi_url" example:"/openapi.json"`
+	// DocsURL is the Swagger UI, omitted when it isn't served
+	DocsURL           string             `json:"docs_url,omitempty" example:"/swagger/index.html"`
+	TermsOfServiceURL string             `json:"terms_of_service_url,omitempty" example:"https://example.com/terms"`
+	Contact           *ContactResponse   `json:"contact,omitempty"`
+	Versions          []*VersionResponse `json:"versions"`
+}
+
+// ContactResponse is who to contact about the API
+type ContactResponse struct {
+	Name  string `json:"name,omitempty" example:"API Support"`
+	Email string `json:"email,omitempty" example:"support@example.com"`
+}
+
+// VersionResponse is a version the API is served under
+type VersionResponse struct {
+	Name     string `json:"name" example:"v1"`
+	BasePath string `json:"base_path" example:"/api/v1"`
+	// Deprecation and Sunset are omitted while the version is current
+	Deprecation *time.Time `json:"deprecation,omitempty"`
+	Sunset      *time.Time `json:"sunset,omitempty"`
+	Successor   string     `json:"successor,omitempty" example:"/api/v2"`
+}
@@ -0,0 +1,160 @@
+// Package apidocs publishes the documentation of the API, so external consumers can discover it:
+// the /.well-known/api descriptor, the OpenAPI spec, the Swagger UI and the robots.txt letting
+// crawlers index them but not the API itself.
+package apidocs
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	swaggerFiles "github.com/swaggo/files"
+	ginSwagger "github.com/swaggo/gin-swagger"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// Constants for the documentation routes
+const (
+	DescriptorRoute = "/.well-known/api"
+	SpecRoute       = "/openapi.json"
+	SwaggerRoute    = "/swagger/*any"
+	RobotsRoute     = "/robots.txt"
+
+	swaggerIndexPath = "/swagger/index.html"
+	swaggerSpecPath  = "/doc.json"
+
+	// descriptorCacheControl lets clients and proxies cache the documentation, which only changes
+	// with a deployment
+	descriptorCacheControl = "public, max-age=3600"
+)
+
+// Config configures the documentation served
+type Config struct {
+	// Spec is the OpenAPI spec of the API, as generated by swag
+	Spec string
+	// Versions are the versions the API is served under
+	Versions []httpservice.APIVersion
+	// SwaggerUI serves the Swagger UI at /swagger/index.html
+	SwaggerUI bool
+	// ReadOnly restricts the Swagger UI to the read operations of the public routes, so it is safe
+	// to serve in production
+	ReadOnly bool
+	// TermsOfServiceURL is linked from the descriptor when set
+	TermsOfServiceURL string
+}
+
+// Handler serves the documentation of the API
+type Handler struct {
+	cfg          Config
+	descriptor   *DescriptorResponse
+	swaggerSpec  string
+	swaggerFiles gin.HandlerFunc
+}
+
+// NewHandler creates a new documentation handler, failing when the spec isn't valid
+func NewHandler(cfg Config) (*Handler, error) {
+	info, err := parseSpecInfo(cfg.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	swaggerSpec := cfg.Spec
+	if cfg.ReadOnly {
+		if swaggerSpec, err = readOnlySpec(cfg.Spec); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Handler{
+		cfg:          cfg,
+		descriptor:   newDescriptor(info, &cfg),
+		swaggerSpec:  swaggerSpec,
+		swaggerFiles: ginSwagger.WrapHandler(swaggerFiles.Handler),
+	}, nil
+}
+
+// RegisterRoutes registers the documentation routes with the given router
+func (h *Handler) RegisterRoutes(r gin.IRoutes) {
+	r.GET(DescriptorRoute, h.Descriptor)
+	r.GET(SpecRoute, h.Spec)
+	r.GET(RobotsRoute, h.Robots)
+	if h.cfg.SwaggerUI {
+		r.GET(SwaggerRoute, h.SwaggerUI)
+	}
+}
+
+// Descriptor serves the machine-readable descriptor of the API
+func (h *Handler) Descriptor(c *gin.Context) {
+	c.Header("Cache-Control", descriptorCacheControl)
+	c.JSON(http.StatusOK, h.descriptor)
+}
+
+// Spec serves the raw OpenAPI spec, so clients can generate their code from it
+func (h *Handler) Spec(c *gin.Context) {
+	c.Data(http.StatusOK, "application/json; charset=utf-8", []byte(h.cfg.Spec))
+}
+
+// Robots serves the robots.txt letting crawlers index the documentation but not the API, whose
+// searches would load the database
+func (h *Handler) Robots(c *gin.Context) {
+	var robots strings.Builder
+	robots.WriteString("User-agent: *\n")
+	robots.WriteString("Allow: " + DescriptorRoute + "\n")
+	robots.WriteString("Allow: " + SpecRoute + "\n")
+	if h.cfg.SwaggerUI {
+		robots.WriteString("Allow: /swagger/\n")
+	}
+	robots.WriteString("Disallow: /\n")
+
+	c.Header("Cache-Control", descriptorCacheControl)
+	c.Data(http.StatusOK, "text/plain; charset=utf-8", []byte(robots.String()))
+}
+
+// SwaggerUI serves the Swagger UI, showing the read-only spec when the docs are read-only
+func (h *Handler) SwaggerUI(c *gin.Context) {
+	if c.Param("any") == swaggerSpecPath {
+		c.Data(http.StatusOK, "application/json; charset=utf-8", []byte(h.swaggerSpec))
+		return
+	}
+	h.swaggerFiles(c)
+}
+
+// newDescriptor returns the descriptor of the API with the general information of its spec
+func newDescriptor(info *specInfo, cfg *Config) *DescriptorResponse {
+	descriptor := &DescriptorResponse{
+		Name:              info.Title,
+		Description:       info.Description,
+		Version:           info.Version,
+		OpenAPIURL:        SpecRoute,
+		TermsOfServiceURL: cfg.TermsOfServiceURL,
+		Versions:          make([]*VersionResponse, len(cfg.Versions)),
+	}
+	if cfg.SwaggerUI {
+		descriptor.DocsURL = swaggerIndexPath
+	}
+	if info.Contact != nil {
+		descriptor.Contact = &ContactResponse{Name: info.Contact.Name, Email: info.Contact.Email}
+	}
+
+	for i := range cfg.Versions {
+		version := &cfg.Versions[i]
+		descriptor.Versions[i] = &VersionResponse{
+			Name:        version.Name,
+			BasePath:    version.Path(),
+			Deprecation: timeOrNil(version.Deprecation),
+			Sunset:      timeOrNil(version.Sunset),
+			Successor:   version.Successor,
+		}
+	}
+	return descriptor
+}
+
+// timeOrNil returns a pointer to t, nil when t is zero
+func timeOrNil(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
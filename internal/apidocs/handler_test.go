@@ -0,0 +1,185 @@
+package apidocs
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// testSpec is an OpenAPI spec with public, user and admin routes
+const testSpec = `{
+	"swagger": "2.0",
+	"info": {"title": "Job Board API", "description": "A job board API", "version": "1.0",
+		"contact": {"name": "API Support", "email": "support@example.com"}},
+	"host": "localhost:8080",
+	"basePath": "/api/v1",
+	"paths": {
+		"/jobs": {"get": {"summary": "Search for jobs"}},
+		"/me/bookmarks": {
+			"get": {"summary": "List bookmarks", "security": [{"BearerAuth": []}]},
+			"post": {"summary": "Bookmark a job", "security": [{"BearerAuth": []}]}
+		},
+		"/auth/login": {"post": {"summary": "Sign in"}},
+		"/admin/jobs": {"get": {"summary": "List jobs by status", "security": [{"AdminAPIKey": []}]}},
+		"/ingest/jobs": {"post": {"summary": "Ingest jobs", "security": [{"IngestAPIKey": []}]}}
+	},
+	"securityDefinitions": {
+		"AdminAPIKey": {"type": "apiKey", "name": "X-API-Key", "in": "header"},
+		"BearerAuth": {"type": "apiKey", "name": "Authorization", "in": "header"},
+		"IngestAPIKey": {"type": "apiKey", "name": "X-API-Key", "in": "header"}
+	}
+}`
+
+func newTestRouter(t *testing.T, cfg Config) *gin.Engine {
+	t.Helper()
+	handler, err := NewHandler(cfg)
+	require.NoError(t, err)
+
+	router := gin.New()
+	handler.RegisterRoutes(router)
+	return router
+}
+
+func serve(router *gin.Engine, path string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, http.NoBody))
+	return recorder
+}
+
+func TestHandler_Descriptor(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+	sunset := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	router := newTestRouter(t, Config{
+		Spec: testSpec,
+		Versions: []httpservice.APIVersion{
+			{Name: "v1", Deprecation: sunset.AddDate(0, -6, 0), Sunset: sunset, Successor: "/api/v2"},
+			{Name: "v2"},
+		},
+		SwaggerUI:         true,
+		TermsOfServiceURL: "https://example.com/terms",
+	})
+
+	recorder := serve(router, DescriptorRoute)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, descriptorCacheControl, recorder.Header().Get("Cache-Control"))
+	var descriptor DescriptorResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &descriptor))
+	deprecation := sunset.AddDate(0, -6, 0)
+	assert.Equal(t, DescriptorResponse{
+		Name:              "Job Board API",
+		Description:       "A job board API",
+		Version:           "1.0",
+		OpenAPIURL:        SpecRoute,
+		DocsURL:           "/swagger/index.html",
+		TermsOfServiceURL: "https://example.com/terms",
+		Contact:           &ContactResponse{Name: "API Support", Email: "support@example.com"},
+		Versions: []*VersionResponse{
+			{Name: "v1", BasePath: "/api/v1", Deprecation: &deprecation, Sunset: &sunset, Successor: "/api/v2"},
+			{Name: "v2", BasePath: "/api/v2"},
+		},
+	}, descriptor)
+}
+
+func TestHandler_SwaggerUI(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name          string
+		cfg           Config
+		wantUI        bool
+		expectedPaths []string
+	}{
+		{
+			name:   "docs disabled",
+			cfg:    Config{Spec: testSpec},
+			wantUI: false,
+		},
+		{
+			name:          "every route",
+			cfg:           Config{Spec: testSpec, SwaggerUI: true},
+			wantUI:        true,
+			expectedPaths: []string{"/admin/jobs", "/auth/login", "/ingest/jobs", "/jobs", "/me/bookmarks"},
+		},
+		{
+			name:          "read-only",
+			cfg:           Config{Spec: testSpec, SwaggerUI: true, ReadOnly: true},
+			wantUI:        true,
+			expectedPaths: []string{"/jobs", "/me/bookmarks"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			router := newTestRouter(t, tt.cfg)
+
+			index := serve(router, "/swagger/index.html")
+			robots := serve(router, RobotsRoute)
+			descriptor := serve(router, DescriptorRoute)
+			if !tt.wantUI {
+				assert.Equal(t, http.StatusNotFound, index.Code)
+				assert.NotContains(t, robots.Body.String(), "/swagger/")
+				assert.NotContains(t, descriptor.Body.String(), "docs_url")
+				return
+			}
+			assert.Equal(t, http.StatusOK, index.Code)
+			assert.Contains(t, robots.Body.String(), "Allow: /swagger/\n")
+
+			recorder := serve(router, "/swagger/doc.json")
+			require.Equal(t, http.StatusOK, recorder.Code)
+			var spec struct {
+				Host                string                    `json:"host"`
+				Paths               map[string]map[string]any `json:"paths"`
+				SecurityDefinitions map[string]any            `json:"securityDefinitions"`
+			}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &spec))
+			assert.ElementsMatch(t, tt.expectedPaths, keys(spec.Paths))
+			if tt.cfg.ReadOnly {
+				assert.Empty(t, spec.Host)
+				assert.Equal(t, []string{"get"}, keys(spec.Paths["/me/bookmarks"]))
+				assert.Equal(t, []string{"BearerAuth"}, keys(spec.SecurityDefinitions))
+			}
+		})
+	}
+}
+
+func TestHandler_SpecAndRobots(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+	router := newTestRouter(t, Config{Spec: testSpec, SwaggerUI: true, ReadOnly: true})
+
+	spec := serve(router, SpecRoute)
+	assert.Equal(t, http.StatusOK, spec.Code)
+	assert.JSONEq(t, testSpec, spec.Body.String())
+
+	robots := serve(router, RobotsRoute)
+	assert.Equal(t, http.StatusOK, robots.Code)
+	assert.Equal(t, "User-agent: *\nAllow: /.well-known/api\nAllow: /openapi.json\nAllow: /swagger/\nDisallow: /\n",
+		robots.Body.String())
+}
+
+func TestNewHandler_InvalidSpec(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewHandler(Config{Spec: "not json"})
+	assert.Error(t, err)
+}
+
+func keys[V any](m map[string]V) []string {
+	result := make([]string, 0, len(m))
+	for key := range m {
+		result = append(result, key)
+	}
+	return result
+}
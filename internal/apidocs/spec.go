@@ -0,0 +1,126 @@
+package apidocs
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// privatePathPrefixes are the paths of the routes restricted to the admins and the scrapers
+var privatePathPrefixes = []string{"/admin", "/ingest"}
+
+// specInfo is the general information of an OpenAPI spec
+type specInfo struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Version     string `json:"version"`
+	Contact     *struct {
+		Name  string `json:"name"`
+		Email string `json:"email"`
+	} `json:"contact"`
+}
+
+// parseSpecInfo returns the general information of an OpenAPI spec
+func parseSpecInfo(spec string) (*specInfo, error) {
+	var doc struct {
+		Info specInfo `json:"info"`
+	}
+	if err := json.Unmarshal([]byte(spec), &doc); err != nil {
+		return nil, fmt.Errorf("parse OpenAPI spec: %w", err)
+	}
+	return &doc.Info, nil
+}
+
+// readOnlySpec returns the OpenAPI spec restricted to the read operations of the public routes, so
+// requests tried out from the Swagger UI can't change anything. The security definitions nothing
+// uses anymore are dropped, and so is the host, so requests go to the server serving the docs.
+func readOnlySpec(spec string) (string, error) {
+	var doc map[string]any
+	if err := json.Unmarshal([]byte(spec), &doc); err != nil {
+		return "", fmt.Errorf("parse OpenAPI spec: %w", err)
+	}
+
+	paths, _ := doc["paths"].(map[string]any)
+	used := make(map[string]bool)
+	for path, item := range paths {
+		operations, _ := item.(map[string]any)
+		if isPrivatePath(path) {
+			delete(paths, path)
+			continue
+		}
+		for method, operation := range operations {
+			if !isOperation(method) {
+				continue
+			}
+			if !strings.EqualFold(method, http.MethodGet) {
+				delete(operations, method)
+				continue
+			}
+			for _, name := range securityNames(operation) {
+				used[name] = true
+			}
+		}
+		if !hasOperation(operations) {
+			delete(paths, path)
+		}
+	}
+
+	definitions, _ := doc["securityDefinitions"].(map[string]any)
+	for name := range definitions {
+		if !used[name] {
+			delete(definitions, name)
+		}
+	}
+	delete(doc, "host")
+
+	readOnly, err := json.Marshal(doc)
+	if err != nil {
+		return "", fmt.Errorf("encode OpenAPI spec: %w", err)
+	}
+	return string(readOnly), nil
+}
+
+// isPrivatePath reports whether path is a route restricted to the admins or the scrapers
+func isPrivatePath(path string) bool {
+	for _, prefix := range privatePathPrefixes {
+		if path == prefix || strings.HasPrefix(path, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// isOperation reports whether key of a path item is an HTTP method rather than, e.g., its parameters
+func isOperation(key string) bool {
+	switch strings.ToUpper(key) {
+	case http.MethodGet, http.MethodPut, http.MethodPost, http.MethodDelete, http.MethodOptions, http.MethodHead,
+		http.MethodPatch:
+		return true
+	}
+	return false
+}
+
+// hasOperation reports whether a path item has operations left
+func hasOperation(item map[string]any) bool {
+	for key := range item {
+		if isOperation(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// securityNames returns the names of the security definitions an operation requires
+func securityNames(operation any) []string {
+	op, _ := operation.(map[string]any)
+	requirements, _ := op["security"].([]any)
+	var names []string
+	for _, requirement := range requirements {
+		schemes, _ := requirement.(map[string]any)
+		for name := range schemes {
+			names = append(names, name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,56 @@
+// Package assets stores the images shown on the job board, such as company logos, in
+// S3-compatible object storage (AWS S3, GCS interoperability, MinIO, R2). Images are
+// downloaded from their source, validated, resized and served from a stable public URL,
+// so the board doesn't hotlink third-party sites.
+package assets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// DefaultRegion is used when no storage region is configured
+const DefaultRegion = "us-east-1"
+
+// Config holds the object storage settings. Storage is only used when configured.
+type Config struct {
+	// Endpoint is the storage API URL, e.g. https://s3.us-east-1.amazonaws.com or https://storage.googleapis.com
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// PublicURL is the base URL the stored objects are served from, usually a CDN in front of
+	// the bucket. Defaults to the bucket URL.
+	PublicURL string
+}
+
+// Enabled reports whether the object storage is configured
+func (c *Config) Enabled() bool {
+	return c.Endpoint != "" && c.Bucket != ""
+}
+
+// BaseURL returns the URL the stored objects are served from, without a trailing slash
+func (c *Config) BaseURL() string {
+	if c.PublicURL != "" {
+		return strings.TrimRight(c.PublicURL, "/")
+	}
+	return strings.TrimRight(c.Endpoint, "/") + "/" + c.Bucket
+}
+
+// Storage interface to store objects under a key.
+type Storage interface {
+	Put(ctx context.Context, key, contentType string, body []byte) error
+}
+
+// ImageError represents an image that can't be used, e.g. its source is unreachable
+// or it isn't a supported image
+type ImageError struct {
+	URL    string
+	Reason string
+}
+
+func (e *ImageError) Error() string {
+	return fmt.Sprintf("invalid image %s: %s", e.URL, e.Reason)
+}
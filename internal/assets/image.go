@@ -0,0 +1,107 @@
+package assets
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/gif"  // Register the GIF decoder
+	_ "image/jpeg" // Register the JPEG decoder
+	"image/png"
+)
+
+// Image limits
+const (
+	// MaxLogoSize is the width and height logos are scaled down to fit in
+	MaxLogoSize = 256
+	// MaxSourcePixels rejects huge images before they are decoded
+	MaxSourcePixels = 4096 * 4096
+)
+
+// placeholderColor is the background of the placeholder logo
+var placeholderColor = color.RGBA{R: 0xe5, G: 0xe7, B: 0xeb, A: 0xff}
+
+// normalizeLogo decodes a PNG, JPEG or GIF image, scales it down to fit in MaxLogoSize
+// keeping its aspect ratio, and encodes it as PNG. Smaller images keep their size.
+func normalizeLogo(data []byte) ([]byte, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("unsupported image format: %w", err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > MaxSourcePixels {
+		return nil, fmt.Errorf("unsupported %s image size %dx%d", format, cfg.Width, cfg.Height)
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s image: %w", format, err)
+	}
+
+	width, height := fitSize(cfg.Width, cfg.Height, MaxLogoSize)
+	return encodePNG(resize(src, width, height))
+}
+
+// placeholderLogo returns the PNG logo used when a company logo can't be stored
+func placeholderLogo() ([]byte, error) {
+	img := image.NewRGBA(image.Rect(0, 0, MaxLogoSize, MaxLogoSize))
+	draw.Draw(img, img.Bounds(), &image.Uniform{C: placeholderColor}, image.Point{}, draw.Src)
+	return encodePNG(img)
+}
+
+// fitSize returns the size of a width x height image scaled down to fit in a square of side maxSize
+func fitSize(width, height, maxSize int) (int, int) {
+	if width <= maxSize && height <= maxSize {
+		return width, height
+	}
+	if width >= height {
+		return maxSize, max(1, height*maxSize/width)
+	}
+	return max(1, width*maxSize/height), maxSize
+}
+
+// resize scales src to width x height, averaging the source pixels covered by each target
+// pixel (box filter). Colors are averaged premultiplied so transparent pixels don't bleed.
+func resize(src image.Image, width, height int) *image.RGBA {
+	bounds := src.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), src, bounds.Min, draw.Src)
+	if bounds.Dx() == width && bounds.Dy() == height {
+		return rgba
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := range height {
+		y0, y1 := y*bounds.Dy()/height, max((y+1)*bounds.Dy()/height, y*bounds.Dy()/height+1)
+		for x := range width {
+			x0, x1 := x*bounds.Dx()/width, max((x+1)*bounds.Dx()/width, x*bounds.Dx()/width+1)
+			dst.SetRGBA(x, y, averageColor(rgba, x0, y0, x1, y1))
+		}
+	}
+	return dst
+}
+
+// averageColor returns the average color of the img pixels in [x0, x1) x [y0, y1)
+func averageColor(img *image.RGBA, x0, y0, x1, y1 int) color.RGBA {
+	var r, g, b, a, n uint32
+	for y := y0; y < y1; y++ {
+		for x := x0; x < x1; x++ {
+			c := img.RGBAAt(x, y)
+			r += uint32(c.R)
+			g += uint32(c.G)
+			b += uint32(c.B)
+			a += uint32(c.A)
+			n++
+		}
+	}
+	return color.RGBA{R: uint8(r / n), G: uint8(g / n), B: uint8(b / n), A: uint8(a / n)}
+}
+
+func encodePNG(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := png.Encoder{CompressionLevel: png.BestCompression}
+	if err := encoder.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("failed to encode png image: %w", err)
+	}
+	return buf.Bytes(), nil
+}
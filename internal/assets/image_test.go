@@ -0,0 +1,133 @@
+package assets
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeLogo(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		data         func(t *testing.T) []byte
+		checkResults func(t *testing.T, logo image.Image, err error)
+	}{
+		{
+			name: "large logo scaled down keeping the aspect ratio",
+			data: func(t *testing.T) []byte {
+				t.Helper()
+				return encodeTestJPEG(t, 1024, 512)
+			},
+			checkResults: func(t *testing.T, logo image.Image, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, image.Rect(0, 0, 256, 128), logo.Bounds())
+				r, g, b, _ := logo.At(10, 10).RGBA()
+				assert.InDelta(t, 0xffff, r, 0x0800)
+				assert.InDelta(t, 0, g, 0x0800)
+				assert.InDelta(t, 0, b, 0x0800)
+			},
+		},
+		{
+			name: "small logo keeps its size",
+			data: func(t *testing.T) []byte {
+				t.Helper()
+				return encodeTestJPEG(t, 64, 32)
+			},
+			checkResults: func(t *testing.T, logo image.Image, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, image.Rect(0, 0, 64, 32), logo.Bounds())
+			},
+		},
+		{
+			name: "not an image",
+			data: func(_ *testing.T) []byte {
+				return []byte("<svg></svg>")
+			},
+			checkResults: func(t *testing.T, _ image.Image, err error) {
+				t.Helper()
+				require.Error(t, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := normalizeLogo(tt.data(t))
+			var logo image.Image
+			if err == nil {
+				var format string
+				logo, format, err = image.Decode(bytes.NewReader(data))
+				require.NoError(t, err)
+				assert.Equal(t, "png", format)
+			}
+			tt.checkResults(t, logo, err)
+		})
+	}
+}
+
+func TestFitSize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		width, height  int
+		expectedWidth  int
+		expectedHeight int
+	}{
+		{100, 50, 100, 50},
+		{512, 512, 256, 256},
+		{1000, 250, 256, 64},
+		{300, 900, 85, 256},
+		{10000, 1, 256, 1},
+	}
+
+	for _, tt := range tests {
+		width, height := fitSize(tt.width, tt.height, MaxLogoSize)
+		assert.Equal(t, tt.expectedWidth, width)
+		assert.Equal(t, tt.expectedHeight, height)
+	}
+}
+
+func TestResize_AveragesPixels(t *testing.T) {
+	t.Parallel()
+
+	// Black and white columns average to grey
+	src := image.NewRGBA(image.Rect(0, 0, 4, 2))
+	for y := range 2 {
+		for x := range 4 {
+			c := color.RGBA{A: 0xff}
+			if x%2 == 1 {
+				c = color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+			}
+			src.SetRGBA(x, y, c)
+		}
+	}
+
+	dst := resize(src, 2, 1)
+	assert.Equal(t, color.RGBA{R: 0x7f, G: 0x7f, B: 0x7f, A: 0xff}, dst.RGBAAt(0, 0))
+	assert.Equal(t, color.RGBA{R: 0x7f, G: 0x7f, B: 0x7f, A: 0xff}, dst.RGBAAt(1, 0))
+}
+
+func encodeTestJPEG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := range height {
+		for x := range width {
+			img.SetRGBA(x, y, color.RGBA{R: 0xff, A: 0xff})
+		}
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, nil))
+	return buf.Bytes()
+}
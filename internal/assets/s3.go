@@ -0,0 +1,164 @@
+package assets
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	neturl "net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultTimeout bounds each request to the object storage
+	DefaultTimeout = 30 * time.Second
+
+	// CacheControl is set on stored objects. Keys are content addressed, so objects never change.
+	CacheControl = "public, max-age=31536000, immutable"
+
+	// maxErrorBodySize bounds how much of an error response is kept in the error message
+	maxErrorBodySize = 512
+
+	signingAlgorithm = "AWS4-HMAC-SHA256"
+	signedHeaders    = "host;x-amz-content-sha256;x-amz-date"
+	amzDateFormat    = "20060102T150405Z"
+)
+
+// StorageError represents a request rejected by the object storage
+type StorageError struct {
+	Key        string
+	StatusCode int
+	Body       string
+}
+
+func (e *StorageError) Error() string {
+	return fmt.Sprintf("storage responded with status %d for %s: %s", e.StatusCode, e.Key, e.Body)
+}
+
+// S3Storage stores objects through the S3 API, signing requests with AWS Signature Version 4.
+// Buckets are addressed by path (endpoint/bucket/key), which every S3-compatible service supports.
+type S3Storage struct {
+	endpoint        *neturl.URL
+	region          string
+	bucket          string
+	accessKeyID     string
+	secretAccessKey string
+	client          *http.Client
+	now             func() time.Time
+}
+
+// NewS3Storage creates a new instance of S3Storage from cfg
+func NewS3Storage(cfg *Config) (*S3Storage, error) {
+	endpoint, err := neturl.Parse(strings.TrimRight(cfg.Endpoint, "/"))
+	if err != nil || endpoint.Host == "" || (endpoint.Scheme != "http" && endpoint.Scheme != "https") {
+		return nil, fmt.Errorf("invalid storage endpoint %q", cfg.Endpoint)
+	}
+	if cfg.Bucket == "" {
+		return nil, errors.New("storage bucket cannot be empty")
+	}
+
+	region := cfg.Region
+	if region == "" {
+		region = DefaultRegion
+	}
+
+	return &S3Storage{
+		endpoint:        endpoint,
+		region:          region,
+		bucket:          cfg.Bucket,
+		accessKeyID:     cfg.AccessKeyID,
+		secretAccessKey: cfg.SecretAccessKey,
+		client:          &http.Client{Timeout: DefaultTimeout},
+		now:             time.Now,
+	}, nil
+}
+
+// Put uploads body under key, replacing any existing object
+func (s *S3Storage) Put(ctx context.Context, key, contentType string, body []byte) error {
+	path := s.objectPath(key)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.endpoint.Scheme+"://"+s.endpoint.Host+path,
+		bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create storage request: %w", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Cache-Control", CacheControl)
+	s.sign(req, path, body)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to store %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return &StorageError{Key: key, StatusCode: resp.StatusCode, Body: string(respBody)}
+	}
+
+	return nil
+}
+
+// objectPath returns the escaped request path of key, e.g. /bucket/logos/a.png
+func (s *S3Storage) objectPath(key string) string {
+	segments := strings.Split(strings.TrimLeft(key, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = neturl.PathEscape(segment)
+	}
+	return s.endpoint.EscapedPath() + "/" + neturl.PathEscape(s.bucket) + "/" + strings.Join(segments, "/")
+}
+
+// sign adds the Signature Version 4 headers to req. Anonymous requests are sent unsigned.
+func (s *S3Storage) sign(req *http.Request, path string, body []byte) {
+	if s.accessKeyID == "" {
+		return
+	}
+
+	now := s.now().UTC()
+	amzDate := now.Format(amzDateFormat)
+	date := amzDate[:8]
+	payloadHash := sha256Hex(body)
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		"", // No query string
+		"host:" + req.URL.Host,
+		"x-amz-content-sha256:" + payloadHash,
+		"x-amz-date:" + amzDate,
+		"",
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + s.region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{signingAlgorithm, amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.secretAccessKey), date)
+	for _, part := range []string{s.region, "s3", "aws4_request"} {
+		signingKey = hmacSHA256(signingKey, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		signingAlgorithm, s.accessKeyID, scope, signedHeaders, signature))
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,139 @@
+package assets
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestS3Storage_Put(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		accessKeyID  string
+		statusCode   int
+		checkRequest func(t *testing.T, r *http.Request)
+		checkResults func(t *testing.T, err error)
+	}{
+		{
+			name:        "object stored",
+			accessKeyID: "AKID",
+			statusCode:  http.StatusOK,
+			checkRequest: func(t *testing.T, r *http.Request) {
+				t.Helper()
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, "/ticos-assets/logos/a.png", r.URL.Path)
+				assert.Equal(t, "image/png", r.Header.Get("Content-Type"))
+				assert.Equal(t, CacheControl, r.Header.Get("Cache-Control"))
+				assert.Equal(t, "20240115T103000Z", r.Header.Get("X-Amz-Date"))
+				assert.Contains(t, r.Header.Get("Authorization"),
+					"AWS4-HMAC-SHA256 Credential=AKID/20240115/us-east-1/s3/aws4_request")
+				body, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.Equal(t, "logo", string(body))
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:       "anonymous request is not signed",
+			statusCode: http.StatusOK,
+			checkRequest: func(t *testing.T, r *http.Request) {
+				t.Helper()
+				assert.Empty(t, r.Header.Get("Authorization"))
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:         "rejected request",
+			accessKeyID:  "AKID",
+			statusCode:   http.StatusForbidden,
+			checkRequest: func(_ *testing.T, _ *http.Request) {},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				var storageErr *StorageError
+				require.ErrorAs(t, err, &storageErr)
+				assert.Equal(t, http.StatusForbidden, storageErr.StatusCode)
+				assert.Equal(t, "logos/a.png", storageErr.Key)
+				assert.Equal(t, "<Error>AccessDenied</Error>", storageErr.Body)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				tt.checkRequest(t, r)
+				w.WriteHeader(tt.statusCode)
+				if tt.statusCode != http.StatusOK {
+					_, _ = w.Write([]byte("<Error>AccessDenied</Error>"))
+				}
+			}))
+			defer server.Close()
+
+			storage, err := NewS3Storage(&Config{
+				Endpoint:        server.URL,
+				Bucket:          "ticos-assets",
+				AccessKeyID:     tt.accessKeyID,
+				SecretAccessKey: "secret",
+			})
+			require.NoError(t, err)
+			storage.now = func() time.Time { return time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC) }
+
+			err = storage.Put(context.Background(), "logos/a.png", "image/png", []byte("logo"))
+			tt.checkResults(t, err)
+		})
+	}
+}
+
+func TestS3Storage_Sign(t *testing.T) {
+	t.Parallel()
+
+	storage, err := NewS3Storage(&Config{
+		Endpoint:        "https://s3.us-east-1.amazonaws.com",
+		Bucket:          "ticos-assets",
+		AccessKeyID:     "AKID",
+		SecretAccessKey: "secret",
+	})
+	require.NoError(t, err)
+	storage.now = func() time.Time { return time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC) }
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPut,
+		"https://s3.us-east-1.amazonaws.com/ticos-assets/logos/a.png", http.NoBody)
+	require.NoError(t, err)
+	storage.sign(req, storage.objectPath("logos/a.png"), []byte("logo"))
+
+	assert.Equal(t, "3598ce6f965b2481fe26316c06b30950c46ac7f8e7229f104aa78f579997668d",
+		req.Header.Get("X-Amz-Content-Sha256"))
+	assert.Equal(t, "AWS4-HMAC-SHA256 Credential=AKID/20240115/us-east-1/s3/aws4_request, "+
+		"SignedHeaders=host;x-amz-content-sha256;x-amz-date, "+
+		"Signature=2936d0a0d0430d1c63fd985bbf2b3e23c15f3df808a9c7fa4c6ec7aa013f3400",
+		req.Header.Get("Authorization"))
+}
+
+func TestNewS3Storage(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewS3Storage(&Config{Endpoint: "s3.amazonaws.com", Bucket: "ticos-assets"})
+	require.Error(t, err)
+
+	_, err = NewS3Storage(&Config{Endpoint: "https://s3.amazonaws.com"})
+	require.Error(t, err)
+
+	storage, err := NewS3Storage(&Config{Endpoint: "https://s3.amazonaws.com/", Bucket: "ticos-assets"})
+	require.NoError(t, err)
+	assert.Equal(t, DefaultRegion, storage.region)
+}
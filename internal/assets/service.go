@@ -0,0 +1,178 @@
+package assets
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	neturl "net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Logo settings
+const (
+	// MaxLogoBytes bounds the size of a downloaded logo
+	MaxLogoBytes = 2 << 20
+	// DownloadTimeout bounds the download of a logo from its source
+	DownloadTimeout = 15 * time.Second
+
+	logoPrefix     = "logos/"
+	placeholderKey = logoPrefix + "placeholder.png"
+	pngContentType = "image/png"
+)
+
+// supportedContentTypes are the logo content types accepted from a source
+var supportedContentTypes = map[string]bool{
+	"image/png":  true,
+	"image/jpeg": true,
+	"image/gif":  true,
+}
+
+// LogoService downloads company logos from their source, validates and resizes them, and
+// stores them under a content addressed key, so their public URL is stable and cacheable.
+type LogoService struct {
+	storage Storage
+	baseURL string
+	client  *http.Client
+
+	// placeholderStored records whether the placeholder was uploaded, it is only uploaded once needed
+	placeholderMu     sync.Mutex
+	placeholderStored bool
+}
+
+// NewLogoService creates a new instance of LogoService storing logos in storage, which serves
+// them from baseURL
+func NewLogoService(storage Storage, baseURL string) *LogoService {
+	return &LogoService{
+		storage: storage,
+		baseURL: strings.TrimRight(baseURL, "/"),
+		client:  &http.Client{Timeout: DownloadTimeout},
+	}
+}
+
+// NewLogoServiceFromConfig creates a LogoService storing logos in the S3-compatible storage of cfg
+func NewLogoServiceFromConfig(cfg *Config) (*LogoService, error) {
+	storage, err := NewS3Storage(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return NewLogoService(storage, cfg.BaseURL()), nil
+}
+
+// StoreLogo stores the logo at sourceURL and returns its public URL. Logos already stored are
+// returned as is. When the source can't be used (unreachable, not a supported image, too large)
+// the placeholder URL is returned along with the ImageError, so callers can still save the company.
+func (s *LogoService) StoreLogo(ctx context.Context, sourceURL string) (string, error) {
+	if s.IsStored(sourceURL) {
+		return sourceURL, nil
+	}
+
+	logo, err := s.fetchLogo(ctx, sourceURL)
+	if err == nil {
+		logo, err = normalizeLogo(logo)
+		if err != nil {
+			err = &ImageError{URL: sourceURL, Reason: err.Error()}
+		}
+	}
+
+	var imageErr *ImageError
+	if errors.As(err, &imageErr) {
+		placeholderURL, placeholderErr := s.placeholder(ctx)
+		if placeholderErr != nil {
+			return "", placeholderErr
+		}
+		return placeholderURL, err
+	}
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(logo)
+	key := logoPrefix + hex.EncodeToString(sum[:16]) + ".png"
+	if err = s.storage.Put(ctx, key, pngContentType, logo); err != nil {
+		return "", err
+	}
+
+	return s.publicURL(key), nil
+}
+
+// PlaceholderURL returns the public URL of the placeholder logo
+func (s *LogoService) PlaceholderURL() string {
+	return s.publicURL(placeholderKey)
+}
+
+// IsStored reports whether url is a logo stored by the service, placeholder included
+func (s *LogoService) IsStored(url string) bool {
+	return strings.HasPrefix(url, s.publicURL(logoPrefix))
+}
+
+// fetchLogo downloads the logo at sourceURL. Unusable sources are returned as an ImageError.
+func (s *LogoService) fetchLogo(ctx context.Context, sourceURL string) ([]byte, error) {
+	parsed, err := neturl.Parse(sourceURL)
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return nil, &ImageError{URL: sourceURL, Reason: "must be an absolute http or https URL"}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, sourceURL, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create logo request: %w", err)
+	}
+	req.Header.Set("Accept", "image/png, image/jpeg, image/gif")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, &ImageError{URL: sourceURL, Reason: "download failed"}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &ImageError{URL: sourceURL, Reason: fmt.Sprintf("source responded with status %d", resp.StatusCode)}
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if !supportedContentTypes[mediaType] {
+		return nil, &ImageError{URL: sourceURL, Reason: fmt.Sprintf("unsupported content type %q", mediaType)}
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, MaxLogoBytes+1))
+	if err != nil {
+		return nil, &ImageError{URL: sourceURL, Reason: "download failed"}
+	}
+	if len(data) > MaxLogoBytes {
+		return nil, &ImageError{URL: sourceURL, Reason: fmt.Sprintf("larger than %d bytes", MaxLogoBytes)}
+	}
+
+	return data, nil
+}
+
+// placeholder uploads the placeholder logo the first time it's used and returns its URL
+func (s *LogoService) placeholder(ctx context.Context) (string, error) {
+	s.placeholderMu.Lock()
+	defer s.placeholderMu.Unlock()
+
+	if !s.placeholderStored {
+		logo, err := placeholderLogo()
+		if err != nil {
+			return "", err
+		}
+		if err = s.storage.Put(ctx, placeholderKey, pngContentType, logo); err != nil {
+			return "", err
+		}
+		s.placeholderStored = true
+	}
+
+	return s.PlaceholderURL(), nil
+}
+
+func (s *LogoService) publicURL(key string) string {
+	return s.baseURL + "/" + key
+}
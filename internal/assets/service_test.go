@@ -0,0 +1,169 @@
+package assets
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testBaseURL = "https://cdn.ticosintech.com"
+
+func TestLogoService_StoreLogo(t *testing.T) {
+	t.Parallel()
+	logo := encodeTestJPEG(t, 512, 512)
+	storageError := errors.New("storage error")
+
+	tests := []struct {
+		name         string
+		path         string
+		contentType  string
+		body         []byte
+		sourceURL    string
+		mockSetup    func(mockStorage *MockStorage)
+		checkResults func(t *testing.T, url string, err error)
+	}{
+		{
+			name:        "logo stored",
+			path:        "/logo.jpg",
+			contentType: "image/jpeg",
+			body:        logo,
+			mockSetup: func(mockStorage *MockStorage) {
+				t.Helper()
+				mockStorage.EXPECT().Put(context.Background(), mock.MatchedBy(func(key string) bool {
+					return strings.HasPrefix(key, "logos/") && strings.HasSuffix(key, ".png")
+				}), "image/png", mock.Anything).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, url string, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Regexp(t, `^https://cdn\.ticosintech\.com/logos/[0-9a-f]{32}\.png$`, url)
+			},
+		},
+		{
+			name:      "stored logo returned as is",
+			sourceURL: testBaseURL + "/logos/0123.png",
+			mockSetup: func(_ *MockStorage) {},
+			checkResults: func(t *testing.T, url string, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, testBaseURL+"/logos/0123.png", url)
+			},
+		},
+		{
+			name:        "missing logo replaced by the placeholder",
+			path:        "/missing.png",
+			contentType: "text/html",
+			mockSetup: func(mockStorage *MockStorage) {
+				t.Helper()
+				mockStorage.EXPECT().Put(context.Background(), "logos/placeholder.png", "image/png", mock.Anything).
+					Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, url string, err error) {
+				t.Helper()
+				var imageErr *ImageError
+				require.ErrorAs(t, err, &imageErr)
+				assert.Contains(t, imageErr.Reason, "status 404")
+				assert.Equal(t, testBaseURL+"/logos/placeholder.png", url)
+			},
+		},
+		{
+			name:        "unsupported content type replaced by the placeholder",
+			path:        "/logo.svg",
+			contentType: "image/svg+xml",
+			body:        []byte("<svg></svg>"),
+			mockSetup: func(mockStorage *MockStorage) {
+				t.Helper()
+				mockStorage.EXPECT().Put(context.Background(), "logos/placeholder.png", "image/png", mock.Anything).
+					Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, url string, err error) {
+				t.Helper()
+				var imageErr *ImageError
+				require.ErrorAs(t, err, &imageErr)
+				assert.Equal(t, testBaseURL+"/logos/placeholder.png", url)
+			},
+		},
+		{
+			name:        "corrupted image replaced by the placeholder",
+			path:        "/logo.png",
+			contentType: "image/png",
+			body:        []byte("not a png"),
+			mockSetup: func(mockStorage *MockStorage) {
+				t.Helper()
+				mockStorage.EXPECT().Put(context.Background(), "logos/placeholder.png", "image/png", mock.Anything).
+					Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, url string, err error) {
+				t.Helper()
+				var imageErr *ImageError
+				require.ErrorAs(t, err, &imageErr)
+				assert.Equal(t, testBaseURL+"/logos/placeholder.png", url)
+			},
+		},
+		{
+			name:        "storage error",
+			path:        "/logo.jpg",
+			contentType: "image/jpeg",
+			body:        logo,
+			mockSetup: func(mockStorage *MockStorage) {
+				t.Helper()
+				mockStorage.EXPECT().Put(context.Background(), mock.Anything, "image/png", mock.Anything).
+					Return(storageError).Once()
+			},
+			checkResults: func(t *testing.T, url string, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, storageError)
+				assert.Empty(t, url)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", tt.contentType)
+				if r.URL.Path != tt.path || tt.body == nil {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, _ = w.Write(tt.body)
+			}))
+			defer server.Close()
+
+			mockStorage := NewMockStorage(t)
+			service := NewLogoService(mockStorage, testBaseURL+"/")
+			tt.mockSetup(mockStorage)
+
+			sourceURL := tt.sourceURL
+			if sourceURL == "" {
+				sourceURL = server.URL + tt.path
+			}
+			url, err := service.StoreLogo(context.Background(), sourceURL)
+			tt.checkResults(t, url, err)
+		})
+	}
+}
+
+func TestLogoService_PlaceholderStoredOnce(t *testing.T) {
+	t.Parallel()
+
+	mockStorage := NewMockStorage(t)
+	mockStorage.EXPECT().Put(context.Background(), "logos/placeholder.png", "image/png", mock.Anything).
+		Return(nil).Once()
+	service := NewLogoService(mockStorage, testBaseURL)
+
+	for range 2 {
+		url, err := service.StoreLogo(context.Background(), "ftp://example.com/logo.png")
+		var imageErr *ImageError
+		require.ErrorAs(t, err, &imageErr)
+		assert.Equal(t, service.PlaceholderURL(), url)
+	}
+}
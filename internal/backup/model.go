@@ -0,0 +1,67 @@
+// Package backup dumps the database to a file with pg_dump and restores it with pg_restore, for
+// the environments without managed database backups. A manifest written next to the dump holds
+// the row counts of the core tables, checked again once the dump is restored.
+package backup
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+)
+
+// CoreTables are the tables whose row counts are checked after a restore
+var CoreTables = []string{
+	"companies",
+	"company_aliases",
+	"technologies",
+	"technology_aliases",
+	"jobs",
+	"job_technologies",
+	"job_revisions",
+	"users",
+	"bookmarks",
+	"applications",
+	"job_alerts",
+}
+
+// ManifestSuffix is appended to the path of a dump to name its manifest
+const ManifestSuffix = ".manifest.json"
+
+// Manifest describes a dump
+type Manifest struct {
+	CreatedAt time.Time `json:"created_at"`
+	// RowCounts holds the number of rows of the core tables when the dump was taken
+	RowCounts map[string]int64 `json:"row_counts"`
+}
+
+// MismatchError is returned when the restored tables don't have the row counts of the manifest
+type MismatchError struct {
+	// Tables maps the mismatched tables to their expected and restored row counts
+	Tables map[string][2]int64
+}
+
+// Error implements the error interface
+func (e *MismatchError) Error() string {
+	mismatches := make([]string, 0, len(e.Tables))
+	for _, table := range slices.Sorted(maps.Keys(e.Tables)) {
+		counts := e.Tables[table]
+		mismatches = append(mismatches, fmt.Sprintf("%s has %d rows, expected %d", table, counts[1], counts[0]))
+	}
+	return "restored row counts don't match the backup: " + strings.Join(mismatches, ", ")
+}
+
+// Verify compares the row counts of the manifest with the restored ones
+func (m *Manifest) Verify(restored map[string]int64) error {
+	mismatched := map[string][2]int64{}
+	for table, expected := range m.RowCounts {
+		if restored[table] != expected {
+			mismatched[table] = [2]int64{expected, restored[table]}
+		}
+	}
+	if len(mismatched) > 0 {
+		return &MismatchError{Tables: mismatched}
+	}
+	return nil
+}
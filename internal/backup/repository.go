@@ -0,0 +1,78 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const exportSnapshotQuery = `SELECT pg_export_snapshot()`
+
+// Database interface to support pgxpool and mocks
+type Database interface {
+	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
+	BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error)
+}
+
+// rowQuerier is the part of Database and pgx.Tx the counts run on
+type rowQuerier interface {
+	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
+}
+
+// Repository handles the database operations of the backups.
+type Repository struct {
+	db Database
+}
+
+// NewRepository creates a new Repository instance.
+func NewRepository(db Database) *Repository {
+	return &Repository{db: db}
+}
+
+// CountRows returns the number of rows of each of the tables
+func (r *Repository) CountRows(ctx context.Context, tables []string) (map[string]int64, error) {
+	return countRows(ctx, r.db, tables)
+}
+
+// Snapshot counts the rows of the tables in a snapshot of the database, then calls fn with the ID
+// of the snapshot while it is held, so pg_dump can dump the same data the counts saw.
+func (r *Repository) Snapshot(
+	ctx context.Context, tables []string, fn func(ctx context.Context, snapshotID string) error,
+) (map[string]int64, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin snapshot transaction: %w", err)
+	}
+	// The transaction only reads, ending it releases the snapshot
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var snapshotID string
+	if err = tx.QueryRow(ctx, exportSnapshotQuery).Scan(&snapshotID); err != nil {
+		return nil, fmt.Errorf("failed to export snapshot: %w", err)
+	}
+
+	counts, err := countRows(ctx, tx, tables)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = fn(ctx, snapshotID); err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+// countRows counts the rows of each of the tables
+func countRows(ctx context.Context, db rowQuerier, tables []string) (map[string]int64, error) {
+	counts := make(map[string]int64, len(tables))
+	for _, table := range tables {
+		var count int64
+		query := "SELECT COUNT(*) FROM " + pgx.Identifier{table}.Sanitize()
+		if err := db.QueryRow(ctx, query).Scan(&count); err != nil {
+			return nil, fmt.Errorf("failed to count rows of %s: %w", table, err)
+		}
+		counts[table] = count
+	}
+	return counts, nil
+}
@@ -0,0 +1,71 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Snapshot(t *testing.T) {
+	t.Parallel()
+	dumpError := errors.New("dump error")
+	txOptions := pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly}
+
+	tests := []struct {
+		name         string
+		fnErr        error
+		checkResults func(t *testing.T, counts map[string]int64, snapshotID string, err error)
+	}{
+		{
+			name: "tables counted in the exported snapshot",
+			checkResults: func(t *testing.T, counts map[string]int64, snapshotID string, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, map[string]int64{"jobs": 120, "companies": 14}, counts)
+				assert.Equal(t, "00000003-0000001B-1", snapshotID)
+			},
+		},
+		{
+			name:  "failing dump",
+			fnErr: dumpError,
+			checkResults: func(t *testing.T, _ map[string]int64, _ string, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dumpError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			mockDB.ExpectBeginTx(txOptions)
+			mockDB.ExpectQuery(regexp.QuoteMeta(exportSnapshotQuery)).
+				WillReturnRows(pgxmock.NewRows([]string{"pg_export_snapshot"}).AddRow("00000003-0000001B-1"))
+			mockDB.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM "jobs"`)).
+				WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(int64(120)))
+			mockDB.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM "companies"`)).
+				WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(int64(14)))
+			mockDB.ExpectRollback()
+
+			var snapshotID string
+			counts, err := NewRepository(mockDB).Snapshot(context.Background(), []string{"jobs", "companies"},
+				func(_ context.Context, id string) error {
+					snapshotID = id
+					return tt.fnErr
+				})
+			tt.checkResults(t, counts, snapshotID, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
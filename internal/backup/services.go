@@ -0,0 +1,122 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+	"time"
+)
+
+// DataRepository interface to read the database being backed up or restored.
+type DataRepository interface {
+	CountRows(ctx context.Context, tables []string) (map[string]int64, error)
+	Snapshot(
+		ctx context.Context, tables []string, fn func(ctx context.Context, snapshotID string) error,
+	) (map[string]int64, error)
+}
+
+// CommandRunner runs an external command with the variables of env added to its environment
+type CommandRunner func(ctx context.Context, env []string, name string, args ...string) error
+
+// BackupService holds the business logic of the backups.
+type BackupService struct {
+	repo DataRepository
+	// dbName and env tell pg_dump and pg_restore which database to connect to, keeping the
+	// password out of their command line
+	dbName string
+	env    []string
+	run    CommandRunner
+	now    func() time.Time
+}
+
+// NewBackupService creates a new instance of BackupService for the database of connString, a
+// PostgreSQL URL or key/value connection string.
+func NewBackupService(repo DataRepository, connString string) *BackupService {
+	dbName, env := splitPassword(connString)
+	return &BackupService{repo: repo, dbName: dbName, env: env, run: runCommand, now: time.Now}
+}
+
+// Backup dumps the database to path in the pg_dump custom format and writes the manifest of the
+// dump next to it. The row counts of the manifest are taken from the data that was dumped.
+func (s *BackupService) Backup(ctx context.Context, path string) (*Manifest, error) {
+	createdAt := s.now().UTC()
+	counts, err := s.repo.Snapshot(ctx, CoreTables, func(ctx context.Context, snapshotID string) error {
+		return s.run(ctx, s.env, "pg_dump", "--format=custom", "--no-owner", "--snapshot="+snapshotID,
+			"--file="+path, "--dbname="+s.dbName)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{CreatedAt: createdAt, RowCounts: counts}
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode backup manifest: %w", err)
+	}
+	if err = os.WriteFile(path+ManifestSuffix, data, 0o600); err != nil {
+		return nil, fmt.Errorf("failed to write backup manifest: %w", err)
+	}
+	return manifest, nil
+}
+
+// Restore replaces the content of the database with the dump at path in a single transaction,
+// then checks the restored tables have the row counts of its manifest, returning a MismatchError
+// when they don't.
+func (s *BackupService) Restore(ctx context.Context, path string) (*Manifest, error) {
+	data, err := os.ReadFile(path + ManifestSuffix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup manifest: %w", err)
+	}
+	manifest := &Manifest{}
+	if err = json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode backup manifest: %w", err)
+	}
+
+	err = s.run(ctx, s.env, "pg_restore", "--clean", "--if-exists", "--no-owner", "--single-transaction",
+		"--exit-on-error", "--dbname="+s.dbName, path)
+	if err != nil {
+		return nil, err
+	}
+
+	restored, err := s.repo.CountRows(ctx, slices.Sorted(maps.Keys(manifest.RowCounts)))
+	if err != nil {
+		return nil, err
+	}
+	return manifest, manifest.Verify(restored)
+}
+
+// splitPassword removes the password from a PostgreSQL URL, returning it as the PGPASSWORD
+// environment variable. Other connection strings are returned unchanged.
+func splitPassword(connString string) (string, []string) {
+	u, err := url.Parse(connString)
+	if err != nil || (u.Scheme != "postgres" && u.Scheme != "postgresql") || u.User == nil {
+		return connString, nil
+	}
+	password, ok := u.User.Password()
+	if !ok {
+		return connString, nil
+	}
+	u.User = url.User(u.User.Username())
+	return u.String(), []string{"PGPASSWORD=" + password}
+}
+
+// runCommand runs a command, returning its output in the error when it fails
+func runCommand(ctx context.Context, env []string, name string, args ...string) error {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Env = append(os.Environ(), env...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return fmt.Errorf("%s not found, install the PostgreSQL client tools: %w", name, err)
+		}
+		return fmt.Errorf("%s failed: %w: %s", name, err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package benefit
+
+// Data Transfer Objects (DTOs) for the benefit API layer.
+
+// BenefitResponse represents the API response for a benefit
+type BenefitResponse struct {
+	Slug string `json:"slug" example:"health-insurance"`
+	Name string `json:"name" example:"Health insurance"`
+}
+
+// ListResponse represents the API response listing benefits
+type ListResponse struct {
+	Data []*BenefitResponse `json:"data"`
+}
+
+// MapBenefitsToResponse converts benefit database models to the list API response format
+func MapBenefitsToResponse(benefits []*Benefit) *ListResponse {
+	data := make([]*BenefitResponse, 0, len(benefits))
+	for _, benefit := range benefits {
+		data = append(data, &BenefitResponse{Slug: benefit.Slug, Name: benefit.Name})
+	}
+	return &ListResponse{Data: data}
+}
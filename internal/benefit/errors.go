@@ -0,0 +1,30 @@
+// Package benefit provides functionality for managing the benefits taxonomy (health insurance,
+// stock options, education budget, ...) and the association of jobs with the benefits they offer.
+package benefit
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// NotFoundError represents a benefit not found error
+type NotFoundError struct {
+	Name string
+}
+
+func (e NotFoundError) Error() string {
+	return fmt.Sprintf("benefit %q not found", e.Name)
+}
+
+// Is matches httpservice.ErrNotFound
+func (e NotFoundError) Is(target error) bool {
+	return target == httpservice.ErrNotFound
+}
+
+// IsNotFound checks if an error is a benefit not found error
+func IsNotFound(err error) bool {
+	var notFoundErr *NotFoundError
+	return errors.As(err, &notFoundErr)
+}
@@ -0,0 +1,45 @@
+package benefit
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Constants for benefit routes and endpoints
+const (
+	BenefitsRoute = "/benefits"
+)
+
+// Handler handles HTTP requests for benefit operations
+type Handler struct {
+	repo *Repository
+}
+
+// NewHandler creates a new benefit handler
+func NewHandler(repo *Repository) *Handler {
+	return &Handler{repo: repo}
+}
+
+// RegisterRoutes registers benefit routes with the given router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.GET(BenefitsRoute, h.ListBenefits)
+}
+
+// ListBenefits godoc
+// @Summary List benefits
+// @Description Lists the benefits whose slugs can be used with the benefits filter of the job search
+// @Tags jobs
+// @Produce json
+// @Success 200 {object} ListResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /benefits [get]
+func (h *Handler) ListBenefits(c *gin.Context) {
+	benefits, err := h.repo.List(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapBenefitsToResponse(benefits))
+}
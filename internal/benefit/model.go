@@ -0,0 +1,15 @@
+package benefit
+
+import (
+	"time"
+)
+
+// Benefit represents a benefit offered with jobs, such as health insurance or stock options.
+type Benefit struct {
+	ID   int    `json:"id" db:"id"`
+	Slug string `json:"slug" db:"slug"`
+	Name string `json:"name" db:"name"`
+	// Aliases are the slugs of other names of the benefit, e.g. "seguro-medico" for health insurance
+	Aliases   []string  `json:"aliases" db:"aliases"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
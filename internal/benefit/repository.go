@@ -0,0 +1,107 @@
+package benefit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/company"
+)
+
+// SQL query constants
+const (
+	listBenefitsQuery = `
+        SELECT id, slug, name, aliases, created_at
+        FROM benefits
+        ORDER BY name
+    `
+
+	getBenefitBySlugQuery = `
+        SELECT id, slug, name, aliases, created_at
+        FROM benefits
+        WHERE slug = $1 OR $1 = ANY(aliases)
+        ORDER BY slug = $1 DESC
+        LIMIT 1
+    `
+
+	assignBenefitQuery = `
+        INSERT INTO job_benefits (job_id, benefit_id)
+        VALUES ($1, $2)
+        ON CONFLICT (job_id, benefit_id) DO NOTHING
+    `
+)
+
+// Database interface to support pgxpool and mocks
+type Database interface {
+	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
+	Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+}
+
+// Repository handles database operations for the Benefit model.
+type Repository struct {
+	db Database
+}
+
+// NewRepository creates a new Repository instance.
+func NewRepository(db Database) *Repository {
+	return &Repository{db: db}
+}
+
+// List retrieves all benefits ordered by name.
+func (r *Repository) List(ctx context.Context) ([]*Benefit, error) {
+	rows, err := r.db.Query(ctx, listBenefitsQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list benefits: %w", err)
+	}
+	defer rows.Close()
+
+	var benefits []*Benefit
+	for rows.Next() {
+		benefit := &Benefit{}
+		if err = rows.Scan(&benefit.ID, &benefit.Slug, &benefit.Name, &benefit.Aliases,
+			&benefit.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan benefit row: %w", err)
+		}
+		benefits = append(benefits, benefit)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating benefit rows: %w", err)
+	}
+
+	return benefits, nil
+}
+
+// GetByName retrieves a benefit by its name or one of its aliases. Names are compared by their
+// slug, so "Seguro Médico" matches the "seguro-medico" alias of health insurance.
+func (r *Repository) GetByName(ctx context.Context, name string) (*Benefit, error) {
+	benefit := &Benefit{}
+	err := r.db.QueryRow(ctx, getBenefitBySlugQuery, company.Slugify(name)).Scan(
+		&benefit.ID,
+		&benefit.Slug,
+		&benefit.Name,
+		&benefit.Aliases,
+		&benefit.CreatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &NotFoundError{Name: name}
+		}
+		return nil, fmt.Errorf("failed to get benefit: %w", err)
+	}
+
+	return benefit, nil
+}
+
+// AssignToJob associates a job with a benefit. Existing associations are left untouched.
+func (r *Repository) AssignToJob(ctx context.Context, jobID, benefitID int) error {
+	if _, err := r.db.Exec(ctx, assignBenefitQuery, jobID, benefitID); err != nil {
+		return fmt.Errorf("failed to assign benefit: %w", err)
+	}
+	return nil
+}
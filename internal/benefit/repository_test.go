@@ -0,0 +1,189 @@
+package benefit
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_List(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, result []*Benefit, err error)
+	}{
+		{
+			name: "benefits found",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(listBenefitsQuery)).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "slug", "name", "aliases", "created_at"}).
+						AddRow(1, "health-insurance", "Health insurance", []string{"seguro-medico"}, now).
+						AddRow(3, "stock-options", "Stock options", []string{}, now))
+			},
+			checkResults: func(t *testing.T, result []*Benefit, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, result, 2)
+				assert.Equal(t, "Health insurance", result[0].Name)
+				assert.Equal(t, []string{"seguro-medico"}, result[0].Aliases)
+				assert.Equal(t, "stock-options", result[1].Slug)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(listBenefitsQuery)).WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ []*Benefit, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			result, err := repo.List(context.Background())
+			tt.checkResults(t, result, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_GetByName(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+
+	tests := []struct {
+		name         string
+		benefitName  string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, result *Benefit, err error)
+	}{
+		{
+			name:        "benefit found by an alias",
+			benefitName: "Seguro Médico",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getBenefitBySlugQuery)).
+					WithArgs("seguro-medico").
+					WillReturnRows(pgxmock.NewRows([]string{"id", "slug", "name", "aliases", "created_at"}).
+						AddRow(1, "health-insurance", "Health insurance", []string{"seguro-medico"}, now))
+			},
+			checkResults: func(t *testing.T, result *Benefit, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, "health-insurance", result.Slug)
+			},
+		},
+		{
+			name:        "benefit not found",
+			benefitName: "Free Ponies",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getBenefitBySlugQuery)).
+					WithArgs("free-ponies").
+					WillReturnError(pgx.ErrNoRows)
+			},
+			checkResults: func(t *testing.T, result *Benefit, err error) {
+				t.Helper()
+				assert.Nil(t, result)
+				assert.True(t, IsNotFound(err))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			result, err := repo.GetByName(context.Background(), tt.benefitName)
+			tt.checkResults(t, result, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_AssignToJob(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, err error)
+	}{
+		{
+			name: "successful assignment",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(assignBenefitQuery)).
+					WithArgs(10, 1).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(assignBenefitQuery)).
+					WithArgs(10, 1).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			err = repo.AssignToJob(context.Background(), 10, 1)
+			tt.checkResults(t, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
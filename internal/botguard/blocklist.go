@@ -0,0 +1,39 @@
+package botguard
+
+import (
+	"net/netip"
+	"slices"
+	"sync/atomic"
+	"time"
+)
+
+// Blocklist holds an in-memory snapshot of the blocked networks, safe for concurrent use. The guard
+// checks the clients against it, which the server reloads whenever the networks change.
+type Blocklist struct {
+	snapshot atomic.Pointer[[]*BlockedNetwork]
+}
+
+// NewBlocklist creates a blocklist blocking nothing until it is replaced
+func NewBlocklist() *Blocklist {
+	b := &Blocklist{}
+	b.snapshot.Store(&[]*BlockedNetwork{})
+	return b
+}
+
+// Replace replaces the snapshot with the given networks
+func (b *Blocklist) Replace(networks []*BlockedNetwork) {
+	snapshot := slices.Clone(networks)
+	b.snapshot.Store(&snapshot)
+}
+
+// Match returns the network blocking addr at now, nil when none does. IPv4 addresses mapped to
+// IPv6 ones match the IPv4 networks.
+func (b *Blocklist) Match(addr netip.Addr, now time.Time) *BlockedNetwork {
+	addr = addr.Unmap()
+	for _, network := range *b.snapshot.Load() {
+		if network.CIDR.Contains(addr) && network.Active(now) {
+			return network
+		}
+	}
+	return nil
+}
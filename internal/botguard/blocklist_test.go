@@ -0,0 +1,31 @@
+package botguard
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlocklist_Match(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	expired := now.Add(-time.Minute)
+	network := &BlockedNetwork{ID: 1, CIDR: netip.MustParsePrefix("203.0.113.0/24")}
+	address := &BlockedNetwork{ID: 2, CIDR: netip.MustParsePrefix("2001:db8::1/128")}
+
+	blocklist := NewBlocklist()
+	assert.Nil(t, blocklist.Match(netip.MustParseAddr("203.0.113.7"), now), "nothing blocked until replaced")
+
+	blocklist.Replace([]*BlockedNetwork{
+		network,
+		address,
+		{ID: 3, CIDR: netip.MustParsePrefix("198.51.100.0/24"), ExpiresAt: &expired},
+	})
+	assert.Equal(t, network, blocklist.Match(netip.MustParseAddr("203.0.113.7"), now))
+	assert.Equal(t, network, blocklist.Match(netip.MustParseAddr("::ffff:203.0.113.7"), now))
+	assert.Equal(t, address, blocklist.Match(netip.MustParseAddr("2001:db8::1"), now))
+	assert.Nil(t, blocklist.Match(netip.MustParseAddr("2001:db8::2"), now))
+	assert.Nil(t, blocklist.Match(netip.MustParseAddr("198.51.100.7"), now), "expired networks aren't blocked")
+}
@@ -0,0 +1,51 @@
+package botguard
+
+import (
+	"time"
+)
+
+// Data Transfer Objects (DTOs) for the blocklist admin API layer.
+
+// BlockRequest represents the request body to block a network
+type BlockRequest struct {
+	// CIDR is a network in CIDR notation or a single address
+	CIDR      string     `json:"cidr" binding:"required,notblank,max=50" example:"203.0.113.0/24"`
+	Reason    string     `json:"reason" binding:"max=255" example:"Scraping the job search"`
+	ExpiresAt *time.Time `json:"expires_at" example:"2024-02-15T00:00:00Z"`
+}
+
+// BlockedNetworkResponse represents a blocked network in API responses
+type BlockedNetworkResponse struct {
+	ID        int        `json:"id" example:"1"`
+	CIDR      string     `json:"cidr" example:"203.0.113.0/24"`
+	Reason    string     `json:"reason" example:"Scraping the job search"`
+	CreatedBy string     `json:"created_by" example:"admin"`
+	CreatedAt time.Time  `json:"created_at" example:"2024-01-15T10:30:00Z"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty" example:"2024-02-15T00:00:00Z"`
+}
+
+// BlockedNetworkListResponse represents the list of blocked networks
+type BlockedNetworkListResponse struct {
+	Data []*BlockedNetworkResponse `json:"data"`
+}
+
+// MapBlockedNetworkToResponse converts a BlockedNetwork to its BlockedNetworkResponse
+func MapBlockedNetworkToResponse(network *BlockedNetwork) *BlockedNetworkResponse {
+	return &BlockedNetworkResponse{
+		ID:        network.ID,
+		CIDR:      network.CIDR.String(),
+		Reason:    network.Reason,
+		CreatedBy: network.CreatedBy,
+		CreatedAt: network.CreatedAt,
+		ExpiresAt: network.ExpiresAt,
+	}
+}
+
+// MapBlockedNetworksToResponse converts networks to the list API response format
+func MapBlockedNetworksToResponse(networks []*BlockedNetwork) *BlockedNetworkListResponse {
+	data := make([]*BlockedNetworkResponse, len(networks))
+	for i, network := range networks {
+		data[i] = MapBlockedNetworkToResponse(network)
+	}
+	return &BlockedNetworkListResponse{Data: data}
+}
@@ -0,0 +1,38 @@
+// Package botguard protects the public job search from the scrapers copying the board: it bans
+// the clients making bursts of requests or following honeypot parameters, and blocks the networks
+// of the blocklist the admins keep in the blocked_networks table.
+package botguard
+
+import (
+	"fmt"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// DuplicateError represents a network that is already blocked
+type DuplicateError struct {
+	CIDR string
+}
+
+func (e DuplicateError) Error() string {
+	return fmt.Sprintf("network %s is already blocked", e.CIDR)
+}
+
+// Is matches httpservice.ErrConflict
+func (e DuplicateError) Is(target error) bool {
+	return target == httpservice.ErrConflict
+}
+
+// NotFoundError represents a blocked network that doesn't exist
+type NotFoundError struct {
+	ID int
+}
+
+func (e NotFoundError) Error() string {
+	return fmt.Sprintf("blocked network with ID %d not found", e.ID)
+}
+
+// Is matches httpservice.ErrNotFound
+func (e NotFoundError) Is(target error) bool {
+	return target == httpservice.ErrNotFound
+}
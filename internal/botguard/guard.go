@@ -0,0 +1,165 @@
+package botguard
+
+import (
+	"math"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// Reasons a client is banned for
+const (
+	ReasonBurst    = "burst"
+	ReasonHoneypot = "honeypot"
+)
+
+var (
+	// errBlocked is the error of the requests of the blocked networks and the honeypot requests
+	errBlocked = httpservice.NewError(httpservice.ErrForbidden, "Access denied")
+	// errBanned is the error of the requests of the banned clients
+	errBanned = httpservice.NewError(httpservice.ErrTooManyRequests, "Too many requests, try again later")
+)
+
+// Config configures the guard
+type Config struct {
+	// BurstLimit is how many requests a client, by IP, can make per BurstWindow before it is banned
+	// for BanDuration. Zero disables the burst detection.
+	BurstLimit  int
+	BurstWindow time.Duration
+	BanDuration time.Duration
+	// HoneypotParams are query parameters only scrapers send, e.g. found in links hidden from the
+	// visitors of the board. The clients sending any of them with a value are banned for BanDuration.
+	HoneypotParams []string
+}
+
+// DefaultConfig returns the burst detection disabled and no honeypot parameters, the guard only
+// enforcing the blocklist
+func DefaultConfig() Config {
+	return Config{
+		BurstWindow: 10 * time.Second,
+		BanDuration: 15 * time.Minute,
+	}
+}
+
+// BanFunc is called with the IP of each client banned and the reason, one of the Reason constants
+type BanFunc func(ip, reason string)
+
+// client tracks the requests of a client in its current burst window and its ban
+type client struct {
+	windowStart time.Time
+	count       int
+	bannedUntil time.Time
+}
+
+// Guard rejects the requests of the clients of the blocked networks with 403 Forbidden, and bans
+// for a while the clients making bursts of requests or sending honeypot parameters. The requests
+// of the banned clients are answered with 429 Too Many Requests and a Retry-After header.
+// The clients are tracked in memory, so each server instance bans them on its own.
+type Guard struct {
+	cfg       Config
+	blocklist *Blocklist
+	onBan     BanFunc
+
+	mu      sync.Mutex
+	clients map[string]*client
+	// swept is when the clients that are neither counted nor banned were last removed
+	swept time.Time
+}
+
+// NewGuard creates a guard checking the clients against blocklist and calling onBan, unless nil,
+// when it bans one
+func NewGuard(cfg Config, blocklist *Blocklist, onBan BanFunc) *Guard {
+	return &Guard{cfg: cfg, blocklist: blocklist, onBan: onBan, clients: make(map[string]*client)}
+}
+
+// Middleware returns the middleware guarding the routes it is used on
+func (g *Guard) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ip := c.ClientIP()
+		now := time.Now()
+		if addr, err := netip.ParseAddr(ip); err == nil && g.blocklist.Match(addr, now) != nil {
+			_ = c.Error(errBlocked)
+			c.Abort()
+			return
+		}
+
+		reason, retryAfter := g.inspect(ip, c.Request.URL.Query(), now)
+		if reason != "" && g.onBan != nil {
+			g.onBan(ip, reason)
+		}
+		switch {
+		case reason == ReasonHoneypot:
+			_ = c.Error(errBlocked)
+			c.Abort()
+		case retryAfter > 0:
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+			_ = c.Error(errBanned)
+			c.Abort()
+		default:
+			c.Next()
+		}
+	}
+}
+
+// inspect counts a request of the client at ip sending query at now. It returns the reason the
+// client was banned for by this request, if it was, and how long until the ban of the client ends
+// when it is banned.
+func (g *Guard) inspect(ip string, query url.Values, now time.Time) (string, time.Duration) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.sweep(now)
+	current, ok := g.clients[ip]
+	if ok && current.bannedUntil.After(now) {
+		return "", current.bannedUntil.Sub(now)
+	}
+
+	for _, param := range g.cfg.HoneypotParams {
+		if query.Get(param) != "" {
+			return ReasonHoneypot, g.ban(ip, current, now)
+		}
+	}
+
+	if g.cfg.BurstLimit <= 0 {
+		return "", 0
+	}
+	if !ok || now.Sub(current.windowStart) >= g.cfg.BurstWindow {
+		current = &client{windowStart: now}
+		g.clients[ip] = current
+	}
+	current.count++
+	if current.count > g.cfg.BurstLimit {
+		return ReasonBurst, g.ban(ip, current, now)
+	}
+	return "", 0
+}
+
+// ban bans the client at ip from now, returning the duration of the ban
+func (g *Guard) ban(ip string, current *client, now time.Time) time.Duration {
+	if current == nil {
+		current = &client{windowStart: now}
+		g.clients[ip] = current
+	}
+	current.bannedUntil = now.Add(g.cfg.BanDuration)
+	return g.cfg.BanDuration
+}
+
+// sweep removes the clients whose window and ban ended, at most once per window, so clients that
+// stopped making requests are forgotten
+func (g *Guard) sweep(now time.Time) {
+	if now.Sub(g.swept) < g.cfg.BurstWindow {
+		return
+	}
+	for ip, current := range g.clients {
+		if now.Sub(current.windowStart) >= g.cfg.BurstWindow && !current.bannedUntil.After(now) {
+			delete(g.clients, ip)
+		}
+	}
+	g.swept = now
+}
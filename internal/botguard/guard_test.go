@@ -0,0 +1,165 @@
+package botguard
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// newGuardedRouter returns a router serving /jobs through guard
+func newGuardedRouter(guard *Guard) func(target, remoteAddr string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(httpservice.ErrorHandler())
+	router.GET("/jobs", guard.Middleware(), func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	return func(target, remoteAddr string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, target, http.NoBody)
+		req.RemoteAddr = remoteAddr
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, req)
+		return recorder
+	}
+}
+
+func TestGuard_Blocklist(t *testing.T) {
+	t.Parallel()
+	blocklist := NewBlocklist()
+	blocklist.Replace([]*BlockedNetwork{{ID: 1, CIDR: netip.MustParsePrefix("203.0.113.0/24")}})
+	request := newGuardedRouter(NewGuard(DefaultConfig(), blocklist, nil))
+
+	blocked := request("/jobs?q=golang", "203.0.113.7:1234")
+	assert.Equal(t, http.StatusForbidden, blocked.Code)
+	var response httpservice.ErrorResponse
+	require.NoError(t, json.Unmarshal(blocked.Body.Bytes(), &response))
+	assert.Equal(t, httpservice.ErrCodeForbidden, response.Error.Code)
+
+	assert.Equal(t, http.StatusNoContent, request("/jobs?q=golang", "198.51.100.7:1234").Code)
+}
+
+func TestGuard_Burst(t *testing.T) {
+	t.Parallel()
+	cfg := DefaultConfig()
+	cfg.BurstLimit = 2
+	var bans []string
+	request := newGuardedRouter(NewGuard(cfg, NewBlocklist(), func(ip, reason string) {
+		bans = append(bans, ip+" "+reason)
+	}))
+
+	assert.Equal(t, http.StatusNoContent, request("/jobs?q=golang", "10.0.0.1:1234").Code)
+	assert.Equal(t, http.StatusNoContent, request("/jobs?q=golang", "10.0.0.1:1234").Code)
+
+	banned := request("/jobs?q=golang", "10.0.0.1:1234")
+	assert.Equal(t, http.StatusTooManyRequests, banned.Code)
+	assert.Equal(t, "900", banned.Header().Get("Retry-After"))
+	assert.Equal(t, http.StatusTooManyRequests, request("/jobs?q=golang", "10.0.0.1:1234").Code)
+	assert.Equal(t, []string{"10.0.0.1 burst"}, bans, "a client is reported once per ban")
+
+	// Other clients have their own bursts
+	assert.Equal(t, http.StatusNoContent, request("/jobs?q=golang", "10.0.0.2:1234").Code)
+}
+
+func TestGuard_ForwardedFor(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+	cfg := DefaultConfig()
+	cfg.BurstLimit = 2
+	blocklist := NewBlocklist()
+	blocklist.Replace([]*BlockedNetwork{{ID: 1, CIDR: netip.MustParsePrefix("203.0.113.0/24")}})
+	var bans []string
+	guard := NewGuard(cfg, blocklist, func(ip, reason string) {
+		bans = append(bans, ip+" "+reason)
+	})
+
+	// The proxies are trusted like cmd/server does, none by default
+	newRouter := func(trustedProxies []string) func(remoteAddr, forwardedFor string) int {
+		router := gin.New()
+		require.NoError(t, router.SetTrustedProxies(trustedProxies))
+		router.Use(httpservice.ErrorHandler())
+		router.GET("/jobs", guard.Middleware(), func(c *gin.Context) {
+			c.Status(http.StatusNoContent)
+		})
+		return func(remoteAddr, forwardedFor string) int {
+			req := httptest.NewRequest(http.MethodGet, "/jobs?q=golang", http.NoBody)
+			req.RemoteAddr = remoteAddr
+			req.Header.Set("X-Forwarded-For", forwardedFor)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+			return recorder.Code
+		}
+	}
+
+	t.Run("spoofed header", func(t *testing.T) {
+		request := newRouter(nil)
+		// Rotating the header doesn't dodge the burst detection
+		assert.Equal(t, http.StatusNoContent, request("10.0.0.1:1234", "198.51.100.1"))
+		assert.Equal(t, http.StatusNoContent, request("10.0.0.1:1234", "198.51.100.2"))
+		assert.Equal(t, http.StatusTooManyRequests, request("10.0.0.1:1234", "198.51.100.3"))
+		assert.Equal(t, []string{"10.0.0.1 burst"}, bans, "the client is banned by its own address")
+
+		// nor frames a blocked address, or escapes the blocklist
+		assert.Equal(t, http.StatusNoContent, request("10.0.0.2:1234", "203.0.113.7"))
+		assert.Equal(t, http.StatusForbidden, request("203.0.113.7:1234", "10.0.0.3"))
+	})
+
+	t.Run("trusted proxy", func(t *testing.T) {
+		request := newRouter([]string{"192.168.0.0/16"})
+		assert.Equal(t, http.StatusForbidden, request("192.168.1.10:1234", "203.0.113.7"))
+		assert.Equal(t, http.StatusNoContent, request("192.168.1.10:1234", "10.0.0.4"))
+	})
+}
+
+func TestGuard_Honeypot(t *testing.T) {
+	t.Parallel()
+	cfg := DefaultConfig()
+	cfg.HoneypotParams = []string{"ref_id"}
+	var bans []string
+	request := newGuardedRouter(NewGuard(cfg, NewBlocklist(), func(ip, reason string) {
+		bans = append(bans, ip+" "+reason)
+	}))
+
+	assert.Equal(t, http.StatusNoContent, request("/jobs?q=golang&ref_id=", "10.0.0.1:1234").Code)
+	assert.Equal(t, http.StatusForbidden, request("/jobs?q=golang&ref_id=42", "10.0.0.1:1234").Code)
+	assert.Equal(t, http.StatusTooManyRequests, request("/jobs?q=golang", "10.0.0.1:1234").Code)
+	assert.Equal(t, []string{"10.0.0.1 honeypot"}, bans)
+}
+
+func TestGuard_inspect(t *testing.T) {
+	t.Parallel()
+	cfg := DefaultConfig()
+	cfg.BurstLimit = 1
+	guard := NewGuard(cfg, NewBlocklist(), nil)
+	start := time.Now()
+
+	reason, retryAfter := guard.inspect("10.0.0.1", nil, start)
+	assert.Empty(t, reason)
+	assert.Zero(t, retryAfter)
+
+	// A new window starts once the previous one ends
+	reason, _ = guard.inspect("10.0.0.1", nil, start.Add(cfg.BurstWindow))
+	assert.Empty(t, reason)
+
+	reason, retryAfter = guard.inspect("10.0.0.1", nil, start.Add(cfg.BurstWindow+time.Second))
+	assert.Equal(t, ReasonBurst, reason)
+	assert.Equal(t, cfg.BanDuration, retryAfter)
+
+	// The ban ends after its duration and the client is forgotten once its window ends too
+	_, retryAfter = guard.inspect("10.0.0.1", nil, start.Add(cfg.BurstWindow+time.Minute))
+	assert.Equal(t, cfg.BanDuration-time.Minute+time.Second, retryAfter)
+	ended := start.Add(cfg.BurstWindow + time.Second + cfg.BanDuration)
+	reason, retryAfter = guard.inspect("10.0.0.2", nil, ended)
+	assert.Empty(t, reason)
+	assert.Zero(t, retryAfter)
+	assert.NotContains(t, guard.clients, "10.0.0.1")
+}
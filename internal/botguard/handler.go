@@ -0,0 +1,113 @@
+package botguard
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// Constants for blocklist routes and endpoints
+const (
+	BlocklistRoute      = "/blocklist"
+	BlockedNetworkRoute = "/blocklist/:id"
+)
+
+// Handler handles HTTP requests for the blocklist administration
+type Handler struct {
+	service *BlocklistService
+}
+
+// NewHandler creates a new blocklist handler
+func NewHandler(service *BlocklistService) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterAdminRoutes registers the blocklist admin routes with the given (protected) router group
+func (h *Handler) RegisterAdminRoutes(rg *gin.RouterGroup) {
+	rg.GET(BlocklistRoute, h.ListBlockedNetworks)
+	rg.POST(BlocklistRoute, h.BlockNetwork)
+	rg.DELETE(BlockedNetworkRoute, h.UnblockNetwork)
+}
+
+// ListBlockedNetworks godoc
+// @Summary List blocked networks
+// @Description Returns the networks blocked from the public job search, the newest first, including
+// @Description the expired ones
+// @Tags admin
+// @Produce json
+// @Security AdminAPIKey
+// @Success 200 {object} BlockedNetworkListResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/blocklist [get]
+func (h *Handler) ListBlockedNetworks(c *gin.Context) {
+	networks, err := h.service.List(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapBlockedNetworksToResponse(networks))
+}
+
+// BlockNetwork godoc
+// @Summary Block a network
+// @Description Blocks the clients of a network, or of a single address, from the public job search
+// @Description until it expires, answering their requests with 403 Forbidden. Every server instance
+// @Description blocks it once it reloads its blocklist.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security AdminAPIKey
+// @Param request body BlockRequest true "Blocked network"
+// @Success 201 {object} BlockedNetworkResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 409 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/blocklist [post]
+func (h *Handler) BlockNetwork(c *gin.Context) {
+	var req BlockRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	network, err := h.service.Block(c.Request.Context(), req.CIDR, req.Reason, req.ExpiresAt)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, MapBlockedNetworkToResponse(network))
+}
+
+// UnblockNetwork godoc
+// @Summary Unblock a network
+// @Description Removes a network from the blocklist
+// @Tags admin
+// @Security AdminAPIKey
+// @Param id path int true "Blocked network ID"
+// @Success 204
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/blocklist/{id} [delete]
+func (h *Handler) UnblockNetwork(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(&httpservice.ValidationError{Errors: []string{"invalid blocked network id"}})
+		return
+	}
+
+	if err = h.service.Unblock(c.Request.Context(), id); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,28 @@
+package botguard
+
+import (
+	"net/netip"
+	"time"
+)
+
+// ChangesChannel is the notification channel where the database announces changes to the blocked
+// networks. The payload is the operation, e.g. "INSERT".
+const ChangesChannel = "blocklist_changes"
+
+// BlockedNetwork represents a network whose clients can't use the public job search, e.g. the
+// range of the cloud provider a scraper runs from
+type BlockedNetwork struct {
+	ID int `db:"id"`
+	// CIDR is the blocked network, a single address being blocked as a /32 or /128 network
+	CIDR      netip.Prefix `db:"cidr"`
+	Reason    string       `db:"reason"`
+	CreatedBy string       `db:"created_by"`
+	CreatedAt time.Time    `db:"created_at"`
+	// ExpiresAt is when the network is unblocked, nil while it stays blocked until removed
+	ExpiresAt *time.Time `db:"expires_at"`
+}
+
+// Active reports whether the network is blocked at now
+func (n *BlockedNetwork) Active(now time.Time) bool {
+	return n.ExpiresAt == nil || n.ExpiresAt.After(now)
+}
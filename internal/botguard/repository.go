@@ -0,0 +1,106 @@
+package botguard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/actor"
+)
+
+// SQL query constants
+const (
+	listNetworksQuery = `
+        SELECT id, cidr, reason, created_by, created_at, expires_at
+        FROM blocked_networks
+        ORDER BY created_at DESC, id DESC
+    `
+
+	createNetworkQuery = `
+        INSERT INTO blocked_networks (cidr, reason, created_by, expires_at)
+        VALUES ($1, $2, $3, $4)
+        RETURNING id, created_at
+    `
+
+	deleteNetworkQuery = `DELETE FROM blocked_networks WHERE id = $1`
+)
+
+// Database interface to support pgxpool and mocks
+type Database interface {
+	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
+	Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+}
+
+// Repository handles database operations for the BlockedNetwork model. Writes record the actor of
+// their context as the one who blocked the network, see actor.From.
+type Repository struct {
+	db Database
+}
+
+// NewRepository creates a new Repository instance.
+func NewRepository(db Database) *Repository {
+	return &Repository{db: db}
+}
+
+// List retrieves the blocked networks, expired or not, the newest first.
+func (r *Repository) List(ctx context.Context) ([]*BlockedNetwork, error) {
+	rows, err := r.db.Query(ctx, listNetworksQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list blocked networks: %w", err)
+	}
+	defer rows.Close()
+
+	networks := []*BlockedNetwork{}
+	for rows.Next() {
+		network := &BlockedNetwork{}
+		if err := rows.Scan(&network.ID, &network.CIDR, &network.Reason, &network.CreatedBy, &network.CreatedAt,
+			&network.ExpiresAt); err != nil {
+			return nil, fmt.Errorf("failed to scan blocked network: %w", err)
+		}
+		networks = append(networks, network)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating blocked networks: %w", err)
+	}
+
+	return networks, nil
+}
+
+// Create blocks a network, setting its ID, actor and creation time. The expiry is stored in UTC.
+// It returns a DuplicateError when the network is already blocked.
+func (r *Repository) Create(ctx context.Context, network *BlockedNetwork) error {
+	network.CreatedBy = actor.From(ctx).String()
+	if network.ExpiresAt != nil {
+		expiresAt := network.ExpiresAt.UTC()
+		network.ExpiresAt = &expiresAt
+	}
+
+	err := r.db.QueryRow(ctx, createNetworkQuery, network.CIDR, network.Reason, network.CreatedBy,
+		network.ExpiresAt).Scan(&network.ID, &network.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return &DuplicateError{CIDR: network.CIDR.String()}
+		}
+		return fmt.Errorf("failed to block network: %w", err)
+	}
+	return nil
+}
+
+// Delete unblocks a network. It returns a NotFoundError when the network isn't blocked.
+func (r *Repository) Delete(ctx context.Context, id int) error {
+	commandTag, err := r.db.Exec(ctx, deleteNetworkQuery, id)
+	if err != nil {
+		return fmt.Errorf("failed to unblock network: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return &NotFoundError{ID: id}
+	}
+
+	return nil
+}
@@ -0,0 +1,197 @@
+package botguard
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/actor"
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+func TestRepository_List(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	expiresAt := now.Add(time.Hour)
+	network := netip.MustParsePrefix("203.0.113.0/24")
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, result []*BlockedNetwork, err error)
+	}{
+		{
+			name: "networks found",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(regexp.QuoteMeta(listNetworksQuery)).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "cidr", "reason", "created_by", "created_at", "expires_at"}).
+						AddRow(2, network, "Scraping", "admin", now, &expiresAt).
+						AddRow(1, netip.MustParsePrefix("2001:db8::1/128"), "", "", now, nil))
+			},
+			checkResults: func(t *testing.T, result []*BlockedNetwork, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, result, 2)
+				assert.Equal(t, &BlockedNetwork{
+					ID: 2, CIDR: network, Reason: "Scraping", CreatedBy: "admin", CreatedAt: now, ExpiresAt: &expiresAt,
+				}, result[0])
+				assert.Nil(t, result[1].ExpiresAt)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(regexp.QuoteMeta(listNetworksQuery)).WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ []*BlockedNetwork, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			result, err := repo.List(context.Background())
+			tt.checkResults(t, result, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_Create(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	local := time.Date(2024, 2, 15, 0, 0, 0, 0, time.FixedZone("CST", -6*60*60))
+	network := netip.MustParsePrefix("203.0.113.0/24")
+	ctx := actor.With(context.Background(), actor.APIKey("secret"))
+	createdBy := actor.APIKey("secret").String()
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, network *BlockedNetwork, err error)
+	}{
+		{
+			name: "network blocked",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(regexp.QuoteMeta(createNetworkQuery)).
+					WithArgs(network, "Scraping", createdBy, pgxmock.AnyArg()).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "created_at"}).AddRow(1, now))
+			},
+			checkResults: func(t *testing.T, network *BlockedNetwork, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 1, network.ID)
+				assert.Equal(t, now, network.CreatedAt)
+				assert.Equal(t, createdBy, network.CreatedBy)
+				assert.Equal(t, time.UTC, network.ExpiresAt.Location(), "the expiry is stored in UTC")
+				assert.True(t, local.Equal(*network.ExpiresAt))
+			},
+		},
+		{
+			name: "network already blocked",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(regexp.QuoteMeta(createNetworkQuery)).
+					WithArgs(network, "Scraping", createdBy, pgxmock.AnyArg()).
+					WillReturnError(&pgconn.PgError{Code: "23505"})
+			},
+			checkResults: func(t *testing.T, _ *BlockedNetwork, err error) {
+				t.Helper()
+				var duplicateErr *DuplicateError
+				require.ErrorAs(t, err, &duplicateErr)
+				assert.Equal(t, "203.0.113.0/24", duplicateErr.CIDR)
+				assert.ErrorIs(t, err, httpservice.ErrConflict)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			blocked := &BlockedNetwork{CIDR: network, Reason: "Scraping", ExpiresAt: &local}
+			err = repo.Create(ctx, blocked)
+			tt.checkResults(t, blocked, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_Delete(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, err error)
+	}{
+		{
+			name: "network unblocked",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(regexp.QuoteMeta(deleteNetworkQuery)).
+					WithArgs(1).
+					WillReturnResult(pgxmock.NewResult("DELETE", 1))
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "network not blocked",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(regexp.QuoteMeta(deleteNetworkQuery)).
+					WithArgs(1).
+					WillReturnResult(pgxmock.NewResult("DELETE", 0))
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				var notFoundErr *NotFoundError
+				require.ErrorAs(t, err, &notFoundErr)
+				assert.ErrorIs(t, err, httpservice.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			tt.checkResults(t, repo.Delete(context.Background(), 1))
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
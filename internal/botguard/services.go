@@ -0,0 +1,103 @@
+package botguard
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// maxReasonLength is the length of the reason column
+const maxReasonLength = 255
+
+// DataRepository interface to make database operations for the blocked networks.
+type DataRepository interface {
+	List(ctx context.Context) ([]*BlockedNetwork, error)
+	Create(ctx context.Context, network *BlockedNetwork) error
+	Delete(ctx context.Context, id int) error
+}
+
+// BlocklistService holds the business logic to manage the blocked networks and keep the snapshot of
+// a blocklist up to date.
+type BlocklistService struct {
+	repo      DataRepository
+	blocklist *Blocklist
+}
+
+// NewBlocklistService creates a new instance of BlocklistService reloading blocklist
+func NewBlocklistService(repo DataRepository, blocklist *Blocklist) *BlocklistService {
+	return &BlocklistService{repo: repo, blocklist: blocklist}
+}
+
+// Load replaces the snapshot of the blocklist with the stored networks
+func (s *BlocklistService) Load(ctx context.Context) error {
+	networks, err := s.repo.List(ctx)
+	if err != nil {
+		return err
+	}
+	s.blocklist.Replace(networks)
+	return nil
+}
+
+// List returns the blocked networks, expired or not, the newest first
+func (s *BlocklistService) List(ctx context.Context) ([]*BlockedNetwork, error) {
+	return s.repo.List(ctx)
+}
+
+// Block validates and stores a blocked network, until expiresAt unless nil, and reloads the
+// blocklist. cidr is a network in CIDR notation or a single address, the bits of a network
+// address right of its mask being cleared, e.g. 203.0.113.7/24 blocks 203.0.113.0/24. The other
+// server instances reload their blocklist when the database notifies the change.
+func (s *BlocklistService) Block(ctx context.Context, cidr, reason string, expiresAt *time.Time) (
+	*BlockedNetwork, error) {
+	prefix, err := parseNetwork(cidr)
+	if err != nil {
+		return nil, &httpservice.ValidationError{Errors: []string{err.Error()}}
+	}
+	network := &BlockedNetwork{CIDR: prefix, Reason: strings.TrimSpace(reason), ExpiresAt: expiresAt}
+	if len(network.Reason) > maxReasonLength {
+		return nil, &httpservice.ValidationError{Errors: []string{"reason must have at most 255 characters"}}
+	}
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return nil, &httpservice.ValidationError{Errors: []string{"expires_at must be in the future"}}
+	}
+
+	if err = s.repo.Create(ctx, network); err != nil {
+		return nil, err
+	}
+	if err = s.Load(ctx); err != nil {
+		return nil, err
+	}
+	return network, nil
+}
+
+// Unblock removes a blocked network and reloads the blocklist
+func (s *BlocklistService) Unblock(ctx context.Context, id int) error {
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	return s.Load(ctx)
+}
+
+// parseNetwork parses a network in CIDR notation, e.g. 203.0.113.0/24, or a single address, blocked
+// as a /32 or /128 network. The bits of the address right of the mask are cleared.
+func parseNetwork(cidr string) (netip.Prefix, error) {
+	cidr = strings.TrimSpace(cidr)
+	if !strings.Contains(cidr, "/") {
+		addr, err := netip.ParseAddr(cidr)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("invalid network %q, expected an address or CIDR notation", cidr)
+		}
+		addr = addr.Unmap()
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid network %q, expected an address or CIDR notation", cidr)
+	}
+	return prefix.Masked(), nil
+}
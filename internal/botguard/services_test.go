@@ -0,0 +1,147 @@
+package botguard
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+func TestBlocklistService_Load(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+	addr := netip.MustParseAddr("203.0.113.7")
+
+	mockRepo := NewMockDataRepository(t)
+	mockRepo.EXPECT().List(context.Background()).
+		Return([]*BlockedNetwork{{ID: 1, CIDR: netip.MustParsePrefix("203.0.113.0/24")}}, nil).Once()
+	mockRepo.EXPECT().List(context.Background()).Return(nil, dbError).Once()
+
+	blocklist := NewBlocklist()
+	service := NewBlocklistService(mockRepo, blocklist)
+
+	require.NoError(t, service.Load(context.Background()))
+	assert.NotNil(t, blocklist.Match(addr, time.Now()))
+
+	require.ErrorIs(t, service.Load(context.Background()), dbError)
+	assert.NotNil(t, blocklist.Match(addr, time.Now()), "a failed load keeps the snapshot")
+}
+
+func TestBlocklistService_Block(t *testing.T) {
+	t.Parallel()
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name         string
+		cidr         string
+		reason       string
+		expiresAt    *time.Time
+		mockSetup    func(mockRepo *MockDataRepository)
+		checkResults func(t *testing.T, blocklist *Blocklist, network *BlockedNetwork, err error)
+	}{
+		{
+			name:   "network blocked and blocklist reloaded",
+			cidr:   " 203.0.113.7/24 ",
+			reason: " Scraping ",
+			mockSetup: func(mockRepo *MockDataRepository) {
+				mockRepo.EXPECT().Create(context.Background(), mock.MatchedBy(func(n *BlockedNetwork) bool {
+					return n.CIDR == netip.MustParsePrefix("203.0.113.0/24") && n.Reason == "Scraping"
+				})).Run(func(_ context.Context, n *BlockedNetwork) { n.ID = 1 }).Return(nil).Once()
+				mockRepo.EXPECT().List(context.Background()).Return([]*BlockedNetwork{
+					{ID: 1, CIDR: netip.MustParsePrefix("203.0.113.0/24")},
+				}, nil).Once()
+			},
+			checkResults: func(t *testing.T, blocklist *Blocklist, network *BlockedNetwork, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 1, network.ID)
+				assert.NotNil(t, blocklist.Match(netip.MustParseAddr("203.0.113.200"), time.Now()))
+			},
+		},
+		{
+			name: "single address",
+			cidr: "::ffff:198.51.100.7",
+			mockSetup: func(mockRepo *MockDataRepository) {
+				mockRepo.EXPECT().Create(context.Background(), mock.MatchedBy(func(n *BlockedNetwork) bool {
+					return n.CIDR == netip.MustParsePrefix("198.51.100.7/32")
+				})).Return(nil).Once()
+				mockRepo.EXPECT().List(context.Background()).Return([]*BlockedNetwork{}, nil).Once()
+			},
+			checkResults: func(t *testing.T, _ *Blocklist, _ *BlockedNetwork, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:      "invalid network",
+			cidr:      "203.0.113.0/33",
+			mockSetup: func(*MockDataRepository) {},
+			checkResults: func(t *testing.T, _ *Blocklist, _ *BlockedNetwork, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+			},
+		},
+		{
+			name:      "expiry in the past",
+			cidr:      "203.0.113.0/24",
+			expiresAt: &past,
+			mockSetup: func(*MockDataRepository) {},
+			checkResults: func(t *testing.T, _ *Blocklist, _ *BlockedNetwork, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Contains(t, validationErr.Errors, "expires_at must be in the future")
+			},
+		},
+		{
+			name: "network already blocked",
+			cidr: "203.0.113.0/24",
+			mockSetup: func(mockRepo *MockDataRepository) {
+				mockRepo.EXPECT().Create(context.Background(), mock.Anything).
+					Return(&DuplicateError{CIDR: "203.0.113.0/24"}).Once()
+			},
+			checkResults: func(t *testing.T, _ *Blocklist, _ *BlockedNetwork, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, httpservice.ErrConflict)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			tt.mockSetup(mockRepo)
+			blocklist := NewBlocklist()
+
+			network, err := NewBlocklistService(mockRepo, blocklist).
+				Block(context.Background(), tt.cidr, tt.reason, tt.expiresAt)
+			tt.checkResults(t, blocklist, network, err)
+		})
+	}
+}
+
+func TestBlocklistService_Unblock(t *testing.T) {
+	t.Parallel()
+
+	mockRepo := NewMockDataRepository(t)
+	mockRepo.EXPECT().Delete(context.Background(), 1).Return(nil).Once()
+	mockRepo.EXPECT().List(context.Background()).Return([]*BlockedNetwork{}, nil).Once()
+	mockRepo.EXPECT().Delete(context.Background(), 2).Return(&NotFoundError{ID: 2}).Once()
+
+	blocklist := NewBlocklist()
+	blocklist.Replace([]*BlockedNetwork{{ID: 1, CIDR: netip.MustParsePrefix("203.0.113.0/24")}})
+	service := NewBlocklistService(mockRepo, blocklist)
+
+	require.NoError(t, service.Unblock(context.Background(), 1))
+	assert.Nil(t, blocklist.Match(netip.MustParseAddr("203.0.113.7"), time.Now()))
+	require.ErrorIs(t, service.Unblock(context.Background(), 2), httpservice.ErrNotFound)
+}
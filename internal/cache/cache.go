@@ -0,0 +1,151 @@
+// Package cache provides a small in-memory cache whose entries expire after a fixed TTL.
+// It is meant for expensive read-only results, like statistics, that can be slightly stale.
+// Results shared by the instances of the server go in a Tiered cache, a bounded LRU in front of Redis.
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/metrics"
+)
+
+// Stats holds the counters of a cache since it was created
+type Stats struct {
+	Hits   int64
+	Misses int64
+	// Evictions are the entries removed once expired
+	Evictions int64
+	// Entries is the number of entries currently stored, expired ones included until removed
+	Entries int
+}
+
+type entry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// Cache is a concurrency safe in-memory cache with a fixed TTL per entry
+type Cache[K comparable, V any] struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[K]entry[V]
+	stats   Stats
+	now     func() time.Time
+}
+
+// New creates a cache whose entries expire ttl after being set
+func New[K comparable, V any](ttl time.Duration) *Cache[K, V] {
+	return &Cache[K, V]{
+		ttl:     ttl,
+		entries: make(map[K]entry[V]),
+		now:     time.Now,
+	}
+}
+
+// Get returns the value stored for key, if it hasn't expired
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok || !c.now().Before(e.expiresAt) {
+		if ok {
+			delete(c.entries, key)
+			c.stats.Evictions++
+		}
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	return e.value, true
+}
+
+// Set stores value for key
+func (c *Cache[K, V]) Set(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = entry[V]{value: value, expiresAt: c.now().Add(c.ttl)}
+}
+
+// Delete removes the value stored for key
+func (c *Cache[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, key)
+}
+
+// Clear removes every entry, e.g. when the data they were loaded from changed
+func (c *Cache[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	clear(c.entries)
+}
+
+// Purge removes the expired entries, which are otherwise only removed when read, and returns
+// how many were removed
+func (c *Cache[K, V]) Purge() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	purged := 0
+	for key, e := range c.entries {
+		if !now.Before(e.expiresAt) {
+			delete(c.entries, key)
+			purged++
+		}
+	}
+	c.stats.Evictions += int64(purged)
+	return purged
+}
+
+// Stats returns the counters of the cache
+func (c *Cache[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := c.stats
+	stats.Entries = len(c.entries)
+	return stats
+}
+
+// GetOrLoad returns the value stored for key or loads and stores it when missing or expired.
+// Errors are not cached. Concurrent misses may load the value more than once.
+func (c *Cache[K, V]) GetOrLoad(ctx context.Context, key K, load func(ctx context.Context) (V, error)) (V, error) {
+	if value, ok := c.Get(key); ok {
+		return value, nil
+	}
+
+	value, err := load(ctx)
+	if err != nil {
+		var zero V
+		return zero, err
+	}
+
+	c.Set(key, value)
+	return value, nil
+}
+
+// StatsCollector returns a collector of the counters returned by stats, labeled with the cache name
+func StatsCollector(name string, stats func() Stats) metrics.Collector {
+	return metrics.CollectorFunc(func() []metrics.Metric {
+		current := stats()
+		labels := map[string]string{"cache": name}
+		return []metrics.Metric{
+			{Name: "cache_hits_total", Help: "Number of lookups served from the cache.",
+				Type: metrics.Counter, Labels: labels, Value: float64(current.Hits)},
+			{Name: "cache_misses_total", Help: "Number of lookups not found in the cache.",
+				Type: metrics.Counter, Labels: labels, Value: float64(current.Misses)},
+			{Name: "cache_evictions_total", Help: "Number of expired entries removed from the cache.",
+				Type: metrics.Counter, Labels: labels, Value: float64(current.Evictions)},
+			{Name: "cache_entries", Help: "Number of entries stored in the cache.",
+				Type: metrics.Gauge, Labels: labels, Value: float64(current.Entries)},
+		}
+	})
+}
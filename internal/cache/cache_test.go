@@ -0,0 +1,115 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache_Get(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	c := New[string, int](time.Minute)
+	c.now = func() time.Time { return now }
+
+	_, ok := c.Get("jobs")
+	assert.False(t, ok)
+
+	c.Set("jobs", 42)
+	value, ok := c.Get("jobs")
+	assert.True(t, ok)
+	assert.Equal(t, 42, value)
+
+	now = now.Add(time.Minute)
+	_, ok = c.Get("jobs")
+	assert.False(t, ok)
+}
+
+func TestCache_GetOrLoad(t *testing.T) {
+	t.Parallel()
+	loadErr := errors.New("load error")
+	c := New[string, int](time.Minute)
+
+	loads := 0
+	load := func(_ context.Context) (int, error) {
+		loads++
+		return 42, nil
+	}
+
+	for range 3 {
+		value, err := c.GetOrLoad(context.Background(), "jobs", load)
+		require.NoError(t, err)
+		assert.Equal(t, 42, value)
+	}
+	assert.Equal(t, 1, loads)
+
+	_, err := c.GetOrLoad(context.Background(), "companies", func(_ context.Context) (int, error) {
+		return 0, loadErr
+	})
+	require.ErrorIs(t, err, loadErr)
+	_, ok := c.Get("companies")
+	assert.False(t, ok)
+}
+
+func TestCache_Purge(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	c := New[string, int](time.Minute)
+	c.now = func() time.Time { return now }
+
+	c.Set("jobs", 42)
+	now = now.Add(30 * time.Second)
+	c.Set("companies", 7)
+	assert.Equal(t, 0, c.Purge())
+
+	now = now.Add(30 * time.Second)
+	assert.Equal(t, 1, c.Purge())
+	_, ok := c.Get("companies")
+	assert.True(t, ok)
+
+	c.Delete("companies")
+	_, ok = c.Get("companies")
+	assert.False(t, ok)
+}
+
+func TestCache_Clear(t *testing.T) {
+	t.Parallel()
+	c := New[string, int](time.Minute)
+
+	c.Set("jobs", 42)
+	c.Set("companies", 7)
+	c.Clear()
+
+	_, ok := c.Get("jobs")
+	assert.False(t, ok)
+	assert.Equal(t, 0, c.Purge())
+}
+
+func TestCache_Stats(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	c := New[string, int](time.Minute)
+	c.now = func() time.Time { return now }
+
+	c.Get("jobs")
+	c.Set("jobs", 42)
+	c.Set("companies", 7)
+	c.Get("jobs")
+	c.Get("jobs")
+
+	now = now.Add(time.Minute)
+	c.Get("jobs")
+	c.Purge()
+
+	assert.Equal(t, Stats{Hits: 2, Misses: 2, Evictions: 2}, c.Stats())
+
+	collected := StatsCollector("overview", c.Stats).Collect()
+	require.Len(t, collected, 4)
+	assert.Equal(t, "cache_hits_total", collected[0].Name)
+	assert.Equal(t, map[string]string{"cache": "overview"}, collected[0].Labels)
+	assert.InDelta(t, 2, collected[0].Value, 0)
+}
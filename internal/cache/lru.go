@@ -0,0 +1,126 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+type lruEntry[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt time.Time
+}
+
+// LRU is a concurrency safe in-memory cache holding at most a fixed number of entries, the least
+// recently used one being evicted to make room for a new one. Entries also expire after a fixed TTL.
+type LRU[K comparable, V any] struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	maxEntries int
+	// order holds the entries from the most to the least recently used
+	order   *list.List
+	entries map[K]*list.Element
+	stats   Stats
+	now     func() time.Time
+}
+
+// NewLRU creates a cache of at most maxEntries entries expiring ttl after being set
+func NewLRU[K comparable, V any](maxEntries int, ttl time.Duration) *LRU[K, V] {
+	return &LRU[K, V]{
+		ttl:        ttl,
+		maxEntries: max(maxEntries, 1),
+		order:      list.New(),
+		entries:    make(map[K]*list.Element),
+		now:        time.Now,
+	}
+}
+
+// Get returns the value stored for key, if it hasn't expired, marking it as the most recently used
+func (c *LRU[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok || !c.now().Before(elem.Value.(*lruEntry[K, V]).expiresAt) {
+		if ok {
+			c.removeLocked(elem)
+			c.stats.Evictions++
+		}
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	c.order.MoveToFront(elem)
+	return elem.Value.(*lruEntry[K, V]).value, true
+}
+
+// Set stores value for key, evicting the least recently used entry when the cache is full
+func (c *LRU[K, V]) Set(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := c.now().Add(c.ttl)
+	if elem, ok := c.entries[key]; ok {
+		entry := elem.Value.(*lruEntry[K, V])
+		entry.value, entry.expiresAt = value, expiresAt
+		c.order.MoveToFront(elem)
+		return
+	}
+	if c.order.Len() >= c.maxEntries {
+		c.removeLocked(c.order.Back())
+		c.stats.Evictions++
+	}
+	c.entries[key] = c.order.PushFront(&lruEntry[K, V]{key: key, value: value, expiresAt: expiresAt})
+}
+
+// TTL returns how long the entries are kept after being set
+func (c *LRU[K, V]) TTL() time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ttl
+}
+
+// SetTTL changes how long the entries set from now on are kept, the ones already set keep their expiry
+func (c *LRU[K, V]) SetTTL(ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ttl = ttl
+}
+
+// Delete removes the value stored for key
+func (c *LRU[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.removeLocked(elem)
+	}
+}
+
+// Clear removes every entry
+func (c *LRU[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.order.Init()
+	clear(c.entries)
+}
+
+// Stats returns the counters of the cache, the evictions counting the expired and the least
+// recently used entries removed
+func (c *LRU[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := c.stats
+	stats.Entries = len(c.entries)
+	return stats
+}
+
+// removeLocked removes an entry, with c.mu held
+func (c *LRU[K, V]) removeLocked(elem *list.Element) {
+	c.order.Remove(elem)
+	delete(c.entries, elem.Value.(*lruEntry[K, V]).key)
+}
@@ -0,0 +1,72 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLRU_Set(t *testing.T) {
+	t.Parallel()
+	c := NewLRU[string, int](2, time.Minute)
+
+	c.Set("golang", 1)
+	c.Set("python", 2)
+	// Reading golang makes python the least recently used
+	_, ok := c.Get("golang")
+	assert.True(t, ok)
+	c.Set("rust", 3)
+
+	_, ok = c.Get("python")
+	assert.False(t, ok)
+	value, ok := c.Get("golang")
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+	value, ok = c.Get("rust")
+	assert.True(t, ok)
+	assert.Equal(t, 3, value)
+
+	assert.Equal(t, Stats{Hits: 3, Misses: 1, Evictions: 1, Entries: 2}, c.Stats())
+}
+
+func TestLRU_Get(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	c := NewLRU[string, int](10, time.Minute)
+	c.now = func() time.Time { return now }
+
+	c.Set("jobs", 42)
+	value, ok := c.Get("jobs")
+	assert.True(t, ok)
+	assert.Equal(t, 42, value)
+
+	now = now.Add(time.Minute)
+	_, ok = c.Get("jobs")
+	assert.False(t, ok)
+	assert.Equal(t, Stats{Hits: 1, Misses: 1, Evictions: 1}, c.Stats())
+
+	c.Set("jobs", 43)
+	c.Delete("jobs")
+	_, ok = c.Get("jobs")
+	assert.False(t, ok)
+}
+
+func TestLRU_SetTTL(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	c := NewLRU[string, int](10, time.Minute)
+	c.now = func() time.Time { return now }
+
+	c.Set("jobs", 42)
+	c.SetTTL(time.Hour)
+	assert.Equal(t, time.Hour, c.TTL())
+	c.Set("companies", 7)
+
+	// The entries set before keep their expiry
+	now = now.Add(time.Minute)
+	_, ok := c.Get("jobs")
+	assert.False(t, ok)
+	_, ok = c.Get("companies")
+	assert.True(t, ok)
+}
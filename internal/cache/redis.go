@@ -0,0 +1,244 @@
+package cache
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	neturl "net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultRedisTimeout bounds each command sent to Redis
+const DefaultRedisTimeout = time.Second
+
+// Redis is a client of a Redis server over its RESP protocol, storing the values shared by the
+// instances of the server. The commands are sent one at a time over a single connection, opened on
+// the first command and again after any error.
+type Redis struct {
+	address  string
+	username string
+	password string
+	database int
+
+	mu     sync.Mutex
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+// NewRedis creates a new instance of Redis for the server at url, redis://[user:password@]host[:port][/db]
+func NewRedis(url string) (*Redis, error) {
+	parsed, err := neturl.Parse(url)
+	if err != nil || parsed.Scheme != "redis" || parsed.Host == "" {
+		return nil, errors.New("invalid Redis URL, expected redis://host:port/db")
+	}
+
+	address := parsed.Host
+	if parsed.Port() == "" {
+		address = net.JoinHostPort(parsed.Hostname(), "6379")
+	}
+
+	database := 0
+	if db := strings.Trim(parsed.Path, "/"); db != "" {
+		if database, err = strconv.Atoi(db); err != nil || database < 0 {
+			return nil, fmt.Errorf("invalid Redis database %q", db)
+		}
+	}
+
+	r := &Redis{address: address, database: database}
+	if password, ok := parsed.User.Password(); ok {
+		r.username = parsed.User.Username()
+		r.password = password
+	}
+	return r, nil
+}
+
+// Get returns the value stored for key, if any
+func (r *Redis) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	reply, err := r.do(ctx, "GET", key)
+	if err != nil {
+		return nil, false, err
+	}
+	if reply == nil {
+		return nil, false, nil
+	}
+	value, ok := reply.([]byte)
+	if !ok {
+		return nil, false, fmt.Errorf("unexpected Redis reply to GET: %v", reply)
+	}
+	return value, true, nil
+}
+
+// Set stores value for key, expiring after ttl
+func (r *Redis) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	_, err := r.do(ctx, "SET", key, string(value), "PX", strconv.FormatInt(ttl.Milliseconds(), 10))
+	return err
+}
+
+// Incr increments the integer stored for key, 0 when missing, and returns its new value
+func (r *Redis) Incr(ctx context.Context, key string) (int64, error) {
+	reply, err := r.do(ctx, "INCR", key)
+	if err != nil {
+		return 0, err
+	}
+	n, ok := reply.(int64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected Redis reply to INCR: %v", reply)
+	}
+	return n, nil
+}
+
+// Ping checks that the server answers
+func (r *Redis) Ping(ctx context.Context) error {
+	_, err := r.do(ctx, "PING")
+	return err
+}
+
+// Close closes the connection to the server
+func (r *Redis) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.closeConn()
+}
+
+// do sends a command and returns its reply: nil, a string, an int64 or []byte
+func (r *Redis) do(ctx context.Context, args ...string) (any, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.connect(ctx); err != nil {
+		return nil, err
+	}
+	r.setDeadline(ctx)
+	reply, err := r.command(args...)
+	if err != nil {
+		var redisErr *RedisError
+		if !errors.As(err, &redisErr) {
+			// The connection is in an unknown state
+			r.closeConn()
+		}
+		return nil, err
+	}
+	return reply, nil
+}
+
+// connect opens the connection when there is none, authenticating and selecting the database
+func (r *Redis) connect(ctx context.Context) error {
+	if r.conn != nil {
+		return nil
+	}
+
+	dialer := net.Dialer{Timeout: DefaultRedisTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", r.address)
+	if err != nil {
+		return fmt.Errorf("failed to connect to Redis: %w", err)
+	}
+	r.conn = conn
+	r.reader = bufio.NewReader(conn)
+	r.setDeadline(ctx)
+
+	if r.password != "" {
+		args := []string{"AUTH", r.password}
+		if r.username != "" {
+			args = []string{"AUTH", r.username, r.password}
+		}
+		if _, err = r.command(args...); err != nil {
+			r.closeConn()
+			return err
+		}
+	}
+	if r.database != 0 {
+		if _, err = r.command("SELECT", strconv.Itoa(r.database)); err != nil {
+			r.closeConn()
+			return err
+		}
+	}
+	return nil
+}
+
+// command writes a command as an array of bulk strings and reads its reply
+func (r *Redis) command(args ...string) (any, error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "*%d\r\n", len(args))
+	for _, arg := range args {
+		fmt.Fprintf(&b, "$%d\r\n%s\r\n", len(arg), arg)
+	}
+	if _, err := io.WriteString(r.conn, b.String()); err != nil {
+		return nil, fmt.Errorf("failed to write to Redis: %w", err)
+	}
+	return r.readReply()
+}
+
+// readReply reads a simple string, error, integer or bulk string reply
+func (r *Redis) readReply() (any, error) {
+	line, err := r.reader.ReadString('\n')
+	if err != nil {
+		return nil, fmt.Errorf("failed to read from Redis: %w", err)
+	}
+	line = strings.TrimRight(line, "\r\n")
+	if line == "" {
+		return nil, errors.New("empty Redis reply")
+	}
+
+	switch line[0] {
+	case '+':
+		return line[1:], nil
+	case '-':
+		return nil, &RedisError{Message: line[1:]}
+	case ':':
+		n, err := strconv.ParseInt(line[1:], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Redis integer reply %q", line)
+		}
+		return n, nil
+	case '$':
+		size, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid Redis bulk string reply %q", line)
+		}
+		if size < 0 {
+			return nil, nil
+		}
+		data := make([]byte, size+2)
+		if _, err = io.ReadFull(r.reader, data); err != nil {
+			return nil, fmt.Errorf("failed to read from Redis: %w", err)
+		}
+		return data[:size], nil
+	default:
+		return nil, fmt.Errorf("unsupported Redis reply %q", line)
+	}
+}
+
+// setDeadline bounds the next reads and writes by the deadline of ctx or DefaultRedisTimeout
+func (r *Redis) setDeadline(ctx context.Context) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(DefaultRedisTimeout)
+	}
+	_ = r.conn.SetDeadline(deadline)
+}
+
+// closeConn closes the connection, so the next command opens a new one
+func (r *Redis) closeConn() error {
+	if r.conn == nil {
+		return nil
+	}
+	err := r.conn.Close()
+	r.conn = nil
+	r.reader = nil
+	return err
+}
+
+// RedisError is an error reply of Redis, e.g. to a command with the wrong arguments
+type RedisError struct {
+	Message string
+}
+
+func (e *RedisError) Error() string {
+	return "Redis error: " + e.Message
+}
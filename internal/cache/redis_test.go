@@ -0,0 +1,147 @@
+package cache
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRedisServer accepts a single connection and answers it with serve, which gets the
+// commands sent by the client
+func fakeRedisServer(t *testing.T, serve func(conn net.Conn, commands *bufio.Reader)) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		serve(conn, bufio.NewReader(conn))
+	}()
+
+	return listener.Addr().String()
+}
+
+// readRedisCommand reads a command sent by the client, its arguments joined by spaces
+func readRedisCommand(t *testing.T, commands *bufio.Reader) string {
+	t.Helper()
+	line, err := commands.ReadString('\n')
+	if err != nil {
+		return ""
+	}
+	count, _ := strconv.Atoi(strings.TrimSpace(line[1:]))
+	args := make([]string, 0, count)
+	for range count {
+		if _, err = commands.ReadString('\n'); err != nil {
+			return ""
+		}
+		arg, err := commands.ReadString('\n')
+		if err != nil {
+			return ""
+		}
+		args = append(args, strings.TrimRight(arg, "\r\n"))
+	}
+	return strings.Join(args, " ")
+}
+
+func TestNewRedis(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		url      string
+		address  string
+		database int
+		err      string
+	}{
+		{name: "default port", url: "redis://cache", address: "cache:6379"},
+		{name: "port and database", url: "redis://:secret@cache:6380/2", address: "cache:6380", database: 2},
+		{name: "invalid scheme", url: "http://cache:6379", err: "invalid Redis URL"},
+		{name: "invalid database", url: "redis://cache/jobs", err: `invalid Redis database "jobs"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r, err := NewRedis(tt.url)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.address, r.address)
+			assert.Equal(t, tt.database, r.database)
+		})
+	}
+}
+
+func TestRedis_Commands(t *testing.T) {
+	t.Parallel()
+	address := fakeRedisServer(t, func(conn net.Conn, commands *bufio.Reader) {
+		assert.Equal(t, "AUTH secret", readRedisCommand(t, commands))
+		_, _ = conn.Write([]byte("+OK\r\n"))
+		assert.Equal(t, "SELECT 2", readRedisCommand(t, commands))
+		_, _ = conn.Write([]byte("+OK\r\n"))
+
+		assert.Equal(t, `SET jobs:golang {"total":3} PX 30000`, readRedisCommand(t, commands))
+		_, _ = conn.Write([]byte("+OK\r\n"))
+		assert.Equal(t, "GET jobs:golang", readRedisCommand(t, commands))
+		_, _ = conn.Write([]byte("$11\r\n{\"total\":3}\r\n"))
+		assert.Equal(t, "GET jobs:rust", readRedisCommand(t, commands))
+		_, _ = conn.Write([]byte("$-1\r\n"))
+		assert.Equal(t, "GET jobs:python", readRedisCommand(t, commands))
+		_, _ = conn.Write([]byte("-WRONGTYPE Operation against a key holding the wrong kind of value\r\n"))
+		assert.Equal(t, "INCR jobs:version", readRedisCommand(t, commands))
+		_, _ = conn.Write([]byte(":4\r\n"))
+	})
+
+	r, err := NewRedis("redis://:secret@" + address + "/2")
+	require.NoError(t, err)
+	defer r.Close()
+	ctx := context.Background()
+
+	require.NoError(t, r.Set(ctx, "jobs:golang", []byte(`{"total":3}`), 30*time.Second))
+
+	value, found, err := r.Get(ctx, "jobs:golang")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.JSONEq(t, `{"total":3}`, string(value))
+
+	_, found, err = r.Get(ctx, "jobs:rust")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	_, _, err = r.Get(ctx, "jobs:python")
+	var redisErr *RedisError
+	require.ErrorAs(t, err, &redisErr)
+	assert.Contains(t, redisErr.Message, "WRONGTYPE")
+
+	version, err := r.Incr(ctx, "jobs:version")
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), version)
+}
+
+func TestRedis_Unreachable(t *testing.T) {
+	t.Parallel()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	listener.Close()
+
+	r, err := NewRedis("redis://" + address)
+	require.NoError(t, err)
+
+	err = r.Ping(context.Background())
+	require.ErrorContains(t, err, "failed to connect to Redis")
+}
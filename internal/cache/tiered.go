@@ -0,0 +1,221 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// Defaults of the tiered cache
+const (
+	DefaultLocalMaxEntries = 1000
+	DefaultRetryRemote     = 30 * time.Second
+	DefaultLoadTimeout     = 30 * time.Second
+)
+
+// Remote interface to store the values shared by the instances of the server, e.g. Redis.
+type Remote interface {
+	// Get returns the value stored for key and whether there is one
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Incr increments the integer stored for key, 0 when missing, and returns its new value
+	Incr(ctx context.Context, key string) (int64, error)
+}
+
+// TieredConfig configures a Tiered cache
+type TieredConfig struct {
+	// TTL is how long a value is kept, in both tiers, changed with SetTTL
+	TTL time.Duration
+	// LocalMaxEntries bounds the number of values kept in memory
+	LocalMaxEntries int
+	// RetryRemote is how long the remote tier is skipped once it failed
+	RetryRemote time.Duration
+	// LoadTimeout bounds a load, shared by the concurrent callers missing the same key
+	LoadTimeout time.Duration
+	// OnRemoteError is called when the remote tier fails and is skipped for RetryRemote, optional
+	OnRemoteError func(err error)
+	// VersionKey is the remote key holding the version of the remote values, which is part of their
+	// keys, so bumping it drops them for all the instances at once. Empty not to version them.
+	VersionKey string
+}
+
+// Tiered is a cache of encoded values with an in-memory LRU in front of a remote cache. Values
+// missing from memory are read from the remote cache and loaded when missing from it too. The
+// concurrent callers missing the same key share a single read and load, so a popular key expiring
+// doesn't send a stampede to what it is loaded from. When the remote cache fails it is skipped for
+// a while, the values being cached in memory only.
+type Tiered struct {
+	local  *LRU[string, []byte]
+	remote Remote
+	cfg    TieredConfig
+	group  singleflight.Group
+
+	mu sync.Mutex
+	// remoteDownUntil is when the remote tier is tried again after a failure
+	remoteDownUntil time.Time
+	// remoteHits counts the values read from the remote tier, which were local misses
+	remoteHits int64
+	now        func() time.Time
+}
+
+// NewTiered creates a new instance of Tiered in front of remote, nil to cache in memory only. Unset
+// options take their defaults.
+func NewTiered(remote Remote, cfg TieredConfig) *Tiered {
+	if cfg.LocalMaxEntries <= 0 {
+		cfg.LocalMaxEntries = DefaultLocalMaxEntries
+	}
+	if cfg.RetryRemote <= 0 {
+		cfg.RetryRemote = DefaultRetryRemote
+	}
+	if cfg.LoadTimeout <= 0 {
+		cfg.LoadTimeout = DefaultLoadTimeout
+	}
+	return &Tiered{
+		local:  NewLRU[string, []byte](cfg.LocalMaxEntries, cfg.TTL),
+		remote: remote,
+		cfg:    cfg,
+		now:    time.Now,
+	}
+}
+
+// GetOrLoad returns the value cached for key or loads and caches it. Errors are not cached. The
+// load is shared by the concurrent callers, so it runs with a context of its own, bounded by
+// LoadTimeout, carrying the values of ctx but not its cancellation.
+func (t *Tiered) GetOrLoad(ctx context.Context, key string, load func(ctx context.Context) ([]byte, error)) (
+	[]byte, error) {
+	if value, ok := t.local.Get(key); ok {
+		return value, nil
+	}
+
+	result := t.group.DoChan(key, func() (any, error) {
+		loadCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), t.cfg.LoadTimeout)
+		defer cancel()
+
+		// The version is read before loading, so a value loaded while it is bumped is stored as outdated
+		remoteKey := t.remoteKey(loadCtx, key)
+		if value, ok := t.getRemote(loadCtx, remoteKey); ok {
+			t.local.Set(key, value)
+			return value, nil
+		}
+		value, err := load(loadCtx)
+		if err != nil {
+			return nil, err
+		}
+		t.local.Set(key, value)
+		t.setRemote(loadCtx, remoteKey, value)
+		return value, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-result:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.([]byte), nil
+	}
+}
+
+// SetTTL changes how long the values cached from now on are kept, the ones already cached keep their expiry
+func (t *Tiered) SetTTL(ttl time.Duration) {
+	t.local.SetTTL(ttl)
+}
+
+// Clear removes the values cached in memory, the remote ones expire on their own unless the version
+// is bumped, see BumpVersion
+func (t *Tiered) Clear() {
+	t.local.Clear()
+}
+
+// BumpVersion increments the version of the remote values, so the ones stored before are read by
+// no instance anymore and expire on their own. It does nothing without a remote tier or a VersionKey.
+// The remote tier is tried even when skipped after a failure, a missed bump serving outdated values.
+func (t *Tiered) BumpVersion(ctx context.Context) error {
+	if t.remote == nil || t.cfg.VersionKey == "" {
+		return nil
+	}
+	if _, err := t.remote.Incr(ctx, t.cfg.VersionKey); err != nil {
+		t.remoteFailed(err)
+		return fmt.Errorf("failed to bump the cache version: %w", err)
+	}
+	return nil
+}
+
+// Stats returns the counters of the cache, a value read from the remote tier being a hit
+func (t *Tiered) Stats() Stats {
+	stats := t.local.Stats()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	stats.Hits += t.remoteHits
+	stats.Misses -= t.remoteHits
+	return stats
+}
+
+// RemoteAvailable reports whether the remote tier is used, false without one or after a recent failure
+func (t *Tiered) RemoteAvailable() bool {
+	if t.remote == nil {
+		return false
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return !t.now().Before(t.remoteDownUntil)
+}
+
+// remoteKey returns the key of the remote tier for key, suffixed with the current version of the
+// remote values when they are versioned. The version is 0 until it is bumped.
+func (t *Tiered) remoteKey(ctx context.Context, key string) string {
+	if t.cfg.VersionKey == "" || !t.RemoteAvailable() {
+		return key
+	}
+	version, found, err := t.remote.Get(ctx, t.cfg.VersionKey)
+	if err != nil {
+		t.remoteFailed(err)
+		return key
+	}
+	if !found {
+		return key + ":v0"
+	}
+	return key + ":v" + string(version)
+}
+
+// getRemote reads key from the remote tier, unless it is skipped
+func (t *Tiered) getRemote(ctx context.Context, key string) ([]byte, bool) {
+	if !t.RemoteAvailable() {
+		return nil, false
+	}
+	value, found, err := t.remote.Get(ctx, key)
+	if err != nil {
+		t.remoteFailed(err)
+		return nil, false
+	}
+	if found {
+		t.mu.Lock()
+		t.remoteHits++
+		t.mu.Unlock()
+	}
+	return value, found
+}
+
+// setRemote stores key in the remote tier, unless it is skipped
+func (t *Tiered) setRemote(ctx context.Context, key string, value []byte) {
+	if !t.RemoteAvailable() {
+		return
+	}
+	if err := t.remote.Set(ctx, key, value, t.local.TTL()); err != nil {
+		t.remoteFailed(err)
+	}
+}
+
+// remoteFailed skips the remote tier for RetryRemote
+func (t *Tiered) remoteFailed(err error) {
+	t.mu.Lock()
+	t.remoteDownUntil = t.now().Add(t.cfg.RetryRemote)
+	t.mu.Unlock()
+	if t.cfg.OnRemoteError != nil {
+		t.cfg.OnRemoteError(err)
+	}
+}
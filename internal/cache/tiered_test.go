@@ -0,0 +1,179 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTiered_GetOrLoad(t *testing.T) {
+	t.Parallel()
+	remoteErr := errors.New("connection refused")
+
+	tests := []struct {
+		name          string
+		setupMock     func(remote *MockRemote)
+		expectedLoads int
+		remoteUp      bool
+	}{
+		{
+			name: "loaded and cached in both tiers",
+			setupMock: func(remote *MockRemote) {
+				remote.EXPECT().Get(mock.Anything, "jobs:golang").Return(nil, false, nil).Once()
+				remote.EXPECT().Set(mock.Anything, "jobs:golang", []byte("loaded"), time.Minute).Return(nil).Once()
+			},
+			expectedLoads: 1,
+			remoteUp:      true,
+		},
+		{
+			name: "read from the remote tier",
+			setupMock: func(remote *MockRemote) {
+				remote.EXPECT().Get(mock.Anything, "jobs:golang").Return([]byte("loaded"), true, nil).Once()
+			},
+			remoteUp: true,
+		},
+		{
+			name: "remote tier down",
+			setupMock: func(remote *MockRemote) {
+				// The write is skipped once the read failed
+				remote.EXPECT().Get(mock.Anything, "jobs:golang").Return(nil, false, remoteErr).Once()
+			},
+			expectedLoads: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			remote := NewMockRemote(t)
+			tt.setupMock(remote)
+			var remoteErrors []error
+			c := NewTiered(remote, TieredConfig{
+				TTL:           time.Minute,
+				OnRemoteError: func(err error) { remoteErrors = append(remoteErrors, err) },
+			})
+
+			loads := 0
+			load := func(_ context.Context) ([]byte, error) {
+				loads++
+				return []byte("loaded"), nil
+			}
+			// The second call is served from memory
+			for range 2 {
+				value, err := c.GetOrLoad(context.Background(), "jobs:golang", load)
+				require.NoError(t, err)
+				assert.Equal(t, []byte("loaded"), value)
+			}
+
+			assert.Equal(t, tt.expectedLoads, loads)
+			assert.Equal(t, tt.remoteUp, c.RemoteAvailable())
+			if !tt.remoteUp {
+				assert.Equal(t, []error{remoteErr}, remoteErrors)
+			}
+			assert.Equal(t, int64(tt.expectedLoads), c.Stats().Misses)
+		})
+	}
+}
+
+func TestTiered_BumpVersion(t *testing.T) {
+	t.Parallel()
+	remote := NewMockRemote(t)
+	c := NewTiered(remote, TieredConfig{TTL: time.Minute, VersionKey: "jobs:version"})
+	load := func(_ context.Context) ([]byte, error) { return []byte("loaded"), nil }
+
+	// Values are stored under version 0 until it is bumped
+	remote.EXPECT().Get(mock.Anything, "jobs:version").Return(nil, false, nil).Once()
+	remote.EXPECT().Get(mock.Anything, "jobs:golang:v0").Return(nil, false, nil).Once()
+	remote.EXPECT().Set(mock.Anything, "jobs:golang:v0", []byte("loaded"), time.Minute).Return(nil).Once()
+	_, err := c.GetOrLoad(context.Background(), "jobs:golang", load)
+	require.NoError(t, err)
+
+	remote.EXPECT().Incr(mock.Anything, "jobs:version").Return(1, nil).Once()
+	c.Clear()
+	require.NoError(t, c.BumpVersion(context.Background()))
+
+	// The value stored before is not read anymore
+	remote.EXPECT().Get(mock.Anything, "jobs:version").Return([]byte("1"), true, nil).Once()
+	remote.EXPECT().Get(mock.Anything, "jobs:golang:v1").Return(nil, false, nil).Once()
+	remote.EXPECT().Set(mock.Anything, "jobs:golang:v1", []byte("loaded"), time.Minute).Return(nil).Once()
+	_, err = c.GetOrLoad(context.Background(), "jobs:golang", load)
+	require.NoError(t, err)
+
+	remote.EXPECT().Incr(mock.Anything, "jobs:version").Return(0, errors.New("timeout")).Once()
+	require.Error(t, c.BumpVersion(context.Background()))
+	assert.False(t, c.RemoteAvailable())
+
+	// Without a version key there is nothing to bump
+	require.NoError(t, NewTiered(NewMockRemote(t), TieredConfig{TTL: time.Minute}).BumpVersion(context.Background()))
+}
+
+func TestTiered_RetryRemote(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	remote := NewMockRemote(t)
+	remote.EXPECT().Get(mock.Anything, "jobs:golang").Return(nil, false, errors.New("timeout")).Once()
+	c := NewTiered(remote, TieredConfig{TTL: time.Second, RetryRemote: time.Minute})
+	c.now = func() time.Time { return now }
+
+	load := func(_ context.Context) ([]byte, error) { return []byte("loaded"), nil }
+	_, err := c.GetOrLoad(context.Background(), "jobs:golang", load)
+	require.NoError(t, err)
+	assert.False(t, c.RemoteAvailable())
+
+	now = now.Add(time.Minute)
+	assert.True(t, c.RemoteAvailable())
+}
+
+func TestTiered_GetOrLoadConcurrent(t *testing.T) {
+	t.Parallel()
+	c := NewTiered(nil, TieredConfig{TTL: time.Minute})
+
+	var loads atomic.Int32
+	release := make(chan struct{})
+	load := func(_ context.Context) ([]byte, error) {
+		loads.Add(1)
+		<-release
+		return []byte("loaded"), nil
+	}
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, err := c.GetOrLoad(context.Background(), "jobs:golang", load)
+			assert.NoError(t, err)
+			assert.Equal(t, []byte("loaded"), value)
+		}()
+	}
+	// Let the callers reach the shared load before it returns
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), loads.Load())
+}
+
+func TestTiered_GetOrLoadError(t *testing.T) {
+	t.Parallel()
+	loadErr := errors.New("load error")
+	c := NewTiered(nil, TieredConfig{TTL: time.Minute})
+
+	_, err := c.GetOrLoad(context.Background(), "jobs:golang", func(_ context.Context) ([]byte, error) {
+		return nil, loadErr
+	})
+	require.ErrorIs(t, err, loadErr)
+
+	value, err := c.GetOrLoad(context.Background(), "jobs:golang", func(_ context.Context) ([]byte, error) {
+		return []byte("loaded"), nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("loaded"), value)
+}
@@ -0,0 +1,98 @@
+package company
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+)
+
+// ArchiveRepository interface to archive companies along with their jobs.
+type ArchiveRepository interface {
+	GetBySlug(ctx context.Context, slug string) (*Company, error)
+	Deactivate(ctx context.Context, id int) ([]jobs.Job, error)
+}
+
+// SearchViewRefresher interface to rebuild the job search view once jobs were taken down.
+type SearchViewRefresher interface {
+	RefreshSearchView(ctx context.Context) error
+}
+
+// ArchiveService archives companies that shut down or leave the market. Archived companies are
+// kept for the history of their jobs but are hidden from the public API.
+type ArchiveService struct {
+	repo       ArchiveRepository
+	searchView SearchViewRefresher
+	indexer    jobs.SearchIndexer
+	publisher  jobs.EventPublisher
+}
+
+// NewArchiveService creates a new instance of ArchiveService. indexer is optional, it is only
+// needed when jobs are searched from an external backend. publisher is optional too.
+func NewArchiveService(repo ArchiveRepository, searchView SearchViewRefresher, indexer jobs.SearchIndexer,
+	publisher jobs.EventPublisher) *ArchiveService {
+	return &ArchiveService{repo: repo, searchView: searchView, indexer: indexer, publisher: publisher}
+}
+
+// Deactivate marks a company inactive and takes down all its active jobs, returning how many
+// jobs were taken down. A NotActiveError is returned when the company is already inactive.
+func (s *ArchiveService) Deactivate(ctx context.Context, slug string) (int, error) {
+	slug = strings.ToLower(strings.TrimSpace(slug))
+
+	company, err := s.repo.GetBySlug(ctx, slug)
+	if err != nil {
+		return 0, err
+	}
+	if !company.IsActive {
+		return 0, &NotActiveError{Slug: slug}
+	}
+
+	deactivated, err := s.repo.Deactivate(ctx, company.ID)
+	if err != nil {
+		return 0, err
+	}
+	if len(deactivated) == 0 {
+		return 0, nil
+	}
+
+	if err := s.reindex(ctx, deactivated); err != nil {
+		return 0, err
+	}
+
+	return len(deactivated), s.publish(ctx, deactivated)
+}
+
+// reindex updates the search view and the external search index after jobs were taken down
+func (s *ArchiveService) reindex(ctx context.Context, deactivated []jobs.Job) error {
+	if err := s.searchView.RefreshSearchView(ctx); err != nil {
+		return err
+	}
+	if s.indexer == nil {
+		return nil
+	}
+
+	ids := make([]int, 0, len(deactivated))
+	for i := range deactivated {
+		ids = append(ids, deactivated[i].ID)
+	}
+	return s.indexer.IndexJobs(ctx, ids)
+}
+
+// publish notifies the publisher, when set, of the jobs taken down. Every job is attempted;
+// failures are returned together.
+func (s *ArchiveService) publish(ctx context.Context, deactivated []jobs.Job) error {
+	if s.publisher == nil {
+		return nil
+	}
+
+	var errs []error
+	for i := range deactivated {
+		job := &deactivated[i]
+		if err := s.publisher.PublishJobEvent(ctx, jobs.EventDeactivated, job); err != nil {
+			errs = append(errs, fmt.Errorf("job %d: %w", job.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
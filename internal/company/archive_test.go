@@ -0,0 +1,125 @@
+package company
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+)
+
+func TestArchiveService_Deactivate(t *testing.T) {
+	t.Parallel()
+	publishError := errors.New("publish error")
+	activeCompany := &Company{ID: 1, Name: "Tech Corp", Slug: "tech-corp", IsActive: true}
+	deactivated := []jobs.Job{{ID: 101, CompanyID: 1}, {ID: 102, CompanyID: 1}}
+
+	tests := []struct {
+		name      string
+		mockSetup func(mockRepo *MockArchiveRepository, mockView *MockSearchViewRefresher,
+			mockIndexer *jobs.MockSearchIndexer, mockPublisher *jobs.MockEventPublisher)
+		checkResults func(t *testing.T, count int, err error)
+	}{
+		{
+			name: "company archived and its jobs taken down",
+			mockSetup: func(mockRepo *MockArchiveRepository, mockView *MockSearchViewRefresher,
+				mockIndexer *jobs.MockSearchIndexer, mockPublisher *jobs.MockEventPublisher) {
+				t.Helper()
+				mockRepo.EXPECT().GetBySlug(context.Background(), "tech-corp").Return(activeCompany, nil).Once()
+				mockRepo.EXPECT().Deactivate(context.Background(), 1).Return(deactivated, nil).Once()
+				mockView.EXPECT().RefreshSearchView(context.Background()).Return(nil).Once()
+				mockIndexer.EXPECT().IndexJobs(context.Background(), []int{101, 102}).Return(nil).Once()
+				mockPublisher.EXPECT().PublishJobEvent(context.Background(), jobs.EventDeactivated, mock.Anything).
+					Return(nil).Twice()
+			},
+			checkResults: func(t *testing.T, count int, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 2, count)
+			},
+		},
+		{
+			name: "company without active jobs",
+			mockSetup: func(mockRepo *MockArchiveRepository, _ *MockSearchViewRefresher,
+				_ *jobs.MockSearchIndexer, _ *jobs.MockEventPublisher) {
+				t.Helper()
+				mockRepo.EXPECT().GetBySlug(context.Background(), "tech-corp").Return(activeCompany, nil).Once()
+				mockRepo.EXPECT().Deactivate(context.Background(), 1).Return(nil, nil).Once()
+			},
+			checkResults: func(t *testing.T, count int, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Zero(t, count)
+			},
+		},
+		{
+			name: "company already archived",
+			mockSetup: func(mockRepo *MockArchiveRepository, _ *MockSearchViewRefresher,
+				_ *jobs.MockSearchIndexer, _ *jobs.MockEventPublisher) {
+				t.Helper()
+				mockRepo.EXPECT().GetBySlug(context.Background(), "tech-corp").
+					Return(&Company{ID: 1, Slug: "tech-corp"}, nil).Once()
+			},
+			checkResults: func(t *testing.T, _ int, err error) {
+				t.Helper()
+				assert.True(t, IsNotActive(err))
+				require.ErrorIs(t, err, httpservice.ErrConflict)
+			},
+		},
+		{
+			name: "unknown company",
+			mockSetup: func(mockRepo *MockArchiveRepository, _ *MockSearchViewRefresher,
+				_ *jobs.MockSearchIndexer, _ *jobs.MockEventPublisher) {
+				t.Helper()
+				mockRepo.EXPECT().GetBySlug(context.Background(), "tech-corp").
+					Return(nil, &NotFoundError{Slug: "tech-corp"}).Once()
+			},
+			checkResults: func(t *testing.T, _ int, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, httpservice.ErrNotFound)
+			},
+		},
+		{
+			name: "publish errors are returned together",
+			mockSetup: func(mockRepo *MockArchiveRepository, mockView *MockSearchViewRefresher,
+				mockIndexer *jobs.MockSearchIndexer, mockPublisher *jobs.MockEventPublisher) {
+				t.Helper()
+				mockRepo.EXPECT().GetBySlug(context.Background(), "tech-corp").Return(activeCompany, nil).Once()
+				mockRepo.EXPECT().Deactivate(context.Background(), 1).Return(deactivated, nil).Once()
+				mockView.EXPECT().RefreshSearchView(context.Background()).Return(nil).Once()
+				mockIndexer.EXPECT().IndexJobs(context.Background(), []int{101, 102}).Return(nil).Once()
+				mockPublisher.EXPECT().PublishJobEvent(context.Background(), jobs.EventDeactivated, &deactivated[0]).
+					Return(publishError).Once()
+				mockPublisher.EXPECT().PublishJobEvent(context.Background(), jobs.EventDeactivated, &deactivated[1]).
+					Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, count int, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, publishError)
+				assert.Contains(t, err.Error(), "job 101")
+				assert.Equal(t, 2, count)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockArchiveRepository(t)
+			mockView := NewMockSearchViewRefresher(t)
+			mockIndexer := jobs.NewMockSearchIndexer(t)
+			mockPublisher := jobs.NewMockEventPublisher(t)
+			service := NewArchiveService(mockRepo, mockView, mockIndexer, mockPublisher)
+
+			tt.mockSetup(mockRepo, mockView, mockIndexer, mockPublisher)
+
+			count, err := service.Deactivate(context.Background(), " Tech-Corp ")
+			tt.checkResults(t, count, err)
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package company
+
+// Data Transfer Objects (DTOs) for the company API layer.
+// Public responses identify companies by slug, never by their numeric ID.
+
+// CompanyResponse represents the public API response for a company
+type CompanyResponse struct {
+	Slug    string `json:"slug" example:"tech-corp"`
+	Name    string `json:"name" example:"Tech Corp"`
+	LogoURL string `json:"logo_url" example:"https://techcorp.com/logo.png"`
+	// WebsiteURL is the website of the company, omitted when unknown
+	WebsiteURL string `json:"website_url,omitempty" example:"https://techcorp.com"`
+	// Country is the ISO 3166-1 alpha-2 code of the country where the company hires
+	Country string `json:"country" example:"CR"`
+}
+
+// TechnologyCountResponse represents a technology of the stack of a company
+type TechnologyCountResponse struct {
+	Name     string `json:"name" example:"go"`
+	Category string `json:"category" example:"programming"`
+	// Jobs is the number of active jobs of the company using the technology
+	Jobs int `json:"jobs" example:"4"`
+	// RequiredJobs is the number of those jobs requiring it
+	RequiredJobs int `json:"required_jobs" example:"3"`
+}
+
+// TechnologyStackResponse represents the API response listing the technologies of a company
+type TechnologyStackResponse struct {
+	Company *CompanyResponse           `json:"company"`
+	Data    []*TechnologyCountResponse `json:"data"`
+}
+
+// CompanyCreateRequest represents the request body to create a company
+type CompanyCreateRequest struct {
+	Name       string `json:"name" binding:"required,notblank,max=255" example:"Tech Corp"`
+	LogoURL    string `json:"logo_url" binding:"required,url,max=255" example:"https://techcorp.com/logo.png"`
+	WebsiteURL string `json:"website_url" binding:"omitempty,url,max=255" example:"https://techcorp.com"`
+	// Country is the ISO 3166-1 alpha-2 code of the country where the company hires, CR when omitted
+	Country string `json:"country" binding:"omitempty,iso3166_1_alpha2" example:"CR"`
+	// IgnoreSimilar creates the company even when similar companies exist
+	IgnoreSimilar bool `json:"ignore_similar" example:"false"`
+}
+
+// ToCompany converts a CompanyCreateRequest to an active Company
+func (req *CompanyCreateRequest) ToCompany() *Company {
+	return &Company{
+		Name:       req.Name,
+		LogoURL:    req.LogoURL,
+		WebsiteURL: req.WebsiteURL,
+		Country:    req.Country,
+		IsActive:   true,
+	}
+}
+
+// CompanyPatchRequest represents the request body to change some fields of a company. Omitted
+// fields are left as they are.
+type CompanyPatchRequest struct {
+	Name    *string `json:"name" binding:"omitempty,notblank,max=255" example:"Tech Corp"`
+	LogoURL *string `json:"logo_url" binding:"omitempty,url,max=255" example:"https://techcorp.com/logo.png"`
+	// WebsiteURL is removed when empty
+	WebsiteURL *string `json:"website_url" binding:"omitempty,max=255" example:"https://techcorp.com"`
+}
+
+// ToCompanyPatch converts a CompanyPatchRequest to a CompanyPatch
+func (req *CompanyPatchRequest) ToCompanyPatch() *CompanyPatch {
+	return &CompanyPatch{Name: req.Name, LogoURL: req.LogoURL, WebsiteURL: req.WebsiteURL}
+}
+
+// DeactivateResponse represents the API response of a company deactivation
+type DeactivateResponse struct {
+	Slug string `json:"slug" example:"tech-corp"`
+	// DeactivatedJobs is the number of active jobs of the company taken down
+	DeactivatedJobs int `json:"deactivated_jobs" example:"3"`
+}
+
+// MergeRequest represents the request body to merge a duplicate company into another one
+type MergeRequest struct {
+	// Into is the slug of the company the duplicate is merged into
+	Into string `json:"into" binding:"required,notblank" example:"equifax"`
+}
+
+// MergeResponse represents the API response of a company merge
+type MergeResponse struct {
+	Company *CompanyResponse `json:"company"`
+	// MergedSlug is the slug of the duplicate company, deleted
+	MergedSlug string `json:"merged_slug" example:"equifax-cr"`
+	// MovedJobs is the number of jobs of the duplicate moved to the company
+	MovedJobs int `json:"moved_jobs" example:"4"`
+}
+
+// MapCompanyToResponse converts a company database model to its public API response format
+func MapCompanyToResponse(company *Company) *CompanyResponse {
+	return &CompanyResponse{
+		Slug:       company.Slug,
+		Name:       company.Name,
+		LogoURL:    company.LogoURL,
+		WebsiteURL: company.WebsiteURL,
+		Country:    company.Country,
+	}
+}
+
+// MapMergeResultToResponse converts the merge of the company with mergedSlug to the API response format
+func MapMergeResultToResponse(mergedSlug string, result *MergeResult) *MergeResponse {
+	return &MergeResponse{
+		Company:    MapCompanyToResponse(result.Company),
+		MergedSlug: mergedSlug,
+		MovedJobs:  len(result.MovedJobs),
+	}
+}
+
+// MapTechnologyStackToResponse converts a company and its technologies to the API response format
+func MapTechnologyStackToResponse(company *Company, technologies []*TechnologyCount) *TechnologyStackResponse {
+	data := make([]*TechnologyCountResponse, 0, len(technologies))
+	for _, technology := range technologies {
+		data = append(data, &TechnologyCountResponse{
+			Name:         technology.Name,
+			Category:     technology.Category,
+			Jobs:         technology.Jobs,
+			RequiredJobs: technology.RequiredJobs,
+		})
+	}
+	return &TechnologyStackResponse{Company: MapCompanyToResponse(company), Data: data}
+}
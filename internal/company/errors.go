@@ -5,38 +5,125 @@ package company
 import (
 	"errors"
 	"fmt"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
 )
 
 // NotFoundError represents a company not found error
 type NotFoundError struct {
 	ID   int
 	Name string
+	Slug string
 }
 
 func (e NotFoundError) Error() string {
 	if e.ID > 0 {
 		return fmt.Sprintf("company with ID %d not found", e.ID)
 	}
+	if e.Slug != "" {
+		return fmt.Sprintf("company with slug %s not found", e.Slug)
+	}
 	return fmt.Sprintf("company with name %s not found", e.Name)
 }
 
+// Is matches httpservice.ErrNotFound
+func (e NotFoundError) Is(target error) bool {
+	return target == httpservice.ErrNotFound
+}
+
 // IsNotFound checks if an error is a company not found error
 func IsNotFound(err error) bool {
 	var notFoundErr *NotFoundError
 	return errors.As(err, &notFoundErr)
 }
 
-// DuplicateError represents a duplicate company error
+// DuplicateError represents a duplicate company error.
+// Slug is set when the conflict is on the slug rather than on the name.
 type DuplicateError struct {
 	Name string
+	Slug string
 }
 
 func (e DuplicateError) Error() string {
+	if e.Slug != "" {
+		return fmt.Sprintf("company with slug %s already exists", e.Slug)
+	}
 	return fmt.Sprintf("company with name %s already exists", e.Name)
 }
 
+// Is matches httpservice.ErrConflict
+func (e DuplicateError) Is(target error) bool {
+	return target == httpservice.ErrConflict
+}
+
 // IsDuplicate checks if an error is a duplicate company error
 func IsDuplicate(err error) bool {
 	var duplicateErr *DuplicateError
 	return errors.As(err, &duplicateErr)
 }
+
+// SimilarError represents the creation of a company that may duplicate existing ones, e.g. "Equifax CR"
+// when "EquiFax" exists
+type SimilarError struct {
+	Name    string
+	Similar []*SimilarCompany
+}
+
+func (e SimilarError) Error() string {
+	return fmt.Sprintf("company %s may duplicate %d existing companies", e.Name, len(e.Similar))
+}
+
+// Details lists the similar companies, returned in the error response
+func (e SimilarError) Details() []string {
+	details := make([]string, 0, len(e.Similar))
+	for _, similar := range e.Similar {
+		details = append(details, fmt.Sprintf("%s (%s): similar %s", similar.Company.Name, similar.Company.Slug,
+			similar.Reason))
+	}
+	return details
+}
+
+// Is matches httpservice.ErrConflict
+func (e SimilarError) Is(target error) bool {
+	return target == httpservice.ErrConflict
+}
+
+// IsSimilar checks if an error is a similar company error
+func IsSimilar(err error) bool {
+	var similarErr *SimilarError
+	return errors.As(err, &similarErr)
+}
+
+// SelfMergeError represents the merge of a company into itself
+type SelfMergeError struct {
+	Slug string
+}
+
+func (e SelfMergeError) Error() string {
+	return fmt.Sprintf("company with slug %s can't be merged into itself", e.Slug)
+}
+
+// Is matches httpservice.ErrInvalid
+func (e SelfMergeError) Is(target error) bool {
+	return target == httpservice.ErrInvalid
+}
+
+// NotActiveError represents a deactivation of a company that isn't active
+type NotActiveError struct {
+	Slug string
+}
+
+func (e NotActiveError) Error() string {
+	return fmt.Sprintf("company with slug %s is not active", e.Slug)
+}
+
+// Is matches httpservice.ErrConflict
+func (e NotActiveError) Is(target error) bool {
+	return target == httpservice.ErrConflict
+}
+
+// IsNotActive checks if an error is a not active company error
+func IsNotActive(err error) bool {
+	var notActiveErr *NotActiveError
+	return errors.As(err, &notActiveErr)
+}
@@ -0,0 +1,224 @@
+package company
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// Constants for company routes and endpoints
+const (
+	CompaniesRoute = "/companies"
+	CompanyPath    = CompaniesRoute + "/:slug"
+	// CompanyTechnologiesPath serves the technology stack of a company
+	CompanyTechnologiesPath = CompanyPath + "/technologies"
+	// DeactivateCompanyRoute is served under the admin group
+	DeactivateCompanyRoute = CompanyPath + "/deactivate"
+	// MergeCompanyRoute is served under the admin group
+	MergeCompanyRoute = CompanyPath + "/merge"
+)
+
+// Handler handles HTTP requests for company operations
+type Handler struct {
+	service *CompanyService
+}
+
+// NewHandler creates a new company handler
+func NewHandler(service *CompanyService) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers company routes with the given router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.GET(CompanyPath, h.GetCompany)
+	rg.GET(CompanyTechnologiesPath, h.GetCompanyTechnologies)
+}
+
+// GetCompany godoc
+// @Summary Get a company
+// @Description Get the public profile of a company by its URL slug
+// @Tags companies
+// @Produce json
+// @Param slug path string true "Company slug" example("tech-corp")
+// @Success 200 {object} CompanyResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /companies/{slug} [get]
+func (h *Handler) GetCompany(c *gin.Context) {
+	company, err := h.service.GetBySlug(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapCompanyToResponse(company))
+}
+
+// GetCompanyTechnologies godoc
+// @Summary Get the technology stack of a company
+// @Description Lists the distinct technologies of the active jobs of a company, most used first,
+// @Description with the number of jobs using and requiring each of them
+// @Tags companies
+// @Produce json
+// @Param slug path string true "Company slug" example("tech-corp")
+// @Success 200 {object} TechnologyStackResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /companies/{slug}/technologies [get]
+func (h *Handler) GetCompanyTechnologies(c *gin.Context) {
+	company, technologies, err := h.service.GetTechnologyStack(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapTechnologyStackToResponse(company, technologies))
+}
+
+// AdminHandler handles HTTP requests for company administration
+type AdminHandler struct {
+	service *CompanyService
+	archive *ArchiveService
+	merge   *MergeService
+}
+
+// NewAdminHandler creates a new company admin handler
+func NewAdminHandler(service *CompanyService, archive *ArchiveService, merge *MergeService) *AdminHandler {
+	return &AdminHandler{service: service, archive: archive, merge: merge}
+}
+
+// RegisterAdminRoutes registers the company admin routes with the given (protected) router group
+func (h *AdminHandler) RegisterAdminRoutes(rg *gin.RouterGroup) {
+	rg.POST(CompaniesRoute, h.CreateCompany)
+	rg.PATCH(CompanyPath, h.PatchCompany)
+	rg.POST(DeactivateCompanyRoute, h.DeactivateCompany)
+	rg.POST(MergeCompanyRoute, h.MergeCompany)
+}
+
+// CreateCompany godoc
+// @Summary Create a company
+// @Description Creates a company. Companies that may duplicate existing ones, by a name differing only in
+// @Description case, accents or a legal or country suffix ("Equifax CR" and "EquiFax") or by the domain of
+// @Description their website, are rejected with 409 listing them, unless ignore_similar is set.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security AdminAPIKey
+// @Param request body CompanyCreateRequest true "Company to create"
+// @Success 201 {object} CompanyResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 409 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/companies [post]
+func (h *AdminHandler) CreateCompany(c *gin.Context) {
+	var req CompanyCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	company := req.ToCompany()
+	create := h.service.Create
+	if req.IgnoreSimilar {
+		create = h.service.CreateSimilar
+	}
+	if err := create(c.Request.Context(), company); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, MapCompanyToResponse(company))
+}
+
+// PatchCompany godoc
+// @Summary Change some fields of a company
+// @Description Changes the fields of a company present in the request, e.g. to fix a typo, leaving the
+// @Description others as they are. The slug is kept, and a renamed company keeps its former name as an alias.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security AdminAPIKey
+// @Param slug path string true "Company slug" example("tech-corp")
+// @Param request body CompanyPatchRequest true "Fields to change"
+// @Success 200 {object} CompanyResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 409 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/companies/{slug} [patch]
+func (h *AdminHandler) PatchCompany(c *gin.Context) {
+	var req CompanyPatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	company, err := h.service.Patch(c.Request.Context(), c.Param("slug"), req.ToCompanyPatch())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapCompanyToResponse(company))
+}
+
+// DeactivateCompany godoc
+// @Summary Deactivate a company
+// @Description Archives a company that shut down or left the market, taking down all its active jobs
+// @Description and rejecting its pending ones.
+// @Description The company is hidden from the public API afterwards.
+// @Tags admin
+// @Produce json
+// @Security AdminAPIKey
+// @Param slug path string true "Company slug" example("tech-corp")
+// @Success 200 {object} DeactivateResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 409 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/companies/{slug}/deactivate [post]
+func (h *AdminHandler) DeactivateCompany(c *gin.Context) {
+	deactivatedJobs, err := h.archive.Deactivate(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, &DeactivateResponse{Slug: c.Param("slug"), DeactivatedJobs: deactivatedJobs})
+}
+
+// MergeCompany godoc
+// @Summary Merge a duplicate company into another one
+// @Description Moves the jobs, aliases, claims and members of a company created twice to the company
+// @Description it duplicates, keeping its name as an alias of that company, and deletes it.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security AdminAPIKey
+// @Param slug path string true "Slug of the duplicate company" example("equifax-cr")
+// @Param request body MergeRequest true "Company to merge into"
+// @Success 200 {object} MergeResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/companies/{slug}/merge [post]
+func (h *AdminHandler) MergeCompany(c *gin.Context) {
+	var req MergeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	result, err := h.merge.Merge(c.Request.Context(), c.Param("slug"), req.Into)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapMergeResultToResponse(c.Param("slug"), result))
+}
@@ -0,0 +1,70 @@
+package company
+
+import (
+	"context"
+	"strings"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+)
+
+// MergeRepository interface to merge duplicate companies.
+type MergeRepository interface {
+	GetBySlug(ctx context.Context, slug string) (*Company, error)
+	Merge(ctx context.Context, source *Company, targetID int) ([]int, error)
+}
+
+// MergeService merges the companies created twice, e.g. "EquiFax" and "Equifax CR" created by
+// different scrapers, into one.
+type MergeService struct {
+	repo       MergeRepository
+	searchView SearchViewRefresher
+	indexer    jobs.SearchIndexer
+}
+
+// NewMergeService creates a new instance of MergeService. indexer is optional, it is only needed
+// when jobs are searched from an external backend.
+func NewMergeService(repo MergeRepository, searchView SearchViewRefresher, indexer jobs.SearchIndexer) *MergeService {
+	return &MergeService{repo: repo, searchView: searchView, indexer: indexer}
+}
+
+// Merge moves everything of the duplicate company found by slug to the company found by intoSlug,
+// keeping the name of the duplicate as an alias, then deletes the duplicate.
+func (s *MergeService) Merge(ctx context.Context, slug, intoSlug string) (*MergeResult, error) {
+	slug = strings.ToLower(strings.TrimSpace(slug))
+	intoSlug = strings.ToLower(strings.TrimSpace(intoSlug))
+	if slug == intoSlug {
+		return nil, &SelfMergeError{Slug: slug}
+	}
+
+	source, err := s.repo.GetBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	target, err := s.repo.GetBySlug(ctx, intoSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	moved, err := s.repo.Merge(ctx, source, target.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(moved) > 0 {
+		if err = s.reindex(ctx, moved); err != nil {
+			return nil, err
+		}
+	}
+
+	return &MergeResult{Company: target, MovedJobs: moved}, nil
+}
+
+// reindex updates the search view and the external search index after jobs changed company
+func (s *MergeService) reindex(ctx context.Context, moved []int) error {
+	if err := s.searchView.RefreshSearchView(ctx); err != nil {
+		return err
+	}
+	if s.indexer == nil {
+		return nil
+	}
+	return s.indexer.IndexJobs(ctx, moved)
+}
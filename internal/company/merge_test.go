@@ -0,0 +1,98 @@
+package company
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+)
+
+func TestMergeService_Merge(t *testing.T) {
+	t.Parallel()
+	duplicate := &Company{ID: 2, Name: "Equifax CR", Slug: "equifax-cr", IsActive: true}
+	equifax := &Company{ID: 1, Name: "EquiFax", Slug: "equifax", IsActive: true}
+
+	tests := []struct {
+		name         string
+		into         string
+		mockSetup    func(mockRepo *MockMergeRepository, mockView *MockSearchViewRefresher, mockIndexer *jobs.MockSearchIndexer)
+		checkResults func(t *testing.T, result *MergeResult, err error)
+	}{
+		{
+			name: "duplicate merged and its jobs reindexed",
+			into: "Equifax",
+			mockSetup: func(mockRepo *MockMergeRepository, mockView *MockSearchViewRefresher,
+				mockIndexer *jobs.MockSearchIndexer) {
+				t.Helper()
+				mockRepo.EXPECT().GetBySlug(context.Background(), "equifax-cr").Return(duplicate, nil).Once()
+				mockRepo.EXPECT().GetBySlug(context.Background(), "equifax").Return(equifax, nil).Once()
+				mockRepo.EXPECT().Merge(context.Background(), duplicate, 1).Return([]int{101, 102}, nil).Once()
+				mockView.EXPECT().RefreshSearchView(context.Background()).Return(nil).Once()
+				mockIndexer.EXPECT().IndexJobs(context.Background(), []int{101, 102}).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, result *MergeResult, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, equifax, result.Company)
+				assert.Equal(t, []int{101, 102}, result.MovedJobs)
+			},
+		},
+		{
+			name: "duplicate without jobs",
+			into: "equifax",
+			mockSetup: func(mockRepo *MockMergeRepository, _ *MockSearchViewRefresher, _ *jobs.MockSearchIndexer) {
+				t.Helper()
+				mockRepo.EXPECT().GetBySlug(context.Background(), "equifax-cr").Return(duplicate, nil).Once()
+				mockRepo.EXPECT().GetBySlug(context.Background(), "equifax").Return(equifax, nil).Once()
+				mockRepo.EXPECT().Merge(context.Background(), duplicate, 1).Return(nil, nil).Once()
+			},
+			checkResults: func(t *testing.T, result *MergeResult, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Empty(t, result.MovedJobs)
+			},
+		},
+		{
+			name:      "merge into itself",
+			into:      "equifax-cr",
+			mockSetup: func(_ *MockMergeRepository, _ *MockSearchViewRefresher, _ *jobs.MockSearchIndexer) {},
+			checkResults: func(t *testing.T, _ *MergeResult, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, httpservice.ErrInvalid)
+			},
+		},
+		{
+			name: "unknown company to merge into",
+			into: "equifax",
+			mockSetup: func(mockRepo *MockMergeRepository, _ *MockSearchViewRefresher, _ *jobs.MockSearchIndexer) {
+				t.Helper()
+				mockRepo.EXPECT().GetBySlug(context.Background(), "equifax-cr").Return(duplicate, nil).Once()
+				mockRepo.EXPECT().GetBySlug(context.Background(), "equifax").
+					Return(nil, &NotFoundError{Slug: "equifax"}).Once()
+			},
+			checkResults: func(t *testing.T, _ *MergeResult, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, httpservice.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockMergeRepository(t)
+			mockView := NewMockSearchViewRefresher(t)
+			mockIndexer := jobs.NewMockSearchIndexer(t)
+			service := NewMergeService(mockRepo, mockView, mockIndexer)
+
+			tt.mockSetup(mockRepo, mockView, mockIndexer)
+
+			result, err := service.Merge(context.Background(), " Equifax-CR ", tt.into)
+			tt.checkResults(t, result, err)
+		})
+	}
+}
@@ -8,13 +8,45 @@ import (
 
 // Company represents a company that posts jobs on the platform.
 type Company struct {
-	ID        int       `json:"id" db:"id"`
-	Name      string    `json:"name" db:"name"`
-	LogoURL   string    `json:"logo_url" db:"logo_url"`
+	ID      int    `json:"id" db:"id"`
+	Name    string `json:"name" db:"name"`
+	Slug    string `json:"slug" db:"slug"`
+	LogoURL string `json:"logo_url" db:"logo_url"`
+	// WebsiteURL is the website of the company, empty when unknown
+	WebsiteURL string `json:"website_url" db:"website_url"`
+	// Country is the ISO 3166-1 alpha-2 code of the country where the company hires
+	Country   string    `json:"country" db:"country"`
 	IsActive  bool      `json:"is_active" db:"is_active"`
 	CreatedAt time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
 
 	// Relationships (not stored in database)
 	Jobs []jobs.Job `json:"jobs,omitempty" db:"-"`
+	// Aliases are the former or alternative names of the company, only loaded to find similar companies
+	Aliases []string `json:"aliases,omitempty" db:"-"`
+}
+
+// CompanyPatch holds the fields of a company to change. Nil fields are left as they are.
+type CompanyPatch struct {
+	Name       *string
+	LogoURL    *string
+	WebsiteURL *string
+}
+
+// MergeResult is the outcome of merging a duplicate company into another one
+type MergeResult struct {
+	// Company is the company the duplicate was merged into
+	Company *Company
+	// MovedJobs are the jobs of the duplicate, now of Company
+	MovedJobs []int
+}
+
+// TechnologyCount represents a technology of the stack of a company
+type TechnologyCount struct {
+	Name     string `db:"name"`
+	Category string `db:"category"`
+	// Jobs is the number of active jobs of the company using the technology
+	Jobs int `db:"jobs"`
+	// RequiredJobs is the number of those jobs requiring it
+	RequiredJobs int `db:"required_jobs"`
 }
@@ -8,38 +8,78 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 
+	"github.com/rodruizronald/ticos-in-tech/internal/actor"
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
 	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+	"github.com/rodruizronald/ticos-in-tech/internal/sqlbuilder"
 )
 
 // SQL query constants
 const (
 	createCompanyQuery = `
-        INSERT INTO companies (name, logo_url, is_active)
-        VALUES ($1, $2, $3)
+        INSERT INTO companies (name, slug, logo_url, website_url, country, is_active)
+        VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6)
         RETURNING id
     `
 
+	getCompanyByIDQuery = `
+        SELECT id, name, slug, logo_url, COALESCE(website_url, ''), country, is_active, created_at, updated_at
+        FROM companies
+        WHERE id = $1
+    `
+
 	getCompanyByNameQuery = `
-        SELECT id, name, logo_url, is_active, created_at, updated_at
+        SELECT id, name, slug, logo_url, COALESCE(website_url, ''), country, is_active, created_at, updated_at
         FROM companies
         WHERE name = $1
     `
 
+	getCompaniesByNamesQuery = `
+        SELECT id, name, slug, logo_url, COALESCE(website_url, ''), country, is_active, created_at, updated_at
+        FROM companies
+        WHERE name = ANY($1)
+    `
+
+	getCompanyBySlugQuery = `
+        SELECT id, name, slug, logo_url, COALESCE(website_url, ''), country, is_active, created_at, updated_at
+        FROM companies
+        WHERE slug = $1
+    `
+
 	updateCompanyQuery = `
         UPDATE companies
-        SET name = $1, logo_url = $2, is_active = $3, updated_at = NOW()
-        WHERE id = $4
+        SET name = $1, logo_url = $2, website_url = NULLIF($3, ''), is_active = $4, updated_at = NOW()
+        WHERE id = $5
         RETURNING updated_at
     `
 
+	// Updates the columns set by the patch, formatted in, and returns the company as getCompanyByIDQuery does
+	patchCompanyQuery = `
+        UPDATE companies
+        SET %s, updated_at = NOW()
+        WHERE id = %s
+        RETURNING id, name, slug, logo_url, COALESCE(website_url, ''), country, is_active, created_at, updated_at
+    `
+
 	deleteCompanyQuery = `DELETE FROM companies WHERE id = $1`
 
 	listCompaniesQuery = `
-        SELECT id, name, logo_url, is_active, created_at, updated_at
+        SELECT id, name, slug, logo_url, COALESCE(website_url, ''), country, is_active, created_at, updated_at
         FROM companies
         ORDER BY name
     `
 
+	// Companies with their aliases, to find the ones a new company may duplicate
+	listCompaniesWithAliasesQuery = `
+        SELECT c.id, c.name, c.slug, c.logo_url, COALESCE(c.website_url, ''), c.country, c.is_active,
+               c.created_at, c.updated_at,
+               COALESCE(array_agg(a.alias ORDER BY a.alias) FILTER (WHERE a.alias IS NOT NULL), '{}')
+        FROM companies c
+        LEFT JOIN company_aliases a ON a.company_id = c.id
+        GROUP BY c.id
+        ORDER BY c.name
+    `
+
 	getCompanyJobsQuery = `
         SELECT id, company_id, title, description, experience_level, employment_type,
                location, work_mode, application_url, is_active, signature, created_at, updated_at
@@ -47,39 +87,136 @@ const (
         WHERE company_id = $1 AND is_active = true
         ORDER BY created_at DESC
     `
+
+	deactivateCompanyQuery = `
+        UPDATE companies
+        SET is_active = false, updated_at = NOW()
+        WHERE id = $1
+    `
+
+	// Takes down the active jobs of a company, returning them as getCompanyJobsQuery does
+	deactivateCompanyJobsQuery = `
+        UPDATE jobs
+        SET is_active = false, updated_by = $2, updated_at = NOW()
+        WHERE company_id = $1 AND is_active = true
+        RETURNING id, company_id, title, description, experience_level, employment_type,
+                  location, work_mode, application_url, is_active, signature, created_at, updated_at
+    `
+
+	// Rejects the jobs of a company still waiting for moderation, so they can't be published once it is archived
+	rejectCompanyPendingJobsQuery = `
+        UPDATE jobs
+        SET status = 'rejected', rejection_reason = 'Company archived', reviewed_at = NOW(), reviewed_by = $2,
+            updated_by = $2, updated_at = NOW()
+        WHERE company_id = $1 AND status = 'pending'
+    `
+
+	// Moves the jobs of a duplicate company ($1) to the company it is merged into ($2), returning their IDs.
+	// The actor merging the companies ($3) is the one who last changed the jobs.
+	mergeCompanyJobsQuery = `
+        UPDATE jobs
+        SET company_id = $2, updated_by = $3, updated_at = NOW()
+        WHERE company_id = $1
+        RETURNING id
+    `
+
+	mergeCompanyAliasesQuery = `UPDATE company_aliases SET company_id = $2 WHERE company_id = $1`
+
+	// Keeps the name of the duplicate as an alias, so job data still using it is assigned to the company
+	addMergedNameAliasQuery = `
+        INSERT INTO company_aliases (company_id, alias)
+        VALUES ($1, $2)
+        ON CONFLICT (alias) DO UPDATE SET company_id = $1
+    `
+
+	mergeCompanyClaimsQuery = `UPDATE company_claims SET company_id = $2 WHERE company_id = $1`
+
+	// Members of both companies keep their membership of the company merged into, with its scopes
+	mergeCompanyMembersQuery = `
+        UPDATE company_members m
+        SET company_id = $2
+        WHERE m.company_id = $1
+          AND NOT EXISTS (SELECT 1 FROM company_members t WHERE t.company_id = $2 AND t.user_id = m.user_id)
+    `
+
+	// Runs that reported both companies keep the report of the company merged into
+	mergeCompanyIngestReportsQuery = `
+        UPDATE ingest_run_companies r
+        SET company_id = $2
+        WHERE r.company_id = $1
+          AND NOT EXISTS (SELECT 1 FROM ingest_run_companies t WHERE t.company_id = $2 AND t.run_id = r.run_id)
+    `
+
+	deleteMergedLogoChecksQuery = `DELETE FROM link_checks WHERE kind = 'logo_url' AND target_id = $1`
+
+	// Distinct technologies of the active jobs of a company, most used first
+	getCompanyTechnologiesQuery = `
+        SELECT t.name, t.category,
+               COUNT(*) AS jobs,
+               COUNT(*) FILTER (WHERE jt.is_required) AS required_jobs
+        FROM jobs j
+        JOIN job_technologies jt ON jt.job_id = j.id
+        JOIN technologies t ON jt.technology_id = t.id
+        WHERE j.company_id = $1 AND j.is_active = true
+        GROUP BY t.id
+        ORDER BY jobs DESC, t.name
+    `
 )
 
+// slugConstraint is the unique index on the company slug column
+const slugConstraint = "idx_companies_slug"
+
 // Database interface to support pgxpool and mocks
 type Database interface {
 	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
 	Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error)
 	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+	Begin(ctx context.Context) (pgx.Tx, error)
 }
 
 // Repository handles database operations for the Company model.
 type Repository struct {
 	db Database
+	// replica serves the public list queries, which tolerate replication lag
+	replica Database
 }
 
 // NewRepository creates a new Repository instance.
 func NewRepository(db Database) *Repository {
-	return &Repository{db: db}
+	return &Repository{db: db, replica: db}
+}
+
+// NewRepositoryWithReplica creates a new Repository instance sending the public list queries
+// to replica and everything else to db.
+func NewRepositoryWithReplica(db, replica Database) *Repository {
+	return &Repository{db: db, replica: replica}
 }
 
-// Create inserts a new company into the database.
+// Create inserts a new company into the database. Companies without a country are created in
+// the default one.
 func (r *Repository) Create(ctx context.Context, company *Company) error {
+	if company.Country == "" {
+		company.Country = httpservice.DefaultCountry
+	}
+
 	err := r.db.QueryRow(
 		ctx,
 		createCompanyQuery,
 		company.Name,
+		company.Slug,
 		company.LogoURL,
+		company.WebsiteURL,
+		company.Country,
 		company.IsActive,
 	).Scan(&company.ID)
 
 	if err != nil {
-		// Check for unique constraint violation (duplicate company name)
+		// Check for unique constraint violation (duplicate company name or slug)
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			if pgErr.ConstraintName == slugConstraint {
+				return &DuplicateError{Name: company.Name, Slug: company.Slug}
+			}
 			return &DuplicateError{Name: company.Name}
 		}
 		return fmt.Errorf("failed to create company: %w", err)
@@ -88,13 +225,41 @@ func (r *Repository) Create(ctx context.Context, company *Company) error {
 	return nil
 }
 
+// GetByID retrieves a company by its ID.
+func (r *Repository) GetByID(ctx context.Context, id int) (*Company, error) {
+	company := &Company{}
+	err := r.db.QueryRow(ctx, getCompanyByIDQuery, id).Scan(
+		&company.ID,
+		&company.Name,
+		&company.Slug,
+		&company.LogoURL,
+		&company.WebsiteURL,
+		&company.Country,
+		&company.IsActive,
+		&company.CreatedAt,
+		&company.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &NotFoundError{ID: id}
+		}
+		return nil, fmt.Errorf("failed to get company: %w", err)
+	}
+
+	return company, nil
+}
+
 // GetByName retrieves a company by its name.
 func (r *Repository) GetByName(ctx context.Context, name string) (*Company, error) {
 	company := &Company{}
 	err := r.db.QueryRow(ctx, getCompanyByNameQuery, name).Scan(
 		&company.ID,
 		&company.Name,
+		&company.Slug,
 		&company.LogoURL,
+		&company.WebsiteURL,
+		&company.Country,
 		&company.IsActive,
 		&company.CreatedAt,
 		&company.UpdatedAt,
@@ -110,6 +275,71 @@ func (r *Repository) GetByName(ctx context.Context, name string) (*Company, erro
 	return company, nil
 }
 
+// GetByNames retrieves the companies of the given names in a single query, by name. Names of no
+// company are missing from the map.
+func (r *Repository) GetByNames(ctx context.Context, names []string) (map[string]*Company, error) {
+	companies := make(map[string]*Company, len(names))
+	if len(names) == 0 {
+		return companies, nil
+	}
+
+	rows, err := r.db.Query(ctx, getCompaniesByNamesQuery, names)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get companies by names: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		company := &Company{}
+		err = rows.Scan(
+			&company.ID,
+			&company.Name,
+			&company.Slug,
+			&company.LogoURL,
+			&company.WebsiteURL,
+			&company.Country,
+			&company.IsActive,
+			&company.CreatedAt,
+			&company.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan company row: %w", err)
+		}
+		companies[company.Name] = company
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating company rows: %w", err)
+	}
+
+	return companies, nil
+}
+
+// GetBySlug retrieves a company by its URL slug.
+func (r *Repository) GetBySlug(ctx context.Context, slug string) (*Company, error) {
+	company := &Company{}
+	err := r.db.QueryRow(ctx, getCompanyBySlugQuery, slug).Scan(
+		&company.ID,
+		&company.Name,
+		&company.Slug,
+		&company.LogoURL,
+		&company.WebsiteURL,
+		&company.Country,
+		&company.IsActive,
+		&company.CreatedAt,
+		&company.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &NotFoundError{Slug: slug}
+		}
+		return nil, fmt.Errorf("failed to get company by slug: %w", err)
+	}
+
+	return company, nil
+}
+
 // Update updates an existing company in the database.
 func (r *Repository) Update(ctx context.Context, company *Company) error {
 	err := r.db.QueryRow(
@@ -117,6 +347,7 @@ func (r *Repository) Update(ctx context.Context, company *Company) error {
 		updateCompanyQuery,
 		company.Name,
 		company.LogoURL,
+		company.WebsiteURL,
 		company.IsActive,
 		company.ID,
 	).Scan(&company.UpdatedAt)
@@ -138,6 +369,57 @@ func (r *Repository) Update(ctx context.Context, company *Company) error {
 	return nil
 }
 
+// Patch updates the fields set in patch of an existing company, leaving the others as they are,
+// and returns the updated company. The slug is kept, so the public URL of the company doesn't change.
+func (r *Repository) Patch(ctx context.Context, id int, patch *CompanyPatch) (*Company, error) {
+	var set sqlbuilder.Set
+	if patch.Name != nil {
+		set.Add("name", *patch.Name)
+	}
+	if patch.LogoURL != nil {
+		set.Add("logo_url", *patch.LogoURL)
+	}
+	if patch.WebsiteURL != nil {
+		set.Add("website_url", *patch.WebsiteURL)
+	}
+
+	// Nothing to change, the company is returned as it is
+	if set.Empty() {
+		return r.GetByID(ctx, id)
+	}
+
+	query := fmt.Sprintf(patchCompanyQuery, set.SQL(), set.Arg(id))
+
+	company := &Company{}
+	err := r.db.QueryRow(ctx, query, set.Values()...).Scan(
+		&company.ID,
+		&company.Name,
+		&company.Slug,
+		&company.LogoURL,
+		&company.WebsiteURL,
+		&company.Country,
+		&company.IsActive,
+		&company.CreatedAt,
+		&company.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &NotFoundError{ID: id}
+		}
+
+		// Check for unique constraint violation (duplicate company name)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" && patch.Name != nil {
+			return nil, &DuplicateError{Name: *patch.Name}
+		}
+
+		return nil, fmt.Errorf("failed to patch company: %w", err)
+	}
+
+	return company, nil
+}
+
 // Delete removes a company from the database.
 func (r *Repository) Delete(ctx context.Context, id int) error {
 	commandTag, err := r.db.Exec(ctx, deleteCompanyQuery, id)
@@ -154,7 +436,7 @@ func (r *Repository) Delete(ctx context.Context, id int) error {
 
 // List retrieves all companies from the database.
 func (r *Repository) List(ctx context.Context) ([]*Company, error) {
-	rows, err := r.db.Query(ctx, listCompaniesQuery)
+	rows, err := r.replica.Query(ctx, listCompaniesQuery)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list companies: %w", err)
 	}
@@ -166,7 +448,10 @@ func (r *Repository) List(ctx context.Context) ([]*Company, error) {
 		err = rows.Scan(
 			&company.ID,
 			&company.Name,
+			&company.Slug,
 			&company.LogoURL,
+			&company.WebsiteURL,
+			&company.Country,
 			&company.IsActive,
 			&company.CreatedAt,
 			&company.UpdatedAt,
@@ -184,6 +469,68 @@ func (r *Repository) List(ctx context.Context) ([]*Company, error) {
 	return companies, nil
 }
 
+// GetTechnologies retrieves the technologies used by the active jobs of a company, with the
+// number of jobs using each of them.
+func (r *Repository) GetTechnologies(ctx context.Context, companyID int) ([]*TechnologyCount, error) {
+	rows, err := r.replica.Query(ctx, getCompanyTechnologiesQuery, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get company technologies: %w", err)
+	}
+	defer rows.Close()
+
+	technologies := []*TechnologyCount{}
+	for rows.Next() {
+		technology := &TechnologyCount{}
+		err = rows.Scan(&technology.Name, &technology.Category, &technology.Jobs, &technology.RequiredJobs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan company technology row: %w", err)
+		}
+		technologies = append(technologies, technology)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating company technology rows: %w", err)
+	}
+
+	return technologies, nil
+}
+
+// ListWithAliases retrieves all companies, with their aliases, ordered by name.
+func (r *Repository) ListWithAliases(ctx context.Context) ([]*Company, error) {
+	rows, err := r.db.Query(ctx, listCompaniesWithAliasesQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list companies with aliases: %w", err)
+	}
+	defer rows.Close()
+
+	var companies []*Company
+	for rows.Next() {
+		company := &Company{}
+		err = rows.Scan(
+			&company.ID,
+			&company.Name,
+			&company.Slug,
+			&company.LogoURL,
+			&company.WebsiteURL,
+			&company.Country,
+			&company.IsActive,
+			&company.CreatedAt,
+			&company.UpdatedAt,
+			&company.Aliases,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan company row: %w", err)
+		}
+		companies = append(companies, company)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating company rows: %w", err)
+	}
+
+	return companies, nil
+}
+
 // GetWithJobs retrieves a company by name including its jobs.
 func (r *Repository) GetWithJobs(ctx context.Context, name string) (*Company, error) {
 	company, err := r.GetByName(ctx, name)
@@ -195,12 +542,115 @@ func (r *Repository) GetWithJobs(ctx context.Context, name string) (*Company, er
 	if err != nil {
 		return nil, fmt.Errorf("failed to get company jobs: %w", err)
 	}
+
+	gotJobs, err := scanJobs(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	company.Jobs = gotJobs
+	return company, nil
+}
+
+// Deactivate marks a company inactive, takes down all its active jobs and rejects its pending ones
+// in a single transaction. It returns the jobs taken down.
+func (r *Repository) Deactivate(ctx context.Context, id int) (deactivated []jobs.Job, err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin deactivate transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	commandTag, err := tx.Exec(ctx, deactivateCompanyQuery, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deactivate company: %w", err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return nil, &NotFoundError{ID: id}
+	}
+
+	rows, err := tx.Query(ctx, deactivateCompanyJobsQuery, id, actor.From(ctx).String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to deactivate company jobs: %w", err)
+	}
+	if deactivated, err = scanJobs(rows); err != nil {
+		return nil, err
+	}
+
+	if _, err = tx.Exec(ctx, rejectCompanyPendingJobsQuery, id, actor.From(ctx).String()); err != nil {
+		return nil, fmt.Errorf("failed to reject pending company jobs: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit deactivate transaction: %w", err)
+	}
+
+	return deactivated, nil
+}
+
+// Merge moves the jobs, aliases, claims, members and scrape reports of the duplicate company
+// source to target and deletes source in a single transaction. The name of source is kept as an
+// alias of target. It returns the IDs of the jobs moved.
+func (r *Repository) Merge(ctx context.Context, source *Company, targetID int) (moved []int, err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin merge transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	rows, err := tx.Query(ctx, mergeCompanyJobsQuery, source.ID, targetID, actor.From(ctx).String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to move company jobs: %w", err)
+	}
+	if moved, err = scanIDs(rows); err != nil {
+		return nil, err
+	}
+
+	for _, query := range []string{
+		mergeCompanyAliasesQuery, mergeCompanyClaimsQuery, mergeCompanyMembersQuery, mergeCompanyIngestReportsQuery,
+	} {
+		if _, err = tx.Exec(ctx, query, source.ID, targetID); err != nil {
+			return nil, fmt.Errorf("failed to merge company: %w", err)
+		}
+	}
+	if _, err = tx.Exec(ctx, addMergedNameAliasQuery, targetID, source.Name); err != nil {
+		return nil, fmt.Errorf("failed to add merged company alias: %w", err)
+	}
+	if _, err = tx.Exec(ctx, deleteMergedLogoChecksQuery, source.ID); err != nil {
+		return nil, fmt.Errorf("failed to delete merged company logo checks: %w", err)
+	}
+
+	commandTag, err := tx.Exec(ctx, deleteCompanyQuery, source.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete merged company: %w", err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return nil, &NotFoundError{ID: source.ID}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit merge transaction: %w", err)
+	}
+
+	return moved, nil
+}
+
+// scanJobs reads and closes the job rows of getCompanyJobsQuery
+func scanJobs(rows pgx.Rows) ([]jobs.Job, error) {
 	defer rows.Close()
 
 	var gotJobs []jobs.Job
 	for rows.Next() {
 		gotJob := jobs.Job{}
-		err = rows.Scan(
+		err := rows.Scan(
 			&gotJob.ID,
 			&gotJob.CompanyID,
 			&gotJob.Title,
@@ -221,10 +671,29 @@ func (r *Repository) GetWithJobs(ctx context.Context, name string) (*Company, er
 		gotJobs = append(gotJobs, gotJob)
 	}
 
-	if err = rows.Err(); err != nil {
+	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("error iterating job rows: %w", err)
 	}
 
-	company.Jobs = gotJobs
-	return company, nil
+	return gotJobs, nil
+}
+
+// scanIDs reads and closes rows of IDs
+func scanIDs(rows pgx.Rows) ([]int, error) {
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan id row: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating id rows: %w", err)
+	}
+
+	return ids, nil
 }
@@ -3,6 +3,7 @@ package company
 import (
 	"context"
 	"errors"
+	"fmt"
 	"regexp"
 	"testing"
 	"time"
@@ -12,6 +13,8 @@ import (
 	"github.com/pashagolub/pgxmock/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
 )
 
 func TestRepository_Create(t *testing.T) {
@@ -26,14 +29,15 @@ func TestRepository_Create(t *testing.T) {
 		{
 			name: "successful creation",
 			company: &Company{
-				Name:     "Test Company",
-				LogoURL:  "https://testcompany.com/logo.png",
-				IsActive: true,
+				Name:       "Test Company",
+				LogoURL:    "https://testcompany.com/logo.png",
+				WebsiteURL: "https://testcompany.com",
+				IsActive:   true,
 			},
 			mockSetup: func(mock pgxmock.PgxPoolIface, company *Company) {
 				t.Helper()
 				mock.ExpectQuery(regexp.QuoteMeta(createCompanyQuery)).
-					WithArgs(company.Name, company.LogoURL, company.IsActive).
+					WithArgs(company.Name, company.Slug, company.LogoURL, company.WebsiteURL, "CR", company.IsActive).
 					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
 			},
 			checkResults: func(t *testing.T, result *Company, err error) {
@@ -52,7 +56,7 @@ func TestRepository_Create(t *testing.T) {
 			mockSetup: func(mock pgxmock.PgxPoolIface, company *Company) {
 				t.Helper()
 				mock.ExpectQuery(regexp.QuoteMeta(createCompanyQuery)).
-					WithArgs(company.Name, company.LogoURL, company.IsActive).
+					WithArgs(company.Name, company.Slug, company.LogoURL, company.WebsiteURL, "CR", company.IsActive).
 					WillReturnError(&pgconn.PgError{Code: "23505"})
 			},
 			checkResults: func(t *testing.T, _ *Company, err error) {
@@ -62,6 +66,27 @@ func TestRepository_Create(t *testing.T) {
 				require.ErrorAs(t, err, &duplicateErr)
 			},
 		},
+		{
+			name: "duplicate company slug",
+			company: &Company{
+				Name:     "Acme S.A.",
+				Slug:     "acme-s-a",
+				LogoURL:  "https://acme.com/logo.png",
+				IsActive: true,
+			},
+			mockSetup: func(mock pgxmock.PgxPoolIface, company *Company) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(createCompanyQuery)).
+					WithArgs(company.Name, company.Slug, company.LogoURL, company.WebsiteURL, "CR", company.IsActive).
+					WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: slugConstraint})
+			},
+			checkResults: func(t *testing.T, _ *Company, err error) {
+				t.Helper()
+				var duplicateErr *DuplicateError
+				require.ErrorAs(t, err, &duplicateErr)
+				assert.Equal(t, "acme-s-a", duplicateErr.Slug)
+			},
+		},
 		{
 			name: "database error",
 			company: &Company{
@@ -72,7 +97,7 @@ func TestRepository_Create(t *testing.T) {
 			mockSetup: func(mock pgxmock.PgxPoolIface, company *Company) {
 				t.Helper()
 				mock.ExpectQuery(regexp.QuoteMeta(createCompanyQuery)).
-					WithArgs(company.Name, company.LogoURL, company.IsActive).
+					WithArgs(company.Name, company.Slug, company.LogoURL, company.WebsiteURL, "CR", company.IsActive).
 					WillReturnError(dbError)
 			},
 			checkResults: func(t *testing.T, _ *Company, err error) {
@@ -99,6 +124,96 @@ func TestRepository_Create(t *testing.T) {
 	}
 }
 
+func TestRepository_GetByID(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	dbError := errors.New("database error")
+	tests := []struct {
+		name         string
+		companyID    int
+		mockSetup    func(mock pgxmock.PgxPoolIface, companyID int)
+		checkResults func(t *testing.T, result *Company, err error)
+	}{
+		{
+			name:      "company found",
+			companyID: 1,
+			mockSetup: func(mock pgxmock.PgxPoolIface, companyID int) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getCompanyByIDQuery)).
+					WithArgs(companyID).
+					WillReturnRows(pgxmock.NewRows([]string{
+						"id", "name", "slug", "logo_url", "website_url", "country", "active", "created_at", "updated_at",
+					}).AddRow(
+						companyID, "Test Company", "test-company", "https://testcompany.com/logo.png", "", "CR", true, now, now,
+					))
+			},
+			checkResults: func(t *testing.T, result *Company, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.NotNil(t, result)
+				assert.Equal(t, 1, result.ID)
+				assert.Equal(t, "Test Company", result.Name)
+				assert.Equal(t, "https://testcompany.com/logo.png", result.LogoURL)
+				assert.Equal(t, "CR", result.Country)
+				assert.True(t, result.IsActive)
+				assert.Equal(t, now, result.CreatedAt)
+				assert.Equal(t, now, result.UpdatedAt)
+			},
+		},
+		{
+			name:      "company not found",
+			companyID: 999,
+			mockSetup: func(mock pgxmock.PgxPoolIface, companyID int) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getCompanyByIDQuery)).
+					WithArgs(companyID).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			checkResults: func(t *testing.T, result *Company, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Nil(t, result)
+
+				var notFoundErr *NotFoundError
+				require.ErrorAs(t, err, &notFoundErr)
+				assert.Equal(t, 999, notFoundErr.ID)
+			},
+		},
+		{
+			name:      "database error",
+			companyID: 2,
+			mockSetup: func(mock pgxmock.PgxPoolIface, companyID int) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getCompanyByIDQuery)).
+					WithArgs(companyID).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, result *Company, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Nil(t, result)
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB, tt.companyID)
+
+			result, err := repo.GetByID(context.Background(), tt.companyID)
+			tt.checkResults(t, result, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
 func TestRepository_GetByName(t *testing.T) {
 	t.Parallel()
 	now := time.Now()
@@ -117,9 +232,9 @@ func TestRepository_GetByName(t *testing.T) {
 				mock.ExpectQuery(regexp.QuoteMeta(getCompanyByNameQuery)).
 					WithArgs(companyName).
 					WillReturnRows(pgxmock.NewRows([]string{
-						"id", "name", "logo_url", "active", "created_at", "updated_at",
+						"id", "name", "slug", "logo_url", "website_url", "country", "active", "created_at", "updated_at",
 					}).AddRow(
-						1, companyName, "https://testcompany.com/logo.png", true, now, now,
+						1, companyName, "test-company", "https://testcompany.com/logo.png", "", "CR", true, now, now,
 					))
 			},
 			checkResults: func(t *testing.T, result *Company, err error) {
@@ -188,6 +303,147 @@ func TestRepository_GetByName(t *testing.T) {
 	}
 }
 
+func TestRepository_GetByNames(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	dbError := errors.New("database error")
+	tests := []struct {
+		name         string
+		names        []string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, result map[string]*Company, err error)
+	}{
+		{
+			name:  "companies found",
+			names: []string{"Tech Corp", "Data Corp", "Unknown Corp"},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(regexp.QuoteMeta(getCompaniesByNamesQuery)).
+					WithArgs([]string{"Tech Corp", "Data Corp", "Unknown Corp"}).
+					WillReturnRows(pgxmock.NewRows([]string{
+						"id", "name", "slug", "logo_url", "website_url", "country", "active", "created_at", "updated_at",
+					}).
+						AddRow(1, "Tech Corp", "tech-corp", "https://techcorp.com/logo.png", "", "CR", true, now, now).
+						AddRow(2, "Data Corp", "data-corp", "https://datacorp.com/logo.png", "", "CR", false, now, now))
+			},
+			checkResults: func(t *testing.T, result map[string]*Company, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, result, 2)
+				assert.Equal(t, 1, result["Tech Corp"].ID)
+				assert.Equal(t, 2, result["Data Corp"].ID)
+				assert.False(t, result["Data Corp"].IsActive)
+				assert.NotContains(t, result, "Unknown Corp")
+			},
+		},
+		{
+			name:      "no names",
+			mockSetup: func(_ pgxmock.PgxPoolIface) {},
+			checkResults: func(t *testing.T, result map[string]*Company, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Empty(t, result)
+			},
+		},
+		{
+			name:  "database error",
+			names: []string{"Tech Corp"},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(regexp.QuoteMeta(getCompaniesByNamesQuery)).
+					WithArgs([]string{"Tech Corp"}).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, result map[string]*Company, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+				assert.Nil(t, result)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			result, err := repo.GetByNames(context.Background(), tt.names)
+			tt.checkResults(t, result, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_GetBySlug(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+
+	tests := []struct {
+		name         string
+		slug         string
+		mockSetup    func(mock pgxmock.PgxPoolIface, slug string)
+		checkResults func(t *testing.T, result *Company, err error)
+	}{
+		{
+			name: "company found",
+			slug: "test-company",
+			mockSetup: func(mock pgxmock.PgxPoolIface, slug string) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getCompanyBySlugQuery)).
+					WithArgs(slug).
+					WillReturnRows(pgxmock.NewRows([]string{
+						"id", "name", "slug", "logo_url", "website_url", "country", "active", "created_at", "updated_at",
+					}).AddRow(
+						1, "Test Company", slug, "https://testcompany.com/logo.png", "", "CR", true, now, now,
+					))
+			},
+			checkResults: func(t *testing.T, result *Company, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, "Test Company", result.Name)
+				assert.Equal(t, "test-company", result.Slug)
+			},
+		},
+		{
+			name: "company not found",
+			slug: "missing",
+			mockSetup: func(mock pgxmock.PgxPoolIface, slug string) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getCompanyBySlugQuery)).
+					WithArgs(slug).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			checkResults: func(t *testing.T, result *Company, err error) {
+				t.Helper()
+				assert.Nil(t, result)
+				var notFoundErr *NotFoundError
+				require.ErrorAs(t, err, &notFoundErr)
+				assert.Equal(t, "missing", notFoundErr.Slug)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB, tt.slug)
+
+			result, err := repo.GetBySlug(context.Background(), tt.slug)
+			tt.checkResults(t, result, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
 func TestRepository_Update(t *testing.T) {
 	t.Parallel()
 	now := time.Now()
@@ -202,15 +458,16 @@ func TestRepository_Update(t *testing.T) {
 		{
 			name: "successful update",
 			company: &Company{
-				ID:       1,
-				Name:     "Updated Company",
-				LogoURL:  "https://updated.com/logo.png",
-				IsActive: true,
+				ID:         1,
+				Name:       "Updated Company",
+				LogoURL:    "https://updated.com/logo.png",
+				WebsiteURL: "https://updated.com",
+				IsActive:   true,
 			},
 			mockSetup: func(mock pgxmock.PgxPoolIface, company *Company) {
 				t.Helper()
 				mock.ExpectQuery(regexp.QuoteMeta(updateCompanyQuery)).
-					WithArgs(company.Name, company.LogoURL, company.IsActive, company.ID).
+					WithArgs(company.Name, company.LogoURL, company.WebsiteURL, company.IsActive, company.ID).
 					WillReturnRows(pgxmock.NewRows([]string{"updated_at"}).AddRow(now))
 			},
 			checkResults: func(t *testing.T, result *Company, err error) {
@@ -230,7 +487,7 @@ func TestRepository_Update(t *testing.T) {
 			mockSetup: func(mock pgxmock.PgxPoolIface, company *Company) {
 				t.Helper()
 				mock.ExpectQuery(regexp.QuoteMeta(updateCompanyQuery)).
-					WithArgs(company.Name, company.LogoURL, company.IsActive, company.ID).
+					WithArgs(company.Name, company.LogoURL, company.WebsiteURL, company.IsActive, company.ID).
 					WillReturnError(pgx.ErrNoRows)
 			},
 			checkResults: func(t *testing.T, _ *Company, err error) {
@@ -257,7 +514,7 @@ func TestRepository_Update(t *testing.T) {
 					ConstraintName: "companies_name_key",
 				}
 				mock.ExpectQuery(regexp.QuoteMeta(updateCompanyQuery)).
-					WithArgs(company.Name, company.LogoURL, company.IsActive, company.ID).
+					WithArgs(company.Name, company.LogoURL, company.WebsiteURL, company.IsActive, company.ID).
 					WillReturnError(pgErr)
 			},
 			checkResults: func(t *testing.T, _ *Company, err error) {
@@ -280,7 +537,7 @@ func TestRepository_Update(t *testing.T) {
 			mockSetup: func(mock pgxmock.PgxPoolIface, company *Company) {
 				t.Helper()
 				mock.ExpectQuery(regexp.QuoteMeta(updateCompanyQuery)).
-					WithArgs(company.Name, company.LogoURL, company.IsActive, company.ID).
+					WithArgs(company.Name, company.LogoURL, company.WebsiteURL, company.IsActive, company.ID).
 					WillReturnError(dbError)
 			},
 			checkResults: func(t *testing.T, _ *Company, err error) {
@@ -386,6 +643,134 @@ func TestRepository_Delete(t *testing.T) {
 	}
 }
 
+func TestRepository_Deactivate(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	dbError := errors.New("database error")
+	jobColumns := []string{
+		"id", "company_id", "title", "description", "experience_level", "employment_type",
+		"location", "work_mode", "application_url", "is_active", "signature", "created_at", "updated_at",
+	}
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, deactivated []jobs.Job, err error)
+	}{
+		{
+			name: "company and its jobs deactivated",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(deactivateCompanyQuery)).
+					WithArgs(1).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectQuery(regexp.QuoteMeta(deactivateCompanyJobsQuery)).
+					WithArgs(1, "").
+					WillReturnRows(pgxmock.NewRows(jobColumns).AddRow(
+						101, 1, "Software Engineer", "Job description", "Mid-Level", "Full-Time",
+						"San Francisco", "Remote", "https://example.com/apply", false, "job-signature-1", now, now,
+					))
+				mock.ExpectExec(regexp.QuoteMeta(rejectCompanyPendingJobsQuery)).
+					WithArgs(1, "").
+					WillReturnResult(pgxmock.NewResult("UPDATE", 2))
+				mock.ExpectCommit()
+			},
+			checkResults: func(t *testing.T, deactivated []jobs.Job, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, deactivated, 1)
+				assert.Equal(t, 101, deactivated[0].ID)
+				assert.False(t, deactivated[0].IsActive)
+			},
+		},
+		{
+			name: "pending jobs rejection error rolls back",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(deactivateCompanyQuery)).
+					WithArgs(1).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectQuery(regexp.QuoteMeta(deactivateCompanyJobsQuery)).
+					WithArgs(1, "").
+					WillReturnRows(pgxmock.NewRows(jobColumns))
+				mock.ExpectExec(regexp.QuoteMeta(rejectCompanyPendingJobsQuery)).
+					WithArgs(1, "").
+					WillReturnError(dbError)
+				mock.ExpectRollback()
+			},
+			checkResults: func(t *testing.T, _ []jobs.Job, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+		{
+			name: "company not found",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(deactivateCompanyQuery)).
+					WithArgs(1).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+				mock.ExpectRollback()
+			},
+			checkResults: func(t *testing.T, _ []jobs.Job, err error) {
+				t.Helper()
+				var notFoundErr *NotFoundError
+				require.ErrorAs(t, err, &notFoundErr)
+				assert.Equal(t, 1, notFoundErr.ID)
+			},
+		},
+		{
+			name: "jobs update error rolls back",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(deactivateCompanyQuery)).
+					WithArgs(1).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectQuery(regexp.QuoteMeta(deactivateCompanyJobsQuery)).
+					WithArgs(1, "").
+					WillReturnError(dbError)
+				mock.ExpectRollback()
+			},
+			checkResults: func(t *testing.T, _ []jobs.Job, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+		{
+			name: "begin error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectBegin().WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ []jobs.Job, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			deactivated, err := repo.Deactivate(context.Background(), 1)
+			tt.checkResults(t, deactivated, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
 func TestRepository_List(t *testing.T) {
 	t.Parallel()
 	now := time.Now()
@@ -402,11 +787,11 @@ func TestRepository_List(t *testing.T) {
 				t.Helper()
 				mock.ExpectQuery(regexp.QuoteMeta(listCompaniesQuery)).
 					WillReturnRows(pgxmock.NewRows([]string{
-						"id", "name", "logo_url", "active", "created_at", "updated_at",
+						"id", "name", "slug", "logo_url", "website_url", "country", "active", "created_at", "updated_at",
 					}).AddRow(
-						1, "Company A", "https://example.com/logo1.png", true, now, now,
+						1, "Company A", "company-a", "https://example.com/logo1.png", "", "CR", true, now, now,
 					).AddRow(
-						2, "Company B", "https://example.com/logo2.png", false, now, now,
+						2, "Company B", "company-b", "https://example.com/logo2.png", "", "CR", false, now, now,
 					))
 			},
 			checkResults: func(t *testing.T, companies []*Company, err error) {
@@ -431,7 +816,7 @@ func TestRepository_List(t *testing.T) {
 				t.Helper()
 				mock.ExpectQuery(regexp.QuoteMeta(listCompaniesQuery)).
 					WillReturnRows(pgxmock.NewRows([]string{
-						"id", "name", "logo_url", "active", "created_at", "updated_at",
+						"id", "name", "slug", "logo_url", "website_url", "country", "active", "created_at", "updated_at",
 					}))
 			},
 			checkResults: func(t *testing.T, companies []*Company, err error) {
@@ -493,6 +878,83 @@ func TestRepository_List(t *testing.T) {
 	}
 }
 
+func TestRepository_GetTechnologies(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, technologies []*TechnologyCount, err error)
+	}{
+		{
+			name: "technologies counted",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getCompanyTechnologiesQuery)).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"name", "category", "jobs", "required_jobs"}).
+						AddRow("go", "programming", 4, 3).
+						AddRow("docker", "tools", 2, 0))
+			},
+			checkResults: func(t *testing.T, technologies []*TechnologyCount, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, technologies, 2)
+				assert.Equal(t, TechnologyCount{Name: "go", Category: "programming", Jobs: 4, RequiredJobs: 3},
+					*technologies[0])
+				assert.Equal(t, "docker", technologies[1].Name)
+			},
+		},
+		{
+			name: "company without active jobs",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getCompanyTechnologiesQuery)).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"name", "category", "jobs", "required_jobs"}))
+			},
+			checkResults: func(t *testing.T, technologies []*TechnologyCount, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.NotNil(t, technologies)
+				assert.Empty(t, technologies)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getCompanyTechnologiesQuery)).
+					WithArgs(1).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, technologies []*TechnologyCount, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+				assert.Nil(t, technologies)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			technologies, err := repo.GetTechnologies(context.Background(), 1)
+			tt.checkResults(t, technologies, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
 func TestRepository_GetWithJobs(t *testing.T) {
 	t.Parallel()
 	now := time.Now()
@@ -513,9 +975,9 @@ func TestRepository_GetWithJobs(t *testing.T) {
 				mock.ExpectQuery(regexp.QuoteMeta(getCompanyByNameQuery)).
 					WithArgs(companyName).
 					WillReturnRows(pgxmock.NewRows([]string{
-						"id", "name", "logo_url", "active", "created_at", "updated_at",
+						"id", "name", "slug", "logo_url", "website_url", "country", "active", "created_at", "updated_at",
 					}).AddRow(
-						1, companyName, "https://example.com/logo.png", true, now, now,
+						1, companyName, "test-company", "https://example.com/logo.png", "", "CR", true, now, now,
 					))
 
 				// Second query to get the jobs
@@ -577,9 +1039,9 @@ func TestRepository_GetWithJobs(t *testing.T) {
 				mock.ExpectQuery(regexp.QuoteMeta(getCompanyByNameQuery)).
 					WithArgs(companyName).
 					WillReturnRows(pgxmock.NewRows([]string{
-						"id", "name", "logo_url", "active", "created_at", "updated_at",
+						"id", "name", "slug", "logo_url", "website_url", "country", "active", "created_at", "updated_at",
 					}).AddRow(
-						1, companyName, "https://example.com/logo.png", true, now, now,
+						1, companyName, "test-company", "https://example.com/logo.png", "", "CR", true, now, now,
 					))
 
 				// Second query to get jobs returns error
@@ -603,9 +1065,9 @@ func TestRepository_GetWithJobs(t *testing.T) {
 				mock.ExpectQuery(regexp.QuoteMeta(getCompanyByNameQuery)).
 					WithArgs(companyName).
 					WillReturnRows(pgxmock.NewRows([]string{
-						"id", "name", "logo_url", "active", "created_at", "updated_at",
+						"id", "name", "slug", "logo_url", "website_url", "country", "active", "created_at", "updated_at",
 					}).AddRow(
-						1, companyName, "https://example.com/logo.png", true, now, now,
+						1, companyName, "test-company", "https://example.com/logo.png", "", "CR", true, now, now,
 					))
 
 				// Second query to get jobs returns empty result
@@ -634,9 +1096,9 @@ func TestRepository_GetWithJobs(t *testing.T) {
 				mock.ExpectQuery(regexp.QuoteMeta(getCompanyByNameQuery)).
 					WithArgs(companyName).
 					WillReturnRows(pgxmock.NewRows([]string{
-						"id", "name", "logo_url", "active", "created_at", "updated_at",
+						"id", "name", "slug", "logo_url", "website_url", "country", "active", "created_at", "updated_at",
 					}).AddRow(
-						1, companyName, "https://example.com/logo.png", true, now, now,
+						1, companyName, "test-company", "https://example.com/logo.png", "", "CR", true, now, now,
 					))
 
 				// Second query returns mismatched columns to cause scan error
@@ -674,3 +1136,222 @@ func TestRepository_GetWithJobs(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_Patch(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	dbError := errors.New("database error")
+	name := "Tech Corp Labs"
+	companyColumns := []string{
+		"id", "name", "slug", "logo_url", "website_url", "country", "is_active", "created_at", "updated_at",
+	}
+
+	tests := []struct {
+		name         string
+		patch        *CompanyPatch
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, result *Company, err error)
+	}{
+		{
+			name:  "only the provided fields are updated",
+			patch: &CompanyPatch{Name: &name},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(patchCompanyQuery, "name = $1", "$2"))).
+					WithArgs(name, 1).
+					WillReturnRows(pgxmock.NewRows(companyColumns).
+						AddRow(1, name, "tech-corp", "https://techcorp.com/logo.png", "", "CR", true, now, now))
+			},
+			checkResults: func(t *testing.T, result *Company, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, name, result.Name)
+				assert.Equal(t, "tech-corp", result.Slug)
+				assert.Equal(t, "https://techcorp.com/logo.png", result.LogoURL)
+			},
+		},
+		{
+			name:  "duplicate name",
+			patch: &CompanyPatch{Name: &name},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(patchCompanyQuery, "name = $1", "$2"))).
+					WithArgs(name, 1).
+					WillReturnError(&pgconn.PgError{Code: "23505"})
+			},
+			checkResults: func(t *testing.T, result *Company, err error) {
+				t.Helper()
+				assert.Nil(t, result)
+				assert.True(t, IsDuplicate(err))
+			},
+		},
+		{
+			name:  "company not found",
+			patch: &CompanyPatch{Name: &name},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(patchCompanyQuery, "name = $1", "$2"))).
+					WithArgs(name, 1).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			checkResults: func(t *testing.T, result *Company, err error) {
+				t.Helper()
+				assert.Nil(t, result)
+				assert.True(t, IsNotFound(err))
+			},
+		},
+		{
+			name:  "database error",
+			patch: &CompanyPatch{Name: &name},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(patchCompanyQuery, "name = $1", "$2"))).
+					WithArgs(name, 1).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, result *Company, err error) {
+				t.Helper()
+				assert.Nil(t, result)
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			result, err := repo.Patch(context.Background(), 1, tt.patch)
+			tt.checkResults(t, result, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_ListWithAliases(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	mockDB, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	mockDB.ExpectQuery(regexp.QuoteMeta(listCompaniesWithAliasesQuery)).
+		WillReturnRows(pgxmock.NewRows([]string{
+			"id", "name", "slug", "logo_url", "website_url", "country", "is_active", "created_at", "updated_at",
+			"aliases",
+		}).
+			AddRow(1, "EquiFax", "equifax", "https://equifax.com/logo.png", "https://www.equifax.com", "CR", true,
+				now, now, []string{"Equifax Costa Rica"}).
+			AddRow(2, "Tech Corp", "tech-corp", "https://techcorp.com/logo.png", "", "CR", true,
+				now, now, []string{}))
+
+	companies, err := NewRepository(mockDB).ListWithAliases(context.Background())
+	require.NoError(t, err)
+	require.Len(t, companies, 2)
+	assert.Equal(t, "https://www.equifax.com", companies[0].WebsiteURL)
+	assert.Equal(t, []string{"Equifax Costa Rica"}, companies[0].Aliases)
+	assert.Empty(t, companies[1].Aliases)
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
+
+func TestRepository_Merge(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+	duplicate := &Company{ID: 2, Name: "Equifax CR", Slug: "equifax-cr"}
+
+	// expectMoves expects the statements moving everything of the duplicate
+	expectMoves := func(mock pgxmock.PgxPoolIface) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(mergeCompanyJobsQuery)).
+			WithArgs(2, 1, "").
+			WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(101).AddRow(102))
+		for _, query := range []string{
+			mergeCompanyAliasesQuery, mergeCompanyClaimsQuery, mergeCompanyMembersQuery, mergeCompanyIngestReportsQuery,
+		} {
+			mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(2, 1).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		}
+		mock.ExpectExec(regexp.QuoteMeta(addMergedNameAliasQuery)).
+			WithArgs(1, "Equifax CR").
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mock.ExpectExec(regexp.QuoteMeta(deleteMergedLogoChecksQuery)).
+			WithArgs(2).
+			WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	}
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, moved []int, err error)
+	}{
+		{
+			name: "duplicate merged",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				expectMoves(mock)
+				mock.ExpectExec(regexp.QuoteMeta(deleteCompanyQuery)).
+					WithArgs(2).
+					WillReturnResult(pgxmock.NewResult("DELETE", 1))
+				mock.ExpectCommit()
+			},
+			checkResults: func(t *testing.T, moved []int, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, []int{101, 102}, moved)
+			},
+		},
+		{
+			name: "duplicate deleted meanwhile",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				expectMoves(mock)
+				mock.ExpectExec(regexp.QuoteMeta(deleteCompanyQuery)).
+					WithArgs(2).
+					WillReturnResult(pgxmock.NewResult("DELETE", 0))
+				mock.ExpectRollback()
+			},
+			checkResults: func(t *testing.T, _ []int, err error) {
+				t.Helper()
+				assert.True(t, IsNotFound(err))
+			},
+		},
+		{
+			name: "jobs update error rolls back",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(mergeCompanyJobsQuery)).
+					WithArgs(2, 1, "").
+					WillReturnError(dbError)
+				mock.ExpectRollback()
+			},
+			checkResults: func(t *testing.T, _ []int, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			moved, err := repo.Merge(context.Background(), duplicate, 1)
+			tt.checkResults(t, moved, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,343 @@
+package company
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/companyalias"
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// DataRepository interface to make database operations for the Company model.
+type DataRepository interface {
+	Create(ctx context.Context, company *Company) error
+	GetByID(ctx context.Context, id int) (*Company, error)
+	GetByName(ctx context.Context, name string) (*Company, error)
+	GetByNames(ctx context.Context, names []string) (map[string]*Company, error)
+	GetBySlug(ctx context.Context, slug string) (*Company, error)
+	Update(ctx context.Context, company *Company) error
+	Patch(ctx context.Context, id int, patch *CompanyPatch) (*Company, error)
+	List(ctx context.Context) ([]*Company, error)
+	ListWithAliases(ctx context.Context) ([]*Company, error)
+	GetTechnologies(ctx context.Context, companyID int) ([]*TechnologyCount, error)
+}
+
+// AliasRepository interface to make database operations for the CompanyAlias model.
+type AliasRepository interface {
+	Create(ctx context.Context, alias *companyalias.CompanyAlias) error
+	GetByAlias(ctx context.Context, alias string) (*companyalias.CompanyAlias, error)
+}
+
+// LogoStore interface to store company logos and get the URL they are served from.
+// When the logo can't be used a placeholder URL is returned along with the error.
+type LogoStore interface {
+	StoreLogo(ctx context.Context, sourceURL string) (string, error)
+}
+
+// maxSlugAttempts limits how many numbered slugs are tried when the slug of a new company is taken
+const maxSlugAttempts = 20
+
+// CompanyService holds the business logic for company management.
+// It is shared by the HTTP handlers and the CLI populators so both apply the same rules.
+type CompanyService struct {
+	repo      DataRepository
+	aliasRepo AliasRepository
+	logos     LogoStore
+}
+
+// NewCompanyService creates a new instance of CompanyService. When logos is not nil, company
+// logos are copied to it on create and update instead of linking to their source.
+func NewCompanyService(repo DataRepository, aliasRepo AliasRepository, logos LogoStore) *CompanyService {
+	return &CompanyService{repo: repo, aliasRepo: aliasRepo, logos: logos}
+}
+
+// Create validates and inserts a new company, generating its slug from the name.
+// When the slug is already taken a numbered one is used instead ("acme", "acme-2", ...).
+// Returns a DuplicateError if a company with the same name already exists, and a SimilarError
+// listing them if it may duplicate existing companies, e.g. "Equifax CR" when "EquiFax" exists.
+func (s *CompanyService) Create(ctx context.Context, company *Company) error {
+	return s.create(ctx, company, true)
+}
+
+// CreateSimilar creates a company like Create, even when it is similar to existing companies,
+// once an admin checked they are different companies.
+func (s *CompanyService) CreateSimilar(ctx context.Context, company *Company) error {
+	return s.create(ctx, company, false)
+}
+
+// create validates and inserts a new company, checking first for similar companies when checkSimilar is set
+func (s *CompanyService) create(ctx context.Context, company *Company, checkSimilar bool) error {
+	company.Name = strings.TrimSpace(company.Name)
+	company.LogoURL = strings.TrimSpace(company.LogoURL)
+	company.WebsiteURL = strings.TrimSpace(company.WebsiteURL)
+
+	if err := validateCompany(company); err != nil {
+		return err
+	}
+	if checkSimilar {
+		if err := s.checkSimilar(ctx, company); err != nil {
+			return err
+		}
+	}
+	if err := s.storeLogo(ctx, company); err != nil {
+		return err
+	}
+
+	base := Slugify(company.Name)
+	for attempt := 1; attempt <= maxSlugAttempts; attempt++ {
+		company.Slug = slugCandidate(base, attempt)
+
+		err := s.repo.Create(ctx, company)
+		var duplicateErr *DuplicateError
+		if errors.As(err, &duplicateErr) && duplicateErr.Slug != "" {
+			continue // Slug taken, try the next numbered one
+		}
+		return err
+	}
+
+	return &DuplicateError{Name: company.Name, Slug: base}
+}
+
+// checkSimilar returns a DuplicateError when a company has the name of company, and a SimilarError
+// when company may duplicate existing companies
+func (s *CompanyService) checkSimilar(ctx context.Context, company *Company) error {
+	candidates, err := s.repo.ListWithAliases(ctx)
+	if err != nil {
+		return err
+	}
+
+	similar := findSimilar(company, candidates)
+	for _, match := range similar {
+		if match.Company.Name == company.Name {
+			return &DuplicateError{Name: company.Name}
+		}
+	}
+	if len(similar) > 0 {
+		return &SimilarError{Name: company.Name, Similar: similar}
+	}
+
+	return nil
+}
+
+// GetByName retrieves a company by its exact name.
+func (s *CompanyService) GetByName(ctx context.Context, name string) (*Company, error) {
+	return s.repo.GetByName(ctx, strings.TrimSpace(name))
+}
+
+// GetByNames retrieves the companies of the given exact names in a single query, by trimmed name.
+// Names of no company are missing from the map.
+func (s *CompanyService) GetByNames(ctx context.Context, names []string) (map[string]*Company, error) {
+	seen := make(map[string]bool, len(names))
+	trimmed := make([]string, 0, len(names))
+	for _, name := range names {
+		if name = strings.TrimSpace(name); name != "" && !seen[name] {
+			seen[name] = true
+			trimmed = append(trimmed, name)
+		}
+	}
+	return s.repo.GetByNames(ctx, trimmed)
+}
+
+// FindByNameOrAlias resolves a company by its current name or one of its aliases, so job data
+// still naming a company by its former name is assigned to it.
+func (s *CompanyService) FindByNameOrAlias(ctx context.Context, name string) (*Company, error) {
+	name = strings.TrimSpace(name)
+
+	company, err := s.repo.GetByName(ctx, name)
+	if err == nil {
+		return company, nil
+	}
+	if !IsNotFound(err) {
+		return nil, err
+	}
+
+	// If not found by exact name, try to find by alias
+	alias, err := s.aliasRepo.GetByAlias(ctx, name)
+	if err != nil {
+		if companyalias.IsNotFound(err) {
+			return nil, &NotFoundError{Name: name}
+		}
+		return nil, err
+	}
+
+	return s.repo.GetByID(ctx, alias.CompanyID)
+}
+
+// AddAliases attaches aliases to a company, skipping empty and already existing aliases.
+// All aliases are attempted; failures are returned together.
+func (s *CompanyService) AddAliases(ctx context.Context, companyID int, aliases []string) error {
+	var errs []error
+	for _, aliasName := range aliases {
+		aliasName = strings.TrimSpace(aliasName)
+		if aliasName == "" {
+			continue
+		}
+
+		alias := &companyalias.CompanyAlias{
+			CompanyID: companyID,
+			Alias:     aliasName,
+		}
+		if err := s.aliasRepo.Create(ctx, alias); err != nil && !companyalias.IsDuplicate(err) {
+			errs = append(errs, fmt.Errorf("alias %s: %w", aliasName, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// GetBySlug retrieves an active company by its URL slug. Archived companies are reported as
+// not found, they are hidden from the public API.
+func (s *CompanyService) GetBySlug(ctx context.Context, slug string) (*Company, error) {
+	slug = strings.ToLower(strings.TrimSpace(slug))
+
+	company, err := s.repo.GetBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	if !company.IsActive {
+		return nil, &NotFoundError{Slug: slug}
+	}
+
+	return company, nil
+}
+
+// GetTechnologyStack retrieves a company by its URL slug along with the technologies of its active jobs.
+func (s *CompanyService) GetTechnologyStack(ctx context.Context, slug string) (*Company, []*TechnologyCount, error) {
+	company, err := s.GetBySlug(ctx, slug)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	technologies, err := s.repo.GetTechnologies(ctx, company.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return company, technologies, nil
+}
+
+// Update validates and updates an existing company.
+func (s *CompanyService) Update(ctx context.Context, company *Company) error {
+	company.Name = strings.TrimSpace(company.Name)
+	company.LogoURL = strings.TrimSpace(company.LogoURL)
+	company.WebsiteURL = strings.TrimSpace(company.WebsiteURL)
+
+	if err := validateCompany(company); err != nil {
+		return err
+	}
+	if err := s.storeLogo(ctx, company); err != nil {
+		return err
+	}
+
+	return s.repo.Update(ctx, company)
+}
+
+// Patch changes the fields set in patch of a company, found by its URL slug, e.g. to fix a typo,
+// and returns the updated company. Archived companies can be patched too. A renamed company keeps
+// its former name as an alias, so jobs still posted under it are assigned to the company.
+func (s *CompanyService) Patch(ctx context.Context, slug string, patch *CompanyPatch) (*Company, error) {
+	if err := validatePatch(patch); err != nil {
+		return nil, err
+	}
+
+	company, err := s.repo.GetBySlug(ctx, strings.ToLower(strings.TrimSpace(slug)))
+	if err != nil {
+		return nil, err
+	}
+
+	if patch.LogoURL != nil {
+		logo := &Company{Name: company.Name, LogoURL: *patch.LogoURL}
+		if err = s.storeLogo(ctx, logo); err != nil {
+			return nil, err
+		}
+		patch.LogoURL = &logo.LogoURL
+	}
+
+	patched, err := s.repo.Patch(ctx, company.ID, patch)
+	if err != nil {
+		return nil, err
+	}
+
+	if patched.Name != company.Name {
+		if err = s.AddAliases(ctx, company.ID, []string{company.Name}); err != nil {
+			return nil, err
+		}
+	}
+
+	return patched, nil
+}
+
+// List retrieves all companies ordered by name.
+func (s *CompanyService) List(ctx context.Context) ([]*Company, error) {
+	return s.repo.List(ctx)
+}
+
+// storeLogo replaces the company logo URL by the URL of its stored copy. Logos that can't be
+// used are replaced by the placeholder, so the company is still saved.
+func (s *CompanyService) storeLogo(ctx context.Context, company *Company) error {
+	if s.logos == nil {
+		return nil
+	}
+
+	logoURL, err := s.logos.StoreLogo(ctx, company.LogoURL)
+	if logoURL == "" {
+		return fmt.Errorf("failed to store logo of company %s: %w", company.Name, err)
+	}
+
+	company.LogoURL = logoURL
+	return nil
+}
+
+// validateCompany checks the required company fields
+func validateCompany(company *Company) error {
+	var errs []string
+
+	if company.Name == "" {
+		errs = append(errs, "company name cannot be empty")
+	}
+	if company.LogoURL == "" {
+		errs = append(errs, "company logo_url cannot be empty")
+	}
+
+	if len(errs) > 0 {
+		return &httpservice.ValidationError{Errors: errs}
+	}
+
+	return nil
+}
+
+// validatePatch trims the fields of a company patch and checks that at least one is set and none is empty
+func validatePatch(patch *CompanyPatch) error {
+	if patch.Name == nil && patch.LogoURL == nil && patch.WebsiteURL == nil {
+		return &httpservice.ValidationError{Errors: []string{"at least one field must be provided"}}
+	}
+
+	var errs []string
+	if patch.Name != nil {
+		name := strings.TrimSpace(*patch.Name)
+		patch.Name = &name
+		if name == "" {
+			errs = append(errs, "company name cannot be empty")
+		}
+	}
+	if patch.LogoURL != nil {
+		logoURL := strings.TrimSpace(*patch.LogoURL)
+		patch.LogoURL = &logoURL
+		if logoURL == "" {
+			errs = append(errs, "company logo_url cannot be empty")
+		}
+	}
+	if patch.WebsiteURL != nil {
+		// An empty website removes it
+		websiteURL := strings.TrimSpace(*patch.WebsiteURL)
+		patch.WebsiteURL = &websiteURL
+	}
+
+	if len(errs) > 0 {
+		return &httpservice.ValidationError{Errors: errs}
+	}
+
+	return nil
+}
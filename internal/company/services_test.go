@@ -0,0 +1,547 @@
+package company
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/companyalias"
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+func TestCompanyService_Create(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		company      *Company
+		mockSetup    func(mockRepo *MockDataRepository)
+		checkResults func(t *testing.T, company *Company, err error)
+	}{
+		{
+			name: "successful creation trims fields",
+			company: &Company{
+				Name:     "  Tech Corp  ",
+				LogoURL:  " https://techcorp.com/logo.png ",
+				IsActive: true,
+			},
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().ListWithAliases(context.Background()).Return(nil, nil).Once()
+				mockRepo.EXPECT().Create(context.Background(), mock.MatchedBy(func(c *Company) bool {
+					return c.Name == "Tech Corp" && c.LogoURL == "https://techcorp.com/logo.png"
+				})).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, company *Company, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, "Tech Corp", company.Name)
+				assert.Equal(t, "tech-corp", company.Slug)
+			},
+		},
+		{
+			name: "taken slug gets a counter",
+			company: &Company{
+				Name:    "Tech-Corp",
+				LogoURL: "https://techcorp.com/logo.png",
+			},
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().ListWithAliases(context.Background()).Return(nil, nil).Once()
+				mockRepo.EXPECT().Create(context.Background(), mock.MatchedBy(func(c *Company) bool {
+					return c.Slug == "tech-corp"
+				})).Return(&DuplicateError{Name: "Tech-Corp", Slug: "tech-corp"}).Once()
+				mockRepo.EXPECT().Create(context.Background(), mock.MatchedBy(func(c *Company) bool {
+					return c.Slug == "tech-corp-2"
+				})).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, company *Company, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, "tech-corp-2", company.Slug)
+			},
+		},
+		{
+			name:      "missing name and logo",
+			company:   &Company{Name: " "},
+			mockSetup: func(_ *MockDataRepository) {},
+			checkResults: func(t *testing.T, _ *Company, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Len(t, validationErr.Errors, 2)
+			},
+		},
+		{
+			name: "duplicate company",
+			company: &Company{
+				Name:    "Tech Corp",
+				LogoURL: "https://techcorp.com/logo.png",
+			},
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().ListWithAliases(context.Background()).Return(nil, nil).Once()
+				mockRepo.EXPECT().Create(context.Background(), mock.Anything).
+					Return(&DuplicateError{Name: "Tech Corp"}).Once()
+			},
+			checkResults: func(t *testing.T, _ *Company, err error) {
+				t.Helper()
+				assert.True(t, IsDuplicate(err))
+			},
+		},
+		{
+			name: "name of an existing company",
+			company: &Company{
+				Name:    "Tech Corp",
+				LogoURL: "https://techcorp.com/logo.png",
+			},
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().ListWithAliases(context.Background()).
+					Return([]*Company{{ID: 1, Name: "Tech Corp", Slug: "tech-corp"}}, nil).Once()
+			},
+			checkResults: func(t *testing.T, _ *Company, err error) {
+				t.Helper()
+				assert.True(t, IsDuplicate(err))
+			},
+		},
+		{
+			name: "similar companies",
+			company: &Company{
+				Name:       "Equifax CR",
+				LogoURL:    "https://equifax.com/logo.png",
+				WebsiteURL: "https://www.equifax.co.cr",
+			},
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().ListWithAliases(context.Background()).Return([]*Company{
+					{ID: 1, Name: "EquiFax", Slug: "equifax"},
+					{ID: 2, Name: "Tech Corp", Slug: "tech-corp"},
+					{ID: 3, Name: "Credit Bureau", Slug: "credit-bureau", WebsiteURL: "equifax.co.cr/about"},
+				}, nil).Once()
+			},
+			checkResults: func(t *testing.T, _ *Company, err error) {
+				t.Helper()
+				var similarErr *SimilarError
+				require.ErrorAs(t, err, &similarErr)
+				require.ErrorIs(t, err, httpservice.ErrConflict)
+				assert.Equal(t, []string{
+					"EquiFax (equifax): similar name",
+					"Credit Bureau (credit-bureau): similar domain",
+				}, similarErr.Details())
+			},
+		},
+		{
+			name: "database error",
+			company: &Company{
+				Name:    "Tech Corp",
+				LogoURL: "https://techcorp.com/logo.png",
+			},
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().ListWithAliases(context.Background()).Return(nil, nil).Once()
+				mockRepo.EXPECT().Create(context.Background(), mock.Anything).Return(dbError).Once()
+			},
+			checkResults: func(t *testing.T, _ *Company, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			service := NewCompanyService(mockRepo, nil, nil)
+
+			tt.mockSetup(mockRepo)
+
+			err := service.Create(context.Background(), tt.company)
+			tt.checkResults(t, tt.company, err)
+		})
+	}
+}
+
+func TestCompanyService_CreateSimilar(t *testing.T) {
+	t.Parallel()
+	mockRepo := NewMockDataRepository(t)
+	mockRepo.EXPECT().Create(context.Background(), mock.MatchedBy(func(c *Company) bool {
+		return c.Slug == "equifax-cr"
+	})).Return(nil).Once()
+
+	err := NewCompanyService(mockRepo, nil, nil).CreateSimilar(context.Background(), &Company{
+		Name:    "Equifax CR",
+		LogoURL: "https://equifax.com/logo.png",
+	})
+	require.NoError(t, err)
+}
+
+func TestCompanyService_UpdateStoresLogo(t *testing.T) {
+	t.Parallel()
+	storageError := errors.New("storage error")
+	const sourceURL = "https://techcorp.com/logo.png"
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mockRepo *MockDataRepository, mockLogos *MockLogoStore)
+		checkResults func(t *testing.T, company *Company, err error)
+	}{
+		{
+			name: "logo replaced by its stored copy",
+			mockSetup: func(mockRepo *MockDataRepository, mockLogos *MockLogoStore) {
+				t.Helper()
+				mockLogos.EXPECT().StoreLogo(context.Background(), sourceURL).
+					Return("https://cdn.ticosintech.com/logos/abc.png", nil).Once()
+				mockRepo.EXPECT().Update(context.Background(), mock.Anything).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, company *Company, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, "https://cdn.ticosintech.com/logos/abc.png", company.LogoURL)
+			},
+		},
+		{
+			name: "unusable logo replaced by the placeholder",
+			mockSetup: func(mockRepo *MockDataRepository, mockLogos *MockLogoStore) {
+				t.Helper()
+				mockLogos.EXPECT().StoreLogo(context.Background(), sourceURL).
+					Return("https://cdn.ticosintech.com/logos/placeholder.png", errors.New("not an image")).Once()
+				mockRepo.EXPECT().Update(context.Background(), mock.Anything).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, company *Company, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, "https://cdn.ticosintech.com/logos/placeholder.png", company.LogoURL)
+			},
+		},
+		{
+			name: "storage error",
+			mockSetup: func(_ *MockDataRepository, mockLogos *MockLogoStore) {
+				t.Helper()
+				mockLogos.EXPECT().StoreLogo(context.Background(), sourceURL).Return("", storageError).Once()
+			},
+			checkResults: func(t *testing.T, _ *Company, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, storageError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			mockLogos := NewMockLogoStore(t)
+			service := NewCompanyService(mockRepo, nil, mockLogos)
+
+			tt.mockSetup(mockRepo, mockLogos)
+
+			company := &Company{ID: 1, Name: "Tech Corp", LogoURL: sourceURL}
+			err := service.Update(context.Background(), company)
+			tt.checkResults(t, company, err)
+		})
+	}
+}
+
+func TestCompanyService_Patch(t *testing.T) {
+	t.Parallel()
+	ptr := func(value string) *string { return &value }
+	current := &Company{ID: 1, Name: "Tech Corp", Slug: "tech-corp", LogoURL: "https://techcorp.com/logo.png"}
+
+	tests := []struct {
+		name         string
+		patch        *CompanyPatch
+		mockSetup    func(mockRepo *MockDataRepository, mockAlias *MockAliasRepository)
+		checkResults func(t *testing.T, company *Company, err error)
+	}{
+		{
+			name:  "renamed company keeps its former name as an alias",
+			patch: &CompanyPatch{Name: ptr(" Tech Corp Labs ")},
+			mockSetup: func(mockRepo *MockDataRepository, mockAlias *MockAliasRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetBySlug(context.Background(), "tech-corp").Return(current, nil).Once()
+				mockRepo.EXPECT().Patch(context.Background(), 1, &CompanyPatch{Name: ptr("Tech Corp Labs")}).
+					Return(&Company{ID: 1, Name: "Tech Corp Labs", Slug: "tech-corp"}, nil).Once()
+				mockAlias.EXPECT().Create(context.Background(),
+					&companyalias.CompanyAlias{CompanyID: 1, Alias: "Tech Corp"}).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, company *Company, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, "Tech Corp Labs", company.Name)
+				assert.Equal(t, "tech-corp", company.Slug)
+			},
+		},
+		{
+			name:  "logo changed without adding an alias",
+			patch: &CompanyPatch{LogoURL: ptr("https://techcorp.com/new-logo.png")},
+			mockSetup: func(mockRepo *MockDataRepository, _ *MockAliasRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetBySlug(context.Background(), "tech-corp").Return(current, nil).Once()
+				mockRepo.EXPECT().Patch(context.Background(), 1,
+					&CompanyPatch{LogoURL: ptr("https://techcorp.com/new-logo.png")}).
+					Return(&Company{ID: 1, Name: "Tech Corp", LogoURL: "https://techcorp.com/new-logo.png"}, nil).Once()
+			},
+			checkResults: func(t *testing.T, company *Company, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, "https://techcorp.com/new-logo.png", company.LogoURL)
+			},
+		},
+		{
+			name:      "empty patch",
+			patch:     &CompanyPatch{},
+			mockSetup: func(_ *MockDataRepository, _ *MockAliasRepository) {},
+			checkResults: func(t *testing.T, _ *Company, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Contains(t, validationErr.Errors, "at least one field must be provided")
+			},
+		},
+		{
+			name:      "blank name",
+			patch:     &CompanyPatch{Name: ptr("  ")},
+			mockSetup: func(_ *MockDataRepository, _ *MockAliasRepository) {},
+			checkResults: func(t *testing.T, _ *Company, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Contains(t, validationErr.Errors, "company name cannot be empty")
+			},
+		},
+		{
+			name:  "company not found",
+			patch: &CompanyPatch{Name: ptr("Tech Corp Labs")},
+			mockSetup: func(mockRepo *MockDataRepository, _ *MockAliasRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetBySlug(context.Background(), "tech-corp").
+					Return(nil, &NotFoundError{Slug: "tech-corp"}).Once()
+			},
+			checkResults: func(t *testing.T, _ *Company, err error) {
+				t.Helper()
+				assert.True(t, IsNotFound(err))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			mockAlias := NewMockAliasRepository(t)
+			service := NewCompanyService(mockRepo, mockAlias, nil)
+
+			tt.mockSetup(mockRepo, mockAlias)
+
+			company, err := service.Patch(context.Background(), "Tech-Corp", tt.patch)
+			tt.checkResults(t, company, err)
+		})
+	}
+}
+
+func TestCompanyService_GetTechnologyStack(t *testing.T) {
+	t.Parallel()
+	company := &Company{ID: 1, Name: "Tech Corp", Slug: "tech-corp", IsActive: true}
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mockRepo *MockDataRepository)
+		checkResults func(t *testing.T, company *Company, technologies []*TechnologyCount, err error)
+	}{
+		{
+			name: "technologies of the company",
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetBySlug(context.Background(), "tech-corp").Return(company, nil).Once()
+				mockRepo.EXPECT().GetTechnologies(context.Background(), 1).
+					Return([]*TechnologyCount{{Name: "go", Category: "programming", Jobs: 4, RequiredJobs: 3}}, nil).Once()
+			},
+			checkResults: func(t *testing.T, gotCompany *Company, technologies []*TechnologyCount, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, company, gotCompany)
+				require.Len(t, technologies, 1)
+				assert.Equal(t, "go", technologies[0].Name)
+			},
+		},
+		{
+			name: "unknown company",
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetBySlug(context.Background(), "tech-corp").
+					Return(nil, &NotFoundError{Slug: "tech-corp"}).Once()
+			},
+			checkResults: func(t *testing.T, _ *Company, _ []*TechnologyCount, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, httpservice.ErrNotFound)
+			},
+		},
+		{
+			name: "archived company is hidden",
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetBySlug(context.Background(), "tech-corp").
+					Return(&Company{ID: 1, Slug: "tech-corp"}, nil).Once()
+			},
+			checkResults: func(t *testing.T, _ *Company, _ []*TechnologyCount, err error) {
+				t.Helper()
+				assert.True(t, IsNotFound(err))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			service := NewCompanyService(mockRepo, nil, nil)
+
+			tt.mockSetup(mockRepo)
+
+			gotCompany, technologies, err := service.GetTechnologyStack(context.Background(), " Tech-Corp ")
+			tt.checkResults(t, gotCompany, technologies, err)
+		})
+	}
+}
+
+func TestCompanyService_GetByNames(t *testing.T) {
+	t.Parallel()
+	mockRepo := NewMockDataRepository(t)
+	service := NewCompanyService(mockRepo, NewMockAliasRepository(t), nil)
+
+	companies := map[string]*Company{"Tech Corp": {ID: 1, Name: "Tech Corp"}}
+	mockRepo.EXPECT().GetByNames(context.Background(), []string{"Tech Corp", "Data Corp"}).
+		Return(companies, nil).Once()
+
+	result, err := service.GetByNames(context.Background(), []string{" Tech Corp ", "Data Corp", "", "Tech Corp"})
+	require.NoError(t, err)
+	assert.Equal(t, companies, result)
+}
+
+func TestCompanyService_FindByNameOrAlias(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		input        string
+		mockSetup    func(mockRepo *MockDataRepository, mockAlias *MockAliasRepository)
+		checkResults func(t *testing.T, company *Company, err error)
+	}{
+		{
+			name:  "found by name",
+			input: " Tech Corp ",
+			mockSetup: func(mockRepo *MockDataRepository, _ *MockAliasRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetByName(context.Background(), "Tech Corp").
+					Return(&Company{ID: 1, Name: "Tech Corp"}, nil).Once()
+			},
+			checkResults: func(t *testing.T, company *Company, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 1, company.ID)
+			},
+		},
+		{
+			name:  "found by former name",
+			input: "Old Corp",
+			mockSetup: func(mockRepo *MockDataRepository, mockAlias *MockAliasRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetByName(context.Background(), "Old Corp").
+					Return(nil, &NotFoundError{Name: "Old Corp"}).Once()
+				mockAlias.EXPECT().GetByAlias(context.Background(), "Old Corp").
+					Return(&companyalias.CompanyAlias{ID: 4, CompanyID: 1, Alias: "Old Corp"}, nil).Once()
+				mockRepo.EXPECT().GetByID(context.Background(), 1).
+					Return(&Company{ID: 1, Name: "Tech Corp"}, nil).Once()
+			},
+			checkResults: func(t *testing.T, company *Company, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, "Tech Corp", company.Name)
+			},
+		},
+		{
+			name:  "not found",
+			input: "Unknown Corp",
+			mockSetup: func(mockRepo *MockDataRepository, mockAlias *MockAliasRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetByName(context.Background(), "Unknown Corp").
+					Return(nil, &NotFoundError{Name: "Unknown Corp"}).Once()
+				mockAlias.EXPECT().GetByAlias(context.Background(), "Unknown Corp").
+					Return(nil, &companyalias.NotFoundError{Alias: "Unknown Corp"}).Once()
+			},
+			checkResults: func(t *testing.T, _ *Company, err error) {
+				t.Helper()
+				var notFoundErr *NotFoundError
+				require.ErrorAs(t, err, &notFoundErr)
+				assert.Equal(t, "Unknown Corp", notFoundErr.Name)
+			},
+		},
+		{
+			name:  "database error skips the alias lookup",
+			input: "Tech Corp",
+			mockSetup: func(mockRepo *MockDataRepository, _ *MockAliasRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetByName(context.Background(), "Tech Corp").Return(nil, dbError).Once()
+			},
+			checkResults: func(t *testing.T, _ *Company, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			mockAlias := NewMockAliasRepository(t)
+			service := NewCompanyService(mockRepo, mockAlias, nil)
+
+			tt.mockSetup(mockRepo, mockAlias)
+
+			company, err := service.FindByNameOrAlias(context.Background(), tt.input)
+			tt.checkResults(t, company, err)
+		})
+	}
+}
+
+func TestCompanyService_AddAliases(t *testing.T) {
+	t.Parallel()
+	mockAlias := NewMockAliasRepository(t)
+	service := NewCompanyService(NewMockDataRepository(t), mockAlias, nil)
+
+	mockAlias.EXPECT().Create(context.Background(), &companyalias.CompanyAlias{CompanyID: 1, Alias: "Old Corp"}).
+		Return(&companyalias.DuplicateError{Alias: "Old Corp"}).Once()
+	mockAlias.EXPECT().Create(context.Background(), &companyalias.CompanyAlias{CompanyID: 1, Alias: "TC"}).
+		Return(errors.New("database error")).Once()
+
+	err := service.AddAliases(context.Background(), 1, []string{" Old Corp ", "", "TC"})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "alias TC")
+	assert.NotContains(t, err.Error(), "Old Corp")
+}
+
+func TestSlugify(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"Tech Corp":         "tech-corp",
+		"Café Britt S.A.":   "cafe-britt-s-a",
+		"  Señor--Dev!! ":   "senor-dev",
+		"Intel® Costa Rica": "intel-costa-rica",
+		"???":               "company",
+	}
+
+	for input, expected := range tests {
+		assert.Equal(t, expected, Slugify(input), input)
+	}
+}
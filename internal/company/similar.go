@@ -0,0 +1,102 @@
+package company
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// Reasons of a similar company
+const (
+	// SimilarName means both names are the same once case, accents, punctuation and legal or
+	// country suffixes are ignored, e.g. "EquiFax" and "Equifax CR"
+	SimilarName = "name"
+	// SimilarDomain means both websites have the same domain
+	SimilarDomain = "domain"
+)
+
+// nameSuffixes are the trailing words of company names that don't tell companies apart, legal
+// forms and the country, longest first
+var nameSuffixes = [][]string{
+	{"de", "costa", "rica"},
+	{"costa", "rica"},
+	{"s", "r", "l"},
+	{"s", "a"},
+	{"sa"}, {"srl"}, {"ltda"}, {"ltd"}, {"inc"}, {"llc"}, {"corp"}, {"corporation"}, {"cr"},
+}
+
+// SimilarCompany is an existing company a new one may duplicate
+type SimilarCompany struct {
+	Company *Company
+	// Reason is SimilarName or SimilarDomain
+	Reason string
+}
+
+// NameKey returns the key identifying a company name regardless of case, accents, punctuation,
+// spacing and of its legal or country suffixes, e.g. "Equifax CR" and "EquiFax S.A." -> "equifax"
+func NameKey(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(foldAccents(name)), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	for trimmed := true; trimmed; {
+		trimmed = false
+		for _, suffix := range nameSuffixes {
+			// A name made of a suffix alone is kept, e.g. "Inc"
+			if len(words) > len(suffix) && slices.Equal(words[len(words)-len(suffix):], suffix) {
+				words = words[:len(words)-len(suffix)]
+				trimmed = true
+				break
+			}
+		}
+	}
+
+	return strings.Join(words, "")
+}
+
+// WebsiteDomain returns the domain of a website URL without its www prefix, e.g.
+// "https://www.equifax.com/careers" -> "equifax.com", or an empty string when it has none
+func WebsiteDomain(websiteURL string) string {
+	websiteURL = strings.TrimSpace(websiteURL)
+	if websiteURL == "" {
+		return ""
+	}
+	if !strings.Contains(websiteURL, "://") {
+		websiteURL = "https://" + websiteURL
+	}
+
+	parsed, err := url.Parse(websiteURL)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimPrefix(strings.ToLower(parsed.Hostname()), "www.")
+}
+
+// findSimilar returns the companies among candidates that company may duplicate, by name, by
+// one of their aliases or by the domain of their website
+func findSimilar(company *Company, candidates []*Company) []*SimilarCompany {
+	key := NameKey(company.Name)
+	domain := WebsiteDomain(company.WebsiteURL)
+
+	var similar []*SimilarCompany
+	for _, candidate := range candidates {
+		switch {
+		case key != "" && hasNameKey(candidate, key):
+			similar = append(similar, &SimilarCompany{Company: candidate, Reason: SimilarName})
+		case domain != "" && WebsiteDomain(candidate.WebsiteURL) == domain:
+			similar = append(similar, &SimilarCompany{Company: candidate, Reason: SimilarDomain})
+		}
+	}
+	return similar
+}
+
+// hasNameKey reports whether the name or one of the aliases of company has the given key
+func hasNameKey(company *Company, key string) bool {
+	if NameKey(company.Name) == key {
+		return true
+	}
+	return slices.ContainsFunc(company.Aliases, func(alias string) bool {
+		return NameKey(alias) == key
+	})
+}
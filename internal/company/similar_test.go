@@ -0,0 +1,65 @@
+package company
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNameKey(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"EquiFax":                  "equifax",
+		"Equifax CR":               "equifax",
+		"Equi-Fax S.A.":            "equifax",
+		"Intel de Costa Rica":      "intel",
+		"Café Britt Corp. S.R.L.":  "cafebritt",
+		"Grupo Babel Costa Rica":   "grupobabel",
+		"Inc":                      "inc",
+		"CR Solutions":             "crsolutions",
+		"  Señor Dev, Inc.  ":      "senordev",
+		"Accenture Costa Rica Ltd": "accenture",
+	}
+
+	for input, expected := range tests {
+		assert.Equal(t, expected, NameKey(input), input)
+	}
+}
+
+func TestWebsiteDomain(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"https://www.equifax.com/careers": "equifax.com",
+		"http://Careers.Equifax.com":      "careers.equifax.com",
+		"equifax.co.cr/about":             "equifax.co.cr",
+		"www.equifax.com:443":             "equifax.com",
+		"":                                "",
+		"   ":                             "",
+	}
+
+	for input, expected := range tests {
+		assert.Equal(t, expected, WebsiteDomain(input), input)
+	}
+}
+
+func TestFindSimilar(t *testing.T) {
+	t.Parallel()
+	candidates := []*Company{
+		{ID: 1, Name: "EquiFax", WebsiteURL: "https://www.equifax.com"},
+		{ID: 2, Name: "Credit Bureau", Aliases: []string{"Equifax Costa Rica"}},
+		{ID: 3, Name: "Tech Corp", WebsiteURL: "https://techcorp.com"},
+	}
+
+	similar := findSimilar(&Company{Name: "Equifax CR"}, candidates)
+	assert.Equal(t, []*SimilarCompany{
+		{Company: candidates[0], Reason: SimilarName},
+		{Company: candidates[1], Reason: SimilarName},
+	}, similar)
+
+	similar = findSimilar(&Company{Name: "TC Labs", WebsiteURL: "techcorp.com/labs"}, candidates)
+	assert.Equal(t, []*SimilarCompany{{Company: candidates[2], Reason: SimilarDomain}}, similar)
+
+	assert.Empty(t, findSimilar(&Company{Name: "Data Inc"}, candidates))
+}
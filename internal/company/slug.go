@@ -0,0 +1,56 @@
+package company
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// defaultSlug is used for names without any letter or digit
+const defaultSlug = "company"
+
+// Slugify converts a company name into a URL friendly slug, e.g. "Café Britt S.A." -> "cafe-britt-s-a".
+// Accents are removed and every run of characters other than ASCII letters and digits becomes a dash.
+func Slugify(name string) string {
+	folded := foldAccents(name)
+
+	var b strings.Builder
+	pendingDash := false
+	for _, r := range strings.ToLower(folded) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if pendingDash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			pendingDash = false
+			continue
+		}
+		pendingDash = true
+	}
+
+	if b.Len() == 0 {
+		return defaultSlug
+	}
+	return b.String()
+}
+
+// foldAccents removes the accents of s, e.g. "Café" -> "Cafe"
+func foldAccents(s string) string {
+	folded, _, err := transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn))), s)
+	if err != nil {
+		return s
+	}
+	return folded
+}
+
+// slugCandidate returns the slug to try on the given attempt, adding a counter after the first one
+func slugCandidate(base string, attempt int) string {
+	if attempt <= 1 {
+		return base
+	}
+	return base + "-" + strconv.Itoa(attempt)
+}
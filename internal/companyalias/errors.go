@@ -0,0 +1,54 @@
+// Package companyalias provides functionality for managing company aliases, the former or
+// alternative names companies are known by, including CRUD operations and error handling.
+package companyalias
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// NotFoundError represents a company alias not found error
+type NotFoundError struct {
+	ID    int
+	Alias string
+}
+
+func (e NotFoundError) Error() string {
+	if e.ID != 0 {
+		return fmt.Sprintf("company alias with ID %d not found", e.ID)
+	}
+	return fmt.Sprintf("company alias with value %q not found", e.Alias)
+}
+
+// Is matches httpservice.ErrNotFound
+func (e NotFoundError) Is(target error) bool {
+	return target == httpservice.ErrNotFound
+}
+
+// IsNotFound checks if an error is a company alias not found error
+func IsNotFound(err error) bool {
+	var notFoundErr *NotFoundError
+	return errors.As(err, &notFoundErr)
+}
+
+// DuplicateError represents a duplicate company alias error
+type DuplicateError struct {
+	Alias string
+}
+
+func (e DuplicateError) Error() string {
+	return fmt.Sprintf("company alias %q already exists", e.Alias)
+}
+
+// Is matches httpservice.ErrConflict
+func (e DuplicateError) Is(target error) bool {
+	return target == httpservice.ErrConflict
+}
+
+// IsDuplicate checks if an error is a duplicate company alias error
+func IsDuplicate(err error) bool {
+	var duplicateErr *DuplicateError
+	return errors.As(err, &duplicateErr)
+}
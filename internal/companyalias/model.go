@@ -0,0 +1,14 @@
+package companyalias
+
+import (
+	"time"
+)
+
+// CompanyAlias represents a former or alternative name of a company.
+// For example, a company renamed after an acquisition keeps its former name as an alias.
+type CompanyAlias struct {
+	ID        int       `json:"id" db:"id"`
+	CompanyID int       `json:"company_id" db:"company_id"`
+	Alias     string    `json:"alias" db:"alias"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
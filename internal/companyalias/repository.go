@@ -0,0 +1,193 @@
+package companyalias
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// SQL query constants
+const (
+	createCompanyAliasQuery = `
+        INSERT INTO company_aliases (company_id, alias)
+        VALUES ($1, $2)
+        RETURNING id, created_at
+    `
+
+	getCompanyAliasByIDQuery = `
+        SELECT id, company_id, alias, created_at
+        FROM company_aliases
+        WHERE id = $1
+    `
+
+	getCompanyAliasByAliasQuery = `
+        SELECT id, company_id, alias, created_at
+        FROM company_aliases
+        WHERE alias = $1
+    `
+
+	updateCompanyAliasQuery = `
+        UPDATE company_aliases
+        SET alias = $1
+        WHERE id = $2
+    `
+
+	deleteCompanyAliasQuery = `DELETE FROM company_aliases WHERE id = $1`
+
+	listCompanyAliasesByCompanyIDQuery = `
+        SELECT id, company_id, alias, created_at
+        FROM company_aliases
+        WHERE company_id = $1
+        ORDER BY alias
+    `
+)
+
+// Database interface to support pgxpool and mocks
+type Database interface {
+	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
+	Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+}
+
+// Repository handles database operations for the CompanyAlias model.
+type Repository struct {
+	db Database
+}
+
+// NewRepository creates a new Repository instance.
+func NewRepository(db Database) *Repository {
+	return &Repository{db: db}
+}
+
+// Create inserts a new company alias into the database.
+func (r *Repository) Create(ctx context.Context, alias *CompanyAlias) error {
+	err := r.db.QueryRow(
+		ctx,
+		createCompanyAliasQuery,
+		alias.CompanyID,
+		alias.Alias,
+	).Scan(&alias.ID, &alias.CreatedAt)
+
+	if err != nil {
+		// Check for unique constraint violation (duplicate alias)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return &DuplicateError{Alias: alias.Alias}
+		}
+		return fmt.Errorf("failed to create company alias: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a company alias by its ID.
+func (r *Repository) GetByID(ctx context.Context, id int) (*CompanyAlias, error) {
+	alias := &CompanyAlias{}
+	err := r.db.QueryRow(ctx, getCompanyAliasByIDQuery, id).Scan(
+		&alias.ID,
+		&alias.CompanyID,
+		&alias.Alias,
+		&alias.CreatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &NotFoundError{ID: id}
+		}
+		return nil, fmt.Errorf("failed to get company alias: %w", err)
+	}
+
+	return alias, nil
+}
+
+// GetByAlias retrieves a company alias by its alias value.
+func (r *Repository) GetByAlias(ctx context.Context, aliasValue string) (*CompanyAlias, error) {
+	alias := &CompanyAlias{}
+	err := r.db.QueryRow(ctx, getCompanyAliasByAliasQuery, aliasValue).Scan(
+		&alias.ID,
+		&alias.CompanyID,
+		&alias.Alias,
+		&alias.CreatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &NotFoundError{Alias: aliasValue}
+		}
+		return nil, fmt.Errorf("failed to get company alias: %w", err)
+	}
+
+	return alias, nil
+}
+
+// Update updates an existing company alias in the database.
+func (r *Repository) Update(ctx context.Context, alias *CompanyAlias) error {
+	commandTag, err := r.db.Exec(
+		ctx,
+		updateCompanyAliasQuery,
+		alias.Alias,
+		alias.ID,
+	)
+
+	if err != nil {
+		// Check for unique constraint violation (duplicate alias)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return &DuplicateError{Alias: alias.Alias}
+		}
+		return fmt.Errorf("failed to update company alias: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return &NotFoundError{ID: alias.ID}
+	}
+
+	return nil
+}
+
+// Delete removes a company alias from the database.
+func (r *Repository) Delete(ctx context.Context, id int) error {
+	commandTag, err := r.db.Exec(ctx, deleteCompanyAliasQuery, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete company alias: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return &NotFoundError{ID: id}
+	}
+
+	return nil
+}
+
+// ListByCompanyID retrieves all aliases for a specific company.
+func (r *Repository) ListByCompanyID(ctx context.Context, companyID int) ([]*CompanyAlias, error) {
+	rows, err := r.db.Query(ctx, listCompanyAliasesByCompanyIDQuery, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list company aliases: %w", err)
+	}
+	defer rows.Close()
+
+	var aliases []*CompanyAlias
+	for rows.Next() {
+		alias := &CompanyAlias{}
+		err = rows.Scan(
+			&alias.ID,
+			&alias.CompanyID,
+			&alias.Alias,
+			&alias.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan company alias row: %w", err)
+		}
+		aliases = append(aliases, alias)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating company alias rows: %w", err)
+	}
+
+	return aliases, nil
+}
@@ -0,0 +1,615 @@
+package companyalias
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Create(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		alias        *CompanyAlias
+		mockSetup    func(mock pgxmock.PgxPoolIface, alias *CompanyAlias)
+		checkResults func(t *testing.T, result *CompanyAlias, err error)
+	}{
+		{
+			name: "successful creation",
+			alias: &CompanyAlias{
+				CompanyID: 1,
+				Alias:     "Acme Labs",
+			},
+			mockSetup: func(mock pgxmock.PgxPoolIface, alias *CompanyAlias) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(createCompanyAliasQuery)).
+					WithArgs(
+						alias.CompanyID,
+						alias.Alias,
+					).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "created_at"}).AddRow(1, now))
+			},
+			checkResults: func(t *testing.T, result *CompanyAlias, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 1, result.ID)
+				assert.Equal(t, now, result.CreatedAt)
+			},
+		},
+		{
+			name: "duplicate alias",
+			alias: &CompanyAlias{
+				CompanyID: 1,
+				Alias:     "Acme Labs",
+			},
+			mockSetup: func(mock pgxmock.PgxPoolIface, alias *CompanyAlias) {
+				t.Helper()
+				pgErr := &pgconn.PgError{
+					Code:           "23505",
+					ConstraintName: "company_aliases_alias_key",
+				}
+				mock.ExpectQuery(regexp.QuoteMeta(createCompanyAliasQuery)).
+					WithArgs(
+						alias.CompanyID,
+						alias.Alias,
+					).
+					WillReturnError(pgErr)
+			},
+			checkResults: func(t *testing.T, _ *CompanyAlias, err error) {
+				t.Helper()
+				require.Error(t, err)
+
+				var duplicateErr *DuplicateError
+				require.ErrorAs(t, err, &duplicateErr)
+				assert.Equal(t, "Acme Labs", duplicateErr.Alias)
+			},
+		},
+		{
+			name: "database error",
+			alias: &CompanyAlias{
+				CompanyID: 1,
+				Alias:     "Acme Labs",
+			},
+			mockSetup: func(mock pgxmock.PgxPoolIface, alias *CompanyAlias) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(createCompanyAliasQuery)).
+					WithArgs(
+						alias.CompanyID,
+						alias.Alias,
+					).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ *CompanyAlias, err error) {
+				t.Helper()
+				require.Error(t, err)
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB, tt.alias)
+
+			err = repo.Create(context.Background(), tt.alias)
+			tt.checkResults(t, tt.alias, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_GetByID(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		id           int
+		mockSetup    func(mock pgxmock.PgxPoolIface, id int)
+		checkResults func(t *testing.T, result *CompanyAlias, err error)
+	}{
+		{
+			name: "alias found",
+			id:   1,
+			mockSetup: func(mock pgxmock.PgxPoolIface, id int) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getCompanyAliasByIDQuery)).
+					WithArgs(id).
+					WillReturnRows(pgxmock.NewRows([]string{
+						"id", "company_id", "alias", "created_at",
+					}).AddRow(
+						id, 1, "Acme Labs", now,
+					))
+			},
+			checkResults: func(t *testing.T, result *CompanyAlias, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.NotNil(t, result)
+				assert.Equal(t, 1, result.ID)
+				assert.Equal(t, 1, result.CompanyID)
+				assert.Equal(t, "Acme Labs", result.Alias)
+				assert.Equal(t, now, result.CreatedAt)
+			},
+		},
+		{
+			name: "alias not found",
+			id:   999,
+			mockSetup: func(mock pgxmock.PgxPoolIface, id int) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getCompanyAliasByIDQuery)).
+					WithArgs(id).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			checkResults: func(t *testing.T, result *CompanyAlias, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Nil(t, result)
+
+				var notFoundErr *NotFoundError
+				require.ErrorAs(t, err, &notFoundErr)
+				assert.Equal(t, 999, notFoundErr.ID)
+			},
+		},
+		{
+			name: "database error",
+			id:   1,
+			mockSetup: func(mock pgxmock.PgxPoolIface, id int) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getCompanyAliasByIDQuery)).
+					WithArgs(id).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, result *CompanyAlias, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Nil(t, result)
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB, tt.id)
+
+			result, err := repo.GetByID(context.Background(), tt.id)
+			tt.checkResults(t, result, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_GetByAlias(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		alias        string
+		mockSetup    func(mock pgxmock.PgxPoolIface, alias string)
+		checkResults func(t *testing.T, result *CompanyAlias, err error)
+	}{
+		{
+			name:  "alias found",
+			alias: "Acme Labs",
+			mockSetup: func(mock pgxmock.PgxPoolIface, alias string) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getCompanyAliasByAliasQuery)).
+					WithArgs(alias).
+					WillReturnRows(pgxmock.NewRows([]string{
+						"id", "company_id", "alias", "created_at",
+					}).AddRow(
+						1, 1, alias, now,
+					))
+			},
+			checkResults: func(t *testing.T, result *CompanyAlias, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.NotNil(t, result)
+				assert.Equal(t, 1, result.ID)
+				assert.Equal(t, 1, result.CompanyID)
+				assert.Equal(t, "Acme Labs", result.Alias)
+				assert.Equal(t, now, result.CreatedAt)
+			},
+		},
+		{
+			name:  "alias not found",
+			alias: "NonExistentAlias",
+			mockSetup: func(mock pgxmock.PgxPoolIface, alias string) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getCompanyAliasByAliasQuery)).
+					WithArgs(alias).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			checkResults: func(t *testing.T, result *CompanyAlias, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Nil(t, result)
+
+				var notFoundErr *NotFoundError
+				require.ErrorAs(t, err, &notFoundErr)
+				assert.Equal(t, "NonExistentAlias", notFoundErr.Alias)
+			},
+		},
+		{
+			name:  "database error",
+			alias: "Acme Labs",
+			mockSetup: func(mock pgxmock.PgxPoolIface, alias string) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getCompanyAliasByAliasQuery)).
+					WithArgs(alias).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, result *CompanyAlias, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Nil(t, result)
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB, tt.alias)
+
+			result, err := repo.GetByAlias(context.Background(), tt.alias)
+			tt.checkResults(t, result, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_Update(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		alias        *CompanyAlias
+		mockSetup    func(mock pgxmock.PgxPoolIface, alias *CompanyAlias)
+		checkResults func(t *testing.T, err error)
+	}{
+		{
+			name: "successful update",
+			alias: &CompanyAlias{
+				ID:        1,
+				CompanyID: 1,
+				Alias:     "Acme",
+			},
+			mockSetup: func(mock pgxmock.PgxPoolIface, alias *CompanyAlias) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(updateCompanyAliasQuery)).
+					WithArgs(
+						alias.Alias,
+						alias.ID,
+					).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "alias not found",
+			alias: &CompanyAlias{
+				ID:        999,
+				CompanyID: 1,
+				Alias:     "Acme",
+			},
+			mockSetup: func(mock pgxmock.PgxPoolIface, alias *CompanyAlias) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(updateCompanyAliasQuery)).
+					WithArgs(
+						alias.Alias,
+						alias.ID,
+					).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.Error(t, err)
+
+				var notFoundErr *NotFoundError
+				require.ErrorAs(t, err, &notFoundErr)
+				assert.Equal(t, 999, notFoundErr.ID)
+			},
+		},
+		{
+			name: "duplicate alias",
+			alias: &CompanyAlias{
+				ID:        1,
+				CompanyID: 1,
+				Alias:     "Acme Labs",
+			},
+			mockSetup: func(mock pgxmock.PgxPoolIface, alias *CompanyAlias) {
+				t.Helper()
+				pgErr := &pgconn.PgError{
+					Code:           "23505",
+					ConstraintName: "company_aliases_alias_key",
+				}
+				mock.ExpectExec(regexp.QuoteMeta(updateCompanyAliasQuery)).
+					WithArgs(
+						alias.Alias,
+						alias.ID,
+					).
+					WillReturnError(pgErr)
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.Error(t, err)
+
+				var duplicateErr *DuplicateError
+				require.ErrorAs(t, err, &duplicateErr)
+				assert.Equal(t, "Acme Labs", duplicateErr.Alias)
+			},
+		},
+		{
+			name: "database error",
+			alias: &CompanyAlias{
+				ID:        1,
+				CompanyID: 1,
+				Alias:     "Acme Labs",
+			},
+			mockSetup: func(mock pgxmock.PgxPoolIface, alias *CompanyAlias) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(updateCompanyAliasQuery)).
+					WithArgs(
+						alias.Alias,
+						alias.ID,
+					).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.Error(t, err)
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB, tt.alias)
+
+			err = repo.Update(context.Background(), tt.alias)
+			tt.checkResults(t, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_Delete(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		id           int
+		mockSetup    func(mock pgxmock.PgxPoolIface, id int)
+		checkResults func(t *testing.T, err error)
+	}{
+		{
+			name: "successful deletion",
+			id:   1,
+			mockSetup: func(mock pgxmock.PgxPoolIface, id int) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(deleteCompanyAliasQuery)).
+					WithArgs(id).
+					WillReturnResult(pgxmock.NewResult("DELETE", 1))
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "alias not found",
+			id:   999,
+			mockSetup: func(mock pgxmock.PgxPoolIface, id int) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(deleteCompanyAliasQuery)).
+					WithArgs(id).
+					WillReturnResult(pgxmock.NewResult("DELETE", 0))
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.Error(t, err)
+
+				var notFoundErr *NotFoundError
+				require.ErrorAs(t, err, &notFoundErr)
+				assert.Equal(t, 999, notFoundErr.ID)
+			},
+		},
+		{
+			name: "database error",
+			id:   1,
+			mockSetup: func(mock pgxmock.PgxPoolIface, id int) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(deleteCompanyAliasQuery)).
+					WithArgs(id).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.Error(t, err)
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB, tt.id)
+
+			err = repo.Delete(context.Background(), tt.id)
+			tt.checkResults(t, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_ListByCompanyID(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		companyID    int
+		mockSetup    func(mock pgxmock.PgxPoolIface, companyID int)
+		checkResults func(t *testing.T, results []*CompanyAlias, err error)
+	}{
+		{
+			name:      "successful listing with results",
+			companyID: 1,
+			mockSetup: func(mock pgxmock.PgxPoolIface, companyID int) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(listCompanyAliasesByCompanyIDQuery)).
+					WithArgs(companyID).
+					WillReturnRows(pgxmock.NewRows([]string{
+						"id", "company_id", "alias", "created_at",
+					}).AddRow(
+						1, companyID, "Acme Labs", now,
+					).AddRow(
+						2, companyID, "Acme", now,
+					))
+			},
+			checkResults: func(t *testing.T, results []*CompanyAlias, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Len(t, results, 2)
+
+				assert.Equal(t, 1, results[0].ID)
+				assert.Equal(t, 1, results[0].CompanyID)
+				assert.Equal(t, "Acme Labs", results[0].Alias)
+				assert.Equal(t, now, results[0].CreatedAt)
+
+				assert.Equal(t, 2, results[1].ID)
+				assert.Equal(t, 1, results[1].CompanyID)
+				assert.Equal(t, "Acme", results[1].Alias)
+				assert.Equal(t, now, results[1].CreatedAt)
+			},
+		},
+		{
+			name:      "successful listing with no results",
+			companyID: 999,
+			mockSetup: func(mock pgxmock.PgxPoolIface, companyID int) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(listCompanyAliasesByCompanyIDQuery)).
+					WithArgs(companyID).
+					WillReturnRows(pgxmock.NewRows([]string{
+						"id", "company_id", "alias", "created_at",
+					}))
+			},
+			checkResults: func(t *testing.T, results []*CompanyAlias, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Empty(t, results)
+			},
+		},
+		{
+			name:      "database error",
+			companyID: 1,
+			mockSetup: func(mock pgxmock.PgxPoolIface, companyID int) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(listCompanyAliasesByCompanyIDQuery)).
+					WithArgs(companyID).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, results []*CompanyAlias, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Nil(t, results)
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+		{
+			name:      "scan error",
+			companyID: 1,
+			mockSetup: func(mock pgxmock.PgxPoolIface, companyID int) {
+				t.Helper()
+				// Return mismatched column count to cause scan error
+				mock.ExpectQuery(regexp.QuoteMeta(listCompanyAliasesByCompanyIDQuery)).
+					WithArgs(companyID).
+					WillReturnRows(pgxmock.NewRows([]string{
+						"id", "company_id", // Missing columns to cause scan error
+					}).AddRow(
+						1, companyID,
+					))
+			},
+			checkResults: func(t *testing.T, results []*CompanyAlias, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Nil(t, results)
+				assert.Contains(t, err.Error(), "scan")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB, tt.companyID)
+
+			results, err := repo.ListByCompanyID(context.Background(), tt.companyID)
+			tt.checkResults(t, results, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,823 @@
+// Package config loads the application configuration from environment variables.
+// Every setting has a default suitable for local development so the binaries can run
+// without any environment set up.
+package config
+
+import (
+	"fmt"
+	"net/netip"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/assets"
+	"github.com/rodruizronald/ticos-in-tech/internal/botguard"
+	"github.com/rodruizronald/ticos-in-tech/internal/database"
+	"github.com/rodruizronald/ticos-in-tech/internal/fxrate"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobevent"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+	"github.com/rodruizronald/ticos-in-tech/internal/logging"
+	"github.com/rodruizronald/ticos-in-tech/internal/mailer"
+	"github.com/rodruizronald/ticos-in-tech/internal/notifier"
+	"github.com/rodruizronald/ticos-in-tech/internal/opensearch"
+	"github.com/rodruizronald/ticos-in-tech/internal/queue"
+	"github.com/rodruizronald/ticos-in-tech/internal/sentry"
+	"github.com/rodruizronald/ticos-in-tech/internal/technology"
+	"github.com/rodruizronald/ticos-in-tech/internal/users"
+)
+
+// Environment variable names
+const (
+	envPort                      = "PORT"
+	envGinMode                   = "GIN_MODE"
+	envLogLevel                  = "LOG_LEVEL"
+	envLogFormat                 = "LOG_FORMAT"
+	envLogFile                   = "LOG_FILE"
+	envLogFileMaxSizeMB          = "LOG_FILE_MAX_SIZE_MB"
+	envLogFileMaxBackups         = "LOG_FILE_MAX_BACKUPS"
+	envLogFileMaxAgeDays         = "LOG_FILE_MAX_AGE_DAYS"
+	envAdminAPIKey               = "ADMIN_API_KEY"
+	envIngestAPIKey              = "INGEST_API_KEY"
+	envMetricsAddr               = "METRICS_ADDR"
+	envSearchViewRefreshInterval = "SEARCH_VIEW_REFRESH_INTERVAL"
+	envLinkCheckInterval         = "LINK_CHECK_INTERVAL"
+	envSchedulerDisabledTasks    = "SCHEDULER_DISABLED_TASKS"
+	envSchedulerJitter           = "SCHEDULER_JITTER"
+	envFXRatesURL                = "FX_RATES_URL"
+	envTechArchiveMonths         = "TECH_ARCHIVE_MONTHS"
+	envPurgeRetentionDays        = "PURGE_RETENTION_DAYS"
+	envRequestTimeout            = "REQUEST_TIMEOUT"
+	envSlowSearchThreshold       = "SLOW_SEARCH_THRESHOLD"
+	envSearchRankTextWeight      = "SEARCH_RANK_TEXT_WEIGHT"
+	envSearchRankRecencyWeight   = "SEARCH_RANK_RECENCY_WEIGHT"
+	envSearchRankFeaturedWeight  = "SEARCH_RANK_FEATURED_WEIGHT"
+	envSearchRankPrimaryTech     = "SEARCH_RANK_PRIMARY_TECH_WEIGHT"
+	envSearchRankHalfLife        = "SEARCH_RANK_RECENCY_HALF_LIFE"
+	envExportMaxRows             = "EXPORT_MAX_ROWS"
+	envExportRateLimit           = "EXPORT_RATE_LIMIT"
+	envExportTimeout             = "EXPORT_TIMEOUT"
+	envApplyUTMParams            = "APPLY_UTM_PARAMS"
+	envBotBurstLimit             = "BOT_BURST_LIMIT"
+	envBotBurstWindow            = "BOT_BURST_WINDOW"
+	envBotBanDuration            = "BOT_BAN_DURATION"
+	envBotHoneypotParams         = "BOT_HONEYPOT_PARAMS"
+	envTrustedProxies            = "TRUSTED_PROXIES"
+	envCountries                 = "COUNTRIES"
+	envDefaultCountry            = "DEFAULT_COUNTRY"
+	envAPIV1Deprecation          = "API_V1_DEPRECATION"
+	envAPIV1Sunset               = "API_V1_SUNSET"
+	envPublicAPIDocs             = "PUBLIC_API_DOCS"
+	envTermsOfServiceURL         = "TERMS_OF_SERVICE_URL"
+	envDatabaseURL               = "DATABASE_URL"
+	envDatabaseReplicaURL        = "DATABASE_REPLICA_URL"
+	envDBHost                    = "DB_HOST"
+	envDBPort                    = "DB_PORT"
+	envDBUser                    = "DB_USER"
+	envDBPassword                = "DB_PASSWORD"
+	envDBName                    = "DB_NAME"
+	envDBSSLMode                 = "DB_SSLMODE"
+	envDBMaxConns                = "DB_MAX_CONNS"
+	envDBMinConns                = "DB_MIN_CONNS"
+	envDBMaxConnLifetime         = "DB_MAX_CONN_LIFETIME"
+	envDBMaxConnIdleTime         = "DB_MAX_CONN_IDLE_TIME"
+	envDBStatementCacheMode      = "DB_STATEMENT_CACHE_MODE"
+	envDBPingTimeout             = "DB_PING_TIMEOUT"
+	envDBStartupTimeout          = "DB_STARTUP_TIMEOUT"
+	envDBStartupRetryDelay       = "DB_STARTUP_RETRY_DELAY"
+	envDBHealthCheckInterval     = "DB_HEALTH_CHECK_INTERVAL"
+	envDBRetryMaxAttempts        = "DB_RETRY_MAX_ATTEMPTS"
+	envDBRetryBaseDelay          = "DB_RETRY_BASE_DELAY"
+	envDBBreakerThreshold        = "DB_BREAKER_FAILURE_THRESHOLD"
+	envDBBreakerOpenTimeout      = "DB_BREAKER_OPEN_TIMEOUT"
+	envDBLogQueries              = "DB_LOG_QUERIES"
+	envDBLogQueryArgs            = "DB_LOG_QUERY_ARGS"
+	envSearchBackend             = "SEARCH_BACKEND"
+	envOpenSearchURL             = "OPENSEARCH_URL"
+	envOpenSearchIndex           = "OPENSEARCH_INDEX"
+	envOpenSearchUsername        = "OPENSEARCH_USERNAME"
+	envOpenSearchPassword        = "OPENSEARCH_PASSWORD"
+	envReviewIngestedJobs        = "REVIEW_INGESTED_JOBS"
+	envNotifySlackWebhookURLs    = "NOTIFY_SLACK_WEBHOOK_URLS"
+	envNotifyTelegramBotToken    = "NOTIFY_TELEGRAM_BOT_TOKEN"
+	envNotifyTelegramChatIDs     = "NOTIFY_TELEGRAM_CHAT_IDS"
+	envNotifyTechnologies        = "NOTIFY_TECHNOLOGIES"
+	envAssetsEndpoint            = "ASSETS_S3_ENDPOINT"
+	envAssetsRegion              = "ASSETS_S3_REGION"
+	envAssetsBucket              = "ASSETS_S3_BUCKET"
+	envAssetsAccessKeyID         = "ASSETS_S3_ACCESS_KEY_ID"
+	envAssetsSecretAccessKey     = "ASSETS_S3_SECRET_ACCESS_KEY"
+	envAssetsPublicURL           = "ASSETS_PUBLIC_URL"
+	envQueueDriver               = "QUEUE_DRIVER"
+	envQueueURL                  = "QUEUE_URL"
+	envQueueTopic                = "QUEUE_TOPIC"
+	envQueueSQSRegion            = "QUEUE_SQS_REGION"
+	envQueueSQSAccessKeyID       = "QUEUE_SQS_ACCESS_KEY_ID"
+	envQueueSQSSecretAccessKey   = "QUEUE_SQS_SECRET_ACCESS_KEY"
+	envSMTPHost                  = "SMTP_HOST"
+	envSMTPPort                  = "SMTP_PORT"
+	envSMTPUsername              = "SMTP_USERNAME"
+	envSMTPPassword              = "SMTP_PASSWORD"
+	envSMTPFrom                  = "SMTP_FROM"
+	envAlertsUnsubscribeURL      = "ALERTS_UNSUBSCRIBE_URL"
+	envSentryDSN                 = "SENTRY_DSN"
+	envRedisURL                  = "REDIS_URL"
+	envSearchCacheTTL            = "SEARCH_CACHE_TTL"
+	envOAuthCallbackBaseURL      = "OAUTH_CALLBACK_BASE_URL"
+	envOAuthRedirectURL          = "OAUTH_REDIRECT_URL"
+	envOAuthGoogleClientID       = "OAUTH_GOOGLE_CLIENT_ID"
+	envOAuthGoogleClientSecret   = "OAUTH_GOOGLE_CLIENT_SECRET"
+	envOAuthGitHubClientID       = "OAUTH_GITHUB_CLIENT_ID"
+	envOAuthGitHubClientSecret   = "OAUTH_GITHUB_CLIENT_SECRET"
+)
+
+// Search backends
+const (
+	SearchBackendPostgres   = "postgres"
+	SearchBackendOpenSearch = "opensearch"
+)
+
+// Log levels
+const (
+	LogLevelDebug = "debug"
+	LogLevelInfo  = "info"
+	LogLevelWarn  = "warn"
+	LogLevelError = "error"
+)
+
+// Default values
+const (
+	defaultPort     = "8080"
+	defaultGinMode  = "debug"
+	defaultLogLevel = LogLevelInfo
+	defaultCountry  = "CR"
+
+	defaultSearchViewRefreshInterval = 15 * time.Minute
+	defaultLinkCheckInterval         = 24 * time.Hour
+	defaultSchedulerJitter           = 30 * time.Second
+	defaultRequestTimeout            = 10 * time.Second
+	defaultExportRateLimit           = 5
+	defaultExportTimeout             = 5 * time.Minute
+	defaultSearchCacheTTL            = 30 * time.Second
+)
+
+// Config holds the configuration shared by the server and the command line tools.
+type Config struct {
+	Port    string
+	GinMode string
+	// LogLevel is the least severe level logged, one of the LogLevel constants
+	LogLevel string
+	// Logging holds the format of the logs of the populators and the file they are copied to
+	Logging logging.Config
+	// AdminAPIKey protects the admin API. Admin routes are disabled when it is empty.
+	AdminAPIKey string
+	// IngestAPIKey protects the routes used by the scrapers. They are disabled when it is empty.
+	IngestAPIKey string
+	// MetricsAddr is the address the metrics are served on apart from the API, e.g. ":9090". When
+	// empty they are served by the API behind the admin API key.
+	MetricsAddr string
+	// RequestTimeout bounds the time spent serving an API request. Zero disables it.
+	RequestTimeout time.Duration
+	// SlowSearchThreshold is the time above which the plan of a job search is logged. Zero disables it.
+	SlowSearchThreshold time.Duration
+	// SearchRanking weighs the text relevance, recency, featured status and searched primary
+	// technologies of the jobs found by a search ranked by relevance
+	SearchRanking jobs.Ranking
+	// ExportMaxRows caps the number of jobs of a job search CSV export
+	ExportMaxRows int
+	// ExportRateLimit is how many job search exports a client can request per minute. Zero disables the limit.
+	ExportRateLimit int
+	// ExportTimeout bounds the time spent streaming a job search CSV export, instead of RequestTimeout.
+	// Zero disables it.
+	ExportTimeout time.Duration
+	// ApplyUTMParams are the UTM parameters set on the application links of the jobs applied to
+	// through the board. None are set when empty.
+	ApplyUTMParams url.Values
+	// BotGuard holds the burst detection and honeypot parameters guarding the public job search,
+	// besides the blocklist of the database
+	BotGuard botguard.Config
+	// TrustedProxies are the addresses or CIDR ranges of the reverse proxies in front of the server,
+	// whose X-Forwarded-For header gives the client IP the rate limits, the bans and the blocklist
+	// apply to. The header is ignored when empty, the client IP being the address of the connection.
+	TrustedProxies []string
+	// Countries are the ISO 3166-1 alpha-2 codes of the countries the board serves, picked with
+	// the X-Country header. DefaultCountry is among them.
+	Countries []string
+	// DefaultCountry is the country of the requests without the X-Country header
+	DefaultCountry string
+	// APIV1Deprecation is when /api/v1 was or will be deprecated in favor of /api/v2. Zero while it's current.
+	APIV1Deprecation time.Time
+	// APIV1Sunset is when /api/v1 stops being served. Zero when not planned.
+	APIV1Sunset time.Time
+	// PublicAPIDocs serves the Swagger UI in release mode, restricted to the read operations of the
+	// public routes. It is always served, with every route, in the other modes.
+	PublicAPIDocs bool
+	// TermsOfServiceURL is linked from the /.well-known/api descriptor when set
+	TermsOfServiceURL string
+	// SearchViewRefreshInterval is how often the server refreshes the job search view. Zero disables it.
+	SearchViewRefreshInterval time.Duration
+	// LinkCheckInterval is how often the server checks again the job application links and
+	// company logos. Zero disables the checks.
+	LinkCheckInterval time.Duration
+	// SchedulerDisabledTasks names the scheduled background tasks that don't run on this instance
+	SchedulerDisabledTasks []string
+	// SchedulerJitter is the maximum random delay added to the runs of the scheduled tasks
+	SchedulerJitter time.Duration
+	// FXRatesURL is the exchange rate API the daily rates of the salary currencies are fetched from
+	FXRatesURL string
+	// TechArchiveMonths is how many months a technology can go without being asked for by an active
+	// job before it is archived. Zero disables the archival.
+	TechArchiveMonths int
+	// PurgeRetentionDays is how many days the deactivated jobs and companies are kept before the
+	// scheduled purge removes them. Zero disables the scheduled purge.
+	PurgeRetentionDays int
+	// SearchBackend selects the job search implementation, SearchBackendPostgres or SearchBackendOpenSearch
+	SearchBackend string
+	// ReviewIngestedJobs makes the job populator create jobs as pending, so they are only
+	// published once an admin approves them
+	ReviewIngestedJobs bool
+	Database           database.Config
+	OpenSearch         opensearch.Config
+	// Notifier holds the chat channels where newly published jobs are announced
+	Notifier notifier.Config
+	// Assets holds the object storage where company logos are stored. Logos are linked
+	// from their source when it is not configured.
+	Assets assets.Config
+	// Queue holds the message broker the job events are published to. They are not published
+	// when it is not configured.
+	Queue queue.Config
+	// Mailer holds the SMTP server the verification codes of company claims and the job alerts are
+	// emailed through. Companies can't be claimed nor job alerts sent when it is not configured.
+	Mailer mailer.Config
+	// AlertsUnsubscribeURL is the page the job alert emails link to with the unsubscribe token of the
+	// alert in its token query parameter. Job alerts aren't sent when it is empty.
+	AlertsUnsubscribeURL string
+	// SentryDSN is the Sentry project the panics of the server are reported to. They are only logged
+	// when it is empty.
+	SentryDSN string
+	// RedisURL is the Redis server the job search pages are cached in, shared by the instances of the
+	// server, redis://[user:password@]host:port/db. They are only cached in memory when it is empty.
+	RedisURL string
+	// SearchCacheTTL is how long a job search page is cached. Zero disables the search cache.
+	SearchCacheTTL time.Duration
+	// OAuth holds the Google and GitHub clients users log in with. A provider is disabled when its
+	// client is not configured.
+	OAuth users.OAuthConfig
+}
+
+// Load reads the configuration from the environment, falling back to defaults.
+func Load() (*Config, error) {
+	db := database.DefaultConfig()
+	db.URL = getEnv(envDatabaseURL, db.URL)
+	db.ReplicaURL = os.Getenv(envDatabaseReplicaURL)
+	db.Host = getEnv(envDBHost, db.Host)
+	db.User = getEnv(envDBUser, db.User)
+	db.Password = getEnv(envDBPassword, db.Password)
+	db.DBName = getEnv(envDBName, db.DBName)
+	db.SSLMode = getEnv(envDBSSLMode, db.SSLMode)
+	db.StatementCacheMode = getEnv(envDBStatementCacheMode, db.StatementCacheMode)
+
+	var err error
+	if db.Port, err = getEnvInt(envDBPort, db.Port); err != nil {
+		return nil, err
+	}
+	if db.MaxConns, err = getEnvInt(envDBMaxConns, db.MaxConns); err != nil {
+		return nil, err
+	}
+	if db.MinConns, err = getEnvInt(envDBMinConns, db.MinConns); err != nil {
+		return nil, err
+	}
+	if db.MaxConnLifetime, err = getEnvDuration(envDBMaxConnLifetime, db.MaxConnLifetime); err != nil {
+		return nil, err
+	}
+	if db.MaxConnIdleTime, err = getEnvDuration(envDBMaxConnIdleTime, db.MaxConnIdleTime); err != nil {
+		return nil, err
+	}
+	if db.PingTimeout, err = getEnvDuration(envDBPingTimeout, db.PingTimeout); err != nil {
+		return nil, err
+	}
+	if db.StartupTimeout, err = getEnvDuration(envDBStartupTimeout, db.StartupTimeout); err != nil {
+		return nil, err
+	}
+	if db.StartupRetryDelay, err = getEnvDuration(envDBStartupRetryDelay, db.StartupRetryDelay); err != nil {
+		return nil, err
+	}
+	if db.HealthCheckInterval, err = getEnvDuration(envDBHealthCheckInterval, db.HealthCheckInterval); err != nil {
+		return nil, err
+	}
+	if db.Retry.MaxAttempts, err = getEnvInt(envDBRetryMaxAttempts, db.Retry.MaxAttempts); err != nil {
+		return nil, err
+	}
+	if db.Retry.BaseDelay, err = getEnvDuration(envDBRetryBaseDelay, db.Retry.BaseDelay); err != nil {
+		return nil, err
+	}
+	if db.Breaker.FailureThreshold, err = getEnvInt(envDBBreakerThreshold, db.Breaker.FailureThreshold); err != nil {
+		return nil, err
+	}
+	if db.Breaker.OpenTimeout, err = getEnvDuration(envDBBreakerOpenTimeout, db.Breaker.OpenTimeout); err != nil {
+		return nil, err
+	}
+	if db.LogQueries, err = getEnvBool(envDBLogQueries, false); err != nil {
+		return nil, err
+	}
+	if db.LogQueryArgs, err = getEnvBool(envDBLogQueryArgs, false); err != nil {
+		return nil, err
+	}
+
+	logLevel := getEnv(envLogLevel, defaultLogLevel)
+	switch logLevel {
+	case LogLevelDebug, LogLevelInfo, LogLevelWarn, LogLevelError:
+	default:
+		return nil, fmt.Errorf("invalid value for %s: %q", envLogLevel, logLevel)
+	}
+
+	logs, err := getEnvLogging()
+	if err != nil {
+		return nil, err
+	}
+
+	defaultCountry, countries, err := getEnvCountries()
+	if err != nil {
+		return nil, err
+	}
+
+	searchBackend := getEnv(envSearchBackend, SearchBackendPostgres)
+	if searchBackend != SearchBackendPostgres && searchBackend != SearchBackendOpenSearch {
+		return nil, fmt.Errorf("invalid value for %s: %q", envSearchBackend, searchBackend)
+	}
+
+	queueDriver := os.Getenv(envQueueDriver)
+	switch queueDriver {
+	case "", queue.DriverNATS, queue.DriverKafka, queue.DriverSQS:
+	default:
+		return nil, fmt.Errorf("invalid value for %s: %q", envQueueDriver, queueDriver)
+	}
+
+	search := opensearch.DefaultConfig()
+	search.URL = getEnv(envOpenSearchURL, search.URL)
+	search.Index = getEnv(envOpenSearchIndex, search.Index)
+	search.Username = os.Getenv(envOpenSearchUsername)
+	search.Password = os.Getenv(envOpenSearchPassword)
+
+	refreshInterval, err := getEnvDuration(envSearchViewRefreshInterval, defaultSearchViewRefreshInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	linkCheckInterval, err := getEnvDuration(envLinkCheckInterval, defaultLinkCheckInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	schedulerJitter, err := getEnvDuration(envSchedulerJitter, defaultSchedulerJitter)
+	if err != nil {
+		return nil, err
+	}
+
+	techArchiveMonths, err := getEnvInt(envTechArchiveMonths, technology.DefaultUnusedMonths)
+	if err != nil {
+		return nil, err
+	}
+	if techArchiveMonths < 0 {
+		return nil, fmt.Errorf("invalid value for %s: %d", envTechArchiveMonths, techArchiveMonths)
+	}
+
+	purgeRetentionDays, err := getEnvInt(envPurgeRetentionDays, 0)
+	if err != nil {
+		return nil, err
+	}
+	if purgeRetentionDays < 0 {
+		return nil, fmt.Errorf("invalid value for %s: %d", envPurgeRetentionDays, purgeRetentionDays)
+	}
+
+	requestTimeout, err := getEnvDuration(envRequestTimeout, defaultRequestTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	slowSearchThreshold, err := getEnvDuration(envSlowSearchThreshold, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	searchRanking, err := getEnvRanking()
+	if err != nil {
+		return nil, err
+	}
+
+	exportMaxRows, err := getEnvInt(envExportMaxRows, jobs.DefaultExportMaxRows)
+	if err != nil {
+		return nil, err
+	}
+	if exportMaxRows <= 0 {
+		return nil, fmt.Errorf("invalid value for %s: %d", envExportMaxRows, exportMaxRows)
+	}
+
+	exportRateLimit, err := getEnvInt(envExportRateLimit, defaultExportRateLimit)
+	if err != nil {
+		return nil, err
+	}
+	if exportRateLimit < 0 {
+		return nil, fmt.Errorf("invalid value for %s: %d", envExportRateLimit, exportRateLimit)
+	}
+
+	exportTimeout, err := getEnvDuration(envExportTimeout, defaultExportTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	applyUTMParams, err := getEnvUTMParams()
+	if err != nil {
+		return nil, err
+	}
+
+	botGuard, err := getEnvBotGuard()
+	if err != nil {
+		return nil, err
+	}
+
+	trustedProxies := getEnvList(envTrustedProxies)
+	for _, proxy := range trustedProxies {
+		if _, err = netip.ParsePrefix(proxy); err != nil {
+			if _, err = netip.ParseAddr(proxy); err != nil {
+				return nil, fmt.Errorf("invalid value for %s: %q is neither an IP nor a CIDR range", envTrustedProxies, proxy)
+			}
+		}
+	}
+
+	reviewIngestedJobs, err := getEnvBool(envReviewIngestedJobs, false)
+	if err != nil {
+		return nil, err
+	}
+
+	apiV1Deprecation, err := getEnvTime(envAPIV1Deprecation)
+	if err != nil {
+		return nil, err
+	}
+
+	apiV1Sunset, err := getEnvTime(envAPIV1Sunset)
+	if err != nil {
+		return nil, err
+	}
+
+	publicAPIDocs, err := getEnvBool(envPublicAPIDocs, false)
+	if err != nil {
+		return nil, err
+	}
+
+	smtpPort, err := getEnvInt(envSMTPPort, mailer.DefaultPort)
+	if err != nil {
+		return nil, err
+	}
+
+	searchCacheTTL, err := getEnvDuration(envSearchCacheTTL, defaultSearchCacheTTL)
+	if err != nil {
+		return nil, err
+	}
+	if searchCacheTTL < 0 {
+		return nil, fmt.Errorf("invalid value for %s: %s", envSearchCacheTTL, searchCacheTTL)
+	}
+
+	oauth, err := getEnvOAuth()
+	if err != nil {
+		return nil, err
+	}
+
+	// The DSN holds the key of the project, so it isn't part of the error
+	sentryDSN := os.Getenv(envSentryDSN)
+	if sentryDSN != "" {
+		if _, err = sentry.ParseDSN(sentryDSN); err != nil {
+			return nil, fmt.Errorf("invalid value for %s: %w", envSentryDSN, err)
+		}
+	}
+
+	return &Config{
+		Port:                      getEnv(envPort, defaultPort),
+		GinMode:                   getEnv(envGinMode, defaultGinMode),
+		LogLevel:                  logLevel,
+		Logging:                   logs,
+		AdminAPIKey:               os.Getenv(envAdminAPIKey),
+		IngestAPIKey:              os.Getenv(envIngestAPIKey),
+		MetricsAddr:               os.Getenv(envMetricsAddr),
+		RequestTimeout:            requestTimeout,
+		SlowSearchThreshold:       slowSearchThreshold,
+		SearchRanking:             searchRanking,
+		ExportMaxRows:             exportMaxRows,
+		ExportRateLimit:           exportRateLimit,
+		ExportTimeout:             exportTimeout,
+		ApplyUTMParams:            applyUTMParams,
+		BotGuard:                  botGuard,
+		TrustedProxies:            trustedProxies,
+		Countries:                 countries,
+		DefaultCountry:            defaultCountry,
+		APIV1Deprecation:          apiV1Deprecation,
+		APIV1Sunset:               apiV1Sunset,
+		PublicAPIDocs:             publicAPIDocs,
+		TermsOfServiceURL:         os.Getenv(envTermsOfServiceURL),
+		SearchViewRefreshInterval: refreshInterval,
+		LinkCheckInterval:         linkCheckInterval,
+		SchedulerDisabledTasks:    getEnvList(envSchedulerDisabledTasks),
+		SchedulerJitter:           schedulerJitter,
+		FXRatesURL:                getEnv(envFXRatesURL, fxrate.DefaultURL),
+		TechArchiveMonths:         techArchiveMonths,
+		PurgeRetentionDays:        purgeRetentionDays,
+		SearchBackend:             searchBackend,
+		ReviewIngestedJobs:        reviewIngestedJobs,
+		Database:                  db,
+		OpenSearch:                search,
+		Notifier: notifier.Config{
+			SlackWebhookURLs: getEnvList(envNotifySlackWebhookURLs),
+			TelegramBotToken: os.Getenv(envNotifyTelegramBotToken),
+			TelegramChatIDs:  getEnvList(envNotifyTelegramChatIDs),
+			Technologies:     getEnvList(envNotifyTechnologies),
+		},
+		Assets: assets.Config{
+			Endpoint:        os.Getenv(envAssetsEndpoint),
+			Region:          getEnv(envAssetsRegion, assets.DefaultRegion),
+			Bucket:          os.Getenv(envAssetsBucket),
+			AccessKeyID:     os.Getenv(envAssetsAccessKeyID),
+			SecretAccessKey: os.Getenv(envAssetsSecretAccessKey),
+			PublicURL:       os.Getenv(envAssetsPublicURL),
+		},
+		Queue: queue.Config{
+			Driver:          queueDriver,
+			URL:             os.Getenv(envQueueURL),
+			Topic:           getEnv(envQueueTopic, queue.DefaultTopic),
+			Region:          getEnv(envQueueSQSRegion, queue.DefaultRegion),
+			AccessKeyID:     os.Getenv(envQueueSQSAccessKeyID),
+			SecretAccessKey: os.Getenv(envQueueSQSSecretAccessKey),
+		},
+		Mailer: mailer.Config{
+			Host:     os.Getenv(envSMTPHost),
+			Port:     smtpPort,
+			Username: os.Getenv(envSMTPUsername),
+			Password: os.Getenv(envSMTPPassword),
+			From:     os.Getenv(envSMTPFrom),
+		},
+		AlertsUnsubscribeURL: os.Getenv(envAlertsUnsubscribeURL),
+		SentryDSN:            sentryDSN,
+		RedisURL:             os.Getenv(envRedisURL),
+		SearchCacheTTL:       searchCacheTTL,
+		OAuth:                oauth,
+	}, nil
+}
+
+// getEnvUTMParams returns the UTM parameters of the application links, a query string. Unlike the
+// other variables, setting it empty sets no parameters.
+func getEnvUTMParams() (url.Values, error) {
+	value, ok := os.LookupEnv(envApplyUTMParams)
+	if !ok {
+		value = jobevent.DefaultUTMParams
+	}
+
+	params, err := url.ParseQuery(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for %s: %w", envApplyUTMParams, err)
+	}
+	for name := range params {
+		if name == "" {
+			return nil, fmt.Errorf("invalid value for %s: empty parameter name", envApplyUTMParams)
+		}
+	}
+	return params, nil
+}
+
+// getEnv returns the value of an environment variable or the fallback when unset
+func getEnv(key, fallback string) string {
+	if value, ok := os.LookupEnv(key); ok && value != "" {
+		return value
+	}
+	return fallback
+}
+
+// getEnvCountries returns the default country and the countries served, the default one alone
+// when unset. The codes are uppercased.
+func getEnvCountries() (defaultCode string, countries []string, err error) {
+	defaultCode = strings.ToUpper(getEnv(envDefaultCountry, defaultCountry))
+	if !isCountryCode(defaultCode) {
+		return "", nil, fmt.Errorf("invalid value for %s: %q", envDefaultCountry, defaultCode)
+	}
+
+	for _, country := range getEnvList(envCountries) {
+		country = strings.ToUpper(country)
+		if !isCountryCode(country) {
+			return "", nil, fmt.Errorf("invalid value for %s: %q", envCountries, country)
+		}
+		if !slices.Contains(countries, country) {
+			countries = append(countries, country)
+		}
+	}
+	if countries == nil {
+		countries = []string{defaultCode}
+	} else if !slices.Contains(countries, defaultCode) {
+		return "", nil, fmt.Errorf("invalid value for %s: %q is not in %s", envDefaultCountry, defaultCode, envCountries)
+	}
+	return defaultCode, countries, nil
+}
+
+// isCountryCode reports whether code is made of two uppercase letters, like the ISO 3166-1 alpha-2 codes
+func isCountryCode(code string) bool {
+	return len(code) == 2 && code[0] >= 'A' && code[0] <= 'Z' && code[1] >= 'A' && code[1] <= 'Z'
+}
+
+// getEnvList returns the comma separated values of an environment variable, nil when unset
+func getEnvList(key string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// getEnvInt returns the integer value of an environment variable or the fallback when unset
+func getEnvInt(key string, fallback int) (int, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value for %s: %w", key, err)
+	}
+	return parsed, nil
+}
+
+// getEnvFloat returns the non-negative number value of an environment variable or the fallback when unset
+func getEnvFloat(key string, fallback float64) (float64, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil || parsed < 0 {
+		return 0, fmt.Errorf("invalid value for %s: %q", key, value)
+	}
+	return parsed, nil
+}
+
+// getEnvRanking returns the weights of the search ranking, the default ones when unset
+func getEnvRanking() (jobs.Ranking, error) {
+	ranking := jobs.DefaultRanking()
+	var err error
+	if ranking.TextWeight, err = getEnvFloat(envSearchRankTextWeight, ranking.TextWeight); err != nil {
+		return ranking, err
+	}
+	if ranking.RecencyWeight, err = getEnvFloat(envSearchRankRecencyWeight, ranking.RecencyWeight); err != nil {
+		return ranking, err
+	}
+	if ranking.FeaturedWeight, err = getEnvFloat(envSearchRankFeaturedWeight, ranking.FeaturedWeight); err != nil {
+		return ranking, err
+	}
+	if ranking.PrimaryTechWeight, err = getEnvFloat(envSearchRankPrimaryTech, ranking.PrimaryTechWeight); err != nil {
+		return ranking, err
+	}
+	halfLife, err := getEnvDuration(envSearchRankHalfLife, ranking.RecencyHalfLife)
+	if err != nil || halfLife == 0 {
+		return ranking, fmt.Errorf("invalid value for %s: %q", envSearchRankHalfLife, os.Getenv(envSearchRankHalfLife))
+	}
+	ranking.RecencyHalfLife = halfLife
+	return ranking, nil
+}
+
+// getEnvLogging returns the format and file of the logs, text logs without a file when unset
+func getEnvLogging() (logging.Config, error) {
+	logs := logging.DefaultConfig()
+	logs.Format = getEnv(envLogFormat, logs.Format)
+	if logs.Format != logging.FormatText && logs.Format != logging.FormatJSON {
+		return logs, fmt.Errorf("invalid value for %s: %q", envLogFormat, logs.Format)
+	}
+	logs.File = os.Getenv(envLogFile)
+
+	limits := []struct {
+		key   string
+		value *int
+		min   int
+	}{
+		{envLogFileMaxSizeMB, &logs.MaxSizeMB, 1},
+		{envLogFileMaxBackups, &logs.MaxBackups, 0},
+		{envLogFileMaxAgeDays, &logs.MaxAgeDays, 0},
+	}
+	for _, limit := range limits {
+		value, err := getEnvInt(limit.key, *limit.value)
+		if err != nil {
+			return logs, err
+		}
+		if value < limit.min {
+			return logs, fmt.Errorf("invalid value for %s: %d", limit.key, value)
+		}
+		*limit.value = value
+	}
+	return logs, nil
+}
+
+// getEnvBotGuard returns the configuration of the guard of the public job search, the burst
+// detection disabled and no honeypot parameters when unset
+func getEnvBotGuard() (botguard.Config, error) {
+	guard := botguard.DefaultConfig()
+	guard.HoneypotParams = getEnvList(envBotHoneypotParams)
+
+	var err error
+	if guard.BurstLimit, err = getEnvInt(envBotBurstLimit, guard.BurstLimit); err != nil {
+		return guard, err
+	}
+	if guard.BurstLimit < 0 {
+		return guard, fmt.Errorf("invalid value for %s: %d", envBotBurstLimit, guard.BurstLimit)
+	}
+	if guard.BurstWindow, err = getEnvDuration(envBotBurstWindow, guard.BurstWindow); err != nil {
+		return guard, err
+	}
+	if guard.BurstWindow == 0 {
+		return guard, fmt.Errorf("invalid value for %s: %s", envBotBurstWindow, guard.BurstWindow)
+	}
+	if guard.BanDuration, err = getEnvDuration(envBotBanDuration, guard.BanDuration); err != nil {
+		return guard, err
+	}
+	if guard.BanDuration == 0 {
+		return guard, fmt.Errorf("invalid value for %s: %s", envBotBanDuration, guard.BanDuration)
+	}
+	return guard, nil
+}
+
+// getEnvOAuth returns the OAuth clients users log in with. The providers redirect back to the
+// callback base URL, which redirects to the frontend, both required once a client is configured.
+func getEnvOAuth() (users.OAuthConfig, error) {
+	oauth := users.OAuthConfig{
+		CallbackBaseURL: os.Getenv(envOAuthCallbackBaseURL),
+		RedirectURL:     os.Getenv(envOAuthRedirectURL),
+		Google: users.OAuthClient{
+			ClientID:     os.Getenv(envOAuthGoogleClientID),
+			ClientSecret: os.Getenv(envOAuthGoogleClientSecret),
+		},
+		GitHub: users.OAuthClient{
+			ClientID:     os.Getenv(envOAuthGitHubClientID),
+			ClientSecret: os.Getenv(envOAuthGitHubClientSecret),
+		},
+	}
+	if len(oauth.Providers()) == 0 {
+		return oauth, nil
+	}
+
+	for _, setting := range []struct{ key, value string }{
+		{envOAuthCallbackBaseURL, oauth.CallbackBaseURL},
+		{envOAuthRedirectURL, oauth.RedirectURL},
+	} {
+		parsed, err := url.Parse(setting.value)
+		if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+			return oauth, fmt.Errorf("invalid value for %s: %q, an absolute URL is required with an OAuth client",
+				setting.key, setting.value)
+		}
+	}
+	return oauth, nil
+}
+
+// getEnvBool returns the boolean value (e.g. "true", "1") of an environment variable or the fallback when unset
+func getEnvBool(key string, fallback bool) (bool, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid value for %s: %q", key, value)
+	}
+	return parsed, nil
+}
+
+// getEnvDuration returns the duration value (e.g. "10m") of an environment variable or the fallback when unset
+func getEnvDuration(key string, fallback time.Duration) (time.Duration, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+
+	parsed, err := time.ParseDuration(value)
+	if err != nil || parsed < 0 {
+		return 0, fmt.Errorf("invalid value for %s: %q", key, value)
+	}
+	return parsed, nil
+}
+
+// getEnvTime returns the time value of an environment variable, a date (e.g. "2025-07-01") or
+// an RFC 3339 time, or the zero time when unset
+func getEnvTime(key string) (time.Time, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if parsed, err := time.Parse(time.DateOnly, value); err == nil {
+		return parsed, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid value for %s: %q", key, value)
+	}
+	return parsed, nil
+}
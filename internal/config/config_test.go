@@ -0,0 +1,526 @@
+package config
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/botguard"
+	"github.com/rodruizronald/ticos-in-tech/internal/database"
+	"github.com/rodruizronald/ticos-in-tech/internal/fxrate"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+	"github.com/rodruizronald/ticos-in-tech/internal/logging"
+	"github.com/rodruizronald/ticos-in-tech/internal/technology"
+)
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name         string
+		env          map[string]string
+		checkResults func(t *testing.T, cfg *Config, err error)
+	}{
+		{
+			name: "defaults",
+			env:  map[string]string{},
+			checkResults: func(t *testing.T, cfg *Config, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, defaultPort, cfg.Port)
+				assert.Equal(t, defaultGinMode, cfg.GinMode)
+				assert.Equal(t, LogLevelInfo, cfg.LogLevel)
+				assert.Equal(t, logging.DefaultConfig(), cfg.Logging)
+				assert.False(t, cfg.Database.LogQueries)
+				assert.Empty(t, cfg.AdminAPIKey)
+				assert.Empty(t, cfg.IngestAPIKey)
+				assert.Empty(t, cfg.MetricsAddr)
+				assert.Equal(t, defaultSearchViewRefreshInterval, cfg.SearchViewRefreshInterval)
+				assert.Equal(t, defaultLinkCheckInterval, cfg.LinkCheckInterval)
+				assert.Empty(t, cfg.SchedulerDisabledTasks)
+				assert.Equal(t, defaultSchedulerJitter, cfg.SchedulerJitter)
+				assert.Equal(t, fxrate.DefaultURL, cfg.FXRatesURL)
+				assert.Equal(t, technology.DefaultUnusedMonths, cfg.TechArchiveMonths)
+				assert.Zero(t, cfg.PurgeRetentionDays)
+				assert.Equal(t, defaultRequestTimeout, cfg.RequestTimeout)
+				assert.Zero(t, cfg.SlowSearchThreshold)
+				assert.Equal(t, jobs.DefaultRanking(), cfg.SearchRanking)
+				assert.Equal(t, jobs.DefaultExportMaxRows, cfg.ExportMaxRows)
+				assert.Equal(t, defaultExportRateLimit, cfg.ExportRateLimit)
+				assert.Equal(t, defaultExportTimeout, cfg.ExportTimeout)
+				assert.Equal(t, url.Values{"utm_source": {"ticosintech"}, "utm_medium": {"job_board"}}, cfg.ApplyUTMParams)
+				assert.Equal(t, botguard.DefaultConfig(), cfg.BotGuard)
+				assert.Empty(t, cfg.TrustedProxies)
+				assert.Equal(t, "CR", cfg.DefaultCountry)
+				assert.Equal(t, []string{"CR"}, cfg.Countries)
+				assert.Equal(t, SearchBackendPostgres, cfg.SearchBackend)
+				assert.False(t, cfg.ReviewIngestedJobs)
+				assert.False(t, cfg.PublicAPIDocs)
+				assert.Empty(t, cfg.TermsOfServiceURL)
+				assert.False(t, cfg.Notifier.Enabled())
+				assert.False(t, cfg.Assets.Enabled())
+				assert.False(t, cfg.Queue.Enabled())
+				assert.False(t, cfg.Mailer.Enabled())
+				assert.Empty(t, cfg.AlertsUnsubscribeURL)
+				assert.Empty(t, cfg.SentryDSN)
+				assert.Empty(t, cfg.RedisURL)
+				assert.Equal(t, 30*time.Second, cfg.SearchCacheTTL)
+				assert.Empty(t, cfg.OAuth.Providers())
+				assert.Equal(t, 5432, cfg.Database.Port)
+				assert.Equal(t, database.DefaultRetryMaxAttempts, cfg.Database.Retry.MaxAttempts)
+				assert.Equal(t, database.DefaultOpenTimeout, cfg.Database.Breaker.OpenTimeout)
+				assert.Equal(t, database.DefaultStartupTimeout, cfg.Database.StartupTimeout)
+				assert.Equal(t, database.DefaultHealthCheckInterval, cfg.Database.HealthCheckInterval)
+			},
+		},
+		{
+			name: "environment overrides",
+			env: map[string]string{
+				envPort:         "9090",
+				envLogLevel:     "debug",
+				envLogFormat:    "json",
+				envLogFile:      "logs/populator.log",
+				envAdminAPIKey:  "secret",
+				envIngestAPIKey: "scraper-secret",
+				envMetricsAddr:  "127.0.0.1:9100",
+				envDBHost:       "db",
+				envDBPort:       "6543",
+				envDatabaseURL:  "postgres://u:p@db:6543/jobs",
+
+				envDatabaseReplicaURL:   "postgres://u:p@replica:6543/jobs",
+				envDBMaxConns:           "25",
+				envDBMaxConnIdleTime:    "5m",
+				envDBStatementCacheMode: "describe",
+				envDBRetryMaxAttempts:   "1",
+				envDBBreakerOpenTimeout: "1m",
+				envDBLogQueries:         "true",
+				envDBStartupTimeout:     "0",
+
+				envSearchViewRefreshInterval: "0",
+				envLinkCheckInterval:         "6h",
+				envSchedulerDisabledTasks:    "link-checks, session-purge",
+				envSchedulerJitter:           "0",
+				envFXRatesURL:                "https://rates.example.com/latest/USD",
+				envTechArchiveMonths:         "12",
+				envPurgeRetentionDays:        "90",
+				envRequestTimeout:            "3s",
+				envSlowSearchThreshold:       "500ms",
+				envSearchRankRecencyWeight:   "0.8",
+				envSearchRankFeaturedWeight:  "0",
+				envSearchRankPrimaryTech:     "0.5",
+				envSearchRankHalfLife:        "168h",
+				envExportMaxRows:             "1000",
+				envExportRateLimit:           "0",
+				envExportTimeout:             "0",
+				envApplyUTMParams:            "utm_source=ticos&utm_campaign=jobs",
+				envBotBurstLimit:             "30",
+				envBotBurstWindow:            "5s",
+				envBotBanDuration:            "1h",
+				envBotHoneypotParams:         "ref_id, trk",
+				envTrustedProxies:            "10.0.0.0/8, 192.168.1.10",
+				envCountries:                 "cr, PA,GT,pa",
+				envDefaultCountry:            "pa",
+				envAPIV1Sunset:               "2025-07-01",
+				envPublicAPIDocs:             "true",
+				envTermsOfServiceURL:         "https://ticosintech.com/terms",
+				envSearchBackend:             SearchBackendOpenSearch,
+				envOpenSearchURL:             "https://search:9200",
+				envReviewIngestedJobs:        "true",
+				envNotifyTelegramBotToken:    "bot-token",
+				envNotifyTelegramChatIDs:     "@ticos_jobs, -1001234",
+				envNotifyTechnologies:        "Go,,Python ",
+				envAssetsEndpoint:            "https://storage.googleapis.com",
+				envAssetsBucket:              "ticos-assets",
+				envQueueDriver:               "nats",
+				envQueueURL:                  "nats://nats:4222",
+				envSMTPHost:                  "smtp.example.com",
+				envSMTPFrom:                  "no-reply@example.com",
+				envAlertsUnsubscribeURL:      "https://ticosintech.com/alerts/unsubscribe",
+				envSentryDSN:                 "https://abc123@o1.ingest.sentry.io/42",
+				envRedisURL:                  "redis://cache:6379/1",
+				envSearchCacheTTL:            "0s",
+				envOAuthCallbackBaseURL:      "https://ticosintech.com/api/v1",
+				envOAuthRedirectURL:          "https://ticosintech.com/login/callback",
+				envOAuthGitHubClientID:       "github-client",
+				envOAuthGitHubClientSecret:   "github-secret",
+			},
+			checkResults: func(t *testing.T, cfg *Config, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, "9090", cfg.Port)
+				assert.Equal(t, []string{"CR", "PA", "GT"}, cfg.Countries)
+				assert.Equal(t, "PA", cfg.DefaultCountry)
+				assert.Equal(t, LogLevelDebug, cfg.LogLevel)
+				assert.Equal(t, logging.FormatJSON, cfg.Logging.Format)
+				assert.Equal(t, "logs/populator.log", cfg.Logging.File)
+				assert.Equal(t, logging.DefaultConfig().MaxSizeMB, cfg.Logging.MaxSizeMB)
+				assert.Equal(t, "secret", cfg.AdminAPIKey)
+				assert.Equal(t, "scraper-secret", cfg.IngestAPIKey)
+				assert.Equal(t, "127.0.0.1:9100", cfg.MetricsAddr)
+				assert.Equal(t, "db", cfg.Database.Host)
+				assert.Equal(t, 6543, cfg.Database.Port)
+				assert.Equal(t, "postgres://u:p@db:6543/jobs", cfg.Database.ConnectionString())
+				assert.Equal(t, "postgres://u:p@replica:6543/jobs", cfg.Database.ReplicaURL)
+				assert.Equal(t, 25, cfg.Database.MaxConns)
+				assert.Equal(t, 5*time.Minute, cfg.Database.MaxConnIdleTime)
+				assert.Equal(t, database.DefaultMaxConnLifetime, cfg.Database.MaxConnLifetime)
+				assert.Equal(t, database.StatementCacheModeDescribe, cfg.Database.StatementCacheMode)
+				assert.Equal(t, 1, cfg.Database.Retry.MaxAttempts)
+				assert.Equal(t, database.DefaultRetryBaseDelay, cfg.Database.Retry.BaseDelay)
+				assert.Equal(t, time.Minute, cfg.Database.Breaker.OpenTimeout)
+				assert.True(t, cfg.Database.LogQueries)
+				assert.Zero(t, cfg.Database.StartupTimeout)
+				assert.Equal(t, database.DefaultStartupRetryDelay, cfg.Database.StartupRetryDelay)
+				assert.False(t, cfg.Database.LogQueryArgs)
+				assert.Zero(t, cfg.SearchViewRefreshInterval)
+				assert.Equal(t, 6*time.Hour, cfg.LinkCheckInterval)
+				assert.Equal(t, []string{"link-checks", "session-purge"}, cfg.SchedulerDisabledTasks)
+				assert.Zero(t, cfg.SchedulerJitter)
+				assert.Equal(t, "https://rates.example.com/latest/USD", cfg.FXRatesURL)
+				assert.Equal(t, 12, cfg.TechArchiveMonths)
+				assert.Equal(t, 90, cfg.PurgeRetentionDays)
+				assert.Equal(t, 3*time.Second, cfg.RequestTimeout)
+				assert.Equal(t, 500*time.Millisecond, cfg.SlowSearchThreshold)
+				assert.Equal(t, jobs.Ranking{
+					TextWeight:        jobs.DefaultRankTextWeight,
+					RecencyWeight:     0.8,
+					PrimaryTechWeight: 0.5,
+					RecencyHalfLife:   7 * 24 * time.Hour,
+				}, cfg.SearchRanking)
+				assert.Equal(t, 1000, cfg.ExportMaxRows)
+				assert.Zero(t, cfg.ExportRateLimit)
+				assert.Zero(t, cfg.ExportTimeout)
+				assert.Equal(t, url.Values{"utm_source": {"ticos"}, "utm_campaign": {"jobs"}}, cfg.ApplyUTMParams)
+				assert.Equal(t, botguard.Config{
+					BurstLimit:     30,
+					BurstWindow:    5 * time.Second,
+					BanDuration:    time.Hour,
+					HoneypotParams: []string{"ref_id", "trk"},
+				}, cfg.BotGuard)
+				assert.Equal(t, []string{"10.0.0.0/8", "192.168.1.10"}, cfg.TrustedProxies)
+				assert.Zero(t, cfg.APIV1Deprecation)
+				assert.Equal(t, time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), cfg.APIV1Sunset)
+				assert.True(t, cfg.PublicAPIDocs)
+				assert.Equal(t, "https://ticosintech.com/terms", cfg.TermsOfServiceURL)
+				assert.Equal(t, "https://ticosintech.com/alerts/unsubscribe", cfg.AlertsUnsubscribeURL)
+				assert.Equal(t, "https://abc123@o1.ingest.sentry.io/42", cfg.SentryDSN)
+				assert.Equal(t, "redis://cache:6379/1", cfg.RedisURL)
+				assert.Zero(t, cfg.SearchCacheTTL)
+				assert.Equal(t, "https://ticosintech.com/api/v1", cfg.OAuth.CallbackBaseURL)
+				assert.Equal(t, "https://ticosintech.com/login/callback", cfg.OAuth.RedirectURL)
+				assert.False(t, cfg.OAuth.Google.Enabled())
+				assert.True(t, cfg.OAuth.GitHub.Enabled())
+				assert.Len(t, cfg.OAuth.Providers(), 1)
+				assert.Equal(t, SearchBackendOpenSearch, cfg.SearchBackend)
+				assert.Equal(t, "https://search:9200", cfg.OpenSearch.URL)
+				assert.Equal(t, "jobs", cfg.OpenSearch.Index)
+				assert.True(t, cfg.ReviewIngestedJobs)
+				assert.True(t, cfg.Notifier.Enabled())
+				assert.Equal(t, []string{"@ticos_jobs", "-1001234"}, cfg.Notifier.TelegramChatIDs)
+				assert.Equal(t, []string{"Go", "Python"}, cfg.Notifier.Technologies)
+				assert.True(t, cfg.Assets.Enabled())
+				assert.Equal(t, "https://storage.googleapis.com/ticos-assets", cfg.Assets.BaseURL())
+				assert.True(t, cfg.Queue.Enabled())
+				assert.Equal(t, "nats", cfg.Queue.Driver)
+				assert.Equal(t, "jobs", cfg.Queue.Topic)
+				assert.True(t, cfg.Mailer.Enabled())
+				assert.Equal(t, 587, cfg.Mailer.Port)
+			},
+		},
+		{
+			name: "invalid database port",
+			env:  map[string]string{envDBPort: "not-a-number"},
+			checkResults: func(t *testing.T, _ *Config, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), envDBPort)
+			},
+		},
+		{
+			name: "invalid database max connections",
+			env:  map[string]string{envDBMaxConns: "many"},
+			checkResults: func(t *testing.T, _ *Config, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), envDBMaxConns)
+			},
+		},
+		{
+			name: "invalid database breaker open timeout",
+			env:  map[string]string{envDBBreakerOpenTimeout: "soon"},
+			checkResults: func(t *testing.T, _ *Config, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), envDBBreakerOpenTimeout)
+			},
+		},
+		{
+			name: "invalid database startup timeout",
+			env:  map[string]string{envDBStartupTimeout: "a while"},
+			checkResults: func(t *testing.T, _ *Config, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), envDBStartupTimeout)
+			},
+		},
+		{
+			name: "invalid API sunset",
+			env:  map[string]string{envAPIV1Sunset: "next summer"},
+			checkResults: func(t *testing.T, _ *Config, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), envAPIV1Sunset)
+			},
+		},
+		{
+			name: "invalid SMTP port",
+			env:  map[string]string{envSMTPPort: "smtp"},
+			checkResults: func(t *testing.T, _ *Config, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), envSMTPPort)
+			},
+		},
+		{
+			name: "invalid search backend",
+			env:  map[string]string{envSearchBackend: "solr"},
+			checkResults: func(t *testing.T, _ *Config, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), envSearchBackend)
+			},
+		},
+		{
+			name: "invalid queue driver",
+			env:  map[string]string{envQueueDriver: "rabbitmq"},
+			checkResults: func(t *testing.T, _ *Config, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), envQueueDriver)
+			},
+		},
+		{
+			name: "invalid search view refresh interval",
+			env:  map[string]string{envSearchViewRefreshInterval: "often"},
+			checkResults: func(t *testing.T, _ *Config, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), envSearchViewRefreshInterval)
+			},
+		},
+		{
+			name: "negative search rank weight",
+			env:  map[string]string{envSearchRankTextWeight: "-1"},
+			checkResults: func(t *testing.T, _ *Config, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), envSearchRankTextWeight)
+			},
+		},
+		{
+			name: "zero search rank half-life",
+			env:  map[string]string{envSearchRankHalfLife: "0s"},
+			checkResults: func(t *testing.T, _ *Config, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), envSearchRankHalfLife)
+			},
+		},
+		{
+			name: "zero export max rows",
+			env:  map[string]string{envExportMaxRows: "0"},
+			checkResults: func(t *testing.T, _ *Config, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), envExportMaxRows)
+			},
+		},
+		{
+			name: "no UTM parameters",
+			env:  map[string]string{envApplyUTMParams: ""},
+			checkResults: func(t *testing.T, cfg *Config, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Empty(t, cfg.ApplyUTMParams)
+			},
+		},
+		{
+			name: "invalid UTM parameters",
+			env:  map[string]string{envApplyUTMParams: "utm_source=%zz"},
+			checkResults: func(t *testing.T, _ *Config, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), envApplyUTMParams)
+			},
+		},
+		{
+			name: "negative bot burst limit",
+			env:  map[string]string{envBotBurstLimit: "-1"},
+			checkResults: func(t *testing.T, _ *Config, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), envBotBurstLimit)
+			},
+		},
+		{
+			name: "zero bot ban duration",
+			env:  map[string]string{envBotBanDuration: "0s"},
+			checkResults: func(t *testing.T, _ *Config, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), envBotBanDuration)
+			},
+		},
+		{
+			name: "negative export rate limit",
+			env:  map[string]string{envExportRateLimit: "-1"},
+			checkResults: func(t *testing.T, _ *Config, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), envExportRateLimit)
+			},
+		},
+		{
+			name: "negative technology archive months",
+			env:  map[string]string{envTechArchiveMonths: "-3"},
+			checkResults: func(t *testing.T, _ *Config, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), envTechArchiveMonths)
+			},
+		},
+		{
+			name: "negative purge retention days",
+			env:  map[string]string{envPurgeRetentionDays: "-30"},
+			checkResults: func(t *testing.T, _ *Config, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), envPurgeRetentionDays)
+			},
+		},
+		{
+			name: "negative search cache TTL",
+			env:  map[string]string{envSearchCacheTTL: "-1m"},
+			checkResults: func(t *testing.T, _ *Config, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), envSearchCacheTTL)
+			},
+		},
+		{
+			name: "invalid Sentry DSN",
+			env:  map[string]string{envSentryDSN: "https://o1.ingest.sentry.io/42"},
+			checkResults: func(t *testing.T, _ *Config, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), envSentryDSN)
+			},
+		},
+		{
+			name: "OAuth client without callback base URL",
+			env:  map[string]string{envOAuthGoogleClientID: "google-client", envOAuthGoogleClientSecret: "google-secret"},
+			checkResults: func(t *testing.T, _ *Config, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), envOAuthCallbackBaseURL)
+			},
+		},
+		{
+			name: "OAuth client without redirect URL",
+			env: map[string]string{
+				envOAuthGitHubClientID:     "github-client",
+				envOAuthGitHubClientSecret: "github-secret",
+				envOAuthCallbackBaseURL:    "https://ticosintech.com/api/v1",
+			},
+			checkResults: func(t *testing.T, _ *Config, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), envOAuthRedirectURL)
+			},
+		},
+		{
+			name: "invalid trusted proxy",
+			env:  map[string]string{envTrustedProxies: "10.0.0.0/8,load-balancer"},
+			checkResults: func(t *testing.T, _ *Config, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), envTrustedProxies)
+			},
+		},
+		{
+			name: "invalid log format",
+			env:  map[string]string{envLogFormat: "xml"},
+			checkResults: func(t *testing.T, _ *Config, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), envLogFormat)
+			},
+		},
+		{
+			name: "log file rotated at zero size",
+			env:  map[string]string{envLogFileMaxSizeMB: "0"},
+			checkResults: func(t *testing.T, _ *Config, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), envLogFileMaxSizeMB)
+			},
+		},
+		{
+			name: "invalid log level",
+			env:  map[string]string{envLogLevel: "verbose"},
+			checkResults: func(t *testing.T, _ *Config, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), envLogLevel)
+			},
+		},
+		{
+			name: "invalid country",
+			env:  map[string]string{envCountries: "CR,Panama"},
+			checkResults: func(t *testing.T, _ *Config, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), envCountries)
+			},
+		},
+		{
+			name: "default country not served",
+			env:  map[string]string{envCountries: "PA,GT"},
+			checkResults: func(t *testing.T, _ *Config, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), envDefaultCountry)
+			},
+		},
+		{
+			name: "invalid review ingested jobs",
+			env:  map[string]string{envReviewIngestedJobs: "maybe"},
+			checkResults: func(t *testing.T, _ *Config, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), envReviewIngestedJobs)
+			},
+		},
+		{
+			name: "invalid public API docs",
+			env:  map[string]string{envPublicAPIDocs: "sometimes"},
+			checkResults: func(t *testing.T, _ *Config, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), envPublicAPIDocs)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			cfg, err := Load()
+			tt.checkResults(t, cfg, err)
+		})
+	}
+}
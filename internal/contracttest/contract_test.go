@@ -0,0 +1,2129 @@
+package contracttest_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/alerts"
+	"github.com/rodruizronald/ticos-in-tech/internal/benefit"
+	"github.com/rodruizronald/ticos-in-tech/internal/botguard"
+	"github.com/rodruizronald/ticos-in-tech/internal/company"
+	"github.com/rodruizronald/ticos-in-tech/internal/contracttest"
+	"github.com/rodruizronald/ticos-in-tech/internal/employer"
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+	"github.com/rodruizronald/ticos-in-tech/internal/ingest"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobevent"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobfunction"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobrevision"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobtech"
+	"github.com/rodruizronald/ticos-in-tech/internal/linkcheck"
+	"github.com/rodruizronald/ticos-in-tech/internal/location"
+	"github.com/rodruizronald/ticos-in-tech/internal/maintenance"
+	"github.com/rodruizronald/ticos-in-tech/internal/pendingtech"
+	"github.com/rodruizronald/ticos-in-tech/internal/quality"
+	"github.com/rodruizronald/ticos-in-tech/internal/reference"
+	"github.com/rodruizronald/ticos-in-tech/internal/scheduler"
+	"github.com/rodruizronald/ticos-in-tech/internal/settings"
+	"github.com/rodruizronald/ticos-in-tech/internal/stats"
+	"github.com/rodruizronald/ticos-in-tech/internal/suggest"
+	"github.com/rodruizronald/ticos-in-tech/internal/techalias"
+	"github.com/rodruizronald/ticos-in-tech/internal/techdetect"
+	"github.com/rodruizronald/ticos-in-tech/internal/technology"
+	"github.com/rodruizronald/ticos-in-tech/internal/users"
+	"github.com/rodruizronald/ticos-in-tech/internal/webhooks"
+	"github.com/rodruizronald/ticos-in-tech/internal/widget"
+)
+
+const (
+	apiKey       = "contract-test-key"
+	sessionToken = "contract-test-token"
+	oauthState   = "contract-test-state"
+)
+
+// api serves the API routes the way the server does, with mocked repositories
+type api struct {
+	router *gin.Engine
+	// db backs the repositories used without an interface
+	db pgxmock.PgxPoolIface
+
+	jobs         *jobs.MockDataRepository
+	terms        *jobs.MockTermRepository
+	exports      *jobs.MockExportRepository
+	similar      *jobs.MockSimilarRepository
+	duplicates   *jobs.MockDuplicateRepository
+	moderation   *jobs.MockModerationRepository
+	publisher    *jobs.MockEventPublisher
+	revisions    *jobrevision.MockDataRepository
+	revisionJobs *jobrevision.MockJobRepository
+	webhooks     *webhooks.MockDataRepository
+	linkChecks   *linkcheck.MockDataRepository
+	events       *jobevent.MockDataRepository
+	recorder     *jobevent.MockEventRecorder
+	companies    *company.MockDataRepository
+	aliases      *company.MockAliasRepository
+	archive      *company.MockArchiveRepository
+	merge        *company.MockMergeRepository
+	searchView   *company.MockSearchViewRefresher
+	users        *users.MockDataRepository
+	userTechs    *users.MockTechnologyRepository
+	identities   *users.MockIdentityRepository
+	oauth        *users.MockOAuthProvider
+	stats        *stats.MockDataRepository
+	refreshView  *maintenance.MockSearchViewRefresher
+	refreshStats *maintenance.MockStatsRefresher
+	techs        *technology.MockDataRepository
+	techAliases  *technology.MockAliasRepository
+	pending      *pendingtech.MockDataRepository
+	detections   *techdetect.MockDataRepository
+	quality      *quality.MockDataRepository
+	references   *reference.MockDataRepository
+	ingest       *ingest.MockDataRepository
+	claims       *employer.MockClaimRepository
+	mailer       *employer.MockMailer
+	portal       *employer.MockPortalRepository
+	portalJobs   *employer.MockJobRepository
+	closer       *employer.MockJobCloser
+	locations    *employer.MockLocationResolver
+	techFinder   *employer.MockTechnologyFinder
+	jobAlerts    *alerts.MockDataRepository
+	suggestions  *suggest.MockDataRepository
+	settings     *settings.MockDataRepository
+	blocklist    *botguard.MockDataRepository
+}
+
+// newAPI creates the API with new mocks, checked when the test ends
+func newAPI(t *testing.T) *api {
+	t.Helper()
+	db, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, db.ExpectationsWereMet())
+		db.Close()
+	})
+
+	a := &api{
+		db:           db,
+		jobs:         jobs.NewMockDataRepository(t),
+		terms:        jobs.NewMockTermRepository(t),
+		exports:      jobs.NewMockExportRepository(t),
+		similar:      jobs.NewMockSimilarRepository(t),
+		duplicates:   jobs.NewMockDuplicateRepository(t),
+		moderation:   jobs.NewMockModerationRepository(t),
+		publisher:    jobs.NewMockEventPublisher(t),
+		revisions:    jobrevision.NewMockDataRepository(t),
+		revisionJobs: jobrevision.NewMockJobRepository(t),
+		webhooks:     webhooks.NewMockDataRepository(t),
+		linkChecks:   linkcheck.NewMockDataRepository(t),
+		events:       jobevent.NewMockDataRepository(t),
+		recorder:     jobevent.NewMockEventRecorder(t),
+		companies:    company.NewMockDataRepository(t),
+		aliases:      company.NewMockAliasRepository(t),
+		archive:      company.NewMockArchiveRepository(t),
+		merge:        company.NewMockMergeRepository(t),
+		searchView:   company.NewMockSearchViewRefresher(t),
+		users:        users.NewMockDataRepository(t),
+		userTechs:    users.NewMockTechnologyRepository(t),
+		identities:   users.NewMockIdentityRepository(t),
+		oauth:        users.NewMockOAuthProvider(t),
+		stats:        stats.NewMockDataRepository(t),
+		refreshView:  maintenance.NewMockSearchViewRefresher(t),
+		refreshStats: maintenance.NewMockStatsRefresher(t),
+		techs:        technology.NewMockDataRepository(t),
+		techAliases:  technology.NewMockAliasRepository(t),
+		pending:      pendingtech.NewMockDataRepository(t),
+		detections:   techdetect.NewMockDataRepository(t),
+		quality:      quality.NewMockDataRepository(t),
+		references:   reference.NewMockDataRepository(t),
+		ingest:       ingest.NewMockDataRepository(t),
+		claims:       employer.NewMockClaimRepository(t),
+		mailer:       employer.NewMockMailer(t),
+		portal:       employer.NewMockPortalRepository(t),
+		portalJobs:   employer.NewMockJobRepository(t),
+		closer:       employer.NewMockJobCloser(t),
+		locations:    employer.NewMockLocationResolver(t),
+		techFinder:   employer.NewMockTechnologyFinder(t),
+		jobAlerts:    alerts.NewMockDataRepository(t),
+		suggestions:  suggest.NewMockDataRepository(t),
+		settings:     settings.NewMockDataRepository(t),
+		blocklist:    botguard.NewMockDataRepository(t),
+	}
+	a.oauth.EXPECT().Name().Return(users.ProviderGitHub).Once()
+	a.router = a.newRouter()
+	return a
+}
+
+// newRouter registers the API routes like cmd/server, with both API keys set
+func (a *api) newRouter() *gin.Engine {
+	moderationService := jobs.NewModerationService(a.moderation, nil, a.publisher)
+	companyService := company.NewCompanyService(a.companies, a.aliases, nil)
+	statsService := stats.NewStatsService(a.stats)
+	techService := technology.NewTechnologyService(a.techs, a.techAliases, nil)
+	schedulerRepo := scheduler.NewRepository(a.db)
+
+	jobHandler := jobs.NewHandler(a.jobs, a.terms)
+	recommendationHandler := jobs.NewRecommendationHandler(jobs.NewRecommendationService(a.similar))
+	exportHandler := jobs.NewExportHandler(jobs.NewExportService(a.exports, a.terms, 0))
+	jobAdminHandler := jobs.NewAdminHandler(jobs.NewDuplicateDetector(a.duplicates), moderationService)
+	revisionHandler := jobrevision.NewHandler(jobrevision.NewRevisionService(a.revisions, a.revisionJobs))
+	webhookHandler := webhooks.NewHandler(webhooks.NewWebhookService(a.webhooks))
+	linkCheckHandler := linkcheck.NewHandler(linkcheck.NewLinkCheckService(a.linkChecks))
+	eventHandler := jobevent.NewHandler(
+		jobevent.NewEventService(a.events, a.recorder, url.Values{"utm_source": {"ticosintech"}}))
+	companyHandler := company.NewHandler(companyService)
+	companyAdminHandler := company.NewAdminHandler(companyService,
+		company.NewArchiveService(a.archive, a.searchView, nil, a.publisher),
+		company.NewMergeService(a.merge, a.searchView, nil))
+	jobFunctionHandler := jobfunction.NewHandler(jobfunction.NewRepository(a.db))
+	benefitHandler := benefit.NewHandler(benefit.NewRepository(a.db))
+	suggestHandler := suggest.NewHandler(suggest.NewSuggestService(a.suggestions))
+	userService := users.NewUserService(a.users, a.userTechs)
+	userHandler := users.NewHandler(userService)
+	oauthHandler := users.NewOAuthHandler(users.NewOAuthService(a.identities, userService, a.oauth),
+		"https://ticosintech.com/login/callback")
+	employerHandler := employer.NewHandler(employer.NewClaimService(a.claims, a.mailer),
+		employer.NewPortalService(a.portal, a.portalJobs, a.closer, a.locations, a.techFinder),
+		userService)
+	alertHandler := alerts.NewHandler(alerts.NewAlertService(a.jobAlerts), userService)
+	statsHandler := stats.NewHandler(statsService)
+	widgetHandler := widget.NewHandler(widget.NewWidgetService(widget.NewRepository(a.db)))
+	maintenanceHandler := maintenance.NewHandler(maintenance.NewMaintenanceService(a.refreshView, a.refreshStats))
+	techHandler := technology.NewHandler(techService)
+	pendingHandler := pendingtech.NewHandler(pendingtech.NewPendingTechnologyService(a.pending, techService))
+	detectionHandler := techdetect.NewHandler(
+		techdetect.NewDetectionService(a.detections, techdetect.DefaultAutoAcceptConfidence))
+	qualityHandler := quality.NewHandler(quality.NewQualityService(a.quality))
+	referenceHandler := reference.NewHandler(reference.NewReferenceService(a.references, reference.NewCatalog()))
+	ingestHandler := ingest.NewHandler(ingest.NewIngestService(a.ingest))
+	schedulerHandler := scheduler.NewHandler(scheduler.NewScheduler(schedulerRepo, scheduler.Config{}), schedulerRepo)
+	settingsHandler := settings.NewHandler(settings.NewSettingsService(a.settings,
+		settings.Enum("log_level", "Least severe level logged", "info", []string{"debug", "info"}, func(string) {})))
+	blocklist := botguard.NewBlocklist()
+	blocklistHandler := botguard.NewHandler(botguard.NewBlocklistService(a.blocklist, blocklist))
+	botGuard := botguard.NewGuard(botguard.DefaultConfig(), blocklist, nil)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	versions := []httpservice.APIVersion{{Name: "v1"}}
+	httpservice.RegisterVersions(r, versions, func(api *gin.RouterGroup, _ *httpservice.APIVersion) {
+		jobHandler.RegisterRoutes(api.Group("", botGuard.Middleware()))
+		recommendationHandler.RegisterRoutes(api)
+		exportHandler.RegisterRoutes(api.Group("", botGuard.Middleware()))
+		eventHandler.RegisterRoutes(api)
+		companyHandler.RegisterRoutes(api)
+		jobFunctionHandler.RegisterRoutes(api)
+		benefitHandler.RegisterRoutes(api)
+		suggestHandler.RegisterRoutes(api)
+		userHandler.RegisterRoutes(api)
+		oauthHandler.RegisterRoutes(api)
+		employerHandler.RegisterRoutes(api)
+		alertHandler.RegisterRoutes(api)
+		statsHandler.RegisterRoutes(api)
+		widgetHandler.RegisterRoutes(api)
+		ingestHandler.RegisterRoutes(api)
+
+		ingestHandler.RegisterIngestRoutes(api.Group("", httpservice.RequireAPIKey(apiKey)))
+
+		admin := api.Group("/admin", httpservice.RequireAPIKey(apiKey))
+		jobAdminHandler.RegisterAdminRoutes(admin)
+		revisionHandler.RegisterAdminRoutes(admin)
+		companyAdminHandler.RegisterAdminRoutes(admin)
+		employerHandler.RegisterAdminRoutes(admin)
+		eventHandler.RegisterAdminRoutes(admin)
+		techHandler.RegisterAdminRoutes(admin)
+		pendingHandler.RegisterAdminRoutes(admin)
+		detectionHandler.RegisterAdminRoutes(admin)
+		webhookHandler.RegisterAdminRoutes(admin)
+		ingestHandler.RegisterAdminRoutes(admin)
+		linkCheckHandler.RegisterAdminRoutes(admin)
+		maintenanceHandler.RegisterAdminRoutes(admin)
+		qualityHandler.RegisterAdminRoutes(admin)
+		referenceHandler.RegisterAdminRoutes(admin)
+		blocklistHandler.RegisterAdminRoutes(admin)
+		schedulerHandler.RegisterAdminRoutes(admin)
+		settingsHandler.RegisterAdminRoutes(admin)
+	}, httpservice.ErrorHandler())
+	return r
+}
+
+// contractCase is a request and the mocked data its handler reads
+type contractCase struct {
+	name   string
+	method string
+	target string
+	body   string
+	setup  func(a *api)
+	status int
+}
+
+// contractCases cover every documented operation with a successful response and some of the
+// documented error responses
+var contractCases = slices.Concat(
+	jobCases,
+	companyCases,
+	userCases,
+	portalCases,
+	alertCases,
+	ingestCases,
+	statsCases,
+	embedCases,
+	adminCases,
+	catalogCases,
+)
+
+func TestContract(t *testing.T) {
+	t.Parallel()
+	validator := contracttest.NewValidator(t)
+
+	for _, tc := range contractCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			a := newAPI(t)
+			if tc.setup != nil {
+				tc.setup(a)
+			}
+
+			recorder := validator.Serve(t, a.router, newRequest(tc.method, tc.target, tc.body))
+			assert.Equal(t, tc.status, recorder.Code, recorder.Body.String())
+		})
+	}
+}
+
+func TestContract_Coverage(t *testing.T) {
+	t.Parallel()
+	validator := contracttest.NewValidator(t)
+
+	// Every served route is documented
+	assert.Empty(t, validator.Undocumented(newAPI(t).router.Routes()), "routes missing swagger annotations")
+
+	// Every documented operation is checked by a successful request
+	covered := make(map[string]bool)
+	for _, tc := range contractCases {
+		if tc.status >= http.StatusBadRequest {
+			continue
+		}
+		if operation, ok := validator.Operation(newRequest(tc.method, tc.target, tc.body)); ok {
+			covered[operation] = true
+		}
+	}
+	for _, operation := range validator.Operations() {
+		assert.True(t, covered[operation], "no contract case for %s", operation)
+	}
+}
+
+// newRequest creates an API request with the API key, a session token and the state of an OAuth
+// login
+func newRequest(method, target, body string) *http.Request {
+	req := httptest.NewRequest(method, "/api/v1"+target, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set(httpservice.APIKeyHeader, apiKey)
+	req.Header.Set(users.AuthorizationHeader, "Bearer "+sessionToken)
+	req.AddCookie(&http.Cookie{Name: users.OAuthStateCookie, Value: oauthState + ".verifier"})
+	return req
+}
+
+// timestamp is the time of the mocked data
+var timestamp = time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+var jobCases = []contractCase{
+	{
+		name:   "search jobs",
+		method: http.MethodGet,
+		target: "/jobs?q=golang&highlight=true&limit=1",
+		setup: func(a *api) {
+			a.terms.EXPECT().ListSynonyms(mock.Anything, []string{"golang"}).Return(nil, nil).Once()
+			a.jobs.EXPECT().SearchJobsWithCount(mock.Anything, mock.Anything).
+				Return([]*jobs.JobWithCompany{jobWithCompany()}, 3, nil).Once()
+			a.jobs.EXPECT().GetJobTechnologiesBatch(mock.Anything, []int{7}).Return(jobTechnologies(), nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "search jobs with selected fields",
+		method: http.MethodGet,
+		target: "/jobs?q=golang&fields=job_id,title",
+		setup: func(a *api) {
+			a.terms.EXPECT().ListSynonyms(mock.Anything, []string{"golang"}).Return(nil, nil).Once()
+			a.jobs.EXPECT().SearchJobsWithCount(mock.Anything, mock.Anything).
+				Return([]*jobs.JobWithCompany{jobWithCompany()}, 1, nil).Once()
+			a.jobs.EXPECT().GetJobTechnologiesBatch(mock.Anything, []int{7}).Return(jobTechnologies(), nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "export jobs as csv",
+		method: http.MethodGet,
+		target: "/jobs/export.csv?q=golang&work_mode=Remote",
+		setup: func(a *api) {
+			a.terms.EXPECT().ListSynonyms(mock.Anything, []string{"golang"}).Return(nil, nil).Once()
+			a.exports.EXPECT().StreamSearchJobs(mock.Anything, mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, _ *jobs.SearchParams, fn func(*jobs.JobWithCompany) error) error {
+					return fn(jobWithCompany())
+				}).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "export jobs without a query",
+		method: http.MethodGet,
+		target: "/jobs/export.csv",
+		status: http.StatusBadRequest,
+	},
+	{
+		name:   "search jobs finding nothing",
+		method: http.MethodGet,
+		target: "/jobs?q=kubernetess",
+		setup: func(a *api) {
+			a.terms.EXPECT().ListSynonyms(mock.Anything, []string{"kubernetess"}).Return(nil, nil).Once()
+			a.jobs.EXPECT().SearchJobsWithCount(mock.Anything, mock.Anything).Return(nil, 0, nil).Once()
+			a.jobs.EXPECT().GetJobTechnologiesBatch(mock.Anything, []int{}).Return(nil, nil).Once()
+			a.terms.EXPECT().FindClosestTerms(mock.Anything, []string{"kubernetess"}, mock.Anything).
+				Return(map[string]string{"kubernetess": "kubernetes"}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "search jobs without query",
+		method: http.MethodGet,
+		target: "/jobs",
+		status: http.StatusBadRequest,
+	},
+	{
+		name:   "list similar jobs",
+		method: http.MethodGet,
+		target: "/jobs/7/similar",
+		setup: func(a *api) {
+			a.similar.EXPECT().GetByID(mock.Anything, 7).Return(&jobWithCompany().Job, nil).Once()
+			a.similar.EXPECT().FindSimilarJobs(mock.Anything, mock.Anything).Return([]*jobs.SimilarJob{
+				{JobWithCompany: *jobWithCompany(), SharedTechnologies: 2},
+			}, nil).Once()
+			a.similar.EXPECT().GetJobTechnologiesBatch(mock.Anything, []int{7}).Return(jobTechnologies(), nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "list similar jobs of unknown job",
+		method: http.MethodGet,
+		target: "/jobs/8/similar",
+		setup: func(a *api) {
+			a.similar.EXPECT().GetByID(mock.Anything, 8).Return(nil, &jobs.NotFoundError{ID: 8}).Once()
+		},
+		status: http.StatusNotFound,
+	},
+	{
+		name:   "list moderation queue",
+		method: http.MethodGet,
+		target: "/admin/jobs?status=rejected&limit=1",
+		setup: func(a *api) {
+			job := &jobs.ModerationJob{
+				JobWithCompany:  *jobWithCompany(),
+				RejectionReason: "Posting is a recruiting agency ad",
+				ReviewedAt:      &timestamp,
+				ApplicationURLStatus: &jobs.LinkStatus{
+					OK: false, StatusCode: 404, Error: "responded with status 404", CheckedAt: timestamp,
+				},
+				CompanyLogoStatus: &jobs.LinkStatus{OK: true, StatusCode: 200, CheckedAt: timestamp},
+			}
+			job.Status = jobs.StatusRejected
+			a.moderation.EXPECT().ListByStatus(mock.Anything, mock.Anything).
+				Return([]*jobs.ModerationJob{job}, 2, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "list jobs with admin filters",
+		method: http.MethodGet,
+		target: "/admin/jobs?status=published&company_id=2&is_active=true&created_from=2024-01-01&created_to=2024-01-31",
+		setup: func(a *api) {
+			job := &jobs.ModerationJob{JobWithCompany: *jobWithCompany()}
+			job.Status = jobs.StatusPublished
+			a.moderation.EXPECT().ListByStatus(mock.Anything, mock.MatchedBy(func(params *jobs.StatusListParams) bool {
+				return *params.CompanyID == 2 && *params.IsActive &&
+					params.CreatedFrom.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) &&
+					params.CreatedBefore.Equal(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+			})).Return([]*jobs.ModerationJob{job}, 1, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "list jobs with an invalid date",
+		method: http.MethodGet,
+		target: "/admin/jobs?created_from=01/31/2024",
+		status: http.StatusBadRequest,
+	},
+	{
+		name:   "list jobs with an inverted date range",
+		method: http.MethodGet,
+		target: "/admin/jobs?created_from=2024-02-01&created_to=2024-01-31",
+		status: http.StatusBadRequest,
+	},
+	{
+		name:   "list near-duplicate jobs",
+		method: http.MethodGet,
+		target: "/admin/jobs/near-duplicates",
+		setup: func(a *api) {
+			a.duplicates.EXPECT().FindNearDuplicates(mock.Anything, mock.Anything).Return([]*jobs.NearDuplicate{{
+				CompanyID:       3,
+				CompanyName:     "Tech Corp",
+				First:           jobs.DuplicateCandidate{JobID: 7, Title: "Go Developer", CreatedAt: timestamp},
+				Second:          jobs.DuplicateCandidate{JobID: 9, Title: "Go Developer (Remote)", CreatedAt: timestamp},
+				TitleSimilarity: 0.85,
+				URLSimilarity:   0.4,
+			}}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "approve job",
+		method: http.MethodPost,
+		target: "/admin/jobs/7/approve",
+		setup: func(a *api) {
+			job := &jobWithCompany().Job
+			job.Status = jobs.StatusPending
+			a.moderation.EXPECT().GetByID(mock.Anything, 7).Return(job, nil).Once()
+			a.moderation.EXPECT().IsCompanyActive(mock.Anything, job.CompanyID).Return(true, nil).Once()
+			a.moderation.EXPECT().Review(mock.Anything, 7, jobs.StatusPublished, "").Return(true, nil).Once()
+			a.moderation.EXPECT().RefreshSearchView(mock.Anything).Return(nil).Once()
+			a.publisher.EXPECT().PublishJobEvent(mock.Anything, jobs.EventCreated, mock.Anything).Return(nil).Once()
+		},
+		status: http.StatusNoContent,
+	},
+	{
+		name:   "approve job of archived company",
+		method: http.MethodPost,
+		target: "/admin/jobs/7/approve",
+		setup: func(a *api) {
+			job := &jobWithCompany().Job
+			job.Status = jobs.StatusPending
+			a.moderation.EXPECT().GetByID(mock.Anything, 7).Return(job, nil).Once()
+			a.moderation.EXPECT().IsCompanyActive(mock.Anything, job.CompanyID).Return(false, nil).Once()
+		},
+		status: http.StatusConflict,
+	},
+	{
+		name:   "approve published job",
+		method: http.MethodPost,
+		target: "/admin/jobs/7/approve",
+		setup: func(a *api) {
+			a.moderation.EXPECT().GetByID(mock.Anything, 7).Return(&jobWithCompany().Job, nil).Once()
+		},
+		status: http.StatusConflict,
+	},
+	{
+		name:   "reject job",
+		method: http.MethodPost,
+		target: "/admin/jobs/7/reject",
+		body:   `{"reason": "Posting is a recruiting agency ad"}`,
+		setup: func(a *api) {
+			job := &jobWithCompany().Job
+			job.Status = jobs.StatusPending
+			a.moderation.EXPECT().GetByID(mock.Anything, 7).Return(job, nil).Once()
+			a.moderation.EXPECT().Review(mock.Anything, 7, jobs.StatusRejected, "Posting is a recruiting agency ad").
+				Return(true, nil).Once()
+		},
+		status: http.StatusNoContent,
+	},
+	{
+		name:   "deactivate job",
+		method: http.MethodPost,
+		target: "/admin/jobs/7/deactivate",
+		setup: func(a *api) {
+			a.moderation.EXPECT().GetByID(mock.Anything, 7).Return(&jobWithCompany().Job, nil).Once()
+			a.moderation.EXPECT().Deactivate(mock.Anything, 7).Return(true, nil).Once()
+			a.moderation.EXPECT().RefreshSearchView(mock.Anything).Return(nil).Once()
+			a.publisher.EXPECT().PublishJobEvent(mock.Anything, jobs.EventDeactivated, mock.Anything).Return(nil).Once()
+		},
+		status: http.StatusNoContent,
+	},
+	{
+		name:   "patch job",
+		method: http.MethodPatch,
+		target: "/admin/jobs/7",
+		body:   `{"title": "Senior Go Developer"}`,
+		setup: func(a *api) {
+			job := &jobWithCompany().Job
+			job.IsActive = false
+			a.moderation.EXPECT().Patch(mock.Anything, 7, mock.Anything).Return(job, nil).Once()
+		},
+		status: http.StatusNoContent,
+	},
+	{
+		name:   "patch job without fields",
+		method: http.MethodPatch,
+		target: "/admin/jobs/7",
+		body:   `{}`,
+		status: http.StatusBadRequest,
+	},
+}
+
+// jobWithCompany returns a new published job with all its fields set
+func jobWithCompany() *jobs.JobWithCompany {
+	eligibility := jobs.RemoteEligibilityLATAM
+	offsetMin, offsetMax, locationID := -6, -3, 1
+	return &jobs.JobWithCompany{
+		Job: jobs.Job{
+			ID:                7,
+			CompanyID:         3,
+			Title:             "Go Developer",
+			Description:       "Build APIs in Go",
+			ExperienceLevel:   "Senior",
+			EmploymentType:    "Full-time",
+			Location:          "Costa Rica",
+			WorkMode:          "Remote",
+			ApplicationURL:    "https://techcorp.example.com/jobs/7",
+			IsActive:          true,
+			Signature:         "a1b2c3",
+			Language:          jobs.LanguageEnglish,
+			Status:            jobs.StatusPublished,
+			RemoteEligibility: &eligibility,
+			UTCOffsetMin:      &offsetMin,
+			UTCOffsetMax:      &offsetMax,
+			LocationID:        &locationID,
+			CreatedAt:         timestamp,
+			UpdatedAt:         timestamp,
+		},
+		CompanyName:    "Tech Corp",
+		CompanySlug:    "tech-corp",
+		CompanyLogoURL: "https://techcorp.example.com/logo.png",
+		Benefits:       []string{"health-insurance"},
+		Highlight:      "Build APIs in <mark>Go</mark>",
+	}
+}
+
+// jobTechnologies returns the technologies of the job returned by jobWithCompany
+func jobTechnologies() map[int][]*jobtech.JobTechnologyWithDetails {
+	return map[int][]*jobtech.JobTechnologyWithDetails{
+		7: {{JobID: 7, TechnologyID: 1, TechName: "Go", TechCategory: "Programming Language", IsRequired: true}},
+	}
+}
+
+var companyCases = []contractCase{
+	{
+		name:   "get company",
+		method: http.MethodGet,
+		target: "/companies/tech-corp",
+		setup: func(a *api) {
+			a.companies.EXPECT().GetBySlug(mock.Anything, "tech-corp").Return(techCorp(), nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "get unknown company",
+		method: http.MethodGet,
+		target: "/companies/data-inc",
+		setup: func(a *api) {
+			a.companies.EXPECT().GetBySlug(mock.Anything, "data-inc").
+				Return(nil, &company.NotFoundError{Slug: "data-inc"}).Once()
+		},
+		status: http.StatusNotFound,
+	},
+	{
+		name:   "get company technologies",
+		method: http.MethodGet,
+		target: "/companies/tech-corp/technologies",
+		setup: func(a *api) {
+			a.companies.EXPECT().GetBySlug(mock.Anything, "tech-corp").Return(techCorp(), nil).Once()
+			a.companies.EXPECT().GetTechnologies(mock.Anything, 3).Return([]*company.TechnologyCount{
+				{Name: "Go", Category: "Programming Language", Jobs: 4, RequiredJobs: 3},
+			}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "create company",
+		method: http.MethodPost,
+		target: "/admin/companies",
+		body:   `{"name": "Data Inc", "logo_url": "https://data.example.com/logo.png", "website_url": "https://data.example.com"}`,
+		setup: func(a *api) {
+			a.companies.EXPECT().ListWithAliases(mock.Anything).Return([]*company.Company{techCorp()}, nil).Once()
+			a.companies.EXPECT().Create(mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, created *company.Company) error {
+					created.ID, created.Country = 4, "CR"
+					return nil
+				}).Once()
+		},
+		status: http.StatusCreated,
+	},
+	{
+		name:   "create similar company",
+		method: http.MethodPost,
+		target: "/admin/companies",
+		body:   `{"name": "Tech Corp CR", "logo_url": "https://techcorp.example.com/logo.png"}`,
+		setup: func(a *api) {
+			a.companies.EXPECT().ListWithAliases(mock.Anything).Return([]*company.Company{techCorp()}, nil).Once()
+		},
+		status: http.StatusConflict,
+	},
+	{
+		name:   "merge company",
+		method: http.MethodPost,
+		target: "/admin/companies/tech-corp-cr/merge",
+		body:   `{"into": "tech-corp"}`,
+		setup: func(a *api) {
+			duplicate := techCorp()
+			duplicate.ID, duplicate.Name, duplicate.Slug = 4, "Tech Corp CR", "tech-corp-cr"
+			a.merge.EXPECT().GetBySlug(mock.Anything, "tech-corp-cr").Return(duplicate, nil).Once()
+			a.merge.EXPECT().GetBySlug(mock.Anything, "tech-corp").Return(techCorp(), nil).Once()
+			a.merge.EXPECT().Merge(mock.Anything, duplicate, 3).Return([]int{1}, nil).Once()
+			a.searchView.EXPECT().RefreshSearchView(mock.Anything).Return(nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "patch company",
+		method: http.MethodPatch,
+		target: "/admin/companies/tech-corp",
+		body:   `{"name": "Tech Corporation"}`,
+		setup: func(a *api) {
+			patched := techCorp()
+			patched.Name = "Tech Corporation"
+			a.companies.EXPECT().GetBySlug(mock.Anything, "tech-corp").Return(techCorp(), nil).Once()
+			a.companies.EXPECT().Patch(mock.Anything, 3, mock.Anything).Return(patched, nil).Once()
+			a.aliases.EXPECT().Create(mock.Anything, mock.Anything).Return(nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "deactivate company",
+		method: http.MethodPost,
+		target: "/admin/companies/tech-corp/deactivate",
+		setup: func(a *api) {
+			a.archive.EXPECT().GetBySlug(mock.Anything, "tech-corp").Return(techCorp(), nil).Once()
+			a.archive.EXPECT().Deactivate(mock.Anything, 3).Return([]jobs.Job{jobWithCompany().Job}, nil).Once()
+			a.searchView.EXPECT().RefreshSearchView(mock.Anything).Return(nil).Once()
+			a.publisher.EXPECT().PublishJobEvent(mock.Anything, jobs.EventDeactivated, mock.Anything).Return(nil).Once()
+		},
+		status: http.StatusOK,
+	},
+}
+
+// techCorp returns a new active company
+func techCorp() *company.Company {
+	return &company.Company{
+		ID:        3,
+		Name:      "Tech Corp",
+		Slug:      "tech-corp",
+		LogoURL:   "https://techcorp.example.com/logo.png",
+		Country:   "CR",
+		IsActive:  true,
+		CreatedAt: timestamp,
+		UpdatedAt: timestamp,
+	}
+}
+
+var userCases = []contractCase{
+	{
+		name:   "register user",
+		method: http.MethodPost,
+		target: "/auth/register",
+		body:   `{"email": "ana@example.com", "password": "correct-horse-battery"}`,
+		setup: func(a *api) {
+			a.users.EXPECT().Create(mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, user *users.User) error {
+					user.ID, user.CreatedAt, user.UpdatedAt = 5, timestamp, timestamp
+					return nil
+				}).Once()
+		},
+		status: http.StatusCreated,
+	},
+	{
+		name:   "register user with short password",
+		method: http.MethodPost,
+		target: "/auth/register",
+		body:   `{"email": "ana@example.com", "password": "short"}`,
+		status: http.StatusBadRequest,
+	},
+	{
+		name:   "login",
+		method: http.MethodPost,
+		target: "/auth/login",
+		body:   `{"email": "ana@example.com", "password": "correct-horse-battery"}`,
+		setup: func(a *api) {
+			hash, err := bcrypt.GenerateFromPassword([]byte("correct-horse-battery"), bcrypt.MinCost)
+			if err != nil {
+				panic(err)
+			}
+			user := ana()
+			user.PasswordHash = string(hash)
+			a.users.EXPECT().GetByEmail(mock.Anything, "ana@example.com").Return(user, nil).Once()
+			a.users.EXPECT().CreateSession(mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, session *users.Session) error {
+					session.CreatedAt = timestamp
+					return nil
+				}).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "login with wrong password",
+		method: http.MethodPost,
+		target: "/auth/login",
+		body:   `{"email": "ana@example.com", "password": "wrong-password"}`,
+		setup: func(a *api) {
+			a.users.EXPECT().GetByEmail(mock.Anything, "ana@example.com").Return(ana(), nil).Once()
+		},
+		status: http.StatusUnauthorized,
+	},
+	{
+		name:   "begin OAuth login",
+		method: http.MethodGet,
+		target: "/auth/oauth/github",
+		setup: func(a *api) {
+			a.oauth.EXPECT().AuthCodeURL(mock.Anything, mock.Anything).
+				Return("https://github.com/login/oauth/authorize").Once()
+		},
+		status: http.StatusFound,
+	},
+	{
+		name:   "begin OAuth login with unknown provider",
+		method: http.MethodGet,
+		target: "/auth/oauth/gitlab",
+		status: http.StatusNotFound,
+	},
+	{
+		name:   "complete OAuth login",
+		method: http.MethodGet,
+		target: "/auth/oauth/github/callback?code=code&state=" + oauthState,
+		setup: func(a *api) {
+			a.oauth.EXPECT().Identify(mock.Anything, "code", "verifier").Return(&users.Identity{
+				Provider: users.ProviderGitHub, Subject: "583231", Email: "ana@example.com", EmailVerified: true,
+			}, nil).Once()
+			a.identities.EXPECT().GetIdentityUser(mock.Anything, users.ProviderGitHub, "583231").Return(ana(), nil).Once()
+			a.identities.EXPECT().CreateLoginCode(mock.Anything, mock.Anything).Return(nil).Once()
+		},
+		status: http.StatusFound,
+	},
+	{
+		name:   "exchange OAuth login code",
+		method: http.MethodPost,
+		target: "/auth/oauth/exchange",
+		body:   `{"code": "login-code"}`,
+		setup: func(a *api) {
+			a.identities.EXPECT().ConsumeLoginCode(mock.Anything, mock.Anything).Return(ana(), nil).Once()
+			a.users.EXPECT().CreateSession(mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, session *users.Session) error {
+					session.CreatedAt = timestamp
+					return nil
+				}).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "exchange expired OAuth login code",
+		method: http.MethodPost,
+		target: "/auth/oauth/exchange",
+		body:   `{"code": "login-code"}`,
+		setup: func(a *api) {
+			a.identities.EXPECT().ConsumeLoginCode(mock.Anything, mock.Anything).Return(nil, users.ErrInvalidLoginCode).Once()
+		},
+		status: http.StatusUnauthorized,
+	},
+	{
+		name:   "logout",
+		method: http.MethodPost,
+		target: "/auth/logout",
+		setup: func(a *api) {
+			signIn(a)
+			a.users.EXPECT().DeleteSession(mock.Anything, mock.Anything).Return(nil).Once()
+		},
+		status: http.StatusNoContent,
+	},
+	{
+		name:   "get current user",
+		method: http.MethodGet,
+		target: "/me",
+		setup:  signIn,
+		status: http.StatusOK,
+	},
+	{
+		name:   "get current user with expired session",
+		method: http.MethodGet,
+		target: "/me",
+		setup: func(a *api) {
+			a.users.EXPECT().GetSessionUser(mock.Anything, mock.Anything).Return(nil, users.ErrInvalidSession).Once()
+		},
+		status: http.StatusUnauthorized,
+	},
+	{
+		name:   "list bookmarks",
+		method: http.MethodGet,
+		target: "/me/bookmarks?limit=1",
+		setup: func(a *api) {
+			signIn(a)
+			a.users.EXPECT().ListBookmarks(mock.Anything, mock.Anything).Return([]*users.SavedJob{
+				{JobWithCompany: *jobWithCompany(), SavedAt: timestamp},
+			}, 2, nil).Once()
+			a.userTechs.EXPECT().GetJobTechnologiesBatch(mock.Anything, []int{7}).Return(jobTechnologies(), nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "save job",
+		method: http.MethodPut,
+		target: "/me/bookmarks/7",
+		setup: func(a *api) {
+			signIn(a)
+			a.users.EXPECT().AddBookmark(mock.Anything, 5, 7).Return(nil).Once()
+		},
+		status: http.StatusNoContent,
+	},
+	{
+		name:   "unsave job",
+		method: http.MethodDelete,
+		target: "/me/bookmarks/7",
+		setup: func(a *api) {
+			signIn(a)
+			a.users.EXPECT().RemoveBookmark(mock.Anything, 5, 7).Return(nil).Once()
+		},
+		status: http.StatusNoContent,
+	},
+	{
+		name:   "mark job applied",
+		method: http.MethodPost,
+		target: "/jobs/7/applied",
+		body:   `{"status": "interviewing", "notes": "Referred by a friend"}`,
+		setup: func(a *api) {
+			signIn(a)
+			a.users.EXPECT().UpsertApplication(mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, application *users.Application) error {
+					application.CreatedAt, application.UpdatedAt = timestamp, timestamp
+					return nil
+				}).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "list applications",
+		method: http.MethodGet,
+		target: "/me/applications?status=interviewing",
+		setup: func(a *api) {
+			signIn(a)
+			a.users.EXPECT().ListApplications(mock.Anything, mock.Anything).Return([]*users.AppliedJob{{
+				JobWithCompany: *jobWithCompany(),
+				Application: users.Application{UserID: 5, JobID: 7, Status: users.StatusInterviewing,
+					Notes: "Referred by a friend", CreatedAt: timestamp, UpdatedAt: timestamp},
+			}}, 1, nil).Once()
+			a.userTechs.EXPECT().GetJobTechnologiesBatch(mock.Anything, []int{7}).Return(jobTechnologies(), nil).Once()
+		},
+		status: http.StatusOK,
+	},
+}
+
+// ana returns a new user
+func ana() *users.User {
+	return &users.User{ID: 5, Email: "ana@example.com", CreatedAt: timestamp, UpdatedAt: timestamp}
+}
+
+// signIn authenticates the session token of the requests as ana
+func signIn(a *api) {
+	a.users.EXPECT().GetSessionUser(mock.Anything, mock.Anything).Return(ana(), nil).Once()
+}
+
+var portalCases = []contractCase{
+	{
+		name:   "claim company",
+		method: http.MethodPost,
+		target: "/companies/tech-corp/claims",
+		body:   `{"email": "ana@techcorp.com"}`,
+		setup: func(a *api) {
+			signIn(a)
+			a.claims.EXPECT().GetCompanyBySlug(mock.Anything, "tech-corp").Return(portalCompany(), nil).Once()
+			a.claims.EXPECT().CountClaimsSince(mock.Anything, 5, mock.Anything).Return(0, nil).Once()
+			a.claims.EXPECT().CreateClaim(mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, claim *employer.Claim) error {
+					claim.ID, claim.CreatedAt = 7, timestamp
+					return nil
+				}).Once()
+			a.mailer.EXPECT().Send(mock.Anything, "ana@techcorp.com", mock.Anything, mock.Anything).Return(nil).Once()
+		},
+		status: http.StatusCreated,
+	},
+	{
+		name:   "claim company with another domain",
+		method: http.MethodPost,
+		target: "/companies/tech-corp/claims",
+		body:   `{"email": "ana@example.com"}`,
+		setup: func(a *api) {
+			signIn(a)
+			a.claims.EXPECT().GetCompanyBySlug(mock.Anything, "tech-corp").Return(portalCompany(), nil).Once()
+		},
+		status: http.StatusBadRequest,
+	},
+	{
+		name:   "verify claim",
+		method: http.MethodPost,
+		target: "/companies/tech-corp/claims/7/verify",
+		body:   `{"code": "042137"}`,
+		setup: func(a *api) {
+			signIn(a)
+			hash := sha256.Sum256([]byte("042137"))
+			a.claims.EXPECT().GetCompanyBySlug(mock.Anything, "tech-corp").Return(portalCompany(), nil).Once()
+			a.claims.EXPECT().GetClaim(mock.Anything, 7).Return(&employer.Claim{
+				ID: 7, CompanyID: 3, UserID: 5, Email: "ana@techcorp.com", CodeHash: hex.EncodeToString(hash[:]),
+				ExpiresAt: time.Now().Add(time.Hour), CreatedAt: timestamp,
+			}, nil).Once()
+			a.claims.EXPECT().ConsumeClaimAttempt(mock.Anything, 7).Return(hex.EncodeToString(hash[:]), nil).Once()
+			a.claims.EXPECT().VerifyClaim(mock.Anything, 7, mock.Anything, mock.Anything).Return(manager(), nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "verify claim with malformed code",
+		method: http.MethodPost,
+		target: "/companies/tech-corp/claims/7/verify",
+		body:   `{"code": "42"}`,
+		setup:  signIn,
+		status: http.StatusBadRequest,
+	},
+	{
+		name:   "list my companies",
+		method: http.MethodGet,
+		target: "/me/companies",
+		setup: func(a *api) {
+			signIn(a)
+			member := manager()
+			member.Company = portalCompany()
+			a.portal.EXPECT().ListMemberships(mock.Anything, 5).Return([]*employer.Member{member}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "list company jobs",
+		method: http.MethodGet,
+		target: "/me/companies/tech-corp/jobs?status=rejected&limit=1",
+		setup: func(a *api) {
+			signInMember(a)
+			a.portal.EXPECT().ListPostings(mock.Anything, mock.Anything).
+				Return([]*employer.Posting{posting(jobs.StatusRejected)}, 2, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "list company jobs without membership",
+		method: http.MethodGet,
+		target: "/me/companies/tech-corp/jobs",
+		setup: func(a *api) {
+			signIn(a)
+			a.portal.EXPECT().GetCompanyBySlug(mock.Anything, "tech-corp").Return(portalCompany(), nil).Once()
+			a.portal.EXPECT().GetMember(mock.Anything, 3, 5).Return(nil, nil).Once()
+		},
+		status: http.StatusForbidden,
+	},
+	{
+		name:   "post company job",
+		method: http.MethodPost,
+		target: "/me/companies/tech-corp/jobs",
+		body: `{"title": "Senior Go Developer", "description": "We are looking for a Go developer",
+			"experience_level": "Senior", "employment_type": "Full-time", "location": "San José, Costa Rica",
+			"work_mode": "Hybrid", "application_url": "https://techcorp.com/careers/go", "technologies": ["golang"]}`,
+		setup: func(a *api) {
+			signInMember(a)
+			a.techFinder.EXPECT().FindByNameOrAlias(mock.Anything, "golang").Return(golang(), nil).Once()
+			a.locations.EXPECT().Resolve(mock.Anything, "San José, Costa Rica").
+				Return(&location.Location{ID: 2, Region: "San José"}, nil).Once()
+			a.portal.EXPECT().CreatePosting(mock.Anything, mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, job *jobs.Job, _ []*jobtech.JobTechnology) error {
+					job.ID, job.CreatedAt, job.UpdatedAt = 42, timestamp, timestamp
+					return nil
+				}).Once()
+		},
+		status: http.StatusCreated,
+	},
+	{
+		name:   "update company job",
+		method: http.MethodPatch,
+		target: "/me/companies/tech-corp/jobs/42",
+		body:   `{"title": "Go Developer"}`,
+		setup: func(a *api) {
+			signInMember(a)
+			a.portal.EXPECT().GetPosting(mock.Anything, 3, 42).Return(posting(jobs.StatusPending), nil).Twice()
+			a.portalJobs.EXPECT().Patch(mock.Anything, 42, mock.Anything).Return(&jobs.Job{ID: 42}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "close company job",
+		method: http.MethodPost,
+		target: "/me/companies/tech-corp/jobs/42/close",
+		setup: func(a *api) {
+			signInMember(a)
+			a.portal.EXPECT().GetPosting(mock.Anything, 3, 42).Return(posting(jobs.StatusPublished), nil).Once()
+			a.closer.EXPECT().Deactivate(mock.Anything, 42).Return(nil).Once()
+		},
+		status: http.StatusNoContent,
+	},
+	{
+		name:   "list company members",
+		method: http.MethodGet,
+		target: "/me/companies/tech-corp/members",
+		setup: func(a *api) {
+			signInMember(a)
+			a.portal.EXPECT().ListMembers(mock.Anything, 3).Return([]*employer.Member{manager()}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "set member scopes",
+		method: http.MethodPut,
+		target: "/me/companies/tech-corp/members/12",
+		body:   `{"scopes": ["jobs"]}`,
+		setup: func(a *api) {
+			signInMember(a)
+			a.portal.EXPECT().CountOtherManagers(mock.Anything, 3, 12).Return(1, nil).Once()
+			a.portal.EXPECT().UpdateMemberScopes(mock.Anything, 3, 12, []string{employer.ScopeJobs}).
+				Return(&employer.Member{CompanyID: 3, UserID: 12, Email: "luis@techcorp.com",
+					Scopes: []string{employer.ScopeJobs}, CreatedAt: timestamp, UpdatedAt: timestamp}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "set member scopes with unknown scope",
+		method: http.MethodPut,
+		target: "/me/companies/tech-corp/members/12",
+		body:   `{"scopes": ["billing"]}`,
+		setup:  signIn,
+		status: http.StatusBadRequest,
+	},
+	{
+		name:   "remove last manager",
+		method: http.MethodDelete,
+		target: "/me/companies/tech-corp/members/5",
+		setup: func(a *api) {
+			signIn(a)
+			a.portal.EXPECT().GetCompanyBySlug(mock.Anything, "tech-corp").Return(portalCompany(), nil).Once()
+			a.portal.EXPECT().GetMember(mock.Anything, 3, 5).Return(manager(), nil).Once()
+			a.portal.EXPECT().CountOtherManagers(mock.Anything, 3, 5).Return(0, nil).Once()
+		},
+		status: http.StatusConflict,
+	},
+	{
+		name:   "remove member",
+		method: http.MethodDelete,
+		target: "/me/companies/tech-corp/members/12",
+		setup: func(a *api) {
+			signInMember(a)
+			a.portal.EXPECT().CountOtherManagers(mock.Anything, 3, 12).Return(1, nil).Once()
+			a.portal.EXPECT().DeleteMember(mock.Anything, 3, 12).Return(true, nil).Once()
+		},
+		status: http.StatusNoContent,
+	},
+	{
+		name:   "set company email domain",
+		method: http.MethodPut,
+		target: "/admin/companies/tech-corp/email-domain",
+		body:   `{"email_domain": "techcorp.com"}`,
+		setup: func(a *api) {
+			domain := "techcorp.com"
+			a.claims.EXPECT().SetEmailDomain(mock.Anything, "tech-corp", &domain).Return(portalCompany(), nil).Once()
+		},
+		status: http.StatusOK,
+	},
+}
+
+// portalCompany returns a new claimable company
+func portalCompany() *employer.Company {
+	domain := "techcorp.com"
+	return &employer.Company{ID: 3, Name: "Tech Corp", Slug: "tech-corp", EmailDomain: &domain, IsActive: true}
+}
+
+// manager returns ana as a new member of Tech Corp with every scope
+func manager() *employer.Member {
+	return &employer.Member{CompanyID: 3, UserID: 5, Email: "ana@techcorp.com", Scopes: employer.Scopes(),
+		CreatedAt: timestamp, UpdatedAt: timestamp}
+}
+
+// posting returns a new job of Tech Corp with status
+func posting(status jobs.Status) *employer.Posting {
+	return &employer.Posting{ID: 42, Title: "Senior Go Developer", Status: status,
+		IsActive: status == jobs.StatusPublished, ExperienceLevel: "Senior", EmploymentType: "Full-time",
+		Location: "San José", WorkMode: "Hybrid", ApplicationURL: "https://techcorp.com/careers/go",
+		CreatedAt: timestamp, UpdatedAt: timestamp}
+}
+
+// signInMember authenticates the session token of the requests as ana, a member of Tech Corp
+// with every scope
+func signInMember(a *api) {
+	signIn(a)
+	a.portal.EXPECT().GetCompanyBySlug(mock.Anything, "tech-corp").Return(portalCompany(), nil).Once()
+	a.portal.EXPECT().GetMember(mock.Anything, 3, 5).Return(manager(), nil).Once()
+}
+
+var alertCases = []contractCase{
+	{
+		name:   "list alerts",
+		method: http.MethodGet,
+		target: "/me/alerts",
+		setup: func(a *api) {
+			signIn(a)
+			a.jobAlerts.EXPECT().ListByUser(mock.Anything, 5).Return([]*alerts.Alert{jobAlert()}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "create alert",
+		method: http.MethodPost,
+		target: "/me/alerts",
+		body: `{"query": "golang", "work_mode": "Remote", "frequency": "weekly", "timezone": "America/Bogota",
+			"quiet_hours_start": 22, "quiet_hours_end": 7}`,
+		setup: func(a *api) {
+			signIn(a)
+			a.jobAlerts.EXPECT().CountByUser(mock.Anything, 5).Return(0, nil).Once()
+			a.jobAlerts.EXPECT().Create(mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, alert *alerts.Alert) error {
+					alert.ID, alert.LastSentAt, alert.CreatedAt, alert.UpdatedAt = 9, timestamp, timestamp, timestamp
+					return nil
+				}).Once()
+		},
+		status: http.StatusCreated,
+	},
+	{
+		name:   "create alert with unknown timezone",
+		method: http.MethodPost,
+		target: "/me/alerts",
+		body:   `{"query": "golang", "timezone": "Mars/Olympus_Mons"}`,
+		setup:  signIn,
+		status: http.StatusBadRequest,
+	},
+	{
+		name:   "update alert",
+		method: http.MethodPut,
+		target: "/me/alerts/9",
+		body:   `{"query": "golang", "frequency": "instant"}`,
+		setup: func(a *api) {
+			signIn(a)
+			a.jobAlerts.EXPECT().Update(mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, alert *alerts.Alert) error {
+					*alert = *jobAlert()
+					alert.Frequency = alerts.FrequencyInstant
+					return nil
+				}).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "update alert of another user",
+		method: http.MethodPut,
+		target: "/me/alerts/10",
+		body:   `{"query": "golang"}`,
+		setup: func(a *api) {
+			signIn(a)
+			a.jobAlerts.EXPECT().Update(mock.Anything, mock.Anything).Return(&alerts.AlertNotFoundError{ID: 10}).Once()
+		},
+		status: http.StatusNotFound,
+	},
+	{
+		name:   "delete alert",
+		method: http.MethodDelete,
+		target: "/me/alerts/9",
+		setup: func(a *api) {
+			signIn(a)
+			a.jobAlerts.EXPECT().Delete(mock.Anything, 5, 9).Return(nil).Once()
+		},
+		status: http.StatusNoContent,
+	},
+	{
+		name:   "unsubscribe from alert",
+		method: http.MethodPost,
+		target: "/alerts/unsubscribe",
+		body:   `{"token": "` + strings.Repeat("ab", 32) + `"}`,
+		setup: func(a *api) {
+			a.jobAlerts.EXPECT().Unsubscribe(mock.Anything, strings.Repeat("ab", 32)).Return(nil).Once()
+		},
+		status: http.StatusNoContent,
+	},
+	{
+		name:   "unsubscribe with unknown token",
+		method: http.MethodPost,
+		target: "/alerts/unsubscribe",
+		body:   `{"token": "` + strings.Repeat("cd", 32) + `"}`,
+		setup: func(a *api) {
+			a.jobAlerts.EXPECT().Unsubscribe(mock.Anything, strings.Repeat("cd", 32)).
+				Return(alerts.ErrInvalidUnsubscribeToken).Once()
+		},
+		status: http.StatusBadRequest,
+	},
+}
+
+// jobAlert returns a new daily alert of ana
+func jobAlert() *alerts.Alert {
+	return &alerts.Alert{ID: 9, UserID: 5, Query: "golang", Frequency: alerts.FrequencyDaily,
+		Timezone: alerts.DefaultTimezone, UnsubscribeToken: strings.Repeat("ab", 32), LastSentAt: timestamp,
+		CreatedAt: timestamp, UpdatedAt: timestamp}
+}
+
+var ingestCases = []contractCase{
+	{
+		name:   "start ingest run",
+		method: http.MethodPost,
+		target: "/ingest/runs",
+		body:   `{"source": "careers-scraper"}`,
+		setup: func(a *api) {
+			a.ingest.EXPECT().CreateRun(mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, run *ingest.Run) error {
+					run.ID, run.Status, run.StartedAt = 15, ingest.StatusRunning, timestamp
+					return nil
+				}).Once()
+		},
+		status: http.StatusCreated,
+	},
+	{
+		name:   "start ingest run without source",
+		method: http.MethodPost,
+		target: "/ingest/runs",
+		body:   `{}`,
+		status: http.StatusBadRequest,
+	},
+	{
+		name:   "get jobs schema",
+		method: http.MethodGet,
+		target: "/schemas/jobs.json",
+		status: http.StatusOK,
+	},
+	{
+		name:   "validate jobs",
+		method: http.MethodPost,
+		target: "/ingest/jobs/validate",
+		body: `{"jobs": [{"company": "Tech Corp", "title": "Go Developer", "description": "Build APIs",
+			"application_url": "https://techcorp.com/jobs/1", "technologies": [{"name": "Go", "primary": true}]}]}`,
+		status: http.StatusOK,
+	},
+	{
+		name:   "validate jobs not matching the schema",
+		method: http.MethodPost,
+		target: "/ingest/jobs/validate",
+		body:   `{"jobs": [{"company": "Tech Corp", "title": "Go Developer", "language": "fr"}]}`,
+		status: http.StatusBadRequest,
+	},
+	{
+		name:   "report company scrape",
+		method: http.MethodPut,
+		target: "/ingest/runs/15/companies",
+		body:   `{"company": "Tech Corp", "status": "failed", "jobs_found": 0, "error": "careers page returned 403"}`,
+		setup: func(a *api) {
+			a.ingest.EXPECT().GetRun(mock.Anything, 15).Return(runningRun(), nil).Once()
+			a.ingest.EXPECT().ReportCompany(mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, report *ingest.CompanyReport) error {
+					report.CompanyID, report.ReportedAt = 3, timestamp
+					return nil
+				}).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "report company scrape to closed run",
+		method: http.MethodPut,
+		target: "/ingest/runs/15/companies",
+		body:   `{"company": "Tech Corp", "status": "succeeded", "jobs_found": 12}`,
+		setup: func(a *api) {
+			run := runningRun()
+			run.Status = ingest.StatusSucceeded
+			a.ingest.EXPECT().GetRun(mock.Anything, 15).Return(run, nil).Once()
+		},
+		status: http.StatusConflict,
+	},
+	{
+		name:   "close ingest run",
+		method: http.MethodPost,
+		target: "/ingest/runs/15/close",
+		body:   `{"status": "failed", "error": "careers site timed out"}`,
+		setup: func(a *api) {
+			a.ingest.EXPECT().GetRun(mock.Anything, 15).Return(runningRun(), nil).Once()
+			a.ingest.EXPECT().CloseRun(mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, run *ingest.Run) (bool, error) {
+					finishedAt := timestamp.Add(20 * time.Minute)
+					run.FinishedAt = &finishedAt
+					return true, nil
+				}).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "list ingest runs",
+		method: http.MethodGet,
+		target: "/admin/ingest/runs?limit=10",
+		setup: func(a *api) {
+			finishedAt := timestamp.Add(20 * time.Minute)
+			a.ingest.EXPECT().ListRuns(mock.Anything, 10).Return([]*ingest.RunSummary{
+				{Run: *runningRun(), CompaniesSucceeded: 3},
+				{
+					Run: ingest.Run{ID: 14, Source: "careers-scraper", Status: ingest.StatusFailed,
+						Error: "careers site timed out", StartedAt: timestamp, FinishedAt: &finishedAt},
+					CompaniesSucceeded: 40, CompaniesFailed: 2, JobsFound: 315,
+				},
+			}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "list ingest run anomalies",
+		method: http.MethodGet,
+		target: "/admin/ingest/runs/15/anomalies",
+		setup: func(a *api) {
+			a.ingest.EXPECT().GetRun(mock.Anything, 15).Return(runningRun(), nil).Once()
+			a.ingest.EXPECT().ListCompanyVolumes(mock.Anything, 15, ingest.AnomalyHistoryRuns).Return([]*ingest.CompanyVolume{
+				{CompanyID: 3, CompanyName: "Tech Corp", JobsFound: 0, Average: 14.5, BaselineRuns: 10},
+			}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "list anomalies of unknown ingest run",
+		method: http.MethodGet,
+		target: "/admin/ingest/runs/16/anomalies",
+		setup: func(a *api) {
+			a.ingest.EXPECT().GetRun(mock.Anything, 16).Return(nil, &ingest.RunNotFoundError{ID: 16}).Once()
+		},
+		status: http.StatusNotFound,
+	},
+	{
+		name:   "list stale companies",
+		method: http.MethodGet,
+		target: "/admin/ingest/stale",
+		setup: func(a *api) {
+			lastStatus := ingest.StatusFailed
+			lastError := "careers page returned 403"
+			a.ingest.EXPECT().ListStaleCompanies(mock.Anything, mock.Anything).Return([]*ingest.StaleCompany{
+				{CompanyID: 4, CompanyName: "Never Scraped"},
+				{CompanyID: 3, CompanyName: "Tech Corp", LastSuccessAt: &timestamp, LastStatus: &lastStatus,
+					LastError: &lastError, LastReportedAt: &timestamp},
+			}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+}
+
+// runningRun returns a new running ingest run
+func runningRun() *ingest.Run {
+	return &ingest.Run{ID: 15, Source: "careers-scraper", Status: ingest.StatusRunning, StartedAt: timestamp}
+}
+
+var statsCases = []contractCase{
+	{
+		name:   "technology stats",
+		method: http.MethodGet,
+		target: "/stats/technologies?date_from=2024-01-01&date_to=2024-01-31&limit=2",
+		setup: func(a *api) {
+			a.stats.EXPECT().GetTechnologyCounts(mock.Anything, mock.Anything).Return([]*stats.TechnologyCount{
+				{TechnologyID: 1, Name: "Go", Category: "Programming Language", JobCount: 40, ThisWeek: 12, LastWeek: 8},
+				{TechnologyID: 2, Name: "Rust", Category: "Programming Language", JobCount: 3, ThisWeek: 3},
+			}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "technology stats with reversed dates",
+		method: http.MethodGet,
+		target: "/stats/technologies?date_from=2024-01-31&date_to=2024-01-01",
+		status: http.StatusBadRequest,
+	},
+	{
+		name:   "stats overview",
+		method: http.MethodGet,
+		target: "/stats/overview",
+		setup: func(a *api) {
+			a.stats.EXPECT().GetJobTotals(mock.Anything, mock.Anything, mock.Anything).
+				Return(&stats.JobTotals{ActiveJobs: 120, NewJobs: 14}, nil).Once()
+			a.stats.EXPECT().CountJobsByWorkMode(mock.Anything, mock.Anything).
+				Return([]*stats.Bucket{{Value: "Remote", Count: 80}, {Value: "Hybrid", Count: 40}}, nil).Once()
+			a.stats.EXPECT().CountJobsByExperienceLevel(mock.Anything, mock.Anything).
+				Return([]*stats.Bucket{{Value: "Senior", Count: 70}}, nil).Once()
+			a.stats.EXPECT().GetTopHiringCompanies(mock.Anything, mock.Anything, stats.TopCompaniesLimit).
+				Return([]*stats.CompanyCount{{Slug: "tech-corp", Name: "Tech Corp", ActiveJobs: 12}}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "technology history",
+		method: http.MethodGet,
+		target: "/stats/history?metric=technology_jobs&key=go&date_from=2024-01-01&date_to=2024-01-31",
+		setup: func(a *api) {
+			a.stats.EXPECT().GetHistory(mock.Anything, mock.Anything).Return([]*stats.HistoryPoint{
+				{Day: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Value: 38},
+				{Day: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), Value: 40},
+			}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "history of an unknown metric",
+		method: http.MethodGet,
+		target: "/stats/history?metric=salaries",
+		status: http.StatusBadRequest,
+	},
+	{
+		name:   "track job view",
+		method: http.MethodPost,
+		target: "/jobs/7/events",
+		body:   `{"type": "view"}`,
+		setup: func(a *api) {
+			a.recorder.EXPECT().Record(mock.Anything).Return(nil).Once()
+		},
+		status: http.StatusAccepted,
+	},
+	{
+		name:   "track unknown job event",
+		method: http.MethodPost,
+		target: "/jobs/7/events",
+		body:   `{"type": "share"}`,
+		status: http.StatusBadRequest,
+	},
+	{
+		name:   "apply to job",
+		method: http.MethodGet,
+		target: "/jobs/7/apply",
+		setup: func(a *api) {
+			a.events.EXPECT().GetApplicationURL(mock.Anything, 7).Return("https://techcorp.com/jobs/7", nil).Once()
+			a.recorder.EXPECT().Record(mock.Anything).Return(nil).Once()
+		},
+		status: http.StatusFound,
+	},
+	{
+		name:   "apply to unpublished job",
+		method: http.MethodGet,
+		target: "/jobs/8/apply",
+		setup: func(a *api) {
+			a.events.EXPECT().GetApplicationURL(mock.Anything, 8).Return("", &jobevent.JobNotFoundError{ID: 8}).Once()
+		},
+		status: http.StatusNotFound,
+	},
+	{
+		name:   "job event stats",
+		method: http.MethodGet,
+		target: "/admin/job-events/stats?limit=5",
+		setup: func(a *api) {
+			a.events.EXPECT().GetJobStats(mock.Anything, mock.Anything).Return([]*jobevent.JobStats{
+				{JobID: 7, Title: "Go Developer", CompanyName: "Tech Corp", Views: 150, ApplyClicks: 12},
+			}, nil).Once()
+			a.events.EXPECT().GetCompanyStats(mock.Anything, mock.Anything).Return([]*jobevent.CompanyStats{
+				{CompanyID: 3, CompanyName: "Tech Corp", Views: 420, ApplyClicks: 31},
+			}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+}
+
+var adminCases = []contractCase{
+	{
+		name:   "list settings",
+		method: http.MethodGet,
+		target: "/admin/settings",
+		status: http.StatusOK,
+	},
+	{
+		name:   "change setting",
+		method: http.MethodPut,
+		target: "/admin/settings/log_level",
+		body:   `{"value": "debug"}`,
+		setup: func(a *api) {
+			a.settings.EXPECT().Set(mock.Anything, mock.Anything).Return(nil).Once()
+			a.settings.EXPECT().List(mock.Anything).Return(map[string]string{"log_level": "debug"}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "change setting to an invalid value",
+		method: http.MethodPut,
+		target: "/admin/settings/log_level",
+		body:   `{"value": "verbose"}`,
+		status: http.StatusBadRequest,
+	},
+	{
+		name:   "change unknown setting",
+		method: http.MethodPut,
+		target: "/admin/settings/database_url",
+		body:   `{"value": "postgres://localhost"}`,
+		status: http.StatusNotFound,
+	},
+	{
+		name:   "reset setting",
+		method: http.MethodDelete,
+		target: "/admin/settings/log_level",
+		status: http.StatusOK,
+	},
+	{
+		name:   "list setting changes",
+		method: http.MethodGet,
+		target: "/admin/settings/changes?limit=10",
+		setup: func(a *api) {
+			a.settings.EXPECT().ListChanges(mock.Anything, 10).Return([]*settings.Change{{
+				ID: 1, Name: "log_level", OldValue: "info", NewValue: "debug", ChangedBy: "api_key:3f2a9c1b7d4e",
+				ChangedAt: timestamp,
+			}}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "list job revisions",
+		method: http.MethodGet,
+		target: "/admin/jobs/7/revisions",
+		setup: func(a *api) {
+			job := jobWithCompany().Job
+			a.revisionJobs.EXPECT().GetByID(mock.Anything, 7).Return(&job, nil).Once()
+			a.revisions.EXPECT().ListByJobID(mock.Anything, 7).Return([]*jobrevision.Revision{
+				{ID: 2, JobID: 7, Title: "Go Engineer", Description: "Build APIs", ExperienceLevel: "Mid-level",
+					EmploymentType: "Full-time", Location: "Costa Rica", WorkMode: "Hybrid",
+					ApplicationURL: "https://techcorp.example.com/jobs/7", ValidFrom: timestamp.Add(-48 * time.Hour),
+					ReplacedAt: timestamp},
+			}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "subscribe webhook",
+		method: http.MethodPost,
+		target: "/admin/webhooks",
+		body:   `{"url": "https://partner.example.com/hooks/jobs", "event_types": ["job.created"]}`,
+		setup: func(a *api) {
+			a.webhooks.EXPECT().CreateSubscription(mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, subscription *webhooks.Subscription) error {
+					subscription.ID, subscription.CreatedAt, subscription.UpdatedAt = 2, timestamp, timestamp
+					return nil
+				}).Once()
+		},
+		status: http.StatusCreated,
+	},
+	{
+		name:   "subscribe webhook with relative url",
+		method: http.MethodPost,
+		target: "/admin/webhooks",
+		body:   `{"url": "/hooks/jobs"}`,
+		status: http.StatusBadRequest,
+	},
+	{
+		name:   "list webhooks",
+		method: http.MethodGet,
+		target: "/admin/webhooks",
+		setup: func(a *api) {
+			a.webhooks.EXPECT().ListSubscriptions(mock.Anything).Return([]*webhooks.Subscription{
+				{ID: 2, URL: "https://partner.example.com/hooks/jobs", Secret: "s3cret", EventTypes: webhooks.EventTypes,
+					IsActive: true, CreatedAt: timestamp, UpdatedAt: timestamp},
+			}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "unsubscribe webhook",
+		method: http.MethodDelete,
+		target: "/admin/webhooks/2",
+		setup: func(a *api) {
+			a.webhooks.EXPECT().DeleteSubscription(mock.Anything, 2).Return(nil).Once()
+		},
+		status: http.StatusNoContent,
+	},
+	{
+		name:   "list dead webhook deliveries",
+		method: http.MethodGet,
+		target: "/admin/webhooks/dead-letters?limit=10",
+		setup: func(a *api) {
+			a.webhooks.EXPECT().ListDeadDeliveries(mock.Anything, 10).Return([]*webhooks.Delivery{
+				{ID: 9, SubscriptionID: 2, EventType: "job.created", Payload: []byte(`{"type":"job.created"}`),
+					Status: webhooks.StatusDead, Attempts: 8, NextAttemptAt: timestamp, LastError: "status 500",
+					CreatedAt: timestamp, URL: "https://partner.example.com/hooks/jobs"},
+			}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "retry dead webhook delivery",
+		method: http.MethodPost,
+		target: "/admin/webhooks/dead-letters/9/retry",
+		setup: func(a *api) {
+			a.webhooks.EXPECT().RetryDeadDelivery(mock.Anything, int64(9)).Return(nil).Once()
+		},
+		status: http.StatusNoContent,
+	},
+	{
+		name:   "list link checks",
+		method: http.MethodGet,
+		target: "/admin/link-checks?kind=application_url&failing=true",
+		setup: func(a *api) {
+			a.linkChecks.EXPECT().List(mock.Anything, mock.Anything).Return([]*linkcheck.Check{
+				{
+					Result: linkcheck.Result{
+						Target: linkcheck.Target{Kind: linkcheck.KindApplicationURL, TargetID: 7,
+							URL: "https://techcorp.example.com/jobs/7"},
+						StatusCode: http.StatusNotFound, ContentType: "text/html", CheckedAt: timestamp,
+					},
+					JobTitle: "Go Developer", CompanyName: "Tech Corp", NotFoundCount: 2,
+				},
+			}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "refresh derived data",
+		method: http.MethodPost,
+		target: "/admin/maintenance/refresh",
+		setup: func(a *api) {
+			a.refreshView.EXPECT().RefreshSearchView(mock.Anything).Return(nil).Once()
+			a.refreshStats.EXPECT().RefreshOverview(mock.Anything).Return(nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "list scheduled task runs",
+		method: http.MethodGet,
+		target: "/admin/scheduler/runs?task=search-view-refresh&limit=5",
+		setup: func(a *api) {
+			a.db.ExpectQuery("FROM scheduled_task_runs").
+				WithArgs("search-view-refresh", 5).
+				WillReturnRows(pgxmock.NewRows([]string{"id", "task", "status", "error", "started_at", "finished_at"}).
+					AddRow(int64(3), "search-view-refresh", scheduler.RunStatusFailed, "timeout", timestamp,
+						timestamp.Add(time.Minute)))
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "list blocked networks",
+		method: http.MethodGet,
+		target: "/admin/blocklist",
+		setup: func(a *api) {
+			expiresAt := timestamp.Add(24 * time.Hour)
+			a.blocklist.EXPECT().List(mock.Anything).Return([]*botguard.BlockedNetwork{
+				{ID: 2, CIDR: netip.MustParsePrefix("203.0.113.0/24"), Reason: "Scraping the job search",
+					CreatedBy: "admin", CreatedAt: timestamp, ExpiresAt: &expiresAt},
+				{ID: 1, CIDR: netip.MustParsePrefix("198.51.100.7/32"), CreatedAt: timestamp},
+			}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "block network",
+		method: http.MethodPost,
+		target: "/admin/blocklist",
+		body:   `{"cidr": "203.0.113.0/24", "reason": "Scraping the job search"}`,
+		setup: func(a *api) {
+			a.blocklist.EXPECT().Create(mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, network *botguard.BlockedNetwork) error {
+					network.ID, network.CreatedAt = 2, timestamp
+					return nil
+				}).Once()
+			a.blocklist.EXPECT().List(mock.Anything).Return([]*botguard.BlockedNetwork{}, nil).Once()
+		},
+		status: http.StatusCreated,
+	},
+	{
+		name:   "block invalid network",
+		method: http.MethodPost,
+		target: "/admin/blocklist",
+		body:   `{"cidr": "203.0.113.0/33"}`,
+		status: http.StatusBadRequest,
+	},
+	{
+		name:   "block network already blocked",
+		method: http.MethodPost,
+		target: "/admin/blocklist",
+		body:   `{"cidr": "203.0.113.0/24"}`,
+		setup: func(a *api) {
+			a.blocklist.EXPECT().Create(mock.Anything, mock.Anything).
+				Return(&botguard.DuplicateError{CIDR: "203.0.113.0/24"}).Once()
+		},
+		status: http.StatusConflict,
+	},
+	{
+		name:   "unblock network",
+		method: http.MethodDelete,
+		target: "/admin/blocklist/2",
+		setup: func(a *api) {
+			a.blocklist.EXPECT().Delete(mock.Anything, 2).Return(nil).Once()
+			a.blocklist.EXPECT().List(mock.Anything).Return([]*botguard.BlockedNetwork{}, nil).Once()
+		},
+		status: http.StatusNoContent,
+	},
+	{
+		name:   "unblock unknown network",
+		method: http.MethodDelete,
+		target: "/admin/blocklist/9",
+		setup: func(a *api) {
+			a.blocklist.EXPECT().Delete(mock.Anything, 9).Return(&botguard.NotFoundError{ID: 9}).Once()
+		},
+		status: http.StatusNotFound,
+	},
+}
+
+var catalogCases = []contractCase{
+	{
+		name:   "suggest",
+		method: http.MethodGet,
+		target: "/suggest?q=Go&limit=3",
+		setup: func(a *api) {
+			a.suggestions.EXPECT().Suggest(mock.Anything, &suggest.Params{Prefix: "go", Limit: 3}).
+				Return([]*suggest.Suggestion{
+					{Type: suggest.TypeTechnology, Text: "Go", ActiveJobs: 42},
+					{
+						Type:        suggest.TypeCompany,
+						Text:        "Gorilla Logic",
+						CompanySlug: "gorilla-logic",
+						LogoURL:     "https://example.com/logo.png",
+						ActiveJobs:  12,
+					},
+				}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "suggest without query",
+		method: http.MethodGet,
+		target: "/suggest",
+		status: http.StatusBadRequest,
+	},
+	{
+		name:   "list benefits",
+		method: http.MethodGet,
+		target: "/benefits",
+		setup: func(a *api) {
+			a.db.ExpectQuery("FROM benefits").
+				WillReturnRows(pgxmock.NewRows([]string{"id", "slug", "name", "aliases", "created_at"}).
+					AddRow(1, "health-insurance", "Health insurance", []string{"private insurance"}, timestamp))
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "list job functions",
+		method: http.MethodGet,
+		target: "/job-functions",
+		setup: func(a *api) {
+			a.db.ExpectQuery("FROM job_functions").
+				WillReturnRows(pgxmock.NewRows([]string{"id", "name", "created_at"}).AddRow(1, "Backend", timestamp))
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "merge technologies",
+		method: http.MethodPost,
+		target: "/admin/technologies/2/merge",
+		body:   `{"into_id": 1}`,
+		setup: func(a *api) {
+			a.techs.EXPECT().GetByID(mock.Anything, 2).
+				Return(&technology.Technology{ID: 2, Name: "golang", Category: "Programming Language"}, nil).Once()
+			a.techs.EXPECT().GetByID(mock.Anything, 1).Return(golang(), nil).Once()
+			a.techs.EXPECT().Merge(mock.Anything, 2, 1, "golang").Return(nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "merge technology into itself",
+		method: http.MethodPost,
+		target: "/admin/technologies/1/merge",
+		body:   `{"into_id": 1}`,
+		status: http.StatusBadRequest,
+	},
+	{
+		name:   "import technology aliases",
+		method: http.MethodPost,
+		target: "/admin/technologies/1/aliases/batch",
+		body:   `{"aliases": ["golang", "js"]}`,
+		setup: func(a *api) {
+			a.techs.EXPECT().GetByID(mock.Anything, 1).Return(golang(), nil).Once()
+			a.techs.EXPECT().GetByName(mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, name string) (*technology.Technology, error) {
+					return nil, &technology.NotFoundError{Name: name}
+				}).Twice()
+			a.techAliases.EXPECT().Create(mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, alias *techalias.TechnologyAlias) error {
+					if alias.Alias == "js" {
+						return &techalias.DuplicateError{Alias: alias.Alias}
+					}
+					return nil
+				}).Twice()
+			a.techAliases.EXPECT().GetByAlias(mock.Anything, "js").
+				Return(&techalias.TechnologyAlias{ID: 4, TechnologyID: 7, Alias: "js"}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "import aliases of unknown technology",
+		method: http.MethodPost,
+		target: "/admin/technologies/99/aliases/batch",
+		body:   `{"aliases": ["golang"]}`,
+		setup: func(a *api) {
+			a.techs.EXPECT().GetByID(mock.Anything, 99).Return(nil, &technology.NotFoundError{ID: 99}).Once()
+		},
+		status: http.StatusNotFound,
+	},
+	{
+		name:   "export technology aliases",
+		method: http.MethodGet,
+		target: "/admin/technologies/aliases",
+		setup: func(a *api) {
+			tech := golang()
+			tech.Aliases = []techalias.TechnologyAlias{{ID: 3, TechnologyID: 1, Alias: "golang", CreatedAt: timestamp}}
+			a.techs.EXPECT().ListWithAliases(mock.Anything).Return([]*technology.Technology{tech}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "export technology aliases as csv",
+		method: http.MethodGet,
+		target: "/admin/technologies/aliases.csv",
+		setup: func(a *api) {
+			a.techs.EXPECT().ListWithAliases(mock.Anything).Return([]*technology.Technology{golang()}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "list unused technologies",
+		method: http.MethodGet,
+		target: "/admin/technologies/unused?months=12",
+		setup: func(a *api) {
+			a.techs.EXPECT().ListUnused(mock.Anything, mock.Anything).Return([]*technology.Usage{
+				{Technology: golang(), LastJobAt: &timestamp},
+				{Technology: &technology.Technology{ID: 5, Name: "flash", Category: "tool"}, ArchivedAt: &timestamp},
+			}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "list pending technologies",
+		method: http.MethodGet,
+		target: "/admin/pending-technologies",
+		setup: func(a *api) {
+			suggestedID, score := 1, 0.62
+			a.pending.EXPECT().List(mock.Anything).Return([]*pendingtech.PendingTechnology{
+				{ID: 4, Name: "go lang", Occurrences: 6, FirstSeenAt: timestamp, LastSeenAt: timestamp,
+					SuggestedTechnologyID: &suggestedID, SuggestionScore: &score},
+				{ID: 5, Name: "htmx", Occurrences: 1, FirstSeenAt: timestamp, LastSeenAt: timestamp},
+			}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "approve pending technology",
+		method: http.MethodPost,
+		target: "/admin/pending-technologies/5/approve",
+		body:   `{"category": "Frontend"}`,
+		setup: func(a *api) {
+			a.pending.EXPECT().GetByID(mock.Anything, 5).
+				Return(&pendingtech.PendingTechnology{ID: 5, Name: "htmx", Occurrences: 1}, nil).Once()
+			a.techs.EXPECT().Create(mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, tech *technology.Technology) error {
+					tech.ID, tech.CreatedAt = 9, timestamp
+					return nil
+				}).Once()
+			a.pending.EXPECT().Delete(mock.Anything, 5).Return(nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "approve pending technology without category",
+		method: http.MethodPost,
+		target: "/admin/pending-technologies/5/approve",
+		body:   `{}`,
+		setup: func(a *api) {
+			a.pending.EXPECT().GetByID(mock.Anything, 5).
+				Return(&pendingtech.PendingTechnology{ID: 5, Name: "htmx", Occurrences: 1}, nil).Once()
+		},
+		status: http.StatusBadRequest,
+	},
+	{
+		name:   "reject pending technology",
+		method: http.MethodDelete,
+		target: "/admin/pending-technologies/5",
+		setup: func(a *api) {
+			a.pending.EXPECT().Delete(mock.Anything, 5).Return(nil).Once()
+		},
+		status: http.StatusNoContent,
+	},
+	{
+		name:   "list pending technology detections",
+		method: http.MethodGet,
+		target: "/admin/technology-detections",
+		setup: func(a *api) {
+			a.detections.EXPECT().ListPending(mock.Anything).Return([]*techdetect.Detection{
+				{ID: 3, JobID: 1, JobTitle: "Backend Developer", TechnologyID: 1, TechnologyName: "go", Term: "go",
+					Occurrences: 2, Confidence: 0.35, Status: techdetect.StatusPending, CreatedAt: timestamp},
+			}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "accept technology detection",
+		method: http.MethodPost,
+		target: "/admin/technology-detections/3/accept",
+		setup: func(a *api) {
+			reviewedAt := timestamp
+			a.detections.EXPECT().Review(mock.Anything, 3, techdetect.StatusAccepted).Return(true, nil).Once()
+			a.detections.EXPECT().GetByID(mock.Anything, 3).Return(&techdetect.Detection{
+				ID: 3, JobID: 1, JobTitle: "Backend Developer", TechnologyID: 1, TechnologyName: "go", Term: "go",
+				Occurrences: 2, Confidence: 0.35, Status: techdetect.StatusAccepted, CreatedAt: timestamp,
+				ReviewedAt: &reviewedAt,
+			}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "accept reviewed technology detection",
+		method: http.MethodPost,
+		target: "/admin/technology-detections/3/accept",
+		setup: func(a *api) {
+			a.detections.EXPECT().Review(mock.Anything, 3, techdetect.StatusAccepted).Return(false, nil).Once()
+			a.detections.EXPECT().GetByID(mock.Anything, 3).
+				Return(&techdetect.Detection{ID: 3, Status: techdetect.StatusRejected}, nil).Once()
+		},
+		status: http.StatusConflict,
+	},
+	{
+		name:   "reject technology detection",
+		method: http.MethodPost,
+		target: "/admin/technology-detections/3/reject",
+		setup: func(a *api) {
+			a.detections.EXPECT().Review(mock.Anything, 3, techdetect.StatusRejected).Return(true, nil).Once()
+		},
+		status: http.StatusNoContent,
+	},
+	{
+		name:   "data quality summary",
+		method: http.MethodGet,
+		target: "/admin/quality",
+		setup: func(a *api) {
+			a.quality.EXPECT().GetSummary(mock.Anything, mock.Anything).Return(&quality.Summary{
+				ActiveJobs: 850, JobsMissingTechnologies: 12, JobsWithUnknownValues: 3,
+				JobsWithInferredExperienceLevel: 40, CompaniesWithoutLogos: 2, PendingTechnologies: 7,
+				PendingTechnologyDetections: 25,
+			}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "list jobs missing technologies",
+		method: http.MethodGet,
+		target: "/admin/quality/jobs-missing-technologies?limit=1",
+		setup: func(a *api) {
+			a.quality.EXPECT().ListJobsMissingTechnologies(mock.Anything, 1).Return([]*quality.JobIssue{
+				{JobID: 1, Title: "Backend Developer", CompanyName: "Tech Corp", ExperienceLevel: "Senior",
+					EmploymentType: "Full-time", WorkMode: "Remote", CreatedAt: timestamp},
+			}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "list jobs with unknown values",
+		method: http.MethodGet,
+		target: "/admin/quality/jobs-unknown-values",
+		setup: func(a *api) {
+			a.quality.EXPECT().ListJobsWithUnknownValues(mock.Anything, mock.Anything, quality.DefaultLimit).
+				Return([]*quality.JobIssue{
+					{JobID: 1, Title: "Backend Developer", CompanyName: "Tech Corp", ExperienceLevel: "Senior",
+						EmploymentType: "Full-time", WorkMode: "Anywhere", CreatedAt: timestamp},
+				}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "list jobs with unknown values with an invalid limit",
+		method: http.MethodGet,
+		target: "/admin/quality/jobs-unknown-values?limit=500",
+		status: http.StatusBadRequest,
+	},
+	{
+		name:   "list companies without logos",
+		method: http.MethodGet,
+		target: "/admin/quality/companies-without-logos",
+		setup: func(a *api) {
+			statusCode := 404
+			logoError := "responded with status 404"
+			a.quality.EXPECT().ListCompaniesWithoutLogos(mock.Anything, quality.DefaultLimit).
+				Return([]*quality.CompanyIssue{
+					{CompanyID: 3, Name: "Tech Corp", Slug: "tech-corp", LogoURL: "https://techcorp.com/logo.png",
+						LogoStatusCode: &statusCode, LogoError: &logoError, ActiveJobs: 5},
+				}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "list reference values",
+		method: http.MethodGet,
+		target: "/admin/reference-values",
+		setup: func(a *api) {
+			a.references.EXPECT().List(mock.Anything).Return([]*reference.Value{
+				{Kind: reference.KindLocation, Value: "Costa Rica", Position: 1, CreatedAt: timestamp},
+			}, nil).Once()
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "add reference value",
+		method: http.MethodPost,
+		target: "/admin/reference-values",
+		body:   `{"kind": "location", "value": "Nicaragua"}`,
+		setup: func(a *api) {
+			a.references.EXPECT().Create(mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, value *reference.Value) error {
+					value.Position, value.CreatedAt = 3, timestamp
+					return nil
+				}).Once()
+			a.references.EXPECT().List(mock.Anything).Return([]*reference.Value{
+				{Kind: reference.KindLocation, Value: "Nicaragua", Position: 3, CreatedAt: timestamp},
+			}, nil).Once()
+		},
+		status: http.StatusCreated,
+	},
+	{
+		name:   "add reference value of unknown kind",
+		method: http.MethodPost,
+		target: "/admin/reference-values",
+		body:   `{"kind": "seniority", "value": "Staff"}`,
+		status: http.StatusBadRequest,
+	},
+}
+
+// golang returns the Go technology
+func golang() *technology.Technology {
+	return &technology.Technology{ID: 1, Name: "go", Category: "Programming Language", CreatedAt: timestamp}
+}
+
+var embedCases = []contractCase{
+	{
+		name:   "get company openings to embed",
+		method: http.MethodGet,
+		target: "/embed/jobs?company=tech-corp&limit=5",
+		setup: func(a *api) {
+			a.db.ExpectQuery("FROM companies").
+				WithArgs("tech-corp").
+				WillReturnRows(pgxmock.NewRows([]string{"id", "name", "slug", "logo_url"}).
+					AddRow(3, "Tech Corp", "tech-corp", "https://techcorp.example.com/logo.png"))
+			a.db.ExpectQuery("FROM jobs").
+				WithArgs(3, 5).
+				WillReturnRows(pgxmock.NewRows([]string{
+					"id", "title", "experience_level", "employment_type", "location", "work_mode",
+					"application_url", "created_at",
+				}).AddRow(7, "Senior Go Developer", "Senior", "Full-time", "San José", "Hybrid",
+					"https://techcorp.example.com/careers/go", timestamp))
+		},
+		status: http.StatusOK,
+	},
+	{
+		name:   "get openings of unknown company to embed",
+		method: http.MethodGet,
+		target: "/embed/jobs?company=ghost",
+		setup: func(a *api) {
+			a.db.ExpectQuery("FROM companies").WithArgs("ghost").WillReturnError(pgx.ErrNoRows)
+		},
+		status: http.StatusNotFound,
+	},
+	{
+		name:   "get openings to embed in an unknown format",
+		method: http.MethodGet,
+		target: "/embed/jobs?company=tech-corp&format=xml",
+		status: http.StatusBadRequest,
+	},
+}
@@ -0,0 +1,159 @@
+// Package contracttest checks the API responses against the generated OpenAPI document, so the swagger
+// annotations can't silently drift from the actual payloads. The Swagger 2.0 document is converted to
+// OpenAPI 3 with kin-openapi, and every request served through a Validator must match a documented
+// operation with a documented response status and a response body matching its schema.
+package contracttest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi2"
+	"github.com/getkin/kin-openapi/openapi2conv"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+	"github.com/gin-gonic/gin"
+
+	"github.com/rodruizronald/ticos-in-tech/docs"
+)
+
+var (
+	documentOnce sync.Once
+	document     *openapi3.T
+	documentErr  error
+
+	// ginParam matches the path parameters of gin routes, :id or *path
+	ginParam = regexp.MustCompile(`[:*]([A-Za-z0-9_]+)`)
+)
+
+// Document returns the generated OpenAPI document converted to OpenAPI 3, served relative to its base path
+func Document() (*openapi3.T, error) {
+	documentOnce.Do(func() {
+		document, documentErr = loadDocument(context.Background())
+	})
+	return document, documentErr
+}
+
+// loadDocument converts and validates the generated document
+func loadDocument(ctx context.Context) (*openapi3.T, error) {
+	var doc2 openapi2.T
+	if err := json.Unmarshal([]byte(docs.SwaggerInfo.ReadDoc()), &doc2); err != nil {
+		return nil, fmt.Errorf("failed to parse the OpenAPI document: %w", err)
+	}
+
+	doc, err := openapi2conv.ToV3(&doc2)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert the OpenAPI document: %w", err)
+	}
+	if err = doc.Validate(ctx); err != nil {
+		return nil, fmt.Errorf("invalid OpenAPI document: %w", err)
+	}
+
+	// Requests are served without a host, match them by path only
+	doc.Servers = openapi3.Servers{{URL: docs.SwaggerInfo.BasePath}}
+	return doc, nil
+}
+
+// Validator serves requests and checks their responses against the documented operations
+type Validator struct {
+	doc    *openapi3.T
+	router routers.Router
+}
+
+// NewValidator creates a new instance of Validator. The test fails when the document is invalid.
+func NewValidator(t *testing.T) *Validator {
+	t.Helper()
+	doc, err := Document()
+	if err != nil {
+		t.Fatal(err)
+	}
+	router, err := legacy.NewRouter(doc)
+	if err != nil {
+		t.Fatalf("failed to route the OpenAPI document: %v", err)
+	}
+	return &Validator{doc: doc, router: router}
+}
+
+// Serve serves the request with the handler and fails the test when the request matches no documented
+// operation or the response doesn't match the operation
+func (v *Validator) Serve(t *testing.T, handler http.Handler, req *http.Request) *httptest.ResponseRecorder {
+	t.Helper()
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	route, pathParams, err := v.router.FindRoute(req)
+	if err != nil {
+		t.Errorf("%s %s is not documented: %v", req.Method, req.URL.Path, err)
+		return recorder
+	}
+
+	input := &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: &openapi3filter.RequestValidationInput{
+			Request:    req,
+			PathParams: pathParams,
+			Route:      route,
+		},
+		Status: recorder.Code,
+		Header: recorder.Header(),
+		Options: &openapi3filter.Options{
+			IncludeResponseStatus: true,
+			MultiError:            true,
+		},
+	}
+	input.SetBodyBytes(recorder.Body.Bytes())
+	if err = openapi3filter.ValidateResponse(req.Context(), input); err != nil {
+		t.Errorf("%s %s response doesn't match the documented operation: %v\nstatus %d, body %s",
+			req.Method, req.URL.Path, err, recorder.Code, recorder.Body.String())
+	}
+	return recorder
+}
+
+// Operation returns the documented operation matching the request, as "METHOD /path"
+func (v *Validator) Operation(req *http.Request) (string, bool) {
+	route, _, err := v.router.FindRoute(req)
+	if err != nil {
+		return "", false
+	}
+	return route.Method + " " + route.Path, true
+}
+
+// Operations returns all the documented operations, as "METHOD /path"
+func (v *Validator) Operations() []string {
+	var operations []string
+	for path, item := range v.doc.Paths.Map() {
+		for method := range item.Operations() {
+			operations = append(operations, method+" "+path)
+		}
+	}
+	sort.Strings(operations)
+	return operations
+}
+
+// Undocumented returns the routes served under the base path of the document that it doesn't document,
+// as "METHOD /path"
+func (v *Validator) Undocumented(routes gin.RoutesInfo) []string {
+	basePath := docs.SwaggerInfo.BasePath
+	var undocumented []string
+	for _, route := range routes {
+		path, ok := strings.CutPrefix(route.Path, basePath)
+		if !ok {
+			continue
+		}
+		path = ginParam.ReplaceAllString(path, "{$1}")
+		if item := v.doc.Paths.Value(path); item == nil || item.GetOperation(route.Method) == nil {
+			undocumented = append(undocumented, route.Method+" "+path)
+		}
+	}
+	sort.Strings(undocumented)
+	return undocumented
+}
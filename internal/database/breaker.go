@@ -0,0 +1,102 @@
+package database
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// Defaults for the circuit breaker
+const (
+	DefaultFailureThreshold = 5
+	DefaultOpenTimeout      = 30 * time.Second
+)
+
+// ErrCircuitOpen is returned while the circuit breaker rejects calls
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// BreakerConfig configures when the circuit breaker opens
+type BreakerConfig struct {
+	// FailureThreshold is the number of consecutive failures that opens the circuit
+	FailureThreshold int
+	// OpenTimeout is how long the circuit stays open before a trial call is let through
+	OpenTimeout time.Duration
+}
+
+// circuitState is the state of a circuit breaker
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+// CircuitBreaker stops calling a failing dependency for a while, so requests fail fast
+// instead of piling up on timeouts. After the open timeout a single trial call is let
+// through: its success closes the circuit again, its failure keeps it open.
+type CircuitBreaker struct {
+	mu       sync.Mutex
+	cfg      BreakerConfig
+	state    circuitState
+	failures int
+	openedAt time.Time
+	// trialInFlight is set while the trial call of a half-open circuit runs
+	trialInFlight bool
+	now           func() time.Time
+}
+
+// NewCircuitBreaker creates a new instance of CircuitBreaker
+func NewCircuitBreaker(cfg BreakerConfig) *CircuitBreaker {
+	if cfg.FailureThreshold <= 0 {
+		cfg.FailureThreshold = DefaultFailureThreshold
+	}
+	if cfg.OpenTimeout <= 0 {
+		cfg.OpenTimeout = DefaultOpenTimeout
+	}
+	return &CircuitBreaker{cfg: cfg, now: time.Now}
+}
+
+// Allow returns ErrCircuitOpen when a call must not be made. Every allowed call must be
+// followed by a call to Record with its outcome.
+func (b *CircuitBreaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case circuitOpen:
+		if b.now().Sub(b.openedAt) < b.cfg.OpenTimeout {
+			return ErrCircuitOpen
+		}
+		b.state = circuitHalfOpen
+		b.trialInFlight = true
+		return nil
+	case circuitHalfOpen:
+		if b.trialInFlight {
+			return ErrCircuitOpen
+		}
+		b.trialInFlight = true
+		return nil
+	default:
+		return nil
+	}
+}
+
+// Record records the outcome of an allowed call
+func (b *CircuitBreaker) Record(failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.trialInFlight = false
+	if !failed {
+		b.state = circuitClosed
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.state == circuitHalfOpen || b.failures >= b.cfg.FailureThreshold {
+		b.state = circuitOpen
+		b.openedAt = b.now()
+	}
+}
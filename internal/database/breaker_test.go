@@ -0,0 +1,44 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 1, 15, 6, 0, 0, 0, time.UTC)
+	breaker := NewCircuitBreaker(BreakerConfig{FailureThreshold: 2, OpenTimeout: time.Minute})
+	breaker.now = func() time.Time { return now }
+
+	// A success resets the consecutive failures
+	require.NoError(t, breaker.Allow())
+	breaker.Record(true)
+	require.NoError(t, breaker.Allow())
+	breaker.Record(false)
+	require.NoError(t, breaker.Allow())
+	breaker.Record(true)
+	require.NoError(t, breaker.Allow())
+
+	// The second consecutive failure opens the circuit
+	breaker.Record(true)
+	require.ErrorIs(t, breaker.Allow(), ErrCircuitOpen)
+
+	// After the open timeout a single trial call goes through, its failure opens the circuit again
+	now = now.Add(time.Minute)
+	require.NoError(t, breaker.Allow())
+	require.ErrorIs(t, breaker.Allow(), ErrCircuitOpen)
+	breaker.Record(true)
+	require.ErrorIs(t, breaker.Allow(), ErrCircuitOpen)
+
+	// A successful trial call closes the circuit
+	now = now.Add(time.Minute)
+	require.NoError(t, breaker.Allow())
+	breaker.Record(false)
+	require.NoError(t, breaker.Allow())
+	require.NoError(t, breaker.Allow())
+	assert.Equal(t, circuitClosed, breaker.state)
+}
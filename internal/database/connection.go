@@ -4,19 +4,89 @@ package database
 import (
 	"context"
 	"fmt"
+	"math"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// Defaults for the connection pool
+const (
+	DefaultMaxConns        = 10
+	DefaultMaxConnLifetime = time.Hour
+	DefaultMaxConnIdleTime = 30 * time.Minute
+	DefaultPingTimeout     = 5 * time.Second
+
+	DefaultStartupTimeout    = 30 * time.Second
+	DefaultStartupRetryDelay = 500 * time.Millisecond
+
+	// maxStartupRetryDelay caps the wait between the startup attempts
+	maxStartupRetryDelay = 5 * time.Second
+)
+
+// Statement cache modes, they select how queries are sent to the database
+const (
+	// StatementCacheModePrepare prepares every query once per connection, the fastest mode
+	StatementCacheModePrepare = "prepare"
+	// StatementCacheModeDescribe caches the description of the queries without keeping
+	// prepared statements, for poolers like PgBouncer in transaction mode
+	StatementCacheModeDescribe = "describe"
+	// StatementCacheModeNone describes every query before running it, caching nothing
+	StatementCacheModeNone = "none"
+)
+
+// queryExecModes maps the statement cache modes to the pgx query execution modes
+var queryExecModes = map[string]pgx.QueryExecMode{
+	StatementCacheModePrepare:  pgx.QueryExecModeCacheStatement,
+	StatementCacheModeDescribe: pgx.QueryExecModeCacheDescribe,
+	StatementCacheModeNone:     pgx.QueryExecModeDescribeExec,
+}
+
 // Config holds the configuration for the database connection.
 type Config struct {
-	Host     string
-	Port     int
-	User     string
-	Password string
-	DBName   string
-	SSLMode  string
+	// URL is a full connection string. When set, it takes precedence over the individual fields.
+	URL string
+	// ReplicaURL is the connection string of a read replica serving the public list queries.
+	// Without it, every query goes to the primary database.
+	ReplicaURL string
+	Host       string
+	Port       int
+	User       string
+	Password   string
+	DBName     string
+	SSLMode    string
+	// MaxConns is the maximum number of connections of the pool
+	MaxConns int
+	// MinConns is the number of connections the pool keeps open even when idle
+	MinConns int
+	// MaxConnLifetime is the time after which a connection is closed and replaced
+	MaxConnLifetime time.Duration
+	// MaxConnIdleTime is the time after which an idle connection is closed
+	MaxConnIdleTime time.Duration
+	// StatementCacheMode is one of the StatementCacheMode constants
+	StatementCacheMode string
+	// PingTimeout bounds the ping verifying the connection on startup
+	PingTimeout time.Duration
+	// StartupTimeout is how long Connect keeps retrying while the database can't be reached, so
+	// the server can start before the database is up. Zero fails on the first failed ping.
+	StartupTimeout time.Duration
+	// StartupRetryDelay is the wait before the first startup retry, doubled on every following one
+	StartupRetryDelay time.Duration
+	// HealthCheckInterval is the wait between the pings of the HealthMonitor of the server
+	HealthCheckInterval time.Duration
+	// OnStartupRetry is called when a startup attempt fails and is retried after delay. Optional.
+	OnStartupRetry func(attempt int, delay time.Duration, err error)
+	// Retry configures how queries failing with a transient error are retried
+	Retry RetryPolicy
+	// Breaker configures when queries stop being sent to an unreachable database
+	Breaker BreakerConfig
+	// LogQueries makes the server log every query at debug level, see QueryTracer
+	LogQueries bool
+	// LogQueryArgs adds the arguments to the logged queries
+	LogQueryArgs bool
+	// Tracer traces the queries of the pools, e.g. a QueryTracer. Optional.
+	Tracer pgx.QueryTracer
 }
 
 // DefaultConfig returns a default configuration for local development.
@@ -28,30 +98,77 @@ func DefaultConfig() Config {
 		Password: "postgres",
 		DBName:   "marketplace",
 		SSLMode:  "disable",
+
+		MaxConns:            DefaultMaxConns,
+		MaxConnLifetime:     DefaultMaxConnLifetime,
+		MaxConnIdleTime:     DefaultMaxConnIdleTime,
+		StatementCacheMode:  StatementCacheModePrepare,
+		PingTimeout:         DefaultPingTimeout,
+		StartupTimeout:      DefaultStartupTimeout,
+		StartupRetryDelay:   DefaultStartupRetryDelay,
+		HealthCheckInterval: DefaultHealthCheckInterval,
+		Retry: RetryPolicy{
+			MaxAttempts: DefaultRetryMaxAttempts,
+			BaseDelay:   DefaultRetryBaseDelay,
+			MaxDelay:    DefaultRetryMaxDelay,
+		},
+		Breaker: BreakerConfig{
+			FailureThreshold: DefaultFailureThreshold,
+			OpenTimeout:      DefaultOpenTimeout,
+		},
 	}
 }
 
 // ConnectionString returns a PostgreSQL connection string based on the configuration.
 func (c *Config) ConnectionString() string {
+	if c.URL != "" {
+		return c.URL
+	}
 	return fmt.Sprintf(
 		"postgres://%s:%s@%s:%d/%s?sslmode=%s",
 		c.User, c.Password, c.Host, c.Port, c.DBName, c.SSLMode,
 	)
 }
 
-// Connect establishes a connection to the PostgreSQL database.
-func Connect(ctx context.Context, config *Config) (*pgxpool.Pool, error) {
-	connString := config.ConnectionString()
+// PoolConfig returns the pgx pool configuration for the connection string and pool settings.
+func (c *Config) PoolConfig() (*pgxpool.Config, error) {
+	if c.MaxConns <= 0 || c.MaxConns > math.MaxInt32 {
+		return nil, fmt.Errorf("invalid max connections %d", c.MaxConns)
+	}
+	if c.MinConns < 0 || c.MinConns > c.MaxConns {
+		return nil, fmt.Errorf("invalid min connections %d, must be between 0 and %d", c.MinConns, c.MaxConns)
+	}
+	execMode, ok := queryExecModes[c.StatementCacheMode]
+	if !ok {
+		return nil, fmt.Errorf("invalid statement cache mode %q", c.StatementCacheMode)
+	}
 
-	poolConfig, err := pgxpool.ParseConfig(connString)
+	poolConfig, err := pgxpool.ParseConfig(c.ConnectionString())
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse connection string: %w", err)
 	}
 
-	// Set connection pool settings
-	poolConfig.MaxConns = 10
-	poolConfig.MaxConnLifetime = 1 * time.Hour
-	poolConfig.MaxConnIdleTime = 30 * time.Minute
+	poolConfig.MaxConns = int32(c.MaxConns)
+	poolConfig.MinConns = int32(c.MinConns)
+	if c.MaxConnLifetime > 0 {
+		poolConfig.MaxConnLifetime = c.MaxConnLifetime
+	}
+	if c.MaxConnIdleTime > 0 {
+		poolConfig.MaxConnIdleTime = c.MaxConnIdleTime
+	}
+	poolConfig.ConnConfig.DefaultQueryExecMode = execMode
+	poolConfig.ConnConfig.Tracer = c.Tracer
+
+	return poolConfig, nil
+}
+
+// Connect establishes a connection to the PostgreSQL database. While the database can't be
+// reached, the connection is retried with exponential backoff for up to StartupTimeout.
+func Connect(ctx context.Context, config *Config) (*pgxpool.Pool, error) {
+	poolConfig, err := config.PoolConfig()
+	if err != nil {
+		return nil, err
+	}
 
 	// Connect to the database
 	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
@@ -59,11 +176,90 @@ func Connect(ctx context.Context, config *Config) (*pgxpool.Pool, error) {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 
-	// Verify the connection
-	if err = pool.Ping(ctx); err != nil {
+	if err = waitForDatabase(ctx, pool.Ping, config, sleep); err != nil {
 		pool.Close()
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
 	return pool, nil
 }
+
+// waitForDatabase pings the database until it answers, retrying the failed pings while the
+// waits between them add up to less than the startup timeout
+func waitForDatabase(
+	ctx context.Context,
+	ping func(ctx context.Context) error,
+	config *Config,
+	sleep func(ctx context.Context, d time.Duration) error,
+) error {
+	// Verify the connection, without waiting forever on an unreachable database
+	pingTimeout := config.PingTimeout
+	if pingTimeout <= 0 {
+		pingTimeout = DefaultPingTimeout
+	}
+	delay := config.StartupRetryDelay
+	if delay <= 0 {
+		delay = DefaultStartupRetryDelay
+	}
+
+	var waited time.Duration
+	for attempt := 1; ; attempt++ {
+		pingCtx, cancel := context.WithTimeout(ctx, pingTimeout)
+		err := ping(pingCtx)
+		cancel()
+		if err == nil || ctx.Err() != nil || waited+delay > config.StartupTimeout {
+			return err
+		}
+
+		if config.OnStartupRetry != nil {
+			config.OnStartupRetry(attempt, delay, err)
+		}
+		if sleepErr := sleep(ctx, delay); sleepErr != nil {
+			return err
+		}
+		waited += delay
+		delay = min(delay*2, maxStartupRetryDelay)
+	}
+}
+
+// Pools holds the connection pools of the primary database and of its read replica.
+type Pools struct {
+	Primary *pgxpool.Pool
+	// Replica is the read replica pool, the primary pool when no replica is configured
+	Replica *pgxpool.Pool
+}
+
+// HasReplica reports whether a read replica is configured
+func (p *Pools) HasReplica() bool {
+	return p.Replica != p.Primary
+}
+
+// Close closes the pools
+func (p *Pools) Close() {
+	if p.HasReplica() {
+		p.Replica.Close()
+	}
+	p.Primary.Close()
+}
+
+// ConnectPools connects to the primary database and, when ReplicaURL is set, to its read replica.
+// Both pools share the pool settings.
+func ConnectPools(ctx context.Context, config *Config) (*Pools, error) {
+	primary, err := Connect(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	if config.ReplicaURL == "" {
+		return &Pools{Primary: primary, Replica: primary}, nil
+	}
+
+	replicaConfig := *config
+	replicaConfig.URL = config.ReplicaURL
+	replica, err := Connect(ctx, &replicaConfig)
+	if err != nil {
+		primary.Close()
+		return nil, fmt.Errorf("read replica: %w", err)
+	}
+
+	return &Pools{Primary: primary, Replica: replica}, nil
+}
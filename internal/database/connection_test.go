@@ -0,0 +1,186 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/metrics"
+)
+
+func TestConfig_PoolConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		modify       func(cfg *Config)
+		checkResults func(t *testing.T, poolConfig *pgxpool.Config, err error)
+	}{
+		{
+			name:   "defaults",
+			modify: func(*Config) {},
+			checkResults: func(t *testing.T, poolConfig *pgxpool.Config, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, int32(DefaultMaxConns), poolConfig.MaxConns)
+				assert.Zero(t, poolConfig.MinConns)
+				assert.Equal(t, DefaultMaxConnLifetime, poolConfig.MaxConnLifetime)
+				assert.Equal(t, DefaultMaxConnIdleTime, poolConfig.MaxConnIdleTime)
+				assert.Equal(t, pgx.QueryExecModeCacheStatement, poolConfig.ConnConfig.DefaultQueryExecMode)
+				assert.Nil(t, poolConfig.ConnConfig.Tracer)
+			},
+		},
+		{
+			name: "tuned pool",
+			modify: func(cfg *Config) {
+				cfg.MaxConns = 25
+				cfg.MinConns = 5
+				cfg.MaxConnLifetime = 15 * time.Minute
+				cfg.MaxConnIdleTime = time.Minute
+				cfg.StatementCacheMode = StatementCacheModeDescribe
+				cfg.Tracer = NewQueryTracer(func(context.Context, *QueryLog) {}, false)
+			},
+			checkResults: func(t *testing.T, poolConfig *pgxpool.Config, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, int32(25), poolConfig.MaxConns)
+				assert.Equal(t, int32(5), poolConfig.MinConns)
+				assert.Equal(t, 15*time.Minute, poolConfig.MaxConnLifetime)
+				assert.Equal(t, time.Minute, poolConfig.MaxConnIdleTime)
+				assert.Equal(t, pgx.QueryExecModeCacheDescribe, poolConfig.ConnConfig.DefaultQueryExecMode)
+				assert.IsType(t, &QueryTracer{}, poolConfig.ConnConfig.Tracer)
+			},
+		},
+		{
+			name:   "min connections above max",
+			modify: func(cfg *Config) { cfg.MinConns = 20 },
+			checkResults: func(t *testing.T, _ *pgxpool.Config, err error) {
+				t.Helper()
+				require.ErrorContains(t, err, "invalid min connections")
+			},
+		},
+		{
+			name:   "invalid statement cache mode",
+			modify: func(cfg *Config) { cfg.StatementCacheMode = "sometimes" },
+			checkResults: func(t *testing.T, _ *pgxpool.Config, err error) {
+				t.Helper()
+				require.ErrorContains(t, err, "invalid statement cache mode")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cfg := DefaultConfig()
+			tt.modify(&cfg)
+
+			poolConfig, err := cfg.PoolConfig()
+			tt.checkResults(t, poolConfig, err)
+		})
+	}
+}
+
+func TestWaitForDatabase(t *testing.T) {
+	t.Parallel()
+	refused := errors.New("connection refused")
+
+	tests := []struct {
+		name           string
+		startupTimeout time.Duration
+		failures       int
+		wantErr        error
+		wantPings      int
+		wantDelays     []time.Duration
+	}{
+		{
+			name:           "database up",
+			startupTimeout: 10 * time.Second,
+			wantPings:      1,
+		},
+		{
+			name:           "database comes up",
+			startupTimeout: 10 * time.Second,
+			failures:       3,
+			wantPings:      4,
+			wantDelays:     []time.Duration{time.Second, 2 * time.Second, 4 * time.Second},
+		},
+		{
+			name:           "startup timeout",
+			startupTimeout: 10 * time.Second,
+			failures:       10,
+			wantErr:        refused,
+			wantPings:      4,
+			wantDelays:     []time.Duration{time.Second, 2 * time.Second, 4 * time.Second},
+		},
+		{
+			name:      "no startup retries",
+			failures:  1,
+			wantErr:   refused,
+			wantPings: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			pings := 0
+			ping := func(context.Context) error {
+				pings++
+				if pings <= tt.failures {
+					return refused
+				}
+				return nil
+			}
+			var delays, retried []time.Duration
+			fakeSleep := func(_ context.Context, d time.Duration) error {
+				delays = append(delays, d)
+				return nil
+			}
+			cfg := DefaultConfig()
+			cfg.StartupTimeout = tt.startupTimeout
+			cfg.StartupRetryDelay = time.Second
+			cfg.OnStartupRetry = func(_ int, delay time.Duration, err error) {
+				assert.ErrorIs(t, err, refused)
+				retried = append(retried, delay)
+			}
+
+			err := waitForDatabase(context.Background(), ping, &cfg, fakeSleep)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantPings, pings)
+			assert.Equal(t, tt.wantDelays, delays)
+			assert.Equal(t, tt.wantDelays, retried)
+		})
+	}
+}
+
+func TestPoolCollector(t *testing.T) {
+	t.Parallel()
+	cfg := DefaultConfig()
+	poolConfig, err := cfg.PoolConfig()
+	require.NoError(t, err)
+
+	// The pool only connects when a connection is acquired
+	pool, err := pgxpool.NewWithConfig(context.Background(), poolConfig)
+	require.NoError(t, err)
+	defer pool.Close()
+
+	registry := metrics.NewRegistry()
+	registry.Register(PoolCollector(pool, "primary"))
+
+	var sb strings.Builder
+	require.NoError(t, registry.WriteText(&sb))
+	assert.Contains(t, sb.String(), "# TYPE db_pool_max_conns gauge\ndb_pool_max_conns{pool=\"primary\"} 10\n")
+	assert.Contains(t, sb.String(), "db_pool_acquires_total{pool=\"primary\"} 0\n")
+}
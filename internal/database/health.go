@@ -0,0 +1,122 @@
+package database
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultHealthCheckInterval is the wait between the pings of the health monitor
+const DefaultHealthCheckInterval = 10 * time.Second
+
+// Pinger is a database answering pings, such as a pgx pool
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// HealthConfig configures the health monitor
+type HealthConfig struct {
+	// Interval is the wait between the pings
+	Interval time.Duration
+	// Timeout bounds every ping
+	Timeout time.Duration
+	// OnChange is called when the database becomes unreachable or reachable again. Optional.
+	OnChange func(health Health)
+}
+
+// Health is the state of the connection to a database
+type Health struct {
+	// Healthy reports whether the database answered the last ping
+	Healthy bool
+	// Since is when the database became healthy or unhealthy
+	Since time.Time
+	// CheckedAt is when the database was last pinged
+	CheckedAt time.Time
+	// Err is the error of the last ping, nil when healthy
+	Err error
+	// Reconnections counts the times the database became reachable again after being lost
+	Reconnections int
+}
+
+// HealthMonitor pings a database at regular intervals and keeps the state of the connection, so
+// readiness probes don't hit the database. It starts healthy, as it is created once connected.
+type HealthMonitor struct {
+	pinger Pinger
+	cfg    HealthConfig
+	now    func() time.Time
+
+	mu     sync.RWMutex
+	health Health
+}
+
+// NewHealthMonitor creates a new instance of HealthMonitor pinging pinger. Run must be started
+// for the state to be updated.
+func NewHealthMonitor(pinger Pinger, cfg HealthConfig) *HealthMonitor {
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultHealthCheckInterval
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultPingTimeout
+	}
+	now := time.Now()
+	return &HealthMonitor{
+		pinger: pinger,
+		cfg:    cfg,
+		now:    time.Now,
+		health: Health{Healthy: true, Since: now, CheckedAt: now},
+	}
+}
+
+// Health returns the state of the connection as of the last ping
+func (m *HealthMonitor) Health() Health {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.health
+}
+
+// Check pings the database and returns the updated state of the connection. The state is left
+// unchanged when ctx is canceled.
+func (m *HealthMonitor) Check(ctx context.Context) Health {
+	pingCtx, cancel := context.WithTimeout(ctx, m.cfg.Timeout)
+	err := m.pinger.Ping(pingCtx)
+	cancel()
+	if err != nil && ctx.Err() != nil {
+		// The caller gave up, which says nothing about the database
+		return m.Health()
+	}
+
+	m.mu.Lock()
+	now := m.now()
+	changed := m.health.Healthy != (err == nil)
+	if changed {
+		m.health.Since = now
+		if err == nil {
+			m.health.Reconnections++
+		}
+	}
+	m.health.Healthy = err == nil
+	m.health.CheckedAt = now
+	m.health.Err = err
+	health := m.health
+	m.mu.Unlock()
+
+	if changed && m.cfg.OnChange != nil {
+		m.cfg.OnChange(health)
+	}
+	return health
+}
+
+// Run pings the database every interval until ctx is canceled.
+func (m *HealthMonitor) Run(ctx context.Context) error {
+	ticker := time.NewTicker(m.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			m.Check(ctx)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pingFunc is a Pinger calling itself
+type pingFunc func(ctx context.Context) error
+
+func (f pingFunc) Ping(ctx context.Context) error {
+	return f(ctx)
+}
+
+func TestHealthMonitor_Check(t *testing.T) {
+	t.Parallel()
+	refused := errors.New("connection refused")
+	pings := []error{nil, refused, refused, nil}
+	ping := pingFunc(func(context.Context) error {
+		err := pings[0]
+		pings = pings[1:]
+		return err
+	})
+
+	var changes []Health
+	monitor := NewHealthMonitor(ping, HealthConfig{OnChange: func(health Health) {
+		changes = append(changes, health)
+	}})
+	clock := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	monitor.now = func() time.Time {
+		clock = clock.Add(time.Minute)
+		return clock
+	}
+	require.True(t, monitor.Health().Healthy)
+
+	health := monitor.Check(context.Background())
+	assert.True(t, health.Healthy)
+	assert.Empty(t, changes)
+
+	health = monitor.Check(context.Background())
+	assert.False(t, health.Healthy)
+	require.ErrorIs(t, health.Err, refused)
+	lostAt := health.Since
+	assert.Equal(t, clock, lostAt)
+
+	health = monitor.Check(context.Background())
+	assert.False(t, health.Healthy)
+	assert.Equal(t, lostAt, health.Since)
+	assert.Equal(t, clock, health.CheckedAt)
+
+	health = monitor.Check(context.Background())
+	assert.True(t, health.Healthy)
+	require.NoError(t, health.Err)
+	assert.Equal(t, 1, health.Reconnections)
+	assert.Equal(t, health, monitor.Health())
+
+	require.Len(t, changes, 2)
+	assert.False(t, changes[0].Healthy)
+	assert.True(t, changes[1].Healthy)
+}
+
+func TestHealthMonitor_CheckCanceled(t *testing.T) {
+	t.Parallel()
+	monitor := NewHealthMonitor(pingFunc(func(ctx context.Context) error {
+		return ctx.Err()
+	}), HealthConfig{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.True(t, monitor.Check(ctx).Healthy)
+}
@@ -0,0 +1,144 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// DefaultReconnectDelay is the wait before the listener reconnects after losing its connection
+const DefaultReconnectDelay = 5 * time.Second
+
+// ListenerConfig configures the notification listener
+type ListenerConfig struct {
+	// ReconnectDelay is the wait before reconnecting after the connection is lost
+	ReconnectDelay time.Duration
+	// OnError is called when the connection can't be established or is lost. Optional.
+	OnError func(err error)
+}
+
+// listenConn is a connection receiving notifications
+type listenConn interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	WaitForNotification(ctx context.Context) (*pgconn.Notification, error)
+	Release()
+}
+
+// Listener receives the notifications sent with NOTIFY on a set of channels and passes their
+// payload to the channel handlers. It holds a dedicated connection, reconnecting when it is lost.
+// Notifications sent while disconnected are missed, so after a reconnection every handler is
+// called with an empty payload, meaning anything may have changed.
+type Listener struct {
+	connect  func(ctx context.Context) (listenConn, error)
+	handlers map[string][]func(payload string)
+	cfg      ListenerConfig
+	sleep    func(ctx context.Context, d time.Duration) error
+}
+
+// NewListener creates a new instance of Listener on a connection of pool. Run must be started
+// for the notifications to be received.
+func NewListener(pool *pgxpool.Pool, cfg ListenerConfig) *Listener {
+	return newListener(func(ctx context.Context) (listenConn, error) {
+		conn, err := pool.Acquire(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &poolListenConn{conn: conn}, nil
+	}, cfg)
+}
+
+// newListener creates a new instance of Listener getting its connections from connect
+func newListener(connect func(ctx context.Context) (listenConn, error), cfg ListenerConfig) *Listener {
+	if cfg.ReconnectDelay <= 0 {
+		cfg.ReconnectDelay = DefaultReconnectDelay
+	}
+	return &Listener{
+		connect:  connect,
+		handlers: make(map[string][]func(payload string)),
+		cfg:      cfg,
+		sleep:    sleep,
+	}
+}
+
+// Handle registers a handler of the notifications of channel. Handlers must be registered
+// before Run is started, and must return quickly.
+func (l *Listener) Handle(channel string, handler func(payload string)) {
+	l.handlers[channel] = append(l.handlers[channel], handler)
+}
+
+// Run listens on the channels with a handler until ctx is canceled.
+func (l *Listener) Run(ctx context.Context) error {
+	for connected := false; ; connected = true {
+		err := l.listen(ctx, connected)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if l.cfg.OnError != nil {
+			l.cfg.OnError(err)
+		}
+		if l.sleep(ctx, l.cfg.ReconnectDelay) != nil {
+			return nil
+		}
+	}
+}
+
+// listen connects, listens on the channels and dispatches the notifications until the
+// connection fails. reconnected is set when a previous connection was lost.
+func (l *Listener) listen(ctx context.Context, reconnected bool) error {
+	conn, err := l.connect(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to connect listener: %w", err)
+	}
+	defer conn.Release()
+
+	for channel := range l.handlers {
+		if _, err = conn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize()); err != nil {
+			return fmt.Errorf("failed to listen on channel %s: %w", channel, err)
+		}
+	}
+
+	if reconnected {
+		for _, handlers := range l.handlers {
+			for _, handle := range handlers {
+				handle("")
+			}
+		}
+	}
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to wait for notification: %w", err)
+		}
+		for _, handle := range l.handlers[notification.Channel] {
+			handle(notification.Payload)
+		}
+	}
+}
+
+// poolListenConn is a pool connection receiving notifications
+type poolListenConn struct {
+	conn *pgxpool.Conn
+}
+
+// Exec runs a statement on the connection
+func (c *poolListenConn) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	return c.conn.Exec(ctx, sql, args...)
+}
+
+// WaitForNotification waits for a notification on the connection
+func (c *poolListenConn) WaitForNotification(ctx context.Context) (*pgconn.Notification, error) {
+	return c.conn.Conn().WaitForNotification(ctx)
+}
+
+// Release closes the connection. It may be broken or still listening, so it is taken out of
+// the pool instead of going back to it.
+func (c *poolListenConn) Release() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_ = c.conn.Hijack().Close(ctx)
+}
@@ -0,0 +1,85 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeListenConn serves queued notifications, then fails with err
+type fakeListenConn struct {
+	listened      []string
+	notifications []*pgconn.Notification
+	err           error
+	released      bool
+}
+
+func (c *fakeListenConn) Exec(_ context.Context, sql string, _ ...any) (pgconn.CommandTag, error) {
+	c.listened = append(c.listened, sql)
+	return pgconn.NewCommandTag("LISTEN"), nil
+}
+
+func (c *fakeListenConn) WaitForNotification(ctx context.Context) (*pgconn.Notification, error) {
+	if len(c.notifications) > 0 {
+		notification := c.notifications[0]
+		c.notifications = c.notifications[1:]
+		return notification, nil
+	}
+	if c.err != nil {
+		return nil, c.err
+	}
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (c *fakeListenConn) Release() {
+	c.released = true
+}
+
+func TestListener_Run(t *testing.T) {
+	t.Parallel()
+	connError := errors.New("connection reset")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	first := &fakeListenConn{
+		notifications: []*pgconn.Notification{
+			{Channel: "job_changes", Payload: "jobs:INSERT"},
+			{Channel: "other", Payload: "ignored"},
+		},
+		err: connError,
+	}
+	second := &fakeListenConn{notifications: []*pgconn.Notification{{Channel: "job_changes", Payload: "jobs:DELETE"}}}
+	conns := []*fakeListenConn{first, second}
+
+	var errs []error
+	listener := newListener(func(_ context.Context) (listenConn, error) {
+		conn := conns[0]
+		conns = conns[1:]
+		return conn, nil
+	}, ListenerConfig{OnError: func(err error) { errs = append(errs, err) }})
+	listener.sleep = func(context.Context, time.Duration) error { return nil }
+
+	var payloads []string
+	listener.Handle("job_changes", func(payload string) {
+		payloads = append(payloads, payload)
+		if len(payloads) == 3 {
+			cancel()
+		}
+	})
+
+	require.NoError(t, listener.Run(ctx))
+
+	// The empty payload follows the reconnection
+	assert.Equal(t, []string{"jobs:INSERT", "", "jobs:DELETE"}, payloads)
+	assert.Equal(t, []string{`LISTEN "job_changes"`}, first.listened)
+	assert.True(t, first.released)
+	assert.True(t, second.released)
+	require.Len(t, errs, 1)
+	require.ErrorIs(t, errs[0], connError)
+}
@@ -0,0 +1,175 @@
+package database
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/metrics"
+)
+
+// PoolCollector returns a collector of the connection pool statistics, labeled with the pool name
+func PoolCollector(pool *pgxpool.Pool, name string) metrics.Collector {
+	return metrics.CollectorFunc(func() []metrics.Metric {
+		stat := pool.Stat()
+		poolMetrics := []metrics.Metric{
+			{Name: "db_pool_max_conns", Help: "Maximum number of connections of the pool.",
+				Type: metrics.Gauge, Value: float64(stat.MaxConns())},
+			{Name: "db_pool_total_conns", Help: "Number of open connections.",
+				Type: metrics.Gauge, Value: float64(stat.TotalConns())},
+			{Name: "db_pool_acquired_conns", Help: "Number of connections in use.",
+				Type: metrics.Gauge, Value: float64(stat.AcquiredConns())},
+			{Name: "db_pool_idle_conns", Help: "Number of idle connections.",
+				Type: metrics.Gauge, Value: float64(stat.IdleConns())},
+			{Name: "db_pool_constructing_conns", Help: "Number of connections being opened.",
+				Type: metrics.Gauge, Value: float64(stat.ConstructingConns())},
+			{Name: "db_pool_acquires_total", Help: "Number of connections acquired from the pool.",
+				Type: metrics.Counter, Value: float64(stat.AcquireCount())},
+			{Name: "db_pool_acquire_duration_seconds_total", Help: "Time spent acquiring connections.",
+				Type: metrics.Counter, Value: stat.AcquireDuration().Seconds()},
+			{Name: "db_pool_empty_acquires_total", Help: "Number of acquires that waited for a free connection.",
+				Type: metrics.Counter, Value: float64(stat.EmptyAcquireCount())},
+			{Name: "db_pool_canceled_acquires_total", Help: "Number of acquires canceled by their context.",
+				Type: metrics.Counter, Value: float64(stat.CanceledAcquireCount())},
+			{Name: "db_pool_new_conns_total", Help: "Number of connections opened.",
+				Type: metrics.Counter, Value: float64(stat.NewConnsCount())},
+			{Name: "db_pool_max_lifetime_closed_conns_total", Help: "Number of connections closed for their lifetime.",
+				Type: metrics.Counter, Value: float64(stat.MaxLifetimeDestroyCount())},
+			{Name: "db_pool_max_idle_closed_conns_total", Help: "Number of connections closed for being idle.",
+				Type: metrics.Counter, Value: float64(stat.MaxIdleDestroyCount())},
+		}
+		for i := range poolMetrics {
+			poolMetrics[i].Labels = map[string]string{"pool": name}
+		}
+		return poolMetrics
+	})
+}
+
+// statementName identifies the queries counted by a QueryCounter
+type statementName struct {
+	operation string
+	table     string
+}
+
+// queryCount holds the counters of the queries of a statement name
+type queryCount struct {
+	queries  int64
+	errors   int64
+	duration time.Duration
+}
+
+// QueryCounter is a pgx.QueryTracer counting the queries run by the pools by operation and table,
+// e.g. select on jobs, so the effect of the caches on the database load can be measured. It is a
+// metrics.Collector of the counts.
+type QueryCounter struct {
+	mu     sync.Mutex
+	counts map[statementName]*queryCount
+	now    func() time.Time
+}
+
+// queryCounterStartKey holds the statement name of a counted query and when it started in its context
+type queryCounterStartKey struct{}
+
+// queryCounterStart is the statement name of a counted query and when it started
+type queryCounterStart struct {
+	name statementName
+	at   time.Time
+}
+
+// NewQueryCounter creates a new instance of QueryCounter
+func NewQueryCounter() *QueryCounter {
+	return &QueryCounter{counts: make(map[statementName]*queryCount), now: time.Now}
+}
+
+// TraceQueryStart records the statement name of a query and when it started in its context
+func (q *QueryCounter) TraceQueryStart(ctx context.Context, _ *pgx.Conn,
+	data pgx.TraceQueryStartData) context.Context {
+	start := &queryCounterStart{name: nameStatement(data.SQL), at: q.now()}
+	return context.WithValue(ctx, queryCounterStartKey{}, start)
+}
+
+// TraceQueryEnd counts the query, with its duration and whether it failed
+func (q *QueryCounter) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	start, ok := ctx.Value(queryCounterStartKey{}).(*queryCounterStart)
+	if !ok {
+		return
+	}
+	duration := q.now().Sub(start.at)
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	count, ok := q.counts[start.name]
+	if !ok {
+		count = &queryCount{}
+		q.counts[start.name] = count
+	}
+	count.queries++
+	count.duration += duration
+	if data.Err != nil {
+		count.errors++
+	}
+}
+
+// Collect returns the query counts, labeled with their operation and table
+func (q *QueryCounter) Collect() []metrics.Metric {
+	q.mu.Lock()
+	names := make([]statementName, 0, len(q.counts))
+	counts := make(map[statementName]queryCount, len(q.counts))
+	for name, count := range q.counts {
+		names = append(names, name)
+		counts[name] = *count
+	}
+	q.mu.Unlock()
+
+	sort.Slice(names, func(i, j int) bool {
+		if names[i].table != names[j].table {
+			return names[i].table < names[j].table
+		}
+		return names[i].operation < names[j].operation
+	})
+
+	queryMetrics := make([]metrics.Metric, 0, 3*len(names))
+	for _, name := range names {
+		labels := map[string]string{"operation": name.operation, "table": name.table}
+		count := counts[name]
+		queryMetrics = append(queryMetrics,
+			metrics.Metric{Name: "db_queries_total", Help: "Number of queries run, by operation and table.",
+				Type: metrics.Counter, Labels: labels, Value: float64(count.queries)},
+			metrics.Metric{Name: "db_query_errors_total", Help: "Number of failed queries, by operation and table.",
+				Type: metrics.Counter, Labels: labels, Value: float64(count.errors)},
+			metrics.Metric{Name: "db_query_duration_seconds_total",
+				Help: "Time spent running queries, by operation and table.",
+				Type: metrics.Counter, Labels: labels, Value: count.duration.Seconds()},
+		)
+	}
+	return queryMetrics
+}
+
+// nameStatement names a statement by its first keyword and the first table it reads or writes,
+// e.g. select on jobs for "SELECT ... FROM jobs j JOIN companies c ...". Statements without a
+// table, like "SELECT 1", get the table "none".
+func nameStatement(sql string) statementName {
+	fields := strings.Fields(strings.ToLower(sql))
+	if len(fields) == 0 {
+		return statementName{operation: "none", table: "none"}
+	}
+
+	name := statementName{operation: fields[0], table: "none"}
+	if name.operation == "refresh" {
+		// REFRESH MATERIALIZED VIEW [CONCURRENTLY] name
+		name.table = strings.Trim(fields[len(fields)-1], `";`)
+		return name
+	}
+	for i, field := range fields[:len(fields)-1] {
+		if field == "from" || field == "into" || (field == "update" && i == 0) {
+			name.table = strings.Trim(fields[i+1], `"(),;`)
+			break
+		}
+	}
+	return name
+}
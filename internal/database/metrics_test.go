@@ -0,0 +1,67 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/metrics"
+)
+
+func TestQueryCounter(t *testing.T) {
+	t.Parallel()
+	counter := NewQueryCounter()
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	counter.now = func() time.Time { return now }
+
+	run := func(sql string, err error) {
+		ctx := counter.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{SQL: sql})
+		now = now.Add(100 * time.Millisecond)
+		counter.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{Err: err})
+	}
+	run("SELECT id, title FROM jobs WHERE id = $1", nil)
+	run("SELECT COUNT(*) FROM jobs", errors.New("canceled"))
+	run("UPDATE companies SET is_active = false WHERE id = $1", nil)
+
+	labels := map[string]string{"operation": "select", "table": "jobs"}
+	collected := counter.Collect()
+	require.Len(t, collected, 6)
+	assert.Equal(t, metrics.Metric{Name: "db_queries_total", Help: "Number of queries run, by operation and table.",
+		Type: metrics.Counter, Labels: map[string]string{"operation": "update", "table": "companies"}, Value: 1},
+		collected[0])
+	assert.Equal(t, labels, collected[3].Labels)
+	assert.InDelta(t, 2, collected[3].Value, 0)
+	assert.InDelta(t, 1, collected[4].Value, 0)
+	assert.InDelta(t, 0.2, collected[5].Value, 1e-9)
+}
+
+func TestNameStatement(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		sql      string
+		expected statementName
+	}{
+		{"SELECT j.id FROM jobs j JOIN companies c ON j.company_id = c.id", statementName{"select", "jobs"}},
+		{"\n        INSERT INTO job_alerts (user_id) VALUES ($1)", statementName{"insert", "job_alerts"}},
+		{"UPDATE jobs SET is_active = false WHERE id = $1", statementName{"update", "jobs"}},
+		{"DELETE FROM link_checks lc USING jobs j", statementName{"delete", "link_checks"}},
+		{"WITH due AS (SELECT id FROM outbox) UPDATE outbox SET attempts = 1", statementName{"with", "outbox"}},
+		{"REFRESH MATERIALIZED VIEW CONCURRENTLY job_search_view", statementName{"refresh", "job_search_view"}},
+		{`SELECT COUNT(*) FROM "jobs"`, statementName{"select", "jobs"}},
+		{"SELECT pg_export_snapshot()", statementName{"select", "none"}},
+		{"", statementName{"none", "none"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, nameStatement(tt.sql))
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// Defaults for the retry policy
+const (
+	DefaultRetryMaxAttempts = 3
+	DefaultRetryBaseDelay   = 50 * time.Millisecond
+	DefaultRetryMaxDelay    = time.Second
+
+	// serviceName names the database in ServiceUnavailableError
+	serviceName = "database"
+)
+
+// PostgreSQL error codes of transient failures
+const (
+	serializationFailure = "40001"
+	deadlockDetected     = "40P01"
+	tooManyConnections   = "53300"
+	cannotConnectNow     = "57P03"
+)
+
+// RetryPolicy configures how calls failing with a transient error are retried
+type RetryPolicy struct {
+	// MaxAttempts is the number of attempts of a call, the first one included. 1 disables retries.
+	MaxAttempts int
+	// BaseDelay is the wait before the first retry, doubled on every following retry
+	BaseDelay time.Duration
+	// MaxDelay caps the wait between retries
+	MaxDelay time.Duration
+}
+
+// Pool interface of the pgx pool methods used by the repositories.
+type Pool interface {
+	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
+	Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// ResilientDB wraps a pool so repositories don't deal with transient failures. Calls failing with
+// a serialization failure, a deadlock or a connection error raised before the query was sent are
+// retried with exponential backoff. Connection failures open a circuit breaker, and while the
+// database is unreachable calls fail with an httpservice.ServiceUnavailableError instead of the
+// driver error. It satisfies the Database interface of every repository.
+type ResilientDB struct {
+	pool    Pool
+	retry   RetryPolicy
+	breaker *CircuitBreaker
+	sleep   func(ctx context.Context, d time.Duration) error
+}
+
+// NewResilientDB creates a new instance of ResilientDB calling pool
+func NewResilientDB(pool Pool, retry RetryPolicy, breaker BreakerConfig) *ResilientDB {
+	if retry.MaxAttempts <= 0 {
+		retry.MaxAttempts = DefaultRetryMaxAttempts
+	}
+	if retry.BaseDelay <= 0 {
+		retry.BaseDelay = DefaultRetryBaseDelay
+	}
+	if retry.MaxDelay <= 0 {
+		retry.MaxDelay = DefaultRetryMaxDelay
+	}
+
+	return &ResilientDB{
+		pool:    pool,
+		retry:   retry,
+		breaker: NewCircuitBreaker(breaker),
+		sleep:   sleep,
+	}
+}
+
+// QueryRow runs a query expected to return at most one row. The query runs when Scan is called.
+func (db *ResilientDB) QueryRow(ctx context.Context, query string, args ...any) pgx.Row {
+	return &resilientRow{db: db, ctx: ctx, query: query, args: args}
+}
+
+// Exec runs a query that returns no rows
+func (db *ResilientDB) Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error) {
+	var commandTag pgconn.CommandTag
+	err := db.do(ctx, func() error {
+		var err error
+		commandTag, err = db.pool.Exec(ctx, query, args...)
+		return err
+	})
+	return commandTag, err
+}
+
+// Query runs a query that returns rows. Only the failures of sending the query are retried,
+// the ones happening while reading the rows are returned by rows.Err.
+func (db *ResilientDB) Query(ctx context.Context, query string, args ...any) (pgx.Rows, error) {
+	var rows pgx.Rows
+	err := db.do(ctx, func() error {
+		var err error
+		rows, err = db.pool.Query(ctx, query, args...)
+		return err
+	})
+	return rows, err
+}
+
+// Begin starts a transaction. The statements of the transaction are not retried.
+func (db *ResilientDB) Begin(ctx context.Context) (pgx.Tx, error) {
+	var tx pgx.Tx
+	err := db.do(ctx, func() error {
+		var err error
+		tx, err = db.pool.Begin(ctx)
+		return err
+	})
+	return tx, err
+}
+
+// do calls fn through the circuit breaker, retrying transient failures
+func (db *ResilientDB) do(ctx context.Context, fn func() error) error {
+	delay := db.retry.BaseDelay
+	for attempt := 1; ; attempt++ {
+		if err := db.breaker.Allow(); err != nil {
+			return &httpservice.ServiceUnavailableError{Service: serviceName, Err: err}
+		}
+
+		err := fn()
+		if err != nil && ctx.Err() != nil && !errors.Is(err, ctx.Err()) {
+			// The database canceled the query because the caller gave up, let the caller know
+			err = fmt.Errorf("%w: %w", ctx.Err(), err)
+		}
+		unavailable := isConnectionError(ctx, err)
+		db.breaker.Record(unavailable)
+
+		if err == nil || attempt >= db.retry.MaxAttempts || !isTransient(err) ||
+			db.sleep(ctx, delay) != nil {
+			if unavailable {
+				return &httpservice.ServiceUnavailableError{Service: serviceName, Err: err}
+			}
+			return err
+		}
+		delay = min(delay*2, db.retry.MaxDelay)
+	}
+}
+
+// isTransient reports whether a call failing with err can be retried: the query was rolled back
+// by a serialization failure or a deadlock, or it was never sent to the database.
+func isTransient(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case serializationFailure, deadlockDetected, tooManyConnections, cannotConnectNow:
+			return true
+		}
+		return false
+	}
+	return pgconn.SafeToRetry(err)
+}
+
+// isConnectionError reports whether err means the database can't be reached or can't serve
+// requests, as opposed to a query error or the caller giving up
+func isConnectionError(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil || errors.Is(err, pgx.ErrNoRows) {
+		return false
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		// Class 08 are connection exceptions, 53 insufficient resources and 57 operator intervention
+		switch pgErr.Code[:2] {
+		case "08", "53", "57":
+			return true
+		}
+		return false
+	}
+
+	var connectErr *pgconn.ConnectError
+	var netErr net.Error
+	return errors.As(err, &connectErr) || errors.As(err, &netErr) || pgconn.SafeToRetry(err) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// sleep waits for d or until ctx is canceled
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// resilientRow runs the query of QueryRow when it is scanned
+type resilientRow struct {
+	db    *ResilientDB
+	ctx   context.Context //nolint:containedctx // pgx.Row has no context, the query runs on Scan
+	query string
+	args  []any
+}
+
+// Scan runs the query and scans its first row into dest
+func (r *resilientRow) Scan(dest ...any) error {
+	return r.db.do(r.ctx, func() error {
+		return r.db.pool.QueryRow(r.ctx, r.query, r.args...).Scan(dest...)
+	})
+}
@@ -0,0 +1,190 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+const testQuery = "UPDATE jobs SET is_active = false WHERE id = $1"
+
+// newTestDB creates a ResilientDB on a mocked pool that retries without waiting
+func newTestDB(t *testing.T, breaker BreakerConfig) (*ResilientDB, pgxmock.PgxPoolIface) {
+	t.Helper()
+	mockDB, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	t.Cleanup(mockDB.Close)
+
+	db := NewResilientDB(mockDB, RetryPolicy{MaxAttempts: 3}, breaker)
+	db.sleep = func(context.Context, time.Duration) error { return nil }
+	return db, mockDB
+}
+
+func TestResilientDB_Exec(t *testing.T) {
+	t.Parallel()
+	serializationError := &pgconn.PgError{Code: serializationFailure}
+	adminShutdown := &pgconn.PgError{Code: "57P01"}
+	uniqueViolation := &pgconn.PgError{Code: "23505"}
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, err error)
+	}{
+		{
+			name: "serialization failure retried",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(testQuery)).WithArgs(42).WillReturnError(serializationError)
+				mock.ExpectExec(regexp.QuoteMeta(testQuery)).WithArgs(42).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "attempts exhausted",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				for range 3 {
+					mock.ExpectExec(regexp.QuoteMeta(testQuery)).WithArgs(42).WillReturnError(serializationError)
+				}
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, serializationError)
+				var unavailableErr *httpservice.ServiceUnavailableError
+				assert.NotErrorAs(t, err, &unavailableErr)
+			},
+		},
+		{
+			name: "query error not retried",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(testQuery)).WithArgs(42).WillReturnError(uniqueViolation)
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, uniqueViolation)
+			},
+		},
+		{
+			name: "connection error reported as unavailable",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(testQuery)).WithArgs(42).WillReturnError(adminShutdown)
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				var unavailableErr *httpservice.ServiceUnavailableError
+				require.ErrorAs(t, err, &unavailableErr)
+				assert.Equal(t, "database", unavailableErr.Service)
+				require.ErrorIs(t, err, adminShutdown)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mockDB := newTestDB(t, BreakerConfig{})
+			tt.mockSetup(mockDB)
+
+			_, err := db.Exec(context.Background(), testQuery, 42)
+			tt.checkResults(t, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestResilientDB_QueryRow(t *testing.T) {
+	t.Parallel()
+	query := "SELECT name FROM companies WHERE id = $1"
+	db, mockDB := newTestDB(t, BreakerConfig{})
+
+	mockDB.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(3).
+		WillReturnError(&pgconn.PgError{Code: deadlockDetected})
+	mockDB.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(3).
+		WillReturnRows(pgxmock.NewRows([]string{"name"}).AddRow("Tech Corp"))
+	mockDB.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(4).
+		WillReturnRows(pgxmock.NewRows([]string{"name"}))
+
+	var name string
+	require.NoError(t, db.QueryRow(context.Background(), query, 3).Scan(&name))
+	assert.Equal(t, "Tech Corp", name)
+
+	err := db.QueryRow(context.Background(), query, 4).Scan(&name)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
+
+func TestResilientDB_CircuitOpen(t *testing.T) {
+	t.Parallel()
+	db, mockDB := newTestDB(t, BreakerConfig{FailureThreshold: 2, OpenTimeout: time.Minute})
+	connError := &pgconn.PgError{Code: "08006"}
+
+	mockDB.ExpectExec(regexp.QuoteMeta(testQuery)).WithArgs(42).WillReturnError(connError)
+	mockDB.ExpectExec(regexp.QuoteMeta(testQuery)).WithArgs(42).WillReturnError(connError)
+
+	for range 2 {
+		_, err := db.Exec(context.Background(), testQuery, 42)
+		require.ErrorIs(t, err, connError)
+	}
+
+	// The database is not called while the circuit is open
+	_, err := db.Exec(context.Background(), testQuery, 42)
+	var unavailableErr *httpservice.ServiceUnavailableError
+	require.ErrorAs(t, err, &unavailableErr)
+	require.ErrorIs(t, err, ErrCircuitOpen)
+
+	code, response := httpservice.MapError(err)
+	assert.Equal(t, 503, code)
+	assert.Equal(t, httpservice.ErrCodeUnavailable, response.Error.Code)
+
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
+
+func TestResilientDB_Timeout(t *testing.T) {
+	t.Parallel()
+	db, mockDB := newTestDB(t, BreakerConfig{})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	mockDB.ExpectExec(regexp.QuoteMeta(testQuery)).WithArgs(42).
+		WillDelayFor(time.Second).
+		WillReturnError(&pgconn.PgError{Code: "57014", Message: "canceling statement due to user request"})
+
+	_, err := db.Exec(ctx, testQuery, 42)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	code, response := httpservice.MapError(err)
+	assert.Equal(t, 504, code)
+	assert.Equal(t, httpservice.ErrCodeTimeout, response.Error.Code)
+}
+
+func TestIsConnectionError(t *testing.T) {
+	t.Parallel()
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.True(t, isConnectionError(context.Background(), &pgconn.PgError{Code: "08006"}))
+	assert.True(t, isConnectionError(context.Background(), &pgconn.PgError{Code: tooManyConnections}))
+	assert.False(t, isConnectionError(context.Background(), &pgconn.PgError{Code: serializationFailure}))
+	assert.False(t, isConnectionError(context.Background(), pgx.ErrNoRows))
+	assert.False(t, isConnectionError(context.Background(), errors.New("syntax error")))
+	assert.False(t, isConnectionError(canceled, &pgconn.PgError{Code: "08006"}))
+}
@@ -0,0 +1,93 @@
+package database
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// maxLoggedArgLength is the length past which the string arguments of the logged queries are
+// cut, job descriptions would flood the logs
+const maxLoggedArgLength = 64
+
+// QueryLog is a query run by a pool, once it finished
+type QueryLog struct {
+	// SQL is the statement with its whitespace collapsed, so it fits on a line
+	SQL string
+	// Args are the arguments of the statement, nil unless the tracer logs them
+	Args     []any
+	Duration time.Duration
+	// Rows is the number of rows returned or affected
+	Rows int64
+	Err  error
+}
+
+// QueryTracer is a pgx.QueryTracer passing the queries run by a pool to a logger, with the
+// context of the query so the logger can tell the request it was run for
+type QueryTracer struct {
+	log     func(ctx context.Context, query *QueryLog)
+	logArgs bool
+	now     func() time.Time
+}
+
+// queryStartKey holds the start of a traced query in its context
+type queryStartKey struct{}
+
+// queryStart is the statement of a traced query and when it started
+type queryStart struct {
+	sql  string
+	args []any
+	at   time.Time
+}
+
+// NewQueryTracer creates a new instance of QueryTracer. The arguments of the queries are only
+// passed to log when logArgs is set, as they may hold personal data.
+func NewQueryTracer(log func(ctx context.Context, query *QueryLog), logArgs bool) *QueryTracer {
+	return &QueryTracer{log: log, logArgs: logArgs, now: time.Now}
+}
+
+// TraceQueryStart records the statement of a query and when it started in its context
+func (t *QueryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn,
+	data pgx.TraceQueryStartData) context.Context {
+	return context.WithValue(ctx, queryStartKey{}, &queryStart{sql: data.SQL, args: data.Args, at: t.now()})
+}
+
+// TraceQueryEnd logs the query, with its duration and number of rows
+func (t *QueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	start, ok := ctx.Value(queryStartKey{}).(*queryStart)
+	if !ok {
+		return
+	}
+
+	query := &QueryLog{
+		SQL:      strings.Join(strings.Fields(start.sql), " "),
+		Duration: t.now().Sub(start.at),
+		Rows:     data.CommandTag.RowsAffected(),
+		Err:      data.Err,
+	}
+	if t.logArgs {
+		query.Args = make([]any, len(start.args))
+		for i, arg := range start.args {
+			if s, ok := arg.(string); ok {
+				arg = truncate(s)
+			}
+			query.Args[i] = arg
+		}
+	}
+	t.log(ctx, query)
+}
+
+// truncate cuts s past maxLoggedArgLength, without splitting a character
+func truncate(s string) string {
+	if len(s) <= maxLoggedArgLength {
+		return s
+	}
+	end := maxLoggedArgLength
+	for end > 0 && !utf8.RuneStart(s[end]) {
+		end--
+	}
+	return s[:end] + "..."
+}
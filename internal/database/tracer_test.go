@@ -0,0 +1,71 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryTracer(t *testing.T) {
+	t.Parallel()
+	queryError := errors.New("relation \"jobz\" does not exist")
+	description := strings.Repeat("á", 40)
+
+	tests := []struct {
+		name     string
+		logArgs  bool
+		end      pgx.TraceQueryEndData
+		expected *QueryLog
+	}{
+		{
+			name:    "query with its arguments",
+			logArgs: true,
+			end:     pgx.TraceQueryEndData{CommandTag: pgconn.NewCommandTag("SELECT 3")},
+			expected: &QueryLog{
+				SQL:      "SELECT id FROM jobs WHERE title = $1 AND description = $2",
+				Args:     []any{"Go Developer", strings.Repeat("á", 32) + "..."},
+				Duration: 150 * time.Millisecond,
+				Rows:     3,
+			},
+		},
+		{
+			name: "failed query without its arguments",
+			end:  pgx.TraceQueryEndData{Err: queryError},
+			expected: &QueryLog{
+				SQL:      "SELECT id FROM jobs WHERE title = $1 AND description = $2",
+				Duration: 150 * time.Millisecond,
+				Err:      queryError,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var logged *QueryLog
+			tracer := NewQueryTracer(func(_ context.Context, query *QueryLog) { logged = query }, tt.logArgs)
+			now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+			tracer.now = func() time.Time { return now }
+
+			ctx := tracer.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{
+				SQL: `
+                    SELECT id FROM jobs
+                    WHERE title = $1 AND description = $2
+                `,
+				Args: []any{"Go Developer", description},
+			})
+			now = now.Add(150 * time.Millisecond)
+			tracer.TraceQueryEnd(ctx, nil, tt.end)
+
+			require.NotNil(t, logged)
+			assert.Equal(t, tt.expected, logged)
+		})
+	}
+}
@@ -0,0 +1,267 @@
+package employer
+
+import (
+	"strings"
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+)
+
+// Data Transfer Objects (DTOs) for the company portal API layer.
+
+// ClaimRequest represents the request body to claim a company
+type ClaimRequest struct {
+	Email string `json:"email" binding:"required,max=255" example:"ana@techcorp.com"`
+}
+
+// VerifyClaimRequest represents the request body to verify a claim with the emailed code
+type VerifyClaimRequest struct {
+	Code string `json:"code" binding:"required,len=6,numeric" example:"042137"`
+}
+
+// ScopesRequest represents the request body to set the scopes of a member
+type ScopesRequest struct {
+	Scopes []string `json:"scopes" binding:"required,min=1,dive,oneof=jobs members" example:"jobs"`
+}
+
+// EmailDomainRequest represents the request body to set the email domain of a company
+type EmailDomainRequest struct {
+	// EmailDomain is the domain claims are verified with, an empty one makes the company unclaimable
+	EmailDomain string `json:"email_domain" binding:"max=255" example:"techcorp.com"`
+}
+
+// PostingRequest represents the request body to post a job
+type PostingRequest struct {
+	Title           string `json:"title" binding:"required,notblank,max=255" example:"Senior Go Developer"`
+	Description     string `json:"description" binding:"required,notblank" example:"We are looking for a Go developer..."`
+	ExperienceLevel string `json:"experience_level" binding:"required,experience_level" example:"Senior"`
+	EmploymentType  string `json:"employment_type" binding:"required,employment_type" example:"Full-time"`
+	// Location is where the job is located, e.g. "San José, Costa Rica"
+	Location       string `json:"location" binding:"required,notblank,max=255" example:"San José, Costa Rica"`
+	WorkMode       string `json:"work_mode" binding:"required,work_mode" example:"Hybrid"`
+	ApplicationURL string `json:"application_url" binding:"required,url,max=255" example:"https://techcorp.com/careers/go"`
+	// Technologies are the names or aliases of the technologies the job requires
+	Technologies      []string `json:"technologies" binding:"required,min=1,max=30,dive,notblank,max=100" example:"Go"`
+	Language          string   `json:"language" binding:"omitempty,language" example:"en"`
+	RemoteEligibility string   `json:"remote_eligibility" binding:"omitempty,remote_eligibility" example:"LATAM only"`
+	// UTCOffsetMin and UTCOffsetMax are set together
+	UTCOffsetMin *int `json:"utc_offset_min" binding:"required_with=UTCOffsetMax,omitempty,min=-12,max=14" example:"-6"`
+	UTCOffsetMax *int `json:"utc_offset_max" binding:"required_with=UTCOffsetMin,omitempty,min=-12,max=14" example:"-3"`
+}
+
+// ToNewPosting converts a PostingRequest to a NewPosting, trimming the text fields
+func (req *PostingRequest) ToNewPosting() *NewPosting {
+	source := jobs.SourceEmployerPortal
+	job := &jobs.Job{
+		Title:           strings.TrimSpace(req.Title),
+		RawDescription:  strings.TrimSpace(req.Description), // Sanitized when the job is stored
+		ExperienceLevel: req.ExperienceLevel,
+		EmploymentType:  req.EmploymentType,
+		WorkMode:        req.WorkMode,
+		ApplicationURL:  strings.TrimSpace(req.ApplicationURL),
+		Language:        jobs.Language(req.Language),
+		UTCOffsetMin:    req.UTCOffsetMin,
+		UTCOffsetMax:    req.UTCOffsetMax,
+		Source:          &source,
+	}
+	if req.RemoteEligibility != "" {
+		eligibility := jobs.RemoteEligibility(req.RemoteEligibility)
+		job.RemoteEligibility = &eligibility
+	}
+
+	technologies := make([]string, len(req.Technologies))
+	for i, name := range req.Technologies {
+		technologies[i] = strings.TrimSpace(name)
+	}
+
+	return &NewPosting{Job: job, Location: strings.TrimSpace(req.Location), Technologies: technologies}
+}
+
+// PostingListRequest represents the query parameters to list the jobs of a company
+type PostingListRequest struct {
+	Status string `form:"status" binding:"omitempty,oneof=pending published rejected" example:"pending"`
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=100" example:"20"`
+	Offset int    `form:"offset" binding:"omitempty,min=0" example:"0"`
+}
+
+// ToPostingListParams converts a PostingListRequest to PostingListParams
+func (req *PostingListRequest) ToPostingListParams() PostingListParams {
+	return PostingListParams{Status: jobs.Status(req.Status), Limit: req.Limit, Offset: req.Offset}
+}
+
+// ClaimResponse represents the API response of a claim, verified with the code emailed to its address
+type ClaimResponse struct {
+	ID        int       `json:"id" example:"7"`
+	Email     string    `json:"email" example:"ana@techcorp.com"`
+	ExpiresAt time.Time `json:"expires_at" example:"2024-01-15T06:30:00Z"`
+	CreatedAt time.Time `json:"created_at" example:"2024-01-15T06:00:00Z"`
+}
+
+// CompanyResponse represents the API response for a company of the portal
+type CompanyResponse struct {
+	ID          int     `json:"id" example:"3"`
+	Name        string  `json:"name" example:"Tech Corp"`
+	Slug        string  `json:"slug" example:"tech-corp"`
+	EmailDomain *string `json:"email_domain" example:"techcorp.com"`
+}
+
+// MembershipResponse represents the API response for a company the user is a member of
+type MembershipResponse struct {
+	CompanyID   int       `json:"company_id" example:"3"`
+	CompanyName string    `json:"company_name" example:"Tech Corp"`
+	CompanySlug string    `json:"company_slug" example:"tech-corp"`
+	Email       string    `json:"email" example:"ana@techcorp.com"`
+	Scopes      []string  `json:"scopes" example:"jobs,members"`
+	CreatedAt   time.Time `json:"created_at" example:"2024-01-15T06:00:00Z"`
+}
+
+// MembershipListResponse represents the API response listing the companies of the user
+type MembershipListResponse struct {
+	Data []*MembershipResponse `json:"data"`
+}
+
+// MemberResponse represents the API response for a member of a company
+type MemberResponse struct {
+	UserID    int       `json:"user_id" example:"12"`
+	Email     string    `json:"email" example:"ana@techcorp.com"`
+	Scopes    []string  `json:"scopes" example:"jobs"`
+	CreatedAt time.Time `json:"created_at" example:"2024-01-15T06:00:00Z"`
+	UpdatedAt time.Time `json:"updated_at" example:"2024-01-15T06:00:00Z"`
+}
+
+// MemberListResponse represents the API response listing the members of a company
+type MemberListResponse struct {
+	Data []*MemberResponse `json:"data"`
+}
+
+// PostingResponse represents the API response for a job of a company, whatever its status
+type PostingResponse struct {
+	ID       int    `json:"id" example:"42"`
+	Title    string `json:"title" example:"Senior Go Developer"`
+	Status   string `json:"status" example:"pending"`
+	IsActive bool   `json:"is_active" example:"false"`
+	// RejectionReason is why an admin rejected the job, omitted unless rejected
+	RejectionReason string    `json:"rejection_reason,omitempty" example:"The application link is broken"`
+	ExperienceLevel string    `json:"experience_level" example:"Senior"`
+	EmploymentType  string    `json:"employment_type" example:"Full-time"`
+	Location        string    `json:"location" example:"Costa Rica"`
+	WorkMode        string    `json:"work_mode" example:"Hybrid"`
+	ApplicationURL  string    `json:"application_url" example:"https://techcorp.com/careers/go"`
+	CreatedAt       time.Time `json:"created_at" example:"2024-01-15T06:00:00Z"`
+	UpdatedAt       time.Time `json:"updated_at" example:"2024-01-15T06:00:00Z"`
+}
+
+// PostingListResponse represents the API response listing the jobs of a company
+type PostingListResponse struct {
+	Data       []*PostingResponse            `json:"data"`
+	Pagination httpservice.PaginationDetails `json:"pagination"`
+}
+
+// MapClaimToResponse converts a claim to its API response format
+func MapClaimToResponse(claim *Claim) *ClaimResponse {
+	return &ClaimResponse{
+		ID:        claim.ID,
+		Email:     claim.Email,
+		ExpiresAt: claim.ExpiresAt,
+		CreatedAt: claim.CreatedAt,
+	}
+}
+
+// MapCompanyToResponse converts a company to its API response format
+func MapCompanyToResponse(company *Company) *CompanyResponse {
+	return &CompanyResponse{
+		ID:          company.ID,
+		Name:        company.Name,
+		Slug:        company.Slug,
+		EmailDomain: company.EmailDomain,
+	}
+}
+
+// MapMembershipToResponse converts a member with its company to its membership API response format
+func MapMembershipToResponse(member *Member) *MembershipResponse {
+	return &MembershipResponse{
+		CompanyID:   member.CompanyID,
+		CompanyName: member.Company.Name,
+		CompanySlug: member.Company.Slug,
+		Email:       member.Email,
+		Scopes:      member.Scopes,
+		CreatedAt:   member.CreatedAt,
+	}
+}
+
+// MapMembershipsToResponse converts the memberships of a user to the list API response format
+func MapMembershipsToResponse(members []*Member) *MembershipListResponse {
+	data := make([]*MembershipResponse, 0, len(members))
+	for _, member := range members {
+		data = append(data, MapMembershipToResponse(member))
+	}
+	return &MembershipListResponse{Data: data}
+}
+
+// MapMemberToResponse converts a member to its API response format
+func MapMemberToResponse(member *Member) *MemberResponse {
+	return &MemberResponse{
+		UserID:    member.UserID,
+		Email:     member.Email,
+		Scopes:    member.Scopes,
+		CreatedAt: member.CreatedAt,
+		UpdatedAt: member.UpdatedAt,
+	}
+}
+
+// MapMembersToResponse converts the members of a company to the list API response format
+func MapMembersToResponse(members []*Member) *MemberListResponse {
+	data := make([]*MemberResponse, 0, len(members))
+	for _, member := range members {
+		data = append(data, MapMemberToResponse(member))
+	}
+	return &MemberListResponse{Data: data}
+}
+
+// MapPostingToResponse converts a job of a company to its API response format
+func MapPostingToResponse(posting *Posting) *PostingResponse {
+	return &PostingResponse{
+		ID:              posting.ID,
+		Title:           posting.Title,
+		Status:          string(posting.Status),
+		IsActive:        posting.IsActive,
+		RejectionReason: posting.RejectionReason,
+		ExperienceLevel: posting.ExperienceLevel,
+		EmploymentType:  posting.EmploymentType,
+		Location:        posting.Location,
+		WorkMode:        posting.WorkMode,
+		ApplicationURL:  posting.ApplicationURL,
+		CreatedAt:       posting.CreatedAt,
+		UpdatedAt:       posting.UpdatedAt,
+	}
+}
+
+// MapPostingsToResponse converts a page of the jobs of a company to the list API response format
+func MapPostingsToResponse(postings []*Posting, total int, params *PostingListParams) *PostingListResponse {
+	data := make([]*PostingResponse, 0, len(postings))
+	for _, posting := range postings {
+		data = append(data, MapPostingToResponse(posting))
+	}
+	return &PostingListResponse{
+		Data:       data,
+		Pagination: httpservice.NewPaginationDetails(total, params.Limit, params.Offset, len(postings)),
+	}
+}
+
+// mapJobToPosting converts a stored job to the posting its company sees
+func mapJobToPosting(job *jobs.Job) *Posting {
+	return &Posting{
+		ID:              job.ID,
+		Title:           job.Title,
+		Status:          job.Status,
+		IsActive:        job.IsActive,
+		ExperienceLevel: job.ExperienceLevel,
+		EmploymentType:  job.EmploymentType,
+		Location:        job.Location,
+		WorkMode:        job.WorkMode,
+		ApplicationURL:  job.ApplicationURL,
+		CreatedAt:       job.CreatedAt,
+		UpdatedAt:       job.UpdatedAt,
+	}
+}
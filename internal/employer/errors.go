@@ -0,0 +1,169 @@
+// Package employer is the self-service portal of companies. Users claim a company by verifying an
+// address of its email domain, then post and manage its jobs, which wait in the moderation queue
+// until an admin approves them, and manage the other members of the company.
+package employer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+)
+
+// Sentinel errors returned by the employer services
+var (
+	// ErrClaimsDisabled is returned when claims are made while no mailer is configured
+	ErrClaimsDisabled = httpservice.NewError(httpservice.ErrUnavailable, "company claims are disabled")
+	// ErrInvalidCode is returned when a claim is verified with a wrong or expired code
+	ErrInvalidCode = httpservice.NewError(httpservice.ErrInvalid, "invalid or expired verification code")
+	// ErrTooManyClaims is returned when a user makes more than MaxClaimsPerHour claims
+	ErrTooManyClaims = httpservice.NewError(httpservice.ErrConflict, "too many company claims, try again later")
+)
+
+// CompanyNotFoundError represents a company not found error
+type CompanyNotFoundError struct {
+	Slug string
+}
+
+func (e CompanyNotFoundError) Error() string {
+	return fmt.Sprintf("company %s not found", e.Slug)
+}
+
+// Is matches httpservice.ErrNotFound
+func (e CompanyNotFoundError) Is(target error) bool {
+	return target == httpservice.ErrNotFound
+}
+
+// NotClaimableError represents a claim of a company without an email domain, or an archived one
+type NotClaimableError struct {
+	Slug string
+}
+
+func (e NotClaimableError) Error() string {
+	return fmt.Sprintf("company %s can't be claimed", e.Slug)
+}
+
+// Is matches httpservice.ErrConflict
+func (e NotClaimableError) Is(target error) bool {
+	return target == httpservice.ErrConflict
+}
+
+// ArchivedError represents a job posted for an archived company
+type ArchivedError struct {
+	Slug string
+}
+
+func (e ArchivedError) Error() string {
+	return fmt.Sprintf("company %s is archived", e.Slug)
+}
+
+// Is matches httpservice.ErrConflict
+func (e ArchivedError) Is(target error) bool {
+	return target == httpservice.ErrConflict
+}
+
+// DomainMismatchError represents a claim made with an address outside of the email domain of the company
+type DomainMismatchError struct {
+	Email  string
+	Domain string
+}
+
+func (e DomainMismatchError) Error() string {
+	return fmt.Sprintf("%s is not an address of the %s domain", e.Email, e.Domain)
+}
+
+// Is matches httpservice.ErrInvalid
+func (e DomainMismatchError) Is(target error) bool {
+	return target == httpservice.ErrInvalid
+}
+
+// ClaimNotFoundError represents a claim not found error. Claims of other users aren't found either.
+type ClaimNotFoundError struct {
+	ID int
+}
+
+func (e ClaimNotFoundError) Error() string {
+	return fmt.Sprintf("company claim with ID %d not found", e.ID)
+}
+
+// Is matches httpservice.ErrNotFound
+func (e ClaimNotFoundError) Is(target error) bool {
+	return target == httpservice.ErrNotFound
+}
+
+// ForbiddenError represents a user who isn't a member of a company, or lacks the scope needed
+type ForbiddenError struct {
+	Slug  string
+	Scope string
+}
+
+func (e ForbiddenError) Error() string {
+	if e.Scope == "" {
+		return fmt.Sprintf("not a member of company %s", e.Slug)
+	}
+	return fmt.Sprintf("missing the %s scope of company %s", e.Scope, e.Slug)
+}
+
+// Is matches httpservice.ErrForbidden
+func (e ForbiddenError) Is(target error) bool {
+	return target == httpservice.ErrForbidden
+}
+
+// MemberNotFoundError represents a member not found error
+type MemberNotFoundError struct {
+	Slug   string
+	UserID int
+}
+
+func (e MemberNotFoundError) Error() string {
+	return fmt.Sprintf("user with ID %d is not a member of company %s", e.UserID, e.Slug)
+}
+
+// Is matches httpservice.ErrNotFound
+func (e MemberNotFoundError) Is(target error) bool {
+	return target == httpservice.ErrNotFound
+}
+
+// LastManagerError represents a change leaving a company without a member able to manage the others
+type LastManagerError struct {
+	Slug string
+}
+
+func (e LastManagerError) Error() string {
+	return fmt.Sprintf("company %s must keep a member with the %s scope", e.Slug, ScopeMembers)
+}
+
+// Is matches httpservice.ErrConflict
+func (e LastManagerError) Is(target error) bool {
+	return target == httpservice.ErrConflict
+}
+
+// NotEditableError represents a change to a job that was already published
+type NotEditableError struct {
+	ID     int
+	Status jobs.Status
+}
+
+func (e NotEditableError) Error() string {
+	return fmt.Sprintf("job with ID %d is %s, only pending and rejected jobs can be edited", e.ID, e.Status)
+}
+
+// Is matches httpservice.ErrConflict
+func (e NotEditableError) Is(target error) bool {
+	return target == httpservice.ErrConflict
+}
+
+// UnknownTechnologiesError represents technologies of a posting that aren't known by name or alias
+type UnknownTechnologiesError struct {
+	Names []string
+}
+
+func (e UnknownTechnologiesError) Error() string {
+	return fmt.Sprintf("unknown technologies: %s", strings.Join(e.Names, ", "))
+}
+
+// Is matches httpservice.ErrInvalid
+func (e UnknownTechnologiesError) Is(target error) bool {
+	return target == httpservice.ErrInvalid
+}
@@ -0,0 +1,431 @@
+package employer
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+	"github.com/rodruizronald/ticos-in-tech/internal/users"
+)
+
+// Constants for company portal routes and endpoints
+const (
+	ClaimsRoute      = "/companies/:slug/claims"
+	VerifyClaimRoute = ClaimsRoute + "/:id/verify"
+
+	MyCompaniesRoute = "/me/companies"
+	MyCompanyPath    = MyCompaniesRoute + "/:slug"
+	PostingsRoute    = MyCompanyPath + "/jobs"
+	PostingPath      = PostingsRoute + "/:id"
+	ClosePostingPath = PostingPath + "/close"
+	MembersRoute     = MyCompanyPath + "/members"
+	MemberPath       = MembersRoute + "/:user_id"
+
+	// EmailDomainRoute is served under the admin group
+	EmailDomainRoute = "/companies/:slug/email-domain"
+)
+
+// Handler handles HTTP requests for the company portal
+type Handler struct {
+	claims *ClaimService
+	portal *PortalService
+	auth   users.Authenticator
+}
+
+// NewHandler creates a new company portal handler, authenticating the users with auth
+func NewHandler(claims *ClaimService, portal *PortalService, auth users.Authenticator) *Handler {
+	return &Handler{claims: claims, portal: portal, auth: auth}
+}
+
+// RegisterRoutes registers the company portal routes with the given router group.
+// All of them require a session token.
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	authenticated := rg.Group("", users.RequireUser(h.auth))
+	authenticated.POST(ClaimsRoute, h.ClaimCompany)
+	authenticated.POST(VerifyClaimRoute, h.VerifyClaim)
+	authenticated.GET(MyCompaniesRoute, h.ListMyCompanies)
+	authenticated.GET(PostingsRoute, h.ListPostings)
+	authenticated.POST(PostingsRoute, h.CreatePosting)
+	authenticated.PATCH(PostingPath, h.UpdatePosting)
+	authenticated.POST(ClosePostingPath, h.ClosePosting)
+	authenticated.GET(MembersRoute, h.ListMembers)
+	authenticated.PUT(MemberPath, h.SetMemberScopes)
+	authenticated.DELETE(MemberPath, h.RemoveMember)
+}
+
+// RegisterAdminRoutes registers the company portal admin routes with the given (protected) router group
+func (h *Handler) RegisterAdminRoutes(rg *gin.RouterGroup) {
+	rg.PUT(EmailDomainRoute, h.SetEmailDomain)
+}
+
+// ClaimCompany godoc
+// @Summary Claim a company
+// @Description Emails a verification code to an address of the email domain of the company, or of
+// @Description one of its subdomains. The code is valid for 30 minutes, a user can claim 5 times an hour.
+// @Tags company portal
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param slug path string true "Company slug" example("tech-corp")
+// @Param request body ClaimRequest true "Address to verify"
+// @Success 201 {object} ClaimResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 409 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Failure 503 {object} httpservice.ErrorResponse
+// @Router /companies/{slug}/claims [post]
+func (h *Handler) ClaimCompany(c *gin.Context) {
+	var req ClaimRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	claim, err := h.claims.Claim(c.Request.Context(), users.CurrentUser(c).ID, c.Param("slug"), req.Email)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, MapClaimToResponse(claim))
+}
+
+// VerifyClaim godoc
+// @Summary Verify a company claim
+// @Description Checks the emailed code of a claim and makes the user a member of the company. The first
+// @Description member gets the jobs and members scopes, the next ones only the jobs scope.
+// @Tags company portal
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param slug path string true "Company slug" example("tech-corp")
+// @Param id path int true "Claim ID"
+// @Param request body VerifyClaimRequest true "Emailed code"
+// @Success 200 {object} MembershipResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /companies/{slug}/claims/{id}/verify [post]
+func (h *Handler) VerifyClaim(c *gin.Context) {
+	claimID, ok := parseID(c, "id", "invalid claim id")
+	if !ok {
+		return
+	}
+
+	var req VerifyClaimRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	member, err := h.claims.Verify(c.Request.Context(), users.CurrentUser(c).ID, c.Param("slug"), claimID, req.Code)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapMembershipToResponse(member))
+}
+
+// ListMyCompanies godoc
+// @Summary List my companies
+// @Description Lists the companies the current user is a member of, with their scopes
+// @Tags company portal
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} MembershipListResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /me/companies [get]
+func (h *Handler) ListMyCompanies(c *gin.Context) {
+	members, err := h.portal.Memberships(c.Request.Context(), users.CurrentUser(c).ID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapMembershipsToResponse(members))
+}
+
+// ListPostings godoc
+// @Summary List the jobs of my company
+// @Description Lists the jobs of a company whatever their status, newest first. Needs the jobs scope.
+// @Tags company portal
+// @Produce json
+// @Security BearerAuth
+// @Param slug path string true "Company slug" example("tech-corp")
+// @Param status query string false "Moderation status" Enums(pending, published, rejected)
+// @Param limit query int false "Number of jobs to return (max 100)" default(20) example(20)
+// @Param offset query int false "Number of jobs to skip" default(0) example(0)
+// @Success 200 {object} PostingListResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 403 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /me/companies/{slug}/jobs [get]
+func (h *Handler) ListPostings(c *gin.Context) {
+	var req PostingListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	postings, err := h.portal.Postings(c.Request.Context(), users.CurrentUser(c).ID, c.Param("slug"),
+		req.ToPostingListParams())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, postings)
+}
+
+// CreatePosting godoc
+// @Summary Post a job for my company
+// @Description Creates a job of the company, pending until an admin approves it in the moderation
+// @Description queue. Its technologies are given by name or alias. Needs the jobs scope.
+// @Tags company portal
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param slug path string true "Company slug" example("tech-corp")
+// @Param request body PostingRequest true "Job to post"
+// @Success 201 {object} PostingResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 403 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 409 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /me/companies/{slug}/jobs [post]
+func (h *Handler) CreatePosting(c *gin.Context) {
+	var req PostingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	posting, err := h.portal.CreatePosting(c.Request.Context(), users.CurrentUser(c).ID, c.Param("slug"),
+		req.ToNewPosting())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, MapPostingToResponse(posting))
+}
+
+// UpdatePosting godoc
+// @Summary Change a job of my company
+// @Description Changes the fields of a pending or rejected job present in the request. Rejected jobs
+// @Description go back to the moderation queue, published jobs can't be changed. Needs the jobs scope.
+// @Description Only admins can set is_featured.
+// @Tags company portal
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param slug path string true "Company slug" example("tech-corp")
+// @Param id path int true "Job ID"
+// @Param request body jobs.JobPatchRequest true "Fields to change"
+// @Success 200 {object} PostingResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 403 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 409 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /me/companies/{slug}/jobs/{id} [patch]
+func (h *Handler) UpdatePosting(c *gin.Context) {
+	jobID, ok := parseID(c, "id", "invalid job id")
+	if !ok {
+		return
+	}
+
+	var req jobs.JobPatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	posting, err := h.portal.UpdatePosting(c.Request.Context(), users.CurrentUser(c).ID, c.Param("slug"), jobID,
+		req.ToJobPatch())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapPostingToResponse(posting))
+}
+
+// ClosePosting godoc
+// @Summary Close a job of my company
+// @Description Takes down a published job of the company, e.g. once the position is filled. Needs the
+// @Description jobs scope.
+// @Tags company portal
+// @Security BearerAuth
+// @Param slug path string true "Company slug" example("tech-corp")
+// @Param id path int true "Job ID"
+// @Success 204
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 403 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 409 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /me/companies/{slug}/jobs/{id}/close [post]
+func (h *Handler) ClosePosting(c *gin.Context) {
+	jobID, ok := parseID(c, "id", "invalid job id")
+	if !ok {
+		return
+	}
+
+	if err := h.portal.ClosePosting(c.Request.Context(), users.CurrentUser(c).ID, c.Param("slug"), jobID); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListMembers godoc
+// @Summary List the members of my company
+// @Description Lists the members of a company with their scopes, oldest first. Needs the members scope.
+// @Tags company portal
+// @Produce json
+// @Security BearerAuth
+// @Param slug path string true "Company slug" example("tech-corp")
+// @Success 200 {object} MemberListResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 403 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /me/companies/{slug}/members [get]
+func (h *Handler) ListMembers(c *gin.Context) {
+	members, err := h.portal.Members(c.Request.Context(), users.CurrentUser(c).ID, c.Param("slug"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapMembersToResponse(members))
+}
+
+// SetMemberScopes godoc
+// @Summary Set the scopes of a member of my company
+// @Description Replaces the scopes of a member: jobs to manage the jobs of the company, members to
+// @Description manage its members. A company keeps a member with the members scope. Needs the members scope.
+// @Tags company portal
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param slug path string true "Company slug" example("tech-corp")
+// @Param user_id path int true "User ID of the member"
+// @Param request body ScopesRequest true "Scopes of the member"
+// @Success 200 {object} MemberResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 403 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 409 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /me/companies/{slug}/members/{user_id} [put]
+func (h *Handler) SetMemberScopes(c *gin.Context) {
+	memberID, ok := parseID(c, "user_id", "invalid user id")
+	if !ok {
+		return
+	}
+
+	var req ScopesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	member, err := h.portal.SetMemberScopes(c.Request.Context(), users.CurrentUser(c).ID, c.Param("slug"),
+		memberID, req.Scopes)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapMemberToResponse(member))
+}
+
+// RemoveMember godoc
+// @Summary Remove a member of my company
+// @Description Removes a member from the company, which needs the members scope, or leaves it when the
+// @Description member is the current user. A company keeps a member with the members scope.
+// @Tags company portal
+// @Security BearerAuth
+// @Param slug path string true "Company slug" example("tech-corp")
+// @Param user_id path int true "User ID of the member"
+// @Success 204
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 403 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 409 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /me/companies/{slug}/members/{user_id} [delete]
+func (h *Handler) RemoveMember(c *gin.Context) {
+	memberID, ok := parseID(c, "user_id", "invalid user id")
+	if !ok {
+		return
+	}
+
+	if err := h.portal.RemoveMember(c.Request.Context(), users.CurrentUser(c).ID, c.Param("slug"), memberID); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// SetEmailDomain godoc
+// @Summary Set the email domain of a company
+// @Description Sets the domain of the addresses users claim the company with, e.g. techcorp.com. An
+// @Description empty domain makes the company unclaimable, its members are kept.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security AdminAPIKey
+// @Param slug path string true "Company slug" example("tech-corp")
+// @Param request body EmailDomainRequest true "Email domain"
+// @Success 200 {object} CompanyResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/companies/{slug}/email-domain [put]
+func (h *Handler) SetEmailDomain(c *gin.Context) {
+	var req EmailDomainRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	company, err := h.claims.SetEmailDomain(c.Request.Context(), c.Param("slug"), req.EmailDomain)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapCompanyToResponse(company))
+}
+
+// parseID parses a positive ID path parameter, reporting message as a validation error otherwise
+func parseID(c *gin.Context, param, message string) (int, bool) {
+	id, err := strconv.Atoi(c.Param(param))
+	if err != nil || id <= 0 {
+		_ = c.Error(&httpservice.ValidationError{Errors: []string{message}})
+		return 0, false
+	}
+	return id, true
+}
@@ -0,0 +1,97 @@
+package employer
+
+import (
+	"slices"
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+)
+
+// Scopes of the members of a company
+const (
+	// ScopeJobs allows to post and manage the jobs of the company
+	ScopeJobs = "jobs"
+	// ScopeMembers allows to manage the other members of the company
+	ScopeMembers = "members"
+)
+
+// Scopes returns every scope, the ones of the first member of a company
+func Scopes() []string {
+	return []string{ScopeJobs, ScopeMembers}
+}
+
+// Company represents a company as seen by the portal
+type Company struct {
+	ID   int    `db:"id"`
+	Name string `db:"name"`
+	Slug string `db:"slug"`
+	// EmailDomain is the domain of the addresses of the staff, nil when the company can't be claimed
+	EmailDomain *string `db:"email_domain"`
+	IsActive    bool    `db:"is_active"`
+}
+
+// Claim represents the request of a user to join a company. Only the hash of the code
+// is stored, the code itself is emailed to the claimed address.
+type Claim struct {
+	ID         int        `db:"id"`
+	CompanyID  int        `db:"company_id"`
+	UserID     int        `db:"user_id"`
+	Email      string     `db:"email"`
+	Code       string     `db:"-"`
+	CodeHash   string     `db:"code_hash"`
+	Attempts   int        `db:"attempts"`
+	ExpiresAt  time.Time  `db:"expires_at"`
+	VerifiedAt *time.Time `db:"verified_at"`
+	CreatedAt  time.Time  `db:"created_at"`
+}
+
+// Member represents a user managing a company
+type Member struct {
+	CompanyID int `db:"company_id"`
+	UserID    int `db:"user_id"`
+	// Email is the verified address of the company the user claimed it with
+	Email     string    `db:"email"`
+	Scopes    []string  `db:"scopes"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+
+	// Relationships (not stored in database)
+	Company *Company `db:"-"`
+}
+
+// HasScope reports whether the member has scope
+func (m *Member) HasScope(scope string) bool {
+	return slices.Contains(m.Scopes, scope)
+}
+
+// Posting represents a job of a company as seen by its members, whatever its status
+type Posting struct {
+	ID              int         `db:"id"`
+	Title           string      `db:"title"`
+	Status          jobs.Status `db:"status"`
+	IsActive        bool        `db:"is_active"`
+	RejectionReason string      `db:"rejection_reason"`
+	ExperienceLevel string      `db:"experience_level"`
+	EmploymentType  string      `db:"employment_type"`
+	Location        string      `db:"location"`
+	WorkMode        string      `db:"work_mode"`
+	ApplicationURL  string      `db:"application_url"`
+	CreatedAt       time.Time   `db:"created_at"`
+	UpdatedAt       time.Time   `db:"updated_at"`
+}
+
+// PostingListParams defines the parameters to list the jobs of a company (repository layer)
+type PostingListParams struct {
+	CompanyID int
+	// Status filters the jobs, all of them are listed when empty
+	Status jobs.Status
+	Limit  int
+	Offset int
+}
+
+// NewPosting holds the job a member posts, with the names or aliases of its technologies
+type NewPosting struct {
+	Job          *jobs.Job
+	Location     string
+	Technologies []string
+}
@@ -0,0 +1,485 @@
+package employer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/actor"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobtech"
+)
+
+// SQL query constants
+const (
+	getCompanyBySlugQuery = `SELECT id, name, slug, email_domain, is_active FROM companies WHERE slug = $1`
+
+	setEmailDomainQuery = `
+        UPDATE companies
+        SET email_domain = $2, updated_at = NOW()
+        WHERE slug = $1
+        RETURNING id, name, slug, email_domain, is_active
+    `
+
+	createClaimQuery = `
+        INSERT INTO company_claims (company_id, user_id, email, code_hash, expires_at)
+        VALUES ($1, $2, $3, $4, $5)
+        RETURNING id, attempts, created_at
+    `
+
+	countClaimsSinceQuery = `SELECT COUNT(*) FROM company_claims WHERE user_id = $1 AND created_at > $2`
+
+	getClaimQuery = `
+        SELECT id, company_id, user_id, email, code_hash, attempts, expires_at, verified_at, created_at
+        FROM company_claims
+        WHERE id = $1
+    `
+
+	// An attempt is counted before the code is compared, in one statement, so concurrent attempts
+	// can't go over the limit
+	consumeClaimAttemptQuery = `
+        UPDATE company_claims
+        SET attempts = attempts + 1
+        WHERE id = $1 AND attempts < $2 AND verified_at IS NULL AND expires_at > NOW()
+        RETURNING code_hash
+    `
+
+	// The claimant joins the company with firstScopes when it has no member yet, with scopes
+	// otherwise. A member verifying a claim again keeps their scopes.
+	verifyClaimQuery = `
+        WITH verified AS (
+            UPDATE company_claims
+            SET verified_at = NOW()
+            WHERE id = $1 AND verified_at IS NULL AND attempts <= $4 AND expires_at > NOW()
+            RETURNING company_id, user_id, email
+        )
+        INSERT INTO company_members (company_id, user_id, email, scopes)
+        SELECT v.company_id, v.user_id, v.email,
+               CASE WHEN EXISTS (SELECT 1 FROM company_members m WHERE m.company_id = v.company_id)
+                    THEN $2::TEXT[] ELSE $3::TEXT[] END
+        FROM verified v
+        ON CONFLICT (company_id, user_id)
+        DO UPDATE SET email = EXCLUDED.email, updated_at = NOW()
+        RETURNING company_id, user_id, email, scopes, created_at, updated_at
+    `
+
+	getMemberQuery = `
+        SELECT company_id, user_id, email, scopes, created_at, updated_at
+        FROM company_members
+        WHERE company_id = $1 AND user_id = $2
+    `
+
+	listMembershipsQuery = `
+        SELECT m.company_id, m.user_id, m.email, m.scopes, m.created_at, m.updated_at,
+               c.name, c.slug, c.email_domain, c.is_active
+        FROM company_members m
+        JOIN companies c ON m.company_id = c.id
+        WHERE m.user_id = $1
+        ORDER BY c.name
+    `
+
+	listMembersQuery = `
+        SELECT company_id, user_id, email, scopes, created_at, updated_at
+        FROM company_members
+        WHERE company_id = $1
+        ORDER BY created_at, user_id
+    `
+
+	updateMemberScopesQuery = `
+        UPDATE company_members
+        SET scopes = $3, updated_at = NOW()
+        WHERE company_id = $1 AND user_id = $2
+        RETURNING company_id, user_id, email, scopes, created_at, updated_at
+    `
+
+	deleteMemberQuery = `DELETE FROM company_members WHERE company_id = $1 AND user_id = $2`
+
+	// Members able to manage the others, besides the given user
+	countOtherManagersQuery = `
+        SELECT COUNT(*) FROM company_members
+        WHERE company_id = $1 AND user_id <> $2 AND $3 = ANY(scopes)
+    `
+
+	// Newest jobs first
+	listPostingsQuery = `
+        SELECT id, title, status, is_active, rejection_reason, experience_level, employment_type,
+               location, work_mode, application_url, created_at, updated_at,
+               COUNT(*) OVER() AS total_count
+        FROM jobs
+        WHERE company_id = $1 AND ($2 = '' OR status = $2)
+        ORDER BY created_at DESC, id DESC
+        LIMIT $3 OFFSET $4
+    `
+
+	getPostingQuery = `
+        SELECT id, title, status, is_active, rejection_reason, experience_level, employment_type,
+               location, work_mode, application_url, created_at, updated_at
+        FROM jobs
+        WHERE id = $1 AND company_id = $2
+    `
+
+	// Rejected jobs go back to the moderation queue once edited
+	resubmitPostingQuery = `
+        UPDATE jobs
+        SET status = 'pending', rejection_reason = '', reviewed_at = NULL, reviewed_by = '',
+            updated_by = $2, updated_at = NOW()
+        WHERE id = $1 AND status = 'rejected'
+    `
+)
+
+// Database interface to support pgxpool and mocks
+type Database interface {
+	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
+	Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// Repository handles database operations for company claims, members and postings.
+type Repository struct {
+	db Database
+}
+
+// NewRepository creates a new Repository instance.
+func NewRepository(db Database) *Repository {
+	return &Repository{db: db}
+}
+
+// GetCompanyBySlug retrieves a company by its slug.
+func (r *Repository) GetCompanyBySlug(ctx context.Context, slug string) (*Company, error) {
+	company := &Company{}
+	err := r.db.QueryRow(ctx, getCompanyBySlugQuery, slug).
+		Scan(&company.ID, &company.Name, &company.Slug, &company.EmailDomain, &company.IsActive)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &CompanyNotFoundError{Slug: slug}
+		}
+		return nil, fmt.Errorf("failed to get company: %w", err)
+	}
+
+	return company, nil
+}
+
+// SetEmailDomain sets the email domain of a company, nil making it unclaimable.
+func (r *Repository) SetEmailDomain(ctx context.Context, slug string, domain *string) (*Company, error) {
+	company := &Company{}
+	err := r.db.QueryRow(ctx, setEmailDomainQuery, slug, domain).
+		Scan(&company.ID, &company.Name, &company.Slug, &company.EmailDomain, &company.IsActive)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &CompanyNotFoundError{Slug: slug}
+		}
+		return nil, fmt.Errorf("failed to set company email domain: %w", err)
+	}
+
+	return company, nil
+}
+
+// CreateClaim inserts a new company claim into the database.
+func (r *Repository) CreateClaim(ctx context.Context, claim *Claim) error {
+	err := r.db.QueryRow(ctx, createClaimQuery,
+		claim.CompanyID, claim.UserID, claim.Email, claim.CodeHash, claim.ExpiresAt,
+	).Scan(&claim.ID, &claim.Attempts, &claim.CreatedAt)
+
+	if err != nil {
+		return fmt.Errorf("failed to create company claim: %w", err)
+	}
+
+	return nil
+}
+
+// CountClaimsSince counts the claims a user made after since.
+func (r *Repository) CountClaimsSince(ctx context.Context, userID int, since time.Time) (int, error) {
+	var count int
+	if err := r.db.QueryRow(ctx, countClaimsSinceQuery, userID, since).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count company claims: %w", err)
+	}
+	return count, nil
+}
+
+// GetClaim retrieves a company claim by its ID.
+func (r *Repository) GetClaim(ctx context.Context, id int) (*Claim, error) {
+	claim := &Claim{}
+	err := r.db.QueryRow(ctx, getClaimQuery, id).Scan(
+		&claim.ID,
+		&claim.CompanyID,
+		&claim.UserID,
+		&claim.Email,
+		&claim.CodeHash,
+		&claim.Attempts,
+		&claim.ExpiresAt,
+		&claim.VerifiedAt,
+		&claim.CreatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &ClaimNotFoundError{ID: id}
+		}
+		return nil, fmt.Errorf("failed to get company claim: %w", err)
+	}
+
+	return claim, nil
+}
+
+// ConsumeClaimAttempt counts an attempt to verify a claim and returns the hash of its code. An
+// ErrInvalidCode is returned, without counting it, when the claim was already verified, expired or
+// was tried MaxClaimAttempts times.
+func (r *Repository) ConsumeClaimAttempt(ctx context.Context, id int) (string, error) {
+	var codeHash string
+	if err := r.db.QueryRow(ctx, consumeClaimAttemptQuery, id, MaxClaimAttempts).Scan(&codeHash); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", ErrInvalidCode
+		}
+		return "", fmt.Errorf("failed to count company claim attempt: %w", err)
+	}
+	return codeHash, nil
+}
+
+// VerifyClaim marks a claim as verified and adds the claimant to the members of the company,
+// with firstScopes when they are its first member and scopes otherwise. An ErrInvalidCode is
+// returned when the claim was already verified, expired or was tried too many times.
+func (r *Repository) VerifyClaim(ctx context.Context, id int, scopes, firstScopes []string) (*Member, error) {
+	member, err := scanMember(r.db.QueryRow(ctx, verifyClaimQuery, id, scopes, firstScopes, MaxClaimAttempts))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalidCode
+		}
+		return nil, fmt.Errorf("failed to verify company claim: %w", err)
+	}
+	return member, nil
+}
+
+// GetMember retrieves a member of a company. Returns nil without error when the user isn't one.
+func (r *Repository) GetMember(ctx context.Context, companyID, userID int) (*Member, error) {
+	member, err := scanMember(r.db.QueryRow(ctx, getMemberQuery, companyID, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get company member: %w", err)
+	}
+	return member, nil
+}
+
+// ListMemberships retrieves the memberships of a user with their companies, by company name.
+func (r *Repository) ListMemberships(ctx context.Context, userID int) ([]*Member, error) {
+	rows, err := r.db.Query(ctx, listMembershipsQuery, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list company memberships: %w", err)
+	}
+	defer rows.Close()
+
+	var members []*Member
+	for rows.Next() {
+		member := &Member{Company: &Company{}}
+		if err := rows.Scan(
+			&member.CompanyID,
+			&member.UserID,
+			&member.Email,
+			&member.Scopes,
+			&member.CreatedAt,
+			&member.UpdatedAt,
+			&member.Company.Name,
+			&member.Company.Slug,
+			&member.Company.EmailDomain,
+			&member.Company.IsActive,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan company membership: %w", err)
+		}
+		member.Company.ID = member.CompanyID
+		members = append(members, member)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating company memberships: %w", err)
+	}
+
+	return members, nil
+}
+
+// ListMembers retrieves the members of a company, oldest first.
+func (r *Repository) ListMembers(ctx context.Context, companyID int) ([]*Member, error) {
+	rows, err := r.db.Query(ctx, listMembersQuery, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list company members: %w", err)
+	}
+	defer rows.Close()
+
+	var members []*Member
+	for rows.Next() {
+		member, err := scanMember(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan company member: %w", err)
+		}
+		members = append(members, member)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating company members: %w", err)
+	}
+
+	return members, nil
+}
+
+// UpdateMemberScopes replaces the scopes of a member. Returns nil without error when the user
+// isn't a member of the company.
+func (r *Repository) UpdateMemberScopes(ctx context.Context, companyID, userID int, scopes []string) (*Member, error) {
+	member, err := scanMember(r.db.QueryRow(ctx, updateMemberScopesQuery, companyID, userID, scopes))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to update company member: %w", err)
+	}
+	return member, nil
+}
+
+// DeleteMember removes a member from a company. It reports false when the user wasn't a member.
+func (r *Repository) DeleteMember(ctx context.Context, companyID, userID int) (bool, error) {
+	commandTag, err := r.db.Exec(ctx, deleteMemberQuery, companyID, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete company member: %w", err)
+	}
+	return commandTag.RowsAffected() > 0, nil
+}
+
+// CountOtherManagers counts the members of a company other than userID that have the members scope.
+func (r *Repository) CountOtherManagers(ctx context.Context, companyID, userID int) (int, error) {
+	var count int
+	if err := r.db.QueryRow(ctx, countOtherManagersQuery, companyID, userID, ScopeMembers).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count company managers: %w", err)
+	}
+	return count, nil
+}
+
+// ListPostings retrieves a page of the jobs of a company, whatever their status, and their total.
+func (r *Repository) ListPostings(ctx context.Context, params *PostingListParams) ([]*Posting, int, error) {
+	rows, err := r.db.Query(ctx, listPostingsQuery, params.CompanyID, params.Status, params.Limit, params.Offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list company jobs: %w", err)
+	}
+	defer rows.Close()
+
+	var postings []*Posting
+	var total int
+	for rows.Next() {
+		posting := &Posting{}
+		if err := rows.Scan(
+			&posting.ID,
+			&posting.Title,
+			&posting.Status,
+			&posting.IsActive,
+			&posting.RejectionReason,
+			&posting.ExperienceLevel,
+			&posting.EmploymentType,
+			&posting.Location,
+			&posting.WorkMode,
+			&posting.ApplicationURL,
+			&posting.CreatedAt,
+			&posting.UpdatedAt,
+			&total,
+		); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan company job: %w", err)
+		}
+		postings = append(postings, posting)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating company jobs: %w", err)
+	}
+
+	return postings, total, nil
+}
+
+// CreatePosting inserts a job posted by a member and associates it with its technologies. It runs
+// in a single transaction, so no job is left without its technologies.
+func (r *Repository) CreatePosting(ctx context.Context, job *jobs.Job, techs []*jobtech.JobTechnology) (err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin posting transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	if err = jobs.NewRepository(tx).Create(ctx, job); err != nil {
+		return err
+	}
+	jobTechRepo := jobtech.NewRepository(tx)
+	for _, jobTech := range techs {
+		jobTech.JobID = job.ID
+		if err = jobTechRepo.Create(ctx, jobTech); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit posting transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetPosting retrieves a job of a company. Jobs of other companies aren't found.
+func (r *Repository) GetPosting(ctx context.Context, companyID, id int) (*Posting, error) {
+	posting := &Posting{}
+	err := r.db.QueryRow(ctx, getPostingQuery, id, companyID).Scan(
+		&posting.ID,
+		&posting.Title,
+		&posting.Status,
+		&posting.IsActive,
+		&posting.RejectionReason,
+		&posting.ExperienceLevel,
+		&posting.EmploymentType,
+		&posting.Location,
+		&posting.WorkMode,
+		&posting.ApplicationURL,
+		&posting.CreatedAt,
+		&posting.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get company job: %w", err)
+	}
+
+	return posting, nil
+}
+
+// ResubmitPosting moves a rejected job back to the moderation queue. It reports false when the
+// job isn't rejected.
+func (r *Repository) ResubmitPosting(ctx context.Context, id int) (bool, error) {
+	commandTag, err := r.db.Exec(ctx, resubmitPostingQuery, id, actor.From(ctx).String())
+	if err != nil {
+		return false, fmt.Errorf("failed to resubmit job: %w", err)
+	}
+	return commandTag.RowsAffected() > 0, nil
+}
+
+// scanMember scans a member from a row
+func scanMember(row pgx.Row) (*Member, error) {
+	member := &Member{}
+	err := row.Scan(
+		&member.CompanyID,
+		&member.UserID,
+		&member.Email,
+		&member.Scopes,
+		&member.CreatedAt,
+		&member.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return member, nil
+}
@@ -0,0 +1,62 @@
+//go:build integration
+
+package employer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/testdb"
+)
+
+func TestRepository_ConsumeClaimAttempt_Integration(t *testing.T) {
+	t.Parallel()
+	db := testdb.New(t)
+	ctx := context.Background()
+
+	companyID := testdb.InsertCompany(t, db, "Tech Corp")
+	var userID int
+	require.NoError(t, db.QueryRow(ctx,
+		`INSERT INTO users (email, password_hash) VALUES ('ana@example.com', '') RETURNING id`).Scan(&userID))
+	repo := NewRepository(db)
+	claim := &Claim{
+		CompanyID: companyID, UserID: userID, Email: "ana@techcorp.com",
+		CodeHash: hashCode("042137"), ExpiresAt: time.Now().Add(ClaimTTL),
+	}
+	require.NoError(t, repo.CreateClaim(ctx, claim))
+
+	// Concurrent attempts can't go over the limit
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	counted, rejected := 0, 0
+	for range 4 * MaxClaimAttempts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := repo.ConsumeClaimAttempt(ctx, claim.ID)
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == nil:
+				counted++
+			case errors.Is(err, ErrInvalidCode):
+				rejected++
+			default:
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, MaxClaimAttempts, counted)
+	assert.Equal(t, 3*MaxClaimAttempts, rejected)
+
+	// and the claim can't be verified anymore once they are exhausted
+	_, err := repo.VerifyClaim(ctx, claim.ID, []string{ScopeJobs}, Scopes())
+	require.ErrorIs(t, err, ErrInvalidCode)
+}
@@ -0,0 +1,268 @@
+package employer
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobtech"
+)
+
+func TestRepository_ConsumeClaimAttempt(t *testing.T) {
+	t.Parallel()
+	mockDB, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockDB.Close()
+	repo := NewRepository(mockDB)
+
+	mockDB.ExpectQuery(regexp.QuoteMeta(consumeClaimAttemptQuery)).
+		WithArgs(7, MaxClaimAttempts).
+		WillReturnRows(pgxmock.NewRows([]string{"code_hash"}).AddRow(hashCode("042137")))
+	codeHash, err := repo.ConsumeClaimAttempt(context.Background(), 7)
+	require.NoError(t, err)
+	assert.Equal(t, hashCode("042137"), codeHash)
+
+	// No row is updated once the attempts are exhausted, or the claim expired or was verified
+	mockDB.ExpectQuery(regexp.QuoteMeta(consumeClaimAttemptQuery)).
+		WithArgs(7, MaxClaimAttempts).
+		WillReturnError(pgx.ErrNoRows)
+	_, err = repo.ConsumeClaimAttempt(context.Background(), 7)
+	require.ErrorIs(t, err, ErrInvalidCode)
+
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
+
+func TestRepository_CreatePosting(t *testing.T) {
+	t.Parallel()
+	createdAt := time.Date(2024, 1, 15, 6, 0, 0, 0, time.UTC)
+	dbError := errors.New("database error")
+	// The job is inserted by jobs.Repository, whose arguments are tested there
+	jobArgs := make([]any, 22)
+	for i := range jobArgs {
+		jobArgs[i] = pgxmock.AnyArg()
+	}
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, job *jobs.Job, techs []*jobtech.JobTechnology, err error)
+	}{
+		{
+			name: "job and technologies created",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectBegin()
+				mock.ExpectQuery("INSERT INTO jobs").
+					WithArgs(jobArgs...).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "created_at", "updated_at"}).
+						AddRow(42, createdAt, createdAt))
+				mock.ExpectQuery("INSERT INTO job_technologies").
+					WithArgs(42, 1, false, true).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "created_at"}).AddRow(7, createdAt))
+				mock.ExpectQuery("INSERT INTO job_technologies").
+					WithArgs(42, 2, false, true).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "created_at"}).AddRow(8, createdAt))
+				mock.ExpectCommit()
+			},
+			checkResults: func(t *testing.T, job *jobs.Job, techs []*jobtech.JobTechnology, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 42, job.ID)
+				assert.Equal(t, 42, techs[0].JobID)
+				assert.Equal(t, 8, techs[1].ID)
+			},
+		},
+		{
+			name: "error creating a job technology rolls back",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectBegin()
+				mock.ExpectQuery("INSERT INTO jobs").
+					WithArgs(jobArgs...).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "created_at", "updated_at"}).
+						AddRow(42, createdAt, createdAt))
+				mock.ExpectQuery("INSERT INTO job_technologies").
+					WithArgs(42, 1, false, true).
+					WillReturnError(dbError)
+				mock.ExpectRollback()
+			},
+			checkResults: func(t *testing.T, _ *jobs.Job, _ []*jobtech.JobTechnology, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+		{
+			name: "begin error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectBegin().WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ *jobs.Job, _ []*jobtech.JobTechnology, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+			tt.mockSetup(mockDB)
+			repo := NewRepository(mockDB)
+
+			job := &jobs.Job{CompanyID: 3, Title: "Go Developer", Status: jobs.StatusPending}
+			techs := []*jobtech.JobTechnology{
+				{TechnologyID: 1, IsRequired: true},
+				{TechnologyID: 2, IsRequired: true},
+			}
+			err = repo.CreatePosting(context.Background(), job, techs)
+			tt.checkResults(t, job, techs, err)
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_VerifyClaim(t *testing.T) {
+	t.Parallel()
+	createdAt := time.Date(2024, 1, 15, 6, 0, 0, 0, time.UTC)
+	dbError := errors.New("database error")
+	memberColumns := []string{"company_id", "user_id", "email", "scopes", "created_at", "updated_at"}
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, member *Member, err error)
+	}{
+		{
+			name: "claimant added as member",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(verifyClaimQuery)).
+					WithArgs(7, []string{ScopeJobs}, Scopes(), MaxClaimAttempts).
+					WillReturnRows(pgxmock.NewRows(memberColumns).
+						AddRow(3, 12, "ana@techcorp.com", Scopes(), createdAt, createdAt))
+			},
+			checkResults: func(t *testing.T, member *Member, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, &Member{
+					CompanyID: 3,
+					UserID:    12,
+					Email:     "ana@techcorp.com",
+					Scopes:    []string{ScopeJobs, ScopeMembers},
+					CreatedAt: createdAt,
+					UpdatedAt: createdAt,
+				}, member)
+			},
+		},
+		{
+			name: "claim already verified",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(verifyClaimQuery)).
+					WithArgs(7, []string{ScopeJobs}, Scopes(), MaxClaimAttempts).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			checkResults: func(t *testing.T, _ *Member, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, ErrInvalidCode)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(verifyClaimQuery)).
+					WithArgs(7, []string{ScopeJobs}, Scopes(), MaxClaimAttempts).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ *Member, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			member, err := repo.VerifyClaim(context.Background(), 7, []string{ScopeJobs}, Scopes())
+			tt.checkResults(t, member, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_GetCompanyBySlug(t *testing.T) {
+	t.Parallel()
+
+	mockDB, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	domain := "techcorp.com"
+	mockDB.ExpectQuery(regexp.QuoteMeta(getCompanyBySlugQuery)).
+		WithArgs("tech-corp").
+		WillReturnRows(pgxmock.NewRows([]string{"id", "name", "slug", "email_domain", "is_active"}).
+			AddRow(3, "Tech Corp", "tech-corp", &domain, true))
+	mockDB.ExpectQuery(regexp.QuoteMeta(getCompanyBySlugQuery)).
+		WithArgs("ghost").
+		WillReturnError(pgx.ErrNoRows)
+
+	repo := NewRepository(mockDB)
+
+	company, err := repo.GetCompanyBySlug(context.Background(), "tech-corp")
+	require.NoError(t, err)
+	assert.Equal(t, &domain, company.EmailDomain)
+
+	_, err = repo.GetCompanyBySlug(context.Background(), "ghost")
+	var notFoundErr *CompanyNotFoundError
+	require.ErrorAs(t, err, &notFoundErr)
+
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
+
+func TestRepository_ListPostings(t *testing.T) {
+	t.Parallel()
+	createdAt := time.Date(2024, 1, 15, 6, 0, 0, 0, time.UTC)
+
+	mockDB, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	mockDB.ExpectQuery(regexp.QuoteMeta(listPostingsQuery)).
+		WithArgs(3, jobs.StatusRejected, 20, 0).
+		WillReturnRows(pgxmock.NewRows([]string{
+			"id", "title", "status", "is_active", "rejection_reason", "experience_level", "employment_type",
+			"location", "work_mode", "application_url", "created_at", "updated_at", "total_count",
+		}).AddRow(42, "Go Developer", jobs.StatusRejected, false, "Broken link", "Senior", "Full-time",
+			"Costa Rica", "Remote", "https://techcorp.com/careers/go", createdAt, createdAt, 1))
+
+	postings, total, err := NewRepository(mockDB).ListPostings(context.Background(),
+		&PostingListParams{CompanyID: 3, Status: jobs.StatusRejected, Limit: 20})
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, postings, 1)
+	assert.Equal(t, "Broken link", postings[0].RejectionReason)
+
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
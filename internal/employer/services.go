@@ -0,0 +1,529 @@
+package employer
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"net/mail"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobtech"
+	"github.com/rodruizronald/ticos-in-tech/internal/location"
+	"github.com/rodruizronald/ticos-in-tech/internal/technology"
+)
+
+// Claim and posting settings
+const (
+	// ClaimTTL is how long the code of a claim can be verified
+	ClaimTTL = 30 * time.Minute
+	// MaxClaimAttempts is the number of wrong codes after which a claim can't be verified anymore
+	MaxClaimAttempts = 5
+	// MaxClaimsPerHour bounds the verification emails a user can trigger
+	MaxClaimsPerHour = 5
+	claimCodeDigits  = 6
+
+	// Page size of the job list of a company
+	DefaultPostingLimit = 20
+	MaxPostingLimit     = 100
+)
+
+// domainPattern matches a lowercase domain name with at least two labels
+var domainPattern = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]*[a-z0-9])?\.)+[a-z]{2,}$`)
+
+// ClaimRepository interface to make database operations for company claims.
+type ClaimRepository interface {
+	GetCompanyBySlug(ctx context.Context, slug string) (*Company, error)
+	SetEmailDomain(ctx context.Context, slug string, domain *string) (*Company, error)
+	CreateClaim(ctx context.Context, claim *Claim) error
+	CountClaimsSince(ctx context.Context, userID int, since time.Time) (int, error)
+	GetClaim(ctx context.Context, id int) (*Claim, error)
+	ConsumeClaimAttempt(ctx context.Context, id int) (string, error)
+	VerifyClaim(ctx context.Context, id int, scopes, firstScopes []string) (*Member, error)
+}
+
+// Mailer interface to email the verification codes of claims.
+type Mailer interface {
+	Send(ctx context.Context, to, subject, body string) error
+}
+
+// ClaimService holds the business logic for users claiming companies.
+type ClaimService struct {
+	repo   ClaimRepository
+	mailer Mailer
+	now    func() time.Time
+}
+
+// NewClaimService creates a new instance of ClaimService. Claims are disabled when mailer is nil,
+// the codes couldn't be delivered.
+func NewClaimService(repo ClaimRepository, mailer Mailer) *ClaimService {
+	return &ClaimService{repo: repo, mailer: mailer, now: time.Now}
+}
+
+// Claim starts the claim of a company by a user and emails a verification code to email, which
+// must be an address of the email domain of the company or of one of its subdomains.
+func (s *ClaimService) Claim(ctx context.Context, userID int, slug, email string) (*Claim, error) {
+	if s.mailer == nil {
+		return nil, ErrClaimsDisabled
+	}
+
+	email = strings.ToLower(strings.TrimSpace(email))
+	if address, err := mail.ParseAddress(email); err != nil || address.Address != email {
+		return nil, &httpservice.ValidationError{Errors: []string{"email must be a valid email address"}}
+	}
+
+	company, err := s.repo.GetCompanyBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	if !company.IsActive || company.EmailDomain == nil {
+		return nil, &NotClaimableError{Slug: slug}
+	}
+	if !inDomain(email, *company.EmailDomain) {
+		return nil, &DomainMismatchError{Email: email, Domain: *company.EmailDomain}
+	}
+
+	now := s.now()
+	claims, err := s.repo.CountClaimsSince(ctx, userID, now.Add(-time.Hour))
+	if err != nil {
+		return nil, err
+	}
+	if claims >= MaxClaimsPerHour {
+		return nil, ErrTooManyClaims
+	}
+
+	code, err := newClaimCode()
+	if err != nil {
+		return nil, err
+	}
+	claim := &Claim{
+		CompanyID: company.ID,
+		UserID:    userID,
+		Email:     email,
+		Code:      code,
+		CodeHash:  hashCode(code),
+		ExpiresAt: now.Add(ClaimTTL),
+	}
+	if err := s.repo.CreateClaim(ctx, claim); err != nil {
+		return nil, err
+	}
+
+	subject := fmt.Sprintf("Your code to manage %s on Ticos in Tech", company.Name)
+	body := fmt.Sprintf("Your verification code is %s. It expires in %d minutes.\n\n"+
+		"If you didn't claim %s on Ticos in Tech, you can ignore this email.\n",
+		code, int(ClaimTTL.Minutes()), company.Name)
+	if err := s.mailer.Send(ctx, email, subject, body); err != nil {
+		return nil, err
+	}
+
+	return claim, nil
+}
+
+// Verify checks the code of a claim of the user and makes them a member of the company. The first
+// member of a company gets every scope, the next ones can only manage its jobs until a member with
+// the members scope grants them more. An ErrInvalidCode is returned when the code is wrong, expired
+// or was tried too many times. The attempt is counted before the code is compared, so concurrent
+// attempts can't go over MaxClaimAttempts.
+func (s *ClaimService) Verify(ctx context.Context, userID int, slug string, claimID int, code string) (*Member, error) {
+	company, err := s.repo.GetCompanyBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	claim, err := s.repo.GetClaim(ctx, claimID)
+	if err != nil {
+		return nil, err
+	}
+	if claim.UserID != userID || claim.CompanyID != company.ID {
+		return nil, &ClaimNotFoundError{ID: claimID}
+	}
+
+	codeHash, err := s.repo.ConsumeClaimAttempt(ctx, claimID)
+	if err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare([]byte(hashCode(strings.TrimSpace(code))), []byte(codeHash)) != 1 {
+		return nil, ErrInvalidCode
+	}
+
+	member, err := s.repo.VerifyClaim(ctx, claimID, []string{ScopeJobs}, Scopes())
+	if err != nil {
+		return nil, err
+	}
+	member.Company = company
+	return member, nil
+}
+
+// SetEmailDomain sets the email domain the company is claimed with, e.g. "techcorp.com". An empty
+// domain makes the company unclaimable, its current members are kept.
+func (s *ClaimService) SetEmailDomain(ctx context.Context, slug, domain string) (*Company, error) {
+	domain = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(domain)), "@")
+	if domain == "" {
+		return s.repo.SetEmailDomain(ctx, slug, nil)
+	}
+	if !domainPattern.MatchString(domain) {
+		return nil, &httpservice.ValidationError{Errors: []string{"email_domain must be a domain name, e.g. techcorp.com"}}
+	}
+	return s.repo.SetEmailDomain(ctx, slug, &domain)
+}
+
+// PortalRepository interface to make database operations for the members and jobs of companies.
+type PortalRepository interface {
+	GetCompanyBySlug(ctx context.Context, slug string) (*Company, error)
+	GetMember(ctx context.Context, companyID, userID int) (*Member, error)
+	ListMemberships(ctx context.Context, userID int) ([]*Member, error)
+	ListMembers(ctx context.Context, companyID int) ([]*Member, error)
+	UpdateMemberScopes(ctx context.Context, companyID, userID int, scopes []string) (*Member, error)
+	DeleteMember(ctx context.Context, companyID, userID int) (bool, error)
+	CountOtherManagers(ctx context.Context, companyID, userID int) (int, error)
+	ListPostings(ctx context.Context, params *PostingListParams) ([]*Posting, int, error)
+	CreatePosting(ctx context.Context, job *jobs.Job, techs []*jobtech.JobTechnology) error
+	GetPosting(ctx context.Context, companyID, id int) (*Posting, error)
+	ResubmitPosting(ctx context.Context, id int) (bool, error)
+}
+
+// JobRepository interface to change the jobs posted by members.
+type JobRepository interface {
+	Patch(ctx context.Context, id int, patch *jobs.JobPatch) (*jobs.Job, error)
+}
+
+// JobCloser interface to take down published jobs, jobs.ModerationService outside of the tests.
+type JobCloser interface {
+	Deactivate(ctx context.Context, id int) error
+}
+
+// LocationResolver interface to resolve the location of the jobs posted by members.
+type LocationResolver interface {
+	Resolve(ctx context.Context, value string) (*location.Location, error)
+}
+
+// TechnologyFinder interface to resolve the technologies of the jobs posted by members.
+type TechnologyFinder interface {
+	FindByNameOrAlias(ctx context.Context, name string) (*technology.Technology, error)
+}
+
+// PortalService holds the business logic for the members of companies managing its jobs and members.
+type PortalService struct {
+	repo      PortalRepository
+	jobRepo   JobRepository
+	closer    JobCloser
+	locations LocationResolver
+	techs     TechnologyFinder
+}
+
+// NewPortalService creates a new instance of PortalService
+func NewPortalService(repo PortalRepository, jobRepo JobRepository, closer JobCloser,
+	locations LocationResolver, techs TechnologyFinder) *PortalService {
+	return &PortalService{
+		repo:      repo,
+		jobRepo:   jobRepo,
+		closer:    closer,
+		locations: locations,
+		techs:     techs,
+	}
+}
+
+// Memberships lists the companies the user is a member of.
+func (s *PortalService) Memberships(ctx context.Context, userID int) ([]*Member, error) {
+	return s.repo.ListMemberships(ctx, userID)
+}
+
+// Members lists the members of a company. The user needs the members scope.
+func (s *PortalService) Members(ctx context.Context, userID int, slug string) ([]*Member, error) {
+	company, err := s.authorize(ctx, userID, slug, ScopeMembers)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListMembers(ctx, company.ID)
+}
+
+// SetMemberScopes replaces the scopes of a member of a company. The user needs the members scope,
+// and the company must keep another member with it when it is taken from memberID.
+func (s *PortalService) SetMemberScopes(ctx context.Context, userID int, slug string, memberID int,
+	scopes []string) (*Member, error) {
+	scopes = slices.Compact(slices.Sorted(slices.Values(scopes)))
+	for _, scope := range scopes {
+		if !slices.Contains(Scopes(), scope) {
+			return nil, &httpservice.ValidationError{Errors: []string{
+				fmt.Sprintf("scopes must be %s or %s", ScopeJobs, ScopeMembers),
+			}}
+		}
+	}
+	if len(scopes) == 0 {
+		return nil, &httpservice.ValidationError{Errors: []string{"at least one scope must be provided"}}
+	}
+
+	company, err := s.authorize(ctx, userID, slug, ScopeMembers)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(scopes, ScopeMembers) {
+		if err := s.checkOtherManagers(ctx, company, memberID); err != nil {
+			return nil, err
+		}
+	}
+
+	member, err := s.repo.UpdateMemberScopes(ctx, company.ID, memberID, scopes)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return nil, &MemberNotFoundError{Slug: slug, UserID: memberID}
+	}
+	return member, nil
+}
+
+// RemoveMember removes a member from a company. The user needs the members scope to remove the
+// others, but can always leave the company, as long as another member can manage it.
+func (s *PortalService) RemoveMember(ctx context.Context, userID int, slug string, memberID int) error {
+	scope := ScopeMembers
+	if memberID == userID {
+		scope = ""
+	}
+	company, err := s.authorize(ctx, userID, slug, scope)
+	if err != nil {
+		return err
+	}
+	if err := s.checkOtherManagers(ctx, company, memberID); err != nil {
+		return err
+	}
+
+	removed, err := s.repo.DeleteMember(ctx, company.ID, memberID)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return &MemberNotFoundError{Slug: slug, UserID: memberID}
+	}
+	return nil
+}
+
+// Postings returns a page of the jobs of a company, whatever their status. The user needs the
+// jobs scope. Unset params take their defaults and out of range values are clamped.
+func (s *PortalService) Postings(ctx context.Context, userID int, slug string,
+	params PostingListParams) (*PostingListResponse, error) {
+	if params.Status != "" && !params.Status.IsValid() {
+		return nil, &httpservice.ValidationError{Errors: []string{
+			fmt.Sprintf("status must be one of %s, %s or %s", jobs.StatusPending, jobs.StatusPublished, jobs.StatusRejected),
+		}}
+	}
+
+	company, err := s.authorize(ctx, userID, slug, ScopeJobs)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.Limit <= 0 {
+		params.Limit = DefaultPostingLimit
+	}
+	params.Limit = min(params.Limit, MaxPostingLimit)
+	params.Offset = max(params.Offset, 0)
+	params.CompanyID = company.ID
+
+	postings, total, err := s.repo.ListPostings(ctx, &params)
+	if err != nil {
+		return nil, err
+	}
+
+	return MapPostingsToResponse(postings, total, &params), nil
+}
+
+// CreatePosting posts a job for a company. The user needs the jobs scope. The job waits in the
+// moderation queue, inactive, until an admin approves it. An UnknownTechnologiesError is returned
+// when any of its technologies isn't known by name or alias.
+func (s *PortalService) CreatePosting(ctx context.Context, userID int, slug string,
+	posting *NewPosting) (*Posting, error) {
+	company, err := s.authorize(ctx, userID, slug, ScopeJobs)
+	if err != nil {
+		return nil, err
+	}
+	if !company.IsActive {
+		return nil, &ArchivedError{Slug: slug}
+	}
+
+	techIDs, err := s.resolveTechnologies(ctx, posting.Technologies)
+	if err != nil {
+		return nil, err
+	}
+
+	jobLocation, err := s.locations.Resolve(ctx, posting.Location)
+	if err != nil {
+		return nil, err
+	}
+
+	job := posting.Job
+	job.CompanyID = company.ID
+	job.Location = jobLocation.Region
+	job.LocationID = &jobLocation.ID
+	job.Status = jobs.StatusPending
+	job.IsActive = false // Only published jobs can be active
+	job.Signature = jobs.ComputeSignature(company.Name, job.Title, job.ApplicationURL)
+	jobTechs := make([]*jobtech.JobTechnology, 0, len(techIDs))
+	for _, techID := range techIDs {
+		jobTechs = append(jobTechs, &jobtech.JobTechnology{TechnologyID: techID, IsRequired: true})
+	}
+	if err := s.repo.CreatePosting(ctx, job, jobTechs); err != nil {
+		return nil, err
+	}
+
+	return mapJobToPosting(job), nil
+}
+
+// UpdatePosting changes the fields set in patch of a job of a company. The user needs the jobs
+// scope. Only jobs waiting for moderation or rejected can be changed, rejected jobs go back to
+// the moderation queue. Only admins feature jobs, so is_featured is refused.
+func (s *PortalService) UpdatePosting(ctx context.Context, userID int, slug string, id int,
+	patch *jobs.JobPatch) (*Posting, error) {
+	if patch.IsEmpty() {
+		return nil, &httpservice.ValidationError{Errors: []string{"at least one field must be provided"}}
+	}
+	if patch.UTCOffsetMin != nil && patch.UTCOffsetMax != nil && *patch.UTCOffsetMin > *patch.UTCOffsetMax {
+		return nil, &httpservice.ValidationError{Errors: []string{"utc_offset_min cannot be greater than utc_offset_max"}}
+	}
+	if patch.IsFeatured != nil {
+		return nil, &httpservice.ValidationError{Errors: []string{"is_featured can only be set by admins"}}
+	}
+
+	company, err := s.authorize(ctx, userID, slug, ScopeJobs)
+	if err != nil {
+		return nil, err
+	}
+
+	posting, err := s.getPosting(ctx, company, id)
+	if err != nil {
+		return nil, err
+	}
+	if posting.Status == jobs.StatusPublished {
+		return nil, &NotEditableError{ID: id, Status: posting.Status}
+	}
+
+	if _, err := s.jobRepo.Patch(ctx, id, patch); err != nil {
+		return nil, err
+	}
+	if posting.Status == jobs.StatusRejected {
+		if _, err := s.repo.ResubmitPosting(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.getPosting(ctx, company, id)
+}
+
+// ClosePosting takes down a published job of a company, e.g. once the position is filled. The
+// user needs the jobs scope. A jobs.NotActiveError is returned when the job isn't active.
+func (s *PortalService) ClosePosting(ctx context.Context, userID int, slug string, id int) error {
+	company, err := s.authorize(ctx, userID, slug, ScopeJobs)
+	if err != nil {
+		return err
+	}
+	if _, err := s.getPosting(ctx, company, id); err != nil {
+		return err
+	}
+	return s.closer.Deactivate(ctx, id)
+}
+
+// authorize returns the company of slug when the user is one of its members with scope, or any
+// member when scope is empty. A ForbiddenError is returned otherwise.
+func (s *PortalService) authorize(ctx context.Context, userID int, slug, scope string) (*Company, error) {
+	company, err := s.repo.GetCompanyBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := s.repo.GetMember(ctx, company.ID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return nil, &ForbiddenError{Slug: slug}
+	}
+	if scope != "" && !member.HasScope(scope) {
+		return nil, &ForbiddenError{Slug: slug, Scope: scope}
+	}
+	return company, nil
+}
+
+// checkOtherManagers returns a LastManagerError when no member besides memberID has the members scope
+func (s *PortalService) checkOtherManagers(ctx context.Context, company *Company, memberID int) error {
+	managers, err := s.repo.CountOtherManagers(ctx, company.ID, memberID)
+	if err != nil {
+		return err
+	}
+	if managers == 0 {
+		return &LastManagerError{Slug: company.Slug}
+	}
+	return nil
+}
+
+// getPosting returns a job of the company. Jobs of other companies aren't found.
+func (s *PortalService) getPosting(ctx context.Context, company *Company, id int) (*Posting, error) {
+	posting, err := s.repo.GetPosting(ctx, company.ID, id)
+	if err != nil {
+		return nil, err
+	}
+	if posting == nil {
+		return nil, &jobs.NotFoundError{ID: id}
+	}
+	return posting, nil
+}
+
+// resolveTechnologies returns the IDs of the technologies of names, without duplicates
+func (s *PortalService) resolveTechnologies(ctx context.Context, names []string) ([]int, error) {
+	var ids []int
+	var unknown []string
+	for _, name := range names {
+		tech, err := s.techs.FindByNameOrAlias(ctx, name)
+		if err != nil {
+			if technology.IsNotFound(err) {
+				unknown = append(unknown, name)
+				continue
+			}
+			return nil, err
+		}
+		if !slices.Contains(ids, tech.ID) {
+			ids = append(ids, tech.ID)
+		}
+	}
+
+	if len(unknown) > 0 {
+		return nil, &UnknownTechnologiesError{Names: unknown}
+	}
+	return ids, nil
+}
+
+// inDomain reports whether email is an address of domain or of one of its subdomains
+func inDomain(email, domain string) bool {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return false
+	}
+	emailDomain := email[at+1:]
+	return emailDomain == domain || strings.HasSuffix(emailDomain, "."+domain)
+}
+
+// newClaimCode generates a random numeric verification code
+func newClaimCode() (string, error) {
+	limit := big.NewInt(1)
+	for range claimCodeDigits {
+		limit.Mul(limit, big.NewInt(10))
+	}
+	n, err := rand.Int(rand.Reader, limit)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate verification code: %w", err)
+	}
+	return fmt.Sprintf("%0*d", claimCodeDigits, n), nil
+}
+
+// hashCode returns the hex SHA-256 of a verification code, the form in which it is stored
+func hashCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,624 @@
+package employer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobtech"
+	"github.com/rodruizronald/ticos-in-tech/internal/location"
+	"github.com/rodruizronald/ticos-in-tech/internal/technology"
+)
+
+var testNow = time.Date(2024, 1, 15, 6, 0, 0, 0, time.UTC)
+
+func testCompany() *Company {
+	domain := "techcorp.com"
+	return &Company{ID: 3, Name: "Tech Corp", Slug: "tech-corp", EmailDomain: &domain, IsActive: true}
+}
+
+func TestClaimService_Claim(t *testing.T) {
+	t.Parallel()
+	sendError := errors.New("send error")
+
+	tests := []struct {
+		name         string
+		email        string
+		mockSetup    func(mockRepo *MockClaimRepository, mockMailer *MockMailer)
+		checkResults func(t *testing.T, claim *Claim, err error)
+	}{
+		{
+			name:  "code emailed to an address of a subdomain",
+			email: " Ana@CR.TechCorp.com ",
+			mockSetup: func(mockRepo *MockClaimRepository, mockMailer *MockMailer) {
+				t.Helper()
+				mockRepo.EXPECT().GetCompanyBySlug(context.Background(), "tech-corp").Return(testCompany(), nil).Once()
+				mockRepo.EXPECT().CountClaimsSince(context.Background(), 12, testNow.Add(-time.Hour)).
+					Return(MaxClaimsPerHour-1, nil).Once()
+				mockRepo.EXPECT().CreateClaim(context.Background(), mock.MatchedBy(func(claim *Claim) bool {
+					return claim.CompanyID == 3 && claim.UserID == 12 && claim.Email == "ana@cr.techcorp.com" &&
+						claim.CodeHash == hashCode(claim.Code) && claim.ExpiresAt.Equal(testNow.Add(ClaimTTL))
+				})).Return(nil).Once()
+				mockMailer.EXPECT().Send(context.Background(), "ana@cr.techcorp.com",
+					"Your code to manage Tech Corp on Ticos in Tech", mock.Anything).
+					Run(func(_ context.Context, _, _, body string) {
+						assert.Contains(t, body, "expires in 30 minutes")
+					}).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, claim *Claim, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Len(t, claim.Code, 6)
+			},
+		},
+		{
+			name:  "address of another domain",
+			email: "ana@nottechcorp.com",
+			mockSetup: func(mockRepo *MockClaimRepository, _ *MockMailer) {
+				t.Helper()
+				mockRepo.EXPECT().GetCompanyBySlug(context.Background(), "tech-corp").Return(testCompany(), nil).Once()
+			},
+			checkResults: func(t *testing.T, _ *Claim, err error) {
+				t.Helper()
+				var mismatchErr *DomainMismatchError
+				require.ErrorAs(t, err, &mismatchErr)
+				assert.Equal(t, "techcorp.com", mismatchErr.Domain)
+				require.ErrorIs(t, err, httpservice.ErrInvalid)
+			},
+		},
+		{
+			name:  "company without an email domain",
+			email: "ana@techcorp.com",
+			mockSetup: func(mockRepo *MockClaimRepository, _ *MockMailer) {
+				t.Helper()
+				company := testCompany()
+				company.EmailDomain = nil
+				mockRepo.EXPECT().GetCompanyBySlug(context.Background(), "tech-corp").Return(company, nil).Once()
+			},
+			checkResults: func(t *testing.T, _ *Claim, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, httpservice.ErrConflict)
+			},
+		},
+		{
+			name:  "too many claims",
+			email: "ana@techcorp.com",
+			mockSetup: func(mockRepo *MockClaimRepository, _ *MockMailer) {
+				t.Helper()
+				mockRepo.EXPECT().GetCompanyBySlug(context.Background(), "tech-corp").Return(testCompany(), nil).Once()
+				mockRepo.EXPECT().CountClaimsSince(context.Background(), 12, testNow.Add(-time.Hour)).
+					Return(MaxClaimsPerHour, nil).Once()
+			},
+			checkResults: func(t *testing.T, _ *Claim, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, ErrTooManyClaims)
+			},
+		},
+		{
+			name:      "invalid email",
+			email:     "ana",
+			mockSetup: func(*MockClaimRepository, *MockMailer) {},
+			checkResults: func(t *testing.T, _ *Claim, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+			},
+		},
+		{
+			name:  "mailer error",
+			email: "ana@techcorp.com",
+			mockSetup: func(mockRepo *MockClaimRepository, mockMailer *MockMailer) {
+				t.Helper()
+				mockRepo.EXPECT().GetCompanyBySlug(context.Background(), "tech-corp").Return(testCompany(), nil).Once()
+				mockRepo.EXPECT().CountClaimsSince(context.Background(), 12, mock.Anything).Return(0, nil).Once()
+				mockRepo.EXPECT().CreateClaim(context.Background(), mock.Anything).Return(nil).Once()
+				mockMailer.EXPECT().Send(context.Background(), "ana@techcorp.com", mock.Anything, mock.Anything).
+					Return(sendError).Once()
+			},
+			checkResults: func(t *testing.T, _ *Claim, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, sendError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockClaimRepository(t)
+			mockMailer := NewMockMailer(t)
+			service := NewClaimService(mockRepo, mockMailer)
+			service.now = func() time.Time { return testNow }
+
+			tt.mockSetup(mockRepo, mockMailer)
+
+			claim, err := service.Claim(context.Background(), 12, "tech-corp", tt.email)
+			tt.checkResults(t, claim, err)
+		})
+	}
+}
+
+func TestClaimService_ClaimDisabled(t *testing.T) {
+	t.Parallel()
+
+	service := NewClaimService(NewMockClaimRepository(t), nil)
+
+	_, err := service.Claim(context.Background(), 12, "tech-corp", "ana@techcorp.com")
+	require.ErrorIs(t, err, httpservice.ErrUnavailable)
+}
+
+func TestClaimService_Verify(t *testing.T) {
+	t.Parallel()
+	validClaim := func() *Claim {
+		return &Claim{
+			ID: 7, CompanyID: 3, UserID: 12, Email: "ana@techcorp.com",
+			CodeHash: hashCode("042137"), Attempts: 1, ExpiresAt: testNow.Add(time.Minute),
+		}
+	}
+
+	tests := []struct {
+		name         string
+		code         string
+		mockSetup    func(mockRepo *MockClaimRepository)
+		checkResults func(t *testing.T, member *Member, err error)
+	}{
+		{
+			name: "claimant becomes a member",
+			code: "042137",
+			mockSetup: func(mockRepo *MockClaimRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetCompanyBySlug(context.Background(), "tech-corp").Return(testCompany(), nil).Once()
+				mockRepo.EXPECT().GetClaim(context.Background(), 7).Return(validClaim(), nil).Once()
+				mockRepo.EXPECT().ConsumeClaimAttempt(context.Background(), 7).Return(hashCode("042137"), nil).Once()
+				mockRepo.EXPECT().VerifyClaim(context.Background(), 7, []string{ScopeJobs}, []string{ScopeJobs, ScopeMembers}).
+					Return(&Member{CompanyID: 3, UserID: 12, Scopes: []string{ScopeJobs, ScopeMembers}}, nil).Once()
+			},
+			checkResults: func(t *testing.T, member *Member, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.True(t, member.HasScope(ScopeMembers))
+				assert.Equal(t, "tech-corp", member.Company.Slug)
+			},
+		},
+		{
+			name: "wrong code counted",
+			code: "000000",
+			mockSetup: func(mockRepo *MockClaimRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetCompanyBySlug(context.Background(), "tech-corp").Return(testCompany(), nil).Once()
+				mockRepo.EXPECT().GetClaim(context.Background(), 7).Return(validClaim(), nil).Once()
+				mockRepo.EXPECT().ConsumeClaimAttempt(context.Background(), 7).Return(hashCode("042137"), nil).Once()
+			},
+			checkResults: func(t *testing.T, _ *Member, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, ErrInvalidCode)
+			},
+		},
+		{
+			name: "attempts exhausted or claim expired",
+			code: "042137",
+			mockSetup: func(mockRepo *MockClaimRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetCompanyBySlug(context.Background(), "tech-corp").Return(testCompany(), nil).Once()
+				mockRepo.EXPECT().GetClaim(context.Background(), 7).Return(validClaim(), nil).Once()
+				mockRepo.EXPECT().ConsumeClaimAttempt(context.Background(), 7).Return("", ErrInvalidCode).Once()
+			},
+			checkResults: func(t *testing.T, _ *Member, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, ErrInvalidCode)
+			},
+		},
+		{
+			name: "claim of another user",
+			code: "042137",
+			mockSetup: func(mockRepo *MockClaimRepository) {
+				t.Helper()
+				claim := validClaim()
+				claim.UserID = 13
+				mockRepo.EXPECT().GetCompanyBySlug(context.Background(), "tech-corp").Return(testCompany(), nil).Once()
+				mockRepo.EXPECT().GetClaim(context.Background(), 7).Return(claim, nil).Once()
+			},
+			checkResults: func(t *testing.T, _ *Member, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, httpservice.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockClaimRepository(t)
+			service := NewClaimService(mockRepo, NewMockMailer(t))
+			service.now = func() time.Time { return testNow }
+
+			tt.mockSetup(mockRepo)
+
+			member, err := service.Verify(context.Background(), 12, "tech-corp", 7, tt.code)
+			tt.checkResults(t, member, err)
+		})
+	}
+}
+
+func TestClaimService_SetEmailDomain(t *testing.T) {
+	t.Parallel()
+
+	mockRepo := NewMockClaimRepository(t)
+	service := NewClaimService(mockRepo, nil)
+
+	domain := "techcorp.com"
+	mockRepo.EXPECT().SetEmailDomain(context.Background(), "tech-corp", &domain).Return(testCompany(), nil).Once()
+	mockRepo.EXPECT().SetEmailDomain(context.Background(), "tech-corp", (*string)(nil)).Return(&Company{}, nil).Once()
+
+	_, err := service.SetEmailDomain(context.Background(), "tech-corp", " @TechCorp.com ")
+	require.NoError(t, err)
+
+	_, err = service.SetEmailDomain(context.Background(), "tech-corp", "")
+	require.NoError(t, err)
+
+	_, err = service.SetEmailDomain(context.Background(), "tech-corp", "https://techcorp.com")
+	var validationErr *httpservice.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+}
+
+type portalMocks struct {
+	repo      *MockPortalRepository
+	jobRepo   *MockJobRepository
+	closer    *MockJobCloser
+	locations *MockLocationResolver
+	techs     *MockTechnologyFinder
+}
+
+func newPortalService(t *testing.T) (*PortalService, *portalMocks) {
+	t.Helper()
+	mocks := &portalMocks{
+		repo:      NewMockPortalRepository(t),
+		jobRepo:   NewMockJobRepository(t),
+		closer:    NewMockJobCloser(t),
+		locations: NewMockLocationResolver(t),
+		techs:     NewMockTechnologyFinder(t),
+	}
+	service := NewPortalService(mocks.repo, mocks.jobRepo, mocks.closer, mocks.locations, mocks.techs)
+	return service, mocks
+}
+
+// expectMember makes user 12 a member of Tech Corp with scopes
+func (m *portalMocks) expectMember(scopes ...string) {
+	m.repo.EXPECT().GetCompanyBySlug(context.Background(), "tech-corp").Return(testCompany(), nil).Once()
+	if scopes == nil {
+		m.repo.EXPECT().GetMember(context.Background(), 3, 12).Return(nil, nil).Once()
+		return
+	}
+	m.repo.EXPECT().GetMember(context.Background(), 3, 12).
+		Return(&Member{CompanyID: 3, UserID: 12, Scopes: scopes}, nil).Once()
+}
+
+func TestPortalService_CreatePosting(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+	newPosting := func() *NewPosting {
+		return &NewPosting{
+			Job: &jobs.Job{
+				Title:          "Go Developer",
+				RawDescription: "<p>Build APIs</p>",
+				WorkMode:       "Remote",
+				ApplicationURL: "https://techcorp.com/careers/go",
+			},
+			Location:     "San José, Costa Rica",
+			Technologies: []string{"golang", "Go", "PostgreSQL"},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mocks *portalMocks)
+		checkResults func(t *testing.T, posting *Posting, err error)
+	}{
+		{
+			name: "job created pending with its technologies",
+			mockSetup: func(mocks *portalMocks) {
+				t.Helper()
+				mocks.expectMember(ScopeJobs)
+				mocks.techs.EXPECT().FindByNameOrAlias(context.Background(), "golang").
+					Return(&technology.Technology{ID: 1, Name: "go"}, nil).Once()
+				mocks.techs.EXPECT().FindByNameOrAlias(context.Background(), "Go").
+					Return(&technology.Technology{ID: 1, Name: "go"}, nil).Once()
+				mocks.techs.EXPECT().FindByNameOrAlias(context.Background(), "PostgreSQL").
+					Return(&technology.Technology{ID: 2, Name: "postgresql"}, nil).Once()
+				mocks.locations.EXPECT().Resolve(context.Background(), "San José, Costa Rica").
+					Return(&location.Location{ID: 5, Region: "Costa Rica"}, nil).Once()
+				mocks.repo.EXPECT().CreatePosting(context.Background(), mock.MatchedBy(func(job *jobs.Job) bool {
+					return job.CompanyID == 3 && job.Status == jobs.StatusPending && !job.IsActive &&
+						job.Location == "Costa Rica" && *job.LocationID == 5 &&
+						job.Signature == jobs.ComputeSignature("Tech Corp", "Go Developer", "https://techcorp.com/careers/go")
+				}), []*jobtech.JobTechnology{
+					{TechnologyID: 1, IsRequired: true},
+					{TechnologyID: 2, IsRequired: true},
+				}).Run(func(_ context.Context, job *jobs.Job, _ []*jobtech.JobTechnology) { job.ID = 42 }).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, posting *Posting, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 42, posting.ID)
+				assert.Equal(t, jobs.StatusPending, posting.Status)
+			},
+		},
+		{
+			name: "job and technologies not created",
+			mockSetup: func(mocks *portalMocks) {
+				t.Helper()
+				mocks.expectMember(ScopeJobs)
+				mocks.techs.EXPECT().FindByNameOrAlias(context.Background(), mock.Anything).
+					Return(&technology.Technology{ID: 1, Name: "go"}, nil).Times(3)
+				mocks.locations.EXPECT().Resolve(context.Background(), "San José, Costa Rica").
+					Return(&location.Location{ID: 5, Region: "Costa Rica"}, nil).Once()
+				mocks.repo.EXPECT().CreatePosting(context.Background(), mock.Anything, mock.Anything).Return(dbError).Once()
+			},
+			checkResults: func(t *testing.T, posting *Posting, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+				assert.Nil(t, posting)
+			},
+		},
+		{
+			name: "unknown technologies",
+			mockSetup: func(mocks *portalMocks) {
+				t.Helper()
+				mocks.expectMember(ScopeJobs)
+				mocks.techs.EXPECT().FindByNameOrAlias(context.Background(), "golang").
+					Return(&technology.Technology{ID: 1, Name: "go"}, nil).Once()
+				mocks.techs.EXPECT().FindByNameOrAlias(context.Background(), "Go").
+					Return(&technology.Technology{ID: 1, Name: "go"}, nil).Once()
+				mocks.techs.EXPECT().FindByNameOrAlias(context.Background(), "PostgreSQL").
+					Return(nil, &technology.NotFoundError{Name: "postgresql"}).Once()
+			},
+			checkResults: func(t *testing.T, _ *Posting, err error) {
+				t.Helper()
+				var unknownErr *UnknownTechnologiesError
+				require.ErrorAs(t, err, &unknownErr)
+				assert.Equal(t, []string{"PostgreSQL"}, unknownErr.Names)
+				require.ErrorIs(t, err, httpservice.ErrInvalid)
+			},
+		},
+		{
+			name: "member without the jobs scope",
+			mockSetup: func(mocks *portalMocks) {
+				t.Helper()
+				mocks.expectMember(ScopeMembers)
+			},
+			checkResults: func(t *testing.T, _ *Posting, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, httpservice.ErrForbidden)
+				assert.EqualError(t, err, "missing the jobs scope of company tech-corp")
+			},
+		},
+		{
+			name: "not a member",
+			mockSetup: func(mocks *portalMocks) {
+				t.Helper()
+				mocks.expectMember()
+			},
+			checkResults: func(t *testing.T, _ *Posting, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, httpservice.ErrForbidden)
+			},
+		},
+		{
+			name: "repository error",
+			mockSetup: func(mocks *portalMocks) {
+				t.Helper()
+				mocks.repo.EXPECT().GetCompanyBySlug(context.Background(), "tech-corp").Return(testCompany(), nil).Once()
+				mocks.repo.EXPECT().GetMember(context.Background(), 3, 12).Return(nil, dbError).Once()
+			},
+			checkResults: func(t *testing.T, _ *Posting, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			service, mocks := newPortalService(t)
+
+			tt.mockSetup(mocks)
+
+			posting, err := service.CreatePosting(context.Background(), 12, "tech-corp", newPosting())
+			tt.checkResults(t, posting, err)
+		})
+	}
+}
+
+func TestPortalService_UpdatePosting(t *testing.T) {
+	t.Parallel()
+	title := "Senior Go Developer"
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mocks *portalMocks)
+		checkResults func(t *testing.T, posting *Posting, err error)
+	}{
+		{
+			name: "rejected job resubmitted",
+			mockSetup: func(mocks *portalMocks) {
+				t.Helper()
+				mocks.expectMember(ScopeJobs)
+				mocks.repo.EXPECT().GetPosting(context.Background(), 3, 42).
+					Return(&Posting{ID: 42, Status: jobs.StatusRejected}, nil).Once()
+				mocks.jobRepo.EXPECT().Patch(context.Background(), 42, &jobs.JobPatch{Title: &title}).
+					Return(&jobs.Job{ID: 42}, nil).Once()
+				mocks.repo.EXPECT().ResubmitPosting(context.Background(), 42).Return(true, nil).Once()
+				mocks.repo.EXPECT().GetPosting(context.Background(), 3, 42).
+					Return(&Posting{ID: 42, Title: title, Status: jobs.StatusPending}, nil).Once()
+			},
+			checkResults: func(t *testing.T, posting *Posting, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, jobs.StatusPending, posting.Status)
+			},
+		},
+		{
+			name: "published job",
+			mockSetup: func(mocks *portalMocks) {
+				t.Helper()
+				mocks.expectMember(ScopeJobs)
+				mocks.repo.EXPECT().GetPosting(context.Background(), 3, 42).
+					Return(&Posting{ID: 42, Status: jobs.StatusPublished}, nil).Once()
+			},
+			checkResults: func(t *testing.T, _ *Posting, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, httpservice.ErrConflict)
+			},
+		},
+		{
+			name: "job of another company",
+			mockSetup: func(mocks *portalMocks) {
+				t.Helper()
+				mocks.expectMember(ScopeJobs)
+				mocks.repo.EXPECT().GetPosting(context.Background(), 3, 42).Return(nil, nil).Once()
+			},
+			checkResults: func(t *testing.T, _ *Posting, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, httpservice.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			service, mocks := newPortalService(t)
+
+			tt.mockSetup(mocks)
+
+			posting, err := service.UpdatePosting(context.Background(), 12, "tech-corp", 42, &jobs.JobPatch{Title: &title})
+			tt.checkResults(t, posting, err)
+		})
+	}
+
+	t.Run("featured flag refused", func(t *testing.T) {
+		t.Parallel()
+		service, _ := newPortalService(t)
+		featured := true
+
+		_, err := service.UpdatePosting(context.Background(), 12, "tech-corp", 42,
+			&jobs.JobPatch{Title: &title, IsFeatured: &featured})
+		var validationErr *httpservice.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []string{"is_featured can only be set by admins"}, validationErr.Errors)
+	})
+}
+
+func TestPortalService_SetMemberScopes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		memberID     int
+		scopes       []string
+		mockSetup    func(mocks *portalMocks)
+		checkResults func(t *testing.T, member *Member, err error)
+	}{
+		{
+			name:     "scopes deduplicated",
+			memberID: 13,
+			scopes:   []string{ScopeMembers, ScopeJobs, ScopeMembers},
+			mockSetup: func(mocks *portalMocks) {
+				t.Helper()
+				mocks.expectMember(ScopeMembers)
+				mocks.repo.EXPECT().UpdateMemberScopes(context.Background(), 3, 13, []string{ScopeJobs, ScopeMembers}).
+					Return(&Member{UserID: 13, Scopes: []string{ScopeJobs, ScopeMembers}}, nil).Once()
+			},
+			checkResults: func(t *testing.T, member *Member, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 13, member.UserID)
+			},
+		},
+		{
+			name:     "last manager",
+			memberID: 12,
+			scopes:   []string{ScopeJobs},
+			mockSetup: func(mocks *portalMocks) {
+				t.Helper()
+				mocks.expectMember(ScopeMembers)
+				mocks.repo.EXPECT().CountOtherManagers(context.Background(), 3, 12).Return(0, nil).Once()
+			},
+			checkResults: func(t *testing.T, _ *Member, err error) {
+				t.Helper()
+				var lastErr *LastManagerError
+				require.ErrorAs(t, err, &lastErr)
+			},
+		},
+		{
+			name:     "unknown member",
+			memberID: 14,
+			scopes:   []string{ScopeJobs},
+			mockSetup: func(mocks *portalMocks) {
+				t.Helper()
+				mocks.expectMember(ScopeMembers)
+				mocks.repo.EXPECT().CountOtherManagers(context.Background(), 3, 14).Return(1, nil).Once()
+				mocks.repo.EXPECT().UpdateMemberScopes(context.Background(), 3, 14, []string{ScopeJobs}).
+					Return(nil, nil).Once()
+			},
+			checkResults: func(t *testing.T, _ *Member, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, httpservice.ErrNotFound)
+			},
+		},
+		{
+			name:      "unknown scope",
+			memberID:  13,
+			scopes:    []string{"admin"},
+			mockSetup: func(*portalMocks) {},
+			checkResults: func(t *testing.T, _ *Member, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			service, mocks := newPortalService(t)
+
+			tt.mockSetup(mocks)
+
+			member, err := service.SetMemberScopes(context.Background(), 12, "tech-corp", tt.memberID, tt.scopes)
+			tt.checkResults(t, member, err)
+		})
+	}
+}
+
+func TestPortalService_RemoveMember(t *testing.T) {
+	t.Parallel()
+
+	t.Run("member leaves without the members scope", func(t *testing.T) {
+		t.Parallel()
+		service, mocks := newPortalService(t)
+		mocks.expectMember(ScopeJobs)
+		mocks.repo.EXPECT().CountOtherManagers(context.Background(), 3, 12).Return(1, nil).Once()
+		mocks.repo.EXPECT().DeleteMember(context.Background(), 3, 12).Return(true, nil).Once()
+
+		require.NoError(t, service.RemoveMember(context.Background(), 12, "tech-corp", 12))
+	})
+
+	t.Run("other member needs the members scope", func(t *testing.T) {
+		t.Parallel()
+		service, mocks := newPortalService(t)
+		mocks.expectMember(ScopeJobs)
+
+		err := service.RemoveMember(context.Background(), 12, "tech-corp", 13)
+		require.ErrorIs(t, err, httpservice.ErrForbidden)
+	})
+}
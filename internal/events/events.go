@@ -0,0 +1,113 @@
+// Package events is an in-process bus of domain events. Services publish what happened, like a job
+// being created or a technology being added, and the interested parts of the application, like
+// webhooks or cache invalidation, subscribe independently instead of being wired into the services.
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+	"github.com/rodruizronald/ticos-in-tech/internal/technology"
+)
+
+// Domain event types
+const (
+	JobCreated      = jobs.EventCreated
+	JobUpdated      = jobs.EventUpdated
+	JobDeactivated  = jobs.EventDeactivated
+	TechnologyAdded = technology.EventAdded
+)
+
+// JobEvents are the types of the job lifecycle events
+var JobEvents = []string{JobCreated, JobUpdated, JobDeactivated}
+
+// Event is something that happened in the domain
+type Event struct {
+	Type       string
+	OccurredAt time.Time
+	// Job is set for the job events
+	Job *jobs.Job
+	// Technology is set for the technology events
+	Technology *technology.Technology
+}
+
+// Handler handles an event. It runs in the goroutine of the publisher, so slow work must be queued.
+type Handler func(ctx context.Context, event *Event) error
+
+// subscriber is a named handler of some event types
+type subscriber struct {
+	name       string
+	eventTypes []string
+	handle     Handler
+}
+
+// Bus delivers the published events to their subscribers. It implements jobs.EventPublisher and
+// technology.EventPublisher, so the services publish to it without knowing the subscribers.
+type Bus struct {
+	subscribers []subscriber
+	now         func() time.Time
+}
+
+// NewBus creates a new instance of Bus without subscribers
+func NewBus() *Bus {
+	return &Bus{now: time.Now}
+}
+
+// Subscribe registers handler for the events of eventTypes, all the events when none are given.
+// name identifies the subscriber in the errors. Subscribers must be registered before publishing.
+func (b *Bus) Subscribe(name string, handler Handler, eventTypes ...string) {
+	b.subscribers = append(b.subscribers, subscriber{name: name, eventTypes: eventTypes, handle: handler})
+}
+
+// Publish delivers event to its subscribers in the order they subscribed. A failing subscriber
+// doesn't stop the others, the errors are joined.
+func (b *Bus) Publish(ctx context.Context, event *Event) error {
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = b.now().UTC()
+	}
+
+	var errs []error
+	for _, s := range b.subscribers {
+		if len(s.eventTypes) > 0 && !slices.Contains(s.eventTypes, event.Type) {
+			continue
+		}
+		if err := s.deliver(ctx, event); err != nil {
+			errs = append(errs, fmt.Errorf("subscriber %s: %w", s.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// PublishJobEvent publishes a job lifecycle event
+func (b *Bus) PublishJobEvent(ctx context.Context, eventType string, job *jobs.Job) error {
+	return b.Publish(ctx, &Event{Type: eventType, Job: job})
+}
+
+// PublishTechnologyEvent publishes a technology event
+func (b *Bus) PublishTechnologyEvent(ctx context.Context, eventType string, tech *technology.Technology) error {
+	return b.Publish(ctx, &Event{Type: eventType, Technology: tech})
+}
+
+// deliver passes event to the handler, turning a panic into an error so other subscribers still run
+func (s *subscriber) deliver(ctx context.Context, event *Event) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic handling %s event: %v", event.Type, r)
+		}
+	}()
+	return s.handle(ctx, event)
+}
+
+// JobHandler adapts a jobs.EventPublisher, like webhooks.Publisher, into a handler of the job events
+func JobHandler(publisher jobs.EventPublisher) Handler {
+	return func(ctx context.Context, event *Event) error {
+		if event.Job == nil {
+			return nil
+		}
+		return publisher.PublishJobEvent(ctx, event.Type, event.Job)
+	}
+}
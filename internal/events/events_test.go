@@ -0,0 +1,117 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+	"github.com/rodruizronald/ticos-in-tech/internal/technology"
+)
+
+func TestBus_Publish(t *testing.T) {
+	t.Parallel()
+	handlerError := errors.New("handler error")
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	// recordingHandler appends the name of the subscriber and the event type to delivered
+	recordingHandler := func(delivered *[]string, name string, err error) Handler {
+		return func(_ context.Context, event *Event) error {
+			*delivered = append(*delivered, name+" "+event.Type)
+			return err
+		}
+	}
+
+	tests := []struct {
+		name         string
+		subscribe    func(bus *Bus, delivered *[]string)
+		event        *Event
+		checkResults func(t *testing.T, delivered []string, event *Event, err error)
+	}{
+		{
+			name: "delivered to the subscribers of its type in order",
+			subscribe: func(bus *Bus, delivered *[]string) {
+				bus.Subscribe("webhooks", recordingHandler(delivered, "webhooks", nil), JobEvents...)
+				bus.Subscribe("techs", recordingHandler(delivered, "techs", nil), TechnologyAdded)
+				bus.Subscribe("audit", recordingHandler(delivered, "audit", nil))
+			},
+			event: &Event{Type: JobCreated, Job: &jobs.Job{ID: 7}},
+			checkResults: func(t *testing.T, delivered []string, event *Event, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, []string{"webhooks job.created", "audit job.created"}, delivered)
+				assert.Equal(t, now, event.OccurredAt)
+			},
+		},
+		{
+			name: "occurrence time is kept",
+			subscribe: func(bus *Bus, delivered *[]string) {
+				bus.Subscribe("techs", recordingHandler(delivered, "techs", nil), TechnologyAdded)
+			},
+			event: &Event{Type: TechnologyAdded, OccurredAt: now.Add(-time.Hour), Technology: &technology.Technology{ID: 1}},
+			checkResults: func(t *testing.T, delivered []string, event *Event, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, []string{"techs technology.added"}, delivered)
+				assert.Equal(t, now.Add(-time.Hour), event.OccurredAt)
+			},
+		},
+		{
+			name: "failing subscriber doesn't stop the others",
+			subscribe: func(bus *Bus, delivered *[]string) {
+				bus.Subscribe("webhooks", recordingHandler(delivered, "webhooks", handlerError))
+				bus.Subscribe("panicking", func(context.Context, *Event) error { panic("boom") })
+				bus.Subscribe("audit", recordingHandler(delivered, "audit", nil))
+			},
+			event: &Event{Type: JobDeactivated, Job: &jobs.Job{ID: 7}},
+			checkResults: func(t *testing.T, delivered []string, _ *Event, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, handlerError)
+				assert.ErrorContains(t, err, "subscriber webhooks")
+				assert.ErrorContains(t, err, "subscriber panicking: panic handling job.deactivated event: boom")
+				assert.Equal(t, []string{"webhooks job.deactivated", "audit job.deactivated"}, delivered)
+			},
+		},
+		{
+			name:      "no subscribers",
+			subscribe: func(_ *Bus, _ *[]string) {},
+			event:     &Event{Type: JobUpdated, Job: &jobs.Job{ID: 7}},
+			checkResults: func(t *testing.T, delivered []string, _ *Event, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Empty(t, delivered)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			bus := NewBus()
+			bus.now = func() time.Time { return now }
+			var delivered []string
+			tt.subscribe(bus, &delivered)
+
+			err := bus.Publish(context.Background(), tt.event)
+			tt.checkResults(t, delivered, tt.event, err)
+		})
+	}
+}
+
+func TestBus_PublishJobEvent(t *testing.T) {
+	t.Parallel()
+	job := &jobs.Job{ID: 7, Title: "Go Developer"}
+	publisher := jobs.NewMockEventPublisher(t)
+	publisher.EXPECT().PublishJobEvent(context.Background(), JobCreated, job).Return(nil).Once()
+
+	bus := NewBus()
+	bus.Subscribe("webhooks", JobHandler(publisher), JobEvents...)
+
+	require.NoError(t, bus.PublishJobEvent(context.Background(), JobCreated, job))
+	// Technology events don't reach the job handler
+	require.NoError(t, bus.PublishTechnologyEvent(context.Background(), TechnologyAdded, &technology.Technology{ID: 1}))
+}
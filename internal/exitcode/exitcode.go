@@ -0,0 +1,101 @@
+// Package exitcode defines the exit codes of the populators and titoctl, so CI pipelines and
+// schedulers can tell an invalid configuration or an unreachable database from an import where
+// some items failed, and react accordingly.
+package exitcode
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Exit codes
+const (
+	// OK is the exit code of a successful run
+	OK = 0
+	// Failure is the exit code of the errors without a more specific code
+	Failure = 1
+	// Usage is the exit code of invalid command line arguments, the one of the flag package
+	Usage = 2
+	// Config is the exit code of an invalid configuration
+	Config = 3
+	// Database is the exit code of a database that can't be reached
+	Database = 4
+	// Validation is the exit code of a run whose input had invalid items, skipped while the
+	// others were processed
+	Validation = 5
+	// Partial is the exit code of a run where some items failed to be processed
+	Partial = 6
+)
+
+// Error is an error ending a command with an exit code
+type Error struct {
+	Code int
+	Err  error
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Wrap returns err ending a command with the exit code, nil when err is nil
+func Wrap(code int, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{Code: code, Err: err}
+}
+
+// Of returns the exit code of a command ending with err: OK without error, the code of the Error
+// it wraps, Failure otherwise
+func Of(err error) int {
+	if err == nil {
+		return OK
+	}
+	var codeErr *Error
+	if errors.As(err, &codeErr) {
+		return codeErr.Code
+	}
+	return Failure
+}
+
+// Collector collects the errors of the items processed by a run, so the run goes on with the
+// other items and fails once done. In strict mode the run stops at the first error instead.
+type Collector struct {
+	strict bool
+	errs   []error
+	code   int
+}
+
+// NewCollector creates a collector, stopping at the first error in strict mode
+func NewCollector(strict bool) *Collector {
+	return &Collector{strict: strict}
+}
+
+// Add records the error of an item with its exit code, Validation or Partial. In strict mode it
+// returns the error with its exit code, for the run to stop, and nil otherwise.
+func (c *Collector) Add(code int, err error) error {
+	c.errs = append(c.errs, err)
+	c.code = max(c.code, code)
+	if c.strict {
+		return Wrap(code, err)
+	}
+	return nil
+}
+
+// Len returns the number of errors recorded
+func (c *Collector) Len() int {
+	return len(c.errs)
+}
+
+// Err returns the recorded errors joined, with the most severe exit code among them: Partial
+// over Validation. It is nil without errors.
+func (c *Collector) Err() error {
+	if len(c.errs) == 0 {
+		return nil
+	}
+	return Wrap(c.code, fmt.Errorf("%d items failed: %w", len(c.errs), errors.Join(c.errs...)))
+}
@@ -0,0 +1,59 @@
+package exitcode
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOf(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("connection refused")
+
+	assert.Equal(t, OK, Of(nil))
+	assert.Equal(t, Failure, Of(dbError))
+	assert.Equal(t, Database, Of(Wrap(Database, dbError)))
+	assert.Equal(t, Config, Of(fmt.Errorf("loading: %w", Wrap(Config, dbError))))
+	assert.NoError(t, Wrap(Config, nil))
+	assert.ErrorIs(t, Wrap(Database, dbError), dbError)
+}
+
+func TestCollector(t *testing.T) {
+	t.Parallel()
+	invalidErr := errors.New("job 3: missing title")
+	failedErr := errors.New("job 7: company not found")
+
+	t.Run("no errors", func(t *testing.T) {
+		t.Parallel()
+		collector := NewCollector(false)
+		assert.NoError(t, collector.Err())
+		assert.Zero(t, collector.Len())
+	})
+
+	t.Run("most severe code", func(t *testing.T) {
+		t.Parallel()
+		collector := NewCollector(false)
+		require.NoError(t, collector.Add(Validation, invalidErr))
+		assert.Equal(t, Validation, Of(collector.Err()))
+
+		require.NoError(t, collector.Add(Partial, failedErr))
+		require.NoError(t, collector.Add(Validation, invalidErr))
+		err := collector.Err()
+		assert.Equal(t, Partial, Of(err))
+		assert.Equal(t, 3, collector.Len())
+		assert.ErrorIs(t, err, invalidErr)
+		assert.ErrorIs(t, err, failedErr)
+		assert.Contains(t, err.Error(), "3 items failed")
+	})
+
+	t.Run("strict", func(t *testing.T) {
+		t.Parallel()
+		collector := NewCollector(true)
+		err := collector.Add(Partial, failedErr)
+		require.ErrorIs(t, err, failedErr)
+		assert.Equal(t, Partial, Of(err))
+	})
+}
@@ -0,0 +1,69 @@
+package fxrate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	// DefaultURL is the exchange rate API the rates are fetched from, with US dollar rates. Its
+	// rates are updated daily and need no API key.
+	DefaultURL = "https://open.er-api.com/v6/latest/USD"
+	// DefaultTimeout bounds each request to the exchange rate API
+	DefaultTimeout = 10 * time.Second
+
+	// maxErrorBodySize bounds how much of an error response is kept in the error message
+	maxErrorBodySize = 512
+)
+
+// latestResponse is the response of the exchange rate API
+type latestResponse struct {
+	Result   string             `json:"result"`
+	BaseCode string             `json:"base_code"`
+	Rates    map[string]float64 `json:"rates"`
+}
+
+// Client fetches the exchange rates from the exchange rate API
+type Client struct {
+	url    string
+	client *http.Client
+}
+
+// NewClient creates a new instance of Client fetching the rates from url
+func NewClient(url string) *Client {
+	return &Client{url: url, client: &http.Client{Timeout: DefaultTimeout}}
+}
+
+// Latest fetches the latest rates, as units per US dollar by currency
+func (c *Client) Latest(ctx context.Context) (map[string]float64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create exchange rate request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch exchange rates: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return nil, &ResponseError{StatusCode: resp.StatusCode, Body: string(body)}
+	}
+
+	var latest latestResponse
+	if err = json.NewDecoder(resp.Body).Decode(&latest); err != nil {
+		return nil, fmt.Errorf("failed to decode exchange rates: %w", err)
+	}
+	if latest.Result != "success" || latest.BaseCode != USD {
+		return nil, fmt.Errorf("unexpected exchange rates: result %q, base %q", latest.Result, latest.BaseCode)
+	}
+
+	return latest.Rates, nil
+}
@@ -0,0 +1,63 @@
+package fxrate
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Latest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		status   int
+		body     string
+		expected map[string]float64
+		err      string
+	}{
+		{
+			name:     "rates fetched",
+			status:   http.StatusOK,
+			body:     `{"result": "success", "base_code": "USD", "rates": {"USD": 1, "CRC": 507.25, "EUR": 0.92}}`,
+			expected: map[string]float64{USD: 1, CRC: 507.25, "EUR": 0.92},
+		},
+		{
+			name:   "failed result",
+			status: http.StatusOK,
+			body:   `{"result": "error", "error-type": "unsupported-code"}`,
+			err:    `unexpected exchange rates: result "error"`,
+		},
+		{
+			name:   "error response",
+			status: http.StatusServiceUnavailable,
+			body:   "maintenance",
+			err:    "exchange rate API responded with status 503: maintenance",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			rates, err := NewClient(server.URL).Latest(context.Background())
+			if tt.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, rates)
+		})
+	}
+}
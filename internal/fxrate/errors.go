@@ -0,0 +1,31 @@
+package fxrate
+
+import (
+	"fmt"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// UnsupportedCurrencyError represents a currency without an exchange rate
+type UnsupportedCurrencyError struct {
+	Currency string
+}
+
+func (e *UnsupportedCurrencyError) Error() string {
+	return fmt.Sprintf("unsupported currency %q", e.Currency)
+}
+
+// Is matches httpservice.ErrInvalid
+func (e *UnsupportedCurrencyError) Is(target error) bool {
+	return target == httpservice.ErrInvalid
+}
+
+// ResponseError represents a failed response of the exchange rate API
+type ResponseError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("exchange rate API responded with status %d: %s", e.StatusCode, e.Body)
+}
@@ -0,0 +1,40 @@
+// Package fxrate keeps the exchange rates of the currencies salaries are posted in, refreshed
+// daily from an exchange rate API, and converts amounts between them.
+package fxrate
+
+import (
+	"time"
+)
+
+// Supported currencies, ISO 4217 codes
+const (
+	USD = "USD"
+	CRC = "CRC"
+)
+
+// Currencies are the supported currencies, the ones whose rates are kept
+var Currencies = []string{USD, CRC}
+
+// Rate is the exchange rate of a currency
+type Rate struct {
+	Currency string `db:"currency"`
+	// PerUSD is the number of units of the currency a US dollar buys
+	PerUSD    float64   `db:"per_usd"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// Rates holds the units per US dollar of the currencies, by currency
+type Rates map[string]float64
+
+// Convert converts amount from a currency to another. It fails for the currencies without a rate.
+func (r Rates) Convert(amount float64, from, to string) (float64, error) {
+	fromRate, ok := r[from]
+	if !ok {
+		return 0, &UnsupportedCurrencyError{Currency: from}
+	}
+	toRate, ok := r[to]
+	if !ok {
+		return 0, &UnsupportedCurrencyError{Currency: to}
+	}
+	return amount / fromRate * toRate, nil
+}
@@ -0,0 +1,83 @@
+package fxrate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// SQL query constants
+const (
+	// Stores the rates of the currencies $1, units per US dollar in $2
+	upsertRatesQuery = `
+        INSERT INTO exchange_rates (currency, per_usd, updated_at)
+        SELECT r.currency, r.per_usd, NOW()
+        FROM unnest($1::text[], $2::float8[]) AS r(currency, per_usd)
+        ON CONFLICT (currency) DO UPDATE
+        SET per_usd = EXCLUDED.per_usd, updated_at = EXCLUDED.updated_at
+    `
+
+	listRatesQuery = `
+        SELECT currency, per_usd::float8, updated_at
+        FROM exchange_rates
+        ORDER BY currency
+    `
+)
+
+// Database interface to support pgxpool and mocks
+type Database interface {
+	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
+	Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+}
+
+// Repository handles database operations for the exchange rates.
+type Repository struct {
+	db Database
+}
+
+// NewRepository creates a new Repository instance.
+func NewRepository(db Database) *Repository {
+	return &Repository{db: db}
+}
+
+// Upsert stores the rates, replacing the ones of their currencies.
+func (r *Repository) Upsert(ctx context.Context, rates []*Rate) error {
+	currencies := make([]string, len(rates))
+	perUSD := make([]float64, len(rates))
+	for i, rate := range rates {
+		currencies[i] = rate.Currency
+		perUSD[i] = rate.PerUSD
+	}
+
+	if _, err := r.db.Exec(ctx, upsertRatesQuery, currencies, perUSD); err != nil {
+		return fmt.Errorf("failed to store exchange rates: %w", err)
+	}
+	return nil
+}
+
+// List retrieves the rates of all currencies.
+func (r *Repository) List(ctx context.Context) ([]*Rate, error) {
+	rows, err := r.db.Query(ctx, listRatesQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list exchange rates: %w", err)
+	}
+	defer rows.Close()
+
+	var rates []*Rate
+	for rows.Next() {
+		rate := &Rate{}
+		if err = rows.Scan(&rate.Currency, &rate.PerUSD, &rate.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan exchange rate row: %w", err)
+		}
+		rates = append(rates, rate)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating exchange rate rows: %w", err)
+	}
+
+	return rates, nil
+}
@@ -0,0 +1,85 @@
+package fxrate
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Upsert(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+	rates := []*Rate{{Currency: USD, PerUSD: 1}, {Currency: CRC, PerUSD: 507.25}}
+
+	tests := []struct {
+		name      string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		expected  error
+	}{
+		{
+			name: "rates stored",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(upsertRatesQuery)).
+					WithArgs([]string{USD, CRC}, []float64{1, 507.25}).
+					WillReturnResult(pgxmock.NewResult("INSERT", 2))
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(upsertRatesQuery)).
+					WithArgs([]string{USD, CRC}, []float64{1, 507.25}).
+					WillReturnError(dbError)
+			},
+			expected: dbError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			tt.mockSetup(mockDB)
+			err = NewRepository(mockDB).Upsert(context.Background(), rates)
+			if tt.expected != nil {
+				require.ErrorIs(t, err, tt.expected)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_List(t *testing.T) {
+	t.Parallel()
+	mockDB, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	updatedAt := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+	mockDB.ExpectQuery(regexp.QuoteMeta(listRatesQuery)).
+		WillReturnRows(pgxmock.NewRows([]string{"currency", "per_usd", "updated_at"}).
+			AddRow(CRC, 507.25, updatedAt).
+			AddRow(USD, 1.0, updatedAt))
+
+	rates, err := NewRepository(mockDB).List(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []*Rate{
+		{Currency: CRC, PerUSD: 507.25, UpdatedAt: updatedAt},
+		{Currency: USD, PerUSD: 1, UpdatedAt: updatedAt},
+	}, rates)
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
@@ -0,0 +1,81 @@
+package fxrate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/cache"
+)
+
+// RatesCacheTTL is how long the stored rates are served from memory, they change daily
+const RatesCacheTTL = time.Hour
+
+const ratesCacheKey = "rates"
+
+// DataRepository interface to store and read the exchange rates.
+type DataRepository interface {
+	Upsert(ctx context.Context, rates []*Rate) error
+	List(ctx context.Context) ([]*Rate, error)
+}
+
+// Source interface to fetch the latest exchange rates, as units per US dollar by currency.
+type Source interface {
+	Latest(ctx context.Context) (map[string]float64, error)
+}
+
+// RateService keeps the exchange rates of the supported currencies.
+type RateService struct {
+	repo       DataRepository
+	source     Source
+	ratesCache *cache.Cache[string, Rates]
+}
+
+// NewRateService creates a new instance of RateService
+func NewRateService(repo DataRepository, source Source) *RateService {
+	return &RateService{
+		repo:       repo,
+		source:     source,
+		ratesCache: cache.New[string, Rates](RatesCacheTTL),
+	}
+}
+
+// Refresh fetches the latest rates of the supported currencies and stores them. The stored rates
+// are kept when the source misses one of the currencies.
+func (s *RateService) Refresh(ctx context.Context) error {
+	latest, err := s.source.Latest(ctx)
+	if err != nil {
+		return err
+	}
+
+	rates := make([]*Rate, 0, len(Currencies))
+	for _, currency := range Currencies {
+		perUSD, ok := latest[currency]
+		if !ok || perUSD <= 0 {
+			return fmt.Errorf("no exchange rate for %s", currency)
+		}
+		rates = append(rates, &Rate{Currency: currency, PerUSD: perUSD})
+	}
+
+	if err = s.repo.Upsert(ctx, rates); err != nil {
+		return err
+	}
+	s.ratesCache.Delete(ratesCacheKey)
+	return nil
+}
+
+// Rates returns the stored rates. Results are cached for RatesCacheTTL.
+func (s *RateService) Rates(ctx context.Context) (Rates, error) {
+	return s.ratesCache.GetOrLoad(ctx, ratesCacheKey, func(ctx context.Context) (Rates, error) {
+		stored, err := s.repo.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		rates := make(Rates, len(stored))
+		for _, rate := range stored {
+			rates[rate.Currency] = rate.PerUSD
+		}
+		return rates, nil
+	})
+}
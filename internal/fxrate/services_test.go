@@ -0,0 +1,106 @@
+package fxrate
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+func TestRateService_Refresh(t *testing.T) {
+	t.Parallel()
+	sourceError := errors.New("source error")
+
+	tests := []struct {
+		name      string
+		mockSetup func(repo *MockDataRepository, source *MockSource)
+		err       error
+	}{
+		{
+			name: "rates of the supported currencies stored",
+			mockSetup: func(repo *MockDataRepository, source *MockSource) {
+				source.EXPECT().Latest(mock.Anything).
+					Return(map[string]float64{USD: 1, CRC: 507.25, "EUR": 0.92}, nil).Once()
+				repo.EXPECT().Upsert(mock.Anything, []*Rate{{Currency: USD, PerUSD: 1}, {Currency: CRC, PerUSD: 507.25}}).
+					Return(nil).Once()
+			},
+		},
+		{
+			name: "missing currency",
+			mockSetup: func(_ *MockDataRepository, source *MockSource) {
+				source.EXPECT().Latest(mock.Anything).Return(map[string]float64{USD: 1}, nil).Once()
+			},
+			err: errors.New("no exchange rate for CRC"),
+		},
+		{
+			name: "source error",
+			mockSetup: func(_ *MockDataRepository, source *MockSource) {
+				source.EXPECT().Latest(mock.Anything).Return(nil, sourceError).Once()
+			},
+			err: sourceError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := NewMockDataRepository(t)
+			source := NewMockSource(t)
+			tt.mockSetup(repo, source)
+
+			err := NewRateService(repo, source).Refresh(context.Background())
+			if tt.err != nil {
+				require.EqualError(t, err, tt.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRateService_Rates(t *testing.T) {
+	t.Parallel()
+	repo := NewMockDataRepository(t)
+	source := NewMockSource(t)
+	service := NewRateService(repo, source)
+
+	repo.EXPECT().List(mock.Anything).
+		Return([]*Rate{{Currency: CRC, PerUSD: 500}, {Currency: USD, PerUSD: 1}}, nil).Once()
+	for range 2 {
+		rates, err := service.Rates(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, Rates{CRC: 500, USD: 1}, rates)
+	}
+
+	// Refreshed rates are read again
+	source.EXPECT().Latest(mock.Anything).Return(map[string]float64{USD: 1, CRC: 510}, nil).Once()
+	repo.EXPECT().Upsert(mock.Anything, mock.Anything).Return(nil).Once()
+	repo.EXPECT().List(mock.Anything).
+		Return([]*Rate{{Currency: CRC, PerUSD: 510}, {Currency: USD, PerUSD: 1}}, nil).Once()
+	require.NoError(t, service.Refresh(context.Background()))
+	rates, err := service.Rates(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Rates{CRC: 510, USD: 1}, rates)
+}
+
+func TestRates_Convert(t *testing.T) {
+	t.Parallel()
+	rates := Rates{USD: 1, CRC: 500}
+
+	amount, err := rates.Convert(1_000_000, CRC, USD)
+	require.NoError(t, err)
+	assert.InDelta(t, 2000, amount, 0.001)
+
+	amount, err = rates.Convert(3000, USD, CRC)
+	require.NoError(t, err)
+	assert.InDelta(t, 1_500_000, amount, 0.001)
+
+	_, err = rates.Convert(100, "EUR", USD)
+	require.ErrorIs(t, err, httpservice.ErrInvalid)
+	assert.EqualError(t, err, `unsupported currency "EUR"`)
+}
@@ -0,0 +1,43 @@
+package health
+
+import (
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/database"
+)
+
+// Data Transfer Objects (DTOs) for the health API layer.
+
+// StatusResponse represents the state of the server
+type StatusResponse struct {
+	Status string           `json:"status" example:"ok"`
+	Checks []*CheckResponse `json:"checks,omitempty"`
+}
+
+// CheckResponse represents the state of a connection of the server
+type CheckResponse struct {
+	Name          string    `json:"name" example:"database"`
+	Status        string    `json:"status" example:"ok"`
+	Since         time.Time `json:"since" example:"2024-01-15T10:30:00Z"`
+	CheckedAt     time.Time `json:"checked_at" example:"2024-01-15T10:45:00Z"`
+	Error         string    `json:"error,omitempty" example:"connection refused"`
+	Reconnections int       `json:"reconnections" example:"0"`
+}
+
+// ToCheckResponse converts the health of a connection to a CheckResponse
+func ToCheckResponse(name string, health database.Health) *CheckResponse {
+	response := &CheckResponse{
+		Name:          name,
+		Status:        statusOK,
+		Since:         health.Since,
+		CheckedAt:     health.CheckedAt,
+		Reconnections: health.Reconnections,
+	}
+	if !health.Healthy {
+		response.Status = statusUnavailable
+		if health.Err != nil {
+			response.Error = health.Err.Error()
+		}
+	}
+	return response
+}
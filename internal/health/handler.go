@@ -0,0 +1,72 @@
+// Package health provides the liveness and readiness probes of the server.
+package health
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/database"
+)
+
+// Constants for health routes and endpoints
+const (
+	LivenessRoute  = "/healthz"
+	ReadinessRoute = "/readyz"
+
+	statusOK          = "ok"
+	statusUnavailable = "unavailable"
+)
+
+// Checker reports the state of a connection the server needs to serve requests, such as a
+// database.HealthMonitor
+type Checker interface {
+	Health() database.Health
+}
+
+// Handler handles the probes of the container orchestrator
+type Handler struct {
+	checkers map[string]Checker
+}
+
+// NewHandler creates a new health handler, ready when every checker is healthy
+func NewHandler(checkers map[string]Checker) *Handler {
+	return &Handler{checkers: checkers}
+}
+
+// RegisterRoutes registers the health routes with the given router
+func (h *Handler) RegisterRoutes(r gin.IRoutes) {
+	r.GET(LivenessRoute, h.Liveness)
+	r.GET(ReadinessRoute, h.Readiness)
+}
+
+// Liveness answers 200 OK while the server is running
+func (h *Handler) Liveness(c *gin.Context) {
+	c.JSON(http.StatusOK, &StatusResponse{Status: statusOK})
+}
+
+// Readiness answers 200 OK when every connection is healthy, and 503 Service Unavailable with
+// the state of the connections otherwise, so no traffic is routed to the server meanwhile
+func (h *Handler) Readiness(c *gin.Context) {
+	names := make([]string, 0, len(h.checkers))
+	for name := range h.checkers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	response := &StatusResponse{Status: statusOK, Checks: make([]*CheckResponse, 0, len(names))}
+	for _, name := range names {
+		check := ToCheckResponse(name, h.checkers[name].Health())
+		if check.Status != statusOK {
+			response.Status = statusUnavailable
+		}
+		response.Checks = append(response.Checks, check)
+	}
+
+	status := http.StatusOK
+	if response.Status != statusOK {
+		status = http.StatusServiceUnavailable
+	}
+	c.JSON(status, response)
+}
@@ -0,0 +1,82 @@
+package health
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/database"
+)
+
+// staticChecker is a Checker always reporting the same health
+type staticChecker database.Health
+
+func (c staticChecker) Health() database.Health {
+	return database.Health(c)
+}
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+	since := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	healthy := staticChecker{Healthy: true, Since: since, CheckedAt: since, Reconnections: 2}
+	unhealthy := staticChecker{Since: since, CheckedAt: since, Err: errors.New("connection refused")}
+
+	tests := []struct {
+		name       string
+		path       string
+		checkers   map[string]Checker
+		wantStatus int
+		wantBody   *StatusResponse
+	}{
+		{
+			name:       "alive with an unhealthy database",
+			path:       LivenessRoute,
+			checkers:   map[string]Checker{"database": unhealthy},
+			wantStatus: http.StatusOK,
+			wantBody:   &StatusResponse{Status: "ok"},
+		},
+		{
+			name:       "ready",
+			path:       ReadinessRoute,
+			checkers:   map[string]Checker{"database": healthy},
+			wantStatus: http.StatusOK,
+			wantBody: &StatusResponse{Status: "ok", Checks: []*CheckResponse{
+				{Name: "database", Status: "ok", Since: since, CheckedAt: since, Reconnections: 2},
+			}},
+		},
+		{
+			name:       "replica unavailable",
+			path:       ReadinessRoute,
+			checkers:   map[string]Checker{"replica": unhealthy, "database": healthy},
+			wantStatus: http.StatusServiceUnavailable,
+			wantBody: &StatusResponse{Status: "unavailable", Checks: []*CheckResponse{
+				{Name: "database", Status: "ok", Since: since, CheckedAt: since, Reconnections: 2},
+				{Name: "replica", Status: "unavailable", Since: since, CheckedAt: since, Error: "connection refused"},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := gin.New()
+			NewHandler(tt.checkers).RegisterRoutes(r)
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, http.NoBody))
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			var body StatusResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			assert.Equal(t, tt.wantBody, &body)
+		})
+	}
+}
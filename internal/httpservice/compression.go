@@ -0,0 +1,101 @@
+package httpservice
+
+import (
+	"compress/gzip"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Gzip returns a middleware compressing the response bodies with gzip for the clients
+// accepting it. Responses without a body, or already encoded, are sent as they are.
+func Gzip(level int) gin.HandlerFunc {
+	writers := sync.Pool{New: func() any {
+		writer, err := gzip.NewWriterLevel(nil, level)
+		if err != nil {
+			// Invalid level, fall back to the default compression
+			writer = gzip.NewWriter(nil)
+		}
+		return writer
+	}}
+
+	return func(c *gin.Context) {
+		if !acceptsGzip(c.Request) {
+			c.Next()
+			return
+		}
+
+		writer := &gzipWriter{ResponseWriter: c.Writer, pool: &writers}
+		c.Writer = writer
+		c.Header("Vary", "Accept-Encoding")
+		defer writer.close()
+
+		c.Next()
+	}
+}
+
+// acceptsGzip reports whether the client accepts gzip encoded responses
+func acceptsGzip(req *http.Request) bool {
+	for encoding := range strings.SplitSeq(req.Header.Get("Accept-Encoding"), ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(encoding), ";")
+		if strings.TrimSpace(name) == "gzip" && strings.ReplaceAll(params, " ", "") != "q=0" {
+			return true
+		}
+	}
+	return false
+}
+
+// gzipWriter compresses the body written by the handlers. Compression starts with the first
+// write, so responses without a body stay empty.
+type gzipWriter struct {
+	gin.ResponseWriter
+	pool *sync.Pool
+	gz   *gzip.Writer
+	// skipped is set when the response is sent uncompressed
+	skipped bool
+}
+
+// Write compresses data into the response body
+func (w *gzipWriter) Write(data []byte) (int, error) {
+	if w.gz == nil && !w.skipped {
+		w.start()
+	}
+	if w.skipped {
+		return w.ResponseWriter.Write(data)
+	}
+	return w.gz.Write(data)
+}
+
+// WriteString compresses s into the response body
+func (w *gzipWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// start decides whether the response is compressed, right before its headers are sent
+func (w *gzipWriter) start() {
+	header := w.Header()
+	if header.Get("Content-Encoding") != "" || w.Status() == http.StatusNoContent ||
+		w.Status() == http.StatusNotModified || w.Status() == http.StatusPartialContent {
+		w.skipped = true
+		return
+	}
+
+	header.Set("Content-Encoding", "gzip")
+	// The length of the compressed body isn't known upfront
+	header.Del("Content-Length")
+
+	w.gz = w.pool.Get().(*gzip.Writer)
+	w.gz.Reset(w.ResponseWriter)
+}
+
+// close flushes the compressed body and returns the gzip writer to the pool
+func (w *gzipWriter) close() {
+	if w.gz == nil {
+		return
+	}
+	_ = w.gz.Close()
+	w.pool.Put(w.gz)
+	w.gz = nil
+}
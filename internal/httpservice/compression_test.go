@@ -0,0 +1,80 @@
+package httpservice
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGzip(t *testing.T) {
+	t.Parallel()
+	body := strings.Repeat(`{"title":"Go Developer"}`, 100)
+
+	tests := []struct {
+		name           string
+		acceptEncoding string
+		path           string
+		checkResults   func(t *testing.T, w *httptest.ResponseRecorder)
+	}{
+		{
+			name:           "compressed for clients accepting gzip",
+			acceptEncoding: "deflate, gzip;q=0.8",
+			path:           "/jobs",
+			checkResults: func(t *testing.T, w *httptest.ResponseRecorder) {
+				t.Helper()
+				assert.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+				assert.Empty(t, w.Header().Get("Content-Length"))
+				reader, err := gzip.NewReader(w.Body)
+				require.NoError(t, err)
+				decoded, err := io.ReadAll(reader)
+				require.NoError(t, err)
+				assert.Equal(t, body, string(decoded))
+			},
+		},
+		{
+			name:           "plain for other clients",
+			acceptEncoding: "gzip;q=0",
+			path:           "/jobs",
+			checkResults: func(t *testing.T, w *httptest.ResponseRecorder) {
+				t.Helper()
+				assert.Empty(t, w.Header().Get("Content-Encoding"))
+				assert.Equal(t, body, w.Body.String())
+			},
+		},
+		{
+			name:           "responses without a body left empty",
+			acceptEncoding: "gzip",
+			path:           "/empty",
+			checkResults: func(t *testing.T, w *httptest.ResponseRecorder) {
+				t.Helper()
+				assert.Equal(t, http.StatusNoContent, w.Code)
+				assert.Empty(t, w.Header().Get("Content-Encoding"))
+				assert.Zero(t, w.Body.Len())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := gin.New()
+			r.Use(Gzip(gzip.DefaultCompression))
+			r.GET("/jobs", func(c *gin.Context) { c.String(http.StatusOK, body) })
+			r.GET("/empty", func(c *gin.Context) { c.Status(http.StatusNoContent) })
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, http.NoBody)
+			req.Header.Set("Accept-Encoding", tt.acceptEncoding)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			tt.checkResults(t, w)
+		})
+	}
+}
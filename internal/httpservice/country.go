@@ -0,0 +1,58 @@
+package httpservice
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Country scoping of the requests
+const (
+	// CountryHeader selects the country a request is scoped to, an ISO 3166-1 alpha-2 code such as "CR"
+	CountryHeader = "X-Country"
+	// DefaultCountry is the country of the board when no other is configured, Costa Rica
+	DefaultCountry = "CR"
+
+	countryContextKey = "httpservice.country"
+)
+
+// Countries are the countries served by a deployment
+type Countries struct {
+	// Default is the country of the requests without the X-Country header
+	Default string
+	// Supported are the countries accepted in the X-Country header, Default among them
+	Supported []string
+}
+
+// CountryScoped is implemented by the search params of the searches scoped to the country of
+// the request. It is set before the search is executed.
+type CountryScoped interface {
+	SetCountry(country string)
+}
+
+// Middleware returns a middleware storing in the request context the country of its X-Country
+// header, or the default country without it. Requests for a country that isn't supported are
+// answered with 400 Bad Request. The responses vary with the header, so caches keep them apart.
+func (cs *Countries) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Writer.Header().Add("Vary", CountryHeader)
+
+		country := strings.ToUpper(strings.TrimSpace(c.GetHeader(CountryHeader)))
+		if country == "" {
+			country = cs.Default
+		} else if !slices.Contains(cs.Supported, country) {
+			_ = c.Error(&ValidationError{Errors: []string{"unsupported country: " + country}})
+			c.Abort()
+			return
+		}
+
+		c.Set(countryContextKey, country)
+		c.Next()
+	}
+}
+
+// CountryOf returns the country the request is scoped to, empty outside of the routes scoped to a country
+func CountryOf(c *gin.Context) string {
+	return c.GetString(countryContextKey)
+}
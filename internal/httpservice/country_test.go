@@ -0,0 +1,50 @@
+package httpservice
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountries_Middleware(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	countries := Countries{Default: "CR", Supported: []string{"CR", "PA"}}
+	router.Use(ErrorHandler(), countries.Middleware())
+	router.GET("/jobs", func(c *gin.Context) {
+		c.String(http.StatusOK, CountryOf(c))
+	})
+
+	tests := []struct {
+		name     string
+		header   string
+		status   int
+		expected string
+	}{
+		{name: "default country", status: http.StatusOK, expected: "CR"},
+		{name: "country of the header", header: " pa ", status: http.StatusOK, expected: "PA"},
+		{name: "unsupported country", header: "MX", status: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			req := httptest.NewRequest(http.MethodGet, "/jobs", http.NoBody)
+			req.Header.Set(CountryHeader, tt.header)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tt.status, recorder.Code)
+			assert.Equal(t, CountryHeader, recorder.Header().Get("Vary"))
+			if tt.expected != "" {
+				assert.Equal(t, tt.expected, recorder.Body.String())
+			} else {
+				assert.Contains(t, recorder.Body.String(), "unsupported country: MX")
+			}
+		})
+	}
+}
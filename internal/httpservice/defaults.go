@@ -4,10 +4,6 @@
 package httpservice
 
 import (
-	"errors"
-	"fmt"
-	"net/http"
-
 	"github.com/gin-gonic/gin"
 )
 
@@ -17,6 +13,13 @@ const (
 	ErrCodeInvalidRequest  = "INVALID_REQUEST"
 	ErrCodeValidationError = "VALIDATION_ERROR"
 	ErrCodeSearchError     = "SEARCH_ERROR"
+	ErrCodeNotFound        = "NOT_FOUND"
+	ErrCodeConflict        = "CONFLICT"
+	ErrCodeUnauthorized    = "UNAUTHORIZED"
+	ErrCodeForbidden       = "FORBIDDEN"
+	ErrCodeUnavailable     = "SERVICE_UNAVAILABLE"
+	ErrCodeTimeout         = "TIMEOUT"
+	ErrCodeTooManyRequests = "TOO_MANY_REQUESTS"
 )
 
 // DefaultRequestParser - GENERIC IMPLEMENTATION that consumers can use
@@ -50,66 +53,15 @@ func NewDefaultResponseBuilder[TResult SearchResult, TParams SearchParams]() Res
 // BuildSearchResponse - GENERIC IMPLEMENTATION that consumers can use
 func (b *DefaultResponseBuilder[TResult, TParams]) BuildSearchResponse(results TResult, total int,
 	params TParams) SearchResponse {
-	hasMore := params.GetOffset()+len(results.GetItems()) < total
+	items := results.GetItems()
 
 	return SearchResponse{
-		Data: results.GetItems(),
-		Pagination: PaginationDetails{
-			Total:   total,
-			Limit:   params.GetLimit(),
-			Offset:  params.GetOffset(),
-			HasMore: hasMore,
-		},
+		Data:       items,
+		Pagination: NewPaginationDetails(total, params.GetLimit(), params.GetOffset(), len(items)),
 	}
 }
 
 // BuildErrorResponse - GENERIC IMPLEMENTATION that consumers can use
 func (b *DefaultResponseBuilder[TResult, TParams]) BuildErrorResponse(err error) (int, ErrorResponse) {
-	var e *RequestParseError
-	var e1 *ValidationError
-	var e2 *SearchError
-	var e3 *ConversionError
-	switch {
-	case errors.As(err, &e):
-		return http.StatusBadRequest,
-			ErrorResponse{
-				Error: ErrorDetails{
-					Code:    ErrCodeInvalidRequest,
-					Message: "Invalid request parameters",
-					Details: []string{e.Error()},
-				},
-			}
-	case errors.As(err, &e1):
-		return http.StatusBadRequest, ErrorResponse{
-			Error: ErrorDetails{
-				Code:    ErrCodeValidationError,
-				Message: "Invalid search parameters",
-				Details: e1.Errors,
-			},
-		}
-	case errors.As(err, &e2):
-		return http.StatusInternalServerError, ErrorResponse{
-			Error: ErrorDetails{
-				Code:    ErrCodeSearchError,
-				Message: fmt.Sprintf("Failed to %s", e2.Operation),
-				Details: []string{e2.Error()},
-			},
-		}
-	case errors.As(err, &e3):
-		return http.StatusBadRequest, ErrorResponse{
-			Error: ErrorDetails{
-				Code:    ErrCodeValidationError,
-				Message: "Invalid search parameters",
-				Details: []string{e3.Error()},
-			},
-		}
-	default:
-		return http.StatusInternalServerError, ErrorResponse{
-			Error: ErrorDetails{
-				Code:    ErrCodeInternalError,
-				Message: "Internal server error",
-				Details: []string{err.Error()},
-			},
-		}
-	}
+	return MapError(err)
 }
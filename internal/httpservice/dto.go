@@ -4,24 +4,48 @@ package httpservice
 type SearchResponse struct {
 	Data       []any             `json:"data"`
 	Pagination PaginationDetails `json:"pagination"`
+	// DidYouMean is a corrected query suggested when the search finds nothing
+	DidYouMean string `json:"did_you_mean,omitempty"`
+}
+
+// ListResponse represents a list response whose items only contain the selected fields
+type ListResponse struct {
+	Data []any `json:"data"`
 }
 
 // PaginationDetails contains pagination metadata
 type PaginationDetails struct {
-	Total   int  `json:"total"`
-	Limit   int  `json:"limit"`
-	Offset  int  `json:"offset"`
-	HasMore bool `json:"has_more"`
+	Total       int  `json:"total" example:"42"`
+	Limit       int  `json:"limit" example:"20"`
+	Offset      int  `json:"offset" example:"0"`
+	HasMore     bool `json:"has_more" example:"true"`
+	TotalPages  int  `json:"total_pages" example:"3"`
+	CurrentPage int  `json:"current_page" example:"1"`
+	// Next and Prev are the URLs of the next and previous pages, omitted on the last and first pages
+	Next string `json:"next,omitempty" example:"/api/v1/jobs?limit=20&offset=20&q=golang"`
+	Prev string `json:"prev,omitempty" example:"/api/v1/jobs?limit=20&offset=0&q=golang"`
 }
 
-// ErrorResponse represents an API error response
+// ErrorResponse represents an API error response. Every failed request is answered with it.
 type ErrorResponse struct {
 	Error ErrorDetails `json:"error"`
 }
 
 // ErrorDetails contains error information
 type ErrorDetails struct {
-	Code    string   `json:"code"`
-	Message string   `json:"message"`
-	Details []string `json:"details,omitempty"`
+	// Code is one of the ErrCode constants, clients should branch on it rather than on the message
+	Code    string   `json:"code" example:"VALIDATION_ERROR"`
+	Message string   `json:"message" example:"Invalid search parameters"`
+	Details []string `json:"details,omitempty" example:"limit must be between 1 and 100"`
+}
+
+// NewErrorResponse creates an ErrorResponse with the given code, message and optional details
+func NewErrorResponse(code, message string, details ...string) ErrorResponse {
+	return ErrorResponse{
+		Error: ErrorDetails{
+			Code:    code,
+			Message: message,
+			Details: details,
+		},
+	}
 }
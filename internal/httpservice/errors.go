@@ -1,10 +1,59 @@
 package httpservice
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 )
 
+// Error kinds. The domain errors of the modules match one of them with errors.Is, through an
+// Is method or by being created with NewError, so MapError answers them with the matching
+// status and code without knowing every module.
+var (
+	// ErrNotFound results in HTTP 404 Not Found
+	ErrNotFound = errors.New("not found")
+	// ErrConflict results in HTTP 409 Conflict
+	ErrConflict = errors.New("conflict")
+	// ErrUnauthorized results in HTTP 401 Unauthorized
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrForbidden results in HTTP 403 Forbidden
+	ErrForbidden = errors.New("forbidden")
+	// ErrInvalid results in HTTP 400 Bad Request
+	ErrInvalid = errors.New("invalid")
+	// ErrUnavailable results in HTTP 503 Service Unavailable
+	ErrUnavailable = errors.New("unavailable")
+	// ErrTooManyRequests results in HTTP 429 Too Many Requests
+	ErrTooManyRequests = errors.New("too many requests")
+)
+
+// DetailedError is implemented by the errors with details for the client, e.g. the existing
+// records a new one conflicts with. They are returned in the details of the error response.
+type DetailedError interface {
+	error
+	Details() []string
+}
+
+// kindError is an error of one of the error kinds with its own message
+type kindError struct {
+	kind    error
+	message string
+}
+
+// NewError returns an error with the given message that matches kind with errors.Is
+func NewError(kind error, message string) error {
+	return &kindError{kind: kind, message: message}
+}
+
+func (e *kindError) Error() string {
+	return e.message
+}
+
+func (e *kindError) Is(target error) bool {
+	return target == e.kind
+}
+
 // RequestParseError represents an error that occurred while parsing HTTP request parameters.
 // This typically happens when query parameters cannot be bound to the request struct,
 // indicating malformed or invalid client input.
@@ -45,6 +94,10 @@ func (e *SearchError) Error() string {
 	return fmt.Sprintf("search error during %s: %v", e.Operation, e.Err)
 }
 
+func (e *SearchError) Unwrap() error {
+	return e.Err
+}
+
 // ConversionError represents an error that occurred while converting request data
 // to search parameters. This happens when the request contains data that cannot
 // be properly converted to the expected types (e.g., invalid date formats).
@@ -58,3 +111,77 @@ type ConversionError struct {
 func (e *ConversionError) Error() string {
 	return fmt.Sprintf("conversion error for field %s with value %s: %v", e.Field, e.Value, e.Err)
 }
+
+// ServiceUnavailableError represents a dependency, such as the database, that can't serve
+// requests for now, e.g. while it restarts. Clients are expected to try again later.
+// Results in HTTP 503 Service Unavailable.
+type ServiceUnavailableError struct {
+	Service string
+	Err     error
+}
+
+func (e *ServiceUnavailableError) Error() string {
+	return fmt.Sprintf("%s unavailable: %v", e.Service, e.Err)
+}
+
+func (e *ServiceUnavailableError) Unwrap() error {
+	return e.Err
+}
+
+// MapError returns the status and the error response of err, the single place turning
+// errors into the API error envelope. Unknown errors result in HTTP 500.
+func MapError(err error) (int, ErrorResponse) {
+	var parseErr *RequestParseError
+	var validationErr *ValidationError
+	var conversionErr *ConversionError
+	var unavailableErr *ServiceUnavailableError
+	var searchErr *SearchError
+
+	switch {
+	case errors.As(err, &parseErr):
+		// The binding tags failing when the request is bound are reported like the other validations
+		if errors.As(toValidationError(parseErr.Err), &validationErr) {
+			return http.StatusBadRequest, NewErrorResponse(ErrCodeValidationError, "Invalid request parameters",
+				validationErr.Errors...)
+		}
+		return http.StatusBadRequest, NewErrorResponse(ErrCodeInvalidRequest, "Invalid request parameters",
+			parseErr.Error())
+	case errors.As(err, &validationErr):
+		return http.StatusBadRequest, NewErrorResponse(ErrCodeValidationError, "Invalid request parameters",
+			validationErr.Errors...)
+	case errors.As(err, &conversionErr), errors.Is(err, ErrInvalid):
+		return http.StatusBadRequest, NewErrorResponse(ErrCodeValidationError, "Invalid request parameters",
+			err.Error())
+	case errors.Is(err, ErrUnauthorized):
+		return http.StatusUnauthorized, NewErrorResponse(ErrCodeUnauthorized, err.Error())
+	case errors.Is(err, ErrForbidden):
+		return http.StatusForbidden, NewErrorResponse(ErrCodeForbidden, err.Error())
+	case errors.Is(err, ErrNotFound):
+		return http.StatusNotFound, NewErrorResponse(ErrCodeNotFound, err.Error())
+	case errors.Is(err, ErrConflict):
+		return http.StatusConflict, NewErrorResponse(ErrCodeConflict, err.Error(), errorDetails(err)...)
+	case errors.Is(err, ErrTooManyRequests):
+		return http.StatusTooManyRequests, NewErrorResponse(ErrCodeTooManyRequests, err.Error())
+	case errors.As(err, &unavailableErr), errors.Is(err, ErrUnavailable):
+		return http.StatusServiceUnavailable, NewErrorResponse(ErrCodeUnavailable,
+			"Service temporarily unavailable, try again later", err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout, NewErrorResponse(ErrCodeTimeout,
+			"The request took too long to complete, try again later", err.Error())
+	case errors.As(err, &searchErr):
+		return http.StatusInternalServerError, NewErrorResponse(ErrCodeSearchError,
+			fmt.Sprintf("Failed to %s", searchErr.Operation), searchErr.Error())
+	default:
+		return http.StatusInternalServerError, NewErrorResponse(ErrCodeInternalError, "Internal server error",
+			err.Error())
+	}
+}
+
+// errorDetails returns the details of err when it is a DetailedError
+func errorDetails(err error) []string {
+	var detailedErr DetailedError
+	if errors.As(err, &detailedErr) {
+		return detailedErr.Details()
+	}
+	return nil
+}
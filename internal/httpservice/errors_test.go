@@ -0,0 +1,176 @@
+package httpservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// notFoundError is a domain error matching ErrNotFound, like the ones of the modules
+type notFoundError struct {
+	ID int
+}
+
+func (e notFoundError) Error() string {
+	return fmt.Sprintf("job with ID %d not found", e.ID)
+}
+
+func (e notFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// duplicateError is a conflict listing the records it conflicts with
+type duplicateError struct{}
+
+func (duplicateError) Error() string {
+	return "job may duplicate 1 existing job"
+}
+
+func (duplicateError) Details() []string {
+	return []string{"job 7"}
+}
+
+func (duplicateError) Is(target error) bool {
+	return target == ErrConflict
+}
+
+func TestMapError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		err            error
+		expectedStatus int
+		expectedCode   string
+		expectedMsg    string
+		expectedDetail []string
+	}{
+		{
+			name:           "parse error",
+			err:            &RequestParseError{Err: errors.New("bad json")},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ErrCodeInvalidRequest,
+			expectedMsg:    "Invalid request parameters",
+		},
+		{
+			name:           "validation error",
+			err:            &ValidationError{Errors: []string{"invalid job id"}},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ErrCodeValidationError,
+			expectedMsg:    "Invalid request parameters",
+		},
+		{
+			name:           "wrapped domain not found error",
+			err:            fmt.Errorf("failed to get job: %w", &notFoundError{ID: 42}),
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   ErrCodeNotFound,
+			expectedMsg:    "failed to get job: job with ID 42 not found",
+		},
+		{
+			name:           "conflict",
+			err:            NewError(ErrConflict, "job with ID 42 is not active"),
+			expectedStatus: http.StatusConflict,
+			expectedCode:   ErrCodeConflict,
+			expectedMsg:    "job with ID 42 is not active",
+		},
+		{
+			name:           "conflict with details",
+			err:            &duplicateError{},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   ErrCodeConflict,
+			expectedMsg:    "job may duplicate 1 existing job",
+			expectedDetail: []string{"job 7"},
+		},
+		{
+			name:           "unauthorized",
+			err:            NewError(ErrUnauthorized, "invalid or expired session"),
+			expectedStatus: http.StatusUnauthorized,
+			expectedCode:   ErrCodeUnauthorized,
+			expectedMsg:    "invalid or expired session",
+		},
+		{
+			name:           "forbidden",
+			err:            NewError(ErrForbidden, "missing the jobs scope of company tech-corp"),
+			expectedStatus: http.StatusForbidden,
+			expectedCode:   ErrCodeForbidden,
+			expectedMsg:    "missing the jobs scope of company tech-corp",
+		},
+		{
+			name:           "too many requests",
+			err:            NewError(ErrTooManyRequests, "too many job exports, try again later"),
+			expectedStatus: http.StatusTooManyRequests,
+			expectedCode:   ErrCodeTooManyRequests,
+			expectedMsg:    "too many job exports, try again later",
+		},
+		{
+			name:           "database unavailable during a search",
+			err:            &SearchError{Operation: "search jobs", Err: &ServiceUnavailableError{Service: "database"}},
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedCode:   ErrCodeUnavailable,
+			expectedMsg:    "Service temporarily unavailable, try again later",
+		},
+		{
+			name:           "deadline exceeded",
+			err:            fmt.Errorf("failed to search jobs: %w", context.DeadlineExceeded),
+			expectedStatus: http.StatusGatewayTimeout,
+			expectedCode:   ErrCodeTimeout,
+			expectedMsg:    "The request took too long to complete, try again later",
+		},
+		{
+			name:           "search error",
+			err:            &SearchError{Operation: "search jobs", Err: errors.New("syntax error")},
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   ErrCodeSearchError,
+			expectedMsg:    "Failed to search jobs",
+		},
+		{
+			name:           "unknown error",
+			err:            errors.New("boom"),
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   ErrCodeInternalError,
+			expectedMsg:    "Internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			status, response := MapError(tt.err)
+			assert.Equal(t, tt.expectedStatus, status)
+			assert.Equal(t, tt.expectedCode, response.Error.Code)
+			assert.Equal(t, tt.expectedMsg, response.Error.Message)
+			if tt.expectedDetail != nil {
+				assert.Equal(t, tt.expectedDetail, response.Error.Details)
+			}
+		})
+	}
+}
+
+func TestErrorHandler(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(ErrorHandler())
+	router.GET("/missing", func(c *gin.Context) {
+		_ = c.Error(&notFoundError{ID: 42})
+	})
+	router.GET("/admin", RequireAPIKey("secret"), func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/missing", http.NoBody))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.JSONEq(t, `{"error":{"code":"NOT_FOUND","message":"job with ID 42 not found"}}`, recorder.Body.String())
+
+	recorder = httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/admin", http.NoBody))
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	assert.JSONEq(t, `{"error":{"code":"UNAUTHORIZED","message":"Missing or invalid API key"}}`, recorder.Body.String())
+}
@@ -0,0 +1,75 @@
+package httpservice
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// FieldsParam is the query parameter listing the fields returned for each item of a response,
+// e.g. "fields=job_id,title,company_name,application_url"
+const FieldsParam = "fields"
+
+// FieldSelection is implemented by the search params of the searches supporting sparse fieldsets.
+// The items of their search response only contain the selected fields, all of them when none is.
+type FieldSelection interface {
+	GetFields() []string
+}
+
+// ParseFields parses a comma-separated list of fields, rejecting the ones not in allowed.
+// It returns nil for an empty list.
+func ParseFields(value string, allowed []string) ([]string, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+
+	var fields []string
+	for field := range strings.SplitSeq(value, ",") {
+		field = strings.TrimSpace(field)
+		if !slices.Contains(allowed, field) {
+			return nil, fmt.Errorf("invalid value for field: '%s', unknown field %q", FieldsParam, field)
+		}
+		if !slices.Contains(fields, field) {
+			fields = append(fields, field)
+		}
+	}
+	return fields, nil
+}
+
+// SelectFields returns the JSON object of item with only the given fields. Item is returned
+// unchanged when fields is empty.
+func SelectFields(item any, fields []string) (any, error) {
+	if len(fields) == 0 {
+		return item, nil
+	}
+
+	encoded, err := json.Marshal(item)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode item: %w", err)
+	}
+	var object map[string]json.RawMessage
+	if err = json.Unmarshal(encoded, &object); err != nil {
+		return nil, fmt.Errorf("failed to select fields: %w", err)
+	}
+
+	selected := make(map[string]json.RawMessage, len(fields))
+	for _, field := range fields {
+		if value, ok := object[field]; ok {
+			selected[field] = value
+		}
+	}
+	return selected, nil
+}
+
+// SelectListFields applies SelectFields to every item of a list
+func SelectListFields[T any](items []T, fields []string) ([]any, error) {
+	selected := make([]any, len(items))
+	for i, item := range items {
+		var err error
+		if selected[i], err = SelectFields(item, fields); err != nil {
+			return nil, err
+		}
+	}
+	return selected, nil
+}
@@ -0,0 +1,61 @@
+package httpservice
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectListFields(t *testing.T) {
+	t.Parallel()
+	type job struct {
+		ID          int    `json:"job_id"`
+		Title       string `json:"title"`
+		Description string `json:"description"`
+	}
+	items := []*job{{ID: 1, Title: "Go Developer", Description: "A long description"}}
+
+	tests := []struct {
+		name     string
+		value    string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "all fields when none is selected",
+			value:    "",
+			expected: `[{"job_id":1,"title":"Go Developer","description":"A long description"}]`,
+		},
+		{
+			name:     "selected fields",
+			value:    "title, job_id",
+			expected: `[{"job_id":1,"title":"Go Developer"}]`,
+		},
+		{
+			name:    "unknown field",
+			value:   "job_id,salary",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			fields, err := ParseFields(tt.value, []string{"job_id", "title", "description"})
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), `"salary"`)
+				return
+			}
+			require.NoError(t, err)
+
+			selected, err := SelectListFields(items, fields)
+			require.NoError(t, err)
+			encoded, err := json.Marshal(selected)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.expected, string(encoded))
+		})
+	}
+}
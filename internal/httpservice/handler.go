@@ -62,6 +62,9 @@ func (h *SearchHandler[TRequest, TParams, TResult]) HandleSearch(c *gin.Context)
 		c.JSON(statusCode, errorResp)
 		return
 	}
+	if scoped, ok := searchParams.(CountryScoped); ok {
+		scoped.SetCountry(CountryOf(c))
+	}
 
 	// Execute search using consumer's business logic
 	results, total, err := h.service.ExecuteSearch(c.Request.Context(), searchParams.(TParams))
@@ -73,5 +76,16 @@ func (h *SearchHandler[TRequest, TParams, TResult]) HandleSearch(c *gin.Context)
 
 	// Build and send response using generic builder
 	response := h.responseBuilder.BuildSearchResponse(results, total, searchParams.(TParams))
+	if selection, ok := searchParams.(FieldSelection); ok {
+		if response.Data, err = SelectListFields(response.Data, selection.GetFields()); err != nil {
+			statusCode, errorResp := h.responseBuilder.BuildErrorResponse(err)
+			c.JSON(statusCode, errorResp)
+			return
+		}
+	}
+	SetPaginationLinks(c, &response.Pagination)
+	if suggester, ok := h.service.(QuerySuggester[TParams]); ok && total == 0 {
+		response.DidYouMean = suggester.SuggestQuery(c.Request.Context(), searchParams.(TParams))
+	}
 	c.JSON(http.StatusOK, response)
 }
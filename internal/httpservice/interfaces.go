@@ -29,6 +29,13 @@ type SearchService[TParams SearchParams, TResult SearchResult] interface {
 	ExecuteSearch(ctx context.Context, params TParams) (TResult, int, error)
 }
 
+// QuerySuggester is implemented by the search services able to suggest a corrected query, e.g. with
+// its misspelled terms fixed. The suggestion is the did_you_mean of the searches finding nothing,
+// none is given when it is empty.
+type QuerySuggester[TParams SearchParams] interface {
+	SuggestQuery(ctx context.Context, params TParams) string
+}
+
 // RequestParser handles HTTP request parsing (HTTP layer concern) - WITH DEFAULT IMPLEMENTATION PROVIDED
 type RequestParser[T SearchRequest] interface {
 	ParseSearchRequest(c *gin.Context) (T, error)
@@ -0,0 +1,35 @@
+package httpservice
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/i18n"
+)
+
+// Language negotiation of the requests
+const (
+	// AcceptLanguageHeader lists the languages a client prefers, e.g. "es-CR,es;q=0.9,en;q=0.8"
+	AcceptLanguageHeader = "Accept-Language"
+	// ContentLanguageHeader is the language the labels of a response are in
+	ContentLanguageHeader = "Content-Language"
+)
+
+// Language returns a middleware storing in the request context the supported language its
+// Accept-Language header prefers, see i18n.Negotiate. The responses vary with the header, so
+// caches keep them apart.
+func Language() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Writer.Header().Add("Vary", AcceptLanguageHeader)
+
+		lang := i18n.Negotiate(c.GetHeader(AcceptLanguageHeader))
+		c.Writer.Header().Set(ContentLanguageHeader, string(lang))
+		c.Request = c.Request.WithContext(i18n.With(c.Request.Context(), lang))
+		c.Next()
+	}
+}
+
+// LanguageOf returns the language of the request, the default one outside of the routes the
+// Language middleware is installed on
+func LanguageOf(c *gin.Context) i18n.Language {
+	return i18n.From(c.Request.Context())
+}
@@ -0,0 +1,45 @@
+package httpservice
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLanguage(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(Language())
+	router.GET("/jobs", func(c *gin.Context) {
+		c.String(http.StatusOK, string(LanguageOf(c)))
+	})
+
+	tests := []struct {
+		name     string
+		header   string
+		expected string
+	}{
+		{name: "default language", expected: "en"},
+		{name: "language of the header", header: "es-CR,es;q=0.9,en;q=0.8", expected: "es"},
+		{name: "unsupported language", header: "fr", expected: "en"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			req := httptest.NewRequest(http.MethodGet, "/jobs", http.NoBody)
+			req.Header.Set(AcceptLanguageHeader, tt.header)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			assert.Equal(t, AcceptLanguageHeader, recorder.Header().Get("Vary"))
+			assert.Equal(t, tt.expected, recorder.Header().Get(ContentLanguageHeader))
+			assert.Equal(t, tt.expected, recorder.Body.String())
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package httpservice
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/actor"
+)
+
+// APIKeyHeader is the request header carrying the admin API key
+const APIKeyHeader = "X-API-Key"
+
+// errInvalidAPIKey is the error of requests without a valid API key
+var errInvalidAPIKey = NewError(ErrUnauthorized, "Missing or invalid API key")
+
+// RequireAPIKey returns a middleware that rejects requests whose X-API-Key header
+// does not match apiKey. It is used to protect the admin routes. The API key is the actor of the
+// changes made by the requests it lets through.
+func RequireAPIKey(apiKey string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		provided := c.GetHeader(APIKeyHeader)
+		if apiKey == "" || subtle.ConstantTimeCompare([]byte(provided), []byte(apiKey)) != 1 {
+			_ = c.Error(errInvalidAPIKey)
+			c.Abort()
+			return
+		}
+		c.Request = c.Request.WithContext(actor.With(c.Request.Context(), actor.APIKey(provided)))
+		c.Next()
+	}
+}
+
+// RequestTimeout returns a middleware that cancels the request context after timeout, so the
+// database queries of slow requests are canceled instead of hanging. Handlers answer requests
+// that ran out of time with 504 Gateway Timeout. A zero timeout disables it.
+func RequestTimeout(timeout time.Duration) gin.HandlerFunc {
+	return RequestTimeoutFunc(func() time.Duration { return timeout })
+}
+
+// RequestTimeoutFunc returns a middleware like RequestTimeout whose timeout is read on every
+// request, so it can be changed while the server runs
+func RequestTimeoutFunc(timeout func() time.Duration) gin.HandlerFunc {
+	return RouteTimeouts(timeout, nil)
+}
+
+// RouteTimeouts returns a middleware like RequestTimeoutFunc, except for the routes of routes which
+// get their own timeout, e.g. the long streamed ones. Routes are keyed by their path within the API
+// version, e.g. "/jobs/export.csv", so it must be installed on the groups of the API versions.
+func RouteTimeouts(timeout func() time.Duration, routes map[string]time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		current, ok := routes[strings.TrimPrefix(c.FullPath(), "/api/"+VersionOf(c))]
+		if !ok {
+			current = timeout()
+		}
+		if current <= 0 {
+			c.Next()
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), current)
+		defer cancel()
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
+// ErrorHandler returns a middleware writing the error response of the last error a handler
+// attached with c.Error, when the handler didn't write a response itself. Handlers report
+// their errors with c.Error, so it must be installed on the groups of all the API routes.
+func ErrorHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
+		}
+		c.JSON(MapError(c.Errors.Last().Err))
+	}
+}
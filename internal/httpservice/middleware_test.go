@@ -0,0 +1,63 @@
+package httpservice
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/actor"
+)
+
+func TestRequireAPIKey_SetsActor(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(ErrorHandler())
+
+	var got actor.Actor
+	router.GET("/admin", RequireAPIKey("secret"), func(c *gin.Context) {
+		got = actor.From(c.Request.Context())
+		c.Status(http.StatusNoContent)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/admin", http.NoBody)
+	req.Header.Set(APIKeyHeader, "secret")
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+	assert.Equal(t, actor.APIKey("secret"), got)
+}
+
+func TestRouteTimeouts(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	deadlines := make(map[string]time.Duration)
+	handler := func(c *gin.Context) {
+		if deadline, ok := c.Request.Context().Deadline(); ok {
+			deadlines[c.FullPath()] = time.Until(deadline).Round(time.Minute)
+		}
+		c.Status(http.StatusNoContent)
+	}
+	RegisterVersions(router, []APIVersion{{Name: "v1"}}, func(api *gin.RouterGroup, _ *APIVersion) {
+		api.GET("/jobs", handler)
+		api.GET("/jobs/export.csv", handler)
+		api.GET("/stats", handler)
+	}, RouteTimeouts(func() time.Duration { return time.Minute },
+		map[string]time.Duration{"/jobs/export.csv": 10 * time.Minute, "/stats": 0}))
+
+	for _, target := range []string{"/api/v1/jobs", "/api/v1/jobs/export.csv", "/api/v1/stats"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, target, http.NoBody))
+	}
+
+	assert.Equal(t, map[string]time.Duration{
+		"/api/v1/jobs":            time.Minute,
+		"/api/v1/jobs/export.csv": 10 * time.Minute,
+	}, deadlines)
+}
@@ -0,0 +1,49 @@
+package httpservice
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// LinkHeader is the header listing the links of the previous and next pages of a response (RFC 5988)
+const LinkHeader = "Link"
+
+// NewPaginationDetails returns the pagination metadata of a page of count items, out of total, starting
+// at offset. Pages are counted from 1 and have limit items.
+func NewPaginationDetails(total, limit, offset, count int) PaginationDetails {
+	pagination := PaginationDetails{
+		Total:   total,
+		Limit:   limit,
+		Offset:  offset,
+		HasMore: offset+count < total,
+	}
+	if limit > 0 {
+		pagination.TotalPages = (total + limit - 1) / limit
+		pagination.CurrentPage = offset/limit + 1
+	}
+	return pagination
+}
+
+// SetPaginationLinks sets the links of the next and previous pages of the pagination, the request URL
+// with another offset, and adds them to the Link header of the response.
+func SetPaginationLinks(c *gin.Context, pagination *PaginationDetails) {
+	if pagination.HasMore {
+		pagination.Next = pageURL(c.Request.URL, pagination.Limit, pagination.Offset+pagination.Limit)
+		c.Writer.Header().Add(LinkHeader, fmt.Sprintf(`<%s>; rel="next"`, pagination.Next))
+	}
+	if pagination.Offset > 0 {
+		pagination.Prev = pageURL(c.Request.URL, pagination.Limit, max(pagination.Offset-pagination.Limit, 0))
+		c.Writer.Header().Add(LinkHeader, fmt.Sprintf(`<%s>; rel="prev"`, pagination.Prev))
+	}
+}
+
+// pageURL returns the path and query of u with the limit and offset of a page
+func pageURL(u *url.URL, limit, offset int) string {
+	query := u.Query()
+	query.Set("limit", strconv.Itoa(limit))
+	query.Set("offset", strconv.Itoa(offset))
+	return u.Path + "?" + query.Encode()
+}
@@ -0,0 +1,107 @@
+package httpservice
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPaginationDetails(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		total    int
+		limit    int
+		offset   int
+		count    int
+		expected PaginationDetails
+	}{
+		{
+			name:  "first page",
+			total: 42, limit: 20, offset: 0, count: 20,
+			expected: PaginationDetails{Total: 42, Limit: 20, Offset: 0, HasMore: true, TotalPages: 3, CurrentPage: 1},
+		},
+		{
+			name:  "last page",
+			total: 42, limit: 20, offset: 40, count: 2,
+			expected: PaginationDetails{Total: 42, Limit: 20, Offset: 40, HasMore: false, TotalPages: 3, CurrentPage: 3},
+		},
+		{
+			name:  "offset within a page",
+			total: 42, limit: 20, offset: 25, count: 17,
+			expected: PaginationDetails{Total: 42, Limit: 20, Offset: 25, HasMore: false, TotalPages: 3, CurrentPage: 2},
+		},
+		{
+			name:  "no results",
+			total: 0, limit: 20, offset: 0, count: 0,
+			expected: PaginationDetails{Total: 0, Limit: 20, Offset: 0, HasMore: false, TotalPages: 0, CurrentPage: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, NewPaginationDetails(tt.total, tt.limit, tt.offset, tt.count))
+		})
+	}
+}
+
+func TestSetPaginationLinks(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name         string
+		pagination   PaginationDetails
+		expectedNext string
+		expectedPrev string
+		expectedLink []string
+	}{
+		{
+			name:         "first page",
+			pagination:   NewPaginationDetails(42, 20, 0, 20),
+			expectedNext: "/api/v1/jobs?limit=20&offset=20&q=golang",
+			expectedLink: []string{`</api/v1/jobs?limit=20&offset=20&q=golang>; rel="next"`},
+		},
+		{
+			name:         "middle page",
+			pagination:   NewPaginationDetails(42, 20, 20, 20),
+			expectedNext: "/api/v1/jobs?limit=20&offset=40&q=golang",
+			expectedPrev: "/api/v1/jobs?limit=20&offset=0&q=golang",
+			expectedLink: []string{
+				`</api/v1/jobs?limit=20&offset=40&q=golang>; rel="next"`,
+				`</api/v1/jobs?limit=20&offset=0&q=golang>; rel="prev"`,
+			},
+		},
+		{
+			name:         "last page with an offset within a page",
+			pagination:   NewPaginationDetails(42, 20, 30, 12),
+			expectedPrev: "/api/v1/jobs?limit=20&offset=10&q=golang",
+			expectedLink: []string{`</api/v1/jobs?limit=20&offset=10&q=golang>; rel="prev"`},
+		},
+		{
+			name:       "single page",
+			pagination: NewPaginationDetails(5, 20, 0, 5),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/jobs?q=golang&offset=7", http.NoBody)
+
+			pagination := tt.pagination
+			SetPaginationLinks(c, &pagination)
+
+			assert.Equal(t, tt.expectedNext, pagination.Next)
+			assert.Equal(t, tt.expectedPrev, pagination.Prev)
+			assert.Equal(t, tt.expectedLink, recorder.Header().Values(LinkHeader))
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package httpservice
+
+import (
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// errRateLimited is the error of the requests over the rate limit
+var errRateLimited = NewError(ErrTooManyRequests, "Too many requests, try again later")
+
+// RateLimit returns a middleware letting each client, by IP, make at most limit requests per
+// window. The other requests are answered with 429 Too Many Requests and a Retry-After header.
+// The requests are counted in memory, so each server instance limits them on its own.
+// A zero limit disables it.
+func RateLimit(limit int, window time.Duration) gin.HandlerFunc {
+	return RateLimitFunc(func() int { return limit }, window)
+}
+
+// RateLimitFunc returns a middleware like RateLimit whose limit is read on every request, so it
+// can be changed while the server runs
+func RateLimitFunc(limit func() int, window time.Duration) gin.HandlerFunc {
+	limiter := newRateLimiter(window)
+	return func(c *gin.Context) {
+		current := limit()
+		if current <= 0 || window <= 0 {
+			c.Next()
+			return
+		}
+
+		allowed, retryAfter := limiter.allow(c.ClientIP(), current, time.Now())
+		if !allowed {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+			_ = c.Error(errRateLimited)
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// rateWindow counts the requests of a client since the start of its current window
+type rateWindow struct {
+	start time.Time
+	count int
+}
+
+// rateLimiter counts the requests of each client in fixed windows
+type rateLimiter struct {
+	window time.Duration
+
+	mu      sync.Mutex
+	clients map[string]*rateWindow
+	// swept is when the windows that ended were last removed
+	swept time.Time
+}
+
+// newRateLimiter creates a rate limiter counting the requests per window
+func newRateLimiter(window time.Duration) *rateLimiter {
+	return &rateLimiter{window: window, clients: make(map[string]*rateWindow)}
+}
+
+// allow counts a request of client at now, reporting whether it is under limit and otherwise how
+// long until the client's window ends
+func (l *rateLimiter) allow(client string, limit int, now time.Time) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep(now)
+	current, ok := l.clients[client]
+	if !ok || now.Sub(current.start) >= l.window {
+		current = &rateWindow{start: now}
+		l.clients[client] = current
+	}
+	if current.count >= limit {
+		return false, current.start.Add(l.window).Sub(now)
+	}
+	current.count++
+	return true, 0
+}
+
+// sweep removes the windows that ended, at most once per window, so clients that stopped
+// making requests are forgotten
+func (l *rateLimiter) sweep(now time.Time) {
+	if now.Sub(l.swept) < l.window {
+		return
+	}
+	for client, current := range l.clients {
+		if now.Sub(current.start) >= l.window {
+			delete(l.clients, client)
+		}
+	}
+	l.swept = now
+}
@@ -0,0 +1,130 @@
+package httpservice
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimit(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(ErrorHandler())
+	router.GET("/export", RateLimit(2, time.Minute), func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	request := func(remoteAddr string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/export", http.NoBody)
+		req.RemoteAddr = remoteAddr
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	assert.Equal(t, http.StatusNoContent, request("10.0.0.1:1234").Code)
+	assert.Equal(t, http.StatusNoContent, request("10.0.0.1:1234").Code)
+
+	limited := request("10.0.0.1:1234")
+	assert.Equal(t, http.StatusTooManyRequests, limited.Code)
+	assert.Equal(t, "60", limited.Header().Get("Retry-After"))
+	var response ErrorResponse
+	require.NoError(t, json.Unmarshal(limited.Body.Bytes(), &response))
+	assert.Equal(t, ErrCodeTooManyRequests, response.Error.Code)
+
+	// Other clients have their own limit
+	assert.Equal(t, http.StatusNoContent, request("10.0.0.2:1234").Code)
+}
+
+func TestRateLimit_SpoofedForwardedFor(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	// No proxy is trusted, like cmd/server without TRUSTED_PROXIES
+	require.NoError(t, router.SetTrustedProxies(nil))
+	router.Use(ErrorHandler())
+	router.GET("/export", RateLimit(1, time.Minute), func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	request := func(forwardedFor string) int {
+		req := httptest.NewRequest(http.MethodGet, "/export", http.NoBody)
+		req.RemoteAddr = "10.0.0.1:1234"
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, req)
+		return recorder.Code
+	}
+
+	assert.Equal(t, http.StatusNoContent, request("198.51.100.1"))
+	assert.Equal(t, http.StatusTooManyRequests, request("198.51.100.2"), "the header doesn't change the limited client")
+}
+
+func TestRateLimit_Disabled(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/export", RateLimit(0, time.Minute), func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	for range 3 {
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/export", http.NoBody))
+		assert.Equal(t, http.StatusNoContent, recorder.Code)
+	}
+}
+
+func TestRateLimitFunc(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+	var limit atomic.Int64
+	limit.Store(1)
+	router := gin.New()
+	router.Use(ErrorHandler())
+	router.GET("/export", RateLimitFunc(func() int { return int(limit.Load()) }, time.Minute), func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+	request := func() int {
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/export", http.NoBody))
+		return recorder.Code
+	}
+
+	assert.Equal(t, http.StatusNoContent, request())
+	assert.Equal(t, http.StatusTooManyRequests, request())
+
+	// Raising the limit lets the client go on in the same window
+	limit.Store(2)
+	assert.Equal(t, http.StatusNoContent, request())
+	assert.Equal(t, http.StatusTooManyRequests, request())
+}
+
+func TestRateLimiter_Allow(t *testing.T) {
+	t.Parallel()
+	limiter := newRateLimiter(time.Minute)
+	start := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	allowed, _ := limiter.allow("client", 1, start)
+	assert.True(t, allowed)
+
+	allowed, retryAfter := limiter.allow("client", 1, start.Add(20*time.Second))
+	assert.False(t, allowed)
+	assert.Equal(t, 40*time.Second, retryAfter)
+
+	// A new window starts once the current one ends, and the ended ones are swept
+	allowed, _ = limiter.allow("other", 1, start.Add(time.Minute))
+	assert.True(t, allowed)
+	assert.NotContains(t, limiter.clients, "client")
+
+	allowed, _ = limiter.allow("client", 1, start.Add(time.Minute))
+	assert.True(t, allowed)
+}
@@ -0,0 +1,105 @@
+package httpservice
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"syscall"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxPanicFrames bounds the number of calls kept from the stack of a panic
+const maxPanicFrames = 64
+
+// Panic is a panic recovered while serving a request
+type Panic struct {
+	Value any
+	// Stack is the stack trace of the goroutine that panicked, as printed by debug.Stack
+	Stack []byte
+	// Frames are the calls that led to the panic, the one that panicked first
+	Frames []runtime.Frame
+}
+
+// Recovery returns a middleware recovering the panics of the next handlers. They are answered with
+// the internal error response, without the panic value, and passed to onPanic with the context of
+// the request so they can be logged and reported. Panics of connections closed by the client are
+// neither answered nor passed on. Handlers aborting their response with http.ErrAbortHandler panic
+// again, so the server closes the connection instead of ending the response. It replaces the
+// recovery of gin, so it must be installed before the other middlewares, after the logger so the
+// 500 responses are logged.
+func Recovery(onPanic func(c *gin.Context, p *Panic)) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			value := recover()
+			if value == nil {
+				return
+			}
+			if err, ok := value.(error); ok && errors.Is(err, http.ErrAbortHandler) {
+				c.Abort()
+				panic(value)
+			}
+			if isBrokenConnection(value) {
+				c.Abort()
+				return
+			}
+
+			onPanic(c, &Panic{Value: value, Stack: debug.Stack(), Frames: panicFrames()})
+			if c.Writer.Written() {
+				c.Abort()
+				return
+			}
+			c.AbortWithStatusJSON(http.StatusInternalServerError,
+				NewErrorResponse(ErrCodeInternalError, "Internal server error"))
+		}()
+		c.Next()
+	}
+}
+
+// isBrokenConnection reports whether a panic was caused by the client closing the connection
+func isBrokenConnection(value any) bool {
+	err, ok := value.(error)
+	if !ok {
+		return false
+	}
+	var opErr *net.OpError
+	if !errors.As(err, &opErr) {
+		return false
+	}
+	var syscallErr *os.SyscallError
+	return errors.As(opErr, &syscallErr) &&
+		(errors.Is(syscallErr, syscall.EPIPE) || errors.Is(syscallErr, syscall.ECONNRESET))
+}
+
+// panicFrames returns the calls that led to the panic being recovered, skipping the ones of the
+// recovery and of the runtime raising the panic, e.g. runtime.panicmem for a nil dereference
+func panicFrames() []runtime.Frame {
+	pcs := make([]uintptr, maxPanicFrames)
+	n := runtime.Callers(1, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	var calls []runtime.Frame
+	for {
+		frame, more := frames.Next()
+		calls = append(calls, frame)
+		if !more {
+			break
+		}
+	}
+
+	for i, frame := range calls {
+		if frame.Function != "runtime.gopanic" {
+			continue
+		}
+		i++
+		for i < len(calls) && strings.HasPrefix(calls[i].Function, "runtime.") {
+			i++
+		}
+		return calls[i:]
+	}
+	return calls
+}
@@ -0,0 +1,119 @@
+package httpservice
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecovery(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		handler    gin.HandlerFunc
+		wantStatus int
+		wantBody   string
+		checkPanic func(t *testing.T, p *Panic)
+	}{
+		{
+			name:       "panic answered with the internal error response",
+			handler:    func(_ *gin.Context) { panic("boom") },
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   `{"error":{"code":"INTERNAL_ERROR","message":"Internal server error"}}`,
+			checkPanic: func(t *testing.T, p *Panic) {
+				t.Helper()
+				assert.Equal(t, "boom", p.Value)
+				assert.Contains(t, string(p.Stack), "goroutine")
+				require.NotEmpty(t, p.Frames)
+				assert.Contains(t, p.Frames[0].Function, "TestRecovery")
+			},
+		},
+		{
+			name: "runtime error reported from the faulty call",
+			handler: func(_ *gin.Context) {
+				var counts map[string]int
+				counts["jobs"]++
+			},
+			wantStatus: http.StatusInternalServerError,
+			checkPanic: func(t *testing.T, p *Panic) {
+				t.Helper()
+				assert.ErrorContains(t, p.Value.(error), "nil map")
+				require.NotEmpty(t, p.Frames)
+				assert.Contains(t, p.Frames[0].Function, "TestRecovery")
+			},
+		},
+		{
+			name: "panic after the response was written",
+			handler: func(c *gin.Context) {
+				c.String(http.StatusOK, "partial")
+				panic("boom")
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   "partial",
+			checkPanic: func(t *testing.T, p *Panic) {
+				t.Helper()
+				assert.Equal(t, "boom", p.Value)
+			},
+		},
+		{
+			name: "connection closed by the client",
+			handler: func(_ *gin.Context) {
+				panic(&net.OpError{Op: "write", Net: "tcp", Err: os.NewSyscallError("write", syscall.EPIPE)})
+			},
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+
+			var recovered *Panic
+			router.Use(Recovery(func(_ *gin.Context, p *Panic) { recovered = p }))
+			router.GET("/jobs", tt.handler)
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/jobs", http.NoBody))
+
+			assert.Equal(t, tt.wantStatus, recorder.Code)
+			if tt.checkPanic == nil {
+				assert.Nil(t, recovered)
+				return
+			}
+			require.NotNil(t, recovered)
+			tt.checkPanic(t, recovered)
+			if tt.wantBody != "" {
+				assert.Equal(t, tt.wantBody, recorder.Body.String())
+			}
+		})
+	}
+}
+
+func TestRecovery_AbortHandler(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	var recovered *Panic
+	router.Use(Recovery(func(_ *gin.Context, p *Panic) { recovered = p }))
+	router.GET("/jobs", func(c *gin.Context) {
+		c.String(http.StatusOK, "partial")
+		panic(http.ErrAbortHandler)
+	})
+
+	// The server closes the connection on the panic, instead of ending the partial response
+	assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/jobs", http.NoBody))
+	})
+	assert.Nil(t, recovered)
+}
@@ -0,0 +1,48 @@
+package httpservice
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestID(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(RequestID())
+	router.GET("/jobs", func(c *gin.Context) {
+		c.String(http.StatusOK, RequestIDFrom(c.Request.Context()))
+	})
+
+	tests := []struct {
+		name     string
+		header   string
+		expected string
+	}{
+		{name: "ID of the proxy kept", header: "req-7f3a.1", expected: "req-7f3a.1"},
+		{name: "missing ID generated"},
+		{name: "invalid ID replaced", header: "bad id\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			req := httptest.NewRequest(http.MethodGet, "/jobs", http.NoBody)
+			req.Header.Set(RequestIDHeader, tt.header)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			id := recorder.Header().Get(RequestIDHeader)
+			assert.Equal(t, id, recorder.Body.String())
+			if tt.expected != "" {
+				assert.Equal(t, tt.expected, id)
+			} else {
+				assert.Regexp(t, "^[0-9a-f]{32}$", id)
+			}
+		})
+	}
+}
@@ -0,0 +1,286 @@
+package httpservice
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/cache"
+)
+
+// Values of the CacheStatusHeader
+const (
+	// CacheStatusHeader tells the client whether the response was served from the response cache
+	CacheStatusHeader = "X-Cache"
+
+	CacheHit   = "HIT"
+	CacheStale = "STALE"
+	CacheMiss  = "MISS"
+)
+
+// Defaults of the response cache
+const (
+	DefaultCacheMaxEntries     = 10000
+	DefaultCacheRefreshTimeout = 30 * time.Second
+)
+
+// CacheRule configures the caching of the responses of a route
+type CacheRule struct {
+	// TTL is how long a response is served from the cache as it is
+	TTL time.Duration
+	// StaleTTL is how long a response is still served once TTL passed, while it is refreshed in the background
+	StaleTTL time.Duration
+}
+
+// ResponseCacheConfig configures a ResponseCache
+type ResponseCacheConfig struct {
+	// Rules are the cached routes by their path within the API version, e.g. "/stats/overview"
+	// or "/companies/:slug/technologies"
+	Rules map[string]CacheRule
+	// MaxEntries bounds the number of cached responses, new responses aren't cached once reached
+	MaxEntries int
+	// RefreshTimeout bounds the time spent refreshing a stale response
+	RefreshTimeout time.Duration
+}
+
+// cachedResponse is a successful response, with the headers set by its handler
+type cachedResponse struct {
+	header    http.Header
+	body      []byte
+	staleAt   time.Time
+	expiresAt time.Time
+}
+
+// ResponseCache caches the successful responses of the read-heavy GET routes, so traffic spikes are
+// served from memory. Responses are cached by URL, and once stale they are served one last time while
+// the request is replayed in the background to refresh them (stale-while-revalidate), so no client
+// waits on the refresh.
+type ResponseCache struct {
+	handler http.Handler
+	cfg     ResponseCacheConfig
+
+	mu         sync.Mutex
+	entries    map[string]*cachedResponse
+	refreshing map[string]bool
+	// stats counts the stale responses served as hits
+	stats cache.Stats
+	now   func() time.Time
+}
+
+// refreshContextKey marks the requests replayed to refresh a stale response
+type refreshContextKey struct{}
+
+// NewResponseCache creates a new instance of ResponseCache. Stale responses are refreshed by serving
+// their request again with handler, the router serving the API. Unset options take their defaults.
+func NewResponseCache(handler http.Handler, cfg ResponseCacheConfig) *ResponseCache {
+	if cfg.MaxEntries <= 0 {
+		cfg.MaxEntries = DefaultCacheMaxEntries
+	}
+	if cfg.RefreshTimeout <= 0 {
+		cfg.RefreshTimeout = DefaultCacheRefreshTimeout
+	}
+	return &ResponseCache{
+		handler:    handler,
+		cfg:        cfg,
+		entries:    make(map[string]*cachedResponse),
+		refreshing: make(map[string]bool),
+		now:        time.Now,
+	}
+}
+
+// Middleware returns a middleware serving the routes with a rule from the cache. It must be installed
+// on the groups of the API versions, after the version and the country middlewares.
+func (rc *ResponseCache) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		rule, ok := rc.cfg.Rules[strings.TrimPrefix(c.FullPath(), "/api/"+VersionOf(c))]
+		if !ok || c.Request.Method != http.MethodGet {
+			c.Next()
+			return
+		}
+
+		// The responses of a route differ from a country and a language to another
+		key := CountryOf(c) + " " + string(LanguageOf(c)) + " " + c.Request.URL.Path + "?" +
+			c.Request.URL.Query().Encode()
+		if c.Request.Context().Value(refreshContextKey{}) == nil {
+			if entry, stale, found := rc.get(key); found {
+				status := CacheHit
+				if stale {
+					status = CacheStale
+					rc.refresh(key, c.Request)
+				}
+				entry.write(c, status)
+				c.Abort()
+				return
+			}
+			c.Header(CacheStatusHeader, CacheMiss)
+		}
+
+		writer := &recordingWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+		c.Next()
+
+		if writer.Status() == http.StatusOK && len(c.Errors) == 0 && writer.header != nil {
+			rc.set(key, rule, &cachedResponse{header: writer.header, body: writer.body.Bytes()})
+		}
+	}
+}
+
+// Clear removes every cached response, e.g. when the data they were rendered from changed
+func (rc *ResponseCache) Clear() {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	clear(rc.entries)
+}
+
+// Stats returns the counters of the cache, the refreshes of the stale responses aside
+func (rc *ResponseCache) Stats() cache.Stats {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	stats := rc.stats
+	stats.Entries = len(rc.entries)
+	return stats
+}
+
+// PurgeCache removes the expired responses, which are otherwise only removed when requested, and
+// returns how many were removed
+func (rc *ResponseCache) PurgeCache() int {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	return rc.purgeLocked()
+}
+
+// purgeLocked removes the expired responses, with rc.mu held
+func (rc *ResponseCache) purgeLocked() int {
+	now := rc.now()
+	purged := 0
+	for key, entry := range rc.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(rc.entries, key)
+			purged++
+		}
+	}
+	rc.stats.Evictions += int64(purged)
+	return purged
+}
+
+// get returns the response cached for key, unless it expired, and whether it is stale
+func (rc *ResponseCache) get(key string) (entry *cachedResponse, stale, found bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	entry, found = rc.entries[key]
+	if !found {
+		rc.stats.Misses++
+		return nil, false, false
+	}
+	now := rc.now()
+	if !now.Before(entry.expiresAt) {
+		delete(rc.entries, key)
+		rc.stats.Evictions++
+		rc.stats.Misses++
+		return nil, false, false
+	}
+	rc.stats.Hits++
+	return entry, !now.Before(entry.staleAt), true
+}
+
+// set caches the response of key with rule. It is dropped when the cache is full of unexpired responses.
+func (rc *ResponseCache) set(key string, rule CacheRule, entry *cachedResponse) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if _, ok := rc.entries[key]; !ok && len(rc.entries) >= rc.cfg.MaxEntries && rc.purgeLocked() == 0 {
+		return
+	}
+	now := rc.now()
+	entry.staleAt = now.Add(rule.TTL)
+	entry.expiresAt = entry.staleAt.Add(rule.StaleTTL)
+	rc.entries[key] = entry
+}
+
+// refresh replays req in the background to refresh the response cached for key, unless it is
+// already being refreshed
+func (rc *ResponseCache) refresh(key string, req *http.Request) {
+	rc.mu.Lock()
+	if rc.refreshing[key] {
+		rc.mu.Unlock()
+		return
+	}
+	rc.refreshing[key] = true
+	rc.mu.Unlock()
+
+	// The replayed request outlives the one it was copied from
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(req.Context()), rc.cfg.RefreshTimeout)
+	replay := req.Clone(context.WithValue(ctx, refreshContextKey{}, true))
+	go func() {
+		defer func() {
+			cancel()
+			rc.mu.Lock()
+			delete(rc.refreshing, key)
+			rc.mu.Unlock()
+		}()
+		rc.handler.ServeHTTP(&discardWriter{header: make(http.Header)}, replay)
+	}()
+}
+
+// write writes the cached response with the cache status
+func (r *cachedResponse) write(c *gin.Context, status string) {
+	header := c.Writer.Header()
+	for name, values := range r.header {
+		header[name] = slices.Clone(values)
+	}
+	c.Header(CacheStatusHeader, status)
+	c.Data(http.StatusOK, r.header.Get("Content-Type"), r.body)
+}
+
+// recordingWriter records the headers and the body written by the handlers, as they are written.
+// The headers are recorded with the first write, gin sets the content type after the status.
+type recordingWriter struct {
+	gin.ResponseWriter
+	// header holds the headers of the response when its body is first written, without the ones of
+	// the encoding
+	header http.Header
+	body   bytes.Buffer
+}
+
+// Write records data and writes it to the response body
+func (w *recordingWriter) Write(data []byte) (int, error) {
+	w.record()
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+// WriteString records s and writes it to the response body
+func (w *recordingWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// record copies the headers of the response the first time it is called. The request ID is left
+// out, every request served from the cache gets its own.
+func (w *recordingWriter) record() {
+	if w.header != nil {
+		return
+	}
+	w.header = w.Header().Clone()
+	for _, name := range []string{"Content-Encoding", "Content-Length", CacheStatusHeader, RequestIDHeader} {
+		w.header.Del(name)
+	}
+}
+
+// discardWriter is the response writer of the replayed requests, whose responses are only cached
+type discardWriter struct {
+	header http.Header
+}
+
+func (w *discardWriter) Header() http.Header            { return w.header }
+func (w *discardWriter) Write(data []byte) (int, error) { return len(data), nil }
+func (w *discardWriter) WriteHeader(int)                {}
@@ -0,0 +1,208 @@
+package httpservice
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/cache"
+)
+
+// cacheTestRouter serves /api/v1/stats, which counts the times it was served, through the cache
+type cacheTestRouter struct {
+	router *gin.Engine
+	cache  *ResponseCache
+	served atomic.Int32
+	failed atomic.Bool
+
+	mu  sync.Mutex
+	now time.Time
+}
+
+func newCacheTestRouter(t *testing.T, cfg ResponseCacheConfig) *cacheTestRouter {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	r := &cacheTestRouter{router: gin.New(), now: time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)}
+	r.cache = NewResponseCache(r.router, cfg)
+	r.cache.now = func() time.Time {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		return r.now
+	}
+	r.router.Use(RequestID())
+
+	versions := []APIVersion{{Name: "v1"}}
+	countries := Countries{Default: "CR", Supported: []string{"CR", "PA"}}
+	RegisterVersions(r.router, versions, func(api *gin.RouterGroup, _ *APIVersion) {
+		handler := func(c *gin.Context) {
+			if r.failed.Load() {
+				_ = c.Error(errors.New("database error"))
+				return
+			}
+			c.Header("Link", `</api/v1/stats?page=2>; rel="next"`)
+			c.JSON(http.StatusOK, gin.H{"served": r.served.Add(1)})
+		}
+		api.GET("/stats", handler)
+		api.GET("/jobs", handler)
+	}, ErrorHandler(), countries.Middleware(), r.cache.Middleware())
+	return r
+}
+
+// advance moves the clock of the cache forward
+func (r *cacheTestRouter) advance(d time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.now = r.now.Add(d)
+}
+
+func (r *cacheTestRouter) get(t *testing.T, target string) *httptest.ResponseRecorder {
+	t.Helper()
+	w := httptest.NewRecorder()
+	r.router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, target, nil))
+	return w
+}
+
+func TestResponseCache(t *testing.T) {
+	t.Parallel()
+	rules := map[string]CacheRule{"/stats": {TTL: time.Minute, StaleTTL: 10 * time.Minute}}
+
+	t.Run("fresh responses served from the cache", func(t *testing.T) {
+		t.Parallel()
+		r := newCacheTestRouter(t, ResponseCacheConfig{Rules: rules})
+
+		w := r.get(t, "/api/v1/stats?b=2&a=1")
+		assert.Equal(t, CacheMiss, w.Header().Get(CacheStatusHeader))
+		assert.JSONEq(t, `{"served": 1}`, w.Body.String())
+
+		// Same query in another order
+		w = r.get(t, "/api/v1/stats?a=1&b=2")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, CacheHit, w.Header().Get(CacheStatusHeader))
+		assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, `</api/v1/stats?page=2>; rel="next"`, w.Header().Get("Link"))
+		assert.Equal(t, "v1", w.Header().Get(APIVersionHeader))
+		assert.JSONEq(t, `{"served": 1}`, w.Body.String())
+
+		w = r.get(t, "/api/v1/stats?a=2")
+		assert.Equal(t, CacheMiss, w.Header().Get(CacheStatusHeader))
+		assert.JSONEq(t, `{"served": 2}`, w.Body.String())
+
+		assert.Equal(t, cache.Stats{Hits: 1, Misses: 2, Entries: 2}, r.cache.Stats())
+	})
+
+	t.Run("cached responses with the ID of their request", func(t *testing.T) {
+		t.Parallel()
+		r := newCacheTestRouter(t, ResponseCacheConfig{Rules: rules})
+		for _, id := range []string{"first-id", "second-id", "third-id"} {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/stats", nil)
+			req.Header.Set(RequestIDHeader, id)
+			w := httptest.NewRecorder()
+			r.router.ServeHTTP(w, req)
+			assert.Equal(t, id, w.Header().Get(RequestIDHeader))
+			assert.JSONEq(t, `{"served": 1}`, w.Body.String())
+		}
+	})
+
+	t.Run("responses cached per country", func(t *testing.T) {
+		t.Parallel()
+		r := newCacheTestRouter(t, ResponseCacheConfig{Rules: rules})
+		r.get(t, "/api/v1/stats")
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/stats", nil)
+		req.Header.Set(CountryHeader, "PA")
+		w := httptest.NewRecorder()
+		r.router.ServeHTTP(w, req)
+		assert.Equal(t, CacheMiss, w.Header().Get(CacheStatusHeader))
+		assert.JSONEq(t, `{"served": 2}`, w.Body.String())
+
+		assert.Equal(t, CacheHit, r.get(t, "/api/v1/stats").Header().Get(CacheStatusHeader))
+	})
+
+	t.Run("stale responses served while refreshed", func(t *testing.T) {
+		t.Parallel()
+		r := newCacheTestRouter(t, ResponseCacheConfig{Rules: rules})
+		r.get(t, "/api/v1/stats")
+
+		r.advance(2 * time.Minute)
+		w := r.get(t, "/api/v1/stats")
+		assert.Equal(t, CacheStale, w.Header().Get(CacheStatusHeader))
+		assert.JSONEq(t, `{"served": 1}`, w.Body.String())
+
+		require.Eventually(t, func() bool {
+			return r.get(t, "/api/v1/stats").Header().Get(CacheStatusHeader) == CacheHit
+		}, time.Second, 10*time.Millisecond)
+		assert.JSONEq(t, `{"served": 2}`, r.get(t, "/api/v1/stats").Body.String())
+	})
+
+	t.Run("expired responses served again", func(t *testing.T) {
+		t.Parallel()
+		r := newCacheTestRouter(t, ResponseCacheConfig{Rules: rules})
+		r.get(t, "/api/v1/stats")
+
+		r.advance(11 * time.Minute)
+		w := r.get(t, "/api/v1/stats")
+		assert.Equal(t, CacheMiss, w.Header().Get(CacheStatusHeader))
+		assert.JSONEq(t, `{"served": 2}`, w.Body.String())
+	})
+
+	t.Run("errors not cached", func(t *testing.T) {
+		t.Parallel()
+		r := newCacheTestRouter(t, ResponseCacheConfig{Rules: rules})
+		r.failed.Store(true)
+		assert.Equal(t, http.StatusInternalServerError, r.get(t, "/api/v1/stats").Code)
+
+		r.failed.Store(false)
+		w := r.get(t, "/api/v1/stats")
+		assert.Equal(t, CacheMiss, w.Header().Get(CacheStatusHeader))
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("routes without a rule not cached", func(t *testing.T) {
+		t.Parallel()
+		r := newCacheTestRouter(t, ResponseCacheConfig{Rules: rules})
+		r.get(t, "/api/v1/jobs")
+
+		w := r.get(t, "/api/v1/jobs")
+		assert.Empty(t, w.Header().Get(CacheStatusHeader))
+		assert.JSONEq(t, `{"served": 2}`, w.Body.String())
+	})
+
+	t.Run("full cache", func(t *testing.T) {
+		t.Parallel()
+		r := newCacheTestRouter(t, ResponseCacheConfig{Rules: rules, MaxEntries: 2})
+		for i := range 3 {
+			r.get(t, "/api/v1/stats?page="+strconv.Itoa(i))
+		}
+
+		assert.Equal(t, CacheHit, r.get(t, "/api/v1/stats?page=1").Header().Get(CacheStatusHeader))
+		assert.Equal(t, CacheMiss, r.get(t, "/api/v1/stats?page=2").Header().Get(CacheStatusHeader))
+
+		// Expired responses make room
+		r.advance(11 * time.Minute)
+		r.get(t, "/api/v1/stats?page=2")
+		assert.Equal(t, CacheHit, r.get(t, "/api/v1/stats?page=2").Header().Get(CacheStatusHeader))
+	})
+
+	t.Run("purge and clear", func(t *testing.T) {
+		t.Parallel()
+		r := newCacheTestRouter(t, ResponseCacheConfig{Rules: rules})
+		r.get(t, "/api/v1/stats?page=1")
+		r.advance(5 * time.Minute)
+		r.get(t, "/api/v1/stats?page=2")
+
+		r.advance(6 * time.Minute)
+		assert.Equal(t, 1, r.cache.PurgeCache())
+
+		r.cache.Clear()
+		assert.Equal(t, CacheMiss, r.get(t, "/api/v1/stats?page=2").Header().Get(CacheStatusHeader))
+	})
+}
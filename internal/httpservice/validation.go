@@ -0,0 +1,202 @@
+package httpservice
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// Requests declare their rules with binding tags, checked by the gin validator when they are
+// bound. Besides the built-in validators, the ones below are registered once for every module,
+// and the modules register the validators of their own values, such as enums, with
+// RegisterValidation. Failures are answered with a ValidationError listing a message per field.
+
+// DateLayout is the format of the dates of the query parameters
+const DateLayout = "2006-01-02"
+
+// defaultValidationMessage is the message of the validators registered without one
+const defaultValidationMessage = "invalid value for field: '%s'"
+
+// messageFunc returns the message of a failed validation of field, param being the tag parameter
+type messageFunc func(field, param string) string
+
+// validationMessages holds the message of the validators by tag
+var validationMessages = map[string]messageFunc{
+	"required": formatMessage("%s cannot be empty"),
+	"url":      formatMessage("%s must be a valid URL"),
+	// Both fields of the pair carry the tag, so the names are sorted to get the same message
+	"required_with": func(field, param string) string {
+		names := []string{field, param}
+		slices.Sort(names)
+		return fmt.Sprintf("both %s and %s must be provided together", names[0], names[1])
+	},
+}
+
+func init() {
+	engine().RegisterTagNameFunc(fieldName)
+
+	RegisterValidation("notblank", isNotBlank, "%s cannot be empty")
+	RegisterValidation("trimmed_min", hasTrimmedMin, "%s must be at least %s characters")
+	RegisterValidation("trimmed_max", hasTrimmedMax, "%s cannot exceed %s characters")
+	RegisterValidation("date", isDate, "%s must be in YYYY-MM-DD format")
+	RegisterValidation("date_lte", isDateNotAfterField, "%s cannot be after %s")
+}
+
+// engine returns the validator of the gin bindings
+func engine() *validator.Validate {
+	v, _ := binding.Validator.Engine().(*validator.Validate)
+	return v
+}
+
+// RegisterValidation registers a validator for the binding tag, reported with message, a format
+// given the field name and the tag parameter. An empty message reports "invalid value for field".
+// It isn't safe for concurrent use and must be called from an init function, before any request
+// using the tag is bound.
+func RegisterValidation(tag string, fn validator.Func, message string) {
+	if err := engine().RegisterValidation(tag, fn); err != nil {
+		panic(fmt.Sprintf("failed to register validator %s: %v", tag, err))
+	}
+	if message != "" {
+		validationMessages[tag] = formatMessage(message)
+	}
+}
+
+// RegisterAlias registers a binding tag standing for a list of tags, e.g. the rules of a parameter
+// used by several requests. Failures are reported with the message of the tag that failed. Like
+// RegisterValidation, it must be called from an init function.
+func RegisterAlias(alias, tags string) {
+	engine().RegisterAlias(alias, tags)
+}
+
+// OneOf returns a validator accepting the given values only, for enums with values containing
+// spaces that the built-in oneof can't list
+func OneOf(values ...string) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		return slices.Contains(values, fl.Field().String())
+	}
+}
+
+// Validate checks the binding tags of a request, for requests validated after being bound
+func Validate(req any) error {
+	if err := engine().Struct(req); err != nil {
+		return toValidationError(err)
+	}
+	return nil
+}
+
+// toValidationError converts the errors of the validator into a ValidationError with a message
+// per failed field. Other errors are returned as is.
+func toValidationError(err error) error {
+	var fieldErrs validator.ValidationErrors
+	if !errors.As(err, &fieldErrs) {
+		return err
+	}
+
+	messages := make([]string, 0, len(fieldErrs))
+	for _, fieldErr := range fieldErrs {
+		message, ok := validationMessages[fieldErr.ActualTag()]
+		if !ok {
+			message = formatMessage(defaultValidationMessage)
+		}
+		messages = append(messages, message(fieldErr.Field(), paramName(fieldErr.Param())))
+	}
+	return &ValidationError{Errors: messages}
+}
+
+// formatMessage returns a messageFunc formatting the field name and, when used, the tag parameter
+func formatMessage(format string) messageFunc {
+	if strings.Count(format, "%s") < 2 {
+		return func(field, _ string) string { return fmt.Sprintf(format, field) }
+	}
+	return func(field, param string) string { return fmt.Sprintf(format, field, param) }
+}
+
+// fieldName names a field in the messages by its label tag, else by its query or JSON name
+func fieldName(field reflect.StructField) string {
+	for _, tag := range []string{"label", "form", "json"} {
+		if name, _, _ := strings.Cut(field.Tag.Get(tag), ","); name != "" && name != "-" {
+			return name
+		}
+	}
+	return field.Name
+}
+
+// paramName converts the struct field named by the parameter of a cross-field tag, e.g.
+// "DateTo", to the snake case name it has in the requests. Other parameters are kept.
+func paramName(param string) string {
+	if param == "" || !unicode.IsUpper(rune(param[0])) {
+		return param
+	}
+
+	// Acronyms are kept together, e.g. "UTCOffsetMax" is "utc_offset_max"
+	runes := []rune(param)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			startsWord := i > 0 && (!unicode.IsUpper(runes[i-1]) || i+1 < len(runes) && unicode.IsLower(runes[i+1]))
+			if startsWord {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// isNotBlank reports whether a string has characters other than whitespace
+func isNotBlank(fl validator.FieldLevel) bool {
+	return strings.TrimSpace(fl.Field().String()) != ""
+}
+
+// hasTrimmedMin reports whether a string without its surrounding whitespace has at least the
+// number of characters of the parameter
+func hasTrimmedMin(fl validator.FieldLevel) bool {
+	limit, err := strconv.Atoi(fl.Param())
+	return err == nil && len(strings.TrimSpace(fl.Field().String())) >= limit
+}
+
+// hasTrimmedMax reports whether a string without its surrounding whitespace has at most the
+// number of characters of the parameter
+func hasTrimmedMax(fl validator.FieldLevel) bool {
+	limit, err := strconv.Atoi(fl.Param())
+	return err == nil && len(strings.TrimSpace(fl.Field().String())) <= limit
+}
+
+// isDate reports whether a string is a date in DateLayout. Empty strings are accepted, so the
+// optional dates don't need omitempty.
+func isDate(fl validator.FieldLevel) bool {
+	value := fl.Field().String()
+	if value == "" {
+		return true
+	}
+	_, err := time.Parse(DateLayout, value)
+	return err == nil
+}
+
+// isDateNotAfterField reports whether a date is not after the date of the field named by the
+// parameter. It passes when any of both dates is missing or invalid, the date tag reports them.
+func isDateNotAfterField(fl validator.FieldLevel) bool {
+	other, kind, _, ok := fl.GetStructFieldOKAdvanced2(fl.Parent(), fl.Param())
+	if !ok || kind != reflect.String {
+		return true
+	}
+
+	date, err := time.Parse(DateLayout, fl.Field().String())
+	if err != nil {
+		return true
+	}
+	otherDate, err := time.Parse(DateLayout, other.String())
+	if err != nil {
+		return true
+	}
+	return !date.After(otherDate)
+}
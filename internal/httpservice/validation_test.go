@@ -0,0 +1,115 @@
+package httpservice
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// validatedRequest is a request with the validators of every module
+type validatedRequest struct {
+	Query    string `form:"q" label:"search query" binding:"required,notblank,trimmed_min=2,trimmed_max=10"`
+	Status   string `form:"status" binding:"omitempty,oneof=open closed"`
+	Kind     string `form:"kind" binding:"omitempty,test_kind"`
+	DateFrom string `form:"date_from" binding:"required_with=DateTo,date,date_lte=DateTo"`
+	DateTo   string `form:"date_to" binding:"required_with=DateFrom,date"`
+}
+
+func init() {
+	RegisterValidation("test_kind", OneOf("Full time", "Part time"), "")
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		req            validatedRequest
+		expectedErrors []string
+	}{
+		{
+			name: "valid request",
+			req: validatedRequest{
+				Query: "golang", Status: "open", Kind: "Full time", DateFrom: "2024-01-01", DateTo: "2024-01-31",
+			},
+		},
+		{
+			name:           "missing query",
+			req:            validatedRequest{},
+			expectedErrors: []string{"search query cannot be empty"},
+		},
+		{
+			name:           "blank query",
+			req:            validatedRequest{Query: "   "},
+			expectedErrors: []string{"search query cannot be empty"},
+		},
+		{
+			name:           "query too short once trimmed",
+			req:            validatedRequest{Query: " a "},
+			expectedErrors: []string{"search query must be at least 2 characters"},
+		},
+		{
+			name:           "query too long",
+			req:            validatedRequest{Query: "a very long query"},
+			expectedErrors: []string{"search query cannot exceed 10 characters"},
+		},
+		{
+			name:           "invalid enums",
+			req:            validatedRequest{Query: "golang", Status: "draft", Kind: "Contract"},
+			expectedErrors: []string{"invalid value for field: 'status'", "invalid value for field: 'kind'"},
+		},
+		{
+			name:           "date without the other end of the range",
+			req:            validatedRequest{Query: "golang", DateTo: "2024-01-31"},
+			expectedErrors: []string{"both date_from and date_to must be provided together"},
+		},
+		{
+			name:           "invalid dates",
+			req:            validatedRequest{Query: "golang", DateFrom: "2024-13-01", DateTo: "01/31/2024"},
+			expectedErrors: []string{"date_from must be in YYYY-MM-DD format", "date_to must be in YYYY-MM-DD format"},
+		},
+		{
+			name:           "inverted date range",
+			req:            validatedRequest{Query: "golang", DateFrom: "2024-02-01", DateTo: "2024-01-31"},
+			expectedErrors: []string{"date_from cannot be after date_to"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := Validate(&tt.req)
+
+			if tt.expectedErrors == nil {
+				assert.NoError(t, err)
+				return
+			}
+			var validationErr *ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.expectedErrors, validationErr.Errors)
+		})
+	}
+}
+
+func TestMapError_BindingValidation(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodGet, "/?q=go&date_from=2024-02-01&date_to=2024-01-31", http.NoBody)
+
+	var req validatedRequest
+	err := c.ShouldBindQuery(&req)
+	require.Error(t, err)
+
+	status, resp := MapError(&RequestParseError{Err: err})
+
+	assert.Equal(t, http.StatusBadRequest, status)
+	assert.Equal(t, ErrCodeValidationError, resp.Error.Code)
+	assert.Equal(t, []string{"date_from cannot be after date_to"}, resp.Error.Details)
+}
@@ -0,0 +1,76 @@
+package httpservice
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Headers of the API versioning
+const (
+	// APIVersionHeader tells the client the API version that served the request
+	APIVersionHeader = "API-Version"
+	// DeprecationHeader announces when the API version was or will be deprecated (RFC 9745)
+	DeprecationHeader = "Deprecation"
+	// SunsetHeader announces when the API version stops being served (RFC 8594)
+	SunsetHeader = "Sunset"
+
+	versionContextKey = "httpservice.api_version"
+)
+
+// APIVersion is a version of the API, served under /api/<name>. A newer version can change
+// the shape of the responses while the older ones keep working until their sunset.
+type APIVersion struct {
+	// Name is the path segment of the version, e.g. "v1"
+	Name string
+	// Deprecation is when the version was or will be deprecated, zero while it's current
+	Deprecation time.Time
+	// Sunset is when the version stops being served, zero when not planned
+	Sunset time.Time
+	// Successor is the path of the version replacing it, e.g. "/api/v2"
+	Successor string
+}
+
+// Path returns the path prefix of the version routes
+func (v *APIVersion) Path() string {
+	return "/api/" + v.Name
+}
+
+// Middleware returns a middleware storing the version in the request context and adding the
+// version headers to the responses, with the deprecation ones once the version is deprecated
+func (v *APIVersion) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(versionContextKey, v.Name)
+		c.Header(APIVersionHeader, v.Name)
+		if !v.Deprecation.IsZero() {
+			c.Header(DeprecationHeader, "@"+strconv.FormatInt(v.Deprecation.Unix(), 10))
+		}
+		if !v.Sunset.IsZero() {
+			c.Header(SunsetHeader, v.Sunset.UTC().Format(http.TimeFormat))
+		}
+		if !v.Deprecation.IsZero() && v.Successor != "" {
+			c.Header("Link", fmt.Sprintf(`<%s>; rel="successor-version"`, v.Successor))
+		}
+		c.Next()
+	}
+}
+
+// RegisterVersions creates the route group of every version, with the version middleware
+// followed by middleware, and registers the routes of the version with register.
+func RegisterVersions(r gin.IRouter, versions []APIVersion, register func(rg *gin.RouterGroup, version *APIVersion),
+	middleware ...gin.HandlerFunc) {
+	for i := range versions {
+		version := &versions[i]
+		handlers := append([]gin.HandlerFunc{version.Middleware()}, middleware...)
+		register(r.Group(version.Path(), handlers...), version)
+	}
+}
+
+// VersionOf returns the name of the API version serving the request, empty outside of the versioned routes.
+// Handlers whose response shape differs between versions switch on it.
+func VersionOf(c *gin.Context) string {
+	return c.GetString(versionContextKey)
+}
@@ -0,0 +1,45 @@
+package httpservice
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterVersions(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	versions := []APIVersion{
+		{
+			Name:        "v1",
+			Deprecation: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			Sunset:      time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC),
+			Successor:   "/api/v2",
+		},
+		{Name: "v2"},
+	}
+	RegisterVersions(router, versions, func(rg *gin.RouterGroup, _ *APIVersion) {
+		rg.GET("/jobs", func(c *gin.Context) {
+			c.String(http.StatusOK, VersionOf(c))
+		})
+	})
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/jobs", http.NoBody))
+	assert.Equal(t, "v1", recorder.Body.String())
+	assert.Equal(t, "v1", recorder.Header().Get(APIVersionHeader))
+	assert.Equal(t, "@1735689600", recorder.Header().Get(DeprecationHeader))
+	assert.Equal(t, "Tue, 01 Jul 2025 00:00:00 GMT", recorder.Header().Get(SunsetHeader))
+	assert.Equal(t, `</api/v2>; rel="successor-version"`, recorder.Header().Get("Link"))
+
+	recorder = httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v2/jobs", http.NoBody))
+	assert.Equal(t, "v2", recorder.Body.String())
+	assert.Empty(t, recorder.Header().Get(DeprecationHeader))
+	assert.Empty(t, recorder.Header().Get(SunsetHeader))
+}
@@ -0,0 +1,71 @@
+package i18n
+
+// Enums labeled by the catalog
+const (
+	ExperienceLevel = "experience_level"
+	EmploymentType  = "employment_type"
+	WorkMode        = "work_mode"
+)
+
+// catalog holds the labels of the enum values by language, keyed by enum and value
+var catalog = map[Language]map[string]string{
+	English: {
+		"experience_level.Entry-level": "Entry level",
+		"experience_level.Junior":      "Junior",
+		"experience_level.Mid-level":   "Mid level",
+		"experience_level.Senior":      "Senior",
+		"experience_level.Lead":        "Lead",
+		"experience_level.Principal":   "Principal",
+		"experience_level.Executive":   "Executive",
+
+		"employment_type.Full-time":  "Full time",
+		"employment_type.Part-time":  "Part time",
+		"employment_type.Contract":   "Contract",
+		"employment_type.Freelance":  "Freelance",
+		"employment_type.Temporary":  "Temporary",
+		"employment_type.Internship": "Internship",
+
+		"work_mode.Remote": "Remote",
+		"work_mode.Hybrid": "Hybrid",
+		"work_mode.Onsite": "On-site",
+	},
+	Spanish: {
+		"experience_level.Entry-level": "Sin experiencia",
+		"experience_level.Junior":      "Junior",
+		"experience_level.Mid-level":   "Intermedio",
+		"experience_level.Senior":      "Senior",
+		"experience_level.Lead":        "Líder técnico",
+		"experience_level.Principal":   "Principal",
+		"experience_level.Executive":   "Ejecutivo",
+
+		"employment_type.Full-time":  "Tiempo completo",
+		"employment_type.Part-time":  "Medio tiempo",
+		"employment_type.Contract":   "Contrato",
+		"employment_type.Freelance":  "Independiente",
+		"employment_type.Temporary":  "Temporal",
+		"employment_type.Internship": "Pasantía",
+
+		"work_mode.Remote": "Remoto",
+		"work_mode.Hybrid": "Híbrido",
+		"work_mode.Onsite": "Presencial",
+	},
+}
+
+// Label returns the label of a value of an enum in lang, e.g. "Remoto" for the Remote work mode in
+// Spanish. Values the catalog of lang misses are labeled in the default language, or by themselves.
+func Label(lang Language, enum, value string) string {
+	if label, ok := Lookup(lang, enum, value); ok {
+		return label
+	}
+	if label, ok := Lookup(Default, enum, value); ok {
+		return label
+	}
+	return value
+}
+
+// Lookup returns the label of a value of an enum in lang, reporting false when the catalog of lang
+// misses it
+func Lookup(lang Language, enum, value string) (string, bool) {
+	label, ok := catalog[lang][enum+"."+value]
+	return label, ok
+}
@@ -0,0 +1,92 @@
+// Package i18n localizes the API responses. The language of a request is negotiated from its
+// Accept-Language header and carried in its context, and the message catalog labels the values
+// of the job enums in each supported language.
+package i18n
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Language is a supported language, an ISO 639-1 code
+type Language string
+
+// Supported languages
+const (
+	English Language = "en"
+	Spanish Language = "es"
+
+	// Default is the language of the requests accepting none of the supported ones
+	Default = English
+)
+
+// supported are the languages with a message catalog
+var supported = []Language{English, Spanish}
+
+// Supported returns the supported languages
+func Supported() []Language {
+	return slices.Clone(supported)
+}
+
+// contextKey holds the language of a context
+type contextKey struct{}
+
+// With returns a copy of ctx carrying the language lang
+func With(ctx context.Context, lang Language) context.Context {
+	return context.WithValue(ctx, contextKey{}, lang)
+}
+
+// From returns the language of ctx, the default one when there is none
+func From(ctx context.Context) Language {
+	if lang, ok := ctx.Value(contextKey{}).(Language); ok {
+		return lang
+	}
+	return Default
+}
+
+// acceptedLanguage is a language range of an Accept-Language header with its quality
+type acceptedLanguage struct {
+	tag     string
+	quality float64
+}
+
+// Negotiate returns the supported language a request prefers according to its Accept-Language
+// header (RFC 9110), e.g. Spanish for "es-CR,es;q=0.9,en;q=0.8". Regional variants match their
+// language, "*" matches the default one, and the default one is returned when none is supported.
+func Negotiate(header string) Language {
+	var accepted []acceptedLanguage
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			continue
+		}
+		quality := 1.0
+		if name, value, ok := strings.Cut(strings.TrimSpace(params), "="); ok && strings.TrimSpace(name) == "q" {
+			q, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil {
+				continue
+			}
+			quality = q
+		}
+		if quality <= 0 {
+			continue
+		}
+		accepted = append(accepted, acceptedLanguage{tag: tag, quality: quality})
+	}
+	sort.SliceStable(accepted, func(i, j int) bool { return accepted[i].quality > accepted[j].quality })
+
+	for _, a := range accepted {
+		if a.tag == "*" {
+			return Default
+		}
+		primary, _, _ := strings.Cut(a.tag, "-")
+		if lang := Language(primary); slices.Contains(supported, lang) {
+			return lang
+		}
+	}
+	return Default
+}
@@ -0,0 +1,70 @@
+package i18n
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		header   string
+		expected Language
+	}{
+		{name: "no header", header: "", expected: Default},
+		{name: "spanish", header: "es", expected: Spanish},
+		{name: "regional variant", header: "es-CR", expected: Spanish},
+		{name: "upper case", header: "ES-cr", expected: Spanish},
+		{name: "first of equal quality", header: "es, en", expected: Spanish},
+		{name: "highest quality", header: "es;q=0.5, en;q=0.8", expected: English},
+		{name: "browser header", header: "es-CR,es;q=0.9,en-US;q=0.8,en;q=0.7", expected: Spanish},
+		{name: "unsupported skipped", header: "fr-FR, fr;q=0.9, es;q=0.5", expected: Spanish},
+		{name: "refused language", header: "es;q=0, en;q=0.1", expected: English},
+		{name: "invalid quality skipped", header: "es;q=high, en;q=0.3", expected: English},
+		{name: "wildcard", header: "fr, *;q=0.5", expected: Default},
+		{name: "none supported", header: "fr, de", expected: Default},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, Negotiate(tt.header))
+		})
+	}
+}
+
+func TestFrom(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, Default, From(context.Background()))
+	assert.Equal(t, Spanish, From(With(context.Background(), Spanish)))
+}
+
+func TestLabel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		lang     Language
+		enum     string
+		value    string
+		expected string
+	}{
+		{name: "english", lang: English, enum: WorkMode, value: "Onsite", expected: "On-site"},
+		{name: "spanish", lang: Spanish, enum: EmploymentType, value: "Part-time", expected: "Medio tiempo"},
+		{name: "unknown language", lang: "fr", enum: WorkMode, value: "Remote", expected: "Remote"},
+		{name: "unknown value", lang: Spanish, enum: WorkMode, value: "Flexible", expected: "Flexible"},
+		{name: "value of another enum", lang: Spanish, enum: WorkMode, value: "Senior", expected: "Senior"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, Label(tt.lang, tt.enum, tt.value))
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package ingest
+
+import (
+	"time"
+)
+
+// Data Transfer Objects (DTOs) for the ingest API layer.
+
+// StartRunRequest represents the request body to register a scraper run
+type StartRunRequest struct {
+	Source string `json:"source" binding:"required" example:"linkedin-scraper"`
+}
+
+// CompanyReportRequest represents the request body to report the scrape status of a company
+type CompanyReportRequest struct {
+	Company   string `json:"company" binding:"required" example:"Tech Corp"`
+	Status    string `json:"status" binding:"required,oneof=succeeded failed" example:"succeeded"`
+	JobsFound int    `json:"jobs_found" example:"12"`
+	Error     string `json:"error" example:""`
+}
+
+// ToCompanyReport converts a CompanyReportRequest to the CompanyReport of a run
+func (req *CompanyReportRequest) ToCompanyReport(runID int) *CompanyReport {
+	return &CompanyReport{
+		RunID:       runID,
+		CompanyName: req.Company,
+		Status:      RunStatus(req.Status),
+		JobsFound:   req.JobsFound,
+		Error:       req.Error,
+	}
+}
+
+// CloseRunRequest represents the request body to close a scraper run
+type CloseRunRequest struct {
+	Status string `json:"status" binding:"required,oneof=succeeded failed" example:"failed"`
+	Error  string `json:"error" example:"careers site timed out"`
+}
+
+// RunListRequest represents the query parameters to list runs
+type RunListRequest struct {
+	Limit int `form:"limit" binding:"omitempty,min=1,max=100" example:"20"`
+}
+
+// StaleRequest represents the query parameters to list stale companies
+type StaleRequest struct {
+	MaxAgeHours int `form:"max_age_hours" binding:"omitempty,min=1,max=2160" example:"48"`
+}
+
+// JobsValidationResponse represents a batch of jobs matching the schema
+type JobsValidationResponse struct {
+	Jobs int `json:"jobs" example:"42"`
+}
+
+// RunResponse represents a scraper run in API responses
+type RunResponse struct {
+	ID         int        `json:"id" example:"15"`
+	Source     string     `json:"source" example:"linkedin-scraper"`
+	Status     string     `json:"status" example:"running"`
+	Error      string     `json:"error,omitempty" example:""`
+	StartedAt  time.Time  `json:"started_at" example:"2024-01-15T06:00:00Z"`
+	FinishedAt *time.Time `json:"finished_at,omitempty" example:"2024-01-15T06:20:00Z"`
+}
+
+// RunSummaryResponse represents a scraper run with the totals of its company reports
+type RunSummaryResponse struct {
+	RunResponse
+	CompaniesSucceeded int `json:"companies_succeeded" example:"40"`
+	CompaniesFailed    int `json:"companies_failed" example:"2"`
+	JobsFound          int `json:"jobs_found" example:"315"`
+}
+
+// RunListResponse represents the list of recent runs
+type RunListResponse struct {
+	Data []*RunSummaryResponse `json:"data"`
+}
+
+// CompanyReportResponse represents a stored company report
+type CompanyReportResponse struct {
+	RunID       int       `json:"run_id" example:"15"`
+	CompanyID   int       `json:"company_id" example:"3"`
+	CompanyName string    `json:"company_name" example:"Tech Corp"`
+	Status      string    `json:"status" example:"succeeded"`
+	JobsFound   int       `json:"jobs_found" example:"12"`
+	Error       string    `json:"error,omitempty" example:""`
+	ReportedAt  time.Time `json:"reported_at" example:"2024-01-15T06:05:00Z"`
+}
+
+// StaleCompanyResponse represents a company without a recent successful scrape
+type StaleCompanyResponse struct {
+	CompanyID      int        `json:"company_id" example:"3"`
+	CompanyName    string     `json:"company_name" example:"Tech Corp"`
+	LastSuccessAt  *time.Time `json:"last_success_at" example:"2024-01-10T06:05:00Z" extensions:"x-nullable"`
+	LastStatus     *string    `json:"last_status" example:"failed" extensions:"x-nullable"`
+	LastError      *string    `json:"last_error" example:"careers page returned 403" extensions:"x-nullable"`
+	LastReportedAt *time.Time `json:"last_reported_at" example:"2024-01-15T06:05:00Z" extensions:"x-nullable"`
+}
+
+// StaleListResponse represents the list of stale companies
+type StaleListResponse struct {
+	Data []*StaleCompanyResponse `json:"data"`
+}
+
+// AnomalyResponse represents a company with an anomalous number of jobs found during a run
+type AnomalyResponse struct {
+	CompanyID    int     `json:"company_id" example:"3"`
+	CompanyName  string  `json:"company_name" example:"Tech Corp"`
+	Kind         string  `json:"kind" example:"zero"`
+	JobsFound    int     `json:"jobs_found" example:"0"`
+	Average      float64 `json:"average" example:"14.5"`
+	BaselineRuns int     `json:"baseline_runs" example:"10"`
+}
+
+// AnomalyListResponse represents the list of volume anomalies of a run
+type AnomalyListResponse struct {
+	Data []*AnomalyResponse `json:"data"`
+}
+
+// MapRunToResponse converts a Run to its RunResponse
+func MapRunToResponse(run *Run) *RunResponse {
+	return &RunResponse{
+		ID:         run.ID,
+		Source:     run.Source,
+		Status:     string(run.Status),
+		Error:      run.Error,
+		StartedAt:  run.StartedAt,
+		FinishedAt: run.FinishedAt,
+	}
+}
+
+// MapRunsToResponse converts run summaries to a RunListResponse
+func MapRunsToResponse(runs []*RunSummary) *RunListResponse {
+	data := make([]*RunSummaryResponse, len(runs))
+	for i, run := range runs {
+		data[i] = &RunSummaryResponse{
+			RunResponse:        *MapRunToResponse(&run.Run),
+			CompaniesSucceeded: run.CompaniesSucceeded,
+			CompaniesFailed:    run.CompaniesFailed,
+			JobsFound:          run.JobsFound,
+		}
+	}
+	return &RunListResponse{Data: data}
+}
+
+// MapCompanyReportToResponse converts a CompanyReport to its CompanyReportResponse
+func MapCompanyReportToResponse(report *CompanyReport) *CompanyReportResponse {
+	return &CompanyReportResponse{
+		RunID:       report.RunID,
+		CompanyID:   report.CompanyID,
+		CompanyName: report.CompanyName,
+		Status:      string(report.Status),
+		JobsFound:   report.JobsFound,
+		Error:       report.Error,
+		ReportedAt:  report.ReportedAt,
+	}
+}
+
+// MapStaleCompaniesToResponse converts stale companies to a StaleListResponse
+func MapStaleCompaniesToResponse(companies []*StaleCompany) *StaleListResponse {
+	data := make([]*StaleCompanyResponse, len(companies))
+	for i, company := range companies {
+		data[i] = &StaleCompanyResponse{
+			CompanyID:      company.CompanyID,
+			CompanyName:    company.CompanyName,
+			LastSuccessAt:  company.LastSuccessAt,
+			LastError:      company.LastError,
+			LastReportedAt: company.LastReportedAt,
+		}
+		if company.LastStatus != nil {
+			status := string(*company.LastStatus)
+			data[i].LastStatus = &status
+		}
+	}
+	return &StaleListResponse{Data: data}
+}
+
+// MapAnomaliesToResponse converts volume anomalies to an AnomalyListResponse
+func MapAnomaliesToResponse(anomalies []*VolumeAnomaly) *AnomalyListResponse {
+	data := make([]*AnomalyResponse, len(anomalies))
+	for i, anomaly := range anomalies {
+		data[i] = &AnomalyResponse{
+			CompanyID:    anomaly.CompanyID,
+			CompanyName:  anomaly.CompanyName,
+			Kind:         string(anomaly.Kind),
+			JobsFound:    anomaly.JobsFound,
+			Average:      anomaly.Average,
+			BaselineRuns: anomaly.BaselineRuns,
+		}
+	}
+	return &AnomalyListResponse{Data: data}
+}
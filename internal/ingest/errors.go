@@ -0,0 +1,69 @@
+// Package ingest tracks the runs of the job scrapers. Scrapers register a run, report the scrape
+// status of every company and close the run, so stale sources show up without reading their logs.
+package ingest
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// RunNotFoundError represents an ingest run not found error
+type RunNotFoundError struct {
+	ID int
+}
+
+func (e RunNotFoundError) Error() string {
+	return fmt.Sprintf("ingest run with ID %d not found", e.ID)
+}
+
+// Is matches httpservice.ErrNotFound
+func (e RunNotFoundError) Is(target error) bool {
+	return target == httpservice.ErrNotFound
+}
+
+// CompanyNotFoundError represents a report for a company that doesn't exist
+type CompanyNotFoundError struct {
+	Name string
+}
+
+func (e CompanyNotFoundError) Error() string {
+	return fmt.Sprintf("company with name %s not found", e.Name)
+}
+
+// Is matches httpservice.ErrNotFound
+func (e CompanyNotFoundError) Is(target error) bool {
+	return target == httpservice.ErrNotFound
+}
+
+// IsNotFound checks if an error is an ingest run or company not found error
+func IsNotFound(err error) bool {
+	var runNotFoundErr *RunNotFoundError
+	var companyNotFoundErr *CompanyNotFoundError
+	return errors.As(err, &runNotFoundErr) || errors.As(err, &companyNotFoundErr)
+}
+
+// RunClosedError represents a change to an ingest run that was already closed
+type RunClosedError struct {
+	ID     int
+	Status RunStatus
+}
+
+func (e RunClosedError) Error() string {
+	if e.Status == "" {
+		return fmt.Sprintf("ingest run with ID %d is already closed", e.ID)
+	}
+	return fmt.Sprintf("ingest run with ID %d is already closed as %s", e.ID, e.Status)
+}
+
+// Is matches httpservice.ErrConflict
+func (e RunClosedError) Is(target error) bool {
+	return target == httpservice.ErrConflict
+}
+
+// IsRunClosed checks if an error is a closed ingest run error
+func IsRunClosed(err error) bool {
+	var runClosedErr *RunClosedError
+	return errors.As(err, &runClosedErr)
+}
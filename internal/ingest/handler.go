@@ -0,0 +1,298 @@
+package ingest
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// Constants for ingest routes and endpoints
+const (
+	IngestRunsRoute     = "/ingest/runs"
+	CompanyReportsRoute = IngestRunsRoute + "/:id/companies"
+	CloseRunRoute       = IngestRunsRoute + "/:id/close"
+	RunAnomaliesRoute   = IngestRunsRoute + "/:id/anomalies"
+	StaleCompaniesRoute = "/ingest/stale"
+	ValidateJobsRoute   = "/ingest/jobs/validate"
+	JobsSchemaRoute     = "/schemas/jobs.json"
+)
+
+// Handler handles HTTP requests for the scraper runs
+type Handler struct {
+	service *IngestService
+}
+
+// NewHandler creates a new ingest handler
+func NewHandler(service *IngestService) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers the public routes with the given router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.GET(JobsSchemaRoute, h.GetJobsSchema)
+}
+
+// RegisterIngestRoutes registers the routes used by the scrapers with the given (protected) router group
+func (h *Handler) RegisterIngestRoutes(rg *gin.RouterGroup) {
+	rg.POST(IngestRunsRoute, h.StartRun)
+	rg.PUT(CompanyReportsRoute, h.ReportCompany)
+	rg.POST(CloseRunRoute, h.CloseRun)
+	rg.POST(ValidateJobsRoute, h.ValidateJobs)
+}
+
+// RegisterAdminRoutes registers the ingest admin routes with the given (protected) router group
+func (h *Handler) RegisterAdminRoutes(rg *gin.RouterGroup) {
+	rg.GET(IngestRunsRoute, h.ListRuns)
+	rg.GET(RunAnomaliesRoute, h.ListAnomalies)
+	rg.GET(StaleCompaniesRoute, h.ListStaleCompanies)
+}
+
+// StartRun godoc
+// @Summary Register a scraper run
+// @Description Registers a running scraper run. Scrapers report the status of every company
+// @Description they scrape to the run and close it when done.
+// @Tags ingest
+// @Accept json
+// @Produce json
+// @Security IngestAPIKey
+// @Param request body StartRunRequest true "Scraper source"
+// @Success 201 {object} RunResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /ingest/runs [post]
+func (h *Handler) StartRun(c *gin.Context) {
+	var req StartRunRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	run, err := h.service.StartRun(c.Request.Context(), req.Source)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, MapRunToResponse(run))
+}
+
+// ReportCompany godoc
+// @Summary Report the scrape status of a company
+// @Description Stores whether the scrape of a company succeeded during a running run and how many
+// @Description jobs it found. Reporting the same company again replaces its report.
+// @Tags ingest
+// @Accept json
+// @Produce json
+// @Security IngestAPIKey
+// @Param id path int true "Run ID"
+// @Param request body CompanyReportRequest true "Company scrape status"
+// @Success 200 {object} CompanyReportResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 409 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /ingest/runs/{id}/companies [put]
+func (h *Handler) ReportCompany(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(&httpservice.ValidationError{Errors: []string{"invalid run id"}})
+		return
+	}
+
+	var req CompanyReportRequest
+	if err = c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	report := req.ToCompanyReport(id)
+	if err = h.service.ReportCompany(c.Request.Context(), report); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapCompanyReportToResponse(report))
+}
+
+// CloseRun godoc
+// @Summary Close a scraper run
+// @Description Sets the final status of a running run, with the error that made it fail if any
+// @Tags ingest
+// @Accept json
+// @Produce json
+// @Security IngestAPIKey
+// @Param id path int true "Run ID"
+// @Param request body CloseRunRequest true "Final status"
+// @Success 200 {object} RunResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 409 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /ingest/runs/{id}/close [post]
+func (h *Handler) CloseRun(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(&httpservice.ValidationError{Errors: []string{"invalid run id"}})
+		return
+	}
+
+	var req CloseRunRequest
+	if err = c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	run, err := h.service.CloseRun(c.Request.Context(), id, RunStatus(req.Status), req.Error)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapRunToResponse(run))
+}
+
+// GetJobsSchema godoc
+// @Summary Get the JSON Schema of the scraped jobs
+// @Description Returns the JSON Schema of the batches of jobs the scrapers produce, the {"jobs": [...]}
+// @Description files imported by the job populator. Scrapers check their output against it, or with
+// @Description the validate ingest route.
+// @Tags ingest
+// @Produce json
+// @Success 200 {object} object
+// @Router /schemas/jobs.json [get]
+func (h *Handler) GetJobsSchema(c *gin.Context) {
+	c.Data(http.StatusOK, "application/json; charset=utf-8", JobsSchema())
+}
+
+// ValidateJobs godoc
+// @Summary Validate a batch of scraped jobs
+// @Description Checks a batch of jobs against the JSON Schema served at /schemas/jobs.json without
+// @Description importing it. Every value that doesn't match is reported in the details of the error,
+// @Description prefixed by its JSON pointer, e.g. "/jobs/3: missing property 'title'".
+// @Tags ingest
+// @Accept json
+// @Produce json
+// @Security IngestAPIKey
+// @Param request body object true "Batch of jobs"
+// @Success 200 {object} JobsValidationResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /ingest/jobs/validate [post]
+func (h *Handler) ValidateJobs(c *gin.Context) {
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	count, err := ValidateJobs(body)
+	var schemaErr *SchemaError
+	if errors.As(err, &schemaErr) {
+		_ = c.Error(&httpservice.ValidationError{Errors: schemaErr.Details()})
+		return
+	}
+	if err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	c.JSON(http.StatusOK, JobsValidationResponse{Jobs: count})
+}
+
+// ListRuns godoc
+// @Summary List scraper runs
+// @Description Lists the most recent scraper runs with the number of companies scraped and jobs found
+// @Tags admin
+// @Produce json
+// @Security AdminAPIKey
+// @Param limit query int false "Number of runs to return (max 100)" default(20) example(20)
+// @Success 200 {object} RunListResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/ingest/runs [get]
+func (h *Handler) ListRuns(c *gin.Context) {
+	var req RunListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	runs, err := h.service.Runs(c.Request.Context(), req.Limit)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapRunsToResponse(runs))
+}
+
+// ListAnomalies godoc
+// @Summary List the volume anomalies of a scraper run
+// @Description Lists the companies of a run with an anomalous number of jobs found compared with their
+// @Description previous scrapes by the same source: ten times their average or none for an active company.
+// @Description These usually mean a broken scraper selector.
+// @Tags admin
+// @Produce json
+// @Security AdminAPIKey
+// @Param id path int true "Run ID"
+// @Success 200 {object} AnomalyListResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/ingest/runs/{id}/anomalies [get]
+func (h *Handler) ListAnomalies(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(&httpservice.ValidationError{Errors: []string{"invalid run id"}})
+		return
+	}
+
+	anomalies, err := h.service.Anomalies(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapAnomaliesToResponse(anomalies))
+}
+
+// ListStaleCompanies godoc
+// @Summary List stale companies
+// @Description Lists the active companies without a successful scrape within the max age, the ones
+// @Description never scraped first, with their last reported status
+// @Tags admin
+// @Produce json
+// @Security AdminAPIKey
+// @Param max_age_hours query int false "Hours since the last successful scrape (max 2160)" default(48) example(48)
+// @Success 200 {object} StaleListResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/ingest/stale [get]
+func (h *Handler) ListStaleCompanies(c *gin.Context) {
+	var req StaleRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	companies, err := h.service.StaleCompanies(c.Request.Context(), time.Duration(req.MaxAgeHours)*time.Hour)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapStaleCompaniesToResponse(companies))
+}
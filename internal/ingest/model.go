@@ -0,0 +1,96 @@
+package ingest
+
+import "time"
+
+// RunStatus is the status of an ingest run or of the scrape of a company
+type RunStatus string
+
+// Ingest statuses. Runs are running until closed, company scrapes are only succeeded or failed.
+const (
+	StatusRunning   RunStatus = "running"
+	StatusSucceeded RunStatus = "succeeded"
+	StatusFailed    RunStatus = "failed"
+)
+
+// IsFinal reports whether s is the status of a finished run or company scrape
+func (s RunStatus) IsFinal() bool {
+	return s == StatusSucceeded || s == StatusFailed
+}
+
+// Run represents a scraper run
+type Run struct {
+	ID         int        `db:"id"`
+	Source     string     `db:"source"`
+	Status     RunStatus  `db:"status"`
+	Error      string     `db:"error"`
+	StartedAt  time.Time  `db:"started_at"`
+	FinishedAt *time.Time `db:"finished_at"`
+}
+
+// RunSummary represents a run with the totals of its company reports (for read operations only)
+type RunSummary struct {
+	Run
+	CompaniesSucceeded int `db:"companies_succeeded"`
+	CompaniesFailed    int `db:"companies_failed"`
+	JobsFound          int `db:"jobs_found"`
+}
+
+// PopulatorRun represents the last successful run of the job populator for a source of the job
+// data, the jobs without a source under ""
+type PopulatorRun struct {
+	Source        string    `db:"source"`
+	StartedAt     time.Time `db:"started_at"`
+	FinishedAt    time.Time `db:"finished_at"`
+	JobsProcessed int       `db:"jobs_processed"`
+	// LastScrapedAt is the latest scrape time of the jobs of the source imported so far, nil when
+	// none had one
+	LastScrapedAt *time.Time `db:"last_scraped_at"`
+}
+
+// CompanyReport represents the scrape status of a company during a run
+type CompanyReport struct {
+	RunID       int       `db:"run_id"`
+	CompanyID   int       `db:"company_id"`
+	CompanyName string    `db:"company_name"`
+	Status      RunStatus `db:"status"`
+	JobsFound   int       `db:"jobs_found"`
+	Error       string    `db:"error"`
+	ReportedAt  time.Time `db:"reported_at"`
+}
+
+// StaleCompany represents an active company without a successful scrape since a given time
+type StaleCompany struct {
+	CompanyID      int        `db:"company_id"`
+	CompanyName    string     `db:"company_name"`
+	LastSuccessAt  *time.Time `db:"last_success_at"`
+	LastStatus     *RunStatus `db:"last_status"`
+	LastError      *string    `db:"last_error"`
+	LastReportedAt *time.Time `db:"last_reported_at"`
+}
+
+// AnomalyKind is the kind of an anomalous number of jobs found for a company
+type AnomalyKind string
+
+// Volume anomalies, which usually mean a broken scraper selector
+const (
+	// AnomalySpike is a company with many times the jobs it usually has
+	AnomalySpike AnomalyKind = "spike"
+	// AnomalyZero is a normally active company without jobs
+	AnomalyZero AnomalyKind = "zero"
+)
+
+// CompanyVolume represents the jobs found for a company during a run, with the average of its
+// previous successful scrapes by the same source (for read operations only)
+type CompanyVolume struct {
+	CompanyID    int     `db:"company_id"`
+	CompanyName  string  `db:"company_name"`
+	JobsFound    int     `db:"jobs_found"`
+	Average      float64 `db:"average"`
+	BaselineRuns int     `db:"baseline_runs"`
+}
+
+// VolumeAnomaly represents a company with an anomalous number of jobs found during a run
+type VolumeAnomaly struct {
+	CompanyVolume
+	Kind AnomalyKind
+}
@@ -0,0 +1,86 @@
+package ingest
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/metrics"
+)
+
+// DefaultAnomalyRefreshInterval is how often the anomalies of the last runs are detected again
+const DefaultAnomalyRefreshInterval = 15 * time.Minute
+
+// AnomalyMonitor keeps the volume anomalies of the last succeeded run of every source, exposed as
+// metrics so alerts fire when a scraper selector breaks.
+type AnomalyMonitor struct {
+	service *IngestService
+
+	mu        sync.Mutex
+	anomalies map[string][]*VolumeAnomaly
+}
+
+// NewAnomalyMonitor creates a new instance of AnomalyMonitor
+func NewAnomalyMonitor(service *IngestService) *AnomalyMonitor {
+	return &AnomalyMonitor{service: service, anomalies: map[string][]*VolumeAnomaly{}}
+}
+
+// Refresh detects the anomalies of the last succeeded run of every source
+func (m *AnomalyMonitor) Refresh(ctx context.Context) error {
+	runs, err := m.service.repo.ListLatestRuns(ctx)
+	if err != nil {
+		return err
+	}
+
+	anomalies := make(map[string][]*VolumeAnomaly, len(runs))
+	for _, run := range runs {
+		if anomalies[run.Source], err = m.service.runAnomalies(ctx, run.ID); err != nil {
+			return err
+		}
+	}
+
+	m.mu.Lock()
+	m.anomalies = anomalies
+	m.mu.Unlock()
+	return nil
+}
+
+// Collect returns the number of anomalies of every kind by source, and a sample for every
+// anomalous company
+func (m *AnomalyMonitor) Collect() []metrics.Metric {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	sources := make([]string, 0, len(m.anomalies))
+	for source := range m.anomalies {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+
+	var samples []metrics.Metric
+	for _, source := range sources {
+		counts := map[AnomalyKind]int{}
+		for _, anomaly := range m.anomalies[source] {
+			counts[anomaly.Kind]++
+			samples = append(samples, metrics.Metric{
+				Name:   "ingest_company_volume_anomaly",
+				Help:   "Jobs found for the companies with an anomalous volume in the last run of the source.",
+				Type:   metrics.Gauge,
+				Labels: map[string]string{"source": source, "company": anomaly.CompanyName, "kind": string(anomaly.Kind)},
+				Value:  float64(anomaly.JobsFound),
+			})
+		}
+		// Zero samples are kept, so the alerts resolve once the scraper is fixed
+		for _, kind := range []AnomalyKind{AnomalySpike, AnomalyZero} {
+			samples = append(samples, metrics.Metric{
+				Name:   "ingest_volume_anomalies",
+				Help:   "Number of companies with an anomalous number of jobs found in the last run of the source.",
+				Type:   metrics.Gauge,
+				Labels: map[string]string{"source": source, "kind": string(kind)},
+				Value:  float64(counts[kind]),
+			})
+		}
+	}
+	return samples
+}
@@ -0,0 +1,379 @@
+package ingest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// SQL query constants
+const (
+	createRunQuery = `
+        INSERT INTO ingest_runs (source)
+        VALUES ($1)
+        RETURNING id, status, started_at
+    `
+
+	getRunQuery = `
+        SELECT id, source, status, error, started_at, finished_at
+        FROM ingest_runs
+        WHERE id = $1
+    `
+
+	closeRunQuery = `
+        UPDATE ingest_runs
+        SET status = $2, error = $3, finished_at = NOW()
+        WHERE id = $1 AND status = 'running'
+        RETURNING finished_at
+    `
+
+	// Reports of the same company in a run replace each other, so scrapers can retry
+	reportCompanyQuery = `
+        INSERT INTO ingest_run_companies (run_id, company_id, status, jobs_found, error)
+        SELECT $1, c.id, $3, $4, $5
+        FROM companies c
+        WHERE c.name = $2
+        ON CONFLICT (run_id, company_id) DO UPDATE
+        SET status = EXCLUDED.status, jobs_found = EXCLUDED.jobs_found, error = EXCLUDED.error,
+            reported_at = NOW()
+        RETURNING company_id, reported_at
+    `
+
+	listRunsQuery = `
+        SELECT r.id, r.source, r.status, r.error, r.started_at, r.finished_at,
+               COUNT(rc.company_id) FILTER (WHERE rc.status = 'succeeded') AS companies_succeeded,
+               COUNT(rc.company_id) FILTER (WHERE rc.status = 'failed') AS companies_failed,
+               COALESCE(SUM(rc.jobs_found), 0) AS jobs_found
+        FROM ingest_runs r
+        LEFT JOIN ingest_run_companies rc ON rc.run_id = r.id
+        GROUP BY r.id
+        ORDER BY r.started_at DESC, r.id DESC
+        LIMIT $1
+    `
+
+	// Active companies without a successful scrape since $1, with their last report
+	listStaleCompaniesQuery = `
+        SELECT c.id, c.name, s.last_success_at, l.status, l.error, l.reported_at
+        FROM companies c
+        LEFT JOIN LATERAL (
+            SELECT MAX(reported_at) AS last_success_at
+            FROM ingest_run_companies
+            WHERE company_id = c.id AND status = 'succeeded'
+        ) s ON true
+        LEFT JOIN LATERAL (
+            SELECT status, error, reported_at
+            FROM ingest_run_companies
+            WHERE company_id = c.id
+            ORDER BY reported_at DESC
+            LIMIT 1
+        ) l ON true
+        WHERE c.is_active = true AND (s.last_success_at IS NULL OR s.last_success_at < $1)
+        ORDER BY s.last_success_at NULLS FIRST, c.name
+    `
+
+	// Successful company reports of run $1, with the average jobs found by the last $2 successful
+	// scrapes of the company by earlier runs of the same source
+	listCompanyVolumesQuery = `
+        SELECT rc.company_id, c.name, rc.jobs_found,
+               COALESCE(b.average, 0) AS average, COALESCE(b.baseline_runs, 0) AS baseline_runs
+        FROM ingest_run_companies rc
+        JOIN ingest_runs r ON r.id = rc.run_id
+        JOIN companies c ON c.id = rc.company_id
+        LEFT JOIN LATERAL (
+            SELECT AVG(h.jobs_found)::float8 AS average, COUNT(*) AS baseline_runs
+            FROM (
+                SELECT prc.jobs_found
+                FROM ingest_run_companies prc
+                JOIN ingest_runs pr ON pr.id = prc.run_id
+                WHERE prc.company_id = rc.company_id AND prc.status = 'succeeded'
+                  AND pr.source = r.source AND pr.started_at < r.started_at
+                ORDER BY pr.started_at DESC
+                LIMIT $2
+            ) h
+        ) b ON true
+        WHERE rc.run_id = $1 AND rc.status = 'succeeded'
+        ORDER BY c.name
+    `
+
+	// The last succeeded run of every source
+	listLatestRunsQuery = `
+        SELECT DISTINCT ON (source) id, source, status, error, started_at, finished_at
+        FROM ingest_runs
+        WHERE status = 'succeeded'
+        ORDER BY source, started_at DESC, id DESC
+    `
+
+	listPopulatorRunsQuery = `
+        SELECT source, started_at, finished_at, jobs_processed, last_scraped_at
+        FROM populator_runs
+        ORDER BY source
+    `
+
+	// Only the last successful run of a source is kept. Its latest scrape time never goes back,
+	// GREATEST ignoring the NULL of the runs without one.
+	savePopulatorRunQuery = `
+        INSERT INTO populator_runs (source, started_at, jobs_processed, last_scraped_at)
+        VALUES ($1, $2, $3, $4)
+        ON CONFLICT (source) DO UPDATE
+        SET started_at = EXCLUDED.started_at, finished_at = NOW(), jobs_processed = EXCLUDED.jobs_processed,
+            last_scraped_at = GREATEST(populator_runs.last_scraped_at, EXCLUDED.last_scraped_at)
+        RETURNING finished_at, last_scraped_at
+    `
+)
+
+// Database interface to support pgxpool and mocks
+type Database interface {
+	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
+	Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+}
+
+// Repository handles database operations for ingest runs.
+type Repository struct {
+	db Database
+}
+
+// NewRepository creates a new Repository instance.
+func NewRepository(db Database) *Repository {
+	return &Repository{db: db}
+}
+
+// CreateRun inserts a new running ingest run.
+func (r *Repository) CreateRun(ctx context.Context, run *Run) error {
+	err := r.db.QueryRow(ctx, createRunQuery, run.Source).Scan(&run.ID, &run.Status, &run.StartedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create ingest run: %w", err)
+	}
+	return nil
+}
+
+// GetRun retrieves an ingest run by ID.
+func (r *Repository) GetRun(ctx context.Context, id int) (*Run, error) {
+	run := &Run{}
+	err := r.db.QueryRow(ctx, getRunQuery, id).Scan(
+		&run.ID,
+		&run.Source,
+		&run.Status,
+		&run.Error,
+		&run.StartedAt,
+		&run.FinishedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &RunNotFoundError{ID: id}
+		}
+		return nil, fmt.Errorf("failed to get ingest run: %w", err)
+	}
+	return run, nil
+}
+
+// CloseRun sets the final status of a running ingest run. It reports false when the run
+// isn't running (anymore).
+func (r *Repository) CloseRun(ctx context.Context, run *Run) (bool, error) {
+	err := r.db.QueryRow(ctx, closeRunQuery, run.ID, run.Status, run.Error).Scan(&run.FinishedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to close ingest run: %w", err)
+	}
+	return true, nil
+}
+
+// ReportCompany stores the scrape status of a company, found by name, during a run.
+// A previous report of the same company in the run is replaced.
+func (r *Repository) ReportCompany(ctx context.Context, report *CompanyReport) error {
+	err := r.db.QueryRow(
+		ctx,
+		reportCompanyQuery,
+		report.RunID,
+		report.CompanyName,
+		report.Status,
+		report.JobsFound,
+		report.Error,
+	).Scan(&report.CompanyID, &report.ReportedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &CompanyNotFoundError{Name: report.CompanyName}
+		}
+
+		// Check for foreign key violation (run deleted in the meantime)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return &RunNotFoundError{ID: report.RunID}
+		}
+
+		return fmt.Errorf("failed to report company scrape: %w", err)
+	}
+	return nil
+}
+
+// ListRuns retrieves the most recent ingest runs with the totals of their company reports.
+func (r *Repository) ListRuns(ctx context.Context, limit int) ([]*RunSummary, error) {
+	rows, err := r.db.Query(ctx, listRunsQuery, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ingest runs: %w", err)
+	}
+	defer rows.Close()
+
+	var runs []*RunSummary
+	for rows.Next() {
+		run := &RunSummary{}
+		err = rows.Scan(
+			&run.ID,
+			&run.Source,
+			&run.Status,
+			&run.Error,
+			&run.StartedAt,
+			&run.FinishedAt,
+			&run.CompaniesSucceeded,
+			&run.CompaniesFailed,
+			&run.JobsFound,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan ingest run row: %w", err)
+		}
+		runs = append(runs, run)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating ingest run rows: %w", err)
+	}
+
+	return runs, nil
+}
+
+// ListStaleCompanies retrieves the active companies without a successful scrape since the given time,
+// the ones never scraped first.
+func (r *Repository) ListStaleCompanies(ctx context.Context, since time.Time) ([]*StaleCompany, error) {
+	rows, err := r.db.Query(ctx, listStaleCompaniesQuery, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stale companies: %w", err)
+	}
+	defer rows.Close()
+
+	var companies []*StaleCompany
+	for rows.Next() {
+		company := &StaleCompany{}
+		err = rows.Scan(
+			&company.CompanyID,
+			&company.CompanyName,
+			&company.LastSuccessAt,
+			&company.LastStatus,
+			&company.LastError,
+			&company.LastReportedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan stale company row: %w", err)
+		}
+		companies = append(companies, company)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating stale company rows: %w", err)
+	}
+
+	return companies, nil
+}
+
+// ListCompanyVolumes retrieves the jobs found for the companies scraped successfully during a run,
+// with the average of their last successful scrapes by the same source.
+func (r *Repository) ListCompanyVolumes(ctx context.Context, runID, history int) ([]*CompanyVolume, error) {
+	rows, err := r.db.Query(ctx, listCompanyVolumesQuery, runID, history)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list company volumes: %w", err)
+	}
+	defer rows.Close()
+
+	var volumes []*CompanyVolume
+	for rows.Next() {
+		volume := &CompanyVolume{}
+		err = rows.Scan(
+			&volume.CompanyID,
+			&volume.CompanyName,
+			&volume.JobsFound,
+			&volume.Average,
+			&volume.BaselineRuns,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan company volume row: %w", err)
+		}
+		volumes = append(volumes, volume)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating company volume rows: %w", err)
+	}
+
+	return volumes, nil
+}
+
+// ListLatestRuns retrieves the last succeeded run of every source.
+func (r *Repository) ListLatestRuns(ctx context.Context) ([]*Run, error) {
+	rows, err := r.db.Query(ctx, listLatestRunsQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list latest ingest runs: %w", err)
+	}
+	defer rows.Close()
+
+	var runs []*Run
+	for rows.Next() {
+		run := &Run{}
+		err = rows.Scan(&run.ID, &run.Source, &run.Status, &run.Error, &run.StartedAt, &run.FinishedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan ingest run row: %w", err)
+		}
+		runs = append(runs, run)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating ingest run rows: %w", err)
+	}
+
+	return runs, nil
+}
+
+// ListPopulatorRuns retrieves the last successful job populator run of every source.
+func (r *Repository) ListPopulatorRuns(ctx context.Context) ([]*PopulatorRun, error) {
+	rows, err := r.db.Query(ctx, listPopulatorRunsQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list populator runs: %w", err)
+	}
+	defer rows.Close()
+
+	var runs []*PopulatorRun
+	for rows.Next() {
+		run := &PopulatorRun{}
+		err = rows.Scan(&run.Source, &run.StartedAt, &run.FinishedAt, &run.JobsProcessed, &run.LastScrapedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan populator run row: %w", err)
+		}
+		runs = append(runs, run)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating populator run rows: %w", err)
+	}
+
+	return runs, nil
+}
+
+// SavePopulatorRun stores a successful job populator run, replacing the previous one of its source
+// but keeping the latest scrape time of both. The times are stored in UTC.
+func (r *Repository) SavePopulatorRun(ctx context.Context, run *PopulatorRun) error {
+	var lastScrapedAt *time.Time
+	if run.LastScrapedAt != nil {
+		utc := run.LastScrapedAt.UTC()
+		lastScrapedAt = &utc
+	}
+	err := r.db.QueryRow(ctx, savePopulatorRunQuery, run.Source, run.StartedAt.UTC(), run.JobsProcessed, lastScrapedAt).
+		Scan(&run.FinishedAt, &run.LastScrapedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save populator run: %w", err)
+	}
+	return nil
+}
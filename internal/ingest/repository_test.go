@@ -0,0 +1,300 @@
+package ingest
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_GetRun(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, run *Run, err error)
+	}{
+		{
+			name: "run found",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getRunQuery)).
+					WithArgs(15).
+					WillReturnRows(pgxmock.NewRows(
+						[]string{"id", "source", "status", "error", "started_at", "finished_at"}).
+						AddRow(15, "linkedin-scraper", StatusRunning, "", now, nil))
+			},
+			checkResults: func(t *testing.T, run *Run, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, StatusRunning, run.Status)
+				assert.Nil(t, run.FinishedAt)
+			},
+		},
+		{
+			name: "run not found",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getRunQuery)).
+					WithArgs(15).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			checkResults: func(t *testing.T, _ *Run, err error) {
+				t.Helper()
+				assert.True(t, IsNotFound(err))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			run, err := repo.GetRun(context.Background(), 15)
+			tt.checkResults(t, run, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_CloseRun(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, closed bool, err error)
+	}{
+		{
+			name: "running run closed",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(closeRunQuery)).
+					WithArgs(15, StatusSucceeded, "").
+					WillReturnRows(pgxmock.NewRows([]string{"finished_at"}).AddRow(&now))
+			},
+			checkResults: func(t *testing.T, closed bool, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.True(t, closed)
+			},
+		},
+		{
+			name: "run not running",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(closeRunQuery)).
+					WithArgs(15, StatusSucceeded, "").
+					WillReturnError(pgx.ErrNoRows)
+			},
+			checkResults: func(t *testing.T, closed bool, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.False(t, closed)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			closed, err := repo.CloseRun(context.Background(), &Run{ID: 15, Status: StatusSucceeded})
+			tt.checkResults(t, closed, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_ReportCompany(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, report *CompanyReport, err error)
+	}{
+		{
+			name: "report stored",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(reportCompanyQuery)).
+					WithArgs(15, "Tech Corp", StatusSucceeded, 12, "").
+					WillReturnRows(pgxmock.NewRows([]string{"company_id", "reported_at"}).AddRow(3, now))
+			},
+			checkResults: func(t *testing.T, report *CompanyReport, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 3, report.CompanyID)
+				assert.Equal(t, now, report.ReportedAt)
+			},
+		},
+		{
+			name: "unknown company",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(reportCompanyQuery)).
+					WithArgs(15, "Tech Corp", StatusSucceeded, 12, "").
+					WillReturnError(pgx.ErrNoRows)
+			},
+			checkResults: func(t *testing.T, _ *CompanyReport, err error) {
+				t.Helper()
+				var companyNotFoundErr *CompanyNotFoundError
+				require.ErrorAs(t, err, &companyNotFoundErr)
+			},
+		},
+		{
+			name: "run deleted",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(reportCompanyQuery)).
+					WithArgs(15, "Tech Corp", StatusSucceeded, 12, "").
+					WillReturnError(&pgconn.PgError{Code: "23503"})
+			},
+			checkResults: func(t *testing.T, _ *CompanyReport, err error) {
+				t.Helper()
+				var runNotFoundErr *RunNotFoundError
+				require.ErrorAs(t, err, &runNotFoundErr)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(reportCompanyQuery)).
+					WithArgs(15, "Tech Corp", StatusSucceeded, 12, "").
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ *CompanyReport, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			report := &CompanyReport{RunID: 15, CompanyName: "Tech Corp", Status: StatusSucceeded, JobsFound: 12}
+			err = repo.ReportCompany(context.Background(), report)
+			tt.checkResults(t, report, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_ListStaleCompanies(t *testing.T) {
+	t.Parallel()
+	since := time.Date(2024, 1, 13, 10, 0, 0, 0, time.UTC)
+	lastSuccess := since.Add(-24 * time.Hour)
+	lastStatus := StatusFailed
+	lastError := "careers page returned 403"
+
+	mockDB, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	mockDB.ExpectQuery(regexp.QuoteMeta(listStaleCompaniesQuery)).
+		WithArgs(since).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "name", "last_success_at", "status", "error", "reported_at"}).
+			AddRow(4, "Never Scraped", nil, nil, nil, nil).
+			AddRow(3, "Tech Corp", &lastSuccess, &lastStatus, &lastError, &since))
+
+	companies, err := NewRepository(mockDB).ListStaleCompanies(context.Background(), since)
+	require.NoError(t, err)
+	require.Len(t, companies, 2)
+	assert.Nil(t, companies[0].LastSuccessAt)
+	assert.Equal(t, StatusFailed, *companies[1].LastStatus)
+	assert.Equal(t, lastError, *companies[1].LastError)
+
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
+
+func TestRepository_ListCompanyVolumes(t *testing.T) {
+	t.Parallel()
+
+	mockDB, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	mockDB.ExpectQuery(regexp.QuoteMeta(listCompanyVolumesQuery)).
+		WithArgs(15, AnomalyHistoryRuns).
+		WillReturnRows(pgxmock.NewRows([]string{"company_id", "name", "jobs_found", "average", "baseline_runs"}).
+			AddRow(4, "New Corp", 7, 0.0, 0).
+			AddRow(3, "Tech Corp", 0, 14.5, 10))
+
+	volumes, err := NewRepository(mockDB).ListCompanyVolumes(context.Background(), 15, AnomalyHistoryRuns)
+	require.NoError(t, err)
+	require.Len(t, volumes, 2)
+	assert.Equal(t, &CompanyVolume{CompanyID: 3, CompanyName: "Tech Corp", Average: 14.5, BaselineRuns: 10}, volumes[1])
+
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
+
+func TestRepository_PopulatorRuns(t *testing.T) {
+	t.Parallel()
+	startedAt := time.Date(2024, 1, 15, 6, 0, 0, 0, time.FixedZone("CST", -6*60*60))
+	finishedAt := time.Date(2024, 1, 15, 12, 5, 0, 0, time.UTC)
+	scrapedAt := startedAt.Add(-time.Hour)
+	lastScrapedAt := scrapedAt.UTC()
+
+	mockDB, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockDB.Close()
+	repo := NewRepository(mockDB)
+
+	mockDB.ExpectQuery(regexp.QuoteMeta(savePopulatorRunQuery)).
+		WithArgs("linkedin", startedAt.UTC(), 42, &lastScrapedAt).
+		WillReturnRows(pgxmock.NewRows([]string{"finished_at", "last_scraped_at"}).AddRow(finishedAt, &lastScrapedAt))
+	run := &PopulatorRun{Source: "linkedin", StartedAt: startedAt, JobsProcessed: 42, LastScrapedAt: &scrapedAt}
+	require.NoError(t, repo.SavePopulatorRun(context.Background(), run))
+	assert.Equal(t, finishedAt, run.FinishedAt)
+	assert.Equal(t, &lastScrapedAt, run.LastScrapedAt)
+
+	mockDB.ExpectQuery(regexp.QuoteMeta(listPopulatorRunsQuery)).
+		WillReturnRows(pgxmock.NewRows([]string{"source", "started_at", "finished_at", "jobs_processed", "last_scraped_at"}).
+			AddRow("", startedAt.UTC(), finishedAt, 3, nil).
+			AddRow("linkedin", startedAt.UTC(), finishedAt, 42, &lastScrapedAt))
+	runs, err := repo.ListPopulatorRuns(context.Background())
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	assert.Nil(t, runs[0].LastScrapedAt)
+	assert.Equal(t, &PopulatorRun{Source: "linkedin", StartedAt: startedAt.UTC(), FinishedAt: finishedAt, JobsProcessed: 42,
+		LastScrapedAt: &lastScrapedAt}, runs[1])
+
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
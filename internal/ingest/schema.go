@@ -0,0 +1,141 @@
+package ingest
+
+import (
+	"bytes"
+	"cmp"
+	_ "embed"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// jobsSchemaJSON is the JSON Schema of the batches of jobs produced by the scrapers, the
+// {"jobs": [...]} files imported by the job populator
+//
+//go:embed schemas/jobs.json
+var jobsSchemaJSON []byte
+
+// jobsSchemaURL is the URL the schema is compiled under, relative to the schemas route
+const jobsSchemaURL = "jobs.json"
+
+// jobsSchema is the compiled jobsSchemaJSON. The schema is part of the binary, so failing to
+// compile it is a programming error.
+var jobsSchema = func() *jsonschema.Schema {
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(jobsSchemaJSON))
+	if err != nil {
+		panic(fmt.Sprintf("invalid jobs schema: %v", err))
+	}
+	compiler := jsonschema.NewCompiler()
+	compiler.AssertFormat()
+	if err = compiler.AddResource(jobsSchemaURL, doc); err != nil {
+		panic(fmt.Sprintf("invalid jobs schema: %v", err))
+	}
+	return compiler.MustCompile(jobsSchemaURL)
+}()
+
+// schemaPrinter formats the messages of the schema violations
+var schemaPrinter = message.NewPrinter(language.English)
+
+// pointerEscaper escapes the tokens of the JSON pointers
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// JobsSchema returns the JSON Schema of the batches of jobs
+func JobsSchema() []byte {
+	return bytes.Clone(jobsSchemaJSON)
+}
+
+// SchemaViolation is a value of a batch of jobs that doesn't match the schema
+type SchemaViolation struct {
+	// Pointer is the JSON pointer of the value, e.g. /jobs/3/work_mode, empty for the whole batch
+	Pointer string
+	// Job is the index of the job the value belongs to, -1 for the values outside the jobs
+	Job     int
+	Message string
+}
+
+func (v SchemaViolation) String() string {
+	if v.Pointer == "" {
+		return v.Message
+	}
+	return fmt.Sprintf("%s: %s", v.Pointer, v.Message)
+}
+
+// SchemaError represents a batch of jobs that doesn't match the schema
+type SchemaError struct {
+	Violations []SchemaViolation
+}
+
+func (e *SchemaError) Error() string {
+	return fmt.Sprintf("jobs don't match the schema: %s", strings.Join(e.Details(), ", "))
+}
+
+// Details returns a message per violation, prefixed by its JSON pointer
+func (e *SchemaError) Details() []string {
+	details := make([]string, len(e.Violations))
+	for i, violation := range e.Violations {
+		details[i] = violation.String()
+	}
+	return details
+}
+
+// ValidateJobs checks a batch of jobs against the schema, returning the number of jobs it has.
+// A batch that isn't JSON fails with a parse error, and one that doesn't match the schema with a
+// SchemaError listing every violation, ordered by job.
+func ValidateJobs(data []byte) (int, error) {
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(data))
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse jobs: %w", err)
+	}
+
+	err = jobsSchema.Validate(doc)
+	var validationErr *jsonschema.ValidationError
+	if errors.As(err, &validationErr) {
+		schemaErr := &SchemaError{}
+		collectViolations(validationErr, schemaErr)
+		slices.SortStableFunc(schemaErr.Violations, func(a, b SchemaViolation) int {
+			return cmp.Or(cmp.Compare(a.Job, b.Job), cmp.Compare(a.Pointer, b.Pointer))
+		})
+		return 0, schemaErr
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to validate jobs: %w", err)
+	}
+
+	batch, _ := doc.(map[string]any)
+	jobs, _ := batch["jobs"].([]any)
+	return len(jobs), nil
+}
+
+// collectViolations adds the violations causing err to schemaErr. Only the innermost errors are
+// kept, the others just say that a value containing them is invalid.
+func collectViolations(err *jsonschema.ValidationError, schemaErr *SchemaError) {
+	if len(err.Causes) > 0 {
+		for _, cause := range err.Causes {
+			collectViolations(cause, schemaErr)
+		}
+		return
+	}
+
+	var pointer strings.Builder
+	for _, token := range err.InstanceLocation {
+		pointer.WriteByte('/')
+		pointer.WriteString(pointerEscaper.Replace(token))
+	}
+	job := -1
+	if len(err.InstanceLocation) > 1 && err.InstanceLocation[0] == "jobs" {
+		if index, convErr := strconv.Atoi(err.InstanceLocation[1]); convErr == nil {
+			job = index
+		}
+	}
+	schemaErr.Violations = append(schemaErr.Violations, SchemaViolation{
+		Pointer: pointer.String(),
+		Job:     job,
+		Message: err.ErrorKind.LocalizedString(schemaPrinter),
+	})
+}
@@ -0,0 +1,109 @@
+package ingest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobsSchema(t *testing.T) {
+	t.Parallel()
+
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal(JobsSchema(), &schema))
+	assert.Equal(t, "https://json-schema.org/draft/2020-12/schema", schema["$schema"])
+}
+
+func TestValidateJobs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		data         string
+		checkResults func(t *testing.T, count int, err error)
+	}{
+		{
+			name: "valid jobs",
+			data: `{"jobs": [
+				{"company": "Tech Corp", "title": "Go Developer", "description": "Build APIs",
+				 "application_url": "https://techcorp.com/jobs/1", "work_mode": "remote", "language": "en",
+				 "technologies": [{"name": "Go", "primary": true}], "utc_offset_min": -6, "utc_offset_max": null,
+				 "scraped_at": "2024-01-15T06:30:00-06:00"},
+				{"company": "Tech Corp", "title": "QA Engineer", "description": "Test APIs",
+				 "application_url": "https://techcorp.com/jobs/2", "source": "linkedin", "source_job_id": "991"}
+			]}`,
+			checkResults: func(t *testing.T, count int, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 2, count)
+			},
+		},
+		{
+			name: "violations of every job",
+			data: `{"jobs": [
+				{"company": "Tech Corp", "title": "Go Developer", "description": "Build APIs",
+				 "application_url": "https://techcorp.com/jobs/1"},
+				{"company": "Tech Corp", "title": "Go Developer", "description": "Build APIs",
+				 "application_url": "ftp://techcorp.com", "language": "fr", "technologies": [{"primary": "yes"}]},
+				{"company": " ", "title": "QA Engineer", "description": "Test APIs",
+				 "application_url": "https://techcorp.com/jobs/3", "utc_offset_min": 20},
+				{"company": "Tech Corp", "title": "QA Engineer", "description": "Test APIs",
+				 "application_url": "https://techcorp.com/jobs/4", "scraped_at": "2024-01-15 06:30"}
+			]}`,
+			checkResults: func(t *testing.T, _ int, err error) {
+				t.Helper()
+				var schemaErr *SchemaError
+				require.ErrorAs(t, err, &schemaErr)
+				pointers := make([]string, len(schemaErr.Violations))
+				for i, violation := range schemaErr.Violations {
+					pointers[i] = violation.Pointer
+				}
+				assert.Equal(t, []string{
+					"/jobs/1/application_url",
+					"/jobs/1/language",
+					"/jobs/1/technologies/0",
+					"/jobs/1/technologies/0/primary",
+					"/jobs/2/company",
+					"/jobs/2/utc_offset_min",
+					"/jobs/3/scraped_at",
+				}, pointers)
+				assert.Equal(t, 1, schemaErr.Violations[0].Job)
+				assert.Equal(t, 2, schemaErr.Violations[5].Job)
+				assert.Contains(t, schemaErr.Details(), "/jobs/1/language: value must be one of '', 'en', 'es'")
+				assert.Contains(t, schemaErr.Details(), "/jobs/1/technologies/0: missing property 'name'")
+			},
+		},
+		{
+			name: "missing jobs",
+			data: `{"items": []}`,
+			checkResults: func(t *testing.T, _ int, err error) {
+				t.Helper()
+				var schemaErr *SchemaError
+				require.ErrorAs(t, err, &schemaErr)
+				require.Len(t, schemaErr.Violations, 1)
+				assert.Equal(t, -1, schemaErr.Violations[0].Job)
+				assert.Equal(t, []string{"missing property 'jobs'"}, schemaErr.Details())
+			},
+		},
+		{
+			name: "not JSON",
+			data: `{"jobs": [`,
+			checkResults: func(t *testing.T, _ int, err error) {
+				t.Helper()
+				require.Error(t, err)
+				var schemaErr *SchemaError
+				assert.NotErrorAs(t, err, &schemaErr)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			count, err := ValidateJobs([]byte(tt.data))
+			tt.checkResults(t, count, err)
+		})
+	}
+}
@@ -0,0 +1,239 @@
+package ingest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// Ingest run settings
+const (
+	MaxSourceLength = 100
+	// MaxErrorLength bounds the stored error messages, longer ones are truncated
+	MaxErrorLength = 2000
+
+	DefaultRunLimit = 20
+	MaxRunLimit     = 100
+
+	// A company is stale when it had no successful scrape within the max age
+	DefaultStaleMaxAge = 48 * time.Hour
+	MaxStaleMaxAgeDays = 90
+	maxStaleMaxAge     = MaxStaleMaxAgeDays * 24 * time.Hour
+
+	// Jobs found are compared with the average of the last scrapes of the company by the same
+	// source, once there are enough of them
+	AnomalyHistoryRuns = 10
+	MinBaselineRuns    = 3
+	// A spike is at least SpikeFactor times the average (or SpikeFactor jobs for barely active companies)
+	SpikeFactor = 10
+	// Companies without jobs are only anomalous when they averaged at least MinActiveAverage jobs
+	MinActiveAverage = 2.0
+)
+
+// DataRepository interface to make database operations for ingest runs.
+type DataRepository interface {
+	CreateRun(ctx context.Context, run *Run) error
+	GetRun(ctx context.Context, id int) (*Run, error)
+	CloseRun(ctx context.Context, run *Run) (bool, error)
+	ReportCompany(ctx context.Context, report *CompanyReport) error
+	ListRuns(ctx context.Context, limit int) ([]*RunSummary, error)
+	ListStaleCompanies(ctx context.Context, since time.Time) ([]*StaleCompany, error)
+	ListCompanyVolumes(ctx context.Context, runID, history int) ([]*CompanyVolume, error)
+	ListLatestRuns(ctx context.Context) ([]*Run, error)
+	ListPopulatorRuns(ctx context.Context) ([]*PopulatorRun, error)
+	SavePopulatorRun(ctx context.Context, run *PopulatorRun) error
+}
+
+// IngestService holds the business logic for the scraper runs.
+type IngestService struct {
+	repo DataRepository
+	now  func() time.Time
+}
+
+// NewIngestService creates a new instance of IngestService
+func NewIngestService(repo DataRepository) *IngestService {
+	return &IngestService{repo: repo, now: time.Now}
+}
+
+// StartRun registers a new running run of the given scraper source
+func (s *IngestService) StartRun(ctx context.Context, source string) (*Run, error) {
+	source = strings.TrimSpace(source)
+	if source == "" || len(source) > MaxSourceLength {
+		return nil, &httpservice.ValidationError{Errors: []string{
+			fmt.Sprintf("source is required and must be at most %d characters", MaxSourceLength),
+		}}
+	}
+
+	run := &Run{Source: source}
+	if err := s.repo.CreateRun(ctx, run); err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// ReportCompany stores the scrape status of a company during a running run.
+// A RunClosedError is returned when the run was already closed.
+func (s *IngestService) ReportCompany(ctx context.Context, report *CompanyReport) error {
+	report.CompanyName = strings.TrimSpace(report.CompanyName)
+	report.Error = truncate(strings.TrimSpace(report.Error), MaxErrorLength)
+
+	var errs []string
+	if report.CompanyName == "" {
+		errs = append(errs, "company is required")
+	}
+	if !report.Status.IsFinal() {
+		errs = append(errs, fmt.Sprintf("status must be %s or %s", StatusSucceeded, StatusFailed))
+	}
+	if report.JobsFound < 0 {
+		errs = append(errs, "jobs_found must not be negative")
+	}
+	if len(errs) > 0 {
+		return &httpservice.ValidationError{Errors: errs}
+	}
+
+	if _, err := s.runningRun(ctx, report.RunID); err != nil {
+		return err
+	}
+
+	return s.repo.ReportCompany(ctx, report)
+}
+
+// CloseRun sets the final status of a running run, with the error that made it fail if any
+func (s *IngestService) CloseRun(ctx context.Context, id int, status RunStatus, errMsg string) (*Run, error) {
+	if !status.IsFinal() {
+		return nil, &httpservice.ValidationError{Errors: []string{
+			fmt.Sprintf("status must be %s or %s", StatusSucceeded, StatusFailed),
+		}}
+	}
+
+	run, err := s.runningRun(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	run.Status = status
+	run.Error = truncate(strings.TrimSpace(errMsg), MaxErrorLength)
+	closed, err := s.repo.CloseRun(ctx, run)
+	if err != nil {
+		return nil, err
+	}
+	if !closed {
+		// Closed by another request in the meantime
+		return nil, &RunClosedError{ID: id}
+	}
+
+	return run, nil
+}
+
+// Runs returns the most recent runs. Out of range limits are clamped.
+func (s *IngestService) Runs(ctx context.Context, limit int) ([]*RunSummary, error) {
+	if limit <= 0 {
+		limit = DefaultRunLimit
+	}
+	return s.repo.ListRuns(ctx, min(limit, MaxRunLimit))
+}
+
+// StaleCompanies returns the active companies without a successful scrape within maxAge,
+// 48 hours by default. Out of range values are clamped.
+func (s *IngestService) StaleCompanies(ctx context.Context, maxAge time.Duration) ([]*StaleCompany, error) {
+	if maxAge <= 0 {
+		maxAge = DefaultStaleMaxAge
+	}
+	return s.repo.ListStaleCompanies(ctx, s.now().Add(-min(maxAge, maxStaleMaxAge)))
+}
+
+// Anomalies returns the companies of a run whose number of jobs found is anomalous compared
+// with their previous scrapes by the same source: a spike or none at all for an active company.
+func (s *IngestService) Anomalies(ctx context.Context, runID int) ([]*VolumeAnomaly, error) {
+	if _, err := s.repo.GetRun(ctx, runID); err != nil {
+		return nil, err
+	}
+	return s.runAnomalies(ctx, runID)
+}
+
+// runAnomalies returns the anomalies of an existing run
+func (s *IngestService) runAnomalies(ctx context.Context, runID int) ([]*VolumeAnomaly, error) {
+	volumes, err := s.repo.ListCompanyVolumes(ctx, runID, AnomalyHistoryRuns)
+	if err != nil {
+		return nil, err
+	}
+
+	anomalies := []*VolumeAnomaly{}
+	for _, volume := range volumes {
+		if kind, ok := detectAnomaly(volume); ok {
+			anomalies = append(anomalies, &VolumeAnomaly{CompanyVolume: *volume, Kind: kind})
+		}
+	}
+	return anomalies, nil
+}
+
+// detectAnomaly reports whether the jobs found for a company are anomalous and how
+func detectAnomaly(volume *CompanyVolume) (AnomalyKind, bool) {
+	if volume.BaselineRuns < MinBaselineRuns {
+		return "", false
+	}
+	switch {
+	case volume.JobsFound == 0 && volume.Average >= MinActiveAverage:
+		return AnomalyZero, true
+	case float64(volume.JobsFound) >= SpikeFactor*max(volume.Average, 1):
+		return AnomalySpike, true
+	}
+	return "", false
+}
+
+// LastScrapedAt returns the latest scrape time of the jobs imported by the successful job populator
+// runs of every source of the job data, the jobs without a source under "". The jobs scraped up to
+// then were already imported. Sources whose jobs had no scrape time are missing.
+func (s *IngestService) LastScrapedAt(ctx context.Context) (map[string]time.Time, error) {
+	runs, err := s.repo.ListPopulatorRuns(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lastScrapedAt := make(map[string]time.Time, len(runs))
+	for _, run := range runs {
+		if run.LastScrapedAt != nil {
+			lastScrapedAt[run.Source] = *run.LastScrapedAt
+		}
+	}
+	return lastScrapedAt, nil
+}
+
+// RecordPopulatorRun records a successful job populator run over the jobs of a source, so the next
+// runs can skip the jobs scraped up to its LastScrapedAt
+func (s *IngestService) RecordPopulatorRun(ctx context.Context, run *PopulatorRun) error {
+	if len(run.Source) > MaxSourceLength {
+		return &httpservice.ValidationError{Errors: []string{
+			fmt.Sprintf("source must be at most %d characters", MaxSourceLength),
+		}}
+	}
+	return s.repo.SavePopulatorRun(ctx, run)
+}
+
+// runningRun returns a run that is still running. A RunNotFoundError or a RunClosedError
+// is returned when the run doesn't exist or was already closed.
+func (s *IngestService) runningRun(ctx context.Context, id int) (*Run, error) {
+	run, err := s.repo.GetRun(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if run.Status != StatusRunning {
+		return nil, &RunClosedError{ID: id, Status: run.Status}
+	}
+	return run, nil
+}
+
+// truncate shortens s to at most n bytes without splitting a character
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
@@ -0,0 +1,342 @@
+package ingest
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+func TestIngestService_StartRun(t *testing.T) {
+	t.Parallel()
+
+	mockRepo := NewMockDataRepository(t)
+	service := NewIngestService(mockRepo)
+
+	var validationErr *httpservice.ValidationError
+	_, err := service.StartRun(context.Background(), " ")
+	require.ErrorAs(t, err, &validationErr)
+
+	mockRepo.EXPECT().CreateRun(context.Background(), &Run{Source: "linkedin-scraper"}).Return(nil).Once()
+	run, err := service.StartRun(context.Background(), " linkedin-scraper ")
+	require.NoError(t, err)
+	assert.Equal(t, "linkedin-scraper", run.Source)
+}
+
+func TestIngestService_ReportCompany(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		report       *CompanyReport
+		mockSetup    func(mockRepo *MockDataRepository)
+		checkResults func(t *testing.T, report *CompanyReport, err error)
+	}{
+		{
+			name:   "report stored",
+			report: &CompanyReport{RunID: 15, CompanyName: " Tech Corp ", Status: StatusSucceeded, JobsFound: 12},
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetRun(context.Background(), 15).Return(&Run{ID: 15, Status: StatusRunning}, nil).Once()
+				mockRepo.EXPECT().ReportCompany(context.Background(), mock.MatchedBy(func(r *CompanyReport) bool {
+					return r.CompanyName == "Tech Corp"
+				})).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, _ *CompanyReport, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "long error truncated",
+			report: &CompanyReport{RunID: 15, CompanyName: "Tech Corp", Status: StatusFailed,
+				Error: strings.Repeat("é", MaxErrorLength)},
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetRun(context.Background(), 15).Return(&Run{ID: 15, Status: StatusRunning}, nil).Once()
+				mockRepo.EXPECT().ReportCompany(context.Background(), mock.Anything).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, report *CompanyReport, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Len(t, report.Error, MaxErrorLength)
+			},
+		},
+		{
+			name:      "invalid report",
+			report:    &CompanyReport{RunID: 15, Status: StatusRunning, JobsFound: -1},
+			mockSetup: func(_ *MockDataRepository) {},
+			checkResults: func(t *testing.T, _ *CompanyReport, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Len(t, validationErr.Errors, 3)
+			},
+		},
+		{
+			name:   "run already closed",
+			report: &CompanyReport{RunID: 15, CompanyName: "Tech Corp", Status: StatusSucceeded},
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetRun(context.Background(), 15).Return(&Run{ID: 15, Status: StatusFailed}, nil).Once()
+			},
+			checkResults: func(t *testing.T, _ *CompanyReport, err error) {
+				t.Helper()
+				assert.True(t, IsRunClosed(err))
+			},
+		},
+		{
+			name:   "unknown company",
+			report: &CompanyReport{RunID: 15, CompanyName: "Unknown", Status: StatusSucceeded},
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetRun(context.Background(), 15).Return(&Run{ID: 15, Status: StatusRunning}, nil).Once()
+				mockRepo.EXPECT().ReportCompany(context.Background(), mock.Anything).
+					Return(&CompanyNotFoundError{Name: "Unknown"}).Once()
+			},
+			checkResults: func(t *testing.T, _ *CompanyReport, err error) {
+				t.Helper()
+				assert.True(t, IsNotFound(err))
+			},
+		},
+		{
+			name:   "database error",
+			report: &CompanyReport{RunID: 15, CompanyName: "Tech Corp", Status: StatusSucceeded},
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetRun(context.Background(), 15).Return(nil, dbError).Once()
+			},
+			checkResults: func(t *testing.T, _ *CompanyReport, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			service := NewIngestService(mockRepo)
+
+			tt.mockSetup(mockRepo)
+
+			err := service.ReportCompany(context.Background(), tt.report)
+			tt.checkResults(t, tt.report, err)
+		})
+	}
+}
+
+func TestIngestService_CloseRun(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		status       RunStatus
+		mockSetup    func(mockRepo *MockDataRepository)
+		checkResults func(t *testing.T, run *Run, err error)
+	}{
+		{
+			name:   "run closed",
+			status: StatusFailed,
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetRun(context.Background(), 15).Return(&Run{ID: 15, Status: StatusRunning}, nil).Once()
+				mockRepo.EXPECT().CloseRun(context.Background(), &Run{ID: 15, Status: StatusFailed, Error: "timeout"}).
+					Return(true, nil).Once()
+			},
+			checkResults: func(t *testing.T, run *Run, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, StatusFailed, run.Status)
+			},
+		},
+		{
+			name:      "invalid status",
+			status:    StatusRunning,
+			mockSetup: func(_ *MockDataRepository) {},
+			checkResults: func(t *testing.T, _ *Run, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+			},
+		},
+		{
+			name:   "run closed concurrently",
+			status: StatusFailed,
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetRun(context.Background(), 15).Return(&Run{ID: 15, Status: StatusRunning}, nil).Once()
+				mockRepo.EXPECT().CloseRun(context.Background(), mock.Anything).Return(false, nil).Once()
+			},
+			checkResults: func(t *testing.T, _ *Run, err error) {
+				t.Helper()
+				assert.True(t, IsRunClosed(err))
+			},
+		},
+		{
+			name:   "run not found",
+			status: StatusSucceeded,
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetRun(context.Background(), 15).Return(nil, &RunNotFoundError{ID: 15}).Once()
+			},
+			checkResults: func(t *testing.T, _ *Run, err error) {
+				t.Helper()
+				assert.True(t, IsNotFound(err))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			service := NewIngestService(mockRepo)
+
+			tt.mockSetup(mockRepo)
+
+			run, err := service.CloseRun(context.Background(), 15, tt.status, " timeout ")
+			tt.checkResults(t, run, err)
+		})
+	}
+}
+
+func TestIngestService_StaleCompanies(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		maxAge   time.Duration
+		expected time.Time
+	}{
+		"default max age": {maxAge: 0, expected: now.Add(-DefaultStaleMaxAge)},
+		"given max age":   {maxAge: 6 * time.Hour, expected: now.Add(-6 * time.Hour)},
+		"clamped max age": {maxAge: 365 * 24 * time.Hour, expected: now.Add(-maxStaleMaxAge)},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			mockRepo.EXPECT().ListStaleCompanies(context.Background(), tt.expected).Return(nil, nil).Once()
+
+			service := NewIngestService(mockRepo)
+			service.now = func() time.Time { return now }
+
+			_, err := service.StaleCompanies(context.Background(), tt.maxAge)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestIngestService_Anomalies(t *testing.T) {
+	t.Parallel()
+
+	mockRepo := NewMockDataRepository(t)
+	service := NewIngestService(mockRepo)
+
+	mockRepo.EXPECT().GetRun(context.Background(), 16).Return(nil, &RunNotFoundError{ID: 16}).Once()
+	_, err := service.Anomalies(context.Background(), 16)
+	assert.True(t, IsNotFound(err))
+
+	mockRepo.EXPECT().GetRun(context.Background(), 15).Return(&Run{ID: 15, Status: StatusSucceeded}, nil).Once()
+	mockRepo.EXPECT().ListCompanyVolumes(context.Background(), 15, AnomalyHistoryRuns).Return([]*CompanyVolume{
+		{CompanyName: "Broken Selector", JobsFound: 0, Average: 14.5, BaselineRuns: 10},
+		{CompanyName: "Duplicated Listing", JobsFound: 150, Average: 12, BaselineRuns: 10},
+		{CompanyName: "Steady Corp", JobsFound: 11, Average: 12, BaselineRuns: 10},
+		{CompanyName: "New Corp", JobsFound: 0, Average: 14.5, BaselineRuns: 2},
+	}, nil).Once()
+	anomalies, err := service.Anomalies(context.Background(), 15)
+	require.NoError(t, err)
+	require.Len(t, anomalies, 2)
+	assert.Equal(t, AnomalyZero, anomalies[0].Kind)
+	assert.Equal(t, AnomalySpike, anomalies[1].Kind)
+}
+
+func TestIngestService_PopulatorRuns(t *testing.T) {
+	t.Parallel()
+	startedAt := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	mockRepo := NewMockDataRepository(t)
+	service := NewIngestService(mockRepo)
+
+	scrapedAt := startedAt.Add(-2 * time.Hour)
+	mockRepo.EXPECT().ListPopulatorRuns(context.Background()).Return([]*PopulatorRun{
+		{Source: "", StartedAt: startedAt.Add(-24 * time.Hour)},
+		{Source: "linkedin", StartedAt: startedAt, LastScrapedAt: &scrapedAt},
+	}, nil).Once()
+	lastScrapedAt, err := service.LastScrapedAt(context.Background())
+	require.NoError(t, err)
+	// The scrape times are compared, not the start of the runs, and sources without any are missing
+	assert.Equal(t, map[string]time.Time{"linkedin": scrapedAt}, lastScrapedAt)
+
+	var validationErr *httpservice.ValidationError
+	err = service.RecordPopulatorRun(context.Background(), &PopulatorRun{Source: strings.Repeat("a", MaxSourceLength+1)})
+	require.ErrorAs(t, err, &validationErr)
+
+	run := &PopulatorRun{Source: "linkedin", StartedAt: startedAt, JobsProcessed: 42}
+	mockRepo.EXPECT().SavePopulatorRun(context.Background(), run).Return(nil).Once()
+	require.NoError(t, service.RecordPopulatorRun(context.Background(), run))
+}
+
+func TestDetectAnomaly(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		volume   CompanyVolume
+		expected AnomalyKind
+	}{
+		"steady":                   {volume: CompanyVolume{JobsFound: 11, Average: 12, BaselineRuns: 5}},
+		"zero jobs":                {volume: CompanyVolume{JobsFound: 0, Average: 3, BaselineRuns: 5}, expected: AnomalyZero},
+		"zero jobs, barely active": {volume: CompanyVolume{JobsFound: 0, Average: 1, BaselineRuns: 5}},
+		"spike":                    {volume: CompanyVolume{JobsFound: 120, Average: 12, BaselineRuns: 5}, expected: AnomalySpike},
+		"spike, barely active":     {volume: CompanyVolume{JobsFound: 10, Average: 0.2, BaselineRuns: 5}, expected: AnomalySpike},
+		"few jobs, barely active":  {volume: CompanyVolume{JobsFound: 3, Average: 0.2, BaselineRuns: 5}},
+		"short baseline":           {volume: CompanyVolume{JobsFound: 0, Average: 12, BaselineRuns: 2}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			kind, ok := detectAnomaly(&tt.volume)
+			assert.Equal(t, tt.expected, kind)
+			assert.Equal(t, tt.expected != "", ok)
+		})
+	}
+}
+
+func TestAnomalyMonitor(t *testing.T) {
+	t.Parallel()
+
+	mockRepo := NewMockDataRepository(t)
+	monitor := NewAnomalyMonitor(NewIngestService(mockRepo))
+	assert.Empty(t, monitor.Collect())
+
+	mockRepo.EXPECT().ListLatestRuns(context.Background()).Return([]*Run{{ID: 15, Source: "careers-scraper"}}, nil).Once()
+	mockRepo.EXPECT().ListCompanyVolumes(context.Background(), 15, AnomalyHistoryRuns).Return([]*CompanyVolume{
+		{CompanyName: "Tech Corp", JobsFound: 0, Average: 14.5, BaselineRuns: 10},
+	}, nil).Once()
+	require.NoError(t, monitor.Refresh(context.Background()))
+
+	samples := monitor.Collect()
+	require.Len(t, samples, 3)
+	assert.Equal(t, "ingest_company_volume_anomaly", samples[0].Name)
+	assert.Equal(t, "Tech Corp", samples[0].Labels["company"])
+	assert.Equal(t, map[string]string{"source": "careers-scraper", "kind": "spike"}, samples[1].Labels)
+	assert.InDelta(t, 0, samples[1].Value, 0)
+	assert.Equal(t, map[string]string{"source": "careers-scraper", "kind": "zero"}, samples[2].Labels)
+	assert.InDelta(t, 1, samples[2].Value, 0)
+
+	mockRepo.EXPECT().ListLatestRuns(context.Background()).Return(nil, errors.New("database error")).Once()
+	require.Error(t, monitor.Refresh(context.Background()))
+	assert.Len(t, monitor.Collect(), 3)
+}
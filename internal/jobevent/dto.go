@@ -0,0 +1,101 @@
+package jobevent
+
+import (
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// Data Transfer Objects (DTOs) for the job event API layer.
+
+// TrackRequest represents the request body to track a job event
+type TrackRequest struct {
+	Type string `json:"type" binding:"required,oneof=view apply_click" example:"view"`
+}
+
+// StatsRequest represents the query parameters of the event statistics.
+// Both dates are inclusive.
+type StatsRequest struct {
+	DateFrom string `form:"date_from" binding:"date,date_lte=DateTo" example:"2024-01-01"`
+	DateTo   string `form:"date_to" binding:"date" example:"2024-01-31"`
+	Limit    int    `form:"limit" example:"20"`
+}
+
+// ToStatsParams converts a StatsRequest to StatsParams
+func (req *StatsRequest) ToStatsParams() (StatsParams, error) {
+	params := StatsParams{Limit: req.Limit}
+
+	if req.DateFrom != "" {
+		dateFrom, err := time.Parse(httpservice.DateLayout, req.DateFrom)
+		if err != nil {
+			return params, &httpservice.ConversionError{Field: "date_from", Value: req.DateFrom, Err: err}
+		}
+		params.From = dateFrom
+	}
+
+	if req.DateTo != "" {
+		dateTo, err := time.Parse(httpservice.DateLayout, req.DateTo)
+		if err != nil {
+			return params, &httpservice.ConversionError{Field: "date_to", Value: req.DateTo, Err: err}
+		}
+		params.To = dateTo.Add(day) // Include the whole last day
+	}
+
+	return params, nil
+}
+
+// JobStatsResponse represents the API response for the statistics of a job
+type JobStatsResponse struct {
+	JobID       int    `json:"job_id" example:"12"`
+	Title       string `json:"title" example:"Senior Go Developer"`
+	CompanyName string `json:"company_name" example:"Tech Corp"`
+	Views       int    `json:"views" example:"340"`
+	ApplyClicks int    `json:"apply_clicks" example:"25"`
+}
+
+// CompanyStatsResponse represents the API response for the statistics of a company
+type CompanyStatsResponse struct {
+	CompanyID   int    `json:"company_id" example:"1"`
+	CompanyName string `json:"company_name" example:"Tech Corp"`
+	Views       int    `json:"views" example:"1200"`
+	ApplyClicks int    `json:"apply_clicks" example:"87"`
+}
+
+// StatsResponse represents the API response for the event statistics of a date range
+type StatsResponse struct {
+	DateFrom  string                  `json:"date_from" example:"2024-01-01"`
+	DateTo    string                  `json:"date_to" example:"2024-01-31"`
+	Jobs      []*JobStatsResponse     `json:"jobs"`
+	Companies []*CompanyStatsResponse `json:"companies"`
+}
+
+// MapStatsToResponse converts event statistics to their API response format
+func MapStatsToResponse(stats *Stats) *StatsResponse {
+	jobs := make([]*JobStatsResponse, 0, len(stats.Jobs))
+	for _, jobStats := range stats.Jobs {
+		jobs = append(jobs, &JobStatsResponse{
+			JobID:       jobStats.JobID,
+			Title:       jobStats.Title,
+			CompanyName: jobStats.CompanyName,
+			Views:       jobStats.Views,
+			ApplyClicks: jobStats.ApplyClicks,
+		})
+	}
+
+	companies := make([]*CompanyStatsResponse, 0, len(stats.Companies))
+	for _, companyStats := range stats.Companies {
+		companies = append(companies, &CompanyStatsResponse{
+			CompanyID:   companyStats.CompanyID,
+			CompanyName: companyStats.CompanyName,
+			Views:       companyStats.Views,
+			ApplyClicks: companyStats.ApplyClicks,
+		})
+	}
+
+	return &StatsResponse{
+		DateFrom:  stats.From.Format(httpservice.DateLayout),
+		DateTo:    stats.To.Add(-day).Format(httpservice.DateLayout),
+		Jobs:      jobs,
+		Companies: companies,
+	}
+}
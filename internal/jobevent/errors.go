@@ -0,0 +1,40 @@
+// Package jobevent provides functionality for tracking job views and application clicks
+// and for aggregating them into impression and click statistics.
+package jobevent
+
+import (
+	"fmt"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// ErrBufferFull is returned when an event is dropped because the recorder can't keep up
+var ErrBufferFull = httpservice.NewError(httpservice.ErrUnavailable, "job event buffer is full")
+
+// InvalidTypeError represents an unknown event type
+type InvalidTypeError struct {
+	Type string
+}
+
+func (e InvalidTypeError) Error() string {
+	return fmt.Sprintf("invalid job event type %q", e.Type)
+}
+
+// Is matches httpservice.ErrInvalid
+func (e InvalidTypeError) Is(target error) bool {
+	return target == httpservice.ErrInvalid
+}
+
+// JobNotFoundError represents a job that can't be applied to, unknown or not published
+type JobNotFoundError struct {
+	ID int
+}
+
+func (e JobNotFoundError) Error() string {
+	return fmt.Sprintf("job with ID %d not found", e.ID)
+}
+
+// Is matches httpservice.ErrNotFound
+func (e JobNotFoundError) Is(target error) bool {
+	return target == httpservice.ErrNotFound
+}
@@ -0,0 +1,137 @@
+package jobevent
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// Constants for job event routes and endpoints
+const (
+	JobEventsPath  = "/jobs/:id/events"
+	ApplyPath      = "/jobs/:id/apply"
+	EventStatsPath = "/job-events/stats"
+)
+
+// Handler handles HTTP requests for job event operations
+type Handler struct {
+	service *EventService
+}
+
+// NewHandler creates a new job event handler
+func NewHandler(service *EventService) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers job event routes with the given router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.POST(JobEventsPath, h.TrackEvent)
+	rg.GET(ApplyPath, h.Apply)
+}
+
+// RegisterAdminRoutes registers the job event admin routes with the given (protected) router group
+func (h *Handler) RegisterAdminRoutes(rg *gin.RouterGroup) {
+	rg.GET(EventStatsPath, h.GetStats)
+}
+
+// TrackEvent godoc
+// @Summary Track a job event
+// @Description Records a view of a job or a click on its application link. Events are stored asynchronously.
+// @Tags jobs
+// @Accept json
+// @Produce json
+// @Param id path int true "Job ID"
+// @Param request body TrackRequest true "Event to track"
+// @Success 202
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 503 {object} httpservice.ErrorResponse
+// @Router /jobs/{id}/events [post]
+func (h *Handler) TrackEvent(c *gin.Context) {
+	jobID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(&httpservice.ValidationError{Errors: []string{"invalid job id"}})
+		return
+	}
+
+	var req TrackRequest
+	if err = c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	if err = h.service.Track(jobID, Type(req.Type)); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+// Apply godoc
+// @Summary Apply to a job
+// @Description Records a click on the application link of a published job and redirects to the link,
+// @Description with the UTM parameters of the board set (utm_source=ticosintech&utm_medium=job_board by default)
+// @Tags jobs
+// @Produce json
+// @Param id path int true "Job ID"
+// @Success 302 "Redirect to the application link"
+// @Header 302 {string} Location "Application link with the UTM parameters"
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /jobs/{id}/apply [get]
+func (h *Handler) Apply(c *gin.Context) {
+	jobID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(&httpservice.ValidationError{Errors: []string{"invalid job id"}})
+		return
+	}
+
+	applicationURL, err := h.service.Apply(c.Request.Context(), jobID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Redirect(http.StatusFound, applicationURL)
+}
+
+// GetStats godoc
+// @Summary Get job event statistics
+// @Description Returns the views and application clicks per job and per company for a date range,
+// @Description the last 30 days by default
+// @Tags admin
+// @Produce json
+// @Security AdminAPIKey
+// @Param date_from query string false "Start date (YYYY-MM-DD)" example("2024-01-01")
+// @Param date_to query string false "End date, inclusive (YYYY-MM-DD)" example("2024-01-31")
+// @Param limit query int false "Number of jobs and companies to return (max 100)" default(20) example(20)
+// @Success 200 {object} StatsResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/job-events/stats [get]
+func (h *Handler) GetStats(c *gin.Context) {
+	var req StatsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	params, err := req.ToStatsParams()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	stats, err := h.service.Stats(c.Request.Context(), params)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapStatsToResponse(stats))
+}
@@ -0,0 +1,60 @@
+package jobevent
+
+import (
+	"time"
+)
+
+// Type is the kind of interaction a job event records
+type Type string
+
+// Job event types
+const (
+	TypeView       Type = "view"
+	TypeApplyClick Type = "apply_click"
+)
+
+// IsValid reports whether t is a known event type
+func (t Type) IsValid() bool {
+	return t == TypeView || t == TypeApplyClick
+}
+
+// Event represents the database entity
+type Event struct {
+	ID        int64     `db:"id"`
+	JobID     int       `db:"job_id"`
+	Type      Type      `db:"event_type"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// StatsParams defines the date range to aggregate events for.
+// From is inclusive and To is exclusive.
+type StatsParams struct {
+	From  time.Time
+	To    time.Time
+	Limit int
+}
+
+// JobStats holds the aggregated events of a job
+type JobStats struct {
+	JobID       int    `db:"job_id"`
+	Title       string `db:"title"`
+	CompanyName string `db:"company_name"`
+	Views       int    `db:"views"`
+	ApplyClicks int    `db:"apply_clicks"`
+}
+
+// CompanyStats holds the aggregated events of all jobs of a company
+type CompanyStats struct {
+	CompanyID   int    `db:"company_id"`
+	CompanyName string `db:"company_name"`
+	Views       int    `db:"views"`
+	ApplyClicks int    `db:"apply_clicks"`
+}
+
+// Stats holds the per-job and per-company aggregated events for a date range
+type Stats struct {
+	From      time.Time
+	To        time.Time
+	Jobs      []*JobStats
+	Companies []*CompanyStats
+}
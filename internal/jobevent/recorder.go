@@ -0,0 +1,136 @@
+package jobevent
+
+import (
+	"context"
+	"time"
+)
+
+// Defaults for the event recorder
+const (
+	DefaultBufferSize    = 1000
+	DefaultBatchSize     = 100
+	DefaultFlushInterval = 5 * time.Second
+
+	// flushTimeout bounds the final flush done when the recorder stops
+	flushTimeout = 5 * time.Second
+)
+
+// BatchWriter interface to store batches of events.
+type BatchWriter interface {
+	InsertBatch(ctx context.Context, events []*Event) (int64, error)
+}
+
+// RecorderConfig configures how events are buffered and flushed
+type RecorderConfig struct {
+	// BufferSize is the number of events waiting to be written before new ones are dropped
+	BufferSize int
+	// BatchSize is the number of events written at once
+	BatchSize int
+	// FlushInterval is the maximum time an event waits before being written
+	FlushInterval time.Duration
+	// OnFlushError is called when a batch can't be written. Optional.
+	OnFlushError func(err error, dropped int)
+}
+
+// DefaultRecorderConfig returns the default recorder configuration
+func DefaultRecorderConfig() RecorderConfig {
+	return RecorderConfig{
+		BufferSize:    DefaultBufferSize,
+		BatchSize:     DefaultBatchSize,
+		FlushInterval: DefaultFlushInterval,
+	}
+}
+
+// Recorder buffers events in memory and writes them in batches, so tracking an event
+// doesn't cost a database round trip per request.
+type Recorder struct {
+	writer BatchWriter
+	events chan *Event
+	cfg    RecorderConfig
+}
+
+// NewRecorder creates a new instance of Recorder. Run must be started for events to be written.
+func NewRecorder(writer BatchWriter, cfg RecorderConfig) *Recorder {
+	defaults := DefaultRecorderConfig()
+	if cfg.BufferSize <= 0 {
+		cfg.BufferSize = defaults.BufferSize
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaults.BatchSize
+	}
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = defaults.FlushInterval
+	}
+
+	return &Recorder{
+		writer: writer,
+		events: make(chan *Event, cfg.BufferSize),
+		cfg:    cfg,
+	}
+}
+
+// Record queues an event to be written. It never blocks; ErrBufferFull is returned
+// when the event is dropped.
+func (r *Recorder) Record(event *Event) error {
+	select {
+	case r.events <- event:
+		return nil
+	default:
+		return ErrBufferFull
+	}
+}
+
+// Run writes the queued events until ctx is canceled, then flushes what is left.
+func (r *Recorder) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*Event, 0, r.cfg.BatchSize)
+	for {
+		select {
+		case event := <-r.events:
+			batch = append(batch, event)
+			if len(batch) >= r.cfg.BatchSize {
+				batch = r.flush(ctx, batch)
+			}
+		case <-ticker.C:
+			batch = r.flush(ctx, batch)
+		case <-ctx.Done():
+			r.drain(batch)
+			return nil
+		}
+	}
+}
+
+// drain writes the pending batch and the events still in the buffer after the recorder was stopped
+func (r *Recorder) drain(batch []*Event) {
+	ctx, cancel := context.WithTimeout(context.Background(), flushTimeout)
+	defer cancel()
+
+	for {
+		select {
+		case event := <-r.events:
+			batch = append(batch, event)
+			if len(batch) >= r.cfg.BatchSize {
+				batch = r.flush(ctx, batch)
+			}
+		default:
+			r.flush(ctx, batch)
+			return
+		}
+	}
+}
+
+// flush writes a batch and returns it emptied for reuse
+func (r *Recorder) flush(ctx context.Context, batch []*Event) []*Event {
+	if len(batch) == 0 {
+		return batch
+	}
+
+	if _, err := r.writer.InsertBatch(ctx, batch); err != nil && r.cfg.OnFlushError != nil {
+		r.cfg.OnFlushError(err, len(batch))
+	}
+
+	// Events are handed to the writer, so a new slice is used for the next batch
+	return make([]*Event, 0, r.cfg.BatchSize)
+}
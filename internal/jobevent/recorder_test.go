@@ -0,0 +1,73 @@
+package jobevent
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder_Record(t *testing.T) {
+	t.Parallel()
+	recorder := NewRecorder(NewMockBatchWriter(t), RecorderConfig{BufferSize: 1})
+
+	require.NoError(t, recorder.Record(&Event{JobID: 1, Type: TypeView}))
+	require.ErrorIs(t, recorder.Record(&Event{JobID: 2, Type: TypeView}), ErrBufferFull)
+}
+
+func TestRecorder_Run(t *testing.T) {
+	t.Parallel()
+
+	t.Run("full batches are written", func(t *testing.T) {
+		t.Parallel()
+		mockWriter := NewMockBatchWriter(t)
+		recorder := NewRecorder(mockWriter, RecorderConfig{BatchSize: 2, FlushInterval: time.Hour})
+
+		written := make(chan int, 1)
+		mockWriter.EXPECT().InsertBatch(mock.Anything, mock.Anything).
+			RunAndReturn(func(_ context.Context, events []*Event) (int64, error) {
+				written <- len(events)
+				return int64(len(events)), nil
+			}).Once()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+		go func() { done <- recorder.Run(ctx) }()
+
+		require.NoError(t, recorder.Record(&Event{JobID: 1, Type: TypeView}))
+		require.NoError(t, recorder.Record(&Event{JobID: 2, Type: TypeApplyClick}))
+		assert.Equal(t, 2, <-written)
+
+		cancel()
+		require.NoError(t, <-done)
+	})
+
+	t.Run("pending events are written on stop", func(t *testing.T) {
+		t.Parallel()
+		mockWriter := NewMockBatchWriter(t)
+		flushErr := errors.New("database error")
+		var dropped int
+		recorder := NewRecorder(mockWriter, RecorderConfig{
+			BatchSize:     10,
+			FlushInterval: time.Hour,
+			OnFlushError:  func(_ error, n int) { dropped = n },
+		})
+
+		mockWriter.EXPECT().InsertBatch(mock.Anything, mock.MatchedBy(func(events []*Event) bool {
+			return len(events) == 3
+		})).Return(0, flushErr).Once()
+
+		for i := range 3 {
+			require.NoError(t, recorder.Record(&Event{JobID: i + 1, Type: TypeView}))
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		require.NoError(t, recorder.Run(ctx))
+		assert.Equal(t, 3, dropped)
+	})
+}
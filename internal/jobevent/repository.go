@@ -0,0 +1,173 @@
+package jobevent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// SQL query constants
+const (
+	// Inserts a batch of events in a single statement. Events of jobs that no longer exist
+	// are skipped instead of failing the whole batch.
+	insertEventsQuery = `
+        INSERT INTO job_events (job_id, event_type, created_at)
+        SELECT e.job_id, e.event_type, e.created_at
+        FROM unnest($1::int[], $2::text[], $3::timestamp[]) AS e(job_id, event_type, created_at)
+        JOIN jobs j ON j.id = e.job_id
+    `
+
+	getJobStatsQuery = `
+        SELECT j.id, j.title, c.name,
+               COUNT(*) FILTER (WHERE e.event_type = 'view') AS views,
+               COUNT(*) FILTER (WHERE e.event_type = 'apply_click') AS apply_clicks
+        FROM job_events e
+        JOIN jobs j ON e.job_id = j.id
+        JOIN companies c ON j.company_id = c.id
+        WHERE e.created_at >= $1 AND e.created_at < $2
+        GROUP BY j.id, j.title, c.name
+        ORDER BY views DESC, apply_clicks DESC, j.id
+        LIMIT $3
+    `
+
+	getCompanyStatsQuery = `
+        SELECT c.id, c.name,
+               COUNT(*) FILTER (WHERE e.event_type = 'view') AS views,
+               COUNT(*) FILTER (WHERE e.event_type = 'apply_click') AS apply_clicks
+        FROM job_events e
+        JOIN jobs j ON e.job_id = j.id
+        JOIN companies c ON j.company_id = c.id
+        WHERE e.created_at >= $1 AND e.created_at < $2
+        GROUP BY c.id, c.name
+        ORDER BY views DESC, apply_clicks DESC, c.id
+        LIMIT $3
+    `
+
+	// Only the published jobs can be applied to
+	getApplicationURLQuery = `
+        SELECT application_url
+        FROM jobs
+        WHERE id = $1 AND status = 'published' AND is_active = true
+    `
+)
+
+// Database interface to support pgxpool and mocks
+type Database interface {
+	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
+	Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+}
+
+// Repository handles database operations for the Event model.
+type Repository struct {
+	db Database
+}
+
+// NewRepository creates a new Repository instance.
+func NewRepository(db Database) *Repository {
+	return &Repository{db: db}
+}
+
+// InsertBatch inserts events in a single statement and returns how many were stored.
+// Events of unknown jobs are skipped.
+func (r *Repository) InsertBatch(ctx context.Context, events []*Event) (int64, error) {
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	jobIDs := make([]int, len(events))
+	types := make([]string, len(events))
+	createdAt := make([]time.Time, len(events))
+	for i, event := range events {
+		jobIDs[i] = event.JobID
+		types[i] = string(event.Type)
+		createdAt[i] = event.CreatedAt
+	}
+
+	commandTag, err := r.db.Exec(ctx, insertEventsQuery, jobIDs, types, createdAt)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert job events: %w", err)
+	}
+
+	return commandTag.RowsAffected(), nil
+}
+
+// GetJobStats aggregates the views and application clicks per job for the params date range,
+// most viewed jobs first.
+func (r *Repository) GetJobStats(ctx context.Context, params *StatsParams) ([]*JobStats, error) {
+	rows, err := r.db.Query(ctx, getJobStatsQuery, params.From, params.To, params.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job event stats: %w", err)
+	}
+	defer rows.Close()
+
+	var stats []*JobStats
+	for rows.Next() {
+		jobStats := &JobStats{}
+		err = rows.Scan(
+			&jobStats.JobID,
+			&jobStats.Title,
+			&jobStats.CompanyName,
+			&jobStats.Views,
+			&jobStats.ApplyClicks,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan job event stats row: %w", err)
+		}
+		stats = append(stats, jobStats)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating job event stats rows: %w", err)
+	}
+
+	return stats, nil
+}
+
+// GetCompanyStats aggregates the views and application clicks per company for the params date range,
+// most viewed companies first.
+func (r *Repository) GetCompanyStats(ctx context.Context, params *StatsParams) ([]*CompanyStats, error) {
+	rows, err := r.db.Query(ctx, getCompanyStatsQuery, params.From, params.To, params.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get company event stats: %w", err)
+	}
+	defer rows.Close()
+
+	var stats []*CompanyStats
+	for rows.Next() {
+		companyStats := &CompanyStats{}
+		err = rows.Scan(
+			&companyStats.CompanyID,
+			&companyStats.CompanyName,
+			&companyStats.Views,
+			&companyStats.ApplyClicks,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan company event stats row: %w", err)
+		}
+		stats = append(stats, companyStats)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating company event stats rows: %w", err)
+	}
+
+	return stats, nil
+}
+
+// GetApplicationURL retrieves the application link of a published job.
+func (r *Repository) GetApplicationURL(ctx context.Context, jobID int) (string, error) {
+	var applicationURL string
+	err := r.db.QueryRow(ctx, getApplicationURLQuery, jobID).Scan(&applicationURL)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", &JobNotFoundError{ID: jobID}
+		}
+		return "", fmt.Errorf("failed to get job application url: %w", err)
+	}
+	return applicationURL, nil
+}
@@ -0,0 +1,238 @@
+package jobevent
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+func TestRepository_InsertBatch(t *testing.T) {
+	t.Parallel()
+	now := time.Now().UTC()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		events       []*Event
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, inserted int64, err error)
+	}{
+		{
+			name: "batch inserted",
+			events: []*Event{
+				{JobID: 1, Type: TypeView, CreatedAt: now},
+				{JobID: 2, Type: TypeApplyClick, CreatedAt: now},
+			},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(insertEventsQuery)).
+					WithArgs([]int{1, 2}, []string{"view", "apply_click"}, []time.Time{now, now}).
+					WillReturnResult(pgxmock.NewResult("INSERT", 2))
+			},
+			checkResults: func(t *testing.T, inserted int64, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, int64(2), inserted)
+			},
+		},
+		{
+			name:      "empty batch",
+			events:    nil,
+			mockSetup: func(_ pgxmock.PgxPoolIface) {},
+			checkResults: func(t *testing.T, inserted int64, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Zero(t, inserted)
+			},
+		},
+		{
+			name:   "database error",
+			events: []*Event{{JobID: 1, Type: TypeView, CreatedAt: now}},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(insertEventsQuery)).
+					WithArgs([]int{1}, []string{"view"}, []time.Time{now}).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ int64, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			inserted, err := repo.InsertBatch(context.Background(), tt.events)
+			tt.checkResults(t, inserted, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_GetJobStats(t *testing.T) {
+	t.Parallel()
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	params := &StatsParams{From: from, To: to, Limit: 20}
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, stats []*JobStats, err error)
+	}{
+		{
+			name: "stats found",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getJobStatsQuery)).
+					WithArgs(from, to, 20).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "title", "name", "views", "apply_clicks"}).
+						AddRow(1, "Go Developer", "Tech Corp", 340, 25).
+						AddRow(2, "QA Engineer", "Tech Corp", 120, 4))
+			},
+			checkResults: func(t *testing.T, stats []*JobStats, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, stats, 2)
+				assert.Equal(t, 340, stats[0].Views)
+				assert.Equal(t, 25, stats[0].ApplyClicks)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getJobStatsQuery)).
+					WithArgs(from, to, 20).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ []*JobStats, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			stats, err := repo.GetJobStats(context.Background(), params)
+			tt.checkResults(t, stats, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_GetCompanyStats(t *testing.T) {
+	t.Parallel()
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	params := &StatsParams{From: from, To: to, Limit: 20}
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, stats []*CompanyStats, err error)
+	}{
+		{
+			name: "stats found",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getCompanyStatsQuery)).
+					WithArgs(from, to, 20).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "name", "views", "apply_clicks"}).
+						AddRow(1, "Tech Corp", 460, 29))
+			},
+			checkResults: func(t *testing.T, stats []*CompanyStats, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, stats, 1)
+				assert.Equal(t, "Tech Corp", stats[0].CompanyName)
+				assert.Equal(t, 460, stats[0].Views)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getCompanyStatsQuery)).
+					WithArgs(from, to, 20).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ []*CompanyStats, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			stats, err := repo.GetCompanyStats(context.Background(), params)
+			tt.checkResults(t, stats, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_GetApplicationURL(t *testing.T) {
+	t.Parallel()
+
+	mockDB, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockDB.Close()
+	repo := NewRepository(mockDB)
+
+	mockDB.ExpectQuery(regexp.QuoteMeta(getApplicationURLQuery)).
+		WithArgs(7).
+		WillReturnRows(pgxmock.NewRows([]string{"application_url"}).AddRow("https://techcorp.com/jobs/7"))
+	applicationURL, err := repo.GetApplicationURL(context.Background(), 7)
+	require.NoError(t, err)
+	assert.Equal(t, "https://techcorp.com/jobs/7", applicationURL)
+
+	mockDB.ExpectQuery(regexp.QuoteMeta(getApplicationURLQuery)).
+		WithArgs(8).
+		WillReturnError(pgx.ErrNoRows)
+	_, err = repo.GetApplicationURL(context.Background(), 8)
+	require.ErrorIs(t, err, httpservice.ErrNotFound)
+
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
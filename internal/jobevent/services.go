@@ -0,0 +1,140 @@
+package jobevent
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// Constants for statistics queries
+const (
+	DefaultStatsDays = 30
+	MaxStatsDays     = 366
+	DefaultLimit     = 20
+	MaxLimit         = 100
+)
+
+const day = 24 * time.Hour
+
+// DefaultUTMParams are the UTM parameters set on the application links by default, so the companies
+// can tell the applicants came from the board
+const DefaultUTMParams = "utm_source=ticosintech&utm_medium=job_board"
+
+// DataRepository interface to aggregate job events.
+type DataRepository interface {
+	GetJobStats(ctx context.Context, params *StatsParams) ([]*JobStats, error)
+	GetCompanyStats(ctx context.Context, params *StatsParams) ([]*CompanyStats, error)
+	GetApplicationURL(ctx context.Context, jobID int) (string, error)
+}
+
+// EventRecorder interface to queue job events for writing.
+// It is implemented by Recorder.
+type EventRecorder interface {
+	Record(event *Event) error
+}
+
+// EventService holds the business logic for job event tracking and statistics.
+type EventService struct {
+	repo      DataRepository
+	recorder  EventRecorder
+	utmParams url.Values
+	now       func() time.Time
+}
+
+// NewEventService creates a new instance of EventService. The UTM parameters are set on the
+// application links jobs are applied through, none when empty.
+func NewEventService(repo DataRepository, recorder EventRecorder, utmParams url.Values) *EventService {
+	return &EventService{repo: repo, recorder: recorder, utmParams: utmParams, now: time.Now}
+}
+
+// Track records an event for a job. Events are written asynchronously, so events of
+// unknown jobs are accepted here and discarded when written.
+func (s *EventService) Track(jobID int, eventType Type) error {
+	if jobID <= 0 {
+		return &httpservice.ValidationError{Errors: []string{"invalid job id"}}
+	}
+	if !eventType.IsValid() {
+		return &InvalidTypeError{Type: string(eventType)}
+	}
+
+	return s.recorder.Record(&Event{
+		JobID:     jobID,
+		Type:      eventType,
+		CreatedAt: s.now().UTC(),
+	})
+}
+
+// Apply records a click on the application link of a published job and returns the link with the
+// UTM parameters set, replacing the ones it had. A click that can't be recorded doesn't keep the
+// applicant from the job. Jobs without a web link answer a JobNotFoundError, they aren't redirected to.
+func (s *EventService) Apply(ctx context.Context, jobID int) (string, error) {
+	if jobID <= 0 {
+		return "", &httpservice.ValidationError{Errors: []string{"invalid job id"}}
+	}
+
+	applicationURL, err := s.repo.GetApplicationURL(ctx, jobID)
+	if err != nil {
+		return "", err
+	}
+	link, err := url.Parse(applicationURL)
+	if err != nil || (link.Scheme != "http" && link.Scheme != "https") {
+		return "", &JobNotFoundError{ID: jobID}
+	}
+
+	_ = s.recorder.Record(&Event{
+		JobID:     jobID,
+		Type:      TypeApplyClick,
+		CreatedAt: s.now().UTC(),
+	})
+
+	if len(s.utmParams) > 0 {
+		query := link.Query()
+		for name, values := range s.utmParams {
+			query[name] = values
+		}
+		link.RawQuery = query.Encode()
+	}
+	return link.String(), nil
+}
+
+// Stats aggregates the events per job and per company for the params date range.
+// A zero range defaults to the last DefaultStatsDays days.
+func (s *EventService) Stats(ctx context.Context, params StatsParams) (*Stats, error) {
+	if params.To.IsZero() {
+		params.To = s.now().UTC().Truncate(day).Add(day)
+	}
+	if params.From.IsZero() {
+		params.From = params.To.Add(-DefaultStatsDays * day)
+	}
+
+	if !params.From.Before(params.To) {
+		return nil, &httpservice.ValidationError{Errors: []string{"date_from cannot be after date_to"}}
+	}
+	if params.To.Sub(params.From) > MaxStatsDays*day {
+		return nil, &httpservice.ValidationError{Errors: []string{"date range cannot exceed 366 days"}}
+	}
+
+	if params.Limit <= 0 {
+		params.Limit = DefaultLimit
+	}
+	params.Limit = min(params.Limit, MaxLimit)
+
+	jobStats, err := s.repo.GetJobStats(ctx, &params)
+	if err != nil {
+		return nil, err
+	}
+
+	companyStats, err := s.repo.GetCompanyStats(ctx, &params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Stats{
+		From:      params.From,
+		To:        params.To,
+		Jobs:      jobStats,
+		Companies: companyStats,
+	}, nil
+}
@@ -0,0 +1,300 @@
+package jobevent
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+func TestEventService_Track(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		jobID        int
+		eventType    Type
+		mockSetup    func(mockRecorder *MockEventRecorder)
+		checkResults func(t *testing.T, err error)
+	}{
+		{
+			name:      "event recorded",
+			jobID:     1,
+			eventType: TypeApplyClick,
+			mockSetup: func(mockRecorder *MockEventRecorder) {
+				t.Helper()
+				mockRecorder.EXPECT().Record(mock.MatchedBy(func(e *Event) bool {
+					return e.JobID == 1 && e.Type == TypeApplyClick && !e.CreatedAt.IsZero()
+				})).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:      "invalid type",
+			jobID:     1,
+			eventType: "share",
+			mockSetup: func(_ *MockEventRecorder) {},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				var typeErr *InvalidTypeError
+				require.ErrorAs(t, err, &typeErr)
+			},
+		},
+		{
+			name:      "invalid job id",
+			jobID:     0,
+			eventType: TypeView,
+			mockSetup: func(_ *MockEventRecorder) {},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+			},
+		},
+		{
+			name:      "buffer full",
+			jobID:     1,
+			eventType: TypeView,
+			mockSetup: func(mockRecorder *MockEventRecorder) {
+				t.Helper()
+				mockRecorder.EXPECT().Record(mock.Anything).Return(ErrBufferFull).Once()
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, ErrBufferFull)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRecorder := NewMockEventRecorder(t)
+			service := NewEventService(NewMockDataRepository(t), mockRecorder, nil)
+
+			tt.mockSetup(mockRecorder)
+
+			err := service.Track(tt.jobID, tt.eventType)
+			tt.checkResults(t, err)
+		})
+	}
+}
+
+func TestEventService_Apply(t *testing.T) {
+	t.Parallel()
+	utmParams := url.Values{"utm_source": {"ticosintech"}, "utm_medium": {"job_board"}}
+
+	tests := []struct {
+		name           string
+		jobID          int
+		utmParams      url.Values
+		applicationURL string
+		mockSetup      func(mockRepo *MockDataRepository, mockRecorder *MockEventRecorder)
+		checkResults   func(t *testing.T, link string, err error)
+	}{
+		{
+			name:      "click recorded and link tagged",
+			jobID:     7,
+			utmParams: utmParams,
+			mockSetup: func(mockRepo *MockDataRepository, mockRecorder *MockEventRecorder) {
+				t.Helper()
+				mockRepo.EXPECT().GetApplicationURL(context.Background(), 7).
+					Return("https://techcorp.com/jobs/7?ref=board&utm_source=linkedin#apply", nil).Once()
+				mockRecorder.EXPECT().Record(mock.MatchedBy(func(e *Event) bool {
+					return e.JobID == 7 && e.Type == TypeApplyClick
+				})).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, link string, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t,
+					"https://techcorp.com/jobs/7?ref=board&utm_medium=job_board&utm_source=ticosintech#apply", link)
+			},
+		},
+		{
+			name:  "link untouched without UTM parameters",
+			jobID: 7,
+			mockSetup: func(mockRepo *MockDataRepository, mockRecorder *MockEventRecorder) {
+				t.Helper()
+				mockRepo.EXPECT().GetApplicationURL(context.Background(), 7).
+					Return("https://techcorp.com/jobs/7", nil).Once()
+				mockRecorder.EXPECT().Record(mock.Anything).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, link string, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, "https://techcorp.com/jobs/7", link)
+			},
+		},
+		{
+			name:      "redirected when the click can't be recorded",
+			jobID:     7,
+			utmParams: utmParams,
+			mockSetup: func(mockRepo *MockDataRepository, mockRecorder *MockEventRecorder) {
+				t.Helper()
+				mockRepo.EXPECT().GetApplicationURL(context.Background(), 7).
+					Return("https://techcorp.com/jobs/7", nil).Once()
+				mockRecorder.EXPECT().Record(mock.Anything).Return(ErrBufferFull).Once()
+			},
+			checkResults: func(t *testing.T, link string, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, "https://techcorp.com/jobs/7?utm_medium=job_board&utm_source=ticosintech", link)
+			},
+		},
+		{
+			name:  "not a web link",
+			jobID: 7,
+			mockSetup: func(mockRepo *MockDataRepository, _ *MockEventRecorder) {
+				t.Helper()
+				mockRepo.EXPECT().GetApplicationURL(context.Background(), 7).
+					Return("javascript:alert(1)", nil).Once()
+			},
+			checkResults: func(t *testing.T, _ string, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, httpservice.ErrNotFound)
+			},
+		},
+		{
+			name:  "job not published",
+			jobID: 8,
+			mockSetup: func(mockRepo *MockDataRepository, _ *MockEventRecorder) {
+				t.Helper()
+				mockRepo.EXPECT().GetApplicationURL(context.Background(), 8).
+					Return("", &JobNotFoundError{ID: 8}).Once()
+			},
+			checkResults: func(t *testing.T, _ string, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, httpservice.ErrNotFound)
+			},
+		},
+		{
+			name:      "invalid job id",
+			jobID:     0,
+			mockSetup: func(_ *MockDataRepository, _ *MockEventRecorder) {},
+			checkResults: func(t *testing.T, _ string, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			mockRecorder := NewMockEventRecorder(t)
+			service := NewEventService(mockRepo, mockRecorder, tt.utmParams)
+
+			tt.mockSetup(mockRepo, mockRecorder)
+
+			link, err := service.Apply(context.Background(), tt.jobID)
+			tt.checkResults(t, link, err)
+		})
+	}
+}
+
+func TestEventService_Stats(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC)
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		params       StatsParams
+		mockSetup    func(mockRepo *MockDataRepository)
+		checkResults func(t *testing.T, stats *Stats, err error)
+	}{
+		{
+			name:   "defaults to the last 30 days",
+			params: StatsParams{},
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				expected := &StatsParams{
+					From:  time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC),
+					To:    time.Date(2024, 3, 16, 0, 0, 0, 0, time.UTC),
+					Limit: DefaultLimit,
+				}
+				mockRepo.EXPECT().GetJobStats(context.Background(), expected).
+					Return([]*JobStats{{JobID: 1, Views: 10}}, nil).Once()
+				mockRepo.EXPECT().GetCompanyStats(context.Background(), expected).
+					Return([]*CompanyStats{{CompanyID: 1, Views: 10}}, nil).Once()
+			},
+			checkResults: func(t *testing.T, stats *Stats, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Len(t, stats.Jobs, 1)
+				assert.Len(t, stats.Companies, 1)
+
+				response := MapStatsToResponse(stats)
+				assert.Equal(t, "2024-02-15", response.DateFrom)
+				assert.Equal(t, "2024-03-15", response.DateTo)
+			},
+		},
+		{
+			name: "inverted range",
+			params: StatsParams{
+				From: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+				To:   time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			},
+			mockSetup: func(_ *MockDataRepository) {},
+			checkResults: func(t *testing.T, _ *Stats, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+			},
+		},
+		{
+			name: "range too long",
+			params: StatsParams{
+				From: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+				To:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			mockSetup: func(_ *MockDataRepository) {},
+			checkResults: func(t *testing.T, _ *Stats, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+			},
+		},
+		{
+			name:   "repository error",
+			params: StatsParams{Limit: 500},
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetJobStats(context.Background(), mock.MatchedBy(func(p *StatsParams) bool {
+					return p.Limit == MaxLimit
+				})).Return(nil, dbError).Once()
+			},
+			checkResults: func(t *testing.T, _ *Stats, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			service := NewEventService(mockRepo, NewMockEventRecorder(t), nil)
+			service.now = func() time.Time { return now }
+
+			tt.mockSetup(mockRepo)
+
+			stats, err := service.Stats(context.Background(), tt.params)
+			tt.checkResults(t, stats, err)
+		})
+	}
+}
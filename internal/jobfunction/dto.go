@@ -0,0 +1,23 @@
+package jobfunction
+
+// Data Transfer Objects (DTOs) for the job function API layer.
+
+// JobFunctionResponse represents the API response for a job function
+type JobFunctionResponse struct {
+	ID   int    `json:"id" example:"1"`
+	Name string `json:"name" example:"Backend"`
+}
+
+// ListResponse represents the API response listing job functions
+type ListResponse struct {
+	Data []*JobFunctionResponse `json:"data"`
+}
+
+// MapJobFunctionsToResponse converts job function database models to the list API response format
+func MapJobFunctionsToResponse(functions []*JobFunction) *ListResponse {
+	data := make([]*JobFunctionResponse, 0, len(functions))
+	for _, function := range functions {
+		data = append(data, &JobFunctionResponse{ID: function.ID, Name: function.Name})
+	}
+	return &ListResponse{Data: data}
+}
@@ -0,0 +1,30 @@
+// Package jobfunction provides functionality for managing the job function taxonomy
+// (Backend, Frontend, DevOps, ...) and the association of jobs with their functions.
+package jobfunction
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// NotFoundError represents a job function not found error
+type NotFoundError struct {
+	Name string
+}
+
+func (e NotFoundError) Error() string {
+	return fmt.Sprintf("job function %q not found", e.Name)
+}
+
+// Is matches httpservice.ErrNotFound
+func (e NotFoundError) Is(target error) bool {
+	return target == httpservice.ErrNotFound
+}
+
+// IsNotFound checks if an error is a job function not found error
+func IsNotFound(err error) bool {
+	var notFoundErr *NotFoundError
+	return errors.As(err, &notFoundErr)
+}
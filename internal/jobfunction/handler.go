@@ -0,0 +1,45 @@
+package jobfunction
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Constants for job function routes and endpoints
+const (
+	JobFunctionsRoute = "/job-functions"
+)
+
+// Handler handles HTTP requests for job function operations
+type Handler struct {
+	repo *Repository
+}
+
+// NewHandler creates a new job function handler
+func NewHandler(repo *Repository) *Handler {
+	return &Handler{repo: repo}
+}
+
+// RegisterRoutes registers job function routes with the given router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.GET(JobFunctionsRoute, h.ListJobFunctions)
+}
+
+// ListJobFunctions godoc
+// @Summary List job functions
+// @Description Lists the job functions that can be used with the function filter of the job search
+// @Tags jobs
+// @Produce json
+// @Success 200 {object} ListResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /job-functions [get]
+func (h *Handler) ListJobFunctions(c *gin.Context) {
+	functions, err := h.repo.List(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapJobFunctionsToResponse(functions))
+}
@@ -0,0 +1,12 @@
+package jobfunction
+
+import (
+	"time"
+)
+
+// JobFunction represents a job function (role category) such as Backend or DevOps.
+type JobFunction struct {
+	ID        int       `json:"id" db:"id"`
+	Name      string    `json:"name" db:"name"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
@@ -0,0 +1,99 @@
+package jobfunction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// SQL query constants
+const (
+	listJobFunctionsQuery = `
+        SELECT id, name, created_at
+        FROM job_functions
+        ORDER BY name
+    `
+
+	getJobFunctionByNameQuery = `
+        SELECT id, name, created_at
+        FROM job_functions
+        WHERE LOWER(name) = LOWER($1)
+    `
+
+	assignJobFunctionQuery = `
+        INSERT INTO job_function_assignments (job_id, function_id)
+        VALUES ($1, $2)
+        ON CONFLICT (job_id, function_id) DO NOTHING
+    `
+)
+
+// Database interface to support pgxpool and mocks
+type Database interface {
+	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
+	Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+}
+
+// Repository handles database operations for the JobFunction model.
+type Repository struct {
+	db Database
+}
+
+// NewRepository creates a new Repository instance.
+func NewRepository(db Database) *Repository {
+	return &Repository{db: db}
+}
+
+// List retrieves all job functions ordered by name.
+func (r *Repository) List(ctx context.Context) ([]*JobFunction, error) {
+	rows, err := r.db.Query(ctx, listJobFunctionsQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list job functions: %w", err)
+	}
+	defer rows.Close()
+
+	var functions []*JobFunction
+	for rows.Next() {
+		function := &JobFunction{}
+		if err = rows.Scan(&function.ID, &function.Name, &function.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan job function row: %w", err)
+		}
+		functions = append(functions, function)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating job function rows: %w", err)
+	}
+
+	return functions, nil
+}
+
+// GetByName retrieves a job function by its name, ignoring case.
+func (r *Repository) GetByName(ctx context.Context, name string) (*JobFunction, error) {
+	function := &JobFunction{}
+	err := r.db.QueryRow(ctx, getJobFunctionByNameQuery, name).Scan(
+		&function.ID,
+		&function.Name,
+		&function.CreatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &NotFoundError{Name: name}
+		}
+		return nil, fmt.Errorf("failed to get job function: %w", err)
+	}
+
+	return function, nil
+}
+
+// AssignToJob associates a job with a job function. Existing associations are left untouched.
+func (r *Repository) AssignToJob(ctx context.Context, jobID, functionID int) error {
+	if _, err := r.db.Exec(ctx, assignJobFunctionQuery, jobID, functionID); err != nil {
+		return fmt.Errorf("failed to assign job function: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package jobfunction
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_List(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, result []*JobFunction, err error)
+	}{
+		{
+			name: "job functions found",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(listJobFunctionsQuery)).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "name", "created_at"}).
+						AddRow(1, "Backend", now).
+						AddRow(5, "DevOps", now))
+			},
+			checkResults: func(t *testing.T, result []*JobFunction, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, result, 2)
+				assert.Equal(t, "Backend", result[0].Name)
+				assert.Equal(t, 5, result[1].ID)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(listJobFunctionsQuery)).WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ []*JobFunction, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			result, err := repo.List(context.Background())
+			tt.checkResults(t, result, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_GetByName(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+
+	tests := []struct {
+		name         string
+		functionName string
+		mockSetup    func(mock pgxmock.PgxPoolIface, functionName string)
+		checkResults func(t *testing.T, result *JobFunction, err error)
+	}{
+		{
+			name:         "job function found",
+			functionName: "backend",
+			mockSetup: func(mock pgxmock.PgxPoolIface, functionName string) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getJobFunctionByNameQuery)).
+					WithArgs(functionName).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "name", "created_at"}).AddRow(1, "Backend", now))
+			},
+			checkResults: func(t *testing.T, result *JobFunction, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, "Backend", result.Name)
+			},
+		},
+		{
+			name:         "job function not found",
+			functionName: "Astronaut",
+			mockSetup: func(mock pgxmock.PgxPoolIface, functionName string) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getJobFunctionByNameQuery)).
+					WithArgs(functionName).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			checkResults: func(t *testing.T, result *JobFunction, err error) {
+				t.Helper()
+				assert.Nil(t, result)
+				assert.True(t, IsNotFound(err))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB, tt.functionName)
+
+			result, err := repo.GetByName(context.Background(), tt.functionName)
+			tt.checkResults(t, result, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_AssignToJob(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, err error)
+	}{
+		{
+			name: "successful assignment",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(assignJobFunctionQuery)).
+					WithArgs(10, 1).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(assignJobFunctionQuery)).
+					WithArgs(10, 1).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			err = repo.AssignToJob(context.Background(), 10, 1)
+			tt.checkResults(t, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package jobrevision
+
+import (
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+)
+
+// Data Transfer Objects (DTOs) for the job revision API layer.
+
+// RevisionResponse represents the API response for a previous version of a job
+type RevisionResponse struct {
+	ID                int64   `json:"id" example:"31"`
+	Title             string  `json:"title" example:"Go Developer"`
+	Description       string  `json:"description" example:"We are looking for a Go developer..."`
+	ExperienceLevel   string  `json:"experience_level" example:"Mid-level"`
+	EmploymentType    string  `json:"employment_type" example:"Full-time"`
+	Location          string  `json:"location" example:"Costa Rica"`
+	WorkMode          string  `json:"work_mode" example:"Remote"`
+	ApplicationURL    string  `json:"application_url" example:"https://techcorp.com/careers/go-developer"`
+	RemoteEligibility *string `json:"remote_eligibility" example:"LATAM only" extensions:"x-nullable"`
+	UTCOffsetMin      *int    `json:"utc_offset_min" example:"-6" extensions:"x-nullable"`
+	UTCOffsetMax      *int    `json:"utc_offset_max" example:"-3" extensions:"x-nullable"`
+	// ChangedFields are the fields the version replacing this one changed
+	ChangedFields []string  `json:"changed_fields" example:"title,description"`
+	ValidFrom     time.Time `json:"valid_from" example:"2024-01-15T10:30:00Z"`
+	ReplacedAt    time.Time `json:"replaced_at" example:"2024-02-01T08:00:00Z"`
+	// WrittenBy is who wrote the version and ReplacedBy who replaced it as kind:id, omitted when unknown
+	WrittenBy  string `json:"written_by,omitempty" example:"cli:ana@db_job_populator"`
+	ReplacedBy string `json:"replaced_by,omitempty" example:"user:42"`
+}
+
+// RevisionListResponse represents the API response listing the revisions of a job, newest first
+type RevisionListResponse struct {
+	JobID int                 `json:"job_id" example:"12"`
+	Data  []*RevisionResponse `json:"data"`
+}
+
+// content holds the fields of a version of a job that are saved in its revisions
+type content struct {
+	title             string
+	description       string
+	experienceLevel   string
+	employmentType    string
+	location          string
+	workMode          string
+	applicationURL    string
+	remoteEligibility *jobs.RemoteEligibility
+	utcOffsetMin      *int
+	utcOffsetMax      *int
+}
+
+// changedFields lists the JSON names of the fields that differ between two versions
+func (c *content) changedFields(next *content) []string {
+	changed := []string{}
+	for _, field := range []struct {
+		name    string
+		changed bool
+	}{
+		{"title", c.title != next.title},
+		{"description", c.description != next.description},
+		{"experience_level", c.experienceLevel != next.experienceLevel},
+		{"employment_type", c.employmentType != next.employmentType},
+		{"location", c.location != next.location},
+		{"work_mode", c.workMode != next.workMode},
+		{"application_url", c.applicationURL != next.applicationURL},
+		{"remote_eligibility", !equalPtr(c.remoteEligibility, next.remoteEligibility)},
+		{"utc_offset_min", !equalPtr(c.utcOffsetMin, next.utcOffsetMin)},
+		{"utc_offset_max", !equalPtr(c.utcOffsetMax, next.utcOffsetMax)},
+	} {
+		if field.changed {
+			changed = append(changed, field.name)
+		}
+	}
+	return changed
+}
+
+// MapRevisionsToResponse converts the revisions of a job, newest first, to their API response
+// format. Each revision is compared with the one after it, the newest with the current job.
+func MapRevisionsToResponse(job *jobs.Job, revisions []*Revision) *RevisionListResponse {
+	next := jobContent(job)
+	data := make([]*RevisionResponse, 0, len(revisions))
+	for _, revision := range revisions {
+		current := revisionContent(revision)
+
+		var remoteEligibility *string
+		if revision.RemoteEligibility != nil {
+			value := string(*revision.RemoteEligibility)
+			remoteEligibility = &value
+		}
+
+		data = append(data, &RevisionResponse{
+			ID:                revision.ID,
+			Title:             revision.Title,
+			Description:       revision.Description,
+			ExperienceLevel:   revision.ExperienceLevel,
+			EmploymentType:    revision.EmploymentType,
+			Location:          revision.Location,
+			WorkMode:          revision.WorkMode,
+			ApplicationURL:    revision.ApplicationURL,
+			RemoteEligibility: remoteEligibility,
+			UTCOffsetMin:      revision.UTCOffsetMin,
+			UTCOffsetMax:      revision.UTCOffsetMax,
+			ChangedFields:     current.changedFields(next),
+			ValidFrom:         revision.ValidFrom,
+			ReplacedAt:        revision.ReplacedAt,
+			WrittenBy:         revision.WrittenBy,
+			ReplacedBy:        revision.ReplacedBy,
+		})
+		next = current
+	}
+
+	return &RevisionListResponse{JobID: job.ID, Data: data}
+}
+
+func jobContent(job *jobs.Job) *content {
+	return &content{
+		title:             job.Title,
+		description:       job.Description,
+		experienceLevel:   job.ExperienceLevel,
+		employmentType:    job.EmploymentType,
+		location:          job.Location,
+		workMode:          job.WorkMode,
+		applicationURL:    job.ApplicationURL,
+		remoteEligibility: job.RemoteEligibility,
+		utcOffsetMin:      job.UTCOffsetMin,
+		utcOffsetMax:      job.UTCOffsetMax,
+	}
+}
+
+func revisionContent(revision *Revision) *content {
+	return &content{
+		title:             revision.Title,
+		description:       revision.Description,
+		experienceLevel:   revision.ExperienceLevel,
+		employmentType:    revision.EmploymentType,
+		location:          revision.Location,
+		workMode:          revision.WorkMode,
+		applicationURL:    revision.ApplicationURL,
+		remoteEligibility: revision.RemoteEligibility,
+		utcOffsetMin:      revision.UTCOffsetMin,
+		utcOffsetMax:      revision.UTCOffsetMax,
+	}
+}
+
+// equalPtr reports whether two optional values are both unset or set to the same value
+func equalPtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
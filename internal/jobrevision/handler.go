@@ -0,0 +1,60 @@
+package jobrevision
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// Constants for job revision routes and endpoints
+const (
+	JobRevisionsPath = "/jobs/:id/revisions"
+)
+
+// Handler handles HTTP requests for job revision operations
+type Handler struct {
+	service *RevisionService
+}
+
+// NewHandler creates a new job revision handler
+func NewHandler(service *RevisionService) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterAdminRoutes registers the job revision admin routes with the given (protected) router group
+func (h *Handler) RegisterAdminRoutes(rg *gin.RouterGroup) {
+	rg.GET(JobRevisionsPath, h.ListRevisions)
+}
+
+// ListRevisions godoc
+// @Summary List the revisions of a job
+// @Description Lists the previous versions of the content of a job, newest first. Each revision lists
+// @Description the fields changed by the version that replaced it.
+// @Tags admin
+// @Produce json
+// @Security AdminAPIKey
+// @Param id path int true "Job ID"
+// @Success 200 {object} RevisionListResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/jobs/{id}/revisions [get]
+func (h *Handler) ListRevisions(c *gin.Context) {
+	jobID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(&httpservice.ValidationError{Errors: []string{"invalid job id"}})
+		return
+	}
+
+	revisions, err := h.service.List(c.Request.Context(), jobID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, revisions)
+}
@@ -0,0 +1,33 @@
+// Package jobrevision provides access to the previous versions of jobs, saved by the database each
+// time the content of a job changes, to audit and analyse how postings change over time.
+package jobrevision
+
+import (
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+)
+
+// Revision represents the database entity, a previous version of the content of a job
+type Revision struct {
+	ID              int64  `db:"id"`
+	JobID           int    `db:"job_id"`
+	Title           string `db:"title"`
+	Description     string `db:"description"`
+	ExperienceLevel string `db:"experience_level"`
+	EmploymentType  string `db:"employment_type"`
+	Location        string `db:"location"`
+	WorkMode        string `db:"work_mode"`
+	ApplicationURL  string `db:"application_url"`
+	// RemoteEligibility is nil when the version didn't say where applicants could be based
+	RemoteEligibility *jobs.RemoteEligibility `db:"remote_eligibility"`
+	UTCOffsetMin      *int                    `db:"utc_offset_min"`
+	UTCOffsetMax      *int                    `db:"utc_offset_max"`
+	// ValidFrom is when the version was written and ReplacedAt when the next one replaced it
+	ValidFrom  time.Time `db:"valid_from"`
+	ReplacedAt time.Time `db:"replaced_at"`
+	// WrittenBy is the actor who wrote the version and ReplacedBy the one who replaced it, empty when
+	// unknown, see the actor package
+	WrittenBy  string `db:"written_by"`
+	ReplacedBy string `db:"replaced_by"`
+}
@@ -0,0 +1,79 @@
+package jobrevision
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// SQL query constants
+const (
+	// Newest revisions first
+	listRevisionsByJobIDQuery = `
+        SELECT id, job_id, title, description, experience_level, employment_type, location, work_mode,
+               application_url, remote_eligibility, utc_offset_min, utc_offset_max, valid_from, replaced_at,
+               written_by, replaced_by
+        FROM job_revisions
+        WHERE job_id = $1
+        ORDER BY replaced_at DESC, id DESC
+    `
+)
+
+// Database interface to support pgxpool and mocks
+type Database interface {
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+}
+
+// Repository handles database operations for the Revision model. Revisions are written by the
+// database itself when a job is updated, so they are only read here.
+type Repository struct {
+	db Database
+}
+
+// NewRepository creates a new Repository instance.
+func NewRepository(db Database) *Repository {
+	return &Repository{db: db}
+}
+
+// ListByJobID retrieves the revisions of a job, newest first.
+func (r *Repository) ListByJobID(ctx context.Context, jobID int) ([]*Revision, error) {
+	rows, err := r.db.Query(ctx, listRevisionsByJobIDQuery, jobID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list job revisions: %w", err)
+	}
+	defer rows.Close()
+
+	var revisions []*Revision
+	for rows.Next() {
+		revision := &Revision{}
+		err = rows.Scan(
+			&revision.ID,
+			&revision.JobID,
+			&revision.Title,
+			&revision.Description,
+			&revision.ExperienceLevel,
+			&revision.EmploymentType,
+			&revision.Location,
+			&revision.WorkMode,
+			&revision.ApplicationURL,
+			&revision.RemoteEligibility,
+			&revision.UTCOffsetMin,
+			&revision.UTCOffsetMax,
+			&revision.ValidFrom,
+			&revision.ReplacedAt,
+			&revision.WrittenBy,
+			&revision.ReplacedBy,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan job revision row: %w", err)
+		}
+		revisions = append(revisions, revision)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating job revision rows: %w", err)
+	}
+
+	return revisions, nil
+}
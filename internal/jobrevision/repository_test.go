@@ -0,0 +1,87 @@
+package jobrevision
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+)
+
+func TestRepository_ListByJobID(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	dbError := errors.New("database error")
+	latam := jobs.RemoteEligibilityLATAM
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, revisions []*Revision, err error)
+	}{
+		{
+			name: "revisions of the job",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(listRevisionsByJobIDQuery)).
+					WithArgs(12).
+					WillReturnRows(pgxmock.NewRows([]string{
+						"id", "job_id", "title", "description", "experience_level", "employment_type", "location",
+						"work_mode", "application_url", "remote_eligibility", "utc_offset_min", "utc_offset_max",
+						"valid_from", "replaced_at", "written_by", "replaced_by",
+					}).AddRow(
+						int64(31), 12, "Go Developer", "Job description", "Mid-level", "Full-time", "Costa Rica",
+						"Remote", "https://example.com/apply", &latam, nil, nil, now.Add(-time.Hour), now,
+						"cli:ana@db_job_populator", "user:42",
+					))
+			},
+			checkResults: func(t *testing.T, revisions []*Revision, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, revisions, 1)
+				assert.Equal(t, int64(31), revisions[0].ID)
+				assert.Equal(t, "Go Developer", revisions[0].Title)
+				assert.Equal(t, &latam, revisions[0].RemoteEligibility)
+				assert.Nil(t, revisions[0].UTCOffsetMin)
+				assert.Equal(t, "cli:ana@db_job_populator", revisions[0].WrittenBy)
+				assert.Equal(t, "user:42", revisions[0].ReplacedBy)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(listRevisionsByJobIDQuery)).
+					WithArgs(12).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ []*Revision, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			revisions, err := repo.ListByJobID(context.Background(), 12)
+			tt.checkResults(t, revisions, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package jobrevision
+
+import (
+	"context"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+)
+
+// DataRepository interface to read the revisions of jobs.
+type DataRepository interface {
+	ListByJobID(ctx context.Context, jobID int) ([]*Revision, error)
+}
+
+// JobRepository interface to get the current version of a job.
+type JobRepository interface {
+	GetByID(ctx context.Context, id int) (*jobs.Job, error)
+}
+
+// RevisionService holds the business logic for job revisions.
+type RevisionService struct {
+	repo    DataRepository
+	jobRepo JobRepository
+}
+
+// NewRevisionService creates a new instance of RevisionService
+func NewRevisionService(repo DataRepository, jobRepo JobRepository) *RevisionService {
+	return &RevisionService{repo: repo, jobRepo: jobRepo}
+}
+
+// List returns the revisions of a job, newest first, each with the fields changed by the version
+// that replaced it. A jobs.NotFoundError is returned when the job doesn't exist.
+func (s *RevisionService) List(ctx context.Context, jobID int) (*RevisionListResponse, error) {
+	if jobID <= 0 {
+		return nil, &httpservice.ValidationError{Errors: []string{"invalid job id"}}
+	}
+
+	job, err := s.jobRepo.GetByID(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.repo.ListByJobID(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	return MapRevisionsToResponse(job, revisions), nil
+}
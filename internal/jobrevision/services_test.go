@@ -0,0 +1,96 @@
+package jobrevision
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+)
+
+func TestRevisionService_List(t *testing.T) {
+	t.Parallel()
+	job := &jobs.Job{ID: 12, Title: "Senior Go Developer", Description: "New description", WorkMode: "Remote"}
+
+	tests := []struct {
+		name         string
+		jobID        int
+		mockSetup    func(mockRepo *MockDataRepository, mockJobs *MockJobRepository)
+		checkResults func(t *testing.T, resp *RevisionListResponse, err error)
+	}{
+		{
+			name:  "revisions with their changed fields",
+			jobID: 12,
+			mockSetup: func(mockRepo *MockDataRepository, mockJobs *MockJobRepository) {
+				t.Helper()
+				mockJobs.EXPECT().GetByID(context.Background(), 12).Return(job, nil).Once()
+				mockRepo.EXPECT().ListByJobID(context.Background(), 12).Return([]*Revision{
+					{ID: 2, JobID: 12, Title: "Go Developer", Description: "New description", WorkMode: "Remote"},
+					{ID: 1, JobID: 12, Title: "Go Developer", Description: "Old description", WorkMode: "Hybrid"},
+				}, nil).Once()
+			},
+			checkResults: func(t *testing.T, resp *RevisionListResponse, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 12, resp.JobID)
+				require.Len(t, resp.Data, 2)
+				assert.Equal(t, []string{"title"}, resp.Data[0].ChangedFields)
+				assert.Equal(t, []string{"description", "work_mode"}, resp.Data[1].ChangedFields)
+			},
+		},
+		{
+			name:  "job without revisions",
+			jobID: 12,
+			mockSetup: func(mockRepo *MockDataRepository, mockJobs *MockJobRepository) {
+				t.Helper()
+				mockJobs.EXPECT().GetByID(context.Background(), 12).Return(job, nil).Once()
+				mockRepo.EXPECT().ListByJobID(context.Background(), 12).Return(nil, nil).Once()
+			},
+			checkResults: func(t *testing.T, resp *RevisionListResponse, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.NotNil(t, resp.Data)
+				assert.Empty(t, resp.Data)
+			},
+		},
+		{
+			name:  "unknown job",
+			jobID: 99,
+			mockSetup: func(_ *MockDataRepository, mockJobs *MockJobRepository) {
+				t.Helper()
+				mockJobs.EXPECT().GetByID(context.Background(), 99).Return(nil, &jobs.NotFoundError{ID: 99}).Once()
+			},
+			checkResults: func(t *testing.T, _ *RevisionListResponse, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, httpservice.ErrNotFound)
+			},
+		},
+		{
+			name:      "invalid job id",
+			jobID:     0,
+			mockSetup: func(_ *MockDataRepository, _ *MockJobRepository) {},
+			checkResults: func(t *testing.T, _ *RevisionListResponse, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			mockJobs := NewMockJobRepository(t)
+			service := NewRevisionService(mockRepo, mockJobs)
+
+			tt.mockSetup(mockRepo, mockJobs)
+
+			resp, err := service.List(context.Background(), tt.jobID)
+			tt.checkResults(t, resp, err)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package jobs
+
+import (
+	"context"
+)
+
+// MaxDescriptionHashDistance is the number of bits the description hashes of the same opening
+// scraped from different sources may differ by, each source adding its own header and footer or
+// trimming the description
+const MaxDescriptionHashDistance = 8
+
+// CanonicalRepository interface to look up the jobs a new job may be an alternate of.
+type CanonicalRepository interface {
+	ListCanonicalCandidates(ctx context.Context, job *Job) ([]*CanonicalCandidate, error)
+}
+
+// CanonicalMatcher groups the same opening scraped from several sources. The first job stored is the
+// canonical one, served by the public search, and the jobs found later at other sources are stored
+// as its alternates.
+type CanonicalMatcher struct {
+	repo CanonicalRepository
+}
+
+// NewCanonicalMatcher creates a new instance of CanonicalMatcher
+func NewCanonicalMatcher(repo CanonicalRepository) *CanonicalMatcher {
+	return &CanonicalMatcher{repo: repo}
+}
+
+// Match sets the CanonicalJobID of a job about to be created to the published job of the same
+// company with the same title, from another source, whose description is the closest to its own.
+// Descriptions are compared by their hashes, see ComputeDescriptionHash. Jobs matching none are
+// canonical and left without a CanonicalJobID.
+func (m *CanonicalMatcher) Match(ctx context.Context, job *Job) error {
+	candidates, err := m.repo.ListCanonicalCandidates(ctx, job)
+	if err != nil {
+		return err
+	}
+
+	// Candidates are the oldest first, which wins ties
+	hash := ComputeDescriptionHash(job.RawDescription)
+	var match *CanonicalCandidate
+	bestDistance := MaxDescriptionHashDistance + 1
+	for _, candidate := range candidates {
+		distance := DescriptionHashDistance(hash, ComputeDescriptionHash(candidate.RawDescription))
+		if distance < bestDistance {
+			match, bestDistance = candidate, distance
+		}
+	}
+
+	if match != nil {
+		job.CanonicalJobID = &match.JobID
+	}
+	return nil
+}
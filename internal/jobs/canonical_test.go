@@ -0,0 +1,103 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalMatcher_Match(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+	description := "We are looking for a Senior Backend Engineer to join our payments team. You will design, " +
+		"build and operate the services that move money for millions of customers across Latin America, " +
+		"review code, mentor junior engineers and take part in the on-call rotation. Requirements: 5+ years " +
+		"of professional experience building backend systems, strong knowledge of Go, experience with " +
+		"PostgreSQL, Redis and Kafka, and fluent English."
+	other := "Buscamos una persona analista de datos para el equipo de finanzas. Manejo de SQL, Power BI y " +
+		"Excel avanzado, con inglés intermedio. Ofrecemos seguro médico y horario flexible."
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mockRepo *MockCanonicalRepository, job *Job)
+		checkResults func(t *testing.T, job *Job, err error)
+	}{
+		{
+			name: "closest description matched",
+			mockSetup: func(mockRepo *MockCanonicalRepository, job *Job) {
+				t.Helper()
+				mockRepo.EXPECT().ListCanonicalCandidates(context.Background(), job).
+					Return([]*CanonicalCandidate{
+						{JobID: 3, RawDescription: other},
+						{JobID: 5, RawDescription: "<p>" + description + "</p><p>Apply on our careers page.</p>"},
+						{JobID: 8, RawDescription: description},
+					}, nil).Once()
+			},
+			checkResults: func(t *testing.T, job *Job, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, intPtr(8), job.CanonicalJobID)
+			},
+		},
+		{
+			name: "oldest candidate wins ties",
+			mockSetup: func(mockRepo *MockCanonicalRepository, job *Job) {
+				t.Helper()
+				mockRepo.EXPECT().ListCanonicalCandidates(context.Background(), job).
+					Return([]*CanonicalCandidate{
+						{JobID: 5, RawDescription: description},
+						{JobID: 8, RawDescription: description},
+					}, nil).Once()
+			},
+			checkResults: func(t *testing.T, job *Job, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, intPtr(5), job.CanonicalJobID)
+			},
+		},
+		{
+			name: "no similar description",
+			mockSetup: func(mockRepo *MockCanonicalRepository, job *Job) {
+				t.Helper()
+				mockRepo.EXPECT().ListCanonicalCandidates(context.Background(), job).
+					Return([]*CanonicalCandidate{{JobID: 3, RawDescription: other}}, nil).Once()
+			},
+			checkResults: func(t *testing.T, job *Job, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Nil(t, job.CanonicalJobID)
+			},
+		},
+		{
+			name: "repository error",
+			mockSetup: func(mockRepo *MockCanonicalRepository, job *Job) {
+				t.Helper()
+				mockRepo.EXPECT().ListCanonicalCandidates(context.Background(), job).
+					Return(nil, dbError).Once()
+			},
+			checkResults: func(t *testing.T, job *Job, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+				assert.Nil(t, job.CanonicalJobID)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockCanonicalRepository(t)
+			matcher := NewCanonicalMatcher(mockRepo)
+			source := SourceGreenhouse
+			job := &Job{CompanyID: 1, Title: "Senior Backend Engineer", RawDescription: description, Source: &source}
+
+			tt.mockSetup(mockRepo, job)
+
+			err := matcher.Match(context.Background(), job)
+			tt.checkResults(t, job, err)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package jobs
+
+import (
+	"bytes"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	goldmarkhtml "github.com/yuin/goldmark/renderer/html"
+)
+
+var (
+	// htmlTagPattern matches the tags that make a description HTML rather than Markdown or plain
+	// text, which may still contain a stray "<", e.g. "salary < $3000"
+	htmlTagPattern = regexp.MustCompile(
+		`(?i)<(p|br|div|span|ul|ol|li|h[1-6]|strong|b|em|i|u|a|table|tr|td|blockquote|pre|code)\b[^>]*>`)
+
+	// topHeadingPattern matches the tags of the top-level headings, which are reserved for the page
+	topHeadingPattern = regexp.MustCompile(`(?i)<(/?)h[12]\b`)
+
+	// emptyBlockPattern matches the blocks left without content, common in scraped pages
+	emptyBlockPattern = regexp.MustCompile(`<(p|li|h[3-6])>(\s|\x{00a0}|&nbsp;|&#160;|<br/?>)*</(p|li|h[3-6])>`)
+
+	// blankLinesPattern matches the whitespace between blocks
+	blankLinesPattern = regexp.MustCompile(`\n\s*\n+`)
+
+	// markdown renders Markdown, including tables and task lists, with line breaks kept, so plain
+	// text keeps its lines. Inline HTML is rendered as it is, to be sanitized with the rest.
+	markdown = goldmark.New(
+		goldmark.WithExtensions(extension.GFM),
+		goldmark.WithRendererOptions(goldmarkhtml.WithHardWraps(), goldmarkhtml.WithUnsafe()),
+	)
+
+	// descriptionPolicy allows the markup of a formatted job description. Links open in a new tab
+	// without passing on any ranking, and everything else, like scripts, styles and images, is
+	// removed, keeping the text of the removed elements that have some.
+	descriptionPolicy = newDescriptionPolicy()
+)
+
+// newDescriptionPolicy creates the sanitization policy of the job descriptions
+func newDescriptionPolicy() *bluemonday.Policy {
+	policy := bluemonday.NewPolicy()
+	policy.AllowElements("p", "br", "hr", "ul", "ol", "li", "strong", "b", "em", "i", "u", "s", "del",
+		"blockquote", "pre", "code", "h3", "h4", "h5", "h6", "table", "thead", "tbody", "tr", "th", "td")
+	policy.AllowStandardURLs()
+	policy.AllowAttrs("href").OnElements("a")
+	policy.RequireNoFollowOnLinks(true)
+	policy.AddTargetBlankToFullyQualifiedLinks(true)
+	return policy
+}
+
+// SanitizeDescription returns the HTML served for a raw job description. HTML descriptions are
+// sanitized, and Markdown or plain text ones are rendered to HTML first. Top-level headings are
+// demoted, and empty blocks and blank lines dropped, so descriptions scraped from different sites
+// look alike.
+func SanitizeDescription(raw string) string {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return ""
+	}
+
+	source := raw
+	if !htmlTagPattern.MatchString(raw) {
+		var rendered bytes.Buffer
+		if err := markdown.Convert([]byte(raw), &rendered); err != nil {
+			// Only failing writes make rendering fail, never expected of a buffer
+			rendered.Reset()
+			rendered.WriteString("<p>" + html.EscapeString(raw) + "</p>")
+		}
+		source = rendered.String()
+	}
+
+	source = topHeadingPattern.ReplaceAllString(source, "<${1}h3")
+	sanitized := descriptionPolicy.Sanitize(source)
+	sanitized = emptyBlockPattern.ReplaceAllString(sanitized, "")
+	sanitized = blankLinesPattern.ReplaceAllString(sanitized, "\n")
+	return strings.TrimSpace(sanitized)
+}
+
+// sanitizeDescription sets the Description of job sanitized from its RawDescription. Jobs without
+// a RawDescription take their Description as raw.
+func (job *Job) sanitizeDescription() {
+	if job.RawDescription == "" {
+		job.RawDescription = job.Description
+	}
+	job.Description = SanitizeDescription(job.RawDescription)
+}
@@ -0,0 +1,63 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitizeDescription(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		raw      string
+		expected string
+	}{
+		{
+			name:     "empty description",
+			raw:      "  \n ",
+			expected: "",
+		},
+		{
+			name:     "plain text keeps its lines",
+			raw:      "We are hiring.\nJoin us!",
+			expected: "<p>We are hiring.<br>\nJoin us!</p>",
+		},
+		{
+			name:     "plain text with a stray less-than sign",
+			raw:      "Salary < $3000",
+			expected: "<p>Salary &lt; $3000</p>",
+		},
+		{
+			name:     "markdown rendered",
+			raw:      "## Requirements\n\n- **Go**\n- SQL",
+			expected: "<h3>Requirements</h3>\n<ul>\n<li><strong>Go</strong></li>\n<li>SQL</li>\n</ul>",
+		},
+		{
+			name:     "html sanitized",
+			raw:      `<h1>About</h1><p style="color:red">Hi<script>alert(1)</script></p><img src="x.png"><p>&nbsp;</p>`,
+			expected: "<h3>About</h3><p>Hi</p>",
+		},
+		{
+			name:     "links open in a new tab",
+			raw:      `<p><a href="https://techcorp.com" onclick="steal()">Apply</a></p>`,
+			expected: `<p><a href="https://techcorp.com" rel="nofollow noopener" target="_blank">Apply</a></p>`,
+		},
+		{
+			name:     "script links removed",
+			raw:      `<p><a href="javascript:alert(1)">Apply</a></p>`,
+			expected: "<p>Apply</p>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			result := SanitizeDescription(tt.raw)
+			assert.Equal(t, tt.expected, result)
+			// Sanitized descriptions are HTML that is sanitized again unchanged
+			assert.Equal(t, result, SanitizeDescription(result))
+		})
+	}
+}
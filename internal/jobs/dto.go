@@ -1,12 +1,13 @@
 package jobs
 
 import (
-	"fmt"
+	"errors"
 	"slices"
 	"strings"
 	"time"
 
 	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+	"github.com/rodruizronald/ticos-in-tech/internal/location"
 )
 
 // Constants for job attributes and values
@@ -28,50 +29,46 @@ const (
 	employmentTypeTemporary  = "Temporary"
 	employmentTypeInternship = "Internship"
 
-	// Locations
-	locationCostaRica = "Costa Rica"
-	locationLATAM     = "LATAM"
-
 	// Work modes
 	workModeRemote = "Remote"
 	workModeHybrid = "Hybrid"
 	workModeOnsite = "Onsite"
 )
 
-// Validation collections for job attributes and values
+// Validation collections for job attributes and values. The experience levels, employment types,
+// locations and work modes are reference values, see the reference package.
 var (
-	validExperienceLevels = []string{
-		experienceLevelEntry,
-		experienceLevelJunior,
-		experienceLevelMid,
-		experienceLevelSenior,
-		experienceLevelLead,
-		experienceLevelPrincipal,
-		experienceLevelExecutive,
-	}
-	validEmploymentTypes = []string{
-		employmentTypeFullTime,
-		employmentTypePartTime,
-		employmentTypeContract,
-		employmentTypeFreelance,
-		employmentTypeTemporary,
-		employmentTypeInternship,
+	validLanguages = []string{
+		string(LanguageEnglish),
+		string(LanguageSpanish),
 	}
-	validLocations = []string{
-		locationCostaRica,
-		locationLATAM,
-	}
-	validWorkModes = []string{
-		workModeRemote,
-		workModeHybrid,
-		workModeOnsite,
+	validRemoteEligibilities = []string{
+		string(RemoteEligibilityCostaRica),
+		string(RemoteEligibilityLATAM),
+		string(RemoteEligibilityWorldwide),
 	}
 )
 
+// Fields of JobResponse that can be selected with the fields query parameter
+var jobFields = []string{
+	"job_id", "company_slug", "company_name", "company_logo_url", "title", "description", "experience_level",
+	"experience_level_label", "employment_type", "employment_type_label", "location", "work_mode", "work_mode_label",
+	"language", "remote_eligibility", "utc_offset_min", "utc_offset_max", "application_url", "technologies", "benefits",
+	"posted_at", "highlight",
+}
+
+// Fields of SimilarJobResponse that can be selected with the fields query parameter
+var similarJobFields = append(slices.Clone(jobFields), "shared_technologies")
+
 // Constants for search query validation limits
 const (
 	MaxQueryLength = 100 // Maximum characters for search query
 	MinQueryLength = 2   // Minimum meaningful search length
+
+	MaxFunctionLength = 50 // Maximum characters for a job function name
+
+	MaxBenefits      = 10 // Maximum benefits a search can require
+	MaxBenefitLength = 50 // Maximum characters for a benefit slug
 )
 
 // Data Transfer Objects (DTOs) for the job API layer.
@@ -81,16 +78,27 @@ const (
 
 // SearchRequest represents the search request parameters (API layer)
 type SearchRequest struct {
-	Query           string `form:"q" binding:"required" example:"golang developer"`
+	Query           string `form:"q" label:"search query" binding:"required,search_query" example:"golang developer"`
 	Limit           int    `form:"limit" example:"20"`
 	Offset          int    `form:"offset" example:"0"`
-	ExperienceLevel string `form:"experience_level" example:"Senior"`
-	EmploymentType  string `form:"employment_type" example:"Full-time"`
-	Location        string `form:"location" example:"Costa Rica"`
-	WorkMode        string `form:"work_mode" example:"Remote"`
+	ExperienceLevel string `form:"experience_level" binding:"omitempty,experience_level" example:"Senior"`
+	EmploymentType  string `form:"employment_type" binding:"omitempty,employment_type" example:"Full-time"`
+	Location        string `form:"location" binding:"omitempty,location" example:"Costa Rica"`
+	Province        string `form:"province" binding:"omitempty,province" example:"Heredia"`
+	WorkMode        string `form:"work_mode" binding:"omitempty,work_mode" example:"Remote"`
 	Company         string `form:"company" example:"Tech Corp"`
-	DateFrom        string `form:"date_from" example:"2024-01-01"`
-	DateTo          string `form:"date_to" example:"2024-12-31"`
+	Function        string `form:"function" binding:"omitempty,max=50" example:"Backend"`
+	Language        string `form:"language" binding:"omitempty,language" example:"es"`
+	// RemoteEligibility and UTCOffset narrow remote jobs down to the ones an applicant can work
+	RemoteEligibility string `form:"remote_eligibility" binding:"omitempty,remote_eligibility" example:"LATAM only"`
+	UTCOffset         *int   `form:"utc_offset" binding:"omitempty,min=-12,max=14" example:"-6"`
+	Benefits          string `form:"benefits" binding:"omitempty,benefits" example:"health-insurance,stock-options"`
+	DateFrom          string `form:"date_from" binding:"required_with=DateTo,date,date_lte=DateTo" example:"2024-01-01"`
+	DateTo            string `form:"date_to" binding:"required_with=DateFrom,date" example:"2024-12-31"`
+	Fields            string `form:"fields" example:"job_id,title,company_name,application_url"`
+	Highlight         bool   `form:"highlight" example:"true"`
+	// Sort orders the jobs by relevance, the default, or newest first
+	Sort string `form:"sort" binding:"omitempty,oneof=relevance date" example:"relevance"`
 }
 
 // ToSearchParams converts a SearchRequest to SearchParams
@@ -104,10 +112,18 @@ func (req *SearchRequest) ToSearchParams() (httpservice.SearchParams, error) {
 
 	offset := max(req.Offset, 0) // Min offset to prevent negative pagination
 
+	fields, err := httpservice.ParseFields(req.Fields, jobFields)
+	if err != nil {
+		return nil, &httpservice.ValidationError{Errors: []string{err.Error()}}
+	}
+
 	searchParams := &SearchParams{
-		Query:  req.Query,
-		Limit:  limit,
-		Offset: offset,
+		Query:     req.Query,
+		Limit:     limit,
+		Offset:    offset,
+		Fields:    fields,
+		Highlight: req.Highlight,
+		Sort:      SortOrder(req.Sort),
 	}
 
 	// Set optional filters
@@ -120,16 +136,34 @@ func (req *SearchRequest) ToSearchParams() (httpservice.SearchParams, error) {
 	if req.Location != "" {
 		searchParams.Location = &req.Location
 	}
+	if req.Province != "" {
+		// Provinces are matched by their canonical name, "san jose" searches "San José"
+		province := location.ProvinceOf(req.Province)
+		searchParams.Province = &province
+	}
 	if req.WorkMode != "" {
 		searchParams.WorkMode = &req.WorkMode
 	}
 	if req.Company != "" {
 		searchParams.Company = &req.Company
 	}
+	if req.Function != "" {
+		searchParams.Function = &req.Function
+	}
+	if req.Language != "" {
+		searchParams.Language = &req.Language
+	}
+	if req.RemoteEligibility != "" {
+		searchParams.RemoteEligibility = &req.RemoteEligibility
+	}
+	searchParams.UTCOffset = req.UTCOffset
+	if benefits, ok := parseBenefits(req.Benefits); ok {
+		searchParams.Benefits = benefits
+	}
 
 	// Parse dates if provided
 	if req.DateFrom != "" && req.DateTo != "" {
-		dateFrom, err := time.Parse("2006-01-02", req.DateFrom)
+		dateFrom, err := time.Parse(httpservice.DateLayout, req.DateFrom)
 		if err != nil {
 			return nil, &httpservice.ConversionError{
 				Field: "date_from",
@@ -137,7 +171,7 @@ func (req *SearchRequest) ToSearchParams() (httpservice.SearchParams, error) {
 				Err:   err,
 			}
 		}
-		dateTo, err := time.Parse("2006-01-02", req.DateTo)
+		dateTo, err := time.Parse(httpservice.DateLayout, req.DateTo)
 		if err != nil {
 			return nil, &httpservice.ConversionError{
 				Field: "date_to",
@@ -152,132 +186,277 @@ func (req *SearchRequest) ToSearchParams() (httpservice.SearchParams, error) {
 	return searchParams, nil
 }
 
-// Validate validates the search request parameters
+// Validate validates the search request parameters against their binding tags and the selected fields
 func (req *SearchRequest) Validate() error {
-	var errors []string
-
-	// Validate query
-	req.validateQuery(&errors)
-
-	// Validate enum fields
-	req.validateEnumFields(&errors)
+	var messages []string
+	if err := httpservice.Validate(req); err != nil {
+		var validationErr *httpservice.ValidationError
+		if !errors.As(err, &validationErr) {
+			return err
+		}
+		messages = validationErr.Errors
+	}
 
-	// Validate date range
-	req.validateDateRange(&errors)
+	if _, err := httpservice.ParseFields(req.Fields, jobFields); err != nil {
+		messages = append(messages, err.Error())
+	}
 
-	if len(errors) > 0 {
-		return &httpservice.ValidationError{Errors: errors}
+	if len(messages) > 0 {
+		return &httpservice.ValidationError{Errors: messages}
 	}
 
 	return nil
 }
 
-// validateQuery validates the search query parameter
-func (req *SearchRequest) validateQuery(errors *[]string) {
-	trimmedQuery := strings.TrimSpace(req.Query)
-	if trimmedQuery == "" {
-		*errors = append(*errors, "search query cannot be empty")
-		return
-	}
+// NearDuplicateRequest represents the query parameters to review near-duplicate jobs (API layer)
+type NearDuplicateRequest struct {
+	WindowDays         int     `form:"window_days" binding:"omitempty,min=1,max=365" example:"30"`
+	MinTitleSimilarity float64 `form:"min_title_similarity" binding:"omitempty,gt=0,lte=1" example:"0.8"`
+	MinURLSimilarity   float64 `form:"min_url_similarity" binding:"omitempty,gt=0,lte=1" example:"0.9"`
+	Limit              int     `form:"limit" binding:"omitempty,min=1,max=500" example:"100"`
+}
 
-	// Validate query length
-	if len(trimmedQuery) < MinQueryLength {
-		*errors = append(*errors, fmt.Sprintf("search query must be at least %d characters", MinQueryLength))
-	}
-	if len(trimmedQuery) > MaxQueryLength {
-		*errors = append(*errors, fmt.Sprintf("search query cannot exceed %d characters", MaxQueryLength))
+// ToNearDuplicateParams converts a NearDuplicateRequest to NearDuplicateParams
+func (req *NearDuplicateRequest) ToNearDuplicateParams() NearDuplicateParams {
+	return NearDuplicateParams{
+		Window:             time.Duration(req.WindowDays) * 24 * time.Hour,
+		MinTitleSimilarity: req.MinTitleSimilarity,
+		MinURLSimilarity:   req.MinURLSimilarity,
+		Limit:              req.Limit,
 	}
+}
 
-	// Validate for potentially malicious patterns
-	if containsSuspiciousPatterns(trimmedQuery) {
-		*errors = append(*errors, "search query contains invalid characters")
-	}
+// SimilarRequest represents the query parameters of the similar jobs (API layer)
+type SimilarRequest struct {
+	Limit              int    `form:"limit" binding:"omitempty,min=1,max=20" example:"5"`
+	ExcludeSameCompany bool   `form:"exclude_same_company" example:"false"`
+	Fields             string `form:"fields" example:"job_id,title,shared_technologies"`
 }
 
-// validateEnumFields validates enum field values
-func (req *SearchRequest) validateEnumFields(errors *[]string) {
-	if req.ExperienceLevel != "" && !slices.Contains(validExperienceLevels, req.ExperienceLevel) {
-		*errors = append(*errors, "invalid value for field: 'experience_level'")
+// ToSimilarParams converts a SimilarRequest to SimilarParams for the given job
+func (req *SimilarRequest) ToSimilarParams(jobID int) SimilarParams {
+	return SimilarParams{
+		JobID:              jobID,
+		ExcludeSameCompany: req.ExcludeSameCompany,
+		Limit:              req.Limit,
 	}
+}
 
-	if req.EmploymentType != "" && !slices.Contains(validEmploymentTypes, req.EmploymentType) {
-		*errors = append(*errors, "invalid value for field: 'employment_type'")
-	}
+// ModerationListRequest represents the query parameters of the moderation queue (API layer)
+type ModerationListRequest struct {
+	Status string `form:"status" binding:"omitempty,oneof=pending published rejected" example:"pending"`
+	Source string `form:"source" binding:"omitempty,oneof=linkedin greenhouse company_site employer_portal" example:"linkedin"`
+	// The other filters restrict the jobs when set
+	CompanyID *int  `form:"company_id" binding:"omitempty,min=1" example:"2"`
+	IsActive  *bool `form:"is_active" example:"true"`
+	// CreatedFrom and CreatedTo are dates (YYYY-MM-DD) bounding the creation of the jobs, both included
+	CreatedFrom *time.Time `form:"created_from" time_format:"2006-01-02" time_utc:"1" example:"2024-01-01"`
+	CreatedTo   *time.Time `form:"created_to" time_format:"2006-01-02" time_utc:"1" example:"2024-01-31"`
+	Limit       int        `form:"limit" binding:"omitempty,min=1,max=100" example:"20"`
+	Offset      int        `form:"offset" binding:"omitempty,min=0" example:"0"`
+}
 
-	if req.Location != "" && !slices.Contains(validLocations, req.Location) {
-		*errors = append(*errors, "invalid value for field: 'location'")
+// ToStatusListParams converts a ModerationListRequest to StatusListParams
+func (req *ModerationListRequest) ToStatusListParams() StatusListParams {
+	params := StatusListParams{
+		Status:      Status(req.Status),
+		Source:      Source(req.Source),
+		CompanyID:   req.CompanyID,
+		IsActive:    req.IsActive,
+		CreatedFrom: req.CreatedFrom,
+		Limit:       req.Limit,
+		Offset:      req.Offset,
 	}
-
-	if req.WorkMode != "" && !slices.Contains(validWorkModes, req.WorkMode) {
-		*errors = append(*errors, "invalid value for field: 'work_mode'")
+	if req.CreatedTo != nil {
+		// The jobs of the whole day are included
+		before := req.CreatedTo.AddDate(0, 0, 1)
+		params.CreatedBefore = &before
 	}
+	return params
 }
 
-// validateDateRange validates date range parameters
-func (req *SearchRequest) validateDateRange(errors *[]string) {
-	hasDateFrom := req.DateFrom != ""
-	hasDateTo := req.DateTo != ""
-
-	if hasDateFrom != hasDateTo {
-		*errors = append(*errors, "both date_from and date_to must be provided together")
-		return
-	}
+// RejectRequest represents the request body to reject a pending job
+type RejectRequest struct {
+	Reason string `json:"reason" binding:"required" example:"Posting is a recruiting agency ad"`
+}
 
-	// Validate date format if provided
-	if hasDateFrom && hasDateTo {
-		dateFrom, dateFromErr := time.Parse("2006-01-02", req.DateFrom)
-		if dateFromErr != nil {
-			*errors = append(*errors, "date_from must be in YYYY-MM-DD format")
-		}
+// JobPatchRequest represents the request body to change some fields of a job (API layer). Omitted
+// fields are left as they are.
+type JobPatchRequest struct {
+	Title             *string `json:"title" binding:"omitempty,notblank,max=255" example:"Senior Go Developer"`
+	Description       *string `json:"description" binding:"omitempty,notblank" example:"We are looking for a Go developer..."`
+	ExperienceLevel   *string `json:"experience_level" binding:"omitempty,experience_level" example:"Senior"`
+	EmploymentType    *string `json:"employment_type" binding:"omitempty,employment_type" example:"Full-time"`
+	WorkMode          *string `json:"work_mode" binding:"omitempty,work_mode" example:"Remote"`
+	ApplicationURL    *string `json:"application_url" binding:"omitempty,url,max=255" example:"https://techcorp.com/careers/go-developer"`
+	Language          *string `json:"language" binding:"omitempty,language" example:"en"`
+	RemoteEligibility *string `json:"remote_eligibility" binding:"omitempty,remote_eligibility" example:"LATAM only"`
+	// UTCOffsetMin and UTCOffsetMax are changed together
+	UTCOffsetMin *int `json:"utc_offset_min" binding:"required_with=UTCOffsetMax,omitempty,min=-12,max=14" example:"-6"`
+	UTCOffsetMax *int `json:"utc_offset_max" binding:"required_with=UTCOffsetMin,omitempty,min=-12,max=14" example:"-3"`
+	// IsFeatured promotes the job in the relevance ranking of the search
+	IsFeatured *bool `json:"is_featured" example:"true"`
+}
 
-		dateTo, dateToErr := time.Parse("2006-01-02", req.DateTo)
-		if dateToErr != nil {
-			*errors = append(*errors, "date_to must be in YYYY-MM-DD format")
-		}
+// ToJobPatch converts a JobPatchRequest to a JobPatch, trimming the text fields
+func (req *JobPatchRequest) ToJobPatch() *JobPatch {
+	patch := &JobPatch{
+		Title:           trimmed(req.Title),
+		Description:     trimmed(req.Description),
+		ExperienceLevel: req.ExperienceLevel,
+		EmploymentType:  req.EmploymentType,
+		WorkMode:        req.WorkMode,
+		ApplicationURL:  trimmed(req.ApplicationURL),
+		UTCOffsetMin:    req.UTCOffsetMin,
+		UTCOffsetMax:    req.UTCOffsetMax,
+		IsFeatured:      req.IsFeatured,
+	}
+	if req.Language != nil {
+		language := Language(*req.Language)
+		patch.Language = &language
+	}
+	if req.RemoteEligibility != nil {
+		eligibility := RemoteEligibility(*req.RemoteEligibility)
+		patch.RemoteEligibility = &eligibility
+	}
+	return patch
+}
 
-		// Check date range if both dates are valid
-		if dateFromErr == nil && dateToErr == nil && dateFrom.After(dateTo) {
-			*errors = append(*errors, "date_from cannot be after date_to")
-		}
+// trimmed returns the value without its surrounding whitespace, nil when value is nil
+func trimmed(value *string) *string {
+	if value == nil {
+		return nil
 	}
+	trimmedValue := strings.TrimSpace(*value)
+	return &trimmedValue
 }
 
 // JobResponse represents the API response for a single job
 type JobResponse struct {
-	ID              int                  `json:"job_id"`
-	CompanyID       int                  `json:"company_id"`
-	CompanyName     string               `json:"company_name"`
-	CompanyLogoURL  string               `json:"company_logo_url"`
-	Title           string               `json:"title"`
-	Description     string               `json:"description"`
-	ExperienceLevel string               `json:"experience_level"`
-	EmploymentType  string               `json:"employment_type"`
-	Location        string               `json:"location"`
-	WorkMode        string               `json:"work_mode"`
-	ApplicationURL  string               `json:"application_url"`
-	Technologies    []TechnologyResponse `json:"technologies"`
-	PostedAt        time.Time            `json:"posted_at"`
+	ID             int    `json:"job_id"`
+	CompanySlug    string `json:"company_slug"`
+	CompanyName    string `json:"company_name"`
+	CompanyLogoURL string `json:"company_logo_url"`
+	Title          string `json:"title"`
+	Description    string `json:"description"`
+	// ExperienceLevel, EmploymentType and WorkMode are canonical values, their labels are in the
+	// language of the Accept-Language header, English or Spanish
+	ExperienceLevel      string `json:"experience_level" example:"Mid-level"`
+	ExperienceLevelLabel string `json:"experience_level_label" example:"Intermedio"`
+	EmploymentType       string `json:"employment_type" example:"Full-time"`
+	EmploymentTypeLabel  string `json:"employment_type_label" example:"Tiempo completo"`
+	Location             string `json:"location"`
+	WorkMode             string `json:"work_mode" example:"Onsite"`
+	WorkModeLabel        string `json:"work_mode_label" example:"Presencial"`
+	Language             string `json:"language" example:"es"`
+	// RemoteEligibility and the UTC offsets are omitted when the posting doesn't say
+	RemoteEligibility string               `json:"remote_eligibility,omitempty" example:"LATAM only"`
+	UTCOffsetMin      *int                 `json:"utc_offset_min,omitempty" example:"-6"`
+	UTCOffsetMax      *int                 `json:"utc_offset_max,omitempty" example:"-3"`
+	ApplicationURL    string               `json:"application_url"`
+	Technologies      []TechnologyResponse `json:"technologies"`
+	// Benefits are the slugs of the benefits offered with the job, see /benefits
+	Benefits []string  `json:"benefits" example:"health-insurance,stock-options"`
+	PostedAt time.Time `json:"posted_at"`
+	// Highlight holds snippets of the description with the matched terms between <mark> tags,
+	// only returned by searches with highlight=true
+	Highlight string `json:"highlight,omitempty" example:"Backend <mark>Go</mark> developer ... <mark>golang</mark> services"`
 }
 
 // TechnologyResponse represents the API response for job technologies
 type TechnologyResponse struct {
 	Name     string `json:"name"`
 	Category string `json:"category"`
-	Required bool   `json:"required"`
+	// Primary is set for the technologies the job is built around, e.g. Go for a Go developer
+	Primary  bool `json:"primary"`
+	Required bool `json:"required"`
+}
+
+// SimilarJobResponse represents the API response for a job similar to another one
+type SimilarJobResponse struct {
+	JobResponse
+	SharedTechnologies int `json:"shared_technologies" example:"3"`
+}
+
+// SimilarJobListResponse represents the API response listing similar jobs
+type SimilarJobListResponse struct {
+	Data []*SimilarJobResponse `json:"data"`
+}
+
+// ModerationJobResponse represents the API response for a job of the moderation queue
+type ModerationJobResponse struct {
+	JobResponse
+	Status          string     `json:"status" example:"pending"`
+	RejectionReason string     `json:"rejection_reason,omitempty" example:"Posting is a recruiting agency ad"`
+	ReviewedAt      *time.Time `json:"reviewed_at,omitempty"`
+	// ReviewedBy is who last reviewed the job as kind:id, omitted when unknown
+	ReviewedBy string `json:"reviewed_by,omitempty" example:"user:42"`
+	// Source is where the job was scraped from and SourceJobID its ID there, omitted when unknown
+	Source      string `json:"source,omitempty" example:"linkedin"`
+	SourceJobID string `json:"source_job_id,omitempty" example:"3912345678"`
+	// CanonicalJobID is the job this one is an alternate of, the same opening found at another source.
+	// Alternates are left out of the public search, and canonical jobs omit it.
+	CanonicalJobID *int `json:"canonical_job_id,omitempty" example:"42"`
+	// ApplicationURLCheck and CompanyLogoCheck are omitted until the links are checked
+	ApplicationURLCheck *LinkStatusResponse `json:"application_url_check,omitempty"`
+	CompanyLogoCheck    *LinkStatusResponse `json:"company_logo_check,omitempty"`
+}
+
+// LinkStatusResponse represents the last check of a link in API responses
+type LinkStatusResponse struct {
+	OK         bool      `json:"ok" example:"false"`
+	StatusCode int       `json:"status_code,omitempty" example:"404"`
+	Error      string    `json:"error,omitempty" example:"responded with status 404"`
+	CheckedAt  time.Time `json:"checked_at" example:"2024-01-15T06:00:00Z"`
+}
+
+// ModerationListResponse represents the API response listing the jobs of the moderation queue
+type ModerationListResponse struct {
+	Data       []*ModerationJobResponse `json:"data"`
+	Pagination PaginationDetails        `json:"pagination"`
+}
+
+// DuplicateJobResponse represents one of the jobs of a near-duplicate pair
+type DuplicateJobResponse struct {
+	ID             int       `json:"job_id" example:"12"`
+	Title          string    `json:"title" example:"Senior Go Developer"`
+	ApplicationURL string    `json:"application_url" example:"https://techcorp.com/jobs/12"`
+	Source         string    `json:"source,omitempty" example:"greenhouse"`
+	PostedAt       time.Time `json:"posted_at"`
+}
+
+// NearDuplicateResponse represents the API response for a pair of near-duplicate jobs
+type NearDuplicateResponse struct {
+	CompanyName     string                  `json:"company_name" example:"Tech Corp"`
+	Jobs            []*DuplicateJobResponse `json:"jobs"`
+	TitleSimilarity float64                 `json:"title_similarity" example:"0.86"`
+	URLSimilarity   float64                 `json:"url_similarity" example:"0.42"`
+}
+
+// NearDuplicateListResponse represents the API response listing near-duplicate jobs
+type NearDuplicateListResponse struct {
+	Data []*NearDuplicateResponse `json:"data"`
 }
 
 // SearchResponse represents the search response with pagination
 type SearchResponse struct {
 	Data       []*JobResponse    `json:"data"`
 	Pagination PaginationDetails `json:"pagination"`
+	// DidYouMean is a corrected query suggested when the search finds nothing
+	DidYouMean string `json:"did_you_mean,omitempty" example:"kubernetes engineer"`
 }
 
 // PaginationDetails contains pagination metadata
 type PaginationDetails struct {
-	Total   int  `json:"total"`
-	Limit   int  `json:"limit"`
-	Offset  int  `json:"offset"`
-	HasMore bool `json:"has_more"`
+	Total       int  `json:"total"`
+	Limit       int  `json:"limit"`
+	Offset      int  `json:"offset"`
+	HasMore     bool `json:"has_more"`
+	TotalPages  int  `json:"total_pages" example:"3"`
+	CurrentPage int  `json:"current_page" example:"1"`
+	// Next and Prev are the URLs of the next and previous pages, omitted on the last and first pages
+	Next string `json:"next,omitempty" example:"/api/v1/jobs?limit=20&offset=20&q=golang"`
+	Prev string `json:"prev,omitempty" example:"/api/v1/jobs?limit=20&offset=0&q=golang"`
 }
 
 // ErrorResponse represents an API error response
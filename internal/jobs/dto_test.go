@@ -22,16 +22,19 @@ func TestSearchRequest_ToSearchParams(t *testing.T) {
 		{
 			name: "successful conversion with all fields",
 			request: &SearchRequest{
-				Query:           "golang developer",
-				Limit:           25,
-				Offset:          10,
-				ExperienceLevel: "Senior",
-				EmploymentType:  "Full-Time",
-				Location:        "Costa Rica",
-				WorkMode:        "Remote",
-				Company:         "Tech Corp",
-				DateFrom:        "2024-01-01",
-				DateTo:          "2024-12-31",
+				Query:             "golang developer",
+				Limit:             25,
+				Offset:            10,
+				ExperienceLevel:   "Senior",
+				EmploymentType:    "Full-Time",
+				Location:          "Costa Rica",
+				WorkMode:          "Remote",
+				Company:           "Tech Corp",
+				RemoteEligibility: "LATAM only",
+				UTCOffset:         intPtr(-6),
+				Benefits:          " Health-Insurance,stock-options,health-insurance,",
+				DateFrom:          "2024-01-01",
+				DateTo:            "2024-12-31",
 			},
 			checkResults: func(t *testing.T, result httpservice.SearchParams, err error) {
 				t.Helper()
@@ -51,12 +54,68 @@ func TestSearchRequest_ToSearchParams(t *testing.T) {
 				assert.Equal(t, "Remote", *searchParams.WorkMode)
 				assert.NotNil(t, searchParams.Company)
 				assert.Equal(t, "Tech Corp", *searchParams.Company)
+				assert.NotNil(t, searchParams.RemoteEligibility)
+				assert.Equal(t, "LATAM only", *searchParams.RemoteEligibility)
+				assert.Equal(t, intPtr(-6), searchParams.UTCOffset)
+				assert.Equal(t, []string{"health-insurance", "stock-options"}, searchParams.Benefits)
 				assert.NotNil(t, searchParams.DateFrom)
 				assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), *searchParams.DateFrom)
 				assert.NotNil(t, searchParams.DateTo)
 				assert.Equal(t, time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), *searchParams.DateTo)
 			},
 		},
+		{
+			name: "selected fields",
+			request: &SearchRequest{
+				Query:  "golang developer",
+				Fields: "job_id, title,application_url,title",
+			},
+			checkResults: func(t *testing.T, result httpservice.SearchParams, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, []string{"job_id", "title", "application_url"}, result.(*SearchParams).Fields)
+			},
+		},
+		{
+			name: "highlighted search",
+			request: &SearchRequest{
+				Query:     "golang developer",
+				Fields:    "job_id,highlight",
+				Highlight: true,
+			},
+			checkResults: func(t *testing.T, result httpservice.SearchParams, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.True(t, result.(*SearchParams).Highlight)
+				assert.Equal(t, []string{"job_id", "highlight"}, result.(*SearchParams).Fields)
+			},
+		},
+		{
+			name: "search sorted by date",
+			request: &SearchRequest{
+				Query: "golang developer",
+				Sort:  "date",
+			},
+			checkResults: func(t *testing.T, result httpservice.SearchParams, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, SortDate, result.(*SearchParams).Sort)
+			},
+		},
+		{
+			name: "province matched by its canonical name",
+			request: &SearchRequest{
+				Query:    "golang developer",
+				Province: "san jose",
+			},
+			checkResults: func(t *testing.T, result httpservice.SearchParams, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				searchParams := result.(*SearchParams)
+				require.NotNil(t, searchParams.Province)
+				assert.Equal(t, "San José", *searchParams.Province)
+			},
+		},
 		{
 			name: "successful conversion with minimal fields",
 			request: &SearchRequest{
@@ -563,6 +622,71 @@ func TestSearchRequest_Validate(t *testing.T) {
 				assert.Contains(t, validationErr.Errors, "invalid value for field: 'work_mode'")
 			},
 		},
+		{
+			name: "selected fields",
+			request: &SearchRequest{
+				Query:  "engineer",
+				Fields: "job_id, title,application_url,title",
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "unknown selected field",
+			request: &SearchRequest{
+				Query:  "engineer",
+				Fields: "job_id,salary",
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Contains(t, validationErr.Errors, `invalid value for field: 'fields', unknown field "salary"`)
+			},
+		},
+		{
+			name: "invalid province",
+			request: &SearchRequest{
+				Query:    "engineer",
+				Province: "Madrid",
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Contains(t, validationErr.Errors, "invalid value for field: 'province'")
+			},
+		},
+		{
+			name: "invalid remote eligibility and utc offset",
+			request: &SearchRequest{
+				Query:             "engineer",
+				RemoteEligibility: "Mars only",
+				UTCOffset:         intPtr(15),
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Contains(t, validationErr.Errors, "invalid value for field: 'remote_eligibility'")
+				assert.Contains(t, validationErr.Errors, "invalid value for field: 'utc_offset'")
+			},
+		},
+		{
+			name: "invalid benefits",
+			request: &SearchRequest{
+				Query:    "engineer",
+				Benefits: "health-insurance,free ponies",
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Contains(t, validationErr.Errors, "invalid value for field: 'benefits'")
+			},
+		},
 		{
 			name: "only date_from provided",
 			request: &SearchRequest{
@@ -595,6 +719,20 @@ func TestSearchRequest_Validate(t *testing.T) {
 				assert.Contains(t, validationErr.Errors, "both date_from and date_to must be provided together")
 			},
 		},
+		{
+			name: "invalid sort",
+			request: &SearchRequest{
+				Query: "developer",
+				Sort:  "salary",
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.Error(t, err)
+
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+			},
+		},
 		{
 			name: "invalid date_from format",
 			request: &SearchRequest{
@@ -685,3 +823,67 @@ func TestSearchRequest_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestJobPatchRequest_Validate(t *testing.T) {
+	t.Parallel()
+	ptr := func(value string) *string { return &value }
+	offset := func(value int) *int { return &value }
+
+	tests := []struct {
+		name         string
+		request      *JobPatchRequest
+		checkResults func(t *testing.T, err error)
+	}{
+		{
+			name: "valid partial request",
+			request: &JobPatchRequest{
+				Title:        ptr("Senior Go Developer"),
+				WorkMode:     ptr("Hybrid"),
+				UTCOffsetMin: offset(-6),
+				UTCOffsetMax: offset(-3),
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:    "blank title",
+			request: &JobPatchRequest{Title: ptr("  ")},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Contains(t, validationErr.Errors, "title cannot be empty")
+			},
+		},
+		{
+			name:    "invalid application URL",
+			request: &JobPatchRequest{ApplicationURL: ptr("not a url")},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Contains(t, validationErr.Errors, "application_url must be a valid URL")
+			},
+		},
+		{
+			name:    "only one UTC offset",
+			request: &JobPatchRequest{UTCOffsetMin: offset(-6)},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Contains(t, validationErr.Errors, "both utc_offset_max and utc_offset_min must be provided together")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := httpservice.Validate(tt.request)
+			tt.checkResults(t, err)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package jobs
+
+import (
+	"context"
+	"time"
+)
+
+// Defaults for near-duplicate detection
+const (
+	DefaultDuplicateWindow     = 30 * 24 * time.Hour
+	DefaultMinTitleSimilarity  = 0.8
+	DefaultMinURLSimilarity    = 0.9
+	DefaultDuplicateLimit      = 100
+	MaxDuplicateWindowDays     = 365
+	MaxDuplicateLimit          = 500
+	maxDuplicateWindowDuration = MaxDuplicateWindowDays * 24 * time.Hour
+)
+
+// DuplicateRepository interface to look up near-duplicate jobs.
+type DuplicateRepository interface {
+	FindNearDuplicates(ctx context.Context, params *NearDuplicateParams) ([]*NearDuplicate, error)
+}
+
+// DuplicateDetector flags jobs that are probably the same posting published more than once,
+// e.g. reposted with a slightly different title or tracking parameters in the URL.
+// Exact duplicates are already rejected by the job signature.
+type DuplicateDetector struct {
+	repo DuplicateRepository
+}
+
+// NewDuplicateDetector creates a new instance of DuplicateDetector
+func NewDuplicateDetector(repo DuplicateRepository) *DuplicateDetector {
+	return &DuplicateDetector{repo: repo}
+}
+
+// Find returns the near-duplicate job pairs matching params. Unset params take their defaults
+// and out of range values are clamped.
+func (d *DuplicateDetector) Find(ctx context.Context, params NearDuplicateParams) ([]*NearDuplicate, error) {
+	if params.Window <= 0 {
+		params.Window = DefaultDuplicateWindow
+	}
+	params.Window = min(params.Window, maxDuplicateWindowDuration)
+
+	if params.MinTitleSimilarity <= 0 || params.MinTitleSimilarity > 1 {
+		params.MinTitleSimilarity = DefaultMinTitleSimilarity
+	}
+	if params.MinURLSimilarity <= 0 || params.MinURLSimilarity > 1 {
+		params.MinURLSimilarity = DefaultMinURLSimilarity
+	}
+
+	if params.Limit <= 0 {
+		params.Limit = DefaultDuplicateLimit
+	}
+	params.Limit = min(params.Limit, MaxDuplicateLimit)
+
+	return d.repo.FindNearDuplicates(ctx, &params)
+}
+
+// FindForJobs returns the near-duplicate pairs involving any of the given jobs, using the
+// default thresholds. It returns nothing when jobIDs is empty.
+func (d *DuplicateDetector) FindForJobs(ctx context.Context, jobIDs []int) ([]*NearDuplicate, error) {
+	if len(jobIDs) == 0 {
+		return nil, nil
+	}
+	return d.Find(ctx, NearDuplicateParams{JobIDs: jobIDs, Limit: MaxDuplicateLimit})
+}
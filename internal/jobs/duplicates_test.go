@@ -0,0 +1,117 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDuplicateDetector_Find(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		params       NearDuplicateParams
+		mockSetup    func(mockRepo *MockDuplicateRepository)
+		checkResults func(t *testing.T, duplicates []*NearDuplicate, err error)
+	}{
+		{
+			name:   "defaults applied",
+			params: NearDuplicateParams{},
+			mockSetup: func(mockRepo *MockDuplicateRepository) {
+				t.Helper()
+				mockRepo.EXPECT().FindNearDuplicates(context.Background(), &NearDuplicateParams{
+					Window:             DefaultDuplicateWindow,
+					MinTitleSimilarity: DefaultMinTitleSimilarity,
+					MinURLSimilarity:   DefaultMinURLSimilarity,
+					Limit:              DefaultDuplicateLimit,
+				}).Return([]*NearDuplicate{{CompanyName: "Tech Corp"}}, nil).Once()
+			},
+			checkResults: func(t *testing.T, duplicates []*NearDuplicate, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Len(t, duplicates, 1)
+			},
+		},
+		{
+			name: "out of range values clamped",
+			params: NearDuplicateParams{
+				Window:             1000 * 24 * time.Hour,
+				MinTitleSimilarity: 0.7,
+				MinURLSimilarity:   1.5,
+				Limit:              10000,
+			},
+			mockSetup: func(mockRepo *MockDuplicateRepository) {
+				t.Helper()
+				mockRepo.EXPECT().FindNearDuplicates(context.Background(), &NearDuplicateParams{
+					Window:             MaxDuplicateWindowDays * 24 * time.Hour,
+					MinTitleSimilarity: 0.7,
+					MinURLSimilarity:   DefaultMinURLSimilarity,
+					Limit:              MaxDuplicateLimit,
+				}).Return(nil, nil).Once()
+			},
+			checkResults: func(t *testing.T, duplicates []*NearDuplicate, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Empty(t, duplicates)
+			},
+		},
+		{
+			name:   "repository error",
+			params: NearDuplicateParams{},
+			mockSetup: func(mockRepo *MockDuplicateRepository) {
+				t.Helper()
+				mockRepo.EXPECT().FindNearDuplicates(context.Background(), mock.Anything).Return(nil, dbError).Once()
+			},
+			checkResults: func(t *testing.T, _ []*NearDuplicate, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDuplicateRepository(t)
+			detector := NewDuplicateDetector(mockRepo)
+
+			tt.mockSetup(mockRepo)
+
+			duplicates, err := detector.Find(context.Background(), tt.params)
+			tt.checkResults(t, duplicates, err)
+		})
+	}
+}
+
+func TestDuplicateDetector_FindForJobs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no jobs", func(t *testing.T) {
+		t.Parallel()
+		detector := NewDuplicateDetector(NewMockDuplicateRepository(t))
+
+		duplicates, err := detector.FindForJobs(context.Background(), nil)
+		require.NoError(t, err)
+		assert.Nil(t, duplicates)
+	})
+
+	t.Run("restricted to jobs", func(t *testing.T) {
+		t.Parallel()
+		mockRepo := NewMockDuplicateRepository(t)
+		detector := NewDuplicateDetector(mockRepo)
+
+		mockRepo.EXPECT().FindNearDuplicates(context.Background(), mock.MatchedBy(func(p *NearDuplicateParams) bool {
+			return len(p.JobIDs) == 2 && p.Limit == MaxDuplicateLimit
+		})).Return(nil, nil).Once()
+
+		_, err := detector.FindForJobs(context.Background(), []int{1, 2})
+		require.NoError(t, err)
+	})
+}
@@ -5,38 +5,123 @@ package jobs
 import (
 	"errors"
 	"fmt"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
 )
 
 // NotFoundError represents a job not found error
 type NotFoundError struct {
 	ID        int
 	Signature string
+	// Source and SourceJobID are set when looking the job up by its ID at its source
+	Source      Source
+	SourceJobID string
 }
 
 func (e NotFoundError) Error() string {
 	if e.ID != 0 {
 		return fmt.Sprintf("job with ID %d not found", e.ID)
 	}
+	if e.SourceJobID != "" {
+		return fmt.Sprintf("job with ID %s at %s not found", e.SourceJobID, e.Source)
+	}
 	return fmt.Sprintf("job with signature %s not found", e.Signature)
 }
 
+// Is matches httpservice.ErrNotFound
+func (e NotFoundError) Is(target error) bool {
+	return target == httpservice.ErrNotFound
+}
+
 // IsNotFound checks if an error is a job not found error
 func IsNotFound(err error) bool {
 	var notFoundErr *NotFoundError
 	return errors.As(err, &notFoundErr)
 }
 
-// DuplicateError represents a duplicate job error
+// DuplicateError represents a duplicate job error, a job with the same signature or the same ID at
+// its source
 type DuplicateError struct {
 	Signature string
+	// Source and SourceJobID are set when the job has the same ID at its source as another one
+	Source      Source
+	SourceJobID string
 }
 
 func (e DuplicateError) Error() string {
+	if e.SourceJobID != "" {
+		return fmt.Sprintf("job with ID %s at %s already exists", e.SourceJobID, e.Source)
+	}
 	return fmt.Sprintf("job with signature %s already exists", e.Signature)
 }
 
+// Is matches httpservice.ErrConflict
+func (e DuplicateError) Is(target error) bool {
+	return target == httpservice.ErrConflict
+}
+
 // IsDuplicate checks if an error is a duplicate job error
 func IsDuplicate(err error) bool {
 	var duplicateErr *DuplicateError
 	return errors.As(err, &duplicateErr)
 }
+
+// NotPendingError represents a moderation of a job that isn't waiting for review
+type NotPendingError struct {
+	ID     int
+	Status Status
+}
+
+func (e NotPendingError) Error() string {
+	if e.Status == "" {
+		return fmt.Sprintf("job with ID %d was already reviewed", e.ID)
+	}
+	return fmt.Sprintf("job with ID %d is %s, only pending jobs can be reviewed", e.ID, e.Status)
+}
+
+// Is matches httpservice.ErrConflict
+func (e NotPendingError) Is(target error) bool {
+	return target == httpservice.ErrConflict
+}
+
+// IsNotPending checks if an error is a not pending job error
+func IsNotPending(err error) bool {
+	var notPendingErr *NotPendingError
+	return errors.As(err, &notPendingErr)
+}
+
+// CompanyInactiveError represents an approval of a job of an archived company
+type CompanyInactiveError struct {
+	ID        int
+	CompanyID int
+}
+
+func (e CompanyInactiveError) Error() string {
+	return fmt.Sprintf("job with ID %d belongs to the archived company with ID %d and can't be published",
+		e.ID, e.CompanyID)
+}
+
+// Is matches httpservice.ErrConflict
+func (e CompanyInactiveError) Is(target error) bool {
+	return target == httpservice.ErrConflict
+}
+
+// NotActiveError represents a deactivation of a job that isn't active
+type NotActiveError struct {
+	ID int
+}
+
+func (e NotActiveError) Error() string {
+	return fmt.Sprintf("job with ID %d is not active", e.ID)
+}
+
+// Is matches httpservice.ErrConflict
+func (e NotActiveError) Is(target error) bool {
+	return target == httpservice.ErrConflict
+}
+
+// IsNotActive checks if an error is a not active job error
+func IsNotActive(err error) bool {
+	var notActiveErr *NotActiveError
+	return errors.As(err, &notActiveErr)
+}
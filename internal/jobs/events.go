@@ -0,0 +1,18 @@
+package jobs
+
+import "context"
+
+// Job lifecycle events, sent to partner sites mirroring the board
+const (
+	// EventCreated is published when a job becomes visible: created as published, or approved
+	EventCreated = "job.created"
+	// EventUpdated is published when the content of a published job changes
+	EventUpdated = "job.updated"
+	// EventDeactivated is published when a job is taken down
+	EventDeactivated = "job.deactivated"
+)
+
+// EventPublisher interface to notify other systems of job lifecycle events.
+type EventPublisher interface {
+	PublishJobEvent(ctx context.Context, eventType string, job *Job) error
+}
@@ -0,0 +1,80 @@
+package jobs
+
+import (
+	"html"
+	"regexp"
+	"strconv"
+)
+
+// titleLevelPatterns match the keywords giving away the experience level in job titles, in the order
+// they are checked, so "Senior Tech Lead" is a Lead and "Junior Intern" an Entry-level job
+var titleLevelPatterns = []struct {
+	pattern *regexp.Regexp
+	level   string
+}{
+	{regexp.MustCompile(`(?i)\b(intern|internship|trainee|pasante|practicante)\b`), experienceLevelEntry},
+	{regexp.MustCompile(`(?i)\b(head of|director|vp|cto)\b`), experienceLevelExecutive},
+	{regexp.MustCompile(`(?i)\b(principal|staff)\b`), experienceLevelPrincipal},
+	{regexp.MustCompile(`(?i)\b(lead|l[ií]der)\b`), experienceLevelLead},
+	{regexp.MustCompile(`(?i)\b(semi[ -]?senior|ssr|mid|intermediate)\b`), experienceLevelMid},
+	{regexp.MustCompile(`(?i)\b(sr|senior)\b`), experienceLevelSenior},
+	{regexp.MustCompile(`(?i)\b(jr|junior)\b`), experienceLevelJunior},
+}
+
+var (
+	// yearsPattern matches an amount of years and its lower bound: "3+ years", "2-4 years",
+	// "at least 5 yrs" or "3 a 5 años"
+	yearsPattern = regexp.MustCompile(`(?i)\b(\d{1,2})\s*\+?\s*(?:(?:-|–|to|a)\s*\d{1,2}\s*\+?\s*)?(?:years?|yrs?|años)\b`)
+
+	// experiencePattern matches the sentences about experience, in English or Spanish
+	experiencePattern = regexp.MustCompile(`(?i)experien`)
+
+	// sentenceSeparatorPattern matches what separates the sentences of a description, including the
+	// tags of HTML descriptions
+	sentenceSeparatorPattern = regexp.MustCompile(`<[^>]+>|[.;\n]+\s`)
+)
+
+// Years of experience from which a job is of each level
+const (
+	juniorYears = 1
+	midYears    = 3
+	seniorYears = 5
+)
+
+// InferExperienceLevel guesses the experience level of a job whose data has none, or an unknown one.
+// Keywords of the title like "Sr." or "Intern" decide first. Otherwise, the years of experience asked
+// for in the description give the level, the highest amount of the sentences mentioning experience
+// counting. It reports false when neither gives the level away.
+func InferExperienceLevel(title, description string) (string, bool) {
+	for _, keyword := range titleLevelPatterns {
+		if keyword.pattern.MatchString(title) {
+			return keyword.level, true
+		}
+	}
+
+	years := -1
+	for _, sentence := range sentenceSeparatorPattern.Split(description, -1) {
+		sentence = html.UnescapeString(sentence)
+		if !experiencePattern.MatchString(sentence) {
+			continue
+		}
+		for _, match := range yearsPattern.FindAllStringSubmatch(sentence, -1) {
+			if n, err := strconv.Atoi(match[1]); err == nil && n > years {
+				years = n
+			}
+		}
+	}
+
+	switch {
+	case years < 0:
+		return "", false
+	case years >= seniorYears:
+		return experienceLevelSenior, true
+	case years >= midYears:
+		return experienceLevelMid, true
+	case years >= juniorYears:
+		return experienceLevelJunior, true
+	default:
+		return experienceLevelEntry, true
+	}
+}
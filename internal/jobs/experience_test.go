@@ -0,0 +1,75 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInferExperienceLevel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		title       string
+		description string
+		level       string
+	}{
+		{
+			name:  "senior abbreviation in title",
+			title: "Sr. Backend Engineer",
+			level: experienceLevelSenior,
+		},
+		{
+			name:  "lead takes precedence over senior",
+			title: "Senior Tech Lead",
+			level: experienceLevelLead,
+		},
+		{
+			name:  "internship",
+			title: "Software Engineering Intern",
+			level: experienceLevelEntry,
+		},
+		{
+			name:  "semi senior is mid-level",
+			title: "Desarrollador Semi-Senior",
+			level: experienceLevelMid,
+		},
+		{
+			name:  "keywords only match whole words",
+			title: "Leading Juniper Network Engineer",
+		},
+		{
+			name:        "highest years of experience",
+			title:       "Backend Engineer",
+			description: "<p>5+ years of experience building APIs.</p><p>At least 2 years of experience with Go.</p>",
+			level:       experienceLevelSenior,
+		},
+		{
+			name:        "lower bound of a range in Spanish",
+			title:       "Desarrollador Backend",
+			description: "Buscamos alguien con 3 a 5 años de experiencia en Java",
+			level:       experienceLevelMid,
+		},
+		{
+			name:        "years not about experience",
+			title:       "Backend Engineer",
+			description: "We have been in business for 10 years. Join us!",
+		},
+		{
+			name:        "no experience required",
+			title:       "Backend Engineer",
+			description: "0-1 years of experience",
+			level:       experienceLevelEntry,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			level, ok := InferExperienceLevel(tt.title, tt.description)
+			assert.Equal(t, tt.level, level)
+			assert.Equal(t, tt.level != "", ok)
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Defaults of the slow search plans
+const (
+	DefaultExplainTimeout = 30 * time.Second
+)
+
+// explainQueryPrefix runs a query again and returns its plan with the actual timings and buffer usage
+const explainQueryPrefix = "EXPLAIN (ANALYZE, BUFFERS) "
+
+// SlowSearchConfig configures the logging of the query plans of the slow searches
+type SlowSearchConfig struct {
+	// Threshold is the time above which a search is explained. Zero disables it.
+	Threshold time.Duration
+	// Timeout bounds the explained search, which runs again
+	Timeout time.Duration
+	// OnPlan receives the plan of a slow search
+	OnPlan func(plan *SlowSearchPlan)
+	// OnError is called when a slow search can't be explained. Optional.
+	OnError func(err error)
+}
+
+// SlowSearchPlan is the query plan of a search slower than the threshold
+type SlowSearchPlan struct {
+	Query string
+	Args  []any
+	// Elapsed is the time the search took when it was served
+	Elapsed time.Duration
+	// Plan is the output of EXPLAIN (ANALYZE, BUFFERS), one line per node
+	Plan string
+}
+
+// DefaultSlowSearchConfig returns the default configuration of the slow search plans, disabled
+func DefaultSlowSearchConfig() SlowSearchConfig {
+	return SlowSearchConfig{Timeout: DefaultExplainTimeout}
+}
+
+// slowSearchExplainer explains the slow searches in the background, one at a time. Slow searches
+// found while one is explained aren't, so a slow database isn't loaded by their plans.
+type slowSearchExplainer struct {
+	db   Database
+	cfg  SlowSearchConfig
+	busy chan struct{}
+}
+
+// ExplainSlowSearches makes the searches taking longer than cfg.Threshold run again with
+// EXPLAIN (ANALYZE, BUFFERS) in the background, their plans passed to cfg.OnPlan. It must be
+// called before the repository serves searches.
+func (r *Repository) ExplainSlowSearches(cfg SlowSearchConfig) {
+	if cfg.Threshold <= 0 || cfg.OnPlan == nil {
+		r.explainer = nil
+		return
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultExplainTimeout
+	}
+	r.explainer = &slowSearchExplainer{db: r.replica, cfg: cfg, busy: make(chan struct{}, 1)}
+}
+
+// observe explains the search of query and args when it took longer than the threshold
+func (e *slowSearchExplainer) observe(ctx context.Context, query string, args []any, elapsed time.Duration) {
+	if e == nil || elapsed < e.cfg.Threshold {
+		return
+	}
+	select {
+	case e.busy <- struct{}{}:
+	default:
+		return
+	}
+
+	// The plan outlives the request of the search
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), e.cfg.Timeout)
+	go func() {
+		defer func() {
+			cancel()
+			<-e.busy
+		}()
+
+		plan, err := e.explain(ctx, query, args)
+		if err != nil {
+			if e.cfg.OnError != nil {
+				e.cfg.OnError(err)
+			}
+			return
+		}
+		e.cfg.OnPlan(&SlowSearchPlan{Query: query, Args: args, Elapsed: elapsed, Plan: plan})
+	}()
+}
+
+// explain returns the plan of query run with args
+func (e *slowSearchExplainer) explain(ctx context.Context, query string, args []any) (string, error) {
+	rows, err := e.db.Query(ctx, explainQueryPrefix+query, args...)
+	if err != nil {
+		return "", fmt.Errorf("failed to explain search: %w", err)
+	}
+	defer rows.Close()
+
+	var lines []string
+	for rows.Next() {
+		var line string
+		if err = rows.Scan(&line); err != nil {
+			return "", fmt.Errorf("failed to scan plan row: %w", err)
+		}
+		lines = append(lines, line)
+	}
+	if err = rows.Err(); err != nil {
+		return "", fmt.Errorf("error iterating plan rows: %w", err)
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
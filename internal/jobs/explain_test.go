@@ -0,0 +1,105 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_ExplainSlowSearches(t *testing.T) {
+	t.Parallel()
+	searchQuery := searchJobsWithCountBaseQuery + " ORDER BY score DESC, created_at DESC LIMIT $8 OFFSET $9"
+	searchColumns := []string{
+		"id", "company_id", "title", "description", "experience_level", "employment_type",
+		"location", "work_mode", "application_url", "is_active", "signature", "language",
+		"remote_eligibility", "utc_offset_min", "utc_offset_max", "created_at", "updated_at",
+		"company_name", "company_slug", "company_logo_url", "benefits", "score", "total_count",
+	}
+	halfLife := DefaultRankRecencyHalfLife.Seconds()
+	searchArgs := []any{
+		"golang", "", DefaultRankTextWeight, DefaultRankRecencyWeight, DefaultRankFeaturedWeight, halfLife,
+		DefaultRankPrimaryTechWeight, 10, 0,
+	}
+
+	tests := []struct {
+		name      string
+		threshold time.Duration
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		plan      string
+		err       string
+	}{
+		{
+			name:      "slow search explained",
+			threshold: time.Nanosecond,
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(regexp.QuoteMeta(explainQueryPrefix + searchQuery)).
+					WithArgs(searchArgs...).
+					WillReturnRows(pgxmock.NewRows([]string{"QUERY PLAN"}).
+						AddRow("Limit  (cost=0.00..1.00 rows=10 width=64) (actual time=0.010..0.020 rows=0 loops=1)").
+						AddRow("  Buffers: shared hit=4"))
+			},
+			plan: "Limit  (cost=0.00..1.00 rows=10 width=64) (actual time=0.010..0.020 rows=0 loops=1)\n" +
+				"  Buffers: shared hit=4",
+		},
+		{
+			name:      "explain error",
+			threshold: time.Nanosecond,
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(regexp.QuoteMeta(explainQueryPrefix + searchQuery)).
+					WithArgs(searchArgs...).
+					WillReturnError(errors.New("canceling statement due to statement timeout"))
+			},
+			err: "failed to explain search: canceling statement due to statement timeout",
+		},
+		{
+			name:      "fast search not explained",
+			threshold: time.Hour,
+			mockSetup: func(_ pgxmock.PgxPoolIface) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			mockDB.ExpectQuery(regexp.QuoteMeta(searchQuery)).
+				WithArgs(searchArgs...).
+				WillReturnRows(pgxmock.NewRows(searchColumns))
+			tt.mockSetup(mockDB)
+
+			plans := make(chan *SlowSearchPlan, 1)
+			errs := make(chan error, 1)
+			repo := NewRepository(mockDB)
+			repo.ExplainSlowSearches(SlowSearchConfig{
+				Threshold: tt.threshold,
+				OnPlan:    func(plan *SlowSearchPlan) { plans <- plan },
+				OnError:   func(err error) { errs <- err },
+			})
+
+			_, _, err = repo.SearchJobsWithCount(context.Background(), &SearchParams{Query: "golang", Limit: 10})
+			require.NoError(t, err)
+
+			switch {
+			case tt.plan != "":
+				plan := <-plans
+				assert.Equal(t, tt.plan, plan.Plan)
+				assert.Equal(t, searchQuery, plan.Query)
+				assert.Equal(t, searchArgs, plan.Args)
+			case tt.err != "":
+				assert.EqualError(t, <-errs, tt.err)
+			}
+
+			require.Eventually(t, func() bool { return mockDB.ExpectationsWereMet() == nil },
+				time.Second, 10*time.Millisecond)
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package jobs
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// DefaultExportMaxRows is the number of jobs a search export is capped at by default
+const DefaultExportMaxRows = 5000
+
+// exportColumns are the header of the CSV export of a search, one column per field of exportRecord
+var exportColumns = []string{
+	"job_id", "title", "company_name", "experience_level", "employment_type", "location", "work_mode",
+	"remote_eligibility", "language", "benefits", "application_url", "posted_at",
+}
+
+// csvBenefitSeparator separates the benefits of a job in the CSV export, commas being common in
+// spreadsheet cells
+const csvBenefitSeparator = ";"
+
+// csvFormulaPrefixes are the first characters making spreadsheets run a cell as a formula
+const csvFormulaPrefixes = "=+-@\t\r"
+
+// ExportRepository interface to stream the jobs found by a search.
+type ExportRepository interface {
+	StreamSearchJobs(ctx context.Context, params *SearchParams, fn func(*JobWithCompany) error) error
+}
+
+// ExportService exports the jobs found by a search, for analysts who want the current result set
+// offline
+type ExportService struct {
+	repo    ExportRepository
+	terms   TermRepository
+	maxRows int
+}
+
+// NewExportService creates a new instance of ExportService exporting at most maxRows jobs per search,
+// DefaultExportMaxRows when not positive
+func NewExportService(repo ExportRepository, terms TermRepository, maxRows int) *ExportService {
+	if maxRows <= 0 {
+		maxRows = DefaultExportMaxRows
+	}
+	return &ExportService{repo: repo, terms: terms, maxRows: maxRows}
+}
+
+// Export calls fn with each job found by a search, in the order of the search, up to the export cap.
+// Jobs matching the query with its terms replaced by their synonyms are found too, like the search
+// does. The pagination, field selection and highlighting of the search don't apply.
+func (s *ExportService) Export(ctx context.Context, params *SearchParams, fn func(*JobWithCompany) error) error {
+	synonyms, err := s.terms.ListSynonyms(ctx, searchTerms(params.Query))
+	if err != nil {
+		return &httpservice.SearchError{Operation: "list search synonyms", Err: err}
+	}
+	params.SynonymQuery = replaceTerms(params.Query, synonyms)
+	params.Limit, params.Offset = s.maxRows, 0
+	params.Fields, params.Highlight = nil, false
+
+	// Errors of fn are returned as they are, only the search failing is a SearchError
+	var fnErr error
+	err = s.repo.StreamSearchJobs(ctx, params, func(job *JobWithCompany) error {
+		fnErr = fn(job)
+		return fnErr
+	})
+	if err != nil && fnErr == nil {
+		return &httpservice.SearchError{Operation: "export jobs", Err: err}
+	}
+	return err
+}
+
+// JobCSVWriter writes jobs as CSV rows after the exportColumns header
+type JobCSVWriter struct {
+	writer  *csv.Writer
+	started bool
+}
+
+// NewJobCSVWriter creates a JobCSVWriter writing to w. Rows are buffered until Flush, or until
+// the buffer fills, so large exports are sent as they are written.
+func NewJobCSVWriter(w io.Writer) *JobCSVWriter {
+	return &JobCSVWriter{writer: csv.NewWriter(w)}
+}
+
+// Write writes the row of a job, after the header when it is the first one
+func (w *JobCSVWriter) Write(job *JobWithCompany) error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	return w.writer.Write(exportRecord(job))
+}
+
+// Flush writes the header when no job was written, and the buffered rows
+func (w *JobCSVWriter) Flush() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+// writeHeader writes the header once
+func (w *JobCSVWriter) writeHeader() error {
+	if w.started {
+		return nil
+	}
+	w.started = true
+	return w.writer.Write(exportColumns)
+}
+
+// exportRecord returns the CSV row of a job
+func exportRecord(job *JobWithCompany) []string {
+	remoteEligibility := ""
+	if job.RemoteEligibility != nil {
+		remoteEligibility = string(*job.RemoteEligibility)
+	}
+	return []string{
+		strconv.Itoa(job.ID),
+		csvText(job.Title),
+		csvText(job.CompanyName),
+		csvText(job.ExperienceLevel),
+		csvText(job.EmploymentType),
+		csvText(job.Location),
+		csvText(job.WorkMode),
+		csvText(remoteEligibility),
+		csvText(string(job.Language)),
+		csvText(strings.Join(job.Benefits, csvBenefitSeparator)),
+		csvText(job.ApplicationURL),
+		job.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// csvText escapes a text cell starting like a formula with a quote, so spreadsheets opening the
+// export show it as text instead of running it. The jobs are scraped or posted by anyone.
+func csvText(value string) string {
+	if value != "" && strings.ContainsRune(csvFormulaPrefixes, rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
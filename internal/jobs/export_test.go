@@ -0,0 +1,209 @@
+package jobs
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+func TestExportService_Export(t *testing.T) {
+	t.Parallel()
+	job := &JobWithCompany{Job: Job{ID: 1, Title: "QA Engineer"}, CompanyName: "Tech Corp"}
+
+	tests := []struct {
+		name      string
+		mockSetup func(mockRepo *MockExportRepository, mockTerms *MockTermRepository)
+		fnErr     error
+		checkErr  func(t *testing.T, err error)
+		wantJobs  int
+	}{
+		{
+			name: "streams the jobs of the search with its synonyms up to the cap",
+			mockSetup: func(mockRepo *MockExportRepository, mockTerms *MockTermRepository) {
+				mockTerms.EXPECT().ListSynonyms(mock.Anything, []string{"qa"}).
+					Return(map[string]string{"qa": "quality assurance"}, nil).Once()
+				mockRepo.EXPECT().StreamSearchJobs(mock.Anything, mock.MatchedBy(func(params *SearchParams) bool {
+					return params.SynonymQuery == "quality assurance" && params.Limit == 50 && params.Offset == 0 &&
+						!params.Highlight && params.Fields == nil
+				}), mock.Anything).
+					RunAndReturn(func(_ context.Context, _ *SearchParams, fn func(*JobWithCompany) error) error {
+						return fn(job)
+					}).Once()
+			},
+			checkErr: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+			wantJobs: 1,
+		},
+		{
+			name: "search error",
+			mockSetup: func(mockRepo *MockExportRepository, mockTerms *MockTermRepository) {
+				mockTerms.EXPECT().ListSynonyms(mock.Anything, mock.Anything).Return(nil, nil).Once()
+				mockRepo.EXPECT().StreamSearchJobs(mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.New("database error")).Once()
+			},
+			checkErr: func(t *testing.T, err error) {
+				t.Helper()
+				var searchErr *httpservice.SearchError
+				require.ErrorAs(t, err, &searchErr)
+				assert.Equal(t, "export jobs", searchErr.Operation)
+			},
+		},
+		{
+			name: "errors writing a job returned as they are",
+			mockSetup: func(mockRepo *MockExportRepository, mockTerms *MockTermRepository) {
+				mockTerms.EXPECT().ListSynonyms(mock.Anything, mock.Anything).Return(nil, nil).Once()
+				mockRepo.EXPECT().StreamSearchJobs(mock.Anything, mock.Anything, mock.Anything).
+					RunAndReturn(func(_ context.Context, _ *SearchParams, fn func(*JobWithCompany) error) error {
+						return fn(job)
+					}).Once()
+			},
+			fnErr: context.Canceled,
+			checkErr: func(t *testing.T, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, context.Canceled)
+				var searchErr *httpservice.SearchError
+				assert.NotErrorAs(t, err, &searchErr)
+			},
+			wantJobs: 1,
+		},
+		{
+			name: "synonym lookup error",
+			mockSetup: func(_ *MockExportRepository, mockTerms *MockTermRepository) {
+				mockTerms.EXPECT().ListSynonyms(mock.Anything, mock.Anything).
+					Return(nil, errors.New("database error")).Once()
+			},
+			checkErr: func(t *testing.T, err error) {
+				t.Helper()
+				var searchErr *httpservice.SearchError
+				require.ErrorAs(t, err, &searchErr)
+				assert.Equal(t, "list search synonyms", searchErr.Operation)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockExportRepository(t)
+			mockTerms := NewMockTermRepository(t)
+			tt.mockSetup(mockRepo, mockTerms)
+
+			var exported int
+			params := &SearchParams{Query: "qa", Limit: 20, Offset: 40, Highlight: true, Fields: []string{"title"}}
+			err := NewExportService(mockRepo, mockTerms, 50).Export(context.Background(), params,
+				func(*JobWithCompany) error {
+					exported++
+					return tt.fnErr
+				})
+			tt.checkErr(t, err)
+			assert.Equal(t, tt.wantJobs, exported)
+		})
+	}
+}
+
+func TestNewExportService_DefaultMaxRows(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, DefaultExportMaxRows, NewExportService(nil, nil, 0).maxRows)
+}
+
+func TestJobCSVWriter(t *testing.T) {
+	t.Parallel()
+	latam := RemoteEligibilityLATAM
+	posted := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+
+	t.Run("writes the header and a row per job", func(t *testing.T) {
+		t.Parallel()
+		var body bytes.Buffer
+		writer := NewJobCSVWriter(&body)
+		require.NoError(t, writer.Write(&JobWithCompany{
+			Job: Job{ID: 7, Title: "Backend Developer, Go", ExperienceLevel: "Senior", EmploymentType: "Full-time",
+				Location: "Costa Rica", WorkMode: "Remote", RemoteEligibility: &latam, Language: LanguageSpanish,
+				ApplicationURL: "https://techcorp.example.com/jobs/7", CreatedAt: posted},
+			CompanyName: "Tech Corp",
+			Benefits:    []string{"health-insurance", "stock-options"},
+		}))
+		require.NoError(t, writer.Flush())
+
+		assert.Equal(t, "job_id,title,company_name,experience_level,employment_type,location,work_mode,"+
+			"remote_eligibility,language,benefits,application_url,posted_at\n"+
+			`7,"Backend Developer, Go",Tech Corp,Senior,Full-time,Costa Rica,Remote,LATAM only,es,`+
+			"health-insurance;stock-options,https://techcorp.example.com/jobs/7,2024-01-15T10:30:00Z\n",
+			body.String())
+	})
+
+	t.Run("escapes the cells starting like a formula", func(t *testing.T) {
+		t.Parallel()
+		var body bytes.Buffer
+		writer := NewJobCSVWriter(&body)
+		require.NoError(t, writer.Write(&JobWithCompany{
+			Job: Job{ID: 7, Title: `=HYPERLINK("https://evil.example.com","Apply")`, Location: "+506",
+				ApplicationURL: "@SUM(A1:A9)", CreatedAt: posted},
+			CompanyName: "-Tech Corp",
+		}))
+		require.NoError(t, writer.Flush())
+
+		assert.Equal(t, "job_id,title,company_name,experience_level,employment_type,location,work_mode,"+
+			"remote_eligibility,language,benefits,application_url,posted_at\n"+
+			`7,"'=HYPERLINK(""https://evil.example.com"",""Apply"")",'-Tech Corp,,,'+506,,,,,'@SUM(A1:A9),`+
+			"2024-01-15T10:30:00Z\n",
+			body.String())
+	})
+
+	t.Run("writes the header without jobs", func(t *testing.T) {
+		t.Parallel()
+		var body bytes.Buffer
+		require.NoError(t, NewJobCSVWriter(&body).Flush())
+
+		assert.Equal(t, "job_id,title,company_name,experience_level,employment_type,location,work_mode,"+
+			"remote_eligibility,language,benefits,application_url,posted_at\n", body.String())
+	})
+}
+
+func TestExportHandler_ExportJobs_StreamFailure(t *testing.T) {
+	t.Parallel()
+	mockRepo := NewMockExportRepository(t)
+	mockTerms := NewMockTermRepository(t)
+	mockTerms.EXPECT().ListSynonyms(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	mockRepo.EXPECT().StreamSearchJobs(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ *SearchParams, fn func(*JobWithCompany) error) error {
+			// Enough rows for the CSV writer to send some before the stream fails
+			for i := range 500 {
+				job := &JobWithCompany{Job: Job{ID: i + 1, Title: "QA Engineer"}, CompanyName: "Tech Corp"}
+				if err := fn(job); err != nil {
+					return err
+				}
+			}
+			return context.DeadlineExceeded
+		}).Once()
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(httpservice.Recovery(func(_ *gin.Context, p *httpservice.Panic) { t.Errorf("unexpected panic: %v", p.Value) }))
+	router.Use(httpservice.ErrorHandler())
+	NewExportHandler(NewExportService(mockRepo, mockTerms, 1000)).RegisterRoutes(&router.RouterGroup)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + ExportJobsRoute + "?q=qa")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// The download fails instead of ending with the rows sent so far
+	_, err = io.ReadAll(resp.Body)
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
@@ -2,6 +2,8 @@ package jobs
 
 import (
 	"context"
+	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 
@@ -11,7 +13,14 @@ import (
 
 // Constants for job routes and endpoints
 const (
-	JobsRoute = "/jobs"
+	JobsRoute           = "/jobs"
+	JobRoute            = JobsRoute + "/:id"
+	NearDuplicatesRoute = JobsRoute + "/near-duplicates"
+	SimilarJobsRoute    = JobsRoute + "/:id/similar"
+	ApproveJobRoute     = JobsRoute + "/:id/approve"
+	RejectJobRoute      = JobsRoute + "/:id/reject"
+	DeactivateJobRoute  = JobsRoute + "/:id/deactivate"
+	ExportJobsRoute     = JobsRoute + "/export.csv"
 )
 
 // DataRepository interface to make database operations for the Job model.
@@ -37,6 +46,16 @@ func (r *Repositories) GetJobTechnologiesBatch(ctx context.Context, jobIDs []int
 	return r.jobtechRepo.GetJobTechnologiesBatch(ctx, jobIDs)
 }
 
+// GetByID delegates to the job repository's GetByID method
+func (r *Repositories) GetByID(ctx context.Context, id int) (*Job, error) {
+	return r.jobRepo.GetByID(ctx, id)
+}
+
+// FindSimilarJobs delegates to the job repository's FindSimilarJobs method
+func (r *Repositories) FindSimilarJobs(ctx context.Context, params *SimilarParams) ([]*SimilarJob, error) {
+	return r.jobRepo.FindSimilarJobs(ctx, params)
+}
+
 // Handler handles HTTP requests for job operations using the generic httpservice
 type Handler struct {
 	searchHandler *httpservice.SearchHandler[*SearchRequest, *SearchParams, JobResponseList]
@@ -48,9 +67,9 @@ func NewRepositories(jobRepo *Repository, jobtechRepo *jobtech.Repository) *Repo
 }
 
 // NewHandler creates a new job handler using httpservice.NewSearchHandlerWithDefaults
-func NewHandler(repos DataRepository) *Handler {
+func NewHandler(repos DataRepository, terms TermRepository) *Handler {
 	// Create the search service
-	searchService := NewSearchService(repos)
+	searchService := NewSearchService(repos, terms)
 
 	// Create the generic search handler with defaults
 	searchHandler := httpservice.NewSearchHandlerWithDefaults(
@@ -70,7 +89,12 @@ func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
 
 // SearchJobs godoc
 // @Summary Search for jobs
-// @Description Search for jobs with optional filters and pagination
+// @Description Search for jobs with optional filters and pagination. Jobs matching the query with its
+// @Description terms replaced by their synonyms are found too, e.g. "quality assurance" jobs for "qa".
+// @Description Searches finding nothing suggest a corrected query in did_you_mean.
+// @Description Jobs are ranked by relevance, which weighs how well they match the query, how recent
+// @Description they are and whether they are featured, or listed newest first with sort=date.
+// @Description The Link header holds the URLs of the next and previous pages.
 // @Tags jobs
 // @Accept json
 // @Produce json
@@ -82,12 +106,402 @@ func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
 // @Param employment_type query string false "Employment type filter" \
 // Enums(Full-time,Part-time,Contract,Freelance,Temporary,Internship) example("Full-time")
 // @Param location query string false "Location filter" Enums(Costa Rica,LATAM) example("Costa Rica")
+// @Param province query string false "Province of Costa Rica filter, accents optional" \
+// Enums(San José,Alajuela,Cartago,Heredia,Guanacaste,Puntarenas,Limón) example("Heredia")
 // @Param work_mode query string false "Work mode filter" Enums(Remote,Hybrid,Onsite) example("Remote")
 // @Param company query string false "Company name filter (partial match)" example("Tech Corp")
+// @Param function query string false "Job function filter (see /job-functions)" example("Backend")
+// @Param language query string false "Posting language filter" Enums(en,es) example("es")
+// @Param remote_eligibility query string false "Where remote applicants can be based" \
+// Enums(CR only,LATAM only,Worldwide) example("LATAM only")
+// @Param utc_offset query int false "UTC offset in hours the working timezones must include" \
+// minimum(-12) maximum(14) example(-6)
+// @Param benefits query string false "Comma-separated benefit slugs the jobs must all offer (see /benefits)" \
+// example("health-insurance,stock-options")
 // @Param date_from query string false "Start date filter (YYYY-MM-DD)" example("2024-01-01")
 // @Param date_to query string false "End date filter (YYYY-MM-DD)" example("2024-12-31")
+// @Param fields query string false "Comma-separated job fields to return, all when empty" \
+// example("job_id,title,company_name,application_url")
+// @Param highlight query bool false "Add description snippets with the matched terms between <mark> tags" default(false)
+// @Param sort query string false "Order of the jobs" Enums(relevance,date) default(relevance)
 // @Success 200 {object} SearchResponse
 // @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 429 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /jobs [get]
 func (h *Handler) SearchJobs(c *gin.Context) { h.searchHandler.HandleSearch(c) }
+
+// RecommendationHandler handles HTTP requests for job recommendations
+type RecommendationHandler struct {
+	service *RecommendationService
+}
+
+// NewRecommendationHandler creates a new job recommendation handler
+func NewRecommendationHandler(service *RecommendationService) *RecommendationHandler {
+	return &RecommendationHandler{service: service}
+}
+
+// RegisterRoutes registers the job recommendation routes with the given router group
+func (h *RecommendationHandler) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.GET(SimilarJobsRoute, h.GetSimilarJobs)
+}
+
+// GetSimilarJobs godoc
+// @Summary Get similar jobs
+// @Description Returns active jobs with the same experience level that share the most required technologies
+// @Description with the given job
+// @Tags jobs
+// @Produce json
+// @Param id path int true "Job ID"
+// @Param limit query int false "Number of jobs to return (max 20)" default(5) example(5)
+// @Param exclude_same_company query bool false "Exclude jobs of the same company" default(false)
+// @Param fields query string false "Comma-separated job fields to return, all when empty" \
+// example("job_id,title,shared_technologies")
+// @Success 200 {object} SimilarJobListResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /jobs/{id}/similar [get]
+func (h *RecommendationHandler) GetSimilarJobs(c *gin.Context) {
+	jobID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(&httpservice.ValidationError{Errors: []string{"invalid job id"}})
+		return
+	}
+
+	var req SimilarRequest
+	if err = c.ShouldBindQuery(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+	fields, err := httpservice.ParseFields(req.Fields, similarJobFields)
+	if err != nil {
+		_ = c.Error(&httpservice.ValidationError{Errors: []string{err.Error()}})
+		return
+	}
+
+	similar, err := h.service.SimilarJobs(c.Request.Context(), req.ToSimilarParams(jobID))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if len(fields) == 0 {
+		c.JSON(http.StatusOK, similar)
+		return
+	}
+
+	data, err := httpservice.SelectListFields(similar.Data, fields)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, httpservice.ListResponse{Data: data})
+}
+
+// ExportHandler handles HTTP requests for job search exports
+type ExportHandler struct {
+	service *ExportService
+}
+
+// NewExportHandler creates a new job export handler
+func NewExportHandler(service *ExportService) *ExportHandler {
+	return &ExportHandler{service: service}
+}
+
+// RegisterRoutes registers the job export routes with the given router group, which is expected
+// to rate limit them
+func (h *ExportHandler) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.GET(ExportJobsRoute, h.ExportJobs)
+}
+
+// ExportJobs godoc
+// @Summary Export a job search as CSV
+// @Description Streams the jobs found by a search as a CSV file, with the same filters and order as
+// @Description the job search and up to a configured number of jobs. Benefits are separated by
+// @Description semicolons. Cells starting with =, +, -, @, a tab or a carriage return are prefixed
+// @Description with a quote so spreadsheets don't run them as formulas. Exports are rate limited per client.
+// @Tags jobs
+// @Produce text/csv,json
+// @Param q query string true "Search query" example("golang developer")
+// @Param experience_level query string false "Experience level filter" \
+// Enums(Entry-level,Junior,Mid-level,Senior,Lead,Principal,Executive) example("Senior")
+// @Param employment_type query string false "Employment type filter" \
+// Enums(Full-time,Part-time,Contract,Freelance,Temporary,Internship) example("Full-time")
+// @Param location query string false "Location filter" Enums(Costa Rica,LATAM) example("Costa Rica")
+// @Param province query string false "Province of Costa Rica filter, accents optional" \
+// Enums(San José,Alajuela,Cartago,Heredia,Guanacaste,Puntarenas,Limón) example("Heredia")
+// @Param work_mode query string false "Work mode filter" Enums(Remote,Hybrid,Onsite) example("Remote")
+// @Param company query string false "Company name filter (partial match)" example("Tech Corp")
+// @Param function query string false "Job function filter (see /job-functions)" example("Backend")
+// @Param language query string false "Posting language filter" Enums(en,es) example("es")
+// @Param remote_eligibility query string false "Where remote applicants can be based" \
+// Enums(CR only,LATAM only,Worldwide) example("LATAM only")
+// @Param utc_offset query int false "UTC offset in hours the working timezones must include" \
+// minimum(-12) maximum(14) example(-6)
+// @Param benefits query string false "Comma-separated benefit slugs the jobs must all offer (see /benefits)" \
+// example("health-insurance,stock-options")
+// @Param date_from query string false "Start date filter (YYYY-MM-DD)" example("2024-01-01")
+// @Param date_to query string false "End date filter (YYYY-MM-DD)" example("2024-12-31")
+// @Param sort query string false "Order of the jobs" Enums(relevance,date) default(relevance)
+// @Success 200 {string} string "job_id,title,company_name,experience_level,employment_type,location,..."
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 403 {object} httpservice.ErrorResponse
+// @Failure 429 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /jobs/export.csv [get]
+func (h *ExportHandler) ExportJobs(c *gin.Context) {
+	var req SearchRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+	if err := req.Validate(); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	searchParams, err := req.ToSearchParams()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	params := searchParams.(*SearchParams)
+	params.SetCountry(httpservice.CountryOf(c))
+
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", `attachment; filename="jobs.csv"`)
+	writer := NewJobCSVWriter(c.Writer)
+	if err = h.service.Export(c.Request.Context(), params, writer.Write); err == nil {
+		err = writer.Flush()
+	}
+	if err != nil {
+		if c.Writer.Written() {
+			// The export is cut short, the connection is closed instead of ending the response
+			// so the client sees a failed download rather than a truncated CSV
+			panic(http.ErrAbortHandler)
+		}
+		// Nothing was sent yet, so the error is answered with the JSON error response
+		c.Writer.Header().Del("Content-Type")
+		c.Writer.Header().Del("Content-Disposition")
+		_ = c.Error(err)
+	}
+}
+
+// AdminHandler handles HTTP requests for job administration
+type AdminHandler struct {
+	detector   *DuplicateDetector
+	moderation *ModerationService
+}
+
+// NewAdminHandler creates a new job admin handler
+func NewAdminHandler(detector *DuplicateDetector, moderation *ModerationService) *AdminHandler {
+	return &AdminHandler{detector: detector, moderation: moderation}
+}
+
+// RegisterAdminRoutes registers the job admin routes with the given (protected) router group
+func (h *AdminHandler) RegisterAdminRoutes(rg *gin.RouterGroup) {
+	rg.GET(JobsRoute, h.ListJobsByStatus)
+	rg.GET(NearDuplicatesRoute, h.ListNearDuplicates)
+	rg.POST(ApproveJobRoute, h.ApproveJob)
+	rg.POST(RejectJobRoute, h.RejectJob)
+	rg.POST(DeactivateJobRoute, h.DeactivateJob)
+	rg.PATCH(JobRoute, h.PatchJob)
+}
+
+// ListJobsByStatus godoc
+// @Summary List jobs by moderation status
+// @Description Lists the jobs with a moderation status, oldest first. Pending jobs, the default,
+// @Description are waiting for an admin to approve or reject them and are not published. The jobs
+// @Description can be filtered by source, company, active flag and creation dates.
+// @Tags admin
+// @Produce json
+// @Security AdminAPIKey
+// @Param status query string false "Moderation status" Enums(pending,published,rejected) default(pending)
+// @Param source query string false "Source the jobs were scraped from" \
+// Enums(linkedin,greenhouse,company_site,employer_portal) example("linkedin")
+// @Param company_id query int false "Company ID" minimum(1) example(2)
+// @Param is_active query bool false "Whether the jobs are active" example(true)
+// @Param created_from query string false "First creation date of the jobs (YYYY-MM-DD)" example("2024-01-01")
+// @Param created_to query string false "Last creation date of the jobs (YYYY-MM-DD)" example("2024-01-31")
+// @Param limit query int false "Number of jobs to return (max 100)" default(20) example(20)
+// @Param offset query int false "Number of jobs to skip" default(0) example(0)
+// @Success 200 {object} ModerationListResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/jobs [get]
+func (h *AdminHandler) ListJobsByStatus(c *gin.Context) {
+	var req ModerationListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	jobs, err := h.moderation.List(c.Request.Context(), req.ToStatusListParams())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, jobs)
+}
+
+// ApproveJob godoc
+// @Summary Approve a pending job
+// @Description Publishes a pending job, making it active and searchable
+// @Tags admin
+// @Security AdminAPIKey
+// @Param id path int true "Job ID"
+// @Success 204
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 409 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/jobs/{id}/approve [post]
+func (h *AdminHandler) ApproveJob(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(&httpservice.ValidationError{Errors: []string{"invalid job id"}})
+		return
+	}
+
+	if err = h.moderation.Approve(c.Request.Context(), id); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// DeactivateJob godoc
+// @Summary Deactivate a job
+// @Description Takes down an active job, e.g. once the position is filled
+// @Tags admin
+// @Security AdminAPIKey
+// @Param id path int true "Job ID"
+// @Success 204
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 409 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/jobs/{id}/deactivate [post]
+func (h *AdminHandler) DeactivateJob(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(&httpservice.ValidationError{Errors: []string{"invalid job id"}})
+		return
+	}
+
+	if err = h.moderation.Deactivate(c.Request.Context(), id); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// PatchJob godoc
+// @Summary Change some fields of a job
+// @Description Changes the fields of a job present in the request, e.g. to fix a typo, leaving the others
+// @Description as they are. The UTC offsets are changed together.
+// @Tags admin
+// @Accept json
+// @Security AdminAPIKey
+// @Param id path int true "Job ID"
+// @Param request body JobPatchRequest true "Fields to change"
+// @Success 204
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/jobs/{id} [patch]
+func (h *AdminHandler) PatchJob(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(&httpservice.ValidationError{Errors: []string{"invalid job id"}})
+		return
+	}
+
+	var req JobPatchRequest
+	if err = c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	if err = h.moderation.Patch(c.Request.Context(), id, req.ToJobPatch()); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RejectJob godoc
+// @Summary Reject a pending job
+// @Description Rejects a pending job with the reason it won't be published
+// @Tags admin
+// @Accept json
+// @Security AdminAPIKey
+// @Param id path int true "Job ID"
+// @Param request body RejectRequest true "Rejection reason"
+// @Success 204
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 409 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/jobs/{id}/reject [post]
+func (h *AdminHandler) RejectJob(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(&httpservice.ValidationError{Errors: []string{"invalid job id"}})
+		return
+	}
+
+	var req RejectRequest
+	if err = c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	if err = h.moderation.Reject(c.Request.Context(), id, req.Reason); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListNearDuplicates godoc
+// @Summary List near-duplicate jobs
+// @Description Lists pairs of active jobs of the same company posted within a time window whose titles
+// @Description or application URLs are very similar, so reposted jobs can be reviewed
+// @Tags admin
+// @Produce json
+// @Security AdminAPIKey
+// @Param window_days query int false "Maximum days between both postings (max 365)" default(30) example(30)
+// @Param min_title_similarity query number false "Title similarity threshold (0-1]" default(0.8) example(0.8)
+// @Param min_url_similarity query number false "Application URL similarity threshold (0-1]" default(0.9)
+// @Param limit query int false "Number of pairs to return (max 500)" default(100) example(100)
+// @Success 200 {object} NearDuplicateListResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/jobs/near-duplicates [get]
+func (h *AdminHandler) ListNearDuplicates(c *gin.Context) {
+	var req NearDuplicateRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	duplicates, err := h.detector.Find(c.Request.Context(), req.ToNearDuplicateParams())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapNearDuplicatesToResponse(duplicates))
+}
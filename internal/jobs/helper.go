@@ -1,6 +1,7 @@
 package jobs
 
 import (
+	"slices"
 	"strings"
 	"unicode"
 )
@@ -36,3 +37,34 @@ func containsSuspiciousPatterns(query string) bool {
 
 	return false
 }
+
+// parseBenefits splits a comma-separated list of benefit slugs, dropping empty and repeated ones.
+// It returns false when a slug is malformed or there are more than MaxBenefits of them.
+func parseBenefits(value string) ([]string, bool) {
+	var benefits []string
+	for _, slug := range strings.Split(value, ",") {
+		slug = strings.ToLower(strings.TrimSpace(slug))
+		if slug == "" || slices.Contains(benefits, slug) {
+			continue
+		}
+		if !isSlug(slug) {
+			return nil, false
+		}
+		benefits = append(benefits, slug)
+	}
+	return benefits, len(benefits) <= MaxBenefits
+}
+
+// isSlug reports whether s is a lowercase slug like "health-insurance" of at most MaxBenefitLength characters
+func isSlug(s string) bool {
+	if len(s) > MaxBenefitLength || strings.HasPrefix(s, "-") || strings.HasSuffix(s, "-") ||
+		strings.Contains(s, "--") {
+		return false
+	}
+	for _, char := range s {
+		if (char < 'a' || char > 'z') && (char < '0' || char > '9') && char != '-' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,62 @@
+package jobs
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Language is the language a job is posted in
+type Language string
+
+// Job languages. Each one is searched with the PostgreSQL text search dictionary of the language.
+const (
+	LanguageEnglish Language = "en"
+	LanguageSpanish Language = "es"
+)
+
+// IsValid reports whether l is a supported language
+func (l Language) IsValid() bool {
+	return l == LanguageEnglish || l == LanguageSpanish
+}
+
+// Common words that only appear in one of the languages, used to detect the language of a posting
+var (
+	englishWords = wordSet("the", "and", "with", "you", "our", "will", "for", "are", "of", "to",
+		"we", "your", "experience", "team", "work", "skills", "is", "in", "have", "be")
+	spanishWords = wordSet("el", "la", "los", "las", "y", "con", "para", "del", "una", "nuestro",
+		"experiencia", "equipo", "trabajo", "conocimiento", "es", "en", "que", "se", "por", "años")
+)
+
+// DetectLanguage guesses the language of a job from its text by counting the common English and
+// Spanish words it contains. Bilingual postings get the language most of their text is written in,
+// and text without any of those words is English.
+func DetectLanguage(texts ...string) Language {
+	var english, spanish int
+	for _, text := range texts {
+		words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+			return !unicode.IsLetter(r)
+		})
+		for _, word := range words {
+			if _, ok := englishWords[word]; ok {
+				english++
+			}
+			if _, ok := spanishWords[word]; ok {
+				spanish++
+			}
+		}
+	}
+
+	if spanish > english {
+		return LanguageSpanish
+	}
+	return LanguageEnglish
+}
+
+// wordSet builds a set of words
+func wordSet(words ...string) map[string]struct{} {
+	set := make(map[string]struct{}, len(words))
+	for _, word := range words {
+		set[word] = struct{}{}
+	}
+	return set
+}
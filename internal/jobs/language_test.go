@@ -0,0 +1,48 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectLanguage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		texts    []string
+		expected Language
+	}{
+		{
+			name:     "english posting",
+			texts:    []string{"Senior Go Developer", "Join our team and work with the best engineers in the region."},
+			expected: LanguageEnglish,
+		},
+		{
+			name: "spanish posting",
+			texts: []string{"Desarrollador Go Senior",
+				"Buscamos una persona con experiencia en Go para unirse a nuestro equipo de trabajo."},
+			expected: LanguageSpanish,
+		},
+		{
+			name: "bilingual posting mostly in spanish",
+			texts: []string{"Backend Developer",
+				"Requisitos: 5 años de experiencia con Go y PostgreSQL. Trabajo remoto para el equipo de la plataforma. " +
+					"English level: B2 or higher."},
+			expected: LanguageSpanish,
+		},
+		{
+			name:     "no common words",
+			texts:    []string{"Go, PostgreSQL, Kubernetes"},
+			expected: LanguageEnglish,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, DetectLanguage(tt.texts...))
+		})
+	}
+}
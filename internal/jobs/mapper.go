@@ -1,6 +1,10 @@
 package jobs
 
-import "github.com/rodruizronald/ticos-in-tech/internal/jobtech"
+import (
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+	"github.com/rodruizronald/ticos-in-tech/internal/i18n"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobtech"
+)
 
 // Mapping functions to convert between database and API models.
 // This file contains transformation logic that bridges the repository layer (database models)
@@ -8,28 +12,48 @@ import "github.com/rodruizronald/ticos-in-tech/internal/jobtech"
 // to external API representations, including data aggregation and formatting.
 
 // MapJobToResponse converts a single job with company data to API response format.
-// It transforms a database model into a DTO suitable for API responses.
-func MapJobToResponse(job *JobWithCompany, technologies []TechnologyResponse) *JobResponse {
+// It transforms a database model into a DTO suitable for API responses, its enums labeled in lang.
+func MapJobToResponse(job *JobWithCompany, technologies []TechnologyResponse, lang i18n.Language) *JobResponse {
+	var remoteEligibility string
+	if job.RemoteEligibility != nil {
+		remoteEligibility = string(*job.RemoteEligibility)
+	}
+	benefits := job.Benefits
+	if benefits == nil {
+		benefits = []string{}
+	}
+
 	return &JobResponse{
-		ID:              job.ID,
-		CompanyID:       job.CompanyID,
-		CompanyName:     job.CompanyName,
-		CompanyLogoURL:  job.CompanyLogoURL,
-		Title:           job.Title,
-		Description:     job.Description,
-		ExperienceLevel: job.ExperienceLevel,
-		EmploymentType:  job.EmploymentType,
-		Location:        job.Location,
-		WorkMode:        job.WorkMode,
-		ApplicationURL:  job.ApplicationURL,
-		Technologies:    technologies,
-		PostedAt:        job.CreatedAt,
+		ID:                job.ID,
+		CompanySlug:       job.CompanySlug,
+		CompanyName:       job.CompanyName,
+		CompanyLogoURL:    job.CompanyLogoURL,
+		Title:             job.Title,
+		Description:       job.Description,
+		ExperienceLevel:   job.ExperienceLevel,
+		EmploymentType:    job.EmploymentType,
+		Location:          job.Location,
+		WorkMode:          job.WorkMode,
+		Language:          string(job.Language),
+		RemoteEligibility: remoteEligibility,
+		UTCOffsetMin:      job.UTCOffsetMin,
+		UTCOffsetMax:      job.UTCOffsetMax,
+		ApplicationURL:    job.ApplicationURL,
+		Technologies:      technologies,
+		Benefits:          benefits,
+		PostedAt:          job.CreatedAt,
+		Highlight:         job.Highlight,
+
+		ExperienceLevelLabel: i18n.Label(lang, i18n.ExperienceLevel, job.ExperienceLevel),
+		EmploymentTypeLabel:  i18n.Label(lang, i18n.EmploymentType, job.EmploymentType),
+		WorkModeLabel:        i18n.Label(lang, i18n.WorkMode, job.WorkMode),
 	}
 }
 
 // MapJobsToResponse converts jobs with technologies to API response format.
 // It takes jobs with company data and technologies map, transforming them into JobResponse DTOs.
-func MapJobsToResponse(jobs []*JobWithCompany, techMap map[int][]*jobtech.JobTechnologyWithDetails) []*JobResponse {
+func MapJobsToResponse(jobs []*JobWithCompany, techMap map[int][]*jobtech.JobTechnologyWithDetails,
+	lang i18n.Language) []*JobResponse {
 	jobResponses := make([]*JobResponse, len(jobs))
 
 	for i, job := range jobs {
@@ -40,13 +64,109 @@ func MapJobsToResponse(jobs []*JobWithCompany, techMap map[int][]*jobtech.JobTec
 			technologies[j] = TechnologyResponse{
 				Name:     tech.TechName,
 				Category: tech.TechCategory,
+				Primary:  tech.IsPrimary,
 				Required: tech.IsRequired,
 			}
 		}
 
 		// Use the single job mapper
-		jobResponses[i] = MapJobToResponse(job, technologies)
+		jobResponses[i] = MapJobToResponse(job, technologies, lang)
 	}
 
 	return jobResponses
 }
+
+// MapSimilarJobsToResponse converts similar jobs with technologies to the list API response format
+func MapSimilarJobsToResponse(similar []*SimilarJob,
+	techMap map[int][]*jobtech.JobTechnologyWithDetails, lang i18n.Language) *SimilarJobListResponse {
+	jobs := make([]*JobWithCompany, len(similar))
+	for i, job := range similar {
+		jobs[i] = &job.JobWithCompany
+	}
+
+	data := make([]*SimilarJobResponse, len(similar))
+	for i, jobResponse := range MapJobsToResponse(jobs, techMap, lang) {
+		data[i] = &SimilarJobResponse{
+			JobResponse:        *jobResponse,
+			SharedTechnologies: similar[i].SharedTechnologies,
+		}
+	}
+
+	return &SimilarJobListResponse{Data: data}
+}
+
+// MapModerationJobsToResponse converts jobs of the moderation queue to the list API response format
+func MapModerationJobsToResponse(jobs []*ModerationJob, total int, params *StatusListParams,
+	lang i18n.Language) *ModerationListResponse {
+	data := make([]*ModerationJobResponse, len(jobs))
+	for i, job := range jobs {
+		data[i] = &ModerationJobResponse{
+			JobResponse:     *MapJobToResponse(&job.JobWithCompany, []TechnologyResponse{}, lang),
+			Status:          string(job.Status),
+			RejectionReason: job.RejectionReason,
+			ReviewedAt:      job.ReviewedAt,
+			ReviewedBy:      job.ReviewedBy,
+			Source:          string(valueOrZero(job.Source)),
+			SourceJobID:     valueOrZero(job.SourceJobID),
+			CanonicalJobID:  job.CanonicalJobID,
+
+			ApplicationURLCheck: mapLinkStatusToResponse(job.ApplicationURLStatus),
+			CompanyLogoCheck:    mapLinkStatusToResponse(job.CompanyLogoStatus),
+		}
+	}
+
+	return &ModerationListResponse{
+		Data:       data,
+		Pagination: PaginationDetails(httpservice.NewPaginationDetails(total, params.Limit, params.Offset, len(jobs))),
+	}
+}
+
+// mapLinkStatusToResponse converts a link status to its API response, nil when the link wasn't checked
+func mapLinkStatusToResponse(status *LinkStatus) *LinkStatusResponse {
+	if status == nil {
+		return nil
+	}
+	return &LinkStatusResponse{
+		OK:         status.OK,
+		StatusCode: status.StatusCode,
+		Error:      status.Error,
+		CheckedAt:  status.CheckedAt,
+	}
+}
+
+// MapNearDuplicatesToResponse converts near-duplicate job pairs to the list API response format
+func MapNearDuplicatesToResponse(duplicates []*NearDuplicate) *NearDuplicateListResponse {
+	data := make([]*NearDuplicateResponse, 0, len(duplicates))
+	for _, duplicate := range duplicates {
+		data = append(data, &NearDuplicateResponse{
+			CompanyName: duplicate.CompanyName,
+			Jobs: []*DuplicateJobResponse{
+				mapDuplicateCandidateToResponse(&duplicate.First),
+				mapDuplicateCandidateToResponse(&duplicate.Second),
+			},
+			TitleSimilarity: duplicate.TitleSimilarity,
+			URLSimilarity:   duplicate.URLSimilarity,
+		})
+	}
+	return &NearDuplicateListResponse{Data: data}
+}
+
+// mapDuplicateCandidateToResponse converts one job of a near-duplicate pair to its API response format
+func mapDuplicateCandidateToResponse(candidate *DuplicateCandidate) *DuplicateJobResponse {
+	return &DuplicateJobResponse{
+		ID:             candidate.JobID,
+		Title:          candidate.Title,
+		ApplicationURL: candidate.ApplicationURL,
+		Source:         string(valueOrZero(candidate.Source)),
+		PostedAt:       candidate.CreatedAt,
+	}
+}
+
+// valueOrZero returns the value v points to, the zero value when it is nil
+func valueOrZero[T any](v *T) T {
+	var zero T
+	if v == nil {
+		return zero
+	}
+	return *v
+}
@@ -0,0 +1,65 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/i18n"
+)
+
+func TestMapJobToResponse_Labels(t *testing.T) {
+	t.Parallel()
+
+	job := &JobWithCompany{Job: Job{
+		ExperienceLevel: experienceLevelMid,
+		EmploymentType:  employmentTypeFullTime,
+		WorkMode:        workModeOnsite,
+	}}
+
+	tests := []struct {
+		name                    string
+		lang                    i18n.Language
+		expectedExperienceLevel string
+		expectedEmploymentType  string
+		expectedWorkMode        string
+	}{
+		{"english labels", i18n.English, "Mid level", "Full time", "On-site"},
+		{"spanish labels", i18n.Spanish, "Intermedio", "Tiempo completo", "Presencial"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			response := MapJobToResponse(job, []TechnologyResponse{}, tt.lang)
+
+			assert.Equal(t, experienceLevelMid, response.ExperienceLevel)
+			assert.Equal(t, tt.expectedExperienceLevel, response.ExperienceLevelLabel)
+			assert.Equal(t, employmentTypeFullTime, response.EmploymentType)
+			assert.Equal(t, tt.expectedEmploymentType, response.EmploymentTypeLabel)
+			assert.Equal(t, workModeOnsite, response.WorkMode)
+			assert.Equal(t, tt.expectedWorkMode, response.WorkModeLabel)
+		})
+	}
+}
+
+// Every canonical value of the job enums must be labeled in every supported language
+func TestJobEnums_Labeled(t *testing.T) {
+	t.Parallel()
+
+	enums := map[string][]string{
+		i18n.ExperienceLevel: ExperienceLevels(),
+		i18n.EmploymentType:  EmploymentTypes(),
+		i18n.WorkMode:        WorkModes(),
+	}
+
+	for _, lang := range i18n.Supported() {
+		for enum, values := range enums {
+			for _, value := range values {
+				_, ok := i18n.Lookup(lang, enum, value)
+				assert.True(t, ok, "%s %s %q has no label", lang, enum, value)
+			}
+		}
+	}
+}
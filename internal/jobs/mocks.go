@@ -11,6 +11,101 @@ import (
 	mock "github.com/stretchr/testify/mock"
 )
 
+// NewMockCanonicalRepository creates a new instance of MockCanonicalRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCanonicalRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCanonicalRepository {
+	mock := &MockCanonicalRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCanonicalRepository is an autogenerated mock type for the CanonicalRepository type
+type MockCanonicalRepository struct {
+	mock.Mock
+}
+
+type MockCanonicalRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCanonicalRepository) EXPECT() *MockCanonicalRepository_Expecter {
+	return &MockCanonicalRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListCanonicalCandidates provides a mock function for the type MockCanonicalRepository
+func (_mock *MockCanonicalRepository) ListCanonicalCandidates(ctx context.Context, job *Job) ([]*CanonicalCandidate, error) {
+	ret := _mock.Called(ctx, job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCanonicalCandidates")
+	}
+
+	var r0 []*CanonicalCandidate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *Job) ([]*CanonicalCandidate, error)); ok {
+		return returnFunc(ctx, job)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *Job) []*CanonicalCandidate); ok {
+		r0 = returnFunc(ctx, job)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*CanonicalCandidate)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *Job) error); ok {
+		r1 = returnFunc(ctx, job)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCanonicalRepository_ListCanonicalCandidates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCanonicalCandidates'
+type MockCanonicalRepository_ListCanonicalCandidates_Call struct {
+	*mock.Call
+}
+
+// ListCanonicalCandidates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - job *Job
+func (_e *MockCanonicalRepository_Expecter) ListCanonicalCandidates(ctx interface{}, job interface{}) *MockCanonicalRepository_ListCanonicalCandidates_Call {
+	return &MockCanonicalRepository_ListCanonicalCandidates_Call{Call: _e.mock.On("ListCanonicalCandidates", ctx, job)}
+}
+
+func (_c *MockCanonicalRepository_ListCanonicalCandidates_Call) Run(run func(ctx context.Context, job *Job)) *MockCanonicalRepository_ListCanonicalCandidates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *Job
+		if args[1] != nil {
+			arg1 = args[1].(*Job)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCanonicalRepository_ListCanonicalCandidates_Call) Return(canonicalCandidates []*CanonicalCandidate, err error) *MockCanonicalRepository_ListCanonicalCandidates_Call {
+	_c.Call.Return(canonicalCandidates, err)
+	return _c
+}
+
+func (_c *MockCanonicalRepository_ListCanonicalCandidates_Call) RunAndReturn(run func(ctx context.Context, job *Job) ([]*CanonicalCandidate, error)) *MockCanonicalRepository_ListCanonicalCandidates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockDataRepository creates a new instance of MockDataRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockDataRepository(t interface {
@@ -179,3 +274,1367 @@ func (_c *MockDataRepository_SearchJobsWithCount_Call) RunAndReturn(run func(ctx
 	_c.Call.Return(run)
 	return _c
 }
+
+// NewMockDuplicateRepository creates a new instance of MockDuplicateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDuplicateRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDuplicateRepository {
+	mock := &MockDuplicateRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockDuplicateRepository is an autogenerated mock type for the DuplicateRepository type
+type MockDuplicateRepository struct {
+	mock.Mock
+}
+
+type MockDuplicateRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDuplicateRepository) EXPECT() *MockDuplicateRepository_Expecter {
+	return &MockDuplicateRepository_Expecter{mock: &_m.Mock}
+}
+
+// FindNearDuplicates provides a mock function for the type MockDuplicateRepository
+func (_mock *MockDuplicateRepository) FindNearDuplicates(ctx context.Context, params *NearDuplicateParams) ([]*NearDuplicate, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindNearDuplicates")
+	}
+
+	var r0 []*NearDuplicate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *NearDuplicateParams) ([]*NearDuplicate, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *NearDuplicateParams) []*NearDuplicate); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*NearDuplicate)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *NearDuplicateParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDuplicateRepository_FindNearDuplicates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindNearDuplicates'
+type MockDuplicateRepository_FindNearDuplicates_Call struct {
+	*mock.Call
+}
+
+// FindNearDuplicates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *NearDuplicateParams
+func (_e *MockDuplicateRepository_Expecter) FindNearDuplicates(ctx interface{}, params interface{}) *MockDuplicateRepository_FindNearDuplicates_Call {
+	return &MockDuplicateRepository_FindNearDuplicates_Call{Call: _e.mock.On("FindNearDuplicates", ctx, params)}
+}
+
+func (_c *MockDuplicateRepository_FindNearDuplicates_Call) Run(run func(ctx context.Context, params *NearDuplicateParams)) *MockDuplicateRepository_FindNearDuplicates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *NearDuplicateParams
+		if args[1] != nil {
+			arg1 = args[1].(*NearDuplicateParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDuplicateRepository_FindNearDuplicates_Call) Return(nearDuplicates []*NearDuplicate, err error) *MockDuplicateRepository_FindNearDuplicates_Call {
+	_c.Call.Return(nearDuplicates, err)
+	return _c
+}
+
+func (_c *MockDuplicateRepository_FindNearDuplicates_Call) RunAndReturn(run func(ctx context.Context, params *NearDuplicateParams) ([]*NearDuplicate, error)) *MockDuplicateRepository_FindNearDuplicates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockEventPublisher creates a new instance of MockEventPublisher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEventPublisher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEventPublisher {
+	mock := &MockEventPublisher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockEventPublisher is an autogenerated mock type for the EventPublisher type
+type MockEventPublisher struct {
+	mock.Mock
+}
+
+type MockEventPublisher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEventPublisher) EXPECT() *MockEventPublisher_Expecter {
+	return &MockEventPublisher_Expecter{mock: &_m.Mock}
+}
+
+// PublishJobEvent provides a mock function for the type MockEventPublisher
+func (_mock *MockEventPublisher) PublishJobEvent(ctx context.Context, eventType string, job *Job) error {
+	ret := _mock.Called(ctx, eventType, job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishJobEvent")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *Job) error); ok {
+		r0 = returnFunc(ctx, eventType, job)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockEventPublisher_PublishJobEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishJobEvent'
+type MockEventPublisher_PublishJobEvent_Call struct {
+	*mock.Call
+}
+
+// PublishJobEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventType string
+//   - job *Job
+func (_e *MockEventPublisher_Expecter) PublishJobEvent(ctx interface{}, eventType interface{}, job interface{}) *MockEventPublisher_PublishJobEvent_Call {
+	return &MockEventPublisher_PublishJobEvent_Call{Call: _e.mock.On("PublishJobEvent", ctx, eventType, job)}
+}
+
+func (_c *MockEventPublisher_PublishJobEvent_Call) Run(run func(ctx context.Context, eventType string, job *Job)) *MockEventPublisher_PublishJobEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *Job
+		if args[2] != nil {
+			arg2 = args[2].(*Job)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventPublisher_PublishJobEvent_Call) Return(err error) *MockEventPublisher_PublishJobEvent_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockEventPublisher_PublishJobEvent_Call) RunAndReturn(run func(ctx context.Context, eventType string, job *Job) error) *MockEventPublisher_PublishJobEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockExportRepository creates a new instance of MockExportRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExportRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExportRepository {
+	mock := &MockExportRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockExportRepository is an autogenerated mock type for the ExportRepository type
+type MockExportRepository struct {
+	mock.Mock
+}
+
+type MockExportRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExportRepository) EXPECT() *MockExportRepository_Expecter {
+	return &MockExportRepository_Expecter{mock: &_m.Mock}
+}
+
+// StreamSearchJobs provides a mock function for the type MockExportRepository
+func (_mock *MockExportRepository) StreamSearchJobs(ctx context.Context, params *SearchParams, fn func(*JobWithCompany) error) error {
+	ret := _mock.Called(ctx, params, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamSearchJobs")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *SearchParams, func(*JobWithCompany) error) error); ok {
+		r0 = returnFunc(ctx, params, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockExportRepository_StreamSearchJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamSearchJobs'
+type MockExportRepository_StreamSearchJobs_Call struct {
+	*mock.Call
+}
+
+// StreamSearchJobs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *SearchParams
+//   - fn func(*JobWithCompany) error
+func (_e *MockExportRepository_Expecter) StreamSearchJobs(ctx interface{}, params interface{}, fn interface{}) *MockExportRepository_StreamSearchJobs_Call {
+	return &MockExportRepository_StreamSearchJobs_Call{Call: _e.mock.On("StreamSearchJobs", ctx, params, fn)}
+}
+
+func (_c *MockExportRepository_StreamSearchJobs_Call) Run(run func(ctx context.Context, params *SearchParams, fn func(*JobWithCompany) error)) *MockExportRepository_StreamSearchJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *SearchParams
+		if args[1] != nil {
+			arg1 = args[1].(*SearchParams)
+		}
+		var arg2 func(*JobWithCompany) error
+		if args[2] != nil {
+			arg2 = args[2].(func(*JobWithCompany) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExportRepository_StreamSearchJobs_Call) Return(err error) *MockExportRepository_StreamSearchJobs_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockExportRepository_StreamSearchJobs_Call) RunAndReturn(run func(ctx context.Context, params *SearchParams, fn func(*JobWithCompany) error) error) *MockExportRepository_StreamSearchJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockModerationRepository creates a new instance of MockModerationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockModerationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockModerationRepository {
+	mock := &MockModerationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockModerationRepository is an autogenerated mock type for the ModerationRepository type
+type MockModerationRepository struct {
+	mock.Mock
+}
+
+type MockModerationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockModerationRepository) EXPECT() *MockModerationRepository_Expecter {
+	return &MockModerationRepository_Expecter{mock: &_m.Mock}
+}
+
+// Deactivate provides a mock function for the type MockModerationRepository
+func (_mock *MockModerationRepository) Deactivate(ctx context.Context, id int) (bool, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Deactivate")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) (bool, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) bool); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockModerationRepository_Deactivate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Deactivate'
+type MockModerationRepository_Deactivate_Call struct {
+	*mock.Call
+}
+
+// Deactivate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *MockModerationRepository_Expecter) Deactivate(ctx interface{}, id interface{}) *MockModerationRepository_Deactivate_Call {
+	return &MockModerationRepository_Deactivate_Call{Call: _e.mock.On("Deactivate", ctx, id)}
+}
+
+func (_c *MockModerationRepository_Deactivate_Call) Run(run func(ctx context.Context, id int)) *MockModerationRepository_Deactivate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModerationRepository_Deactivate_Call) Return(b bool, err error) *MockModerationRepository_Deactivate_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockModerationRepository_Deactivate_Call) RunAndReturn(run func(ctx context.Context, id int) (bool, error)) *MockModerationRepository_Deactivate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockModerationRepository
+func (_mock *MockModerationRepository) GetByID(ctx context.Context, id int) (*Job, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *Job
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) (*Job, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) *Job); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Job)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockModerationRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockModerationRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *MockModerationRepository_Expecter) GetByID(ctx interface{}, id interface{}) *MockModerationRepository_GetByID_Call {
+	return &MockModerationRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockModerationRepository_GetByID_Call) Run(run func(ctx context.Context, id int)) *MockModerationRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModerationRepository_GetByID_Call) Return(job *Job, err error) *MockModerationRepository_GetByID_Call {
+	_c.Call.Return(job, err)
+	return _c
+}
+
+func (_c *MockModerationRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, id int) (*Job, error)) *MockModerationRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsCompanyActive provides a mock function for the type MockModerationRepository
+func (_mock *MockModerationRepository) IsCompanyActive(ctx context.Context, companyID int) (bool, error) {
+	ret := _mock.Called(ctx, companyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsCompanyActive")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) (bool, error)); ok {
+		return returnFunc(ctx, companyID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) bool); ok {
+		r0 = returnFunc(ctx, companyID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, companyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockModerationRepository_IsCompanyActive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsCompanyActive'
+type MockModerationRepository_IsCompanyActive_Call struct {
+	*mock.Call
+}
+
+// IsCompanyActive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - companyID int
+func (_e *MockModerationRepository_Expecter) IsCompanyActive(ctx interface{}, companyID interface{}) *MockModerationRepository_IsCompanyActive_Call {
+	return &MockModerationRepository_IsCompanyActive_Call{Call: _e.mock.On("IsCompanyActive", ctx, companyID)}
+}
+
+func (_c *MockModerationRepository_IsCompanyActive_Call) Run(run func(ctx context.Context, companyID int)) *MockModerationRepository_IsCompanyActive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModerationRepository_IsCompanyActive_Call) Return(b bool, err error) *MockModerationRepository_IsCompanyActive_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockModerationRepository_IsCompanyActive_Call) RunAndReturn(run func(ctx context.Context, companyID int) (bool, error)) *MockModerationRepository_IsCompanyActive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByStatus provides a mock function for the type MockModerationRepository
+func (_mock *MockModerationRepository) ListByStatus(ctx context.Context, params *StatusListParams) ([]*ModerationJob, int, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByStatus")
+	}
+
+	var r0 []*ModerationJob
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *StatusListParams) ([]*ModerationJob, int, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *StatusListParams) []*ModerationJob); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ModerationJob)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *StatusListParams) int); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, *StatusListParams) error); ok {
+		r2 = returnFunc(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockModerationRepository_ListByStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByStatus'
+type MockModerationRepository_ListByStatus_Call struct {
+	*mock.Call
+}
+
+// ListByStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *StatusListParams
+func (_e *MockModerationRepository_Expecter) ListByStatus(ctx interface{}, params interface{}) *MockModerationRepository_ListByStatus_Call {
+	return &MockModerationRepository_ListByStatus_Call{Call: _e.mock.On("ListByStatus", ctx, params)}
+}
+
+func (_c *MockModerationRepository_ListByStatus_Call) Run(run func(ctx context.Context, params *StatusListParams)) *MockModerationRepository_ListByStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *StatusListParams
+		if args[1] != nil {
+			arg1 = args[1].(*StatusListParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModerationRepository_ListByStatus_Call) Return(moderationJobs []*ModerationJob, n int, err error) *MockModerationRepository_ListByStatus_Call {
+	_c.Call.Return(moderationJobs, n, err)
+	return _c
+}
+
+func (_c *MockModerationRepository_ListByStatus_Call) RunAndReturn(run func(ctx context.Context, params *StatusListParams) ([]*ModerationJob, int, error)) *MockModerationRepository_ListByStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Patch provides a mock function for the type MockModerationRepository
+func (_mock *MockModerationRepository) Patch(ctx context.Context, id int, patch *JobPatch) (*Job, error) {
+	ret := _mock.Called(ctx, id, patch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Patch")
+	}
+
+	var r0 *Job
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, *JobPatch) (*Job, error)); ok {
+		return returnFunc(ctx, id, patch)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, *JobPatch) *Job); ok {
+		r0 = returnFunc(ctx, id, patch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Job)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, *JobPatch) error); ok {
+		r1 = returnFunc(ctx, id, patch)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockModerationRepository_Patch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Patch'
+type MockModerationRepository_Patch_Call struct {
+	*mock.Call
+}
+
+// Patch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - patch *JobPatch
+func (_e *MockModerationRepository_Expecter) Patch(ctx interface{}, id interface{}, patch interface{}) *MockModerationRepository_Patch_Call {
+	return &MockModerationRepository_Patch_Call{Call: _e.mock.On("Patch", ctx, id, patch)}
+}
+
+func (_c *MockModerationRepository_Patch_Call) Run(run func(ctx context.Context, id int, patch *JobPatch)) *MockModerationRepository_Patch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 *JobPatch
+		if args[2] != nil {
+			arg2 = args[2].(*JobPatch)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModerationRepository_Patch_Call) Return(job *Job, err error) *MockModerationRepository_Patch_Call {
+	_c.Call.Return(job, err)
+	return _c
+}
+
+func (_c *MockModerationRepository_Patch_Call) RunAndReturn(run func(ctx context.Context, id int, patch *JobPatch) (*Job, error)) *MockModerationRepository_Patch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshSearchView provides a mock function for the type MockModerationRepository
+func (_mock *MockModerationRepository) RefreshSearchView(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshSearchView")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockModerationRepository_RefreshSearchView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshSearchView'
+type MockModerationRepository_RefreshSearchView_Call struct {
+	*mock.Call
+}
+
+// RefreshSearchView is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockModerationRepository_Expecter) RefreshSearchView(ctx interface{}) *MockModerationRepository_RefreshSearchView_Call {
+	return &MockModerationRepository_RefreshSearchView_Call{Call: _e.mock.On("RefreshSearchView", ctx)}
+}
+
+func (_c *MockModerationRepository_RefreshSearchView_Call) Run(run func(ctx context.Context)) *MockModerationRepository_RefreshSearchView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModerationRepository_RefreshSearchView_Call) Return(err error) *MockModerationRepository_RefreshSearchView_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockModerationRepository_RefreshSearchView_Call) RunAndReturn(run func(ctx context.Context) error) *MockModerationRepository_RefreshSearchView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Review provides a mock function for the type MockModerationRepository
+func (_mock *MockModerationRepository) Review(ctx context.Context, id int, status Status, reason string) (bool, error) {
+	ret := _mock.Called(ctx, id, status, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Review")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, Status, string) (bool, error)); ok {
+		return returnFunc(ctx, id, status, reason)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, Status, string) bool); ok {
+		r0 = returnFunc(ctx, id, status, reason)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, Status, string) error); ok {
+		r1 = returnFunc(ctx, id, status, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockModerationRepository_Review_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Review'
+type MockModerationRepository_Review_Call struct {
+	*mock.Call
+}
+
+// Review is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - status Status
+//   - reason string
+func (_e *MockModerationRepository_Expecter) Review(ctx interface{}, id interface{}, status interface{}, reason interface{}) *MockModerationRepository_Review_Call {
+	return &MockModerationRepository_Review_Call{Call: _e.mock.On("Review", ctx, id, status, reason)}
+}
+
+func (_c *MockModerationRepository_Review_Call) Run(run func(ctx context.Context, id int, status Status, reason string)) *MockModerationRepository_Review_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 Status
+		if args[2] != nil {
+			arg2 = args[2].(Status)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModerationRepository_Review_Call) Return(b bool, err error) *MockModerationRepository_Review_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockModerationRepository_Review_Call) RunAndReturn(run func(ctx context.Context, id int, status Status, reason string) (bool, error)) *MockModerationRepository_Review_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSearchCache creates a new instance of MockSearchCache. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSearchCache(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSearchCache {
+	mock := &MockSearchCache{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSearchCache is an autogenerated mock type for the SearchCache type
+type MockSearchCache struct {
+	mock.Mock
+}
+
+type MockSearchCache_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSearchCache) EXPECT() *MockSearchCache_Expecter {
+	return &MockSearchCache_Expecter{mock: &_m.Mock}
+}
+
+// GetOrLoad provides a mock function for the type MockSearchCache
+func (_mock *MockSearchCache) GetOrLoad(ctx context.Context, key string, load func(ctx context.Context) ([]byte, error)) ([]byte, error) {
+	ret := _mock.Called(ctx, key, load)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrLoad")
+	}
+
+	var r0 []byte
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, func(ctx context.Context) ([]byte, error)) ([]byte, error)); ok {
+		return returnFunc(ctx, key, load)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, func(ctx context.Context) ([]byte, error)) []byte); ok {
+		r0 = returnFunc(ctx, key, load)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, func(ctx context.Context) ([]byte, error)) error); ok {
+		r1 = returnFunc(ctx, key, load)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSearchCache_GetOrLoad_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrLoad'
+type MockSearchCache_GetOrLoad_Call struct {
+	*mock.Call
+}
+
+// GetOrLoad is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - load func(ctx context.Context) ([]byte, error)
+func (_e *MockSearchCache_Expecter) GetOrLoad(ctx interface{}, key interface{}, load interface{}) *MockSearchCache_GetOrLoad_Call {
+	return &MockSearchCache_GetOrLoad_Call{Call: _e.mock.On("GetOrLoad", ctx, key, load)}
+}
+
+func (_c *MockSearchCache_GetOrLoad_Call) Run(run func(ctx context.Context, key string, load func(ctx context.Context) ([]byte, error))) *MockSearchCache_GetOrLoad_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 func(ctx context.Context) ([]byte, error)
+		if args[2] != nil {
+			arg2 = args[2].(func(ctx context.Context) ([]byte, error))
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSearchCache_GetOrLoad_Call) Return(bytes []byte, err error) *MockSearchCache_GetOrLoad_Call {
+	_c.Call.Return(bytes, err)
+	return _c
+}
+
+func (_c *MockSearchCache_GetOrLoad_Call) RunAndReturn(run func(ctx context.Context, key string, load func(ctx context.Context) ([]byte, error)) ([]byte, error)) *MockSearchCache_GetOrLoad_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSearchIndexer creates a new instance of MockSearchIndexer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSearchIndexer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSearchIndexer {
+	mock := &MockSearchIndexer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSearchIndexer is an autogenerated mock type for the SearchIndexer type
+type MockSearchIndexer struct {
+	mock.Mock
+}
+
+type MockSearchIndexer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSearchIndexer) EXPECT() *MockSearchIndexer_Expecter {
+	return &MockSearchIndexer_Expecter{mock: &_m.Mock}
+}
+
+// IndexJobs provides a mock function for the type MockSearchIndexer
+func (_mock *MockSearchIndexer) IndexJobs(ctx context.Context, jobIDs []int) error {
+	ret := _mock.Called(ctx, jobIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IndexJobs")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int) error); ok {
+		r0 = returnFunc(ctx, jobIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSearchIndexer_IndexJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IndexJobs'
+type MockSearchIndexer_IndexJobs_Call struct {
+	*mock.Call
+}
+
+// IndexJobs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobIDs []int
+func (_e *MockSearchIndexer_Expecter) IndexJobs(ctx interface{}, jobIDs interface{}) *MockSearchIndexer_IndexJobs_Call {
+	return &MockSearchIndexer_IndexJobs_Call{Call: _e.mock.On("IndexJobs", ctx, jobIDs)}
+}
+
+func (_c *MockSearchIndexer_IndexJobs_Call) Run(run func(ctx context.Context, jobIDs []int)) *MockSearchIndexer_IndexJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []int
+		if args[1] != nil {
+			arg1 = args[1].([]int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSearchIndexer_IndexJobs_Call) Return(err error) *MockSearchIndexer_IndexJobs_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSearchIndexer_IndexJobs_Call) RunAndReturn(run func(ctx context.Context, jobIDs []int) error) *MockSearchIndexer_IndexJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSimilarRepository creates a new instance of MockSimilarRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSimilarRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSimilarRepository {
+	mock := &MockSimilarRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSimilarRepository is an autogenerated mock type for the SimilarRepository type
+type MockSimilarRepository struct {
+	mock.Mock
+}
+
+type MockSimilarRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSimilarRepository) EXPECT() *MockSimilarRepository_Expecter {
+	return &MockSimilarRepository_Expecter{mock: &_m.Mock}
+}
+
+// FindSimilarJobs provides a mock function for the type MockSimilarRepository
+func (_mock *MockSimilarRepository) FindSimilarJobs(ctx context.Context, params *SimilarParams) ([]*SimilarJob, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSimilarJobs")
+	}
+
+	var r0 []*SimilarJob
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *SimilarParams) ([]*SimilarJob, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *SimilarParams) []*SimilarJob); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*SimilarJob)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *SimilarParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSimilarRepository_FindSimilarJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSimilarJobs'
+type MockSimilarRepository_FindSimilarJobs_Call struct {
+	*mock.Call
+}
+
+// FindSimilarJobs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *SimilarParams
+func (_e *MockSimilarRepository_Expecter) FindSimilarJobs(ctx interface{}, params interface{}) *MockSimilarRepository_FindSimilarJobs_Call {
+	return &MockSimilarRepository_FindSimilarJobs_Call{Call: _e.mock.On("FindSimilarJobs", ctx, params)}
+}
+
+func (_c *MockSimilarRepository_FindSimilarJobs_Call) Run(run func(ctx context.Context, params *SimilarParams)) *MockSimilarRepository_FindSimilarJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *SimilarParams
+		if args[1] != nil {
+			arg1 = args[1].(*SimilarParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSimilarRepository_FindSimilarJobs_Call) Return(similarJobs []*SimilarJob, err error) *MockSimilarRepository_FindSimilarJobs_Call {
+	_c.Call.Return(similarJobs, err)
+	return _c
+}
+
+func (_c *MockSimilarRepository_FindSimilarJobs_Call) RunAndReturn(run func(ctx context.Context, params *SimilarParams) ([]*SimilarJob, error)) *MockSimilarRepository_FindSimilarJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockSimilarRepository
+func (_mock *MockSimilarRepository) GetByID(ctx context.Context, id int) (*Job, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *Job
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) (*Job, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) *Job); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Job)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSimilarRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockSimilarRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *MockSimilarRepository_Expecter) GetByID(ctx interface{}, id interface{}) *MockSimilarRepository_GetByID_Call {
+	return &MockSimilarRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockSimilarRepository_GetByID_Call) Run(run func(ctx context.Context, id int)) *MockSimilarRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSimilarRepository_GetByID_Call) Return(job *Job, err error) *MockSimilarRepository_GetByID_Call {
+	_c.Call.Return(job, err)
+	return _c
+}
+
+func (_c *MockSimilarRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, id int) (*Job, error)) *MockSimilarRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobTechnologiesBatch provides a mock function for the type MockSimilarRepository
+func (_mock *MockSimilarRepository) GetJobTechnologiesBatch(ctx context.Context, jobIDs []int) (map[int][]*jobtech.JobTechnologyWithDetails, error) {
+	ret := _mock.Called(ctx, jobIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobTechnologiesBatch")
+	}
+
+	var r0 map[int][]*jobtech.JobTechnologyWithDetails
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int) (map[int][]*jobtech.JobTechnologyWithDetails, error)); ok {
+		return returnFunc(ctx, jobIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int) map[int][]*jobtech.JobTechnologyWithDetails); ok {
+		r0 = returnFunc(ctx, jobIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int][]*jobtech.JobTechnologyWithDetails)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []int) error); ok {
+		r1 = returnFunc(ctx, jobIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSimilarRepository_GetJobTechnologiesBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobTechnologiesBatch'
+type MockSimilarRepository_GetJobTechnologiesBatch_Call struct {
+	*mock.Call
+}
+
+// GetJobTechnologiesBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobIDs []int
+func (_e *MockSimilarRepository_Expecter) GetJobTechnologiesBatch(ctx interface{}, jobIDs interface{}) *MockSimilarRepository_GetJobTechnologiesBatch_Call {
+	return &MockSimilarRepository_GetJobTechnologiesBatch_Call{Call: _e.mock.On("GetJobTechnologiesBatch", ctx, jobIDs)}
+}
+
+func (_c *MockSimilarRepository_GetJobTechnologiesBatch_Call) Run(run func(ctx context.Context, jobIDs []int)) *MockSimilarRepository_GetJobTechnologiesBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []int
+		if args[1] != nil {
+			arg1 = args[1].([]int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSimilarRepository_GetJobTechnologiesBatch_Call) Return(intToJobTechnologyWithDetailss map[int][]*jobtech.JobTechnologyWithDetails, err error) *MockSimilarRepository_GetJobTechnologiesBatch_Call {
+	_c.Call.Return(intToJobTechnologyWithDetailss, err)
+	return _c
+}
+
+func (_c *MockSimilarRepository_GetJobTechnologiesBatch_Call) RunAndReturn(run func(ctx context.Context, jobIDs []int) (map[int][]*jobtech.JobTechnologyWithDetails, error)) *MockSimilarRepository_GetJobTechnologiesBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTermRepository creates a new instance of MockTermRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTermRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTermRepository {
+	mock := &MockTermRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTermRepository is an autogenerated mock type for the TermRepository type
+type MockTermRepository struct {
+	mock.Mock
+}
+
+type MockTermRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTermRepository) EXPECT() *MockTermRepository_Expecter {
+	return &MockTermRepository_Expecter{mock: &_m.Mock}
+}
+
+// FindClosestTerms provides a mock function for the type MockTermRepository
+func (_mock *MockTermRepository) FindClosestTerms(ctx context.Context, terms []string, minSimilarity float64) (map[string]string, error) {
+	ret := _mock.Called(ctx, terms, minSimilarity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindClosestTerms")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string, float64) (map[string]string, error)); ok {
+		return returnFunc(ctx, terms, minSimilarity)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string, float64) map[string]string); ok {
+		r0 = returnFunc(ctx, terms, minSimilarity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string, float64) error); ok {
+		r1 = returnFunc(ctx, terms, minSimilarity)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTermRepository_FindClosestTerms_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindClosestTerms'
+type MockTermRepository_FindClosestTerms_Call struct {
+	*mock.Call
+}
+
+// FindClosestTerms is a helper method to define mock.On call
+//   - ctx context.Context
+//   - terms []string
+//   - minSimilarity float64
+func (_e *MockTermRepository_Expecter) FindClosestTerms(ctx interface{}, terms interface{}, minSimilarity interface{}) *MockTermRepository_FindClosestTerms_Call {
+	return &MockTermRepository_FindClosestTerms_Call{Call: _e.mock.On("FindClosestTerms", ctx, terms, minSimilarity)}
+}
+
+func (_c *MockTermRepository_FindClosestTerms_Call) Run(run func(ctx context.Context, terms []string, minSimilarity float64)) *MockTermRepository_FindClosestTerms_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		var arg2 float64
+		if args[2] != nil {
+			arg2 = args[2].(float64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTermRepository_FindClosestTerms_Call) Return(stringToString map[string]string, err error) *MockTermRepository_FindClosestTerms_Call {
+	_c.Call.Return(stringToString, err)
+	return _c
+}
+
+func (_c *MockTermRepository_FindClosestTerms_Call) RunAndReturn(run func(ctx context.Context, terms []string, minSimilarity float64) (map[string]string, error)) *MockTermRepository_FindClosestTerms_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSynonyms provides a mock function for the type MockTermRepository
+func (_mock *MockTermRepository) ListSynonyms(ctx context.Context, terms []string) (map[string]string, error) {
+	ret := _mock.Called(ctx, terms)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSynonyms")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) (map[string]string, error)); ok {
+		return returnFunc(ctx, terms)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) map[string]string); ok {
+		r0 = returnFunc(ctx, terms)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, terms)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTermRepository_ListSynonyms_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSynonyms'
+type MockTermRepository_ListSynonyms_Call struct {
+	*mock.Call
+}
+
+// ListSynonyms is a helper method to define mock.On call
+//   - ctx context.Context
+//   - terms []string
+func (_e *MockTermRepository_Expecter) ListSynonyms(ctx interface{}, terms interface{}) *MockTermRepository_ListSynonyms_Call {
+	return &MockTermRepository_ListSynonyms_Call{Call: _e.mock.On("ListSynonyms", ctx, terms)}
+}
+
+func (_c *MockTermRepository_ListSynonyms_Call) Run(run func(ctx context.Context, terms []string)) *MockTermRepository_ListSynonyms_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTermRepository_ListSynonyms_Call) Return(stringToString map[string]string, err error) *MockTermRepository_ListSynonyms_Call {
+	_c.Call.Return(stringToString, err)
+	return _c
+}
+
+func (_c *MockTermRepository_ListSynonyms_Call) RunAndReturn(run func(ctx context.Context, terms []string) (map[string]string, error)) *MockTermRepository_ListSynonyms_Call {
+	_c.Call.Return(run)
+	return _c
+}
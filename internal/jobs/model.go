@@ -8,42 +8,298 @@ import (
 // This file contains the core database models and search parameters used by the repository layer.
 // These models map directly to database tables and are used for data persistence operations.
 
+// ChangesChannel is the notification channel where the database announces changes to jobs, their
+// technologies and companies. The payload is the changed table and the operation, e.g. "jobs:UPDATE".
+const ChangesChannel = "job_changes"
+
+// Status is the moderation status of a job
+type Status string
+
+// Job moderation statuses. Only published jobs can be active.
+const (
+	StatusPending   Status = "pending"
+	StatusPublished Status = "published"
+	StatusRejected  Status = "rejected"
+)
+
+// IsValid reports whether s is a known job status
+func (s Status) IsValid() bool {
+	return s == StatusPending || s == StatusPublished || s == StatusRejected
+}
+
+// RemoteEligibility is where the applicants of a remote job can be based
+type RemoteEligibility string
+
+// Remote eligibilities of jobs
+const (
+	RemoteEligibilityCostaRica RemoteEligibility = "CR only"
+	RemoteEligibilityLATAM     RemoteEligibility = "LATAM only"
+	RemoteEligibilityWorldwide RemoteEligibility = "Worldwide"
+)
+
+// IsValid reports whether e is a known remote eligibility
+func (e RemoteEligibility) IsValid() bool {
+	return e == RemoteEligibilityCostaRica || e == RemoteEligibilityLATAM || e == RemoteEligibilityWorldwide
+}
+
+// Source is where a job was scraped from
+type Source string
+
+// Job sources. Jobs posted by companies themselves come from the employer portal.
+const (
+	SourceLinkedIn       Source = "linkedin"
+	SourceGreenhouse     Source = "greenhouse"
+	SourceCompanySite    Source = "company_site"
+	SourceEmployerPortal Source = "employer_portal"
+)
+
+// IsValid reports whether s is a known job source
+func (s Source) IsValid() bool {
+	return s == SourceLinkedIn || s == SourceGreenhouse || s == SourceCompanySite || s == SourceEmployerPortal
+}
+
+// Bounds of the UTC offsets of the working timezones of a job, in hours
+const (
+	MinUTCOffset = -12
+	MaxUTCOffset = 14
+)
+
 // Job represents the database entity
 type Job struct {
-	ID              int       `db:"id"`
-	CompanyID       int       `db:"company_id"`
-	Title           string    `db:"title"`
-	Description     string    `db:"description"`
-	ExperienceLevel string    `db:"experience_level"`
-	EmploymentType  string    `db:"employment_type"`
-	Location        string    `db:"location"`
-	WorkMode        string    `db:"work_mode"`
-	ApplicationURL  string    `db:"application_url"`
-	IsActive        bool      `db:"is_active"`
-	Signature       string    `db:"signature"`
-	CreatedAt       time.Time `db:"created_at"`
-	UpdatedAt       time.Time `db:"updated_at"`
+	ID        int    `db:"id"`
+	CompanyID int    `db:"company_id"`
+	Title     string `db:"title"`
+	// Description is the sanitized HTML of the description, the only version served
+	Description string `db:"description"`
+	// RawDescription is the description as scraped, kept to sanitize it again when the rules change
+	RawDescription  string `db:"raw_description"`
+	ExperienceLevel string `db:"experience_level"`
+	// ExperienceLevelInferred is true when the experience level was missing or unknown in the job
+	// data, and was inferred from the title and description
+	ExperienceLevelInferred bool     `db:"experience_level_inferred"`
+	EmploymentType          string   `db:"employment_type"`
+	Location                string   `db:"location"`
+	WorkMode                string   `db:"work_mode"`
+	ApplicationURL          string   `db:"application_url"`
+	IsActive                bool     `db:"is_active"`
+	Signature               string   `db:"signature"`
+	Language                Language `db:"language"`
+	Status                  Status   `db:"status"`
+	// RemoteEligibility is nil when the posting doesn't say where applicants can be based
+	RemoteEligibility *RemoteEligibility `db:"remote_eligibility"`
+	// UTCOffsetMin and UTCOffsetMax bound the timezones the job is worked in, nil when unknown
+	UTCOffsetMin *int `db:"utc_offset_min"`
+	UTCOffsetMax *int `db:"utc_offset_max"`
+	// LocationID is the place of the job in the locations table, nil for jobs stored before it existed
+	LocationID *int `db:"location_id"`
+	// Source is where the job was scraped from and SourceJobID its ID there, nil for jobs stored
+	// before they were recorded. SourceJobID is nil when the source has no job IDs.
+	Source      *Source `db:"source"`
+	SourceJobID *string `db:"source_job_id"`
+	// CanonicalJobID is the job this one is an alternate of, the same opening found at another
+	// source, nil for canonical jobs. Alternates are left out of the public search.
+	CanonicalJobID *int      `db:"canonical_job_id"`
+	CreatedAt      time.Time `db:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at"`
 }
 
 // JobWithCompany represents a job with company details (for read operations only)
 type JobWithCompany struct {
 	Job                   // Embed the original Job struct
 	CompanyName    string `db:"company_name"`
+	CompanySlug    string `db:"company_slug"`
 	CompanyLogoURL string `db:"company_logo_url"`
+	// Benefits are the slugs of the benefits offered with the job
+	Benefits []string `db:"benefits"`
+	// Highlight holds snippets of the description with the matched terms marked, only set by
+	// searches asking for them
+	Highlight string `db:"highlight"`
+}
+
+// ModerationJob represents a job with its moderation details (for the moderation queue)
+type ModerationJob struct {
+	JobWithCompany
+	RejectionReason string     `db:"rejection_reason"`
+	ReviewedAt      *time.Time `db:"reviewed_at"`
+	// ReviewedBy is the actor who last reviewed the job, empty when unknown, see the actor package
+	ReviewedBy string `db:"reviewed_by"`
+	// ApplicationURLStatus and CompanyLogoStatus are nil until the links are checked
+	ApplicationURLStatus *LinkStatus
+	CompanyLogoStatus    *LinkStatus
+}
+
+// LinkStatus represents the last check of a link shown with a job, see the linkcheck package
+type LinkStatus struct {
+	OK bool
+	// StatusCode is zero when the request failed
+	StatusCode int
+	Error      string
+	CheckedAt  time.Time
+}
+
+// JobPatch holds the fields of a job to change (repository layer). Nil fields are left as they are.
+type JobPatch struct {
+	Title             *string
+	Description       *string
+	ExperienceLevel   *string
+	EmploymentType    *string
+	WorkMode          *string
+	ApplicationURL    *string
+	Language          *Language
+	RemoteEligibility *RemoteEligibility
+	// UTCOffsetMin and UTCOffsetMax are set together, the database requires both bounds or none
+	UTCOffsetMin *int
+	UTCOffsetMax *int
+	// IsFeatured promotes the job in the relevance ranking of the search, see Ranking
+	IsFeatured *bool
+}
+
+// IsEmpty reports whether the patch changes no field
+func (p *JobPatch) IsEmpty() bool {
+	return p.Title == nil && p.Description == nil && p.ExperienceLevel == nil && p.EmploymentType == nil &&
+		p.WorkMode == nil && p.ApplicationURL == nil && p.Language == nil && p.RemoteEligibility == nil &&
+		p.UTCOffsetMin == nil && p.UTCOffsetMax == nil && p.IsFeatured == nil
+}
+
+// StatusListParams defines the parameters to list jobs by moderation status (repository layer)
+type StatusListParams struct {
+	Status Status
+	// Source restricts the jobs to the ones scraped from it. All jobs when empty.
+	Source Source
+	// The other filters restrict the jobs when set
+	CompanyID *int
+	IsActive  *bool
+	// CreatedFrom and CreatedBefore bound the creation time of the jobs, CreatedBefore excluded
+	CreatedFrom   *time.Time
+	CreatedBefore *time.Time
+	Limit         int
+	Offset        int
+}
+
+// SimilarJob represents a job recommended as similar to another one
+type SimilarJob struct {
+	JobWithCompany
+	// SharedTechnologies is the number of required technologies shared with the reference job
+	SharedTechnologies int `db:"shared_technologies"`
+}
+
+// SimilarParams defines the parameters to find jobs similar to a reference job (repository layer)
+type SimilarParams struct {
+	JobID              int
+	ExcludeSameCompany bool
+	Limit              int
+}
+
+// SearchDocument holds a job as it is indexed by an external search backend
+type SearchDocument struct {
+	JobWithCompany
+	Technologies []string
+	Functions    []string
+	// Province is the province of Costa Rica the job is located in, empty when unknown
+	Province string
+	// Country is the ISO 3166-1 alpha-2 code of the country of the job
+	Country string
+}
+
+// SignatureSource holds the job fields a signature is computed from
+type SignatureSource struct {
+	JobID          int    `db:"id"`
+	CompanyName    string `db:"company_name"`
+	Title          string `db:"title"`
+	ApplicationURL string `db:"application_url"`
+}
+
+// Signature computes the canonical signature of the job
+func (s *SignatureSource) Signature() string {
+	return ComputeSignature(s.CompanyName, s.Title, s.ApplicationURL)
+}
+
+// DescriptionSource holds the descriptions of a job, as scraped and as stored
+type DescriptionSource struct {
+	JobID          int    `db:"id"`
+	RawDescription string `db:"raw_description"`
+	Description    string `db:"description"`
+}
+
+// Sanitized returns the description sanitized from the raw one
+func (s *DescriptionSource) Sanitized() string {
+	return SanitizeDescription(s.RawDescription)
+}
+
+// NearDuplicateParams defines the parameters to look for near-duplicate jobs (repository layer)
+type NearDuplicateParams struct {
+	// Window is the maximum time between the postings of two jobs
+	Window time.Duration
+	// MinTitleSimilarity and MinURLSimilarity are the trigram similarity thresholds; a pair is
+	// flagged when either its titles or its application URLs reach their threshold
+	MinTitleSimilarity float64
+	MinURLSimilarity   float64
+	// JobIDs restricts the results to pairs involving one of these jobs. All jobs when empty.
+	JobIDs []int
+	Limit  int
+}
+
+// DuplicateCandidate is one of the jobs of a near-duplicate pair
+type DuplicateCandidate struct {
+	JobID          int       `db:"id"`
+	Title          string    `db:"title"`
+	ApplicationURL string    `db:"application_url"`
+	Source         *Source   `db:"source"`
+	CreatedAt      time.Time `db:"created_at"`
+}
+
+// CanonicalCandidate is a published job a new job may be an alternate of
+type CanonicalCandidate struct {
+	JobID          int    `db:"id"`
+	RawDescription string `db:"raw_description"`
+}
+
+// NearDuplicate represents two active jobs of the same company that look like the same posting
+type NearDuplicate struct {
+	CompanyID       int    `db:"company_id"`
+	CompanyName     string `db:"company_name"`
+	First           DuplicateCandidate
+	Second          DuplicateCandidate
+	TitleSimilarity float64 `db:"title_similarity"`
+	URLSimilarity   float64 `db:"url_similarity"`
 }
 
 // SearchParams defines parameters for job search (repository layer)
 type SearchParams struct {
-	Query           string
-	Limit           int
-	Offset          int
-	ExperienceLevel *string
-	EmploymentType  *string
-	Location        *string
-	WorkMode        *string
-	Company         *string
-	DateFrom        *time.Time
-	DateTo          *time.Time
+	Query string
+	// SynonymQuery is the query with its terms replaced by their synonyms, matched as an alternative
+	// to it. Empty when none of the terms has a synonym.
+	SynonymQuery      string
+	Limit             int
+	Offset            int
+	ExperienceLevel   *string
+	EmploymentType    *string
+	Location          *string
+	WorkMode          *string
+	Company           *string
+	Function          *string
+	Province          *string
+	Language          *string
+	RemoteEligibility *string
+	// UTCOffset matches the jobs whose working timezones include the offset, in hours
+	UTCOffset *int
+	// Benefits matches the jobs offering all of the benefits, by slug
+	Benefits []string
+	DateFrom *time.Time
+	DateTo   *time.Time
+	// Fields are the fields of the returned jobs, all of them when empty
+	Fields []string
+	// Highlight adds snippets of the descriptions with the matched terms marked to the jobs
+	Highlight bool
+	// Country matches the jobs of the country, by ISO 3166-1 alpha-2 code, all of them when empty
+	Country string
+	// Sort orders the jobs, by relevance when empty
+	Sort SortOrder
+}
+
+// SetCountry scopes the search to a country to satisfy httpservice.CountryScoped interface
+func (sp *SearchParams) SetCountry(country string) {
+	sp.Country = country
 }
 
 // GetLimit returns the limit for pagination to satisfy httpservice.SearchParams interface
@@ -55,3 +311,8 @@ func (sp *SearchParams) GetLimit() int {
 func (sp *SearchParams) GetOffset() int {
 	return sp.Offset
 }
+
+// GetFields returns the selected job fields to satisfy httpservice.FieldSelection interface
+func (sp *SearchParams) GetFields() []string {
+	return sp.Fields
+}
@@ -0,0 +1,207 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+	"github.com/rodruizronald/ticos-in-tech/internal/i18n"
+)
+
+// Moderation queue settings
+const (
+	DefaultModerationLimit   = 20
+	MaxModerationLimit       = 100
+	MaxRejectionReasonLength = 500
+)
+
+// ModerationRepository interface to review jobs waiting for moderation.
+type ModerationRepository interface {
+	GetByID(ctx context.Context, id int) (*Job, error)
+	ListByStatus(ctx context.Context, params *StatusListParams) ([]*ModerationJob, int, error)
+	Review(ctx context.Context, id int, status Status, reason string) (bool, error)
+	IsCompanyActive(ctx context.Context, companyID int) (bool, error)
+	Deactivate(ctx context.Context, id int) (bool, error)
+	Patch(ctx context.Context, id int, patch *JobPatch) (*Job, error)
+	RefreshSearchView(ctx context.Context) error
+}
+
+// SearchIndexer interface to index published jobs into an external search backend.
+type SearchIndexer interface {
+	IndexJobs(ctx context.Context, jobIDs []int) error
+}
+
+// ModerationService reviews jobs ingested from less trusted sources. They are created as pending
+// and don't show up in search until an admin approves them.
+type ModerationService struct {
+	repo      ModerationRepository
+	indexer   SearchIndexer
+	publisher EventPublisher
+}
+
+// NewModerationService creates a new instance of ModerationService. indexer is optional, it is
+// only needed when jobs are searched from an external backend. publisher is optional too.
+func NewModerationService(repo ModerationRepository, indexer SearchIndexer,
+	publisher EventPublisher) *ModerationService {
+	return &ModerationService{repo: repo, indexer: indexer, publisher: publisher}
+}
+
+// List returns a page of the jobs with the status of params, pending by default, restricted by
+// its other filters. Out of range values are clamped.
+func (s *ModerationService) List(ctx context.Context, params StatusListParams) (*ModerationListResponse, error) {
+	if params.Status == "" {
+		params.Status = StatusPending
+	}
+	if !params.Status.IsValid() {
+		return nil, &httpservice.ValidationError{Errors: []string{
+			fmt.Sprintf("status must be one of %s, %s or %s", StatusPending, StatusPublished, StatusRejected),
+		}}
+	}
+	if params.CreatedFrom != nil && params.CreatedBefore != nil && !params.CreatedFrom.Before(*params.CreatedBefore) {
+		return nil, &httpservice.ValidationError{Errors: []string{"created_from must not be after created_to"}}
+	}
+
+	if params.Limit <= 0 {
+		params.Limit = DefaultModerationLimit
+	}
+	params.Limit = min(params.Limit, MaxModerationLimit)
+	params.Offset = max(params.Offset, 0)
+
+	jobs, total, err := s.repo.ListByStatus(ctx, &params)
+	if err != nil {
+		return nil, err
+	}
+
+	return MapModerationJobsToResponse(jobs, total, &params, i18n.From(ctx)), nil
+}
+
+// Approve publishes a pending job and makes it searchable
+func (s *ModerationService) Approve(ctx context.Context, id int) error {
+	job, err := s.review(ctx, id, StatusPublished, "")
+	if err != nil {
+		return err
+	}
+	job.Status = StatusPublished
+	job.IsActive = true
+
+	if err := s.reindex(ctx, id); err != nil {
+		return err
+	}
+
+	return s.publish(ctx, EventCreated, job)
+}
+
+// Deactivate takes down a published job, e.g. once the position is filled.
+// A NotActiveError is returned when the job is already inactive.
+func (s *ModerationService) Deactivate(ctx context.Context, id int) error {
+	job, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	deactivated, err := s.repo.Deactivate(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !deactivated {
+		return &NotActiveError{ID: id}
+	}
+	job.IsActive = false
+
+	if err := s.reindex(ctx, id); err != nil {
+		return err
+	}
+
+	return s.publish(ctx, EventDeactivated, job)
+}
+
+// Patch changes the fields set in patch of a job, e.g. to fix a typo. Active jobs are reindexed
+// and announced as updated. A NotFoundError is returned when the job doesn't exist.
+func (s *ModerationService) Patch(ctx context.Context, id int, patch *JobPatch) error {
+	if patch.IsEmpty() {
+		return &httpservice.ValidationError{Errors: []string{"at least one field must be provided"}}
+	}
+	if patch.UTCOffsetMin != nil && patch.UTCOffsetMax != nil && *patch.UTCOffsetMin > *patch.UTCOffsetMax {
+		return &httpservice.ValidationError{Errors: []string{"utc_offset_min cannot be greater than utc_offset_max"}}
+	}
+
+	job, err := s.repo.Patch(ctx, id, patch)
+	if err != nil {
+		return err
+	}
+	if !job.IsActive {
+		return nil
+	}
+
+	if err := s.reindex(ctx, id); err != nil {
+		return err
+	}
+
+	return s.publish(ctx, EventUpdated, job)
+}
+
+// Reject rejects a pending job with the reason it won't be published
+func (s *ModerationService) Reject(ctx context.Context, id int, reason string) error {
+	reason = strings.TrimSpace(reason)
+	if reason == "" || len(reason) > MaxRejectionReasonLength {
+		return &httpservice.ValidationError{Errors: []string{
+			fmt.Sprintf("reason is required and must be at most %d characters", MaxRejectionReasonLength),
+		}}
+	}
+
+	_, err := s.review(ctx, id, StatusRejected, reason)
+	return err
+}
+
+// review moves a pending job to status and returns the job as it was before the review.
+// A NotFoundError or a NotPendingError is returned when the job doesn't exist or was already reviewed,
+// and a CompanyInactiveError when publishing a job of an archived company.
+func (s *ModerationService) review(ctx context.Context, id int, status Status, reason string) (*Job, error) {
+	job, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if job.Status != StatusPending {
+		return nil, &NotPendingError{ID: id, Status: job.Status}
+	}
+	if status == StatusPublished {
+		active, err := s.repo.IsCompanyActive(ctx, job.CompanyID)
+		if err != nil {
+			return nil, err
+		}
+		if !active {
+			return nil, &CompanyInactiveError{ID: id, CompanyID: job.CompanyID}
+		}
+	}
+
+	reviewed, err := s.repo.Review(ctx, id, status, reason)
+	if err != nil {
+		return nil, err
+	}
+	if !reviewed {
+		// Reviewed by someone else in the meantime
+		return nil, &NotPendingError{ID: id}
+	}
+
+	return job, nil
+}
+
+// reindex updates the search view and the external search index after a job changed
+func (s *ModerationService) reindex(ctx context.Context, id int) error {
+	if err := s.repo.RefreshSearchView(ctx); err != nil {
+		return err
+	}
+	if s.indexer != nil {
+		return s.indexer.IndexJobs(ctx, []int{id})
+	}
+	return nil
+}
+
+// publish notifies the publisher, when set, of a job event
+func (s *ModerationService) publish(ctx context.Context, eventType string, job *Job) error {
+	if s.publisher == nil {
+		return nil
+	}
+	return s.publisher.PublishJobEvent(ctx, eventType, job)
+}
@@ -0,0 +1,366 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+func TestModerationService_List(t *testing.T) {
+	t.Parallel()
+	february, january := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		params       StatusListParams
+		mockSetup    func(mockRepo *MockModerationRepository)
+		checkResults func(t *testing.T, resp *ModerationListResponse, err error)
+	}{
+		{
+			name:   "pending jobs by default",
+			params: StatusListParams{Limit: 1000},
+			mockSetup: func(mockRepo *MockModerationRepository) {
+				t.Helper()
+				mockRepo.EXPECT().ListByStatus(context.Background(), &StatusListParams{
+					Status: StatusPending,
+					Limit:  MaxModerationLimit,
+				}).Return([]*ModerationJob{{
+					JobWithCompany: JobWithCompany{Job: Job{ID: 7, Status: StatusPending}},
+				}}, 1, nil).Once()
+			},
+			checkResults: func(t *testing.T, resp *ModerationListResponse, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, resp.Data, 1)
+				assert.Equal(t, "pending", resp.Data[0].Status)
+				assert.Equal(t, 1, resp.Pagination.Total)
+				assert.Equal(t, MaxModerationLimit, resp.Pagination.Limit)
+			},
+		},
+		{
+			name:      "invalid status",
+			params:    StatusListParams{Status: "archived"},
+			mockSetup: func(_ *MockModerationRepository) {},
+			checkResults: func(t *testing.T, _ *ModerationListResponse, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+			},
+		},
+		{
+			name:      "inverted date range",
+			params:    StatusListParams{CreatedFrom: &february, CreatedBefore: &january},
+			mockSetup: func(_ *MockModerationRepository) {},
+			checkResults: func(t *testing.T, _ *ModerationListResponse, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Equal(t, []string{"created_from must not be after created_to"}, validationErr.Errors)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockModerationRepository(t)
+			service := NewModerationService(mockRepo, nil, nil)
+
+			tt.mockSetup(mockRepo)
+
+			resp, err := service.List(context.Background(), tt.params)
+			tt.checkResults(t, resp, err)
+		})
+	}
+}
+
+func TestModerationService_Approve(t *testing.T) {
+	t.Parallel()
+	indexError := errors.New("index error")
+
+	tests := []struct {
+		name      string
+		mockSetup func(mockRepo *MockModerationRepository, mockIndexer *MockSearchIndexer,
+			mockPublisher *MockEventPublisher)
+		checkResults func(t *testing.T, err error)
+	}{
+		{
+			name: "job published, indexed and announced",
+			mockSetup: func(mockRepo *MockModerationRepository, mockIndexer *MockSearchIndexer,
+				mockPublisher *MockEventPublisher) {
+				t.Helper()
+				mockRepo.EXPECT().GetByID(context.Background(), 7).Return(&Job{ID: 7, CompanyID: 3, Status: StatusPending}, nil).Once()
+				mockRepo.EXPECT().IsCompanyActive(context.Background(), 3).Return(true, nil).Once()
+				mockRepo.EXPECT().Review(context.Background(), 7, StatusPublished, "").Return(true, nil).Once()
+				mockRepo.EXPECT().RefreshSearchView(context.Background()).Return(nil).Once()
+				mockIndexer.EXPECT().IndexJobs(context.Background(), []int{7}).Return(nil).Once()
+				mockPublisher.EXPECT().PublishJobEvent(context.Background(), EventCreated, mock.MatchedBy(func(j *Job) bool {
+					return j.ID == 7 && j.IsActive && j.Status == StatusPublished
+				})).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "job already published",
+			mockSetup: func(mockRepo *MockModerationRepository, _ *MockSearchIndexer, _ *MockEventPublisher) {
+				t.Helper()
+				mockRepo.EXPECT().GetByID(context.Background(), 7).
+					Return(&Job{ID: 7, Status: StatusPublished}, nil).Once()
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				assert.True(t, IsNotPending(err))
+			},
+		},
+		{
+			name: "job reviewed concurrently",
+			mockSetup: func(mockRepo *MockModerationRepository, _ *MockSearchIndexer, _ *MockEventPublisher) {
+				t.Helper()
+				mockRepo.EXPECT().GetByID(context.Background(), 7).Return(&Job{ID: 7, CompanyID: 3, Status: StatusPending}, nil).Once()
+				mockRepo.EXPECT().IsCompanyActive(context.Background(), 3).Return(true, nil).Once()
+				mockRepo.EXPECT().Review(context.Background(), 7, StatusPublished, "").Return(false, nil).Once()
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				assert.True(t, IsNotPending(err))
+			},
+		},
+		{
+			name: "job of an archived company",
+			mockSetup: func(mockRepo *MockModerationRepository, _ *MockSearchIndexer, _ *MockEventPublisher) {
+				t.Helper()
+				mockRepo.EXPECT().GetByID(context.Background(), 7).
+					Return(&Job{ID: 7, CompanyID: 3, Status: StatusPending}, nil).Once()
+				mockRepo.EXPECT().IsCompanyActive(context.Background(), 3).Return(false, nil).Once()
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				var inactiveErr *CompanyInactiveError
+				require.ErrorAs(t, err, &inactiveErr)
+				assert.Equal(t, 3, inactiveErr.CompanyID)
+				require.ErrorIs(t, err, httpservice.ErrConflict)
+			},
+		},
+		{
+			name: "job not found",
+			mockSetup: func(mockRepo *MockModerationRepository, _ *MockSearchIndexer, _ *MockEventPublisher) {
+				t.Helper()
+				mockRepo.EXPECT().GetByID(context.Background(), 7).Return(nil, &NotFoundError{ID: 7}).Once()
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				assert.True(t, IsNotFound(err))
+			},
+		},
+		{
+			name: "indexing error",
+			mockSetup: func(mockRepo *MockModerationRepository, mockIndexer *MockSearchIndexer,
+				_ *MockEventPublisher) {
+				t.Helper()
+				mockRepo.EXPECT().GetByID(context.Background(), 7).Return(&Job{ID: 7, CompanyID: 3, Status: StatusPending}, nil).Once()
+				mockRepo.EXPECT().IsCompanyActive(context.Background(), 3).Return(true, nil).Once()
+				mockRepo.EXPECT().Review(context.Background(), 7, StatusPublished, "").Return(true, nil).Once()
+				mockRepo.EXPECT().RefreshSearchView(context.Background()).Return(nil).Once()
+				mockIndexer.EXPECT().IndexJobs(context.Background(), []int{7}).Return(indexError).Once()
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, indexError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockModerationRepository(t)
+			mockIndexer := NewMockSearchIndexer(t)
+			mockPublisher := NewMockEventPublisher(t)
+			service := NewModerationService(mockRepo, mockIndexer, mockPublisher)
+
+			tt.mockSetup(mockRepo, mockIndexer, mockPublisher)
+
+			err := service.Approve(context.Background(), 7)
+			tt.checkResults(t, err)
+		})
+	}
+}
+
+func TestModerationService_Reject(t *testing.T) {
+	t.Parallel()
+
+	mockRepo := NewMockModerationRepository(t)
+	service := NewModerationService(mockRepo, nil, nil)
+
+	var validationErr *httpservice.ValidationError
+	require.ErrorAs(t, service.Reject(context.Background(), 7, "  "), &validationErr)
+
+	mockRepo.EXPECT().GetByID(context.Background(), 7).Return(&Job{ID: 7, Status: StatusPending}, nil).Once()
+	mockRepo.EXPECT().Review(context.Background(), 7, StatusRejected, "Recruiting agency ad").Return(true, nil).Once()
+	require.NoError(t, service.Reject(context.Background(), 7, " Recruiting agency ad "))
+}
+
+func TestModerationService_Deactivate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mockRepo *MockModerationRepository, mockPublisher *MockEventPublisher)
+		checkResults func(t *testing.T, err error)
+	}{
+		{
+			name: "job deactivated and announced",
+			mockSetup: func(mockRepo *MockModerationRepository, mockPublisher *MockEventPublisher) {
+				t.Helper()
+				mockRepo.EXPECT().GetByID(context.Background(), 7).
+					Return(&Job{ID: 7, Status: StatusPublished, IsActive: true}, nil).Once()
+				mockRepo.EXPECT().Deactivate(context.Background(), 7).Return(true, nil).Once()
+				mockRepo.EXPECT().RefreshSearchView(context.Background()).Return(nil).Once()
+				mockPublisher.EXPECT().PublishJobEvent(context.Background(), EventDeactivated,
+					mock.MatchedBy(func(j *Job) bool { return j.ID == 7 && !j.IsActive })).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "job already inactive",
+			mockSetup: func(mockRepo *MockModerationRepository, _ *MockEventPublisher) {
+				t.Helper()
+				mockRepo.EXPECT().GetByID(context.Background(), 7).Return(&Job{ID: 7, Status: StatusPending}, nil).Once()
+				mockRepo.EXPECT().Deactivate(context.Background(), 7).Return(false, nil).Once()
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				assert.True(t, IsNotActive(err))
+			},
+		},
+		{
+			name: "job not found",
+			mockSetup: func(mockRepo *MockModerationRepository, _ *MockEventPublisher) {
+				t.Helper()
+				mockRepo.EXPECT().GetByID(context.Background(), 7).Return(nil, &NotFoundError{ID: 7}).Once()
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				assert.True(t, IsNotFound(err))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockModerationRepository(t)
+			mockPublisher := NewMockEventPublisher(t)
+			service := NewModerationService(mockRepo, nil, mockPublisher)
+
+			tt.mockSetup(mockRepo, mockPublisher)
+
+			err := service.Deactivate(context.Background(), 7)
+			tt.checkResults(t, err)
+		})
+	}
+}
+
+func TestModerationService_Patch(t *testing.T) {
+	t.Parallel()
+	title := "Senior Go Developer"
+	offsetMin, offsetMax := -6, -3
+
+	tests := []struct {
+		name         string
+		patch        *JobPatch
+		mockSetup    func(mockRepo *MockModerationRepository, mockPublisher *MockEventPublisher)
+		checkResults func(t *testing.T, err error)
+	}{
+		{
+			name:  "active job patched, reindexed and announced",
+			patch: &JobPatch{Title: &title},
+			mockSetup: func(mockRepo *MockModerationRepository, mockPublisher *MockEventPublisher) {
+				t.Helper()
+				mockRepo.EXPECT().Patch(context.Background(), 7, &JobPatch{Title: &title}).
+					Return(&Job{ID: 7, Title: title, Status: StatusPublished, IsActive: true}, nil).Once()
+				mockRepo.EXPECT().RefreshSearchView(context.Background()).Return(nil).Once()
+				mockPublisher.EXPECT().PublishJobEvent(context.Background(), EventUpdated,
+					mock.MatchedBy(func(j *Job) bool { return j.ID == 7 && j.Title == title })).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:  "inactive job patched without announcing it",
+			patch: &JobPatch{Title: &title},
+			mockSetup: func(mockRepo *MockModerationRepository, _ *MockEventPublisher) {
+				t.Helper()
+				mockRepo.EXPECT().Patch(context.Background(), 7, &JobPatch{Title: &title}).
+					Return(&Job{ID: 7, Title: title, Status: StatusPending}, nil).Once()
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:      "empty patch",
+			patch:     &JobPatch{},
+			mockSetup: func(_ *MockModerationRepository, _ *MockEventPublisher) {},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				assert.ErrorAs(t, err, &validationErr)
+			},
+		},
+		{
+			name:      "UTC offsets out of order",
+			patch:     &JobPatch{UTCOffsetMin: &offsetMax, UTCOffsetMax: &offsetMin},
+			mockSetup: func(_ *MockModerationRepository, _ *MockEventPublisher) {},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Contains(t, validationErr.Errors, "utc_offset_min cannot be greater than utc_offset_max")
+			},
+		},
+		{
+			name:  "job not found",
+			patch: &JobPatch{Title: &title},
+			mockSetup: func(mockRepo *MockModerationRepository, _ *MockEventPublisher) {
+				t.Helper()
+				mockRepo.EXPECT().Patch(context.Background(), 7, &JobPatch{Title: &title}).
+					Return(nil, &NotFoundError{ID: 7}).Once()
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				assert.True(t, IsNotFound(err))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockModerationRepository(t)
+			mockPublisher := NewMockEventPublisher(t)
+			service := NewModerationService(mockRepo, nil, mockPublisher)
+
+			tt.mockSetup(mockRepo, mockPublisher)
+
+			err := service.Patch(context.Background(), 7, tt.patch)
+			tt.checkResults(t, err)
+		})
+	}
+}
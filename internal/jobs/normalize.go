@@ -0,0 +1,113 @@
+package jobs
+
+import (
+	"strings"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/reference"
+)
+
+// Variants of the job attributes found in scraped data, by their normalized form. The canonical
+// values themselves are matched regardless of case and separators, e.g. "FULL-TIME" or "mid level".
+var (
+	experienceLevelVariants = map[string]string{
+		"entry":        experienceLevelEntry,
+		"graduate":     experienceLevelEntry,
+		"trainee":      experienceLevelEntry,
+		"jr":           experienceLevelJunior,
+		"mid":          experienceLevelMid,
+		"middle":       experienceLevelMid,
+		"intermediate": experienceLevelMid,
+		"semi senior":  experienceLevelMid,
+		"ssr":          experienceLevelMid,
+		"sr":           experienceLevelSenior,
+		"tech lead":    experienceLevelLead,
+		"team lead":    experienceLevelLead,
+		"staff":        experienceLevelPrincipal,
+		"director":     experienceLevelExecutive,
+	}
+	employmentTypeVariants = map[string]string{
+		"fulltime":    employmentTypeFullTime,
+		"permanent":   employmentTypeFullTime,
+		"parttime":    employmentTypePartTime,
+		"contractor":  employmentTypeContract,
+		"freelancer":  employmentTypeFreelance,
+		"temp":        employmentTypeTemporary,
+		"intern":      employmentTypeInternship,
+		"internships": employmentTypeInternship,
+	}
+	workModeVariants = map[string]string{
+		"on site":        workModeOnsite,
+		"in office":      workModeOnsite,
+		"office":         workModeOnsite,
+		"presencial":     workModeOnsite,
+		"remoto":         workModeRemote,
+		"work from home": workModeRemote,
+		"wfh":            workModeRemote,
+		"hibrido":        workModeHybrid,
+		"híbrido":        workModeHybrid,
+	}
+)
+
+// NormalizeExperienceLevel maps an experience level of scraped data to its canonical value,
+// reporting false when it matches none
+func NormalizeExperienceLevel(value string) (string, bool) {
+	return normalizeEnum(value, ExperienceLevels(), experienceLevelVariants)
+}
+
+// NormalizeEmploymentType maps an employment type of scraped data to its canonical value,
+// reporting false when it matches none
+func NormalizeEmploymentType(value string) (string, bool) {
+	return normalizeEnum(value, EmploymentTypes(), employmentTypeVariants)
+}
+
+// NormalizeWorkMode maps a work mode of scraped data to its canonical value, reporting false
+// when it matches none
+func NormalizeWorkMode(value string) (string, bool) {
+	return normalizeEnum(value, WorkModes(), workModeVariants)
+}
+
+// ExperienceLevels returns the canonical experience levels, the current reference values
+func ExperienceLevels() []string {
+	return reference.Default().Values(reference.KindExperienceLevel)
+}
+
+// EmploymentTypes returns the canonical employment types, the current reference values
+func EmploymentTypes() []string {
+	return reference.Default().Values(reference.KindEmploymentType)
+}
+
+// WorkModes returns the canonical work modes, the current reference values
+func WorkModes() []string {
+	return reference.Default().Values(reference.KindWorkMode)
+}
+
+// normalizeEnum returns the canonical value matching value, either one of canonical or one of
+// the known variants
+func normalizeEnum(value string, canonical []string, variants map[string]string) (string, bool) {
+	key := normalizeKey(value)
+	if key == "" {
+		return "", false
+	}
+
+	for _, c := range canonical {
+		if normalizeKey(c) == key {
+			return c, true
+		}
+	}
+	if c, ok := variants[key]; ok {
+		return c, true
+	}
+	return "", false
+}
+
+// normalizeKey lowercases a value and separates its words with single spaces, so "Full-time",
+// "FULL_TIME", "full  time" and "full.time" compare equal
+func normalizeKey(value string) string {
+	value = strings.Map(func(r rune) rune {
+		if r == '-' || r == '_' || r == '/' || r == '.' {
+			return ' '
+		}
+		return r
+	}, strings.ToLower(value))
+	return strings.Join(strings.Fields(value), " ")
+}
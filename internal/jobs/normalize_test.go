@@ -0,0 +1,44 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		normalize  func(string) (string, bool)
+		value      string
+		expected   string
+		expectedOK bool
+	}{
+		{"canonical experience level", NormalizeExperienceLevel, "Senior", experienceLevelSenior, true},
+		{"experience level with space", NormalizeExperienceLevel, "Mid level", experienceLevelMid, true},
+		{"experience level upper case", NormalizeExperienceLevel, "ENTRY-LEVEL", experienceLevelEntry, true},
+		{"experience level abbreviation", NormalizeExperienceLevel, " Sr. ", experienceLevelSenior, true},
+		{"experience level variant", NormalizeExperienceLevel, "Semi-Senior", experienceLevelMid, true},
+		{"employment type lower case", NormalizeEmploymentType, "full time", employmentTypeFullTime, true},
+		{"employment type upper case", NormalizeEmploymentType, "FULL-TIME", employmentTypeFullTime, true},
+		{"employment type underscore", NormalizeEmploymentType, "part_time", employmentTypePartTime, true},
+		{"employment type variant", NormalizeEmploymentType, "Contractor", employmentTypeContract, true},
+		{"work mode hyphenated", NormalizeWorkMode, "On-site", workModeOnsite, true},
+		{"work mode in spanish", NormalizeWorkMode, "Remoto", workModeRemote, true},
+		{"unknown work mode", NormalizeWorkMode, "Flexible", "", false},
+		{"empty value", NormalizeWorkMode, "  ", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			value, ok := tt.normalize(tt.value)
+
+			assert.Equal(t, tt.expectedOK, ok)
+			assert.Equal(t, tt.expected, value)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package jobs
+
+import (
+	"time"
+)
+
+// SortOrder is the order of the results of a job search
+type SortOrder string
+
+// Sort orders of the job search
+const (
+	// SortRelevance ranks the jobs by their score, see Ranking
+	SortRelevance SortOrder = "relevance"
+	// SortDate lists the newest jobs first
+	SortDate SortOrder = "date"
+)
+
+// Default weights of the relevance ranking
+const (
+	DefaultRankTextWeight        = 1.0
+	DefaultRankRecencyWeight     = 0.5
+	DefaultRankFeaturedWeight    = 0.3
+	DefaultRankPrimaryTechWeight = 0.2
+	DefaultRankRecencyHalfLife   = 14 * 24 * time.Hour
+)
+
+// Ranking holds the weights of the score the search results are ranked by. The score adds the text
+// relevance of a job, from 0 to 1, its recency, 1 when just posted and halved every RecencyHalfLife,
+// 1 when it is featured and 1 when one of its primary technologies is searched for, each multiplied
+// by its weight.
+type Ranking struct {
+	TextWeight        float64
+	RecencyWeight     float64
+	FeaturedWeight    float64
+	PrimaryTechWeight float64
+	RecencyHalfLife   time.Duration
+}
+
+// DefaultRanking returns the ranking of the searches unless another one is set with SetRanking
+func DefaultRanking() Ranking {
+	return Ranking{
+		TextWeight:        DefaultRankTextWeight,
+		RecencyWeight:     DefaultRankRecencyWeight,
+		FeaturedWeight:    DefaultRankFeaturedWeight,
+		PrimaryTechWeight: DefaultRankPrimaryTechWeight,
+		RecencyHalfLife:   DefaultRankRecencyHalfLife,
+	}
+}
+
+// SetRanking sets the weights of the relevance ranking of the searches. A ranking without a half-life
+// uses the default one. It can be changed while the repository serves searches, the ones running
+// keep the previous ranking.
+func (r *Repository) SetRanking(ranking Ranking) {
+	if ranking.RecencyHalfLife <= 0 {
+		ranking.RecencyHalfLife = DefaultRankRecencyHalfLife
+	}
+	r.ranking.Store(&ranking)
+}
+
+// Ranking returns the weights of the relevance ranking of the searches
+func (r *Repository) Ranking() Ranking {
+	return *r.ranking.Load()
+}
+
+// orderTerms returns the terms the results of a search are ordered by, the output columns of the
+// search prefixed by prefix. Jobs with the same score are the newest first.
+func (o SortOrder) orderTerms(prefix string) []string {
+	if o == SortDate {
+		return []string{prefix + "created_at DESC"}
+	}
+	return []string{prefix + "score DESC", prefix + "created_at DESC"}
+}
@@ -0,0 +1,25 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortOrder_orderTerms(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"score DESC", "created_at DESC"}, SortOrder("").orderTerms(""))
+	assert.Equal(t, []string{"r.score DESC", "r.created_at DESC"}, SortRelevance.orderTerms("r."))
+	assert.Equal(t, []string{"created_at DESC"}, SortDate.orderTerms(""))
+}
+
+func TestRepository_SetRanking(t *testing.T) {
+	t.Parallel()
+
+	repo := NewRepository(nil)
+	assert.Equal(t, DefaultRanking(), repo.Ranking())
+
+	repo.SetRanking(Ranking{TextWeight: 1, FeaturedWeight: 2})
+	assert.Equal(t, Ranking{TextWeight: 1, FeaturedWeight: 2, RecencyHalfLife: DefaultRankRecencyHalfLife}, repo.Ranking())
+}
@@ -5,25 +5,34 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/actor"
+	"github.com/rodruizronald/ticos-in-tech/internal/sqlbuilder"
 )
 
 // SQL query constants
 const (
 	// Base query for selecting job fields
 	selectJobBaseQuery = `
-        SELECT id, company_id, title, description, experience_level, employment_type,
-               location, work_mode, application_url, is_active, signature, created_at, updated_at
+        SELECT id, company_id, title, description, raw_description, experience_level, experience_level_inferred,
+               employment_type, location, work_mode, application_url, is_active, signature, language, status,
+               remote_eligibility, utc_offset_min, utc_offset_max, source, source_job_id, canonical_job_id,
+               created_at, updated_at
         FROM jobs
     `
 
 	createJobQuery = `
         INSERT INTO jobs (
-            company_id, title, description, experience_level, employment_type,
-            location, work_mode, application_url, is_active, signature
-        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+            company_id, title, description, raw_description, experience_level, experience_level_inferred,
+            employment_type, location, work_mode, application_url, is_active, signature, language, status,
+            location_id, remote_eligibility, utc_offset_min, utc_offset_max, source, source_job_id, canonical_job_id,
+            updated_by
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)
         RETURNING id, created_at, updated_at
     `
 
@@ -35,31 +44,256 @@ const (
         WHERE signature = $1
     `
 
+	getJobBySourceJobIDQuery = selectJobBaseQuery + `
+        WHERE source = $1 AND source_job_id = $2
+    `
+
 	updateJobQuery = `
         UPDATE jobs
-        SET company_id = $1, title = $2, description = $3, experience_level = $4,
-            employment_type = $5, location = $6, work_mode = $7, application_url = $8,
-            is_active = $9, signature = $10, updated_at = NOW()
-        WHERE id = $11
+        SET company_id = $1, title = $2, description = $3, raw_description = $4, experience_level = $5,
+            experience_level_inferred = $6, employment_type = $7, location = $8, work_mode = $9,
+            application_url = $10, is_active = $11, signature = $12, language = $13, location_id = $14,
+            remote_eligibility = $15, utc_offset_min = $16, utc_offset_max = $17, source = $18,
+            source_job_id = $19, updated_by = $20, updated_at = NOW()
+        WHERE id = $21
         RETURNING updated_at
     `
 
+	// Updates the columns set by the patch, formatted in, and returns the job as getJobByIDQuery does
+	patchJobQuery = `
+        UPDATE jobs
+        SET %s, updated_at = NOW()
+        WHERE id = %s
+        RETURNING id, company_id, title, description, raw_description, experience_level, experience_level_inferred,
+                  employment_type, location, work_mode, application_url, is_active, signature, language, status,
+                  remote_eligibility, utc_offset_min, utc_offset_max, source, source_job_id, canonical_job_id,
+                  created_at, updated_at
+    `
+
 	deleteJobQuery = `DELETE FROM jobs WHERE id = $1`
 
-	// Full-text search query with company data and total count using window function
+	// Jobs of the status $1 scraped from the source $2, or from any source when it is empty
+	// Optional filters of the jobs listed by status, shared by the count and the list
+	jobsByStatusFilter = `
+        j.status = $1 AND ($2 = '' OR j.source = $2)
+          AND ($3::INT IS NULL OR j.company_id = $3)
+          AND ($4::BOOLEAN IS NULL OR j.is_active = $4)
+          AND ($5::TIMESTAMPTZ IS NULL OR j.created_at >= $5)
+          AND ($6::TIMESTAMPTZ IS NULL OR j.created_at < $6)
+    `
+
+	countJobsByStatusQuery = `SELECT COUNT(*) FROM jobs j WHERE ` + jobsByStatusFilter
+
+	// Oldest jobs first, so the moderation queue is reviewed in arrival order
+	listJobsByStatusQuery = `
+        SELECT j.id, j.company_id, j.title, j.description, j.experience_level, j.employment_type,
+               j.location, j.work_mode, j.application_url, j.is_active, j.signature, j.language, j.status,
+               j.remote_eligibility, j.utc_offset_min, j.utc_offset_max, j.source, j.source_job_id,
+               j.canonical_job_id, j.created_at, j.updated_at, c.name, c.slug, c.logo_url,
+               ARRAY(
+                   SELECT bn.slug FROM job_benefits jbn
+                   JOIN benefits bn ON jbn.benefit_id = bn.id
+                   WHERE jbn.job_id = j.id
+                   ORDER BY bn.slug
+               ) AS benefits, j.rejection_reason, j.reviewed_at, j.reviewed_by,
+               al.ok, al.status_code, al.error, al.checked_at, ll.ok, ll.status_code, ll.error, ll.checked_at
+        FROM jobs j
+        JOIN companies c ON j.company_id = c.id
+        LEFT JOIN link_checks al ON al.kind = 'application_url' AND al.target_id = j.id
+                                AND al.url = j.application_url
+        LEFT JOIN link_checks ll ON ll.kind = 'logo_url' AND ll.target_id = c.id AND ll.url = c.logo_url
+        WHERE ` + jobsByStatusFilter + `
+        ORDER BY j.created_at, j.id
+        LIMIT $7 OFFSET $8
+    `
+
+	// Only pending jobs are reviewed. Approved jobs are published and activated,
+	// rejected ones stay inactive.
+	reviewJobQuery = `
+        UPDATE jobs
+        SET status = $2, is_active = ($2 = 'published'), rejection_reason = $3,
+            reviewed_at = NOW(), reviewed_by = $4, updated_by = $4, updated_at = NOW()
+        WHERE id = $1 AND status = 'pending'
+    `
+
+	isCompanyActiveQuery = `SELECT is_active FROM companies WHERE id = $1`
+
+	deactivateJobQuery = `
+        UPDATE jobs SET is_active = false, updated_by = $2, updated_at = NOW() WHERE id = $1 AND is_active
+    `
+
+	listJobsWithoutSignatureQuery = `
+        SELECT j.id, c.name, j.title, j.application_url
+        FROM jobs j
+        JOIN companies c ON j.company_id = c.id
+        WHERE j.signature IS NULL OR j.signature = ''
+        ORDER BY j.id
+    `
+
+	updateJobSignatureQuery = `UPDATE jobs SET signature = $1, updated_by = $2, updated_at = NOW() WHERE id = $3`
+
+	// Jobs stored before the raw description was kept have it empty, their description is the raw one
+	listJobDescriptionsQuery = `
+        SELECT id, COALESCE(NULLIF(raw_description, ''), description), description
+        FROM jobs
+        ORDER BY id
+    `
+
+	updateJobDescriptionQuery = `
+        UPDATE jobs SET description = $1, raw_description = $2, updated_by = $3, updated_at = NOW() WHERE id = $4
+    `
+
+	// Pairs of active canonical jobs of the same company posted within a time window whose titles or
+	// application URLs are similar enough to be the same posting. Alternates are already grouped.
+	findNearDuplicateJobsQuery = `
+        SELECT a.company_id, c.name,
+               a.id, a.title, a.application_url, a.source, a.created_at,
+               b.id, b.title, b.application_url, b.source, b.created_at,
+               similarity(a.title, b.title) AS title_similarity,
+               similarity(a.application_url, b.application_url) AS url_similarity
+        FROM jobs a
+        JOIN jobs b ON b.company_id = a.company_id AND b.id > a.id
+        JOIN companies c ON a.company_id = c.id
+        WHERE a.is_active = true AND b.is_active = true
+          AND a.canonical_job_id IS NULL AND b.canonical_job_id IS NULL
+          AND ABS(EXTRACT(EPOCH FROM b.created_at - a.created_at)) <= $1
+          AND (similarity(a.title, b.title) >= $2
+               OR similarity(a.application_url, b.application_url) >= $3)
+          AND ($4::int[] IS NULL OR a.id = ANY($4) OR b.id = ANY($4))
+        ORDER BY title_similarity DESC, url_similarity DESC, a.id, b.id
+        LIMIT $5
+    `
+
+	// Published canonical jobs of the company $1 with the title $2, normalized as in signatures, from
+	// another source than $3. Jobs stored before the raw description was kept have it empty.
+	listCanonicalCandidatesQuery = `
+        SELECT id, COALESCE(NULLIF(raw_description, ''), description)
+        FROM jobs
+        WHERE company_id = $1 AND status = 'published' AND is_active = true AND canonical_job_id IS NULL
+          AND lower(regexp_replace(btrim(title), '\s+', ' ', 'g')) = $2
+          AND source IS DISTINCT FROM $3
+        ORDER BY created_at, id
+    `
+
+	// Full-text search query with company data and total count using window function.
+	// It reads from the job_search_view materialized view, which already holds the company data.
+	// Jobs match the search query ($1) or its synonym query ($2), which matches nothing when empty.
+	// They are scored by the weights of the text rank ($3), the recency ($4), halved every $6 seconds,
+	// featured status ($5) and primary technologies searched for ($7), see Ranking. The age is capped
+	// as power fails on underflows. The words of the queries are matched against the primary
+	// technologies, lowercase names and aliases in the view.
 	searchJobsWithCountBaseQuery = `
         WITH search_query AS (
-            SELECT plainto_tsquery('english', $1) AS query
+            SELECT plainto_tsquery('english', $1) || plainto_tsquery('english', $2) AS english,
+                   plainto_tsquery('spanish', $1) || plainto_tsquery('spanish', $2) AS spanish,
+                   regexp_split_to_array(lower(btrim($1 || ' ' || $2)), '\s+') AS terms,
+                   $3::float8 AS text_weight, $4::float8 AS recency_weight, $5::float8 AS featured_weight,
+                   $6::float8 AS half_life, $7::float8 AS primary_tech_weight
         )
         SELECT 
             j.id, j.company_id, j.title, j.description, j.experience_level, j.employment_type,
-            j.location, j.work_mode, j.application_url, j.is_active, j.signature, j.created_at, j.updated_at,
-            c.name as company_name, c.logo_url as company_logo_url,
+            j.location, j.work_mode, j.application_url, j.is_active, j.signature, j.language,
+            j.remote_eligibility, j.utc_offset_min, j.utc_offset_max,
+            j.created_at, j.updated_at, j.company_name, j.company_slug, j.company_logo_url, j.benefits,
+            sq.text_weight * ts_rank_cd(j.search_vector,
+                                        CASE WHEN j.language = 'es' THEN sq.spanish ELSE sq.english END, 32)
+              + sq.recency_weight * power(0.5, LEAST(GREATEST(
+                    EXTRACT(EPOCH FROM NOW() - j.created_at) / sq.half_life, 0), 100))
+              + sq.featured_weight * j.is_featured::int
+              + sq.primary_tech_weight * (j.primary_technologies && sq.terms)::int AS score,
             COUNT(*) OVER() as total_count
-        FROM jobs j
-        JOIN companies c ON j.company_id = c.id, search_query sq
-        WHERE j.is_active = true AND j.search_vector @@ sq.query
+        FROM job_search_view j, search_query sq
+        WHERE j.is_active = true
+          AND ((j.language = 'es' AND j.search_vector @@ sq.spanish)
+               OR (j.language <> 'es' AND j.search_vector @@ sq.english))
+    `
+
+	// Adds snippets of the description with the matched terms marked to the jobs of a search query,
+	// whose queries are $1 and $2. ts_headline is slow, so only the returned page of jobs is highlighted.
+	// The markup of the description is left out, a snippet would cut its tags.
+	highlightSearchQuery = `
+        SELECT r.*, ts_headline(
+                   (CASE WHEN r.language = 'es' THEN 'spanish' ELSE 'english' END)::regconfig,
+                   regexp_replace(r.description, '<[^>]+>', ' ', 'g'),
+                   CASE WHEN r.language = 'es'
+                        THEN plainto_tsquery('spanish', $1) || plainto_tsquery('spanish', $2)
+                        ELSE plainto_tsquery('english', $1) || plainto_tsquery('english', $2)
+                   END,
+                   'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=25, MinWords=10'
+               ) AS highlight
+        FROM (%s) r
+        ORDER BY %s
+    `
+
+	// Active jobs with the same experience level as the reference job ($1), scored by the number
+	// of required technologies they share with it
+	findSimilarJobsQuery = `
+        WITH ref AS (
+            SELECT id, company_id, experience_level FROM jobs WHERE id = $1
+        )
+        SELECT j.id, j.company_id, j.title, j.description, j.experience_level, j.employment_type,
+               j.location, j.work_mode, j.application_url, j.is_active, j.signature, j.language,
+               j.remote_eligibility, j.utc_offset_min, j.utc_offset_max,
+               j.created_at, j.updated_at, c.name, c.slug, c.logo_url,
+               ARRAY(
+                   SELECT bn.slug FROM job_benefits jbn
+                   JOIN benefits bn ON jbn.benefit_id = bn.id
+                   WHERE jbn.job_id = j.id
+                   ORDER BY bn.slug
+               ) AS benefits,
+               COUNT(*) AS shared_technologies
+        FROM ref
+        JOIN job_technologies ref_jt ON ref_jt.job_id = ref.id AND ref_jt.is_required = true
+        JOIN job_technologies jt ON jt.technology_id = ref_jt.technology_id
+             AND jt.is_required = true AND jt.job_id <> ref.id
+        JOIN jobs j ON jt.job_id = j.id AND j.is_active = true AND j.experience_level = ref.experience_level
+        JOIN companies c ON j.company_id = c.id
+        WHERE NOT $2::boolean OR j.company_id <> ref.company_id
+        GROUP BY j.id, c.id
+        ORDER BY shared_technologies DESC, j.created_at DESC, j.id
+        LIMIT $3
+    `
+
+	// Jobs of the search view with the names of their technologies and job functions.
+	// All jobs are returned when $1 is NULL.
+	listSearchDocumentsQuery = `
+        SELECT v.id, v.company_id, v.title, v.description, v.experience_level, v.employment_type,
+               v.location, v.work_mode, v.application_url, v.is_active, v.signature, v.language,
+               v.remote_eligibility, v.utc_offset_min, v.utc_offset_max,
+               v.created_at, v.updated_at,
+               v.company_name, v.company_slug, v.company_logo_url, v.benefits, v.technologies, v.country,
+               COALESCE((
+                   SELECT l.province FROM jobs lj
+                   JOIN locations l ON lj.location_id = l.id
+                   WHERE lj.id = v.id
+               ), '') AS province,
+               ARRAY(
+                   SELECT jf.name FROM job_function_assignments jfa
+                   JOIN job_functions jf ON jfa.function_id = jf.id
+                   WHERE jfa.job_id = v.id
+                   ORDER BY jf.name
+               ) AS functions
+        FROM job_search_view v
+        WHERE $1::int[] IS NULL OR v.id = ANY($1)
+        ORDER BY v.id
     `
+
+	// Refreshing concurrently keeps the view readable while it is rebuilt
+	refreshJobSearchViewQuery = `REFRESH MATERIALIZED VIEW CONCURRENTLY job_search_view`
+
+	// Filter condition matching jobs located in a province
+	provinceFilterCondition = `EXISTS (
+            SELECT 1 FROM jobs lj
+            JOIN locations l ON lj.location_id = l.id
+            WHERE lj.id = j.id AND l.province = ?
+        )`
+
+	// Filter condition matching jobs assigned to a job function by name
+	jobFunctionFilterCondition = `EXISTS (
+            SELECT 1 FROM job_function_assignments jfa
+            JOIN job_functions jf ON jfa.function_id = jf.id
+            WHERE jfa.job_id = j.id AND LOWER(jf.name) = LOWER(?)
+        )`
 )
 
 // Constants for pagination
@@ -76,14 +310,29 @@ type Database interface {
 	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
 }
 
-// Repository handles database operations for the Job model.
+// Repository handles database operations for the Job model. Writes record the actor of their
+// context as the one who last changed the job, see actor.From.
 type Repository struct {
 	db Database
+	// replica serves the public list queries, which tolerate replication lag
+	replica Database
+	// explainer logs the plans of the slow searches, nil unless enabled with ExplainSlowSearches
+	explainer *slowSearchExplainer
+	// ranking weighs the scores the searches are ranked by, replaced as a whole by SetRanking
+	ranking atomic.Pointer[Ranking]
 }
 
 // NewRepository creates a new Repository instance.
 func NewRepository(db Database) *Repository {
-	return &Repository{db: db}
+	return NewRepositoryWithReplica(db, db)
+}
+
+// NewRepositoryWithReplica creates a new Repository instance sending the public list queries
+// to replica and everything else to db.
+func NewRepositoryWithReplica(db, replica Database) *Repository {
+	r := &Repository{db: db, replica: replica}
+	r.SetRanking(DefaultRanking())
+	return r
 }
 
 // SearchJobsWithCount performs a full-text search and returns both results and total count
@@ -91,69 +340,11 @@ func (r *Repository) SearchJobsWithCount(ctx context.Context, params *SearchPara
 	// Trim whitespace from query
 	params.Query = strings.TrimSpace(params.Query)
 
-	// Build additional WHERE conditions
-	whereConditions := []string{}
-	args := []any{params.Query}
-	argCount := 2 // Starting at 2 because $1 is the search query
-
-	// Add optional filters
-	if params.ExperienceLevel != nil {
-		whereConditions = append(whereConditions, fmt.Sprintf("j.experience_level = $%d", argCount))
-		args = append(args, *params.ExperienceLevel)
-		argCount++
-	}
-
-	if params.EmploymentType != nil {
-		whereConditions = append(whereConditions, fmt.Sprintf("j.employment_type = $%d", argCount))
-		args = append(args, *params.EmploymentType)
-		argCount++
-	}
-
-	if params.Location != nil {
-		whereConditions = append(whereConditions, fmt.Sprintf("j.location = $%d", argCount))
-		args = append(args, *params.Location)
-		argCount++
-	}
-
-	if params.WorkMode != nil {
-		whereConditions = append(whereConditions, fmt.Sprintf("j.work_mode = $%d", argCount))
-		args = append(args, *params.WorkMode)
-		argCount++
-	}
-
-	if params.Company != nil {
-		whereConditions = append(whereConditions, fmt.Sprintf("LOWER(c.name) LIKE LOWER($%d)", argCount))
-		args = append(args, "%"+*params.Company+"%")
-		argCount++
-	}
-
-	if params.DateFrom != nil {
-		whereConditions = append(whereConditions, fmt.Sprintf("j.created_at >= $%d", argCount))
-		args = append(args, *params.DateFrom)
-		argCount++
-	}
-
-	if params.DateTo != nil {
-		whereConditions = append(whereConditions, fmt.Sprintf("j.created_at <= $%d", argCount))
-		args = append(args, *params.DateTo)
-		argCount++
-	}
-
-	// Build additional WHERE clause
-	additionalWhere := ""
-	if len(whereConditions) > 0 {
-		additionalWhere = " AND " + strings.Join(whereConditions, " AND ")
-	}
-
-	// Build final search query with ordering and pagination
-	searchQuery := searchJobsWithCountBaseQuery + additionalWhere +
-		fmt.Sprintf(" ORDER BY j.created_at DESC LIMIT $%d OFFSET $%d", argCount, argCount+1)
-
-	// Add pagination parameters
-	args = append(args, params.Limit, params.Offset)
+	searchQuery, args := buildSearchQuery(params, r.Ranking())
 
 	// Execute search query
-	rows, err := r.db.Query(ctx, searchQuery, args...)
+	start := time.Now()
+	rows, err := r.replica.Query(ctx, searchQuery, args...)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to search jobs: %w", err)
 	}
@@ -161,27 +352,11 @@ func (r *Repository) SearchJobsWithCount(ctx context.Context, params *SearchPara
 
 	var jobs []*JobWithCompany
 	var total int
+	var score float64
 
 	for rows.Next() {
 		job := &JobWithCompany{}
-		err = rows.Scan(
-			&job.ID,
-			&job.CompanyID,
-			&job.Title,
-			&job.Description,
-			&job.ExperienceLevel,
-			&job.EmploymentType,
-			&job.Location,
-			&job.WorkMode,
-			&job.ApplicationURL,
-			&job.IsActive,
-			&job.Signature,
-			&job.CreatedAt,
-			&job.UpdatedAt,
-			&job.CompanyName,
-			&job.CompanyLogoURL,
-			&total, // Window function gives us the same total for each row
-		)
+		err = rows.Scan(searchRowDest(job, &score, &total, params.Highlight)...)
 		if err != nil {
 			return nil, 0, fmt.Errorf("failed to scan job row: %w", err)
 		}
@@ -191,6 +366,7 @@ func (r *Repository) SearchJobsWithCount(ctx context.Context, params *SearchPara
 	if err = rows.Err(); err != nil {
 		return nil, 0, fmt.Errorf("error iterating job rows: %w", err)
 	}
+	r.explainer.observe(ctx, searchQuery, args, time.Since(start))
 
 	// If no results, total should be 0
 	if len(jobs) == 0 {
@@ -200,28 +376,171 @@ func (r *Repository) SearchJobsWithCount(ctx context.Context, params *SearchPara
 	return jobs, total, nil
 }
 
-// Create inserts a new job into the database.
+// StreamSearchJobs performs a full-text search like SearchJobsWithCount, calling fn with each job
+// found, in order, as its row is read, so the results are never held in memory together. It stops
+// at the first error returned by fn.
+func (r *Repository) StreamSearchJobs(ctx context.Context, params *SearchParams, fn func(*JobWithCompany) error) error {
+	params.Query = strings.TrimSpace(params.Query)
+
+	searchQuery, args := buildSearchQuery(params, r.Ranking())
+	rows, err := r.replica.Query(ctx, searchQuery, args...)
+	if err != nil {
+		return fmt.Errorf("failed to search jobs: %w", err)
+	}
+	defer rows.Close()
+
+	var total int
+	var score float64
+	for rows.Next() {
+		job := &JobWithCompany{}
+		if err = rows.Scan(searchRowDest(job, &score, &total, params.Highlight)...); err != nil {
+			return fmt.Errorf("failed to scan job row: %w", err)
+		}
+		if err = fn(job); err != nil {
+			return err
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("error iterating job rows: %w", err)
+	}
+	return nil
+}
+
+// searchRowDest returns the scan destinations of a row of the search query into job, its score
+// and the total count of the search
+func searchRowDest(job *JobWithCompany, score *float64, total *int, highlight bool) []any {
+	dest := []any{
+		&job.ID,
+		&job.CompanyID,
+		&job.Title,
+		&job.Description,
+		&job.ExperienceLevel,
+		&job.EmploymentType,
+		&job.Location,
+		&job.WorkMode,
+		&job.ApplicationURL,
+		&job.IsActive,
+		&job.Signature,
+		&job.Language,
+		&job.RemoteEligibility,
+		&job.UTCOffsetMin,
+		&job.UTCOffsetMax,
+		&job.CreatedAt,
+		&job.UpdatedAt,
+		&job.CompanyName,
+		&job.CompanySlug,
+		&job.CompanyLogoURL,
+		&job.Benefits,
+		score,
+		total, // Window function gives us the same total for each row
+	}
+	if highlight {
+		dest = append(dest, &job.Highlight)
+	}
+	return dest
+}
+
+// buildSearchQuery returns the query of a search and its arguments. Jobs match the search queries,
+// narrowed by the optional filters, and are scored by ranking.
+func buildSearchQuery(params *SearchParams, ranking Ranking) (string, []any) {
+	query := sqlbuilder.NewFiltered(searchJobsWithCountBaseQuery, params.Query, params.SynonymQuery,
+		ranking.TextWeight, ranking.RecencyWeight, ranking.FeaturedWeight, ranking.RecencyHalfLife.Seconds(),
+		ranking.PrimaryTechWeight)
+	if params.Country != "" {
+		query.Where("j.country = ?", params.Country)
+	}
+	if params.ExperienceLevel != nil {
+		query.Where("j.experience_level = ?", *params.ExperienceLevel)
+	}
+	if params.EmploymentType != nil {
+		query.Where("j.employment_type = ?", *params.EmploymentType)
+	}
+	if params.Location != nil {
+		query.Where("j.location = ?", *params.Location)
+	}
+	if params.WorkMode != nil {
+		query.Where("j.work_mode = ?", *params.WorkMode)
+	}
+	if params.Company != nil {
+		query.Where("LOWER(j.company_name) LIKE LOWER(?)", "%"+*params.Company+"%")
+	}
+	if params.Function != nil {
+		query.Where(jobFunctionFilterCondition, *params.Function)
+	}
+	if params.Province != nil {
+		query.Where(provinceFilterCondition, *params.Province)
+	}
+	if params.Language != nil {
+		query.Where("j.language = ?", *params.Language)
+	}
+	if params.RemoteEligibility != nil {
+		query.Where("j.remote_eligibility = ?", *params.RemoteEligibility)
+	}
+	if params.UTCOffset != nil {
+		query.Where("? BETWEEN j.utc_offset_min AND j.utc_offset_max", *params.UTCOffset)
+	}
+	if len(params.Benefits) > 0 {
+		query.Where("j.benefits @> ?", params.Benefits)
+	}
+	if params.DateFrom != nil {
+		query.Where("j.created_at >= ?", *params.DateFrom)
+	}
+	if params.DateTo != nil {
+		query.Where("j.created_at <= ?", *params.DateTo)
+	}
+	searchQuery, args := query.OrderBy(params.Sort.orderTerms("")...).Limit(params.Limit).Offset(params.Offset).SQL()
+
+	if params.Highlight {
+		searchQuery = fmt.Sprintf(highlightSearchQuery, searchQuery, strings.Join(params.Sort.orderTerms("r."), ", "))
+	}
+	return searchQuery, args
+}
+
+// Create inserts a new job into the database. Jobs without a status are published, and the
+// language of jobs without one is detected from their title and description. The description
+// is stored as received and sanitized, see Job.RawDescription.
 func (r *Repository) Create(ctx context.Context, job *Job) error {
+	if job.Status == "" {
+		job.Status = StatusPublished
+	}
+	job.sanitizeDescription()
+	if job.Language == "" {
+		job.Language = DetectLanguage(job.Title, job.RawDescription)
+	}
+
 	err := r.db.QueryRow(
 		ctx,
 		createJobQuery,
 		job.CompanyID,
 		job.Title,
 		job.Description,
+		job.RawDescription,
 		job.ExperienceLevel,
+		job.ExperienceLevelInferred,
 		job.EmploymentType,
 		job.Location,
 		job.WorkMode,
 		job.ApplicationURL,
 		job.IsActive,
 		job.Signature,
+		job.Language,
+		job.Status,
+		job.LocationID,
+		job.RemoteEligibility,
+		job.UTCOffsetMin,
+		job.UTCOffsetMax,
+		job.Source,
+		job.SourceJobID,
+		job.CanonicalJobID,
+		actor.From(ctx).String(),
 	).Scan(&job.ID, &job.CreatedAt, &job.UpdatedAt)
 
 	if err != nil {
 		// Check for unique constraint violation (duplicate job signature)
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
-			return &DuplicateError{Signature: job.Signature}
+			return newDuplicateError(pgErr, job)
 		}
 		return fmt.Errorf("failed to create job: %w", err)
 	}
@@ -237,13 +556,23 @@ func (r *Repository) GetByID(ctx context.Context, id int) (*Job, error) {
 		&job.CompanyID,
 		&job.Title,
 		&job.Description,
+		&job.RawDescription,
 		&job.ExperienceLevel,
+		&job.ExperienceLevelInferred,
 		&job.EmploymentType,
 		&job.Location,
 		&job.WorkMode,
 		&job.ApplicationURL,
 		&job.IsActive,
 		&job.Signature,
+		&job.Language,
+		&job.Status,
+		&job.RemoteEligibility,
+		&job.UTCOffsetMin,
+		&job.UTCOffsetMax,
+		&job.Source,
+		&job.SourceJobID,
+		&job.CanonicalJobID,
 		&job.CreatedAt,
 		&job.UpdatedAt,
 	)
@@ -258,21 +587,38 @@ func (r *Repository) GetByID(ctx context.Context, id int) (*Job, error) {
 	return job, nil
 }
 
-// Update updates an existing job in the database.
+// Update updates an existing job in the database. The language of a job without one is detected
+// from its title and description. The description is stored as received and sanitized, see
+// Job.RawDescription.
 func (r *Repository) Update(ctx context.Context, job *Job) error {
+	job.sanitizeDescription()
+	if job.Language == "" {
+		job.Language = DetectLanguage(job.Title, job.RawDescription)
+	}
+
 	err := r.db.QueryRow(
 		ctx,
 		updateJobQuery,
 		job.CompanyID,
 		job.Title,
 		job.Description,
+		job.RawDescription,
 		job.ExperienceLevel,
+		job.ExperienceLevelInferred,
 		job.EmploymentType,
 		job.Location,
 		job.WorkMode,
 		job.ApplicationURL,
 		job.IsActive,
 		job.Signature,
+		job.Language,
+		job.LocationID,
+		job.RemoteEligibility,
+		job.UTCOffsetMin,
+		job.UTCOffsetMax,
+		job.Source,
+		job.SourceJobID,
+		actor.From(ctx).String(),
 		job.ID,
 	).Scan(&job.UpdatedAt)
 
@@ -284,7 +630,7 @@ func (r *Repository) Update(ctx context.Context, job *Job) error {
 		// Check for unique constraint violation (duplicate job signature)
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
-			return &DuplicateError{Signature: job.Signature}
+			return newDuplicateError(pgErr, job)
 		}
 
 		return fmt.Errorf("failed to update job: %w", err)
@@ -293,6 +639,91 @@ func (r *Repository) Update(ctx context.Context, job *Job) error {
 	return nil
 }
 
+// Patch updates the fields set in patch of an existing job, leaving the others as they are, and
+// returns the updated job. The signature is kept, so the scraped posting still matches the job.
+func (r *Repository) Patch(ctx context.Context, id int, patch *JobPatch) (*Job, error) {
+	var set sqlbuilder.Set
+	if patch.Title != nil {
+		set.Add("title", *patch.Title)
+	}
+	if patch.Description != nil {
+		set.Add("description", SanitizeDescription(*patch.Description))
+		set.Add("raw_description", *patch.Description)
+	}
+	if patch.ExperienceLevel != nil {
+		set.Add("experience_level", *patch.ExperienceLevel)
+		set.Add("experience_level_inferred", false)
+	}
+	if patch.EmploymentType != nil {
+		set.Add("employment_type", *patch.EmploymentType)
+	}
+	if patch.WorkMode != nil {
+		set.Add("work_mode", *patch.WorkMode)
+	}
+	if patch.ApplicationURL != nil {
+		set.Add("application_url", *patch.ApplicationURL)
+	}
+	if patch.Language != nil {
+		set.Add("language", *patch.Language)
+	}
+	if patch.RemoteEligibility != nil {
+		set.Add("remote_eligibility", *patch.RemoteEligibility)
+	}
+	if patch.UTCOffsetMin != nil {
+		set.Add("utc_offset_min", *patch.UTCOffsetMin)
+	}
+	if patch.UTCOffsetMax != nil {
+		set.Add("utc_offset_max", *patch.UTCOffsetMax)
+	}
+	if patch.IsFeatured != nil {
+		set.Add("is_featured", *patch.IsFeatured)
+	}
+
+	// Nothing to change, the job is returned as it is
+	if set.Empty() {
+		return r.GetByID(ctx, id)
+	}
+
+	set.Add("updated_by", actor.From(ctx).String())
+	query := fmt.Sprintf(patchJobQuery, set.SQL(), set.Arg(id))
+
+	job := &Job{}
+	err := r.db.QueryRow(ctx, query, set.Values()...).Scan(
+		&job.ID,
+		&job.CompanyID,
+		&job.Title,
+		&job.Description,
+		&job.RawDescription,
+		&job.ExperienceLevel,
+		&job.ExperienceLevelInferred,
+		&job.EmploymentType,
+		&job.Location,
+		&job.WorkMode,
+		&job.ApplicationURL,
+		&job.IsActive,
+		&job.Signature,
+		&job.Language,
+		&job.Status,
+		&job.RemoteEligibility,
+		&job.UTCOffsetMin,
+		&job.UTCOffsetMax,
+		&job.Source,
+		&job.SourceJobID,
+		&job.CanonicalJobID,
+		&job.CreatedAt,
+		&job.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &NotFoundError{ID: id}
+		}
+		return nil, fmt.Errorf("failed to patch job: %w", err)
+	}
+
+	return job, nil
+}
+
 // Delete removes a job from the database.
 func (r *Repository) Delete(ctx context.Context, id int) error {
 	commandTag, err := r.db.Exec(ctx, deleteJobQuery, id)
@@ -315,13 +746,23 @@ func (r *Repository) GetBySignature(ctx context.Context, signature string) (*Job
 		&job.CompanyID,
 		&job.Title,
 		&job.Description,
+		&job.RawDescription,
 		&job.ExperienceLevel,
+		&job.ExperienceLevelInferred,
 		&job.EmploymentType,
 		&job.Location,
 		&job.WorkMode,
 		&job.ApplicationURL,
 		&job.IsActive,
 		&job.Signature,
+		&job.Language,
+		&job.Status,
+		&job.RemoteEligibility,
+		&job.UTCOffsetMin,
+		&job.UTCOffsetMax,
+		&job.Source,
+		&job.SourceJobID,
+		&job.CanonicalJobID,
 		&job.CreatedAt,
 		&job.UpdatedAt,
 	)
@@ -335,3 +776,457 @@ func (r *Repository) GetBySignature(ctx context.Context, signature string) (*Job
 
 	return job, nil
 }
+
+// GetBySourceJobID retrieves a job by its ID at the source it was scraped from.
+func (r *Repository) GetBySourceJobID(ctx context.Context, source Source, sourceJobID string) (*Job, error) {
+	job := &Job{}
+	err := r.db.QueryRow(ctx, getJobBySourceJobIDQuery, source, sourceJobID).Scan(
+		&job.ID,
+		&job.CompanyID,
+		&job.Title,
+		&job.Description,
+		&job.RawDescription,
+		&job.ExperienceLevel,
+		&job.ExperienceLevelInferred,
+		&job.EmploymentType,
+		&job.Location,
+		&job.WorkMode,
+		&job.ApplicationURL,
+		&job.IsActive,
+		&job.Signature,
+		&job.Language,
+		&job.Status,
+		&job.RemoteEligibility,
+		&job.UTCOffsetMin,
+		&job.UTCOffsetMax,
+		&job.Source,
+		&job.SourceJobID,
+		&job.CanonicalJobID,
+		&job.CreatedAt,
+		&job.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &NotFoundError{Source: source, SourceJobID: sourceJobID}
+		}
+		return nil, fmt.Errorf("failed to get job by source job ID: %w", err)
+	}
+
+	return job, nil
+}
+
+// ListJobsWithoutSignature retrieves the jobs that have no signature yet, with the fields
+// needed to compute one.
+func (r *Repository) ListJobsWithoutSignature(ctx context.Context) ([]*SignatureSource, error) {
+	rows, err := r.db.Query(ctx, listJobsWithoutSignatureQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jobs without signature: %w", err)
+	}
+	defer rows.Close()
+
+	var sources []*SignatureSource
+	for rows.Next() {
+		source := &SignatureSource{}
+		err = rows.Scan(
+			&source.JobID,
+			&source.CompanyName,
+			&source.Title,
+			&source.ApplicationURL,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan job row: %w", err)
+		}
+		sources = append(sources, source)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating job rows: %w", err)
+	}
+
+	return sources, nil
+}
+
+// UpdateSignature sets the signature of a job.
+func (r *Repository) UpdateSignature(ctx context.Context, id int, signature string) error {
+	commandTag, err := r.db.Exec(ctx, updateJobSignatureQuery, signature, actor.From(ctx).String(), id)
+	if err != nil {
+		// Check for unique constraint violation (another job already has this signature)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return &DuplicateError{Signature: signature}
+		}
+		return fmt.Errorf("failed to update job signature: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return &NotFoundError{ID: id}
+	}
+
+	return nil
+}
+
+// ListDescriptions retrieves the raw and stored descriptions of every job, so they can be
+// sanitized again.
+func (r *Repository) ListDescriptions(ctx context.Context) ([]*DescriptionSource, error) {
+	rows, err := r.db.Query(ctx, listJobDescriptionsQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list job descriptions: %w", err)
+	}
+	defer rows.Close()
+
+	var sources []*DescriptionSource
+	for rows.Next() {
+		source := &DescriptionSource{}
+		if err = rows.Scan(&source.JobID, &source.RawDescription, &source.Description); err != nil {
+			return nil, fmt.Errorf("failed to scan job row: %w", err)
+		}
+		sources = append(sources, source)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating job rows: %w", err)
+	}
+
+	return sources, nil
+}
+
+// UpdateDescription stores the raw description of a job and its sanitized version
+func (r *Repository) UpdateDescription(ctx context.Context, id int, description, rawDescription string) error {
+	commandTag, err := r.db.Exec(ctx, updateJobDescriptionQuery, description, rawDescription, actor.From(ctx).String(), id)
+	if err != nil {
+		return fmt.Errorf("failed to update job description: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return &NotFoundError{ID: id}
+	}
+
+	return nil
+}
+
+// ListCanonicalCandidates retrieves the published canonical jobs of the company of job with the
+// same title, from another source, the oldest first.
+func (r *Repository) ListCanonicalCandidates(ctx context.Context, job *Job) ([]*CanonicalCandidate, error) {
+	rows, err := r.db.Query(ctx, listCanonicalCandidatesQuery,
+		job.CompanyID, normalizeSignatureField(job.Title), job.Source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list canonical job candidates: %w", err)
+	}
+	defer rows.Close()
+
+	var candidates []*CanonicalCandidate
+	for rows.Next() {
+		candidate := &CanonicalCandidate{}
+		if err = rows.Scan(&candidate.JobID, &candidate.RawDescription); err != nil {
+			return nil, fmt.Errorf("failed to scan canonical job candidate row: %w", err)
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating canonical job candidate rows: %w", err)
+	}
+
+	return candidates, nil
+}
+
+// FindNearDuplicates retrieves pairs of active canonical jobs of the same company that were posted
+// within the params window and have similar titles or application URLs.
+func (r *Repository) FindNearDuplicates(ctx context.Context, params *NearDuplicateParams) ([]*NearDuplicate, error) {
+	var jobIDs []int
+	if len(params.JobIDs) > 0 {
+		jobIDs = params.JobIDs
+	}
+
+	rows, err := r.db.Query(ctx, findNearDuplicateJobsQuery,
+		params.Window.Seconds(),
+		params.MinTitleSimilarity,
+		params.MinURLSimilarity,
+		jobIDs,
+		params.Limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find near-duplicate jobs: %w", err)
+	}
+	defer rows.Close()
+
+	var duplicates []*NearDuplicate
+	for rows.Next() {
+		duplicate := &NearDuplicate{}
+		err = rows.Scan(
+			&duplicate.CompanyID,
+			&duplicate.CompanyName,
+			&duplicate.First.JobID,
+			&duplicate.First.Title,
+			&duplicate.First.ApplicationURL,
+			&duplicate.First.Source,
+			&duplicate.First.CreatedAt,
+			&duplicate.Second.JobID,
+			&duplicate.Second.Title,
+			&duplicate.Second.ApplicationURL,
+			&duplicate.Second.Source,
+			&duplicate.Second.CreatedAt,
+			&duplicate.TitleSimilarity,
+			&duplicate.URLSimilarity,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan near-duplicate row: %w", err)
+		}
+		duplicates = append(duplicates, duplicate)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating near-duplicate rows: %w", err)
+	}
+
+	return duplicates, nil
+}
+
+// RefreshSearchView rebuilds the job_search_view materialized view read by the job search.
+// Job changes are not visible to the search until the view is refreshed.
+func (r *Repository) RefreshSearchView(ctx context.Context) error {
+	if _, err := r.db.Exec(ctx, refreshJobSearchViewQuery); err != nil {
+		return fmt.Errorf("failed to refresh job search view: %w", err)
+	}
+	return nil
+}
+
+// ListSearchDocuments retrieves the searchable jobs with the given IDs, or all of them when ids is
+// empty, as documents for an external search backend. Inactive jobs are not returned.
+func (r *Repository) ListSearchDocuments(ctx context.Context, ids []int) ([]*SearchDocument, error) {
+	var jobIDs []int
+	if len(ids) > 0 {
+		jobIDs = ids
+	}
+
+	rows, err := r.db.Query(ctx, listSearchDocumentsQuery, jobIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list search documents: %w", err)
+	}
+	defer rows.Close()
+
+	var documents []*SearchDocument
+	for rows.Next() {
+		doc := &SearchDocument{}
+		err = rows.Scan(
+			&doc.ID,
+			&doc.CompanyID,
+			&doc.Title,
+			&doc.Description,
+			&doc.ExperienceLevel,
+			&doc.EmploymentType,
+			&doc.Location,
+			&doc.WorkMode,
+			&doc.ApplicationURL,
+			&doc.IsActive,
+			&doc.Signature,
+			&doc.Language,
+			&doc.RemoteEligibility,
+			&doc.UTCOffsetMin,
+			&doc.UTCOffsetMax,
+			&doc.CreatedAt,
+			&doc.UpdatedAt,
+			&doc.CompanyName,
+			&doc.CompanySlug,
+			&doc.CompanyLogoURL,
+			&doc.Benefits,
+			&doc.Technologies,
+			&doc.Country,
+			&doc.Province,
+			&doc.Functions,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan search document row: %w", err)
+		}
+		documents = append(documents, doc)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating search document rows: %w", err)
+	}
+
+	return documents, nil
+}
+
+// FindSimilarJobs retrieves the active jobs with the same experience level as the reference job
+// that share the most required technologies with it.
+func (r *Repository) FindSimilarJobs(ctx context.Context, params *SimilarParams) ([]*SimilarJob, error) {
+	rows, err := r.replica.Query(ctx, findSimilarJobsQuery, params.JobID, params.ExcludeSameCompany, params.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find similar jobs: %w", err)
+	}
+	defer rows.Close()
+
+	var similar []*SimilarJob
+	for rows.Next() {
+		job := &SimilarJob{}
+		err = rows.Scan(
+			&job.ID,
+			&job.CompanyID,
+			&job.Title,
+			&job.Description,
+			&job.ExperienceLevel,
+			&job.EmploymentType,
+			&job.Location,
+			&job.WorkMode,
+			&job.ApplicationURL,
+			&job.IsActive,
+			&job.Signature,
+			&job.Language,
+			&job.RemoteEligibility,
+			&job.UTCOffsetMin,
+			&job.UTCOffsetMax,
+			&job.CreatedAt,
+			&job.UpdatedAt,
+			&job.CompanyName,
+			&job.CompanySlug,
+			&job.CompanyLogoURL,
+			&job.Benefits,
+			&job.SharedTechnologies,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan similar job row: %w", err)
+		}
+		similar = append(similar, job)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating similar job rows: %w", err)
+	}
+
+	return similar, nil
+}
+
+// ListByStatus retrieves a page of the jobs with the given moderation status, oldest first,
+// and the total number of jobs with that status.
+func (r *Repository) ListByStatus(ctx context.Context, params *StatusListParams) ([]*ModerationJob, int, error) {
+	filters := []any{
+		params.Status, params.Source, params.CompanyID, params.IsActive, params.CreatedFrom, params.CreatedBefore,
+	}
+	var total int
+	if err := r.db.QueryRow(ctx, countJobsByStatusQuery, filters...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count jobs by status: %w", err)
+	}
+
+	rows, err := r.db.Query(ctx, listJobsByStatusQuery, append(filters, params.Limit, params.Offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list jobs by status: %w", err)
+	}
+	defer rows.Close()
+
+	var jobs []*ModerationJob
+	for rows.Next() {
+		job := &ModerationJob{}
+		var applicationURLStatus, companyLogoStatus nullLinkStatus
+		dest := []any{
+			&job.ID,
+			&job.CompanyID,
+			&job.Title,
+			&job.Description,
+			&job.ExperienceLevel,
+			&job.EmploymentType,
+			&job.Location,
+			&job.WorkMode,
+			&job.ApplicationURL,
+			&job.IsActive,
+			&job.Signature,
+			&job.Language,
+			&job.Status,
+			&job.RemoteEligibility,
+			&job.UTCOffsetMin,
+			&job.UTCOffsetMax,
+			&job.Source,
+			&job.SourceJobID,
+			&job.CanonicalJobID,
+			&job.CreatedAt,
+			&job.UpdatedAt,
+			&job.CompanyName,
+			&job.CompanySlug,
+			&job.CompanyLogoURL,
+			&job.Benefits,
+			&job.RejectionReason,
+			&job.ReviewedAt,
+			&job.ReviewedBy,
+		}
+		dest = append(dest, applicationURLStatus.dest()...)
+		dest = append(dest, companyLogoStatus.dest()...)
+		if err = rows.Scan(dest...); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan job row: %w", err)
+		}
+		job.ApplicationURLStatus = applicationURLStatus.value()
+		job.CompanyLogoStatus = companyLogoStatus.value()
+		jobs = append(jobs, job)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating job rows: %w", err)
+	}
+
+	return jobs, total, nil
+}
+
+// sourceJobIDIndex is the unique index of the jobs by their ID at their source
+const sourceJobIDIndex = "idx_jobs_source_job_id"
+
+// newDuplicateError returns the error of a job violating a unique constraint, reporting its ID at
+// its source when that's the one taken
+func newDuplicateError(pgErr *pgconn.PgError, job *Job) *DuplicateError {
+	if pgErr.ConstraintName == sourceJobIDIndex && job.Source != nil && job.SourceJobID != nil {
+		return &DuplicateError{Signature: job.Signature, Source: *job.Source, SourceJobID: *job.SourceJobID}
+	}
+	return &DuplicateError{Signature: job.Signature}
+}
+
+// nullLinkStatus scans the columns of a link check that may not exist yet
+type nullLinkStatus struct {
+	ok         *bool
+	statusCode *int
+	errMsg     *string
+	checkedAt  *time.Time
+}
+
+// dest returns the scan destinations of the link check columns
+func (s *nullLinkStatus) dest() []any {
+	return []any{&s.ok, &s.statusCode, &s.errMsg, &s.checkedAt}
+}
+
+// value returns the scanned link status, nil when the link wasn't checked
+func (s *nullLinkStatus) value() *LinkStatus {
+	if s.ok == nil || s.statusCode == nil || s.errMsg == nil || s.checkedAt == nil {
+		return nil
+	}
+	return &LinkStatus{OK: *s.ok, StatusCode: *s.statusCode, Error: *s.errMsg, CheckedAt: *s.checkedAt}
+}
+
+// Review sets the moderation status of a pending job. Published jobs are activated.
+// It reports false when the job isn't pending (anymore).
+func (r *Repository) Review(ctx context.Context, id int, status Status, reason string) (bool, error) {
+	commandTag, err := r.db.Exec(ctx, reviewJobQuery, id, status, reason, actor.From(ctx).String())
+	if err != nil {
+		return false, fmt.Errorf("failed to review job: %w", err)
+	}
+	return commandTag.RowsAffected() > 0, nil
+}
+
+// IsCompanyActive reports whether the company with companyID exists and isn't archived
+func (r *Repository) IsCompanyActive(ctx context.Context, companyID int) (bool, error) {
+	var active bool
+	err := r.db.QueryRow(ctx, isCompanyActiveQuery, companyID).Scan(&active)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to check company status: %w", err)
+	}
+	return active, nil
+}
+
+// Deactivate takes down an active job. It reports false when the job isn't active (anymore).
+func (r *Repository) Deactivate(ctx context.Context, id int) (bool, error) {
+	commandTag, err := r.db.Exec(ctx, deactivateJobQuery, id, actor.From(ctx).String())
+	if err != nil {
+		return false, fmt.Errorf("failed to deactivate job: %w", err)
+	}
+	return commandTag.RowsAffected() > 0, nil
+}
@@ -0,0 +1,157 @@
+//go:build integration
+
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/testdb"
+)
+
+// Size of the dataset searched by the benchmarks, well above the jobs the board holds today
+const (
+	benchmarkCompanies = 50
+	benchmarkJobs      = 20000
+)
+
+// insertBenchmarkJobsQuery generates the jobs of the benchmarks, spread over titles, technologies,
+// filter values and the last 90 days. One in ten is inactive and one in five is in Spanish.
+const insertBenchmarkJobsQuery = `
+    INSERT INTO jobs (
+        company_id, title, description, experience_level, employment_type, location, work_mode,
+        application_url, signature, is_active, language, created_at
+    )
+    SELECT
+        ($1::int[])[1 + i % cardinality($1::int[])],
+        (ARRAY['Backend', 'Frontend', 'Full Stack', 'Data', 'DevOps', 'Mobile', 'QA'])[1 + i % 7] || ' ' ||
+            (ARRAY['Engineer', 'Developer', 'Analyst', 'Architect'])[1 + i / 7 % 4],
+        CASE WHEN i % 5 = 0
+            THEN 'Desarrollo de servicios con ' ||
+                (ARRAY['Go', 'Python', 'Java', 'React', 'Kubernetes', 'PostgreSQL', 'AWS'])[1 + i % 7] ||
+                ' para nuestros clientes en toda la región.'
+            ELSE 'Build and maintain services with ' ||
+                (ARRAY['Go', 'Python', 'Java', 'React', 'Kubernetes', 'PostgreSQL', 'AWS'])[1 + i % 7] ||
+                ', working with product teams across the region.'
+        END,
+        (ARRAY['Entry-level', 'Junior', 'Mid-level', 'Senior', 'Lead'])[1 + i / 3 % 5],
+        (ARRAY['Full-time', 'Full-time', 'Full-time', 'Contract', 'Part-time'])[1 + i / 11 % 5],
+        CASE WHEN i % 4 = 0 THEN 'LATAM' ELSE 'Costa Rica' END,
+        (ARRAY['Remote', 'Hybrid', 'Onsite'])[1 + i / 5 % 3],
+        'https://jobs.example.com/benchmark/' || i,
+        md5('benchmark-' || i) || md5('job-' || i),
+        i % 10 <> 0,
+        CASE WHEN i % 5 = 0 THEN 'es' ELSE 'en' END,
+        now() - make_interval(hours => i % 2160)
+    FROM generate_series(1, $2) AS i
+`
+
+// insertBenchmarkBenefitsQuery offers a quarter of the benefits in every job
+const insertBenchmarkBenefitsQuery = `
+    INSERT INTO job_benefits (job_id, benefit_id)
+    SELECT j.id, b.id FROM jobs j CROSS JOIN benefits b
+    WHERE (j.id + b.id) % 4 = 0
+`
+
+// newBenchmarkRepository returns a repository on a database holding the jobs of the benchmarks
+func newBenchmarkRepository(b *testing.B) *Repository {
+	b.Helper()
+	db := testdb.New(b)
+	ctx := context.Background()
+
+	companies := make([]int, benchmarkCompanies)
+	for i := range companies {
+		companies[i] = testdb.InsertCompany(b, db, fmt.Sprintf("Benchmark Company %d", i+1))
+	}
+	seedBenchmarkJobs(ctx, b, db, companies)
+
+	repo := NewRepository(db)
+	if err := repo.RefreshSearchView(ctx); err != nil {
+		b.Fatalf("failed to refresh the job search view: %v", err)
+	}
+	return repo
+}
+
+// seedBenchmarkJobs stores the jobs of the benchmarks and their benefits, and updates the statistics
+// of the planner
+func seedBenchmarkJobs(ctx context.Context, b *testing.B, db *pgxpool.Pool, companies []int) {
+	b.Helper()
+	if _, err := db.Exec(ctx, insertBenchmarkJobsQuery, companies, benchmarkJobs); err != nil {
+		b.Fatalf("failed to insert the benchmark jobs: %v", err)
+	}
+	if _, err := db.Exec(ctx, insertBenchmarkBenefitsQuery); err != nil {
+		b.Fatalf("failed to insert the benchmark benefits: %v", err)
+	}
+	if _, err := db.Exec(ctx, "ANALYZE"); err != nil {
+		b.Fatalf("failed to analyze the benchmark database: %v", err)
+	}
+}
+
+// BenchmarkRepository_SearchJobsWithCount measures the searches of the job board, from the plain
+// query of the home page to the ones narrowed by filters and deep pages
+func BenchmarkRepository_SearchJobsWithCount(b *testing.B) {
+	repo := newBenchmarkRepository(b)
+	dateFrom, dateTo := time.Now().AddDate(0, 0, -30), time.Now()
+
+	benchmarks := []struct {
+		name   string
+		params SearchParams
+	}{
+		{
+			name:   "common term",
+			params: SearchParams{Query: "engineer"},
+		},
+		{
+			name:   "rare term",
+			params: SearchParams{Query: "kubernetes architect"},
+		},
+		{
+			name:   "synonyms",
+			params: SearchParams{Query: "golang", SynonymQuery: "go"},
+		},
+		{
+			name: "filters",
+			params: SearchParams{
+				Query:           "developer",
+				ExperienceLevel: stringPtr("Senior"),
+				WorkMode:        stringPtr("Remote"),
+				Location:        stringPtr("Costa Rica"),
+			},
+		},
+		{
+			name:   "benefits",
+			params: SearchParams{Query: "backend", Benefits: []string{"health-insurance", "stock-options"}},
+		},
+		{
+			name:   "date range",
+			params: SearchParams{Query: "data", DateFrom: &dateFrom, DateTo: &dateTo},
+		},
+		{
+			name:   "deep page",
+			params: SearchParams{Query: "engineer", Offset: 2000},
+		},
+		{
+			name:   "highlight",
+			params: SearchParams{Query: "python", Highlight: true},
+		},
+	}
+
+	ctx := context.Background()
+	for _, bb := range benchmarks {
+		b.Run(bb.name, func(b *testing.B) {
+			params := bb.params
+			params.Limit = DefaultLimit
+			b.ReportAllocs()
+
+			for b.Loop() {
+				if _, _, err := repo.SearchJobsWithCount(ctx, &params); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
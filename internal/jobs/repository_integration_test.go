@@ -0,0 +1,295 @@
+//go:build integration
+
+package jobs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/testdb"
+)
+
+func TestRepository_SearchJobsWithCount_Integration(t *testing.T) {
+	t.Parallel()
+	db := testdb.New(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	techCorp := testdb.InsertCompany(t, db, "Tech Corp")
+	dataInc := testdb.InsertCompany(t, db, "Data Inc")
+	testdb.InsertJob(t, db, &testdb.Job{
+		CompanyID:         techCorp,
+		Title:             "Senior Backend Engineer",
+		ExperienceLevel:   "Senior",
+		Province:          "Heredia",
+		RemoteEligibility: string(RemoteEligibilityLATAM),
+		UTCOffsetMin:      intPtr(-6),
+		UTCOffsetMax:      intPtr(-3),
+		Benefits:          []string{"health-insurance", "stock-options"},
+		CreatedAt:         now.Add(-time.Hour),
+	})
+	testdb.InsertJob(t, db, &testdb.Job{
+		CompanyID:       dataInc,
+		Title:           "Backend Engineer",
+		ExperienceLevel: "Junior",
+		WorkMode:        "Hybrid",
+		Province:        "San José",
+		CreatedAt:       now.Add(-2 * time.Hour),
+	})
+	testdb.InsertJob(t, db, &testdb.Job{CompanyID: techCorp, Title: "Backend Engineer", Inactive: true})
+	testdb.InsertJob(t, db, &testdb.Job{CompanyID: techCorp, Title: "Data Analyst"})
+	testdb.InsertJob(t, db, &testdb.Job{
+		CompanyID:   dataInc,
+		Title:       "Ingeniero Backend",
+		Description: "Desarrollo de servicios backend para nuestros clientes.",
+		Language:    string(LanguageSpanish),
+		Location:    "LATAM",
+		CreatedAt:   now.Add(-3 * time.Hour),
+	})
+
+	dateFrom, dateTo := now.Add(-150*time.Minute), now.Add(-90*time.Minute)
+
+	repo := NewRepository(db)
+	require.NoError(t, repo.RefreshSearchView(ctx))
+
+	tests := []struct {
+		name   string
+		params SearchParams
+		titles []string
+		total  int
+	}{
+		{
+			name:   "query only",
+			params: SearchParams{Query: "backend"},
+			titles: []string{"Senior Backend Engineer", "Backend Engineer", "Ingeniero Backend"},
+			total:  3,
+		},
+		{
+			name:   "experience level and work mode",
+			params: SearchParams{Query: "backend", ExperienceLevel: stringPtr("Junior"), WorkMode: stringPtr("Hybrid")},
+			titles: []string{"Backend Engineer"},
+			total:  1,
+		},
+		{
+			name:   "company and location",
+			params: SearchParams{Query: "backend", Company: stringPtr("data"), Location: stringPtr("LATAM")},
+			titles: []string{"Ingeniero Backend"},
+			total:  1,
+		},
+		{
+			name:   "province",
+			params: SearchParams{Query: "backend", Province: stringPtr("Heredia")},
+			titles: []string{"Senior Backend Engineer"},
+			total:  1,
+		},
+		{
+			name:   "language",
+			params: SearchParams{Query: "backend", Language: stringPtr(string(LanguageSpanish))},
+			titles: []string{"Ingeniero Backend"},
+			total:  1,
+		},
+		{
+			name: "remote eligibility and timezone",
+			params: SearchParams{
+				Query:             "backend",
+				RemoteEligibility: stringPtr(string(RemoteEligibilityLATAM)),
+				UTCOffset:         intPtr(-6),
+			},
+			titles: []string{"Senior Backend Engineer"},
+			total:  1,
+		},
+		{
+			name:   "timezone out of range",
+			params: SearchParams{Query: "backend", UTCOffset: intPtr(1)},
+			total:  0,
+		},
+		{
+			name:   "all of the benefits",
+			params: SearchParams{Query: "backend", Benefits: []string{"health-insurance", "stock-options"}},
+			titles: []string{"Senior Backend Engineer"},
+			total:  1,
+		},
+		{
+			name:   "missing benefit",
+			params: SearchParams{Query: "backend", Benefits: []string{"health-insurance", "english-classes"}},
+			total:  0,
+		},
+		{
+			name: "date range",
+			params: SearchParams{
+				Query:    "backend",
+				DateFrom: &dateFrom,
+				DateTo:   &dateTo,
+			},
+			titles: []string{"Backend Engineer"},
+			total:  1,
+		},
+		{
+			name:   "second page",
+			params: SearchParams{Query: "backend", Limit: 1, Offset: 1},
+			titles: []string{"Backend Engineer"},
+			total:  3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// The filters are checked on the newest jobs first, the ranking has its own test
+			params := tt.params
+			params.Sort = SortDate
+			if params.Limit == 0 {
+				params.Limit = DefaultLimit
+			}
+
+			jobs, total, err := repo.SearchJobsWithCount(ctx, &params)
+			require.NoError(t, err)
+			assert.Equal(t, tt.total, total)
+
+			var titles []string
+			for _, job := range jobs {
+				titles = append(titles, job.Title)
+			}
+			assert.Equal(t, tt.titles, titles)
+		})
+	}
+}
+
+func TestRepository_SearchJobsWithCount_Ranking_Integration(t *testing.T) {
+	t.Parallel()
+	db := testdb.New(t)
+	ctx := context.Background()
+	now := time.Now()
+	day := 24 * time.Hour
+
+	// The corpus mentions golang in the title and description of some jobs and only in the
+	// description of others, posted from today to two months ago
+	company := testdb.InsertCompany(t, db, "Tech Corp")
+	corpus := []*testdb.Job{
+		{Title: "Golang Developer", Description: "Build golang services.", CreatedAt: now.Add(-60 * day)},
+		{Title: "Golang Developer", Description: "Build golang services.", CreatedAt: now.Add(-day)},
+		{Title: "Backend Developer", Description: "Build services, some golang.", CreatedAt: now.Add(-time.Hour)},
+		{Title: "Golang Engineer", Description: "Build golang services.", CreatedAt: now.Add(-61 * day), Featured: true},
+		{Title: "Data Analyst", Description: "Build reports.", CreatedAt: now},
+	}
+	ids := make([]int, len(corpus))
+	for i, job := range corpus {
+		job.CompanyID = company
+		ids[i] = testdb.InsertJob(t, db, job)
+	}
+	oldTitleMatch, newTitleMatch, descriptionMatch, featured := ids[0], ids[1], ids[2], ids[3]
+	// Only the description match is built around golang
+	testdb.AddPrimaryJobTechnology(t, db, descriptionMatch, testdb.InsertTechnology(t, db, "Golang", "programming"))
+	require.NoError(t, NewRepository(db).RefreshSearchView(ctx))
+
+	tests := []struct {
+		name    string
+		ranking Ranking
+		sort    SortOrder
+		ids     []int
+	}{
+		{
+			name:    "newest first",
+			ranking: DefaultRanking(),
+			sort:    SortDate,
+			ids:     []int{descriptionMatch, newTitleMatch, oldTitleMatch, featured},
+		},
+		{
+			name:    "text relevance only",
+			ranking: Ranking{TextWeight: 1},
+			ids:     []int{newTitleMatch, oldTitleMatch, featured, descriptionMatch},
+		},
+		{
+			name:    "recent jobs promoted",
+			ranking: Ranking{TextWeight: 1, RecencyWeight: 1, RecencyHalfLife: 7 * day},
+			ids:     []int{newTitleMatch, descriptionMatch, oldTitleMatch, featured},
+		},
+		{
+			name:    "featured jobs promoted",
+			ranking: Ranking{TextWeight: 1, FeaturedWeight: 1},
+			ids:     []int{featured, newTitleMatch, oldTitleMatch, descriptionMatch},
+		},
+		{
+			name:    "jobs of a primary technology promoted",
+			ranking: Ranking{TextWeight: 1, PrimaryTechWeight: 1},
+			ids:     []int{descriptionMatch, newTitleMatch, oldTitleMatch, featured},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := NewRepository(db)
+			repo.SetRanking(tt.ranking)
+
+			jobs, total, err := repo.SearchJobsWithCount(ctx, &SearchParams{
+				Query: "golang",
+				Limit: DefaultLimit,
+				Sort:  tt.sort,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, len(tt.ids), total)
+
+			var ids []int
+			for _, job := range jobs {
+				ids = append(ids, job.ID)
+			}
+			assert.Equal(t, tt.ids, ids)
+		})
+	}
+}
+
+func TestRepository_Deactivate_PromotesAlternate_Integration(t *testing.T) {
+	t.Parallel()
+	db := testdb.New(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	company := testdb.InsertCompany(t, db, "Tech Corp")
+	canonical := testdb.InsertJob(t, db, &testdb.Job{CompanyID: company, CreatedAt: now.Add(-4 * time.Hour)})
+	// The oldest alternate is inactive, so the next one is promoted
+	inactive := testdb.InsertJob(t, db, &testdb.Job{
+		CompanyID: company, CanonicalJobID: &canonical, Inactive: true, CreatedAt: now.Add(-3 * time.Hour),
+	})
+	oldest := testdb.InsertJob(t, db, &testdb.Job{
+		CompanyID: company, CanonicalJobID: &canonical, CreatedAt: now.Add(-2 * time.Hour),
+	})
+	newest := testdb.InsertJob(t, db, &testdb.Job{
+		CompanyID: company, CanonicalJobID: &canonical, CreatedAt: now.Add(-time.Hour),
+	})
+
+	repo := NewRepository(db)
+	deactivated, err := repo.Deactivate(ctx, canonical)
+	require.NoError(t, err)
+	require.True(t, deactivated)
+
+	promoted, err := repo.GetByID(ctx, oldest)
+	require.NoError(t, err)
+	assert.Nil(t, promoted.CanonicalJobID)
+	assert.True(t, promoted.IsActive)
+	for _, id := range []int{inactive, newest} {
+		alternate, err := repo.GetByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, &oldest, alternate.CanonicalJobID)
+	}
+
+	// The promoted job is served by search in place of the canonical one
+	require.NoError(t, repo.RefreshSearchView(ctx))
+	results, total, err := repo.SearchJobsWithCount(ctx, &SearchParams{Query: "software", Limit: DefaultLimit})
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, results, 1)
+	assert.Equal(t, oldest, results[0].ID)
+
+	// Taking down a job that isn't canonical leaves its group alone
+	deactivated, err = repo.Deactivate(ctx, newest)
+	require.NoError(t, err)
+	require.True(t, deactivated)
+	promoted, err = repo.GetByID(ctx, oldest)
+	require.NoError(t, err)
+	assert.Nil(t, promoted.CanonicalJobID)
+}
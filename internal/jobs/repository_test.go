@@ -3,7 +3,9 @@ package jobs
 import (
 	"context"
 	"errors"
+	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,6 +14,8 @@ import (
 	"github.com/pashagolub/pgxmock/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/actor"
 )
 
 func TestRepository_Create(t *testing.T) {
@@ -45,14 +49,26 @@ func TestRepository_Create(t *testing.T) {
 					WithArgs(
 						job.CompanyID,
 						job.Title,
+						"<p>"+job.Description+"</p>",
 						job.Description,
 						job.ExperienceLevel,
+						job.ExperienceLevelInferred,
 						job.EmploymentType,
 						job.Location,
 						job.WorkMode,
 						job.ApplicationURL,
 						job.IsActive,
 						job.Signature,
+						LanguageEnglish,
+						StatusPublished,
+						job.LocationID,
+						job.RemoteEligibility,
+						job.UTCOffsetMin,
+						job.UTCOffsetMax,
+						job.Source,
+						job.SourceJobID,
+						job.CanonicalJobID,
+						"",
 					).
 					WillReturnRows(pgxmock.NewRows([]string{
 						"id", "created_at", "updated_at",
@@ -62,6 +78,8 @@ func TestRepository_Create(t *testing.T) {
 				t.Helper()
 				require.NoError(t, err)
 				assert.Equal(t, 1, result.ID)
+				assert.Equal(t, "<p>Job description</p>", result.Description)
+				assert.Equal(t, "Job description", result.RawDescription)
 				assert.Equal(t, now, result.CreatedAt)
 				assert.Equal(t, now, result.UpdatedAt)
 			},
@@ -90,14 +108,26 @@ func TestRepository_Create(t *testing.T) {
 					WithArgs(
 						job.CompanyID,
 						job.Title,
+						"<p>"+job.Description+"</p>",
 						job.Description,
 						job.ExperienceLevel,
+						job.ExperienceLevelInferred,
 						job.EmploymentType,
 						job.Location,
 						job.WorkMode,
 						job.ApplicationURL,
 						job.IsActive,
 						job.Signature,
+						LanguageEnglish,
+						StatusPublished,
+						job.LocationID,
+						job.RemoteEligibility,
+						job.UTCOffsetMin,
+						job.UTCOffsetMax,
+						job.Source,
+						job.SourceJobID,
+						job.CanonicalJobID,
+						"",
 					).
 					WillReturnError(pgErr)
 			},
@@ -110,6 +140,40 @@ func TestRepository_Create(t *testing.T) {
 				assert.Equal(t, "duplicate-signature", duplicateErr.Signature)
 			},
 		},
+		{
+			name: "duplicate job at its source",
+			job: &Job{
+				CompanyID:       2,
+				Title:           "Product Manager II",
+				Description:     "Job description",
+				ExperienceLevel: "Senior",
+				EmploymentType:  "Full-Time",
+				Location:        "New York",
+				WorkMode:        "Hybrid",
+				ApplicationURL:  "https://example.com/apply2",
+				IsActive:        true,
+				Signature:       "new-signature",
+				Source:          sourcePtr(SourceLinkedIn),
+				SourceJobID:     stringPtr("3912345678"),
+			},
+			mockSetup: func(mock pgxmock.PgxPoolIface, _ *Job) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(createJobQuery)).
+					WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
+						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
+						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
+						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
+						pgxmock.AnyArg(), pgxmock.AnyArg()).
+					WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: sourceJobIDIndex})
+			},
+			checkResults: func(t *testing.T, _ *Job, err error) {
+				t.Helper()
+				var duplicateErr *DuplicateError
+				require.ErrorAs(t, err, &duplicateErr)
+				assert.Equal(t, SourceLinkedIn, duplicateErr.Source)
+				assert.Equal(t, "3912345678", duplicateErr.SourceJobID)
+			},
+		},
 		{
 			name: "database error",
 			job: &Job{
@@ -130,14 +194,26 @@ func TestRepository_Create(t *testing.T) {
 					WithArgs(
 						job.CompanyID,
 						job.Title,
+						"<p>"+job.Description+"</p>",
 						job.Description,
 						job.ExperienceLevel,
+						job.ExperienceLevelInferred,
 						job.EmploymentType,
 						job.Location,
 						job.WorkMode,
 						job.ApplicationURL,
 						job.IsActive,
 						job.Signature,
+						LanguageEnglish,
+						StatusPublished,
+						job.LocationID,
+						job.RemoteEligibility,
+						job.UTCOffsetMin,
+						job.UTCOffsetMax,
+						job.Source,
+						job.SourceJobID,
+						job.CanonicalJobID,
+						"",
 					).
 					WillReturnError(dbError)
 			},
@@ -186,11 +262,16 @@ func TestRepository_GetByID(t *testing.T) {
 				mock.ExpectQuery(regexp.QuoteMeta(getJobByIDQuery)).
 					WithArgs(jobID).
 					WillReturnRows(pgxmock.NewRows([]string{
-						"id", "company_id", "title", "description", "experience_level", "employment_type",
-						"location", "work_mode", "application_url", "is_active", "signature", "created_at", "updated_at",
+						"id", "company_id", "title", "description", "raw_description", "experience_level", "experience_level_inferred",
+						"employment_type",
+						"location", "work_mode", "application_url", "is_active", "signature", "language", "status",
+						"remote_eligibility", "utc_offset_min", "utc_offset_max", "source", "source_job_id", "canonical_job_id",
+						"created_at", "updated_at",
 					}).AddRow(
-						1, 1, "Software Engineer", "Job description", "Mid-Level", "Full-Time",
-						"San Francisco", "Remote", "https://example.com/apply", true, "job-signature-1", now, now,
+						1, 1, "Software Engineer", "<p>Job description</p>", "Job description", "Mid-Level", false, "Full-Time",
+						"San Francisco", "Remote", "https://example.com/apply", true, "job-signature-1", LanguageEnglish, StatusPublished,
+						nil, nil, nil, nil, nil, nil,
+						now, now,
 					))
 			},
 			checkResults: func(t *testing.T, result *Job, err error) {
@@ -200,7 +281,8 @@ func TestRepository_GetByID(t *testing.T) {
 				assert.Equal(t, 1, result.ID)
 				assert.Equal(t, 1, result.CompanyID)
 				assert.Equal(t, "Software Engineer", result.Title)
-				assert.Equal(t, "Job description", result.Description)
+				assert.Equal(t, "<p>Job description</p>", result.Description)
+				assert.Equal(t, "Job description", result.RawDescription)
 				assert.Equal(t, "Mid-Level", result.ExperienceLevel)
 				assert.Equal(t, "Full-Time", result.EmploymentType)
 				assert.Equal(t, "San Francisco", result.Location)
@@ -208,6 +290,7 @@ func TestRepository_GetByID(t *testing.T) {
 				assert.Equal(t, "https://example.com/apply", result.ApplicationURL)
 				assert.True(t, result.IsActive)
 				assert.Equal(t, "job-signature-1", result.Signature)
+				assert.Equal(t, StatusPublished, result.Status)
 				assert.Equal(t, now, result.CreatedAt)
 				assert.Equal(t, now, result.UpdatedAt)
 			},
@@ -299,14 +382,24 @@ func TestRepository_Update(t *testing.T) {
 					WithArgs(
 						job.CompanyID,
 						job.Title,
+						"<p>"+job.Description+"</p>",
 						job.Description,
 						job.ExperienceLevel,
+						job.ExperienceLevelInferred,
 						job.EmploymentType,
 						job.Location,
 						job.WorkMode,
 						job.ApplicationURL,
 						job.IsActive,
 						job.Signature,
+						LanguageEnglish,
+						job.LocationID,
+						job.RemoteEligibility,
+						job.UTCOffsetMin,
+						job.UTCOffsetMax,
+						job.Source,
+						job.SourceJobID,
+						"",
 						job.ID,
 					).
 					WillReturnRows(pgxmock.NewRows([]string{"updated_at"}).AddRow(now))
@@ -338,14 +431,24 @@ func TestRepository_Update(t *testing.T) {
 					WithArgs(
 						job.CompanyID,
 						job.Title,
+						"<p>"+job.Description+"</p>",
 						job.Description,
 						job.ExperienceLevel,
+						job.ExperienceLevelInferred,
 						job.EmploymentType,
 						job.Location,
 						job.WorkMode,
 						job.ApplicationURL,
 						job.IsActive,
 						job.Signature,
+						LanguageEnglish,
+						job.LocationID,
+						job.RemoteEligibility,
+						job.UTCOffsetMin,
+						job.UTCOffsetMax,
+						job.Source,
+						job.SourceJobID,
+						"",
 						job.ID,
 					).
 					WillReturnError(pgx.ErrNoRows)
@@ -384,14 +487,24 @@ func TestRepository_Update(t *testing.T) {
 					WithArgs(
 						job.CompanyID,
 						job.Title,
+						"<p>"+job.Description+"</p>",
 						job.Description,
 						job.ExperienceLevel,
+						job.ExperienceLevelInferred,
 						job.EmploymentType,
 						job.Location,
 						job.WorkMode,
 						job.ApplicationURL,
 						job.IsActive,
 						job.Signature,
+						LanguageEnglish,
+						job.LocationID,
+						job.RemoteEligibility,
+						job.UTCOffsetMin,
+						job.UTCOffsetMax,
+						job.Source,
+						job.SourceJobID,
+						"",
 						job.ID,
 					).
 					WillReturnError(pgErr)
@@ -426,14 +539,24 @@ func TestRepository_Update(t *testing.T) {
 					WithArgs(
 						job.CompanyID,
 						job.Title,
+						"<p>"+job.Description+"</p>",
 						job.Description,
 						job.ExperienceLevel,
+						job.ExperienceLevelInferred,
 						job.EmploymentType,
 						job.Location,
 						job.WorkMode,
 						job.ApplicationURL,
 						job.IsActive,
 						job.Signature,
+						LanguageEnglish,
+						job.LocationID,
+						job.RemoteEligibility,
+						job.UTCOffsetMin,
+						job.UTCOffsetMax,
+						job.Source,
+						job.SourceJobID,
+						"",
 						job.ID,
 					).
 					WillReturnError(dbError)
@@ -541,6 +664,92 @@ func TestRepository_Delete(t *testing.T) {
 	}
 }
 
+func TestRepository_GetBySourceJobID(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	dbError := errors.New("database error")
+	columns := []string{
+		"id", "company_id", "title", "description", "raw_description", "experience_level", "experience_level_inferred",
+		"employment_type",
+		"location", "work_mode", "application_url", "is_active", "signature", "language", "status",
+		"remote_eligibility", "utc_offset_min", "utc_offset_max", "source", "source_job_id", "canonical_job_id",
+		"created_at", "updated_at",
+	}
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, result *Job, err error)
+	}{
+		{
+			name: "job found",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getJobBySourceJobIDQuery)).
+					WithArgs(SourceGreenhouse, "4501").
+					WillReturnRows(pgxmock.NewRows(columns).AddRow(
+						1, 1, "Software Engineer", "<p>Job description</p>", "Job description", "Mid-Level", false, "Full-Time",
+						"San Francisco", "Remote", "https://example.com/apply", true, "job-signature-1", LanguageEnglish, StatusPublished,
+						nil, nil, nil, sourcePtr(SourceGreenhouse), stringPtr("4501"), nil,
+						now, now,
+					))
+			},
+			checkResults: func(t *testing.T, result *Job, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 1, result.ID)
+				assert.Equal(t, sourcePtr(SourceGreenhouse), result.Source)
+				assert.Equal(t, stringPtr("4501"), result.SourceJobID)
+			},
+		},
+		{
+			name: "job not found",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getJobBySourceJobIDQuery)).
+					WithArgs(SourceGreenhouse, "4501").
+					WillReturnError(pgx.ErrNoRows)
+			},
+			checkResults: func(t *testing.T, _ *Job, err error) {
+				t.Helper()
+				var notFoundErr *NotFoundError
+				require.ErrorAs(t, err, &notFoundErr)
+				assert.Equal(t, "4501", notFoundErr.SourceJobID)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getJobBySourceJobIDQuery)).
+					WithArgs(SourceGreenhouse, "4501").
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ *Job, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			result, err := repo.GetBySourceJobID(context.Background(), SourceGreenhouse, "4501")
+			tt.checkResults(t, result, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
 func TestRepository_GetBySignature(t *testing.T) {
 	t.Parallel()
 	now := time.Now()
@@ -560,11 +769,16 @@ func TestRepository_GetBySignature(t *testing.T) {
 				mock.ExpectQuery(regexp.QuoteMeta(getJobBySignatureQuery)).
 					WithArgs(signature).
 					WillReturnRows(pgxmock.NewRows([]string{
-						"id", "company_id", "title", "description", "experience_level", "employment_type",
-						"location", "work_mode", "application_url", "is_active", "signature", "created_at", "updated_at",
+						"id", "company_id", "title", "description", "raw_description", "experience_level", "experience_level_inferred",
+						"employment_type",
+						"location", "work_mode", "application_url", "is_active", "signature", "language", "status",
+						"remote_eligibility", "utc_offset_min", "utc_offset_max", "source", "source_job_id", "canonical_job_id",
+						"created_at", "updated_at",
 					}).AddRow(
-						1, 1, "Software Engineer", "Job description", "Mid-Level", "Full-Time",
-						"San Francisco", "Remote", "https://example.com/apply", true, "job-signature-1", now, now,
+						1, 1, "Software Engineer", "<p>Job description</p>", "Job description", "Mid-Level", false, "Full-Time",
+						"San Francisco", "Remote", "https://example.com/apply", true, "job-signature-1", LanguageEnglish, StatusPublished,
+						nil, nil, nil, nil, nil, nil,
+						now, now,
 					))
 			},
 			checkResults: func(t *testing.T, result *Job, err error) {
@@ -574,7 +788,8 @@ func TestRepository_GetBySignature(t *testing.T) {
 				assert.Equal(t, 1, result.ID)
 				assert.Equal(t, 1, result.CompanyID)
 				assert.Equal(t, "Software Engineer", result.Title)
-				assert.Equal(t, "Job description", result.Description)
+				assert.Equal(t, "<p>Job description</p>", result.Description)
+				assert.Equal(t, "Job description", result.RawDescription)
 				assert.Equal(t, "Mid-Level", result.ExperienceLevel)
 				assert.Equal(t, "Full-Time", result.EmploymentType)
 				assert.Equal(t, "San Francisco", result.Location)
@@ -647,6 +862,10 @@ func TestRepository_SearchJobsWithCount(t *testing.T) {
 	dbError := errors.New("database error")
 	dateFrom := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
 	dateTo := time.Date(2024, 12, 31, 23, 59, 59, 0, time.UTC)
+	latam := RemoteEligibilityLATAM
+	// Searches are ranked by the default ranking
+	text, recency, featured := DefaultRankTextWeight, DefaultRankRecencyWeight, DefaultRankFeaturedWeight
+	halfLife, primaryTech := DefaultRankRecencyHalfLife.Seconds(), DefaultRankPrimaryTechWeight
 
 	tests := []struct {
 		name         string
@@ -663,21 +882,24 @@ func TestRepository_SearchJobsWithCount(t *testing.T) {
 			},
 			mockSetup: func(mock pgxmock.PgxPoolIface, _ SearchParams) {
 				t.Helper()
-				expectedQuery := searchJobsWithCountBaseQuery + " ORDER BY j.created_at DESC LIMIT $2 OFFSET $3"
+				expectedQuery := searchJobsWithCountBaseQuery + " ORDER BY score DESC, created_at DESC LIMIT $8 OFFSET $9"
 				mock.ExpectQuery(regexp.QuoteMeta(expectedQuery)).
-					WithArgs("software engineer", 10, 0).
+					WithArgs("software engineer", "", text, recency, featured, halfLife, primaryTech, 10, 0).
 					WillReturnRows(pgxmock.NewRows([]string{
 						"id", "company_id", "title", "description", "experience_level", "employment_type",
-						"location", "work_mode", "application_url", "is_active", "signature", "created_at", "updated_at",
-						"company_name", "company_logo_url", "total_count",
+						"location", "work_mode", "application_url", "is_active", "signature", "language",
+						"remote_eligibility", "utc_offset_min", "utc_offset_max", "created_at", "updated_at",
+						"company_name", "company_slug", "company_logo_url", "benefits", "score", "total_count",
 					}).AddRow(
 						1, 1, "Software Engineer", "Job description", "Mid-Level", "Full-Time",
-						"San Francisco", "Remote", "https://example.com/apply", true, "job-signature-1", now, now,
-						"Tech Corp", "https://example.com/logo1.png", 25,
+						"San Francisco", "Remote", "https://example.com/apply", true, "job-signature-1", LanguageEnglish,
+						nil, nil, nil, now, now,
+						"Tech Corp", "tech-corp", "https://example.com/logo1.png", []string{}, 0.5, 25,
 					).AddRow(
 						2, 2, "Senior Software Engineer", "Senior position", "Senior", "Full-Time",
-						"New York", "Hybrid", "https://example.com/apply2", true, "job-signature-2", now, now,
-						"Innovation Inc", "https://example.com/logo2.png", 25,
+						"New York", "Hybrid", "https://example.com/apply2", true, "job-signature-2", LanguageEnglish,
+						nil, nil, nil, now, now,
+						"Innovation Inc", "innovation-inc", "https://example.com/logo2.png", []string{}, 0.5, 25,
 					))
 			},
 			checkResults: func(t *testing.T, jobs []*JobWithCompany, total int, err error) {
@@ -688,6 +910,7 @@ func TestRepository_SearchJobsWithCount(t *testing.T) {
 
 				assert.Equal(t, "Software Engineer", jobs[0].Title)
 				assert.Equal(t, "Tech Corp", jobs[0].CompanyName)
+				assert.Equal(t, "tech-corp", jobs[0].CompanySlug)
 				assert.Equal(t, "https://example.com/logo1.png", jobs[0].CompanyLogoURL)
 
 				assert.Equal(t, "Senior Software Engineer", jobs[1].Title)
@@ -698,33 +921,45 @@ func TestRepository_SearchJobsWithCount(t *testing.T) {
 		{
 			name: "search with all filters applied",
 			params: SearchParams{
-				Query:           "developer",
-				Limit:           5,
-				Offset:          10,
-				ExperienceLevel: stringPtr("Senior"),
-				EmploymentType:  stringPtr("Full-Time"),
-				Location:        stringPtr("San Francisco"),
-				WorkMode:        stringPtr("Remote"),
-				Company:         stringPtr("StartupXYZ"),
-				DateFrom:        &dateFrom,
-				DateTo:          &dateTo,
+				Query:             "developer",
+				Limit:             5,
+				Offset:            10,
+				ExperienceLevel:   stringPtr("Senior"),
+				EmploymentType:    stringPtr("Full-Time"),
+				Location:          stringPtr("San Francisco"),
+				WorkMode:          stringPtr("Remote"),
+				Company:           stringPtr("StartupXYZ"),
+				Province:          stringPtr("San José"),
+				Language:          stringPtr("es"),
+				RemoteEligibility: stringPtr("LATAM only"),
+				UTCOffset:         intPtr(-6),
+				Benefits:          []string{"health-insurance", "stock-options"},
+				DateFrom:          &dateFrom,
+				DateTo:            &dateTo,
 			},
 			mockSetup: func(mock pgxmock.PgxPoolIface, _ SearchParams) {
 				t.Helper()
 				expectedQuery := searchJobsWithCountBaseQuery +
-					" AND j.experience_level = $2 AND j.employment_type = $3 AND j.location = $4 AND j.work_mode = $5" +
-					" AND LOWER(c.name) LIKE LOWER($6) AND j.created_at >= $7 AND j.created_at <= $8" +
-					" ORDER BY j.created_at DESC LIMIT $9 OFFSET $10"
+					" AND j.experience_level = $8 AND j.employment_type = $9 AND j.location = $10 AND j.work_mode = $11" +
+					" AND LOWER(j.company_name) LIKE LOWER($12) AND " + strings.Replace(provinceFilterCondition, "?", "$13", 1) +
+					" AND j.language = $14 AND j.remote_eligibility = $15 AND $16 BETWEEN j.utc_offset_min AND j.utc_offset_max" +
+					" AND j.benefits @> $17 AND j.created_at >= $18 AND j.created_at <= $19" +
+					" ORDER BY score DESC, created_at DESC LIMIT $20 OFFSET $21"
 				mock.ExpectQuery(regexp.QuoteMeta(expectedQuery)).
-					WithArgs("developer", "Senior", "Full-Time", "San Francisco", "Remote", "%StartupXYZ%", dateFrom, dateTo, 5, 10).
+					WithArgs("developer", "", text, recency, featured, halfLife, primaryTech,
+						"Senior", "Full-Time", "San Francisco", "Remote", "%StartupXYZ%", "San José",
+						"es", "LATAM only", -6, []string{"health-insurance", "stock-options"}, dateFrom, dateTo, 5, 10).
 					WillReturnRows(pgxmock.NewRows([]string{
 						"id", "company_id", "title", "description", "experience_level", "employment_type",
-						"location", "work_mode", "application_url", "is_active", "signature", "created_at", "updated_at",
-						"company_name", "company_logo_url", "total_count",
+						"location", "work_mode", "application_url", "is_active", "signature", "language",
+						"remote_eligibility", "utc_offset_min", "utc_offset_max", "created_at", "updated_at",
+						"company_name", "company_slug", "company_logo_url", "benefits", "score", "total_count",
 					}).AddRow(
 						3, 3, "Senior Developer", "Senior developer position", "Senior", "Full-Time",
-						"San Francisco", "Remote", "https://example.com/apply3", true, "job-signature-3", now, now,
-						"StartupXYZ", "https://example.com/logo3.png", 42,
+						"San Francisco", "Remote", "https://example.com/apply3", true, "job-signature-3", LanguageSpanish,
+						&latam, intPtr(-6), intPtr(-3), now, now,
+						"StartupXYZ", "startupxyz", "https://example.com/logo3.png",
+						[]string{"health-insurance", "stock-options"}, 0.5, 42,
 					))
 			},
 			checkResults: func(t *testing.T, jobs []*JobWithCompany, total int, err error) {
@@ -740,6 +975,137 @@ func TestRepository_SearchJobsWithCount(t *testing.T) {
 				assert.Equal(t, "Remote", jobs[0].WorkMode)
 				assert.Equal(t, "StartupXYZ", jobs[0].CompanyName)
 				assert.Equal(t, "https://example.com/logo3.png", jobs[0].CompanyLogoURL)
+				assert.Equal(t, LanguageSpanish, jobs[0].Language)
+				require.NotNil(t, jobs[0].RemoteEligibility)
+				assert.Equal(t, RemoteEligibilityLATAM, *jobs[0].RemoteEligibility)
+				assert.Equal(t, intPtr(-6), jobs[0].UTCOffsetMin)
+				assert.Equal(t, intPtr(-3), jobs[0].UTCOffsetMax)
+				assert.Equal(t, []string{"health-insurance", "stock-options"}, jobs[0].Benefits)
+			},
+		},
+		{
+			name: "search with job function filter",
+			params: SearchParams{
+				Query:    "developer",
+				Limit:    20,
+				Offset:   0,
+				Function: stringPtr("Backend"),
+			},
+			mockSetup: func(mock pgxmock.PgxPoolIface, _ SearchParams) {
+				t.Helper()
+				expectedQuery := searchJobsWithCountBaseQuery + " AND " + strings.Replace(jobFunctionFilterCondition, "?", "$8", 1) +
+					" ORDER BY score DESC, created_at DESC LIMIT $9 OFFSET $10"
+				mock.ExpectQuery(regexp.QuoteMeta(expectedQuery)).
+					WithArgs("developer", "", text, recency, featured, halfLife, primaryTech, "Backend", 20, 0).
+					WillReturnRows(pgxmock.NewRows([]string{
+						"id", "company_id", "title", "description", "experience_level", "employment_type",
+						"location", "work_mode", "application_url", "is_active", "signature", "language",
+						"remote_eligibility", "utc_offset_min", "utc_offset_max", "created_at", "updated_at",
+						"company_name", "company_slug", "company_logo_url", "benefits", "score", "total_count",
+					}))
+			},
+			checkResults: func(t *testing.T, jobs []*JobWithCompany, total int, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Empty(t, jobs)
+				assert.Equal(t, 0, total)
+			},
+		},
+		{
+			name: "search scoped to a country",
+			params: SearchParams{
+				Query:   "developer",
+				Limit:   20,
+				Offset:  0,
+				Country: "PA",
+			},
+			mockSetup: func(mock pgxmock.PgxPoolIface, _ SearchParams) {
+				t.Helper()
+				expectedQuery := searchJobsWithCountBaseQuery + " AND j.country = $8" +
+					" ORDER BY score DESC, created_at DESC LIMIT $9 OFFSET $10"
+				mock.ExpectQuery(regexp.QuoteMeta(expectedQuery)).
+					WithArgs("developer", "", text, recency, featured, halfLife, primaryTech, "PA", 20, 0).
+					WillReturnRows(pgxmock.NewRows([]string{
+						"id", "company_id", "title", "description", "experience_level", "employment_type",
+						"location", "work_mode", "application_url", "is_active", "signature", "language",
+						"remote_eligibility", "utc_offset_min", "utc_offset_max", "created_at", "updated_at",
+						"company_name", "company_slug", "company_logo_url", "benefits", "score", "total_count",
+					}))
+			},
+			checkResults: func(t *testing.T, jobs []*JobWithCompany, total int, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Empty(t, jobs)
+				assert.Equal(t, 0, total)
+			},
+		},
+		{
+			name: "search with synonym query",
+			params: SearchParams{
+				Query:        "qa engineer",
+				SynonymQuery: "quality assurance engineer",
+				Limit:        20,
+				Offset:       0,
+			},
+			mockSetup: func(mock pgxmock.PgxPoolIface, _ SearchParams) {
+				t.Helper()
+				expectedQuery := searchJobsWithCountBaseQuery + " ORDER BY score DESC, created_at DESC LIMIT $8 OFFSET $9"
+				mock.ExpectQuery(regexp.QuoteMeta(expectedQuery)).
+					WithArgs("qa engineer", "quality assurance engineer", text, recency, featured, halfLife, primaryTech, 20, 0).
+					WillReturnRows(pgxmock.NewRows([]string{
+						"id", "company_id", "title", "description", "experience_level", "employment_type",
+						"location", "work_mode", "application_url", "is_active", "signature", "language",
+						"remote_eligibility", "utc_offset_min", "utc_offset_max", "created_at", "updated_at",
+						"company_name", "company_slug", "company_logo_url", "benefits", "score", "total_count",
+					}).AddRow(
+						6, 2, "Quality Assurance Engineer", "Manual and automated testing", "Mid-Level", "Full-Time",
+						"Costa Rica", "Remote", "https://example.com/apply6", true, "job-signature-6", LanguageEnglish,
+						nil, nil, nil, now, now,
+						"Tech Corp", "tech-corp", "https://example.com/logo1.png", []string{}, 0.5, 1,
+					))
+			},
+			checkResults: func(t *testing.T, jobs []*JobWithCompany, total int, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, jobs, 1)
+				assert.Equal(t, 1, total)
+				assert.Equal(t, "Quality Assurance Engineer", jobs[0].Title)
+			},
+		},
+		{
+			name: "search with highlights",
+			params: SearchParams{
+				Query:     "golang",
+				Limit:     20,
+				Offset:    0,
+				Highlight: true,
+			},
+			mockSetup: func(mock pgxmock.PgxPoolIface, _ SearchParams) {
+				t.Helper()
+				expectedQuery := fmt.Sprintf(highlightSearchQuery,
+					searchJobsWithCountBaseQuery+" ORDER BY score DESC, created_at DESC LIMIT $8 OFFSET $9",
+					"r.score DESC, r.created_at DESC")
+				mock.ExpectQuery(regexp.QuoteMeta(expectedQuery)).
+					WithArgs("golang", "", text, recency, featured, halfLife, primaryTech, 20, 0).
+					WillReturnRows(pgxmock.NewRows([]string{
+						"id", "company_id", "title", "description", "experience_level", "employment_type",
+						"location", "work_mode", "application_url", "is_active", "signature", "language",
+						"remote_eligibility", "utc_offset_min", "utc_offset_max", "created_at", "updated_at",
+						"company_name", "company_slug", "company_logo_url", "benefits", "score", "total_count", "highlight",
+					}).AddRow(
+						7, 1, "Backend Developer", "We build golang services", "Senior", "Full-Time",
+						"Costa Rica", "Remote", "https://example.com/apply7", true, "job-signature-7", LanguageEnglish,
+						nil, nil, nil, now, now,
+						"Tech Corp", "tech-corp", "https://example.com/logo1.png", []string{}, 0.5, 1,
+						"We build <mark>golang</mark> services",
+					))
+			},
+			checkResults: func(t *testing.T, jobs []*JobWithCompany, total int, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, jobs, 1)
+				assert.Equal(t, 1, total)
+				assert.Equal(t, "We build <mark>golang</mark> services", jobs[0].Highlight)
 			},
 		},
 		{
@@ -751,13 +1117,41 @@ func TestRepository_SearchJobsWithCount(t *testing.T) {
 			},
 			mockSetup: func(mock pgxmock.PgxPoolIface, _ SearchParams) {
 				t.Helper()
-				expectedQuery := searchJobsWithCountBaseQuery + " ORDER BY j.created_at DESC LIMIT $2 OFFSET $3"
+				expectedQuery := searchJobsWithCountBaseQuery + " ORDER BY score DESC, created_at DESC LIMIT $8 OFFSET $9"
+				mock.ExpectQuery(regexp.QuoteMeta(expectedQuery)).
+					WithArgs("nonexistent job title", "", text, recency, featured, halfLife, primaryTech, 20, 0).
+					WillReturnRows(pgxmock.NewRows([]string{
+						"id", "company_id", "title", "description", "experience_level", "employment_type",
+						"location", "work_mode", "application_url", "is_active", "signature", "language",
+						"remote_eligibility", "utc_offset_min", "utc_offset_max", "created_at", "updated_at",
+						"company_name", "company_slug", "company_logo_url", "benefits", "score", "total_count",
+					}))
+			},
+			checkResults: func(t *testing.T, jobs []*JobWithCompany, total int, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Empty(t, jobs)
+				assert.Equal(t, 0, total)
+			},
+		},
+		{
+			name: "search sorted by date",
+			params: SearchParams{
+				Query:  "golang",
+				Limit:  20,
+				Offset: 0,
+				Sort:   SortDate,
+			},
+			mockSetup: func(mock pgxmock.PgxPoolIface, _ SearchParams) {
+				t.Helper()
+				expectedQuery := searchJobsWithCountBaseQuery + " ORDER BY created_at DESC LIMIT $8 OFFSET $9"
 				mock.ExpectQuery(regexp.QuoteMeta(expectedQuery)).
-					WithArgs("nonexistent job title", 20, 0).
+					WithArgs("golang", "", text, recency, featured, halfLife, primaryTech, 20, 0).
 					WillReturnRows(pgxmock.NewRows([]string{
 						"id", "company_id", "title", "description", "experience_level", "employment_type",
-						"location", "work_mode", "application_url", "is_active", "signature", "created_at", "updated_at",
-						"company_name", "company_logo_url", "total_count",
+						"location", "work_mode", "application_url", "is_active", "signature", "language",
+						"remote_eligibility", "utc_offset_min", "utc_offset_max", "created_at", "updated_at",
+						"company_name", "company_slug", "company_logo_url", "benefits", "score", "total_count",
 					}))
 			},
 			checkResults: func(t *testing.T, jobs []*JobWithCompany, total int, err error) {
@@ -776,9 +1170,9 @@ func TestRepository_SearchJobsWithCount(t *testing.T) {
 			},
 			mockSetup: func(mock pgxmock.PgxPoolIface, _ SearchParams) {
 				t.Helper()
-				expectedQuery := searchJobsWithCountBaseQuery + " ORDER BY j.created_at DESC LIMIT $2 OFFSET $3"
+				expectedQuery := searchJobsWithCountBaseQuery + " ORDER BY score DESC, created_at DESC LIMIT $8 OFFSET $9"
 				mock.ExpectQuery(regexp.QuoteMeta(expectedQuery)).
-					WithArgs("test query", 10, 0).
+					WithArgs("test query", "", text, recency, featured, halfLife, primaryTech, 10, 0).
 					WillReturnError(dbError)
 			},
 			checkResults: func(t *testing.T, jobs []*JobWithCompany, total int, err error) {
@@ -798,13 +1192,14 @@ func TestRepository_SearchJobsWithCount(t *testing.T) {
 			},
 			mockSetup: func(mock pgxmock.PgxPoolIface, _ SearchParams) {
 				t.Helper()
-				expectedQuery := searchJobsWithCountBaseQuery + " ORDER BY j.created_at DESC LIMIT $2 OFFSET $3"
+				expectedQuery := searchJobsWithCountBaseQuery + " ORDER BY score DESC, created_at DESC LIMIT $8 OFFSET $9"
 				mock.ExpectQuery(regexp.QuoteMeta(expectedQuery)).
-					WithArgs("", 10, 0).
+					WithArgs("", "", text, recency, featured, halfLife, primaryTech, 10, 0).
 					WillReturnRows(pgxmock.NewRows([]string{
 						"id", "company_id", "title", "description", "experience_level", "employment_type",
-						"location", "work_mode", "application_url", "is_active", "signature", "created_at", "updated_at",
-						"company_name", "company_logo_url", "total_count",
+						"location", "work_mode", "application_url", "is_active", "signature", "language",
+						"remote_eligibility", "utc_offset_min", "utc_offset_max", "created_at", "updated_at",
+						"company_name", "company_slug", "company_logo_url", "benefits", "score", "total_count",
 					}))
 			},
 			checkResults: func(t *testing.T, jobs []*JobWithCompany, total int, err error) {
@@ -823,13 +1218,14 @@ func TestRepository_SearchJobsWithCount(t *testing.T) {
 			},
 			mockSetup: func(mock pgxmock.PgxPoolIface, _ SearchParams) {
 				t.Helper()
-				expectedQuery := searchJobsWithCountBaseQuery + " ORDER BY j.created_at DESC LIMIT $2 OFFSET $3"
+				expectedQuery := searchJobsWithCountBaseQuery + " ORDER BY score DESC, created_at DESC LIMIT $8 OFFSET $9"
 				mock.ExpectQuery(regexp.QuoteMeta(expectedQuery)).
-					WithArgs("", 10, 0). // Query should be trimmed to empty string
+					WithArgs("", "", text, recency, featured, halfLife, primaryTech, 10, 0). // Query should be trimmed to empty string
 					WillReturnRows(pgxmock.NewRows([]string{
 						"id", "company_id", "title", "description", "experience_level", "employment_type",
-						"location", "work_mode", "application_url", "is_active", "signature", "created_at", "updated_at",
-						"company_name", "company_logo_url", "total_count",
+						"location", "work_mode", "application_url", "is_active", "signature", "language",
+						"remote_eligibility", "utc_offset_min", "utc_offset_max", "created_at", "updated_at",
+						"company_name", "company_slug", "company_logo_url", "benefits", "score", "total_count",
 					}))
 			},
 			checkResults: func(t *testing.T, jobs []*JobWithCompany, total int, err error) {
@@ -848,9 +1244,9 @@ func TestRepository_SearchJobsWithCount(t *testing.T) {
 			},
 			mockSetup: func(mock pgxmock.PgxPoolIface, _ SearchParams) {
 				t.Helper()
-				expectedQuery := searchJobsWithCountBaseQuery + " ORDER BY j.created_at DESC LIMIT $2 OFFSET $3"
+				expectedQuery := searchJobsWithCountBaseQuery + " ORDER BY score DESC, created_at DESC LIMIT $8 OFFSET $9"
 				mock.ExpectQuery(regexp.QuoteMeta(expectedQuery)).
-					WithArgs("test query", 10, 0).
+					WithArgs("test query", "", text, recency, featured, halfLife, primaryTech, 10, 0).
 					WillReturnRows(pgxmock.NewRows([]string{
 						"id", "company_id", "title", // Missing columns to cause scan error
 					}).AddRow(
@@ -874,17 +1270,19 @@ func TestRepository_SearchJobsWithCount(t *testing.T) {
 			},
 			mockSetup: func(mock pgxmock.PgxPoolIface, _ SearchParams) {
 				t.Helper()
-				expectedQuery := searchJobsWithCountBaseQuery + " ORDER BY j.created_at DESC LIMIT $2 OFFSET $3"
+				expectedQuery := searchJobsWithCountBaseQuery + " ORDER BY score DESC, created_at DESC LIMIT $8 OFFSET $9"
 				mock.ExpectQuery(regexp.QuoteMeta(expectedQuery)).
-					WithArgs("golang", 1, 5).
+					WithArgs("golang", "", text, recency, featured, halfLife, primaryTech, 1, 5).
 					WillReturnRows(pgxmock.NewRows([]string{
 						"id", "company_id", "title", "description", "experience_level", "employment_type",
-						"location", "work_mode", "application_url", "is_active", "signature", "created_at", "updated_at",
-						"company_name", "company_logo_url", "total_count",
+						"location", "work_mode", "application_url", "is_active", "signature", "language",
+						"remote_eligibility", "utc_offset_min", "utc_offset_max", "created_at", "updated_at",
+						"company_name", "company_slug", "company_logo_url", "benefits", "score", "total_count",
 					}).AddRow(
 						6, 6, "Golang Developer", "Golang position", "Mid-level", "Full-Time",
-						"Remote", "Remote", "https://example.com/apply6", true, "job-signature-6", now, now,
-						"Go Corp", "https://example.com/logo6.png", 100,
+						"Remote", "Remote", "https://example.com/apply6", true, "job-signature-6", LanguageEnglish,
+						nil, nil, nil, now, now,
+						"Go Corp", "go-corp", "https://example.com/logo6.png", []string{}, 0.5, 100,
 					))
 			},
 			checkResults: func(t *testing.T, jobs []*JobWithCompany, total int, err error) {
@@ -916,7 +1314,1086 @@ func TestRepository_SearchJobsWithCount(t *testing.T) {
 	}
 }
 
+func TestRepository_StreamSearchJobs(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	text, recency, featured := DefaultRankTextWeight, DefaultRankRecencyWeight, DefaultRankFeaturedWeight
+	halfLife, primaryTech := DefaultRankRecencyHalfLife.Seconds(), DefaultRankPrimaryTechWeight
+	expectedQuery := searchJobsWithCountBaseQuery + " ORDER BY score DESC, created_at DESC LIMIT $8 OFFSET $9"
+	columns := []string{
+		"id", "company_id", "title", "description", "experience_level", "employment_type",
+		"location", "work_mode", "application_url", "is_active", "signature", "language",
+		"remote_eligibility", "utc_offset_min", "utc_offset_max", "created_at", "updated_at",
+		"company_name", "company_slug", "company_logo_url", "benefits", "score", "total_count",
+	}
+	rows := func() *pgxmock.Rows {
+		return pgxmock.NewRows(columns).AddRow(
+			1, 1, "Software Engineer", "Job description", "Mid-level", "Full-time",
+			"Costa Rica", "Remote", "https://example.com/apply", true, "job-signature-1", LanguageEnglish,
+			nil, nil, nil, now, now, "Tech Corp", "tech-corp", "", []string{}, 0.5, 2,
+		).AddRow(
+			2, 2, "Senior Software Engineer", "Senior position", "Senior", "Full-time",
+			"Costa Rica", "Hybrid", "https://example.com/apply2", true, "job-signature-2", LanguageEnglish,
+			nil, nil, nil, now, now, "Innovation Inc", "innovation-inc", "", []string{}, 0.4, 2,
+		)
+	}
+	stopErr := errors.New("client gone")
+
+	tests := []struct {
+		name      string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		fnErr     error
+		wantTitle []string
+		wantErr   error
+	}{
+		{
+			name: "calls fn with each job in order",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(regexp.QuoteMeta(expectedQuery)).
+					WithArgs("software engineer", "", text, recency, featured, halfLife, primaryTech, 5000, 0).
+					WillReturnRows(rows())
+			},
+			wantTitle: []string{"Software Engineer", "Senior Software Engineer"},
+		},
+		{
+			name: "stops at the first error of fn",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(regexp.QuoteMeta(expectedQuery)).
+					WithArgs("software engineer", "", text, recency, featured, halfLife, primaryTech, 5000, 0).
+					WillReturnRows(rows())
+			},
+			fnErr:     stopErr,
+			wantTitle: []string{"Software Engineer"},
+			wantErr:   stopErr,
+		},
+		{
+			name: "query error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(regexp.QuoteMeta(expectedQuery)).
+					WithArgs("software engineer", "", text, recency, featured, halfLife, primaryTech, 5000, 0).
+					WillReturnError(errors.New("database error"))
+			},
+			wantErr: errors.New("failed to search jobs: database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+			tt.mockSetup(mockDB)
+
+			var titles []string
+			params := &SearchParams{Query: " software engineer ", Limit: 5000}
+			err = NewRepository(mockDB).StreamSearchJobs(context.Background(), params, func(job *JobWithCompany) error {
+				titles = append(titles, job.Title)
+				return tt.fnErr
+			})
+
+			switch {
+			case tt.wantErr == nil:
+				require.NoError(t, err)
+			case errors.Is(tt.wantErr, stopErr):
+				require.ErrorIs(t, err, stopErr)
+			default:
+				require.EqualError(t, err, tt.wantErr.Error())
+			}
+			assert.Equal(t, tt.wantTitle, titles)
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
 // Helper function to create string pointers
 func stringPtr(s string) *string {
 	return &s
 }
+
+// Helper function to create int pointers
+func intPtr(i int) *int {
+	return &i
+}
+
+// Helper function to create source pointers
+func sourcePtr(source Source) *Source {
+	return &source
+}
+
+func TestRepository_ListJobsWithoutSignature(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, sources []*SignatureSource, err error)
+	}{
+		{
+			name: "jobs found",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(listJobsWithoutSignatureQuery)).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "name", "title", "application_url"}).
+						AddRow(1, "Tech Corp", "Go Developer", "https://techcorp.com/jobs/1").
+						AddRow(2, "Tech Corp", "QA Engineer", "https://techcorp.com/jobs/2"))
+			},
+			checkResults: func(t *testing.T, sources []*SignatureSource, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, sources, 2)
+				assert.Equal(t, "Go Developer", sources[0].Title)
+				assert.Equal(t,
+					ComputeSignature("Tech Corp", "Go Developer", "https://techcorp.com/jobs/1"), sources[0].Signature())
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(listJobsWithoutSignatureQuery)).WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ []*SignatureSource, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			sources, err := repo.ListJobsWithoutSignature(context.Background())
+			tt.checkResults(t, sources, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_UpdateSignature(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, err error)
+	}{
+		{
+			name: "successful update",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(updateJobSignatureQuery)).
+					WithArgs("abc123", "", 1).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "duplicate signature",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(updateJobSignatureQuery)).
+					WithArgs("abc123", "", 1).
+					WillReturnError(&pgconn.PgError{Code: "23505"})
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				assert.True(t, IsDuplicate(err))
+			},
+		},
+		{
+			name: "job not found",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(updateJobSignatureQuery)).
+					WithArgs("abc123", "", 1).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				assert.True(t, IsNotFound(err))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			err = repo.UpdateSignature(context.Background(), 1, "abc123")
+			tt.checkResults(t, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_ListDescriptions(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, sources []*DescriptionSource, err error)
+	}{
+		{
+			name: "jobs found",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(listJobDescriptionsQuery)).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "raw_description", "description"}).
+						AddRow(1, "Build **APIs**", "<p>Build <strong>APIs</strong></p>").
+						AddRow(2, "Write tests", "Write tests"))
+			},
+			checkResults: func(t *testing.T, sources []*DescriptionSource, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, sources, 2)
+				assert.Equal(t, sources[0].Description, sources[0].Sanitized())
+				assert.Equal(t, "<p>Write tests</p>", sources[1].Sanitized())
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(listJobDescriptionsQuery)).WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ []*DescriptionSource, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			sources, err := repo.ListDescriptions(context.Background())
+			tt.checkResults(t, sources, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_UpdateDescription(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, err error)
+	}{
+		{
+			name: "successful update",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(updateJobDescriptionQuery)).
+					WithArgs("<p>Write tests</p>", "Write tests", "", 1).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "job not found",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(updateJobDescriptionQuery)).
+					WithArgs("<p>Write tests</p>", "Write tests", "", 1).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				assert.True(t, IsNotFound(err))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			err = repo.UpdateDescription(context.Background(), 1, "<p>Write tests</p>", "Write tests")
+			tt.checkResults(t, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_ListCanonicalCandidates(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+	source := SourceGreenhouse
+	job := &Job{CompanyID: 2, Title: "  Senior  Backend Engineer ", Source: &source}
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, candidates []*CanonicalCandidate, err error)
+	}{
+		{
+			name: "candidates found",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(listCanonicalCandidatesQuery)).
+					WithArgs(2, "senior backend engineer", &source).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "raw_description"}).
+						AddRow(5, "Build payment services").
+						AddRow(8, "Build payment services in Go"))
+			},
+			checkResults: func(t *testing.T, candidates []*CanonicalCandidate, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, []*CanonicalCandidate{
+					{JobID: 5, RawDescription: "Build payment services"},
+					{JobID: 8, RawDescription: "Build payment services in Go"},
+				}, candidates)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(listCanonicalCandidatesQuery)).
+					WithArgs(2, "senior backend engineer", &source).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ []*CanonicalCandidate, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			candidates, err := repo.ListCanonicalCandidates(context.Background(), job)
+			tt.checkResults(t, candidates, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_FindNearDuplicates(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	dbError := errors.New("database error")
+	linkedIn, greenhouse := SourceLinkedIn, SourceGreenhouse
+	columns := []string{
+		"company_id", "name",
+		"id", "title", "application_url", "source", "created_at",
+		"id", "title", "application_url", "source", "created_at",
+		"title_similarity", "url_similarity",
+	}
+
+	tests := []struct {
+		name         string
+		params       *NearDuplicateParams
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, duplicates []*NearDuplicate, err error)
+	}{
+		{
+			name: "pairs found for all jobs",
+			params: &NearDuplicateParams{
+				Window:             24 * time.Hour,
+				MinTitleSimilarity: 0.8,
+				MinURLSimilarity:   0.9,
+				Limit:              10,
+			},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(findNearDuplicateJobsQuery)).
+					WithArgs(float64(86400), 0.8, 0.9, []int(nil), 10).
+					WillReturnRows(pgxmock.NewRows(columns).
+						AddRow(1, "Tech Corp",
+							3, "Senior Go Developer", "https://techcorp.com/jobs/3", &linkedIn, now.Add(-time.Hour),
+							8, "Sr. Go Developer", "https://techcorp.com/jobs/8", &greenhouse, now,
+							0.82, 0.75))
+			},
+			checkResults: func(t *testing.T, duplicates []*NearDuplicate, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, duplicates, 1)
+				assert.Equal(t, "Tech Corp", duplicates[0].CompanyName)
+				assert.Equal(t, 3, duplicates[0].First.JobID)
+				assert.Equal(t, 8, duplicates[0].Second.JobID)
+				assert.Equal(t, &greenhouse, duplicates[0].Second.Source)
+				assert.InDelta(t, 0.82, duplicates[0].TitleSimilarity, 0.001)
+			},
+		},
+		{
+			name: "restricted to jobs",
+			params: &NearDuplicateParams{
+				Window:             24 * time.Hour,
+				MinTitleSimilarity: 0.8,
+				MinURLSimilarity:   0.9,
+				JobIDs:             []int{8},
+				Limit:              10,
+			},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(findNearDuplicateJobsQuery)).
+					WithArgs(float64(86400), 0.8, 0.9, []int{8}, 10).
+					WillReturnRows(pgxmock.NewRows(columns))
+			},
+			checkResults: func(t *testing.T, duplicates []*NearDuplicate, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Empty(t, duplicates)
+			},
+		},
+		{
+			name:   "database error",
+			params: &NearDuplicateParams{Window: time.Hour, Limit: 10},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(findNearDuplicateJobsQuery)).
+					WithArgs(float64(3600), 0.0, 0.0, []int(nil), 10).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ []*NearDuplicate, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			duplicates, err := repo.FindNearDuplicates(context.Background(), tt.params)
+			tt.checkResults(t, duplicates, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_RefreshSearchView(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, err error)
+	}{
+		{
+			name: "view refreshed",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(refreshJobSearchViewQuery)).
+					WillReturnResult(pgxmock.NewResult("REFRESH MATERIALIZED VIEW", 0))
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(refreshJobSearchViewQuery)).WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			err = repo.RefreshSearchView(context.Background())
+			tt.checkResults(t, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_FindSimilarJobs(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	dbError := errors.New("database error")
+	columns := []string{
+		"id", "company_id", "title", "description", "experience_level", "employment_type",
+		"location", "work_mode", "application_url", "is_active", "signature", "language",
+		"remote_eligibility", "utc_offset_min", "utc_offset_max", "created_at", "updated_at",
+		"name", "slug", "logo_url", "benefits", "shared_technologies",
+	}
+
+	tests := []struct {
+		name         string
+		params       *SimilarParams
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, similar []*SimilarJob, err error)
+	}{
+		{
+			name:   "similar jobs found",
+			params: &SimilarParams{JobID: 1, ExcludeSameCompany: true, Limit: 5},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(findSimilarJobsQuery)).
+					WithArgs(1, true, 5).
+					WillReturnRows(pgxmock.NewRows(columns).
+						AddRow(7, 2, "Go Developer", "Build APIs", "Senior", "Full-time",
+							"Costa Rica", "Remote", "https://other.com/jobs/7", true, "sig7", LanguageEnglish,
+							nil, nil, nil, now, now,
+							"Other Corp", "other-corp", "https://other.com/logo.png", []string{}, 3))
+			},
+			checkResults: func(t *testing.T, similar []*SimilarJob, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, similar, 1)
+				assert.Equal(t, 7, similar[0].ID)
+				assert.Equal(t, "Other Corp", similar[0].CompanyName)
+				assert.Equal(t, 3, similar[0].SharedTechnologies)
+			},
+		},
+		{
+			name:   "database error",
+			params: &SimilarParams{JobID: 1, Limit: 5},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(findSimilarJobsQuery)).
+					WithArgs(1, false, 5).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ []*SimilarJob, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			similar, err := repo.FindSimilarJobs(context.Background(), tt.params)
+			tt.checkResults(t, similar, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_ReplicaQueries(t *testing.T) {
+	t.Parallel()
+	primary, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer primary.Close()
+	replica, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer replica.Close()
+
+	repo := NewRepositoryWithReplica(primary, replica)
+
+	// Public list queries go to the replica
+	replica.ExpectQuery(regexp.QuoteMeta(findSimilarJobsQuery)).
+		WithArgs(1, false, 5).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}))
+	_, err = repo.FindSimilarJobs(context.Background(), &SimilarParams{JobID: 1, Limit: 5})
+	require.NoError(t, err)
+
+	// Writes go to the primary
+	primary.ExpectExec(regexp.QuoteMeta(deactivateJobQuery)).
+		WithArgs(1, "").
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	_, err = repo.Deactivate(context.Background(), 1)
+	require.NoError(t, err)
+
+	require.NoError(t, primary.ExpectationsWereMet())
+	require.NoError(t, replica.ExpectationsWereMet())
+}
+
+func TestRepository_ListByStatus(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	dbError := errors.New("database error")
+	columns := []string{
+		"id", "company_id", "title", "description", "experience_level", "employment_type",
+		"location", "work_mode", "application_url", "is_active", "signature", "language", "status",
+		"remote_eligibility", "utc_offset_min", "utc_offset_max", "source", "source_job_id", "canonical_job_id",
+		"created_at", "updated_at", "name", "slug", "logo_url", "benefits", "rejection_reason", "reviewed_at",
+		"reviewed_by",
+		"ok", "status_code", "error", "checked_at", "ok", "status_code", "error", "checked_at",
+	}
+	// Nullable columns are scanned into pointers, so the mock rows hold pointers too
+	linkOK, linkStatusCode, linkError := false, 404, "responded with status 404"
+	source, sourceJobID := SourceLinkedIn, "3912345678"
+	companyID, isActive := 2, false
+	createdFrom := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	params := &StatusListParams{
+		Status: StatusPending, Source: SourceLinkedIn, CompanyID: &companyID, IsActive: &isActive,
+		CreatedFrom: &createdFrom, Limit: 20,
+	}
+	filters := []any{StatusPending, SourceLinkedIn, &companyID, &isActive, &createdFrom, (*time.Time)(nil)}
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, jobs []*ModerationJob, total int, err error)
+	}{
+		{
+			name: "pending jobs listed",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(countJobsByStatusQuery)).
+					WithArgs(filters...).
+					WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(3))
+				mock.ExpectQuery(regexp.QuoteMeta(listJobsByStatusQuery)).
+					WithArgs(append(filters, 20, 0)...).
+					WillReturnRows(pgxmock.NewRows(columns).
+						AddRow(7, 2, "Go Developer", "Build APIs", "Senior", "Full-time",
+							"Costa Rica", "Remote", "https://techcorp.com/jobs/7", false, "sig7", LanguageEnglish, StatusPending,
+							nil, nil, nil, &source, &sourceJobID, nil,
+							now, now, "Tech Corp", "tech-corp", "https://techcorp.com/logo.png", []string{}, "", nil,
+							"", &linkOK, &linkStatusCode, &linkError, &now, nil, nil, nil, nil))
+			},
+			checkResults: func(t *testing.T, jobs []*ModerationJob, total int, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 3, total)
+				require.Len(t, jobs, 1)
+				assert.Equal(t, StatusPending, jobs[0].Status)
+				assert.Equal(t, "Tech Corp", jobs[0].CompanyName)
+				assert.Equal(t, &source, jobs[0].Source)
+				assert.Equal(t, &sourceJobID, jobs[0].SourceJobID)
+				assert.Nil(t, jobs[0].ReviewedAt)
+				require.NotNil(t, jobs[0].ApplicationURLStatus)
+				assert.False(t, jobs[0].ApplicationURLStatus.OK)
+				assert.Equal(t, 404, jobs[0].ApplicationURLStatus.StatusCode)
+				assert.Nil(t, jobs[0].CompanyLogoStatus)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(countJobsByStatusQuery)).
+					WithArgs(filters...).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ []*ModerationJob, _ int, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			jobs, total, err := repo.ListByStatus(context.Background(), params)
+			tt.checkResults(t, jobs, total, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_Review(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, reviewed bool, err error)
+	}{
+		{
+			name: "pending job rejected",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(reviewJobQuery)).
+					WithArgs(7, StatusRejected, "Spam", "user:3").
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+			checkResults: func(t *testing.T, reviewed bool, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.True(t, reviewed)
+			},
+		},
+		{
+			name: "job not pending",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(reviewJobQuery)).
+					WithArgs(7, StatusRejected, "Spam", "user:3").
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+			checkResults: func(t *testing.T, reviewed bool, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.False(t, reviewed)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(reviewJobQuery)).
+					WithArgs(7, StatusRejected, "Spam", "user:3").
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ bool, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			ctx := actor.With(context.Background(), actor.User(3))
+			reviewed, err := repo.Review(ctx, 7, StatusRejected, "Spam")
+			tt.checkResults(t, reviewed, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_IsCompanyActive(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	mockDB, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockDB.Close()
+	repo := NewRepository(mockDB)
+
+	mockDB.ExpectQuery(regexp.QuoteMeta(isCompanyActiveQuery)).
+		WithArgs(3).
+		WillReturnRows(pgxmock.NewRows([]string{"is_active"}).AddRow(false))
+	active, err := repo.IsCompanyActive(context.Background(), 3)
+	require.NoError(t, err)
+	assert.False(t, active)
+
+	mockDB.ExpectQuery(regexp.QuoteMeta(isCompanyActiveQuery)).
+		WithArgs(4).
+		WillReturnError(pgx.ErrNoRows)
+	active, err = repo.IsCompanyActive(context.Background(), 4)
+	require.NoError(t, err)
+	assert.False(t, active)
+
+	mockDB.ExpectQuery(regexp.QuoteMeta(isCompanyActiveQuery)).
+		WithArgs(3).
+		WillReturnError(dbError)
+	_, err = repo.IsCompanyActive(context.Background(), 3)
+	require.ErrorIs(t, err, dbError)
+
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
+
+func TestRepository_Deactivate(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, deactivated bool, err error)
+	}{
+		{
+			name: "active job deactivated",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(deactivateJobQuery)).
+					WithArgs(7, "").
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+			checkResults: func(t *testing.T, deactivated bool, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.True(t, deactivated)
+			},
+		},
+		{
+			name: "job not active",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(deactivateJobQuery)).
+					WithArgs(7, "").
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+			checkResults: func(t *testing.T, deactivated bool, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.False(t, deactivated)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(deactivateJobQuery)).
+					WithArgs(7, "").
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ bool, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			deactivated, err := repo.Deactivate(context.Background(), 7)
+			tt.checkResults(t, deactivated, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_Patch(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	dbError := errors.New("database error")
+	title := "Senior Go Developer"
+	rawDescription := "Build **APIs**<script>alert(1)</script>"
+	offsetMin, offsetMax := -6, -3
+	featured := true
+	jobColumns := []string{
+		"id", "company_id", "title", "description", "raw_description", "experience_level", "experience_level_inferred",
+		"employment_type",
+		"location", "work_mode", "application_url", "is_active", "signature", "language", "status",
+		"remote_eligibility", "utc_offset_min", "utc_offset_max", "source", "source_job_id", "canonical_job_id",
+		"created_at", "updated_at",
+	}
+
+	tests := []struct {
+		name         string
+		patch        *JobPatch
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, result *Job, err error)
+	}{
+		{
+			name:  "only the provided fields are updated",
+			patch: &JobPatch{Title: &title, UTCOffsetMin: &offsetMin, UTCOffsetMax: &offsetMax},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				query := fmt.Sprintf(patchJobQuery, "title = $1, utc_offset_min = $2, utc_offset_max = $3, updated_by = $4", "$5")
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(title, offsetMin, offsetMax, "", 7).
+					WillReturnRows(pgxmock.NewRows(jobColumns).AddRow(
+						7, 1, title, "<p>Job description</p>", "Job description", "Senior", false, "Full-time",
+						"Costa Rica", "Remote", "https://example.com/apply", true, "job-signature-7", LanguageEnglish,
+						StatusPublished, nil, &offsetMin, &offsetMax, nil, nil, nil, now, now,
+					))
+			},
+			checkResults: func(t *testing.T, result *Job, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 7, result.ID)
+				assert.Equal(t, title, result.Title)
+				assert.Equal(t, "job-signature-7", result.Signature)
+				assert.Equal(t, &offsetMin, result.UTCOffsetMin)
+				assert.Equal(t, &offsetMax, result.UTCOffsetMax)
+			},
+		},
+		{
+			name:  "description stored raw and sanitized",
+			patch: &JobPatch{Description: &rawDescription},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				query := fmt.Sprintf(patchJobQuery, "description = $1, raw_description = $2, updated_by = $3", "$4")
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs("<p>Build <strong>APIs</strong></p>", rawDescription, "", 7).
+					WillReturnRows(pgxmock.NewRows(jobColumns).AddRow(
+						7, 1, "Go Developer", "<p>Build <strong>APIs</strong></p>", rawDescription, "Senior", false, "Full-time",
+						"Costa Rica", "Remote", "https://example.com/apply", true, "job-signature-7", LanguageEnglish,
+						StatusPublished, nil, nil, nil, nil, nil, nil, now, now,
+					))
+			},
+			checkResults: func(t *testing.T, result *Job, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, "<p>Build <strong>APIs</strong></p>", result.Description)
+				assert.Equal(t, rawDescription, result.RawDescription)
+			},
+		},
+		{
+			name:  "job featured",
+			patch: &JobPatch{IsFeatured: &featured},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				query := fmt.Sprintf(patchJobQuery, "is_featured = $1, updated_by = $2", "$3")
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(true, "", 7).
+					WillReturnRows(pgxmock.NewRows(jobColumns).AddRow(
+						7, 1, "Go Developer", "<p>Job description</p>", "Job description", "Senior", false, "Full-time",
+						"Costa Rica", "Remote", "https://example.com/apply", true, "job-signature-7", LanguageEnglish,
+						StatusPublished, nil, nil, nil, nil, nil, nil, now, now,
+					))
+			},
+			checkResults: func(t *testing.T, result *Job, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 7, result.ID)
+			},
+		},
+		{
+			name:  "empty patch returns the job as it is",
+			patch: &JobPatch{},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getJobByIDQuery)).
+					WithArgs(7).
+					WillReturnRows(pgxmock.NewRows(jobColumns).AddRow(
+						7, 1, "Go Developer", "<p>Job description</p>", "Job description", "Senior", false, "Full-time",
+						"Costa Rica", "Remote", "https://example.com/apply", true, "job-signature-7", LanguageEnglish,
+						StatusPublished, nil, nil, nil, nil, nil, nil, now, now,
+					))
+			},
+			checkResults: func(t *testing.T, result *Job, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, "Go Developer", result.Title)
+			},
+		},
+		{
+			name:  "job not found",
+			patch: &JobPatch{Title: &title},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(patchJobQuery, "title = $1, updated_by = $2", "$3"))).
+					WithArgs(title, "", 7).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			checkResults: func(t *testing.T, result *Job, err error) {
+				t.Helper()
+				assert.Nil(t, result)
+				assert.True(t, IsNotFound(err))
+			},
+		},
+		{
+			name:  "database error",
+			patch: &JobPatch{Title: &title},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(patchJobQuery, "title = $1, updated_by = $2", "$3"))).
+					WithArgs(title, "", 7).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, result *Job, err error) {
+				t.Helper()
+				assert.Nil(t, result)
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			result, err := repo.Patch(context.Background(), 7, tt.patch)
+			tt.checkResults(t, result, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package jobs
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/jobtech"
+)
+
+// searchCacheKeyPrefix prefixes the keys of the cached search pages, shared with other caches in Redis
+const searchCacheKeyPrefix = "jobs:search:"
+
+// SearchCacheVersionKey is the Redis key of the version of the cached search pages, bumped when jobs
+// change so no instance serves the pages cached before, see cache.TieredConfig
+const SearchCacheVersionKey = searchCacheKeyPrefix + "version"
+
+// SearchCache interface to cache the encoded search pages, see cache.Tiered.
+type SearchCache interface {
+	GetOrLoad(ctx context.Context, key string, load func(ctx context.Context) ([]byte, error)) ([]byte, error)
+}
+
+// cachedSearchPage is a page of search results as cached
+type cachedSearchPage struct {
+	Jobs  []*JobWithCompany `json:"jobs"`
+	Total int               `json:"total"`
+}
+
+// CachedSearchRepository caches the search pages of a DataRepository, so the popular searches are
+// served from the cache. Pages are cached by their search parameters, country included, until
+// they expire or the cache is cleared and its version bumped when jobs change.
+type CachedSearchRepository struct {
+	repo  DataRepository
+	cache SearchCache
+}
+
+// NewCachedSearchRepository creates a new instance of CachedSearchRepository
+func NewCachedSearchRepository(repo DataRepository, cache SearchCache) *CachedSearchRepository {
+	return &CachedSearchRepository{repo: repo, cache: cache}
+}
+
+// SearchJobsWithCount returns the cached page of params, searching it when missing
+func (r *CachedSearchRepository) SearchJobsWithCount(ctx context.Context, params *SearchParams) (
+	[]*JobWithCompany, int, error) {
+	key, err := searchCacheKey(params)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	data, err := r.cache.GetOrLoad(ctx, key, func(ctx context.Context) ([]byte, error) {
+		jobs, total, err := r.repo.SearchJobsWithCount(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(&cachedSearchPage{Jobs: jobs, Total: total})
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var page cachedSearchPage
+	if err = json.Unmarshal(data, &page); err != nil {
+		return nil, 0, fmt.Errorf("failed to decode cached search page: %w", err)
+	}
+	return page.Jobs, page.Total, nil
+}
+
+// GetJobTechnologiesBatch delegates to the repository, the technologies aren't cached
+func (r *CachedSearchRepository) GetJobTechnologiesBatch(ctx context.Context, jobIDs []int) (
+	map[int][]*jobtech.JobTechnologyWithDetails, error) {
+	return r.repo.GetJobTechnologiesBatch(ctx, jobIDs)
+}
+
+// searchCacheKey returns the cache key of the search parameters, a hash of all of them
+func searchCacheKey(params *SearchParams) (string, error) {
+	data, err := json.Marshal(params)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode search parameters: %w", err)
+	}
+	hash := sha256.Sum256(data)
+	return searchCacheKeyPrefix + hex.EncodeToString(hash[:]), nil
+}
@@ -0,0 +1,86 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachedSearchRepository_SearchJobsWithCount(t *testing.T) {
+	t.Parallel()
+	params := &SearchParams{Query: "golang", Limit: 20, Country: "CR"}
+	key, err := searchCacheKey(params)
+	require.NoError(t, err)
+	jobs := []*JobWithCompany{{Job: Job{ID: 7, Title: "Go Developer"}, CompanyName: "Tech Corp"}}
+	dbErr := errors.New("database error")
+
+	// loadFromRepo runs the load of the cache, as on a miss
+	loadFromRepo := func(ctx context.Context, _ string, load func(ctx context.Context) ([]byte, error)) ([]byte, error) {
+		return load(ctx)
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(repo *MockDataRepository, c *MockSearchCache)
+		err       error
+	}{
+		{
+			name: "searched on a miss",
+			setupMock: func(repo *MockDataRepository, c *MockSearchCache) {
+				c.EXPECT().GetOrLoad(mock.Anything, key, mock.Anything).RunAndReturn(loadFromRepo).Once()
+				repo.EXPECT().SearchJobsWithCount(mock.Anything, params).Return(jobs, 1, nil).Once()
+			},
+		},
+		{
+			name: "served from the cache",
+			setupMock: func(_ *MockDataRepository, c *MockSearchCache) {
+				c.EXPECT().GetOrLoad(mock.Anything, key, mock.Anything).
+					Return([]byte(`{"jobs":[{"ID":7,"Title":"Go Developer","CompanyName":"Tech Corp"}],"total":1}`), nil).Once()
+			},
+		},
+		{
+			name: "search error",
+			setupMock: func(repo *MockDataRepository, c *MockSearchCache) {
+				c.EXPECT().GetOrLoad(mock.Anything, key, mock.Anything).RunAndReturn(loadFromRepo).Once()
+				repo.EXPECT().SearchJobsWithCount(mock.Anything, params).Return(nil, 0, dbErr).Once()
+			},
+			err: dbErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := NewMockDataRepository(t)
+			c := NewMockSearchCache(t)
+			tt.setupMock(repo, c)
+
+			result, total, err := NewCachedSearchRepository(repo, c).SearchJobsWithCount(context.Background(), params)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, 1, total)
+			assert.Equal(t, jobs, result)
+		})
+	}
+}
+
+func TestSearchCacheKey(t *testing.T) {
+	t.Parallel()
+	costaRica, err := searchCacheKey(&SearchParams{Query: "golang", Limit: 20, Country: "CR"})
+	require.NoError(t, err)
+	same, err := searchCacheKey(&SearchParams{Query: "golang", Limit: 20, Country: "CR"})
+	require.NoError(t, err)
+	panama, err := searchCacheKey(&SearchParams{Query: "golang", Limit: 20, Country: "PA"})
+	require.NoError(t, err)
+
+	assert.Equal(t, costaRica, same)
+	assert.NotEqual(t, costaRica, panama)
+	assert.Contains(t, costaRica, searchCacheKeyPrefix)
+}
@@ -2,23 +2,44 @@ package jobs
 
 import (
 	"context"
+	"slices"
+	"strings"
 
 	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+	"github.com/rodruizronald/ticos-in-tech/internal/i18n"
 )
 
+// minTermSimilarity is the trigram similarity a known term needs with a search term to be suggested
+// as its correct spelling
+const minTermSimilarity = 0.5
+
+// TermRepository interface to look up the synonyms and the known spellings of search terms.
+type TermRepository interface {
+	ListSynonyms(ctx context.Context, terms []string) (map[string]string, error)
+	FindClosestTerms(ctx context.Context, terms []string, minSimilarity float64) (map[string]string, error)
+}
+
 // SearchService implements the httpservice.SearchService interface
 type SearchService struct {
 	repos DataRepository
+	terms TermRepository
 }
 
 // NewSearchService creates a new instance of SearchService
-func NewSearchService(repos DataRepository) httpservice.SearchService[*SearchParams, JobResponseList] {
-	return &SearchService{repos: repos}
+func NewSearchService(repos DataRepository, terms TermRepository) httpservice.SearchService[*SearchParams,
+	JobResponseList] {
+	return &SearchService{repos: repos, terms: terms}
 }
 
-// ExecuteSearch implements the SearchService interface to execute a search
+// ExecuteSearch implements the SearchService interface to execute a search. Jobs matching the query
+// with its terms replaced by their synonyms are found too.
 func (s *SearchService) ExecuteSearch(ctx context.Context, params *SearchParams) (JobResponseList, int, error) {
-	// Your existing business logic
+	synonyms, err := s.terms.ListSynonyms(ctx, searchTerms(params.Query))
+	if err != nil {
+		return nil, 0, &httpservice.SearchError{Operation: "list search synonyms", Err: err}
+	}
+	params.SynonymQuery = replaceTerms(params.Query, synonyms)
+
 	jobs, total, err := s.repos.SearchJobsWithCount(ctx, params)
 	if err != nil {
 		return nil, 0, &httpservice.SearchError{Operation: "search jobs", Err: err}
@@ -37,7 +58,46 @@ func (s *SearchService) ExecuteSearch(ctx context.Context, params *SearchParams)
 	}
 
 	// Convert jobs to response format with technologies
-	searchResult := MapJobsToResponse(jobs, technologiesMap)
+	searchResult := MapJobsToResponse(jobs, technologiesMap, i18n.From(ctx))
 
 	return searchResult, total, nil
 }
+
+// SuggestQuery implements the httpservice.QuerySuggester interface, suggesting the query with its
+// misspelled terms replaced by the most similar technology, technology alias or search synonym term.
+// Suggestions are best effort, none is given when the lookup fails.
+func (s *SearchService) SuggestQuery(ctx context.Context, params *SearchParams) string {
+	closest, err := s.terms.FindClosestTerms(ctx, searchTerms(params.Query), minTermSimilarity)
+	if err != nil {
+		return ""
+	}
+	return replaceTerms(params.Query, closest)
+}
+
+// searchTerms returns the distinct lowercase terms of a search query
+func searchTerms(query string) []string {
+	var terms []string
+	for _, term := range strings.Fields(strings.ToLower(query)) {
+		if !slices.Contains(terms, term) {
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
+
+// replaceTerms returns the query with its terms replaced by the ones they are mapped to, or an empty
+// string when no term is replaced by a different one
+func replaceTerms(query string, replacements map[string]string) string {
+	words := strings.Fields(query)
+	replaced := false
+	for i, word := range words {
+		if replacement, ok := replacements[strings.ToLower(word)]; ok && replacement != strings.ToLower(word) {
+			words[i] = replacement
+			replaced = true
+		}
+	}
+	if !replaced {
+		return ""
+	}
+	return strings.Join(words, " ")
+}
@@ -22,7 +22,7 @@ func TestJobSearchService_ExecuteSearch(t *testing.T) {
 	tests := []struct {
 		name         string
 		params       *SearchParams
-		mockSetup    func(mockRepo *MockDataRepository, params *SearchParams)
+		mockSetup    func(mockRepo *MockDataRepository, mockTerms *MockTermRepository, params *SearchParams)
 		checkResults func(t *testing.T, result JobResponseList, total int, err error)
 	}{
 		{
@@ -32,8 +32,11 @@ func TestJobSearchService_ExecuteSearch(t *testing.T) {
 				Limit:  10,
 				Offset: 0,
 			},
-			mockSetup: func(mockRepo *MockDataRepository, params *SearchParams) {
+			mockSetup: func(mockRepo *MockDataRepository, mockTerms *MockTermRepository, params *SearchParams) {
 				t.Helper()
+				mockTerms.EXPECT().ListSynonyms(context.Background(), []string{"golang", "developer"}).
+					Return(map[string]string{}, nil).Once()
+
 				jobs := []*JobWithCompany{
 					{
 						Job: Job{
@@ -81,6 +84,7 @@ func TestJobSearchService_ExecuteSearch(t *testing.T) {
 							TechnologyID: 1,
 							TechName:     "Go",
 							TechCategory: "Programming Language",
+							IsPrimary:    true,
 							IsRequired:   true,
 						},
 						{
@@ -119,8 +123,10 @@ func TestJobSearchService_ExecuteSearch(t *testing.T) {
 				assert.Equal(t, "Tech Corp", result[0].CompanyName)
 				assert.Len(t, result[0].Technologies, 2)
 				assert.Equal(t, "Go", result[0].Technologies[0].Name)
+				assert.True(t, result[0].Technologies[0].Primary)
 				assert.True(t, result[0].Technologies[0].Required)
 				assert.Equal(t, "PostgreSQL", result[0].Technologies[1].Name)
+				assert.False(t, result[0].Technologies[1].Primary)
 				assert.False(t, result[0].Technologies[1].Required)
 
 				// Check second job
@@ -139,8 +145,11 @@ func TestJobSearchService_ExecuteSearch(t *testing.T) {
 				Limit:  20,
 				Offset: 0,
 			},
-			mockSetup: func(mockRepo *MockDataRepository, params *SearchParams) {
+			mockSetup: func(mockRepo *MockDataRepository, mockTerms *MockTermRepository, params *SearchParams) {
 				t.Helper()
+				mockTerms.EXPECT().ListSynonyms(context.Background(), []string{"nonexistent", "technology"}).
+					Return(map[string]string{}, nil).Once()
+
 				mockRepo.EXPECT().SearchJobsWithCount(context.Background(), params).
 					Return([]*JobWithCompany{}, 0, nil).Once()
 
@@ -161,8 +170,11 @@ func TestJobSearchService_ExecuteSearch(t *testing.T) {
 				Limit:  5,
 				Offset: 0,
 			},
-			mockSetup: func(mockRepo *MockDataRepository, params *SearchParams) {
+			mockSetup: func(mockRepo *MockDataRepository, mockTerms *MockTermRepository, params *SearchParams) {
 				t.Helper()
+				mockTerms.EXPECT().ListSynonyms(context.Background(), []string{"simple", "job"}).
+					Return(map[string]string{}, nil).Once()
+
 				jobs := []*JobWithCompany{
 					{
 						Job: Job{
@@ -215,8 +227,11 @@ func TestJobSearchService_ExecuteSearch(t *testing.T) {
 				WorkMode:        stringPtr("Remote"),
 				Company:         stringPtr("TechCorp"),
 			},
-			mockSetup: func(mockRepo *MockDataRepository, params *SearchParams) {
+			mockSetup: func(mockRepo *MockDataRepository, mockTerms *MockTermRepository, params *SearchParams) {
 				t.Helper()
+				mockTerms.EXPECT().ListSynonyms(context.Background(), []string{"senior", "developer"}).
+					Return(map[string]string{}, nil).Once()
+
 				jobs := []*JobWithCompany{
 					{
 						Job: Job{
@@ -280,8 +295,11 @@ func TestJobSearchService_ExecuteSearch(t *testing.T) {
 				Limit:  10,
 				Offset: 0,
 			},
-			mockSetup: func(mockRepo *MockDataRepository, params *SearchParams) {
+			mockSetup: func(mockRepo *MockDataRepository, mockTerms *MockTermRepository, params *SearchParams) {
 				t.Helper()
+				mockTerms.EXPECT().ListSynonyms(context.Background(), []string{"error", "query"}).
+					Return(map[string]string{}, nil).Once()
+
 				mockRepo.EXPECT().SearchJobsWithCount(context.Background(), params).
 					Return(nil, 0, searchError).Once()
 			},
@@ -304,8 +322,11 @@ func TestJobSearchService_ExecuteSearch(t *testing.T) {
 				Limit:  10,
 				Offset: 0,
 			},
-			mockSetup: func(mockRepo *MockDataRepository, params *SearchParams) {
+			mockSetup: func(mockRepo *MockDataRepository, mockTerms *MockTermRepository, params *SearchParams) {
 				t.Helper()
+				mockTerms.EXPECT().ListSynonyms(context.Background(), []string{"tech", "error", "query"}).
+					Return(map[string]string{}, nil).Once()
+
 				jobs := []*JobWithCompany{
 					{
 						Job: Job{
@@ -346,6 +367,58 @@ func TestJobSearchService_ExecuteSearch(t *testing.T) {
 				require.ErrorIs(t, searchErr.Err, technologiesError)
 			},
 		},
+		{
+			name: "search with synonyms of the query terms",
+			params: &SearchParams{
+				Query:  "QA k8s engineer",
+				Limit:  10,
+				Offset: 0,
+			},
+			mockSetup: func(mockRepo *MockDataRepository, mockTerms *MockTermRepository, params *SearchParams) {
+				t.Helper()
+				mockTerms.EXPECT().ListSynonyms(context.Background(), []string{"qa", "k8s", "engineer"}).
+					Return(map[string]string{"qa": "quality assurance", "k8s": "kubernetes"}, nil).Once()
+
+				mockRepo.EXPECT().SearchJobsWithCount(context.Background(), params).
+					Run(func(_ context.Context, params *SearchParams) {
+						assert.Equal(t, "quality assurance kubernetes engineer", params.SynonymQuery)
+					}).
+					Return([]*JobWithCompany{}, 0, nil).Once()
+
+				mockRepo.EXPECT().GetJobTechnologiesBatch(context.Background(), []int{}).
+					Return(map[int][]*jobtech.JobTechnologyWithDetails{}, nil).Once()
+			},
+			checkResults: func(t *testing.T, result JobResponseList, total int, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Empty(t, result)
+				assert.Equal(t, 0, total)
+			},
+		},
+		{
+			name: "synonym lookup error",
+			params: &SearchParams{
+				Query:  "qa",
+				Limit:  10,
+				Offset: 0,
+			},
+			mockSetup: func(_ *MockDataRepository, mockTerms *MockTermRepository, _ *SearchParams) {
+				t.Helper()
+				mockTerms.EXPECT().ListSynonyms(context.Background(), []string{"qa"}).
+					Return(nil, searchError).Once()
+			},
+			checkResults: func(t *testing.T, result JobResponseList, total int, err error) {
+				t.Helper()
+				require.Error(t, err)
+				assert.Nil(t, result)
+				assert.Equal(t, 0, total)
+
+				var searchErr *httpservice.SearchError
+				require.ErrorAs(t, err, &searchErr)
+				assert.Equal(t, "list search synonyms", searchErr.Operation)
+				require.ErrorIs(t, searchErr.Err, searchError)
+			},
+		},
 		{
 			name: "edge case: empty query string",
 			params: &SearchParams{
@@ -353,8 +426,11 @@ func TestJobSearchService_ExecuteSearch(t *testing.T) {
 				Limit:  10,
 				Offset: 0,
 			},
-			mockSetup: func(mockRepo *MockDataRepository, params *SearchParams) {
+			mockSetup: func(mockRepo *MockDataRepository, mockTerms *MockTermRepository, params *SearchParams) {
 				t.Helper()
+				mockTerms.EXPECT().ListSynonyms(context.Background(), []string(nil)).
+					Return(map[string]string{}, nil).Once()
+
 				mockRepo.EXPECT().SearchJobsWithCount(context.Background(), params).
 					Return([]*JobWithCompany{}, 0, nil).Once()
 
@@ -375,8 +451,11 @@ func TestJobSearchService_ExecuteSearch(t *testing.T) {
 				Limit:  100,
 				Offset: 0,
 			},
-			mockSetup: func(mockRepo *MockDataRepository, params *SearchParams) {
+			mockSetup: func(mockRepo *MockDataRepository, mockTerms *MockTermRepository, params *SearchParams) {
 				t.Helper()
+				mockTerms.EXPECT().ListSynonyms(context.Background(), []string{"max", "limit", "test"}).
+					Return(map[string]string{}, nil).Once()
+
 				// Create a large slice of jobs to test maximum limit
 				jobs := make([]*JobWithCompany, 100)
 				jobIDs := make([]int, 100)
@@ -427,8 +506,11 @@ func TestJobSearchService_ExecuteSearch(t *testing.T) {
 				Limit:  10,
 				Offset: 9990,
 			},
-			mockSetup: func(mockRepo *MockDataRepository, params *SearchParams) {
+			mockSetup: func(mockRepo *MockDataRepository, mockTerms *MockTermRepository, params *SearchParams) {
 				t.Helper()
+				mockTerms.EXPECT().ListSynonyms(context.Background(), []string{"high", "offset", "test"}).
+					Return(map[string]string{}, nil).Once()
+
 				mockRepo.EXPECT().SearchJobsWithCount(context.Background(), params).
 					Return([]*JobWithCompany{}, 10000, nil).Once()
 
@@ -449,8 +531,11 @@ func TestJobSearchService_ExecuteSearch(t *testing.T) {
 				Limit:  1,
 				Offset: 0,
 			},
-			mockSetup: func(mockRepo *MockDataRepository, params *SearchParams) {
+			mockSetup: func(mockRepo *MockDataRepository, mockTerms *MockTermRepository, params *SearchParams) {
 				t.Helper()
+				mockTerms.EXPECT().ListSynonyms(context.Background(), []string{"full", "stack", "developer"}).
+					Return(map[string]string{}, nil).Once()
+
 				jobs := []*JobWithCompany{
 					{
 						Job: Job{
@@ -516,12 +601,65 @@ func TestJobSearchService_ExecuteSearch(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			mockRepo := NewMockDataRepository(t)
-			service := NewSearchService(mockRepo)
+			mockTerms := NewMockTermRepository(t)
+			service := NewSearchService(mockRepo, mockTerms)
 
-			tt.mockSetup(mockRepo, tt.params)
+			tt.mockSetup(mockRepo, mockTerms, tt.params)
 
 			result, total, err := service.ExecuteSearch(context.Background(), tt.params)
 			tt.checkResults(t, result, total, err)
 		})
 	}
 }
+
+func TestSearchService_SuggestQuery(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		query     string
+		mockSetup func(mockTerms *MockTermRepository)
+		expected  string
+	}{
+		{
+			name:  "misspelled terms corrected",
+			query: "Kubernetess developer",
+			mockSetup: func(mockTerms *MockTermRepository) {
+				mockTerms.EXPECT().
+					FindClosestTerms(context.Background(), []string{"kubernetess", "developer"}, minTermSimilarity).
+					Return(map[string]string{"kubernetess": "kubernetes"}, nil).Once()
+			},
+			expected: "kubernetes developer",
+		},
+		{
+			name:  "known terms not corrected",
+			query: "golang",
+			mockSetup: func(mockTerms *MockTermRepository) {
+				mockTerms.EXPECT().FindClosestTerms(context.Background(), []string{"golang"}, minTermSimilarity).
+					Return(map[string]string{"golang": "golang"}, nil).Once()
+			},
+			expected: "",
+		},
+		{
+			name:  "lookup error",
+			query: "kubernetess",
+			mockSetup: func(mockTerms *MockTermRepository) {
+				mockTerms.EXPECT().FindClosestTerms(context.Background(), []string{"kubernetess"}, minTermSimilarity).
+					Return(nil, errors.New("database error")).Once()
+			},
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockTerms := NewMockTermRepository(t)
+			service := &SearchService{repos: NewMockDataRepository(t), terms: mockTerms}
+
+			tt.mockSetup(mockTerms)
+
+			assert.Equal(t, tt.expected, service.SuggestQuery(context.Background(), &SearchParams{Query: tt.query}))
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package jobs
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"hash/fnv"
+	"html"
+	"math/bits"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// signatureSeparator separates the normalized fields hashed into a signature
+const signatureSeparator = "\x1f"
+
+// descriptionShingleSize is the number of consecutive words hashed together into a description hash
+const descriptionShingleSize = 3
+
+// markupPattern matches the HTML tags of a description, dropped before hashing it
+var markupPattern = regexp.MustCompile(`<[^>]*>`)
+
+// ComputeSignature returns the canonical signature of a job: the hex encoded SHA-256 of its
+// normalized company name, title and application URL. Jobs that differ only in letter case,
+// surrounding or repeated whitespace, or a trailing slash in the URL get the same signature.
+func ComputeSignature(companyName, title, applicationURL string) string {
+	fields := []string{
+		normalizeSignatureField(companyName),
+		normalizeSignatureField(title),
+		strings.TrimRight(normalizeSignatureField(applicationURL), "/"),
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(fields, signatureSeparator)))
+	return hex.EncodeToString(sum[:])
+}
+
+// normalizeSignatureField lowercases a value and collapses its whitespace
+func normalizeSignatureField(value string) string {
+	return strings.Join(strings.Fields(strings.ToLower(value)), " ")
+}
+
+// ComputeDescriptionHash returns the simhash of a raw job description: a 64-bit hash whose bits are
+// voted by the hashes of its overlapping runs of words. Descriptions differing in markup, case or
+// punctuation get the same hash, and ones differing in a few words get hashes differing in a few
+// bits, see DescriptionHashDistance. Descriptions without words hash to zero.
+func ComputeDescriptionHash(raw string) int64 {
+	text := html.UnescapeString(markupPattern.ReplaceAllString(raw, " "))
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		return 0
+	}
+
+	var votes [64]int
+	shingles := max(len(words)-descriptionShingleSize+1, 1)
+	for i := range shingles {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(strings.Join(words[i:min(i+descriptionShingleSize, len(words))], " ")))
+		sum := h.Sum64()
+		for bit := range votes {
+			if sum&(1<<bit) != 0 {
+				votes[bit]++
+			} else {
+				votes[bit]--
+			}
+		}
+	}
+
+	var hash uint64
+	for bit, vote := range votes {
+		if vote > 0 {
+			hash |= 1 << bit
+		}
+	}
+	return int64(hash)
+}
+
+// DescriptionHashDistance returns the number of bits two description hashes differ by
+func DescriptionHashDistance(a, b int64) int {
+	return bits.OnesCount64(uint64(a ^ b))
+}
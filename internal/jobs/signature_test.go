@@ -0,0 +1,118 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeSignature(t *testing.T) {
+	t.Parallel()
+
+	signature := ComputeSignature("Tech Corp", "Senior Go Developer", "https://techcorp.com/jobs/1")
+	assert.Len(t, signature, 64)
+
+	tests := []struct {
+		name           string
+		companyName    string
+		title          string
+		applicationURL string
+		same           bool
+	}{
+		{
+			name:           "case and whitespace are ignored",
+			companyName:    "  tech corp ",
+			title:          "Senior  GO developer",
+			applicationURL: "HTTPS://techcorp.com/jobs/1/",
+			same:           true,
+		},
+		{
+			name:           "different title",
+			companyName:    "Tech Corp",
+			title:          "Junior Go Developer",
+			applicationURL: "https://techcorp.com/jobs/1",
+			same:           false,
+		},
+		{
+			name:           "different application URL",
+			companyName:    "Tech Corp",
+			title:          "Senior Go Developer",
+			applicationURL: "https://techcorp.com/jobs/2",
+			same:           false,
+		},
+		{
+			name:           "fields are not concatenated ambiguously",
+			companyName:    "Tech Corp Senior",
+			title:          "Go Developer",
+			applicationURL: "https://techcorp.com/jobs/1",
+			same:           false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			result := ComputeSignature(tt.companyName, tt.title, tt.applicationURL)
+			assert.Equal(t, tt.same, result == signature)
+		})
+	}
+}
+
+func TestComputeDescriptionHash(t *testing.T) {
+	t.Parallel()
+
+	description := "We are looking for a Senior Backend Engineer to join our payments team. You will design, " +
+		"build and operate the services that move money for millions of customers across Latin America, " +
+		"review code, mentor junior engineers and take part in the on-call rotation.\n" +
+		"Requirements: 5+ years of professional experience building backend systems, strong knowledge " +
+		"of Go, experience with PostgreSQL, Redis and Kafka, experience with Docker, Kubernetes and AWS, " +
+		"and fluent English, written and spoken.\n" +
+		"What we offer: competitive salary in US dollars, private health insurance, stock options, " +
+		"flexible hours and a learning budget."
+	hash := ComputeDescriptionHash(description)
+
+	tests := []struct {
+		name        string
+		description string
+		minDistance int
+		maxDistance int
+	}{
+		{
+			name: "markup, case and punctuation are ignored",
+			description: "<p>We are looking for a <strong>senior backend engineer</strong> to join our payments " +
+				"team! You will design, build and operate the services that move money for millions of " +
+				"customers across Latin America, review code, mentor junior engineers and take part in the " +
+				"on-call rotation.</p><h3>Requirements</h3><ul><li>5+ years of professional experience " +
+				"building backend systems</li><li>strong knowledge of Go</li><li>experience with PostgreSQL, " +
+				"Redis and Kafka</li><li>experience with Docker, Kubernetes and AWS</li><li>and fluent " +
+				"English, written and spoken</li></ul><h3>What we offer</h3><p>competitive salary in US " +
+				"dollars, private health insurance, stock options, flexible hours and a learning budget.</p>",
+			maxDistance: 0,
+		},
+		{
+			name: "footer added by the source",
+			description: description + "\nApply now through our careers page. Tech Corp is an equal " +
+				"opportunity employer.",
+			maxDistance: MaxDescriptionHashDistance,
+		},
+		{
+			name: "different description",
+			description: "Buscamos una persona analista de datos para el equipo de finanzas. Manejo de SQL, " +
+				"Power BI y Excel avanzado, con inglés intermedio. Ofrecemos seguro médico y horario flexible.",
+			minDistance: MaxDescriptionHashDistance + 1,
+			maxDistance: 64,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			distance := DescriptionHashDistance(hash, ComputeDescriptionHash(tt.description))
+			assert.GreaterOrEqual(t, distance, tt.minDistance)
+			assert.LessOrEqual(t, distance, tt.maxDistance)
+		})
+	}
+
+	assert.Zero(t, ComputeDescriptionHash("<p> - </p>"))
+	assert.NotZero(t, ComputeDescriptionHash("Go"))
+}
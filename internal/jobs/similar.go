@@ -0,0 +1,62 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/i18n"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobtech"
+)
+
+// Defaults for similar job recommendations
+const (
+	DefaultSimilarLimit = 5
+	MaxSimilarLimit     = 20
+)
+
+// SimilarRepository interface to look up jobs similar to a given one.
+type SimilarRepository interface {
+	GetByID(ctx context.Context, id int) (*Job, error)
+	FindSimilarJobs(ctx context.Context, params *SimilarParams) ([]*SimilarJob, error)
+	GetJobTechnologiesBatch(ctx context.Context, jobIDs []int) (map[int][]*jobtech.JobTechnologyWithDetails, error)
+}
+
+// RecommendationService recommends jobs similar to the one a user is looking at
+type RecommendationService struct {
+	repos SimilarRepository
+}
+
+// NewRecommendationService creates a new instance of RecommendationService
+func NewRecommendationService(repos SimilarRepository) *RecommendationService {
+	return &RecommendationService{repos: repos}
+}
+
+// SimilarJobs returns the active jobs with the same experience level that share the most required
+// technologies with the job of params.JobID. A NotFoundError is returned when the job doesn't exist.
+func (s *RecommendationService) SimilarJobs(ctx context.Context, params SimilarParams) (
+	*SimilarJobListResponse, error) {
+	if _, err := s.repos.GetByID(ctx, params.JobID); err != nil {
+		return nil, err
+	}
+
+	if params.Limit <= 0 {
+		params.Limit = DefaultSimilarLimit
+	}
+	params.Limit = min(params.Limit, MaxSimilarLimit)
+
+	similar, err := s.repos.FindSimilarJobs(ctx, &params)
+	if err != nil {
+		return nil, err
+	}
+
+	jobIDs := make([]int, len(similar))
+	for i, job := range similar {
+		jobIDs[i] = job.ID
+	}
+
+	technologiesMap, err := s.repos.GetJobTechnologiesBatch(ctx, jobIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return MapSimilarJobsToResponse(similar, technologiesMap, i18n.From(ctx)), nil
+}
@@ -0,0 +1,110 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/jobtech"
+)
+
+func TestRecommendationService_SimilarJobs(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		params       SimilarParams
+		mockSetup    func(mockRepo *MockSimilarRepository)
+		checkResults func(t *testing.T, resp *SimilarJobListResponse, err error)
+	}{
+		{
+			name:   "similar jobs with technologies",
+			params: SimilarParams{JobID: 1},
+			mockSetup: func(mockRepo *MockSimilarRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetByID(context.Background(), 1).Return(&Job{ID: 1}, nil).Once()
+				mockRepo.EXPECT().FindSimilarJobs(context.Background(), &SimilarParams{
+					JobID: 1,
+					Limit: DefaultSimilarLimit,
+				}).Return([]*SimilarJob{{
+					JobWithCompany:     JobWithCompany{Job: Job{ID: 7, Title: "Go Developer"}},
+					SharedTechnologies: 2,
+				}}, nil).Once()
+				mockRepo.EXPECT().GetJobTechnologiesBatch(context.Background(), []int{7}).
+					Return(map[int][]*jobtech.JobTechnologyWithDetails{
+						7: {{TechName: "Go", TechCategory: "Language", IsRequired: true}},
+					}, nil).Once()
+			},
+			checkResults: func(t *testing.T, resp *SimilarJobListResponse, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, resp.Data, 1)
+				assert.Equal(t, 7, resp.Data[0].ID)
+				assert.Equal(t, 2, resp.Data[0].SharedTechnologies)
+				assert.Equal(t, "Go", resp.Data[0].Technologies[0].Name)
+			},
+		},
+		{
+			name:   "limit clamped",
+			params: SimilarParams{JobID: 1, ExcludeSameCompany: true, Limit: 100},
+			mockSetup: func(mockRepo *MockSimilarRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetByID(context.Background(), 1).Return(&Job{ID: 1}, nil).Once()
+				mockRepo.EXPECT().FindSimilarJobs(context.Background(), &SimilarParams{
+					JobID:              1,
+					ExcludeSameCompany: true,
+					Limit:              MaxSimilarLimit,
+				}).Return(nil, nil).Once()
+				mockRepo.EXPECT().GetJobTechnologiesBatch(context.Background(), []int{}).Return(nil, nil).Once()
+			},
+			checkResults: func(t *testing.T, resp *SimilarJobListResponse, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Empty(t, resp.Data)
+			},
+		},
+		{
+			name:   "job not found",
+			params: SimilarParams{JobID: 99},
+			mockSetup: func(mockRepo *MockSimilarRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetByID(context.Background(), 99).Return(nil, &NotFoundError{ID: 99}).Once()
+			},
+			checkResults: func(t *testing.T, _ *SimilarJobListResponse, err error) {
+				t.Helper()
+				assert.True(t, IsNotFound(err))
+			},
+		},
+		{
+			name:   "repository error",
+			params: SimilarParams{JobID: 1},
+			mockSetup: func(mockRepo *MockSimilarRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetByID(context.Background(), 1).Return(&Job{ID: 1}, nil).Once()
+				mockRepo.EXPECT().FindSimilarJobs(context.Background(), mock.Anything).Return(nil, dbError).Once()
+			},
+			checkResults: func(t *testing.T, _ *SimilarJobListResponse, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockSimilarRepository(t)
+			service := NewRecommendationService(mockRepo)
+
+			tt.mockSetup(mockRepo)
+
+			resp, err := service.SimilarJobs(context.Background(), tt.params)
+			tt.checkResults(t, resp, err)
+		})
+	}
+}
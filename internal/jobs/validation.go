@@ -0,0 +1,52 @@
+package jobs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+	"github.com/rodruizronald/ticos-in-tech/internal/location"
+	"github.com/rodruizronald/ticos-in-tech/internal/reference"
+)
+
+// Binding tags of the job attributes, used by the request DTOs
+func init() {
+	httpservice.RegisterValidation("experience_level", isReferenceValue(reference.KindExperienceLevel), "")
+	httpservice.RegisterValidation("employment_type", isReferenceValue(reference.KindEmploymentType), "")
+	httpservice.RegisterValidation("location", isReferenceValue(reference.KindLocation), "")
+	httpservice.RegisterValidation("work_mode", isReferenceValue(reference.KindWorkMode), "")
+	httpservice.RegisterValidation("language", httpservice.OneOf(validLanguages...), "")
+	httpservice.RegisterValidation("remote_eligibility", httpservice.OneOf(validRemoteEligibilities...), "")
+	httpservice.RegisterValidation("province", isProvince, "")
+	httpservice.RegisterValidation("benefits", isBenefitList, "")
+	httpservice.RegisterValidation("safe_text", isSafeText, "%s contains invalid characters")
+	httpservice.RegisterAlias("search_query", fmt.Sprintf("notblank,trimmed_min=%d,trimmed_max=%d,safe_text",
+		MinQueryLength, MaxQueryLength))
+}
+
+// isReferenceValue returns a validation reporting whether a value is one of the reference values
+// of kind, as of the current snapshot of the reference catalog
+func isReferenceValue(kind string) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		return reference.Default().Contains(kind, fl.Field().String())
+	}
+}
+
+// isProvince reports whether a value is a province of Costa Rica
+func isProvince(fl validator.FieldLevel) bool {
+	return location.ProvinceOf(fl.Field().String()) != ""
+}
+
+// isBenefitList reports whether a value is a comma-separated list of benefit slugs. Benefits live
+// in the database, so only the format of the slugs is checked.
+func isBenefitList(fl validator.FieldLevel) bool {
+	_, ok := parseBenefits(fl.Field().String())
+	return ok
+}
+
+// isSafeText reports whether a free text parameter has no injection-like patterns
+func isSafeText(fl validator.FieldLevel) bool {
+	return !containsSuspiciousPatterns(strings.TrimSpace(fl.Field().String()))
+}
@@ -5,6 +5,8 @@ package jobtech
 import (
 	"errors"
 	"fmt"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
 )
 
 // NotFoundError represents a job technology association not found error
@@ -21,6 +23,11 @@ func (e NotFoundError) Error() string {
 	return fmt.Sprintf("job technology association for job %d and technology %d not found", e.JobID, e.TechnologyID)
 }
 
+// Is matches httpservice.ErrNotFound
+func (e NotFoundError) Is(target error) bool {
+	return target == httpservice.ErrNotFound
+}
+
 // IsNotFound checks if an error is a job technology not found error
 func IsNotFound(err error) bool {
 	var notFoundErr *NotFoundError
@@ -37,6 +44,11 @@ func (e DuplicateError) Error() string {
 	return fmt.Sprintf("job technology association for job %d and technology %d already exists", e.JobID, e.TechnologyID)
 }
 
+// Is matches httpservice.ErrConflict
+func (e DuplicateError) Is(target error) bool {
+	return target == httpservice.ErrConflict
+}
+
 // IsDuplicate checks if an error is a duplicate job technology error
 func IsDuplicate(err error) bool {
 	var duplicateErr *DuplicateError
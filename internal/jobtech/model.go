@@ -5,11 +5,13 @@ import (
 )
 
 // JobTechnology represents the association between a job and a technology,
-// including additional metadata about the relationship.
+// including additional metadata about the relationship. Primary technologies are the ones
+// the job is built around, e.g. Go for a Go developer.
 type JobTechnology struct {
 	ID           int       `db:"id"`
 	JobID        int       `db:"job_id"`
 	TechnologyID int       `db:"technology_id"`
+	IsPrimary    bool      `db:"is_primary"`
 	IsRequired   bool      `db:"is_required"`
 	CreatedAt    time.Time `db:"created_at"`
 }
@@ -20,5 +22,6 @@ type JobTechnologyWithDetails struct {
 	TechnologyID int    `db:"technology_id"`
 	TechName     string `db:"tech_name"`
 	TechCategory string `db:"tech_category"`
+	IsPrimary    bool   `db:"is_primary"`
 	IsRequired   bool   `db:"is_required"`
 }
@@ -20,11 +20,19 @@ type Database interface {
 // Repository handles database operations for the JobTechnology model.
 type Repository struct {
 	db Database
+	// replica serves the public list queries, which tolerate replication lag
+	replica Database
 }
 
 // NewRepository creates a new Repository instance.
 func NewRepository(db Database) *Repository {
-	return &Repository{db: db}
+	return &Repository{db: db, replica: db}
+}
+
+// NewRepositoryWithReplica creates a new Repository instance sending the public list queries
+// to replica and everything else to db.
+func NewRepositoryWithReplica(db, replica Database) *Repository {
+	return &Repository{db: db, replica: replica}
 }
 
 // Create inserts a new job-technology association into the database.
@@ -34,6 +42,7 @@ func (r *Repository) Create(ctx context.Context, jobTech *JobTechnology) error {
 		createJobTechnologyQuery,
 		jobTech.JobID,
 		jobTech.TechnologyID,
+		jobTech.IsPrimary,
 		jobTech.IsRequired,
 	).Scan(&jobTech.ID, &jobTech.CreatedAt)
 
@@ -59,6 +68,7 @@ func (r *Repository) GetByJobAndTechnology(ctx context.Context, jobID, technolog
 		&jobTech.ID,
 		&jobTech.JobID,
 		&jobTech.TechnologyID,
+		&jobTech.IsPrimary,
 		&jobTech.IsRequired,
 		&jobTech.CreatedAt,
 	)
@@ -81,6 +91,7 @@ func (r *Repository) Update(ctx context.Context, jobTech *JobTechnology) error {
 	commandTag, err := r.db.Exec(
 		ctx,
 		updateJobTechnologyQuery,
+		jobTech.IsPrimary,
 		jobTech.IsRequired,
 		jobTech.ID,
 	)
@@ -133,6 +144,7 @@ func (r *Repository) ListByJob(ctx context.Context, jobID int) ([]*JobTechnology
 			&jobTech.ID,
 			&jobTech.JobID,
 			&jobTech.TechnologyID,
+			&jobTech.IsPrimary,
 			&jobTech.IsRequired,
 			&jobTech.CreatedAt,
 		)
@@ -164,6 +176,7 @@ func (r *Repository) ListByTechnology(ctx context.Context, technologyID int) ([]
 			&jobTech.ID,
 			&jobTech.JobID,
 			&jobTech.TechnologyID,
+			&jobTech.IsPrimary,
 			&jobTech.IsRequired,
 			&jobTech.CreatedAt,
 		)
@@ -197,7 +210,7 @@ func (r *Repository) GetJobTechnologiesBatch(ctx context.Context, jobIDs []int)
 
 	query := fmt.Sprintf(getJobTechnologiesBatchQuery, strings.Join(placeholders, ","))
 
-	rows, err := r.db.Query(ctx, query, args...)
+	rows, err := r.replica.Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get job technologies: %w", err)
 	}
@@ -210,6 +223,7 @@ func (r *Repository) GetJobTechnologiesBatch(ctx context.Context, jobIDs []int)
 		err = rows.Scan(
 			&tech.JobID,
 			&tech.TechnologyID,
+			&tech.IsPrimary,
 			&tech.IsRequired,
 			&tech.TechName,
 			&tech.TechCategory,
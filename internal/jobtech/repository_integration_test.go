@@ -0,0 +1,48 @@
+//go:build integration
+
+package jobtech
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/testdb"
+)
+
+func TestRepository_GetJobTechnologiesBatch_Integration(t *testing.T) {
+	t.Parallel()
+	db := testdb.New(t)
+	ctx := context.Background()
+
+	company := testdb.InsertCompany(t, db, "Tech Corp")
+	backend := testdb.InsertJob(t, db, &testdb.Job{CompanyID: company, Title: "Backend Engineer"})
+	frontend := testdb.InsertJob(t, db, &testdb.Job{CompanyID: company, Title: "Frontend Engineer"})
+	analyst := testdb.InsertJob(t, db, &testdb.Job{CompanyID: company, Title: "Data Analyst"})
+	golang := testdb.InsertTechnology(t, db, "Go", "programming")
+	postgres := testdb.InsertTechnology(t, db, "PostgreSQL", "database")
+	react := testdb.InsertTechnology(t, db, "React", "frontend")
+
+	repo := NewRepository(db)
+	require.NoError(t, repo.Create(ctx, &JobTechnology{JobID: backend, TechnologyID: postgres}))
+	require.NoError(t, repo.Create(ctx,
+		&JobTechnology{JobID: backend, TechnologyID: golang, IsPrimary: true, IsRequired: true}))
+	testdb.AddJobTechnology(t, db, frontend, react, true)
+
+	technologies, err := repo.GetJobTechnologiesBatch(ctx, []int{backend, frontend, analyst})
+	require.NoError(t, err)
+
+	require.Len(t, technologies, 2)
+	require.Len(t, technologies[backend], 2)
+	assert.Equal(t, &JobTechnologyWithDetails{
+		JobID: backend, TechnologyID: golang, TechName: "Go", TechCategory: "programming", IsPrimary: true, IsRequired: true,
+	}, technologies[backend][0])
+	assert.Equal(t, "PostgreSQL", technologies[backend][1].TechName)
+	assert.False(t, technologies[backend][1].IsPrimary)
+	assert.False(t, technologies[backend][1].IsRequired)
+	require.Len(t, technologies[frontend], 1)
+	assert.Equal(t, "React", technologies[frontend][0].TechName)
+	assert.Empty(t, technologies[analyst])
+}
@@ -31,6 +31,7 @@ func TestRepository_Create(t *testing.T) {
 			jobTech: &JobTechnology{
 				JobID:        1,
 				TechnologyID: 2,
+				IsPrimary:    true,
 				IsRequired:   true,
 			},
 			mockSetup: func(mock pgxmock.PgxPoolIface, jobTech *JobTechnology) {
@@ -39,6 +40,7 @@ func TestRepository_Create(t *testing.T) {
 					WithArgs(
 						jobTech.JobID,
 						jobTech.TechnologyID,
+						jobTech.IsPrimary,
 						jobTech.IsRequired,
 					).
 					WillReturnRows(pgxmock.NewRows([]string{"id", "created_at"}).AddRow(1, now))
@@ -67,6 +69,7 @@ func TestRepository_Create(t *testing.T) {
 					WithArgs(
 						jobTech.JobID,
 						jobTech.TechnologyID,
+						jobTech.IsPrimary,
 						jobTech.IsRequired,
 					).
 					WillReturnError(pgErr)
@@ -93,6 +96,7 @@ func TestRepository_Create(t *testing.T) {
 					WithArgs(
 						jobTech.JobID,
 						jobTech.TechnologyID,
+						jobTech.IsPrimary,
 						jobTech.IsRequired,
 					).
 					WillReturnError(dbError)
@@ -144,9 +148,9 @@ func TestRepository_GetByJobAndTechnology(t *testing.T) {
 				mock.ExpectQuery(regexp.QuoteMeta(getJobTechnologyByJobAndTechQuery)).
 					WithArgs(jobID, techID).
 					WillReturnRows(pgxmock.NewRows([]string{
-						"id", "job_id", "technology_id", "is_required", "created_at",
+						"id", "job_id", "technology_id", "is_primary", "is_required", "created_at",
 					}).AddRow(
-						1, jobID, techID, true, now,
+						1, jobID, techID, false, true, now,
 					))
 			},
 			checkResults: func(t *testing.T, result *JobTechnology, err error) {
@@ -239,6 +243,7 @@ func TestRepository_Update(t *testing.T) {
 				t.Helper()
 				mock.ExpectExec(regexp.QuoteMeta(updateJobTechnologyQuery)).
 					WithArgs(
+						jobTech.IsPrimary,
 						jobTech.IsRequired,
 						jobTech.ID,
 					).
@@ -261,6 +266,7 @@ func TestRepository_Update(t *testing.T) {
 				t.Helper()
 				mock.ExpectExec(regexp.QuoteMeta(updateJobTechnologyQuery)).
 					WithArgs(
+						jobTech.IsPrimary,
 						jobTech.IsRequired,
 						jobTech.ID,
 					).
@@ -290,6 +296,7 @@ func TestRepository_Update(t *testing.T) {
 				}
 				mock.ExpectExec(regexp.QuoteMeta(updateJobTechnologyQuery)).
 					WithArgs(
+						jobTech.IsPrimary,
 						jobTech.IsRequired,
 						jobTech.ID,
 					).
@@ -316,6 +323,7 @@ func TestRepository_Update(t *testing.T) {
 				t.Helper()
 				mock.ExpectExec(regexp.QuoteMeta(updateJobTechnologyQuery)).
 					WithArgs(
+						jobTech.IsPrimary,
 						jobTech.IsRequired,
 						jobTech.ID,
 					).
@@ -442,11 +450,11 @@ func TestRepository_ListByJob(t *testing.T) {
 				mock.ExpectQuery(regexp.QuoteMeta(listJobTechnologiesByJobQuery)).
 					WithArgs(jobID).
 					WillReturnRows(pgxmock.NewRows([]string{
-						"id", "job_id", "technology_id", "is_required", "created_at",
+						"id", "job_id", "technology_id", "is_primary", "is_required", "created_at",
 					}).AddRow(
-						1, jobID, 2, true, now,
+						1, jobID, 2, false, true, now,
 					).AddRow(
-						2, jobID, 3, true, now,
+						2, jobID, 3, false, true, now,
 					))
 			},
 			checkResults: func(t *testing.T, results []*JobTechnology, err error) {
@@ -471,7 +479,7 @@ func TestRepository_ListByJob(t *testing.T) {
 				mock.ExpectQuery(regexp.QuoteMeta(listJobTechnologiesByJobQuery)).
 					WithArgs(jobID).
 					WillReturnRows(pgxmock.NewRows([]string{
-						"id", "job_id", "technology_id", "is_required", "created_at",
+						"id", "job_id", "technology_id", "is_primary", "is_required", "created_at",
 					}))
 			},
 			checkResults: func(t *testing.T, results []*JobTechnology, err error) {
@@ -556,11 +564,11 @@ func TestRepository_ListByTechnology(t *testing.T) {
 				mock.ExpectQuery(regexp.QuoteMeta(listJobTechnologiesByTechnologyQuery)).
 					WithArgs(techID).
 					WillReturnRows(pgxmock.NewRows([]string{
-						"id", "job_id", "technology_id", "is_required", "created_at",
+						"id", "job_id", "technology_id", "is_primary", "is_required", "created_at",
 					}).AddRow(
-						1, 1, techID, true, now,
+						1, 1, techID, false, true, now,
 					).AddRow(
-						3, 2, techID, true, now,
+						3, 2, techID, false, true, now,
 					))
 			},
 			checkResults: func(t *testing.T, results []*JobTechnology, err error) {
@@ -585,7 +593,7 @@ func TestRepository_ListByTechnology(t *testing.T) {
 				mock.ExpectQuery(regexp.QuoteMeta(listJobTechnologiesByTechnologyQuery)).
 					WithArgs(techID).
 					WillReturnRows(pgxmock.NewRows([]string{
-						"id", "job_id", "technology_id", "is_required", "created_at",
+						"id", "job_id", "technology_id", "is_primary", "is_required", "created_at",
 					}))
 			},
 			checkResults: func(t *testing.T, results []*JobTechnology, err error) {
@@ -670,15 +678,15 @@ func TestRepository_GetJobTechnologiesBatch(t *testing.T) {
 				mock.ExpectQuery(regexp.QuoteMeta(expectedQuery)).
 					WithArgs(1, 2).
 					WillReturnRows(pgxmock.NewRows([]string{
-						"job_id", "technology_id", "is_required", "tech_name", "tech_category",
+						"job_id", "technology_id", "is_primary", "is_required", "tech_name", "tech_category",
 					}).AddRow(
-						1, 10, true, "Go", "Programming Language",
+						1, 10, true, true, "Go", "Programming Language",
 					).AddRow(
-						1, 11, false, "PostgreSQL", "Database",
+						1, 11, false, false, "PostgreSQL", "Database",
 					).AddRow(
-						2, 10, true, "Go", "Programming Language",
+						2, 10, true, true, "Go", "Programming Language",
 					).AddRow(
-						2, 12, true, "React", "Framework",
+						2, 12, false, true, "React", "Framework",
 					))
 			},
 			checkResults: func(t *testing.T, results map[int][]*JobTechnologyWithDetails, err error) {
@@ -693,12 +701,14 @@ func TestRepository_GetJobTechnologiesBatch(t *testing.T) {
 				assert.Equal(t, 10, job1Techs[0].TechnologyID)
 				assert.Equal(t, "Go", job1Techs[0].TechName)
 				assert.Equal(t, "Programming Language", job1Techs[0].TechCategory)
+				assert.True(t, job1Techs[0].IsPrimary)
 				assert.True(t, job1Techs[0].IsRequired)
 
 				assert.Equal(t, 1, job1Techs[1].JobID)
 				assert.Equal(t, 11, job1Techs[1].TechnologyID)
 				assert.Equal(t, "PostgreSQL", job1Techs[1].TechName)
 				assert.Equal(t, "Database", job1Techs[1].TechCategory)
+				assert.False(t, job1Techs[1].IsPrimary)
 				assert.False(t, job1Techs[1].IsRequired)
 
 				// Check job 2 technologies
@@ -726,9 +736,9 @@ func TestRepository_GetJobTechnologiesBatch(t *testing.T) {
 				mock.ExpectQuery(regexp.QuoteMeta(expectedQuery)).
 					WithArgs(1).
 					WillReturnRows(pgxmock.NewRows([]string{
-						"job_id", "technology_id", "is_required", "tech_name", "tech_category",
+						"job_id", "technology_id", "is_primary", "is_required", "tech_name", "tech_category",
 					}).AddRow(
-						1, 10, true, "Go", "Programming Language",
+						1, 10, true, true, "Go", "Programming Language",
 					))
 			},
 			checkResults: func(t *testing.T, results map[int][]*JobTechnologyWithDetails, err error) {
@@ -767,7 +777,7 @@ func TestRepository_GetJobTechnologiesBatch(t *testing.T) {
 				mock.ExpectQuery(regexp.QuoteMeta(expectedQuery)).
 					WithArgs(999, 888).
 					WillReturnRows(pgxmock.NewRows([]string{
-						"job_id", "technology_id", "is_required", "tech_name", "tech_category",
+						"job_id", "technology_id", "is_primary", "is_required", "tech_name", "tech_category",
 					}))
 			},
 			checkResults: func(t *testing.T, results map[int][]*JobTechnologyWithDetails, err error) {
@@ -823,11 +833,11 @@ func TestRepository_GetJobTechnologiesBatch(t *testing.T) {
 				mock.ExpectQuery(regexp.QuoteMeta(expectedQuery)).
 					WithArgs(1, 2, 3).
 					WillReturnRows(pgxmock.NewRows([]string{
-						"job_id", "technology_id", "is_required", "tech_name", "tech_category",
+						"job_id", "technology_id", "is_primary", "is_required", "tech_name", "tech_category",
 					}).AddRow(
-						1, 10, true, "Go", "Programming Language",
+						1, 10, true, true, "Go", "Programming Language",
 					).AddRow(
-						3, 12, false, "React", "Framework",
+						3, 12, false, false, "React", "Framework",
 					))
 			},
 			checkResults: func(t *testing.T, results map[int][]*JobTechnologyWithDetails, err error) {
@@ -0,0 +1,68 @@
+package linkcheck
+
+import (
+	"time"
+)
+
+// Data Transfer Objects (DTOs) for the link check API layer.
+
+// ListRequest represents the query parameters to list link checks
+type ListRequest struct {
+	Kind    string `form:"kind" binding:"omitempty,oneof=application_url logo_url" example:"application_url"`
+	Failing bool   `form:"failing" example:"true"`
+	Limit   int    `form:"limit" binding:"omitempty,min=1,max=500" example:"50"`
+}
+
+// ToListParams converts a ListRequest to ListParams
+func (req *ListRequest) ToListParams() ListParams {
+	return ListParams{Kind: Kind(req.Kind), FailingOnly: req.Failing, Limit: req.Limit}
+}
+
+// CheckResponse represents the last check of a link in API responses
+type CheckResponse struct {
+	Kind string `json:"kind" example:"application_url"`
+	// JobID is set for application URLs
+	JobID       *int   `json:"job_id,omitempty" example:"42"`
+	JobTitle    string `json:"job_title,omitempty" example:"Senior Go Developer"`
+	CompanyID   *int   `json:"company_id,omitempty" example:"3"`
+	CompanyName string `json:"company_name" example:"Tech Corp"`
+	URL         string `json:"url" example:"https://techcorp.com/jobs/42"`
+	OK          bool   `json:"ok" example:"false"`
+	StatusCode  int    `json:"status_code,omitempty" example:"404"`
+	ContentType string `json:"content_type,omitempty" example:"text/html"`
+	Error       string `json:"error,omitempty" example:"responded with status 404"`
+	// NotFoundCount is the number of consecutive checks that answered 404 or 410
+	NotFoundCount int       `json:"not_found_count" example:"2"`
+	CheckedAt     time.Time `json:"checked_at" example:"2024-01-15T06:00:00Z"`
+}
+
+// CheckListResponse represents the list of link checks
+type CheckListResponse struct {
+	Data []*CheckResponse `json:"data"`
+}
+
+// MapChecksToResponse converts link checks to a CheckListResponse
+func MapChecksToResponse(checks []*Check) *CheckListResponse {
+	data := make([]*CheckResponse, len(checks))
+	for i, check := range checks {
+		data[i] = &CheckResponse{
+			Kind:          string(check.Kind),
+			JobTitle:      check.JobTitle,
+			CompanyName:   check.CompanyName,
+			URL:           check.URL,
+			OK:            check.OK,
+			StatusCode:    check.StatusCode,
+			ContentType:   check.ContentType,
+			Error:         check.Error,
+			NotFoundCount: check.NotFoundCount,
+			CheckedAt:     check.CheckedAt,
+		}
+		targetID := check.TargetID
+		if check.Kind == KindApplicationURL {
+			data[i].JobID = &targetID
+		} else {
+			data[i].CompanyID = &targetID
+		}
+	}
+	return &CheckListResponse{Data: data}
+}
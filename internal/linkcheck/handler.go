@@ -0,0 +1,60 @@
+package linkcheck
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// Constants for link check routes and endpoints
+const (
+	LinkChecksRoute = "/link-checks"
+)
+
+// Handler handles HTTP requests for the link checks
+type Handler struct {
+	service *LinkCheckService
+}
+
+// NewHandler creates a new link check handler
+func NewHandler(service *LinkCheckService) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterAdminRoutes registers the link check admin routes with the given (protected) router group
+func (h *Handler) RegisterAdminRoutes(rg *gin.RouterGroup) {
+	rg.GET(LinkChecksRoute, h.ListChecks)
+}
+
+// ListChecks godoc
+// @Summary List link checks
+// @Description Lists the last check of the job application links and company logos, most recent first.
+// @Description Application links answering 404 or 410 on consecutive checks get their job deactivated.
+// @Tags admin
+// @Produce json
+// @Security AdminAPIKey
+// @Param kind query string false "Link kind" Enums(application_url, logo_url)
+// @Param failing query bool false "Only the links that failed their last check"
+// @Param limit query int false "Number of checks to return (max 500)" default(50) example(50)
+// @Success 200 {object} CheckListResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/link-checks [get]
+func (h *Handler) ListChecks(c *gin.Context) {
+	var req ListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	checks, err := h.service.List(c.Request.Context(), req.ToListParams())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapChecksToResponse(checks))
+}
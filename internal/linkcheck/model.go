@@ -0,0 +1,68 @@
+// Package linkcheck validates the links shown on the board in the background: the application URL
+// of every active job and the logo URL of every active company. The result of the last check is
+// stored per job and company, and jobs whose application link keeps answering 404 are deactivated.
+package linkcheck
+
+import (
+	"net/http"
+	"time"
+)
+
+// Kind is the kind of link checked
+type Kind string
+
+// Link kinds
+const (
+	// KindApplicationURL is the application URL of a job
+	KindApplicationURL Kind = "application_url"
+	// KindLogoURL is the logo URL of a company
+	KindLogoURL Kind = "logo_url"
+)
+
+// IsValid reports whether k is a known link kind
+func (k Kind) IsValid() bool {
+	return k == KindApplicationURL || k == KindLogoURL
+}
+
+// Target represents a link due for a check
+type Target struct {
+	Kind Kind `db:"kind"`
+	// TargetID is the job ID of application URLs and the company ID of logo URLs
+	TargetID int    `db:"target_id"`
+	URL      string `db:"url"`
+}
+
+// Result represents the outcome of a link check
+type Result struct {
+	Target
+	OK bool `db:"ok"`
+	// StatusCode is zero when the request failed
+	StatusCode  int       `db:"status_code"`
+	ContentType string    `db:"content_type"`
+	Error       string    `db:"error"`
+	CheckedAt   time.Time `db:"checked_at"`
+}
+
+// IsNotFound reports whether the link answered that its page doesn't exist (anymore)
+func (r *Result) IsNotFound() bool {
+	return r.StatusCode == http.StatusNotFound || r.StatusCode == http.StatusGone
+}
+
+// Check represents the stored result of the last check of a link (for read operations only)
+type Check struct {
+	Result
+	// JobTitle is empty for logo URLs
+	JobTitle    string `db:"job_title"`
+	CompanyName string `db:"company_name"`
+	// NotFoundCount is the number of consecutive checks of the URL that answered 404 or 410
+	NotFoundCount int `db:"not_found_count"`
+}
+
+// ListParams defines the parameters to list link checks (repository layer)
+type ListParams struct {
+	// Kind restricts the list to a link kind, all kinds when empty
+	Kind Kind
+	// FailingOnly restricts the list to the links that failed their last check
+	FailingOnly bool
+	Limit       int
+}
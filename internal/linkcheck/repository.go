@@ -0,0 +1,163 @@
+package linkcheck
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// SQL query constants
+const (
+	// Links of active and pending jobs and of active companies never checked, checked before $1
+	// or changed since their last check, the ones never checked first
+	listDueQuery = `
+        SELECT kind, target_id, url
+        FROM (
+            SELECT 'application_url' AS kind, j.id AS target_id, j.application_url AS url, lc.checked_at
+            FROM jobs j
+            LEFT JOIN link_checks lc ON lc.kind = 'application_url' AND lc.target_id = j.id
+            WHERE (j.is_active OR j.status = 'pending')
+              AND (lc.checked_at IS NULL OR lc.checked_at < $1 OR lc.url <> j.application_url)
+            UNION ALL
+            SELECT 'logo_url', c.id, c.logo_url, lc.checked_at
+            FROM companies c
+            LEFT JOIN link_checks lc ON lc.kind = 'logo_url' AND lc.target_id = c.id
+            WHERE c.is_active
+              AND (lc.checked_at IS NULL OR lc.checked_at < $1 OR lc.url <> c.logo_url)
+        ) due
+        ORDER BY checked_at NULLS FIRST, kind, target_id
+        LIMIT $2
+    `
+
+	// The not found count only keeps growing while the same URL answers 404 or 410
+	saveResultQuery = `
+        INSERT INTO link_checks (kind, target_id, url, ok, status_code, content_type, error,
+                                 not_found_count, checked_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, CASE WHEN $8 THEN 1 ELSE 0 END, $9)
+        ON CONFLICT (kind, target_id) DO UPDATE
+        SET url = EXCLUDED.url, ok = EXCLUDED.ok, status_code = EXCLUDED.status_code,
+            content_type = EXCLUDED.content_type, error = EXCLUDED.error, checked_at = EXCLUDED.checked_at,
+            not_found_count = CASE
+                WHEN NOT $8 THEN 0
+                WHEN link_checks.url = EXCLUDED.url THEN link_checks.not_found_count + 1
+                ELSE 1
+            END
+        RETURNING not_found_count
+    `
+
+	listChecksQuery = `
+        SELECT lc.kind, lc.target_id, lc.url, lc.ok, lc.status_code, lc.content_type, lc.error,
+               lc.checked_at, COALESCE(j.title, '') AS job_title, c.name, lc.not_found_count
+        FROM link_checks lc
+        LEFT JOIN jobs j ON lc.kind = 'application_url' AND j.id = lc.target_id
+        JOIN companies c ON c.id = CASE WHEN lc.kind = 'application_url' THEN j.company_id ELSE lc.target_id END
+        WHERE ($1 = '' OR lc.kind = $1) AND (NOT $2 OR NOT lc.ok)
+        ORDER BY lc.checked_at DESC, lc.id DESC
+        LIMIT $3
+    `
+)
+
+// Database interface to support pgxpool and mocks
+type Database interface {
+	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
+	Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+}
+
+// Repository handles database operations for link checks.
+type Repository struct {
+	db Database
+}
+
+// NewRepository creates a new Repository instance.
+func NewRepository(db Database) *Repository {
+	return &Repository{db: db}
+}
+
+// ListDue retrieves up to limit links never checked, last checked before checkedBefore or
+// changed since their last check.
+func (r *Repository) ListDue(ctx context.Context, checkedBefore time.Time, limit int) ([]*Target, error) {
+	rows, err := r.db.Query(ctx, listDueQuery, checkedBefore, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due links: %w", err)
+	}
+	defer rows.Close()
+
+	var targets []*Target
+	for rows.Next() {
+		target := &Target{}
+		if err = rows.Scan(&target.Kind, &target.TargetID, &target.URL); err != nil {
+			return nil, fmt.Errorf("failed to scan due link row: %w", err)
+		}
+		targets = append(targets, target)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating due link rows: %w", err)
+	}
+
+	return targets, nil
+}
+
+// SaveResult stores the result of the last check of a link and returns the number of
+// consecutive checks of its URL that answered 404 or 410.
+func (r *Repository) SaveResult(ctx context.Context, result *Result) (int, error) {
+	var notFoundCount int
+	err := r.db.QueryRow(
+		ctx,
+		saveResultQuery,
+		result.Kind,
+		result.TargetID,
+		result.URL,
+		result.OK,
+		result.StatusCode,
+		result.ContentType,
+		result.Error,
+		result.IsNotFound(),
+		result.CheckedAt,
+	).Scan(&notFoundCount)
+	if err != nil {
+		return 0, fmt.Errorf("failed to save link check: %w", err)
+	}
+	return notFoundCount, nil
+}
+
+// List retrieves the stored link checks matching params, most recent first.
+func (r *Repository) List(ctx context.Context, params *ListParams) ([]*Check, error) {
+	rows, err := r.db.Query(ctx, listChecksQuery, params.Kind, params.FailingOnly, params.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list link checks: %w", err)
+	}
+	defer rows.Close()
+
+	var checks []*Check
+	for rows.Next() {
+		check := &Check{}
+		err = rows.Scan(
+			&check.Kind,
+			&check.TargetID,
+			&check.URL,
+			&check.OK,
+			&check.StatusCode,
+			&check.ContentType,
+			&check.Error,
+			&check.CheckedAt,
+			&check.JobTitle,
+			&check.CompanyName,
+			&check.NotFoundCount,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan link check row: %w", err)
+		}
+		checks = append(checks, check)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating link check rows: %w", err)
+	}
+
+	return checks, nil
+}
@@ -0,0 +1,170 @@
+package linkcheck
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_ListDue(t *testing.T) {
+	t.Parallel()
+	checkedBefore := time.Date(2024, 1, 14, 6, 0, 0, 0, time.UTC)
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, targets []*Target, err error)
+	}{
+		{
+			name: "due links listed",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(listDueQuery)).
+					WithArgs(checkedBefore, 50).
+					WillReturnRows(pgxmock.NewRows([]string{"kind", "target_id", "url"}).
+						AddRow(KindApplicationURL, 42, "https://techcorp.com/jobs/42").
+						AddRow(KindLogoURL, 3, "https://techcorp.com/logo.png"))
+			},
+			checkResults: func(t *testing.T, targets []*Target, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, targets, 2)
+				assert.Equal(t, Target{Kind: KindApplicationURL, TargetID: 42, URL: "https://techcorp.com/jobs/42"},
+					*targets[0])
+				assert.Equal(t, KindLogoURL, targets[1].Kind)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(listDueQuery)).
+					WithArgs(checkedBefore, 50).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ []*Target, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			targets, err := repo.ListDue(context.Background(), checkedBefore, 50)
+			tt.checkResults(t, targets, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_SaveResult(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	dbError := errors.New("database error")
+	result := &Result{
+		Target:      Target{Kind: KindApplicationURL, TargetID: 42, URL: "https://techcorp.com/jobs/42"},
+		StatusCode:  404,
+		ContentType: "text/html",
+		Error:       "responded with status 404",
+		CheckedAt:   now,
+	}
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, notFoundCount int, err error)
+	}{
+		{
+			name: "result saved",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(saveResultQuery)).
+					WithArgs(KindApplicationURL, 42, "https://techcorp.com/jobs/42", false, 404, "text/html",
+						"responded with status 404", true, now).
+					WillReturnRows(pgxmock.NewRows([]string{"not_found_count"}).AddRow(2))
+			},
+			checkResults: func(t *testing.T, notFoundCount int, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 2, notFoundCount)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(saveResultQuery)).
+					WithArgs(KindApplicationURL, 42, "https://techcorp.com/jobs/42", false, 404, "text/html",
+						"responded with status 404", true, now).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ int, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			notFoundCount, err := repo.SaveResult(context.Background(), result)
+			tt.checkResults(t, notFoundCount, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_List(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	columns := []string{
+		"kind", "target_id", "url", "ok", "status_code", "content_type", "error",
+		"checked_at", "job_title", "name", "not_found_count",
+	}
+
+	mockDB, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	mockDB.ExpectQuery(regexp.QuoteMeta(listChecksQuery)).
+		WithArgs(KindLogoURL, true, 50).
+		WillReturnRows(pgxmock.NewRows(columns).
+			AddRow(KindLogoURL, 3, "https://techcorp.com/logo.png", false, 200, "text/html",
+				`unexpected content type "text/html"`, now, "", "Tech Corp", 0))
+
+	checks, err := NewRepository(mockDB).List(context.Background(),
+		&ListParams{Kind: KindLogoURL, FailingOnly: true, Limit: 50})
+	require.NoError(t, err)
+	require.Len(t, checks, 1)
+	assert.Equal(t, "Tech Corp", checks[0].CompanyName)
+	assert.Empty(t, checks[0].JobTitle)
+	assert.False(t, checks[0].OK)
+
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
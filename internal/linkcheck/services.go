@@ -0,0 +1,46 @@
+package linkcheck
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// Link check listing settings
+const (
+	DefaultListLimit = 50
+	MaxListLimit     = 500
+)
+
+// DataRepository interface to read the stored link checks.
+type DataRepository interface {
+	List(ctx context.Context, params *ListParams) ([]*Check, error)
+}
+
+// LinkCheckService holds the business logic to review the link checks.
+type LinkCheckService struct {
+	repo DataRepository
+}
+
+// NewLinkCheckService creates a new instance of LinkCheckService
+func NewLinkCheckService(repo DataRepository) *LinkCheckService {
+	return &LinkCheckService{repo: repo}
+}
+
+// List returns the last checks of the links matching params, most recent first.
+// Out of range limits are clamped.
+func (s *LinkCheckService) List(ctx context.Context, params ListParams) ([]*Check, error) {
+	if params.Kind != "" && !params.Kind.IsValid() {
+		return nil, &httpservice.ValidationError{Errors: []string{
+			fmt.Sprintf("kind must be %s or %s", KindApplicationURL, KindLogoURL),
+		}}
+	}
+
+	if params.Limit <= 0 {
+		params.Limit = DefaultListLimit
+	}
+	params.Limit = min(params.Limit, MaxListLimit)
+
+	return s.repo.List(ctx, &params)
+}
@@ -0,0 +1,34 @@
+package linkcheck
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+func TestLinkCheckService_List(t *testing.T) {
+	t.Parallel()
+
+	mockRepo := NewMockDataRepository(t)
+	service := NewLinkCheckService(mockRepo)
+
+	var validationErr *httpservice.ValidationError
+	_, err := service.List(context.Background(), ListParams{Kind: "website"})
+	require.ErrorAs(t, err, &validationErr)
+
+	checks := []*Check{{CompanyName: "Tech Corp"}}
+	mockRepo.EXPECT().List(context.Background(), &ListParams{FailingOnly: true, Limit: DefaultListLimit}).
+		Return(checks, nil).Once()
+	result, err := service.List(context.Background(), ListParams{FailingOnly: true})
+	require.NoError(t, err)
+	assert.Equal(t, checks, result)
+
+	mockRepo.EXPECT().List(context.Background(), &ListParams{Kind: KindApplicationURL, Limit: MaxListLimit}).
+		Return(nil, nil).Once()
+	_, err = service.List(context.Background(), ListParams{Kind: KindApplicationURL, Limit: 10000})
+	require.NoError(t, err)
+}
@@ -0,0 +1,250 @@
+package linkcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	neturl "net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+)
+
+// Defaults for the link check worker
+const (
+	DefaultPollInterval      = time.Minute
+	DefaultRecheckInterval   = 24 * time.Hour
+	DefaultBatchSize         = 50
+	DefaultConcurrency       = 8
+	DefaultTimeout           = 10 * time.Second
+	DefaultNotFoundThreshold = 3
+
+	// UserAgent identifies the link checker to the checked sites
+	UserAgent = "TicosInTechLinkChecker/1.0 (+https://ticosintech.com)"
+
+	// maxResponseBytes bounds how much of a GET response body is read before closing it
+	maxResponseBytes = 64 << 10
+)
+
+// Store interface to get the links due for a check and store the results.
+type Store interface {
+	ListDue(ctx context.Context, checkedBefore time.Time, limit int) ([]*Target, error)
+	SaveResult(ctx context.Context, result *Result) (int, error)
+}
+
+// JobDeactivator interface to deactivate the jobs whose application link is gone.
+type JobDeactivator interface {
+	Deactivate(ctx context.Context, id int) error
+}
+
+// WorkerConfig configures how often links are checked
+type WorkerConfig struct {
+	// PollInterval is the time between checks for due links
+	PollInterval time.Duration
+	// RecheckInterval is the time after which a checked link is checked again
+	RecheckInterval time.Duration
+	// BatchSize is the number of links checked every poll
+	BatchSize int
+	// Concurrency is the number of links checked at the same time
+	Concurrency int
+	// Timeout bounds each request to a checked link
+	Timeout time.Duration
+	// NotFoundThreshold is the number of consecutive checks answering 404 or 410 after which
+	// the job of an application link is deactivated
+	NotFoundThreshold int
+	// OnError is called when due links can't be listed or a result can't be stored. Optional.
+	OnError func(err error)
+	// OnDeactivate is called when a job is deactivated because its application link is gone. Optional.
+	OnDeactivate func(jobID int, url string)
+}
+
+// DefaultWorkerConfig returns the default worker configuration
+func DefaultWorkerConfig() WorkerConfig {
+	return WorkerConfig{
+		PollInterval:      DefaultPollInterval,
+		RecheckInterval:   DefaultRecheckInterval,
+		BatchSize:         DefaultBatchSize,
+		Concurrency:       DefaultConcurrency,
+		Timeout:           DefaultTimeout,
+		NotFoundThreshold: DefaultNotFoundThreshold,
+	}
+}
+
+// Worker checks the application links of jobs and the logos of companies in the background.
+// New links are checked first, so links are validated shortly after they are ingested.
+type Worker struct {
+	store       Store
+	deactivator JobDeactivator
+	client      *http.Client
+	cfg         WorkerConfig
+	now         func() time.Time
+}
+
+// NewWorker creates a new instance of Worker. Run must be started for links to be checked.
+// deactivator is optional, jobs are never deactivated without it.
+func NewWorker(store Store, deactivator JobDeactivator, cfg WorkerConfig) *Worker {
+	defaults := DefaultWorkerConfig()
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = defaults.PollInterval
+	}
+	if cfg.RecheckInterval <= 0 {
+		cfg.RecheckInterval = defaults.RecheckInterval
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaults.BatchSize
+	}
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = defaults.Concurrency
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaults.Timeout
+	}
+	if cfg.NotFoundThreshold <= 0 {
+		cfg.NotFoundThreshold = defaults.NotFoundThreshold
+	}
+
+	return &Worker{
+		store:       store,
+		deactivator: deactivator,
+		client:      &http.Client{Timeout: cfg.Timeout},
+		cfg:         cfg,
+		now:         time.Now,
+	}
+}
+
+// Run checks the due links every poll interval until ctx is canceled.
+func (w *Worker) Run(ctx context.Context) error {
+	ticker := time.NewTicker(w.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if _, err := w.ProcessDue(ctx); err != nil && ctx.Err() == nil && w.cfg.OnError != nil {
+				w.cfg.OnError(err)
+			}
+		}
+	}
+}
+
+// ProcessDue checks a batch of due links, stores the results and deactivates the jobs whose
+// application link is gone. It returns the number of links checked.
+func (w *Worker) ProcessDue(ctx context.Context) (int, error) {
+	targets, err := w.store.ListDue(ctx, w.now().Add(-w.cfg.RecheckInterval), w.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	g, gCtx := errgroup.WithContext(ctx)
+	g.SetLimit(w.cfg.Concurrency)
+	for _, target := range targets {
+		g.Go(func() error {
+			return w.process(gCtx, target)
+		})
+	}
+	if err = g.Wait(); err != nil {
+		return 0, err
+	}
+
+	return len(targets), nil
+}
+
+// process checks a link and stores the result. Only a failure to store it is returned.
+func (w *Worker) process(ctx context.Context, target *Target) error {
+	result := w.check(ctx, target)
+	if ctx.Err() != nil {
+		// Shutting down, the link is checked again on the next run
+		return nil
+	}
+
+	notFoundCount, err := w.store.SaveResult(ctx, result)
+	if err != nil {
+		return err
+	}
+
+	if target.Kind != KindApplicationURL || w.deactivator == nil || notFoundCount < w.cfg.NotFoundThreshold {
+		return nil
+	}
+
+	err = w.deactivator.Deactivate(ctx, target.TargetID)
+	switch {
+	case err == nil:
+		if w.cfg.OnDeactivate != nil {
+			w.cfg.OnDeactivate(target.TargetID, target.URL)
+		}
+		return nil
+	case jobs.IsNotActive(err) || jobs.IsNotFound(err):
+		return nil
+	default:
+		return fmt.Errorf("failed to deactivate job %d: %w", target.TargetID, err)
+	}
+}
+
+// check requests a link and returns the result. Application links must answer with a 2xx
+// status, logo links with a 2xx status and an image content type.
+func (w *Worker) check(ctx context.Context, target *Target) *Result {
+	result := &Result{Target: *target, CheckedAt: w.now()}
+
+	resp, err := w.request(ctx, http.MethodHead, target.URL)
+	if err == nil && isHeadUnsupported(resp.StatusCode) {
+		// Some servers refuse HEAD requests, ask again with GET
+		resp.Body.Close()
+		resp, err = w.request(ctx, http.MethodGet, target.URL)
+	}
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	defer resp.Body.Close()
+
+	// Drain part of the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBytes))
+
+	result.StatusCode = resp.StatusCode
+	result.ContentType, _, _ = mime.ParseMediaType(resp.Header.Get("Content-Type"))
+
+	switch {
+	case resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices:
+		result.Error = fmt.Sprintf("responded with status %d", resp.StatusCode)
+	case target.Kind == KindLogoURL && !strings.HasPrefix(result.ContentType, "image/"):
+		result.Error = fmt.Sprintf("unexpected content type %q", result.ContentType)
+	default:
+		result.OK = true
+	}
+
+	return result
+}
+
+// request sends a method request to url, following redirects
+func (w *Worker) request(ctx context.Context, method, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("invalid url: %w", err)
+	}
+	req.Header.Set("User-Agent", UserAgent)
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		// Keep the error short, the URL is stored next to it
+		var urlErr *neturl.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	return resp, nil
+}
+
+// isHeadUnsupported reports whether a HEAD request status means the server doesn't answer HEAD requests
+func isHeadUnsupported(statusCode int) bool {
+	return statusCode == http.StatusMethodNotAllowed || statusCode == http.StatusNotImplemented ||
+		statusCode == http.StatusForbidden
+}
@@ -0,0 +1,215 @@
+package linkcheck
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+)
+
+// newTestSite serves the links checked by the worker tests
+func newTestSite(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/jobs/open", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	})
+	mux.HandleFunc("/jobs/no-head", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html")
+	})
+	mux.HandleFunc("/logo.png", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+	})
+	mux.HandleFunc("/logo-page", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestWorker_ProcessDue(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 1, 15, 6, 0, 0, 0, time.UTC)
+	saveError := errors.New("save error")
+
+	tests := []struct {
+		name         string
+		target       Target
+		mockSetup    func(mockStore *MockStore, mockDeactivator *MockJobDeactivator)
+		checkResults func(t *testing.T, checked int, err error)
+	}{
+		{
+			name:   "open application link",
+			target: Target{Kind: KindApplicationURL, TargetID: 42, URL: "/jobs/open"},
+			mockSetup: func(mockStore *MockStore, _ *MockJobDeactivator) {
+				t.Helper()
+				mockStore.EXPECT().SaveResult(mock.Anything, mock.MatchedBy(func(r *Result) bool {
+					return r.OK && r.StatusCode == http.StatusOK && r.ContentType == "text/html" &&
+						r.CheckedAt.Equal(now)
+				})).Return(0, nil).Once()
+			},
+			checkResults: func(t *testing.T, checked int, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 1, checked)
+			},
+		},
+		{
+			name:   "server refusing HEAD asked with GET",
+			target: Target{Kind: KindApplicationURL, TargetID: 42, URL: "/jobs/no-head"},
+			mockSetup: func(mockStore *MockStore, _ *MockJobDeactivator) {
+				t.Helper()
+				mockStore.EXPECT().SaveResult(mock.Anything, mock.MatchedBy(func(r *Result) bool {
+					return r.OK && r.StatusCode == http.StatusOK
+				})).Return(0, nil).Once()
+			},
+			checkResults: func(t *testing.T, _ int, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:   "missing application link below the threshold",
+			target: Target{Kind: KindApplicationURL, TargetID: 42, URL: "/jobs/closed"},
+			mockSetup: func(mockStore *MockStore, _ *MockJobDeactivator) {
+				t.Helper()
+				mockStore.EXPECT().SaveResult(mock.Anything, mock.MatchedBy(func(r *Result) bool {
+					return !r.OK && r.IsNotFound() && r.Error == "responded with status 404"
+				})).Return(2, nil).Once()
+			},
+			checkResults: func(t *testing.T, _ int, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:   "missing application link deactivates the job",
+			target: Target{Kind: KindApplicationURL, TargetID: 42, URL: "/jobs/closed"},
+			mockSetup: func(mockStore *MockStore, mockDeactivator *MockJobDeactivator) {
+				t.Helper()
+				mockStore.EXPECT().SaveResult(mock.Anything, mock.Anything).Return(3, nil).Once()
+				mockDeactivator.EXPECT().Deactivate(mock.Anything, 42).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, _ int, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:   "job already inactive",
+			target: Target{Kind: KindApplicationURL, TargetID: 42, URL: "/jobs/closed"},
+			mockSetup: func(mockStore *MockStore, mockDeactivator *MockJobDeactivator) {
+				t.Helper()
+				mockStore.EXPECT().SaveResult(mock.Anything, mock.Anything).Return(4, nil).Once()
+				mockDeactivator.EXPECT().Deactivate(mock.Anything, 42).Return(&jobs.NotActiveError{ID: 42}).Once()
+			},
+			checkResults: func(t *testing.T, _ int, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:   "logo served as an image",
+			target: Target{Kind: KindLogoURL, TargetID: 3, URL: "/logo.png"},
+			mockSetup: func(mockStore *MockStore, _ *MockJobDeactivator) {
+				t.Helper()
+				mockStore.EXPECT().SaveResult(mock.Anything, mock.MatchedBy(func(r *Result) bool {
+					return r.OK && r.ContentType == "image/png"
+				})).Return(0, nil).Once()
+			},
+			checkResults: func(t *testing.T, _ int, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:   "logo link serving a page",
+			target: Target{Kind: KindLogoURL, TargetID: 3, URL: "/logo-page"},
+			mockSetup: func(mockStore *MockStore, _ *MockJobDeactivator) {
+				t.Helper()
+				mockStore.EXPECT().SaveResult(mock.Anything, mock.MatchedBy(func(r *Result) bool {
+					return !r.OK && r.StatusCode == http.StatusOK && r.Error == `unexpected content type "text/html"`
+				})).Return(0, nil).Once()
+			},
+			checkResults: func(t *testing.T, _ int, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:   "missing logo never deactivates jobs",
+			target: Target{Kind: KindLogoURL, TargetID: 3, URL: "/missing.png"},
+			mockSetup: func(mockStore *MockStore, _ *MockJobDeactivator) {
+				t.Helper()
+				mockStore.EXPECT().SaveResult(mock.Anything, mock.Anything).Return(5, nil).Once()
+			},
+			checkResults: func(t *testing.T, _ int, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:   "unreachable link",
+			target: Target{Kind: KindApplicationURL, TargetID: 42, URL: "http://127.0.0.1:1/jobs/42"},
+			mockSetup: func(mockStore *MockStore, _ *MockJobDeactivator) {
+				t.Helper()
+				mockStore.EXPECT().SaveResult(mock.Anything, mock.MatchedBy(func(r *Result) bool {
+					return !r.OK && r.StatusCode == 0 && r.Error != ""
+				})).Return(0, nil).Once()
+			},
+			checkResults: func(t *testing.T, _ int, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:   "result not saved",
+			target: Target{Kind: KindApplicationURL, TargetID: 42, URL: "/jobs/open"},
+			mockSetup: func(mockStore *MockStore, _ *MockJobDeactivator) {
+				t.Helper()
+				mockStore.EXPECT().SaveResult(mock.Anything, mock.Anything).Return(0, saveError).Once()
+			},
+			checkResults: func(t *testing.T, _ int, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, saveError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			site := newTestSite(t)
+			mockStore := NewMockStore(t)
+			mockDeactivator := NewMockJobDeactivator(t)
+
+			target := tt.target
+			if target.URL[0] == '/' {
+				target.URL = site.URL + target.URL
+			}
+			mockStore.EXPECT().ListDue(context.Background(), now.Add(-DefaultRecheckInterval), DefaultBatchSize).
+				Return([]*Target{&target}, nil).Once()
+			tt.mockSetup(mockStore, mockDeactivator)
+
+			worker := NewWorker(mockStore, mockDeactivator, DefaultWorkerConfig())
+			worker.now = func() time.Time { return now }
+
+			checked, err := worker.ProcessDue(context.Background())
+			tt.checkResults(t, checked, err)
+		})
+	}
+}
@@ -0,0 +1,30 @@
+// Package location normalizes the free-text locations of job postings into places of the
+// locations table: a region searched by the job board, a country, a province and a city.
+package location
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// UnknownError is returned for a location that doesn't name a known place
+type UnknownError struct {
+	Value string
+}
+
+func (e UnknownError) Error() string {
+	return fmt.Sprintf("unknown location %q", e.Value)
+}
+
+// Is matches httpservice.ErrInvalid
+func (e UnknownError) Is(target error) bool {
+	return target == httpservice.ErrInvalid
+}
+
+// IsUnknown checks if an error is an unknown location error
+func IsUnknown(err error) bool {
+	var unknownErr *UnknownError
+	return errors.As(err, &unknownErr)
+}
@@ -0,0 +1,37 @@
+package location
+
+import (
+	"strings"
+	"time"
+)
+
+// Regions searched by the job board. They are stored in the location column of jobs.
+const (
+	RegionCostaRica = "Costa Rica"
+	RegionLATAM     = "LATAM"
+)
+
+// Location represents a place jobs are located in. Province and City are empty when the
+// posting doesn't name them, and Country is empty for jobs open to the whole region.
+type Location struct {
+	ID        int       `db:"id"`
+	Region    string    `db:"region"`
+	Country   string    `db:"country"`
+	Province  string    `db:"province"`
+	City      string    `db:"city"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// String returns the location as it is written, e.g. "Escazú, San José, Costa Rica"
+func (l *Location) String() string {
+	parts := make([]string, 0, 3)
+	for _, part := range []string{l.City, l.Province, l.Country} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	if len(parts) == 0 {
+		return l.Region
+	}
+	return strings.Join(parts, ", ")
+}
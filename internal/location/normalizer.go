@@ -0,0 +1,178 @@
+package location
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// CountryCostaRica is the country of the Costa Rica region
+const CountryCostaRica = "Costa Rica"
+
+// Provinces of Costa Rica
+var Provinces = []string{"San José", "Alajuela", "Cartago", "Heredia", "Guanacaste", "Puntarenas", "Limón"}
+
+// cityProvinces maps the folded names of the cantons and cities that show up in postings to their province
+var cityProvinces = map[string]string{
+	"escazu":        "San José",
+	"santa ana":     "San José",
+	"curridabat":    "San José",
+	"montes de oca": "San José",
+	"san pedro":     "San José",
+	"moravia":       "San José",
+	"tibas":         "San José",
+	"goicoechea":    "San José",
+	"desamparados":  "San José",
+	"la uruca":      "San José",
+	"rohrmoser":     "San José",
+	"sabana":        "San José",
+	"el coyol":      "Alajuela",
+	"grecia":        "Alajuela",
+	"san ramon":     "Alajuela",
+	"san carlos":    "Alajuela",
+	"tres rios":     "Cartago",
+	"la union":      "Cartago",
+	"paraiso":       "Cartago",
+	"turrialba":     "Cartago",
+	"belen":         "Heredia",
+	"san pablo":     "Heredia",
+	"santo domingo": "Heredia",
+	"barva":         "Heredia",
+	"lagunilla":     "Heredia",
+	"liberia":       "Guanacaste",
+	"nicoya":        "Guanacaste",
+	"santa cruz":    "Guanacaste",
+	"jaco":          "Puntarenas",
+	"quepos":        "Puntarenas",
+	"guapiles":      "Limón",
+}
+
+// latamCountries maps the folded names of the Latin American countries, in English and Spanish,
+// to their name
+var latamCountries = map[string]string{
+	"mexico":               "Mexico",
+	"guatemala":            "Guatemala",
+	"honduras":             "Honduras",
+	"el salvador":          "El Salvador",
+	"nicaragua":            "Nicaragua",
+	"panama":               "Panama",
+	"colombia":             "Colombia",
+	"venezuela":            "Venezuela",
+	"ecuador":              "Ecuador",
+	"peru":                 "Peru",
+	"bolivia":              "Bolivia",
+	"chile":                "Chile",
+	"argentina":            "Argentina",
+	"uruguay":              "Uruguay",
+	"paraguay":             "Paraguay",
+	"brazil":               "Brazil",
+	"brasil":               "Brazil",
+	"dominican republic":   "Dominican Republic",
+	"republica dominicana": "Dominican Republic",
+}
+
+// Folded names of Costa Rica and of the whole region
+var (
+	costaRicaNames = []string{"costa rica", "cr"}
+	latamNames     = []string{"latam", "latin america", "latinoamerica", "america latina", "americas"}
+)
+
+// Normalize converts the free-text location of a posting, e.g. "Escazú, San José" or "Remote - LATAM",
+// into a place. Accents, letter case and the separators between the parts are ignored; the most
+// precise part wins, so "Heredia, Costa Rica" is in the province of Heredia. It returns an
+// UnknownError when no part names a known place. The ID of the returned location is not set.
+func Normalize(value string) (*Location, error) {
+	var countryCR, latam bool
+	var country, province, city string
+
+	for _, part := range splitParts(value) {
+		switch {
+		case slices.Contains(costaRicaNames, part):
+			countryCR = true
+		case slices.Contains(latamNames, part):
+			latam = true
+		case latamCountries[part] != "":
+			country = latamCountries[part]
+		case ProvinceOf(part) != "":
+			province = ProvinceOf(part)
+		case cityProvinces[part] != "":
+			// Provinces are named after their capital, which is left out of the city
+			city = titleCase(part)
+			province = cityProvinces[part]
+		}
+	}
+
+	switch {
+	case province != "" || countryCR:
+		return &Location{Region: RegionCostaRica, Country: CountryCostaRica, Province: province, City: city}, nil
+	case country != "" || latam:
+		return &Location{Region: RegionLATAM, Country: country}, nil
+	default:
+		return nil, &UnknownError{Value: value}
+	}
+}
+
+// ProvinceOf returns the canonical name of a province of Costa Rica, e.g. "San José" for "san jose",
+// or "" when name isn't a province
+func ProvinceOf(name string) string {
+	folded := fold(name)
+	for _, province := range Provinces {
+		if fold(province) == folded {
+			return province
+		}
+	}
+	return ""
+}
+
+// splitParts splits a location into its folded parts
+func splitParts(value string) []string {
+	parts := strings.FieldsFunc(fold(value), func(r rune) bool {
+		return strings.ContainsRune(",;/|()-–·", r)
+	})
+
+	folded := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part = strings.Join(strings.Fields(part), " "); part != "" {
+			folded = append(folded, part)
+		}
+	}
+	return folded
+}
+
+// fold lowercases a value and removes its accents
+func fold(value string) string {
+	folded, _, err := transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn))), value)
+	if err != nil {
+		folded = value
+	}
+	return strings.TrimSpace(strings.ToLower(folded))
+}
+
+// titleCase capitalizes the words of a folded city name, restoring the accents of the known names
+func titleCase(folded string) string {
+	if restored, ok := cityNames[folded]; ok {
+		return restored
+	}
+	words := strings.Fields(folded)
+	for i, word := range words {
+		words[i] = strings.ToUpper(word[:1]) + word[1:]
+	}
+	return strings.Join(words, " ")
+}
+
+// cityNames holds the names of the cities whose folded name lost an accent
+var cityNames = map[string]string{
+	"escazu":    "Escazú",
+	"tibas":     "Tibás",
+	"san ramon": "San Ramón",
+	"tres rios": "Tres Ríos",
+	"la union":  "La Unión",
+	"paraiso":   "Paraíso",
+	"belen":     "Belén",
+	"jaco":      "Jacó",
+	"guapiles":  "Guápiles",
+}
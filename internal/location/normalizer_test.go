@@ -0,0 +1,75 @@
+package location
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		value    string
+		expected *Location
+	}{
+		{
+			name:     "country",
+			value:    "Costa Rica",
+			expected: &Location{Region: RegionCostaRica, Country: CountryCostaRica},
+		},
+		{
+			name:     "province without accents",
+			value:    "san jose, CR",
+			expected: &Location{Region: RegionCostaRica, Country: CountryCostaRica, Province: "San José"},
+		},
+		{
+			name:  "city",
+			value: "Escazu - San José, Costa Rica",
+			expected: &Location{
+				Region: RegionCostaRica, Country: CountryCostaRica, Province: "San José", City: "Escazú",
+			},
+		},
+		{
+			name:     "city without its province",
+			value:    "Belén (Hybrid)",
+			expected: &Location{Region: RegionCostaRica, Country: CountryCostaRica, Province: "Heredia", City: "Belén"},
+		},
+		{
+			name:     "whole region",
+			value:    "Remote - LATAM",
+			expected: &Location{Region: RegionLATAM},
+		},
+		{
+			name:     "latin american country",
+			value:    "Ciudad de México, México",
+			expected: &Location{Region: RegionLATAM, Country: "Mexico"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			location, err := Normalize(tt.value)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, location)
+		})
+	}
+}
+
+func TestNormalize_Unknown(t *testing.T) {
+	t.Parallel()
+
+	_, err := Normalize("Madrid, Spain")
+	require.Error(t, err)
+	assert.True(t, IsUnknown(err))
+}
+
+func TestProvinceOf(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "Limón", ProvinceOf(" LIMON "))
+	assert.Empty(t, ProvinceOf("Escazú"))
+}
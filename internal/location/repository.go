@@ -0,0 +1,61 @@
+package location
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// SQL query constants
+const (
+	// The no-op update makes the existing row return its ID
+	findOrCreateLocationQuery = `
+        INSERT INTO locations (region, country, province, city)
+        VALUES ($1, $2, $3, $4)
+        ON CONFLICT (region, country, province, city) DO UPDATE SET region = EXCLUDED.region
+        RETURNING id, created_at
+    `
+)
+
+// Database interface to support pgxpool and mocks
+type Database interface {
+	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
+	Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+}
+
+// Repository handles database operations for the Location model.
+type Repository struct {
+	db Database
+}
+
+// NewRepository creates a new Repository instance.
+func NewRepository(db Database) *Repository {
+	return &Repository{db: db}
+}
+
+// FindOrCreate sets the ID of a location, inserting it when it isn't stored yet.
+func (r *Repository) FindOrCreate(ctx context.Context, location *Location) error {
+	err := r.db.QueryRow(ctx, findOrCreateLocationQuery,
+		location.Region, location.Country, location.Province, location.City,
+	).Scan(&location.ID, &location.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to find or create location: %w", err)
+	}
+	return nil
+}
+
+// Resolve normalizes the free-text location of a posting and returns the stored place.
+// It returns an UnknownError when the location doesn't name a known place.
+func (r *Repository) Resolve(ctx context.Context, value string) (*Location, error) {
+	location, err := Normalize(value)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.FindOrCreate(ctx, location); err != nil {
+		return nil, err
+	}
+	return location, nil
+}
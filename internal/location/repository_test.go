@@ -0,0 +1,82 @@
+package location
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Resolve(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		value        string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, location *Location, err error)
+	}{
+		{
+			name:  "location stored",
+			value: "Heredia",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(findOrCreateLocationQuery)).
+					WithArgs(RegionCostaRica, CountryCostaRica, "Heredia", "").
+					WillReturnRows(pgxmock.NewRows([]string{"id", "created_at"}).AddRow(5, now))
+			},
+			checkResults: func(t *testing.T, location *Location, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 5, location.ID)
+				assert.Equal(t, "Heredia, Costa Rica", location.String())
+			},
+		},
+		{
+			name:      "unknown location",
+			value:     "Madrid",
+			mockSetup: func(_ pgxmock.PgxPoolIface) {},
+			checkResults: func(t *testing.T, _ *Location, err error) {
+				t.Helper()
+				assert.True(t, IsUnknown(err))
+			},
+		},
+		{
+			name:  "database error",
+			value: "LATAM",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(findOrCreateLocationQuery)).
+					WithArgs(RegionLATAM, "", "", "").
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ *Location, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			tt.mockSetup(mockDB)
+
+			location, err := NewRepository(mockDB).Resolve(context.Background(), tt.value)
+			tt.checkResults(t, location, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Package logging sets up the logs of the populators: the format of their entries and a copy
+// written to a file rotated by size, so their runs can be audited after the fact.
+package logging
+
+import (
+	"io"
+
+	"github.com/sirupsen/logrus"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// Log formats
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// Config configures the logs
+type Config struct {
+	// Format is FormatText or FormatJSON
+	Format string
+	// File is the path of the file the logs are copied to. They are only written to the console
+	// when it is empty.
+	File string
+	// MaxSizeMB is the size in megabytes a log file is rotated at
+	MaxSizeMB int
+	// MaxBackups is how many rotated files are kept. Zero keeps them all.
+	MaxBackups int
+	// MaxAgeDays is how many days the rotated files are kept. Zero keeps them forever.
+	MaxAgeDays int
+}
+
+// DefaultConfig returns text logs written to the console only
+func DefaultConfig() Config {
+	return Config{
+		Format:     FormatText,
+		MaxSizeMB:  100,
+		MaxBackups: 10,
+		MaxAgeDays: 30,
+	}
+}
+
+// nopCloser closes nothing, returned when the logs aren't copied to a file
+type nopCloser struct{}
+
+func (nopCloser) Close() error { return nil }
+
+// Configure sets the format of the entries of log and copies them to the log file, besides its
+// current output. The returned closer closes the log file.
+func Configure(log *logrus.Logger, cfg Config) io.Closer {
+	if cfg.Format == FormatJSON {
+		log.SetFormatter(&logrus.JSONFormatter{})
+	} else {
+		log.SetFormatter(&logrus.TextFormatter{
+			FullTimestamp: true,
+		})
+	}
+
+	if cfg.File == "" {
+		return nopCloser{}
+	}
+
+	// Rotated files are named after the time of the rotation, e.g. populator-2024-01-15T06-00-00.000.log
+	file := &lumberjack.Logger{
+		Filename:   cfg.File,
+		MaxSize:    cfg.MaxSizeMB,
+		MaxBackups: cfg.MaxBackups,
+		MaxAge:     cfg.MaxAgeDays,
+		LocalTime:  true,
+	}
+	log.SetOutput(io.MultiWriter(log.Out, file))
+	return file
+}
@@ -0,0 +1,54 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigure(t *testing.T) {
+	t.Parallel()
+
+	t.Run("console only", func(t *testing.T) {
+		t.Parallel()
+		var console bytes.Buffer
+		log := logrus.New()
+		log.SetOutput(&console)
+
+		closer := Configure(log, DefaultConfig())
+		log.WithField("company", "Tech Corp").Info("Job processed")
+		require.NoError(t, closer.Close())
+
+		assert.Contains(t, console.String(), `msg="Job processed" company="Tech Corp"`)
+	})
+
+	t.Run("json copied to file", func(t *testing.T) {
+		t.Parallel()
+		var console bytes.Buffer
+		log := logrus.New()
+		log.SetOutput(&console)
+		cfg := DefaultConfig()
+		cfg.Format = FormatJSON
+		cfg.File = filepath.Join(t.TempDir(), "logs", "populator.log")
+
+		closer := Configure(log, cfg)
+		log.WithFields(logrus.Fields{"company": "Tech Corp", "outcome": "created"}).Info("Job processed")
+		require.NoError(t, closer.Close())
+
+		written, err := os.ReadFile(cfg.File)
+		require.NoError(t, err)
+		assert.Equal(t, console.String(), string(written))
+
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal(written, &entry))
+		assert.Equal(t, "Job processed", entry["msg"])
+		assert.Equal(t, "Tech Corp", entry["company"])
+		assert.Equal(t, "created", entry["outcome"])
+	})
+}
@@ -0,0 +1,112 @@
+// Package mailer sends the transactional emails of the job board, such as verification codes,
+// through an SMTP server.
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultPort is the SMTP submission port used when none is configured
+const DefaultPort = 587
+
+// Config holds the SMTP server settings. Emails are only sent when configured.
+type Config struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	// From is the sender address, e.g. "Ticos in Tech <no-reply@ticosintech.com>"
+	From string
+}
+
+// Enabled reports whether the SMTP server is configured
+func (c *Config) Enabled() bool {
+	return c.Host != "" && c.From != ""
+}
+
+// sendFunc delivers a message, smtp.SendMail outside of the tests
+type sendFunc func(addr string, auth smtp.Auth, from string, to []string, msg []byte) error
+
+// Mailer sends plain text emails through an SMTP server.
+type Mailer struct {
+	cfg  Config
+	send sendFunc
+}
+
+// NewMailer creates a mailer sending through the SMTP server of cfg
+func NewMailer(cfg *Config) *Mailer {
+	return &Mailer{cfg: *cfg, send: smtp.SendMail}
+}
+
+// Send emails a plain text message to a single recipient. The SMTP exchange can't be canceled,
+// ctx is only checked before it starts.
+func (m *Mailer) Send(ctx context.Context, to, subject, body string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	from, err := mail.ParseAddress(m.cfg.From)
+	if err != nil {
+		return fmt.Errorf("invalid sender address %q: %w", m.cfg.From, err)
+	}
+	recipient, err := mail.ParseAddress(to)
+	if err != nil {
+		return fmt.Errorf("invalid recipient address %q: %w", to, err)
+	}
+
+	msg, err := buildMessage(from, recipient, subject, body, time.Now())
+	if err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if m.cfg.Username != "" {
+		auth = smtp.PlainAuth("", m.cfg.Username, m.cfg.Password, m.cfg.Host)
+	}
+
+	port := m.cfg.Port
+	if port == 0 {
+		port = DefaultPort
+	}
+	addr := net.JoinHostPort(m.cfg.Host, strconv.Itoa(port))
+	if err := m.send(addr, auth, from.Address, []string{recipient.Address}, msg); err != nil {
+		return fmt.Errorf("failed to send email to %s: %w", recipient.Address, err)
+	}
+	return nil
+}
+
+// buildMessage formats an email with a quoted-printable UTF-8 body
+func buildMessage(from, to *mail.Address, subject, body string, date time.Time) ([]byte, error) {
+	if strings.ContainsAny(subject, "\r\n") {
+		return nil, errors.New("email subject cannot contain line breaks")
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", from.String())
+	fmt.Fprintf(&msg, "To: %s\r\n", to.String())
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&msg, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	msg.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+
+	writer := quotedprintable.NewWriter(&msg)
+	if _, err := writer.Write([]byte(strings.ReplaceAll(body, "\n", "\r\n"))); err != nil {
+		return nil, fmt.Errorf("failed to encode email body: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode email body: %w", err)
+	}
+	return msg.Bytes(), nil
+}
@@ -0,0 +1,109 @@
+package mailer
+
+import (
+	"context"
+	"errors"
+	"net/smtp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMailer_Send(t *testing.T) {
+	t.Parallel()
+	sendError := errors.New("connection refused")
+
+	tests := []struct {
+		name         string
+		cfg          Config
+		to           string
+		subject      string
+		sendErr      error
+		checkResults func(t *testing.T, addr string, auth smtp.Auth, to []string, msg string, err error)
+	}{
+		{
+			name: "email sent with authentication",
+			cfg: Config{
+				Host: "smtp.example.com", Port: 2525, Username: "user", Password: "secret",
+				From: "Board <no-reply@example.com>",
+			},
+			to:      "ana@techcorp.com",
+			subject: "Código de verificación",
+			checkResults: func(t *testing.T, addr string, auth smtp.Auth, to []string, msg string, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, "smtp.example.com:2525", addr)
+				assert.NotNil(t, auth)
+				assert.Equal(t, []string{"ana@techcorp.com"}, to)
+				assert.Contains(t, msg, "From: \"Board\" <no-reply@example.com>\r\n")
+				assert.Contains(t, msg, "To: <ana@techcorp.com>\r\n")
+				assert.Contains(t, msg, "Subject: =?utf-8?q?C=C3=B3digo_de_verificaci=C3=B3n?=\r\n")
+				assert.Contains(t, msg, "\r\n\r\nYour code is 123456\r\nBye")
+			},
+		},
+		{
+			name:    "default port without authentication",
+			cfg:     Config{Host: "localhost", From: "no-reply@example.com"},
+			to:      "ana@techcorp.com",
+			subject: "Verification code",
+			checkResults: func(t *testing.T, addr string, auth smtp.Auth, _ []string, _ string, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, "localhost:587", addr)
+				assert.Nil(t, auth)
+			},
+		},
+		{
+			name:    "invalid recipient",
+			cfg:     Config{Host: "localhost", From: "no-reply@example.com"},
+			to:      "not an email",
+			subject: "Verification code",
+			checkResults: func(t *testing.T, _ string, _ smtp.Auth, to []string, _ string, err error) {
+				t.Helper()
+				require.ErrorContains(t, err, "invalid recipient address")
+				assert.Nil(t, to)
+			},
+		},
+		{
+			name:    "subject with line breaks",
+			cfg:     Config{Host: "localhost", From: "no-reply@example.com"},
+			to:      "ana@techcorp.com",
+			subject: "Hi\r\nBcc: eve@example.com",
+			checkResults: func(t *testing.T, _ string, _ smtp.Auth, to []string, _ string, err error) {
+				t.Helper()
+				require.ErrorContains(t, err, "line breaks")
+				assert.Nil(t, to)
+			},
+		},
+		{
+			name:    "server error",
+			cfg:     Config{Host: "localhost", From: "no-reply@example.com"},
+			to:      "ana@techcorp.com",
+			subject: "Verification code",
+			sendErr: sendError,
+			checkResults: func(t *testing.T, _ string, _ smtp.Auth, _ []string, _ string, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, sendError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var gotAddr, gotMsg string
+			var gotAuth smtp.Auth
+			var gotTo []string
+
+			mailer := NewMailer(&tt.cfg)
+			mailer.send = func(addr string, auth smtp.Auth, _ string, to []string, msg []byte) error {
+				gotAddr, gotAuth, gotTo, gotMsg = addr, auth, to, string(msg)
+				return tt.sendErr
+			}
+
+			err := mailer.Send(context.Background(), tt.to, tt.subject, "Your code is 123456\nBye")
+			tt.checkResults(t, gotAddr, gotAuth, gotTo, gotMsg, err)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package maintenance
+
+import (
+	"time"
+)
+
+// Data Transfer Objects (DTOs) for the maintenance API layer.
+
+// RefreshResponse represents the outcome of a refresh in API responses
+type RefreshResponse struct {
+	PurgedCacheEntries int       `json:"purged_cache_entries" example:"3"`
+	StartedAt          time.Time `json:"started_at" example:"2024-01-15T06:00:00Z"`
+	DurationMS         int64     `json:"duration_ms" example:"1250"`
+}
+
+// MapRefreshToResponse converts a RefreshResult to a RefreshResponse
+func MapRefreshToResponse(result *RefreshResult) *RefreshResponse {
+	return &RefreshResponse{
+		PurgedCacheEntries: result.PurgedCacheEntries,
+		StartedAt:          result.StartedAt,
+		DurationMS:         result.Duration.Milliseconds(),
+	}
+}
@@ -0,0 +1,50 @@
+package maintenance
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Constants for maintenance routes and endpoints
+const (
+	MaintenanceRoute = "/maintenance"
+	RefreshRoute     = MaintenanceRoute + "/refresh"
+)
+
+// Handler handles HTTP requests for the maintenance operations
+type Handler struct {
+	service *MaintenanceService
+}
+
+// NewHandler creates a new maintenance handler
+func NewHandler(service *MaintenanceService) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterAdminRoutes registers the maintenance admin routes with the given (protected) router group
+func (h *Handler) RegisterAdminRoutes(rg *gin.RouterGroup) {
+	rg.POST(RefreshRoute, h.Refresh)
+}
+
+// Refresh godoc
+// @Summary Refresh derived data
+// @Description Refreshes the job search view, recomputes the cached statistics and purges the expired
+// @Description cache entries, so recent job changes such as a bulk import show up right away.
+// @Tags admin
+// @Produce json
+// @Security AdminAPIKey
+// @Success 200 {object} RefreshResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Failure 503 {object} httpservice.ErrorResponse
+// @Router /admin/maintenance/refresh [post]
+func (h *Handler) Refresh(c *gin.Context) {
+	result, err := h.service.Refresh(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapRefreshToResponse(result))
+}
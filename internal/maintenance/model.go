@@ -0,0 +1,15 @@
+// Package maintenance lets operators recompute the derived data of the board on demand, such as
+// after a bulk import: the job search view, the cached statistics and the in-memory caches.
+package maintenance
+
+import (
+	"time"
+)
+
+// RefreshResult represents the outcome of a refresh of the derived data
+type RefreshResult struct {
+	// PurgedCacheEntries is the number of expired cache entries removed
+	PurgedCacheEntries int
+	StartedAt          time.Time
+	Duration           time.Duration
+}
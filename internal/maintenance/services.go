@@ -0,0 +1,63 @@
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// SearchViewRefresher interface to refresh the materialized view read by the job search.
+type SearchViewRefresher interface {
+	RefreshSearchView(ctx context.Context) error
+}
+
+// StatsRefresher interface to recompute the cached market statistics.
+type StatsRefresher interface {
+	RefreshOverview(ctx context.Context) error
+}
+
+// CachePurger interface to remove the expired entries of an in-memory cache.
+type CachePurger interface {
+	PurgeCache() int
+}
+
+// MaintenanceService holds the business logic to refresh the derived data.
+type MaintenanceService struct {
+	searchView SearchViewRefresher
+	stats      StatsRefresher
+	caches     []CachePurger
+	now        func() time.Time
+}
+
+// NewMaintenanceService creates a new instance of MaintenanceService
+func NewMaintenanceService(searchView SearchViewRefresher, stats StatsRefresher,
+	caches ...CachePurger,
+) *MaintenanceService {
+	return &MaintenanceService{
+		searchView: searchView,
+		stats:      stats,
+		caches:     caches,
+		now:        time.Now,
+	}
+}
+
+// Refresh refreshes the job search view, then recomputes the statistics from the refreshed data
+// and purges the expired cache entries. It stops at the first failure.
+func (s *MaintenanceService) Refresh(ctx context.Context) (*RefreshResult, error) {
+	result := &RefreshResult{StartedAt: s.now()}
+
+	if err := s.searchView.RefreshSearchView(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := s.stats.RefreshOverview(ctx); err != nil {
+		return nil, fmt.Errorf("failed to recompute statistics: %w", err)
+	}
+
+	for _, c := range s.caches {
+		result.PurgedCacheEntries += c.PurgeCache()
+	}
+
+	result.Duration = s.now().Sub(result.StartedAt)
+	return result, nil
+}
@@ -0,0 +1,85 @@
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaintenanceService_Refresh(t *testing.T) {
+	t.Parallel()
+	startedAt := time.Date(2024, 1, 15, 6, 0, 0, 0, time.UTC)
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mockView *MockSearchViewRefresher, mockStats *MockStatsRefresher, mockCache *MockCachePurger)
+		checkResults func(t *testing.T, result *RefreshResult, err error)
+	}{
+		{
+			name: "everything refreshed",
+			mockSetup: func(mockView *MockSearchViewRefresher, mockStats *MockStatsRefresher, mockCache *MockCachePurger) {
+				t.Helper()
+				mockView.EXPECT().RefreshSearchView(context.Background()).Return(nil).Once()
+				mockStats.EXPECT().RefreshOverview(context.Background()).Return(nil).Once()
+				mockCache.EXPECT().PurgeCache().Return(2).Twice()
+			},
+			checkResults: func(t *testing.T, result *RefreshResult, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 4, result.PurgedCacheEntries)
+				assert.Equal(t, startedAt, result.StartedAt)
+				assert.Equal(t, 2*time.Second, result.Duration)
+			},
+		},
+		{
+			name: "search view not refreshed",
+			mockSetup: func(mockView *MockSearchViewRefresher, _ *MockStatsRefresher, _ *MockCachePurger) {
+				t.Helper()
+				mockView.EXPECT().RefreshSearchView(context.Background()).Return(dbError).Once()
+			},
+			checkResults: func(t *testing.T, result *RefreshResult, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+				assert.Nil(t, result)
+			},
+		},
+		{
+			name: "statistics not recomputed",
+			mockSetup: func(mockView *MockSearchViewRefresher, mockStats *MockStatsRefresher, _ *MockCachePurger) {
+				t.Helper()
+				mockView.EXPECT().RefreshSearchView(context.Background()).Return(nil).Once()
+				mockStats.EXPECT().RefreshOverview(context.Background()).Return(dbError).Once()
+			},
+			checkResults: func(t *testing.T, _ *RefreshResult, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockView := NewMockSearchViewRefresher(t)
+			mockStats := NewMockStatsRefresher(t)
+			mockCache := NewMockCachePurger(t)
+			tt.mockSetup(mockView, mockStats, mockCache)
+
+			service := NewMaintenanceService(mockView, mockStats, mockCache, mockCache)
+			now := startedAt
+			service.now = func() time.Time {
+				current := now
+				now = now.Add(2 * time.Second)
+				return current
+			}
+
+			result, err := service.Refresh(context.Background())
+			tt.checkResults(t, result, err)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Constants for metrics routes and endpoints
+const (
+	MetricsRoute = "/metrics"
+
+	// contentType is the content type of the Prometheus text exposition format
+	contentType = "text/plain; version=0.0.4; charset=utf-8"
+)
+
+// Handler handles the metrics scrapes
+type Handler struct {
+	registry *Registry
+}
+
+// NewHandler creates a new metrics handler
+func NewHandler(registry *Registry) *Handler {
+	return &Handler{registry: registry}
+}
+
+// RegisterRoutes registers the metrics route with the given router
+func (h *Handler) RegisterRoutes(r gin.IRoutes) {
+	r.GET(MetricsRoute, h.Metrics)
+}
+
+// Metrics writes the current metrics in the Prometheus text exposition format
+func (h *Handler) Metrics(c *gin.Context) {
+	c.Status(http.StatusOK)
+	c.Header("Content-Type", contentType)
+	if err := h.registry.WriteText(c.Writer); err != nil {
+		_ = c.Error(err)
+	}
+}
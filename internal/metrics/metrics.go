@@ -0,0 +1,140 @@
+// Package metrics exposes the server metrics in the Prometheus text format. Components
+// register collectors reading their current state, which are gathered on every scrape.
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Type is the Prometheus type of a metric
+type Type string
+
+// Metric types
+const (
+	Counter Type = "counter"
+	Gauge   Type = "gauge"
+)
+
+// Metric is a sample of a metric. Samples of the same metric differ by their labels.
+type Metric struct {
+	Name   string
+	Help   string
+	Type   Type
+	Labels map[string]string
+	Value  float64
+}
+
+// Collector interface to read the current metrics of a component.
+type Collector interface {
+	Collect() []Metric
+}
+
+// CollectorFunc adapts a function to the Collector interface
+type CollectorFunc func() []Metric
+
+// Collect calls f
+func (f CollectorFunc) Collect() []Metric {
+	return f()
+}
+
+// Registry holds the collectors gathered by the metrics endpoint
+type Registry struct {
+	mu         sync.Mutex
+	collectors []Collector
+}
+
+// NewRegistry creates a new instance of Registry
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Register adds a collector to the registry
+func (r *Registry) Register(collector Collector) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.collectors = append(r.collectors, collector)
+}
+
+// Gather returns the metrics of all the collectors, grouped by name in the order they were first collected
+func (r *Registry) Gather() []Metric {
+	r.mu.Lock()
+	collectors := append([]Collector(nil), r.collectors...)
+	r.mu.Unlock()
+
+	var metrics []Metric
+	for _, collector := range collectors {
+		metrics = append(metrics, collector.Collect()...)
+	}
+
+	order := make(map[string]int, len(metrics))
+	for _, m := range metrics {
+		if _, ok := order[m.Name]; !ok {
+			order[m.Name] = len(order)
+		}
+	}
+	sort.SliceStable(metrics, func(i, j int) bool {
+		return order[metrics[i].Name] < order[metrics[j].Name]
+	})
+
+	return metrics
+}
+
+// WriteText writes the gathered metrics to w in the Prometheus text exposition format
+func (r *Registry) WriteText(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	previous := ""
+	for _, m := range r.Gather() {
+		if m.Name != previous {
+			fmt.Fprintf(bw, "# HELP %s %s\n", m.Name, escapeHelp(m.Help))
+			fmt.Fprintf(bw, "# TYPE %s %s\n", m.Name, m.Type)
+			previous = m.Name
+		}
+		fmt.Fprintf(bw, "%s%s %s\n", m.Name, formatLabels(m.Labels),
+			strconv.FormatFloat(m.Value, 'g', -1, 64))
+	}
+	return bw.Flush()
+}
+
+// formatLabels formats labels sorted by name, e.g. {kind="logo",ok="true"}
+func formatLabels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var sb strings.Builder
+	sb.WriteByte('{')
+	for i, name := range names {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(name)
+		sb.WriteString(`="`)
+		sb.WriteString(labelValueEscaper.Replace(labels[name]))
+		sb.WriteByte('"')
+	}
+	sb.WriteByte('}')
+	return sb.String()
+}
+
+// escapeHelp escapes the backslashes and line breaks of a help text
+func escapeHelp(help string) string {
+	return helpEscaper.Replace(help)
+}
+
+// Escapers of the help texts and label values
+var (
+	helpEscaper       = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelValueEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
@@ -0,0 +1,44 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry_WriteText(t *testing.T) {
+	t.Parallel()
+	registry := NewRegistry()
+	registry.Register(CollectorFunc(func() []Metric {
+		return []Metric{
+			{Name: "db_pool_total_conns", Help: "Open connections", Type: Gauge, Value: 4},
+			{Name: "link_checks_total", Help: "Checked links", Type: Counter, Value: 10,
+				Labels: map[string]string{"ok": "true", "kind": "logo_url"}},
+		}
+	}))
+	registry.Register(CollectorFunc(func() []Metric {
+		return []Metric{
+			{Name: "link_checks_total", Help: "Checked links", Type: Counter, Value: 2,
+				Labels: map[string]string{"ok": "false", "kind": `say "hi"`}},
+			{Name: "uptime_seconds", Help: "Time since start\nin seconds", Type: Gauge, Value: 0.5},
+		}
+	}))
+
+	var sb strings.Builder
+	require.NoError(t, registry.WriteText(&sb))
+
+	expected := `# HELP db_pool_total_conns Open connections
+# TYPE db_pool_total_conns gauge
+db_pool_total_conns 4
+# HELP link_checks_total Checked links
+# TYPE link_checks_total counter
+link_checks_total{kind="logo_url",ok="true"} 10
+link_checks_total{kind="say \"hi\"",ok="false"} 2
+# HELP uptime_seconds Time since start\nin seconds
+# TYPE uptime_seconds gauge
+uptime_seconds 0.5
+`
+	assert.Equal(t, expected, sb.String())
+}
@@ -0,0 +1,79 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	neturl "net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultTimeout bounds each request to a chat service
+	DefaultTimeout = 10 * time.Second
+
+	// maxErrorBodySize bounds how much of an error response is kept in the error message
+	maxErrorBodySize = 512
+)
+
+// ResponseError represents a message rejected by a chat service
+type ResponseError struct {
+	Channel    string
+	StatusCode int
+	Body       string
+}
+
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("%s responded with status %d: %s", e.Channel, e.StatusCode, e.Body)
+}
+
+// postJSON posts body encoded as JSON to url. Any non 2xx response is returned as a ResponseError.
+func postJSON(ctx context.Context, client *http.Client, channel, url string, body any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s message: %w", channel, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create %s request: %w", channel, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		// Leave the URL out of the error, it holds the webhook or bot credentials
+		var urlErr *neturl.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("failed to post %s message: %w", channel, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return &ResponseError{Channel: channel, StatusCode: resp.StatusCode, Body: string(respBody)}
+	}
+
+	return nil
+}
+
+// jobDetails returns the details shown after a job title, e.g. "Senior · Remote · Go, PostgreSQL"
+func jobDetails(job *Job) string {
+	details := make([]string, 0, 4)
+	for _, detail := range []string{job.ExperienceLevel, job.WorkMode, job.Location} {
+		if detail != "" {
+			details = append(details, detail)
+		}
+	}
+	if len(job.Technologies) > 0 {
+		details = append(details, strings.Join(job.Technologies, ", "))
+	}
+	return strings.Join(details, " · ")
+}
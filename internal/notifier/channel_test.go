@@ -0,0 +1,85 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testMessage = &Message{
+	Title: "New jobs on Ticos in Tech",
+	Jobs: []*Job{{
+		Title:           "Backend <Go> Developer",
+		CompanyName:     "Tech & Co",
+		ExperienceLevel: "Senior",
+		WorkMode:        "Remote",
+		ApplicationURL:  "https://techco.example.com/jobs/1?ref=a&b=c",
+		Technologies:    []string{"Go", "PostgreSQL"},
+	}},
+}
+
+func TestSlackChannel_Send(t *testing.T) {
+	t.Parallel()
+
+	var body map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	err := NewSlackChannel(server.URL).Send(context.Background(), testMessage)
+	require.NoError(t, err)
+	assert.Equal(t, "*New jobs on Ticos in Tech*\n"+
+		"• <https://techco.example.com/jobs/1?ref=a&b=c|Backend &lt;Go&gt; Developer> at Tech &amp; Co "+
+		"(Senior · Remote · Go, PostgreSQL)", body["text"])
+}
+
+func TestTelegramChannel_Send(t *testing.T) {
+	t.Parallel()
+
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/botbot-token/sendMessage", r.URL.Path)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	channel := NewTelegramChannel("bot-token", "@ticos_jobs")
+	channel.apiURL = server.URL
+
+	err := channel.Send(context.Background(), testMessage)
+	require.NoError(t, err)
+	assert.Equal(t, "@ticos_jobs", body["chat_id"])
+	assert.Equal(t, "HTML", body["parse_mode"])
+	assert.Equal(t, "<b>New jobs on Ticos in Tech</b>\n"+
+		"• <a href=\"https://techco.example.com/jobs/1?ref=a&amp;b=c\">Backend &lt;Go&gt; Developer</a> "+
+		"at Tech &amp; Co (Senior · Remote · Go, PostgreSQL)", body["text"])
+}
+
+func TestTelegramChannel_SendError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"ok":false,"description":"Bad Request: chat not found"}`))
+	}))
+	defer server.Close()
+
+	channel := NewTelegramChannel("bot-token", "@unknown")
+	channel.apiURL = server.URL
+
+	err := channel.Send(context.Background(), testMessage)
+	var responseErr *ResponseError
+	require.ErrorAs(t, err, &responseErr)
+	assert.Equal(t, http.StatusBadRequest, responseErr.StatusCode)
+	assert.Contains(t, responseErr.Body, "chat not found")
+	assert.NotContains(t, err.Error(), "bot-token")
+}
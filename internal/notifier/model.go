@@ -0,0 +1,22 @@
+package notifier
+
+import "time"
+
+// Job represents a published job as announced to the channels
+type Job struct {
+	ID              int       `db:"id"`
+	Title           string    `db:"title"`
+	CompanyName     string    `db:"company_name"`
+	Location        string    `db:"location"`
+	WorkMode        string    `db:"work_mode"`
+	ExperienceLevel string    `db:"experience_level"`
+	ApplicationURL  string    `db:"application_url"`
+	PublishedAt     time.Time `db:"published_at"`
+	Technologies    []string  `db:"technologies"`
+}
+
+// Message is a list of jobs posted to a channel under a title
+type Message struct {
+	Title string
+	Jobs  []*Job
+}
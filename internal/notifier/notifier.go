@@ -0,0 +1,152 @@
+// Package notifier announces newly published jobs on chat channels, Slack incoming webhooks
+// and Telegram channels, optionally only the jobs using some technologies. Jobs are announced
+// right after an import or once a day as a digest.
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Notification settings
+const (
+	// MaxJobsPerMessage keeps messages under the Telegram limit of 4096 characters
+	MaxJobsPerMessage = 15
+	// MaxJobsPerNotification bounds the jobs announced at once, e.g. after a large import
+	MaxJobsPerNotification = 300
+	DigestPeriod           = 24 * time.Hour
+)
+
+// Config holds the channels to post to. Channels are only used when configured.
+type Config struct {
+	SlackWebhookURLs []string
+	TelegramBotToken string
+	TelegramChatIDs  []string
+	// Technologies restricts the announced jobs to the ones using any of them (case insensitive).
+	// All jobs are announced when empty.
+	Technologies []string
+}
+
+// Enabled reports whether any channel is configured
+func (c *Config) Enabled() bool {
+	return len(c.SlackWebhookURLs) > 0 || (c.TelegramBotToken != "" && len(c.TelegramChatIDs) > 0)
+}
+
+// Channel interface to post messages to a chat service.
+type Channel interface {
+	Name() string
+	Send(ctx context.Context, message *Message) error
+}
+
+// JobRepository interface to fetch the published jobs to announce.
+type JobRepository interface {
+	ListPublishedSince(ctx context.Context, since time.Time, limit int) ([]*Job, error)
+}
+
+// Notifier posts the newly published jobs to the configured channels.
+type Notifier struct {
+	repo         JobRepository
+	channels     []Channel
+	technologies map[string]bool
+	now          func() time.Time
+}
+
+// NewNotifier creates a new instance of Notifier posting to channels. Only jobs using any of
+// technologies are announced, all of them when technologies is empty.
+func NewNotifier(repo JobRepository, channels []Channel, technologies []string) *Notifier {
+	techSet := make(map[string]bool, len(technologies))
+	for _, tech := range technologies {
+		if tech = strings.TrimSpace(tech); tech != "" {
+			techSet[strings.ToLower(tech)] = true
+		}
+	}
+	return &Notifier{repo: repo, channels: channels, technologies: techSet, now: time.Now}
+}
+
+// NewNotifierFromConfig creates a Notifier posting to the channels of cfg
+func NewNotifierFromConfig(repo JobRepository, cfg *Config) *Notifier {
+	channels := make([]Channel, 0, len(cfg.SlackWebhookURLs)+len(cfg.TelegramChatIDs))
+	for _, webhookURL := range cfg.SlackWebhookURLs {
+		channels = append(channels, NewSlackChannel(webhookURL))
+	}
+	if cfg.TelegramBotToken != "" {
+		for _, chatID := range cfg.TelegramChatIDs {
+			channels = append(channels, NewTelegramChannel(cfg.TelegramBotToken, chatID))
+		}
+	}
+	return NewNotifier(repo, channels, cfg.Technologies)
+}
+
+// AnnounceNew posts the jobs published since the given time, e.g. the start of an import.
+// It returns the number of jobs announced.
+func (n *Notifier) AnnounceNew(ctx context.Context, since time.Time) (int, error) {
+	return n.notify(ctx, since, "New jobs on Ticos in Tech")
+}
+
+// SendDigest posts the jobs published in the last 24 hours. It returns the number of jobs announced.
+func (n *Notifier) SendDigest(ctx context.Context) (int, error) {
+	return n.notify(ctx, n.now().Add(-DigestPeriod), "Daily digest: jobs published in the last 24 hours")
+}
+
+// notify posts the matching jobs published since the given time to every channel, split in messages
+// of MaxJobsPerMessage jobs. A failing channel doesn't stop the others, the errors are joined.
+func (n *Notifier) notify(ctx context.Context, since time.Time, title string) (int, error) {
+	jobs, err := n.repo.ListPublishedSince(ctx, since, MaxJobsPerNotification)
+	if err != nil {
+		return 0, err
+	}
+
+	jobs = n.filter(jobs)
+	if len(jobs) == 0 {
+		return 0, nil
+	}
+
+	messages := splitMessages(title, jobs)
+	var errs []error
+	for _, channel := range n.channels {
+		for _, message := range messages {
+			if err := channel.Send(ctx, message); err != nil {
+				errs = append(errs, err)
+				break
+			}
+		}
+	}
+
+	return len(jobs), errors.Join(errs...)
+}
+
+// filter returns the jobs using any of the notifier technologies
+func (n *Notifier) filter(jobs []*Job) []*Job {
+	if len(n.technologies) == 0 {
+		return jobs
+	}
+
+	matching := make([]*Job, 0, len(jobs))
+	for _, job := range jobs {
+		for _, tech := range job.Technologies {
+			if n.technologies[strings.ToLower(tech)] {
+				matching = append(matching, job)
+				break
+			}
+		}
+	}
+	return matching
+}
+
+// splitMessages groups jobs in messages of at most MaxJobsPerMessage jobs. The part number
+// is added to the title when more than one message is needed.
+func splitMessages(title string, jobs []*Job) []*Message {
+	parts := (len(jobs) + MaxJobsPerMessage - 1) / MaxJobsPerMessage
+	messages := make([]*Message, 0, parts)
+	for i := 0; i < len(jobs); i += MaxJobsPerMessage {
+		message := &Message{Title: title, Jobs: jobs[i:min(i+MaxJobsPerMessage, len(jobs))]}
+		if parts > 1 {
+			message.Title = fmt.Sprintf("%s (%d/%d)", title, len(messages)+1, parts)
+		}
+		messages = append(messages, message)
+	}
+	return messages
+}
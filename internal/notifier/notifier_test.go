@@ -0,0 +1,146 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotifier_AnnounceNew(t *testing.T) {
+	t.Parallel()
+	since := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	sendError := errors.New("send error")
+	goJob := &Job{ID: 1, Title: "Go Developer", Technologies: []string{"Go", "PostgreSQL"}}
+	javaJob := &Job{ID: 2, Title: "Java Developer", Technologies: []string{"Java"}}
+
+	tests := []struct {
+		name         string
+		technologies []string
+		mockSetup    func(mockRepo *MockJobRepository, slack, telegram *MockChannel)
+		checkResults func(t *testing.T, announced int, err error)
+	}{
+		{
+			name: "all jobs posted to every channel",
+			mockSetup: func(mockRepo *MockJobRepository, slack, telegram *MockChannel) {
+				t.Helper()
+				mockRepo.EXPECT().ListPublishedSince(context.Background(), since, MaxJobsPerNotification).
+					Return([]*Job{goJob, javaJob}, nil).Once()
+				expected := &Message{Title: "New jobs on Ticos in Tech", Jobs: []*Job{goJob, javaJob}}
+				slack.EXPECT().Send(context.Background(), expected).Return(nil).Once()
+				telegram.EXPECT().Send(context.Background(), expected).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, announced int, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 2, announced)
+			},
+		},
+		{
+			name:         "jobs filtered by technology",
+			technologies: []string{" go ", "rust"},
+			mockSetup: func(mockRepo *MockJobRepository, slack, telegram *MockChannel) {
+				t.Helper()
+				mockRepo.EXPECT().ListPublishedSince(context.Background(), since, MaxJobsPerNotification).
+					Return([]*Job{goJob, javaJob}, nil).Once()
+				expected := &Message{Title: "New jobs on Ticos in Tech", Jobs: []*Job{goJob}}
+				slack.EXPECT().Send(context.Background(), expected).Return(nil).Once()
+				telegram.EXPECT().Send(context.Background(), expected).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, announced int, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 1, announced)
+			},
+		},
+		{
+			name:         "no matching jobs",
+			technologies: []string{"Rust"},
+			mockSetup: func(mockRepo *MockJobRepository, _, _ *MockChannel) {
+				t.Helper()
+				mockRepo.EXPECT().ListPublishedSince(context.Background(), since, MaxJobsPerNotification).
+					Return([]*Job{goJob, javaJob}, nil).Once()
+			},
+			checkResults: func(t *testing.T, announced int, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Zero(t, announced)
+			},
+		},
+		{
+			name: "failing channel doesn't stop the others",
+			mockSetup: func(mockRepo *MockJobRepository, slack, telegram *MockChannel) {
+				t.Helper()
+				mockRepo.EXPECT().ListPublishedSince(context.Background(), since, MaxJobsPerNotification).
+					Return([]*Job{goJob}, nil).Once()
+				slack.EXPECT().Send(context.Background(), mock.Anything).Return(sendError).Once()
+				telegram.EXPECT().Send(context.Background(), mock.Anything).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, announced int, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, sendError)
+				assert.Equal(t, 1, announced)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockJobRepository(t)
+			slack := NewMockChannel(t)
+			telegram := NewMockChannel(t)
+			n := NewNotifier(mockRepo, []Channel{slack, telegram}, tt.technologies)
+
+			tt.mockSetup(mockRepo, slack, telegram)
+
+			announced, err := n.AnnounceNew(context.Background(), since)
+			tt.checkResults(t, announced, err)
+		})
+	}
+}
+
+func TestNotifier_SendDigest(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 1, 15, 8, 0, 0, 0, time.UTC)
+
+	jobs := make([]*Job, MaxJobsPerMessage+1)
+	for i := range jobs {
+		jobs[i] = &Job{ID: i + 1, Title: fmt.Sprintf("Job %d", i+1)}
+	}
+
+	mockRepo := NewMockJobRepository(t)
+	mockRepo.EXPECT().ListPublishedSince(context.Background(), now.Add(-DigestPeriod), MaxJobsPerNotification).
+		Return(jobs, nil).Once()
+
+	channel := NewMockChannel(t)
+	channel.EXPECT().Send(context.Background(), &Message{
+		Title: "Daily digest: jobs published in the last 24 hours (1/2)",
+		Jobs:  jobs[:MaxJobsPerMessage],
+	}).Return(nil).Once()
+	channel.EXPECT().Send(context.Background(), &Message{
+		Title: "Daily digest: jobs published in the last 24 hours (2/2)",
+		Jobs:  jobs[MaxJobsPerMessage:],
+	}).Return(nil).Once()
+
+	n := NewNotifier(mockRepo, []Channel{channel}, nil)
+	n.now = func() time.Time { return now }
+
+	announced, err := n.SendDigest(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, MaxJobsPerMessage+1, announced)
+}
+
+func TestConfig_Enabled(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, (&Config{}).Enabled())
+	assert.False(t, (&Config{TelegramChatIDs: []string{"@ticos_jobs"}}).Enabled())
+	assert.True(t, (&Config{TelegramBotToken: "token", TelegramChatIDs: []string{"@ticos_jobs"}}).Enabled())
+	assert.True(t, (&Config{SlackWebhookURLs: []string{"https://hooks.slack.com/services/T/B/X"}}).Enabled())
+}
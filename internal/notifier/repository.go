@@ -0,0 +1,79 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// SQL query constants
+const (
+	// Jobs are published when created as published or when approved, whichever is later
+	listPublishedJobsQuery = `
+        SELECT j.id, j.title, c.name, j.location, j.work_mode, j.experience_level, j.application_url,
+               COALESCE(j.reviewed_at, j.created_at) AS published_at,
+               COALESCE(array_agg(t.name ORDER BY t.name) FILTER (WHERE t.id IS NOT NULL), '{}') AS technologies
+        FROM jobs j
+        JOIN companies c ON j.company_id = c.id
+        LEFT JOIN job_technologies jt ON jt.job_id = j.id
+        LEFT JOIN technologies t ON jt.technology_id = t.id
+        WHERE j.is_active = true AND j.status = 'published'
+          AND COALESCE(j.reviewed_at, j.created_at) >= $1
+        GROUP BY j.id, c.name
+        ORDER BY published_at, j.id
+        LIMIT $2
+    `
+)
+
+// Database interface to support pgxpool and mocks
+type Database interface {
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+}
+
+// Repository handles the database queries of the notifier.
+type Repository struct {
+	db Database
+}
+
+// NewRepository creates a new Repository instance.
+func NewRepository(db Database) *Repository {
+	return &Repository{db: db}
+}
+
+// ListPublishedSince retrieves up to limit active jobs published since the given time,
+// oldest first, with their technologies.
+func (r *Repository) ListPublishedSince(ctx context.Context, since time.Time, limit int) ([]*Job, error) {
+	rows, err := r.db.Query(ctx, listPublishedJobsQuery, since, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list published jobs: %w", err)
+	}
+	defer rows.Close()
+
+	var jobs []*Job
+	for rows.Next() {
+		job := &Job{}
+		err = rows.Scan(
+			&job.ID,
+			&job.Title,
+			&job.CompanyName,
+			&job.Location,
+			&job.WorkMode,
+			&job.ExperienceLevel,
+			&job.ApplicationURL,
+			&job.PublishedAt,
+			&job.Technologies,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan published job row: %w", err)
+		}
+		jobs = append(jobs, job)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating published job rows: %w", err)
+	}
+
+	return jobs, nil
+}
@@ -0,0 +1,75 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_ListPublishedSince(t *testing.T) {
+	t.Parallel()
+	since := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	dbError := errors.New("database error")
+	columns := []string{"id", "title", "company_name", "location", "work_mode", "experience_level",
+		"application_url", "published_at", "technologies"}
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, jobs []*Job, err error)
+	}{
+		{
+			name: "published jobs with technologies",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(listPublishedJobsQuery)).
+					WithArgs(since, 300).
+					WillReturnRows(pgxmock.NewRows(columns).AddRow(1, "Go Developer", "Tech Corp", "San José",
+						"Remote", "Senior", "https://techcorp.com/jobs/1", since, []string{"Go", "PostgreSQL"}))
+			},
+			checkResults: func(t *testing.T, jobs []*Job, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, jobs, 1)
+				assert.Equal(t, "Tech Corp", jobs[0].CompanyName)
+				assert.Equal(t, []string{"Go", "PostgreSQL"}, jobs[0].Technologies)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(listPublishedJobsQuery)).
+					WithArgs(since, 300).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ []*Job, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			jobs, err := repo.ListPublishedSince(context.Background(), since, 300)
+			tt.checkResults(t, jobs, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
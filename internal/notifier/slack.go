@@ -0,0 +1,46 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// slackEscaper escapes the characters Slack uses for its formatting
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// SlackChannel posts messages to a Slack incoming webhook
+type SlackChannel struct {
+	webhookURL string
+	client     *http.Client
+}
+
+// NewSlackChannel creates a new instance of SlackChannel
+func NewSlackChannel(webhookURL string) *SlackChannel {
+	return &SlackChannel{webhookURL: webhookURL, client: &http.Client{Timeout: DefaultTimeout}}
+}
+
+// Name returns the channel name used in logs and errors
+func (c *SlackChannel) Name() string {
+	return "slack"
+}
+
+// Send posts a message with one line per job, linking to its application page
+func (c *SlackChannel) Send(ctx context.Context, message *Message) error {
+	return postJSON(ctx, c.client, c.Name(), c.webhookURL, map[string]string{"text": formatSlack(message)})
+}
+
+// formatSlack formats a message with Slack mrkdwn
+func formatSlack(message *Message) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "*%s*", slackEscaper.Replace(message.Title))
+	for _, job := range message.Jobs {
+		fmt.Fprintf(&b, "\n• <%s|%s> at %s", job.ApplicationURL, slackEscaper.Replace(job.Title),
+			slackEscaper.Replace(job.CompanyName))
+		if details := jobDetails(job); details != "" {
+			fmt.Fprintf(&b, " (%s)", slackEscaper.Replace(details))
+		}
+	}
+	return b.String()
+}
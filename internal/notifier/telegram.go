@@ -0,0 +1,62 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"net/http"
+	"strings"
+)
+
+// DefaultTelegramAPIURL is the base URL of the Telegram Bot API
+const DefaultTelegramAPIURL = "https://api.telegram.org"
+
+// TelegramChannel posts messages to a Telegram channel or group through a bot
+// that is a member (an admin for channels) of it
+type TelegramChannel struct {
+	apiURL   string
+	botToken string
+	chatID   string
+	client   *http.Client
+}
+
+// NewTelegramChannel creates a new instance of TelegramChannel. chatID is the numeric ID
+// of the chat or the @username of a public channel.
+func NewTelegramChannel(botToken, chatID string) *TelegramChannel {
+	return &TelegramChannel{
+		apiURL:   DefaultTelegramAPIURL,
+		botToken: botToken,
+		chatID:   chatID,
+		client:   &http.Client{Timeout: DefaultTimeout},
+	}
+}
+
+// Name returns the channel name used in logs and errors
+func (c *TelegramChannel) Name() string {
+	return "telegram"
+}
+
+// Send posts a message with one line per job, linking to its application page
+func (c *TelegramChannel) Send(ctx context.Context, message *Message) error {
+	url := fmt.Sprintf("%s/bot%s/sendMessage", c.apiURL, c.botToken)
+	return postJSON(ctx, c.client, c.Name(), url, map[string]any{
+		"chat_id":                  c.chatID,
+		"text":                     formatTelegram(message),
+		"parse_mode":               "HTML",
+		"disable_web_page_preview": true,
+	})
+}
+
+// formatTelegram formats a message with the HTML subset supported by Telegram
+func formatTelegram(message *Message) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "<b>%s</b>", html.EscapeString(message.Title))
+	for _, job := range message.Jobs {
+		fmt.Fprintf(&b, "\n• <a href=\"%s\">%s</a> at %s", html.EscapeString(job.ApplicationURL),
+			html.EscapeString(job.Title), html.EscapeString(job.CompanyName))
+		if details := jobDetails(job); details != "" {
+			fmt.Fprintf(&b, " (%s)", html.EscapeString(details))
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,217 @@
+// Package opensearch provides an alternative job search backend on OpenSearch (or Elasticsearch),
+// offering typo tolerance and relevance ranking. Jobs are indexed from the job search view and
+// queried through the same jobs.DataRepository interface as the PostgreSQL search.
+package opensearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Defaults for the OpenSearch client
+const (
+	DefaultIndex   = "jobs"
+	DefaultTimeout = 10 * time.Second
+
+	// maxErrorBodySize bounds how much of an error response is kept in the error message
+	maxErrorBodySize = 512
+)
+
+// Config holds the OpenSearch connection settings
+type Config struct {
+	URL      string
+	Index    string
+	Username string
+	Password string
+}
+
+// DefaultConfig returns the default OpenSearch configuration for local development
+func DefaultConfig() Config {
+	return Config{
+		URL:   "http://localhost:9200",
+		Index: DefaultIndex,
+	}
+}
+
+// ResponseError represents an unexpected response from OpenSearch
+type ResponseError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("opensearch responded with status %d: %s", e.StatusCode, e.Body)
+}
+
+// Client is a minimal client of the OpenSearch REST API bound to a single index
+type Client struct {
+	baseURL    string
+	index      string
+	username   string
+	password   string
+	httpClient *http.Client
+}
+
+// NewClient creates a new OpenSearch client
+func NewClient(cfg Config) *Client {
+	index := cfg.Index
+	if index == "" {
+		index = DefaultIndex
+	}
+
+	return &Client{
+		baseURL:    strings.TrimRight(cfg.URL, "/"),
+		index:      index,
+		username:   cfg.Username,
+		password:   cfg.Password,
+		httpClient: &http.Client{Timeout: DefaultTimeout},
+	}
+}
+
+// CreateIndex creates the index with the job mapping. An existing index is left untouched.
+func (c *Client) CreateIndex(ctx context.Context) error {
+	body, err := json.Marshal(indexMapping)
+	if err != nil {
+		return fmt.Errorf("failed to encode index mapping: %w", err)
+	}
+
+	err = c.do(ctx, http.MethodPut, "/"+c.index, "application/json", body, nil)
+	var respErr *ResponseError
+	if err != nil && !(errors.As(err, &respErr) && strings.Contains(respErr.Body, "resource_already_exists")) {
+		return fmt.Errorf("failed to create index %s: %w", c.index, err)
+	}
+	return nil
+}
+
+// DeleteIndex deletes the index. A missing index is not an error.
+func (c *Client) DeleteIndex(ctx context.Context) error {
+	err := c.do(ctx, http.MethodDelete, "/"+c.index, "", nil, nil)
+	var respErr *ResponseError
+	if err != nil && !(errors.As(err, &respErr) && respErr.StatusCode == http.StatusNotFound) {
+		return fmt.Errorf("failed to delete index %s: %w", c.index, err)
+	}
+	return nil
+}
+
+// bulkResponse is the part of a bulk response needed to detect failed items
+type bulkResponse struct {
+	Errors bool `json:"errors"`
+	Items  []map[string]struct {
+		ID     string          `json:"_id"`
+		Status int             `json:"status"`
+		Error  json.RawMessage `json:"error"`
+	} `json:"items"`
+}
+
+// Bulk indexes the documents and deletes the documents with the given IDs in a single request
+func (c *Client) Bulk(ctx context.Context, documents []*Document, deleteIDs []int) error {
+	if len(documents) == 0 && len(deleteIDs) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, doc := range documents {
+		action := map[string]any{"index": map[string]any{"_index": c.index, "_id": doc.JobID}}
+		if err := enc.Encode(action); err != nil {
+			return fmt.Errorf("failed to encode bulk action: %w", err)
+		}
+		if err := enc.Encode(doc); err != nil {
+			return fmt.Errorf("failed to encode document %d: %w", doc.JobID, err)
+		}
+	}
+	for _, id := range deleteIDs {
+		action := map[string]any{"delete": map[string]any{"_index": c.index, "_id": id}}
+		if err := enc.Encode(action); err != nil {
+			return fmt.Errorf("failed to encode bulk action: %w", err)
+		}
+	}
+
+	var resp bulkResponse
+	if err := c.do(ctx, http.MethodPost, "/_bulk", "application/x-ndjson", buf.Bytes(), &resp); err != nil {
+		return fmt.Errorf("failed to bulk index jobs: %w", err)
+	}
+
+	if resp.Errors {
+		for _, item := range resp.Items {
+			for action, result := range item {
+				// Deleting a document that was never indexed is fine
+				if action == "delete" && result.Status == http.StatusNotFound {
+					continue
+				}
+				if result.Status >= http.StatusBadRequest {
+					return fmt.Errorf("failed to %s job %s: %s", action, result.ID, result.Error)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// searchResponse is the part of a search response holding the matching documents
+type searchResponse struct {
+	Hits struct {
+		Total struct {
+			Value int `json:"value"`
+		} `json:"total"`
+		Hits []struct {
+			Source    Document            `json:"_source"`
+			Highlight map[string][]string `json:"highlight"`
+		} `json:"hits"`
+	} `json:"hits"`
+}
+
+// search runs a search request against the index
+func (c *Client) search(ctx context.Context, query map[string]any) (*searchResponse, error) {
+	body, err := json.Marshal(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode search query: %w", err)
+	}
+
+	var resp searchResponse
+	if err = c.do(ctx, http.MethodPost, "/"+c.index+"/_search", "application/json", body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to search jobs: %w", err)
+	}
+	return &resp, nil
+}
+
+// do sends a request and decodes the JSON response into out, when given
+func (c *Client) do(ctx context.Context, method, path, contentType string, body []byte, out any) error {
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return &ResponseError{StatusCode: resp.StatusCode, Body: string(respBody)}
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err = json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package opensearch
+
+import (
+	"strings"
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+)
+
+// Document is a job as stored in the index
+type Document struct {
+	JobID           int      `json:"job_id"`
+	CompanyID       int      `json:"company_id"`
+	CompanyName     string   `json:"company_name"`
+	CompanySlug     string   `json:"company_slug"`
+	CompanyLogoURL  string   `json:"company_logo_url"`
+	Title           string   `json:"title"`
+	Description     string   `json:"description"`
+	ExperienceLevel string   `json:"experience_level"`
+	EmploymentType  string   `json:"employment_type"`
+	Location        string   `json:"location"`
+	WorkMode        string   `json:"work_mode"`
+	Language        string   `json:"language"`
+	ApplicationURL  string   `json:"application_url"`
+	Signature       string   `json:"signature"`
+	Technologies    []string `json:"technologies"`
+	Functions       []string `json:"functions"`
+	Province        string   `json:"province"`
+	Country         string   `json:"country"`
+	// RemoteEligibility and the UTC offsets are omitted when the posting doesn't say
+	RemoteEligibility string    `json:"remote_eligibility,omitempty"`
+	UTCOffsetMin      *int      `json:"utc_offset_min,omitempty"`
+	UTCOffsetMax      *int      `json:"utc_offset_max,omitempty"`
+	Benefits          []string  `json:"benefits"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// bilingualText is the mapping of the text fields analyzed in English and Spanish
+var bilingualText = map[string]any{
+	"type":     "text",
+	"analyzer": "english",
+	"fields":   map[string]any{"es": map[string]any{"type": "text", "analyzer": "spanish"}},
+}
+
+// indexMapping is the mapping of the jobs index. Functions are stored lowercased so the
+// function filter is case insensitive like the PostgreSQL one. Titles and descriptions are
+// also analyzed in Spanish, in their es subfield, since many postings are in Spanish.
+var indexMapping = map[string]any{
+	"mappings": map[string]any{
+		"properties": map[string]any{
+			"job_id":     map[string]any{"type": "integer"},
+			"company_id": map[string]any{"type": "integer"},
+			"company_name": map[string]any{
+				"type":   "text",
+				"fields": map[string]any{"raw": map[string]any{"type": "keyword"}},
+			},
+			"company_slug":       map[string]any{"type": "keyword"},
+			"company_logo_url":   map[string]any{"type": "keyword", "index": false},
+			"title":              bilingualText,
+			"description":        bilingualText,
+			"experience_level":   map[string]any{"type": "keyword"},
+			"employment_type":    map[string]any{"type": "keyword"},
+			"location":           map[string]any{"type": "keyword"},
+			"work_mode":          map[string]any{"type": "keyword"},
+			"language":           map[string]any{"type": "keyword"},
+			"application_url":    map[string]any{"type": "keyword", "index": false},
+			"signature":          map[string]any{"type": "keyword"},
+			"technologies":       map[string]any{"type": "text"},
+			"functions":          map[string]any{"type": "keyword"},
+			"province":           map[string]any{"type": "keyword"},
+			"country":            map[string]any{"type": "keyword"},
+			"remote_eligibility": map[string]any{"type": "keyword"},
+			"utc_offset_min":     map[string]any{"type": "byte"},
+			"utc_offset_max":     map[string]any{"type": "byte"},
+			"benefits":           map[string]any{"type": "keyword"},
+			"created_at":         map[string]any{"type": "date"},
+			"updated_at":         map[string]any{"type": "date"},
+		},
+	},
+}
+
+// NewDocument converts a job search document to its indexed form
+func NewDocument(doc *jobs.SearchDocument) *Document {
+	functions := make([]string, len(doc.Functions))
+	for i, function := range doc.Functions {
+		functions[i] = strings.ToLower(function)
+	}
+	var remoteEligibility string
+	if doc.RemoteEligibility != nil {
+		remoteEligibility = string(*doc.RemoteEligibility)
+	}
+
+	return &Document{
+		JobID:             doc.ID,
+		CompanyID:         doc.CompanyID,
+		CompanyName:       doc.CompanyName,
+		CompanySlug:       doc.CompanySlug,
+		CompanyLogoURL:    doc.CompanyLogoURL,
+		Title:             doc.Title,
+		Description:       doc.Description,
+		ExperienceLevel:   doc.ExperienceLevel,
+		EmploymentType:    doc.EmploymentType,
+		Location:          doc.Location,
+		WorkMode:          doc.WorkMode,
+		Language:          string(doc.Language),
+		ApplicationURL:    doc.ApplicationURL,
+		Signature:         doc.Signature,
+		Technologies:      doc.Technologies,
+		Functions:         functions,
+		Province:          doc.Province,
+		Country:           doc.Country,
+		RemoteEligibility: remoteEligibility,
+		UTCOffsetMin:      doc.UTCOffsetMin,
+		UTCOffsetMax:      doc.UTCOffsetMax,
+		Benefits:          doc.Benefits,
+		CreatedAt:         doc.CreatedAt,
+		UpdatedAt:         doc.UpdatedAt,
+	}
+}
+
+// toJobWithCompany converts an indexed document back to the job model returned by the search
+func (d *Document) toJobWithCompany() *jobs.JobWithCompany {
+	var remoteEligibility *jobs.RemoteEligibility
+	if d.RemoteEligibility != "" {
+		eligibility := jobs.RemoteEligibility(d.RemoteEligibility)
+		remoteEligibility = &eligibility
+	}
+
+	return &jobs.JobWithCompany{
+		Job: jobs.Job{
+			ID:                d.JobID,
+			CompanyID:         d.CompanyID,
+			Title:             d.Title,
+			Description:       d.Description,
+			ExperienceLevel:   d.ExperienceLevel,
+			EmploymentType:    d.EmploymentType,
+			Location:          d.Location,
+			WorkMode:          d.WorkMode,
+			Language:          jobs.Language(d.Language),
+			ApplicationURL:    d.ApplicationURL,
+			IsActive:          true,
+			Signature:         d.Signature,
+			RemoteEligibility: remoteEligibility,
+			UTCOffsetMin:      d.UTCOffsetMin,
+			UTCOffsetMax:      d.UTCOffsetMax,
+			CreatedAt:         d.CreatedAt,
+			UpdatedAt:         d.UpdatedAt,
+		},
+		CompanyName:    d.CompanyName,
+		CompanySlug:    d.CompanySlug,
+		CompanyLogoURL: d.CompanyLogoURL,
+		Benefits:       d.Benefits,
+	}
+}
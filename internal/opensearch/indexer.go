@@ -0,0 +1,102 @@
+package opensearch
+
+import (
+	"context"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+)
+
+// bulkBatchSize is the number of documents sent per bulk request
+const bulkBatchSize = 500
+
+// DocumentSource interface to load the jobs to index.
+// It is implemented by jobs.Repository.
+type DocumentSource interface {
+	ListSearchDocuments(ctx context.Context, ids []int) ([]*jobs.SearchDocument, error)
+}
+
+// Indexer keeps the index in sync with the job search view
+type Indexer struct {
+	client *Client
+	source DocumentSource
+}
+
+// NewIndexer creates a new Indexer instance
+func NewIndexer(client *Client, source DocumentSource) *Indexer {
+	return &Indexer{client: client, source: source}
+}
+
+// IndexJobs indexes the given jobs. Jobs that are no longer searchable (deleted or inactive)
+// are removed from the index.
+func (i *Indexer) IndexJobs(ctx context.Context, jobIDs []int) error {
+	if len(jobIDs) == 0 {
+		return nil
+	}
+
+	if err := i.client.CreateIndex(ctx); err != nil {
+		return err
+	}
+
+	documents, err := i.source.ListSearchDocuments(ctx, jobIDs)
+	if err != nil {
+		return err
+	}
+
+	found := make(map[int]bool, len(documents))
+	for _, doc := range documents {
+		found[doc.ID] = true
+	}
+	var deleteIDs []int
+	for _, id := range jobIDs {
+		if !found[id] {
+			deleteIDs = append(deleteIDs, id)
+		}
+	}
+
+	return i.bulk(ctx, documents, deleteIDs)
+}
+
+// Reindex rebuilds the index from scratch with all searchable jobs and returns how many were indexed.
+// Searches find no jobs while the index is rebuilt.
+func (i *Indexer) Reindex(ctx context.Context) (int, error) {
+	documents, err := i.source.ListSearchDocuments(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = i.client.DeleteIndex(ctx); err != nil {
+		return 0, err
+	}
+	if err = i.client.CreateIndex(ctx); err != nil {
+		return 0, err
+	}
+
+	if err = i.bulk(ctx, documents, nil); err != nil {
+		return 0, err
+	}
+	return len(documents), nil
+}
+
+// bulk sends the documents to index and the IDs to delete in batches
+func (i *Indexer) bulk(ctx context.Context, documents []*jobs.SearchDocument, deleteIDs []int) error {
+	for start := 0; start < len(documents); start += bulkBatchSize {
+		end := min(start+bulkBatchSize, len(documents))
+
+		batch := make([]*Document, 0, end-start)
+		for _, doc := range documents[start:end] {
+			batch = append(batch, NewDocument(doc))
+		}
+		if err := i.client.Bulk(ctx, batch, nil); err != nil {
+			return err
+		}
+	}
+
+	for start := 0; start < len(deleteIDs); start += bulkBatchSize {
+		end := min(start+bulkBatchSize, len(deleteIDs))
+		if err := i.client.Bulk(ctx, nil, deleteIDs[start:end]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
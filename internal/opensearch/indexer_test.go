@@ -0,0 +1,129 @@
+package opensearch
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+)
+
+// fakeDocumentSource returns the documents of the requested jobs it knows about
+type fakeDocumentSource struct {
+	documents []*jobs.SearchDocument
+}
+
+func (s *fakeDocumentSource) ListSearchDocuments(_ context.Context, ids []int) ([]*jobs.SearchDocument, error) {
+	var documents []*jobs.SearchDocument
+	for _, doc := range s.documents {
+		for _, id := range ids {
+			if doc.ID == id {
+				documents = append(documents, doc)
+			}
+		}
+	}
+	if ids == nil {
+		documents = s.documents
+	}
+	return documents, nil
+}
+
+// bulkRecorder records the bulk actions received by a fake OpenSearch server
+type bulkRecorder struct {
+	mu      sync.Mutex
+	actions []string
+	methods []string
+}
+
+func (b *bulkRecorder) handler(t *testing.T) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.methods = append(b.methods, r.Method+" "+r.URL.Path)
+
+		if r.URL.Path != "/_bulk" {
+			_, _ = w.Write([]byte(`{}`))
+			return
+		}
+
+		scanner := bufio.NewScanner(r.Body)
+		scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			var line map[string]map[string]any
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+			for action, meta := range line {
+				if action == "index" || action == "delete" {
+					b.actions = append(b.actions, action+":"+jsonNumber(meta["_id"]))
+					if action == "index" {
+						scanner.Scan() // Skip the document line
+					}
+				}
+			}
+		}
+		_, _ = w.Write([]byte(`{"errors": false, "items": []}`))
+	}
+}
+
+func jsonNumber(v any) string {
+	data, _ := json.Marshal(v)
+	return string(data)
+}
+
+func TestIndexer_IndexJobs(t *testing.T) {
+	t.Parallel()
+	recorder := &bulkRecorder{}
+	server := httptest.NewServer(recorder.handler(t))
+	defer server.Close()
+
+	source := &fakeDocumentSource{documents: []*jobs.SearchDocument{
+		{JobWithCompany: jobs.JobWithCompany{Job: jobs.Job{ID: 1, Title: "Go Developer"}}, Functions: []string{"Backend"}},
+	}}
+	indexer := NewIndexer(NewClient(Config{URL: server.URL}), source)
+
+	// Job 2 is no longer searchable, so it is removed from the index
+	require.NoError(t, indexer.IndexJobs(context.Background(), []int{1, 2}))
+	assert.Equal(t, []string{"index:1", "delete:2"}, recorder.actions)
+	assert.Equal(t, "PUT /jobs", recorder.methods[0])
+}
+
+func TestIndexer_Reindex(t *testing.T) {
+	t.Parallel()
+	recorder := &bulkRecorder{}
+	server := httptest.NewServer(recorder.handler(t))
+	defer server.Close()
+
+	source := &fakeDocumentSource{documents: []*jobs.SearchDocument{
+		{JobWithCompany: jobs.JobWithCompany{Job: jobs.Job{ID: 1}}},
+		{JobWithCompany: jobs.JobWithCompany{Job: jobs.Job{ID: 2}}},
+	}}
+	indexer := NewIndexer(NewClient(Config{URL: server.URL, Index: "jobs-test"}), source)
+
+	indexed, err := indexer.Reindex(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, indexed)
+	assert.Equal(t, []string{"DELETE /jobs-test", "PUT /jobs-test", "POST /_bulk"}, recorder.methods)
+	assert.Equal(t, []string{"index:1", "index:2"}, recorder.actions)
+}
+
+func TestNewDocument(t *testing.T) {
+	t.Parallel()
+	doc := NewDocument(&jobs.SearchDocument{
+		JobWithCompany: jobs.JobWithCompany{Job: jobs.Job{ID: 3, Title: "QA"}, CompanySlug: "tech-corp"},
+		Technologies:   []string{"selenium"},
+		Functions:      []string{"QA", "Backend"},
+		Country:        "CR",
+	})
+
+	assert.Equal(t, 3, doc.JobID)
+	assert.Equal(t, "tech-corp", doc.CompanySlug)
+	assert.Equal(t, []string{"qa", "backend"}, doc.Functions)
+	assert.Equal(t, "CR", doc.Country)
+}
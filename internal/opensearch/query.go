@@ -0,0 +1,132 @@
+package opensearch
+
+import (
+	"strings"
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+)
+
+// searchFields are the fields matched by the search query, with their boosts
+var searchFields = []string{"title^3", "title.es^3", "technologies^2", "company_name^2", "description", "description.es"}
+
+// highlightField are the settings of the highlighted description snippets, like the ones of the
+// PostgreSQL search
+var highlightField = map[string]any{"number_of_fragments": 2, "fragment_size": 150}
+
+// highlightSeparator separates the highlighted snippets of a description
+const highlightSeparator = " ... "
+
+// wildcardEscaper escapes the wildcard characters of user input
+var wildcardEscaper = strings.NewReplacer(`\`, `\\`, `*`, `\*`, `?`, `\?`)
+
+// buildSearchQuery translates job search parameters to an OpenSearch query. Text matches tolerate
+// typos and are ranked by relevance, then by posting date, or by posting date alone when sorted by
+// date. The recency and featured weights of the PostgreSQL ranking don't apply.
+func buildSearchQuery(params *jobs.SearchParams) map[string]any {
+	filters := []map[string]any{}
+	if params.Country != "" {
+		filters = append(filters, map[string]any{"term": map[string]any{"country": params.Country}})
+	}
+
+	termFilters := []struct {
+		field string
+		value *string
+	}{
+		{"experience_level", params.ExperienceLevel},
+		{"employment_type", params.EmploymentType},
+		{"location", params.Location},
+		{"province", params.Province},
+		{"work_mode", params.WorkMode},
+		{"language", params.Language},
+		{"remote_eligibility", params.RemoteEligibility},
+	}
+	for _, f := range termFilters {
+		if f.value != nil {
+			filters = append(filters, map[string]any{"term": map[string]any{f.field: *f.value}})
+		}
+	}
+
+	if params.Company != nil {
+		filters = append(filters, map[string]any{"wildcard": map[string]any{
+			"company_name.raw": map[string]any{
+				"value":            "*" + wildcardEscaper.Replace(*params.Company) + "*",
+				"case_insensitive": true,
+			},
+		}})
+	}
+
+	if params.Function != nil {
+		filters = append(filters, map[string]any{"term": map[string]any{"functions": strings.ToLower(*params.Function)}})
+	}
+
+	// Jobs must offer every benefit
+	for _, benefit := range params.Benefits {
+		filters = append(filters, map[string]any{"term": map[string]any{"benefits": benefit}})
+	}
+
+	if params.UTCOffset != nil {
+		filters = append(filters,
+			map[string]any{"range": map[string]any{"utc_offset_min": map[string]any{"lte": *params.UTCOffset}}},
+			map[string]any{"range": map[string]any{"utc_offset_max": map[string]any{"gte": *params.UTCOffset}}})
+	}
+
+	if params.DateFrom != nil || params.DateTo != nil {
+		dateRange := map[string]any{}
+		if params.DateFrom != nil {
+			dateRange["gte"] = params.DateFrom.Format(time.RFC3339)
+		}
+		if params.DateTo != nil {
+			dateRange["lte"] = params.DateTo.Format(time.RFC3339)
+		}
+		filters = append(filters, map[string]any{"range": map[string]any{"created_at": dateRange}})
+	}
+
+	match := textMatch(params.Query)
+	if params.SynonymQuery != "" {
+		// Jobs match the query or its synonym query
+		match = map[string]any{"bool": map[string]any{
+			"should":               []map[string]any{match, textMatch(params.SynonymQuery)},
+			"minimum_should_match": 1,
+		}}
+	}
+
+	query := map[string]any{
+		"from":             params.Offset,
+		"size":             params.Limit,
+		"track_total_hits": true,
+		"query": map[string]any{
+			"bool": map[string]any{
+				"must":   []map[string]any{match},
+				"filter": filters,
+			},
+		},
+		"sort": []any{"_score", map[string]any{"created_at": "desc"}},
+	}
+	if params.Sort == jobs.SortDate {
+		query["sort"] = []any{map[string]any{"created_at": "desc"}}
+	}
+	if params.Highlight {
+		query["highlight"] = map[string]any{
+			"pre_tags":  []string{"<mark>"},
+			"post_tags": []string{"</mark>"},
+			"fields": map[string]any{
+				"description":    highlightField,
+				"description.es": highlightField,
+			},
+		}
+	}
+	return query
+}
+
+// textMatch matches the jobs containing all the terms of a query in the search fields
+func textMatch(query string) map[string]any {
+	return map[string]any{
+		"multi_match": map[string]any{
+			"query":     strings.TrimSpace(query),
+			"fields":    searchFields,
+			"fuzziness": "AUTO",
+			"operator":  "and",
+		},
+	}
+}
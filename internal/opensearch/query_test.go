@@ -0,0 +1,166 @@
+package opensearch
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+)
+
+func TestBuildSearchQuery(t *testing.T) {
+	t.Parallel()
+	workMode := "Remote"
+	company := "Tech*Corp"
+	function := "Backend"
+	province := "Heredia"
+	language := "es"
+	remoteEligibility := "LATAM only"
+	utcOffset := -6
+	dateFrom := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	dateTo := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		params   *jobs.SearchParams
+		expected string
+	}{
+		{
+			name:   "query only",
+			params: &jobs.SearchParams{Query: " golang ", Limit: 20},
+			expected: `{
+				"from": 0, "size": 20, "track_total_hits": true,
+				"query": {"bool": {
+					"must": [{"multi_match": {
+						"query": "golang",
+						"fields": ["title^3", "title.es^3", "technologies^2", "company_name^2", "description", "description.es"],
+						"fuzziness": "AUTO", "operator": "and"
+					}}],
+					"filter": []
+				}},
+				"sort": ["_score", {"created_at": "desc"}]
+			}`,
+		},
+		{
+			name:   "sorted by date",
+			params: &jobs.SearchParams{Query: "golang", Limit: 20, Sort: jobs.SortDate},
+			expected: `{
+				"from": 0, "size": 20, "track_total_hits": true,
+				"query": {"bool": {
+					"must": [{"multi_match": {
+						"query": "golang",
+						"fields": ["title^3", "title.es^3", "technologies^2", "company_name^2", "description", "description.es"],
+						"fuzziness": "AUTO", "operator": "and"
+					}}],
+					"filter": []
+				}},
+				"sort": [{"created_at": "desc"}]
+			}`,
+		},
+		{
+			name:   "with highlights",
+			params: &jobs.SearchParams{Query: "golang", Limit: 20, Highlight: true},
+			expected: `{
+				"from": 0, "size": 20, "track_total_hits": true,
+				"query": {"bool": {
+					"must": [{"multi_match": {
+						"query": "golang",
+						"fields": ["title^3", "title.es^3", "technologies^2", "company_name^2", "description", "description.es"],
+						"fuzziness": "AUTO", "operator": "and"
+					}}],
+					"filter": []
+				}},
+				"sort": ["_score", {"created_at": "desc"}],
+				"highlight": {
+					"pre_tags": ["<mark>"], "post_tags": ["</mark>"],
+					"fields": {
+						"description": {"number_of_fragments": 2, "fragment_size": 150},
+						"description.es": {"number_of_fragments": 2, "fragment_size": 150}
+					}
+				}
+			}`,
+		},
+		{
+			name:   "with synonym query",
+			params: &jobs.SearchParams{Query: "qa", SynonymQuery: "quality assurance", Limit: 20},
+			expected: `{
+				"from": 0, "size": 20, "track_total_hits": true,
+				"query": {"bool": {
+					"must": [{"bool": {
+						"should": [
+							{"multi_match": {
+								"query": "qa",
+								"fields": ["title^3", "title.es^3", "technologies^2", "company_name^2", "description", "description.es"],
+								"fuzziness": "AUTO", "operator": "and"
+							}},
+							{"multi_match": {
+								"query": "quality assurance",
+								"fields": ["title^3", "title.es^3", "technologies^2", "company_name^2", "description", "description.es"],
+								"fuzziness": "AUTO", "operator": "and"
+							}}
+						],
+						"minimum_should_match": 1
+					}}],
+					"filter": []
+				}},
+				"sort": ["_score", {"created_at": "desc"}]
+			}`,
+		},
+		{
+			name: "with filters",
+			params: &jobs.SearchParams{
+				Query:             "golang",
+				Limit:             10,
+				Offset:            30,
+				Province:          &province,
+				WorkMode:          &workMode,
+				Company:           &company,
+				Function:          &function,
+				Language:          &language,
+				RemoteEligibility: &remoteEligibility,
+				UTCOffset:         &utcOffset,
+				Benefits:          []string{"health-insurance", "stock-options"},
+				DateFrom:          &dateFrom,
+				DateTo:            &dateTo,
+				Country:           "CR",
+			},
+			expected: `{
+				"from": 30, "size": 10, "track_total_hits": true,
+				"query": {"bool": {
+					"must": [{"multi_match": {
+						"query": "golang",
+						"fields": ["title^3", "title.es^3", "technologies^2", "company_name^2", "description", "description.es"],
+						"fuzziness": "AUTO", "operator": "and"
+					}}],
+					"filter": [
+						{"term": {"country": "CR"}},
+						{"term": {"province": "Heredia"}},
+						{"term": {"work_mode": "Remote"}},
+						{"term": {"language": "es"}},
+						{"term": {"remote_eligibility": "LATAM only"}},
+						{"wildcard": {"company_name.raw": {"value": "*Tech\\*Corp*", "case_insensitive": true}}},
+						{"term": {"functions": "backend"}},
+						{"term": {"benefits": "health-insurance"}},
+						{"term": {"benefits": "stock-options"}},
+						{"range": {"utc_offset_min": {"lte": -6}}},
+						{"range": {"utc_offset_max": {"gte": -6}}},
+						{"range": {"created_at": {"gte": "2024-01-01T00:00:00Z", "lte": "2024-12-31T00:00:00Z"}}}
+					]
+				}},
+				"sort": ["_score", {"created_at": "desc"}]
+			}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			query, err := json.Marshal(buildSearchQuery(tt.params))
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.expected, string(query))
+		})
+	}
+}
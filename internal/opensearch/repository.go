@@ -0,0 +1,57 @@
+package opensearch
+
+import (
+	"context"
+	"strings"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobtech"
+)
+
+// TechnologyRepository interface to load the technologies of the jobs found.
+// It is implemented by jobtech.Repository.
+type TechnologyRepository interface {
+	GetJobTechnologiesBatch(ctx context.Context, jobIDs []int) (map[int][]*jobtech.JobTechnologyWithDetails, error)
+}
+
+// SearchRepository implements jobs.DataRepository on OpenSearch. Technologies are still read
+// from the database so the response matches the PostgreSQL backend.
+type SearchRepository struct {
+	client      *Client
+	jobtechRepo TechnologyRepository
+}
+
+// NewSearchRepository creates a new SearchRepository instance
+func NewSearchRepository(client *Client, jobtechRepo TechnologyRepository) *SearchRepository {
+	return &SearchRepository{client: client, jobtechRepo: jobtechRepo}
+}
+
+// SearchJobsWithCount searches the index and returns the page of jobs and the total number of matches
+func (r *SearchRepository) SearchJobsWithCount(ctx context.Context, params *jobs.SearchParams) (
+	[]*jobs.JobWithCompany, int, error) {
+	resp, err := r.client.search(ctx, buildSearchQuery(params))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	results := make([]*jobs.JobWithCompany, 0, len(resp.Hits.Hits))
+	for i := range resp.Hits.Hits {
+		job := resp.Hits.Hits[i].Source.toJobWithCompany()
+		// The description is analyzed in English and Spanish, the snippets of either are used
+		for _, field := range []string{"description", "description.es"} {
+			if snippets := resp.Hits.Hits[i].Highlight[field]; len(snippets) > 0 {
+				job.Highlight = strings.Join(snippets, highlightSeparator)
+				break
+			}
+		}
+		results = append(results, job)
+	}
+
+	return results, resp.Hits.Total.Value, nil
+}
+
+// GetJobTechnologiesBatch delegates to the jobtech repository
+func (r *SearchRepository) GetJobTechnologiesBatch(ctx context.Context, jobIDs []int) (
+	map[int][]*jobtech.JobTechnologyWithDetails, error) {
+	return r.jobtechRepo.GetJobTechnologiesBatch(ctx, jobIDs)
+}
@@ -0,0 +1,100 @@
+package opensearch
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobtech"
+)
+
+// fakeTechnologyRepository returns no technologies
+type fakeTechnologyRepository struct{}
+
+func (fakeTechnologyRepository) GetJobTechnologiesBatch(_ context.Context, _ []int) (
+	map[int][]*jobtech.JobTechnologyWithDetails, error) {
+	return map[int][]*jobtech.JobTechnologyWithDetails{}, nil
+}
+
+func TestSearchRepository_SearchJobsWithCount(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		handler      http.HandlerFunc
+		checkResults func(t *testing.T, results []*jobs.JobWithCompany, total int, err error)
+	}{
+		{
+			name: "jobs found",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/jobs/_search", r.URL.Path)
+				user, password, ok := r.BasicAuth()
+				assert.True(t, ok)
+				assert.Equal(t, "admin", user)
+				assert.Equal(t, "secret", password)
+
+				_, _ = w.Write([]byte(`{"hits": {"total": {"value": 42}, "hits": [
+					{"_source": {"job_id": 7, "company_name": "Tech Corp", "company_slug": "tech-corp",
+					             "title": "Go Developer", "created_at": "2024-05-01T10:00:00Z"}}
+				]}}`))
+			},
+			checkResults: func(t *testing.T, results []*jobs.JobWithCompany, total int, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 42, total)
+				require.Len(t, results, 1)
+				assert.Equal(t, 7, results[0].ID)
+				assert.Equal(t, "tech-corp", results[0].CompanySlug)
+				assert.True(t, results[0].IsActive)
+			},
+		},
+		{
+			name: "highlighted jobs found",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"hits": {"total": {"value": 1}, "hits": [
+					{"_source": {"job_id": 8, "title": "Desarrollador Go", "created_at": "2024-05-01T10:00:00Z"},
+					 "highlight": {"description.es": ["Servicios en <mark>golang</mark>", "equipo <mark>golang</mark>"]}}
+				]}}`))
+			},
+			checkResults: func(t *testing.T, results []*jobs.JobWithCompany, _ int, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, results, 1)
+				assert.Equal(t, "Servicios en <mark>golang</mark> ... equipo <mark>golang</mark>", results[0].Highlight)
+			},
+		},
+		{
+			name: "error response",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				_, _ = w.Write([]byte(`{"error": "cluster unavailable"}`))
+			},
+			checkResults: func(t *testing.T, _ []*jobs.JobWithCompany, _ int, err error) {
+				t.Helper()
+				var respErr *ResponseError
+				require.ErrorAs(t, err, &respErr)
+				assert.Equal(t, http.StatusServiceUnavailable, respErr.StatusCode)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(tt.handler)
+			defer server.Close()
+
+			client := NewClient(Config{URL: server.URL, Username: "admin", Password: "secret"})
+			repo := NewSearchRepository(client, fakeTechnologyRepository{})
+
+			results, total, err := repo.SearchJobsWithCount(context.Background(),
+				&jobs.SearchParams{Query: "golang", Limit: 20})
+			tt.checkResults(t, results, total, err)
+		})
+	}
+}
@@ -0,0 +1,16 @@
+// Package outbox publishes the domain events recorded in the outbox table. Database triggers record
+// the events in the same transaction as the change they describe, and the Relay publishes them to the
+// events bus, retrying with exponential backoff until every subscriber accepts them. Events are
+// delivered at least once, subscribers may see an event again after a failed attempt.
+package outbox
+
+import "fmt"
+
+// UnknownEventError represents an outbox message of an event type the relay doesn't know
+type UnknownEventError struct {
+	Type string
+}
+
+func (e UnknownEventError) Error() string {
+	return fmt.Sprintf("unknown outbox event type %q", e.Type)
+}
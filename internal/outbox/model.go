@@ -0,0 +1,138 @@
+package outbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/events"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+	"github.com/rodruizronald/ticos-in-tech/internal/technology"
+)
+
+// Message is a domain event recorded in the outbox, waiting to be published
+type Message struct {
+	ID          int64  `db:"id"`
+	EventType   string `db:"event_type"`
+	AggregateID int    `db:"aggregate_id"`
+	// Payload is the changed row as JSON, as it was once changed
+	Payload       []byte     `db:"payload"`
+	Attempts      int        `db:"attempts"`
+	NextAttemptAt time.Time  `db:"next_attempt_at"`
+	LastError     string     `db:"last_error"`
+	CreatedAt     time.Time  `db:"created_at"`
+	PublishedAt   *time.Time `db:"published_at"`
+}
+
+// Event decodes the message into the domain event it records
+func (m *Message) Event() (*events.Event, error) {
+	event := &events.Event{Type: m.EventType, OccurredAt: m.CreatedAt}
+	switch {
+	case strings.HasPrefix(m.EventType, "job."):
+		var row jobRow
+		if err := json.Unmarshal(m.Payload, &row); err != nil {
+			return nil, fmt.Errorf("failed to decode %s event payload: %w", m.EventType, err)
+		}
+		event.Job = row.toJob()
+	case m.EventType == events.TechnologyAdded:
+		var row technologyRow
+		if err := json.Unmarshal(m.Payload, &row); err != nil {
+			return nil, fmt.Errorf("failed to decode %s event payload: %w", m.EventType, err)
+		}
+		event.Technology = row.toTechnology()
+	default:
+		return nil, &UnknownEventError{Type: m.EventType}
+	}
+	return event, nil
+}
+
+// timestamp is a TIMESTAMP column encoded by Postgres to JSON, without a time zone. The columns hold UTC times.
+type timestamp struct {
+	time.Time
+}
+
+// timestampLayout is the JSON encoding of the TIMESTAMP columns, fractional seconds are optional
+const timestampLayout = "2006-01-02T15:04:05.999999999"
+
+// UnmarshalJSON decodes a JSON encoded TIMESTAMP column
+func (t *timestamp) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	parsed, err := time.ParseInLocation(timestampLayout, value, time.UTC)
+	if err != nil {
+		return err
+	}
+	t.Time = parsed
+	return nil
+}
+
+// jobRow is the row of the jobs table recorded with the job events
+type jobRow struct {
+	ID                int                     `json:"id"`
+	CompanyID         int                     `json:"company_id"`
+	Title             string                  `json:"title"`
+	Description       string                  `json:"description"`
+	ExperienceLevel   string                  `json:"experience_level"`
+	EmploymentType    string                  `json:"employment_type"`
+	Location          string                  `json:"location"`
+	WorkMode          string                  `json:"work_mode"`
+	ApplicationURL    string                  `json:"application_url"`
+	IsActive          bool                    `json:"is_active"`
+	Signature         string                  `json:"signature"`
+	Language          jobs.Language           `json:"language"`
+	Status            jobs.Status             `json:"status"`
+	RemoteEligibility *jobs.RemoteEligibility `json:"remote_eligibility"`
+	UTCOffsetMin      *int                    `json:"utc_offset_min"`
+	UTCOffsetMax      *int                    `json:"utc_offset_max"`
+	LocationID        *int                    `json:"location_id"`
+	CreatedAt         timestamp               `json:"created_at"`
+	UpdatedAt         timestamp               `json:"updated_at"`
+}
+
+// toJob converts the row to a job
+func (r *jobRow) toJob() *jobs.Job {
+	return &jobs.Job{
+		ID:                r.ID,
+		CompanyID:         r.CompanyID,
+		Title:             r.Title,
+		Description:       r.Description,
+		ExperienceLevel:   r.ExperienceLevel,
+		EmploymentType:    r.EmploymentType,
+		Location:          r.Location,
+		WorkMode:          r.WorkMode,
+		ApplicationURL:    r.ApplicationURL,
+		IsActive:          r.IsActive,
+		Signature:         r.Signature,
+		Language:          r.Language,
+		Status:            r.Status,
+		RemoteEligibility: r.RemoteEligibility,
+		UTCOffsetMin:      r.UTCOffsetMin,
+		UTCOffsetMax:      r.UTCOffsetMax,
+		LocationID:        r.LocationID,
+		CreatedAt:         r.CreatedAt.Time,
+		UpdatedAt:         r.UpdatedAt.Time,
+	}
+}
+
+// technologyRow is the row of the technologies table recorded with the technology events
+type technologyRow struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Category  string    `json:"category"`
+	ParentID  *int      `json:"parent_id"`
+	CreatedAt timestamp `json:"created_at"`
+}
+
+// toTechnology converts the row to a technology
+func (r *technologyRow) toTechnology() *technology.Technology {
+	return &technology.Technology{
+		ID:        r.ID,
+		Name:      r.Name,
+		Category:  r.Category,
+		ParentID:  r.ParentID,
+		CreatedAt: r.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,111 @@
+package outbox
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/events"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+	"github.com/rodruizronald/ticos-in-tech/internal/technology"
+)
+
+func TestMessage_Event(t *testing.T) {
+	t.Parallel()
+	recordedAt := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	eligibility := jobs.RemoteEligibilityLATAM
+	offsetMin, offsetMax, locationID := -6, -3, 1
+
+	tests := []struct {
+		name         string
+		message      *Message
+		checkResults func(t *testing.T, event *events.Event, err error)
+	}{
+		{
+			name: "job event",
+			message: &Message{
+				ID: 1, EventType: events.JobCreated, AggregateID: 7, CreatedAt: recordedAt,
+				Payload: []byte(`{"id": 7, "company_id": 3, "title": "Go Developer", "description": "Build APIs",
+					"experience_level": "Senior", "employment_type": "Full-time", "location": "Costa Rica",
+					"work_mode": "Remote", "application_url": "https://techcorp.example.com/jobs/7",
+					"is_active": true, "signature": "a1b2c3", "language": "en", "status": "published",
+					"rejection_reason": "", "reviewed_at": null, "remote_eligibility": "LATAM only",
+					"utc_offset_min": -6, "utc_offset_max": -3, "location_id": 1,
+					"created_at": "2024-01-15T09:59:58.123456", "updated_at": "2024-01-15T09:59:59"}`),
+			},
+			checkResults: func(t *testing.T, event *events.Event, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, events.JobCreated, event.Type)
+				assert.Equal(t, recordedAt, event.OccurredAt)
+				assert.Nil(t, event.Technology)
+				assert.Equal(t, &jobs.Job{
+					ID:                7,
+					CompanyID:         3,
+					Title:             "Go Developer",
+					Description:       "Build APIs",
+					ExperienceLevel:   "Senior",
+					EmploymentType:    "Full-time",
+					Location:          "Costa Rica",
+					WorkMode:          "Remote",
+					ApplicationURL:    "https://techcorp.example.com/jobs/7",
+					IsActive:          true,
+					Signature:         "a1b2c3",
+					Language:          jobs.LanguageEnglish,
+					Status:            jobs.StatusPublished,
+					RemoteEligibility: &eligibility,
+					UTCOffsetMin:      &offsetMin,
+					UTCOffsetMax:      &offsetMax,
+					LocationID:        &locationID,
+					CreatedAt:         time.Date(2024, 1, 15, 9, 59, 58, 123456000, time.UTC),
+					UpdatedAt:         time.Date(2024, 1, 15, 9, 59, 59, 0, time.UTC),
+				}, event.Job)
+			},
+		},
+		{
+			name: "technology event",
+			message: &Message{
+				ID: 2, EventType: events.TechnologyAdded, AggregateID: 9, CreatedAt: recordedAt,
+				Payload: []byte(`{"id": 9, "name": "htmx", "category": "Frontend", "parent_id": null,
+					"created_at": "2024-01-15T10:00:00"}`),
+			},
+			checkResults: func(t *testing.T, event *events.Event, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Nil(t, event.Job)
+				assert.Equal(t, &technology.Technology{ID: 9, Name: "htmx", Category: "Frontend", CreatedAt: recordedAt},
+					event.Technology)
+			},
+		},
+		{
+			name:    "invalid payload",
+			message: &Message{ID: 3, EventType: events.JobUpdated, Payload: []byte(`{"created_at": "yesterday"}`)},
+			checkResults: func(t *testing.T, event *events.Event, err error) {
+				t.Helper()
+				require.ErrorContains(t, err, "failed to decode job.updated event payload")
+				assert.Nil(t, event)
+			},
+		},
+		{
+			name:    "unknown event type",
+			message: &Message{ID: 4, EventType: "company.renamed", Payload: []byte(`{}`)},
+			checkResults: func(t *testing.T, event *events.Event, err error) {
+				t.Helper()
+				var unknownErr *UnknownEventError
+				require.ErrorAs(t, err, &unknownErr)
+				assert.Equal(t, "company.renamed", unknownErr.Type)
+				assert.Nil(t, event)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			event, err := tt.message.Event()
+			tt.checkResults(t, event, err)
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/events"
+)
+
+// Defaults for the relay
+const (
+	DefaultPollInterval = 5 * time.Second
+	DefaultBatchSize    = 100
+	DefaultLease        = 2 * time.Minute
+	DefaultBaseBackoff  = 10 * time.Second
+	DefaultMaxBackoff   = time.Hour
+	// DefaultRetention is how long the published messages are kept
+	DefaultRetention = 7 * 24 * time.Hour
+)
+
+// Store interface to claim the outbox messages and record their publication.
+type Store interface {
+	ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*Message, error)
+	MarkPublished(ctx context.Context, id int64) error
+	MarkFailed(ctx context.Context, id int64, lastError string, nextAttemptAt time.Time) error
+}
+
+// Publisher interface to deliver the domain events to their subscribers.
+// It is implemented by events.Bus.
+type Publisher interface {
+	Publish(ctx context.Context, event *events.Event) error
+}
+
+// RelayConfig configures how the messages are published and retried
+type RelayConfig struct {
+	// BatchSize is the number of messages claimed at once
+	BatchSize int
+	// Lease is how long the claimed messages are reserved for the relay publishing them
+	Lease time.Duration
+	// BaseBackoff is the wait before the first retry, doubled on every following retry
+	BaseBackoff time.Duration
+	// MaxBackoff caps the wait between retries
+	MaxBackoff time.Duration
+}
+
+// DefaultRelayConfig returns the default relay configuration
+func DefaultRelayConfig() RelayConfig {
+	return RelayConfig{
+		BatchSize:   DefaultBatchSize,
+		Lease:       DefaultLease,
+		BaseBackoff: DefaultBaseBackoff,
+		MaxBackoff:  DefaultMaxBackoff,
+	}
+}
+
+// Relay publishes the outbox messages in the order they were recorded. A message failing to publish
+// is retried with exponential backoff, and holds back the later messages of the same job or
+// technology so their subscribers see the events in order.
+type Relay struct {
+	store     Store
+	publisher Publisher
+	cfg       RelayConfig
+	now       func() time.Time
+}
+
+// NewRelay creates a new instance of Relay
+func NewRelay(store Store, publisher Publisher, cfg RelayConfig) *Relay {
+	defaults := DefaultRelayConfig()
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaults.BatchSize
+	}
+	if cfg.Lease <= 0 {
+		cfg.Lease = defaults.Lease
+	}
+	if cfg.BaseBackoff <= 0 {
+		cfg.BaseBackoff = defaults.BaseBackoff
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = defaults.MaxBackoff
+	}
+
+	return &Relay{store: store, publisher: publisher, cfg: cfg, now: time.Now}
+}
+
+// ProcessDue claims a batch of due messages and publishes them, recording the outcome of every attempt.
+// It returns the number of messages published.
+func (r *Relay) ProcessDue(ctx context.Context) (int, error) {
+	messages, err := r.store.ClaimDue(ctx, r.cfg.BatchSize, r.cfg.Lease)
+	if err != nil {
+		return 0, err
+	}
+
+	published := 0
+	// The retry time of the aggregates with a failed message in this batch
+	heldBack := make(map[string]time.Time)
+	for _, message := range messages {
+		key := aggregateKey(message)
+		if nextAttemptAt, ok := heldBack[key]; ok {
+			lastError := "waiting for an earlier event of the same " + key
+			if err := r.store.MarkFailed(ctx, message.ID, lastError, nextAttemptAt); err != nil {
+				return published, err
+			}
+			continue
+		}
+
+		publishErr := r.publish(ctx, message)
+		if publishErr == nil {
+			if err := r.store.MarkPublished(ctx, message.ID); err != nil {
+				return published, err
+			}
+			published++
+			continue
+		}
+		if ctx.Err() != nil {
+			// Shutting down, the message is published again once its lease expires
+			return published, nil
+		}
+
+		nextAttemptAt := r.now().Add(r.backoff(message.Attempts + 1))
+		heldBack[key] = nextAttemptAt
+		if err := r.store.MarkFailed(ctx, message.ID, publishErr.Error(), nextAttemptAt); err != nil {
+			return published, err
+		}
+	}
+
+	return published, nil
+}
+
+// publish decodes the message and publishes its event
+func (r *Relay) publish(ctx context.Context, message *Message) error {
+	event, err := message.Event()
+	if err != nil {
+		return err
+	}
+	if err = r.publisher.Publish(ctx, event); err != nil {
+		return fmt.Errorf("failed to publish %s event: %w", message.EventType, err)
+	}
+	return nil
+}
+
+// backoff returns the wait before the next attempt of a message that failed attempts times
+func (r *Relay) backoff(attempts int) time.Duration {
+	wait := r.cfg.BaseBackoff
+	for i := 1; i < attempts && wait < r.cfg.MaxBackoff; i++ {
+		wait *= 2
+	}
+	return min(wait, r.cfg.MaxBackoff)
+}
+
+// aggregateKey identifies what the event of a message is about, e.g. "job 7"
+func aggregateKey(message *Message) string {
+	kind, _, _ := strings.Cut(message.EventType, ".")
+	return fmt.Sprintf("%s %d", kind, message.AggregateID)
+}
@@ -0,0 +1,163 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/events"
+)
+
+func TestRelay_ProcessDue(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	publishError := errors.New("publish error")
+	storeError := errors.New("store error")
+
+	jobMessage := func(id int64, eventType string, jobID, attempts int) *Message {
+		return &Message{ID: id, EventType: eventType, AggregateID: jobID, Attempts: attempts,
+			Payload: []byte(`{"id": 7, "title": "Go Developer", "is_active": true}`), CreatedAt: now}
+	}
+	// isEvent matches the published event of eventType
+	isEvent := func(eventType string) any {
+		return mock.MatchedBy(func(event *events.Event) bool { return event.Type == eventType })
+	}
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mockStore *MockStore, mockPublisher *MockPublisher)
+		checkResults func(t *testing.T, published int, err error)
+	}{
+		{
+			name: "messages published in order",
+			mockSetup: func(mockStore *MockStore, mockPublisher *MockPublisher) {
+				t.Helper()
+				mockStore.EXPECT().ClaimDue(context.Background(), 10, time.Minute).Return([]*Message{
+					jobMessage(1, events.JobCreated, 7, 0),
+					{ID: 2, EventType: events.TechnologyAdded, AggregateID: 9, CreatedAt: now,
+						Payload: []byte(`{"id": 9, "name": "htmx", "category": "Frontend"}`)},
+				}, nil).Once()
+				mockPublisher.EXPECT().Publish(context.Background(), isEvent(events.JobCreated)).Return(nil).Once()
+				mockStore.EXPECT().MarkPublished(context.Background(), int64(1)).Return(nil).Once()
+				mockPublisher.EXPECT().Publish(context.Background(), isEvent(events.TechnologyAdded)).Return(nil).Once()
+				mockStore.EXPECT().MarkPublished(context.Background(), int64(2)).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, published int, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 2, published)
+			},
+		},
+		{
+			name: "failed message holds back the later events of its job",
+			mockSetup: func(mockStore *MockStore, mockPublisher *MockPublisher) {
+				t.Helper()
+				mockStore.EXPECT().ClaimDue(context.Background(), 10, time.Minute).Return([]*Message{
+					jobMessage(1, events.JobCreated, 7, 2),
+					jobMessage(2, events.JobCreated, 8, 0),
+					jobMessage(3, events.JobDeactivated, 7, 0),
+				}, nil).Once()
+				mockPublisher.EXPECT().Publish(context.Background(), mock.Anything).Return(publishError).Once()
+				mockStore.EXPECT().MarkFailed(context.Background(), int64(1),
+					"failed to publish job.created event: publish error", now.Add(4*time.Second)).Return(nil).Once()
+				mockPublisher.EXPECT().Publish(context.Background(), mock.Anything).Return(nil).Once()
+				mockStore.EXPECT().MarkPublished(context.Background(), int64(2)).Return(nil).Once()
+				mockStore.EXPECT().MarkFailed(context.Background(), int64(3),
+					"waiting for an earlier event of the same job 7", now.Add(4*time.Second)).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, published int, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 1, published)
+			},
+		},
+		{
+			name: "undecodable message retried with the max backoff",
+			mockSetup: func(mockStore *MockStore, _ *MockPublisher) {
+				t.Helper()
+				mockStore.EXPECT().ClaimDue(context.Background(), 10, time.Minute).Return([]*Message{
+					{ID: 1, EventType: "company.renamed", AggregateID: 3, Attempts: 20, Payload: []byte(`{}`)},
+				}, nil).Once()
+				mockStore.EXPECT().MarkFailed(context.Background(), int64(1),
+					`unknown outbox event type "company.renamed"`, now.Add(time.Minute)).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, published int, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Zero(t, published)
+			},
+		},
+		{
+			name: "claim error",
+			mockSetup: func(mockStore *MockStore, _ *MockPublisher) {
+				t.Helper()
+				mockStore.EXPECT().ClaimDue(context.Background(), 10, time.Minute).Return(nil, storeError).Once()
+			},
+			checkResults: func(t *testing.T, published int, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, storeError)
+				assert.Zero(t, published)
+			},
+		},
+		{
+			name: "mark published error",
+			mockSetup: func(mockStore *MockStore, mockPublisher *MockPublisher) {
+				t.Helper()
+				mockStore.EXPECT().ClaimDue(context.Background(), 10, time.Minute).
+					Return([]*Message{jobMessage(1, events.JobUpdated, 7, 0)}, nil).Once()
+				mockPublisher.EXPECT().Publish(context.Background(), mock.Anything).Return(nil).Once()
+				mockStore.EXPECT().MarkPublished(context.Background(), int64(1)).Return(storeError).Once()
+			},
+			checkResults: func(t *testing.T, published int, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, storeError)
+				assert.Zero(t, published)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockStore := NewMockStore(t)
+			mockPublisher := NewMockPublisher(t)
+			tt.mockSetup(mockStore, mockPublisher)
+
+			relay := NewRelay(mockStore, mockPublisher, RelayConfig{
+				BatchSize:   10,
+				Lease:       time.Minute,
+				BaseBackoff: time.Second,
+				MaxBackoff:  time.Minute,
+			})
+			relay.now = func() time.Time { return now }
+
+			published, err := relay.ProcessDue(context.Background())
+			tt.checkResults(t, published, err)
+		})
+	}
+}
+
+func TestRelay_ProcessDue_ShuttingDown(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	mockStore := NewMockStore(t)
+	mockPublisher := NewMockPublisher(t)
+	mockStore.EXPECT().ClaimDue(ctx, DefaultBatchSize, DefaultLease).Return([]*Message{
+		{ID: 1, EventType: events.TechnologyAdded, AggregateID: 9, Payload: []byte(`{"id": 9}`)},
+	}, nil).Once()
+	mockPublisher.EXPECT().Publish(ctx, mock.Anything).
+		RunAndReturn(func(ctx context.Context, _ *events.Event) error {
+			cancel()
+			return ctx.Err()
+		}).Once()
+
+	// The message is left to be published again once its lease expires
+	published, err := NewRelay(mockStore, mockPublisher, DefaultRelayConfig()).ProcessDue(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, published)
+}
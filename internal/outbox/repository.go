@@ -0,0 +1,120 @@
+package outbox
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// SQL query constants
+const (
+	// Claims the due messages by pushing their next attempt past the lease, so other relays
+	// skip them while they are being published
+	claimDueMessagesQuery = `
+        UPDATE outbox
+        SET next_attempt_at = NOW() + make_interval(secs => $2)
+        WHERE id IN (
+            SELECT id FROM outbox
+            WHERE published_at IS NULL AND next_attempt_at <= NOW()
+            ORDER BY id
+            LIMIT $1
+            FOR UPDATE SKIP LOCKED
+        )
+        RETURNING id, event_type, aggregate_id, payload, attempts, created_at
+    `
+
+	markPublishedQuery = `
+        UPDATE outbox
+        SET published_at = NOW(), attempts = attempts + 1, last_error = ''
+        WHERE id = $1
+    `
+
+	markFailedQuery = `
+        UPDATE outbox
+        SET attempts = attempts + 1, last_error = $2, next_attempt_at = $3
+        WHERE id = $1
+    `
+
+	purgePublishedQuery = `DELETE FROM outbox WHERE published_at < $1`
+)
+
+// Database interface to support pgxpool and mocks
+type Database interface {
+	Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+}
+
+// Repository handles database operations for the outbox messages.
+type Repository struct {
+	db Database
+}
+
+// NewRepository creates a new Repository instance.
+func NewRepository(db Database) *Repository {
+	return &Repository{db: db}
+}
+
+// ClaimDue retrieves up to limit unpublished messages whose next attempt is due, oldest first.
+// The claimed messages aren't due again until lease has passed.
+func (r *Repository) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*Message, error) {
+	rows, err := r.db.Query(ctx, claimDueMessagesQuery, limit, lease.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim outbox messages: %w", err)
+	}
+	defer rows.Close()
+
+	var messages []*Message
+	for rows.Next() {
+		message := &Message{}
+		err = rows.Scan(
+			&message.ID,
+			&message.EventType,
+			&message.AggregateID,
+			&message.Payload,
+			&message.Attempts,
+			&message.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan outbox message row: %w", err)
+		}
+		messages = append(messages, message)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating outbox message rows: %w", err)
+	}
+
+	// RETURNING doesn't keep the order of the subquery
+	slices.SortFunc(messages, func(a, b *Message) int { return cmp.Compare(a.ID, b.ID) })
+	return messages, nil
+}
+
+// MarkPublished records the successful publication of a message.
+func (r *Repository) MarkPublished(ctx context.Context, id int64) error {
+	if _, err := r.db.Exec(ctx, markPublishedQuery, id); err != nil {
+		return fmt.Errorf("failed to mark outbox message as published: %w", err)
+	}
+	return nil
+}
+
+// MarkFailed records a failed attempt to publish a message, retried at nextAttemptAt.
+func (r *Repository) MarkFailed(ctx context.Context, id int64, lastError string, nextAttemptAt time.Time) error {
+	if _, err := r.db.Exec(ctx, markFailedQuery, id, lastError, nextAttemptAt); err != nil {
+		return fmt.Errorf("failed to mark outbox message as failed: %w", err)
+	}
+	return nil
+}
+
+// PurgePublished removes the messages published before the given time and returns how many were removed.
+func (r *Repository) PurgePublished(ctx context.Context, before time.Time) (int64, error) {
+	commandTag, err := r.db.Exec(ctx, purgePublishedQuery, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge published outbox messages: %w", err)
+	}
+	return commandTag.RowsAffected(), nil
+}
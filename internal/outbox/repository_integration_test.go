@@ -0,0 +1,67 @@
+//go:build integration
+
+package outbox
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/events"
+	"github.com/rodruizronald/ticos-in-tech/internal/testdb"
+)
+
+func TestRepository_RecordedEvents_Integration(t *testing.T) {
+	t.Parallel()
+	db := testdb.New(t)
+	ctx := context.Background()
+
+	techCorp := testdb.InsertCompany(t, db, "Tech Corp")
+	published := testdb.InsertJob(t, db, &testdb.Job{CompanyID: techCorp, Title: "Go Developer"})
+	testdb.InsertJob(t, db, &testdb.Job{CompanyID: techCorp, Title: "Data Analyst", Inactive: true})
+	techID := testdb.InsertTechnology(t, db, "htmx", "Frontend")
+
+	// A pending job is approved, the published one changes and is then taken down
+	_, err := db.Exec(ctx, `UPDATE jobs SET status = 'pending', is_active = false WHERE title = 'Data Analyst'`)
+	require.NoError(t, err)
+	_, err = db.Exec(ctx, `UPDATE jobs SET status = 'published', is_active = true WHERE title = 'Data Analyst'`)
+	require.NoError(t, err)
+	_, err = db.Exec(ctx, `UPDATE jobs SET title = 'Senior Go Developer' WHERE id = $1`, published)
+	require.NoError(t, err)
+	_, err = db.Exec(ctx, `UPDATE jobs SET updated_at = NOW() WHERE id = $1`, published)
+	require.NoError(t, err)
+	_, err = db.Exec(ctx, `UPDATE jobs SET is_active = false WHERE id = $1`, published)
+	require.NoError(t, err)
+
+	repo := NewRepository(db)
+	messages, err := repo.ClaimDue(ctx, 10, time.Minute)
+	require.NoError(t, err)
+
+	var recorded []string
+	for _, message := range messages {
+		event, err := message.Event()
+		require.NoError(t, err)
+		switch {
+		case event.Job != nil:
+			recorded = append(recorded, event.Type+" "+event.Job.Title)
+		case event.Technology != nil:
+			assert.Equal(t, techID, event.Technology.ID)
+			recorded = append(recorded, event.Type+" "+event.Technology.Name)
+		}
+	}
+	assert.Equal(t, []string{
+		events.JobCreated + " Go Developer",
+		events.TechnologyAdded + " htmx",
+		events.JobCreated + " Data Analyst",
+		events.JobUpdated + " Senior Go Developer",
+		events.JobDeactivated + " Senior Go Developer",
+	}, recorded)
+
+	// Claimed messages aren't due again until their lease expires
+	messages, err = repo.ClaimDue(ctx, 10, time.Minute)
+	require.NoError(t, err)
+	assert.Empty(t, messages)
+}
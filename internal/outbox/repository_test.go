@@ -0,0 +1,125 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_ClaimDue(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+	recordedAt := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	columns := []string{"id", "event_type", "aggregate_id", "payload", "attempts", "created_at"}
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, messages []*Message, err error)
+	}{
+		{
+			name: "messages claimed oldest first",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(claimDueMessagesQuery)).
+					WithArgs(10, float64(60)).
+					WillReturnRows(pgxmock.NewRows(columns).
+						AddRow(int64(5), "job.deactivated", 7, []byte(`{"id": 7}`), 0, recordedAt).
+						AddRow(int64(4), "job.created", 7, []byte(`{"id": 7}`), 1, recordedAt))
+			},
+			checkResults: func(t *testing.T, messages []*Message, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, messages, 2)
+				assert.Equal(t, &Message{ID: 4, EventType: "job.created", AggregateID: 7, Payload: []byte(`{"id": 7}`),
+					Attempts: 1, CreatedAt: recordedAt}, messages[0])
+				assert.Equal(t, int64(5), messages[1].ID)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(claimDueMessagesQuery)).
+					WithArgs(10, float64(60)).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, messages []*Message, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+				assert.Nil(t, messages)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			messages, err := repo.ClaimDue(context.Background(), 10, time.Minute)
+			tt.checkResults(t, messages, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_MarkPublished(t *testing.T) {
+	t.Parallel()
+	mockDB, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	mockDB.ExpectExec(regexp.QuoteMeta(markPublishedQuery)).
+		WithArgs(int64(4)).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	require.NoError(t, NewRepository(mockDB).MarkPublished(context.Background(), 4))
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
+
+func TestRepository_MarkFailed(t *testing.T) {
+	t.Parallel()
+	mockDB, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	dbError := errors.New("database error")
+	retryAt := time.Date(2024, 1, 15, 10, 0, 10, 0, time.UTC)
+	mockDB.ExpectExec(regexp.QuoteMeta(markFailedQuery)).
+		WithArgs(int64(4), "publish error", retryAt).
+		WillReturnError(dbError)
+
+	err = NewRepository(mockDB).MarkFailed(context.Background(), 4, "publish error", retryAt)
+	require.ErrorIs(t, err, dbError)
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
+
+func TestRepository_PurgePublished(t *testing.T) {
+	t.Parallel()
+	mockDB, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	before := time.Date(2024, 1, 8, 10, 0, 0, 0, time.UTC)
+	mockDB.ExpectExec(regexp.QuoteMeta(purgePublishedQuery)).
+		WithArgs(before).
+		WillReturnResult(pgxmock.NewResult("DELETE", 12))
+
+	purged, err := NewRepository(mockDB).PurgePublished(context.Background(), before)
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), purged)
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
@@ -0,0 +1,62 @@
+package pendingtech
+
+import (
+	"time"
+)
+
+// Data Transfer Objects (DTOs) for the pending technology API layer.
+
+// ApproveRequest represents the request body to approve a pending technology.
+// Either alias_of or category must be provided.
+type ApproveRequest struct {
+	Category string `json:"category,omitempty" example:"programming"`
+	AliasOf  *int   `json:"alias_of,omitempty" binding:"omitempty,gt=0" example:"1"`
+}
+
+// ToApproveParams converts the request into service parameters
+func (r *ApproveRequest) ToApproveParams() ApproveParams {
+	return ApproveParams{
+		Category: r.Category,
+		AliasOf:  r.AliasOf,
+	}
+}
+
+// PendingTechnologyResponse represents the API response for a pending technology
+type PendingTechnologyResponse struct {
+	ID          int       `json:"id" example:"1"`
+	Name        string    `json:"name" example:"htmx"`
+	Occurrences int       `json:"occurrences" example:"12"`
+	FirstSeenAt time.Time `json:"first_seen_at" example:"2024-01-10T06:00:00Z"`
+	LastSeenAt  time.Time `json:"last_seen_at" example:"2024-01-15T06:00:00Z"`
+
+	SuggestedTechnologyID *int     `json:"suggested_technology_id,omitempty" example:"3"`
+	SuggestionScore       *float64 `json:"suggestion_score,omitempty" example:"0.45"`
+}
+
+// ListResponse represents the API response listing pending technologies
+type ListResponse struct {
+	Data []*PendingTechnologyResponse `json:"data"`
+}
+
+// MapPendingTechnologyToResponse converts a pending technology database model to its API response format
+func MapPendingTechnologyToResponse(pending *PendingTechnology) *PendingTechnologyResponse {
+	return &PendingTechnologyResponse{
+		ID:          pending.ID,
+		Name:        pending.Name,
+		Occurrences: pending.Occurrences,
+		FirstSeenAt: pending.FirstSeenAt,
+		LastSeenAt:  pending.LastSeenAt,
+
+		SuggestedTechnologyID: pending.SuggestedTechnologyID,
+		SuggestionScore:       pending.SuggestionScore,
+	}
+}
+
+// MapPendingTechnologiesToResponse converts pending technologies to the list API response format
+func MapPendingTechnologiesToResponse(pendingTechs []*PendingTechnology) *ListResponse {
+	data := make([]*PendingTechnologyResponse, 0, len(pendingTechs))
+	for _, pending := range pendingTechs {
+		data = append(data, MapPendingTechnologyToResponse(pending))
+	}
+	return &ListResponse{Data: data}
+}
@@ -0,0 +1,30 @@
+// Package pendingtech provides functionality for managing technologies found in job
+// postings that are not known yet, so they can be reviewed and approved by an admin.
+package pendingtech
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// NotFoundError represents a pending technology not found error
+type NotFoundError struct {
+	ID int
+}
+
+func (e NotFoundError) Error() string {
+	return fmt.Sprintf("pending technology with ID %d not found", e.ID)
+}
+
+// Is matches httpservice.ErrNotFound
+func (e NotFoundError) Is(target error) bool {
+	return target == httpservice.ErrNotFound
+}
+
+// IsNotFound checks if an error is a pending technology not found error
+func IsNotFound(err error) bool {
+	var notFoundErr *NotFoundError
+	return errors.As(err, &notFoundErr)
+}
@@ -0,0 +1,130 @@
+package pendingtech
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+	"github.com/rodruizronald/ticos-in-tech/internal/technology"
+)
+
+// Constants for pending technology routes and endpoints
+const (
+	PendingTechnologiesRoute = "/pending-technologies"
+	PendingTechnologyPath    = PendingTechnologiesRoute + "/:id"
+	ApprovePath              = PendingTechnologyPath + "/approve"
+)
+
+// Handler handles HTTP requests for pending technology operations
+type Handler struct {
+	service *PendingTechnologyService
+}
+
+// NewHandler creates a new pending technology handler
+func NewHandler(service *PendingTechnologyService) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterAdminRoutes registers the pending technology admin routes with the given (protected) router group
+func (h *Handler) RegisterAdminRoutes(rg *gin.RouterGroup) {
+	rg.GET(PendingTechnologiesRoute, h.ListPendingTechnologies)
+	rg.POST(ApprovePath, h.ApprovePendingTechnology)
+	rg.DELETE(PendingTechnologyPath, h.RejectPendingTechnology)
+}
+
+// ListPendingTechnologies godoc
+// @Summary List pending technologies
+// @Description Lists technology names found in job postings that don't match any known technology,
+// @Description most frequent first
+// @Tags admin
+// @Produce json
+// @Security AdminAPIKey
+// @Success 200 {object} ListResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/pending-technologies [get]
+func (h *Handler) ListPendingTechnologies(c *gin.Context) {
+	pendingTechs, err := h.service.List(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapPendingTechnologiesToResponse(pendingTechs))
+}
+
+// ApprovePendingTechnology godoc
+// @Summary Approve a pending technology
+// @Description Creates a technology from a pending name, or registers it as an alias of an existing
+// @Description technology when alias_of is set, and removes it from the pending list
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security AdminAPIKey
+// @Param id path int true "Pending technology ID"
+// @Param request body ApproveRequest true "Approval options"
+// @Success 200 {object} technology.TechnologyResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/pending-technologies/{id}/approve [post]
+func (h *Handler) ApprovePendingTechnology(c *gin.Context) {
+	id, ok := parseID(c)
+	if !ok {
+		return
+	}
+
+	var req ApproveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	tech, err := h.service.Approve(c.Request.Context(), id, req.ToApproveParams())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, technology.MapTechnologyToResponse(tech))
+}
+
+// RejectPendingTechnology godoc
+// @Summary Reject a pending technology
+// @Description Removes a pending technology without creating anything
+// @Tags admin
+// @Produce json
+// @Security AdminAPIKey
+// @Param id path int true "Pending technology ID"
+// @Success 204
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/pending-technologies/{id} [delete]
+func (h *Handler) RejectPendingTechnology(c *gin.Context) {
+	id, ok := parseID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Reject(c.Request.Context(), id); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// parseID reads the pending technology ID path parameter, responding with an error when invalid
+func parseID(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		_ = c.Error(&httpservice.ValidationError{Errors: []string{"invalid pending technology id"}})
+		return 0, false
+	}
+	return id, true
+}
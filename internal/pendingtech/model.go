@@ -0,0 +1,19 @@
+package pendingtech
+
+import (
+	"time"
+)
+
+// PendingTechnology represents a technology name seen in job postings that doesn't match
+// any known technology or alias, together with how often it has been seen.
+type PendingTechnology struct {
+	ID          int       `json:"id" db:"id"`
+	Name        string    `json:"name" db:"name"`
+	Occurrences int       `json:"occurrences" db:"occurrences"`
+	FirstSeenAt time.Time `json:"first_seen_at" db:"first_seen_at"`
+	LastSeenAt  time.Time `json:"last_seen_at" db:"last_seen_at"`
+
+	// Closest known technology found by similarity, if any, to help the review
+	SuggestedTechnologyID *int     `json:"suggested_technology_id,omitempty" db:"suggested_technology_id"`
+	SuggestionScore       *float64 `json:"suggestion_score,omitempty" db:"suggestion_score"`
+}
@@ -0,0 +1,140 @@
+package pendingtech
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// SQL query constants
+const (
+	recordPendingTechnologyQuery = `
+        INSERT INTO pending_technologies (name, suggested_technology_id, suggestion_score)
+        VALUES ($1, $2, $3)
+        ON CONFLICT (name) DO UPDATE
+        SET occurrences = pending_technologies.occurrences + 1,
+            last_seen_at = NOW(),
+            suggested_technology_id = COALESCE(
+                EXCLUDED.suggested_technology_id, pending_technologies.suggested_technology_id),
+            suggestion_score = COALESCE(EXCLUDED.suggestion_score, pending_technologies.suggestion_score)
+        RETURNING id, name, occurrences, first_seen_at, last_seen_at, suggested_technology_id, suggestion_score
+    `
+
+	getPendingTechnologyByIDQuery = `
+        SELECT id, name, occurrences, first_seen_at, last_seen_at, suggested_technology_id, suggestion_score
+        FROM pending_technologies
+        WHERE id = $1
+    `
+
+	listPendingTechnologiesQuery = `
+        SELECT id, name, occurrences, first_seen_at, last_seen_at, suggested_technology_id, suggestion_score
+        FROM pending_technologies
+        ORDER BY occurrences DESC, name
+    `
+
+	deletePendingTechnologyQuery = `DELETE FROM pending_technologies WHERE id = $1`
+)
+
+// Database interface to support pgxpool and mocks
+type Database interface {
+	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
+	Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+}
+
+// Repository handles database operations for the PendingTechnology model.
+type Repository struct {
+	db Database
+}
+
+// NewRepository creates a new Repository instance.
+func NewRepository(db Database) *Repository {
+	return &Repository{db: db}
+}
+
+// Record registers an occurrence of an unknown technology name.
+// The first occurrence creates the entry, subsequent ones increment its counter and
+// refresh the suggestion when a new one is given. The stored state is loaded into pending.
+func (r *Repository) Record(ctx context.Context, pending *PendingTechnology) error {
+	err := scanPendingTechnology(r.db.QueryRow(
+		ctx,
+		recordPendingTechnologyQuery,
+		pending.Name,
+		pending.SuggestedTechnologyID,
+		pending.SuggestionScore,
+	), pending)
+	if err != nil {
+		return fmt.Errorf("failed to record pending technology: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a pending technology by its ID.
+func (r *Repository) GetByID(ctx context.Context, id int) (*PendingTechnology, error) {
+	pending := &PendingTechnology{}
+	err := scanPendingTechnology(r.db.QueryRow(ctx, getPendingTechnologyByIDQuery, id), pending)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &NotFoundError{ID: id}
+		}
+		return nil, fmt.Errorf("failed to get pending technology: %w", err)
+	}
+
+	return pending, nil
+}
+
+// List retrieves all pending technologies, most frequent first.
+func (r *Repository) List(ctx context.Context) ([]*PendingTechnology, error) {
+	rows, err := r.db.Query(ctx, listPendingTechnologiesQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pending technologies: %w", err)
+	}
+	defer rows.Close()
+
+	var pendingTechs []*PendingTechnology
+	for rows.Next() {
+		pending := &PendingTechnology{}
+		if err = scanPendingTechnology(rows, pending); err != nil {
+			return nil, fmt.Errorf("failed to scan pending technology row: %w", err)
+		}
+		pendingTechs = append(pendingTechs, pending)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating pending technology rows: %w", err)
+	}
+
+	return pendingTechs, nil
+}
+
+// Delete removes a pending technology from the database.
+func (r *Repository) Delete(ctx context.Context, id int) error {
+	commandTag, err := r.db.Exec(ctx, deletePendingTechnologyQuery, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete pending technology: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return &NotFoundError{ID: id}
+	}
+
+	return nil
+}
+
+// scanPendingTechnology scans a pending technology row into pending
+func scanPendingTechnology(row pgx.Row, pending *PendingTechnology) error {
+	return row.Scan(
+		&pending.ID,
+		&pending.Name,
+		&pending.Occurrences,
+		&pending.FirstSeenAt,
+		&pending.LastSeenAt,
+		&pending.SuggestedTechnologyID,
+		&pending.SuggestionScore,
+	)
+}
@@ -0,0 +1,282 @@
+package pendingtech
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var pendingTechnologyColumns = []string{
+	"id", "name", "occurrences", "first_seen_at", "last_seen_at", "suggested_technology_id", "suggestion_score",
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func floatPtr(f float64) *float64 {
+	return &f
+}
+
+func TestRepository_Record(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		pending      *PendingTechnology
+		mockSetup    func(mock pgxmock.PgxPoolIface, pending *PendingTechnology)
+		checkResults func(t *testing.T, result *PendingTechnology, err error)
+	}{
+		{
+			name:    "new pending technology",
+			pending: &PendingTechnology{Name: "htmx"},
+			mockSetup: func(mock pgxmock.PgxPoolIface, pending *PendingTechnology) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(recordPendingTechnologyQuery)).
+					WithArgs(pending.Name, pending.SuggestedTechnologyID, pending.SuggestionScore).
+					WillReturnRows(pgxmock.NewRows(pendingTechnologyColumns).
+						AddRow(1, pending.Name, 1, now, now, nil, nil))
+			},
+			checkResults: func(t *testing.T, result *PendingTechnology, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 1, result.ID)
+				assert.Equal(t, 1, result.Occurrences)
+				assert.Nil(t, result.SuggestedTechnologyID)
+			},
+		},
+		{
+			name:    "existing pending technology with suggestion",
+			pending: &PendingTechnology{Name: "htmlx", SuggestedTechnologyID: intPtr(3), SuggestionScore: floatPtr(0.4)},
+			mockSetup: func(mock pgxmock.PgxPoolIface, pending *PendingTechnology) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(recordPendingTechnologyQuery)).
+					WithArgs(pending.Name, pending.SuggestedTechnologyID, pending.SuggestionScore).
+					WillReturnRows(pgxmock.NewRows(pendingTechnologyColumns).
+						AddRow(1, pending.Name, 5, now, now, intPtr(3), floatPtr(0.4)))
+			},
+			checkResults: func(t *testing.T, result *PendingTechnology, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 5, result.Occurrences)
+				assert.Equal(t, intPtr(3), result.SuggestedTechnologyID)
+			},
+		},
+		{
+			name:    "database error",
+			pending: &PendingTechnology{Name: "htmx"},
+			mockSetup: func(mock pgxmock.PgxPoolIface, pending *PendingTechnology) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(recordPendingTechnologyQuery)).
+					WithArgs(pending.Name, pending.SuggestedTechnologyID, pending.SuggestionScore).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ *PendingTechnology, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB, tt.pending)
+
+			err = repo.Record(context.Background(), tt.pending)
+			tt.checkResults(t, tt.pending, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_GetByID(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+
+	tests := []struct {
+		name         string
+		id           int
+		mockSetup    func(mock pgxmock.PgxPoolIface, id int)
+		checkResults func(t *testing.T, result *PendingTechnology, err error)
+	}{
+		{
+			name: "found",
+			id:   1,
+			mockSetup: func(mock pgxmock.PgxPoolIface, id int) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getPendingTechnologyByIDQuery)).
+					WithArgs(id).
+					WillReturnRows(pgxmock.NewRows(pendingTechnologyColumns).AddRow(id, "htmx", 3, now, now, nil, nil))
+			},
+			checkResults: func(t *testing.T, result *PendingTechnology, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, "htmx", result.Name)
+			},
+		},
+		{
+			name: "not found",
+			id:   999,
+			mockSetup: func(mock pgxmock.PgxPoolIface, id int) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getPendingTechnologyByIDQuery)).
+					WithArgs(id).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			checkResults: func(t *testing.T, _ *PendingTechnology, err error) {
+				t.Helper()
+				var notFoundErr *NotFoundError
+				require.ErrorAs(t, err, &notFoundErr)
+				assert.Equal(t, 999, notFoundErr.ID)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB, tt.id)
+
+			result, err := repo.GetByID(context.Background(), tt.id)
+			tt.checkResults(t, result, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_List(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, result []*PendingTechnology, err error)
+	}{
+		{
+			name: "pending technologies found",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(listPendingTechnologiesQuery)).
+					WillReturnRows(pgxmock.NewRows(pendingTechnologyColumns).
+						AddRow(2, "htmx", 7, now, now, nil, nil).
+						AddRow(1, "bun", 2, now, now, nil, nil))
+			},
+			checkResults: func(t *testing.T, result []*PendingTechnology, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, result, 2)
+				assert.Equal(t, "htmx", result[0].Name)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(listPendingTechnologiesQuery)).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ []*PendingTechnology, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			result, err := repo.List(context.Background())
+			tt.checkResults(t, result, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_Delete(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		id           int
+		mockSetup    func(mock pgxmock.PgxPoolIface, id int)
+		checkResults func(t *testing.T, err error)
+	}{
+		{
+			name: "successful deletion",
+			id:   1,
+			mockSetup: func(mock pgxmock.PgxPoolIface, id int) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(deletePendingTechnologyQuery)).
+					WithArgs(id).
+					WillReturnResult(pgxmock.NewResult("DELETE", 1))
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "pending technology not found",
+			id:   999,
+			mockSetup: func(mock pgxmock.PgxPoolIface, id int) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(deletePendingTechnologyQuery)).
+					WithArgs(id).
+					WillReturnResult(pgxmock.NewResult("DELETE", 0))
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				assert.True(t, IsNotFound(err))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB, tt.id)
+
+			err = repo.Delete(context.Background(), tt.id)
+			tt.checkResults(t, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package pendingtech
+
+import (
+	"context"
+	"strings"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+	"github.com/rodruizronald/ticos-in-tech/internal/technology"
+)
+
+// DataRepository interface to make database operations for the PendingTechnology model.
+type DataRepository interface {
+	Record(ctx context.Context, pending *PendingTechnology) error
+	GetByID(ctx context.Context, id int) (*PendingTechnology, error)
+	List(ctx context.Context) ([]*PendingTechnology, error)
+	Delete(ctx context.Context, id int) error
+}
+
+// TechnologyManager defines the technology operations needed to approve pending technologies.
+// It is implemented by technology.TechnologyService.
+type TechnologyManager interface {
+	GetByID(ctx context.Context, id int) (*technology.Technology, error)
+	CreateWithAliases(ctx context.Context, tech *technology.Technology, aliases []string) (bool, error)
+	AddAliases(ctx context.Context, techID int, aliases []string) error
+}
+
+// ApproveParams holds how a pending technology is approved.
+// When AliasOf is set, the pending name becomes an alias of that technology;
+// otherwise a new technology is created with the given category.
+type ApproveParams struct {
+	Category string
+	AliasOf  *int
+}
+
+// PendingTechnologyService holds the business logic for reviewing pending technologies.
+type PendingTechnologyService struct {
+	repo        DataRepository
+	techManager TechnologyManager
+}
+
+// NewPendingTechnologyService creates a new instance of PendingTechnologyService
+func NewPendingTechnologyService(repo DataRepository, techManager TechnologyManager) *PendingTechnologyService {
+	return &PendingTechnologyService{repo: repo, techManager: techManager}
+}
+
+// Record registers an occurrence of a technology name that is not known yet.
+// suggestion is the closest technology found for the name, if any.
+func (s *PendingTechnologyService) Record(
+	ctx context.Context, name string, suggestion *technology.Match,
+) (*PendingTechnology, error) {
+	pending := &PendingTechnology{Name: technology.NormalizeName(name)}
+	if pending.Name == "" {
+		return nil, &httpservice.ValidationError{Errors: []string{"technology name cannot be empty"}}
+	}
+
+	if suggestion != nil && suggestion.Technology != nil {
+		score := suggestion.Score
+		pending.SuggestedTechnologyID = &suggestion.Technology.ID
+		pending.SuggestionScore = &score
+	}
+
+	if err := s.repo.Record(ctx, pending); err != nil {
+		return nil, err
+	}
+	return pending, nil
+}
+
+// List retrieves all pending technologies, most frequent first.
+func (s *PendingTechnologyService) List(ctx context.Context) ([]*PendingTechnology, error) {
+	return s.repo.List(ctx)
+}
+
+// Approve turns a pending technology into a technology, or into an alias of an existing one,
+// and removes it from the pending list. It returns the technology the name now resolves to.
+func (s *PendingTechnologyService) Approve(
+	ctx context.Context, id int, params ApproveParams,
+) (*technology.Technology, error) {
+	pending, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var tech *technology.Technology
+	if params.AliasOf != nil {
+		tech, err = s.techManager.GetByID(ctx, *params.AliasOf)
+		if err != nil {
+			return nil, err
+		}
+		if err = s.techManager.AddAliases(ctx, tech.ID, []string{pending.Name}); err != nil {
+			return nil, err
+		}
+	} else {
+		category := strings.TrimSpace(params.Category)
+		if category == "" {
+			return nil, &httpservice.ValidationError{
+				Errors: []string{"category is required when not approving as an alias"},
+			}
+		}
+		tech = &technology.Technology{Name: pending.Name, Category: category}
+		if _, err = s.techManager.CreateWithAliases(ctx, tech, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = s.repo.Delete(ctx, pending.ID); err != nil {
+		return nil, err
+	}
+
+	return tech, nil
+}
+
+// Reject discards a pending technology.
+func (s *PendingTechnologyService) Reject(ctx context.Context, id int) error {
+	return s.repo.Delete(ctx, id)
+}
@@ -0,0 +1,184 @@
+package pendingtech
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+	"github.com/rodruizronald/ticos-in-tech/internal/technology"
+)
+
+func TestPendingTechnologyService_Approve(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+	pending := &PendingTechnology{ID: 4, Name: "htmx", Occurrences: 3}
+
+	tests := []struct {
+		name         string
+		id           int
+		params       ApproveParams
+		mockSetup    func(mockRepo *MockDataRepository, mockTech *MockTechnologyManager)
+		checkResults func(t *testing.T, tech *technology.Technology, err error)
+	}{
+		{
+			name:   "approve as new technology",
+			id:     4,
+			params: ApproveParams{Category: " frontend "},
+			mockSetup: func(mockRepo *MockDataRepository, mockTech *MockTechnologyManager) {
+				t.Helper()
+				mockRepo.EXPECT().GetByID(context.Background(), 4).Return(pending, nil).Once()
+				mockTech.EXPECT().CreateWithAliases(context.Background(),
+					&technology.Technology{Name: "htmx", Category: "frontend"}, []string(nil)).
+					Run(func(_ context.Context, tech *technology.Technology, _ []string) { tech.ID = 10 }).
+					Return(true, nil).Once()
+				mockRepo.EXPECT().Delete(context.Background(), 4).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, tech *technology.Technology, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 10, tech.ID)
+			},
+		},
+		{
+			name:   "approve as alias",
+			id:     4,
+			params: ApproveParams{AliasOf: intPtr(2)},
+			mockSetup: func(mockRepo *MockDataRepository, mockTech *MockTechnologyManager) {
+				t.Helper()
+				mockRepo.EXPECT().GetByID(context.Background(), 4).Return(pending, nil).Once()
+				mockTech.EXPECT().GetByID(context.Background(), 2).
+					Return(&technology.Technology{ID: 2, Name: "javascript"}, nil).Once()
+				mockTech.EXPECT().AddAliases(context.Background(), 2, []string{"htmx"}).Return(nil).Once()
+				mockRepo.EXPECT().Delete(context.Background(), 4).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, tech *technology.Technology, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, "javascript", tech.Name)
+			},
+		},
+		{
+			name:   "alias target not found",
+			id:     4,
+			params: ApproveParams{AliasOf: intPtr(99)},
+			mockSetup: func(mockRepo *MockDataRepository, mockTech *MockTechnologyManager) {
+				t.Helper()
+				mockRepo.EXPECT().GetByID(context.Background(), 4).Return(pending, nil).Once()
+				mockTech.EXPECT().GetByID(context.Background(), 99).
+					Return(nil, &technology.NotFoundError{ID: 99}).Once()
+			},
+			checkResults: func(t *testing.T, _ *technology.Technology, err error) {
+				t.Helper()
+				assert.True(t, technology.IsNotFound(err))
+			},
+		},
+		{
+			name:   "missing category",
+			id:     4,
+			params: ApproveParams{},
+			mockSetup: func(mockRepo *MockDataRepository, _ *MockTechnologyManager) {
+				t.Helper()
+				mockRepo.EXPECT().GetByID(context.Background(), 4).Return(pending, nil).Once()
+			},
+			checkResults: func(t *testing.T, _ *technology.Technology, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+			},
+		},
+		{
+			name:   "pending technology not found",
+			id:     99,
+			params: ApproveParams{Category: "frontend"},
+			mockSetup: func(mockRepo *MockDataRepository, _ *MockTechnologyManager) {
+				t.Helper()
+				mockRepo.EXPECT().GetByID(context.Background(), 99).Return(nil, &NotFoundError{ID: 99}).Once()
+			},
+			checkResults: func(t *testing.T, _ *technology.Technology, err error) {
+				t.Helper()
+				assert.True(t, IsNotFound(err))
+			},
+		},
+		{
+			name:   "create error keeps pending technology",
+			id:     4,
+			params: ApproveParams{Category: "frontend"},
+			mockSetup: func(mockRepo *MockDataRepository, mockTech *MockTechnologyManager) {
+				t.Helper()
+				mockRepo.EXPECT().GetByID(context.Background(), 4).Return(pending, nil).Once()
+				mockTech.EXPECT().CreateWithAliases(context.Background(), mock.Anything, mock.Anything).
+					Return(false, dbError).Once()
+			},
+			checkResults: func(t *testing.T, _ *technology.Technology, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			mockTech := NewMockTechnologyManager(t)
+			service := NewPendingTechnologyService(mockRepo, mockTech)
+
+			tt.mockSetup(mockRepo, mockTech)
+
+			tech, err := service.Approve(context.Background(), tt.id, tt.params)
+			tt.checkResults(t, tech, err)
+		})
+	}
+}
+
+func TestPendingTechnologyService_Record(t *testing.T) {
+	t.Parallel()
+
+	t.Run("normalizes name", func(t *testing.T) {
+		t.Parallel()
+		mockRepo := NewMockDataRepository(t)
+		service := NewPendingTechnologyService(mockRepo, NewMockTechnologyManager(t))
+
+		mockRepo.EXPECT().Record(context.Background(), &PendingTechnology{Name: "htmx"}).
+			Run(func(_ context.Context, pending *PendingTechnology) { pending.ID = 1 }).
+			Return(nil).Once()
+
+		pending, err := service.Record(context.Background(), " HTMX ", nil)
+		require.NoError(t, err)
+		assert.Equal(t, 1, pending.ID)
+	})
+
+	t.Run("keeps suggestion", func(t *testing.T) {
+		t.Parallel()
+		mockRepo := NewMockDataRepository(t)
+		service := NewPendingTechnologyService(mockRepo, NewMockTechnologyManager(t))
+		suggestion := &technology.Match{
+			Technology: &technology.Technology{ID: 3, Name: "htmx"},
+			Method:     technology.MatchSimilarity,
+			Score:      0.4,
+		}
+
+		mockRepo.EXPECT().Record(context.Background(), &PendingTechnology{
+			Name:                  "htmlx",
+			SuggestedTechnologyID: intPtr(3),
+			SuggestionScore:       floatPtr(0.4),
+		}).Return(nil).Once()
+
+		_, err := service.Record(context.Background(), "htmlx", suggestion)
+		require.NoError(t, err)
+	})
+
+	t.Run("empty name", func(t *testing.T) {
+		t.Parallel()
+		service := NewPendingTechnologyService(NewMockDataRepository(t), NewMockTechnologyManager(t))
+
+		_, err := service.Record(context.Background(), "  ", nil)
+		var validationErr *httpservice.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+}
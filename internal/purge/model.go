@@ -0,0 +1,18 @@
+// Package purge permanently removes the jobs and companies taken down a while ago. Jobs and
+// companies are only deactivated when taken down, so they can be restored, which leaves their
+// rows and the rows depending on them in the database until they are purged.
+package purge
+
+// DefaultRetentionDays is how many days a job or company stays deactivated before it is purged
+const DefaultRetentionDays = 180
+
+// Result holds the number of rows purged, or that would be purged in a dry run
+type Result struct {
+	Jobs int64
+	// JobTechnologies, JobEvents and JobRevisions are the rows of the purged jobs removed with them
+	JobTechnologies int64
+	JobEvents       int64
+	JobRevisions    int64
+	// Companies are the deactivated companies left without jobs once the jobs are purged
+	Companies int64
+}
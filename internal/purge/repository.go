@@ -0,0 +1,144 @@
+package purge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// SQL queries, $1 being the time before which the jobs and companies were deactivated. Pending
+// jobs are inactive until reviewed, so they are never purged.
+const (
+	countPurgeableQuery = `
+        WITH purged_jobs AS (
+            SELECT id FROM jobs
+            WHERE is_active = false AND status <> 'pending' AND updated_at < $1
+        )
+        SELECT
+            (SELECT COUNT(*) FROM purged_jobs),
+            (SELECT COUNT(*) FROM job_technologies WHERE job_id IN (SELECT id FROM purged_jobs)),
+            (SELECT COUNT(*) FROM job_events WHERE job_id IN (SELECT id FROM purged_jobs)),
+            (SELECT COUNT(*) FROM job_revisions WHERE job_id IN (SELECT id FROM purged_jobs)),
+            (SELECT COUNT(*) FROM companies c
+             WHERE c.is_active = false AND c.updated_at < $1
+               AND NOT EXISTS (
+                   SELECT 1 FROM jobs j WHERE j.company_id = c.id AND j.id NOT IN (SELECT id FROM purged_jobs)
+               ))
+    `
+
+	// The link checks reference their job or company without a foreign key
+	deleteJobLinkChecksQuery = `
+        DELETE FROM link_checks lc
+        USING jobs j
+        WHERE lc.kind = 'application_url' AND lc.target_id = j.id
+          AND j.is_active = false AND j.status <> 'pending' AND j.updated_at < $1
+    `
+
+	// The technologies, events, revisions and other rows of the jobs are removed by their foreign keys
+	deleteJobsQuery = `
+        DELETE FROM jobs
+        WHERE is_active = false AND status <> 'pending' AND updated_at < $1
+    `
+
+	deleteCompanyLinkChecksQuery = `
+        DELETE FROM link_checks lc
+        USING companies c
+        WHERE lc.kind = 'logo_url' AND lc.target_id = c.id
+          AND c.is_active = false AND c.updated_at < $1
+          AND NOT EXISTS (SELECT 1 FROM jobs j WHERE j.company_id = c.id)
+    `
+
+	deleteCompaniesQuery = `
+        DELETE FROM companies c
+        WHERE c.is_active = false AND c.updated_at < $1
+          AND NOT EXISTS (SELECT 1 FROM jobs j WHERE j.company_id = c.id)
+    `
+)
+
+// Database interface to support pgxpool and mocks
+type Database interface {
+	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
+	Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error)
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// rowQuerier is the part of Database and pgx.Tx the counts run on
+type rowQuerier interface {
+	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
+}
+
+// Repository handles the database operations of the purge.
+type Repository struct {
+	db Database
+}
+
+// NewRepository creates a new Repository instance.
+func NewRepository(db Database) *Repository {
+	return &Repository{db: db}
+}
+
+// CountPurgeable counts the rows Purge would remove for the same time, without removing them.
+func (r *Repository) CountPurgeable(ctx context.Context, before time.Time) (*Result, error) {
+	return countPurgeable(ctx, r.db, before)
+}
+
+// Purge removes the jobs and companies deactivated before the given time, along with the rows
+// depending on them. Companies still having jobs are kept. It runs in a single transaction.
+func (r *Repository) Purge(ctx context.Context, before time.Time) (result *Result, err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin purge transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	// The rows removed by the foreign keys are counted before the deletes
+	result, err = countPurgeable(ctx, tx, before)
+	if err != nil {
+		return nil, err
+	}
+
+	steps := []struct {
+		query string
+		desc  string
+		count *int64
+	}{
+		{deleteJobLinkChecksQuery, "delete job link checks", nil},
+		{deleteJobsQuery, "delete jobs", &result.Jobs},
+		{deleteCompanyLinkChecksQuery, "delete company link checks", nil},
+		{deleteCompaniesQuery, "delete companies", &result.Companies},
+	}
+	for _, step := range steps {
+		commandTag, execErr := tx.Exec(ctx, step.query, before)
+		if execErr != nil {
+			err = fmt.Errorf("failed to %s: %w", step.desc, execErr)
+			return nil, err
+		}
+		if step.count != nil {
+			*step.count = commandTag.RowsAffected()
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit purge transaction: %w", err)
+	}
+
+	return result, nil
+}
+
+// countPurgeable counts the rows purged for the given time
+func countPurgeable(ctx context.Context, db rowQuerier, before time.Time) (*Result, error) {
+	result := &Result{}
+	err := db.QueryRow(ctx, countPurgeableQuery, before).Scan(
+		&result.Jobs, &result.JobTechnologies, &result.JobEvents, &result.JobRevisions, &result.Companies)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count purgeable rows: %w", err)
+	}
+	return result, nil
+}
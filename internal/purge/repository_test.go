@@ -0,0 +1,100 @@
+package purge
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var countColumns = []string{"jobs", "job_technologies", "job_events", "job_revisions", "companies"}
+
+func TestRepository_Purge(t *testing.T) {
+	t.Parallel()
+	before := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, result *Result, err error)
+	}{
+		{
+			name: "jobs and companies purged",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(countPurgeableQuery)).WithArgs(before).
+					WillReturnRows(pgxmock.NewRows(countColumns).AddRow(int64(3), int64(9), int64(40), int64(5), int64(1)))
+				mock.ExpectExec(regexp.QuoteMeta(deleteJobLinkChecksQuery)).WithArgs(before).
+					WillReturnResult(pgxmock.NewResult("DELETE", 3))
+				mock.ExpectExec(regexp.QuoteMeta(deleteJobsQuery)).WithArgs(before).
+					WillReturnResult(pgxmock.NewResult("DELETE", 3))
+				mock.ExpectExec(regexp.QuoteMeta(deleteCompanyLinkChecksQuery)).WithArgs(before).
+					WillReturnResult(pgxmock.NewResult("DELETE", 1))
+				mock.ExpectExec(regexp.QuoteMeta(deleteCompaniesQuery)).WithArgs(before).
+					WillReturnResult(pgxmock.NewResult("DELETE", 1))
+				mock.ExpectCommit()
+			},
+			checkResults: func(t *testing.T, result *Result, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, &Result{Jobs: 3, JobTechnologies: 9, JobEvents: 40, JobRevisions: 5, Companies: 1}, result)
+			},
+		},
+		{
+			name: "failed delete rolls back",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(countPurgeableQuery)).WithArgs(before).
+					WillReturnRows(pgxmock.NewRows(countColumns).AddRow(int64(3), int64(9), int64(40), int64(5), int64(1)))
+				mock.ExpectExec(regexp.QuoteMeta(deleteJobLinkChecksQuery)).WithArgs(before).
+					WillReturnResult(pgxmock.NewResult("DELETE", 3))
+				mock.ExpectExec(regexp.QuoteMeta(deleteJobsQuery)).WithArgs(before).WillReturnError(dbError)
+				mock.ExpectRollback()
+			},
+			checkResults: func(t *testing.T, _ *Result, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+				assert.ErrorContains(t, err, "delete jobs")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+			tt.mockSetup(mockDB)
+
+			result, err := NewRepository(mockDB).Purge(context.Background(), before)
+			tt.checkResults(t, result, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_CountPurgeable(t *testing.T) {
+	t.Parallel()
+	before := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	mockDB, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	mockDB.ExpectQuery(regexp.QuoteMeta(countPurgeableQuery)).WithArgs(before).
+		WillReturnRows(pgxmock.NewRows(countColumns).AddRow(int64(2), int64(6), int64(0), int64(1), int64(0)))
+
+	result, err := NewRepository(mockDB).CountPurgeable(context.Background(), before)
+	require.NoError(t, err)
+	assert.Equal(t, &Result{Jobs: 2, JobTechnologies: 6, JobRevisions: 1}, result)
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
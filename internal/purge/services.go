@@ -0,0 +1,42 @@
+package purge
+
+import (
+	"context"
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// DataRepository interface to purge the deactivated jobs and companies.
+type DataRepository interface {
+	CountPurgeable(ctx context.Context, before time.Time) (*Result, error)
+	Purge(ctx context.Context, before time.Time) (*Result, error)
+}
+
+// PurgeService holds the business logic of the purge.
+type PurgeService struct {
+	repo DataRepository
+	now  func() time.Time
+}
+
+// NewPurgeService creates a new instance of PurgeService.
+func NewPurgeService(repo DataRepository) *PurgeService {
+	return &PurgeService{repo: repo, now: time.Now}
+}
+
+// Purge permanently removes the jobs and companies deactivated more than retentionDays days ago,
+// DefaultRetentionDays when zero. A dry run only counts what would be removed.
+func (s *PurgeService) Purge(ctx context.Context, retentionDays int, dryRun bool) (*Result, error) {
+	if retentionDays == 0 {
+		retentionDays = DefaultRetentionDays
+	}
+	if retentionDays < 0 {
+		return nil, &httpservice.ValidationError{Errors: []string{"retention days must be positive"}}
+	}
+
+	before := s.now().UTC().AddDate(0, 0, -retentionDays)
+	if dryRun {
+		return s.repo.CountPurgeable(ctx, before)
+	}
+	return s.repo.Purge(ctx, before)
+}
@@ -0,0 +1,76 @@
+package purge
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+func TestPurgeService_Purge(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 7, 13, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		retentionDays int
+		dryRun        bool
+		mockSetup     func(mockRepo *MockDataRepository)
+		checkResults  func(t *testing.T, result *Result, err error)
+	}{
+		{
+			name: "default retention",
+			mockSetup: func(mockRepo *MockDataRepository) {
+				mockRepo.EXPECT().Purge(context.Background(), now.AddDate(0, 0, -DefaultRetentionDays)).
+					Return(&Result{Jobs: 3}, nil).Once()
+			},
+			checkResults: func(t *testing.T, result *Result, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, int64(3), result.Jobs)
+			},
+		},
+		{
+			name:          "dry run only counts",
+			retentionDays: 30,
+			dryRun:        true,
+			mockSetup: func(mockRepo *MockDataRepository) {
+				mockRepo.EXPECT().CountPurgeable(context.Background(), time.Date(2024, 6, 13, 10, 30, 0, 0, time.UTC)).
+					Return(&Result{Jobs: 5, Companies: 1}, nil).Once()
+			},
+			checkResults: func(t *testing.T, result *Result, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, &Result{Jobs: 5, Companies: 1}, result)
+			},
+		},
+		{
+			name:          "negative retention",
+			retentionDays: -1,
+			mockSetup:     func(_ *MockDataRepository) {},
+			checkResults: func(t *testing.T, _ *Result, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			tt.mockSetup(mockRepo)
+
+			service := NewPurgeService(mockRepo)
+			service.now = func() time.Time { return now }
+
+			result, err := service.Purge(context.Background(), tt.retentionDays, tt.dryRun)
+			tt.checkResults(t, result, err)
+		})
+	}
+}
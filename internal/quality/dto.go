@@ -0,0 +1,130 @@
+package quality
+
+import (
+	"time"
+)
+
+// Data Transfer Objects (DTOs) for the data quality API layer.
+
+// ListRequest represents the query parameters of the data quality lists
+type ListRequest struct {
+	Limit int `form:"limit" binding:"omitempty,min=1,max=100" example:"20"`
+}
+
+// SummaryResponse represents the API response for the data quality summary
+type SummaryResponse struct {
+	ActiveJobs                      int `json:"active_jobs" example:"850"`
+	JobsMissingTechnologies         int `json:"jobs_missing_technologies" example:"12"`
+	JobsWithUnknownValues           int `json:"jobs_with_unknown_values" example:"3"`
+	JobsWithInferredExperienceLevel int `json:"jobs_with_inferred_experience_level" example:"40"`
+	CompaniesWithoutLogos           int `json:"companies_without_logos" example:"2"`
+	PendingTechnologies             int `json:"pending_technologies" example:"7"`
+	PendingTechnologyDetections     int `json:"pending_technology_detections" example:"25"`
+	// Sources breaks the job counts down by the source the jobs were scraped from
+	Sources []*SourceSummaryResponse `json:"sources"`
+}
+
+// SourceSummaryResponse represents the API response for the data quality summary of a source
+type SourceSummaryResponse struct {
+	// Source is empty for the jobs whose source isn't known
+	Source                          string `json:"source" example:"linkedin"`
+	ActiveJobs                      int    `json:"active_jobs" example:"420"`
+	JobsMissingTechnologies         int    `json:"jobs_missing_technologies" example:"4"`
+	JobsWithUnknownValues           int    `json:"jobs_with_unknown_values" example:"1"`
+	JobsWithInferredExperienceLevel int    `json:"jobs_with_inferred_experience_level" example:"25"`
+}
+
+// JobIssueResponse represents the API response for a job having a data quality issue
+type JobIssueResponse struct {
+	JobID           int    `json:"job_id" example:"42"`
+	Title           string `json:"title" example:"Backend Developer"`
+	CompanyName     string `json:"company_name" example:"Tech Corp"`
+	ExperienceLevel string `json:"experience_level" example:"Mid-level"`
+	EmploymentType  string `json:"employment_type" example:"Full-time"`
+	WorkMode        string `json:"work_mode" example:"Remote"`
+	// UnknownFields are the fields whose value isn't a canonical one, listed for the jobs with unknown values
+	UnknownFields []string  `json:"unknown_fields,omitempty" example:"work_mode"`
+	CreatedAt     time.Time `json:"created_at" example:"2024-01-15T06:00:00Z"`
+}
+
+// JobIssueListResponse represents the API response listing jobs having a data quality issue
+type JobIssueListResponse struct {
+	Data []*JobIssueResponse `json:"data"`
+}
+
+// CompanyIssueResponse represents the API response for a company without a logo
+type CompanyIssueResponse struct {
+	CompanyID int    `json:"company_id" example:"3"`
+	Name      string `json:"name" example:"Tech Corp"`
+	Slug      string `json:"slug" example:"tech-corp"`
+	LogoURL   string `json:"logo_url" example:"https://techcorp.com/logo.png"`
+	// LogoStatusCode and LogoError are those of the last check of the logo, omitted when unchecked
+	LogoStatusCode *int    `json:"logo_status_code,omitempty" example:"404"`
+	LogoError      *string `json:"logo_error,omitempty" example:"responded with status 404"`
+	ActiveJobs     int     `json:"active_jobs" example:"5"`
+}
+
+// CompanyIssueListResponse represents the API response listing companies without a logo
+type CompanyIssueListResponse struct {
+	Data []*CompanyIssueResponse `json:"data"`
+}
+
+// MapSummaryToResponse converts the data quality summary to its API response format
+func MapSummaryToResponse(summary *Summary) *SummaryResponse {
+	sources := make([]*SourceSummaryResponse, 0, len(summary.Sources))
+	for _, source := range summary.Sources {
+		sources = append(sources, &SourceSummaryResponse{
+			Source:                          source.Source,
+			ActiveJobs:                      source.ActiveJobs,
+			JobsMissingTechnologies:         source.JobsMissingTechnologies,
+			JobsWithUnknownValues:           source.JobsWithUnknownValues,
+			JobsWithInferredExperienceLevel: source.JobsWithInferredExperienceLevel,
+		})
+	}
+
+	return &SummaryResponse{
+		ActiveJobs:                      summary.ActiveJobs,
+		JobsMissingTechnologies:         summary.JobsMissingTechnologies,
+		JobsWithUnknownValues:           summary.JobsWithUnknownValues,
+		JobsWithInferredExperienceLevel: summary.JobsWithInferredExperienceLevel,
+		CompaniesWithoutLogos:           summary.CompaniesWithoutLogos,
+		PendingTechnologies:             summary.PendingTechnologies,
+		PendingTechnologyDetections:     summary.PendingTechnologyDetections,
+		Sources:                         sources,
+	}
+}
+
+// MapJobIssuesToResponse converts jobs having a data quality issue to the list API response format
+func MapJobIssuesToResponse(issues []*JobIssue) *JobIssueListResponse {
+	data := make([]*JobIssueResponse, 0, len(issues))
+	for _, issue := range issues {
+		data = append(data, &JobIssueResponse{
+			JobID:           issue.JobID,
+			Title:           issue.Title,
+			CompanyName:     issue.CompanyName,
+			ExperienceLevel: issue.ExperienceLevel,
+			EmploymentType:  issue.EmploymentType,
+			WorkMode:        issue.WorkMode,
+			UnknownFields:   issue.UnknownFields,
+			CreatedAt:       issue.CreatedAt,
+		})
+	}
+	return &JobIssueListResponse{Data: data}
+}
+
+// MapCompanyIssuesToResponse converts companies without a logo to the list API response format
+func MapCompanyIssuesToResponse(issues []*CompanyIssue) *CompanyIssueListResponse {
+	data := make([]*CompanyIssueResponse, 0, len(issues))
+	for _, issue := range issues {
+		data = append(data, &CompanyIssueResponse{
+			CompanyID:      issue.CompanyID,
+			Name:           issue.Name,
+			Slug:           issue.Slug,
+			LogoURL:        issue.LogoURL,
+			LogoStatusCode: issue.LogoStatusCode,
+			LogoError:      issue.LogoError,
+			ActiveJobs:     issue.ActiveJobs,
+		})
+	}
+	return &CompanyIssueListResponse{Data: data}
+}
@@ -0,0 +1,144 @@
+package quality
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// Constants for data quality routes and endpoints
+const (
+	QualityRoute                 = "/quality"
+	JobsMissingTechnologiesRoute = QualityRoute + "/jobs-missing-technologies"
+	JobsWithUnknownValuesRoute   = QualityRoute + "/jobs-unknown-values"
+	CompaniesWithoutLogosRoute   = QualityRoute + "/companies-without-logos"
+)
+
+// Handler handles HTTP requests for the data quality indicators
+type Handler struct {
+	service *QualityService
+}
+
+// NewHandler creates a new data quality handler
+func NewHandler(service *QualityService) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterAdminRoutes registers the data quality admin routes with the given (protected) router group
+func (h *Handler) RegisterAdminRoutes(rg *gin.RouterGroup) {
+	rg.GET(QualityRoute, h.GetSummary)
+	rg.GET(JobsMissingTechnologiesRoute, h.ListJobsMissingTechnologies)
+	rg.GET(JobsWithUnknownValuesRoute, h.ListJobsWithUnknownValues)
+	rg.GET(CompaniesWithoutLogosRoute, h.ListCompaniesWithoutLogos)
+}
+
+// GetSummary godoc
+// @Summary Get the data quality summary
+// @Description Counts the active jobs missing technologies, with unknown experience levels, employment
+// @Description types or work modes, or with an inferred experience level, the active companies without
+// @Description a logo and the technologies and technology detections waiting for review. The job
+// @Description counts are also broken down by the source the jobs were scraped from.
+// @Tags admin
+// @Produce json
+// @Security AdminAPIKey
+// @Success 200 {object} SummaryResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/quality [get]
+func (h *Handler) GetSummary(c *gin.Context) {
+	summary, err := h.service.Summary(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapSummaryToResponse(summary))
+}
+
+// ListJobsMissingTechnologies godoc
+// @Summary List the jobs missing technologies
+// @Description Lists the active jobs not associated with any technology, newest first
+// @Tags admin
+// @Produce json
+// @Security AdminAPIKey
+// @Param limit query int false "Number of jobs to return (max 100)" default(20) example(20)
+// @Success 200 {object} JobIssueListResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/quality/jobs-missing-technologies [get]
+func (h *Handler) ListJobsMissingTechnologies(c *gin.Context) {
+	var req ListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	issues, err := h.service.JobsMissingTechnologies(c.Request.Context(), req.Limit)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapJobIssuesToResponse(issues))
+}
+
+// ListJobsWithUnknownValues godoc
+// @Summary List the jobs with unknown values
+// @Description Lists the active jobs whose experience level, employment type or work mode isn't one of
+// @Description the canonical values, newest first, with the fields at fault
+// @Tags admin
+// @Produce json
+// @Security AdminAPIKey
+// @Param limit query int false "Number of jobs to return (max 100)" default(20) example(20)
+// @Success 200 {object} JobIssueListResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/quality/jobs-unknown-values [get]
+func (h *Handler) ListJobsWithUnknownValues(c *gin.Context) {
+	var req ListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	issues, err := h.service.JobsWithUnknownValues(c.Request.Context(), req.Limit)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapJobIssuesToResponse(issues))
+}
+
+// ListCompaniesWithoutLogos godoc
+// @Summary List the companies without logos
+// @Description Lists the active companies without a logo URL or whose logo failed its last check,
+// @Description the ones with the most active jobs first
+// @Tags admin
+// @Produce json
+// @Security AdminAPIKey
+// @Param limit query int false "Number of companies to return (max 100)" default(20) example(20)
+// @Success 200 {object} CompanyIssueListResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/quality/companies-without-logos [get]
+func (h *Handler) ListCompaniesWithoutLogos(c *gin.Context) {
+	var req ListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	issues, err := h.service.CompaniesWithoutLogos(c.Request.Context(), req.Limit)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapCompanyIssuesToResponse(issues))
+}
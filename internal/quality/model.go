@@ -0,0 +1,71 @@
+// Package quality provides the data quality indicators of the jobs and companies, such as the
+// jobs missing technologies or having unknown values, so admins can follow them on a dashboard.
+package quality
+
+import (
+	"time"
+)
+
+// Fields of the jobs holding a canonical value
+const (
+	FieldExperienceLevel = "experience_level"
+	FieldEmploymentType  = "employment_type"
+	FieldWorkMode        = "work_mode"
+)
+
+// CanonicalValues holds the values the job fields are expected to have
+type CanonicalValues struct {
+	ExperienceLevels []string
+	EmploymentTypes  []string
+	WorkModes        []string
+}
+
+// Summary holds the number of records having each data quality issue. Jobs counts only cover
+// the active jobs, and company counts the active companies.
+type Summary struct {
+	ActiveJobs                      int
+	JobsMissingTechnologies         int
+	JobsWithUnknownValues           int
+	JobsWithInferredExperienceLevel int
+	CompaniesWithoutLogos           int
+	PendingTechnologies             int
+	PendingTechnologyDetections     int
+	// Sources breaks the job counts down by the source the jobs were scraped from
+	Sources []*SourceSummary
+}
+
+// SourceSummary holds the number of active jobs of a source having each data quality issue.
+// Source is empty for the jobs whose source isn't known.
+type SourceSummary struct {
+	Source                          string
+	ActiveJobs                      int
+	JobsMissingTechnologies         int
+	JobsWithUnknownValues           int
+	JobsWithInferredExperienceLevel int
+}
+
+// JobIssue is an active job having a data quality issue
+type JobIssue struct {
+	JobID           int
+	Title           string
+	CompanyName     string
+	ExperienceLevel string
+	EmploymentType  string
+	WorkMode        string
+	CreatedAt       time.Time
+	// UnknownFields lists the fields whose value isn't a canonical one
+	UnknownFields []string
+}
+
+// CompanyIssue is an active company without a logo, either because it has no logo URL or
+// because the last check of its logo failed
+type CompanyIssue struct {
+	CompanyID int
+	Name      string
+	Slug      string
+	LogoURL   string
+	// LogoStatusCode and LogoError are those of the last check of the logo, nil when unchecked
+	LogoStatusCode *int
+	LogoError      *string
+	ActiveJobs     int
+}
@@ -0,0 +1,230 @@
+package quality
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// SQL query constants
+const (
+	// Conditions of the data quality issues, on jobs aliased j and companies aliased c. The
+	// canonical experience levels, employment types and work modes are passed as text arrays.
+	missingTechnologiesCondition = `NOT EXISTS (SELECT 1 FROM job_technologies jt WHERE jt.job_id = j.id)`
+	unknownValuesCondition       = `(j.experience_level <> ALL($1::text[]) OR j.employment_type <> ALL($2::text[])
+              OR j.work_mode <> ALL($3::text[]))`
+	missingLogoCondition = `(c.logo_url = '' OR lc.ok = false)`
+
+	getSummaryQuery = `
+        SELECT
+            (SELECT COUNT(*) FROM jobs j WHERE j.is_active) AS active_jobs,
+            (SELECT COUNT(*) FROM jobs j WHERE j.is_active AND ` + missingTechnologiesCondition + `)
+                AS jobs_missing_technologies,
+            (SELECT COUNT(*) FROM jobs j WHERE j.is_active AND ` + unknownValuesCondition + `)
+                AS jobs_with_unknown_values,
+            (SELECT COUNT(*) FROM jobs j WHERE j.is_active AND j.experience_level_inferred)
+                AS jobs_with_inferred_experience_level,
+            (SELECT COUNT(*) FROM companies c
+             LEFT JOIN link_checks lc ON lc.kind = 'logo_url' AND lc.target_id = c.id
+             WHERE c.is_active AND ` + missingLogoCondition + `) AS companies_without_logos,
+            (SELECT COUNT(*) FROM pending_technologies) AS pending_technologies,
+            (SELECT COUNT(*) FROM technology_detections WHERE status = 'pending') AS pending_technology_detections
+    `
+
+	// Sources with the most active jobs first
+	listSourceSummariesQuery = `
+        SELECT COALESCE(j.source, '') AS source,
+               COUNT(*) AS active_jobs,
+               COUNT(*) FILTER (WHERE ` + missingTechnologiesCondition + `) AS jobs_missing_technologies,
+               COUNT(*) FILTER (WHERE ` + unknownValuesCondition + `) AS jobs_with_unknown_values,
+               COUNT(*) FILTER (WHERE j.experience_level_inferred) AS jobs_with_inferred_experience_level
+        FROM jobs j
+        WHERE j.is_active
+        GROUP BY 1
+        ORDER BY active_jobs DESC, source
+    `
+
+	selectJobIssueBaseQuery = `
+        SELECT j.id, j.title, c.name, j.experience_level, j.employment_type, j.work_mode, j.created_at
+        FROM jobs j
+        JOIN companies c ON c.id = j.company_id
+    `
+
+	listJobsMissingTechnologiesQuery = selectJobIssueBaseQuery + `
+        WHERE j.is_active AND ` + missingTechnologiesCondition + `
+        ORDER BY j.created_at DESC, j.id
+        LIMIT $1
+    `
+
+	listJobsWithUnknownValuesQuery = selectJobIssueBaseQuery + `
+        WHERE j.is_active AND ` + unknownValuesCondition + `
+        ORDER BY j.created_at DESC, j.id
+        LIMIT $4
+    `
+
+	// Companies with the most active jobs first, the ones whose missing logo shows the most
+	listCompaniesWithoutLogosQuery = `
+        SELECT c.id, c.name, c.slug, c.logo_url, lc.status_code, lc.error,
+               (SELECT COUNT(*) FROM jobs j WHERE j.company_id = c.id AND j.is_active) AS active_jobs
+        FROM companies c
+        LEFT JOIN link_checks lc ON lc.kind = 'logo_url' AND lc.target_id = c.id
+        WHERE c.is_active AND ` + missingLogoCondition + `
+        ORDER BY active_jobs DESC, c.name
+        LIMIT $1
+    `
+)
+
+// Database interface to support pgxpool and mocks
+type Database interface {
+	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+}
+
+// Repository handles the data quality queries.
+type Repository struct {
+	db Database
+}
+
+// NewRepository creates a new Repository instance.
+func NewRepository(db Database) *Repository {
+	return &Repository{db: db}
+}
+
+// GetSummary counts the records having each data quality issue, and the jobs having each one by
+// source. Job values not in values are unknown.
+func (r *Repository) GetSummary(ctx context.Context, values *CanonicalValues) (*Summary, error) {
+	summary := &Summary{}
+	err := r.db.QueryRow(ctx, getSummaryQuery, values.ExperienceLevels, values.EmploymentTypes, values.WorkModes).Scan(
+		&summary.ActiveJobs,
+		&summary.JobsMissingTechnologies,
+		&summary.JobsWithUnknownValues,
+		&summary.JobsWithInferredExperienceLevel,
+		&summary.CompaniesWithoutLogos,
+		&summary.PendingTechnologies,
+		&summary.PendingTechnologyDetections,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get data quality summary: %w", err)
+	}
+
+	summary.Sources, err = r.listSourceSummaries(ctx, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+// listSourceSummaries counts the active jobs having each data quality issue by source
+func (r *Repository) listSourceSummaries(ctx context.Context, values *CanonicalValues) ([]*SourceSummary, error) {
+	rows, err := r.db.Query(ctx, listSourceSummariesQuery,
+		values.ExperienceLevels, values.EmploymentTypes, values.WorkModes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list data quality summaries by source: %w", err)
+	}
+	defer rows.Close()
+
+	var sources []*SourceSummary
+	for rows.Next() {
+		source := &SourceSummary{}
+		if err = rows.Scan(
+			&source.Source,
+			&source.ActiveJobs,
+			&source.JobsMissingTechnologies,
+			&source.JobsWithUnknownValues,
+			&source.JobsWithInferredExperienceLevel,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan source row: %w", err)
+		}
+		sources = append(sources, source)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating source rows: %w", err)
+	}
+
+	return sources, nil
+}
+
+// ListJobsMissingTechnologies retrieves the active jobs without technologies, newest first.
+func (r *Repository) ListJobsMissingTechnologies(ctx context.Context, limit int) ([]*JobIssue, error) {
+	rows, err := r.db.Query(ctx, listJobsMissingTechnologiesQuery, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jobs missing technologies: %w", err)
+	}
+	return collectJobIssues(rows)
+}
+
+// ListJobsWithUnknownValues retrieves the active jobs having a value not in values, newest first.
+func (r *Repository) ListJobsWithUnknownValues(
+	ctx context.Context, values *CanonicalValues, limit int,
+) ([]*JobIssue, error) {
+	rows, err := r.db.Query(ctx, listJobsWithUnknownValuesQuery,
+		values.ExperienceLevels, values.EmploymentTypes, values.WorkModes, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jobs with unknown values: %w", err)
+	}
+	return collectJobIssues(rows)
+}
+
+// ListCompaniesWithoutLogos retrieves the active companies without a logo, the ones with the
+// most active jobs first.
+func (r *Repository) ListCompaniesWithoutLogos(ctx context.Context, limit int) ([]*CompanyIssue, error) {
+	rows, err := r.db.Query(ctx, listCompaniesWithoutLogosQuery, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list companies without logos: %w", err)
+	}
+	defer rows.Close()
+
+	var companies []*CompanyIssue
+	for rows.Next() {
+		company := &CompanyIssue{}
+		if err = rows.Scan(
+			&company.CompanyID,
+			&company.Name,
+			&company.Slug,
+			&company.LogoURL,
+			&company.LogoStatusCode,
+			&company.LogoError,
+			&company.ActiveJobs,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan company row: %w", err)
+		}
+		companies = append(companies, company)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating company rows: %w", err)
+	}
+
+	return companies, nil
+}
+
+// collectJobIssues scans the job issue rows and closes them
+func collectJobIssues(rows pgx.Rows) ([]*JobIssue, error) {
+	defer rows.Close()
+
+	var issues []*JobIssue
+	for rows.Next() {
+		issue := &JobIssue{}
+		if err := rows.Scan(
+			&issue.JobID,
+			&issue.Title,
+			&issue.CompanyName,
+			&issue.ExperienceLevel,
+			&issue.EmploymentType,
+			&issue.WorkMode,
+			&issue.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan job row: %w", err)
+		}
+		issues = append(issues, issue)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating job rows: %w", err)
+	}
+
+	return issues, nil
+}
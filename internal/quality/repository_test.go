@@ -0,0 +1,213 @@
+package quality
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testValues = &CanonicalValues{
+	ExperienceLevels: []string{"Junior", "Senior"},
+	EmploymentTypes:  []string{"Full-time"},
+	WorkModes:        []string{"Remote", "Onsite"},
+}
+
+func TestRepository_GetSummary(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, summary *Summary, err error)
+	}{
+		{
+			name: "summary found",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getSummaryQuery)).
+					WithArgs(testValues.ExperienceLevels, testValues.EmploymentTypes, testValues.WorkModes).
+					WillReturnRows(pgxmock.NewRows([]string{
+						"active_jobs", "jobs_missing_technologies", "jobs_with_unknown_values",
+						"jobs_with_inferred_experience_level", "companies_without_logos", "pending_technologies",
+						"pending_technology_detections",
+					}).AddRow(850, 12, 3, 40, 2, 7, 25))
+				mock.ExpectQuery(regexp.QuoteMeta(listSourceSummariesQuery)).
+					WithArgs(testValues.ExperienceLevels, testValues.EmploymentTypes, testValues.WorkModes).
+					WillReturnRows(pgxmock.NewRows([]string{
+						"source", "active_jobs", "jobs_missing_technologies", "jobs_with_unknown_values",
+						"jobs_with_inferred_experience_level",
+					}).AddRow("linkedin", 600, 10, 3, 30).AddRow("", 250, 2, 0, 10))
+			},
+			checkResults: func(t *testing.T, summary *Summary, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, &Summary{
+					ActiveJobs:                      850,
+					JobsMissingTechnologies:         12,
+					JobsWithUnknownValues:           3,
+					JobsWithInferredExperienceLevel: 40,
+					CompaniesWithoutLogos:           2,
+					PendingTechnologies:             7,
+					PendingTechnologyDetections:     25,
+					Sources: []*SourceSummary{
+						{Source: "linkedin", ActiveJobs: 600, JobsMissingTechnologies: 10, JobsWithUnknownValues: 3,
+							JobsWithInferredExperienceLevel: 30},
+						{Source: "", ActiveJobs: 250, JobsMissingTechnologies: 2, JobsWithInferredExperienceLevel: 10},
+					},
+				}, summary)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getSummaryQuery)).
+					WithArgs(testValues.ExperienceLevels, testValues.EmploymentTypes, testValues.WorkModes).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ *Summary, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+		{
+			name: "sources database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getSummaryQuery)).
+					WithArgs(testValues.ExperienceLevels, testValues.EmploymentTypes, testValues.WorkModes).
+					WillReturnRows(pgxmock.NewRows([]string{
+						"active_jobs", "jobs_missing_technologies", "jobs_with_unknown_values",
+						"jobs_with_inferred_experience_level", "companies_without_logos", "pending_technologies",
+						"pending_technology_detections",
+					}).AddRow(850, 12, 3, 40, 2, 7, 25))
+				mock.ExpectQuery(regexp.QuoteMeta(listSourceSummariesQuery)).
+					WithArgs(testValues.ExperienceLevels, testValues.EmploymentTypes, testValues.WorkModes).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ *Summary, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			summary, err := repo.GetSummary(context.Background(), testValues)
+			tt.checkResults(t, summary, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_ListJobsWithUnknownValues(t *testing.T) {
+	t.Parallel()
+	createdAt := time.Date(2024, 1, 15, 6, 0, 0, 0, time.UTC)
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, issues []*JobIssue, err error)
+	}{
+		{
+			name: "jobs found",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(listJobsWithUnknownValuesQuery)).
+					WithArgs(testValues.ExperienceLevels, testValues.EmploymentTypes, testValues.WorkModes, 20).
+					WillReturnRows(pgxmock.NewRows([]string{
+						"id", "title", "name", "experience_level", "employment_type", "work_mode", "created_at",
+					}).AddRow(42, "Backend Developer", "Tech Corp", "Senior", "Full-time", "Anywhere", createdAt))
+			},
+			checkResults: func(t *testing.T, issues []*JobIssue, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, []*JobIssue{{
+					JobID:           42,
+					Title:           "Backend Developer",
+					CompanyName:     "Tech Corp",
+					ExperienceLevel: "Senior",
+					EmploymentType:  "Full-time",
+					WorkMode:        "Anywhere",
+					CreatedAt:       createdAt,
+				}}, issues)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(listJobsWithUnknownValuesQuery)).
+					WithArgs(testValues.ExperienceLevels, testValues.EmploymentTypes, testValues.WorkModes, 20).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ []*JobIssue, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			issues, err := repo.ListJobsWithUnknownValues(context.Background(), testValues, 20)
+			tt.checkResults(t, issues, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_ListCompaniesWithoutLogos(t *testing.T) {
+	t.Parallel()
+	statusCode := 404
+	logoError := "responded with status 404"
+
+	mockDB, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	mockDB.ExpectQuery(regexp.QuoteMeta(listCompaniesWithoutLogosQuery)).
+		WithArgs(20).
+		WillReturnRows(pgxmock.NewRows([]string{
+			"id", "name", "slug", "logo_url", "status_code", "error", "active_jobs",
+		}).
+			AddRow(3, "Tech Corp", "tech-corp", "https://techcorp.com/logo.png", &statusCode, &logoError, 5).
+			AddRow(4, "Startup", "startup", "", nil, nil, 1))
+
+	companies, err := NewRepository(mockDB).ListCompaniesWithoutLogos(context.Background(), 20)
+	require.NoError(t, err)
+	require.Len(t, companies, 2)
+	assert.Equal(t, &statusCode, companies[0].LogoStatusCode)
+	assert.Equal(t, &logoError, companies[0].LogoError)
+	assert.Nil(t, companies[1].LogoStatusCode)
+	assert.Equal(t, 1, companies[1].ActiveJobs)
+
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
@@ -0,0 +1,93 @@
+package quality
+
+import (
+	"context"
+	"slices"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+)
+
+// Constants for the data quality lists
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+// DataRepository interface to query the data quality indicators.
+type DataRepository interface {
+	GetSummary(ctx context.Context, values *CanonicalValues) (*Summary, error)
+	ListJobsMissingTechnologies(ctx context.Context, limit int) ([]*JobIssue, error)
+	ListJobsWithUnknownValues(ctx context.Context, values *CanonicalValues, limit int) ([]*JobIssue, error)
+	ListCompaniesWithoutLogos(ctx context.Context, limit int) ([]*CompanyIssue, error)
+}
+
+// QualityService holds the business logic for the data quality indicators.
+type QualityService struct {
+	repo   DataRepository
+	values *CanonicalValues
+}
+
+// NewQualityService creates a new instance of QualityService, checking the job values against
+// the canonical values of the jobs package
+func NewQualityService(repo DataRepository) *QualityService {
+	return &QualityService{
+		repo: repo,
+		values: &CanonicalValues{
+			ExperienceLevels: jobs.ExperienceLevels(),
+			EmploymentTypes:  jobs.EmploymentTypes(),
+			WorkModes:        jobs.WorkModes(),
+		},
+	}
+}
+
+// Summary counts the records having each data quality issue.
+func (s *QualityService) Summary(ctx context.Context) (*Summary, error) {
+	return s.repo.GetSummary(ctx, s.values)
+}
+
+// JobsMissingTechnologies lists the active jobs without technologies, up to limit.
+func (s *QualityService) JobsMissingTechnologies(ctx context.Context, limit int) ([]*JobIssue, error) {
+	return s.repo.ListJobsMissingTechnologies(ctx, clampLimit(limit))
+}
+
+// JobsWithUnknownValues lists the active jobs having an experience level, employment type or
+// work mode that isn't a canonical one, up to limit, with the fields at fault.
+func (s *QualityService) JobsWithUnknownValues(ctx context.Context, limit int) ([]*JobIssue, error) {
+	issues, err := s.repo.ListJobsWithUnknownValues(ctx, s.values, clampLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, issue := range issues {
+		issue.UnknownFields = s.unknownFields(issue)
+	}
+	return issues, nil
+}
+
+// CompaniesWithoutLogos lists the active companies without a logo, up to limit.
+func (s *QualityService) CompaniesWithoutLogos(ctx context.Context, limit int) ([]*CompanyIssue, error) {
+	return s.repo.ListCompaniesWithoutLogos(ctx, clampLimit(limit))
+}
+
+// unknownFields returns the fields of the job whose value isn't a canonical one
+func (s *QualityService) unknownFields(issue *JobIssue) []string {
+	var fields []string
+	if !slices.Contains(s.values.ExperienceLevels, issue.ExperienceLevel) {
+		fields = append(fields, FieldExperienceLevel)
+	}
+	if !slices.Contains(s.values.EmploymentTypes, issue.EmploymentType) {
+		fields = append(fields, FieldEmploymentType)
+	}
+	if !slices.Contains(s.values.WorkModes, issue.WorkMode) {
+		fields = append(fields, FieldWorkMode)
+	}
+	return fields
+}
+
+// clampLimit defaults limit to DefaultLimit and caps it to MaxLimit
+func clampLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultLimit
+	}
+	return min(limit, MaxLimit)
+}
@@ -0,0 +1,92 @@
+package quality
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQualityService_JobsWithUnknownValues(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		limit        int
+		mockSetup    func(mockRepo *MockDataRepository)
+		checkResults func(t *testing.T, issues []*JobIssue, err error)
+	}{
+		{
+			name:  "fields at fault set",
+			limit: 0,
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().ListJobsWithUnknownValues(context.Background(),
+					mock.MatchedBy(func(values *CanonicalValues) bool {
+						return assert.Contains(t, values.WorkModes, "Remote")
+					}), DefaultLimit).
+					Return([]*JobIssue{
+						{JobID: 1, ExperienceLevel: "Senior", EmploymentType: "Full-time", WorkMode: "Anywhere"},
+						{JobID: 2, ExperienceLevel: "Rockstar", EmploymentType: "Gig", WorkMode: "Remote"},
+					}, nil).Once()
+			},
+			checkResults: func(t *testing.T, issues []*JobIssue, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, issues, 2)
+				assert.Equal(t, []string{FieldWorkMode}, issues[0].UnknownFields)
+				assert.Equal(t, []string{FieldExperienceLevel, FieldEmploymentType}, issues[1].UnknownFields)
+			},
+		},
+		{
+			name:  "repository error",
+			limit: 500,
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().ListJobsWithUnknownValues(context.Background(), mock.Anything, MaxLimit).
+					Return(nil, dbError).Once()
+			},
+			checkResults: func(t *testing.T, _ []*JobIssue, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			service := NewQualityService(mockRepo)
+
+			tt.mockSetup(mockRepo)
+
+			issues, err := service.JobsWithUnknownValues(context.Background(), tt.limit)
+			tt.checkResults(t, issues, err)
+		})
+	}
+}
+
+func TestQualityService_Limits(t *testing.T) {
+	t.Parallel()
+
+	mockRepo := NewMockDataRepository(t)
+	service := NewQualityService(mockRepo)
+
+	mockRepo.EXPECT().ListJobsMissingTechnologies(context.Background(), DefaultLimit).
+		Return([]*JobIssue{{JobID: 1}}, nil).Once()
+	mockRepo.EXPECT().ListCompaniesWithoutLogos(context.Background(), 5).
+		Return([]*CompanyIssue{{CompanyID: 3}}, nil).Once()
+
+	issues, err := service.JobsMissingTechnologies(context.Background(), -1)
+	require.NoError(t, err)
+	assert.Len(t, issues, 1)
+
+	companies, err := service.CompaniesWithoutLogos(context.Background(), 5)
+	require.NoError(t, err)
+	assert.Len(t, companies, 1)
+}
@@ -0,0 +1,125 @@
+package queue
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	neturl "net/url"
+	"strings"
+)
+
+// Content types of the Kafka REST proxy API v2
+const (
+	kafkaContentType = "application/vnd.kafka.json.v2+json"
+	kafkaAccept      = "application/vnd.kafka.v2+json"
+)
+
+// kafkaRecord is a record produced through the REST proxy
+type kafkaRecord struct {
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+// kafkaProduceRequest is the body of a produce request
+type kafkaProduceRequest struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+// kafkaProduceResponse is the body of a produce response, with an offset per record
+type kafkaProduceResponse struct {
+	Offsets []struct {
+		Partition int     `json:"partition"`
+		Offset    int64   `json:"offset"`
+		ErrorCode *int    `json:"error_code"`
+		Error     *string `json:"error"`
+	} `json:"offsets"`
+}
+
+// KafkaBroker produces to a Kafka topic through the REST proxy (Confluent REST Proxy API v2 or
+// a compatible one, like Redpanda's), so the board doesn't need a native Kafka client. Records
+// are keyed, so the messages of the same job go to the same partition and keep their order.
+type KafkaBroker struct {
+	topicURL string
+	username string
+	password string
+	client   *http.Client
+}
+
+// NewKafkaBroker creates a new instance of KafkaBroker producing to topic through the REST proxy at url
+func NewKafkaBroker(url, topic string) (*KafkaBroker, error) {
+	parsed, err := neturl.Parse(strings.TrimRight(url, "/"))
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return nil, errors.New("invalid Kafka REST proxy URL, expected http(s)://host:port")
+	}
+
+	broker := &KafkaBroker{client: &http.Client{Timeout: DefaultTimeout}}
+	if parsed.User != nil {
+		broker.username = parsed.User.Username()
+		broker.password, _ = parsed.User.Password()
+		parsed.User = nil
+	}
+	broker.topicURL = parsed.String() + "/topics/" + neturl.PathEscape(topic)
+
+	return broker, nil
+}
+
+// Name returns the name of the broker
+func (b *KafkaBroker) Name() string {
+	return DriverKafka
+}
+
+// Publish produces body, which must be JSON, as a record keyed by key
+func (b *KafkaBroker) Publish(ctx context.Context, key string, body []byte) error {
+	payload, err := json.Marshal(&kafkaProduceRequest{Records: []kafkaRecord{{Key: key, Value: body}}})
+	if err != nil {
+		return fmt.Errorf("failed to encode Kafka record: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, b.topicURL, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create Kafka request: %w", err)
+	}
+	req.Header.Set("Content-Type", kafkaContentType)
+	req.Header.Set("Accept", kafkaAccept)
+	if b.username != "" {
+		req.SetBasicAuth(b.username, b.password)
+	}
+
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to produce Kafka record: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return &ResponseError{Broker: DriverKafka, StatusCode: resp.StatusCode, Body: string(respBody)}
+	}
+
+	// The proxy answers 200 even when a record is rejected, the error is set on its offset
+	var produced kafkaProduceResponse
+	if err = json.NewDecoder(resp.Body).Decode(&produced); err != nil {
+		return fmt.Errorf("failed to decode Kafka response: %w", err)
+	}
+	for _, offset := range produced.Offsets {
+		if offset.ErrorCode != nil || offset.Error != nil {
+			message := ""
+			if offset.Error != nil {
+				message = *offset.Error
+			}
+			return fmt.Errorf("kafka rejected the record: %s", message)
+		}
+	}
+
+	return nil
+}
+
+// Close releases the idle connections to the proxy
+func (b *KafkaBroker) Close() error {
+	b.client.CloseIdleConnections()
+	return nil
+}
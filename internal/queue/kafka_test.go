@@ -0,0 +1,82 @@
+package queue
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKafkaBroker_Publish(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		statusCode   int
+		response     string
+		checkResults func(t *testing.T, err error)
+	}{
+		{
+			name:       "record produced",
+			statusCode: http.StatusOK,
+			response:   `{"offsets": [{"partition": 2, "offset": 100, "error_code": null, "error": null}]}`,
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:       "record rejected",
+			statusCode: http.StatusOK,
+			response:   `{"offsets": [{"partition": null, "offset": null, "error_code": 2, "error": "Record too large"}]}`,
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.ErrorContains(t, err, "kafka rejected the record: Record too large")
+			},
+		},
+		{
+			name:       "unknown topic",
+			statusCode: http.StatusNotFound,
+			response:   `{"error_code": 40401, "message": "Topic not found."}`,
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				var respErr *ResponseError
+				require.ErrorAs(t, err, &respErr)
+				assert.Equal(t, http.StatusNotFound, respErr.StatusCode)
+				assert.Contains(t, respErr.Body, "Topic not found.")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/topics/jobs", r.URL.Path)
+				assert.Equal(t, kafkaContentType, r.Header.Get("Content-Type"))
+				username, password, ok := r.BasicAuth()
+				assert.True(t, ok)
+				assert.Equal(t, "user:secret", username+":"+password)
+				body, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.JSONEq(t, `{"records": [{"key": "7", "value": {"id": 7}}]}`, string(body))
+
+				w.WriteHeader(tt.statusCode)
+				_, _ = w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			broker, err := NewKafkaBroker(strings.Replace(server.URL, "http://", "http://user:secret@", 1), DefaultTopic)
+			require.NoError(t, err)
+
+			err = broker.Publish(context.Background(), "7", []byte(`{"id": 7}`))
+			tt.checkResults(t, err)
+		})
+	}
+}
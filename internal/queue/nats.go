@@ -0,0 +1,215 @@
+package queue
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	neturl "net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// natsClientName identifies the connections of the job board on the NATS server
+const natsClientName = "ticos-in-tech"
+
+// natsConnectOptions are the options sent with the CONNECT command
+type natsConnectOptions struct {
+	Verbose   bool   `json:"verbose"`
+	Pedantic  bool   `json:"pedantic"`
+	Name      string `json:"name"`
+	Lang      string `json:"lang"`
+	User      string `json:"user,omitempty"`
+	Pass      string `json:"pass,omitempty"`
+	AuthToken string `json:"auth_token,omitempty"`
+}
+
+// natsServerInfo holds the fields of the INFO sent by the server that matter to a publisher
+type natsServerInfo struct {
+	TLSRequired bool `json:"tls_required"`
+	MaxPayload  int  `json:"max_payload"`
+}
+
+// NATSBroker publishes to a subject of a NATS server over the plain text protocol. Every message
+// is followed by a PING, so it is only reported as published once the server has processed it.
+// The connection is opened on the first publication and again after any error.
+type NATSBroker struct {
+	address string
+	subject string
+	options natsConnectOptions
+
+	mu         sync.Mutex
+	conn       net.Conn
+	reader     *bufio.Reader
+	maxPayload int
+}
+
+// NewNATSBroker creates a new instance of NATSBroker publishing to subject of the server at url
+func NewNATSBroker(url, subject string) (*NATSBroker, error) {
+	parsed, err := neturl.Parse(url)
+	if err != nil || parsed.Scheme != "nats" || parsed.Host == "" {
+		return nil, errors.New("invalid NATS URL, expected nats://host:port")
+	}
+	if strings.ContainsAny(subject, " \t\r\n") {
+		return nil, fmt.Errorf("invalid NATS subject %q", subject)
+	}
+
+	address := parsed.Host
+	if parsed.Port() == "" {
+		address = net.JoinHostPort(parsed.Hostname(), "4222")
+	}
+
+	options := natsConnectOptions{Name: natsClientName, Lang: "go"}
+	if password, ok := parsed.User.Password(); ok {
+		options.User = parsed.User.Username()
+		options.Pass = password
+	} else if parsed.User != nil {
+		options.AuthToken = parsed.User.Username()
+	}
+
+	return &NATSBroker{address: address, subject: subject, options: options}, nil
+}
+
+// Name returns the name of the broker
+func (b *NATSBroker) Name() string {
+	return DriverNATS
+}
+
+// Publish sends body to the subject of the broker. NATS has no message keys, the messages
+// are kept in order by being published one at a time.
+func (b *NATSBroker) Publish(ctx context.Context, _ string, body []byte) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if err := b.connect(ctx); err != nil {
+		return err
+	}
+	if b.maxPayload > 0 && len(body) > b.maxPayload {
+		return fmt.Errorf("message of %d bytes exceeds the NATS maximum payload of %d bytes", len(body), b.maxPayload)
+	}
+
+	b.setDeadline(ctx)
+	command := fmt.Sprintf("PUB %s %d\r\n%s\r\nPING\r\n", b.subject, len(body), body)
+	if _, err := b.conn.Write([]byte(command)); err != nil {
+		b.closeConn()
+		return fmt.Errorf("failed to write to NATS: %w", err)
+	}
+	if err := b.awaitPong(); err != nil {
+		b.closeConn()
+		return err
+	}
+	return nil
+}
+
+// Close closes the connection to the server
+func (b *NATSBroker) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.closeConn()
+}
+
+// connect opens the connection when there is none, reading the INFO of the server and
+// sending the CONNECT options
+func (b *NATSBroker) connect(ctx context.Context) error {
+	if b.conn != nil {
+		return nil
+	}
+
+	dialer := net.Dialer{Timeout: DefaultTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", b.address)
+	if err != nil {
+		return fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+	b.conn = conn
+	b.reader = bufio.NewReader(conn)
+	b.setDeadline(ctx)
+
+	if err = b.handshake(); err != nil {
+		b.closeConn()
+		return err
+	}
+	return nil
+}
+
+// handshake reads the INFO of the server, sends the CONNECT options and waits for the server
+// to accept them
+func (b *NATSBroker) handshake() error {
+	line, err := b.readLine()
+	if err != nil {
+		return err
+	}
+	infoJSON, ok := strings.CutPrefix(line, "INFO ")
+	if !ok {
+		return fmt.Errorf("unexpected NATS greeting: %s", line)
+	}
+	var info natsServerInfo
+	if err = json.Unmarshal([]byte(infoJSON), &info); err != nil {
+		return fmt.Errorf("failed to decode NATS server info: %w", err)
+	}
+	if info.TLSRequired {
+		return errors.New("NATS server requires TLS, which is not supported")
+	}
+	b.maxPayload = info.MaxPayload
+
+	options, err := json.Marshal(&b.options)
+	if err != nil {
+		return fmt.Errorf("failed to encode NATS options: %w", err)
+	}
+	if _, err = b.conn.Write([]byte("CONNECT " + string(options) + "\r\nPING\r\n")); err != nil {
+		return fmt.Errorf("failed to write to NATS: %w", err)
+	}
+	return b.awaitPong()
+}
+
+// awaitPong reads until the PONG answering our PING, answering the PINGs of the server
+func (b *NATSBroker) awaitPong() error {
+	for {
+		line, err := b.readLine()
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case line == "PONG":
+			return nil
+		case line == "PING":
+			if _, err = b.conn.Write([]byte("PONG\r\n")); err != nil {
+				return fmt.Errorf("failed to write to NATS: %w", err)
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return fmt.Errorf("NATS error: %s", strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		}
+	}
+}
+
+// readLine reads a protocol line without its CRLF
+func (b *NATSBroker) readLine() (string, error) {
+	line, err := b.reader.ReadString('\n')
+	if err != nil {
+		return "", fmt.Errorf("failed to read from NATS: %w", err)
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// setDeadline bounds the next reads and writes by the deadline of ctx or DefaultTimeout
+func (b *NATSBroker) setDeadline(ctx context.Context) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(DefaultTimeout)
+	}
+	_ = b.conn.SetDeadline(deadline)
+}
+
+// closeConn closes the connection, so the next publication opens a new one
+func (b *NATSBroker) closeConn() error {
+	if b.conn == nil {
+		return nil
+	}
+	err := b.conn.Close()
+	b.conn = nil
+	b.reader = nil
+	return err
+}
@@ -0,0 +1,116 @@
+package queue
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeNATSServer accepts a single connection and answers it with serve, which gets the
+// lines sent by the client
+func fakeNATSServer(t *testing.T, serve func(conn net.Conn, lines *bufio.Reader)) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		serve(conn, bufio.NewReader(conn))
+	}()
+
+	return "nats://" + listener.Addr().String()
+}
+
+// readCommand reads a protocol line sent by the client
+func readCommand(t *testing.T, lines *bufio.Reader) string {
+	t.Helper()
+	line, err := lines.ReadString('\n')
+	if err != nil {
+		return ""
+	}
+	return strings.TrimRight(line, "\r\n")
+}
+
+func TestNATSBroker_Publish(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		serve        func(t *testing.T, conn net.Conn, lines *bufio.Reader)
+		checkResults func(t *testing.T, err error)
+	}{
+		{
+			name: "message published",
+			serve: func(t *testing.T, conn net.Conn, lines *bufio.Reader) {
+				t.Helper()
+				_, _ = conn.Write([]byte(`INFO {"server_id":"test","max_payload":1048576}` + "\r\n"))
+				assert.Contains(t, readCommand(t, lines), `CONNECT {"verbose":false,"pedantic":false`)
+				assert.Equal(t, "PING", readCommand(t, lines))
+				_, _ = conn.Write([]byte("PONG\r\n"))
+
+				assert.Equal(t, "PUB jobs 9", readCommand(t, lines))
+				assert.Equal(t, `{"id": 7}`, readCommand(t, lines))
+				assert.Equal(t, "PING", readCommand(t, lines))
+				// The server may ping in between, the broker must answer
+				_, _ = conn.Write([]byte("PING\r\n"))
+				assert.Equal(t, "PONG", readCommand(t, lines))
+				_, _ = conn.Write([]byte("PONG\r\n"))
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "publication rejected",
+			serve: func(t *testing.T, conn net.Conn, lines *bufio.Reader) {
+				t.Helper()
+				_, _ = conn.Write([]byte(`INFO {"server_id":"test"}` + "\r\n"))
+				readCommand(t, lines)
+				readCommand(t, lines)
+				_, _ = conn.Write([]byte("PONG\r\n"))
+				readCommand(t, lines)
+				_, _ = conn.Write([]byte("-ERR 'Permissions Violation for Publish to jobs'\r\n"))
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.ErrorContains(t, err, "NATS error: 'Permissions Violation for Publish to jobs'")
+			},
+		},
+		{
+			name: "TLS required",
+			serve: func(t *testing.T, conn net.Conn, _ *bufio.Reader) {
+				t.Helper()
+				_, _ = conn.Write([]byte(`INFO {"server_id":"test","tls_required":true}` + "\r\n"))
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.ErrorContains(t, err, "NATS server requires TLS")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			url := fakeNATSServer(t, func(conn net.Conn, lines *bufio.Reader) { tt.serve(t, conn, lines) })
+
+			broker, err := NewNATSBroker(url, DefaultTopic)
+			require.NoError(t, err)
+			defer broker.Close()
+
+			err = broker.Publish(context.Background(), "7", []byte(`{"id": 7}`))
+			tt.checkResults(t, err)
+		})
+	}
+}
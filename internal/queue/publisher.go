@@ -0,0 +1,104 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/events"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+)
+
+// JobMessage is the message published for a job event. Consumers can tell redeliveries
+// apart by the type, job ID and occurred_at, which don't change between them.
+type JobMessage struct {
+	Type       string    `json:"type"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Job        *JobData  `json:"job"`
+}
+
+// JobData is the normalized job sent with a message
+type JobData struct {
+	ID                int     `json:"id"`
+	CompanyID         int     `json:"company_id"`
+	Title             string  `json:"title"`
+	Description       string  `json:"description"`
+	ExperienceLevel   string  `json:"experience_level"`
+	EmploymentType    string  `json:"employment_type"`
+	Location          string  `json:"location"`
+	LocationID        *int    `json:"location_id"`
+	WorkMode          string  `json:"work_mode"`
+	RemoteEligibility *string `json:"remote_eligibility"`
+	UTCOffsetMin      *int    `json:"utc_offset_min"`
+	UTCOffsetMax      *int    `json:"utc_offset_max"`
+	Language          string  `json:"language"`
+	ApplicationURL    string  `json:"application_url"`
+	Signature         string  `json:"signature"`
+	IsActive          bool    `json:"is_active"`
+	// PostedAt is when the job was first stored
+	PostedAt  time.Time `json:"posted_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Publisher publishes the job events to a broker, keyed by job ID.
+type Publisher struct {
+	broker Broker
+}
+
+// NewPublisher creates a new instance of Publisher
+func NewPublisher(broker Broker) *Publisher {
+	return &Publisher{broker: broker}
+}
+
+// HandleEvent publishes the job of event. It is an events.Handler subscribed to the job events.
+func (p *Publisher) HandleEvent(ctx context.Context, event *events.Event) error {
+	if event.Job == nil {
+		return nil
+	}
+
+	body, err := json.Marshal(&JobMessage{
+		Type:       event.Type,
+		OccurredAt: event.OccurredAt.UTC(),
+		Job:        mapJobToData(event.Job),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode %s message: %w", event.Type, err)
+	}
+
+	if err = p.broker.Publish(ctx, strconv.Itoa(event.Job.ID), body); err != nil {
+		return fmt.Errorf("failed to publish job %d to %s: %w", event.Job.ID, p.broker.Name(), err)
+	}
+	return nil
+}
+
+// mapJobToData converts a job to the fields sent with a message
+func mapJobToData(job *jobs.Job) *JobData {
+	var remoteEligibility *string
+	if job.RemoteEligibility != nil {
+		value := string(*job.RemoteEligibility)
+		remoteEligibility = &value
+	}
+
+	return &JobData{
+		ID:                job.ID,
+		CompanyID:         job.CompanyID,
+		Title:             job.Title,
+		Description:       job.Description,
+		ExperienceLevel:   job.ExperienceLevel,
+		EmploymentType:    job.EmploymentType,
+		Location:          job.Location,
+		LocationID:        job.LocationID,
+		WorkMode:          job.WorkMode,
+		RemoteEligibility: remoteEligibility,
+		UTCOffsetMin:      job.UTCOffsetMin,
+		UTCOffsetMax:      job.UTCOffsetMax,
+		Language:          string(job.Language),
+		ApplicationURL:    job.ApplicationURL,
+		Signature:         job.Signature,
+		IsActive:          job.IsActive,
+		PostedAt:          job.CreatedAt,
+		UpdatedAt:         job.UpdatedAt,
+	}
+}
@@ -0,0 +1,168 @@
+package queue
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	neturl "net/url"
+	"strings"
+	"time"
+)
+
+const (
+	sqsContentType       = "application/x-amz-json-1.0"
+	sqsSendMessageTarget = "AmazonSQS.SendMessage"
+
+	signingAlgorithm = "AWS4-HMAC-SHA256"
+	signedHeaders    = "content-type;host;x-amz-date;x-amz-target"
+	amzDateFormat    = "20060102T150405Z"
+)
+
+// sqsSendMessageRequest is the body of a SendMessage request
+type sqsSendMessageRequest struct {
+	QueueURL               string `json:"QueueUrl"`
+	MessageBody            string `json:"MessageBody"`
+	MessageGroupID         string `json:"MessageGroupId,omitempty"`
+	MessageDeduplicationID string `json:"MessageDeduplicationId,omitempty"`
+}
+
+// SQSBroker sends messages to an Amazon SQS queue (or a compatible service, like ElasticMQ or
+// LocalStack) through the JSON API, signing requests with AWS Signature Version 4. On FIFO queues
+// the messages are grouped by key, so the messages of the same job keep their order, and
+// deduplicated by their body.
+type SQSBroker struct {
+	queueURL        string
+	endpoint        string
+	host            string
+	fifo            bool
+	region          string
+	accessKeyID     string
+	secretAccessKey string
+	client          *http.Client
+	now             func() time.Time
+}
+
+// NewSQSBroker creates a new instance of SQSBroker sending to the queue at cfg.URL
+func NewSQSBroker(cfg *Config) (*SQSBroker, error) {
+	parsed, err := neturl.Parse(cfg.URL)
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return nil, errors.New("invalid SQS queue URL, expected http(s)://host/account/queue")
+	}
+
+	region := cfg.Region
+	if region == "" {
+		region = DefaultRegion
+	}
+
+	return &SQSBroker{
+		queueURL:        cfg.URL,
+		endpoint:        parsed.Scheme + "://" + parsed.Host + "/",
+		host:            parsed.Host,
+		fifo:            strings.HasSuffix(parsed.Path, ".fifo"),
+		region:          region,
+		accessKeyID:     cfg.AccessKeyID,
+		secretAccessKey: cfg.SecretAccessKey,
+		client:          &http.Client{Timeout: DefaultTimeout},
+		now:             time.Now,
+	}, nil
+}
+
+// Name returns the name of the broker
+func (b *SQSBroker) Name() string {
+	return DriverSQS
+}
+
+// Publish sends body as a message of the queue
+func (b *SQSBroker) Publish(ctx context.Context, key string, body []byte) error {
+	message := sqsSendMessageRequest{QueueURL: b.queueURL, MessageBody: string(body)}
+	if b.fifo {
+		message.MessageGroupID = key
+		message.MessageDeduplicationID = sha256Hex(body)
+	}
+	payload, err := json.Marshal(&message)
+	if err != nil {
+		return fmt.Errorf("failed to encode SQS message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, b.endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create SQS request: %w", err)
+	}
+	req.Header.Set("Content-Type", sqsContentType)
+	req.Header.Set("X-Amz-Target", sqsSendMessageTarget)
+	b.sign(req, payload)
+
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send SQS message: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return &ResponseError{Broker: DriverSQS, StatusCode: resp.StatusCode, Body: string(respBody)}
+	}
+
+	return nil
+}
+
+// Close releases the idle connections to SQS
+func (b *SQSBroker) Close() error {
+	b.client.CloseIdleConnections()
+	return nil
+}
+
+// sign adds the Signature Version 4 headers to req. Anonymous requests are sent unsigned.
+func (b *SQSBroker) sign(req *http.Request, body []byte) {
+	if b.accessKeyID == "" {
+		return
+	}
+
+	now := b.now().UTC()
+	amzDate := now.Format(amzDateFormat)
+	date := amzDate[:8]
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		"/",
+		"", // No query string
+		"content-type:" + sqsContentType,
+		"host:" + b.host,
+		"x-amz-date:" + amzDate,
+		"x-amz-target:" + sqsSendMessageTarget,
+		"",
+		signedHeaders,
+		sha256Hex(body),
+	}, "\n")
+
+	scope := date + "/" + b.region + "/sqs/aws4_request"
+	stringToSign := strings.Join([]string{signingAlgorithm, amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+b.secretAccessKey), date)
+	for _, part := range []string{b.region, "sqs", "aws4_request"} {
+		signingKey = hmacSHA256(signingKey, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		signingAlgorithm, b.accessKeyID, scope, signedHeaders, signature))
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
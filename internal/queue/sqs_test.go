@@ -0,0 +1,105 @@
+package queue
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQSBroker_Publish(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		queuePath    string
+		statusCode   int
+		checkRequest func(t *testing.T, r *http.Request, body string)
+		checkResults func(t *testing.T, err error)
+	}{
+		{
+			name:       "message sent",
+			queuePath:  "/123456789012/jobs",
+			statusCode: http.StatusOK,
+			checkRequest: func(t *testing.T, r *http.Request, body string) {
+				t.Helper()
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/", r.URL.Path)
+				assert.Equal(t, sqsContentType, r.Header.Get("Content-Type"))
+				assert.Equal(t, sqsSendMessageTarget, r.Header.Get("X-Amz-Target"))
+				assert.Equal(t, "20240115T103000Z", r.Header.Get("X-Amz-Date"))
+				assert.Contains(t, r.Header.Get("Authorization"),
+					"AWS4-HMAC-SHA256 Credential=AKID/20240115/us-east-2/sqs/aws4_request")
+				assert.Contains(t, body, `"MessageBody":"{\"id\": 7}"`)
+				assert.NotContains(t, body, "MessageGroupId")
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:       "FIFO queue message grouped by key",
+			queuePath:  "/123456789012/jobs.fifo",
+			statusCode: http.StatusOK,
+			checkRequest: func(t *testing.T, _ *http.Request, body string) {
+				t.Helper()
+				assert.Contains(t, body, `"MessageGroupId":"7"`)
+				assert.Contains(t, body, `"MessageDeduplicationId":"`+sha256Hex([]byte(`{"id": 7}`))+`"`)
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:         "message rejected",
+			queuePath:    "/123456789012/jobs",
+			statusCode:   http.StatusBadRequest,
+			checkRequest: func(*testing.T, *http.Request, string) {},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				var respErr *ResponseError
+				require.ErrorAs(t, err, &respErr)
+				assert.Equal(t, DriverSQS, respErr.Broker)
+				assert.Contains(t, respErr.Body, "QueueDoesNotExist")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				tt.checkRequest(t, r, string(body))
+
+				w.WriteHeader(tt.statusCode)
+				if tt.statusCode != http.StatusOK {
+					_, _ = w.Write([]byte(`{"__type": "com.amazonaws.sqs#QueueDoesNotExist"}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"MessageId": "5fea7756-0ea4-451a-a703-a558b933e274"}`))
+			}))
+			defer server.Close()
+
+			broker, err := NewSQSBroker(&Config{
+				URL:             server.URL + tt.queuePath,
+				Region:          "us-east-2",
+				AccessKeyID:     "AKID",
+				SecretAccessKey: "secret",
+			})
+			require.NoError(t, err)
+			broker.now = func() time.Time { return time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC) }
+
+			err = broker.Publish(context.Background(), "7", []byte(`{"id": 7}`))
+			tt.checkResults(t, err)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package reference
+
+import (
+	"slices"
+	"sort"
+	"sync/atomic"
+)
+
+// defaultValues are the values seeded by the reference_values migration, the snapshot of a catalog
+// until it is loaded from the database
+var defaultValues = map[string][]string{
+	KindExperienceLevel: {"Entry-level", "Junior", "Mid-level", "Senior", "Lead", "Principal", "Executive"},
+	KindEmploymentType:  {"Full-time", "Part-time", "Contract", "Freelance", "Temporary", "Internship"},
+	KindLocation:        {"Costa Rica", "LATAM"},
+	KindWorkMode:        {"Remote", "Hybrid", "Onsite"},
+}
+
+// Catalog holds an in-memory snapshot of the reference values, safe for concurrent use. The request
+// validation reads the snapshot of the default catalog, which the server reloads whenever the
+// values change.
+type Catalog struct {
+	snapshot atomic.Pointer[map[string][]string]
+}
+
+// defaultCatalog is the catalog the job attributes are validated against
+var defaultCatalog = NewCatalog()
+
+// Default returns the catalog the job attributes are validated against
+func Default() *Catalog {
+	return defaultCatalog
+}
+
+// NewCatalog creates a catalog holding the seeded values until it is replaced
+func NewCatalog() *Catalog {
+	c := &Catalog{}
+	c.snapshot.Store(&defaultValues)
+	return c
+}
+
+// Values returns the values of a kind, in their order
+func (c *Catalog) Values(kind string) []string {
+	return slices.Clone((*c.snapshot.Load())[kind])
+}
+
+// Contains reports whether value is a value of a kind
+func (c *Catalog) Contains(kind, value string) bool {
+	return slices.Contains((*c.snapshot.Load())[kind], value)
+}
+
+// Replace replaces the snapshot with the given values, ordered by their position. The kinds
+// without any value keep their seeded ones, so an emptied table doesn't reject every request.
+func (c *Catalog) Replace(values []*Value) {
+	sorted := slices.Clone(values)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Position < sorted[j].Position })
+
+	snapshot := make(map[string][]string, len(defaultValues))
+	for _, value := range sorted {
+		snapshot[value.Kind] = append(snapshot[value.Kind], value.Value)
+	}
+	for kind, seeded := range defaultValues {
+		if len(snapshot[kind]) == 0 {
+			snapshot[kind] = seeded
+		}
+	}
+	c.snapshot.Store(&snapshot)
+}
@@ -0,0 +1,32 @@
+package reference
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalog(t *testing.T) {
+	t.Parallel()
+
+	catalog := NewCatalog()
+	assert.True(t, catalog.Contains(KindWorkMode, "Remote"))
+	assert.False(t, catalog.Contains(KindWorkMode, "remote"))
+	assert.False(t, catalog.Contains(KindLocation, "Nicaragua"))
+	assert.Equal(t, []string{"Costa Rica", "LATAM"}, catalog.Values(KindLocation))
+
+	catalog.Replace([]*Value{
+		{Kind: KindLocation, Value: "Nicaragua", Position: 3},
+		{Kind: KindLocation, Value: "Costa Rica", Position: 1},
+		{Kind: KindLocation, Value: "LATAM", Position: 2},
+		{Kind: KindWorkMode, Value: "Remote", Position: 1},
+	})
+
+	assert.True(t, catalog.Contains(KindLocation, "Nicaragua"))
+	assert.Equal(t, []string{"Costa Rica", "LATAM", "Nicaragua"}, catalog.Values(KindLocation))
+	assert.Equal(t, []string{"Remote"}, catalog.Values(KindWorkMode))
+	assert.False(t, catalog.Contains(KindWorkMode, "Hybrid"))
+	// Kinds without stored values keep the seeded ones
+	assert.True(t, catalog.Contains(KindEmploymentType, "Full-time"))
+	assert.True(t, NewCatalog().Contains(KindWorkMode, "Hybrid"), "the seeded values are not shared")
+}
@@ -0,0 +1,45 @@
+package reference
+
+import (
+	"time"
+)
+
+// Data Transfer Objects (DTOs) for the reference value admin API layer.
+
+// CreateRequest represents the request body to add a reference value
+type CreateRequest struct {
+	Kind  string `json:"kind" binding:"required,oneof=experience_level employment_type location work_mode" example:"location"`
+	Value string `json:"value" binding:"required,notblank,max=100" example:"Nicaragua"`
+}
+
+// ValueResponse represents a reference value in API responses
+type ValueResponse struct {
+	Kind      string    `json:"kind" example:"location"`
+	Value     string    `json:"value" example:"Nicaragua"`
+	Position  int       `json:"position" example:"3"`
+	CreatedAt time.Time `json:"created_at" example:"2024-01-15T10:30:00Z"`
+}
+
+// ValueListResponse represents the list of reference values
+type ValueListResponse struct {
+	Data []*ValueResponse `json:"data"`
+}
+
+// MapValueToResponse converts a Value to its ValueResponse
+func MapValueToResponse(value *Value) *ValueResponse {
+	return &ValueResponse{
+		Kind:      value.Kind,
+		Value:     value.Value,
+		Position:  value.Position,
+		CreatedAt: value.CreatedAt,
+	}
+}
+
+// MapValuesToResponse converts values to the list API response format
+func MapValuesToResponse(values []*Value) *ValueListResponse {
+	data := make([]*ValueResponse, len(values))
+	for i, value := range values {
+		data[i] = MapValueToResponse(value)
+	}
+	return &ValueListResponse{Data: data}
+}
@@ -0,0 +1,25 @@
+// Package reference holds the values of the job attributes the requests are validated against,
+// such as the work modes or the locations searched. They are stored in the reference_values table,
+// so adding one doesn't take a deploy, and validated against an in-memory snapshot of the table.
+package reference
+
+import (
+	"fmt"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// DuplicateError represents a reference value that already exists
+type DuplicateError struct {
+	Kind  string
+	Value string
+}
+
+func (e DuplicateError) Error() string {
+	return fmt.Sprintf("%s %q already exists", e.Kind, e.Value)
+}
+
+// Is matches httpservice.ErrConflict
+func (e DuplicateError) Is(target error) bool {
+	return target == httpservice.ErrConflict
+}
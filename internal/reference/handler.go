@@ -0,0 +1,82 @@
+package reference
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// Constants for reference value routes and endpoints
+const (
+	ValuesRoute = "/reference-values"
+)
+
+// Handler handles HTTP requests for the reference value administration
+type Handler struct {
+	service *ReferenceService
+}
+
+// NewHandler creates a new reference value handler
+func NewHandler(service *ReferenceService) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterAdminRoutes registers the reference value admin routes with the given (protected) router group
+func (h *Handler) RegisterAdminRoutes(rg *gin.RouterGroup) {
+	rg.GET(ValuesRoute, h.ListValues)
+	rg.POST(ValuesRoute, h.CreateValue)
+}
+
+// ListValues godoc
+// @Summary List reference values
+// @Description Returns the values of the job attributes the requests are validated against, by kind
+// @Description and position
+// @Tags admin
+// @Produce json
+// @Security AdminAPIKey
+// @Success 200 {object} ValueListResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/reference-values [get]
+func (h *Handler) ListValues(c *gin.Context) {
+	values, err := h.service.List(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapValuesToResponse(values))
+}
+
+// CreateValue godoc
+// @Summary Add a reference value
+// @Description Adds a value to a job attribute, e.g. the "Nicaragua" location, after its existing
+// @Description values. Every server instance accepts it once it reloads its reference values.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security AdminAPIKey
+// @Param request body CreateRequest true "Reference value"
+// @Success 201 {object} ValueResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 409 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/reference-values [post]
+func (h *Handler) CreateValue(c *gin.Context) {
+	var req CreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	value, err := h.service.Add(c.Request.Context(), req.Kind, req.Value)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, MapValueToResponse(value))
+}
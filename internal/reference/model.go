@@ -0,0 +1,40 @@
+package reference
+
+import (
+	"slices"
+	"time"
+)
+
+// ChangesChannel is the notification channel where the database announces changes to the
+// reference values. The payload is the operation, e.g. "INSERT".
+const ChangesChannel = "reference_changes"
+
+// Kinds of reference values, named after the job attribute they are values of
+const (
+	KindExperienceLevel = "experience_level"
+	KindEmploymentType  = "employment_type"
+	KindLocation        = "location"
+	KindWorkMode        = "work_mode"
+)
+
+// kinds are the kinds of reference values
+var kinds = []string{KindExperienceLevel, KindEmploymentType, KindLocation, KindWorkMode}
+
+// Kinds returns the kinds of reference values
+func Kinds() []string {
+	return slices.Clone(kinds)
+}
+
+// IsKind reports whether kind is a kind of reference values
+func IsKind(kind string) bool {
+	return slices.Contains(kinds, kind)
+}
+
+// Value represents a value of a job attribute, e.g. the "Remote" work mode
+type Value struct {
+	Kind  string `db:"kind"`
+	Value string `db:"value"`
+	// Position orders the values of a kind, e.g. the experience levels by seniority
+	Position  int       `db:"position"`
+	CreatedAt time.Time `db:"created_at"`
+}
@@ -0,0 +1,82 @@
+package reference
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// SQL query constants
+const (
+	listValuesQuery = `
+        SELECT kind, value, position, created_at
+        FROM reference_values
+        ORDER BY kind, position, value
+    `
+
+	// New values go after the existing ones of their kind
+	createValueQuery = `
+        INSERT INTO reference_values (kind, value, position)
+        SELECT $1, $2, COALESCE(MAX(position), 0) + 1
+        FROM reference_values
+        WHERE kind = $1
+        RETURNING position, created_at
+    `
+)
+
+// Database interface to support pgxpool and mocks
+type Database interface {
+	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
+	Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+}
+
+// Repository handles database operations for the Value model.
+type Repository struct {
+	db Database
+}
+
+// NewRepository creates a new Repository instance.
+func NewRepository(db Database) *Repository {
+	return &Repository{db: db}
+}
+
+// List retrieves all reference values ordered by kind and position.
+func (r *Repository) List(ctx context.Context) ([]*Value, error) {
+	rows, err := r.db.Query(ctx, listValuesQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reference values: %w", err)
+	}
+	defer rows.Close()
+
+	var values []*Value
+	for rows.Next() {
+		value := &Value{}
+		if err := rows.Scan(&value.Kind, &value.Value, &value.Position, &value.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan reference value: %w", err)
+		}
+		values = append(values, value)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating reference values: %w", err)
+	}
+
+	return values, nil
+}
+
+// Create inserts a new reference value after the existing ones of its kind, setting its position.
+// It returns a DuplicateError when the kind already has the value.
+func (r *Repository) Create(ctx context.Context, value *Value) error {
+	err := r.db.QueryRow(ctx, createValueQuery, value.Kind, value.Value).Scan(&value.Position, &value.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return &DuplicateError{Kind: value.Kind, Value: value.Value}
+		}
+		return fmt.Errorf("failed to create reference value: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package reference
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+func TestRepository_List(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, result []*Value, err error)
+	}{
+		{
+			name: "values found",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(regexp.QuoteMeta(listValuesQuery)).
+					WillReturnRows(pgxmock.NewRows([]string{"kind", "value", "position", "created_at"}).
+						AddRow(KindLocation, "Costa Rica", 1, now).
+						AddRow(KindWorkMode, "Remote", 1, now))
+			},
+			checkResults: func(t *testing.T, result []*Value, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, result, 2)
+				assert.Equal(t, &Value{Kind: KindLocation, Value: "Costa Rica", Position: 1, CreatedAt: now}, result[0])
+				assert.Equal(t, "Remote", result[1].Value)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(regexp.QuoteMeta(listValuesQuery)).WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ []*Value, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			result, err := repo.List(context.Background())
+			tt.checkResults(t, result, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_Create(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, value *Value, err error)
+	}{
+		{
+			name: "value created after the existing ones",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(regexp.QuoteMeta(createValueQuery)).
+					WithArgs(KindLocation, "Nicaragua").
+					WillReturnRows(pgxmock.NewRows([]string{"position", "created_at"}).AddRow(3, now))
+			},
+			checkResults: func(t *testing.T, value *Value, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 3, value.Position)
+				assert.Equal(t, now, value.CreatedAt)
+			},
+		},
+		{
+			name: "duplicate value",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(regexp.QuoteMeta(createValueQuery)).
+					WithArgs(KindLocation, "Nicaragua").
+					WillReturnError(&pgconn.PgError{Code: "23505"})
+			},
+			checkResults: func(t *testing.T, _ *Value, err error) {
+				t.Helper()
+				var duplicateErr *DuplicateError
+				require.ErrorAs(t, err, &duplicateErr)
+				assert.ErrorIs(t, err, httpservice.ErrConflict)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			value := &Value{Kind: KindLocation, Value: "Nicaragua"}
+			err = repo.Create(context.Background(), value)
+			tt.checkResults(t, value, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package reference
+
+import (
+	"context"
+	"strings"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// maxValueLength is the length of the value column
+const maxValueLength = 100
+
+// DataRepository interface to make database operations for the reference values.
+type DataRepository interface {
+	List(ctx context.Context) ([]*Value, error)
+	Create(ctx context.Context, value *Value) error
+}
+
+// ReferenceService holds the business logic to manage the reference values and keep the snapshot
+// of a catalog up to date.
+type ReferenceService struct {
+	repo    DataRepository
+	catalog *Catalog
+}
+
+// NewReferenceService creates a new instance of ReferenceService reloading catalog
+func NewReferenceService(repo DataRepository, catalog *Catalog) *ReferenceService {
+	return &ReferenceService{repo: repo, catalog: catalog}
+}
+
+// Load replaces the snapshot of the catalog with the stored values
+func (s *ReferenceService) Load(ctx context.Context) error {
+	values, err := s.repo.List(ctx)
+	if err != nil {
+		return err
+	}
+	s.catalog.Replace(values)
+	return nil
+}
+
+// List returns the stored values ordered by kind and position
+func (s *ReferenceService) List(ctx context.Context) ([]*Value, error) {
+	return s.repo.List(ctx)
+}
+
+// Add validates and stores a new value of a kind, after the existing ones, and reloads the catalog.
+// The other server instances reload theirs when the database notifies the change.
+func (s *ReferenceService) Add(ctx context.Context, kind, value string) (*Value, error) {
+	created := &Value{Kind: kind, Value: strings.TrimSpace(value)}
+	if !IsKind(created.Kind) {
+		return nil, &httpservice.ValidationError{Errors: []string{"unknown kind: " + kind}}
+	}
+	if created.Value == "" || len(created.Value) > maxValueLength {
+		return nil, &httpservice.ValidationError{Errors: []string{"value must have 1 to 100 characters"}}
+	}
+
+	if err := s.repo.Create(ctx, created); err != nil {
+		return nil, err
+	}
+	if err := s.Load(ctx); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
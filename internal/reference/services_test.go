@@ -0,0 +1,113 @@
+package reference
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+func TestReferenceService_Load(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	mockRepo := NewMockDataRepository(t)
+	mockRepo.EXPECT().List(context.Background()).
+		Return([]*Value{{Kind: KindLocation, Value: "Nicaragua", Position: 1}}, nil).Once()
+	mockRepo.EXPECT().List(context.Background()).Return(nil, dbError).Once()
+
+	catalog := NewCatalog()
+	service := NewReferenceService(mockRepo, catalog)
+
+	require.NoError(t, service.Load(context.Background()))
+	assert.Equal(t, []string{"Nicaragua"}, catalog.Values(KindLocation))
+
+	require.ErrorIs(t, service.Load(context.Background()), dbError)
+	assert.Equal(t, []string{"Nicaragua"}, catalog.Values(KindLocation), "a failed load keeps the snapshot")
+}
+
+func TestReferenceService_Add(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		kind         string
+		value        string
+		mockSetup    func(mockRepo *MockDataRepository)
+		checkResults func(t *testing.T, catalog *Catalog, value *Value, err error)
+	}{
+		{
+			name:  "value added and catalog reloaded",
+			kind:  KindLocation,
+			value: " Nicaragua ",
+			mockSetup: func(mockRepo *MockDataRepository) {
+				mockRepo.EXPECT().Create(context.Background(), mock.MatchedBy(func(v *Value) bool {
+					return v.Kind == KindLocation && v.Value == "Nicaragua"
+				})).Run(func(_ context.Context, v *Value) { v.Position = 3 }).Return(nil).Once()
+				mockRepo.EXPECT().List(context.Background()).Return([]*Value{
+					{Kind: KindLocation, Value: "Costa Rica", Position: 1},
+					{Kind: KindLocation, Value: "LATAM", Position: 2},
+					{Kind: KindLocation, Value: "Nicaragua", Position: 3},
+				}, nil).Once()
+			},
+			checkResults: func(t *testing.T, catalog *Catalog, value *Value, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 3, value.Position)
+				assert.True(t, catalog.Contains(KindLocation, "Nicaragua"))
+			},
+		},
+		{
+			name:      "unknown kind",
+			kind:      "salary_range",
+			value:     "High",
+			mockSetup: func(*MockDataRepository) {},
+			checkResults: func(t *testing.T, _ *Catalog, _ *Value, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+			},
+		},
+		{
+			name:      "blank value",
+			kind:      KindWorkMode,
+			value:     "   ",
+			mockSetup: func(*MockDataRepository) {},
+			checkResults: func(t *testing.T, _ *Catalog, _ *Value, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+			},
+		},
+		{
+			name:  "duplicate value",
+			kind:  KindWorkMode,
+			value: "Remote",
+			mockSetup: func(mockRepo *MockDataRepository) {
+				mockRepo.EXPECT().Create(context.Background(), mock.Anything).
+					Return(&DuplicateError{Kind: KindWorkMode, Value: "Remote"}).Once()
+			},
+			checkResults: func(t *testing.T, _ *Catalog, _ *Value, err error) {
+				t.Helper()
+				assert.ErrorIs(t, err, httpservice.ErrConflict)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			tt.mockSetup(mockRepo)
+			catalog := NewCatalog()
+
+			value, err := NewReferenceService(mockRepo, catalog).Add(context.Background(), tt.kind, tt.value)
+			tt.checkResults(t, catalog, value, err)
+		})
+	}
+}
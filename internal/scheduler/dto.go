@@ -0,0 +1,72 @@
+package scheduler
+
+import (
+	"time"
+)
+
+// Data Transfer Objects (DTOs) for the scheduler API layer.
+
+// Task run listing settings
+const (
+	DefaultListLimit = 50
+	MaxListLimit     = 500
+)
+
+// ListRunsRequest represents the query parameters to list task runs
+type ListRunsRequest struct {
+	Task  string `form:"task" example:"search-view-refresh"`
+	Limit int    `form:"limit" binding:"omitempty,min=1,max=500" example:"50"`
+}
+
+// ToListRunsParams converts a ListRunsRequest to ListRunsParams
+func (req *ListRunsRequest) ToListRunsParams() *ListRunsParams {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = DefaultListLimit
+	}
+	return &ListRunsParams{Task: req.Task, Limit: min(limit, MaxListLimit)}
+}
+
+// TaskResponse represents a scheduled task in API responses
+type TaskResponse struct {
+	Name    string `json:"name" example:"search-view-refresh"`
+	Enabled bool   `json:"enabled" example:"true"`
+}
+
+// RunResponse represents a task run in API responses
+type RunResponse struct {
+	ID         int64     `json:"id" example:"1"`
+	Task       string    `json:"task" example:"search-view-refresh"`
+	Status     string    `json:"status" example:"succeeded" enums:"succeeded,failed"`
+	Error      string    `json:"error,omitempty" example:""`
+	StartedAt  time.Time `json:"started_at" example:"2024-01-15T06:00:00Z"`
+	FinishedAt time.Time `json:"finished_at" example:"2024-01-15T06:00:02Z"`
+}
+
+// RunListResponse represents the scheduled tasks and their recent runs
+type RunListResponse struct {
+	Tasks []*TaskResponse `json:"tasks"`
+	Data  []*RunResponse  `json:"data"`
+}
+
+// MapRunsToResponse converts the tasks and their runs to a RunListResponse
+func MapRunsToResponse(tasks []*Task, runs []*Run) *RunListResponse {
+	taskData := make([]*TaskResponse, len(tasks))
+	for i, task := range tasks {
+		taskData[i] = &TaskResponse{Name: task.Name, Enabled: task.Enabled}
+	}
+
+	data := make([]*RunResponse, len(runs))
+	for i, run := range runs {
+		data[i] = &RunResponse{
+			ID:         run.ID,
+			Task:       run.Task,
+			Status:     string(run.Status),
+			Error:      run.Error,
+			StartedAt:  run.StartedAt,
+			FinishedAt: run.FinishedAt,
+		}
+	}
+
+	return &RunListResponse{Tasks: taskData, Data: data}
+}
@@ -0,0 +1,61 @@
+package scheduler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// Constants for scheduler routes and endpoints
+const (
+	SchedulerRoute = "/scheduler"
+	RunsRoute      = SchedulerRoute + "/runs"
+)
+
+// Handler handles HTTP requests for the scheduled tasks
+type Handler struct {
+	scheduler *Scheduler
+	repo      *Repository
+}
+
+// NewHandler creates a new scheduler handler
+func NewHandler(scheduler *Scheduler, repo *Repository) *Handler {
+	return &Handler{scheduler: scheduler, repo: repo}
+}
+
+// RegisterAdminRoutes registers the scheduler admin routes with the given (protected) router group
+func (h *Handler) RegisterAdminRoutes(rg *gin.RouterGroup) {
+	rg.GET(RunsRoute, h.ListRuns)
+}
+
+// ListRuns godoc
+// @Summary List scheduled task runs
+// @Description Lists the background tasks of the server, whether they are enabled, and their runs, most
+// @Description recent first. Runs skipped because another instance held the task lock are not listed.
+// @Tags admin
+// @Produce json
+// @Security AdminAPIKey
+// @Param task query string false "Task name" example(search-view-refresh)
+// @Param limit query int false "Number of runs to return (max 500)" default(50) example(50)
+// @Success 200 {object} RunListResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/scheduler/runs [get]
+func (h *Handler) ListRuns(c *gin.Context) {
+	var req ListRunsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	runs, err := h.repo.ListRuns(c.Request.Context(), req.ToListRunsParams())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapRunsToResponse(h.scheduler.Tasks(), runs))
+}
@@ -0,0 +1,38 @@
+// Package scheduler runs the background tasks of the server on cron-style schedules. Every task
+// can be disabled, its start is spread with a random jitter, and it runs on a single server
+// instance at a time thanks to a Postgres advisory lock. The outcome of every run is stored.
+package scheduler
+
+import (
+	"time"
+)
+
+// DefaultRunRetention is how long the run history is kept
+const DefaultRunRetention = 30 * 24 * time.Hour
+
+// RunStatus is the outcome of a task run
+type RunStatus string
+
+// Run statuses
+const (
+	RunStatusSucceeded RunStatus = "succeeded"
+	RunStatusFailed    RunStatus = "failed"
+)
+
+// Run represents a run of a scheduled task
+type Run struct {
+	ID     int64     `json:"id" db:"id"`
+	Task   string    `json:"task" db:"task"`
+	Status RunStatus `json:"status" db:"status"`
+	// Error is the error of a failed run
+	Error      string    `json:"error" db:"error"`
+	StartedAt  time.Time `json:"started_at" db:"started_at"`
+	FinishedAt time.Time `json:"finished_at" db:"finished_at"`
+}
+
+// ListRunsParams represents the filters to list task runs
+type ListRunsParams struct {
+	// Task lists the runs of a single task when set
+	Task  string
+	Limit int
+}
@@ -0,0 +1,131 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// SQL query constants
+const (
+	// Transaction level advisory lock, released by the end of the transaction, namespaced so it
+	// can't collide with the locks of other features
+	tryLockQuery = `SELECT pg_try_advisory_xact_lock(hashtext('scheduler'), hashtext($1))`
+
+	recordRunQuery = `
+        INSERT INTO scheduled_task_runs (task, status, error, started_at, finished_at)
+        VALUES ($1, $2, $3, $4, $5)
+        RETURNING id
+    `
+
+	purgeRunsQuery = `DELETE FROM scheduled_task_runs WHERE started_at < $1`
+
+	listRunsQuery = `
+        SELECT id, task, status, error, started_at, finished_at
+        FROM scheduled_task_runs
+        WHERE $1 = '' OR task = $1
+        ORDER BY started_at DESC, id DESC
+        LIMIT $2
+    `
+)
+
+// Database interface to support pgxpool and mocks
+type Database interface {
+	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
+	Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// Repository handles the task locks and the run history.
+type Repository struct {
+	db Database
+}
+
+// NewRepository creates a new Repository instance.
+func NewRepository(db Database) *Repository {
+	return &Repository{db: db}
+}
+
+// TryLock takes the advisory lock of a task without waiting. The lock lives in a transaction,
+// so it holds a connection until it is released and can't outlive it if the instance dies.
+func (r *Repository) TryLock(ctx context.Context, task string) (Lock, bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	var acquired bool
+	if err = tx.QueryRow(ctx, tryLockQuery, task).Scan(&acquired); err != nil {
+		_ = tx.Rollback(ctx)
+		return nil, false, fmt.Errorf("failed to take task lock: %w", err)
+	}
+
+	if !acquired {
+		if err = tx.Rollback(ctx); err != nil {
+			return nil, false, fmt.Errorf("failed to rollback transaction: %w", err)
+		}
+		return nil, false, nil
+	}
+
+	return &txLock{tx: tx}, true, nil
+}
+
+// RecordRun stores a task run
+func (r *Repository) RecordRun(ctx context.Context, run *Run) error {
+	err := r.db.QueryRow(ctx, recordRunQuery, run.Task, run.Status, run.Error, run.StartedAt, run.FinishedAt).
+		Scan(&run.ID)
+	if err != nil {
+		return fmt.Errorf("failed to record task run: %w", err)
+	}
+	return nil
+}
+
+// PurgeRuns removes the runs started before the given time and returns how many were removed
+func (r *Repository) PurgeRuns(ctx context.Context, before time.Time) (int64, error) {
+	commandTag, err := r.db.Exec(ctx, purgeRunsQuery, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge task runs: %w", err)
+	}
+	return commandTag.RowsAffected(), nil
+}
+
+// ListRuns retrieves the task runs matching params, most recent first.
+func (r *Repository) ListRuns(ctx context.Context, params *ListRunsParams) ([]*Run, error) {
+	rows, err := r.db.Query(ctx, listRunsQuery, params.Task, params.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list task runs: %w", err)
+	}
+	defer rows.Close()
+
+	var runs []*Run
+	for rows.Next() {
+		run := &Run{}
+		if err = rows.Scan(&run.ID, &run.Task, &run.Status, &run.Error, &run.StartedAt, &run.FinishedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan task run row: %w", err)
+		}
+		runs = append(runs, run)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating task run rows: %w", err)
+	}
+
+	return runs, nil
+}
+
+// txLock is a task lock held by a transaction
+type txLock struct {
+	tx pgx.Tx
+}
+
+// Release ends the transaction holding the lock
+func (l *txLock) Release(ctx context.Context) error {
+	if err := l.tx.Rollback(ctx); err != nil {
+		return fmt.Errorf("failed to release task lock: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_TryLock(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, lock Lock, acquired bool, err error)
+	}{
+		{
+			name: "lock taken",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(tryLockQuery)).
+					WithArgs("search-view-refresh").
+					WillReturnRows(pgxmock.NewRows([]string{"pg_try_advisory_xact_lock"}).AddRow(true))
+				mock.ExpectRollback()
+			},
+			checkResults: func(t *testing.T, lock Lock, acquired bool, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.True(t, acquired)
+				require.NoError(t, lock.Release(context.Background()))
+			},
+		},
+		{
+			name: "lock held by another instance",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(tryLockQuery)).
+					WithArgs("search-view-refresh").
+					WillReturnRows(pgxmock.NewRows([]string{"pg_try_advisory_xact_lock"}).AddRow(false))
+				mock.ExpectRollback()
+			},
+			checkResults: func(t *testing.T, lock Lock, acquired bool, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.False(t, acquired)
+				assert.Nil(t, lock)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(tryLockQuery)).
+					WithArgs("search-view-refresh").
+					WillReturnError(dbError)
+				mock.ExpectRollback()
+			},
+			checkResults: func(t *testing.T, _ Lock, acquired bool, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+				assert.False(t, acquired)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			lock, acquired, err := repo.TryLock(context.Background(), "search-view-refresh")
+			tt.checkResults(t, lock, acquired, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_RecordRun(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	run := &Run{
+		Task:       "link-checks",
+		Status:     RunStatusFailed,
+		Error:      "database error",
+		StartedAt:  now,
+		FinishedAt: now.Add(time.Second),
+	}
+
+	mockDB, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	mockDB.ExpectQuery(regexp.QuoteMeta(recordRunQuery)).
+		WithArgs("link-checks", RunStatusFailed, "database error", now, now.Add(time.Second)).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(int64(7)))
+
+	require.NoError(t, NewRepository(mockDB).RecordRun(context.Background(), run))
+	assert.Equal(t, int64(7), run.ID)
+
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
+
+func TestRepository_ListRuns(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+
+	mockDB, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	mockDB.ExpectQuery(regexp.QuoteMeta(listRunsQuery)).
+		WithArgs("link-checks", 50).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "task", "status", "error", "started_at", "finished_at"}).
+			AddRow(int64(7), "link-checks", RunStatusSucceeded, "", now, now.Add(time.Second)))
+
+	runs, err := NewRepository(mockDB).ListRuns(context.Background(), &ListRunsParams{Task: "link-checks", Limit: 50})
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, RunStatusSucceeded, runs[0].Status)
+
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
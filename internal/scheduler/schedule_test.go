@@ -0,0 +1,56 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSchedule(t *testing.T) {
+	t.Parallel()
+	// A Monday
+	from := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		spec string
+		next time.Time
+	}{
+		{name: "every minute", spec: "* * * * *", next: time.Date(2024, 1, 15, 10, 31, 0, 0, time.UTC)},
+		{name: "interval", spec: "@every 90s", next: from.Add(90 * time.Second)},
+		{name: "hourly", spec: "@hourly", next: time.Date(2024, 1, 15, 11, 0, 0, 0, time.UTC)},
+		{name: "daily", spec: "@daily", next: time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC)},
+		{name: "weekly on sunday", spec: "@weekly", next: time.Date(2024, 1, 21, 0, 0, 0, 0, time.UTC)},
+		{name: "minute step", spec: "*/20 * * * *", next: time.Date(2024, 1, 15, 10, 40, 0, 0, time.UTC)},
+		{name: "later in the hour", spec: "45 10 * * *", next: time.Date(2024, 1, 15, 10, 45, 0, 0, time.UTC)},
+		{name: "next day", spec: "15 6 * * *", next: time.Date(2024, 1, 16, 6, 15, 0, 0, time.UTC)},
+		{name: "hour list and range", spec: "0 8,12-14 * * *", next: time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)},
+		{name: "weekdays", spec: "0 9 * * 1-5", next: time.Date(2024, 1, 16, 9, 0, 0, 0, time.UTC)},
+		{name: "sunday as 7", spec: "0 9 * * 7", next: time.Date(2024, 1, 21, 9, 0, 0, 0, time.UTC)},
+		{name: "day of month", spec: "0 0 1 * *", next: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "day of month or week", spec: "0 0 1 * 3", next: time.Date(2024, 1, 17, 0, 0, 0, 0, time.UTC)},
+		{name: "leap day", spec: "0 0 29 2 *", next: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{name: "impossible date", spec: "0 0 30 2 *", next: time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			schedule, err := ParseSchedule(tt.spec)
+			require.NoError(t, err)
+			assert.Equal(t, tt.next, schedule.Next(from))
+		})
+	}
+}
+
+func TestParseSchedule_Invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "*/0 * * * *",
+		"5-1 * * * *", "@every", "@every -1m", "@yearly", "a * * * *"} {
+		_, err := ParseSchedule(spec)
+		assert.Error(t, err, spec)
+	}
+}
@@ -0,0 +1,158 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/actor"
+)
+
+// Store interface to lock the tasks and keep their run history.
+type Store interface {
+	// TryLock takes the lock of a task without waiting. It returns false when another
+	// instance holds it.
+	TryLock(ctx context.Context, task string) (Lock, bool, error)
+	RecordRun(ctx context.Context, run *Run) error
+}
+
+// Lock is a held task lock
+type Lock interface {
+	Release(ctx context.Context) error
+}
+
+// Task is a background task run on a schedule
+type Task struct {
+	// Name identifies the task in the locks and the run history
+	Name     string
+	Schedule Schedule
+	// Enabled must be set for the task to be scheduled
+	Enabled bool
+	// Jitter is the maximum random delay added to every scheduled run, so instances
+	// started together don't all compete for the lock at the same time
+	Jitter time.Duration
+	// Timeout bounds a run. Zero disables it.
+	Timeout time.Duration
+	Run     func(ctx context.Context) error
+}
+
+// Config configures the scheduler
+type Config struct {
+	// OnError is called when a run fails or can't be locked or recorded. Optional.
+	OnError func(task string, err error)
+}
+
+// Scheduler runs tasks on their schedule
+type Scheduler struct {
+	store  Store
+	tasks  []*Task
+	cfg    Config
+	now    func() time.Time
+	jitter func(maxJitter time.Duration) time.Duration
+}
+
+// NewScheduler creates a new instance of Scheduler. Run must be started for the tasks to run.
+func NewScheduler(store Store, cfg Config, tasks ...*Task) *Scheduler {
+	return &Scheduler{
+		store:  store,
+		tasks:  tasks,
+		cfg:    cfg,
+		now:    time.Now,
+		jitter: randomJitter,
+	}
+}
+
+// Tasks returns the scheduled tasks
+func (s *Scheduler) Tasks() []*Task {
+	return s.tasks
+}
+
+// Run runs the enabled tasks on their schedule until ctx is canceled, then waits for the
+// running tasks to return.
+func (s *Scheduler) Run(ctx context.Context) error {
+	var wg sync.WaitGroup
+	for _, task := range s.tasks {
+		if !task.Enabled {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.loop(ctx, task)
+		}()
+	}
+
+	wg.Wait()
+	return nil
+}
+
+// loop runs task on its schedule until ctx is canceled
+func (s *Scheduler) loop(ctx context.Context, task *Task) {
+	for {
+		next := task.Schedule.Next(s.now())
+		if next.IsZero() {
+			return
+		}
+
+		timer := time.NewTimer(next.Sub(s.now()) + s.jitter(task.Jitter))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		if err := s.RunTask(ctx, task); err != nil && ctx.Err() == nil && s.cfg.OnError != nil {
+			s.cfg.OnError(task.Name, err)
+		}
+	}
+}
+
+// RunTask runs task now and records the run, unless another instance is running it. The task is
+// the actor of the changes it makes, unless ctx already carries one.
+func (s *Scheduler) RunTask(ctx context.Context, task *Task) error {
+	lock, acquired, err := s.store.TryLock(ctx, task.Name)
+	if err != nil {
+		return fmt.Errorf("failed to lock task %s: %w", task.Name, err)
+	}
+	if !acquired {
+		return nil
+	}
+	// The lock is released and the run recorded even when shutting down
+	defer func() {
+		_ = lock.Release(context.WithoutCancel(ctx))
+	}()
+
+	run := &Run{Task: task.Name, StartedAt: s.now()}
+
+	runCtx := ctx
+	if actor.From(ctx).IsZero() {
+		runCtx = actor.With(ctx, actor.Task(task.Name))
+	}
+	if task.Timeout > 0 {
+		var cancel context.CancelFunc
+		runCtx, cancel = context.WithTimeout(runCtx, task.Timeout)
+		defer cancel()
+	}
+
+	runErr := task.Run(runCtx)
+	run.FinishedAt = s.now()
+	run.Status = RunStatusSucceeded
+	if runErr != nil {
+		run.Status = RunStatusFailed
+		run.Error = runErr.Error()
+	}
+
+	return errors.Join(runErr, s.store.RecordRun(context.WithoutCancel(ctx), run))
+}
+
+// randomJitter returns a random duration in [0, maxJitter)
+func randomJitter(maxJitter time.Duration) time.Duration {
+	if maxJitter <= 0 {
+		return 0
+	}
+	return rand.N(maxJitter)
+}
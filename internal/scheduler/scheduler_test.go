@@ -0,0 +1,165 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/actor"
+)
+
+func TestScheduler_RunTask(t *testing.T) {
+	t.Parallel()
+	startedAt := time.Date(2024, 1, 15, 6, 0, 0, 0, time.UTC)
+	taskError := errors.New("task error")
+	lockError := errors.New("lock error")
+
+	tests := []struct {
+		name         string
+		taskErr      error
+		mockSetup    func(mockStore *MockStore, mockLock *MockLock)
+		checkResults func(t *testing.T, ran bool, err error)
+	}{
+		{
+			name: "successful run recorded",
+			mockSetup: func(mockStore *MockStore, mockLock *MockLock) {
+				t.Helper()
+				mockStore.EXPECT().TryLock(context.Background(), "search-view-refresh").Return(mockLock, true, nil).Once()
+				mockStore.EXPECT().RecordRun(mock.Anything, &Run{
+					Task:       "search-view-refresh",
+					Status:     RunStatusSucceeded,
+					StartedAt:  startedAt,
+					FinishedAt: startedAt.Add(time.Second),
+				}).Return(nil).Once()
+				mockLock.EXPECT().Release(mock.Anything).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, ran bool, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.True(t, ran)
+			},
+		},
+		{
+			name:    "failed run recorded",
+			taskErr: taskError,
+			mockSetup: func(mockStore *MockStore, mockLock *MockLock) {
+				t.Helper()
+				mockStore.EXPECT().TryLock(context.Background(), "search-view-refresh").Return(mockLock, true, nil).Once()
+				mockStore.EXPECT().RecordRun(mock.Anything, mock.MatchedBy(func(run *Run) bool {
+					return run.Status == RunStatusFailed && run.Error == "task error"
+				})).Return(nil).Once()
+				mockLock.EXPECT().Release(mock.Anything).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, ran bool, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, taskError)
+				assert.True(t, ran)
+			},
+		},
+		{
+			name: "running on another instance",
+			mockSetup: func(mockStore *MockStore, _ *MockLock) {
+				t.Helper()
+				mockStore.EXPECT().TryLock(context.Background(), "search-view-refresh").Return(nil, false, nil).Once()
+			},
+			checkResults: func(t *testing.T, ran bool, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.False(t, ran)
+			},
+		},
+		{
+			name: "lock error",
+			mockSetup: func(mockStore *MockStore, _ *MockLock) {
+				t.Helper()
+				mockStore.EXPECT().TryLock(context.Background(), "search-view-refresh").Return(nil, false, lockError).Once()
+			},
+			checkResults: func(t *testing.T, ran bool, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, lockError)
+				assert.False(t, ran)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockStore := NewMockStore(t)
+			mockLock := NewMockLock(t)
+			tt.mockSetup(mockStore, mockLock)
+
+			ran := false
+			task := &Task{
+				Name:     "search-view-refresh",
+				Schedule: Every(time.Minute),
+				Enabled:  true,
+				Timeout:  time.Minute,
+				Run: func(ctx context.Context) error {
+					_, hasDeadline := ctx.Deadline()
+					assert.True(t, hasDeadline)
+					assert.Equal(t, actor.Task("search-view-refresh"), actor.From(ctx))
+					ran = true
+					return tt.taskErr
+				},
+			}
+
+			s := NewScheduler(mockStore, Config{}, task)
+			now := startedAt
+			s.now = func() time.Time {
+				current := now
+				now = now.Add(time.Second)
+				return current
+			}
+
+			err := s.RunTask(context.Background(), task)
+			tt.checkResults(t, ran, err)
+		})
+	}
+}
+
+func TestScheduler_Run(t *testing.T) {
+	t.Parallel()
+	mockStore := NewMockStore(t)
+	mockLock := NewMockLock(t)
+	mockStore.EXPECT().TryLock(mock.Anything, "enabled").Return(mockLock, true, nil)
+	mockStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil)
+	mockLock.EXPECT().Release(mock.Anything).Return(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	runs := make(chan string, 10)
+	newTask := func(name string, enabled bool) *Task {
+		return &Task{
+			Name:     name,
+			Schedule: Every(time.Millisecond),
+			Enabled:  enabled,
+			Run: func(ctx context.Context) error {
+				select {
+				case runs <- name:
+				case <-ctx.Done():
+				}
+				return nil
+			},
+		}
+	}
+
+	s := NewScheduler(mockStore, Config{}, newTask("enabled", true), newTask("disabled", false))
+	done := make(chan error)
+	go func() {
+		done <- s.Run(ctx)
+	}()
+
+	assert.Equal(t, "enabled", <-runs)
+	cancel()
+	require.NoError(t, <-done)
+	for len(runs) > 0 {
+		assert.Equal(t, "enabled", <-runs)
+	}
+}
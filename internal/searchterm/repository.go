@@ -0,0 +1,106 @@
+// Package searchterm looks up the synonyms and the known spellings of the terms of job searches,
+// the search synonyms and the technologies with their aliases.
+package searchterm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// SQL query constants
+const (
+	// Synonym of each of the terms ($1), a search synonym taking precedence over the technology
+	// the term is an alias of
+	listSynonymsQuery = `
+        SELECT DISTINCT ON (s.term) s.term, s.synonym
+        FROM (
+            SELECT term, synonym, 1 AS priority FROM search_synonyms WHERE term = ANY($1)
+            UNION ALL
+            SELECT LOWER(ta.alias), LOWER(t.name), 2 FROM technology_aliases ta
+            JOIN technologies t ON ta.technology_id = t.id
+            WHERE LOWER(ta.alias) = ANY($1) AND LOWER(ta.alias) <> LOWER(t.name)
+        ) s
+        ORDER BY s.term, s.priority
+    `
+
+	// Known term most similar to each of the terms ($1) using trigram similarity, known terms
+	// scoring below $2 are ignored. Archived technologies are no longer suggested.
+	findClosestTermsQuery = `
+        WITH vocabulary AS (
+            SELECT LOWER(name) AS term FROM technologies WHERE archived_at IS NULL
+            UNION
+            SELECT LOWER(ta.alias) FROM technology_aliases ta
+            JOIN technologies t ON ta.technology_id = t.id
+            WHERE t.archived_at IS NULL
+            UNION
+            SELECT term FROM search_synonyms
+        )
+        SELECT DISTINCT ON (w.term) w.term, v.term
+        FROM unnest($1::text[]) AS w(term)
+        JOIN vocabulary v ON similarity(v.term, w.term) >= $2
+        ORDER BY w.term, similarity(v.term, w.term) DESC, v.term
+    `
+)
+
+// Database interface to support pgxpool and mocks
+type Database interface {
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+}
+
+// Repository handles the database lookups of search terms.
+type Repository struct {
+	db Database
+}
+
+// NewRepository creates a new Repository instance.
+func NewRepository(db Database) *Repository {
+	return &Repository{db: db}
+}
+
+// ListSynonyms returns the synonyms of the given lowercase terms by term. Terms without a synonym
+// are left out.
+func (r *Repository) ListSynonyms(ctx context.Context, terms []string) (map[string]string, error) {
+	synonyms, err := r.queryTerms(ctx, listSynonymsQuery, terms)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list search synonyms: %w", err)
+	}
+	return synonyms, nil
+}
+
+// FindClosestTerms returns the known term most similar to each of the given lowercase terms,
+// by term. A term that is known is its own closest term, terms without a known term scoring
+// minSimilarity or more are left out.
+func (r *Repository) FindClosestTerms(ctx context.Context, terms []string, minSimilarity float64) (
+	map[string]string, error) {
+	closest, err := r.queryTerms(ctx, findClosestTermsQuery, terms, minSimilarity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find closest search terms: %w", err)
+	}
+	return closest, nil
+}
+
+// queryTerms runs a query mapping the terms to another term, the terms being its first argument
+func (r *Repository) queryTerms(ctx context.Context, query string, terms []string, args ...any) (
+	map[string]string, error) {
+	mapped := make(map[string]string)
+	if len(terms) == 0 {
+		return mapped, nil
+	}
+
+	rows, err := r.db.Query(ctx, query, append([]any{terms}, args...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var term, other string
+		if err = rows.Scan(&term, &other); err != nil {
+			return nil, err
+		}
+		mapped[term] = other
+	}
+	return mapped, rows.Err()
+}
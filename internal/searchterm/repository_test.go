@@ -0,0 +1,146 @@
+package searchterm
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_ListSynonyms(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		terms        []string
+		mockSetup    func(mock pgxmock.PgxPoolIface, terms []string)
+		checkResults func(t *testing.T, result map[string]string, err error)
+	}{
+		{
+			name:  "synonyms found",
+			terms: []string{"qa", "k8s", "engineer"},
+			mockSetup: func(mock pgxmock.PgxPoolIface, terms []string) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(listSynonymsQuery)).
+					WithArgs(terms).
+					WillReturnRows(pgxmock.NewRows([]string{"term", "synonym"}).
+						AddRow("k8s", "kubernetes").
+						AddRow("qa", "quality assurance"))
+			},
+			checkResults: func(t *testing.T, result map[string]string, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, map[string]string{"k8s": "kubernetes", "qa": "quality assurance"}, result)
+			},
+		},
+		{
+			name:      "no terms",
+			terms:     nil,
+			mockSetup: func(_ pgxmock.PgxPoolIface, _ []string) {},
+			checkResults: func(t *testing.T, result map[string]string, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Empty(t, result)
+			},
+		},
+		{
+			name:  "database error",
+			terms: []string{"qa"},
+			mockSetup: func(mock pgxmock.PgxPoolIface, terms []string) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(listSynonymsQuery)).
+					WithArgs(terms).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, result map[string]string, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+				assert.Nil(t, result)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB, tt.terms)
+
+			result, err := repo.ListSynonyms(context.Background(), tt.terms)
+			tt.checkResults(t, result, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_FindClosestTerms(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		terms        []string
+		mockSetup    func(mock pgxmock.PgxPoolIface, terms []string)
+		checkResults func(t *testing.T, result map[string]string, err error)
+	}{
+		{
+			name:  "closest terms found",
+			terms: []string{"kubernetess", "golang"},
+			mockSetup: func(mock pgxmock.PgxPoolIface, terms []string) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(findClosestTermsQuery)).
+					WithArgs(terms, 0.5).
+					WillReturnRows(pgxmock.NewRows([]string{"term", "closest"}).
+						AddRow("golang", "golang").
+						AddRow("kubernetess", "kubernetes"))
+			},
+			checkResults: func(t *testing.T, result map[string]string, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, map[string]string{"golang": "golang", "kubernetess": "kubernetes"}, result)
+			},
+		},
+		{
+			name:  "database error",
+			terms: []string{"kubernetess"},
+			mockSetup: func(mock pgxmock.PgxPoolIface, terms []string) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(findClosestTermsQuery)).
+					WithArgs(terms, 0.5).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, result map[string]string, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+				assert.Nil(t, result)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB, tt.terms)
+
+			result, err := repo.FindClosestTerms(context.Background(), tt.terms, 0.5)
+			tt.checkResults(t, result, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package seed_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/company"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+	"github.com/rodruizronald/ticos-in-tech/internal/seed"
+)
+
+// The seed package doesn't import the company and jobs packages, so their tests can use it.
+// The slugs and signatures stored in the default dataset must match the ones they compute.
+func TestDefault_SlugsAndSignatures(t *testing.T) {
+	t.Parallel()
+
+	dataset, err := seed.Default()
+	require.NoError(t, err)
+
+	for _, c := range dataset.Companies {
+		assert.Equal(t, company.Slugify(c.Name), c.Slug, c.Name)
+	}
+	for _, j := range dataset.Jobs {
+		assert.Equal(t, jobs.ComputeSignature(j.Company, j.Title, j.ApplicationURL), j.Signature, j.Title)
+	}
+}
@@ -0,0 +1,190 @@
+package seed
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// SQL query constants. Rows already stored are left untouched: the upserts only return their ID,
+// with inserted false.
+const (
+	upsertCompanyQuery = `
+        INSERT INTO companies (name, slug, logo_url, is_active)
+        VALUES ($1, $2, $3, true)
+        ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name
+        RETURNING id, (xmax = 0) AS inserted
+    `
+
+	upsertTechnologyQuery = `
+        INSERT INTO technologies (name, category, parent_id)
+        VALUES ($1, $2, $3)
+        ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name
+        RETURNING id, (xmax = 0) AS inserted
+    `
+
+	insertAliasQuery = `
+        INSERT INTO technology_aliases (technology_id, alias)
+        VALUES ($1, $2)
+        ON CONFLICT (alias) DO NOTHING
+    `
+
+	upsertJobQuery = `
+        INSERT INTO jobs (
+            company_id, title, description, experience_level, employment_type, location, work_mode,
+            application_url, signature, is_active, language, remote_eligibility, utc_offset_min,
+            utc_offset_max, location_id, created_at, updated_at
+        ) VALUES (
+            $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, NULLIF($12, ''), $13, $14,
+            (SELECT id FROM locations WHERE region = $6 AND province = $15 AND city = ''), $16, $16
+        )
+        ON CONFLICT (signature) DO UPDATE SET signature = EXCLUDED.signature
+        RETURNING id, (xmax = 0) AS inserted
+    `
+
+	insertJobTechnologyQuery = `
+        INSERT INTO job_technologies (job_id, technology_id, is_primary, is_required)
+        VALUES ($1, $2, $3, $4)
+        ON CONFLICT (job_id, technology_id) DO NOTHING
+    `
+
+	insertJobBenefitQuery = `
+        INSERT INTO job_benefits (job_id, benefit_id)
+        SELECT $1, id FROM benefits WHERE slug = $2
+        ON CONFLICT (job_id, benefit_id) DO NOTHING
+    `
+)
+
+// Database interface for database operations
+type Database interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// Load stores the rows of dataset missing from the database in a single transaction, so loading
+// the same dataset twice creates nothing the second time. Jobs are dated relative to now and
+// identified by their signature. The job search view is not refreshed.
+func Load(ctx context.Context, db Database, dataset *Dataset, now time.Time) (*Summary, error) {
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	l := &loader{
+		tx:           tx,
+		summary:      &Summary{},
+		companies:    make(map[string]int, len(dataset.Companies)),
+		technologies: make(map[string]int, len(dataset.Technologies)),
+	}
+	if err = l.loadCompanies(ctx, dataset.Companies); err != nil {
+		return nil, err
+	}
+	if err = l.loadTechnologies(ctx, dataset.Technologies); err != nil {
+		return nil, err
+	}
+	if err = l.loadJobs(ctx, dataset.Jobs, now); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return l.summary, nil
+}
+
+// loader stores a dataset in a transaction, keeping the IDs of the stored rows by name
+type loader struct {
+	tx           pgx.Tx
+	summary      *Summary
+	companies    map[string]int
+	technologies map[string]int
+}
+
+// loadCompanies stores the companies
+func (l *loader) loadCompanies(ctx context.Context, companies []Company) error {
+	for _, c := range companies {
+		var id int
+		var inserted bool
+		err := l.tx.QueryRow(ctx, upsertCompanyQuery, c.Name, c.Slug, c.LogoURL).
+			Scan(&id, &inserted)
+		if err != nil {
+			return fmt.Errorf("failed to seed company %s: %w", c.Name, err)
+		}
+
+		l.companies[c.Name] = id
+		if inserted {
+			l.summary.Companies++
+		}
+	}
+	return nil
+}
+
+// loadTechnologies stores the technologies and their aliases
+func (l *loader) loadTechnologies(ctx context.Context, technologies []Technology) error {
+	for _, t := range technologies {
+		var parentID *int
+		if id, ok := l.technologies[t.Parent]; ok {
+			parentID = &id
+		}
+
+		var id int
+		var inserted bool
+		err := l.tx.QueryRow(ctx, upsertTechnologyQuery, t.Name, t.Category, parentID).Scan(&id, &inserted)
+		if err != nil {
+			return fmt.Errorf("failed to seed technology %s: %w", t.Name, err)
+		}
+		l.technologies[t.Name] = id
+		if inserted {
+			l.summary.Technologies++
+		}
+
+		for _, alias := range t.Aliases {
+			commandTag, err := l.tx.Exec(ctx, insertAliasQuery, id, alias)
+			if err != nil {
+				return fmt.Errorf("failed to seed alias %s of %s: %w", alias, t.Name, err)
+			}
+			l.summary.Aliases += int(commandTag.RowsAffected())
+		}
+	}
+	return nil
+}
+
+// loadJobs stores the jobs with their technologies and benefits
+func (l *loader) loadJobs(ctx context.Context, jobs []Job, now time.Time) error {
+	for _, j := range jobs {
+		createdAt := now.AddDate(0, 0, -j.PostedDaysAgo)
+
+		var id int
+		var inserted bool
+		err := l.tx.QueryRow(ctx, upsertJobQuery, l.companies[j.Company], j.Title, j.Description,
+			j.ExperienceLevel, j.EmploymentType, j.Location, j.WorkMode, j.ApplicationURL, j.Signature,
+			!j.Inactive, j.Language, j.RemoteEligibility, j.UTCOffsetMin, j.UTCOffsetMax, j.Province, createdAt,
+		).Scan(&id, &inserted)
+		if err != nil {
+			return fmt.Errorf("failed to seed job %s at %s: %w", j.Title, j.Company, err)
+		}
+		if !inserted {
+			continue
+		}
+		l.summary.Jobs++
+
+		for _, t := range j.Technologies {
+			if _, err = l.tx.Exec(ctx, insertJobTechnologyQuery, id, l.technologies[t.Name], t.Primary, t.Required); err != nil {
+				return fmt.Errorf("failed to add technology %s to job %s: %w", t.Name, j.Title, err)
+			}
+		}
+
+		for _, benefit := range j.Benefits {
+			commandTag, err := l.tx.Exec(ctx, insertJobBenefitQuery, id, benefit)
+			if err != nil {
+				return fmt.Errorf("failed to add benefit %s to job %s: %w", benefit, j.Title, err)
+			}
+			if commandTag.RowsAffected() == 0 {
+				return fmt.Errorf("failed to add benefit %s to job %s: unknown benefit", benefit, j.Title)
+			}
+		}
+	}
+	return nil
+}
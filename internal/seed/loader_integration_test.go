@@ -0,0 +1,39 @@
+//go:build integration
+
+package seed_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/seed"
+	"github.com/rodruizronald/ticos-in-tech/internal/testdb"
+)
+
+func TestLoad_Integration(t *testing.T) {
+	t.Parallel()
+	db := testdb.New(t)
+	ctx := context.Background()
+
+	dataset, err := seed.Default()
+	require.NoError(t, err)
+
+	summary := testdb.Seed(t, db)
+	assert.Equal(t, len(dataset.Companies), summary.Companies)
+	assert.Equal(t, len(dataset.Technologies), summary.Technologies)
+	assert.Equal(t, len(dataset.Jobs), summary.Jobs)
+	assert.Positive(t, summary.Aliases)
+
+	var searchable int
+	require.NoError(t, db.QueryRow(ctx, "SELECT COUNT(*) FROM job_search_view").Scan(&searchable))
+	assert.Positive(t, searchable)
+
+	// Loading again creates nothing
+	summary, err = seed.Load(ctx, db, dataset, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, &seed.Summary{}, summary)
+}
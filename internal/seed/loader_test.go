@@ -0,0 +1,164 @@
+package seed
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 1, 15, 6, 0, 0, 0, time.UTC)
+	dbError := errors.New("database error")
+	dataset := &Dataset{
+		Companies: []Company{{Name: "Tech Corp", Slug: "tech-corp", LogoURL: "https://techcorp.com/logo.png"}},
+		Technologies: []Technology{
+			{Name: "JavaScript", Category: "programming", Aliases: []string{"JS"}},
+			{Name: "React", Category: "frontend", Parent: "JavaScript"},
+		},
+		Jobs: []Job{{
+			Company:         "Tech Corp",
+			Title:           "Frontend Engineer",
+			Description:     "Build our web app.",
+			ApplicationURL:  "https://techcorp.com/jobs/1",
+			Signature:       "frontend-engineer-signature",
+			Location:        "Costa Rica",
+			Province:        "Heredia",
+			WorkMode:        "Hybrid",
+			ExperienceLevel: "Mid-level",
+			EmploymentType:  "Full-time",
+			Language:        "en",
+			Benefits:        []string{"health-insurance"},
+			Technologies:    []JobTechnology{{Name: "React", Primary: true, Required: true}},
+			PostedDaysAgo:   2,
+		}},
+	}
+	upsertedRows := func(id int, inserted bool) *pgxmock.Rows {
+		return pgxmock.NewRows([]string{"id", "inserted"}).AddRow(id, inserted)
+	}
+	expectCatalog := func(mock pgxmock.PgxPoolIface, inserted bool) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(upsertCompanyQuery)).
+			WithArgs("Tech Corp", "tech-corp", "https://techcorp.com/logo.png").
+			WillReturnRows(upsertedRows(1, inserted))
+		mock.ExpectQuery(regexp.QuoteMeta(upsertTechnologyQuery)).
+			WithArgs("JavaScript", "programming", (*int)(nil)).
+			WillReturnRows(upsertedRows(10, inserted))
+		affected := int64(0)
+		if inserted {
+			affected = 1
+		}
+		mock.ExpectExec(regexp.QuoteMeta(insertAliasQuery)).
+			WithArgs(10, "JS").
+			WillReturnResult(pgxmock.NewResult("INSERT", affected))
+		parentID := 10
+		mock.ExpectQuery(regexp.QuoteMeta(upsertTechnologyQuery)).
+			WithArgs("React", "frontend", &parentID).
+			WillReturnRows(upsertedRows(11, inserted))
+	}
+	expectJob := func(mock pgxmock.PgxPoolIface) *pgxmock.ExpectedQuery {
+		return mock.ExpectQuery(regexp.QuoteMeta(upsertJobQuery)).
+			WithArgs(1, "Frontend Engineer", "Build our web app.", "Mid-level", "Full-time", "Costa Rica",
+				"Hybrid", "https://techcorp.com/jobs/1", "frontend-engineer-signature", true, "en", "", (*int)(nil), (*int)(nil),
+				"Heredia", now.AddDate(0, 0, -2))
+	}
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, summary *Summary, err error)
+	}{
+		{
+			name: "empty database",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				expectCatalog(mock, true)
+				expectJob(mock).WillReturnRows(upsertedRows(100, true))
+				mock.ExpectExec(regexp.QuoteMeta(insertJobTechnologyQuery)).
+					WithArgs(100, 11, true, true).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectExec(regexp.QuoteMeta(insertJobBenefitQuery)).
+					WithArgs(100, "health-insurance").
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectCommit()
+			},
+			checkResults: func(t *testing.T, summary *Summary, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, &Summary{Companies: 1, Technologies: 2, Aliases: 1, Jobs: 1}, summary)
+			},
+		},
+		{
+			name: "dataset already loaded",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				expectCatalog(mock, false)
+				expectJob(mock).WillReturnRows(upsertedRows(100, false))
+				mock.ExpectCommit()
+			},
+			checkResults: func(t *testing.T, summary *Summary, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, &Summary{}, summary)
+			},
+		},
+		{
+			name: "unknown benefit",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				expectCatalog(mock, true)
+				expectJob(mock).WillReturnRows(upsertedRows(100, true))
+				mock.ExpectExec(regexp.QuoteMeta(insertJobTechnologyQuery)).
+					WithArgs(100, 11, true, true).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectExec(regexp.QuoteMeta(insertJobBenefitQuery)).
+					WithArgs(100, "health-insurance").
+					WillReturnResult(pgxmock.NewResult("INSERT", 0))
+				mock.ExpectRollback()
+			},
+			checkResults: func(t *testing.T, summary *Summary, err error) {
+				t.Helper()
+				require.EqualError(t, err,
+					"failed to add benefit health-insurance to job Frontend Engineer: unknown benefit")
+				assert.Nil(t, summary)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(upsertCompanyQuery)).
+					WithArgs("Tech Corp", "tech-corp", "https://techcorp.com/logo.png").
+					WillReturnError(dbError)
+				mock.ExpectRollback()
+			},
+			checkResults: func(t *testing.T, _ *Summary, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			tt.mockSetup(mockDB)
+
+			summary, err := Load(context.Background(), mockDB, dataset, now)
+			tt.checkResults(t, summary, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,133 @@
+// Package seed loads a small, deterministic dataset of companies, technologies, aliases and jobs
+// into a database, so local development and integration tests work against realistic data
+// without production exports.
+package seed
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+)
+
+// datasetJSON is the default dataset
+//
+//go:embed dataset.json
+var datasetJSON []byte
+
+// Dataset is a set of rows to load. Jobs and technologies reference companies and technologies by name.
+type Dataset struct {
+	Companies    []Company    `json:"companies"`
+	Technologies []Technology `json:"technologies"`
+	Jobs         []Job        `json:"jobs"`
+}
+
+// Company is a company of the dataset
+type Company struct {
+	Name string `json:"name"`
+	// Slug is company.Slugify of the name
+	Slug    string `json:"slug"`
+	LogoURL string `json:"logo_url"`
+}
+
+// Technology is a technology of the dataset with its aliases
+type Technology struct {
+	Name     string   `json:"name"`
+	Category string   `json:"category"`
+	Aliases  []string `json:"alias"`
+	// Parent is the name of the parent technology, declared before this one
+	Parent string `json:"parent"`
+}
+
+// JobTechnology is a technology used by a job of the dataset, primary when the job is built around it
+type JobTechnology struct {
+	Name     string `json:"name"`
+	Primary  bool   `json:"primary"`
+	Required bool   `json:"required"`
+}
+
+// Job is a published job of the dataset
+type Job struct {
+	Company        string `json:"company"`
+	Title          string `json:"title"`
+	Description    string `json:"description"`
+	ApplicationURL string `json:"application_url"`
+	// Signature is jobs.ComputeSignature of the company, title and application URL. It identifies
+	// the job, so loading a dataset again doesn't duplicate it.
+	Signature       string `json:"signature"`
+	Location        string `json:"location"`
+	Province        string `json:"province"`
+	WorkMode        string `json:"work_mode"`
+	ExperienceLevel string `json:"experience_level"`
+	EmploymentType  string `json:"employment_type"`
+	Language        string `json:"language"`
+	// RemoteEligibility, UTCOffsetMin and UTCOffsetMax are optional
+	RemoteEligibility string          `json:"remote_eligibility"`
+	UTCOffsetMin      *int            `json:"utc_offset_min"`
+	UTCOffsetMax      *int            `json:"utc_offset_max"`
+	Benefits          []string        `json:"benefits"`
+	Technologies      []JobTechnology `json:"technologies"`
+	// PostedDaysAgo dates the job relative to the time the dataset is loaded, so it stays recent
+	PostedDaysAgo int  `json:"posted_days_ago"`
+	Inactive      bool `json:"inactive"`
+}
+
+// Summary counts the rows created by a load. Rows already in the database are not counted.
+type Summary struct {
+	Companies    int
+	Technologies int
+	Aliases      int
+	Jobs         int
+}
+
+// Default returns the dataset shipped with the package
+func Default() (*Dataset, error) {
+	return Parse(datasetJSON)
+}
+
+// Parse decodes and validates a JSON dataset
+func Parse(data []byte) (*Dataset, error) {
+	var dataset Dataset
+	if err := json.Unmarshal(data, &dataset); err != nil {
+		return nil, fmt.Errorf("failed to parse dataset: %w", err)
+	}
+	if err := dataset.Validate(); err != nil {
+		return nil, err
+	}
+	return &dataset, nil
+}
+
+// Validate checks that the slugs and signatures are set, and that every name referenced by the
+// dataset is declared in it
+func (d *Dataset) Validate() error {
+	companies := make(map[string]bool, len(d.Companies))
+	for _, c := range d.Companies {
+		if c.Slug == "" {
+			return fmt.Errorf("company %s: missing slug", c.Name)
+		}
+		companies[c.Name] = true
+	}
+
+	technologies := make(map[string]bool, len(d.Technologies))
+	for _, t := range d.Technologies {
+		if t.Parent != "" && !technologies[t.Parent] {
+			return fmt.Errorf("technology %s: parent %s must be declared before it", t.Name, t.Parent)
+		}
+		technologies[t.Name] = true
+	}
+
+	for _, j := range d.Jobs {
+		if !companies[j.Company] {
+			return fmt.Errorf("job %s: unknown company %s", j.Title, j.Company)
+		}
+		if j.Signature == "" {
+			return fmt.Errorf("job %s: missing signature", j.Title)
+		}
+		for _, t := range j.Technologies {
+			if !technologies[t.Name] {
+				return fmt.Errorf("job %s: unknown technology %s", j.Title, t.Name)
+			}
+		}
+	}
+
+	return nil
+}
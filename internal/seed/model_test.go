@@ -0,0 +1,83 @@
+package seed
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefault(t *testing.T) {
+	t.Parallel()
+
+	dataset, err := Default()
+	require.NoError(t, err)
+	assert.NotEmpty(t, dataset.Companies)
+	assert.NotEmpty(t, dataset.Technologies)
+	assert.NotEmpty(t, dataset.Jobs)
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{
+			name: "valid dataset",
+			data: `{"companies": [{"name": "Tech Corp", "slug": "tech-corp"}],
+                "technologies": [{"name": "JavaScript"}, {"name": "React", "parent": "JavaScript"}],
+                "jobs": [{"company": "Tech Corp", "title": "Frontend", "signature": "abc",
+                    "technologies": [{"name": "React"}]}]}`,
+		},
+		{
+			name:    "invalid json",
+			data:    `{"companies": [`,
+			wantErr: "failed to parse dataset",
+		},
+		{
+			name:    "parent declared after the technology",
+			data:    `{"technologies": [{"name": "React", "parent": "JavaScript"}, {"name": "JavaScript"}]}`,
+			wantErr: "technology React: parent JavaScript must be declared before it",
+		},
+		{
+			name:    "missing slug",
+			data:    `{"companies": [{"name": "Tech Corp"}]}`,
+			wantErr: "company Tech Corp: missing slug",
+		},
+		{
+			name:    "unknown company",
+			data:    `{"jobs": [{"company": "Tech Corp", "title": "Frontend"}]}`,
+			wantErr: "job Frontend: unknown company Tech Corp",
+		},
+		{
+			name: "missing signature",
+			data: `{"companies": [{"name": "Tech Corp", "slug": "tech-corp"}],
+                "jobs": [{"company": "Tech Corp", "title": "Frontend"}]}`,
+			wantErr: "job Frontend: missing signature",
+		},
+		{
+			name: "unknown technology",
+			data: `{"companies": [{"name": "Tech Corp", "slug": "tech-corp"}],
+                "jobs": [{"company": "Tech Corp", "title": "Frontend", "signature": "abc",
+                    "technologies": [{"name": "React"}]}]}`,
+			wantErr: "job Frontend: unknown technology React",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			dataset, err := Parse([]byte(tt.data))
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, dataset.Technologies, 2)
+		})
+	}
+}
@@ -0,0 +1,268 @@
+// Package sentry reports the panics of the server to Sentry through its envelope endpoint.
+package sentry
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"runtime"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultTimeout bounds each request to Sentry
+	DefaultTimeout = 5 * time.Second
+
+	// modulePrefix marks the functions of this module as in app in the reported stack traces
+	modulePrefix = "github.com/rodruizronald/ticos-in-tech/"
+	// clientName identifies the client in the authentication header
+	clientName = "ticos-in-tech/1.0"
+	// maxErrorBodySize bounds how much of an error response is kept in the error message
+	maxErrorBodySize = 512
+)
+
+// ErrInvalidDSN is returned for a DSN that isn't scheme://key@host/project
+var ErrInvalidDSN = errors.New("invalid Sentry DSN")
+
+// DSN is a parsed Sentry DSN, https://public_key@host/project_id
+type DSN struct {
+	raw       string
+	publicKey string
+	// envelopeURL is where the events of the project are posted
+	envelopeURL string
+}
+
+// ParseDSN parses a Sentry DSN
+func ParseDSN(dsn string) (*DSN, error) {
+	u, err := url.Parse(dsn)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || u.User == nil {
+		return nil, ErrInvalidDSN
+	}
+	publicKey := u.User.Username()
+	path := strings.TrimSuffix(u.Path, "/")
+	slash := strings.LastIndex(path, "/")
+	if publicKey == "" || slash < 0 || slash == len(path)-1 {
+		return nil, ErrInvalidDSN
+	}
+	prefix, projectID := path[:slash], path[slash+1:]
+
+	return &DSN{
+		raw:         dsn,
+		publicKey:   publicKey,
+		envelopeURL: fmt.Sprintf("%s://%s%s/api/%s/envelope/", u.Scheme, u.Host, prefix, projectID),
+	}, nil
+}
+
+// Event is a panic reported to Sentry
+type Event struct {
+	// Value is the recovered panic value
+	Value any
+	// Frames are the calls that led to the panic, the one that panicked first
+	Frames []runtime.Frame
+	// Request is the request being served, only its method, URL and user agent are reported
+	Request *http.Request
+	// Tags index the event, e.g. the request ID
+	Tags map[string]string
+}
+
+// Client sends the events to a Sentry project
+type Client struct {
+	dsn         *DSN
+	environment string
+	client      *http.Client
+	now         func() time.Time
+}
+
+// NewClient creates a new instance of Client reporting to the project of dsn, tagging the events
+// with environment
+func NewClient(dsn *DSN, environment string) *Client {
+	return &Client{dsn: dsn, environment: environment, client: &http.Client{Timeout: DefaultTimeout}, now: time.Now}
+}
+
+// Capture sends an event to Sentry, returning its ID
+func (c *Client) Capture(ctx context.Context, event *Event) (string, error) {
+	eventID, err := newEventID()
+	if err != nil {
+		return "", err
+	}
+	body, err := c.envelope(eventID, event)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.dsn.envelopeURL, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to create Sentry request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-sentry-envelope")
+	req.Header.Set("X-Sentry-Auth", fmt.Sprintf("Sentry sentry_version=7, sentry_key=%s, sentry_client=%s",
+		c.dsn.publicKey, clientName))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to send Sentry event: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return "", &ResponseError{StatusCode: resp.StatusCode, Body: string(respBody)}
+	}
+	return eventID, nil
+}
+
+// ResponseError represents a failed response of Sentry
+type ResponseError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("Sentry responded with status %d: %s", e.StatusCode, e.Body)
+}
+
+// envelope encodes an event as an envelope of a single item: the envelope header, the item header
+// and the event, one per line
+func (c *Client) envelope(eventID string, event *Event) ([]byte, error) {
+	now := c.now().UTC()
+	payload, err := json.Marshal(c.newPayload(eventID, now, event))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode Sentry event: %w", err)
+	}
+	header, err := json.Marshal(map[string]string{
+		"event_id": eventID,
+		"sent_at":  now.Format(time.RFC3339Nano),
+		"dsn":      c.dsn.raw,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode Sentry envelope: %w", err)
+	}
+
+	var b bytes.Buffer
+	b.Write(header)
+	fmt.Fprintf(&b, "\n{\"type\":\"event\",\"length\":%d}\n", len(payload))
+	b.Write(payload)
+	b.WriteByte('\n')
+	return b.Bytes(), nil
+}
+
+// payload is the event as sent to Sentry
+type payload struct {
+	EventID     string            `json:"event_id"`
+	Timestamp   time.Time         `json:"timestamp"`
+	Platform    string            `json:"platform"`
+	Level       string            `json:"level"`
+	Environment string            `json:"environment,omitempty"`
+	Exception   exceptions        `json:"exception"`
+	Request     *requestInfo      `json:"request,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty"`
+}
+
+type exceptions struct {
+	Values []exception `json:"values"`
+}
+
+type exception struct {
+	Type       string     `json:"type"`
+	Value      string     `json:"value"`
+	Stacktrace stacktrace `json:"stacktrace"`
+}
+
+type stacktrace struct {
+	Frames []frame `json:"frames"`
+}
+
+type frame struct {
+	Function string `json:"function"`
+	Module   string `json:"module,omitempty"`
+	AbsPath  string `json:"abs_path"`
+	Lineno   int    `json:"lineno"`
+	InApp    bool   `json:"in_app"`
+}
+
+type requestInfo struct {
+	Method  string            `json:"method"`
+	URL     string            `json:"url"`
+	Query   string            `json:"query_string,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// newPayload converts an event to what Sentry expects, the frames of its stack trace going from
+// the oldest call to the one that panicked
+func (c *Client) newPayload(eventID string, now time.Time, event *Event) *payload {
+	frames := make([]frame, len(event.Frames))
+	for i, f := range event.Frames {
+		module, function := splitFunction(f.Function)
+		frames[len(frames)-1-i] = frame{
+			Function: function,
+			Module:   module,
+			AbsPath:  f.File,
+			Lineno:   f.Line,
+			InApp:    strings.HasPrefix(f.Function, modulePrefix),
+		}
+	}
+
+	p := &payload{
+		EventID:     eventID,
+		Timestamp:   now,
+		Platform:    "go",
+		Level:       "error",
+		Environment: c.environment,
+		Exception: exceptions{Values: []exception{{
+			Type:       "panic",
+			Value:      fmt.Sprint(event.Value),
+			Stacktrace: stacktrace{Frames: frames},
+		}}},
+		Tags: event.Tags,
+	}
+	if r := event.Request; r != nil {
+		p.Request = &requestInfo{
+			Method: r.Method,
+			URL:    (&url.URL{Scheme: scheme(r), Host: r.Host, Path: r.URL.Path}).String(),
+			Query:  r.URL.RawQuery,
+		}
+		if userAgent := r.UserAgent(); userAgent != "" {
+			p.Request.Headers = map[string]string{"User-Agent": userAgent}
+		}
+	}
+	return p
+}
+
+// splitFunction splits a qualified function name into its package path and name,
+// e.g. github.com/a/b/pkg.(*T).Method into github.com/a/b/pkg and (*T).Method
+func splitFunction(qualified string) (module, function string) {
+	start := strings.LastIndex(qualified, "/") + 1
+	dot := strings.Index(qualified[start:], ".")
+	if dot < 0 {
+		return "", qualified
+	}
+	return qualified[:start+dot], qualified[start+dot+1:]
+}
+
+// scheme returns the scheme the request was made with
+func scheme(r *http.Request) string {
+	if r.TLS != nil {
+		return "https"
+	}
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		return proto
+	}
+	return "http"
+}
+
+// newEventID returns a random event ID, 32 hex characters
+func newEventID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate Sentry event ID: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
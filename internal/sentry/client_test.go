@@ -0,0 +1,142 @@
+package sentry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDSN(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		dsn         string
+		envelopeURL string
+		err         error
+	}{
+		{
+			name:        "project DSN",
+			dsn:         "https://abc123@o1.ingest.sentry.io/42",
+			envelopeURL: "https://o1.ingest.sentry.io/api/42/envelope/",
+		},
+		{
+			name:        "self-hosted DSN with a path",
+			dsn:         "http://abc123@sentry.example.com:9000/sentry/7",
+			envelopeURL: "http://sentry.example.com:9000/sentry/api/7/envelope/",
+		},
+		{name: "missing key", dsn: "https://o1.ingest.sentry.io/42", err: ErrInvalidDSN},
+		{name: "missing project", dsn: "https://abc123@o1.ingest.sentry.io/", err: ErrInvalidDSN},
+		{name: "unsupported scheme", dsn: "ftp://abc123@o1.ingest.sentry.io/42", err: ErrInvalidDSN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			dsn, err := ParseDSN(tt.dsn)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "abc123", dsn.publicKey)
+			assert.Equal(t, tt.envelopeURL, dsn.envelopeURL)
+		})
+	}
+}
+
+func TestClient_Capture(t *testing.T) {
+	t.Parallel()
+
+	frames := []runtime.Frame{
+		{Function: "github.com/rodruizronald/ticos-in-tech/internal/jobs.(*Handler).GetJob", File: "jobs.go", Line: 42},
+		{Function: "github.com/gin-gonic/gin.(*Context).Next", File: "context.go", Line: 185},
+	}
+	request := httptest.NewRequest(http.MethodGet, "http://api.example.com/api/v1/jobs/5?lang=es", http.NoBody)
+	request.Header.Set("User-Agent", "curl/8.0")
+	request.Header.Set("Authorization", "Bearer secret")
+
+	tests := []struct {
+		name   string
+		status int
+		err    string
+	}{
+		{name: "event sent", status: http.StatusOK},
+		{name: "error response", status: http.StatusTooManyRequests, err: "Sentry responded with status 429"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var body []byte
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/42/envelope/", r.URL.Path)
+				assert.Equal(t, "application/x-sentry-envelope", r.Header.Get("Content-Type"))
+				assert.Contains(t, r.Header.Get("X-Sentry-Auth"), "sentry_key=abc123")
+				body, _ = io.ReadAll(r.Body)
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			dsn, err := ParseDSN(strings.Replace(server.URL, "://", "://abc123@", 1) + "/42")
+			require.NoError(t, err)
+			client := NewClient(dsn, "release")
+			client.now = func() time.Time { return time.Date(2024, 1, 15, 14, 0, 0, 0, time.UTC) }
+
+			eventID, err := client.Capture(context.Background(), &Event{
+				Value:   "runtime error: invalid memory address or nil pointer dereference",
+				Frames:  frames,
+				Request: request,
+				Tags:    map[string]string{"request_id": "req-1"},
+			})
+			if tt.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, eventID, 32)
+
+			lines := bytes.Split(bytes.TrimSpace(body), []byte("\n"))
+			require.Len(t, lines, 3)
+			assert.Contains(t, string(lines[0]), eventID)
+			assert.JSONEq(t, `{"type": "event", "length": `+strconv.Itoa(len(lines[2]))+`}`, string(lines[1]))
+
+			var event payload
+			require.NoError(t, json.Unmarshal(lines[2], &event))
+			assert.Equal(t, "release", event.Environment)
+			assert.Equal(t, map[string]string{"request_id": "req-1"}, event.Tags)
+			assert.Equal(t, &requestInfo{
+				Method:  http.MethodGet,
+				URL:     "http://api.example.com/api/v1/jobs/5",
+				Query:   "lang=es",
+				Headers: map[string]string{"User-Agent": "curl/8.0"},
+			}, event.Request)
+
+			require.Len(t, event.Exception.Values, 1)
+			exception := event.Exception.Values[0]
+			assert.Equal(t, "panic", exception.Type)
+			assert.Equal(t, []frame{
+				{Function: "(*Context).Next", Module: "github.com/gin-gonic/gin", AbsPath: "context.go", Lineno: 185},
+				{
+					Function: "(*Handler).GetJob",
+					Module:   "github.com/rodruizronald/ticos-in-tech/internal/jobs",
+					AbsPath:  "jobs.go",
+					Lineno:   42,
+					InApp:    true,
+				},
+			}, exception.Stacktrace.Frames)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package settings
+
+import (
+	"time"
+)
+
+// Data Transfer Objects (DTOs) for the settings admin API layer.
+
+// UpdateRequest represents the request body to change a setting
+type UpdateRequest struct {
+	Value string `json:"value" binding:"required,notblank,max=255" example:"debug"`
+}
+
+// ChangesRequest represents the query parameters to list the setting changes
+type ChangesRequest struct {
+	Limit int `form:"limit" binding:"omitempty,min=1,max=500" example:"50"`
+}
+
+// SettingResponse represents the current value of a setting in API responses
+type SettingResponse struct {
+	Name        string `json:"name" example:"log_level"`
+	Description string `json:"description" example:"Least severe level logged"`
+	Value       string `json:"value" example:"debug"`
+	// Default is the value of the environment, used when the setting isn't overridden
+	Default    string `json:"default" example:"info"`
+	Overridden bool   `json:"overridden" example:"true"`
+}
+
+// SettingListResponse represents the list of settings
+type SettingListResponse struct {
+	Data []*SettingResponse `json:"data"`
+}
+
+// ChangeResponse represents a setting change in API responses
+type ChangeResponse struct {
+	ID       int64  `json:"id" example:"12"`
+	Name     string `json:"name" example:"log_level"`
+	OldValue string `json:"old_value" example:"info"`
+	NewValue string `json:"new_value" example:"debug"`
+	// ChangedBy is the actor who changed the setting, e.g. "api_key:3f2a9c1b7d4e"
+	ChangedBy string    `json:"changed_by" example:"api_key:3f2a9c1b7d4e"`
+	ChangedAt time.Time `json:"changed_at" example:"2024-01-15T10:30:00Z"`
+}
+
+// ChangeListResponse represents the list of setting changes
+type ChangeListResponse struct {
+	Data []*ChangeResponse `json:"data"`
+}
+
+// MapValueToResponse converts a Value to its SettingResponse
+func MapValueToResponse(value *Value) *SettingResponse {
+	return &SettingResponse{
+		Name:        value.Name,
+		Description: value.Description,
+		Value:       value.Value,
+		Default:     value.Default,
+		Overridden:  value.Overridden,
+	}
+}
+
+// MapValuesToResponse converts values to the list API response format
+func MapValuesToResponse(values []*Value) *SettingListResponse {
+	data := make([]*SettingResponse, len(values))
+	for i, value := range values {
+		data[i] = MapValueToResponse(value)
+	}
+	return &SettingListResponse{Data: data}
+}
+
+// MapChangesToResponse converts setting changes to the list API response format
+func MapChangesToResponse(changes []*Change) *ChangeListResponse {
+	data := make([]*ChangeResponse, len(changes))
+	for i, change := range changes {
+		data[i] = &ChangeResponse{
+			ID:        change.ID,
+			Name:      change.Name,
+			OldValue:  change.OldValue,
+			NewValue:  change.NewValue,
+			ChangedBy: change.ChangedBy,
+			ChangedAt: change.ChangedAt,
+		}
+	}
+	return &ChangeListResponse{Data: data}
+}
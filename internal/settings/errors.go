@@ -0,0 +1,25 @@
+// Package settings changes the settings of the server that don't need a restart, such as the log
+// level, the rate limits or the cache TTLs, while it runs. The values changed by the admins override
+// the ones of the environment; they are stored in the settings table so every server instance
+// applies them, when the database notifies the change or on SIGHUP, and every change is audited.
+package settings
+
+import (
+	"fmt"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// NotFoundError represents a setting that doesn't exist or can't be changed while the server runs
+type NotFoundError struct {
+	Name string
+}
+
+func (e NotFoundError) Error() string {
+	return fmt.Sprintf("setting %q not found", e.Name)
+}
+
+// Is matches httpservice.ErrNotFound
+func (e NotFoundError) Is(target error) bool {
+	return target == httpservice.ErrNotFound
+}
@@ -0,0 +1,131 @@
+package settings
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// Constants for setting routes and endpoints
+const (
+	SettingsRoute = "/settings"
+	SettingRoute  = SettingsRoute + "/:name"
+	ChangesRoute  = SettingsRoute + "/changes"
+)
+
+// Handler handles HTTP requests for the settings administration
+type Handler struct {
+	service *SettingsService
+}
+
+// NewHandler creates a new settings handler
+func NewHandler(service *SettingsService) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterAdminRoutes registers the settings admin routes with the given (protected) router group
+func (h *Handler) RegisterAdminRoutes(rg *gin.RouterGroup) {
+	rg.GET(SettingsRoute, h.ListSettings)
+	rg.GET(ChangesRoute, h.ListChanges)
+	rg.PUT(SettingRoute, h.UpdateSetting)
+	rg.DELETE(SettingRoute, h.ResetSetting)
+}
+
+// ListSettings godoc
+// @Summary List settings
+// @Description Returns the settings that can be changed while the server runs, with their current value
+// @Description and the one of the environment
+// @Tags admin
+// @Produce json
+// @Security AdminAPIKey
+// @Success 200 {object} SettingListResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Router /admin/settings [get]
+func (h *Handler) ListSettings(c *gin.Context) {
+	c.JSON(http.StatusOK, MapValuesToResponse(h.service.List()))
+}
+
+// UpdateSetting godoc
+// @Summary Change a setting
+// @Description Overrides the value of a setting from the environment without a restart. Every server
+// @Description instance applies it once the database notifies the change, and the change is audited.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security AdminAPIKey
+// @Param name path string true "Setting name" example("log_level")
+// @Param request body UpdateRequest true "New value"
+// @Success 200 {object} SettingResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/settings/{name} [put]
+func (h *Handler) UpdateSetting(c *gin.Context) {
+	var req UpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	value, err := h.service.Update(c.Request.Context(), c.Param("name"), req.Value)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapValueToResponse(value))
+}
+
+// ResetSetting godoc
+// @Summary Reset a setting
+// @Description Removes the override of a setting, bringing back the value of the environment. The
+// @Description change is audited.
+// @Tags admin
+// @Produce json
+// @Security AdminAPIKey
+// @Param name path string true "Setting name" example("log_level")
+// @Success 200 {object} SettingResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/settings/{name} [delete]
+func (h *Handler) ResetSetting(c *gin.Context) {
+	value, err := h.service.Reset(c.Request.Context(), c.Param("name"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapValueToResponse(value))
+}
+
+// ListChanges godoc
+// @Summary List setting changes
+// @Description Returns who changed which setting, from and to which value, the newest changes first
+// @Tags admin
+// @Produce json
+// @Security AdminAPIKey
+// @Param limit query int false "Number of changes to return (max 500)" default(50) example(50)
+// @Success 200 {object} ChangeListResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/settings/changes [get]
+func (h *Handler) ListChanges(c *gin.Context) {
+	var req ChangesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	changes, err := h.service.Changes(c.Request.Context(), req.Limit)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapChangesToResponse(changes))
+}
@@ -0,0 +1,31 @@
+package settings
+
+import (
+	"time"
+)
+
+// ChangesChannel is the notification channel where the database announces changes to the stored
+// values of the settings. The payload is the operation, e.g. "INSERT".
+const ChangesChannel = "setting_changes"
+
+// Value is the current value of a setting
+type Value struct {
+	Name        string
+	Description string
+	Value       string
+	// Default is the value of the setting when it isn't overridden, the one of the environment
+	Default string
+	// Overridden is set when the value was changed by an admin instead of coming from the environment
+	Overridden bool
+}
+
+// Change is an audit entry of a setting changed by an admin
+type Change struct {
+	ID       int64
+	Name     string
+	OldValue string
+	NewValue string
+	// ChangedBy is the actor who changed the setting, empty when unknown, see the actor package
+	ChangedBy string
+	ChangedAt time.Time
+}
@@ -0,0 +1,136 @@
+package settings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/actor"
+)
+
+// SQL query constants
+const (
+	listValuesQuery = `
+        SELECT name, value
+        FROM settings
+    `
+
+	// Stores the value $3 of the setting $1, changed from $2 by the actor $4, and audits the change
+	setValueQuery = `
+        WITH stored AS (
+            INSERT INTO settings (name, value, updated_by)
+            VALUES ($1, $3, $4)
+            ON CONFLICT (name) DO UPDATE
+            SET value = EXCLUDED.value, updated_by = EXCLUDED.updated_by, updated_at = NOW()
+        )
+        INSERT INTO setting_changes (name, old_value, new_value, changed_by)
+        VALUES ($1, $2, $3, $4)
+        RETURNING id, changed_at
+    `
+
+	// Removes the stored value of the setting $1, changed from $2 back to its default $3 by the
+	// actor $4, and audits the change
+	deleteValueQuery = `
+        WITH removed AS (
+            DELETE FROM settings WHERE name = $1
+        )
+        INSERT INTO setting_changes (name, old_value, new_value, changed_by)
+        VALUES ($1, $2, $3, $4)
+        RETURNING id, changed_at
+    `
+
+	listChangesQuery = `
+        SELECT id, name, old_value, new_value, changed_by, changed_at
+        FROM setting_changes
+        ORDER BY changed_at DESC, id DESC
+        LIMIT $1
+    `
+)
+
+// Database interface to support pgxpool and mocks
+type Database interface {
+	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+}
+
+// Repository handles database operations for the stored setting values and their changes. Writes
+// record the actor of their context as the one who changed the setting, see actor.From.
+type Repository struct {
+	db Database
+}
+
+// NewRepository creates a new Repository instance.
+func NewRepository(db Database) *Repository {
+	return &Repository{db: db}
+}
+
+// List retrieves the stored setting values by name.
+func (r *Repository) List(ctx context.Context) (map[string]string, error) {
+	rows, err := r.db.Query(ctx, listValuesQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list settings: %w", err)
+	}
+	defer rows.Close()
+
+	values := make(map[string]string)
+	for rows.Next() {
+		var name, value string
+		if err := rows.Scan(&name, &value); err != nil {
+			return nil, fmt.Errorf("failed to scan setting: %w", err)
+		}
+		values[name] = value
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating settings: %w", err)
+	}
+
+	return values, nil
+}
+
+// Set stores the new value of a setting and audits the change, setting its ID, actor and time.
+func (r *Repository) Set(ctx context.Context, change *Change) error {
+	change.ChangedBy = actor.From(ctx).String()
+	err := r.db.QueryRow(ctx, setValueQuery, change.Name, change.OldValue, change.NewValue, change.ChangedBy).
+		Scan(&change.ID, &change.ChangedAt)
+	if err != nil {
+		return fmt.Errorf("failed to set setting: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the stored value of a setting, its new value being its default, and audits the
+// change, setting its ID, actor and time.
+func (r *Repository) Delete(ctx context.Context, change *Change) error {
+	change.ChangedBy = actor.From(ctx).String()
+	err := r.db.QueryRow(ctx, deleteValueQuery, change.Name, change.OldValue, change.NewValue, change.ChangedBy).
+		Scan(&change.ID, &change.ChangedAt)
+	if err != nil {
+		return fmt.Errorf("failed to reset setting: %w", err)
+	}
+	return nil
+}
+
+// ListChanges retrieves the latest changes of the settings, the newest first.
+func (r *Repository) ListChanges(ctx context.Context, limit int) ([]*Change, error) {
+	rows, err := r.db.Query(ctx, listChangesQuery, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list setting changes: %w", err)
+	}
+	defer rows.Close()
+
+	changes := []*Change{}
+	for rows.Next() {
+		change := &Change{}
+		if err := rows.Scan(&change.ID, &change.Name, &change.OldValue, &change.NewValue, &change.ChangedBy,
+			&change.ChangedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan setting change: %w", err)
+		}
+		changes = append(changes, change)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating setting changes: %w", err)
+	}
+
+	return changes, nil
+}
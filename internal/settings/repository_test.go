@@ -0,0 +1,168 @@
+package settings
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/actor"
+)
+
+func TestRepository_List(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, values map[string]string, err error)
+	}{
+		{
+			name: "values found",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(regexp.QuoteMeta(listValuesQuery)).
+					WillReturnRows(pgxmock.NewRows([]string{"name", "value"}).
+						AddRow("log_level", "debug").
+						AddRow("export_rate_limit", "10"))
+			},
+			checkResults: func(t *testing.T, values map[string]string, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, map[string]string{"log_level": "debug", "export_rate_limit": "10"}, values)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(regexp.QuoteMeta(listValuesQuery)).WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ map[string]string, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			values, err := repo.List(context.Background())
+			tt.checkResults(t, values, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_SetAndDelete(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	dbError := errors.New("database error")
+	ctx := actor.With(context.Background(), actor.APIKey("secret"))
+	changedBy := actor.APIKey("secret").String()
+
+	tests := []struct {
+		name         string
+		query        string
+		write        func(repo *Repository, change *Change) error
+		mockSetup    func(mock pgxmock.PgxPoolIface, query string)
+		checkResults func(t *testing.T, change *Change, err error)
+	}{
+		{
+			name:  "value set",
+			query: setValueQuery,
+			write: func(repo *Repository, change *Change) error { return repo.Set(ctx, change) },
+			mockSetup: func(mock pgxmock.PgxPoolIface, query string) {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs("log_level", "info", "debug", changedBy).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "changed_at"}).AddRow(int64(12), now))
+			},
+			checkResults: func(t *testing.T, change *Change, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, &Change{
+					ID: 12, Name: "log_level", OldValue: "info", NewValue: "debug", ChangedBy: changedBy, ChangedAt: now,
+				}, change)
+			},
+		},
+		{
+			name:  "value deleted",
+			query: deleteValueQuery,
+			write: func(repo *Repository, change *Change) error { return repo.Delete(ctx, change) },
+			mockSetup: func(mock pgxmock.PgxPoolIface, query string) {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs("log_level", "info", "debug", changedBy).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "changed_at"}).AddRow(int64(13), now))
+			},
+			checkResults: func(t *testing.T, change *Change, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, int64(13), change.ID)
+			},
+		},
+		{
+			name:  "database error",
+			query: setValueQuery,
+			write: func(repo *Repository, change *Change) error { return repo.Set(ctx, change) },
+			mockSetup: func(mock pgxmock.PgxPoolIface, query string) {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs("log_level", "info", "debug", changedBy).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ *Change, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB, tt.query)
+
+			change := &Change{Name: "log_level", OldValue: "info", NewValue: "debug"}
+			err = tt.write(repo, change)
+			tt.checkResults(t, change, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_ListChanges(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+
+	mockDB, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	mockDB.ExpectQuery(regexp.QuoteMeta(listChangesQuery)).
+		WithArgs(50).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "name", "old_value", "new_value", "changed_by", "changed_at"}).
+			AddRow(int64(12), "log_level", "info", "debug", "api_key:3f2a9c1b7d4e", now))
+
+	changes, err := NewRepository(mockDB).ListChanges(context.Background(), 50)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, "api_key:3f2a9c1b7d4e", changes[0].ChangedBy)
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
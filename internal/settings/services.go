@@ -0,0 +1,172 @@
+package settings
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// Constants for the setting changes
+const (
+	DefaultChangesLimit = 50
+	MaxChangesLimit     = 500
+)
+
+// DataRepository interface to make database operations for the stored setting values.
+type DataRepository interface {
+	List(ctx context.Context) (map[string]string, error)
+	Set(ctx context.Context, change *Change) error
+	Delete(ctx context.Context, change *Change) error
+	ListChanges(ctx context.Context, limit int) ([]*Change, error)
+}
+
+// SettingsService holds the business logic to change the settings while the server runs and
+// apply their stored values.
+type SettingsService struct {
+	repo DataRepository
+	// settings are the settings by name, names their registration order
+	settings map[string]*Setting
+	names    []string
+
+	// mu serializes the loads, so the stored values are applied in order
+	mu sync.Mutex
+	// values are the applied values by name, overridden the names of the stored ones
+	values     map[string]string
+	overridden map[string]bool
+}
+
+// NewSettingsService creates a new instance of SettingsService changing settings. Their defaults
+// are assumed to be applied already, the stored values are applied by Load.
+func NewSettingsService(repo DataRepository, settings ...Setting) *SettingsService {
+	s := &SettingsService{
+		repo:       repo,
+		settings:   make(map[string]*Setting, len(settings)),
+		values:     make(map[string]string, len(settings)),
+		overridden: make(map[string]bool, len(settings)),
+	}
+	for _, setting := range settings {
+		s.settings[setting.Name] = &setting
+		s.names = append(s.names, setting.Name)
+		s.values[setting.Name] = setting.Default
+	}
+	return s
+}
+
+// Load applies the stored values of the settings, and the defaults of the ones no longer stored.
+// Stored values that are no longer valid are skipped, the setting keeping its value, and reported
+// in the returned error.
+func (s *SettingsService) Load(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, err := s.repo.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, name := range s.names {
+		setting := s.settings[name]
+		value, overridden := stored[name]
+		if !overridden {
+			value = setting.Default
+		}
+		normalized, apply, err := setting.parse(value)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid stored value of setting %s: %w", name, err))
+			continue
+		}
+		s.overridden[name] = overridden
+		if normalized != s.values[name] {
+			apply()
+			s.values[name] = normalized
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// List returns the current values of the settings, in their registration order
+func (s *SettingsService) List() []*Value {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	values := make([]*Value, len(s.names))
+	for i, name := range s.names {
+		values[i] = s.valueLocked(name)
+	}
+	return values
+}
+
+// Update validates and stores a new value of a setting, audited, and applies it. The other server
+// instances apply it when the database notifies the change.
+func (s *SettingsService) Update(ctx context.Context, name, value string) (*Value, error) {
+	setting, ok := s.settings[name]
+	if !ok {
+		return nil, &NotFoundError{Name: name}
+	}
+	normalized, _, err := setting.parse(value)
+	if err != nil {
+		return nil, &httpservice.ValidationError{Errors: []string{err.Error()}}
+	}
+
+	change := &Change{Name: name, OldValue: s.value(name).Value, NewValue: normalized}
+	if err = s.repo.Set(ctx, change); err != nil {
+		return nil, err
+	}
+	if err = s.Load(ctx); err != nil {
+		return nil, err
+	}
+	return s.value(name), nil
+}
+
+// Reset removes the stored value of a setting, audited, bringing back its default. Settings that
+// aren't overridden are left as they are.
+func (s *SettingsService) Reset(ctx context.Context, name string) (*Value, error) {
+	setting, ok := s.settings[name]
+	if !ok {
+		return nil, &NotFoundError{Name: name}
+	}
+	current := s.value(name)
+	if !current.Overridden {
+		return current, nil
+	}
+
+	change := &Change{Name: name, OldValue: current.Value, NewValue: setting.Default}
+	if err := s.repo.Delete(ctx, change); err != nil {
+		return nil, err
+	}
+	if err := s.Load(ctx); err != nil {
+		return nil, err
+	}
+	return s.value(name), nil
+}
+
+// Changes returns the latest changes of the settings, the newest first
+func (s *SettingsService) Changes(ctx context.Context, limit int) ([]*Change, error) {
+	if limit <= 0 {
+		limit = DefaultChangesLimit
+	}
+	return s.repo.ListChanges(ctx, min(limit, MaxChangesLimit))
+}
+
+// value returns the current value of a setting
+func (s *SettingsService) value(name string) *Value {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.valueLocked(name)
+}
+
+// valueLocked returns the current value of a setting, with s.mu held
+func (s *SettingsService) valueLocked(name string) *Value {
+	setting := s.settings[name]
+	return &Value{
+		Name:        name,
+		Description: setting.Description,
+		Value:       s.values[name],
+		Default:     setting.Default,
+		Overridden:  s.overridden[name],
+	}
+}
@@ -0,0 +1,180 @@
+package settings
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// applied holds the values applied by the settings of newTestService
+type applied struct {
+	logLevel string
+	timeout  time.Duration
+}
+
+// newTestService creates a service changing a log level and a timeout, recording what it applies
+func newTestService(repo DataRepository) (*SettingsService, *applied) {
+	values := &applied{logLevel: "info", timeout: 10 * time.Second}
+	service := NewSettingsService(repo,
+		Enum("log_level", "Least severe level logged", "info", []string{"debug", "info", "warn", "error"},
+			func(level string) { values.logLevel = level }),
+		Duration("request_timeout", "Time bound of the API requests", 10*time.Second, 0,
+			func(timeout time.Duration) { values.timeout = timeout }),
+	)
+	return service, values
+}
+
+func TestSettingsService_Load(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	mockRepo := NewMockDataRepository(t)
+	mockRepo.EXPECT().List(context.Background()).
+		Return(map[string]string{"log_level": "debug", "request_timeout": "-1s"}, nil).Once()
+	mockRepo.EXPECT().List(context.Background()).Return(map[string]string{}, nil).Once()
+	mockRepo.EXPECT().List(context.Background()).Return(nil, dbError).Once()
+	service, values := newTestService(mockRepo)
+
+	err := service.Load(context.Background())
+	require.Error(t, err, "invalid stored values are reported")
+	assert.Contains(t, err.Error(), "request_timeout")
+	assert.Equal(t, "debug", values.logLevel)
+	assert.Equal(t, 10*time.Second, values.timeout, "invalid stored values are skipped")
+	assert.True(t, service.List()[0].Overridden)
+	assert.False(t, service.List()[1].Overridden)
+
+	// Settings no longer stored are back to their default
+	require.NoError(t, service.Load(context.Background()))
+	assert.Equal(t, "info", values.logLevel)
+	assert.False(t, service.List()[0].Overridden)
+
+	require.ErrorIs(t, service.Load(context.Background()), dbError)
+}
+
+func TestSettingsService_Update(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		setting      string
+		value        string
+		mockSetup    func(mockRepo *MockDataRepository)
+		checkResults func(t *testing.T, values *applied, value *Value, err error)
+	}{
+		{
+			name:    "value stored, audited and applied",
+			setting: "request_timeout",
+			value:   " 30s ",
+			mockSetup: func(mockRepo *MockDataRepository) {
+				mockRepo.EXPECT().Set(context.Background(), &Change{
+					Name: "request_timeout", OldValue: "10s", NewValue: "30s",
+				}).Return(nil).Once()
+				mockRepo.EXPECT().List(context.Background()).
+					Return(map[string]string{"request_timeout": "30s"}, nil).Once()
+			},
+			checkResults: func(t *testing.T, values *applied, value *Value, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, &Value{
+					Name:        "request_timeout",
+					Description: "Time bound of the API requests",
+					Value:       "30s",
+					Default:     "10s",
+					Overridden:  true,
+				}, value)
+				assert.Equal(t, 30*time.Second, values.timeout)
+			},
+		},
+		{
+			name:      "unknown setting",
+			setting:   "database_url",
+			value:     "postgres://localhost",
+			mockSetup: func(*MockDataRepository) {},
+			checkResults: func(t *testing.T, _ *applied, _ *Value, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, httpservice.ErrNotFound)
+			},
+		},
+		{
+			name:      "invalid value",
+			setting:   "log_level",
+			value:     "verbose",
+			mockSetup: func(*MockDataRepository) {},
+			checkResults: func(t *testing.T, values *applied, _ *Value, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Equal(t, "info", values.logLevel)
+			},
+		},
+		{
+			name:    "repository error",
+			setting: "log_level",
+			value:   "debug",
+			mockSetup: func(mockRepo *MockDataRepository) {
+				mockRepo.EXPECT().Set(context.Background(), mock.Anything).Return(dbError).Once()
+			},
+			checkResults: func(t *testing.T, values *applied, _ *Value, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+				assert.Equal(t, "info", values.logLevel)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			tt.mockSetup(mockRepo)
+			service, values := newTestService(mockRepo)
+
+			value, err := service.Update(context.Background(), tt.setting, tt.value)
+			tt.checkResults(t, values, value, err)
+		})
+	}
+}
+
+func TestSettingsService_Reset(t *testing.T) {
+	t.Parallel()
+
+	mockRepo := NewMockDataRepository(t)
+	mockRepo.EXPECT().List(context.Background()).Return(map[string]string{"log_level": "debug"}, nil).Once()
+	mockRepo.EXPECT().Delete(context.Background(), &Change{Name: "log_level", OldValue: "debug", NewValue: "info"}).
+		Return(nil).Once()
+	mockRepo.EXPECT().List(context.Background()).Return(map[string]string{}, nil).Once()
+	service, values := newTestService(mockRepo)
+	require.NoError(t, service.Load(context.Background()))
+
+	value, err := service.Reset(context.Background(), "log_level")
+	require.NoError(t, err)
+	assert.False(t, value.Overridden)
+	assert.Equal(t, "info", values.logLevel)
+
+	// Settings that aren't overridden aren't audited
+	value, err = service.Reset(context.Background(), "request_timeout")
+	require.NoError(t, err)
+	assert.Equal(t, "10s", value.Value)
+}
+
+func TestSettingsService_Changes(t *testing.T) {
+	t.Parallel()
+
+	mockRepo := NewMockDataRepository(t)
+	mockRepo.EXPECT().ListChanges(context.Background(), DefaultChangesLimit).Return([]*Change{}, nil).Once()
+	mockRepo.EXPECT().ListChanges(context.Background(), MaxChangesLimit).Return([]*Change{}, nil).Once()
+	service, _ := newTestService(mockRepo)
+
+	_, err := service.Changes(context.Background(), 0)
+	require.NoError(t, err)
+	_, err = service.Changes(context.Background(), 10000)
+	require.NoError(t, err)
+}
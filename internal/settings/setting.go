@@ -0,0 +1,69 @@
+package settings
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Setting is a setting that can be changed while the server runs, created with one of the
+// constructors below. Its values are strings, parsed like the environment variable it overrides.
+type Setting struct {
+	Name        string
+	Description string
+	// Default is the value of the setting when it isn't overridden, the one of the environment
+	Default string
+	// parse validates a value and returns it normalized, with the function applying it
+	parse func(value string) (normalized string, apply func(), err error)
+}
+
+// Enum returns a setting taking one of values, applied with apply
+func Enum(name, description, value string, values []string, apply func(string)) Setting {
+	return Setting{Name: name, Description: description, Default: value,
+		parse: func(value string) (string, func(), error) {
+			value = strings.ToLower(strings.TrimSpace(value))
+			if !slices.Contains(values, value) {
+				return "", nil, fmt.Errorf("%s must be one of %s", name, strings.Join(values, ", "))
+			}
+			return value, func() { apply(value) }, nil
+		}}
+}
+
+// Int returns an integer setting of at least minimum, applied with apply
+func Int(name, description string, value, minimum int, apply func(int)) Setting {
+	return Setting{Name: name, Description: description, Default: strconv.Itoa(value),
+		parse: func(value string) (string, func(), error) {
+			parsed, err := strconv.Atoi(strings.TrimSpace(value))
+			if err != nil || parsed < minimum {
+				return "", nil, fmt.Errorf("%s must be an integer of at least %d", name, minimum)
+			}
+			return strconv.Itoa(parsed), func() { apply(parsed) }, nil
+		}}
+}
+
+// Float returns a number setting of at least minimum, applied with apply
+func Float(name, description string, value, minimum float64, apply func(float64)) Setting {
+	format := func(f float64) string { return strconv.FormatFloat(f, 'g', -1, 64) }
+	return Setting{Name: name, Description: description, Default: format(value),
+		parse: func(value string) (string, func(), error) {
+			parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil || parsed < minimum {
+				return "", nil, fmt.Errorf("%s must be a number of at least %s", name, format(minimum))
+			}
+			return format(parsed), func() { apply(parsed) }, nil
+		}}
+}
+
+// Duration returns a duration setting (e.g. "10s") of at least minimum, applied with apply
+func Duration(name, description string, value, minimum time.Duration, apply func(time.Duration)) Setting {
+	return Setting{Name: name, Description: description, Default: value.String(),
+		parse: func(value string) (string, func(), error) {
+			parsed, err := time.ParseDuration(strings.TrimSpace(value))
+			if err != nil || parsed < minimum {
+				return "", nil, fmt.Errorf("%s must be a duration of at least %s", name, minimum)
+			}
+			return parsed.String(), func() { apply(parsed) }, nil
+		}}
+}
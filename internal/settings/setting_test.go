@@ -0,0 +1,57 @@
+package settings
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetting_parse(t *testing.T) {
+	t.Parallel()
+	var (
+		level   string
+		limit   int
+		weight  float64
+		timeout time.Duration
+	)
+	enum := Enum("log_level", "", "info", []string{"debug", "info"}, func(v string) { level = v })
+	integer := Int("export_rate_limit", "", 5, 0, func(v int) { limit = v })
+	float := Float("search_rank_text_weight", "", 1, 0, func(v float64) { weight = v })
+	duration := Duration("request_timeout", "", 10*time.Second, 0, func(v time.Duration) { timeout = v })
+
+	tests := []struct {
+		name       string
+		setting    Setting
+		value      string
+		normalized string
+		wantErr    bool
+	}{
+		{name: "enum", setting: enum, value: " DEBUG ", normalized: "debug"},
+		{name: "enum out of values", setting: enum, value: "trace", wantErr: true},
+		{name: "int", setting: integer, value: "10", normalized: "10"},
+		{name: "int below minimum", setting: integer, value: "-1", wantErr: true},
+		{name: "float", setting: float, value: "0.50", normalized: "0.5"},
+		{name: "float not a number", setting: float, value: "high", wantErr: true},
+		{name: "duration", setting: duration, value: "90s", normalized: "1m30s"},
+		{name: "duration below minimum", setting: duration, value: "-5s", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		normalized, apply, err := tt.setting.parse(tt.value)
+		if tt.wantErr {
+			require.Error(t, err, tt.name)
+			continue
+		}
+		require.NoError(t, err, tt.name)
+		assert.Equal(t, tt.normalized, normalized, tt.name)
+		apply()
+	}
+
+	assert.Equal(t, "debug", level)
+	assert.Equal(t, 10, limit)
+	assert.InDelta(t, 0.5, weight, 0.001)
+	assert.Equal(t, 90*time.Second, timeout)
+	assert.Equal(t, "10s", duration.Default)
+}
@@ -0,0 +1,138 @@
+// Package sqlbuilder builds the parts of SQL queries chosen at runtime: the filters, ordering and
+// pagination of a search and the columns set by a patch. The placeholders of their arguments are
+// numbered as the parts are added, so they can't drift from the arguments as filters grow.
+package sqlbuilder
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Placeholder stands for the next argument in the conditions of a Query, numbered when added.
+// "??" stands for a literal question mark, e.g. the jsonb ? operator.
+const Placeholder = "?"
+
+// Args holds the arguments of a query
+type Args struct {
+	values []any
+}
+
+// Arg adds value to the arguments and returns its placeholder, e.g. "$3"
+func (a *Args) Arg(value any) string {
+	a.values = append(a.values, value)
+	return "$" + strconv.Itoa(len(a.values))
+}
+
+// Values returns the arguments, in the order of their placeholders
+func (a *Args) Values() []any {
+	return a.values
+}
+
+// Query builds a query from a base query followed by the conditions, ordering and pagination
+// added to it
+type Query struct {
+	Args
+	base string
+	// filtered is whether the base query ends with a WHERE clause the conditions are joined to
+	filtered   bool
+	conditions []string
+	orderBy    []string
+	limit      string
+	offset     string
+}
+
+// New creates a Query from base, whose placeholders $1 to $n are args. The conditions added to
+// it start its WHERE clause.
+func New(base string, args ...any) *Query {
+	return &Query{Args: Args{values: args}, base: base}
+}
+
+// NewFiltered creates a Query from base, a query ending with a WHERE clause, whose placeholders
+// $1 to $n are args. The conditions added to it are joined to its WHERE clause.
+func NewFiltered(base string, args ...any) *Query {
+	return &Query{Args: Args{values: args}, base: base, filtered: true}
+}
+
+// Where adds condition, joined with AND to the others. Every Placeholder in condition stands for
+// the next of args; it panics when their numbers differ, as the query is wrong.
+func (q *Query) Where(condition string, args ...any) *Query {
+	parts := strings.Split(strings.ReplaceAll(condition, "??", "\x00"), Placeholder)
+	if len(parts)-1 != len(args) {
+		panic(fmt.Sprintf("sqlbuilder: condition %q has %d placeholders for %d arguments",
+			condition, len(parts)-1, len(args)))
+	}
+
+	var b strings.Builder
+	for i, part := range parts {
+		if i > 0 {
+			b.WriteString(q.Arg(args[i-1]))
+		}
+		b.WriteString(strings.ReplaceAll(part, "\x00", Placeholder))
+	}
+	q.conditions = append(q.conditions, b.String())
+	return q
+}
+
+// OrderBy sets the terms the rows are ordered by, e.g. "j.created_at DESC"
+func (q *Query) OrderBy(terms ...string) *Query {
+	q.orderBy = terms
+	return q
+}
+
+// Limit sets the maximum number of rows returned
+func (q *Query) Limit(limit int) *Query {
+	q.limit = q.Arg(limit)
+	return q
+}
+
+// Offset sets the number of rows skipped
+func (q *Query) Offset(offset int) *Query {
+	q.offset = q.Arg(offset)
+	return q
+}
+
+// SQL returns the query and its arguments
+func (q *Query) SQL() (query string, args []any) {
+	var b strings.Builder
+	b.WriteString(q.base)
+	if len(q.conditions) > 0 {
+		if q.filtered {
+			b.WriteString(" AND ")
+		} else {
+			b.WriteString(" WHERE ")
+		}
+		b.WriteString(strings.Join(q.conditions, " AND "))
+	}
+	if len(q.orderBy) > 0 {
+		b.WriteString(" ORDER BY " + strings.Join(q.orderBy, ", "))
+	}
+	if q.limit != "" {
+		b.WriteString(" LIMIT " + q.limit)
+	}
+	if q.offset != "" {
+		b.WriteString(" OFFSET " + q.offset)
+	}
+	return b.String(), q.values
+}
+
+// Set builds the SET clause of an update from the columns set at runtime
+type Set struct {
+	Args
+	assignments []string
+}
+
+// Add sets column to value
+func (s *Set) Add(column string, value any) {
+	s.assignments = append(s.assignments, column+" = "+s.Arg(value))
+}
+
+// Empty returns whether no column is set
+func (s *Set) Empty() bool {
+	return len(s.assignments) == 0
+}
+
+// SQL returns the assignments of the clause, e.g. "title = $1, language = $2"
+func (s *Set) SQL() string {
+	return strings.Join(s.assignments, ", ")
+}
@@ -0,0 +1,98 @@
+package sqlbuilder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuery_SQL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		query func() *Query
+		sql   string
+		args  []any
+	}{
+		{
+			name:  "base query only",
+			query: func() *Query { return New("SELECT id FROM jobs", 1) },
+			sql:   "SELECT id FROM jobs",
+			args:  []any{1},
+		},
+		{
+			name: "conditions start the WHERE clause",
+			query: func() *Query {
+				return New("SELECT id FROM jobs").
+					Where("work_mode = ?", "Remote").
+					Where("utc_offset_min <= ? AND utc_offset_max >= ?", -6, -6)
+			},
+			sql:  "SELECT id FROM jobs WHERE work_mode = $1 AND utc_offset_min <= $2 AND utc_offset_max >= $3",
+			args: []any{"Remote", -6, -6},
+		},
+		{
+			name: "conditions joined to the WHERE clause, numbered after the base arguments",
+			query: func() *Query {
+				return NewFiltered("SELECT id FROM jobs WHERE title = $1", "Go Developer").
+					Where("is_active").
+					Where("language = ?", "es")
+			},
+			sql:  "SELECT id FROM jobs WHERE title = $1 AND is_active AND language = $2",
+			args: []any{"Go Developer", "es"},
+		},
+		{
+			name: "literal question mark",
+			query: func() *Query {
+				return New("SELECT id FROM jobs").Where("metadata ?? ?", "salary")
+			},
+			sql:  "SELECT id FROM jobs WHERE metadata ? $1",
+			args: []any{"salary"},
+		},
+		{
+			name: "ordering and pagination",
+			query: func() *Query {
+				return New("SELECT id FROM jobs").
+					Where("company_id = ?", 3).
+					OrderBy("created_at DESC", "id").
+					Limit(20).
+					Offset(40)
+			},
+			sql:  "SELECT id FROM jobs WHERE company_id = $1 ORDER BY created_at DESC, id LIMIT $2 OFFSET $3",
+			args: []any{3, 20, 40},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			sql, args := tt.query().SQL()
+			assert.Equal(t, tt.sql, sql)
+			assert.Equal(t, tt.args, args)
+		})
+	}
+}
+
+func TestQuery_WherePlaceholderMismatch(t *testing.T) {
+	t.Parallel()
+
+	assert.PanicsWithValue(t, `sqlbuilder: condition "title = ?" has 1 placeholders for 2 arguments`, func() {
+		New("SELECT id FROM jobs").Where("title = ?", "Go", "Rust")
+	})
+}
+
+func TestSet(t *testing.T) {
+	t.Parallel()
+
+	var set Set
+	assert.True(t, set.Empty())
+
+	set.Add("title", "Senior Go Developer")
+	set.Add("language", "en")
+	id := set.Arg(42)
+
+	assert.False(t, set.Empty())
+	assert.Equal(t, "title = $1, language = $2", set.SQL())
+	assert.Equal(t, "$3", id)
+	assert.Equal(t, []any{"Senior Go Developer", "en", 42}, set.Values())
+}
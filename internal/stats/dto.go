@@ -0,0 +1,205 @@
+package stats
+
+import (
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// Data Transfer Objects (DTOs) for the statistics API layer.
+
+// TechnologyStatsRequest represents the query parameters of the technology statistics.
+// Both dates are inclusive.
+type TechnologyStatsRequest struct {
+	DateFrom     string `form:"date_from" binding:"date,date_lte=DateTo" example:"2024-01-01"`
+	DateTo       string `form:"date_to" binding:"date" example:"2024-01-31"`
+	RequiredOnly bool   `form:"required_only" example:"false"`
+	Limit        int    `form:"limit" example:"20"`
+}
+
+// ToTechnologyStatsParams converts a TechnologyStatsRequest to TechnologyStatsParams
+func (req *TechnologyStatsRequest) ToTechnologyStatsParams() (TechnologyStatsParams, error) {
+	params := TechnologyStatsParams{RequiredOnly: req.RequiredOnly, Limit: req.Limit}
+
+	if req.DateFrom != "" {
+		dateFrom, err := time.Parse(httpservice.DateLayout, req.DateFrom)
+		if err != nil {
+			return params, &httpservice.ConversionError{Field: "date_from", Value: req.DateFrom, Err: err}
+		}
+		params.From = dateFrom
+	}
+
+	if req.DateTo != "" {
+		dateTo, err := time.Parse(httpservice.DateLayout, req.DateTo)
+		if err != nil {
+			return params, &httpservice.ConversionError{Field: "date_to", Value: req.DateTo, Err: err}
+		}
+		params.To = dateTo.Add(day) // Include the whole last day
+	}
+
+	return params, nil
+}
+
+// TechnologyCountResponse represents the API response for the job count of a technology
+type TechnologyCountResponse struct {
+	Name     string `json:"name" example:"go"`
+	Category string `json:"category" example:"programming"`
+	Jobs     int    `json:"jobs" example:"42"`
+	ThisWeek int    `json:"this_week" example:"12"`
+	LastWeek int    `json:"last_week" example:"8"`
+	// WeekOverWeekDelta is this_week - last_week
+	WeekOverWeekDelta int `json:"week_over_week_delta" example:"4"`
+	// WeekOverWeekChange is the percentage change from last week, omitted when last_week is 0
+	WeekOverWeekChange *float64 `json:"week_over_week_change,omitempty" example:"50"`
+}
+
+// TechnologyStatsResponse represents the API response for the technology statistics
+type TechnologyStatsResponse struct {
+	DateFrom     string                     `json:"date_from" example:"2024-01-01"`
+	DateTo       string                     `json:"date_to" example:"2024-01-31"`
+	RequiredOnly bool                       `json:"required_only" example:"false"`
+	Data         []*TechnologyCountResponse `json:"data"`
+}
+
+// MapTechnologyStatsToResponse converts technology statistics to their API response format
+func MapTechnologyStatsToResponse(stats *TechnologyStats) *TechnologyStatsResponse {
+	data := make([]*TechnologyCountResponse, 0, len(stats.Technologies))
+	for _, count := range stats.Technologies {
+		data = append(data, &TechnologyCountResponse{
+			Name:               count.Name,
+			Category:           count.Category,
+			Jobs:               count.JobCount,
+			ThisWeek:           count.ThisWeek,
+			LastWeek:           count.LastWeek,
+			WeekOverWeekDelta:  count.WeekOverWeekDelta(),
+			WeekOverWeekChange: count.WeekOverWeekChange(),
+		})
+	}
+
+	return &TechnologyStatsResponse{
+		DateFrom:     stats.From.Format(httpservice.DateLayout),
+		DateTo:       stats.To.Add(-day).Format(httpservice.DateLayout),
+		RequiredOnly: stats.RequiredOnly,
+		Data:         data,
+	}
+}
+
+// HistoryRequest represents the query parameters of the history of a metric. Both dates are inclusive.
+type HistoryRequest struct {
+	Metric string `form:"metric" binding:"required,oneof=active_jobs technology_jobs company_jobs" example:"technology_jobs"`
+	// Key is the technology name or company slug of the keyed metrics
+	Key      string `form:"key" binding:"max=255" example:"go"`
+	DateFrom string `form:"date_from" binding:"date,date_lte=DateTo" example:"2024-01-01"`
+	DateTo   string `form:"date_to" binding:"date" example:"2024-03-31"`
+}
+
+// ToHistoryParams converts a HistoryRequest to HistoryParams
+func (req *HistoryRequest) ToHistoryParams() (HistoryParams, error) {
+	params := HistoryParams{Metric: Metric(req.Metric), Key: req.Key}
+
+	if req.DateFrom != "" {
+		dateFrom, err := time.Parse(httpservice.DateLayout, req.DateFrom)
+		if err != nil {
+			return params, &httpservice.ConversionError{Field: "date_from", Value: req.DateFrom, Err: err}
+		}
+		params.From = dateFrom
+	}
+
+	if req.DateTo != "" {
+		dateTo, err := time.Parse(httpservice.DateLayout, req.DateTo)
+		if err != nil {
+			return params, &httpservice.ConversionError{Field: "date_to", Value: req.DateTo, Err: err}
+		}
+		params.To = dateTo.Add(day) // Include the whole last day
+	}
+
+	return params, nil
+}
+
+// HistoryPointResponse represents the API response for the value of a metric on a day
+type HistoryPointResponse struct {
+	Date  string `json:"date" example:"2024-01-15"`
+	Value int    `json:"value" example:"42"`
+}
+
+// HistoryResponse represents the API response for the history of a metric
+type HistoryResponse struct {
+	Metric   string `json:"metric" example:"technology_jobs"`
+	Key      string `json:"key,omitempty" example:"go"`
+	DateFrom string `json:"date_from" example:"2024-01-01"`
+	DateTo   string `json:"date_to" example:"2024-03-31"`
+	// Data holds the days with a snapshot, oldest first
+	Data []*HistoryPointResponse `json:"data"`
+}
+
+// MapHistoryToResponse converts the history of a metric to its API response format
+func MapHistoryToResponse(history *History) *HistoryResponse {
+	data := make([]*HistoryPointResponse, 0, len(history.Points))
+	for _, point := range history.Points {
+		data = append(data, &HistoryPointResponse{
+			Date:  point.Day.Format(httpservice.DateLayout),
+			Value: point.Value,
+		})
+	}
+
+	return &HistoryResponse{
+		Metric:   string(history.Metric),
+		Key:      history.Key,
+		DateFrom: history.From.Format(httpservice.DateLayout),
+		DateTo:   history.To.Add(-day).Format(httpservice.DateLayout),
+		Data:     data,
+	}
+}
+
+// BucketResponse represents the API response for the number of jobs having a given attribute value
+type BucketResponse struct {
+	Value string `json:"value" example:"Remote"`
+	Jobs  int    `json:"jobs" example:"120"`
+}
+
+// CompanyCountResponse represents the API response for the number of jobs of a company
+type CompanyCountResponse struct {
+	Slug string `json:"slug" example:"tech-corp"`
+	Name string `json:"name" example:"Tech Corp"`
+	Jobs int    `json:"jobs" example:"35"`
+}
+
+// OverviewResponse represents the API response for the dashboard overview
+type OverviewResponse struct {
+	ActiveJobs        int                     `json:"active_jobs" example:"850"`
+	NewJobsThisWeek   int                     `json:"new_jobs_this_week" example:"64"`
+	ByWorkMode        []*BucketResponse       `json:"by_work_mode"`
+	ByExperienceLevel []*BucketResponse       `json:"by_experience_level"`
+	TopCompanies      []*CompanyCountResponse `json:"top_companies"`
+	GeneratedAt       time.Time               `json:"generated_at"`
+}
+
+// MapOverviewToResponse converts the dashboard overview to its API response format
+func MapOverviewToResponse(overview *Overview) *OverviewResponse {
+	topCompanies := make([]*CompanyCountResponse, 0, len(overview.TopCompanies))
+	for _, company := range overview.TopCompanies {
+		topCompanies = append(topCompanies, &CompanyCountResponse{
+			Slug: company.Slug,
+			Name: company.Name,
+			Jobs: company.ActiveJobs,
+		})
+	}
+
+	return &OverviewResponse{
+		ActiveJobs:        overview.ActiveJobs,
+		NewJobsThisWeek:   overview.NewJobs,
+		ByWorkMode:        mapBucketsToResponse(overview.ByWorkMode),
+		ByExperienceLevel: mapBucketsToResponse(overview.ByExperienceLevel),
+		TopCompanies:      topCompanies,
+		GeneratedAt:       overview.GeneratedAt,
+	}
+}
+
+// mapBucketsToResponse converts job counts per attribute value to their API response format
+func mapBucketsToResponse(buckets []*Bucket) []*BucketResponse {
+	data := make([]*BucketResponse, 0, len(buckets))
+	for _, bucket := range buckets {
+		data = append(data, &BucketResponse{Value: bucket.Value, Jobs: bucket.Count})
+	}
+	return data
+}
@@ -0,0 +1,128 @@
+package stats
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// Constants for statistics routes and endpoints
+const (
+	StatsRoute           = "/stats"
+	TechnologyStatsRoute = StatsRoute + "/technologies"
+	OverviewRoute        = StatsRoute + "/overview"
+	HistoryRoute         = StatsRoute + "/history"
+)
+
+// Handler handles HTTP requests for the statistics
+type Handler struct {
+	service *StatsService
+}
+
+// NewHandler creates a new statistics handler
+func NewHandler(service *StatsService) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers statistics routes with the given router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.GET(TechnologyStatsRoute, h.GetTechnologyStats)
+	rg.GET(OverviewRoute, h.GetOverview)
+	rg.GET(HistoryRoute, h.GetHistory)
+}
+
+// GetTechnologyStats godoc
+// @Summary Get trending technologies
+// @Description Returns the number of jobs posted per technology for a date range, the last 30 days
+// @Description by default, with the counts of the last two weeks of the range to show the trend
+// @Tags stats
+// @Produce json
+// @Param date_from query string false "Start date (YYYY-MM-DD)" example("2024-01-01")
+// @Param date_to query string false "End date, inclusive (YYYY-MM-DD)" example("2024-01-31")
+// @Param required_only query bool false "Only count jobs where the technology is required" default(false)
+// @Param limit query int false "Number of technologies to return (max 100)" default(20) example(20)
+// @Success 200 {object} TechnologyStatsResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /stats/technologies [get]
+func (h *Handler) GetTechnologyStats(c *gin.Context) {
+	var req TechnologyStatsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	params, err := req.ToTechnologyStatsParams()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	params.Country = httpservice.CountryOf(c)
+
+	stats, err := h.service.TechnologyCounts(c.Request.Context(), params)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapTechnologyStatsToResponse(stats))
+}
+
+// GetOverview godoc
+// @Summary Get the market overview
+// @Description Returns the number of active jobs, the jobs posted this week, the active jobs by work mode
+// @Description and experience level and the top hiring companies. Results are cached for a minute.
+// @Tags stats
+// @Produce json
+// @Success 200 {object} OverviewResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /stats/overview [get]
+func (h *Handler) GetOverview(c *gin.Context) {
+	overview, err := h.service.Overview(c.Request.Context(), httpservice.CountryOf(c))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapOverviewToResponse(overview))
+}
+
+// GetHistory godoc
+// @Summary Get the history of a metric
+// @Description Returns the daily values of a metric snapshotted once a day, the last 90 days by default,
+// @Description to draw trend charts. technology_jobs needs the technology name as key and company_jobs the
+// @Description company slug. Days without a snapshot are missing.
+// @Tags stats
+// @Produce json
+// @Param metric query string true "Metric" Enums(active_jobs, technology_jobs, company_jobs)
+// @Param key query string false "Technology name or company slug of the keyed metrics" example("go")
+// @Param date_from query string false "Start date (YYYY-MM-DD)" example("2024-01-01")
+// @Param date_to query string false "End date, inclusive (YYYY-MM-DD)" example("2024-03-31")
+// @Success 200 {object} HistoryResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /stats/history [get]
+func (h *Handler) GetHistory(c *gin.Context) {
+	var req HistoryRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	params, err := req.ToHistoryParams()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	params.Country = httpservice.CountryOf(c)
+
+	history, err := h.service.History(c.Request.Context(), params)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapHistoryToResponse(history))
+}
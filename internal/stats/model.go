@@ -0,0 +1,147 @@
+// Package stats provides aggregated market statistics over the job postings,
+// such as how many jobs ask for each technology and how that changes week over week.
+package stats
+
+import (
+	"time"
+)
+
+// TechnologyStatsParams defines the parameters of the technology statistics (repository layer).
+// From is inclusive and To is exclusive. The week-over-week counts cover the 7 days before To
+// and the 7 days before those.
+type TechnologyStatsParams struct {
+	From         time.Time
+	To           time.Time
+	RequiredOnly bool
+	Limit        int
+	// Country counts the jobs of the country, of all of them when empty
+	Country string
+}
+
+// WeekStart returns the start of the last week of the range
+func (p *TechnologyStatsParams) WeekStart() time.Time {
+	return p.To.Add(-week)
+}
+
+// PreviousWeekStart returns the start of the week before the last week of the range
+func (p *TechnologyStatsParams) PreviousWeekStart() time.Time {
+	return p.To.Add(-2 * week)
+}
+
+// TechnologyCount holds the number of jobs asking for a technology
+type TechnologyCount struct {
+	TechnologyID int    `db:"id"`
+	Name         string `db:"name"`
+	Category     string `db:"category"`
+	// JobCount is the number of jobs posted in the range
+	JobCount int `db:"job_count"`
+	// ThisWeek and LastWeek are the number of jobs posted in the last two weeks of the range
+	ThisWeek int `db:"this_week"`
+	LastWeek int `db:"last_week"`
+}
+
+// WeekOverWeekDelta returns the difference between this week's and last week's job counts
+func (c *TechnologyCount) WeekOverWeekDelta() int {
+	return c.ThisWeek - c.LastWeek
+}
+
+// WeekOverWeekChange returns the relative change between last week's and this week's job
+// counts as a percentage. It returns nil when there were no jobs last week.
+func (c *TechnologyCount) WeekOverWeekChange() *float64 {
+	if c.LastWeek == 0 {
+		return nil
+	}
+	change := float64(c.WeekOverWeekDelta()) / float64(c.LastWeek) * 100
+	return &change
+}
+
+// TechnologyStats holds the technology counts of a date range
+type TechnologyStats struct {
+	From         time.Time
+	To           time.Time
+	RequiredOnly bool
+	Technologies []*TechnologyCount
+}
+
+// JobTotals holds the overall job counts
+type JobTotals struct {
+	ActiveJobs int `db:"active_jobs"`
+	// NewJobs is the number of active jobs posted since the requested time
+	NewJobs int `db:"new_jobs"`
+}
+
+// Bucket holds the number of active jobs having a given value of an attribute
+type Bucket struct {
+	Value string `db:"value"`
+	Count int    `db:"count"`
+}
+
+// CompanyCount holds the number of active jobs of a company
+type CompanyCount struct {
+	Slug       string `db:"slug"`
+	Name       string `db:"name"`
+	ActiveJobs int    `db:"active_jobs"`
+}
+
+// Overview holds the market statistics shown on the public dashboard
+type Overview struct {
+	JobTotals
+	ByWorkMode        []*Bucket
+	ByExperienceLevel []*Bucket
+	TopCompanies      []*CompanyCount
+	GeneratedAt       time.Time
+}
+
+// Metric is an aggregate snapshotted once a day in the statistics history
+type Metric string
+
+// Metrics of the statistics history
+const (
+	// MetricActiveJobs is the number of active jobs of the country
+	MetricActiveJobs Metric = "active_jobs"
+	// MetricTechnologyJobs is the number of active jobs asking for a technology, keyed by its lowercase name
+	MetricTechnologyJobs Metric = "technology_jobs"
+	// MetricCompanyJobs is the number of active jobs of a company, keyed by its slug
+	MetricCompanyJobs Metric = "company_jobs"
+)
+
+// IsValid reports whether m is a known metric
+func (m Metric) IsValid() bool {
+	switch m {
+	case MetricActiveJobs, MetricTechnologyJobs, MetricCompanyJobs:
+		return true
+	}
+	return false
+}
+
+// Keyed reports whether the metric is snapshotted per technology or company, so read with a key
+func (m Metric) Keyed() bool {
+	return m == MetricTechnologyJobs || m == MetricCompanyJobs
+}
+
+// HistoryParams defines the parameters of the history of a metric (repository layer). From is
+// inclusive and To is exclusive, both dates at midnight UTC.
+type HistoryParams struct {
+	Metric Metric
+	// Key is the technology or company of the keyed metrics, empty for the others
+	Key     string
+	Country string
+	From    time.Time
+	To      time.Time
+}
+
+// HistoryPoint holds the value of a metric snapshotted on a day
+type HistoryPoint struct {
+	Day   time.Time `db:"day"`
+	Value int       `db:"value"`
+}
+
+// History holds the daily values of a metric in a date range, oldest first. Days without a
+// snapshot are missing.
+type History struct {
+	Metric Metric
+	Key    string
+	From   time.Time
+	To     time.Time
+	Points []*HistoryPoint
+}
@@ -0,0 +1,261 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// SQL query constants
+const (
+	// Counts the jobs per technology posted in the range ($1, $2) and in the last two weeks
+	// of it ($3 and $4 are the starts of the last and the previous week), in the country $7
+	// or in all of them when it is empty
+	getTechnologyCountsQuery = `
+        SELECT t.id, t.name, t.category,
+               COUNT(DISTINCT j.id) FILTER (WHERE j.created_at >= $1) AS job_count,
+               COUNT(DISTINCT j.id) FILTER (WHERE j.created_at >= $3) AS this_week,
+               COUNT(DISTINCT j.id) FILTER (WHERE j.created_at >= $4 AND j.created_at < $3) AS last_week
+        FROM job_technologies jt
+        JOIN technologies t ON jt.technology_id = t.id
+        JOIN jobs j ON jt.job_id = j.id
+        WHERE j.created_at >= LEAST($1, $4) AND j.created_at < $2
+          AND (NOT $5::boolean OR jt.is_required)
+          AND ($7 = '' OR j.country = $7)
+        GROUP BY t.id, t.name, t.category
+        HAVING COUNT(DISTINCT j.id) FILTER (WHERE j.created_at >= $1) > 0
+        ORDER BY job_count DESC, t.name
+        LIMIT $6
+    `
+
+	// The job counts are of the country $1, or of all of them when it is empty
+	getJobTotalsQuery = `
+        SELECT COUNT(*) AS active_jobs,
+               COUNT(*) FILTER (WHERE created_at >= $2) AS new_jobs
+        FROM jobs
+        WHERE is_active = true AND ($1 = '' OR country = $1)
+    `
+
+	countJobsByWorkModeQuery = `
+        SELECT work_mode, COUNT(*)
+        FROM jobs
+        WHERE is_active = true AND ($1 = '' OR country = $1)
+        GROUP BY work_mode
+        ORDER BY COUNT(*) DESC, work_mode
+    `
+
+	countJobsByExperienceLevelQuery = `
+        SELECT experience_level, COUNT(*)
+        FROM jobs
+        WHERE is_active = true AND ($1 = '' OR country = $1)
+        GROUP BY experience_level
+        ORDER BY COUNT(*) DESC, experience_level
+    `
+
+	getTopHiringCompaniesQuery = `
+        SELECT c.slug, c.name, COUNT(*) AS active_jobs
+        FROM jobs j
+        JOIN companies c ON j.company_id = c.id
+        WHERE j.is_active = true AND ($1 = '' OR j.country = $1)
+        GROUP BY c.id, c.slug, c.name
+        ORDER BY active_jobs DESC, c.name
+        LIMIT $2
+    `
+
+	// Snapshots the metrics of the active jobs of the country $2, all countries when empty, on the
+	// day $1, the metric names being $3 to $5. Taking the snapshot of a day again replaces its values.
+	snapshotDailyQuery = `
+        INSERT INTO stats_daily (day, country, metric, key, value)
+        SELECT $1::DATE, $2, $3, '', COUNT(*)
+        FROM jobs
+        WHERE is_active = true AND ($2 = '' OR country = $2)
+        UNION ALL
+        SELECT $1::DATE, $2, $4, lower(t.name), COUNT(DISTINCT j.id)
+        FROM job_technologies jt
+        JOIN technologies t ON jt.technology_id = t.id
+        JOIN jobs j ON jt.job_id = j.id
+        WHERE j.is_active = true AND ($2 = '' OR j.country = $2)
+        GROUP BY lower(t.name)
+        UNION ALL
+        SELECT $1::DATE, $2, $5, c.slug, COUNT(*)
+        FROM jobs j
+        JOIN companies c ON j.company_id = c.id
+        WHERE j.is_active = true AND ($2 = '' OR j.country = $2)
+        GROUP BY c.slug
+        ON CONFLICT (country, metric, key, day) DO UPDATE SET value = EXCLUDED.value
+    `
+
+	getHistoryQuery = `
+        SELECT day, value
+        FROM stats_daily
+        WHERE country = $1 AND metric = $2 AND key = $3 AND day >= $4 AND day < $5
+        ORDER BY day
+    `
+)
+
+// Database interface to support pgxpool and mocks
+type Database interface {
+	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
+	Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+}
+
+// Repository handles the statistics database queries.
+type Repository struct {
+	db Database
+}
+
+// NewRepository creates a new Repository instance.
+func NewRepository(db Database) *Repository {
+	return &Repository{db: db}
+}
+
+// GetTechnologyCounts counts the jobs asking for each technology, most requested first.
+func (r *Repository) GetTechnologyCounts(ctx context.Context, params *TechnologyStatsParams) ([]*TechnologyCount, error) {
+	rows, err := r.db.Query(ctx, getTechnologyCountsQuery,
+		params.From,
+		params.To,
+		params.WeekStart(),
+		params.PreviousWeekStart(),
+		params.RequiredOnly,
+		params.Limit,
+		params.Country,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get technology counts: %w", err)
+	}
+	defer rows.Close()
+
+	var counts []*TechnologyCount
+	for rows.Next() {
+		count := &TechnologyCount{}
+		err = rows.Scan(
+			&count.TechnologyID,
+			&count.Name,
+			&count.Category,
+			&count.JobCount,
+			&count.ThisWeek,
+			&count.LastWeek,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan technology count row: %w", err)
+		}
+		counts = append(counts, count)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating technology count rows: %w", err)
+	}
+
+	return counts, nil
+}
+
+// GetJobTotals counts the active jobs of a country and the ones posted since the given time.
+// All countries are counted when country is empty.
+func (r *Repository) GetJobTotals(ctx context.Context, country string, since time.Time) (*JobTotals, error) {
+	totals := &JobTotals{}
+	err := r.db.QueryRow(ctx, getJobTotalsQuery, country, since).Scan(&totals.ActiveJobs, &totals.NewJobs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job totals: %w", err)
+	}
+
+	return totals, nil
+}
+
+// CountJobsByWorkMode counts the active jobs of a country per work mode, of all countries when it is empty.
+func (r *Repository) CountJobsByWorkMode(ctx context.Context, country string) ([]*Bucket, error) {
+	return r.queryBuckets(ctx, countJobsByWorkModeQuery, country)
+}
+
+// CountJobsByExperienceLevel counts the active jobs of a country per experience level, of all
+// countries when it is empty.
+func (r *Repository) CountJobsByExperienceLevel(ctx context.Context, country string) ([]*Bucket, error) {
+	return r.queryBuckets(ctx, countJobsByExperienceLevelQuery, country)
+}
+
+// queryBuckets runs a query of the jobs of a country returning value and count rows
+func (r *Repository) queryBuckets(ctx context.Context, query, country string) ([]*Bucket, error) {
+	rows, err := r.db.Query(ctx, query, country)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count jobs: %w", err)
+	}
+	defer rows.Close()
+
+	var buckets []*Bucket
+	for rows.Next() {
+		bucket := &Bucket{}
+		if err = rows.Scan(&bucket.Value, &bucket.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan job count row: %w", err)
+		}
+		buckets = append(buckets, bucket)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating job count rows: %w", err)
+	}
+
+	return buckets, nil
+}
+
+// GetTopHiringCompanies retrieves the companies with the most active jobs in a country, in all
+// countries when it is empty.
+func (r *Repository) GetTopHiringCompanies(ctx context.Context, country string, limit int) ([]*CompanyCount, error) {
+	rows, err := r.db.Query(ctx, getTopHiringCompaniesQuery, country, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top hiring companies: %w", err)
+	}
+	defer rows.Close()
+
+	var companies []*CompanyCount
+	for rows.Next() {
+		company := &CompanyCount{}
+		if err = rows.Scan(&company.Slug, &company.Name, &company.ActiveJobs); err != nil {
+			return nil, fmt.Errorf("failed to scan company count row: %w", err)
+		}
+		companies = append(companies, company)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating company count rows: %w", err)
+	}
+
+	return companies, nil
+}
+
+// SnapshotDaily stores the metrics of the active jobs of a country on the given day, replacing
+// the ones stored for it. It returns the number of values stored.
+func (r *Repository) SnapshotDaily(ctx context.Context, day time.Time, country string) (int64, error) {
+	commandTag, err := r.db.Exec(ctx, snapshotDailyQuery, day, country,
+		MetricActiveJobs, MetricTechnologyJobs, MetricCompanyJobs)
+	if err != nil {
+		return 0, fmt.Errorf("failed to snapshot daily statistics: %w", err)
+	}
+	return commandTag.RowsAffected(), nil
+}
+
+// GetHistory retrieves the daily values of a metric in the params range, oldest first.
+func (r *Repository) GetHistory(ctx context.Context, params *HistoryParams) ([]*HistoryPoint, error) {
+	rows, err := r.db.Query(ctx, getHistoryQuery, params.Country, params.Metric, params.Key, params.From, params.To)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get statistics history: %w", err)
+	}
+	defer rows.Close()
+
+	var points []*HistoryPoint
+	for rows.Next() {
+		point := &HistoryPoint{}
+		if err = rows.Scan(&point.Day, &point.Value); err != nil {
+			return nil, fmt.Errorf("failed to scan statistics history row: %w", err)
+		}
+		points = append(points, point)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating statistics history rows: %w", err)
+	}
+
+	return points, nil
+}
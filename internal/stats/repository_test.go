@@ -0,0 +1,312 @@
+package stats
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_GetTechnologyCounts(t *testing.T) {
+	t.Parallel()
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	weekStart := time.Date(2024, 1, 25, 0, 0, 0, 0, time.UTC)
+	previousWeekStart := time.Date(2024, 1, 18, 0, 0, 0, 0, time.UTC)
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		params       *TechnologyStatsParams
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, counts []*TechnologyCount, err error)
+	}{
+		{
+			name:   "counts found",
+			params: &TechnologyStatsParams{From: from, To: to, Limit: 20},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getTechnologyCountsQuery)).
+					WithArgs(from, to, weekStart, previousWeekStart, false, 20, "").
+					WillReturnRows(pgxmock.NewRows([]string{
+						"id", "name", "category", "job_count", "this_week", "last_week",
+					}).
+						AddRow(1, "go", "programming", 42, 12, 8).
+						AddRow(2, "react", "frontend", 30, 5, 0))
+			},
+			checkResults: func(t *testing.T, counts []*TechnologyCount, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, counts, 2)
+				assert.Equal(t, 4, counts[0].WeekOverWeekDelta())
+				require.NotNil(t, counts[0].WeekOverWeekChange())
+				assert.InDelta(t, 50.0, *counts[0].WeekOverWeekChange(), 0.001)
+				assert.Nil(t, counts[1].WeekOverWeekChange())
+			},
+		},
+		{
+			name:   "required only",
+			params: &TechnologyStatsParams{From: from, To: to, RequiredOnly: true, Limit: 5, Country: "CR"},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getTechnologyCountsQuery)).
+					WithArgs(from, to, weekStart, previousWeekStart, true, 5, "CR").
+					WillReturnRows(pgxmock.NewRows([]string{
+						"id", "name", "category", "job_count", "this_week", "last_week",
+					}))
+			},
+			checkResults: func(t *testing.T, counts []*TechnologyCount, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Empty(t, counts)
+			},
+		},
+		{
+			name:   "database error",
+			params: &TechnologyStatsParams{From: from, To: to, Limit: 20},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getTechnologyCountsQuery)).
+					WithArgs(from, to, weekStart, previousWeekStart, false, 20, "").
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ []*TechnologyCount, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			counts, err := repo.GetTechnologyCounts(context.Background(), tt.params)
+			tt.checkResults(t, counts, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_GetJobTotals(t *testing.T) {
+	t.Parallel()
+	since := time.Date(2024, 1, 25, 0, 0, 0, 0, time.UTC)
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, totals *JobTotals, err error)
+	}{
+		{
+			name: "totals found",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getJobTotalsQuery)).
+					WithArgs("CR", since).
+					WillReturnRows(pgxmock.NewRows([]string{"active_jobs", "new_jobs"}).AddRow(850, 64))
+			},
+			checkResults: func(t *testing.T, totals *JobTotals, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, &JobTotals{ActiveJobs: 850, NewJobs: 64}, totals)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getJobTotalsQuery)).WithArgs("CR", since).WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ *JobTotals, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			totals, err := repo.GetJobTotals(context.Background(), "CR", since)
+			tt.checkResults(t, totals, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_CountJobsByWorkMode(t *testing.T) {
+	t.Parallel()
+	mockDB, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	mockDB.ExpectQuery(regexp.QuoteMeta(countJobsByWorkModeQuery)).
+		WithArgs("CR").
+		WillReturnRows(pgxmock.NewRows([]string{"work_mode", "count"}).
+			AddRow("Remote", 120).
+			AddRow("Hybrid", 80))
+
+	buckets, err := NewRepository(mockDB).CountJobsByWorkMode(context.Background(), "CR")
+	require.NoError(t, err)
+	assert.Equal(t, []*Bucket{{Value: "Remote", Count: 120}, {Value: "Hybrid", Count: 80}}, buckets)
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
+
+func TestRepository_GetTopHiringCompanies(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, companies []*CompanyCount, err error)
+	}{
+		{
+			name: "companies found",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getTopHiringCompaniesQuery)).
+					WithArgs("CR", 10).
+					WillReturnRows(pgxmock.NewRows([]string{"slug", "name", "active_jobs"}).
+						AddRow("tech-corp", "Tech Corp", 35))
+			},
+			checkResults: func(t *testing.T, companies []*CompanyCount, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, []*CompanyCount{{Slug: "tech-corp", Name: "Tech Corp", ActiveJobs: 35}}, companies)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getTopHiringCompaniesQuery)).WithArgs("CR", 10).WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ []*CompanyCount, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			companies, err := repo.GetTopHiringCompanies(context.Background(), "CR", 10)
+			tt.checkResults(t, companies, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_SnapshotDaily(t *testing.T) {
+	t.Parallel()
+	mockDB, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	today := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+	mockDB.ExpectExec(regexp.QuoteMeta(snapshotDailyQuery)).
+		WithArgs(today, "CR", MetricActiveJobs, MetricTechnologyJobs, MetricCompanyJobs).
+		WillReturnResult(pgxmock.NewResult("INSERT", 42))
+
+	stored, err := NewRepository(mockDB).SnapshotDaily(context.Background(), today, "CR")
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), stored)
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
+
+func TestRepository_GetHistory(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+	params := &HistoryParams{
+		Metric:  MetricTechnologyJobs,
+		Key:     "go",
+		Country: "CR",
+		From:    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:      time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, points []*HistoryPoint, err error)
+	}{
+		{
+			name: "points found",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getHistoryQuery)).
+					WithArgs("CR", MetricTechnologyJobs, "go", params.From, params.To).
+					WillReturnRows(pgxmock.NewRows([]string{"day", "value"}).
+						AddRow(params.From, 40).
+						AddRow(params.From.AddDate(0, 0, 1), 42))
+			},
+			checkResults: func(t *testing.T, points []*HistoryPoint, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, []*HistoryPoint{
+					{Day: params.From, Value: 40},
+					{Day: params.From.AddDate(0, 0, 1), Value: 42},
+				}, points)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getHistoryQuery)).
+					WithArgs("CR", MetricTechnologyJobs, "go", params.From, params.To).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ []*HistoryPoint, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+			tt.mockSetup(mockDB)
+
+			points, err := NewRepository(mockDB).GetHistory(context.Background(), params)
+			tt.checkResults(t, points, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/cache"
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// Constants for statistics queries
+const (
+	DefaultStatsDays = 30
+	// DefaultHistoryDays is the range of the metric history when none is given
+	DefaultHistoryDays = 90
+	MaxStatsDays       = 366
+	DefaultLimit       = 20
+	MaxLimit           = 100
+
+	// OverviewCacheTTL is how long the dashboard overview is served from memory
+	OverviewCacheTTL  = time.Minute
+	TopCompaniesLimit = 10
+)
+
+const (
+	day  = 24 * time.Hour
+	week = 7 * day
+)
+
+// DataRepository interface to query the statistics.
+type DataRepository interface {
+	GetTechnologyCounts(ctx context.Context, params *TechnologyStatsParams) ([]*TechnologyCount, error)
+	GetJobTotals(ctx context.Context, country string, since time.Time) (*JobTotals, error)
+	CountJobsByWorkMode(ctx context.Context, country string) ([]*Bucket, error)
+	CountJobsByExperienceLevel(ctx context.Context, country string) ([]*Bucket, error)
+	GetTopHiringCompanies(ctx context.Context, country string, limit int) ([]*CompanyCount, error)
+	SnapshotDaily(ctx context.Context, day time.Time, country string) (int64, error)
+	GetHistory(ctx context.Context, params *HistoryParams) ([]*HistoryPoint, error)
+}
+
+// StatsService holds the business logic for the market statistics.
+type StatsService struct {
+	repo DataRepository
+	// countries are the countries whose overviews are refreshed
+	countries []string
+	// overviewCache holds the overviews by country
+	overviewCache *cache.Cache[string, *Overview]
+	now           func() time.Time
+}
+
+// NewStatsService creates a new instance of StatsService. The overviews of countries are
+// refreshed by RefreshOverview, the one of all countries when none is given.
+func NewStatsService(repo DataRepository, countries ...string) *StatsService {
+	if len(countries) == 0 {
+		countries = []string{""}
+	}
+	return &StatsService{
+		repo:          repo,
+		countries:     countries,
+		overviewCache: cache.New[string, *Overview](OverviewCacheTTL),
+		now:           time.Now,
+	}
+}
+
+// TechnologyCounts returns the number of jobs asking for each technology in the params range.
+// A zero range defaults to the last DefaultStatsDays days.
+func (s *StatsService) TechnologyCounts(ctx context.Context, params TechnologyStatsParams) (*TechnologyStats, error) {
+	if params.To.IsZero() {
+		params.To = s.now().UTC().Truncate(day).Add(day)
+	}
+	if params.From.IsZero() {
+		params.From = params.To.Add(-DefaultStatsDays * day)
+	}
+
+	if !params.From.Before(params.To) {
+		return nil, &httpservice.ValidationError{Errors: []string{"date_from cannot be after date_to"}}
+	}
+	if params.To.Sub(params.From) > MaxStatsDays*day {
+		return nil, &httpservice.ValidationError{Errors: []string{"date range cannot exceed 366 days"}}
+	}
+
+	if params.Limit <= 0 {
+		params.Limit = DefaultLimit
+	}
+	params.Limit = min(params.Limit, MaxLimit)
+
+	counts, err := s.repo.GetTechnologyCounts(ctx, &params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TechnologyStats{
+		From:         params.From,
+		To:           params.To,
+		RequiredOnly: params.RequiredOnly,
+		Technologies: counts,
+	}, nil
+}
+
+// History returns the daily values of a metric in the params range, the last DefaultHistoryDays
+// days by default. The keyed metrics need the lowercase technology name or company slug as key.
+func (s *StatsService) History(ctx context.Context, params HistoryParams) (*History, error) {
+	if params.To.IsZero() {
+		params.To = s.now().UTC().Truncate(day).Add(day)
+	}
+	if params.From.IsZero() {
+		params.From = params.To.Add(-DefaultHistoryDays * day)
+	}
+	params.Key = strings.ToLower(strings.TrimSpace(params.Key))
+
+	var errs []string
+	switch {
+	case !params.Metric.IsValid():
+		errs = append(errs, "metric must be one of active_jobs, technology_jobs or company_jobs")
+	case params.Metric.Keyed() && params.Key == "":
+		errs = append(errs, fmt.Sprintf("key is required for the %s metric", params.Metric))
+	case !params.Metric.Keyed() && params.Key != "":
+		errs = append(errs, fmt.Sprintf("key is not allowed for the %s metric", params.Metric))
+	}
+	if !params.From.Before(params.To) {
+		errs = append(errs, "date_from cannot be after date_to")
+	} else if params.To.Sub(params.From) > MaxStatsDays*day {
+		errs = append(errs, "date range cannot exceed 366 days")
+	}
+	if len(errs) > 0 {
+		return nil, &httpservice.ValidationError{Errors: errs}
+	}
+
+	points, err := s.repo.GetHistory(ctx, &params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &History{
+		Metric: params.Metric,
+		Key:    params.Key,
+		From:   params.From,
+		To:     params.To,
+		Points: points,
+	}, nil
+}
+
+// SnapshotDaily stores today's metrics of the countries, replacing the ones already stored
+// today, and returns the number of values stored.
+func (s *StatsService) SnapshotDaily(ctx context.Context) (int64, error) {
+	today := s.now().UTC().Truncate(day)
+
+	var stored int64
+	for _, country := range s.countries {
+		n, err := s.repo.SnapshotDaily(ctx, today, country)
+		if err != nil {
+			return stored, err
+		}
+		stored += n
+	}
+	return stored, nil
+}
+
+// Overview returns the market statistics of a country shown on the public dashboard, of all
+// countries when it is empty. Results are cached for OverviewCacheTTL.
+func (s *StatsService) Overview(ctx context.Context, country string) (*Overview, error) {
+	return s.overviewCache.GetOrLoad(ctx, country, func(ctx context.Context) (*Overview, error) {
+		return s.loadOverview(ctx, country)
+	})
+}
+
+// RefreshOverview recomputes the dashboard overviews of the countries and caches them, so they
+// reflect data changed since they were last cached.
+func (s *StatsService) RefreshOverview(ctx context.Context) error {
+	for _, country := range s.countries {
+		overview, err := s.loadOverview(ctx, country)
+		if err != nil {
+			return err
+		}
+		s.overviewCache.Set(country, overview)
+	}
+	return nil
+}
+
+// InvalidateCache drops the cached overviews, so the next requests recompute them
+func (s *StatsService) InvalidateCache() {
+	s.overviewCache.Clear()
+}
+
+// CacheStats returns the counters of the cache
+func (s *StatsService) CacheStats() cache.Stats {
+	return s.overviewCache.Stats()
+}
+
+// PurgeCache removes the expired cache entries and returns how many were removed
+func (s *StatsService) PurgeCache() int {
+	return s.overviewCache.Purge()
+}
+
+// loadOverview computes the dashboard overview of a country
+func (s *StatsService) loadOverview(ctx context.Context, country string) (*Overview, error) {
+	now := s.now().UTC()
+
+	totals, err := s.repo.GetJobTotals(ctx, country, now.Add(-week))
+	if err != nil {
+		return nil, err
+	}
+
+	byWorkMode, err := s.repo.CountJobsByWorkMode(ctx, country)
+	if err != nil {
+		return nil, err
+	}
+
+	byExperienceLevel, err := s.repo.CountJobsByExperienceLevel(ctx, country)
+	if err != nil {
+		return nil, err
+	}
+
+	topCompanies, err := s.repo.GetTopHiringCompanies(ctx, country, TopCompaniesLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Overview{
+		JobTotals:         *totals,
+		ByWorkMode:        byWorkMode,
+		ByExperienceLevel: byExperienceLevel,
+		TopCompanies:      topCompanies,
+		GeneratedAt:       now,
+	}, nil
+}
@@ -0,0 +1,344 @@
+package stats
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+func TestStatsService_TechnologyCounts(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC)
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		params       TechnologyStatsParams
+		mockSetup    func(mockRepo *MockDataRepository)
+		checkResults func(t *testing.T, stats *TechnologyStats, err error)
+	}{
+		{
+			name:   "defaults to the last 30 days",
+			params: TechnologyStatsParams{RequiredOnly: true},
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetTechnologyCounts(context.Background(), &TechnologyStatsParams{
+					From:         time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC),
+					To:           time.Date(2024, 3, 16, 0, 0, 0, 0, time.UTC),
+					RequiredOnly: true,
+					Limit:        DefaultLimit,
+				}).Return([]*TechnologyCount{{Name: "go", JobCount: 42}}, nil).Once()
+			},
+			checkResults: func(t *testing.T, stats *TechnologyStats, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Len(t, stats.Technologies, 1)
+
+				response := MapTechnologyStatsToResponse(stats)
+				assert.Equal(t, "2024-02-15", response.DateFrom)
+				assert.Equal(t, "2024-03-15", response.DateTo)
+				assert.True(t, response.RequiredOnly)
+			},
+		},
+		{
+			name: "inverted range",
+			params: TechnologyStatsParams{
+				From: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+				To:   time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			},
+			mockSetup: func(_ *MockDataRepository) {},
+			checkResults: func(t *testing.T, _ *TechnologyStats, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+			},
+		},
+		{
+			name: "range too long",
+			params: TechnologyStatsParams{
+				From: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+				To:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			mockSetup: func(_ *MockDataRepository) {},
+			checkResults: func(t *testing.T, _ *TechnologyStats, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+			},
+		},
+		{
+			name:   "repository error",
+			params: TechnologyStatsParams{Limit: 500},
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetTechnologyCounts(context.Background(),
+					mock.MatchedBy(func(p *TechnologyStatsParams) bool {
+						return p.Limit == MaxLimit
+					})).Return(nil, dbError).Once()
+			},
+			checkResults: func(t *testing.T, _ *TechnologyStats, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			service := NewStatsService(mockRepo)
+			service.now = func() time.Time { return now }
+
+			tt.mockSetup(mockRepo)
+
+			stats, err := service.TechnologyCounts(context.Background(), tt.params)
+			tt.checkResults(t, stats, err)
+		})
+	}
+}
+
+func TestStatsService_Overview(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC)
+	dbError := errors.New("database error")
+
+	t.Run("overview is cached", func(t *testing.T) {
+		t.Parallel()
+		mockRepo := NewMockDataRepository(t)
+		service := NewStatsService(mockRepo)
+		service.now = func() time.Time { return now }
+
+		mockRepo.EXPECT().GetJobTotals(mock.Anything, "CR", now.Add(-7*24*time.Hour)).
+			Return(&JobTotals{ActiveJobs: 850, NewJobs: 64}, nil).Once()
+		mockRepo.EXPECT().CountJobsByWorkMode(mock.Anything, "CR").
+			Return([]*Bucket{{Value: "Remote", Count: 120}}, nil).Once()
+		mockRepo.EXPECT().CountJobsByExperienceLevel(mock.Anything, "CR").
+			Return([]*Bucket{{Value: "Senior", Count: 300}}, nil).Once()
+		mockRepo.EXPECT().GetTopHiringCompanies(mock.Anything, "CR", TopCompaniesLimit).
+			Return([]*CompanyCount{{Slug: "tech-corp", Name: "Tech Corp", ActiveJobs: 35}}, nil).Once()
+
+		for range 2 {
+			overview, err := service.Overview(context.Background(), "CR")
+			require.NoError(t, err)
+
+			response := MapOverviewToResponse(overview)
+			assert.Equal(t, 850, response.ActiveJobs)
+			assert.Equal(t, 64, response.NewJobsThisWeek)
+			assert.Equal(t, "Remote", response.ByWorkMode[0].Value)
+			assert.Equal(t, "tech-corp", response.TopCompanies[0].Slug)
+			assert.Equal(t, now, response.GeneratedAt)
+		}
+	})
+
+	t.Run("refresh replaces the cached overview", func(t *testing.T) {
+		t.Parallel()
+		mockRepo := NewMockDataRepository(t)
+		service := NewStatsService(mockRepo, "CR")
+		service.now = func() time.Time { return now }
+
+		mockRepo.EXPECT().GetJobTotals(mock.Anything, "CR", mock.Anything).
+			Return(&JobTotals{ActiveJobs: 850}, nil).Once()
+		mockRepo.EXPECT().GetJobTotals(mock.Anything, "CR", mock.Anything).
+			Return(&JobTotals{ActiveJobs: 900}, nil).Once()
+		mockRepo.EXPECT().CountJobsByWorkMode(mock.Anything, "CR").Return([]*Bucket{}, nil).Twice()
+		mockRepo.EXPECT().CountJobsByExperienceLevel(mock.Anything, "CR").Return([]*Bucket{}, nil).Twice()
+		mockRepo.EXPECT().GetTopHiringCompanies(mock.Anything, "CR", TopCompaniesLimit).
+			Return([]*CompanyCount{}, nil).Twice()
+
+		overview, err := service.Overview(context.Background(), "CR")
+		require.NoError(t, err)
+		assert.Equal(t, 850, overview.ActiveJobs)
+
+		require.NoError(t, service.RefreshOverview(context.Background()))
+		overview, err = service.Overview(context.Background(), "CR")
+		require.NoError(t, err)
+		assert.Equal(t, 900, overview.ActiveJobs)
+	})
+
+	t.Run("overviews cached per country", func(t *testing.T) {
+		t.Parallel()
+		mockRepo := NewMockDataRepository(t)
+		service := NewStatsService(mockRepo, "CR", "PA")
+
+		for _, country := range []string{"CR", "PA"} {
+			mockRepo.EXPECT().GetJobTotals(mock.Anything, country, mock.Anything).Return(&JobTotals{}, nil).Once()
+			mockRepo.EXPECT().CountJobsByWorkMode(mock.Anything, country).Return([]*Bucket{}, nil).Once()
+			mockRepo.EXPECT().CountJobsByExperienceLevel(mock.Anything, country).Return([]*Bucket{}, nil).Once()
+			mockRepo.EXPECT().GetTopHiringCompanies(mock.Anything, country, TopCompaniesLimit).
+				Return([]*CompanyCount{}, nil).Once()
+		}
+
+		require.NoError(t, service.RefreshOverview(context.Background()))
+		for _, country := range []string{"CR", "PA"} {
+			_, err := service.Overview(context.Background(), country)
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("invalidated overview is recomputed", func(t *testing.T) {
+		t.Parallel()
+		mockRepo := NewMockDataRepository(t)
+		service := NewStatsService(mockRepo)
+
+		mockRepo.EXPECT().GetJobTotals(mock.Anything, "CR", mock.Anything).Return(&JobTotals{}, nil).Twice()
+		mockRepo.EXPECT().CountJobsByWorkMode(mock.Anything, "CR").Return([]*Bucket{}, nil).Twice()
+		mockRepo.EXPECT().CountJobsByExperienceLevel(mock.Anything, "CR").Return([]*Bucket{}, nil).Twice()
+		mockRepo.EXPECT().GetTopHiringCompanies(mock.Anything, "CR", TopCompaniesLimit).
+			Return([]*CompanyCount{}, nil).Twice()
+
+		for range 2 {
+			_, err := service.Overview(context.Background(), "CR")
+			require.NoError(t, err)
+			service.InvalidateCache()
+		}
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		t.Parallel()
+		mockRepo := NewMockDataRepository(t)
+		service := NewStatsService(mockRepo)
+
+		mockRepo.EXPECT().GetJobTotals(mock.Anything, "CR", mock.Anything).Return(nil, dbError).Twice()
+
+		for range 2 {
+			_, err := service.Overview(context.Background(), "CR")
+			require.ErrorIs(t, err, dbError)
+		}
+	})
+}
+
+func TestStatsService_History(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC)
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		params       HistoryParams
+		mockSetup    func(mockRepo *MockDataRepository)
+		checkResults func(t *testing.T, history *History, err error)
+	}{
+		{
+			name:   "defaults to the last 90 days, key lowercased",
+			params: HistoryParams{Metric: MetricTechnologyJobs, Key: " Go ", Country: "CR"},
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetHistory(context.Background(), &HistoryParams{
+					Metric:  MetricTechnologyJobs,
+					Key:     "go",
+					Country: "CR",
+					From:    time.Date(2023, 12, 17, 0, 0, 0, 0, time.UTC),
+					To:      time.Date(2024, 3, 16, 0, 0, 0, 0, time.UTC),
+				}).Return([]*HistoryPoint{{Day: time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), Value: 42}}, nil).Once()
+			},
+			checkResults: func(t *testing.T, history *History, err error) {
+				t.Helper()
+				require.NoError(t, err)
+
+				response := MapHistoryToResponse(history)
+				assert.Equal(t, "technology_jobs", response.Metric)
+				assert.Equal(t, "go", response.Key)
+				assert.Equal(t, "2023-12-17", response.DateFrom)
+				assert.Equal(t, "2024-03-15", response.DateTo)
+				assert.Equal(t, []*HistoryPointResponse{{Date: "2024-03-15", Value: 42}}, response.Data)
+			},
+		},
+		{
+			name:      "keyed metric without key",
+			params:    HistoryParams{Metric: MetricCompanyJobs},
+			mockSetup: func(_ *MockDataRepository) {},
+			checkResults: func(t *testing.T, _ *History, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Equal(t, []string{"key is required for the company_jobs metric"}, validationErr.Errors)
+			},
+		},
+		{
+			name: "unkeyed metric with key and inverted range",
+			params: HistoryParams{
+				Metric: MetricActiveJobs,
+				Key:    "go",
+				From:   time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+				To:     time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			},
+			mockSetup: func(_ *MockDataRepository) {},
+			checkResults: func(t *testing.T, _ *History, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Equal(t, []string{
+					"key is not allowed for the active_jobs metric",
+					"date_from cannot be after date_to",
+				}, validationErr.Errors)
+			},
+		},
+		{
+			name:   "repository error",
+			params: HistoryParams{Metric: MetricActiveJobs},
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetHistory(context.Background(), mock.Anything).Return(nil, dbError).Once()
+			},
+			checkResults: func(t *testing.T, _ *History, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			service := NewStatsService(mockRepo)
+			service.now = func() time.Time { return now }
+
+			tt.mockSetup(mockRepo)
+
+			history, err := service.History(context.Background(), tt.params)
+			tt.checkResults(t, history, err)
+		})
+	}
+}
+
+func TestStatsService_SnapshotDaily(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC)
+	today := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+
+	t.Run("snapshot of each country", func(t *testing.T) {
+		t.Parallel()
+		mockRepo := NewMockDataRepository(t)
+		service := NewStatsService(mockRepo, "CR", "PA")
+		service.now = func() time.Time { return now }
+
+		mockRepo.EXPECT().SnapshotDaily(context.Background(), today, "CR").Return(40, nil).Once()
+		mockRepo.EXPECT().SnapshotDaily(context.Background(), today, "PA").Return(2, nil).Once()
+
+		stored, err := service.SnapshotDaily(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), stored)
+	})
+
+	t.Run("failing country stops the snapshot", func(t *testing.T) {
+		t.Parallel()
+		dbError := errors.New("database error")
+		mockRepo := NewMockDataRepository(t)
+		service := NewStatsService(mockRepo, "CR", "PA")
+		service.now = func() time.Time { return now }
+
+		mockRepo.EXPECT().SnapshotDaily(context.Background(), today, "CR").Return(0, dbError).Once()
+
+		_, err := service.SnapshotDaily(context.Background())
+		require.ErrorIs(t, err, dbError)
+	})
+}
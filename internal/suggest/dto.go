@@ -0,0 +1,45 @@
+package suggest
+
+// Data Transfer Objects (DTOs) for the suggestions API layer.
+
+// SuggestRequest represents the query parameters of the suggestions
+type SuggestRequest struct {
+	Query string `form:"q" binding:"required,max=100" example:"go"`
+	Limit int    `form:"limit" binding:"omitempty,min=1,max=10" example:"5"`
+}
+
+// ToParams converts a SuggestRequest to Params
+func (req *SuggestRequest) ToParams() Params {
+	return Params{Prefix: req.Query, Limit: req.Limit}
+}
+
+// SuggestionResponse represents the API response for a suggestion
+type SuggestionResponse struct {
+	Type string `json:"type" example:"company" enums:"technology,company,title"`
+	Text string `json:"text" example:"Gorilla Logic"`
+	// CompanySlug and LogoURL are only set for companies, to link to the company page
+	CompanySlug string `json:"company_slug,omitempty" example:"gorilla-logic"`
+	LogoURL     string `json:"logo_url,omitempty" example:"https://example.com/logo.png"`
+	ActiveJobs  int    `json:"active_jobs" example:"12"`
+}
+
+// SuggestResponse represents the API response for the suggestions
+type SuggestResponse struct {
+	Data []*SuggestionResponse `json:"data"`
+}
+
+// MapSuggestionsToResponse converts suggestions to their API response format
+func MapSuggestionsToResponse(suggestions []*Suggestion) *SuggestResponse {
+	data := make([]*SuggestionResponse, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		data = append(data, &SuggestionResponse{
+			Type:        string(suggestion.Type),
+			Text:        suggestion.Text,
+			CompanySlug: suggestion.CompanySlug,
+			LogoURL:     suggestion.LogoURL,
+			ActiveJobs:  suggestion.ActiveJobs,
+		})
+	}
+
+	return &SuggestResponse{Data: data}
+}
@@ -0,0 +1,61 @@
+package suggest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// Constants for suggestion routes and endpoints
+const (
+	SuggestRoute = "/suggest"
+)
+
+// Handler handles HTTP requests for the search box suggestions
+type Handler struct {
+	service *SuggestService
+}
+
+// NewHandler creates a new suggestions handler
+func NewHandler(service *SuggestService) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers suggestion routes with the given router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.GET(SuggestRoute, h.Suggest)
+}
+
+// Suggest godoc
+// @Summary Suggest search box completions
+// @Description Completes the text typed in the search box with technologies, companies and job titles, in
+// @Description this order, the ones with the most active jobs first. The type of each suggestion tells where
+// @Description it leads: companies link to their page with company_slug, the others search the jobs.
+// @Tags suggest
+// @Produce json
+// @Param q query string true "Text typed in the search box" example("go")
+// @Param limit query int false "Number of suggestions of each type (max 10)" default(5) example(5)
+// @Success 200 {object} SuggestResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /suggest [get]
+func (h *Handler) Suggest(c *gin.Context) {
+	var req SuggestRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	params := req.ToParams()
+	params.Country = httpservice.CountryOf(c)
+
+	suggestions, err := h.service.Suggest(c.Request.Context(), params)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapSuggestionsToResponse(suggestions))
+}
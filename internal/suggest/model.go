@@ -0,0 +1,36 @@
+// Package suggest completes the text typed in the search box with technologies, companies and job
+// titles, so the search box can search the jobs of a technology or route to a company page directly.
+package suggest
+
+// Type is the kind of a suggestion, telling the search box where it leads
+type Type string
+
+// Types of the suggestions
+const (
+	// TypeTechnology searches the jobs of a technology
+	TypeTechnology Type = "technology"
+	// TypeCompany opens the page of a company
+	TypeCompany Type = "company"
+	// TypeTitle searches the jobs with a title
+	TypeTitle Type = "title"
+)
+
+// Suggestion is a completion of the text typed in the search box
+type Suggestion struct {
+	Type Type
+	Text string
+	// CompanySlug and LogoURL are only set for the company suggestions
+	CompanySlug string
+	LogoURL     string
+	// ActiveJobs is the number of active jobs of the suggestion in the country
+	ActiveJobs int
+}
+
+// Params defines the parameters to look up suggestions (repository layer)
+type Params struct {
+	// Prefix is the lowercase text typed, completed at the start of a name, alias or word of a title
+	Prefix  string
+	Country string
+	// Limit is the maximum number of suggestions of each type
+	Limit int
+}
@@ -0,0 +1,91 @@
+package suggest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// SQL query constants
+const (
+	// Technologies, companies and job titles completing the escaped prefix ($1) in the country $2, all
+	// countries when empty, up to $3 of each type, the ones with the most active jobs first. Technologies
+	// are completed by their name or an alias, companies and titles by the start of any of their words.
+	// Archived technologies and inactive companies aren't suggested.
+	suggestQuery = `
+        (SELECT 'technology', t.name, '', '', COUNT(DISTINCT j.id) AS active_jobs
+         FROM technologies t
+         LEFT JOIN technology_aliases ta ON ta.technology_id = t.id
+         LEFT JOIN job_technologies jt ON jt.technology_id = t.id
+         LEFT JOIN job_search_view j ON j.id = jt.job_id AND ($2 = '' OR j.country = $2)
+         WHERE t.archived_at IS NULL AND (LOWER(t.name) LIKE $1 || '%' OR LOWER(ta.alias) LIKE $1 || '%')
+         GROUP BY t.id
+         ORDER BY active_jobs DESC, t.name
+         LIMIT $3)
+        UNION ALL
+        (SELECT 'company', c.name, c.slug, c.logo_url, COUNT(j.id) AS active_jobs
+         FROM companies c
+         LEFT JOIN job_search_view j ON j.company_id = c.id
+         WHERE c.is_active AND ($2 = '' OR c.country = $2)
+           AND (LOWER(c.name) LIKE $1 || '%' OR LOWER(c.name) LIKE '% ' || $1 || '%')
+         GROUP BY c.id
+         ORDER BY active_jobs DESC, c.name
+         LIMIT $3)
+        UNION ALL
+        (SELECT 'title', MIN(j.title), '', '', COUNT(*) AS active_jobs
+         FROM job_search_view j
+         WHERE ($2 = '' OR j.country = $2)
+           AND (LOWER(j.title) LIKE $1 || '%' OR LOWER(j.title) LIKE '% ' || $1 || '%')
+         GROUP BY LOWER(j.title)
+         ORDER BY active_jobs DESC, MIN(j.title)
+         LIMIT $3)
+    `
+)
+
+// likeEscaper escapes the wildcards of a LIKE pattern, so they match themselves
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// Database interface to support pgxpool and mocks
+type Database interface {
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+}
+
+// Repository handles the database lookups of the search box suggestions.
+type Repository struct {
+	db Database
+}
+
+// NewRepository creates a new Repository instance.
+func NewRepository(db Database) *Repository {
+	return &Repository{db: db}
+}
+
+// Suggest returns the technologies, companies and job titles completing the prefix of params, in
+// this order.
+func (r *Repository) Suggest(ctx context.Context, params *Params) ([]*Suggestion, error) {
+	rows, err := r.db.Query(ctx, suggestQuery, likeEscaper.Replace(params.Prefix), params.Country, params.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up suggestions: %w", err)
+	}
+	defer rows.Close()
+
+	suggestions := []*Suggestion{}
+	for rows.Next() {
+		var suggestionType string
+		suggestion := &Suggestion{}
+		if err = rows.Scan(&suggestionType, &suggestion.Text, &suggestion.CompanySlug, &suggestion.LogoURL,
+			&suggestion.ActiveJobs); err != nil {
+			return nil, fmt.Errorf("failed to scan suggestion row: %w", err)
+		}
+		suggestion.Type = Type(suggestionType)
+		suggestions = append(suggestions, suggestion)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating suggestion rows: %w", err)
+	}
+
+	return suggestions, nil
+}
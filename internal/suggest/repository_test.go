@@ -0,0 +1,97 @@
+package suggest
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Suggest(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+	columns := []string{"type", "text", "slug", "logo_url", "active_jobs"}
+
+	tests := []struct {
+		name         string
+		params       *Params
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, suggestions []*Suggestion, err error)
+	}{
+		{
+			name:   "suggestions found",
+			params: &Params{Prefix: "go", Country: "CR", Limit: 5},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(suggestQuery)).
+					WithArgs("go", "CR", 5).
+					WillReturnRows(pgxmock.NewRows(columns).
+						AddRow("technology", "Go", "", "", 42).
+						AddRow("company", "Gorilla Logic", "gorilla-logic", "https://example.com/logo.png", 12).
+						AddRow("title", "Go Developer", "", "", 7))
+			},
+			checkResults: func(t *testing.T, suggestions []*Suggestion, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, suggestions, 3)
+				assert.Equal(t, TypeTechnology, suggestions[0].Type)
+				assert.Equal(t, &Suggestion{
+					Type:        TypeCompany,
+					Text:        "Gorilla Logic",
+					CompanySlug: "gorilla-logic",
+					LogoURL:     "https://example.com/logo.png",
+					ActiveJobs:  12,
+				}, suggestions[1])
+				assert.Equal(t, TypeTitle, suggestions[2].Type)
+			},
+		},
+		{
+			name:   "wildcards escaped",
+			params: &Params{Prefix: `c_%\`, Limit: 5},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(suggestQuery)).
+					WithArgs(`c\_\%\\`, "", 5).
+					WillReturnRows(pgxmock.NewRows(columns))
+			},
+			checkResults: func(t *testing.T, suggestions []*Suggestion, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Empty(t, suggestions)
+			},
+		},
+		{
+			name:   "database error",
+			params: &Params{Prefix: "go", Limit: 5},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(suggestQuery)).WithArgs("go", "", 5).WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ []*Suggestion, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			suggestions, err := repo.Suggest(context.Background(), tt.params)
+			tt.checkResults(t, suggestions, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
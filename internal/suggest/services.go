@@ -0,0 +1,44 @@
+package suggest
+
+import (
+	"context"
+	"strings"
+)
+
+// Constants for the suggestions
+const (
+	// DefaultLimit is the number of suggestions of each type when none is given
+	DefaultLimit = 5
+	MaxLimit     = 10
+)
+
+// DataRepository interface to look up the suggestions.
+type DataRepository interface {
+	Suggest(ctx context.Context, params *Params) ([]*Suggestion, error)
+}
+
+// SuggestService holds the business logic for the search box suggestions.
+type SuggestService struct {
+	repo DataRepository
+}
+
+// NewSuggestService creates a new instance of SuggestService.
+func NewSuggestService(repo DataRepository) *SuggestService {
+	return &SuggestService{repo: repo}
+}
+
+// Suggest returns the technologies, companies and job titles completing the text typed, up to
+// params.Limit of each type. Blank texts have no suggestions.
+func (s *SuggestService) Suggest(ctx context.Context, params Params) ([]*Suggestion, error) {
+	params.Prefix = strings.Join(strings.Fields(strings.ToLower(params.Prefix)), " ")
+	if params.Prefix == "" {
+		return []*Suggestion{}, nil
+	}
+
+	if params.Limit <= 0 {
+		params.Limit = DefaultLimit
+	}
+	params.Limit = min(params.Limit, MaxLimit)
+
+	return s.repo.Suggest(ctx, &params)
+}
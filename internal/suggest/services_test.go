@@ -0,0 +1,90 @@
+package suggest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuggestService_Suggest(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		params       Params
+		mockSetup    func(mockRepo *MockDataRepository)
+		checkResults func(t *testing.T, suggestions []*Suggestion, err error)
+	}{
+		{
+			name:   "normalizes the prefix and defaults the limit",
+			params: Params{Prefix: "  Go   Dev ", Country: "CR"},
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().Suggest(context.Background(), &Params{Prefix: "go dev", Country: "CR", Limit: DefaultLimit}).
+					Return([]*Suggestion{{Type: TypeTitle, Text: "Go Developer", ActiveJobs: 7}}, nil).Once()
+			},
+			checkResults: func(t *testing.T, suggestions []*Suggestion, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, suggestions, 1)
+
+				response := MapSuggestionsToResponse(suggestions)
+				assert.Equal(t, "title", response.Data[0].Type)
+				assert.Empty(t, response.Data[0].CompanySlug)
+			},
+		},
+		{
+			name:   "clamps the limit",
+			params: Params{Prefix: "go", Limit: 50},
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().Suggest(context.Background(), &Params{Prefix: "go", Limit: MaxLimit}).
+					Return([]*Suggestion{}, nil).Once()
+			},
+			checkResults: func(t *testing.T, suggestions []*Suggestion, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Empty(t, suggestions)
+			},
+		},
+		{
+			name:      "blank prefix",
+			params:    Params{Prefix: "   "},
+			mockSetup: func(_ *MockDataRepository) {},
+			checkResults: func(t *testing.T, suggestions []*Suggestion, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Empty(t, suggestions)
+			},
+		},
+		{
+			name:   "repository error",
+			params: Params{Prefix: "go"},
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().Suggest(context.Background(), &Params{Prefix: "go", Limit: DefaultLimit}).
+					Return(nil, dbError).Once()
+			},
+			checkResults: func(t *testing.T, _ []*Suggestion, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			tt.mockSetup(mockRepo)
+
+			service := NewSuggestService(mockRepo)
+			suggestions, err := service.Suggest(context.Background(), tt.params)
+			tt.checkResults(t, suggestions, err)
+		})
+	}
+}
@@ -5,6 +5,8 @@ package techalias
 import (
 	"errors"
 	"fmt"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
 )
 
 // NotFoundError represents a technology alias not found error
@@ -20,6 +22,11 @@ func (e NotFoundError) Error() string {
 	return fmt.Sprintf("technology alias with value %q not found", e.Alias)
 }
 
+// Is matches httpservice.ErrNotFound
+func (e NotFoundError) Is(target error) bool {
+	return target == httpservice.ErrNotFound
+}
+
 // IsNotFound checks if an error is a technology alias not found error
 func IsNotFound(err error) bool {
 	var notFoundErr *NotFoundError
@@ -35,6 +42,11 @@ func (e DuplicateError) Error() string {
 	return fmt.Sprintf("technology alias %q already exists", e.Alias)
 }
 
+// Is matches httpservice.ErrConflict
+func (e DuplicateError) Is(target error) bool {
+	return target == httpservice.ErrConflict
+}
+
 // IsDuplicate checks if an error is a duplicate technology alias error
 func IsDuplicate(err error) bool {
 	var duplicateErr *DuplicateError
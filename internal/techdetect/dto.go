@@ -0,0 +1,53 @@
+package techdetect
+
+import (
+	"time"
+)
+
+// Data Transfer Objects (DTOs) for the technology detection API layer.
+
+// DetectionResponse represents the API response for a technology detection
+type DetectionResponse struct {
+	ID             int        `json:"id" example:"1"`
+	JobID          int        `json:"job_id" example:"42"`
+	JobTitle       string     `json:"job_title" example:"Backend Developer"`
+	TechnologyID   int        `json:"technology_id" example:"3"`
+	TechnologyName string     `json:"technology_name" example:"go"`
+	Term           string     `json:"term" example:"golang"`
+	Occurrences    int        `json:"occurrences" example:"2"`
+	Confidence     float64    `json:"confidence" example:"0.65"`
+	Status         string     `json:"status" example:"pending"`
+	CreatedAt      time.Time  `json:"created_at" example:"2024-01-15T06:00:00Z"`
+	ReviewedAt     *time.Time `json:"reviewed_at,omitempty" example:"2024-01-15T09:30:00Z"`
+}
+
+// ListResponse represents the API response listing technology detections
+type ListResponse struct {
+	Data []*DetectionResponse `json:"data"`
+}
+
+// MapDetectionToResponse converts a technology detection database model to its API response format
+func MapDetectionToResponse(detection *Detection) *DetectionResponse {
+	return &DetectionResponse{
+		ID:             detection.ID,
+		JobID:          detection.JobID,
+		JobTitle:       detection.JobTitle,
+		TechnologyID:   detection.TechnologyID,
+		TechnologyName: detection.TechnologyName,
+		Term:           detection.Term,
+		Occurrences:    detection.Occurrences,
+		Confidence:     detection.Confidence,
+		Status:         string(detection.Status),
+		CreatedAt:      detection.CreatedAt,
+		ReviewedAt:     detection.ReviewedAt,
+	}
+}
+
+// MapDetectionsToResponse converts technology detections to the list API response format
+func MapDetectionsToResponse(detections []*Detection) *ListResponse {
+	data := make([]*DetectionResponse, 0, len(detections))
+	for _, detection := range detections {
+		data = append(data, MapDetectionToResponse(detection))
+	}
+	return &ListResponse{Data: data}
+}
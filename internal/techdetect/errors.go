@@ -0,0 +1,52 @@
+// Package techdetect finds known technologies in job descriptions, by their names and aliases,
+// to propose the job technologies the scrapers missed. Confident detections are associated with
+// the jobs right away, flagged as auto-detected, and the others wait for an admin review.
+package techdetect
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// NotFoundError represents a technology detection not found error
+type NotFoundError struct {
+	ID int
+}
+
+func (e NotFoundError) Error() string {
+	return fmt.Sprintf("technology detection with ID %d not found", e.ID)
+}
+
+// Is matches httpservice.ErrNotFound
+func (e NotFoundError) Is(target error) bool {
+	return target == httpservice.ErrNotFound
+}
+
+// IsNotFound checks if an error is a technology detection not found error
+func IsNotFound(err error) bool {
+	var notFoundErr *NotFoundError
+	return errors.As(err, &notFoundErr)
+}
+
+// NotPendingError represents a review of a technology detection that was already reviewed
+type NotPendingError struct {
+	ID     int
+	Status Status
+}
+
+func (e NotPendingError) Error() string {
+	return fmt.Sprintf("technology detection with ID %d is %s, only pending detections can be reviewed", e.ID, e.Status)
+}
+
+// Is matches httpservice.ErrConflict
+func (e NotPendingError) Is(target error) bool {
+	return target == httpservice.ErrConflict
+}
+
+// IsNotPending checks if an error is a not pending technology detection error
+func IsNotPending(err error) bool {
+	var notPendingErr *NotPendingError
+	return errors.As(err, &notPendingErr)
+}
@@ -0,0 +1,123 @@
+package techdetect
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// Constants for technology detection routes and endpoints
+const (
+	DetectionsRoute = "/technology-detections"
+	DetectionPath   = DetectionsRoute + "/:id"
+	AcceptPath      = DetectionPath + "/accept"
+	RejectPath      = DetectionPath + "/reject"
+)
+
+// Handler handles HTTP requests for technology detection operations
+type Handler struct {
+	service *DetectionService
+}
+
+// NewHandler creates a new technology detection handler
+func NewHandler(service *DetectionService) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterAdminRoutes registers the technology detection admin routes with the given (protected) router group
+func (h *Handler) RegisterAdminRoutes(rg *gin.RouterGroup) {
+	rg.GET(DetectionsRoute, h.ListPendingDetections)
+	rg.POST(AcceptPath, h.AcceptDetection)
+	rg.POST(RejectPath, h.RejectDetection)
+}
+
+// ListPendingDetections godoc
+// @Summary List pending technology detections
+// @Description Lists technologies found in job descriptions that the jobs aren't associated with and
+// @Description weren't confident enough to be accepted right away, most confident first
+// @Tags admin
+// @Produce json
+// @Security AdminAPIKey
+// @Success 200 {object} ListResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/technology-detections [get]
+func (h *Handler) ListPendingDetections(c *gin.Context) {
+	detections, err := h.service.ListPending(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapDetectionsToResponse(detections))
+}
+
+// AcceptDetection godoc
+// @Summary Accept a technology detection
+// @Description Associates the detected technology with the job, flagged as auto-detected
+// @Tags admin
+// @Produce json
+// @Security AdminAPIKey
+// @Param id path int true "Technology detection ID"
+// @Success 200 {object} DetectionResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 409 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/technology-detections/{id}/accept [post]
+func (h *Handler) AcceptDetection(c *gin.Context) {
+	id, ok := parseID(c)
+	if !ok {
+		return
+	}
+
+	detection, err := h.service.Accept(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapDetectionToResponse(detection))
+}
+
+// RejectDetection godoc
+// @Summary Reject a technology detection
+// @Description Discards a technology detection, so the technology isn't proposed for the job again
+// @Tags admin
+// @Produce json
+// @Security AdminAPIKey
+// @Param id path int true "Technology detection ID"
+// @Success 204
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 409 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/technology-detections/{id}/reject [post]
+func (h *Handler) RejectDetection(c *gin.Context) {
+	id, ok := parseID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Reject(c.Request.Context(), id); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// parseID reads the technology detection ID path parameter, responding with an error when invalid
+func parseID(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		_ = c.Error(&httpservice.ValidationError{Errors: []string{"invalid technology detection id"}})
+		return 0, false
+	}
+	return id, true
+}
@@ -0,0 +1,158 @@
+package techdetect
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/technology"
+)
+
+// Term is a name or alias of a technology to look for
+type Term struct {
+	TechnologyID int
+	Text         string
+}
+
+// Occurrence is a term found in a text, at the byte offset Start of the lowercased text
+type Occurrence struct {
+	Term  Term
+	Start int
+}
+
+// node is a state of the automaton, the prefix of one or more terms
+type node struct {
+	next map[byte]int
+	// fail is the state of the longest proper suffix of the prefix that is a prefix too
+	fail int
+	// terms holds the terms ending at the state, including the ones of its suffixes
+	terms []int
+}
+
+// Matcher finds the terms of a dictionary in texts with the Aho-Corasick algorithm, so every
+// name and alias is looked for in a single pass over a description. Matching ignores case, and
+// terms only match whole words: "go" is found in "Go, SQL" but not in "Google" or "go2".
+type Matcher struct {
+	nodes []node
+	terms []Term
+}
+
+// NewMatcher builds the Matcher of terms. Terms are normalized like technology names.
+func NewMatcher(terms []Term) *Matcher {
+	m := &Matcher{nodes: []node{{next: map[byte]int{}}}}
+	for _, term := range terms {
+		text := technology.NormalizeName(term.Text)
+		if text == "" {
+			continue
+		}
+		m.terms = append(m.terms, Term{TechnologyID: term.TechnologyID, Text: text})
+		m.insert(text, len(m.terms)-1)
+	}
+	m.link()
+	return m
+}
+
+// insert adds the states spelling text, ending with the term of index
+func (m *Matcher) insert(text string, index int) {
+	state := 0
+	for i := 0; i < len(text); i++ {
+		next, ok := m.nodes[state].next[text[i]]
+		if !ok {
+			m.nodes = append(m.nodes, node{next: map[byte]int{}})
+			next = len(m.nodes) - 1
+			m.nodes[state].next[text[i]] = next
+		}
+		state = next
+	}
+	m.nodes[state].terms = append(m.nodes[state].terms, index)
+}
+
+// link sets the failure links breadth first, so the links of shorter prefixes are set before
+// the ones of the prefixes extending them
+func (m *Matcher) link() {
+	queue := make([]int, 0, len(m.nodes))
+	for _, child := range m.nodes[0].next {
+		queue = append(queue, child)
+	}
+
+	for len(queue) > 0 {
+		state := queue[0]
+		queue = queue[1:]
+		for c, child := range m.nodes[state].next {
+			fail := m.nodes[state].fail
+			for fail != 0 && !m.hasTransition(fail, c) {
+				fail = m.nodes[fail].fail
+			}
+			if next, ok := m.nodes[fail].next[c]; ok {
+				fail = next
+			}
+			m.nodes[child].fail = fail
+			m.nodes[child].terms = append(m.nodes[child].terms, m.nodes[fail].terms...)
+			queue = append(queue, child)
+		}
+	}
+}
+
+// hasTransition reports whether state has a transition on c
+func (m *Matcher) hasTransition(state int, c byte) bool {
+	_, ok := m.nodes[state].next[c]
+	return ok
+}
+
+// Find returns the occurrences of the terms in text, in order. Overlapping occurrences are
+// resolved in favor of the longest one, so "react native" isn't found as "react" too.
+func (m *Matcher) Find(text string) []Occurrence {
+	text = strings.ToLower(text)
+
+	var found []Occurrence
+	state := 0
+	for i := 0; i < len(text); i++ {
+		for state != 0 && !m.hasTransition(state, text[i]) {
+			state = m.nodes[state].fail
+		}
+		if next, ok := m.nodes[state].next[text[i]]; ok {
+			state = next
+		}
+
+		for _, index := range m.nodes[state].terms {
+			term := m.terms[index]
+			start := i + 1 - len(term.Text)
+			if isWholeWord(text, start, i+1) {
+				found = append(found, Occurrence{Term: term, Start: start})
+			}
+		}
+	}
+
+	return longestOccurrences(found)
+}
+
+// isWholeWord reports whether text[start:end] isn't part of a longer word
+func isWholeWord(text string, start, end int) bool {
+	return (start == 0 || !isWordByte(text[start-1])) && (end == len(text) || !isWordByte(text[end]))
+}
+
+// isWordByte reports whether c continues a word. '+' and '#' do, so "c" isn't found in "c++" or
+// "c#", as do the bytes of non-ASCII letters.
+func isWordByte(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') || c == '_' || c == '+' || c == '#' || c >= 0x80
+}
+
+// longestOccurrences drops the occurrences overlapping a longer one that starts before or with them
+func longestOccurrences(found []Occurrence) []Occurrence {
+	sort.SliceStable(found, func(i, j int) bool {
+		if found[i].Start != found[j].Start {
+			return found[i].Start < found[j].Start
+		}
+		return len(found[i].Term.Text) > len(found[j].Term.Text)
+	})
+
+	kept := found[:0]
+	end := 0
+	for _, occurrence := range found {
+		if occurrence.Start < end {
+			continue
+		}
+		kept = append(kept, occurrence)
+		end = occurrence.Start + len(occurrence.Term.Text)
+	}
+	return kept
+}
@@ -0,0 +1,72 @@
+package techdetect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatcher_Find(t *testing.T) {
+	t.Parallel()
+
+	matcher := NewMatcher([]Term{
+		{TechnologyID: 1, Text: "Go"},
+		{TechnologyID: 1, Text: "golang"},
+		{TechnologyID: 2, Text: "c"},
+		{TechnologyID: 3, Text: "c++"},
+		{TechnologyID: 4, Text: "react"},
+		{TechnologyID: 5, Text: "react native"},
+		{TechnologyID: 6, Text: "node.js"},
+		{TechnologyID: 7, Text: "sql"},
+		{TechnologyID: 8, Text: "postgresql"},
+		{TechnologyID: 9, Text: " "},
+	})
+
+	tests := []struct {
+		name     string
+		text     string
+		expected []string
+	}{
+		{
+			name:     "terms found ignoring case",
+			text:     "We use Go, GoLang and SQL.",
+			expected: []string{"go", "golang", "sql"},
+		},
+		{
+			name:     "only whole words",
+			text:     "Google go2 MySQL gopher",
+			expected: nil,
+		},
+		{
+			name:     "symbols are part of the terms",
+			text:     "C++ and C, not C#",
+			expected: []string{"c++", "c"},
+		},
+		{
+			name:     "longest overlapping term",
+			text:     "React Native apps with React and Node.js",
+			expected: []string{"react native", "react", "node.js"},
+		},
+		{
+			name:     "suffix of a term",
+			text:     "PostgreSQL",
+			expected: []string{"postgresql"},
+		},
+		{
+			name:     "no text",
+			text:     "",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var found []string
+			for _, occurrence := range matcher.Find(tt.text) {
+				found = append(found, occurrence.Term.Text)
+			}
+			assert.Equal(t, tt.expected, found)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package techdetect
+
+import (
+	"time"
+)
+
+// Status is the review status of a technology detection
+type Status string
+
+// Technology detection statuses
+const (
+	StatusPending  Status = "pending"
+	StatusAccepted Status = "accepted"
+	StatusRejected Status = "rejected"
+)
+
+// Detection represents a known technology found in the description of a job that wasn't
+// associated with it. Accepted detections are job technologies flagged as auto-detected.
+type Detection struct {
+	ID           int     `json:"id" db:"id"`
+	JobID        int     `json:"job_id" db:"job_id"`
+	TechnologyID int     `json:"technology_id" db:"technology_id"`
+	Term         string  `json:"term" db:"term"`
+	Occurrences  int     `json:"occurrences" db:"occurrences"`
+	Confidence   float64 `json:"confidence" db:"confidence"`
+	Status       Status  `json:"status" db:"status"`
+
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty" db:"reviewed_at"`
+
+	// Joined fields, to help the review
+	JobTitle       string `json:"job_title" db:"job_title"`
+	TechnologyName string `json:"technology_name" db:"technology_name"`
+}
+
+// JobSource holds the description of a job to detect technologies in
+type JobSource struct {
+	JobID       int
+	Description string
+}
+
+// Summary counts the detections recorded for a set of jobs
+type Summary struct {
+	Jobs     int
+	Accepted int
+	Pending  int
+}
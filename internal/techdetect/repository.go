@@ -0,0 +1,223 @@
+package techdetect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// SQL query constants
+const (
+	listTermsQuery = `
+        SELECT id, name FROM technologies
+        UNION ALL
+        SELECT technology_id, alias FROM technology_aliases
+    `
+
+	listActiveJobDescriptionsQuery = `SELECT id, description FROM jobs WHERE is_active ORDER BY id`
+
+	// recordDetectionQuery skips the technologies the job is already associated with, and the ones
+	// already detected in it, including rejected ones. Accepted detections are associated right away.
+	recordDetectionQuery = `
+        WITH detection AS (
+            INSERT INTO technology_detections (job_id, technology_id, term, occurrences, confidence, status)
+            SELECT $1, $2, $3, $4, $5, $6
+            WHERE NOT EXISTS (SELECT 1 FROM job_technologies WHERE job_id = $1 AND technology_id = $2)
+            ON CONFLICT (job_id, technology_id) DO NOTHING
+            RETURNING id, job_id, technology_id, status, created_at
+        ), accepted AS (
+            INSERT INTO job_technologies (job_id, technology_id, is_required, auto_detected)
+            SELECT job_id, technology_id, FALSE, TRUE FROM detection WHERE status = 'accepted'
+            ON CONFLICT (job_id, technology_id) DO NOTHING
+        )
+        SELECT id, created_at FROM detection
+    `
+
+	selectDetectionBaseQuery = `
+        SELECT d.id, d.job_id, d.technology_id, d.term, d.occurrences, d.confidence, d.status,
+               d.created_at, d.reviewed_at, j.title, t.name
+        FROM technology_detections d
+        JOIN jobs j ON j.id = d.job_id
+        JOIN technologies t ON t.id = d.technology_id
+    `
+
+	getDetectionByIDQuery = selectDetectionBaseQuery + `WHERE d.id = $1`
+
+	listPendingDetectionsQuery = selectDetectionBaseQuery + `
+        WHERE d.status = 'pending'
+        ORDER BY d.confidence DESC, d.id
+    `
+
+	reviewDetectionQuery = `
+        WITH reviewed AS (
+            UPDATE technology_detections
+            SET status = $2, reviewed_at = NOW()
+            WHERE id = $1 AND status = 'pending'
+            RETURNING job_id, technology_id, status
+        ), accepted AS (
+            INSERT INTO job_technologies (job_id, technology_id, is_required, auto_detected)
+            SELECT job_id, technology_id, FALSE, TRUE FROM reviewed WHERE status = 'accepted'
+            ON CONFLICT (job_id, technology_id) DO NOTHING
+        )
+        SELECT job_id FROM reviewed
+    `
+)
+
+// Database interface to support pgxpool and mocks
+type Database interface {
+	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
+	Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+}
+
+// Repository handles database operations for the Detection model.
+type Repository struct {
+	db Database
+}
+
+// NewRepository creates a new Repository instance.
+func NewRepository(db Database) *Repository {
+	return &Repository{db: db}
+}
+
+// ListTerms retrieves the names and aliases of all the technologies.
+func (r *Repository) ListTerms(ctx context.Context) ([]Term, error) {
+	rows, err := r.db.Query(ctx, listTermsQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list technology terms: %w", err)
+	}
+	defer rows.Close()
+
+	var terms []Term
+	for rows.Next() {
+		var term Term
+		if err = rows.Scan(&term.TechnologyID, &term.Text); err != nil {
+			return nil, fmt.Errorf("failed to scan technology term row: %w", err)
+		}
+		terms = append(terms, term)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating technology term rows: %w", err)
+	}
+
+	return terms, nil
+}
+
+// ListActiveJobs retrieves the descriptions of the active jobs.
+func (r *Repository) ListActiveJobs(ctx context.Context) ([]*JobSource, error) {
+	rows, err := r.db.Query(ctx, listActiveJobDescriptionsQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list job descriptions: %w", err)
+	}
+	defer rows.Close()
+
+	var sources []*JobSource
+	for rows.Next() {
+		source := &JobSource{}
+		if err = rows.Scan(&source.JobID, &source.Description); err != nil {
+			return nil, fmt.Errorf("failed to scan job row: %w", err)
+		}
+		sources = append(sources, source)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating job rows: %w", err)
+	}
+
+	return sources, nil
+}
+
+// Record stores a detection, associating the technology with the job when it is accepted. It
+// reports false when the job already has the technology or the technology was already detected
+// in it. The ID and creation time of recorded detections are loaded into detection.
+func (r *Repository) Record(ctx context.Context, detection *Detection) (bool, error) {
+	err := r.db.QueryRow(
+		ctx,
+		recordDetectionQuery,
+		detection.JobID,
+		detection.TechnologyID,
+		detection.Term,
+		detection.Occurrences,
+		detection.Confidence,
+		detection.Status,
+	).Scan(&detection.ID, &detection.CreatedAt)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to record technology detection: %w", err)
+	}
+
+	return true, nil
+}
+
+// GetByID retrieves a technology detection by its ID.
+func (r *Repository) GetByID(ctx context.Context, id int) (*Detection, error) {
+	detection := &Detection{}
+	err := scanDetection(r.db.QueryRow(ctx, getDetectionByIDQuery, id), detection)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &NotFoundError{ID: id}
+		}
+		return nil, fmt.Errorf("failed to get technology detection: %w", err)
+	}
+
+	return detection, nil
+}
+
+// ListPending retrieves the detections waiting for review, most confident first.
+func (r *Repository) ListPending(ctx context.Context) ([]*Detection, error) {
+	rows, err := r.db.Query(ctx, listPendingDetectionsQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list technology detections: %w", err)
+	}
+	defer rows.Close()
+
+	var detections []*Detection
+	for rows.Next() {
+		detection := &Detection{}
+		if err = scanDetection(rows, detection); err != nil {
+			return nil, fmt.Errorf("failed to scan technology detection row: %w", err)
+		}
+		detections = append(detections, detection)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating technology detection rows: %w", err)
+	}
+
+	return detections, nil
+}
+
+// Review accepts or rejects a pending detection, associating the technology with the job when
+// it is accepted. It reports false when the detection isn't pending (anymore).
+func (r *Repository) Review(ctx context.Context, id int, status Status) (bool, error) {
+	commandTag, err := r.db.Exec(ctx, reviewDetectionQuery, id, status)
+	if err != nil {
+		return false, fmt.Errorf("failed to review technology detection: %w", err)
+	}
+	return commandTag.RowsAffected() > 0, nil
+}
+
+// scanDetection scans a technology detection row into detection
+func scanDetection(row pgx.Row, detection *Detection) error {
+	return row.Scan(
+		&detection.ID,
+		&detection.JobID,
+		&detection.TechnologyID,
+		&detection.Term,
+		&detection.Occurrences,
+		&detection.Confidence,
+		&detection.Status,
+		&detection.CreatedAt,
+		&detection.ReviewedAt,
+		&detection.JobTitle,
+		&detection.TechnologyName,
+	)
+}
@@ -0,0 +1,204 @@
+package techdetect
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var detectionColumns = []string{
+	"id", "job_id", "technology_id", "term", "occurrences", "confidence", "status",
+	"created_at", "reviewed_at", "title", "name",
+}
+
+func TestRepository_Record(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface, detection *Detection)
+		checkResults func(t *testing.T, detection *Detection, recorded bool, err error)
+	}{
+		{
+			name: "detection recorded",
+			mockSetup: func(mock pgxmock.PgxPoolIface, detection *Detection) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(recordDetectionQuery)).
+					WithArgs(detection.JobID, detection.TechnologyID, detection.Term, detection.Occurrences,
+						detection.Confidence, detection.Status).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "created_at"}).AddRow(7, now))
+			},
+			checkResults: func(t *testing.T, detection *Detection, recorded bool, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.True(t, recorded)
+				assert.Equal(t, 7, detection.ID)
+			},
+		},
+		{
+			name: "technology already associated or detected",
+			mockSetup: func(mock pgxmock.PgxPoolIface, detection *Detection) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(recordDetectionQuery)).
+					WithArgs(detection.JobID, detection.TechnologyID, detection.Term, detection.Occurrences,
+						detection.Confidence, detection.Status).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "created_at"}))
+			},
+			checkResults: func(t *testing.T, detection *Detection, recorded bool, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.False(t, recorded)
+				assert.Zero(t, detection.ID)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface, detection *Detection) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(recordDetectionQuery)).
+					WithArgs(detection.JobID, detection.TechnologyID, detection.Term, detection.Occurrences,
+						detection.Confidence, detection.Status).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ *Detection, _ bool, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			detection := &Detection{
+				JobID: 42, TechnologyID: 1, Term: "golang", Occurrences: 2, Confidence: 0.95, Status: StatusAccepted,
+			}
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB, detection)
+
+			recorded, err := repo.Record(context.Background(), detection)
+			tt.checkResults(t, detection, recorded, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_GetByID(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, detection *Detection, err error)
+	}{
+		{
+			name: "detection found",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getDetectionByIDQuery)).
+					WithArgs(7).
+					WillReturnRows(pgxmock.NewRows(detectionColumns).
+						AddRow(7, 42, 1, "go", 1, 0.3, StatusPending, now, nil, "Backend Developer", "go"))
+			},
+			checkResults: func(t *testing.T, detection *Detection, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, "Backend Developer", detection.JobTitle)
+				assert.Equal(t, StatusPending, detection.Status)
+				assert.Nil(t, detection.ReviewedAt)
+			},
+		},
+		{
+			name: "detection not found",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getDetectionByIDQuery)).
+					WithArgs(7).
+					WillReturnRows(pgxmock.NewRows(detectionColumns))
+			},
+			checkResults: func(t *testing.T, _ *Detection, err error) {
+				t.Helper()
+				assert.True(t, IsNotFound(err))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			detection, err := repo.GetByID(context.Background(), 7)
+			tt.checkResults(t, detection, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_Review(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		affected     int64
+		checkResults func(t *testing.T, reviewed bool, err error)
+	}{
+		{
+			name:     "pending detection reviewed",
+			affected: 1,
+			checkResults: func(t *testing.T, reviewed bool, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.True(t, reviewed)
+			},
+		},
+		{
+			name:     "detection not pending",
+			affected: 0,
+			checkResults: func(t *testing.T, reviewed bool, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.False(t, reviewed)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			mockDB.ExpectExec(regexp.QuoteMeta(reviewDetectionQuery)).
+				WithArgs(7, StatusAccepted).
+				WillReturnResult(pgxmock.NewResult("SELECT", tt.affected))
+
+			repo := NewRepository(mockDB)
+			reviewed, err := repo.Review(context.Background(), 7, StatusAccepted)
+			tt.checkResults(t, reviewed, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
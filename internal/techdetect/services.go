@@ -0,0 +1,191 @@
+package techdetect
+
+import (
+	"context"
+	"html"
+	"math"
+	"regexp"
+	"sync"
+)
+
+// DefaultAutoAcceptConfidence is the confidence from which detections are accepted without review
+const DefaultAutoAcceptConfidence = 0.8
+
+// Confidence of the detections by the length of the term found. Short terms are also common words
+// or letters, e.g. "go" or "r", so they need review unless they are found many times.
+const (
+	shortTermConfidence  = 0.3 // Terms of up to 2 characters
+	mediumTermConfidence = 0.6 // Terms of 3 characters
+	longTermConfidence   = 0.9
+	// occurrenceConfidence is added for each further occurrence of the technology
+	occurrenceConfidence = 0.05
+)
+
+// htmlTagPattern matches the tags of the sanitized descriptions
+var htmlTagPattern = regexp.MustCompile(`<[^>]+>`)
+
+// DataRepository interface to make database operations for the Detection model.
+type DataRepository interface {
+	ListTerms(ctx context.Context) ([]Term, error)
+	ListActiveJobs(ctx context.Context) ([]*JobSource, error)
+	Record(ctx context.Context, detection *Detection) (bool, error)
+	GetByID(ctx context.Context, id int) (*Detection, error)
+	ListPending(ctx context.Context) ([]*Detection, error)
+	Review(ctx context.Context, id int, status Status) (bool, error)
+}
+
+// DetectionService holds the business logic for detecting technologies in job descriptions and
+// reviewing the detections.
+type DetectionService struct {
+	repo       DataRepository
+	autoAccept float64
+
+	// matcher is built from the technologies on the first detection, and kept for the lifetime
+	// of the service, the run of a populator or command
+	mu      sync.Mutex
+	matcher *Matcher
+}
+
+// NewDetectionService creates a new instance of DetectionService. Detections of autoAcceptConfidence
+// or more are accepted right away, the others wait for review.
+func NewDetectionService(repo DataRepository, autoAcceptConfidence float64) *DetectionService {
+	return &DetectionService{repo: repo, autoAccept: autoAcceptConfidence}
+}
+
+// Confidence returns how likely a technology found occurrences times by term is actually required
+// by a job, between 0 and 1.
+func Confidence(term string, occurrences int) float64 {
+	confidence := longTermConfidence
+	switch {
+	case len(term) <= 2:
+		confidence = shortTermConfidence
+	case len(term) == 3:
+		confidence = mediumTermConfidence
+	}
+	confidence += occurrenceConfidence * float64(occurrences-1)
+	return math.Min(confidence, 1)
+}
+
+// Detect looks for the known technologies in the description of a job and records the ones the
+// job isn't associated with yet, and that weren't detected in it before. It returns the detections
+// recorded, accepted or pending.
+func (s *DetectionService) Detect(ctx context.Context, source *JobSource) ([]*Detection, error) {
+	matcher, err := s.loadMatcher(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	text := html.UnescapeString(htmlTagPattern.ReplaceAllString(source.Description, " "))
+
+	// Group the occurrences by technology, keeping the longest term found, the most specific
+	var detections []*Detection
+	byTechnology := map[int]*Detection{}
+	for _, occurrence := range matcher.Find(text) {
+		detection, ok := byTechnology[occurrence.Term.TechnologyID]
+		if !ok {
+			detection = &Detection{JobID: source.JobID, TechnologyID: occurrence.Term.TechnologyID}
+			byTechnology[occurrence.Term.TechnologyID] = detection
+			detections = append(detections, detection)
+		}
+		detection.Occurrences++
+		if len(occurrence.Term.Text) > len(detection.Term) {
+			detection.Term = occurrence.Term.Text
+		}
+	}
+
+	recorded := make([]*Detection, 0, len(detections))
+	for _, detection := range detections {
+		detection.Confidence = Confidence(detection.Term, detection.Occurrences)
+		detection.Status = StatusPending
+		if detection.Confidence >= s.autoAccept {
+			detection.Status = StatusAccepted
+		}
+
+		var inserted bool
+		if inserted, err = s.repo.Record(ctx, detection); err != nil {
+			return recorded, err
+		}
+		if inserted {
+			recorded = append(recorded, detection)
+		}
+	}
+
+	return recorded, nil
+}
+
+// DetectAll runs Detect on every active job and counts the detections recorded.
+func (s *DetectionService) DetectAll(ctx context.Context) (*Summary, error) {
+	sources, err := s.repo.ListActiveJobs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &Summary{Jobs: len(sources)}
+	for _, source := range sources {
+		var detections []*Detection
+		if detections, err = s.Detect(ctx, source); err != nil {
+			return summary, err
+		}
+		for _, detection := range detections {
+			if detection.Status == StatusAccepted {
+				summary.Accepted++
+			} else {
+				summary.Pending++
+			}
+		}
+	}
+
+	return summary, nil
+}
+
+// loadMatcher returns the matcher of the technology names and aliases, building it the first time
+func (s *DetectionService) loadMatcher(ctx context.Context) (*Matcher, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.matcher == nil {
+		terms, err := s.repo.ListTerms(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.matcher = NewMatcher(terms)
+	}
+	return s.matcher, nil
+}
+
+// ListPending retrieves the detections waiting for review, most confident first.
+func (s *DetectionService) ListPending(ctx context.Context) ([]*Detection, error) {
+	return s.repo.ListPending(ctx)
+}
+
+// Accept accepts a pending detection, associating the technology with the job as auto-detected.
+// It returns the reviewed detection.
+func (s *DetectionService) Accept(ctx context.Context, id int) (*Detection, error) {
+	if err := s.review(ctx, id, StatusAccepted); err != nil {
+		return nil, err
+	}
+	return s.repo.GetByID(ctx, id)
+}
+
+// Reject rejects a pending detection, so the technology isn't proposed for the job again.
+func (s *DetectionService) Reject(ctx context.Context, id int) error {
+	return s.review(ctx, id, StatusRejected)
+}
+
+// review sets the status of a pending detection, telling apart unknown detections from the ones
+// already reviewed
+func (s *DetectionService) review(ctx context.Context, id int, status Status) error {
+	reviewed, err := s.repo.Review(ctx, id, status)
+	if err != nil {
+		return err
+	}
+	if reviewed {
+		return nil
+	}
+
+	detection, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	return &NotPendingError{ID: id, Status: detection.Status}
+}
@@ -0,0 +1,168 @@
+package techdetect
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfidence(t *testing.T) {
+	t.Parallel()
+
+	assert.InDelta(t, 0.3, Confidence("go", 1), 0.001)
+	assert.InDelta(t, 0.6, Confidence("aws", 1), 0.001)
+	assert.InDelta(t, 0.7, Confidence("aws", 3), 0.001)
+	assert.InDelta(t, 0.9, Confidence("kotlin", 1), 0.001)
+	assert.InDelta(t, 1.0, Confidence("kotlin", 10), 0.001)
+}
+
+func TestDetectionService_Detect(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+	terms := []Term{
+		{TechnologyID: 1, Text: "go"},
+		{TechnologyID: 1, Text: "golang"},
+		{TechnologyID: 2, Text: "kubernetes"},
+		{TechnologyID: 3, Text: "docker"},
+	}
+	source := &JobSource{
+		JobID:       42,
+		Description: "<p>Go &amp; Kubernetes, golang experience</p><ul><li>Docker</li></ul>",
+	}
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mockRepo *MockDataRepository)
+		checkResults func(t *testing.T, detections []*Detection, err error)
+	}{
+		{
+			name: "detections recorded by confidence",
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().ListTerms(context.Background()).Return(terms, nil).Once()
+				mockRepo.EXPECT().Record(context.Background(), mock.MatchedBy(func(d *Detection) bool {
+					return d.TechnologyID == 1 && d.Term == "golang" && d.Occurrences == 2 && d.Status == StatusAccepted
+				})).Return(true, nil).Once()
+				mockRepo.EXPECT().Record(context.Background(), mock.MatchedBy(func(d *Detection) bool {
+					return d.TechnologyID == 2 && d.JobID == 42 && d.Status == StatusAccepted
+				})).Return(true, nil).Once()
+				// Already associated with the job
+				mockRepo.EXPECT().Record(context.Background(), mock.MatchedBy(func(d *Detection) bool {
+					return d.TechnologyID == 3
+				})).Return(false, nil).Once()
+			},
+			checkResults: func(t *testing.T, detections []*Detection, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, detections, 2)
+				assert.Equal(t, 1, detections[0].TechnologyID)
+				assert.Equal(t, 2, detections[1].TechnologyID)
+			},
+		},
+		{
+			name: "terms not loaded",
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().ListTerms(context.Background()).Return(nil, dbError).Once()
+			},
+			checkResults: func(t *testing.T, _ []*Detection, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			tt.mockSetup(mockRepo)
+
+			service := NewDetectionService(mockRepo, DefaultAutoAcceptConfidence)
+			detections, err := service.Detect(context.Background(), source)
+			tt.checkResults(t, detections, err)
+		})
+	}
+}
+
+func TestDetectionService_ShortTermsPending(t *testing.T) {
+	t.Parallel()
+
+	mockRepo := NewMockDataRepository(t)
+	mockRepo.EXPECT().ListTerms(context.Background()).Return([]Term{{TechnologyID: 1, Text: "go"}}, nil).Once()
+	mockRepo.EXPECT().Record(context.Background(), mock.MatchedBy(func(d *Detection) bool {
+		return d.Status == StatusPending
+	})).Return(true, nil).Once()
+
+	service := NewDetectionService(mockRepo, DefaultAutoAcceptConfidence)
+	detections, err := service.Detect(context.Background(), &JobSource{JobID: 1, Description: "Ready to go?"})
+	require.NoError(t, err)
+	require.Len(t, detections, 1)
+	assert.InDelta(t, 0.3, detections[0].Confidence, 0.001)
+}
+
+func TestDetectionService_Accept(t *testing.T) {
+	t.Parallel()
+	detection := &Detection{ID: 5, JobID: 42, TechnologyID: 1, Status: StatusAccepted}
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mockRepo *MockDataRepository)
+		checkResults func(t *testing.T, detection *Detection, err error)
+	}{
+		{
+			name: "pending detection accepted",
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().Review(context.Background(), 5, StatusAccepted).Return(true, nil).Once()
+				mockRepo.EXPECT().GetByID(context.Background(), 5).Return(detection, nil).Once()
+			},
+			checkResults: func(t *testing.T, result *Detection, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, detection, result)
+			},
+		},
+		{
+			name: "detection already reviewed",
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().Review(context.Background(), 5, StatusAccepted).Return(false, nil).Once()
+				mockRepo.EXPECT().GetByID(context.Background(), 5).
+					Return(&Detection{ID: 5, Status: StatusRejected}, nil).Once()
+			},
+			checkResults: func(t *testing.T, _ *Detection, err error) {
+				t.Helper()
+				assert.True(t, IsNotPending(err))
+			},
+		},
+		{
+			name: "detection not found",
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().Review(context.Background(), 5, StatusAccepted).Return(false, nil).Once()
+				mockRepo.EXPECT().GetByID(context.Background(), 5).Return(nil, &NotFoundError{ID: 5}).Once()
+			},
+			checkResults: func(t *testing.T, _ *Detection, err error) {
+				t.Helper()
+				assert.True(t, IsNotFound(err))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			tt.mockSetup(mockRepo)
+
+			service := NewDetectionService(mockRepo, DefaultAutoAcceptConfidence)
+			result, err := service.Accept(context.Background(), 5)
+			tt.checkResults(t, result, err)
+		})
+	}
+}
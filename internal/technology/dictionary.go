@@ -0,0 +1,64 @@
+package technology
+
+// Dictionary resolves technology names and aliases in memory, as FindMatch does in the database
+// without its similarity lookup. It is loaded at once with LoadDictionary by the imports resolving
+// the technologies of many jobs, instead of querying the database for every name.
+type Dictionary struct {
+	// byName holds the technologies by normalized name and alias
+	byName map[string]*Technology
+	// byCompact holds the technologies by compact name and alias, names taking precedence
+	byCompact map[string]*Technology
+	size      int
+}
+
+// NewDictionary creates a dictionary of the technologies, with their aliases
+func NewDictionary(techs []*Technology) *Dictionary {
+	d := &Dictionary{
+		byName:    make(map[string]*Technology, len(techs)),
+		byCompact: make(map[string]*Technology, len(techs)),
+		size:      len(techs),
+	}
+	for _, tech := range techs {
+		d.byName[NormalizeName(tech.Name)] = tech
+		if compact := CompactName(tech.Name); compact != "" {
+			d.byCompact[compact] = tech
+		}
+	}
+	for _, tech := range techs {
+		for _, alias := range tech.Aliases {
+			if _, ok := d.byName[NormalizeName(alias.Alias)]; !ok {
+				d.byName[NormalizeName(alias.Alias)] = tech
+			}
+			if compact := CompactName(alias.Alias); compact != "" {
+				if _, ok := d.byCompact[compact]; !ok {
+					d.byCompact[compact] = tech
+				}
+			}
+		}
+	}
+	return d
+}
+
+// Len returns the number of technologies of the dictionary
+func (d *Dictionary) Len() int {
+	return d.size
+}
+
+// Find resolves a technology by its canonical name or one of its aliases
+func (d *Dictionary) Find(name string) (*Technology, bool) {
+	tech, ok := d.byName[NormalizeName(name)]
+	return tech, ok
+}
+
+// Match resolves a technology name by its name or alias, falling back to a normalized match
+func (d *Dictionary) Match(name string) (*Match, bool) {
+	if tech, ok := d.Find(name); ok {
+		return &Match{Technology: tech, Method: MatchExact, Score: 1, Confident: true}, true
+	}
+	if compact := CompactName(name); compact != "" {
+		if tech, ok := d.byCompact[compact]; ok {
+			return &Match{Technology: tech, Method: MatchNormalized, Score: 1, Confident: true}, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,48 @@
+package technology
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/techalias"
+)
+
+func TestDictionary_Match(t *testing.T) {
+	t.Parallel()
+	golang := &Technology{ID: 1, Name: "go", Aliases: []techalias.TechnologyAlias{{Alias: "golang"}}}
+	node := &Technology{ID: 2, Name: "node.js", Aliases: []techalias.TechnologyAlias{{Alias: "node"}}}
+	// The alias of another technology doesn't shadow a name
+	nodeFork := &Technology{ID: 3, Name: "io.js", Aliases: []techalias.TechnologyAlias{{Alias: "nodejs"}}}
+	dict := NewDictionary([]*Technology{golang, node, nodeFork})
+
+	tests := []struct {
+		name   string
+		input  string
+		tech   *Technology
+		method MatchMethod
+	}{
+		{name: "by name", input: " Go ", tech: golang, method: MatchExact},
+		{name: "by alias", input: "GoLang", tech: golang, method: MatchExact},
+		{name: "by compact name", input: "Node JS", tech: node, method: MatchNormalized},
+		{name: "by compact alias", input: "io js", tech: nodeFork, method: MatchNormalized},
+		{name: "unknown", input: "rust"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			match, ok := dict.Match(tt.input)
+			if tt.tech == nil {
+				assert.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			assert.Same(t, tt.tech, match.Technology)
+			assert.Equal(t, tt.method, match.Method)
+			assert.True(t, match.Confident)
+		})
+	}
+	assert.Equal(t, 3, dict.Len())
+}
@@ -0,0 +1,176 @@
+package technology
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Data Transfer Objects (DTOs) for the technology API layer.
+// These models define the external API contract and are decoupled from the database models.
+
+// MergeRequest represents the request body to merge a duplicate technology into a canonical one
+type MergeRequest struct {
+	IntoID int `json:"into_id" binding:"required,gt=0" example:"1"`
+}
+
+// TechnologyResponse represents the API response for a single technology
+type TechnologyResponse struct {
+	ID       int    `json:"id" example:"1"`
+	Name     string `json:"name" example:"go"`
+	Category string `json:"category" example:"programming"`
+	ParentID *int   `json:"parent_id,omitempty" example:"3"`
+}
+
+// MapTechnologyToResponse converts a technology database model to its API response format
+func MapTechnologyToResponse(tech *Technology) *TechnologyResponse {
+	return &TechnologyResponse{
+		ID:       tech.ID,
+		Name:     tech.Name,
+		Category: tech.Category,
+		ParentID: tech.ParentID,
+	}
+}
+
+// AliasBatchRequest represents the request body to import the aliases of a technology
+type AliasBatchRequest struct {
+	Aliases []string `json:"aliases" binding:"max=500,dive,max=100" example:"golang,go-lang"`
+	// Replace deletes the aliases of the technology missing from the list
+	Replace bool `json:"replace" example:"false"`
+}
+
+// AliasConflictResponse represents an alias already naming another technology in API responses
+type AliasConflictResponse struct {
+	Alias        string `json:"alias" example:"js"`
+	TechnologyID int    `json:"technology_id" example:"7"`
+}
+
+// AliasBatchResponse represents the API response for an alias import
+type AliasBatchResponse struct {
+	Created   []string                 `json:"created" example:"golang"`
+	Unchanged []string                 `json:"unchanged" example:"go-lang"`
+	Removed   []string                 `json:"removed" example:"go language"`
+	Conflicts []*AliasConflictResponse `json:"conflicts"`
+}
+
+// TechnologyAliasesResponse represents a technology and its aliases in the alias export
+type TechnologyAliasesResponse struct {
+	ID       int      `json:"id" example:"1"`
+	Name     string   `json:"name" example:"go"`
+	Category string   `json:"category" example:"programming"`
+	Aliases  []string `json:"aliases" example:"golang,go-lang"`
+}
+
+// AliasExportResponse represents the API response for the alias export
+type AliasExportResponse struct {
+	Data []*TechnologyAliasesResponse `json:"data"`
+}
+
+// UnusedRequest represents the query parameters of the unused technology report
+type UnusedRequest struct {
+	Months int `form:"months" binding:"omitempty,min=1,max=120" example:"6"`
+}
+
+// UnusedTechnologyResponse represents a technology no recent active job asks for in API responses
+type UnusedTechnologyResponse struct {
+	ID         int        `json:"id" example:"12"`
+	Name       string     `json:"name" example:"coffeescript"`
+	Category   string     `json:"category" example:"programming"`
+	LastJobAt  *time.Time `json:"last_job_at,omitempty" example:"2023-04-02T15:04:05Z"`
+	ArchivedAt *time.Time `json:"archived_at,omitempty" example:"2024-01-15T03:00:00Z"`
+}
+
+// UnusedTechnologiesResponse represents the API response for the unused technology report
+type UnusedTechnologiesResponse struct {
+	Months int                         `json:"months" example:"6"`
+	Data   []*UnusedTechnologyResponse `json:"data"`
+}
+
+// MapUsagesToResponse converts the unused technologies of the last months to their API response format
+func MapUsagesToResponse(months int, usages []*Usage) *UnusedTechnologiesResponse {
+	data := make([]*UnusedTechnologyResponse, 0, len(usages))
+	for _, usage := range usages {
+		data = append(data, &UnusedTechnologyResponse{
+			ID:         usage.Technology.ID,
+			Name:       usage.Technology.Name,
+			Category:   usage.Technology.Category,
+			LastJobAt:  usage.LastJobAt,
+			ArchivedAt: usage.ArchivedAt,
+		})
+	}
+	return &UnusedTechnologiesResponse{Months: months, Data: data}
+}
+
+// MapAliasImportToResponse converts an alias import to its API response format
+func MapAliasImportToResponse(result *AliasImport) *AliasBatchResponse {
+	conflicts := make([]*AliasConflictResponse, 0, len(result.Conflicts))
+	for _, conflict := range result.Conflicts {
+		conflicts = append(conflicts, &AliasConflictResponse{Alias: conflict.Alias, TechnologyID: conflict.TechnologyID})
+	}
+
+	return &AliasBatchResponse{
+		Created:   nonNil(result.Created),
+		Unchanged: nonNil(result.Unchanged),
+		Removed:   nonNil(result.Removed),
+		Conflicts: conflicts,
+	}
+}
+
+// MapAliasExportToResponse converts technologies with their aliases to the alias export format
+func MapAliasExportToResponse(techs []*Technology) *AliasExportResponse {
+	data := make([]*TechnologyAliasesResponse, 0, len(techs))
+	for _, tech := range techs {
+		data = append(data, &TechnologyAliasesResponse{
+			ID:       tech.ID,
+			Name:     tech.Name,
+			Category: tech.Category,
+			Aliases:  aliasNames(tech),
+		})
+	}
+	return &AliasExportResponse{Data: data}
+}
+
+// csvAliasSeparator separates the aliases of a technology in the CSV export, commas being common in
+// spreadsheet cells
+const csvAliasSeparator = ";"
+
+// WriteAliasExportCSV writes technologies with their aliases as CSV, one row per technology with its
+// aliases in a single column, the way they are imported back
+func WriteAliasExportCSV(w io.Writer, techs []*Technology) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"id", "name", "category", "aliases"}); err != nil {
+		return err
+	}
+	for _, tech := range techs {
+		record := []string{
+			strconv.Itoa(tech.ID),
+			tech.Name,
+			tech.Category,
+			strings.Join(aliasNames(tech), csvAliasSeparator),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// aliasNames returns the aliases of a technology, an empty list when it has none
+func aliasNames(tech *Technology) []string {
+	names := make([]string, 0, len(tech.Aliases))
+	for _, alias := range tech.Aliases {
+		names = append(names, alias.Alias)
+	}
+	return names
+}
+
+// nonNil returns values, an empty list when it is nil, so it is encoded as [] instead of null
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,26 @@
+package technology
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/techalias"
+)
+
+func TestWriteAliasExportCSV(t *testing.T) {
+	t.Parallel()
+	techs := []*Technology{
+		{ID: 1, Name: "go", Category: "programming", Aliases: []techalias.TechnologyAlias{
+			{Alias: "go-lang"}, {Alias: "golang"},
+		}},
+		{ID: 2, Name: "node.js, server side", Category: "runtime"},
+	}
+
+	var body bytes.Buffer
+	require.NoError(t, WriteAliasExportCSV(&body, techs))
+	assert.Equal(t, "id,name,category,aliases\n1,go,programming,go-lang;golang\n2,\"node.js, server side\",runtime,\n",
+		body.String())
+}
@@ -0,0 +1,14 @@
+package technology
+
+import "context"
+
+// Technology events
+const (
+	// EventAdded is published when a new technology is created
+	EventAdded = "technology.added"
+)
+
+// EventPublisher interface to notify other systems of technology events.
+type EventPublisher interface {
+	PublishTechnologyEvent(ctx context.Context, eventType string, tech *Technology) error
+}
@@ -0,0 +1,205 @@
+package technology
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// Constants for technology routes and endpoints
+const (
+	TechnologiesRoute   = "/technologies"
+	MergeTechnologyPath = TechnologiesRoute + "/:id/merge"
+	AliasesBatchPath    = TechnologiesRoute + "/:id/aliases/batch"
+	AliasesExportRoute  = TechnologiesRoute + "/aliases"
+	AliasesCSVRoute     = TechnologiesRoute + "/aliases.csv"
+	UnusedRoute         = TechnologiesRoute + "/unused"
+)
+
+// Handler handles HTTP requests for technology operations
+type Handler struct {
+	service *TechnologyService
+}
+
+// NewHandler creates a new technology handler
+func NewHandler(service *TechnologyService) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterAdminRoutes registers the technology admin routes with the given (protected) router group
+func (h *Handler) RegisterAdminRoutes(rg *gin.RouterGroup) {
+	rg.POST(MergeTechnologyPath, h.MergeTechnology)
+	rg.POST(AliasesBatchPath, h.ImportAliases)
+	rg.GET(AliasesExportRoute, h.ExportAliases)
+	rg.GET(AliasesCSVRoute, h.ExportAliasesCSV)
+	rg.GET(UnusedRoute, h.ListUnused)
+}
+
+// MergeTechnology godoc
+// @Summary Merge a duplicate technology
+// @Description Re-points job associations, aliases and children of a duplicate technology to the
+// @Description canonical one, registers the old name as an alias and deletes the duplicate
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security AdminAPIKey
+// @Param id path int true "Duplicate technology ID"
+// @Param request body MergeRequest true "Canonical technology"
+// @Success 200 {object} TechnologyResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/technologies/{id}/merge [post]
+func (h *Handler) MergeTechnology(c *gin.Context) {
+	fromID, ok := parseID(c)
+	if !ok {
+		return
+	}
+
+	var req MergeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	tech, err := h.service.Merge(c.Request.Context(), fromID, req.IntoID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapTechnologyToResponse(tech))
+}
+
+// ImportAliases godoc
+// @Summary Import the aliases of a technology
+// @Description Gives a technology the aliases of a list, e.g. maintained in a spreadsheet. Aliases naming
+// @Description another technology or aliasing it are reported as conflicts and left as they are. With
+// @Description replace, the aliases of the technology missing from the list are deleted.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security AdminAPIKey
+// @Param id path int true "Technology ID"
+// @Param request body AliasBatchRequest true "Aliases of the technology"
+// @Success 200 {object} AliasBatchResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/technologies/{id}/aliases/batch [post]
+func (h *Handler) ImportAliases(c *gin.Context) {
+	id, ok := parseID(c)
+	if !ok {
+		return
+	}
+
+	var req AliasBatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	result, err := h.service.ImportAliases(c.Request.Context(), id, req.Aliases, req.Replace)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapAliasImportToResponse(result))
+}
+
+// ExportAliases godoc
+// @Summary Export the technology aliases
+// @Description Lists every technology with its aliases, by name
+// @Tags admin
+// @Produce json
+// @Security AdminAPIKey
+// @Success 200 {object} AliasExportResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/technologies/aliases [get]
+func (h *Handler) ExportAliases(c *gin.Context) {
+	techs, err := h.service.ListWithAliases(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapAliasExportToResponse(techs))
+}
+
+// ExportAliasesCSV godoc
+// @Summary Export the technology aliases as CSV
+// @Description Lists every technology with its aliases, by name, as a CSV file to edit in a spreadsheet.
+// @Description Each technology is a row with its aliases separated by semicolons.
+// @Tags admin
+// @Produce text/csv
+// @Security AdminAPIKey
+// @Success 200 {string} string "id,name,category,aliases"
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/technologies/aliases.csv [get]
+func (h *Handler) ExportAliasesCSV(c *gin.Context) {
+	techs, err := h.service.ListWithAliases(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var body bytes.Buffer
+	if err = WriteAliasExportCSV(&body, techs); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.Header("Content-Disposition", `attachment; filename="technology-aliases.csv"`)
+	c.Data(http.StatusOK, "text/csv; charset=utf-8", body.Bytes())
+}
+
+// ListUnused godoc
+// @Summary List the unused technologies
+// @Description Lists the technologies no active job posted in the last months asks for, the least recently
+// @Description used first, with when they were archived. Technologies added in the meantime are left out.
+// @Description They are archived daily, which leaves them out of the search suggestions.
+// @Tags admin
+// @Produce json
+// @Security AdminAPIKey
+// @Param months query int false "Months without active jobs (max 120)" default(6) example(6)
+// @Success 200 {object} UnusedTechnologiesResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/technologies/unused [get]
+func (h *Handler) ListUnused(c *gin.Context) {
+	var req UnusedRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+	if req.Months == 0 {
+		req.Months = DefaultUnusedMonths
+	}
+
+	usages, err := h.service.ListUnused(c.Request.Context(), req.Months)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapUsagesToResponse(req.Months, usages))
+}
+
+// parseID reads the technology ID path parameter, reporting a validation error when it is invalid
+func parseID(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		_ = c.Error(&httpservice.ValidationError{Errors: []string{"invalid technology id"}})
+		return 0, false
+	}
+	return id, true
+}
@@ -20,3 +20,12 @@ type Technology struct {
 	Aliases []techalias.TechnologyAlias `json:"aliases,omitempty" db:"-"`
 	Jobs    []jobtech.JobTechnology     `json:"jobs,omitempty" db:"-"`
 }
+
+// Usage is how recently a technology was asked for by the job postings
+type Usage struct {
+	Technology *Technology
+	// LastJobAt is when the last job asking for the technology was posted, nil when none ever did
+	LastJobAt *time.Time
+	// ArchivedAt is when the technology was archived, nil while it isn't
+	ArchivedAt *time.Time
+}
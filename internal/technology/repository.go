@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -32,6 +33,32 @@ const (
         WHERE name = $1
     `
 
+	// Compact names strip everything but letters, digits, '+' and '#' (see CompactName)
+	getTechnologyByCompactNameQuery = `
+        SELECT t.id, t.name, t.category, t.parent_id, t.created_at
+        FROM technologies t
+        WHERE regexp_replace(t.name, '[^a-z0-9+#]', '', 'g') = $1
+        UNION
+        SELECT t.id, t.name, t.category, t.parent_id, t.created_at
+        FROM technologies t
+        JOIN technology_aliases ta ON ta.technology_id = t.id
+        WHERE regexp_replace(ta.alias, '[^a-z0-9+#]', '', 'g') = $1
+        LIMIT 1
+    `
+
+	findMostSimilarTechnologyQuery = `
+        SELECT t.id, t.name, t.category, t.parent_id, t.created_at, m.score
+        FROM (
+            SELECT id AS technology_id, similarity(name, $1) AS score FROM technologies
+            UNION ALL
+            SELECT technology_id, similarity(alias, $1) FROM technology_aliases
+        ) m
+        JOIN technologies t ON t.id = m.technology_id
+        WHERE m.score >= $2
+        ORDER BY m.score DESC, t.name
+        LIMIT 1
+    `
+
 	updateTechnologyQuery = `
         UPDATE technologies
         SET name = $1, category = $2, parent_id = $3
@@ -47,12 +74,84 @@ const (
         ORDER BY alias
     `
 
+	listTechnologiesWithAliasesQuery = `
+        SELECT t.id, t.name, t.category, t.parent_id, t.created_at, ta.id, ta.alias, ta.created_at
+        FROM technologies t
+        LEFT JOIN technology_aliases ta ON ta.technology_id = t.id
+        ORDER BY t.name, ta.alias
+    `
+
 	getTechnologyJobsQuery = `
         SELECT id, job_id, technology_id, is_primary, is_required, created_at
         FROM job_technologies
         WHERE technology_id = $1
         ORDER BY created_at DESC
     `
+
+	// Merge queries re-point every reference of a duplicate technology ($1) to the canonical one ($2)
+	mergeJobTechnologyFlagsQuery = `
+        UPDATE job_technologies dst
+        SET is_primary = dst.is_primary OR src.is_primary, is_required = dst.is_required OR src.is_required
+        FROM job_technologies src
+        WHERE src.technology_id = $1 AND dst.technology_id = $2 AND src.job_id = dst.job_id
+    `
+
+	deleteOverlappingJobTechnologiesQuery = `
+        DELETE FROM job_technologies
+        WHERE technology_id = $1
+          AND job_id IN (SELECT job_id FROM job_technologies WHERE technology_id = $2)
+    `
+
+	repointJobTechnologiesQuery = `UPDATE job_technologies SET technology_id = $2 WHERE technology_id = $1`
+
+	repointTechnologyAliasesQuery = `UPDATE technology_aliases SET technology_id = $2 WHERE technology_id = $1`
+
+	repointTechnologyChildrenQuery = `
+        UPDATE technologies
+        SET parent_id = CASE WHEN id = $2 THEN NULL ELSE $2 END
+        WHERE parent_id = $1
+    `
+
+	createMergedAliasQuery = `
+        INSERT INTO technology_aliases (technology_id, alias)
+        VALUES ($1, $2)
+        ON CONFLICT (alias) DO NOTHING
+    `
+
+	// Technologies created before $1 not asked for by any active job posted since $1, the least
+	// recently used first
+	listUnusedTechnologiesQuery = `
+        SELECT t.id, t.name, t.category, t.parent_id, t.created_at, MAX(j.created_at), t.archived_at
+        FROM technologies t
+        LEFT JOIN job_technologies jt ON jt.technology_id = t.id
+        LEFT JOIN jobs j ON j.id = jt.job_id
+        WHERE t.created_at < $1
+        GROUP BY t.id
+        HAVING COUNT(j.id) FILTER (WHERE j.is_active AND j.created_at >= $1) = 0
+        ORDER BY MAX(j.created_at) NULLS FIRST, t.name
+    `
+
+	archiveUnusedTechnologiesQuery = `
+        UPDATE technologies t
+        SET archived_at = NOW()
+        WHERE t.archived_at IS NULL AND t.created_at < $1
+          AND NOT EXISTS (
+              SELECT 1 FROM job_technologies jt
+              JOIN jobs j ON j.id = jt.job_id
+              WHERE jt.technology_id = t.id AND j.is_active AND j.created_at >= $1
+          )
+    `
+
+	restoreUsedTechnologiesQuery = `
+        UPDATE technologies t
+        SET archived_at = NULL
+        WHERE t.archived_at IS NOT NULL
+          AND EXISTS (
+              SELECT 1 FROM job_technologies jt
+              JOIN jobs j ON j.id = jt.job_id
+              WHERE jt.technology_id = t.id AND j.is_active AND j.created_at >= $1
+          )
+    `
 )
 
 // Database interface to support pgxpool and mocks
@@ -60,6 +159,7 @@ type Database interface {
 	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
 	Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error)
 	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+	Begin(ctx context.Context) (pgx.Tx, error)
 }
 
 // Repository handles database operations for the Technology model.
@@ -136,6 +236,51 @@ func (r *Repository) GetByName(ctx context.Context, name string) (*Technology, e
 	return tech, nil
 }
 
+// GetByCompactName retrieves the technology whose name or alias has the given compact form.
+func (r *Repository) GetByCompactName(ctx context.Context, compactName string) (*Technology, error) {
+	tech := &Technology{}
+	err := r.db.QueryRow(ctx, getTechnologyByCompactNameQuery, compactName).Scan(
+		&tech.ID,
+		&tech.Name,
+		&tech.Category,
+		&tech.ParentID,
+		&tech.CreatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &NotFoundError{Name: compactName}
+		}
+		return nil, fmt.Errorf("failed to get technology by compact name: %w", err)
+	}
+
+	return tech, nil
+}
+
+// FindMostSimilar retrieves the technology whose name or alias is most similar to name
+// using trigram similarity, together with its score. Candidates scoring below minScore are ignored.
+func (r *Repository) FindMostSimilar(ctx context.Context, name string, minScore float64) (*Technology, float64, error) {
+	tech := &Technology{}
+	var score float64
+	err := r.db.QueryRow(ctx, findMostSimilarTechnologyQuery, name, minScore).Scan(
+		&tech.ID,
+		&tech.Name,
+		&tech.Category,
+		&tech.ParentID,
+		&tech.CreatedAt,
+		&score,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, 0, &NotFoundError{Name: name}
+		}
+		return nil, 0, fmt.Errorf("failed to find similar technology: %w", err)
+	}
+
+	return tech, score, nil
+}
+
 // Update updates an existing technology in the database.
 func (r *Repository) Update(ctx context.Context, tech *Technology) error {
 	commandTag, err := r.db.Exec(
@@ -213,6 +358,68 @@ func (r *Repository) GetWithAliases(ctx context.Context, id int) (*Technology, e
 	return tech, nil
 }
 
+// ListWithAliases retrieves all the technologies including their aliases, by name.
+func (r *Repository) ListWithAliases(ctx context.Context) ([]*Technology, error) {
+	rows, err := r.db.Query(ctx, listTechnologiesWithAliasesQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list technologies with aliases: %w", err)
+	}
+	defer rows.Close()
+
+	var techs []*Technology
+	for rows.Next() {
+		tech := &Technology{}
+		var (
+			aliasID        *int
+			aliasValue     *string
+			aliasCreatedAt *time.Time
+		)
+		err = rows.Scan(
+			&tech.ID,
+			&tech.Name,
+			&tech.Category,
+			&tech.ParentID,
+			&tech.CreatedAt,
+			&aliasID,
+			&aliasValue,
+			&aliasCreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan technology row: %w", err)
+		}
+
+		// Rows of the same technology are consecutive, one per alias
+		if len(techs) == 0 || techs[len(techs)-1].ID != tech.ID {
+			techs = append(techs, tech)
+		}
+		if aliasID != nil {
+			last := techs[len(techs)-1]
+			last.Aliases = append(last.Aliases, techalias.TechnologyAlias{
+				ID:           *aliasID,
+				TechnologyID: last.ID,
+				Alias:        *aliasValue,
+				CreatedAt:    *aliasCreatedAt,
+			})
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating technology rows: %w", err)
+	}
+
+	return techs, nil
+}
+
+// LoadDictionary retrieves all the technologies with their aliases in a single query, as a
+// dictionary resolving them in memory.
+func (r *Repository) LoadDictionary(ctx context.Context) (*Dictionary, error) {
+	techs, err := r.ListWithAliases(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return NewDictionary(techs), nil
+}
+
 // GetWithJobs retrieves a technology by ID including its job associations.
 func (r *Repository) GetWithJobs(ctx context.Context, id int) (*Technology, error) {
 	tech, err := r.GetByID(ctx, id)
@@ -233,6 +440,7 @@ func (r *Repository) GetWithJobs(ctx context.Context, id int) (*Technology, erro
 			&job.ID,
 			&job.JobID,
 			&job.TechnologyID,
+			&job.IsPrimary,
 			&job.IsRequired,
 			&job.CreatedAt,
 		)
@@ -249,3 +457,103 @@ func (r *Repository) GetWithJobs(ctx context.Context, id int) (*Technology, erro
 	tech.Jobs = jobs
 	return tech, nil
 }
+
+// Merge moves every job association, alias and child of the technology fromID to toID,
+// deletes fromID and registers oldName as an alias of toID. It runs in a single transaction.
+func (r *Repository) Merge(ctx context.Context, fromID, toID int, oldName string) (err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin merge transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	// Jobs that reference both technologies keep a single association
+	mergeSteps := []struct {
+		query string
+		desc  string
+	}{
+		{mergeJobTechnologyFlagsQuery, "merge job technology flags"},
+		{deleteOverlappingJobTechnologiesQuery, "delete overlapping job technologies"},
+		{repointJobTechnologiesQuery, "re-point job technologies"},
+		{repointTechnologyAliasesQuery, "re-point technology aliases"},
+		{repointTechnologyChildrenQuery, "re-point technology children"},
+	}
+	for _, step := range mergeSteps {
+		if _, err = tx.Exec(ctx, step.query, fromID, toID); err != nil {
+			return fmt.Errorf("failed to %s: %w", step.desc, err)
+		}
+	}
+
+	commandTag, err := tx.Exec(ctx, deleteTechnologyQuery, fromID)
+	if err != nil {
+		return fmt.Errorf("failed to delete technology: %w", err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return &NotFoundError{ID: fromID}
+	}
+
+	if _, err = tx.Exec(ctx, createMergedAliasQuery, toID, oldName); err != nil {
+		return fmt.Errorf("failed to create alias for merged technology: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit merge transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ListUnused retrieves the technologies created before since that no active job posted since then
+// asks for, archived or not, the least recently used first.
+func (r *Repository) ListUnused(ctx context.Context, since time.Time) ([]*Usage, error) {
+	rows, err := r.db.Query(ctx, listUnusedTechnologiesQuery, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list unused technologies: %w", err)
+	}
+	defer rows.Close()
+
+	var usages []*Usage
+	for rows.Next() {
+		usage := &Usage{Technology: &Technology{}}
+		err = rows.Scan(
+			&usage.Technology.ID,
+			&usage.Technology.Name,
+			&usage.Technology.Category,
+			&usage.Technology.ParentID,
+			&usage.Technology.CreatedAt,
+			&usage.LastJobAt,
+			&usage.ArchivedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan unused technology row: %w", err)
+		}
+		usages = append(usages, usage)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating unused technology rows: %w", err)
+	}
+
+	return usages, nil
+}
+
+// Archive archives the technologies created before since that no active job posted since then
+// asks for, and restores the archived ones asked for again. It returns the number of technologies
+// archived and restored.
+func (r *Repository) Archive(ctx context.Context, since time.Time) (archived, restored int64, err error) {
+	commandTag, err := r.db.Exec(ctx, archiveUnusedTechnologiesQuery, since)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to archive unused technologies: %w", err)
+	}
+	archived = commandTag.RowsAffected()
+
+	commandTag, err = r.db.Exec(ctx, restoreUsedTechnologiesQuery, since)
+	if err != nil {
+		return archived, 0, fmt.Errorf("failed to restore used technologies: %w", err)
+	}
+	return archived, commandTag.RowsAffected(), nil
+}
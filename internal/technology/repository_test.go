@@ -360,6 +360,149 @@ func TestRepository_GetByName(t *testing.T) {
 	}
 }
 
+func TestRepository_GetByCompactName(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+
+	tests := []struct {
+		name         string
+		compactName  string
+		mockSetup    func(mock pgxmock.PgxPoolIface, compactName string)
+		checkResults func(t *testing.T, result *Technology, err error)
+	}{
+		{
+			name:        "technology found",
+			compactName: "nodejs",
+			mockSetup: func(mock pgxmock.PgxPoolIface, compactName string) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getTechnologyByCompactNameQuery)).
+					WithArgs(compactName).
+					WillReturnRows(pgxmock.NewRows([]string{
+						"id", "name", "category", "parent_id", "created_at",
+					}).AddRow(
+						1, "node.js", "backend", nil, now,
+					))
+			},
+			checkResults: func(t *testing.T, result *Technology, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, "node.js", result.Name)
+			},
+		},
+		{
+			name:        "technology not found",
+			compactName: "cobol",
+			mockSetup: func(mock pgxmock.PgxPoolIface, compactName string) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getTechnologyByCompactNameQuery)).
+					WithArgs(compactName).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			checkResults: func(t *testing.T, result *Technology, err error) {
+				t.Helper()
+				assert.Nil(t, result)
+				assert.True(t, IsNotFound(err))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB, tt.compactName)
+
+			result, err := repo.GetByCompactName(context.Background(), tt.compactName)
+			tt.checkResults(t, result, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_FindMostSimilar(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		techName     string
+		mockSetup    func(mock pgxmock.PgxPoolIface, techName string)
+		checkResults func(t *testing.T, result *Technology, score float64, err error)
+	}{
+		{
+			name:     "similar technology found",
+			techName: "postgress",
+			mockSetup: func(mock pgxmock.PgxPoolIface, techName string) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(findMostSimilarTechnologyQuery)).
+					WithArgs(techName, 0.3).
+					WillReturnRows(pgxmock.NewRows([]string{
+						"id", "name", "category", "parent_id", "created_at", "score",
+					}).AddRow(
+						4, "postgresql", "database", nil, now, 0.64,
+					))
+			},
+			checkResults: func(t *testing.T, result *Technology, score float64, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 4, result.ID)
+				assert.InDelta(t, 0.64, score, 0.001)
+			},
+		},
+		{
+			name:     "no similar technology",
+			techName: "cobol",
+			mockSetup: func(mock pgxmock.PgxPoolIface, techName string) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(findMostSimilarTechnologyQuery)).
+					WithArgs(techName, 0.3).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			checkResults: func(t *testing.T, _ *Technology, _ float64, err error) {
+				t.Helper()
+				assert.True(t, IsNotFound(err))
+			},
+		},
+		{
+			name:     "database error",
+			techName: "cobol",
+			mockSetup: func(mock pgxmock.PgxPoolIface, techName string) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(findMostSimilarTechnologyQuery)).
+					WithArgs(techName, 0.3).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ *Technology, _ float64, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB, tt.techName)
+
+			result, score, err := repo.FindMostSimilar(context.Background(), tt.techName, 0.3)
+			tt.checkResults(t, result, score, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
 func TestRepository_Update(t *testing.T) {
 	t.Parallel()
 	dbError := errors.New("database error")
@@ -792,6 +935,95 @@ func TestRepository_GetWithAliases(t *testing.T) {
 	}
 }
 
+func TestRepository_ListWithAliases(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	dbError := errors.New("database error")
+	columns := []string{"id", "name", "category", "parent_id", "created_at", "id", "alias", "created_at"}
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, techs []*Technology, err error)
+	}{
+		{
+			name: "technologies grouped with their aliases",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(listTechnologiesWithAliasesQuery)).
+					WillReturnRows(pgxmock.NewRows(columns).
+						AddRow(1, "go", "programming", nil, now, intPtr(10), strPtr("go-lang"), &now).
+						AddRow(1, "go", "programming", nil, now, intPtr(11), strPtr("golang"), &now).
+						AddRow(2, "rust", "programming", nil, now, nil, nil, nil))
+			},
+			checkResults: func(t *testing.T, techs []*Technology, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, techs, 2)
+				require.Len(t, techs[0].Aliases, 2)
+				assert.Equal(t, "go-lang", techs[0].Aliases[0].Alias)
+				assert.Equal(t, 1, techs[0].Aliases[1].TechnologyID)
+				assert.Equal(t, "rust", techs[1].Name)
+				assert.Empty(t, techs[1].Aliases)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(listTechnologiesWithAliasesQuery)).WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ []*Technology, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			techs, err := repo.ListWithAliases(context.Background())
+			tt.checkResults(t, techs, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_LoadDictionary(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	mockDB, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	mockDB.ExpectQuery(regexp.QuoteMeta(listTechnologiesWithAliasesQuery)).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "name", "category", "parent_id", "created_at", "id", "alias", "created_at"}).
+			AddRow(1, "go", "programming", nil, now, intPtr(10), strPtr("golang"), &now).
+			AddRow(2, "rust", "programming", nil, now, nil, nil, nil))
+
+	dict, err := NewRepository(mockDB).LoadDictionary(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, dict.Len())
+	tech, ok := dict.Find("golang")
+	require.True(t, ok)
+	assert.Equal(t, 1, tech.ID)
+
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
 func TestRepository_GetWithJobs(t *testing.T) {
 	t.Parallel()
 	now := time.Now()
@@ -822,11 +1054,11 @@ func TestRepository_GetWithJobs(t *testing.T) {
 				mock.ExpectQuery(regexp.QuoteMeta(getTechnologyJobsQuery)).
 					WithArgs(id).
 					WillReturnRows(pgxmock.NewRows([]string{
-						"id", "job_id", "technology_id", "is_required", "created_at",
+						"id", "job_id", "technology_id", "is_primary", "is_required", "created_at",
 					}).AddRow(
-						1, 101, id, true, now,
+						1, 101, id, true, true, now,
 					).AddRow(
-						2, 102, id, true, now,
+						2, 102, id, false, true, now,
 					))
 			},
 			checkResults: func(t *testing.T, result *Technology, err error) {
@@ -841,7 +1073,9 @@ func TestRepository_GetWithJobs(t *testing.T) {
 				// Check job associations
 				assert.Len(t, result.Jobs, 2)
 				assert.Equal(t, 101, result.Jobs[0].JobID)
+				assert.True(t, result.Jobs[0].IsPrimary)
 				assert.Equal(t, 102, result.Jobs[1].JobID)
+				assert.False(t, result.Jobs[1].IsPrimary)
 			},
 		},
 		{
@@ -862,9 +1096,9 @@ func TestRepository_GetWithJobs(t *testing.T) {
 				mock.ExpectQuery(regexp.QuoteMeta(getTechnologyJobsQuery)).
 					WithArgs(id).
 					WillReturnRows(pgxmock.NewRows([]string{
-						"id", "job_id", "technology_id", "is_required", "created_at",
+						"id", "job_id", "technology_id", "is_primary", "is_required", "created_at",
 					}).AddRow(
-						3, 201, id, false, now,
+						3, 201, id, false, false, now,
 					))
 			},
 			checkResults: func(t *testing.T, result *Technology, err error) {
@@ -1009,3 +1243,252 @@ func TestRepository_GetWithJobs(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_Merge(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	expectRepointing := func(mock pgxmock.PgxPoolIface, fromID, toID int) {
+		for _, query := range []string{
+			mergeJobTechnologyFlagsQuery,
+			deleteOverlappingJobTechnologiesQuery,
+			repointJobTechnologiesQuery,
+			repointTechnologyAliasesQuery,
+			repointTechnologyChildrenQuery,
+		} {
+			mock.ExpectExec(regexp.QuoteMeta(query)).
+				WithArgs(fromID, toID).
+				WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		}
+	}
+
+	tests := []struct {
+		name         string
+		fromID       int
+		toID         int
+		oldName      string
+		mockSetup    func(mock pgxmock.PgxPoolIface, fromID, toID int, oldName string)
+		checkResults func(t *testing.T, err error)
+	}{
+		{
+			name:    "successful merge",
+			fromID:  2,
+			toID:    1,
+			oldName: "golang",
+			mockSetup: func(mock pgxmock.PgxPoolIface, fromID, toID int, oldName string) {
+				t.Helper()
+				mock.ExpectBegin()
+				expectRepointing(mock, fromID, toID)
+				mock.ExpectExec(regexp.QuoteMeta(deleteTechnologyQuery)).
+					WithArgs(fromID).
+					WillReturnResult(pgxmock.NewResult("DELETE", 1))
+				mock.ExpectExec(regexp.QuoteMeta(createMergedAliasQuery)).
+					WithArgs(toID, oldName).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectCommit()
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:    "duplicate technology already deleted",
+			fromID:  2,
+			toID:    1,
+			oldName: "golang",
+			mockSetup: func(mock pgxmock.PgxPoolIface, fromID, toID int, _ string) {
+				t.Helper()
+				mock.ExpectBegin()
+				expectRepointing(mock, fromID, toID)
+				mock.ExpectExec(regexp.QuoteMeta(deleteTechnologyQuery)).
+					WithArgs(fromID).
+					WillReturnResult(pgxmock.NewResult("DELETE", 0))
+				mock.ExpectRollback()
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				assert.True(t, IsNotFound(err))
+			},
+		},
+		{
+			name:    "error re-pointing job technologies rolls back",
+			fromID:  2,
+			toID:    1,
+			oldName: "golang",
+			mockSetup: func(mock pgxmock.PgxPoolIface, fromID, toID int, _ string) {
+				t.Helper()
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(mergeJobTechnologyFlagsQuery)).
+					WithArgs(fromID, toID).
+					WillReturnError(dbError)
+				mock.ExpectRollback()
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+		{
+			name:    "begin error",
+			fromID:  2,
+			toID:    1,
+			oldName: "golang",
+			mockSetup: func(mock pgxmock.PgxPoolIface, _, _ int, _ string) {
+				t.Helper()
+				mock.ExpectBegin().WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB, tt.fromID, tt.toID, tt.oldName)
+
+			err = repo.Merge(context.Background(), tt.fromID, tt.toID, tt.oldName)
+			tt.checkResults(t, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_ListUnused(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	since := now.AddDate(0, -6, 0)
+	lastJobAt := now.AddDate(-1, 0, 0)
+	dbError := errors.New("database error")
+	columns := []string{"id", "name", "category", "parent_id", "created_at", "max", "archived_at"}
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, usages []*Usage, err error)
+	}{
+		{
+			name: "technologies with their last job",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(listUnusedTechnologiesQuery)).
+					WithArgs(since).
+					WillReturnRows(pgxmock.NewRows(columns).
+						AddRow(3, "flash", "tool", nil, lastJobAt, nil, &now).
+						AddRow(2, "coffeescript", "programming", nil, lastJobAt, &lastJobAt, nil))
+			},
+			checkResults: func(t *testing.T, usages []*Usage, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, usages, 2)
+				assert.Equal(t, "flash", usages[0].Technology.Name)
+				assert.Nil(t, usages[0].LastJobAt)
+				assert.Equal(t, &now, usages[0].ArchivedAt)
+				assert.Equal(t, &lastJobAt, usages[1].LastJobAt)
+				assert.Nil(t, usages[1].ArchivedAt)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(listUnusedTechnologiesQuery)).WithArgs(since).WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ []*Usage, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			usages, err := repo.ListUnused(context.Background(), since)
+			tt.checkResults(t, usages, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_Archive(t *testing.T) {
+	t.Parallel()
+	since := time.Now().AddDate(0, -6, 0)
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, archived, restored int64, err error)
+	}{
+		{
+			name: "archives the unused technologies and restores the used ones",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(archiveUnusedTechnologiesQuery)).
+					WithArgs(since).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 3))
+				mock.ExpectExec(regexp.QuoteMeta(restoreUsedTechnologiesQuery)).
+					WithArgs(since).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+			checkResults: func(t *testing.T, archived, restored int64, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, int64(3), archived)
+				assert.Equal(t, int64(1), restored)
+			},
+		},
+		{
+			name: "restore error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(archiveUnusedTechnologiesQuery)).
+					WithArgs(since).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 3))
+				mock.ExpectExec(regexp.QuoteMeta(restoreUsedTechnologiesQuery)).
+					WithArgs(since).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, archived, _ int64, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+				assert.Equal(t, int64(3), archived)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			archived, restored, err := repo.Archive(context.Background(), since)
+			tt.checkResults(t, archived, restored, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,466 @@
+package technology
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+	"github.com/rodruizronald/ticos-in-tech/internal/techalias"
+)
+
+// DataRepository interface to make database operations for the Technology model.
+type DataRepository interface {
+	Create(ctx context.Context, tech *Technology) error
+	GetByID(ctx context.Context, id int) (*Technology, error)
+	GetByName(ctx context.Context, name string) (*Technology, error)
+	Update(ctx context.Context, tech *Technology) error
+	Merge(ctx context.Context, fromID, toID int, oldName string) error
+	GetByCompactName(ctx context.Context, compactName string) (*Technology, error)
+	FindMostSimilar(ctx context.Context, name string, minScore float64) (*Technology, float64, error)
+	ListWithAliases(ctx context.Context) ([]*Technology, error)
+	LoadDictionary(ctx context.Context) (*Dictionary, error)
+	ListUnused(ctx context.Context, since time.Time) ([]*Usage, error)
+	Archive(ctx context.Context, since time.Time) (archived, restored int64, err error)
+}
+
+// DefaultUnusedMonths is how many months a technology can go without being asked for by an active
+// job before it is archived
+const DefaultUnusedMonths = 6
+
+// maxUnusedMonths bounds the months of the unused technology report
+const maxUnusedMonths = 120
+
+// AliasRepository interface to make database operations for the TechnologyAlias model.
+type AliasRepository interface {
+	Create(ctx context.Context, alias *techalias.TechnologyAlias) error
+	GetByAlias(ctx context.Context, alias string) (*techalias.TechnologyAlias, error)
+	ListByTechnologyID(ctx context.Context, technologyID int) ([]*techalias.TechnologyAlias, error)
+	Delete(ctx context.Context, id int) error
+}
+
+// MatchMethod describes how a technology name was matched
+type MatchMethod string
+
+// Match methods, from the most to the least reliable
+const (
+	MatchExact      MatchMethod = "exact"
+	MatchNormalized MatchMethod = "normalized"
+	MatchSimilarity MatchMethod = "similarity"
+)
+
+// MatchOptions configures how FindMatch resolves names that don't match exactly
+type MatchOptions struct {
+	// Similarity enables the trigram similarity lookup
+	Similarity bool
+	// MinConfidence is the similarity score from which a match is accepted
+	MinConfidence float64
+	// MinCandidate is the lowest similarity score still returned as a candidate for review
+	MinCandidate float64
+}
+
+// Match is the result of resolving a technology name
+type Match struct {
+	Technology *Technology
+	Method     MatchMethod
+	// Score is 1 for exact and normalized matches, the similarity score otherwise
+	Score float64
+	// Confident is false when the match is only a candidate that needs review
+	Confident bool
+}
+
+// AliasConflict is an alias that can't be given to a technology because it already names another one
+type AliasConflict struct {
+	Alias        string
+	TechnologyID int
+}
+
+// AliasImport is the outcome of importing the aliases of a technology
+type AliasImport struct {
+	Created   []string
+	Unchanged []string
+	// Removed holds the aliases deleted because they were missing from a replacing import
+	Removed   []string
+	Conflicts []AliasConflict
+}
+
+// ArchiveResult is the outcome of archiving the unused technologies
+type ArchiveResult struct {
+	Archived int64
+	// Restored counts the archived technologies asked for again
+	Restored int64
+}
+
+// TechnologyService holds the business logic for technology management.
+// It is shared by the HTTP handlers and the CLI populators so both apply the same rules.
+type TechnologyService struct {
+	repo      DataRepository
+	aliasRepo AliasRepository
+	publisher EventPublisher
+	now       func() time.Time
+}
+
+// NewTechnologyService creates a new instance of TechnologyService. publisher is optional, it is
+// notified of the technologies created.
+func NewTechnologyService(repo DataRepository, aliasRepo AliasRepository, publisher EventPublisher) *TechnologyService {
+	return &TechnologyService{repo: repo, aliasRepo: aliasRepo, publisher: publisher, now: time.Now}
+}
+
+// NormalizeName converts a technology name or alias to its canonical stored form.
+func NormalizeName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// CreateWithAliases creates a technology together with its aliases.
+// If the technology already exists, the existing record is loaded into tech and the
+// aliases are attached to it. Aliases that already exist are skipped. It returns true
+// when a new technology was created, which is then published as an EventAdded.
+func (s *TechnologyService) CreateWithAliases(ctx context.Context, tech *Technology, aliases []string) (bool, error) {
+	tech.Name = NormalizeName(tech.Name)
+	if tech.Name == "" {
+		return false, &httpservice.ValidationError{Errors: []string{"technology name cannot be empty"}}
+	}
+
+	created := true
+	err := s.repo.Create(ctx, tech)
+	if err != nil {
+		if !IsDuplicate(err) {
+			return false, err
+		}
+
+		// Reuse the existing technology so aliases can still be attached
+		existing, getErr := s.repo.GetByName(ctx, tech.Name)
+		if getErr != nil {
+			return false, getErr
+		}
+		*tech = *existing
+		created = false
+	}
+
+	if err = s.AddAliases(ctx, tech.ID, aliases); err != nil {
+		return created, err
+	}
+	if created && s.publisher != nil {
+		return created, s.publisher.PublishTechnologyEvent(ctx, EventAdded, tech)
+	}
+	return created, nil
+}
+
+// AddAliases attaches aliases to a technology, skipping empty and already existing aliases.
+// All aliases are attempted; failures are returned together.
+func (s *TechnologyService) AddAliases(ctx context.Context, techID int, aliases []string) error {
+	var errs []error
+	for _, aliasName := range aliases {
+		aliasName = NormalizeName(aliasName)
+		if aliasName == "" {
+			continue
+		}
+
+		alias := &techalias.TechnologyAlias{
+			TechnologyID: techID,
+			Alias:        aliasName,
+		}
+		if err := s.aliasRepo.Create(ctx, alias); err != nil && !techalias.IsDuplicate(err) {
+			errs = append(errs, fmt.Errorf("alias %s: %w", aliasName, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// SetParent updates the parent of a technology in the hierarchy.
+// A nil parentID removes the parent. Self references and cycles are rejected.
+func (s *TechnologyService) SetParent(ctx context.Context, techID int, parentID *int) error {
+	tech, err := s.repo.GetByID(ctx, techID)
+	if err != nil {
+		return err
+	}
+
+	if parentID != nil {
+		if err = s.checkHierarchy(ctx, techID, *parentID); err != nil {
+			return err
+		}
+	}
+
+	tech.ParentID = parentID
+	return s.repo.Update(ctx, tech)
+}
+
+// checkHierarchy walks up the ancestors of parentID to make sure techID is not among them
+func (s *TechnologyService) checkHierarchy(ctx context.Context, techID, parentID int) error {
+	visited := map[int]bool{}
+	currentID := &parentID
+
+	for currentID != nil {
+		if *currentID == techID {
+			return &httpservice.ValidationError{
+				Errors: []string{fmt.Sprintf("technology %d cannot be its own ancestor", techID)},
+			}
+		}
+		if visited[*currentID] {
+			break // Existing cycle above us, nothing more to check
+		}
+		visited[*currentID] = true
+
+		ancestor, err := s.repo.GetByID(ctx, *currentID)
+		if err != nil {
+			return err
+		}
+		currentID = ancestor.ParentID
+	}
+
+	return nil
+}
+
+// CompactName reduces a technology name to the letters, digits, '+' and '#' of its
+// normalized form, so spelling variants like "Node JS" and "node.js" compare equal.
+func CompactName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '+' || r == '#' {
+			return r
+		}
+		return -1
+	}, NormalizeName(name))
+}
+
+// GetByID retrieves a technology by its ID.
+func (s *TechnologyService) GetByID(ctx context.Context, id int) (*Technology, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+// FindByNameOrAlias resolves a technology by its canonical name or one of its aliases.
+func (s *TechnologyService) FindByNameOrAlias(ctx context.Context, name string) (*Technology, error) {
+	name = NormalizeName(name)
+
+	tech, err := s.repo.GetByName(ctx, name)
+	if err == nil {
+		return tech, nil
+	}
+	if !IsNotFound(err) {
+		return nil, err
+	}
+
+	// If not found by exact name, try to find by alias
+	alias, err := s.aliasRepo.GetByAlias(ctx, name)
+	if err != nil {
+		if techalias.IsNotFound(err) {
+			return nil, &NotFoundError{Name: name}
+		}
+		return nil, err
+	}
+
+	return s.repo.GetByID(ctx, alias.TechnologyID)
+}
+
+// FindMatch resolves a technology name, falling back from an exact name or alias match to
+// a normalized match and, when enabled, to the most similar technology. Similar technologies
+// scoring below MinConfidence are returned as non confident matches so they can be reviewed.
+func (s *TechnologyService) FindMatch(ctx context.Context, name string, opts MatchOptions) (*Match, error) {
+	tech, err := s.FindByNameOrAlias(ctx, name)
+	if err == nil {
+		return &Match{Technology: tech, Method: MatchExact, Score: 1, Confident: true}, nil
+	}
+	if !IsNotFound(err) {
+		return nil, err
+	}
+
+	if compact := CompactName(name); compact != "" {
+		tech, err = s.repo.GetByCompactName(ctx, compact)
+		if err == nil {
+			return &Match{Technology: tech, Method: MatchNormalized, Score: 1, Confident: true}, nil
+		}
+		if !IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	return s.findSimilar(ctx, name, opts)
+}
+
+// LoadDictionary loads all the technologies with their aliases, to resolve many names with
+// FindMatchIn without a query per name
+func (s *TechnologyService) LoadDictionary(ctx context.Context) (*Dictionary, error) {
+	return s.repo.LoadDictionary(ctx)
+}
+
+// FindMatchIn resolves a technology name as FindMatch does, the exact and normalized matches being
+// looked up in dict. Only the names it doesn't know are looked up in the database, by similarity.
+func (s *TechnologyService) FindMatchIn(ctx context.Context, dict *Dictionary, name string, opts MatchOptions) (
+	*Match, error) {
+	if match, ok := dict.Match(name); ok {
+		return match, nil
+	}
+	return s.findSimilar(ctx, name, opts)
+}
+
+// findSimilar resolves a name matching no technology exactly to the most similar one, when enabled
+func (s *TechnologyService) findSimilar(ctx context.Context, name string, opts MatchOptions) (*Match, error) {
+	if !opts.Similarity {
+		return nil, &NotFoundError{Name: NormalizeName(name)}
+	}
+
+	tech, score, err := s.repo.FindMostSimilar(ctx, NormalizeName(name), opts.MinCandidate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Match{
+		Technology: tech,
+		Method:     MatchSimilarity,
+		Score:      score,
+		Confident:  score >= opts.MinConfidence,
+	}, nil
+}
+
+// Merge folds the duplicate technology fromID into the canonical technology toID.
+// Job associations, aliases and children are re-pointed to toID, the old name becomes
+// an alias of toID and the duplicate is deleted. It returns the canonical technology.
+func (s *TechnologyService) Merge(ctx context.Context, fromID, toID int) (*Technology, error) {
+	if fromID == toID {
+		return nil, &httpservice.ValidationError{Errors: []string{"cannot merge a technology into itself"}}
+	}
+
+	from, err := s.repo.GetByID(ctx, fromID)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := s.repo.GetByID(ctx, toID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.repo.Merge(ctx, from.ID, to.ID, from.Name); err != nil {
+		return nil, err
+	}
+
+	return to, nil
+}
+
+// ImportAliases gives a technology the aliases of a list maintained outside the database, e.g. in a
+// spreadsheet. Aliases are normalized, and the ones naming another technology, or aliasing it, are
+// reported as conflicts instead of being moved. With replace, the aliases of the technology missing
+// from the list are deleted, so the technology ends up with exactly the listed aliases.
+func (s *TechnologyService) ImportAliases(
+	ctx context.Context, techID int, aliases []string, replace bool,
+) (*AliasImport, error) {
+	tech, err := s.repo.GetByID(ctx, techID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &AliasImport{}
+	listed := map[string]bool{}
+	for _, aliasName := range aliases {
+		aliasName = NormalizeName(aliasName)
+		if aliasName == "" || listed[aliasName] {
+			continue
+		}
+		listed[aliasName] = true
+
+		if err = s.importAlias(ctx, tech, aliasName, result); err != nil {
+			return nil, err
+		}
+	}
+
+	if replace {
+		var existing []*techalias.TechnologyAlias
+		if existing, err = s.aliasRepo.ListByTechnologyID(ctx, tech.ID); err != nil {
+			return nil, err
+		}
+		for _, alias := range existing {
+			if listed[alias.Alias] {
+				continue
+			}
+			if err = s.aliasRepo.Delete(ctx, alias.ID); err != nil && !techalias.IsNotFound(err) {
+				return nil, err
+			}
+			result.Removed = append(result.Removed, alias.Alias)
+		}
+	}
+
+	return result, nil
+}
+
+// importAlias creates aliasName for tech unless it already names a technology, recording the
+// outcome in result
+func (s *TechnologyService) importAlias(ctx context.Context, tech *Technology, aliasName string, result *AliasImport) error {
+	if aliasName == tech.Name {
+		result.Unchanged = append(result.Unchanged, aliasName)
+		return nil
+	}
+
+	named, err := s.repo.GetByName(ctx, aliasName)
+	if err == nil {
+		result.Conflicts = append(result.Conflicts, AliasConflict{Alias: aliasName, TechnologyID: named.ID})
+		return nil
+	}
+	if !IsNotFound(err) {
+		return err
+	}
+
+	err = s.aliasRepo.Create(ctx, &techalias.TechnologyAlias{TechnologyID: tech.ID, Alias: aliasName})
+	if err == nil {
+		result.Created = append(result.Created, aliasName)
+		return nil
+	}
+	if !techalias.IsDuplicate(err) {
+		return fmt.Errorf("alias %s: %w", aliasName, err)
+	}
+
+	existing, err := s.aliasRepo.GetByAlias(ctx, aliasName)
+	if err != nil {
+		return err
+	}
+	if existing.TechnologyID == tech.ID {
+		result.Unchanged = append(result.Unchanged, aliasName)
+	} else {
+		result.Conflicts = append(result.Conflicts, AliasConflict{Alias: aliasName, TechnologyID: existing.TechnologyID})
+	}
+	return nil
+}
+
+// ListWithAliases retrieves all the technologies including their aliases, by name.
+func (s *TechnologyService) ListWithAliases(ctx context.Context) ([]*Technology, error) {
+	return s.repo.ListWithAliases(ctx)
+}
+
+// ListUnused retrieves the technologies no active job posted in the last months asks for, the least
+// recently used first. Technologies added in the meantime are left out.
+func (s *TechnologyService) ListUnused(ctx context.Context, months int) ([]*Usage, error) {
+	since, err := s.unusedSince(months)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListUnused(ctx, since)
+}
+
+// ArchiveUnused archives the technologies no active job posted in the last months asks for, so
+// they are no longer suggested to searches, keeping their job associations. The archived
+// technologies asked for again are restored.
+func (s *TechnologyService) ArchiveUnused(ctx context.Context, months int) (*ArchiveResult, error) {
+	since, err := s.unusedSince(months)
+	if err != nil {
+		return nil, err
+	}
+	archived, restored, err := s.repo.Archive(ctx, since)
+	if err != nil {
+		return nil, err
+	}
+	return &ArchiveResult{Archived: archived, Restored: restored}, nil
+}
+
+// unusedSince returns the start of the last months, defaulting to DefaultUnusedMonths
+func (s *TechnologyService) unusedSince(months int) (time.Time, error) {
+	if months == 0 {
+		months = DefaultUnusedMonths
+	}
+	if months < 0 || months > maxUnusedMonths {
+		return time.Time{}, &httpservice.ValidationError{
+			Errors: []string{fmt.Sprintf("months must be between 1 and %d", maxUnusedMonths)},
+		}
+	}
+	return s.now().UTC().AddDate(0, -months, 0), nil
+}
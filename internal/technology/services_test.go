@@ -0,0 +1,771 @@
+package technology
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+	"github.com/rodruizronald/ticos-in-tech/internal/techalias"
+)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func TestTechnologyService_CreateWithAliases(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		tech         *Technology
+		aliases      []string
+		mockSetup    func(mockRepo *MockDataRepository, mockAlias *MockAliasRepository, mockPublisher *MockEventPublisher)
+		checkResults func(t *testing.T, tech *Technology, created bool, err error)
+	}{
+		{
+			name:    "new technology with aliases",
+			tech:    &Technology{Name: "JavaScript", Category: "programming"},
+			aliases: []string{"JS", "", "ecmascript"},
+			mockSetup: func(mockRepo *MockDataRepository, mockAlias *MockAliasRepository, mockPublisher *MockEventPublisher) {
+				t.Helper()
+				mockRepo.EXPECT().Create(context.Background(), mock.AnythingOfType("*technology.Technology")).
+					Run(func(_ context.Context, tech *Technology) { tech.ID = 1 }).
+					Return(nil).Once()
+				mockAlias.EXPECT().Create(context.Background(), &techalias.TechnologyAlias{TechnologyID: 1, Alias: "js"}).
+					Return(nil).Once()
+				mockAlias.EXPECT().Create(context.Background(),
+					&techalias.TechnologyAlias{TechnologyID: 1, Alias: "ecmascript"}).
+					Return(&techalias.DuplicateError{Alias: "ecmascript"}).Once()
+				mockPublisher.EXPECT().PublishTechnologyEvent(context.Background(), EventAdded,
+					mock.MatchedBy(func(tech *Technology) bool { return tech.ID == 1 })).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, tech *Technology, created bool, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.True(t, created)
+				assert.Equal(t, 1, tech.ID)
+				assert.Equal(t, "javascript", tech.Name)
+			},
+		},
+		{
+			name:    "existing technology is reused",
+			tech:    &Technology{Name: "Go", Category: "programming"},
+			aliases: []string{"golang"},
+			mockSetup: func(mockRepo *MockDataRepository, mockAlias *MockAliasRepository, _ *MockEventPublisher) {
+				t.Helper()
+				mockRepo.EXPECT().Create(context.Background(), mock.Anything).
+					Return(&DuplicateError{Name: "go"}).Once()
+				mockRepo.EXPECT().GetByName(context.Background(), "go").
+					Return(&Technology{ID: 7, Name: "go", Category: "programming"}, nil).Once()
+				mockAlias.EXPECT().Create(context.Background(), &techalias.TechnologyAlias{TechnologyID: 7, Alias: "golang"}).
+					Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, tech *Technology, created bool, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.False(t, created)
+				assert.Equal(t, 7, tech.ID)
+			},
+		},
+		{
+			name:      "empty name",
+			tech:      &Technology{Name: "  "},
+			mockSetup: func(_ *MockDataRepository, _ *MockAliasRepository, _ *MockEventPublisher) {},
+			checkResults: func(t *testing.T, _ *Technology, created bool, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.False(t, created)
+			},
+		},
+		{
+			name:    "alias creation error is returned",
+			tech:    &Technology{Name: "python", Category: "programming"},
+			aliases: []string{"py"},
+			mockSetup: func(mockRepo *MockDataRepository, mockAlias *MockAliasRepository, _ *MockEventPublisher) {
+				t.Helper()
+				mockRepo.EXPECT().Create(context.Background(), mock.Anything).
+					Run(func(_ context.Context, tech *Technology) { tech.ID = 3 }).
+					Return(nil).Once()
+				mockAlias.EXPECT().Create(context.Background(), mock.Anything).Return(dbError).Once()
+			},
+			checkResults: func(t *testing.T, tech *Technology, created bool, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+				assert.True(t, created)
+				assert.Equal(t, 3, tech.ID)
+			},
+		},
+		{
+			name:    "publish error is returned",
+			tech:    &Technology{Name: "rust", Category: "programming"},
+			aliases: nil,
+			mockSetup: func(mockRepo *MockDataRepository, _ *MockAliasRepository, mockPublisher *MockEventPublisher) {
+				t.Helper()
+				mockRepo.EXPECT().Create(context.Background(), mock.Anything).
+					Run(func(_ context.Context, tech *Technology) { tech.ID = 4 }).
+					Return(nil).Once()
+				mockPublisher.EXPECT().PublishTechnologyEvent(context.Background(), EventAdded, mock.Anything).
+					Return(dbError).Once()
+			},
+			checkResults: func(t *testing.T, _ *Technology, created bool, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+				assert.True(t, created)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			mockAlias := NewMockAliasRepository(t)
+			mockPublisher := NewMockEventPublisher(t)
+			service := NewTechnologyService(mockRepo, mockAlias, mockPublisher)
+
+			tt.mockSetup(mockRepo, mockAlias, mockPublisher)
+
+			created, err := service.CreateWithAliases(context.Background(), tt.tech, tt.aliases)
+			tt.checkResults(t, tt.tech, created, err)
+		})
+	}
+}
+
+func TestTechnologyService_SetParent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		techID       int
+		parentID     *int
+		mockSetup    func(mockRepo *MockDataRepository)
+		checkResults func(t *testing.T, err error)
+	}{
+		{
+			name:     "set parent",
+			techID:   2,
+			parentID: intPtr(1),
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetByID(context.Background(), 2).
+					Return(&Technology{ID: 2, Name: "react"}, nil).Once()
+				mockRepo.EXPECT().GetByID(context.Background(), 1).
+					Return(&Technology{ID: 1, Name: "javascript"}, nil).Once()
+				mockRepo.EXPECT().Update(context.Background(), &Technology{ID: 2, Name: "react", ParentID: intPtr(1)}).
+					Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:     "self reference",
+			techID:   2,
+			parentID: intPtr(2),
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetByID(context.Background(), 2).
+					Return(&Technology{ID: 2, Name: "react"}, nil).Once()
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+			},
+		},
+		{
+			name:     "cycle through ancestor",
+			techID:   1,
+			parentID: intPtr(3),
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetByID(context.Background(), 1).
+					Return(&Technology{ID: 1, Name: "javascript"}, nil).Once()
+				mockRepo.EXPECT().GetByID(context.Background(), 3).
+					Return(&Technology{ID: 3, Name: "next.js", ParentID: intPtr(2)}, nil).Once()
+				mockRepo.EXPECT().GetByID(context.Background(), 2).
+					Return(&Technology{ID: 2, Name: "react", ParentID: intPtr(1)}, nil).Once()
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+			},
+		},
+		{
+			name:     "technology not found",
+			techID:   9,
+			parentID: nil,
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetByID(context.Background(), 9).
+					Return(nil, &NotFoundError{ID: 9}).Once()
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				assert.True(t, IsNotFound(err))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			service := NewTechnologyService(mockRepo, NewMockAliasRepository(t), nil)
+
+			tt.mockSetup(mockRepo)
+
+			err := service.SetParent(context.Background(), tt.techID, tt.parentID)
+			tt.checkResults(t, err)
+		})
+	}
+}
+
+func TestTechnologyService_FindByNameOrAlias(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		input        string
+		mockSetup    func(mockRepo *MockDataRepository, mockAlias *MockAliasRepository)
+		checkResults func(t *testing.T, tech *Technology, err error)
+	}{
+		{
+			name:  "found by name",
+			input: "Go",
+			mockSetup: func(mockRepo *MockDataRepository, _ *MockAliasRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetByName(context.Background(), "go").
+					Return(&Technology{ID: 1, Name: "go"}, nil).Once()
+			},
+			checkResults: func(t *testing.T, tech *Technology, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 1, tech.ID)
+			},
+		},
+		{
+			name:  "found by alias",
+			input: "golang",
+			mockSetup: func(mockRepo *MockDataRepository, mockAlias *MockAliasRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetByName(context.Background(), "golang").
+					Return(nil, &NotFoundError{Name: "golang"}).Once()
+				mockAlias.EXPECT().GetByAlias(context.Background(), "golang").
+					Return(&techalias.TechnologyAlias{ID: 4, TechnologyID: 1, Alias: "golang"}, nil).Once()
+				mockRepo.EXPECT().GetByID(context.Background(), 1).
+					Return(&Technology{ID: 1, Name: "go"}, nil).Once()
+			},
+			checkResults: func(t *testing.T, tech *Technology, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, "go", tech.Name)
+			},
+		},
+		{
+			name:  "not found",
+			input: "cobol",
+			mockSetup: func(mockRepo *MockDataRepository, mockAlias *MockAliasRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetByName(context.Background(), "cobol").
+					Return(nil, &NotFoundError{Name: "cobol"}).Once()
+				mockAlias.EXPECT().GetByAlias(context.Background(), "cobol").
+					Return(nil, &techalias.NotFoundError{Alias: "cobol"}).Once()
+			},
+			checkResults: func(t *testing.T, _ *Technology, err error) {
+				t.Helper()
+				assert.True(t, IsNotFound(err))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			mockAlias := NewMockAliasRepository(t)
+			service := NewTechnologyService(mockRepo, mockAlias, nil)
+
+			tt.mockSetup(mockRepo, mockAlias)
+
+			tech, err := service.FindByNameOrAlias(context.Background(), tt.input)
+			tt.checkResults(t, tech, err)
+		})
+	}
+}
+
+func TestCompactName(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"Node JS":    "nodejs",
+		"node.js":    "nodejs",
+		" C++ ":      "c++",
+		"C#":         "c#",
+		"ASP.NET":    "aspnet",
+		"...":        "",
+		"Vue-Router": "vuerouter",
+	}
+
+	for input, expected := range tests {
+		assert.Equal(t, expected, CompactName(input), input)
+	}
+}
+
+func TestTechnologyService_FindMatch(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+	opts := MatchOptions{Similarity: true, MinConfidence: 0.6, MinCandidate: 0.3}
+
+	// expectNoExactMatch sets up the exact name and alias lookups to miss
+	expectNoExactMatch := func(mockRepo *MockDataRepository, mockAlias *MockAliasRepository, name string) {
+		mockRepo.EXPECT().GetByName(context.Background(), name).
+			Return(nil, &NotFoundError{Name: name}).Once()
+		mockAlias.EXPECT().GetByAlias(context.Background(), name).
+			Return(nil, &techalias.NotFoundError{Alias: name}).Once()
+	}
+
+	tests := []struct {
+		name         string
+		input        string
+		opts         MatchOptions
+		mockSetup    func(mockRepo *MockDataRepository, mockAlias *MockAliasRepository)
+		checkResults func(t *testing.T, match *Match, err error)
+	}{
+		{
+			name:  "exact match",
+			input: "Go",
+			opts:  opts,
+			mockSetup: func(mockRepo *MockDataRepository, _ *MockAliasRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetByName(context.Background(), "go").
+					Return(&Technology{ID: 1, Name: "go"}, nil).Once()
+			},
+			checkResults: func(t *testing.T, match *Match, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, MatchExact, match.Method)
+				assert.True(t, match.Confident)
+			},
+		},
+		{
+			name:  "normalized match",
+			input: "Node JS",
+			opts:  opts,
+			mockSetup: func(mockRepo *MockDataRepository, mockAlias *MockAliasRepository) {
+				t.Helper()
+				expectNoExactMatch(mockRepo, mockAlias, "node js")
+				mockRepo.EXPECT().GetByCompactName(context.Background(), "nodejs").
+					Return(&Technology{ID: 2, Name: "node.js"}, nil).Once()
+			},
+			checkResults: func(t *testing.T, match *Match, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, MatchNormalized, match.Method)
+				assert.Equal(t, 2, match.Technology.ID)
+				assert.True(t, match.Confident)
+			},
+		},
+		{
+			name:  "confident similarity match",
+			input: "postgress",
+			opts:  opts,
+			mockSetup: func(mockRepo *MockDataRepository, mockAlias *MockAliasRepository) {
+				t.Helper()
+				expectNoExactMatch(mockRepo, mockAlias, "postgress")
+				mockRepo.EXPECT().GetByCompactName(context.Background(), "postgress").
+					Return(nil, &NotFoundError{Name: "postgress"}).Once()
+				mockRepo.EXPECT().FindMostSimilar(context.Background(), "postgress", 0.3).
+					Return(&Technology{ID: 4, Name: "postgresql"}, 0.7, nil).Once()
+			},
+			checkResults: func(t *testing.T, match *Match, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, MatchSimilarity, match.Method)
+				assert.True(t, match.Confident)
+			},
+		},
+		{
+			name:  "low confidence similarity match",
+			input: "htmlx",
+			opts:  opts,
+			mockSetup: func(mockRepo *MockDataRepository, mockAlias *MockAliasRepository) {
+				t.Helper()
+				expectNoExactMatch(mockRepo, mockAlias, "htmlx")
+				mockRepo.EXPECT().GetByCompactName(context.Background(), "htmlx").
+					Return(nil, &NotFoundError{Name: "htmlx"}).Once()
+				mockRepo.EXPECT().FindMostSimilar(context.Background(), "htmlx", 0.3).
+					Return(&Technology{ID: 5, Name: "html"}, 0.4, nil).Once()
+			},
+			checkResults: func(t *testing.T, match *Match, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 5, match.Technology.ID)
+				assert.False(t, match.Confident)
+			},
+		},
+		{
+			name:  "similarity disabled",
+			input: "htmlx",
+			opts:  MatchOptions{},
+			mockSetup: func(mockRepo *MockDataRepository, mockAlias *MockAliasRepository) {
+				t.Helper()
+				expectNoExactMatch(mockRepo, mockAlias, "htmlx")
+				mockRepo.EXPECT().GetByCompactName(context.Background(), "htmlx").
+					Return(nil, &NotFoundError{Name: "htmlx"}).Once()
+			},
+			checkResults: func(t *testing.T, _ *Match, err error) {
+				t.Helper()
+				assert.True(t, IsNotFound(err))
+			},
+		},
+		{
+			name:  "normalized lookup error",
+			input: "htmlx",
+			opts:  opts,
+			mockSetup: func(mockRepo *MockDataRepository, mockAlias *MockAliasRepository) {
+				t.Helper()
+				expectNoExactMatch(mockRepo, mockAlias, "htmlx")
+				mockRepo.EXPECT().GetByCompactName(context.Background(), "htmlx").Return(nil, dbError).Once()
+			},
+			checkResults: func(t *testing.T, _ *Match, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			mockAlias := NewMockAliasRepository(t)
+			service := NewTechnologyService(mockRepo, mockAlias, nil)
+
+			tt.mockSetup(mockRepo, mockAlias)
+
+			match, err := service.FindMatch(context.Background(), tt.input, tt.opts)
+			tt.checkResults(t, match, err)
+		})
+	}
+}
+
+func TestTechnologyService_FindMatchIn(t *testing.T) {
+	t.Parallel()
+	opts := MatchOptions{Similarity: true, MinConfidence: 0.6, MinCandidate: 0.3}
+	golang := &Technology{ID: 1, Name: "go", Aliases: []techalias.TechnologyAlias{{Alias: "golang"}}}
+	dict := NewDictionary([]*Technology{golang})
+
+	mockRepo := NewMockDataRepository(t)
+	service := NewTechnologyService(mockRepo, NewMockAliasRepository(t), nil)
+
+	// Names of the dictionary are matched without a query
+	match, err := service.FindMatchIn(context.Background(), dict, "Golang", opts)
+	require.NoError(t, err)
+	assert.Same(t, golang, match.Technology)
+
+	mockRepo.EXPECT().FindMostSimilar(context.Background(), "golangg", 0.3).Return(golang, 0.7, nil).Once()
+	match, err = service.FindMatchIn(context.Background(), dict, "golangg", opts)
+	require.NoError(t, err)
+	assert.Equal(t, MatchSimilarity, match.Method)
+	assert.True(t, match.Confident)
+
+	_, err = service.FindMatchIn(context.Background(), dict, "rust", MatchOptions{})
+	assert.True(t, IsNotFound(err))
+}
+
+func TestTechnologyService_Merge(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		fromID       int
+		toID         int
+		mockSetup    func(mockRepo *MockDataRepository)
+		checkResults func(t *testing.T, tech *Technology, err error)
+	}{
+		{
+			name:   "successful merge",
+			fromID: 2,
+			toID:   1,
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetByID(context.Background(), 2).
+					Return(&Technology{ID: 2, Name: "golang"}, nil).Once()
+				mockRepo.EXPECT().GetByID(context.Background(), 1).
+					Return(&Technology{ID: 1, Name: "go"}, nil).Once()
+				mockRepo.EXPECT().Merge(context.Background(), 2, 1, "golang").Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, tech *Technology, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 1, tech.ID)
+			},
+		},
+		{
+			name:      "merge into itself",
+			fromID:    1,
+			toID:      1,
+			mockSetup: func(_ *MockDataRepository) {},
+			checkResults: func(t *testing.T, _ *Technology, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+			},
+		},
+		{
+			name:   "canonical technology not found",
+			fromID: 2,
+			toID:   99,
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetByID(context.Background(), 2).
+					Return(&Technology{ID: 2, Name: "golang"}, nil).Once()
+				mockRepo.EXPECT().GetByID(context.Background(), 99).
+					Return(nil, &NotFoundError{ID: 99}).Once()
+			},
+			checkResults: func(t *testing.T, _ *Technology, err error) {
+				t.Helper()
+				assert.True(t, IsNotFound(err))
+			},
+		},
+		{
+			name:   "merge error",
+			fromID: 2,
+			toID:   1,
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetByID(context.Background(), 2).
+					Return(&Technology{ID: 2, Name: "golang"}, nil).Once()
+				mockRepo.EXPECT().GetByID(context.Background(), 1).
+					Return(&Technology{ID: 1, Name: "go"}, nil).Once()
+				mockRepo.EXPECT().Merge(context.Background(), 2, 1, "golang").Return(dbError).Once()
+			},
+			checkResults: func(t *testing.T, _ *Technology, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			service := NewTechnologyService(mockRepo, NewMockAliasRepository(t), nil)
+
+			tt.mockSetup(mockRepo)
+
+			tech, err := service.Merge(context.Background(), tt.fromID, tt.toID)
+			tt.checkResults(t, tech, err)
+		})
+	}
+}
+
+func TestTechnologyService_ImportAliases(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+	goTech := &Technology{ID: 1, Name: "go"}
+
+	tests := []struct {
+		name         string
+		aliases      []string
+		replace      bool
+		mockSetup    func(mockRepo *MockDataRepository, mockAlias *MockAliasRepository)
+		checkResults func(t *testing.T, result *AliasImport, err error)
+	}{
+		{
+			name:    "aliases created, kept and in conflict",
+			aliases: []string{" Golang ", "golang", "", "go", "go-lang", "javascript", "js"},
+			mockSetup: func(mockRepo *MockDataRepository, mockAlias *MockAliasRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetByID(context.Background(), 1).Return(goTech, nil).Once()
+				mockRepo.EXPECT().GetByName(context.Background(), mock.Anything).
+					RunAndReturn(func(_ context.Context, name string) (*Technology, error) {
+						if name == "javascript" {
+							return &Technology{ID: 7, Name: name}, nil
+						}
+						return nil, &NotFoundError{Name: name}
+					}).Times(4)
+				mockAlias.EXPECT().Create(context.Background(), &techalias.TechnologyAlias{TechnologyID: 1, Alias: "golang"}).
+					Return(nil).Once()
+				mockAlias.EXPECT().Create(context.Background(), &techalias.TechnologyAlias{TechnologyID: 1, Alias: "go-lang"}).
+					Return(&techalias.DuplicateError{Alias: "go-lang"}).Once()
+				mockAlias.EXPECT().GetByAlias(context.Background(), "go-lang").
+					Return(&techalias.TechnologyAlias{TechnologyID: 1, Alias: "go-lang"}, nil).Once()
+				mockAlias.EXPECT().Create(context.Background(), &techalias.TechnologyAlias{TechnologyID: 1, Alias: "js"}).
+					Return(&techalias.DuplicateError{Alias: "js"}).Once()
+				mockAlias.EXPECT().GetByAlias(context.Background(), "js").
+					Return(&techalias.TechnologyAlias{TechnologyID: 7, Alias: "js"}, nil).Once()
+			},
+			checkResults: func(t *testing.T, result *AliasImport, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, []string{"golang"}, result.Created)
+				assert.Equal(t, []string{"go", "go-lang"}, result.Unchanged)
+				assert.Empty(t, result.Removed)
+				assert.Equal(t, []AliasConflict{
+					{Alias: "javascript", TechnologyID: 7},
+					{Alias: "js", TechnologyID: 7},
+				}, result.Conflicts)
+			},
+		},
+		{
+			name:    "replace deletes the missing aliases",
+			aliases: []string{"golang"},
+			replace: true,
+			mockSetup: func(mockRepo *MockDataRepository, mockAlias *MockAliasRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetByID(context.Background(), 1).Return(goTech, nil).Once()
+				mockRepo.EXPECT().GetByName(context.Background(), "golang").
+					Return(nil, &NotFoundError{Name: "golang"}).Once()
+				mockAlias.EXPECT().Create(context.Background(), &techalias.TechnologyAlias{TechnologyID: 1, Alias: "golang"}).
+					Return(&techalias.DuplicateError{Alias: "golang"}).Once()
+				mockAlias.EXPECT().GetByAlias(context.Background(), "golang").
+					Return(&techalias.TechnologyAlias{ID: 10, TechnologyID: 1, Alias: "golang"}, nil).Once()
+				mockAlias.EXPECT().ListByTechnologyID(context.Background(), 1).Return([]*techalias.TechnologyAlias{
+					{ID: 10, TechnologyID: 1, Alias: "golang"},
+					{ID: 11, TechnologyID: 1, Alias: "go language"},
+				}, nil).Once()
+				mockAlias.EXPECT().Delete(context.Background(), 11).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, result *AliasImport, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Empty(t, result.Created)
+				assert.Equal(t, []string{"golang"}, result.Unchanged)
+				assert.Equal(t, []string{"go language"}, result.Removed)
+			},
+		},
+		{
+			name:    "technology not found",
+			aliases: []string{"golang"},
+			mockSetup: func(mockRepo *MockDataRepository, _ *MockAliasRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetByID(context.Background(), 1).Return(nil, &NotFoundError{ID: 1}).Once()
+			},
+			checkResults: func(t *testing.T, _ *AliasImport, err error) {
+				t.Helper()
+				assert.True(t, IsNotFound(err))
+			},
+		},
+		{
+			name:    "alias creation error",
+			aliases: []string{"golang"},
+			mockSetup: func(mockRepo *MockDataRepository, mockAlias *MockAliasRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetByID(context.Background(), 1).Return(goTech, nil).Once()
+				mockRepo.EXPECT().GetByName(context.Background(), "golang").
+					Return(nil, &NotFoundError{Name: "golang"}).Once()
+				mockAlias.EXPECT().Create(context.Background(), &techalias.TechnologyAlias{TechnologyID: 1, Alias: "golang"}).
+					Return(dbError).Once()
+			},
+			checkResults: func(t *testing.T, _ *AliasImport, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			mockAlias := NewMockAliasRepository(t)
+			service := NewTechnologyService(mockRepo, mockAlias, nil)
+
+			tt.mockSetup(mockRepo, mockAlias)
+
+			result, err := service.ImportAliases(context.Background(), 1, tt.aliases, tt.replace)
+			tt.checkResults(t, result, err)
+		})
+	}
+}
+
+func TestTechnologyService_ArchiveUnused(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 7, 15, 3, 0, 0, 0, time.UTC)
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		months       int
+		mockSetup    func(mockRepo *MockDataRepository)
+		checkResults func(t *testing.T, result *ArchiveResult, err error)
+	}{
+		{
+			name:   "archives the technologies unused in the last months",
+			months: 3,
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().Archive(context.Background(), time.Date(2024, 4, 15, 3, 0, 0, 0, time.UTC)).
+					Return(4, 1, nil).Once()
+			},
+			checkResults: func(t *testing.T, result *ArchiveResult, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, &ArchiveResult{Archived: 4, Restored: 1}, result)
+			},
+		},
+		{
+			name: "defaults to the default months",
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().Archive(context.Background(), time.Date(2024, 1, 15, 3, 0, 0, 0, time.UTC)).
+					Return(0, 0, nil).Once()
+			},
+			checkResults: func(t *testing.T, result *ArchiveResult, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, &ArchiveResult{}, result)
+			},
+		},
+		{
+			name:      "invalid months",
+			months:    -1,
+			mockSetup: func(_ *MockDataRepository) {},
+			checkResults: func(t *testing.T, _ *ArchiveResult, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+			},
+		},
+		{
+			name:   "archive error",
+			months: 6,
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().Archive(context.Background(), mock.Anything).Return(0, 0, dbError).Once()
+			},
+			checkResults: func(t *testing.T, _ *ArchiveResult, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			service := NewTechnologyService(mockRepo, NewMockAliasRepository(t), nil)
+			service.now = func() time.Time { return now }
+
+			tt.mockSetup(mockRepo)
+
+			result, err := service.ArchiveUnused(context.Background(), tt.months)
+			tt.checkResults(t, result, err)
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package testdb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/seed"
+)
+
+// jobCount numbers the application URLs and signatures of the job fixtures
+var jobCount atomic.Int64
+
+// Job holds the fields of a job fixture. Empty fields get a default value.
+type Job struct {
+	CompanyID       int
+	Title           string
+	Description     string
+	ExperienceLevel string
+	EmploymentType  string
+	// Location is the region, "Costa Rica" or "LATAM"
+	Location string
+	// Province locates the job in a province of Costa Rica
+	Province          string
+	WorkMode          string
+	Language          string
+	RemoteEligibility string
+	UTCOffsetMin      *int
+	UTCOffsetMax      *int
+	// Benefits are slugs of the seeded benefits
+	Benefits []string
+	Inactive bool
+	Featured bool
+	// CanonicalJobID makes the job an alternate of another one
+	CanonicalJobID *int
+	// CreatedAt defaults to now
+	CreatedAt time.Time
+}
+
+// InsertCompany stores an active company and returns its ID. Its slug is its name in kebab case.
+func InsertCompany(t testing.TB, db *pgxpool.Pool, name string) int {
+	t.Helper()
+	slug := strings.ToLower(strings.Join(strings.Fields(name), "-"))
+
+	var id int
+	err := db.QueryRow(context.Background(), `
+        INSERT INTO companies (name, slug, logo_url, is_active)
+        VALUES ($1, $2, $3, true)
+        RETURNING id
+    `, name, slug, "https://"+slug+".com/logo.png").Scan(&id)
+	if err != nil {
+		t.Fatalf("failed to insert company %s: %v", name, err)
+	}
+	return id
+}
+
+// InsertTechnology stores a technology and returns its ID
+func InsertTechnology(t testing.TB, db *pgxpool.Pool, name, category string) int {
+	t.Helper()
+
+	var id int
+	err := db.QueryRow(context.Background(),
+		`INSERT INTO technologies (name, category) VALUES ($1, $2) RETURNING id`, name, category).Scan(&id)
+	if err != nil {
+		t.Fatalf("failed to insert technology %s: %v", name, err)
+	}
+	return id
+}
+
+// InsertJob stores a published job and its benefits, and returns its ID
+func InsertJob(t testing.TB, db *pgxpool.Pool, job *Job) int {
+	t.Helper()
+	ctx := context.Background()
+	j := withDefaults(job)
+	n := jobCount.Add(1)
+
+	var id int
+	err := db.QueryRow(ctx, `
+        INSERT INTO jobs (
+            company_id, title, description, experience_level, employment_type, location, work_mode,
+            application_url, signature, is_active, language, remote_eligibility, utc_offset_min,
+            utc_offset_max, location_id, created_at, is_featured, canonical_job_id
+        ) VALUES (
+            $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, NULLIF($12, ''), $13, $14,
+            (SELECT id FROM locations WHERE region = $6 AND province = $15 AND city = ''), $16, $17, $18
+        )
+        RETURNING id
+    `, j.CompanyID, j.Title, j.Description, j.ExperienceLevel, j.EmploymentType, j.Location, j.WorkMode,
+		fmt.Sprintf("https://jobs.example.com/%d", n), fmt.Sprintf("%064d", n), !j.Inactive, j.Language,
+		j.RemoteEligibility, j.UTCOffsetMin, j.UTCOffsetMax, j.Province, j.CreatedAt, j.Featured,
+		j.CanonicalJobID,
+	).Scan(&id)
+	if err != nil {
+		t.Fatalf("failed to insert job %s: %v", j.Title, err)
+	}
+
+	for _, benefit := range j.Benefits {
+		_, err = db.Exec(ctx, `
+            INSERT INTO job_benefits (job_id, benefit_id)
+            SELECT $1, id FROM benefits WHERE slug = $2
+        `, id, benefit)
+		if err != nil {
+			t.Fatalf("failed to add benefit %s to job %d: %v", benefit, id, err)
+		}
+	}
+
+	return id
+}
+
+// AddJobTechnology associates a technology with a job
+func AddJobTechnology(t testing.TB, db *pgxpool.Pool, jobID, technologyID int, required bool) {
+	t.Helper()
+
+	_, err := db.Exec(context.Background(),
+		`INSERT INTO job_technologies (job_id, technology_id, is_required) VALUES ($1, $2, $3)`,
+		jobID, technologyID, required)
+	if err != nil {
+		t.Fatalf("failed to add technology %d to job %d: %v", technologyID, jobID, err)
+	}
+}
+
+// AddPrimaryJobTechnology associates a technology with a job as a required, primary one
+func AddPrimaryJobTechnology(t testing.TB, db *pgxpool.Pool, jobID, technologyID int) {
+	t.Helper()
+
+	_, err := db.Exec(context.Background(),
+		`INSERT INTO job_technologies (job_id, technology_id, is_primary, is_required) VALUES ($1, $2, true, true)`,
+		jobID, technologyID)
+	if err != nil {
+		t.Fatalf("failed to add primary technology %d to job %d: %v", technologyID, jobID, err)
+	}
+}
+
+// withDefaults returns a copy of job with its empty fields set
+func withDefaults(job *Job) Job {
+	j := *job
+	defaults := []struct {
+		field *string
+		value string
+	}{
+		{&j.Title, "Software Engineer"},
+		{&j.Description, "Build and maintain our services."},
+		{&j.ExperienceLevel, "Mid-level"},
+		{&j.EmploymentType, "Full-time"},
+		{&j.Location, "Costa Rica"},
+		{&j.WorkMode, "Remote"},
+		{&j.Language, "en"},
+	}
+	for _, d := range defaults {
+		if *d.field == "" {
+			*d.field = d.value
+		}
+	}
+	if j.CreatedAt.IsZero() {
+		j.CreatedAt = time.Now()
+	}
+	return j
+}
+
+// Seed loads the default dataset of the seed package and refreshes the job search view
+func Seed(t testing.TB, db *pgxpool.Pool) *seed.Summary {
+	t.Helper()
+	ctx := context.Background()
+
+	dataset, err := seed.Default()
+	if err != nil {
+		t.Fatalf("failed to read the seed dataset: %v", err)
+	}
+	summary, err := seed.Load(ctx, db, dataset, time.Now())
+	if err != nil {
+		t.Fatalf("failed to seed the database: %v", err)
+	}
+	if _, err = db.Exec(ctx, "REFRESH MATERIALIZED VIEW job_search_view"); err != nil {
+		t.Fatalf("failed to refresh the job search view: %v", err)
+	}
+	return summary
+}
@@ -0,0 +1,192 @@
+// Package testdb runs repository tests against a real PostgreSQL server, catching the SQL errors
+// that mocked pools can't. The server runs in a Docker container started with testcontainers once
+// per test binary, and every test gets its own database created from a migrated template.
+// Tests using it are skipped when Docker is not available.
+package testdb
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+// Settings of the test server
+const (
+	// Image is the PostgreSQL image the tests run against
+	Image = "postgres:16-alpine"
+
+	// templateDatabase holds the migrated schema copied into the database of every test
+	templateDatabase = "ticos_template"
+
+	startupTimeout = 2 * time.Minute
+)
+
+var (
+	serverOnce sync.Once
+	shared     *server
+	serverErr  error
+
+	databaseCount atomic.Int64
+)
+
+// server is the PostgreSQL container shared by the tests of a test binary
+type server struct {
+	config *pgxpool.Config
+}
+
+// New returns a pool on a new database holding the migrated schema, dropped when the test or
+// benchmark ends. It is skipped when Docker is not available.
+func New(t testing.TB) *pgxpool.Pool {
+	t.Helper()
+	skipWithoutDocker(t)
+
+	serverOnce.Do(func() {
+		shared, serverErr = startServer(context.Background())
+	})
+	if serverErr != nil {
+		t.Fatalf("failed to start test database: %v", serverErr)
+	}
+
+	ctx := context.Background()
+	name := fmt.Sprintf("test_%d", databaseCount.Add(1))
+	if err := shared.exec(ctx, "CREATE DATABASE "+pgx.Identifier{name}.Sanitize()+
+		" TEMPLATE "+templateDatabase); err != nil {
+		t.Fatalf("failed to create test database: %v", err)
+	}
+
+	config := shared.config.Copy()
+	config.ConnConfig.Database = name
+	pool, err := pgxpool.NewWithConfig(ctx, config)
+	if err != nil {
+		t.Fatalf("failed to connect to test database: %v", err)
+	}
+
+	t.Cleanup(func() {
+		pool.Close()
+		_ = shared.exec(context.Background(), "DROP DATABASE IF EXISTS "+pgx.Identifier{name}.Sanitize()+
+			" WITH (FORCE)")
+	})
+
+	return pool
+}
+
+// skipWithoutDocker skips t when Docker is not available, as testcontainers.SkipIfProviderIsNotHealthy
+// does for tests only
+func skipWithoutDocker(t testing.TB) {
+	t.Helper()
+	defer func() {
+		if r := recover(); r != nil {
+			t.Skipf("Docker is not available: %v", r)
+		}
+	}()
+
+	provider, err := testcontainers.ProviderDocker.GetProvider()
+	if err == nil {
+		err = provider.Health(context.Background())
+	}
+	if err != nil {
+		t.Skipf("Docker is not available: %v", err)
+	}
+}
+
+// startServer starts the PostgreSQL container and migrates the template database
+func startServer(ctx context.Context) (*server, error) {
+	container, err := postgres.Run(ctx, Image,
+		postgres.WithDatabase("postgres"),
+		postgres.WithUsername("postgres"),
+		postgres.WithPassword("postgres"),
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("database system is ready to accept connections").
+				WithOccurrence(2).
+				WithStartupTimeout(startupTimeout),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start container: %w", err)
+	}
+
+	connString, err := container.ConnectionString(ctx, "sslmode=disable")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get connection string: %w", err)
+	}
+
+	config, err := pgxpool.ParseConfig(connString)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse connection string: %w", err)
+	}
+
+	s := &server{config: config}
+	if err = s.exec(ctx, "CREATE DATABASE "+templateDatabase); err != nil {
+		return nil, err
+	}
+	if err = s.migrate(ctx); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// exec runs a statement on the maintenance database of the server
+func (s *server) exec(ctx context.Context, sql string) error {
+	conn, err := pgx.ConnectConfig(ctx, s.config.ConnConfig)
+	if err != nil {
+		return fmt.Errorf("failed to connect to test server: %w", err)
+	}
+	defer conn.Close(ctx)
+
+	if _, err = conn.Exec(ctx, sql); err != nil {
+		return fmt.Errorf("failed to run %q: %w", sql, err)
+	}
+	return nil
+}
+
+// migrate applies the up migrations to the template database, in order. The connection is
+// closed afterwards, as a template can't be copied while in use.
+func (s *server) migrate(ctx context.Context) error {
+	files, err := filepath.Glob(filepath.Join(MigrationsDir(), "*.up.sql"))
+	if err != nil {
+		return fmt.Errorf("failed to list migrations: %w", err)
+	}
+	sort.Strings(files)
+
+	config := s.config.ConnConfig.Copy()
+	config.Database = templateDatabase
+	conn, err := pgx.ConnectConfig(ctx, config)
+	if err != nil {
+		return fmt.Errorf("failed to connect to template database: %w", err)
+	}
+	defer conn.Close(ctx)
+
+	for _, file := range files {
+		migration, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("failed to read migration: %w", err)
+		}
+		// Without arguments the migration is sent with the simple protocol, which runs every statement
+		if _, err = conn.Exec(ctx, string(migration)); err != nil {
+			return fmt.Errorf("failed to apply migration %s: %w", strings.TrimSuffix(filepath.Base(file), ".up.sql"), err)
+		}
+	}
+
+	return nil
+}
+
+// MigrationsDir returns the directory holding the migrations of the repository
+func MigrationsDir() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "..", "..", "migrations")
+}
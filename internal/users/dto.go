@@ -0,0 +1,200 @@
+package users
+
+import (
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+	"github.com/rodruizronald/ticos-in-tech/internal/i18n"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobtech"
+)
+
+// Data Transfer Objects (DTOs) for the user API layer.
+
+// CredentialsRequest represents the request body to register or log in
+type CredentialsRequest struct {
+	Email    string `json:"email" binding:"required" example:"ana@example.com"`
+	Password string `json:"password" binding:"required" example:"correct-horse-battery"`
+}
+
+// OAuthCallbackRequest represents the query parameters an OAuth provider redirects back with
+type OAuthCallbackRequest struct {
+	Code  string `form:"code" example:"4/0AfJohXn"`
+	State string `form:"state" example:"Jx2f0kq3"`
+	// Error is set instead of the code when the login failed, e.g. access_denied
+	Error string `form:"error" example:"access_denied"`
+}
+
+// LoginCodeRequest represents the request body to exchange the code of an OAuth login for a session
+type LoginCodeRequest struct {
+	Code string `json:"code" binding:"required" example:"Qm9rZ3Vz"`
+}
+
+// BookmarkListRequest represents the query parameters to list saved jobs
+type BookmarkListRequest struct {
+	Limit  int `form:"limit" binding:"omitempty,min=1,max=100" example:"20"`
+	Offset int `form:"offset" binding:"omitempty,min=0" example:"0"`
+}
+
+// ToBookmarkListParams converts a BookmarkListRequest to BookmarkListParams for the given user
+func (req *BookmarkListRequest) ToBookmarkListParams(userID int) BookmarkListParams {
+	return BookmarkListParams{UserID: userID, Limit: req.Limit, Offset: req.Offset}
+}
+
+// MarkAppliedRequest represents the request body to mark a job as applied to
+type MarkAppliedRequest struct {
+	Status string `json:"status" binding:"omitempty,oneof=applied interviewing rejected offer" example:"applied"`
+	Notes  string `json:"notes" example:"Referred by a friend"`
+}
+
+// ToApplication converts a MarkAppliedRequest to the Application of a user to a job
+func (req *MarkAppliedRequest) ToApplication(userID, jobID int) *Application {
+	return &Application{
+		UserID: userID,
+		JobID:  jobID,
+		Status: ApplicationStatus(req.Status),
+		Notes:  req.Notes,
+	}
+}
+
+// ApplicationListRequest represents the query parameters to list applications
+type ApplicationListRequest struct {
+	Status string `form:"status" binding:"omitempty,oneof=applied interviewing rejected offer" example:"interviewing"`
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=100" example:"20"`
+	Offset int    `form:"offset" binding:"omitempty,min=0" example:"0"`
+}
+
+// ToApplicationListParams converts an ApplicationListRequest to ApplicationListParams for the given user
+func (req *ApplicationListRequest) ToApplicationListParams(userID int) ApplicationListParams {
+	return ApplicationListParams{
+		UserID: userID,
+		Status: ApplicationStatus(req.Status),
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	}
+}
+
+// UserResponse represents the API response for a user
+type UserResponse struct {
+	ID        int       `json:"id" example:"1"`
+	Email     string    `json:"email" example:"ana@example.com"`
+	CreatedAt time.Time `json:"created_at" example:"2024-01-15T06:00:00Z"`
+}
+
+// SessionResponse represents the API response of a login. The token is sent as
+// "Authorization: Bearer <token>" on authenticated requests.
+type SessionResponse struct {
+	Token     string        `json:"token" example:"Jx2f0kq3..."`
+	ExpiresAt time.Time     `json:"expires_at" example:"2024-02-14T06:00:00Z"`
+	User      *UserResponse `json:"user"`
+}
+
+// SavedJobResponse represents the API response for a job saved by a user
+type SavedJobResponse struct {
+	jobs.JobResponse
+	IsActive bool      `json:"is_active" example:"true"`
+	SavedAt  time.Time `json:"saved_at" example:"2024-01-15T06:00:00Z"`
+}
+
+// SavedJobListResponse represents the API response listing the jobs saved by a user
+type SavedJobListResponse struct {
+	Data       []*SavedJobResponse           `json:"data"`
+	Pagination httpservice.PaginationDetails `json:"pagination"`
+}
+
+// ApplicationResponse represents the API response for the application of a user to a job
+type ApplicationResponse struct {
+	JobID     int       `json:"job_id" example:"7"`
+	Status    string    `json:"status" example:"applied"`
+	Notes     string    `json:"notes" example:"Referred by a friend"`
+	AppliedAt time.Time `json:"applied_at" example:"2024-01-15T06:00:00Z"`
+	UpdatedAt time.Time `json:"updated_at" example:"2024-01-20T06:00:00Z"`
+}
+
+// AppliedJobResponse represents the API response for a job a user applied to
+type AppliedJobResponse struct {
+	jobs.JobResponse
+	IsActive    bool                 `json:"is_active" example:"true"`
+	Application *ApplicationResponse `json:"application"`
+}
+
+// ApplicationListResponse represents the API response listing the applications of a user
+type ApplicationListResponse struct {
+	Data       []*AppliedJobResponse         `json:"data"`
+	Pagination httpservice.PaginationDetails `json:"pagination"`
+}
+
+// MapUserToResponse converts a user to its API response format
+func MapUserToResponse(user *User) *UserResponse {
+	return &UserResponse{
+		ID:        user.ID,
+		Email:     user.Email,
+		CreatedAt: user.CreatedAt,
+	}
+}
+
+// MapSessionToResponse converts a new session to the login API response format
+func MapSessionToResponse(session *Session) *SessionResponse {
+	return &SessionResponse{
+		Token:     session.Token,
+		ExpiresAt: session.ExpiresAt,
+		User:      MapUserToResponse(session.User),
+	}
+}
+
+// MapSavedJobsToResponse converts saved jobs with technologies to the list API response format
+func MapSavedJobsToResponse(saved []*SavedJob, techMap map[int][]*jobtech.JobTechnologyWithDetails,
+	total int, params *BookmarkListParams, lang i18n.Language) *SavedJobListResponse {
+	jobsWithCompany := make([]*jobs.JobWithCompany, len(saved))
+	for i, job := range saved {
+		jobsWithCompany[i] = &job.JobWithCompany
+	}
+
+	data := make([]*SavedJobResponse, len(saved))
+	for i, jobResponse := range jobs.MapJobsToResponse(jobsWithCompany, techMap, lang) {
+		data[i] = &SavedJobResponse{
+			JobResponse: *jobResponse,
+			IsActive:    saved[i].IsActive,
+			SavedAt:     saved[i].SavedAt,
+		}
+	}
+
+	return &SavedJobListResponse{
+		Data:       data,
+		Pagination: httpservice.NewPaginationDetails(total, params.Limit, params.Offset, len(saved)),
+	}
+}
+
+// MapApplicationToResponse converts an application to its API response format
+func MapApplicationToResponse(application *Application) *ApplicationResponse {
+	return &ApplicationResponse{
+		JobID:     application.JobID,
+		Status:    string(application.Status),
+		Notes:     application.Notes,
+		AppliedAt: application.CreatedAt,
+		UpdatedAt: application.UpdatedAt,
+	}
+}
+
+// MapApplicationsToResponse converts applied jobs with technologies to the list API response format
+func MapApplicationsToResponse(applied []*AppliedJob, techMap map[int][]*jobtech.JobTechnologyWithDetails,
+	total int, params *ApplicationListParams, lang i18n.Language) *ApplicationListResponse {
+	jobsWithCompany := make([]*jobs.JobWithCompany, len(applied))
+	for i, job := range applied {
+		jobsWithCompany[i] = &job.JobWithCompany
+	}
+
+	data := make([]*AppliedJobResponse, len(applied))
+	for i, jobResponse := range jobs.MapJobsToResponse(jobsWithCompany, techMap, lang) {
+		data[i] = &AppliedJobResponse{
+			JobResponse: *jobResponse,
+			IsActive:    applied[i].IsActive,
+			Application: MapApplicationToResponse(&applied[i].Application),
+		}
+	}
+
+	return &ApplicationListResponse{
+		Data:       data,
+		Pagination: httpservice.NewPaginationDetails(total, params.Limit, params.Offset, len(applied)),
+	}
+}
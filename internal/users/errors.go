@@ -0,0 +1,131 @@
+// Package users provides user accounts, their login sessions, with a password or an OAuth provider,
+// and the jobs they bookmark.
+package users
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// Sentinel errors returned by the user service
+var (
+	// ErrInvalidCredentials is returned when the email or the password of a login is wrong
+	ErrInvalidCredentials = httpservice.NewError(httpservice.ErrUnauthorized, "invalid email or password")
+	// ErrInvalidSession is returned when a session token is unknown or expired
+	ErrInvalidSession = httpservice.NewError(httpservice.ErrUnauthorized, "invalid or expired session")
+	// ErrInvalidOAuthState is returned when an OAuth provider redirects back with another state than
+	// the one of the login started by the client, or after the login expired
+	ErrInvalidOAuthState = httpservice.NewError(httpservice.ErrUnauthorized, "invalid or expired OAuth login")
+	// ErrUnverifiedEmail is returned when the email of an account at an OAuth provider isn't verified,
+	// so it can't be linked to a user
+	ErrUnverifiedEmail = httpservice.NewError(httpservice.ErrUnauthorized,
+		"the email of the account is not verified by the provider")
+	// ErrInvalidLoginCode is returned when the code of an OAuth login is unknown, expired or was
+	// already exchanged
+	ErrInvalidLoginCode = httpservice.NewError(httpservice.ErrUnauthorized, "invalid or expired login code")
+)
+
+// NotFoundError represents a user not found error
+type NotFoundError struct {
+	Email string
+}
+
+func (e NotFoundError) Error() string {
+	return fmt.Sprintf("user with email %s not found", e.Email)
+}
+
+// Is matches httpservice.ErrNotFound
+func (e NotFoundError) Is(target error) bool {
+	return target == httpservice.ErrNotFound
+}
+
+// IsNotFound checks if an error is a user not found error
+func IsNotFound(err error) bool {
+	var notFoundErr *NotFoundError
+	return errors.As(err, &notFoundErr)
+}
+
+// DuplicateError represents a duplicate user error
+type DuplicateError struct {
+	Email string
+}
+
+func (e DuplicateError) Error() string {
+	return fmt.Sprintf("user with email %s already exists", e.Email)
+}
+
+// Is matches httpservice.ErrConflict
+func (e DuplicateError) Is(target error) bool {
+	return target == httpservice.ErrConflict
+}
+
+// IsDuplicate checks if an error is a duplicate user error
+func IsDuplicate(err error) bool {
+	var duplicateErr *DuplicateError
+	return errors.As(err, &duplicateErr)
+}
+
+// InvalidStatusError represents an unknown application status
+type InvalidStatusError struct {
+	Status string
+}
+
+func (e InvalidStatusError) Error() string {
+	return fmt.Sprintf("invalid application status %q", e.Status)
+}
+
+// Is matches httpservice.ErrInvalid
+func (e InvalidStatusError) Is(target error) bool {
+	return target == httpservice.ErrInvalid
+}
+
+// UnknownProviderError represents an OAuth provider that isn't configured
+type UnknownProviderError struct {
+	Provider string
+}
+
+func (e UnknownProviderError) Error() string {
+	return fmt.Sprintf("OAuth provider %q not found", e.Provider)
+}
+
+// Is matches httpservice.ErrNotFound
+func (e UnknownProviderError) Is(target error) bool {
+	return target == httpservice.ErrNotFound
+}
+
+// IdentityNotFoundError represents an account at an OAuth provider that isn't linked to a user
+type IdentityNotFoundError struct {
+	Provider string
+	Subject  string
+}
+
+func (e IdentityNotFoundError) Error() string {
+	return fmt.Sprintf("%s account %s is not linked to a user", e.Provider, e.Subject)
+}
+
+// Is matches httpservice.ErrNotFound
+func (e IdentityNotFoundError) Is(target error) bool {
+	return target == httpservice.ErrNotFound
+}
+
+// OAuthError represents a login an OAuth provider failed or the user cancelled. Err holds the
+// details, which aren't sent to the client.
+type OAuthError struct {
+	Provider string
+	Err      error
+}
+
+func (e OAuthError) Error() string {
+	return fmt.Sprintf("%s login failed", e.Provider)
+}
+
+func (e OAuthError) Unwrap() error {
+	return e.Err
+}
+
+// Is matches httpservice.ErrUnauthorized
+func (e OAuthError) Is(target error) bool {
+	return target == httpservice.ErrUnauthorized
+}
@@ -0,0 +1,449 @@
+package users
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// Constants for user routes and endpoints
+const (
+	AuthRoute      = "/auth"
+	RegisterRoute  = AuthRoute + "/register"
+	LoginRoute     = AuthRoute + "/login"
+	LogoutRoute    = AuthRoute + "/logout"
+	MeRoute        = "/me"
+	BookmarksRoute = MeRoute + "/bookmarks"
+	BookmarkPath   = BookmarksRoute + "/:job_id"
+
+	ApplicationsRoute = MeRoute + "/applications"
+	AppliedRoute      = "/jobs/:id/applied"
+
+	OAuthRoute         = AuthRoute + "/oauth/:provider"
+	OAuthCallbackRoute = OAuthRoute + "/callback"
+	OAuthExchangeRoute = AuthRoute + "/oauth/exchange"
+)
+
+// OAuthStateCookie is the cookie keeping the state and the verifier of an OAuth login, as
+// "<state>.<verifier>", until the provider redirects back to the callback
+const OAuthStateCookie = "oauth_state"
+
+// oauthLoginMaxAge is how many seconds the user has to consent to an OAuth login
+const oauthLoginMaxAge = 10 * 60
+
+// Handler handles HTTP requests for user accounts and bookmarks
+type Handler struct {
+	service *UserService
+}
+
+// NewHandler creates a new user handler
+func NewHandler(service *UserService) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers the user routes with the given router group.
+// All routes but registration and login require a session token.
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.POST(RegisterRoute, h.Register)
+	rg.POST(LoginRoute, h.Login)
+
+	authenticated := rg.Group("", RequireUser(h.service))
+	authenticated.POST(LogoutRoute, h.Logout)
+	authenticated.GET(MeRoute, h.GetMe)
+	authenticated.GET(BookmarksRoute, h.ListBookmarks)
+	authenticated.PUT(BookmarkPath, h.SaveJob)
+	authenticated.DELETE(BookmarkPath, h.UnsaveJob)
+	authenticated.GET(ApplicationsRoute, h.ListApplications)
+	authenticated.POST(AppliedRoute, h.MarkApplied)
+}
+
+// Register godoc
+// @Summary Register a user
+// @Description Creates a user account with an email and a password of 8 to 72 characters
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param request body CredentialsRequest true "User credentials"
+// @Success 201 {object} UserResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 409 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /auth/register [post]
+func (h *Handler) Register(c *gin.Context) {
+	var req CredentialsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	user, err := h.service.Register(c.Request.Context(), req.Email, req.Password)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, MapUserToResponse(user))
+}
+
+// Login godoc
+// @Summary Log in
+// @Description Checks the credentials of a user and returns a session token valid for 30 days
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param request body CredentialsRequest true "User credentials"
+// @Success 200 {object} SessionResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /auth/login [post]
+func (h *Handler) Login(c *gin.Context) {
+	var req CredentialsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	session, err := h.service.Login(c.Request.Context(), req.Email, req.Password)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapSessionToResponse(session))
+}
+
+// Logout godoc
+// @Summary Log out
+// @Description Closes the session of the token used in the request
+// @Tags users
+// @Security BearerAuth
+// @Success 204
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /auth/logout [post]
+func (h *Handler) Logout(c *gin.Context) {
+	if err := h.service.Logout(c.Request.Context(), bearerToken(c)); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetMe godoc
+// @Summary Get the current user
+// @Description Returns the user of the session token
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} UserResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Router /me [get]
+func (h *Handler) GetMe(c *gin.Context) {
+	c.JSON(http.StatusOK, MapUserToResponse(CurrentUser(c)))
+}
+
+// ListBookmarks godoc
+// @Summary List saved jobs
+// @Description Lists the jobs saved by the current user, most recently saved first.
+// @Description Saved jobs are kept when they are deactivated.
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Param limit query int false "Number of jobs to return (max 100)" default(20) example(20)
+// @Param offset query int false "Number of jobs to skip" default(0) example(0)
+// @Success 200 {object} SavedJobListResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /me/bookmarks [get]
+func (h *Handler) ListBookmarks(c *gin.Context) {
+	var req BookmarkListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	saved, err := h.service.SavedJobs(c.Request.Context(), req.ToBookmarkListParams(CurrentUser(c).ID))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, saved)
+}
+
+// SaveJob godoc
+// @Summary Save a job
+// @Description Bookmarks a job for the current user. Saving a job twice has no effect.
+// @Tags users
+// @Security BearerAuth
+// @Param job_id path int true "Job ID"
+// @Success 204
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /me/bookmarks/{job_id} [put]
+func (h *Handler) SaveJob(c *gin.Context) {
+	jobID, ok := parseJobID(c, "job_id")
+	if !ok {
+		return
+	}
+
+	if err := h.service.SaveJob(c.Request.Context(), CurrentUser(c).ID, jobID); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// UnsaveJob godoc
+// @Summary Unsave a job
+// @Description Removes a job from the jobs saved by the current user
+// @Tags users
+// @Security BearerAuth
+// @Param job_id path int true "Job ID"
+// @Success 204
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /me/bookmarks/{job_id} [delete]
+func (h *Handler) UnsaveJob(c *gin.Context) {
+	jobID, ok := parseJobID(c, "job_id")
+	if !ok {
+		return
+	}
+
+	if err := h.service.UnsaveJob(c.Request.Context(), CurrentUser(c).ID, jobID); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// MarkApplied godoc
+// @Summary Mark a job as applied
+// @Description Records that the current user applied to a job. Marking it again updates the status
+// @Description and notes of the application.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Job ID"
+// @Param request body MarkAppliedRequest false "Application status, applied by default, and notes"
+// @Success 200 {object} ApplicationResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /jobs/{id}/applied [post]
+func (h *Handler) MarkApplied(c *gin.Context) {
+	jobID, ok := parseJobID(c, "id")
+	if !ok {
+		return
+	}
+
+	var req MarkAppliedRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			_ = c.Error(&httpservice.RequestParseError{Err: err})
+			return
+		}
+	}
+
+	application := req.ToApplication(CurrentUser(c).ID, jobID)
+	if err := h.service.MarkApplied(c.Request.Context(), application); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapApplicationToResponse(application))
+}
+
+// ListApplications godoc
+// @Summary List applications
+// @Description Lists the jobs the current user applied to with the status of each application,
+// @Description most recently updated first
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Application status filter" Enums(applied,interviewing,rejected,offer)
+// @Param limit query int false "Number of applications to return (max 100)" default(20) example(20)
+// @Param offset query int false "Number of applications to skip" default(0) example(0)
+// @Success 200 {object} ApplicationListResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /me/applications [get]
+func (h *Handler) ListApplications(c *gin.Context) {
+	var req ApplicationListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	applications, err := h.service.Applications(c.Request.Context(), req.ToApplicationListParams(CurrentUser(c).ID))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, applications)
+}
+
+// parseJobID reads the job ID path parameter, writing the error response when it is invalid
+func parseJobID(c *gin.Context, param string) (int, bool) {
+	jobID, err := strconv.Atoi(c.Param(param))
+	if err != nil || jobID <= 0 {
+		_ = c.Error(&httpservice.ValidationError{Errors: []string{"invalid job id"}})
+		return 0, false
+	}
+	return jobID, true
+}
+
+// OAuthHandler handles HTTP requests for the logins with OAuth providers
+type OAuthHandler struct {
+	service     *OAuthService
+	redirectURL string
+}
+
+// NewOAuthHandler creates a new OAuth login handler, redirecting the completed logins to the page
+// of the frontend at redirectURL
+func NewOAuthHandler(service *OAuthService, redirectURL string) *OAuthHandler {
+	return &OAuthHandler{service: service, redirectURL: redirectURL}
+}
+
+// RegisterRoutes registers the OAuth login routes with the given router group
+func (h *OAuthHandler) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.GET(OAuthRoute, h.BeginOAuth)
+	rg.GET(OAuthCallbackRoute, h.CompleteOAuth)
+	rg.POST(OAuthExchangeRoute, h.ExchangeLoginCode)
+}
+
+// BeginOAuth godoc
+// @Summary Log in with an OAuth provider
+// @Description Redirects to the consent page of Google or GitHub, which redirects back to the callback.
+// @Description The state of the login is kept for 10 minutes in the oauth_state cookie.
+// @Tags users
+// @Param provider path string true "OAuth provider" Enums(google, github)
+// @Success 302 "Redirect to the consent page of the provider"
+// @Header 302 {string} Location "Consent page of the provider"
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /auth/oauth/{provider} [get]
+func (h *OAuthHandler) BeginOAuth(c *gin.Context) {
+	login, err := h.service.Begin(c.Param("provider"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	// Lax, so the cookie is sent back when the provider redirects to the callback
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(OAuthStateCookie, login.State+"."+login.Verifier, oauthLoginMaxAge, "/", "", true, true)
+	c.Redirect(http.StatusFound, login.URL)
+}
+
+// CompleteOAuth godoc
+// @Summary Complete a login with an OAuth provider
+// @Description Called by the provider once the user consents, with the state of the login started in
+// @Description the same browser. Redirects to the page of the frontend with a one-time code in its code
+// @Description query parameter, exchanged for a session at /auth/oauth/exchange within a minute, or with
+// @Description the code of the error in its error one, e.g. UNAUTHORIZED.
+// @Description An account logging in for the first time is linked to the user of its email, created
+// @Description without a password when not registered, as long as the provider verified the email.
+// @Tags users
+// @Param provider path string true "OAuth provider" Enums(google, github)
+// @Param code query string false "Authorization code" example("4/0AfJohXn")
+// @Param state query string false "State of the login" example("Jx2f0kq3")
+// @Param error query string false "Error of the provider, e.g. access_denied when the user didn't consent"
+// @Success 302 "Redirect to the frontend"
+// @Header 302 {string} Location "Page of the frontend with the code or the error of the login"
+// @Router /auth/oauth/{provider}/callback [get]
+func (h *OAuthHandler) CompleteOAuth(c *gin.Context) {
+	query := url.Values{}
+	loginCode, err := h.completeOAuth(c)
+	if err != nil {
+		// The frontend only gets the code of the error, the error itself is logged with the request
+		_ = c.Error(err)
+		_, response := httpservice.MapError(err)
+		query.Set("error", response.Error.Code)
+	} else {
+		query.Set("code", loginCode.Code)
+	}
+
+	redirectURL, err := url.Parse(h.redirectURL)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	redirectURL.RawQuery = query.Encode()
+	c.Redirect(http.StatusFound, redirectURL.String())
+}
+
+// completeOAuth checks the state of the login the provider redirected back to and returns the
+// one-time code of the user of the account
+func (h *OAuthHandler) completeOAuth(c *gin.Context) (*LoginCode, error) {
+	var req OAuthCallbackRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		return nil, &httpservice.RequestParseError{Err: err}
+	}
+
+	// The login is over whatever its outcome, so its state can't be used twice
+	cookie, _ := c.Cookie(OAuthStateCookie)
+	c.SetCookie(OAuthStateCookie, "", -1, "/", "", true, true)
+	state, verifier, ok := strings.Cut(cookie, ".")
+	if !ok || req.State == "" || subtle.ConstantTimeCompare([]byte(state), []byte(req.State)) != 1 {
+		return nil, ErrInvalidOAuthState
+	}
+
+	provider := c.Param("provider")
+	if req.Error != "" {
+		return nil, &OAuthError{Provider: provider, Err: errors.New(req.Error)}
+	}
+	if req.Code == "" {
+		return nil, &httpservice.ValidationError{Errors: []string{"code is required"}}
+	}
+
+	return h.service.Complete(c.Request.Context(), provider, req.Code, verifier)
+}
+
+// ExchangeLoginCode godoc
+// @Summary Exchange the code of an OAuth login for a session
+// @Description Opens a session of the user of the one-time code the OAuth callback redirected to the
+// @Description frontend with, like a login with a password. A code is only exchanged once.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param request body LoginCodeRequest true "One-time code of the login"
+// @Success 200 {object} SessionResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /auth/oauth/exchange [post]
+func (h *OAuthHandler) ExchangeLoginCode(c *gin.Context) {
+	var req LoginCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	session, err := h.service.Exchange(c.Request.Context(), req.Code)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapSessionToResponse(session))
+}
@@ -0,0 +1,123 @@
+package users
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+const frontendURL = "https://ticosintech.com/login/callback"
+
+// newOAuthRouter serves the OAuth routes of a GitHub provider
+func newOAuthRouter(t *testing.T) (*gin.Engine, *MockOAuthProvider, *MockIdentityRepository, *MockDataRepository) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	provider := NewMockOAuthProvider(t)
+	provider.EXPECT().Name().Return(ProviderGitHub).Once()
+	identities := NewMockIdentityRepository(t)
+	mockRepo := NewMockDataRepository(t)
+	service := NewOAuthService(identities, NewUserService(mockRepo, NewMockTechnologyRepository(t)), provider)
+
+	r := gin.New()
+	r.Use(httpservice.ErrorHandler())
+	NewOAuthHandler(service, frontendURL).RegisterRoutes(r.Group(""))
+	return r, provider, identities, mockRepo
+}
+
+func TestOAuthHandler_CompleteOAuth(t *testing.T) {
+	t.Parallel()
+	user := &User{ID: 1, Email: "ana@example.com"}
+
+	tests := []struct {
+		name      string
+		query     string
+		mockSetup func(provider *MockOAuthProvider, identities *MockIdentityRepository)
+		wantQuery func(t *testing.T, query url.Values)
+	}{
+		{
+			name:  "redirected with a login code",
+			query: "?code=code&state=state",
+			mockSetup: func(provider *MockOAuthProvider, identities *MockIdentityRepository) {
+				t.Helper()
+				provider.EXPECT().Identify(mock.Anything, "code", "verifier").
+					Return(&Identity{Provider: ProviderGitHub, Subject: "583231"}, nil).Once()
+				identities.EXPECT().GetIdentityUser(mock.Anything, ProviderGitHub, "583231").Return(user, nil).Once()
+				identities.EXPECT().CreateLoginCode(mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			wantQuery: func(t *testing.T, query url.Values) {
+				t.Helper()
+				assert.NotEmpty(t, query.Get("code"))
+				assert.Empty(t, query.Get("error"))
+			},
+		},
+		{
+			name:  "another state",
+			query: "?code=code&state=forged",
+			wantQuery: func(t *testing.T, query url.Values) {
+				t.Helper()
+				assert.Empty(t, query.Get("code"))
+				assert.Equal(t, httpservice.ErrCodeUnauthorized, query.Get("error"))
+			},
+		},
+		{
+			name:  "consent denied",
+			query: "?error=access_denied&state=state",
+			wantQuery: func(t *testing.T, query url.Values) {
+				t.Helper()
+				assert.Equal(t, httpservice.ErrCodeUnauthorized, query.Get("error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			router, provider, identities, _ := newOAuthRouter(t)
+			if tt.mockSetup != nil {
+				tt.mockSetup(provider, identities)
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/auth/oauth/github/callback"+tt.query, http.NoBody)
+			req.AddCookie(&http.Cookie{Name: OAuthStateCookie, Value: "state.verifier"})
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			require.Equal(t, http.StatusFound, recorder.Code)
+			location, err := url.Parse(recorder.Header().Get("Location"))
+			require.NoError(t, err)
+			assert.Equal(t, frontendURL, location.Scheme+"://"+location.Host+location.Path)
+			tt.wantQuery(t, location.Query())
+			assert.Contains(t, recorder.Header().Get("Set-Cookie"), OAuthStateCookie+"=;",
+				"the state of the login is cleared")
+		})
+	}
+}
+
+func TestOAuthHandler_ExchangeLoginCode(t *testing.T) {
+	t.Parallel()
+	router, _, identities, mockRepo := newOAuthRouter(t)
+	identities.EXPECT().ConsumeLoginCode(mock.Anything, hashToken("login-code")).
+		Return(&User{ID: 1, Email: "ana@example.com"}, nil).Once()
+	mockRepo.EXPECT().CreateSession(mock.Anything, mock.Anything).Return(nil).Once()
+
+	req := httptest.NewRequest(http.MethodPost, "/auth/oauth/exchange", strings.NewReader(`{"code": "login-code"}`))
+	req.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	var response SessionResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.NotEmpty(t, response.Token)
+	assert.Equal(t, 1, response.User.ID)
+}
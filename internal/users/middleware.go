@@ -0,0 +1,61 @@
+package users
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/actor"
+)
+
+// AuthorizationHeader is the request header carrying the session token as "Bearer <token>"
+const AuthorizationHeader = "Authorization"
+
+const (
+	bearerPrefix   = "Bearer "
+	userContextKey = "users.user"
+)
+
+// Authenticator resolves the user of a session token
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (*User, error)
+}
+
+// RequireUser returns a middleware that rejects requests without a valid session token.
+// The user of the session is available to the next handlers through CurrentUser, and is the
+// actor of the changes made by the request.
+func RequireUser(auth Authenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, err := auth.Authenticate(c.Request.Context(), bearerToken(c))
+		if err != nil {
+			// Invalid sessions are answered with 401 Unauthorized by the error middleware
+			_ = c.Error(err)
+			c.Abort()
+			return
+		}
+
+		c.Set(userContextKey, user)
+		c.Request = c.Request.WithContext(actor.With(c.Request.Context(), actor.User(user.ID)))
+		c.Next()
+	}
+}
+
+// CurrentUser returns the user authenticated by RequireUser, nil when there is none
+func CurrentUser(c *gin.Context) *User {
+	value, ok := c.Get(userContextKey)
+	if !ok {
+		return nil
+	}
+	user, _ := value.(*User)
+	return user
+}
+
+// bearerToken extracts the session token from the Authorization header
+func bearerToken(c *gin.Context) string {
+	header := c.GetHeader(AuthorizationHeader)
+	if len(header) < len(bearerPrefix) || !strings.EqualFold(header[:len(bearerPrefix)], bearerPrefix) {
+		return ""
+	}
+	return strings.TrimSpace(header[len(bearerPrefix):])
+}
@@ -0,0 +1,96 @@
+package users
+
+import (
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+)
+
+// User represents a registered user of the job board.
+type User struct {
+	ID           int       `json:"id" db:"id"`
+	Email        string    `json:"email" db:"email"`
+	PasswordHash string    `json:"-" db:"password_hash"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// LoginCode is a one-time code of an OAuth login, exchanged for a session by the frontend the
+// login redirects back to. Only the hash of the code is stored.
+type LoginCode struct {
+	Code      string    `json:"-" db:"-"`
+	CodeHash  string    `json:"-" db:"code_hash"`
+	UserID    int       `json:"user_id" db:"user_id"`
+	ExpiresAt time.Time `json:"expires_at" db:"expires_at"`
+}
+
+// Session represents a login session. Only the hash of the token is stored,
+// the token itself is handed to the user once at login.
+type Session struct {
+	Token     string    `json:"-" db:"-"`
+	TokenHash string    `json:"-" db:"token_hash"`
+	UserID    int       `json:"user_id" db:"user_id"`
+	ExpiresAt time.Time `json:"expires_at" db:"expires_at"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+
+	// Relationships (not stored in database)
+	User *User `json:"user,omitempty" db:"-"`
+}
+
+// SavedJob represents a job bookmarked by a user
+type SavedJob struct {
+	jobs.JobWithCompany
+	SavedAt time.Time `db:"saved_at"`
+}
+
+// BookmarkListParams defines the parameters to list the jobs saved by a user (repository layer)
+type BookmarkListParams struct {
+	UserID int
+	Limit  int
+	Offset int
+}
+
+// ApplicationStatus is the stage of a job application
+type ApplicationStatus string
+
+// Application statuses
+const (
+	StatusApplied      ApplicationStatus = "applied"
+	StatusInterviewing ApplicationStatus = "interviewing"
+	StatusRejected     ApplicationStatus = "rejected"
+	StatusOffer        ApplicationStatus = "offer"
+)
+
+// IsValid reports whether s is a known application status
+func (s ApplicationStatus) IsValid() bool {
+	switch s {
+	case StatusApplied, StatusInterviewing, StatusRejected, StatusOffer:
+		return true
+	}
+	return false
+}
+
+// Application represents a job a user applied to
+type Application struct {
+	UserID    int               `db:"user_id"`
+	JobID     int               `db:"job_id"`
+	Status    ApplicationStatus `db:"status"`
+	Notes     string            `db:"notes"`
+	CreatedAt time.Time         `db:"created_at"`
+	UpdatedAt time.Time         `db:"updated_at"`
+}
+
+// AppliedJob represents a job a user applied to, with its application
+type AppliedJob struct {
+	jobs.JobWithCompany
+	Application Application
+}
+
+// ApplicationListParams defines the parameters to list the applications of a user (repository layer).
+// An empty Status lists applications of any status.
+type ApplicationListParams struct {
+	UserID int
+	Status ApplicationStatus
+	Limit  int
+	Offset int
+}
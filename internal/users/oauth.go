@@ -0,0 +1,224 @@
+package users
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/endpoints"
+)
+
+// OAuth providers users log in with
+const (
+	ProviderGoogle = "google"
+	ProviderGitHub = "github"
+)
+
+// Identity is the account of a user at an OAuth provider
+type Identity struct {
+	Provider string `db:"provider"`
+	// Subject identifies the account at the provider, it doesn't change when its email does
+	Subject string `db:"subject"`
+	Email   string `db:"email"`
+	// EmailVerified reports whether the provider verified the user owns the email
+	EmailVerified bool `db:"-"`
+}
+
+// OAuthProvider logs users in with the OAuth2 authorization code flow of a provider
+type OAuthProvider interface {
+	// Name returns the name of the provider, one of the Provider constants
+	Name() string
+	// AuthCodeURL returns the consent page of the provider, redirecting back with the state and
+	// a code once the user consents. The code is only exchanged with the verifier.
+	AuthCodeURL(state, verifier string) string
+	// Identify exchanges the code for a token and returns the identity of the user
+	Identify(ctx context.Context, code, verifier string) (*Identity, error)
+}
+
+// OAuthClient is the client of the app registered with an OAuth provider
+type OAuthClient struct {
+	ClientID     string
+	ClientSecret string
+}
+
+// Enabled reports whether the client is configured
+func (c OAuthClient) Enabled() bool {
+	return c.ClientID != "" && c.ClientSecret != ""
+}
+
+// OAuthConfig configures the OAuth providers users log in with
+type OAuthConfig struct {
+	// CallbackBaseURL is the public URL of the API the providers redirect back to, e.g.
+	// https://ticosintech.com/api/v1, registered with the providers followed by
+	// /auth/oauth/{provider}/callback
+	CallbackBaseURL string
+	// RedirectURL is the page of the frontend the callback redirects to, with the one-time code of
+	// the login in its code query parameter, or the code of the error in its error one
+	RedirectURL string
+	Google      OAuthClient
+	GitHub      OAuthClient
+}
+
+// Providers returns the providers whose client is configured
+func (c OAuthConfig) Providers() []OAuthProvider {
+	var providers []OAuthProvider
+	if c.Google.Enabled() {
+		providers = append(providers, NewGoogleProvider(c.Google, c.callbackURL(ProviderGoogle)))
+	}
+	if c.GitHub.Enabled() {
+		providers = append(providers, NewGitHubProvider(c.GitHub, c.callbackURL(ProviderGitHub)))
+	}
+	return providers
+}
+
+// callbackURL returns the URL provider redirects back to
+func (c OAuthConfig) callbackURL(provider string) string {
+	return strings.TrimSuffix(c.CallbackBaseURL, "/") + strings.Replace(OAuthCallbackRoute, ":provider", provider, 1)
+}
+
+// oauthProvider implements the authorization code flow with PKCE shared by the providers
+type oauthProvider struct {
+	name   string
+	config *oauth2.Config
+}
+
+func (p *oauthProvider) Name() string {
+	return p.name
+}
+
+func (p *oauthProvider) AuthCodeURL(state, verifier string) string {
+	return p.config.AuthCodeURL(state, oauth2.S256ChallengeOption(verifier))
+}
+
+// client exchanges the code for a token and returns an HTTP client authorized with it
+func (p *oauthProvider) client(ctx context.Context, code, verifier string) (*http.Client, error) {
+	token, err := p.config.Exchange(ctx, code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange %s code: %w", p.name, err)
+	}
+	return p.config.Client(ctx, token), nil
+}
+
+// googleUserInfoURL is the OpenID Connect user info endpoint of Google
+const googleUserInfoURL = "https://openidconnect.googleapis.com/v1/userinfo"
+
+// googleProvider logs users in with their Google account
+type googleProvider struct {
+	oauthProvider
+	userInfoURL string
+}
+
+// NewGoogleProvider creates the provider of the Google accounts, redirecting back to callbackURL
+func NewGoogleProvider(client OAuthClient, callbackURL string) OAuthProvider {
+	return &googleProvider{
+		oauthProvider: oauthProvider{name: ProviderGoogle, config: &oauth2.Config{
+			ClientID:     client.ClientID,
+			ClientSecret: client.ClientSecret,
+			Endpoint:     endpoints.Google,
+			RedirectURL:  callbackURL,
+			Scopes:       []string{"openid", "email"},
+		}},
+		userInfoURL: googleUserInfoURL,
+	}
+}
+
+func (p *googleProvider) Identify(ctx context.Context, code, verifier string) (*Identity, error) {
+	client, err := p.client(ctx, code, verifier)
+	if err != nil {
+		return nil, err
+	}
+
+	var info struct {
+		Subject       string `json:"sub"`
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+	}
+	if err = getJSON(ctx, client, p.userInfoURL, &info); err != nil {
+		return nil, fmt.Errorf("failed to get google user info: %w", err)
+	}
+	return &Identity{
+		Provider:      ProviderGoogle,
+		Subject:       info.Subject,
+		Email:         info.Email,
+		EmailVerified: info.EmailVerified,
+	}, nil
+}
+
+// githubAPIURL is the REST API of GitHub
+const githubAPIURL = "https://api.github.com"
+
+// githubProvider logs users in with their GitHub account
+type githubProvider struct {
+	oauthProvider
+	apiURL string
+}
+
+// NewGitHubProvider creates the provider of the GitHub accounts, redirecting back to callbackURL
+func NewGitHubProvider(client OAuthClient, callbackURL string) OAuthProvider {
+	return &githubProvider{
+		oauthProvider: oauthProvider{name: ProviderGitHub, config: &oauth2.Config{
+			ClientID:     client.ClientID,
+			ClientSecret: client.ClientSecret,
+			Endpoint:     endpoints.GitHub,
+			RedirectURL:  callbackURL,
+			Scopes:       []string{"user:email"},
+		}},
+		apiURL: githubAPIURL,
+	}
+}
+
+// Identify returns the GitHub account with its primary email. The public email of the profile
+// may be unverified or hidden, so the primary one is read from the emails of the account.
+func (p *githubProvider) Identify(ctx context.Context, code, verifier string) (*Identity, error) {
+	client, err := p.client(ctx, code, verifier)
+	if err != nil {
+		return nil, err
+	}
+
+	var user struct {
+		ID int64 `json:"id"`
+	}
+	if err = getJSON(ctx, client, p.apiURL+"/user", &user); err != nil {
+		return nil, fmt.Errorf("failed to get github user: %w", err)
+	}
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	if err = getJSON(ctx, client, p.apiURL+"/user/emails", &emails); err != nil {
+		return nil, fmt.Errorf("failed to get github user emails: %w", err)
+	}
+
+	identity := &Identity{Provider: ProviderGitHub, Subject: strconv.FormatInt(user.ID, 10)}
+	for _, email := range emails {
+		if email.Primary {
+			identity.Email, identity.EmailVerified = email.Email, email.Verified
+		}
+	}
+	return identity, nil
+}
+
+// getJSON gets url with client and decodes its JSON response into v
+func getJSON(ctx context.Context, client *http.Client, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
@@ -0,0 +1,90 @@
+package users
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+// newProviderServer serves the token endpoint and the routes of the API of a provider, requiring
+// the token issued on the routes
+func newProviderServer(t *testing.T, routes map[string]any) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "code", r.FormValue("code"))
+		assert.Equal(t, "verifier", r.FormValue("code_verifier"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"token","token_type":"bearer"}`))
+	})
+	for path, body := range routes {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(body)
+		})
+	}
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newTestProvider(name, tokenURL string) oauthProvider {
+	return oauthProvider{name: name, config: &oauth2.Config{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		Endpoint:     oauth2.Endpoint{AuthURL: "https://provider.test/authorize", TokenURL: tokenURL},
+	}}
+}
+
+func TestGoogleProvider_Identify(t *testing.T) {
+	t.Parallel()
+	server := newProviderServer(t, map[string]any{
+		"/userinfo": map[string]any{"sub": "1098", "email": "ana@example.com", "email_verified": true},
+	})
+	provider := &googleProvider{
+		oauthProvider: newTestProvider(ProviderGoogle, server.URL+"/token"),
+		userInfoURL:   server.URL + "/userinfo",
+	}
+
+	identity, err := provider.Identify(context.Background(), "code", "verifier")
+	require.NoError(t, err)
+	assert.Equal(t, &Identity{Provider: ProviderGoogle, Subject: "1098", Email: "ana@example.com", EmailVerified: true},
+		identity)
+}
+
+func TestGitHubProvider_Identify(t *testing.T) {
+	t.Parallel()
+	server := newProviderServer(t, map[string]any{
+		"/user": map[string]any{"id": 583231, "email": "public@example.com"},
+		"/user/emails": []map[string]any{
+			{"email": "old@example.com", "primary": false, "verified": true},
+			{"email": "ana@example.com", "primary": true, "verified": true},
+		},
+	})
+	provider := &githubProvider{oauthProvider: newTestProvider(ProviderGitHub, server.URL+"/token"), apiURL: server.URL}
+
+	identity, err := provider.Identify(context.Background(), "code", "verifier")
+	require.NoError(t, err)
+	assert.Equal(t, &Identity{Provider: ProviderGitHub, Subject: "583231", Email: "ana@example.com", EmailVerified: true},
+		identity)
+}
+
+func TestOAuthProvider_AuthCodeURL(t *testing.T) {
+	t.Parallel()
+	provider := NewGitHubProvider(OAuthClient{ClientID: "client", ClientSecret: "secret"},
+		OAuthConfig{CallbackBaseURL: "https://ticosintech.com/api/v1/"}.callbackURL(ProviderGitHub))
+
+	url := provider.AuthCodeURL("state", "verifier")
+	assert.Contains(t, url, "state=state")
+	assert.Contains(t, url, "code_challenge="+oauth2.S256ChallengeFromVerifier("verifier"))
+	assert.Contains(t, url, "redirect_uri=https%3A%2F%2Fticosintech.com%2Fapi%2Fv1%2Fauth%2Foauth%2Fgithub%2Fcallback")
+}
@@ -0,0 +1,512 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+)
+
+// SQL query constants
+const (
+	createUserQuery = `
+        INSERT INTO users (email, password_hash)
+        VALUES ($1, $2)
+        RETURNING id, created_at, updated_at
+    `
+
+	getUserByEmailQuery = `
+        SELECT id, email, password_hash, created_at, updated_at
+        FROM users
+        WHERE email = $1
+    `
+
+	createSessionQuery = `
+        INSERT INTO user_sessions (token_hash, user_id, expires_at)
+        VALUES ($1, $2, $3)
+        RETURNING created_at
+    `
+
+	getSessionUserQuery = `
+        SELECT u.id, u.email, u.password_hash, u.created_at, u.updated_at
+        FROM user_sessions s
+        JOIN users u ON s.user_id = u.id
+        WHERE s.token_hash = $1 AND s.expires_at > NOW()
+    `
+
+	deleteSessionQuery = `DELETE FROM user_sessions WHERE token_hash = $1`
+
+	getIdentityUserQuery = `
+        SELECT u.id, u.email, u.password_hash, u.created_at, u.updated_at
+        FROM user_identities i
+        JOIN users u ON i.user_id = u.id
+        WHERE i.provider = $1 AND i.subject = $2
+    `
+
+	// Linking an already linked account keeps the original link. Linking an account removes the
+	// password of the user and ends its sessions: the email of a password registration isn't
+	// verified, so they may belong to someone who registered the email before its owner.
+	linkIdentityQuery = `
+        WITH linked AS (
+            INSERT INTO user_identities (provider, subject, user_id, email)
+            VALUES ($1, $2, $3, $4)
+            ON CONFLICT (provider, subject) DO NOTHING
+            RETURNING user_id
+        ), reset AS (
+            UPDATE users SET password_hash = '', updated_at = NOW()
+            WHERE id IN (SELECT user_id FROM linked) AND password_hash <> ''
+            RETURNING id
+        )
+        DELETE FROM user_sessions WHERE user_id IN (SELECT id FROM reset)
+    `
+
+	// Creates a user without a password, who logs in with the account $3 at the provider $2
+	createIdentityUserQuery = `
+        WITH created AS (
+            INSERT INTO users (email, password_hash)
+            VALUES ($1, '')
+            RETURNING id, created_at, updated_at
+        ), linked AS (
+            INSERT INTO user_identities (provider, subject, user_id, email)
+            SELECT $2, $3, id, $1 FROM created
+        )
+        SELECT id, created_at, updated_at FROM created
+    `
+
+	createLoginCodeQuery = `
+        INSERT INTO oauth_login_codes (code_hash, user_id, expires_at)
+        VALUES ($1, $2, $3)
+    `
+
+	// A code is exchanged once, it is removed as it is read
+	consumeLoginCodeQuery = `
+        DELETE FROM oauth_login_codes c
+        USING users u
+        WHERE c.code_hash = $1 AND c.user_id = u.id AND c.expires_at > NOW()
+        RETURNING u.id, u.email, u.password_hash, u.created_at, u.updated_at
+    `
+
+	deleteExpiredSessionsQuery = `DELETE FROM user_sessions WHERE user_id = $1 AND expires_at <= NOW()`
+
+	// The expired OAuth login codes are purged along with the sessions
+	purgeExpiredSessionsQuery = `
+        WITH codes AS (
+            DELETE FROM oauth_login_codes WHERE expires_at <= NOW()
+        )
+        DELETE FROM user_sessions WHERE expires_at <= NOW()
+    `
+
+	// Saving an already saved job keeps the original bookmark
+	addBookmarkQuery = `
+        INSERT INTO bookmarks (user_id, job_id)
+        VALUES ($1, $2)
+        ON CONFLICT (user_id, job_id) DO NOTHING
+    `
+
+	removeBookmarkQuery = `DELETE FROM bookmarks WHERE user_id = $1 AND job_id = $2`
+
+	countBookmarksQuery = `SELECT COUNT(*) FROM bookmarks WHERE user_id = $1`
+
+	// Applying again to a job updates the status and notes of the existing application
+	upsertApplicationQuery = `
+        INSERT INTO applications (user_id, job_id, status, notes)
+        VALUES ($1, $2, $3, $4)
+        ON CONFLICT (user_id, job_id)
+        DO UPDATE SET status = EXCLUDED.status, notes = EXCLUDED.notes, updated_at = NOW()
+        RETURNING created_at, updated_at
+    `
+
+	countApplicationsQuery = `
+        SELECT COUNT(*) FROM applications
+        WHERE user_id = $1 AND ($2 = '' OR status = $2)
+    `
+
+	// Most recently updated applications first
+	listApplicationsQuery = `
+        SELECT j.id, j.company_id, j.title, j.description, j.experience_level, j.employment_type,
+               j.location, j.work_mode, j.application_url, j.is_active, j.signature, j.language,
+               j.remote_eligibility, j.utc_offset_min, j.utc_offset_max,
+               j.created_at, j.updated_at, c.name, c.slug, c.logo_url,
+               ARRAY(
+                   SELECT bn.slug FROM job_benefits jbn
+                   JOIN benefits bn ON jbn.benefit_id = bn.id
+                   WHERE jbn.job_id = j.id
+                   ORDER BY bn.slug
+               ) AS benefits,
+               a.status, a.notes, a.created_at, a.updated_at
+        FROM applications a
+        JOIN jobs j ON a.job_id = j.id
+        JOIN companies c ON j.company_id = c.id
+        WHERE a.user_id = $1 AND ($2 = '' OR a.status = $2)
+        ORDER BY a.updated_at DESC, j.id
+        LIMIT $3 OFFSET $4
+    `
+
+	// Saved jobs are listed even after they are deactivated, most recently saved first
+	listBookmarksQuery = `
+        SELECT j.id, j.company_id, j.title, j.description, j.experience_level, j.employment_type,
+               j.location, j.work_mode, j.application_url, j.is_active, j.signature, j.language,
+               j.remote_eligibility, j.utc_offset_min, j.utc_offset_max,
+               j.created_at, j.updated_at, c.name, c.slug, c.logo_url,
+               ARRAY(
+                   SELECT bn.slug FROM job_benefits jbn
+                   JOIN benefits bn ON jbn.benefit_id = bn.id
+                   WHERE jbn.job_id = j.id
+                   ORDER BY bn.slug
+               ) AS benefits, b.created_at
+        FROM bookmarks b
+        JOIN jobs j ON b.job_id = j.id
+        JOIN companies c ON j.company_id = c.id
+        WHERE b.user_id = $1
+        ORDER BY b.created_at DESC, j.id
+        LIMIT $2 OFFSET $3
+    `
+)
+
+// Database interface to support pgxpool and mocks
+type Database interface {
+	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
+	Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+}
+
+// Repository handles database operations for the User model and its sessions and bookmarks.
+type Repository struct {
+	db Database
+}
+
+// NewRepository creates a new Repository instance.
+func NewRepository(db Database) *Repository {
+	return &Repository{db: db}
+}
+
+// Create inserts a new user into the database.
+func (r *Repository) Create(ctx context.Context, user *User) error {
+	err := r.db.QueryRow(ctx, createUserQuery, user.Email, user.PasswordHash).
+		Scan(&user.ID, &user.CreatedAt, &user.UpdatedAt)
+
+	if err != nil {
+		// Check for unique constraint violation (duplicate email)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return &DuplicateError{Email: user.Email}
+		}
+		return fmt.Errorf("failed to create user: %w", err)
+	}
+
+	return nil
+}
+
+// GetByEmail retrieves a user by its email.
+func (r *Repository) GetByEmail(ctx context.Context, email string) (*User, error) {
+	user := &User{}
+	err := r.db.QueryRow(ctx, getUserByEmailQuery, email).Scan(
+		&user.ID,
+		&user.Email,
+		&user.PasswordHash,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &NotFoundError{Email: email}
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return user, nil
+}
+
+// CreateSession stores a new session and removes the expired sessions of its user.
+func (r *Repository) CreateSession(ctx context.Context, session *Session) error {
+	if _, err := r.db.Exec(ctx, deleteExpiredSessionsQuery, session.UserID); err != nil {
+		return fmt.Errorf("failed to delete expired sessions: %w", err)
+	}
+
+	err := r.db.QueryRow(ctx, createSessionQuery, session.TokenHash, session.UserID, session.ExpiresAt).
+		Scan(&session.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create session: %w", err)
+	}
+
+	return nil
+}
+
+// GetSessionUser retrieves the user of an unexpired session. ErrInvalidSession is returned
+// when there is no such session.
+func (r *Repository) GetSessionUser(ctx context.Context, tokenHash string) (*User, error) {
+	user := &User{}
+	err := r.db.QueryRow(ctx, getSessionUserQuery, tokenHash).Scan(
+		&user.ID,
+		&user.Email,
+		&user.PasswordHash,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalidSession
+		}
+		return nil, fmt.Errorf("failed to get session user: %w", err)
+	}
+
+	return user, nil
+}
+
+// GetIdentityUser retrieves the user an account at an OAuth provider is linked to. An
+// IdentityNotFoundError is returned when the account isn't linked.
+func (r *Repository) GetIdentityUser(ctx context.Context, provider, subject string) (*User, error) {
+	user := &User{}
+	err := r.db.QueryRow(ctx, getIdentityUserQuery, provider, subject).Scan(
+		&user.ID,
+		&user.Email,
+		&user.PasswordHash,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &IdentityNotFoundError{Provider: provider, Subject: subject}
+		}
+		return nil, fmt.Errorf("failed to get identity user: %w", err)
+	}
+
+	return user, nil
+}
+
+// LinkIdentity links an account at an OAuth provider to a user, removing the password of the user
+// and ending its sessions when it has one. Linking it twice is not an error.
+func (r *Repository) LinkIdentity(ctx context.Context, userID int, identity *Identity) error {
+	_, err := r.db.Exec(ctx, linkIdentityQuery, identity.Provider, identity.Subject, userID, identity.Email)
+	if err != nil {
+		return fmt.Errorf("failed to link identity: %w", err)
+	}
+	return nil
+}
+
+// CreateWithIdentity inserts a new user without a password, linked to an account at an OAuth
+// provider. A DuplicateError is returned when the email is already registered.
+func (r *Repository) CreateWithIdentity(ctx context.Context, user *User, identity *Identity) error {
+	err := r.db.QueryRow(ctx, createIdentityUserQuery, user.Email, identity.Provider, identity.Subject).
+		Scan(&user.ID, &user.CreatedAt, &user.UpdatedAt)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return &DuplicateError{Email: user.Email}
+		}
+		return fmt.Errorf("failed to create user: %w", err)
+	}
+
+	return nil
+}
+
+// CreateLoginCode inserts the one-time code of an OAuth login
+func (r *Repository) CreateLoginCode(ctx context.Context, code *LoginCode) error {
+	if _, err := r.db.Exec(ctx, createLoginCodeQuery, code.CodeHash, code.UserID, code.ExpiresAt); err != nil {
+		return fmt.Errorf("failed to create login code: %w", err)
+	}
+	return nil
+}
+
+// ConsumeLoginCode removes the one-time code of an OAuth login and returns its user.
+// ErrInvalidLoginCode is returned when the code is unknown, expired or already consumed.
+func (r *Repository) ConsumeLoginCode(ctx context.Context, codeHash string) (*User, error) {
+	var user User
+	err := r.db.QueryRow(ctx, consumeLoginCodeQuery, codeHash).
+		Scan(&user.ID, &user.Email, &user.PasswordHash, &user.CreatedAt, &user.UpdatedAt)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalidLoginCode
+		}
+		return nil, fmt.Errorf("failed to consume login code: %w", err)
+	}
+
+	return &user, nil
+}
+
+// DeleteSession removes a session. Deleting an unknown session is not an error.
+func (r *Repository) DeleteSession(ctx context.Context, tokenHash string) error {
+	if _, err := r.db.Exec(ctx, deleteSessionQuery, tokenHash); err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+	return nil
+}
+
+// PurgeExpiredSessions removes the expired sessions of every user and returns how many were removed.
+// Expired sessions of a user are otherwise only removed when the user logs in again. The expired
+// OAuth login codes are removed too, without being counted.
+func (r *Repository) PurgeExpiredSessions(ctx context.Context) (int64, error) {
+	commandTag, err := r.db.Exec(ctx, purgeExpiredSessionsQuery)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge expired sessions: %w", err)
+	}
+	return commandTag.RowsAffected(), nil
+}
+
+// AddBookmark saves a job for a user. Saving a job twice is not an error.
+// A jobs.NotFoundError is returned when the job doesn't exist.
+func (r *Repository) AddBookmark(ctx context.Context, userID, jobID int) error {
+	if _, err := r.db.Exec(ctx, addBookmarkQuery, userID, jobID); err != nil {
+		// Check for foreign key violation (unknown job)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return &jobs.NotFoundError{ID: jobID}
+		}
+		return fmt.Errorf("failed to add bookmark: %w", err)
+	}
+	return nil
+}
+
+// RemoveBookmark removes a saved job of a user. Removing a job that wasn't saved is not an error.
+func (r *Repository) RemoveBookmark(ctx context.Context, userID, jobID int) error {
+	if _, err := r.db.Exec(ctx, removeBookmarkQuery, userID, jobID); err != nil {
+		return fmt.Errorf("failed to remove bookmark: %w", err)
+	}
+	return nil
+}
+
+// ListBookmarks retrieves a page of the jobs saved by a user and the total number of saved jobs.
+func (r *Repository) ListBookmarks(ctx context.Context, params *BookmarkListParams) ([]*SavedJob, int, error) {
+	var total int
+	if err := r.db.QueryRow(ctx, countBookmarksQuery, params.UserID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count bookmarks: %w", err)
+	}
+
+	rows, err := r.db.Query(ctx, listBookmarksQuery, params.UserID, params.Limit, params.Offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list bookmarks: %w", err)
+	}
+	defer rows.Close()
+
+	var saved []*SavedJob
+	for rows.Next() {
+		job := &SavedJob{}
+		err = rows.Scan(
+			&job.ID,
+			&job.CompanyID,
+			&job.Title,
+			&job.Description,
+			&job.ExperienceLevel,
+			&job.EmploymentType,
+			&job.Location,
+			&job.WorkMode,
+			&job.ApplicationURL,
+			&job.IsActive,
+			&job.Signature,
+			&job.Language,
+			&job.RemoteEligibility,
+			&job.UTCOffsetMin,
+			&job.UTCOffsetMax,
+			&job.CreatedAt,
+			&job.UpdatedAt,
+			&job.CompanyName,
+			&job.CompanySlug,
+			&job.CompanyLogoURL,
+			&job.Benefits,
+			&job.SavedAt,
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan bookmark row: %w", err)
+		}
+		saved = append(saved, job)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating bookmark rows: %w", err)
+	}
+
+	return saved, total, nil
+}
+
+// UpsertApplication stores the application of a user to a job, updating it when it already exists.
+// A jobs.NotFoundError is returned when the job doesn't exist.
+func (r *Repository) UpsertApplication(ctx context.Context, application *Application) error {
+	err := r.db.QueryRow(
+		ctx,
+		upsertApplicationQuery,
+		application.UserID,
+		application.JobID,
+		application.Status,
+		application.Notes,
+	).Scan(&application.CreatedAt, &application.UpdatedAt)
+
+	if err != nil {
+		// Check for foreign key violation (unknown job)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return &jobs.NotFoundError{ID: application.JobID}
+		}
+		return fmt.Errorf("failed to upsert application: %w", err)
+	}
+
+	return nil
+}
+
+// ListApplications retrieves a page of the applications of a user with their jobs,
+// and the total number of applications matching params.
+func (r *Repository) ListApplications(ctx context.Context, params *ApplicationListParams) ([]*AppliedJob, int, error) {
+	var total int
+	err := r.db.QueryRow(ctx, countApplicationsQuery, params.UserID, params.Status).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count applications: %w", err)
+	}
+
+	rows, err := r.db.Query(ctx, listApplicationsQuery, params.UserID, params.Status, params.Limit, params.Offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list applications: %w", err)
+	}
+	defer rows.Close()
+
+	var applied []*AppliedJob
+	for rows.Next() {
+		job := &AppliedJob{}
+		err = rows.Scan(
+			&job.ID,
+			&job.CompanyID,
+			&job.Title,
+			&job.Description,
+			&job.ExperienceLevel,
+			&job.EmploymentType,
+			&job.Location,
+			&job.WorkMode,
+			&job.ApplicationURL,
+			&job.IsActive,
+			&job.Signature,
+			&job.Language,
+			&job.RemoteEligibility,
+			&job.UTCOffsetMin,
+			&job.UTCOffsetMax,
+			&job.CreatedAt,
+			&job.UpdatedAt,
+			&job.CompanyName,
+			&job.CompanySlug,
+			&job.CompanyLogoURL,
+			&job.Benefits,
+			&job.Application.Status,
+			&job.Application.Notes,
+			&job.Application.CreatedAt,
+			&job.Application.UpdatedAt,
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan application row: %w", err)
+		}
+		job.Application.UserID = params.UserID
+		job.Application.JobID = job.ID
+		applied = append(applied, job)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating application rows: %w", err)
+	}
+
+	return applied, total, nil
+}
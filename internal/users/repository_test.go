@@ -0,0 +1,595 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+)
+
+func TestRepository_Create(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+
+	tests := []struct {
+		name         string
+		user         *User
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, user *User, err error)
+	}{
+		{
+			name: "user created",
+			user: &User{Email: "ana@example.com", PasswordHash: "hash"},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(createUserQuery)).
+					WithArgs("ana@example.com", "hash").
+					WillReturnRows(pgxmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow(1, now, now))
+			},
+			checkResults: func(t *testing.T, user *User, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 1, user.ID)
+				assert.Equal(t, now, user.CreatedAt)
+			},
+		},
+		{
+			name: "duplicate email",
+			user: &User{Email: "ana@example.com", PasswordHash: "hash"},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(createUserQuery)).
+					WithArgs("ana@example.com", "hash").
+					WillReturnError(&pgconn.PgError{Code: "23505"})
+			},
+			checkResults: func(t *testing.T, _ *User, err error) {
+				t.Helper()
+				assert.True(t, IsDuplicate(err))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			err = repo.Create(context.Background(), tt.user)
+			tt.checkResults(t, tt.user, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_GetSessionUser(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, user *User, err error)
+	}{
+		{
+			name: "session user found",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getSessionUserQuery)).
+					WithArgs("token-hash").
+					WillReturnRows(pgxmock.NewRows([]string{"id", "email", "password_hash", "created_at", "updated_at"}).
+						AddRow(1, "ana@example.com", "hash", now, now))
+			},
+			checkResults: func(t *testing.T, user *User, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, "ana@example.com", user.Email)
+			},
+		},
+		{
+			name: "unknown or expired session",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getSessionUserQuery)).
+					WithArgs("token-hash").
+					WillReturnError(pgx.ErrNoRows)
+			},
+			checkResults: func(t *testing.T, _ *User, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, ErrInvalidSession)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getSessionUserQuery)).
+					WithArgs("token-hash").
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ *User, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			user, err := repo.GetSessionUser(context.Background(), "token-hash")
+			tt.checkResults(t, user, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_GetIdentityUser(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, user *User, err error)
+	}{
+		{
+			name: "linked user found",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getIdentityUserQuery)).
+					WithArgs(ProviderGitHub, "583231").
+					WillReturnRows(pgxmock.NewRows([]string{"id", "email", "password_hash", "created_at", "updated_at"}).
+						AddRow(1, "ana@example.com", "", now, now))
+			},
+			checkResults: func(t *testing.T, user *User, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 1, user.ID)
+			},
+		},
+		{
+			name: "account not linked",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(getIdentityUserQuery)).
+					WithArgs(ProviderGitHub, "583231").
+					WillReturnError(pgx.ErrNoRows)
+			},
+			checkResults: func(t *testing.T, _ *User, err error) {
+				t.Helper()
+				var notLinkedErr *IdentityNotFoundError
+				require.ErrorAs(t, err, &notLinkedErr)
+				assert.ErrorIs(t, err, httpservice.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			user, err := repo.GetIdentityUser(context.Background(), ProviderGitHub, "583231")
+			tt.checkResults(t, user, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_CreateWithIdentity(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	identity := &Identity{Provider: ProviderGoogle, Subject: "1098", Email: "ana@example.com", EmailVerified: true}
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, user *User, err error)
+	}{
+		{
+			name: "user created and linked",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(createIdentityUserQuery)).
+					WithArgs("ana@example.com", ProviderGoogle, "1098").
+					WillReturnRows(pgxmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow(7, now, now))
+			},
+			checkResults: func(t *testing.T, user *User, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 7, user.ID)
+				assert.Equal(t, now, user.CreatedAt)
+			},
+		},
+		{
+			name: "email already registered",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(createIdentityUserQuery)).
+					WithArgs("ana@example.com", ProviderGoogle, "1098").
+					WillReturnError(&pgconn.PgError{Code: "23505"})
+			},
+			checkResults: func(t *testing.T, _ *User, err error) {
+				t.Helper()
+				assert.True(t, IsDuplicate(err))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			user := &User{Email: "ana@example.com"}
+			err = repo.CreateWithIdentity(context.Background(), user, identity)
+			tt.checkResults(t, user, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_LinkIdentity(t *testing.T) {
+	t.Parallel()
+	mockDB, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	mockDB.ExpectExec(regexp.QuoteMeta(linkIdentityQuery)).
+		WithArgs(ProviderGitHub, "583231", 1, "ana@example.com").
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	identity := &Identity{Provider: ProviderGitHub, Subject: "583231", Email: "ana@example.com"}
+	require.NoError(t, NewRepository(mockDB).LinkIdentity(context.Background(), 1, identity))
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
+
+func TestRepository_ConsumeLoginCode(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, user *User, err error)
+	}{
+		{
+			name: "code consumed",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(consumeLoginCodeQuery)).
+					WithArgs("code-hash").
+					WillReturnRows(pgxmock.NewRows([]string{"id", "email", "password_hash", "created_at", "updated_at"}).
+						AddRow(1, "ana@example.com", "", now, now))
+			},
+			checkResults: func(t *testing.T, user *User, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 1, user.ID)
+			},
+		},
+		{
+			name: "code expired or already consumed",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(consumeLoginCodeQuery)).
+					WithArgs("code-hash").
+					WillReturnError(pgx.ErrNoRows)
+			},
+			checkResults: func(t *testing.T, _ *User, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, ErrInvalidLoginCode)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			user, err := repo.ConsumeLoginCode(context.Background(), "code-hash")
+			tt.checkResults(t, user, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_PurgeExpiredSessions(t *testing.T) {
+	t.Parallel()
+	mockDB, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	mockDB.ExpectExec(regexp.QuoteMeta(purgeExpiredSessionsQuery)).
+		WillReturnResult(pgxmock.NewResult("DELETE", 3))
+
+	purged, err := NewRepository(mockDB).PurgeExpiredSessions(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), purged)
+
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
+
+func TestRepository_AddBookmark(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, err error)
+	}{
+		{
+			name: "bookmark added",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(addBookmarkQuery)).
+					WithArgs(1, 7).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "unknown job",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(addBookmarkQuery)).
+					WithArgs(1, 7).
+					WillReturnError(&pgconn.PgError{Code: "23503"})
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				assert.True(t, jobs.IsNotFound(err))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			err = repo.AddBookmark(context.Background(), 1, 7)
+			tt.checkResults(t, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_ListBookmarks(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	dbError := errors.New("database error")
+	columns := []string{
+		"id", "company_id", "title", "description", "experience_level", "employment_type",
+		"location", "work_mode", "application_url", "is_active", "signature", "language",
+		"remote_eligibility", "utc_offset_min", "utc_offset_max", "created_at", "updated_at",
+		"name", "slug", "logo_url", "benefits", "created_at",
+	}
+	params := &BookmarkListParams{UserID: 1, Limit: 20}
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, saved []*SavedJob, total int, err error)
+	}{
+		{
+			name: "saved jobs listed",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(countBookmarksQuery)).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectQuery(regexp.QuoteMeta(listBookmarksQuery)).
+					WithArgs(1, 20, 0).
+					WillReturnRows(pgxmock.NewRows(columns).
+						AddRow(7, 2, "Go Developer", "Build APIs", "Senior", "Full-time",
+							"Costa Rica", "Remote", "https://techcorp.com/jobs/7", false, "sig7", jobs.LanguageEnglish,
+							nil, nil, nil, now, now,
+							"Tech Corp", "tech-corp", "https://techcorp.com/logo.png", []string{}, now))
+			},
+			checkResults: func(t *testing.T, saved []*SavedJob, total int, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 1, total)
+				require.Len(t, saved, 1)
+				assert.Equal(t, 7, saved[0].ID)
+				assert.False(t, saved[0].IsActive)
+				assert.Equal(t, "tech-corp", saved[0].CompanySlug)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(countBookmarksQuery)).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectQuery(regexp.QuoteMeta(listBookmarksQuery)).
+					WithArgs(1, 20, 0).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ []*SavedJob, _ int, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			saved, total, err := repo.ListBookmarks(context.Background(), params)
+			tt.checkResults(t, saved, total, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_UpsertApplication(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, application *Application, err error)
+	}{
+		{
+			name: "application stored",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(upsertApplicationQuery)).
+					WithArgs(1, 7, StatusInterviewing, "Second round").
+					WillReturnRows(pgxmock.NewRows([]string{"created_at", "updated_at"}).AddRow(now, now))
+			},
+			checkResults: func(t *testing.T, application *Application, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, now, application.UpdatedAt)
+			},
+		},
+		{
+			name: "unknown job",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(upsertApplicationQuery)).
+					WithArgs(1, 7, StatusInterviewing, "Second round").
+					WillReturnError(&pgconn.PgError{Code: "23503"})
+			},
+			checkResults: func(t *testing.T, _ *Application, err error) {
+				t.Helper()
+				assert.True(t, jobs.IsNotFound(err))
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(upsertApplicationQuery)).
+					WithArgs(1, 7, StatusInterviewing, "Second round").
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ *Application, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			application := &Application{UserID: 1, JobID: 7, Status: StatusInterviewing, Notes: "Second round"}
+			err = repo.UpsertApplication(context.Background(), application)
+			tt.checkResults(t, application, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_ListApplications(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	columns := []string{
+		"id", "company_id", "title", "description", "experience_level", "employment_type",
+		"location", "work_mode", "application_url", "is_active", "signature", "language",
+		"remote_eligibility", "utc_offset_min", "utc_offset_max", "created_at", "updated_at",
+		"name", "slug", "logo_url", "benefits", "status", "notes", "created_at", "updated_at",
+	}
+
+	mockDB, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	mockDB.ExpectQuery(regexp.QuoteMeta(countApplicationsQuery)).
+		WithArgs(1, StatusOffer).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(1))
+	mockDB.ExpectQuery(regexp.QuoteMeta(listApplicationsQuery)).
+		WithArgs(1, StatusOffer, 20, 0).
+		WillReturnRows(pgxmock.NewRows(columns).
+			AddRow(7, 2, "Go Developer", "Build APIs", "Senior", "Full-time",
+				"Costa Rica", "Remote", "https://techcorp.com/jobs/7", true, "sig7", jobs.LanguageEnglish,
+				nil, nil, nil, now, now,
+				"Tech Corp", "tech-corp", "https://techcorp.com/logo.png", []string{}, StatusOffer, "", now, now))
+
+	repo := NewRepository(mockDB)
+	applied, total, err := repo.ListApplications(context.Background(),
+		&ApplicationListParams{UserID: 1, Status: StatusOffer, Limit: 20})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, applied, 1)
+	assert.Equal(t, 7, applied[0].Application.JobID)
+	assert.Equal(t, StatusOffer, applied[0].Application.Status)
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
@@ -0,0 +1,407 @@
+package users
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/oauth2"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+	"github.com/rodruizronald/ticos-in-tech/internal/i18n"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobtech"
+)
+
+// Account and session settings
+const (
+	MinPasswordLength = 8
+	// MaxPasswordLength is the longest password bcrypt can hash
+	MaxPasswordLength = 72
+	SessionTTL        = 30 * 24 * time.Hour
+	// LoginCodeTTL is how long the frontend has to exchange the code of an OAuth login
+	LoginCodeTTL      = time.Minute
+	sessionTokenBytes = 32
+
+	// Page size of the saved jobs and applications lists
+	DefaultListLimit = 20
+	MaxListLimit     = 100
+
+	MaxApplicationNotesLength = 2000
+)
+
+// DataRepository interface to make database operations for users, sessions and bookmarks.
+type DataRepository interface {
+	Create(ctx context.Context, user *User) error
+	GetByEmail(ctx context.Context, email string) (*User, error)
+	CreateSession(ctx context.Context, session *Session) error
+	GetSessionUser(ctx context.Context, tokenHash string) (*User, error)
+	DeleteSession(ctx context.Context, tokenHash string) error
+	AddBookmark(ctx context.Context, userID, jobID int) error
+	RemoveBookmark(ctx context.Context, userID, jobID int) error
+	ListBookmarks(ctx context.Context, params *BookmarkListParams) ([]*SavedJob, int, error)
+	UpsertApplication(ctx context.Context, application *Application) error
+	ListApplications(ctx context.Context, params *ApplicationListParams) ([]*AppliedJob, int, error)
+}
+
+// TechnologyRepository interface to fetch the technologies of the saved jobs.
+type TechnologyRepository interface {
+	GetJobTechnologiesBatch(ctx context.Context, jobIDs []int) (map[int][]*jobtech.JobTechnologyWithDetails, error)
+}
+
+// UserService holds the business logic for user accounts, sessions and bookmarks.
+type UserService struct {
+	repo     DataRepository
+	techRepo TechnologyRepository
+	now      func() time.Time
+}
+
+// NewUserService creates a new instance of UserService
+func NewUserService(repo DataRepository, techRepo TechnologyRepository) *UserService {
+	return &UserService{repo: repo, techRepo: techRepo, now: time.Now}
+}
+
+// Register validates and creates a new user with a hashed password.
+// Returns a DuplicateError if the email is already registered.
+func (s *UserService) Register(ctx context.Context, email, password string) (*User, error) {
+	email = normalizeEmail(email)
+	if err := validateCredentials(email, password); err != nil {
+		return nil, err
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	user := &User{Email: email, PasswordHash: string(hash)}
+	if err = s.repo.Create(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// Login checks the credentials of a user and opens a new session.
+// ErrInvalidCredentials is returned for an unknown email or a wrong password.
+func (s *UserService) Login(ctx context.Context, email, password string) (*Session, error) {
+	user, err := s.repo.GetByEmail(ctx, normalizeEmail(email))
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, err
+	}
+
+	if err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	return s.openSession(ctx, user)
+}
+
+// openSession opens a new session of a user
+func (s *UserService) openSession(ctx context.Context, user *User) (*Session, error) {
+	token, err := newSessionToken()
+	if err != nil {
+		return nil, err
+	}
+
+	session := &Session{
+		Token:     token,
+		TokenHash: hashToken(token),
+		UserID:    user.ID,
+		ExpiresAt: s.now().Add(SessionTTL),
+		User:      user,
+	}
+	if err = s.repo.CreateSession(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// Authenticate returns the user of a session token. ErrInvalidSession is returned
+// when the token is unknown or expired.
+func (s *UserService) Authenticate(ctx context.Context, token string) (*User, error) {
+	if token == "" {
+		return nil, ErrInvalidSession
+	}
+	return s.repo.GetSessionUser(ctx, hashToken(token))
+}
+
+// Logout closes the session of a token
+func (s *UserService) Logout(ctx context.Context, token string) error {
+	return s.repo.DeleteSession(ctx, hashToken(token))
+}
+
+// SaveJob bookmarks a job for a user
+func (s *UserService) SaveJob(ctx context.Context, userID, jobID int) error {
+	return s.repo.AddBookmark(ctx, userID, jobID)
+}
+
+// UnsaveJob removes a bookmarked job of a user
+func (s *UserService) UnsaveJob(ctx context.Context, userID, jobID int) error {
+	return s.repo.RemoveBookmark(ctx, userID, jobID)
+}
+
+// SavedJobs returns a page of the jobs saved by a user with their technologies.
+// Unset params take their defaults and out of range values are clamped.
+func (s *UserService) SavedJobs(ctx context.Context, params BookmarkListParams) (*SavedJobListResponse, error) {
+	if params.Limit <= 0 {
+		params.Limit = DefaultListLimit
+	}
+	params.Limit = min(params.Limit, MaxListLimit)
+	params.Offset = max(params.Offset, 0)
+
+	saved, total, err := s.repo.ListBookmarks(ctx, &params)
+	if err != nil {
+		return nil, err
+	}
+
+	jobIDs := make([]int, len(saved))
+	for i, job := range saved {
+		jobIDs[i] = job.ID
+	}
+
+	technologiesMap, err := s.techRepo.GetJobTechnologiesBatch(ctx, jobIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return MapSavedJobsToResponse(saved, technologiesMap, total, &params, i18n.From(ctx)), nil
+}
+
+// MarkApplied records that a user applied to a job, or updates the status and notes of the
+// application when it was already recorded. An empty status means StatusApplied.
+func (s *UserService) MarkApplied(ctx context.Context, application *Application) error {
+	if application.Status == "" {
+		application.Status = StatusApplied
+	}
+	if !application.Status.IsValid() {
+		return &InvalidStatusError{Status: string(application.Status)}
+	}
+
+	application.Notes = strings.TrimSpace(application.Notes)
+	if len(application.Notes) > MaxApplicationNotesLength {
+		return &httpservice.ValidationError{Errors: []string{
+			fmt.Sprintf("notes must be at most %d characters", MaxApplicationNotesLength),
+		}}
+	}
+
+	return s.repo.UpsertApplication(ctx, application)
+}
+
+// Applications returns a page of the applications of a user with their jobs and technologies.
+// Unset params take their defaults and out of range values are clamped.
+func (s *UserService) Applications(ctx context.Context, params ApplicationListParams) (
+	*ApplicationListResponse, error) {
+	if params.Status != "" && !params.Status.IsValid() {
+		return nil, &InvalidStatusError{Status: string(params.Status)}
+	}
+
+	if params.Limit <= 0 {
+		params.Limit = DefaultListLimit
+	}
+	params.Limit = min(params.Limit, MaxListLimit)
+	params.Offset = max(params.Offset, 0)
+
+	applied, total, err := s.repo.ListApplications(ctx, &params)
+	if err != nil {
+		return nil, err
+	}
+
+	jobIDs := make([]int, len(applied))
+	for i, job := range applied {
+		jobIDs[i] = job.ID
+	}
+
+	technologiesMap, err := s.techRepo.GetJobTechnologiesBatch(ctx, jobIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return MapApplicationsToResponse(applied, technologiesMap, total, &params, i18n.From(ctx)), nil
+}
+
+// IdentityRepository interface to make database operations for the accounts at OAuth providers
+// users log in with.
+type IdentityRepository interface {
+	GetByEmail(ctx context.Context, email string) (*User, error)
+	GetIdentityUser(ctx context.Context, provider, subject string) (*User, error)
+	LinkIdentity(ctx context.Context, userID int, identity *Identity) error
+	CreateWithIdentity(ctx context.Context, user *User, identity *Identity) error
+	CreateLoginCode(ctx context.Context, code *LoginCode) error
+	ConsumeLoginCode(ctx context.Context, codeHash string) (*User, error)
+}
+
+// OAuthLogin is an OAuth login started by a client, redirected to the consent page of the
+// provider. The client keeps the state and the verifier until the provider redirects back.
+type OAuthLogin struct {
+	URL      string
+	State    string
+	Verifier string
+}
+
+// OAuthService holds the business logic to log users in with their accounts at OAuth providers,
+// opening the same sessions as a login with a password.
+type OAuthService struct {
+	repo      IdentityRepository
+	users     *UserService
+	providers map[string]OAuthProvider
+}
+
+// NewOAuthService creates a new instance of OAuthService logging users in with providers
+func NewOAuthService(repo IdentityRepository, users *UserService, providers ...OAuthProvider) *OAuthService {
+	byName := make(map[string]OAuthProvider, len(providers))
+	for _, provider := range providers {
+		byName[provider.Name()] = provider
+	}
+	return &OAuthService{repo: repo, users: users, providers: byName}
+}
+
+// Begin starts a login with provider, returning the consent page the client is redirected to.
+// An UnknownProviderError is returned when the provider isn't configured.
+func (s *OAuthService) Begin(provider string) (*OAuthLogin, error) {
+	p, ok := s.providers[provider]
+	if !ok {
+		return nil, &UnknownProviderError{Provider: provider}
+	}
+
+	state, err := newSessionToken()
+	if err != nil {
+		return nil, err
+	}
+	verifier := oauth2.GenerateVerifier()
+	return &OAuthLogin{URL: p.AuthCodeURL(state, verifier), State: state, Verifier: verifier}, nil
+}
+
+// Complete exchanges the code the provider redirected back with and returns a one-time login code
+// of the user of the account, exchanged for a session with Exchange. An account logging in for the
+// first time is linked to the user of its email, or to a new user without a password when the
+// email isn't registered, as long as the provider verified the email. Otherwise ErrUnverifiedEmail
+// is returned. A user linked this way loses its password.
+func (s *OAuthService) Complete(ctx context.Context, provider, code, verifier string) (*LoginCode, error) {
+	p, ok := s.providers[provider]
+	if !ok {
+		return nil, &UnknownProviderError{Provider: provider}
+	}
+
+	identity, err := p.Identify(ctx, code, verifier)
+	if err != nil {
+		return nil, &OAuthError{Provider: provider, Err: err}
+	}
+	if identity.Subject == "" {
+		return nil, &OAuthError{Provider: provider, Err: errors.New("account without an ID")}
+	}
+
+	user, err := s.repo.GetIdentityUser(ctx, provider, identity.Subject)
+	var notLinkedErr *IdentityNotFoundError
+	if errors.As(err, &notLinkedErr) {
+		user, err = s.link(ctx, identity)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := newSessionToken()
+	if err != nil {
+		return nil, err
+	}
+	loginCode := &LoginCode{
+		Code:      token,
+		CodeHash:  hashToken(token),
+		UserID:    user.ID,
+		ExpiresAt: s.users.now().Add(LoginCodeTTL),
+	}
+	if err = s.repo.CreateLoginCode(ctx, loginCode); err != nil {
+		return nil, err
+	}
+	return loginCode, nil
+}
+
+// Exchange opens a session of the user of the one-time code of an OAuth login, consuming the code.
+// ErrInvalidLoginCode is returned when the code is unknown, expired or already exchanged.
+func (s *OAuthService) Exchange(ctx context.Context, code string) (*Session, error) {
+	user, err := s.repo.ConsumeLoginCode(ctx, hashToken(code))
+	if err != nil {
+		return nil, err
+	}
+	return s.users.openSession(ctx, user)
+}
+
+// link links an account to the user of its verified email, created when it isn't registered. The
+// email of a password registration isn't verified, so linking removes the password of the user and
+// ends its sessions: whoever registered the email before its owner loses access to the account.
+func (s *OAuthService) link(ctx context.Context, identity *Identity) (*User, error) {
+	identity.Email = normalizeEmail(identity.Email)
+	if identity.Email == "" || !identity.EmailVerified {
+		return nil, ErrUnverifiedEmail
+	}
+
+	user, err := s.repo.GetByEmail(ctx, identity.Email)
+	if err == nil {
+		if err = s.repo.LinkIdentity(ctx, user.ID, identity); err != nil {
+			return nil, err
+		}
+		user.PasswordHash = ""
+		return user, nil
+	}
+	if !IsNotFound(err) {
+		return nil, err
+	}
+
+	user = &User{Email: identity.Email}
+	if err = s.repo.CreateWithIdentity(ctx, user, identity); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// normalizeEmail makes emails case-insensitive
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// validateCredentials validates the email and password of a new user
+func validateCredentials(email, password string) error {
+	var errs []string
+
+	if address, err := mail.ParseAddress(email); err != nil || address.Address != email {
+		errs = append(errs, "email must be a valid email address")
+	}
+	if len(password) < MinPasswordLength {
+		errs = append(errs, fmt.Sprintf("password must be at least %d characters", MinPasswordLength))
+	}
+	if len(password) > MaxPasswordLength {
+		errs = append(errs, fmt.Sprintf("password must be at most %d bytes", MaxPasswordLength))
+	}
+
+	if len(errs) > 0 {
+		return &httpservice.ValidationError{Errors: errs}
+	}
+
+	return nil
+}
+
+// newSessionToken generates a random URL-safe session token
+func newSessionToken() (string, error) {
+	b := make([]byte, sessionTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate session token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashToken returns the hex SHA-256 of a session token, the form in which it is stored
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
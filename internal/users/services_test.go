@@ -0,0 +1,506 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobtech"
+)
+
+func TestUserService_Register(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		email        string
+		password     string
+		mockSetup    func(mockRepo *MockDataRepository)
+		checkResults func(t *testing.T, user *User, err error)
+	}{
+		{
+			name:     "user registered with normalized email and hashed password",
+			email:    "  Ana@Example.com ",
+			password: "correct-horse",
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().Create(context.Background(), mock.MatchedBy(func(u *User) bool {
+					return u.Email == "ana@example.com" &&
+						bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte("correct-horse")) == nil
+				})).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, user *User, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, "ana@example.com", user.Email)
+			},
+		},
+		{
+			name:      "invalid email and short password",
+			email:     "not an email",
+			password:  "short",
+			mockSetup: func(_ *MockDataRepository) {},
+			checkResults: func(t *testing.T, _ *User, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Len(t, validationErr.Errors, 2)
+			},
+		},
+		{
+			name:     "repository error",
+			email:    "ana@example.com",
+			password: "correct-horse",
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().Create(context.Background(), mock.Anything).Return(dbError).Once()
+			},
+			checkResults: func(t *testing.T, _ *User, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			service := NewUserService(mockRepo, NewMockTechnologyRepository(t))
+
+			tt.mockSetup(mockRepo)
+
+			user, err := service.Register(context.Background(), tt.email, tt.password)
+			tt.checkResults(t, user, err)
+		})
+	}
+}
+
+func TestUserService_Login(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+	hash, err := bcrypt.GenerateFromPassword([]byte("correct-horse"), bcrypt.MinCost)
+	require.NoError(t, err)
+	user := &User{ID: 1, Email: "ana@example.com", PasswordHash: string(hash)}
+
+	tests := []struct {
+		name         string
+		password     string
+		mockSetup    func(mockRepo *MockDataRepository)
+		checkResults func(t *testing.T, session *Session, err error)
+	}{
+		{
+			name:     "session created",
+			password: "correct-horse",
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetByEmail(context.Background(), "ana@example.com").Return(user, nil).Once()
+				mockRepo.EXPECT().CreateSession(context.Background(), mock.MatchedBy(func(s *Session) bool {
+					return s.UserID == 1 && s.TokenHash == hashToken(s.Token) && s.ExpiresAt.Equal(now.Add(SessionTTL))
+				})).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, session *Session, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.NotEmpty(t, session.Token)
+				assert.Equal(t, user, session.User)
+			},
+		},
+		{
+			name:     "wrong password",
+			password: "wrong-horse",
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetByEmail(context.Background(), "ana@example.com").Return(user, nil).Once()
+			},
+			checkResults: func(t *testing.T, _ *Session, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, ErrInvalidCredentials)
+			},
+		},
+		{
+			name:     "unknown email",
+			password: "correct-horse",
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().GetByEmail(context.Background(), "ana@example.com").
+					Return(nil, &NotFoundError{Email: "ana@example.com"}).Once()
+			},
+			checkResults: func(t *testing.T, _ *Session, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, ErrInvalidCredentials)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			service := NewUserService(mockRepo, NewMockTechnologyRepository(t))
+			service.now = func() time.Time { return now }
+
+			tt.mockSetup(mockRepo)
+
+			session, err := service.Login(context.Background(), "Ana@example.com", tt.password)
+			tt.checkResults(t, session, err)
+		})
+	}
+}
+
+func TestUserService_SavedJobs(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+	savedAt := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		params       BookmarkListParams
+		mockSetup    func(mockRepo *MockDataRepository, mockTechRepo *MockTechnologyRepository)
+		checkResults func(t *testing.T, resp *SavedJobListResponse, err error)
+	}{
+		{
+			name:   "saved jobs with technologies",
+			params: BookmarkListParams{UserID: 1},
+			mockSetup: func(mockRepo *MockDataRepository, mockTechRepo *MockTechnologyRepository) {
+				t.Helper()
+				mockRepo.EXPECT().ListBookmarks(context.Background(), &BookmarkListParams{
+					UserID: 1,
+					Limit:  DefaultListLimit,
+				}).Return([]*SavedJob{{
+					JobWithCompany: jobs.JobWithCompany{Job: jobs.Job{ID: 7, Title: "Go Developer", IsActive: true}},
+					SavedAt:        savedAt,
+				}}, 3, nil).Once()
+				mockTechRepo.EXPECT().GetJobTechnologiesBatch(context.Background(), []int{7}).
+					Return(map[int][]*jobtech.JobTechnologyWithDetails{
+						7: {{TechName: "Go", TechCategory: "Language", IsRequired: true}},
+					}, nil).Once()
+			},
+			checkResults: func(t *testing.T, resp *SavedJobListResponse, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, resp.Data, 1)
+				assert.Equal(t, 7, resp.Data[0].ID)
+				assert.True(t, resp.Data[0].IsActive)
+				assert.Equal(t, savedAt, resp.Data[0].SavedAt)
+				assert.Equal(t, "Go", resp.Data[0].Technologies[0].Name)
+				assert.Equal(t, 3, resp.Pagination.Total)
+				assert.True(t, resp.Pagination.HasMore)
+			},
+		},
+		{
+			name:   "limit clamped",
+			params: BookmarkListParams{UserID: 1, Limit: 1000, Offset: -5},
+			mockSetup: func(mockRepo *MockDataRepository, mockTechRepo *MockTechnologyRepository) {
+				t.Helper()
+				mockRepo.EXPECT().ListBookmarks(context.Background(), &BookmarkListParams{
+					UserID: 1,
+					Limit:  MaxListLimit,
+				}).Return(nil, 0, nil).Once()
+				mockTechRepo.EXPECT().GetJobTechnologiesBatch(context.Background(), []int{}).Return(nil, nil).Once()
+			},
+			checkResults: func(t *testing.T, resp *SavedJobListResponse, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Empty(t, resp.Data)
+				assert.False(t, resp.Pagination.HasMore)
+			},
+		},
+		{
+			name:   "repository error",
+			params: BookmarkListParams{UserID: 1},
+			mockSetup: func(mockRepo *MockDataRepository, _ *MockTechnologyRepository) {
+				t.Helper()
+				mockRepo.EXPECT().ListBookmarks(context.Background(), mock.Anything).Return(nil, 0, dbError).Once()
+			},
+			checkResults: func(t *testing.T, _ *SavedJobListResponse, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			mockTechRepo := NewMockTechnologyRepository(t)
+			service := NewUserService(mockRepo, mockTechRepo)
+
+			tt.mockSetup(mockRepo, mockTechRepo)
+
+			resp, err := service.SavedJobs(context.Background(), tt.params)
+			tt.checkResults(t, resp, err)
+		})
+	}
+}
+
+func TestUserService_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	mockRepo := NewMockDataRepository(t)
+	service := NewUserService(mockRepo, NewMockTechnologyRepository(t))
+	user := &User{ID: 1}
+	mockRepo.EXPECT().GetSessionUser(context.Background(), hashToken("token")).Return(user, nil).Once()
+
+	authenticated, err := service.Authenticate(context.Background(), "token")
+	require.NoError(t, err)
+	assert.Equal(t, user, authenticated)
+
+	_, err = service.Authenticate(context.Background(), "")
+	require.ErrorIs(t, err, ErrInvalidSession)
+}
+
+func TestUserService_MarkApplied(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		application  *Application
+		mockSetup    func(mockRepo *MockDataRepository)
+		checkResults func(t *testing.T, application *Application, err error)
+	}{
+		{
+			name:        "status defaults to applied",
+			application: &Application{UserID: 1, JobID: 7, Notes: "  Referred  "},
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().UpsertApplication(context.Background(), &Application{
+					UserID: 1,
+					JobID:  7,
+					Status: StatusApplied,
+					Notes:  "Referred",
+				}).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, application *Application, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, StatusApplied, application.Status)
+			},
+		},
+		{
+			name:        "invalid status",
+			application: &Application{UserID: 1, JobID: 7, Status: "ghosted"},
+			mockSetup:   func(_ *MockDataRepository) {},
+			checkResults: func(t *testing.T, _ *Application, err error) {
+				t.Helper()
+				var statusErr *InvalidStatusError
+				require.ErrorAs(t, err, &statusErr)
+			},
+		},
+		{
+			name:        "repository error",
+			application: &Application{UserID: 1, JobID: 7, Status: StatusOffer},
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().UpsertApplication(context.Background(), mock.Anything).Return(dbError).Once()
+			},
+			checkResults: func(t *testing.T, _ *Application, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			service := NewUserService(mockRepo, NewMockTechnologyRepository(t))
+
+			tt.mockSetup(mockRepo)
+
+			err := service.MarkApplied(context.Background(), tt.application)
+			tt.checkResults(t, tt.application, err)
+		})
+	}
+}
+
+func TestOAuthService_Begin(t *testing.T) {
+	t.Parallel()
+	provider := NewMockOAuthProvider(t)
+	provider.EXPECT().Name().Return(ProviderGitHub).Once()
+	provider.EXPECT().AuthCodeURL(mock.Anything, mock.Anything).
+		RunAndReturn(func(state, verifier string) string {
+			return "https://github.com/login/oauth/authorize?state=" + state
+		}).Once()
+	service := NewOAuthService(NewMockIdentityRepository(t), nil, provider)
+
+	login, err := service.Begin(ProviderGitHub)
+	require.NoError(t, err)
+	assert.NotEmpty(t, login.State)
+	assert.NotEmpty(t, login.Verifier)
+	assert.Equal(t, "https://github.com/login/oauth/authorize?state="+login.State, login.URL)
+
+	_, err = service.Begin(ProviderGoogle)
+	assert.ErrorIs(t, err, httpservice.ErrNotFound)
+}
+
+func TestOAuthService_Complete(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+	user := &User{ID: 1, Email: "ana@example.com"}
+	identity := func(verified bool) *Identity {
+		return &Identity{Provider: ProviderGitHub, Subject: "583231", Email: "Ana@example.com", EmailVerified: verified}
+	}
+	notLinked := &IdentityNotFoundError{Provider: ProviderGitHub, Subject: "583231"}
+	loginCodeOf := func(userID int) any {
+		return mock.MatchedBy(func(c *LoginCode) bool {
+			return c.UserID == userID && c.CodeHash == hashToken(c.Code) && c.ExpiresAt.Equal(now.Add(LoginCodeTTL))
+		})
+	}
+
+	tests := []struct {
+		name         string
+		provider     string
+		mockSetup    func(provider *MockOAuthProvider, identities *MockIdentityRepository)
+		checkResults func(t *testing.T, loginCode *LoginCode, err error)
+	}{
+		{
+			name:     "linked account",
+			provider: ProviderGitHub,
+			mockSetup: func(provider *MockOAuthProvider, identities *MockIdentityRepository) {
+				t.Helper()
+				provider.EXPECT().Identify(context.Background(), "code", "verifier").Return(identity(true), nil).Once()
+				identities.EXPECT().GetIdentityUser(context.Background(), ProviderGitHub, "583231").Return(user, nil).Once()
+				identities.EXPECT().CreateLoginCode(context.Background(), loginCodeOf(1)).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, loginCode *LoginCode, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.NotEmpty(t, loginCode.Code)
+			},
+		},
+		{
+			name:     "account linked to the user of its email",
+			provider: ProviderGitHub,
+			mockSetup: func(provider *MockOAuthProvider, identities *MockIdentityRepository) {
+				t.Helper()
+				registered := &User{ID: 1, Email: "ana@example.com", PasswordHash: "$2a$10$registered"}
+				provider.EXPECT().Identify(context.Background(), "code", "verifier").Return(identity(true), nil).Once()
+				identities.EXPECT().GetIdentityUser(context.Background(), ProviderGitHub, "583231").Return(nil, notLinked).Once()
+				identities.EXPECT().GetByEmail(context.Background(), "ana@example.com").Return(registered, nil).Once()
+				identities.EXPECT().LinkIdentity(context.Background(), 1, mock.MatchedBy(func(i *Identity) bool {
+					return i.Subject == "583231" && i.Email == "ana@example.com"
+				})).Return(nil).Once()
+				identities.EXPECT().CreateLoginCode(context.Background(), loginCodeOf(1)).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, loginCode *LoginCode, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 1, loginCode.UserID)
+			},
+		},
+		{
+			name:     "user created for a new email",
+			provider: ProviderGitHub,
+			mockSetup: func(provider *MockOAuthProvider, identities *MockIdentityRepository) {
+				t.Helper()
+				provider.EXPECT().Identify(context.Background(), "code", "verifier").Return(identity(true), nil).Once()
+				identities.EXPECT().GetIdentityUser(context.Background(), ProviderGitHub, "583231").Return(nil, notLinked).Once()
+				identities.EXPECT().GetByEmail(context.Background(), "ana@example.com").
+					Return(nil, &NotFoundError{Email: "ana@example.com"}).Once()
+				identities.EXPECT().CreateWithIdentity(context.Background(), mock.MatchedBy(func(u *User) bool {
+					return u.Email == "ana@example.com" && u.PasswordHash == ""
+				}), mock.Anything).
+					RunAndReturn(func(_ context.Context, u *User, _ *Identity) error {
+						u.ID = 7
+						return nil
+					}).Once()
+				identities.EXPECT().CreateLoginCode(context.Background(), loginCodeOf(7)).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, loginCode *LoginCode, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, 7, loginCode.UserID)
+			},
+		},
+		{
+			name:     "unverified email",
+			provider: ProviderGitHub,
+			mockSetup: func(provider *MockOAuthProvider, identities *MockIdentityRepository) {
+				t.Helper()
+				provider.EXPECT().Identify(context.Background(), "code", "verifier").Return(identity(false), nil).Once()
+				identities.EXPECT().GetIdentityUser(context.Background(), ProviderGitHub, "583231").Return(nil, notLinked).Once()
+			},
+			checkResults: func(t *testing.T, _ *LoginCode, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, ErrUnverifiedEmail)
+			},
+		},
+		{
+			name:     "code exchange failed",
+			provider: ProviderGitHub,
+			mockSetup: func(provider *MockOAuthProvider, _ *MockIdentityRepository) {
+				t.Helper()
+				provider.EXPECT().Identify(context.Background(), "code", "verifier").
+					Return(nil, errors.New("bad_verification_code")).Once()
+			},
+			checkResults: func(t *testing.T, _ *LoginCode, err error) {
+				t.Helper()
+				var oauthErr *OAuthError
+				require.ErrorAs(t, err, &oauthErr)
+				assert.ErrorIs(t, err, httpservice.ErrUnauthorized)
+			},
+		},
+		{
+			name:     "unknown provider",
+			provider: "gitlab",
+			mockSetup: func(_ *MockOAuthProvider, _ *MockIdentityRepository) {
+				t.Helper()
+			},
+			checkResults: func(t *testing.T, _ *LoginCode, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, httpservice.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			provider := NewMockOAuthProvider(t)
+			provider.EXPECT().Name().Return(ProviderGitHub).Once()
+			identities := NewMockIdentityRepository(t)
+			users := NewUserService(NewMockDataRepository(t), NewMockTechnologyRepository(t))
+			users.now = func() time.Time { return now }
+			service := NewOAuthService(identities, users, provider)
+
+			tt.mockSetup(provider, identities)
+
+			loginCode, err := service.Complete(context.Background(), tt.provider, "code", "verifier")
+			tt.checkResults(t, loginCode, err)
+		})
+	}
+}
+
+func TestOAuthService_Exchange(t *testing.T) {
+	t.Parallel()
+	user := &User{ID: 1, Email: "ana@example.com"}
+	identities := NewMockIdentityRepository(t)
+	mockRepo := NewMockDataRepository(t)
+	service := NewOAuthService(identities, NewUserService(mockRepo, NewMockTechnologyRepository(t)))
+
+	identities.EXPECT().ConsumeLoginCode(context.Background(), hashToken("login-code")).Return(user, nil).Once()
+	mockRepo.EXPECT().CreateSession(context.Background(), mock.MatchedBy(func(s *Session) bool {
+		return s.UserID == 1 && s.TokenHash == hashToken(s.Token)
+	})).Return(nil).Once()
+	session, err := service.Exchange(context.Background(), "login-code")
+	require.NoError(t, err)
+	assert.Equal(t, user, session.User)
+
+	identities.EXPECT().ConsumeLoginCode(context.Background(), hashToken("login-code")).
+		Return(nil, ErrInvalidLoginCode).Once()
+	_, err = service.Exchange(context.Background(), "login-code")
+	assert.ErrorIs(t, err, ErrInvalidLoginCode)
+}
@@ -0,0 +1,101 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Data Transfer Objects (DTOs) for the webhook admin API layer.
+
+// SubscribeRequest represents the request body to create a subscription
+type SubscribeRequest struct {
+	URL        string   `json:"url" binding:"required" example:"https://partner.example.com/hooks/jobs"`
+	EventTypes []string `json:"event_types" example:"job.created,job.deactivated"`
+}
+
+// DeadLetterListRequest represents the query parameters to list dead deliveries
+type DeadLetterListRequest struct {
+	Limit int `form:"limit" binding:"omitempty,min=1,max=500" example:"50"`
+}
+
+// SubscriptionResponse represents a subscription in API responses. The secret is never listed.
+type SubscriptionResponse struct {
+	ID         int       `json:"id" example:"1"`
+	URL        string    `json:"url" example:"https://partner.example.com/hooks/jobs"`
+	EventTypes []string  `json:"event_types" example:"job.created,job.deactivated"`
+	IsActive   bool      `json:"is_active" example:"true"`
+	CreatedAt  time.Time `json:"created_at" example:"2024-01-15T10:30:00Z"`
+}
+
+// SubscribeResponse represents a created subscription, with the secret to verify its deliveries.
+// It is only returned once.
+type SubscribeResponse struct {
+	SubscriptionResponse
+	Secret string `json:"secret" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+}
+
+// SubscriptionListResponse represents the list of subscriptions
+type SubscriptionListResponse struct {
+	Data []*SubscriptionResponse `json:"data"`
+}
+
+// DeadLetterResponse represents a delivery that failed every attempt
+type DeadLetterResponse struct {
+	ID             int64           `json:"id" example:"42"`
+	SubscriptionID int             `json:"subscription_id" example:"1"`
+	EventType      string          `json:"event_type" example:"job.created"`
+	Payload        json.RawMessage `json:"payload" swaggertype:"object"`
+	Attempts       int             `json:"attempts" example:"8"`
+	LastError      string          `json:"last_error" example:"webhook endpoint responded with status 503"`
+	CreatedAt      time.Time       `json:"created_at" example:"2024-01-15T10:30:00Z"`
+}
+
+// DeadLetterListResponse represents the dead-letter log
+type DeadLetterListResponse struct {
+	Data []*DeadLetterResponse `json:"data"`
+}
+
+// MapSubscriptionToResponse converts a Subscription to its SubscriptionResponse
+func MapSubscriptionToResponse(subscription *Subscription) *SubscriptionResponse {
+	return &SubscriptionResponse{
+		ID:         subscription.ID,
+		URL:        subscription.URL,
+		EventTypes: subscription.EventTypes,
+		IsActive:   subscription.IsActive,
+		CreatedAt:  subscription.CreatedAt,
+	}
+}
+
+// MapSubscribeToResponse converts a created Subscription to a SubscribeResponse with its secret
+func MapSubscribeToResponse(subscription *Subscription) *SubscribeResponse {
+	return &SubscribeResponse{
+		SubscriptionResponse: *MapSubscriptionToResponse(subscription),
+		Secret:               subscription.Secret,
+	}
+}
+
+// MapSubscriptionsToResponse converts subscriptions to a SubscriptionListResponse
+func MapSubscriptionsToResponse(subscriptions []*Subscription) *SubscriptionListResponse {
+	data := make([]*SubscriptionResponse, len(subscriptions))
+	for i, subscription := range subscriptions {
+		data[i] = MapSubscriptionToResponse(subscription)
+	}
+	return &SubscriptionListResponse{Data: data}
+}
+
+// MapDeadLettersToResponse converts dead deliveries to a DeadLetterListResponse
+func MapDeadLettersToResponse(deliveries []*Delivery) *DeadLetterListResponse {
+	data := make([]*DeadLetterResponse, len(deliveries))
+	for i, delivery := range deliveries {
+		data[i] = &DeadLetterResponse{
+			ID:             delivery.ID,
+			SubscriptionID: delivery.SubscriptionID,
+			EventType:      delivery.EventType,
+			Payload:        delivery.Payload,
+			Attempts:       delivery.Attempts,
+			LastError:      delivery.LastError,
+			CreatedAt:      delivery.CreatedAt,
+		}
+	}
+	return &DeadLetterListResponse{Data: data}
+}
@@ -0,0 +1,41 @@
+// Package webhooks notifies partner sites of job events through signed HTTP callbacks.
+// Events are stored as deliveries and sent by a background worker that retries failed
+// deliveries with exponential backoff before moving them to the dead-letter log.
+package webhooks
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// NotFoundError represents a webhook subscription or delivery not found error
+type NotFoundError struct {
+	Resource string
+	ID       int64
+}
+
+func (e NotFoundError) Error() string {
+	return fmt.Sprintf("webhook %s with ID %d not found", e.Resource, e.ID)
+}
+
+// Is matches httpservice.ErrNotFound
+func (e NotFoundError) Is(target error) bool {
+	return target == httpservice.ErrNotFound
+}
+
+// IsNotFound checks if an error is a webhook not found error
+func IsNotFound(err error) bool {
+	var notFoundErr *NotFoundError
+	return errors.As(err, &notFoundErr)
+}
+
+// DeliveryError represents a delivery rejected by the subscriber endpoint
+type DeliveryError struct {
+	StatusCode int
+}
+
+func (e DeliveryError) Error() string {
+	return fmt.Sprintf("webhook endpoint responded with status %d", e.StatusCode)
+}
@@ -0,0 +1,171 @@
+package webhooks
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// Constants for webhook routes and endpoints
+const (
+	WebhooksRoute        = "/webhooks"
+	WebhookRoute         = WebhooksRoute + "/:id"
+	DeadLettersRoute     = WebhooksRoute + "/dead-letters"
+	RetryDeadLetterRoute = DeadLettersRoute + "/:id/retry"
+)
+
+// Handler handles HTTP requests for the webhook administration
+type Handler struct {
+	service *WebhookService
+}
+
+// NewHandler creates a new webhook handler
+func NewHandler(service *WebhookService) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterAdminRoutes registers the webhook admin routes with the given (protected) router group
+func (h *Handler) RegisterAdminRoutes(rg *gin.RouterGroup) {
+	rg.POST(WebhooksRoute, h.Subscribe)
+	rg.GET(WebhooksRoute, h.ListSubscriptions)
+	rg.DELETE(WebhookRoute, h.Unsubscribe)
+	rg.GET(DeadLettersRoute, h.ListDeadLetters)
+	rg.POST(RetryDeadLetterRoute, h.RetryDeadLetter)
+}
+
+// Subscribe godoc
+// @Summary Create a webhook subscription
+// @Description Subscribes an endpoint to job events, all of them when no event types are given.
+// @Description Deliveries are signed with the returned secret, which isn't shown again: the
+// @Description X-Webhook-Signature header is "sha256=" followed by the hex HMAC-SHA256 of
+// @Description "<X-Webhook-Timestamp>.<body>".
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security AdminAPIKey
+// @Param request body SubscribeRequest true "Subscription"
+// @Success 201 {object} SubscribeResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/webhooks [post]
+func (h *Handler) Subscribe(c *gin.Context) {
+	var req SubscribeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	subscription, err := h.service.Subscribe(c.Request.Context(), req.URL, req.EventTypes)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, MapSubscribeToResponse(subscription))
+}
+
+// ListSubscriptions godoc
+// @Summary List webhook subscriptions
+// @Description Returns all the webhook subscriptions, without their secrets
+// @Tags webhooks
+// @Produce json
+// @Security AdminAPIKey
+// @Success 200 {object} SubscriptionListResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/webhooks [get]
+func (h *Handler) ListSubscriptions(c *gin.Context) {
+	subscriptions, err := h.service.Subscriptions(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapSubscriptionsToResponse(subscriptions))
+}
+
+// Unsubscribe godoc
+// @Summary Delete a webhook subscription
+// @Description Deletes a subscription with its pending and dead deliveries
+// @Tags webhooks
+// @Security AdminAPIKey
+// @Param id path int true "Subscription ID"
+// @Success 204
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/webhooks/{id} [delete]
+func (h *Handler) Unsubscribe(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(&httpservice.ValidationError{Errors: []string{"invalid subscription id"}})
+		return
+	}
+
+	if err := h.service.Unsubscribe(c.Request.Context(), id); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListDeadLetters godoc
+// @Summary List dead webhook deliveries
+// @Description Returns the most recent deliveries that failed every attempt, newest first
+// @Tags webhooks
+// @Produce json
+// @Security AdminAPIKey
+// @Param limit query int false "Number of deliveries to return (max 500)" default(50) example(50)
+// @Success 200 {object} DeadLetterListResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/webhooks/dead-letters [get]
+func (h *Handler) ListDeadLetters(c *gin.Context) {
+	var req DeadLetterListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	deliveries, err := h.service.DeadLetters(c.Request.Context(), req.Limit)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MapDeadLettersToResponse(deliveries))
+}
+
+// RetryDeadLetter godoc
+// @Summary Retry a dead webhook delivery
+// @Description Queues a dead delivery again with a fresh set of attempts
+// @Tags webhooks
+// @Security AdminAPIKey
+// @Param id path int true "Delivery ID"
+// @Success 204
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 401 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /admin/webhooks/dead-letters/{id}/retry [post]
+func (h *Handler) RetryDeadLetter(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(&httpservice.ValidationError{Errors: []string{"invalid delivery id"}})
+		return
+	}
+
+	if err := h.service.RetryDeadLetter(c.Request.Context(), id); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,79 @@
+package webhooks
+
+import (
+	"slices"
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+)
+
+// EventTypes lists the events a subscription can receive
+var EventTypes = []string{jobs.EventCreated, jobs.EventUpdated, jobs.EventDeactivated}
+
+// IsValidEventType reports whether eventType is a known event type
+func IsValidEventType(eventType string) bool {
+	return slices.Contains(EventTypes, eventType)
+}
+
+// DeliveryStatus is the state of a delivery
+type DeliveryStatus string
+
+// Delivery statuses
+const (
+	StatusPending   DeliveryStatus = "pending"
+	StatusDelivered DeliveryStatus = "delivered"
+	// StatusDead marks deliveries that failed every attempt, the dead-letter log
+	StatusDead DeliveryStatus = "dead"
+)
+
+// Subscription represents a partner endpoint subscribed to job events
+type Subscription struct {
+	ID         int       `db:"id"`
+	URL        string    `db:"url"`
+	Secret     string    `db:"secret"`
+	EventTypes []string  `db:"event_types"`
+	IsActive   bool      `db:"is_active"`
+	CreatedAt  time.Time `db:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at"`
+}
+
+// Delivery represents an event to be sent to a subscription
+type Delivery struct {
+	ID             int64          `db:"id"`
+	SubscriptionID int            `db:"subscription_id"`
+	EventType      string         `db:"event_type"`
+	Payload        []byte         `db:"payload"`
+	Status         DeliveryStatus `db:"status"`
+	Attempts       int            `db:"attempts"`
+	NextAttemptAt  time.Time      `db:"next_attempt_at"`
+	LastError      string         `db:"last_error"`
+	CreatedAt      time.Time      `db:"created_at"`
+	DeliveredAt    *time.Time     `db:"delivered_at"`
+
+	// Subscription endpoint, loaded with the deliveries to send (not stored in the deliveries table)
+	URL    string `db:"url"`
+	Secret string `db:"secret"`
+}
+
+// Event is the JSON body posted to subscribers
+type Event struct {
+	Type       string    `json:"type"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Job        *JobData  `json:"job"`
+}
+
+// JobData holds the job fields sent with an event
+type JobData struct {
+	ID              int       `json:"id"`
+	CompanyID       int       `json:"company_id"`
+	Title           string    `json:"title"`
+	Description     string    `json:"description"`
+	ExperienceLevel string    `json:"experience_level"`
+	EmploymentType  string    `json:"employment_type"`
+	Location        string    `json:"location"`
+	WorkMode        string    `json:"work_mode"`
+	ApplicationURL  string    `json:"application_url"`
+	IsActive        bool      `json:"is_active"`
+	PostedAt        time.Time `json:"posted_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
@@ -0,0 +1,60 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+)
+
+// DeliveryQueue interface to store the deliveries of an event.
+type DeliveryQueue interface {
+	EnqueueDeliveries(ctx context.Context, eventType string, payload []byte) (int64, error)
+}
+
+// Publisher queues job events for the subscribed endpoints. It implements jobs.EventPublisher;
+// the events are sent by the Worker, so publishing never waits on a partner site.
+type Publisher struct {
+	queue DeliveryQueue
+	now   func() time.Time
+}
+
+// NewPublisher creates a new instance of Publisher
+func NewPublisher(queue DeliveryQueue) *Publisher {
+	return &Publisher{queue: queue, now: time.Now}
+}
+
+// PublishJobEvent queues a delivery of the event for every active subscription to eventType
+func (p *Publisher) PublishJobEvent(ctx context.Context, eventType string, job *jobs.Job) error {
+	payload, err := json.Marshal(&Event{
+		Type:       eventType,
+		OccurredAt: p.now().UTC(),
+		Job:        mapJobToData(job),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook event: %w", err)
+	}
+
+	_, err = p.queue.EnqueueDeliveries(ctx, eventType, payload)
+	return err
+}
+
+// mapJobToData converts a job to the fields sent with an event
+func mapJobToData(job *jobs.Job) *JobData {
+	return &JobData{
+		ID:              job.ID,
+		CompanyID:       job.CompanyID,
+		Title:           job.Title,
+		Description:     job.Description,
+		ExperienceLevel: job.ExperienceLevel,
+		EmploymentType:  job.EmploymentType,
+		Location:        job.Location,
+		WorkMode:        job.WorkMode,
+		ApplicationURL:  job.ApplicationURL,
+		IsActive:        job.IsActive,
+		PostedAt:        job.CreatedAt,
+		UpdatedAt:       job.UpdatedAt,
+	}
+}
@@ -0,0 +1,271 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// SQL query constants
+const (
+	createSubscriptionQuery = `
+        INSERT INTO webhook_subscriptions (url, secret, event_types, is_active)
+        VALUES ($1, $2, $3, $4)
+        RETURNING id, created_at, updated_at
+    `
+
+	listSubscriptionsQuery = `
+        SELECT id, url, secret, event_types, is_active, created_at, updated_at
+        FROM webhook_subscriptions
+        ORDER BY id
+    `
+
+	deleteSubscriptionQuery = `DELETE FROM webhook_subscriptions WHERE id = $1`
+
+	// Creates a delivery of the event for every active subscription to its type
+	enqueueDeliveriesQuery = `
+        INSERT INTO webhook_deliveries (subscription_id, event_type, payload)
+        SELECT id, $1, $2
+        FROM webhook_subscriptions
+        WHERE is_active = true AND $1 = ANY(event_types)
+    `
+
+	// Claims the due deliveries by pushing their next attempt past the lease, so other
+	// workers skip them while they are being sent
+	claimDueDeliveriesQuery = `
+        UPDATE webhook_deliveries d
+        SET next_attempt_at = NOW() + make_interval(secs => $2)
+        FROM webhook_subscriptions s
+        WHERE s.id = d.subscription_id AND d.id IN (
+            SELECT id FROM webhook_deliveries
+            WHERE status = 'pending' AND next_attempt_at <= NOW()
+            ORDER BY next_attempt_at
+            LIMIT $1
+            FOR UPDATE SKIP LOCKED
+        )
+        RETURNING d.id, d.subscription_id, d.event_type, d.payload, d.attempts, s.url, s.secret
+    `
+
+	markDeliveredQuery = `
+        UPDATE webhook_deliveries
+        SET status = 'delivered', attempts = attempts + 1, last_error = '', delivered_at = NOW()
+        WHERE id = $1
+    `
+
+	markFailedQuery = `
+        UPDATE webhook_deliveries
+        SET status = $2, attempts = attempts + 1, last_error = $3, next_attempt_at = $4
+        WHERE id = $1
+    `
+
+	listDeadDeliveriesQuery = `
+        SELECT id, subscription_id, event_type, payload, status, attempts, next_attempt_at,
+               last_error, created_at, delivered_at
+        FROM webhook_deliveries
+        WHERE status = 'dead'
+        ORDER BY created_at DESC, id DESC
+        LIMIT $1
+    `
+
+	// Moves a dead delivery back to the queue with a fresh set of attempts
+	retryDeadDeliveryQuery = `
+        UPDATE webhook_deliveries
+        SET status = 'pending', attempts = 0, next_attempt_at = NOW()
+        WHERE id = $1 AND status = 'dead'
+    `
+)
+
+// Database interface to support pgxpool and mocks
+type Database interface {
+	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
+	Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+}
+
+// Repository handles database operations for webhook subscriptions and deliveries.
+type Repository struct {
+	db Database
+}
+
+// NewRepository creates a new Repository instance.
+func NewRepository(db Database) *Repository {
+	return &Repository{db: db}
+}
+
+// CreateSubscription inserts a new subscription into the database.
+func (r *Repository) CreateSubscription(ctx context.Context, subscription *Subscription) error {
+	err := r.db.QueryRow(
+		ctx,
+		createSubscriptionQuery,
+		subscription.URL,
+		subscription.Secret,
+		subscription.EventTypes,
+		subscription.IsActive,
+	).Scan(&subscription.ID, &subscription.CreatedAt, &subscription.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook subscription: %w", err)
+	}
+	return nil
+}
+
+// ListSubscriptions retrieves all the subscriptions.
+func (r *Repository) ListSubscriptions(ctx context.Context) ([]*Subscription, error) {
+	rows, err := r.db.Query(ctx, listSubscriptionsQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook subscriptions: %w", err)
+	}
+	defer rows.Close()
+
+	var subscriptions []*Subscription
+	for rows.Next() {
+		subscription := &Subscription{}
+		err = rows.Scan(
+			&subscription.ID,
+			&subscription.URL,
+			&subscription.Secret,
+			&subscription.EventTypes,
+			&subscription.IsActive,
+			&subscription.CreatedAt,
+			&subscription.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook subscription row: %w", err)
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating webhook subscription rows: %w", err)
+	}
+
+	return subscriptions, nil
+}
+
+// DeleteSubscription removes a subscription and its deliveries.
+func (r *Repository) DeleteSubscription(ctx context.Context, id int) error {
+	commandTag, err := r.db.Exec(ctx, deleteSubscriptionQuery, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook subscription: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return &NotFoundError{Resource: "subscription", ID: int64(id)}
+	}
+
+	return nil
+}
+
+// EnqueueDeliveries stores a delivery of the event payload for every active subscription
+// to eventType and returns how many were created.
+func (r *Repository) EnqueueDeliveries(ctx context.Context, eventType string, payload []byte) (int64, error) {
+	commandTag, err := r.db.Exec(ctx, enqueueDeliveriesQuery, eventType, payload)
+	if err != nil {
+		return 0, fmt.Errorf("failed to enqueue webhook deliveries: %w", err)
+	}
+	return commandTag.RowsAffected(), nil
+}
+
+// ClaimDueDeliveries retrieves up to limit pending deliveries whose next attempt is due, with their
+// subscription endpoint. The claimed deliveries aren't due again until lease has passed.
+func (r *Repository) ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*Delivery, error) {
+	rows, err := r.db.Query(ctx, claimDueDeliveriesQuery, limit, lease.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	var deliveries []*Delivery
+	for rows.Next() {
+		delivery := &Delivery{Status: StatusPending}
+		err = rows.Scan(
+			&delivery.ID,
+			&delivery.SubscriptionID,
+			&delivery.EventType,
+			&delivery.Payload,
+			&delivery.Attempts,
+			&delivery.URL,
+			&delivery.Secret,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery row: %w", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating webhook delivery rows: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+// MarkDelivered records the successful attempt of a delivery.
+func (r *Repository) MarkDelivered(ctx context.Context, id int64) error {
+	if _, err := r.db.Exec(ctx, markDeliveredQuery, id); err != nil {
+		return fmt.Errorf("failed to mark webhook delivery as delivered: %w", err)
+	}
+	return nil
+}
+
+// MarkFailed records a failed attempt of a delivery. The delivery is retried at nextAttemptAt
+// when status is StatusPending and moved to the dead-letter log when it is StatusDead.
+func (r *Repository) MarkFailed(ctx context.Context, id int64, status DeliveryStatus, lastError string,
+	nextAttemptAt time.Time) error {
+	if _, err := r.db.Exec(ctx, markFailedQuery, id, status, lastError, nextAttemptAt); err != nil {
+		return fmt.Errorf("failed to mark webhook delivery as failed: %w", err)
+	}
+	return nil
+}
+
+// ListDeadDeliveries retrieves the most recent deliveries of the dead-letter log.
+func (r *Repository) ListDeadDeliveries(ctx context.Context, limit int) ([]*Delivery, error) {
+	rows, err := r.db.Query(ctx, listDeadDeliveriesQuery, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dead webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	var deliveries []*Delivery
+	for rows.Next() {
+		delivery := &Delivery{}
+		err = rows.Scan(
+			&delivery.ID,
+			&delivery.SubscriptionID,
+			&delivery.EventType,
+			&delivery.Payload,
+			&delivery.Status,
+			&delivery.Attempts,
+			&delivery.NextAttemptAt,
+			&delivery.LastError,
+			&delivery.CreatedAt,
+			&delivery.DeliveredAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery row: %w", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating webhook delivery rows: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+// RetryDeadDelivery moves a delivery of the dead-letter log back to the queue.
+func (r *Repository) RetryDeadDelivery(ctx context.Context, id int64) error {
+	commandTag, err := r.db.Exec(ctx, retryDeadDeliveryQuery, id)
+	if err != nil {
+		return fmt.Errorf("failed to retry webhook delivery: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return &NotFoundError{Resource: "dead delivery", ID: id}
+	}
+
+	return nil
+}
@@ -0,0 +1,256 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+)
+
+func TestRepository_CreateSubscription(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	eventTypes := []string{jobs.EventCreated}
+
+	mockDB, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	mockDB.ExpectQuery(regexp.QuoteMeta(createSubscriptionQuery)).
+		WithArgs("https://partner.example.com/hooks", "secret", eventTypes, true).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow(3, now, now))
+
+	subscription := &Subscription{
+		URL:        "https://partner.example.com/hooks",
+		Secret:     "secret",
+		EventTypes: eventTypes,
+		IsActive:   true,
+	}
+	err = NewRepository(mockDB).CreateSubscription(context.Background(), subscription)
+	require.NoError(t, err)
+	assert.Equal(t, 3, subscription.ID)
+	assert.Equal(t, now, subscription.CreatedAt)
+
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
+
+func TestRepository_DeleteSubscription(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, err error)
+	}{
+		{
+			name: "subscription deleted",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(deleteSubscriptionQuery)).
+					WithArgs(3).
+					WillReturnResult(pgxmock.NewResult("DELETE", 1))
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "subscription not found",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(deleteSubscriptionQuery)).
+					WithArgs(3).
+					WillReturnResult(pgxmock.NewResult("DELETE", 0))
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				assert.True(t, IsNotFound(err))
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectExec(regexp.QuoteMeta(deleteSubscriptionQuery)).
+					WithArgs(3).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			err = repo.DeleteSubscription(context.Background(), 3)
+			tt.checkResults(t, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_EnqueueDeliveries(t *testing.T) {
+	t.Parallel()
+	payload := []byte(`{"type":"job.created"}`)
+
+	mockDB, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	mockDB.ExpectExec(regexp.QuoteMeta(enqueueDeliveriesQuery)).
+		WithArgs(jobs.EventCreated, payload).
+		WillReturnResult(pgxmock.NewResult("INSERT", 2))
+
+	enqueued, err := NewRepository(mockDB).EnqueueDeliveries(context.Background(), jobs.EventCreated, payload)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), enqueued)
+
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
+
+func TestRepository_ClaimDueDeliveries(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+	columns := []string{"id", "subscription_id", "event_type", "payload", "attempts", "url", "secret"}
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, deliveries []*Delivery, err error)
+	}{
+		{
+			name: "due deliveries claimed",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(claimDueDeliveriesQuery)).
+					WithArgs(20, float64(200)).
+					WillReturnRows(pgxmock.NewRows(columns).
+						AddRow(int64(42), 3, jobs.EventCreated, []byte(`{}`), 1, "https://partner.example.com/hooks", "secret"))
+			},
+			checkResults: func(t *testing.T, deliveries []*Delivery, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Len(t, deliveries, 1)
+				assert.Equal(t, int64(42), deliveries[0].ID)
+				assert.Equal(t, 1, deliveries[0].Attempts)
+				assert.Equal(t, StatusPending, deliveries[0].Status)
+				assert.Equal(t, "https://partner.example.com/hooks", deliveries[0].URL)
+				assert.Equal(t, "secret", deliveries[0].Secret)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(claimDueDeliveriesQuery)).
+					WithArgs(20, float64(200)).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ []*Delivery, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := NewRepository(mockDB)
+			tt.mockSetup(mockDB)
+
+			deliveries, err := repo.ClaimDueDeliveries(context.Background(), 20, 200*time.Second)
+			tt.checkResults(t, deliveries, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_MarkFailed(t *testing.T) {
+	t.Parallel()
+	next := time.Now().Add(time.Minute)
+
+	mockDB, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	mockDB.ExpectExec(regexp.QuoteMeta(markFailedQuery)).
+		WithArgs(int64(42), StatusDead, "webhook endpoint responded with status 500", next).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	err = NewRepository(mockDB).MarkFailed(context.Background(), 42, StatusDead,
+		"webhook endpoint responded with status 500", next)
+	require.NoError(t, err)
+
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
+
+func TestRepository_RetryDeadDelivery(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		checkResults func(t *testing.T, err error)
+	}{
+		{
+			name:         "dead delivery queued again",
+			rowsAffected: 1,
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:         "delivery not dead",
+			rowsAffected: 0,
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				assert.True(t, IsNotFound(err))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			mockDB.ExpectExec(regexp.QuoteMeta(retryDeadDeliveryQuery)).
+				WithArgs(int64(42)).
+				WillReturnResult(pgxmock.NewResult("UPDATE", tt.rowsAffected))
+
+			err = NewRepository(mockDB).RetryDeadDelivery(context.Background(), 42)
+			tt.checkResults(t, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// Subscription and dead-letter settings
+const (
+	MaxURLLength           = 2048
+	secretBytes            = 32
+	DefaultDeadLetterLimit = 50
+	MaxDeadLetterLimit     = 500
+)
+
+// DataRepository interface to make database operations for subscriptions and the dead-letter log.
+type DataRepository interface {
+	CreateSubscription(ctx context.Context, subscription *Subscription) error
+	ListSubscriptions(ctx context.Context) ([]*Subscription, error)
+	DeleteSubscription(ctx context.Context, id int) error
+	ListDeadDeliveries(ctx context.Context, limit int) ([]*Delivery, error)
+	RetryDeadDelivery(ctx context.Context, id int64) error
+}
+
+// WebhookService holds the business logic to manage subscriptions and their failed deliveries.
+type WebhookService struct {
+	repo DataRepository
+}
+
+// NewWebhookService creates a new instance of WebhookService
+func NewWebhookService(repo DataRepository) *WebhookService {
+	return &WebhookService{repo: repo}
+}
+
+// Subscribe validates and creates an active subscription of endpoint to eventTypes, all the events
+// when empty. The returned subscription holds the generated secret used to sign its deliveries.
+func (s *WebhookService) Subscribe(ctx context.Context, endpoint string, eventTypes []string) (*Subscription, error) {
+	endpoint = strings.TrimSpace(endpoint)
+	eventTypes, err := validateSubscription(endpoint, eventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := newSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	subscription := &Subscription{
+		URL:        endpoint,
+		Secret:     secret,
+		EventTypes: eventTypes,
+		IsActive:   true,
+	}
+	if err := s.repo.CreateSubscription(ctx, subscription); err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+// Subscriptions returns all the subscriptions
+func (s *WebhookService) Subscriptions(ctx context.Context) ([]*Subscription, error) {
+	return s.repo.ListSubscriptions(ctx)
+}
+
+// Unsubscribe deletes a subscription with its pending and dead deliveries
+func (s *WebhookService) Unsubscribe(ctx context.Context, id int) error {
+	return s.repo.DeleteSubscription(ctx, id)
+}
+
+// DeadLetters returns the most recent deliveries that failed every attempt. Out of range limits are clamped.
+func (s *WebhookService) DeadLetters(ctx context.Context, limit int) ([]*Delivery, error) {
+	if limit <= 0 {
+		limit = DefaultDeadLetterLimit
+	}
+	return s.repo.ListDeadDeliveries(ctx, min(limit, MaxDeadLetterLimit))
+}
+
+// RetryDeadLetter queues a dead delivery again, e.g. once the subscriber endpoint is fixed
+func (s *WebhookService) RetryDeadLetter(ctx context.Context, id int64) error {
+	return s.repo.RetryDeadDelivery(ctx, id)
+}
+
+// validateSubscription checks the endpoint is an absolute http(s) URL and the event types are known.
+// It returns the deduplicated event types, all of them when none are given.
+func validateSubscription(endpoint string, eventTypes []string) ([]string, error) {
+	var errs []string
+
+	parsed, err := url.Parse(endpoint)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		errs = append(errs, "url must be an absolute http or https URL")
+	} else if len(endpoint) > MaxURLLength {
+		errs = append(errs, fmt.Sprintf("url must be at most %d characters", MaxURLLength))
+	}
+
+	if len(eventTypes) == 0 {
+		eventTypes = EventTypes
+	}
+	unique := make([]string, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		if !IsValidEventType(eventType) {
+			errs = append(errs, fmt.Sprintf("unknown event type %q, must be one of %s",
+				eventType, strings.Join(EventTypes, ", ")))
+			continue
+		}
+		if !slices.Contains(unique, eventType) {
+			unique = append(unique, eventType)
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, &httpservice.ValidationError{Errors: errs}
+	}
+	return unique, nil
+}
+
+// newSecret generates a random subscription secret
+func newSecret() (string, error) {
+	b := make([]byte, secretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
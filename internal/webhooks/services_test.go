@@ -0,0 +1,152 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+)
+
+func TestWebhookService_Subscribe(t *testing.T) {
+	t.Parallel()
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		url          string
+		eventTypes   []string
+		mockSetup    func(mockRepo *MockDataRepository)
+		checkResults func(t *testing.T, subscription *Subscription, err error)
+	}{
+		{
+			name:       "subscription created with a secret",
+			url:        " https://partner.example.com/hooks ",
+			eventTypes: []string{jobs.EventCreated, jobs.EventCreated, jobs.EventDeactivated},
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().CreateSubscription(context.Background(), mock.MatchedBy(func(s *Subscription) bool {
+					return s.URL == "https://partner.example.com/hooks" && s.IsActive
+				})).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, subscription *Subscription, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, []string{jobs.EventCreated, jobs.EventDeactivated}, subscription.EventTypes)
+				assert.Len(t, subscription.Secret, 2*secretBytes)
+			},
+		},
+		{
+			name: "all events by default",
+			url:  "http://partner.example.com/hooks",
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().CreateSubscription(context.Background(), mock.Anything).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, subscription *Subscription, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, EventTypes, subscription.EventTypes)
+			},
+		},
+		{
+			name:       "invalid url and event type",
+			url:        "ftp://partner.example.com",
+			eventTypes: []string{"job.deleted"},
+			mockSetup:  func(_ *MockDataRepository) {},
+			checkResults: func(t *testing.T, _ *Subscription, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Len(t, validationErr.Errors, 2)
+			},
+		},
+		{
+			name:      "relative url",
+			url:       "/hooks",
+			mockSetup: func(_ *MockDataRepository) {},
+			checkResults: func(t *testing.T, _ *Subscription, err error) {
+				t.Helper()
+				var validationErr *httpservice.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+			},
+		},
+		{
+			name: "database error",
+			url:  "https://partner.example.com/hooks",
+			mockSetup: func(mockRepo *MockDataRepository) {
+				t.Helper()
+				mockRepo.EXPECT().CreateSubscription(context.Background(), mock.Anything).Return(dbError).Once()
+			},
+			checkResults: func(t *testing.T, _ *Subscription, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			service := NewWebhookService(mockRepo)
+
+			tt.mockSetup(mockRepo)
+
+			subscription, err := service.Subscribe(context.Background(), tt.url, tt.eventTypes)
+			tt.checkResults(t, subscription, err)
+		})
+	}
+}
+
+func TestWebhookService_DeadLetters(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		limit    int
+		expected int
+	}{
+		"default limit": {limit: 0, expected: DefaultDeadLetterLimit},
+		"clamped limit": {limit: 10000, expected: MaxDeadLetterLimit},
+		"given limit":   {limit: 5, expected: 5},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mockRepo := NewMockDataRepository(t)
+			mockRepo.EXPECT().ListDeadDeliveries(context.Background(), tt.expected).Return(nil, nil).Once()
+
+			_, err := NewWebhookService(mockRepo).DeadLetters(context.Background(), tt.limit)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPublisher_PublishJobEvent(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+
+	mockQueue := NewMockDeliveryQueue(t)
+	mockQueue.EXPECT().EnqueueDeliveries(context.Background(), jobs.EventCreated, mock.MatchedBy(func(payload []byte) bool {
+		var event Event
+		if err := json.Unmarshal(payload, &event); err != nil {
+			return false
+		}
+		return event.Type == jobs.EventCreated && event.OccurredAt.Equal(now) &&
+			event.Job.ID == 7 && event.Job.Title == "Backend Developer"
+	})).Return(int64(1), nil).Once()
+
+	publisher := NewPublisher(mockQueue)
+	publisher.now = func() time.Time { return now }
+
+	err := publisher.PublishJobEvent(context.Background(), jobs.EventCreated, &jobs.Job{ID: 7, Title: "Backend Developer"})
+	require.NoError(t, err)
+}
@@ -0,0 +1,216 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Headers sent with every delivery
+const (
+	SignatureHeader = "X-Webhook-Signature"
+	TimestampHeader = "X-Webhook-Timestamp"
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+)
+
+// Defaults for the delivery worker
+const (
+	DefaultPollInterval = 10 * time.Second
+	DefaultBatchSize    = 20
+	DefaultMaxAttempts  = 8
+	DefaultBaseBackoff  = 30 * time.Second
+	DefaultMaxBackoff   = 6 * time.Hour
+	DefaultTimeout      = 10 * time.Second
+
+	// maxResponseBytes bounds how much of a response body is read before closing it
+	maxResponseBytes = 64 << 10
+)
+
+// DeliveryStore interface to claim deliveries and record their attempts.
+type DeliveryStore interface {
+	ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*Delivery, error)
+	MarkDelivered(ctx context.Context, id int64) error
+	MarkFailed(ctx context.Context, id int64, status DeliveryStatus, lastError string, nextAttemptAt time.Time) error
+}
+
+// WorkerConfig configures how deliveries are sent and retried
+type WorkerConfig struct {
+	// PollInterval is the time between checks for due deliveries
+	PollInterval time.Duration
+	// BatchSize is the number of deliveries claimed at once
+	BatchSize int
+	// MaxAttempts is the number of attempts before a delivery is moved to the dead-letter log
+	MaxAttempts int
+	// BaseBackoff is the wait before the first retry, doubled on every following retry
+	BaseBackoff time.Duration
+	// MaxBackoff caps the wait between retries
+	MaxBackoff time.Duration
+	// Timeout bounds each request to a subscriber endpoint
+	Timeout time.Duration
+	// OnError is called when deliveries can't be claimed or an attempt can't be recorded. Optional.
+	OnError func(err error)
+}
+
+// DefaultWorkerConfig returns the default worker configuration
+func DefaultWorkerConfig() WorkerConfig {
+	return WorkerConfig{
+		PollInterval: DefaultPollInterval,
+		BatchSize:    DefaultBatchSize,
+		MaxAttempts:  DefaultMaxAttempts,
+		BaseBackoff:  DefaultBaseBackoff,
+		MaxBackoff:   DefaultMaxBackoff,
+		Timeout:      DefaultTimeout,
+	}
+}
+
+// Worker sends the queued deliveries to the subscriber endpoints. Failed deliveries are retried
+// with exponential backoff and moved to the dead-letter log after the last attempt.
+type Worker struct {
+	store  DeliveryStore
+	client *http.Client
+	cfg    WorkerConfig
+	now    func() time.Time
+}
+
+// NewWorker creates a new instance of Worker. Run must be started for deliveries to be sent.
+func NewWorker(store DeliveryStore, cfg WorkerConfig) *Worker {
+	defaults := DefaultWorkerConfig()
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = defaults.PollInterval
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaults.BatchSize
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = defaults.MaxAttempts
+	}
+	if cfg.BaseBackoff <= 0 {
+		cfg.BaseBackoff = defaults.BaseBackoff
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = defaults.MaxBackoff
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaults.Timeout
+	}
+
+	return &Worker{
+		store:  store,
+		client: &http.Client{Timeout: cfg.Timeout},
+		cfg:    cfg,
+		now:    time.Now,
+	}
+}
+
+// Run sends the due deliveries every poll interval until ctx is canceled.
+func (w *Worker) Run(ctx context.Context) error {
+	ticker := time.NewTicker(w.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := w.ProcessDue(ctx); err != nil && ctx.Err() == nil && w.cfg.OnError != nil {
+				w.cfg.OnError(err)
+			}
+		}
+	}
+}
+
+// ProcessDue claims a batch of due deliveries and sends them, recording the outcome of every attempt.
+func (w *Worker) ProcessDue(ctx context.Context) error {
+	// Deliveries are sent one after the other, so the lease covers the whole batch timing out
+	lease := time.Duration(w.cfg.BatchSize) * w.cfg.Timeout
+	deliveries, err := w.store.ClaimDueDeliveries(ctx, w.cfg.BatchSize, lease)
+	if err != nil {
+		return err
+	}
+
+	for _, delivery := range deliveries {
+		if err := w.deliver(ctx, delivery); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deliver sends a delivery and records the attempt. Only a failure to record it is returned.
+func (w *Worker) deliver(ctx context.Context, delivery *Delivery) error {
+	sendErr := w.send(ctx, delivery)
+	if sendErr == nil {
+		return w.store.MarkDelivered(ctx, delivery.ID)
+	}
+	if ctx.Err() != nil {
+		// Shutting down, the delivery is sent again once its lease expires
+		return nil
+	}
+
+	attempts := delivery.Attempts + 1
+	status := StatusPending
+	if attempts >= w.cfg.MaxAttempts {
+		status = StatusDead
+	}
+
+	return w.store.MarkFailed(ctx, delivery.ID, status, sendErr.Error(), w.now().Add(w.backoff(attempts)))
+}
+
+// send posts the delivery payload to the subscriber endpoint. Any 2xx response is a success.
+func (w *Worker) send(ctx context.Context, delivery *Delivery) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+
+	timestamp := w.now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, delivery.EventType)
+	req.Header.Set(DeliveryHeader, strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(SignatureHeader, Sign(delivery.Secret, timestamp, delivery.Payload))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Drain part of the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBytes))
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return &DeliveryError{StatusCode: resp.StatusCode}
+	}
+
+	return nil
+}
+
+// backoff returns the wait before the next attempt of a delivery that failed attempts times
+func (w *Worker) backoff(attempts int) time.Duration {
+	wait := w.cfg.BaseBackoff
+	for i := 1; i < attempts && wait < w.cfg.MaxBackoff; i++ {
+		wait *= 2
+	}
+	return min(wait, w.cfg.MaxBackoff)
+}
+
+// Sign returns the signature header value of a payload: the hex encoded HMAC-SHA256 of
+// "<timestamp>.<payload>" keyed with the subscription secret, prefixed with "sha256=".
+// Subscribers compute it the same way to check the delivery comes from the board.
+func Sign(secret string, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,148 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+)
+
+func TestWorker_ProcessDue(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	payload := []byte(`{"type":"job.created"}`)
+	claimError := errors.New("claim error")
+
+	tests := []struct {
+		name         string
+		statusCode   int
+		attempts     int
+		mockSetup    func(mockStore *MockDeliveryStore)
+		checkResults func(t *testing.T, err error)
+	}{
+		{
+			name:       "delivery sent",
+			statusCode: http.StatusNoContent,
+			mockSetup: func(mockStore *MockDeliveryStore) {
+				t.Helper()
+				mockStore.EXPECT().MarkDelivered(context.Background(), int64(42)).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:       "failed delivery retried with backoff",
+			statusCode: http.StatusServiceUnavailable,
+			attempts:   2,
+			mockSetup: func(mockStore *MockDeliveryStore) {
+				t.Helper()
+				mockStore.EXPECT().MarkFailed(context.Background(), int64(42), StatusPending,
+					"webhook endpoint responded with status 503", now.Add(4*time.Second)).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:       "last attempt moves the delivery to the dead-letter log",
+			statusCode: http.StatusInternalServerError,
+			attempts:   4,
+			mockSetup: func(mockStore *MockDeliveryStore) {
+				t.Helper()
+				mockStore.EXPECT().MarkFailed(context.Background(), int64(42), StatusDead,
+					mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				timestamp, _ := strconv.ParseInt(r.Header.Get(TimestampHeader), 10, 64)
+				assert.Equal(t, now.Unix(), timestamp)
+				assert.Equal(t, Sign("secret", timestamp, body), r.Header.Get(SignatureHeader))
+				assert.Equal(t, jobs.EventCreated, r.Header.Get(EventHeader))
+				assert.Equal(t, "42", r.Header.Get(DeliveryHeader))
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			mockStore := NewMockDeliveryStore(t)
+			worker := NewWorker(mockStore, WorkerConfig{
+				BatchSize:   10,
+				MaxAttempts: 5,
+				BaseBackoff: time.Second,
+				MaxBackoff:  time.Minute,
+			})
+			worker.now = func() time.Time { return now }
+
+			mockStore.EXPECT().ClaimDueDeliveries(context.Background(), 10, 10*DefaultTimeout).Return([]*Delivery{{
+				ID:        42,
+				EventType: jobs.EventCreated,
+				Payload:   payload,
+				Attempts:  tt.attempts,
+				URL:       server.URL,
+				Secret:    "secret",
+			}}, nil).Once()
+			tt.mockSetup(mockStore)
+
+			err := worker.ProcessDue(context.Background())
+			tt.checkResults(t, err)
+		})
+	}
+
+	t.Run("claim error", func(t *testing.T) {
+		t.Parallel()
+		mockStore := NewMockDeliveryStore(t)
+		mockStore.EXPECT().ClaimDueDeliveries(context.Background(), DefaultBatchSize, mock.Anything).
+			Return(nil, claimError).Once()
+
+		err := NewWorker(mockStore, WorkerConfig{}).ProcessDue(context.Background())
+		require.ErrorIs(t, err, claimError)
+	})
+}
+
+func TestWorker_Backoff(t *testing.T) {
+	t.Parallel()
+	worker := NewWorker(nil, WorkerConfig{BaseBackoff: time.Minute, MaxBackoff: time.Hour})
+
+	tests := map[int]time.Duration{
+		1:  time.Minute,
+		2:  2 * time.Minute,
+		3:  4 * time.Minute,
+		7:  time.Hour,
+		50: time.Hour,
+	}
+
+	for attempts, expected := range tests {
+		assert.Equal(t, expected, worker.backoff(attempts), attempts)
+	}
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+
+	// Computed with: printf '1700000000.{"type":"job.created"}' | openssl dgst -sha256 -hmac secret
+	assert.Equal(t,
+		"sha256=d3fa3c653825ea3dfe8de37f70e18cfc8fcdcb81b793cf416610c3461c6ce00d",
+		Sign("secret", 1700000000, []byte(`{"type":"job.created"}`)))
+}
@@ -0,0 +1,77 @@
+package widget
+
+import (
+	"strings"
+	"time"
+)
+
+// Data Transfer Objects (DTOs) for the widget API layer.
+
+// Formats of the openings
+const (
+	FormatJSON = "json"
+	FormatHTML = "html"
+)
+
+// OpeningsRequest represents the query parameters to get the openings of a company
+type OpeningsRequest struct {
+	Company string `form:"company" binding:"required,max=255" example:"tech-corp"`
+	Format  string `form:"format" binding:"omitempty,oneof=json html" example:"html"`
+	Limit   int    `form:"limit" binding:"omitempty,min=1,max=50" example:"10"`
+}
+
+// ToOpeningsParams converts an OpeningsRequest to OpeningsParams
+func (req *OpeningsRequest) ToOpeningsParams() OpeningsParams {
+	return OpeningsParams{Slug: strings.TrimSpace(req.Company), Limit: req.Limit}
+}
+
+// CompanyResponse represents the API response for the company of the openings
+type CompanyResponse struct {
+	Name    string `json:"name" example:"Tech Corp"`
+	Slug    string `json:"slug" example:"tech-corp"`
+	LogoURL string `json:"logo_url" example:"https://techcorp.com/logo.png"`
+}
+
+// JobResponse represents the API response for an opening
+type JobResponse struct {
+	ID              int       `json:"id" example:"42"`
+	Title           string    `json:"title" example:"Senior Go Developer"`
+	ExperienceLevel string    `json:"experience_level" example:"Senior"`
+	EmploymentType  string    `json:"employment_type" example:"Full-time"`
+	Location        string    `json:"location" example:"San José"`
+	WorkMode        string    `json:"work_mode" example:"Hybrid"`
+	ApplicationURL  string    `json:"application_url" example:"https://techcorp.com/careers/go"`
+	PostedAt        time.Time `json:"posted_at" example:"2024-01-15T06:00:00Z"`
+}
+
+// OpeningsResponse represents the API response listing the openings of a company, newest first
+type OpeningsResponse struct {
+	Company CompanyResponse `json:"company"`
+	Data    []*JobResponse  `json:"data"`
+}
+
+// MapOpeningsToResponse converts the openings of a company to their API response format
+func MapOpeningsToResponse(openings *Openings) *OpeningsResponse {
+	data := make([]*JobResponse, 0, len(openings.Jobs))
+	for _, job := range openings.Jobs {
+		data = append(data, &JobResponse{
+			ID:              job.ID,
+			Title:           job.Title,
+			ExperienceLevel: job.ExperienceLevel,
+			EmploymentType:  job.EmploymentType,
+			Location:        job.Location,
+			WorkMode:        job.WorkMode,
+			ApplicationURL:  job.ApplicationURL,
+			PostedAt:        job.CreatedAt,
+		})
+	}
+
+	return &OpeningsResponse{
+		Company: CompanyResponse{
+			Name:    openings.Company.Name,
+			Slug:    openings.Company.Slug,
+			LogoURL: openings.Company.LogoURL,
+		},
+		Data: data,
+	}
+}
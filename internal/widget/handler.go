@@ -0,0 +1,84 @@
+package widget
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// Constants for widget routes and endpoints
+const (
+	EmbedJobsRoute = "/embed/jobs"
+
+	// CacheControl lets the browsers and CDNs in front of the careers pages keep the openings
+	// as long as the service does
+	CacheControl = "public, max-age=300"
+)
+
+// Handler handles HTTP requests for the embeddable widget
+type Handler struct {
+	service *WidgetService
+}
+
+// NewHandler creates a new widget handler
+func NewHandler(service *WidgetService) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers widget routes with the given router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.GET(EmbedJobsRoute, h.GetOpenings)
+}
+
+// IsEmbedRoute reports whether route, the full path of a gin route, is served to the careers pages
+// of the companies, whatever their origin
+func IsEmbedRoute(route string) bool {
+	return strings.HasSuffix(route, EmbedJobsRoute)
+}
+
+// GetOpenings godoc
+// @Summary Get the openings of a company to embed
+// @Description Lists the newest active jobs of a company for its careers page, to any origin. With
+// @Description format=html the openings are a pre-rendered HTML snippet to insert in the page as is.
+// @Description Results are cached for 5 minutes.
+// @Tags embed
+// @Produce json,html
+// @Param company query string true "Company slug" example("tech-corp")
+// @Param format query string false "Response format" Enums(json, html) default(json)
+// @Param limit query int false "Number of jobs to return (max 50)" default(10) example(10)
+// @Success 200 {object} OpeningsResponse
+// @Failure 400 {object} httpservice.ErrorResponse
+// @Failure 404 {object} httpservice.ErrorResponse
+// @Failure 500 {object} httpservice.ErrorResponse
+// @Router /embed/jobs [get]
+func (h *Handler) GetOpenings(c *gin.Context) {
+	var req OpeningsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		_ = c.Error(&httpservice.RequestParseError{Err: err})
+		return
+	}
+
+	openings, err := h.service.Openings(c.Request.Context(), req.ToOpeningsParams())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response := MapOpeningsToResponse(openings)
+	c.Header("Cache-Control", CacheControl)
+	if req.Format != FormatHTML {
+		c.JSON(http.StatusOK, response)
+		return
+	}
+
+	var body bytes.Buffer
+	if err = WriteOpeningsHTML(&body, response); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.Data(http.StatusOK, "text/html; charset=utf-8", body.Bytes())
+}
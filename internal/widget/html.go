@@ -0,0 +1,31 @@
+package widget
+
+import (
+	"html/template"
+	"io"
+)
+
+// openingsHTML is the snippet inserted in the careers pages. Its classes are prefixed with tit- for
+// the pages to style it without clashing with their own.
+const openingsHTML = `<div class="tit-openings" data-company="{{.Company.Slug}}">
+  <ul class="tit-openings__list">
+  {{- range .Data}}
+    <li class="tit-openings__job">
+      <a class="tit-openings__title" href="{{.ApplicationURL}}" target="_blank" rel="noopener">{{.Title}}</a>
+      <span class="tit-openings__details">{{.Location}} · {{.WorkMode}} · {{.ExperienceLevel}} · {{.EmploymentType}}</span>
+    </li>
+  {{- else}}
+    <li class="tit-openings__empty">{{.Company.Name}} has no open positions right now.</li>
+  {{- end}}
+  </ul>
+</div>
+`
+
+// openingsTemplate renders the openings. The values are escaped, and application links that
+// aren't http(s) are replaced by the template.
+var openingsTemplate = template.Must(template.New("openings").Parse(openingsHTML))
+
+// WriteOpeningsHTML writes the openings of a company as an HTML snippet to w
+func WriteOpeningsHTML(w io.Writer, openings *OpeningsResponse) error {
+	return openingsTemplate.Execute(w, openings)
+}
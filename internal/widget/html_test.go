@@ -0,0 +1,63 @@
+package widget
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteOpeningsHTML(t *testing.T) {
+	t.Parallel()
+	company := CompanyResponse{Name: "Tech & Co", Slug: "tech-co"}
+
+	tests := []struct {
+		name     string
+		openings *OpeningsResponse
+		contains []string
+		excludes []string
+	}{
+		{
+			name: "openings listed",
+			openings: &OpeningsResponse{Company: company, Data: []*JobResponse{{
+				Title: "Senior Go Developer", Location: "San José", WorkMode: "Hybrid", ExperienceLevel: "Senior",
+				EmploymentType: "Full-time", ApplicationURL: "https://techcorp.com/careers/go",
+			}}},
+			contains: []string{
+				`data-company="tech-co"`,
+				`href="https://techcorp.com/careers/go" target="_blank" rel="noopener">Senior Go Developer</a>`,
+				"San José · Hybrid · Senior · Full-time",
+			},
+			excludes: []string{"tit-openings__empty"},
+		},
+		{
+			name:     "no openings",
+			openings: &OpeningsResponse{Company: company},
+			contains: []string{"Tech &amp; Co has no open positions right now."},
+		},
+		{
+			name: "values escaped",
+			openings: &OpeningsResponse{Company: company, Data: []*JobResponse{{
+				Title: "<script>alert(1)</script>", ApplicationURL: "javascript:alert(1)",
+			}}},
+			contains: []string{"&lt;script&gt;alert(1)&lt;/script&gt;", `href="#ZgotmplZ"`},
+			excludes: []string{"<script>", "javascript:"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var body strings.Builder
+			require.NoError(t, WriteOpeningsHTML(&body, tt.openings))
+
+			for _, s := range tt.contains {
+				assert.Contains(t, body.String(), s)
+			}
+			for _, s := range tt.excludes {
+				assert.NotContains(t, body.String(), s)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+// Package widget serves the active openings of a company for it to embed on its own careers
+// page, as JSON or as a ready to insert HTML snippet, to any origin.
+package widget
+
+import (
+	"time"
+)
+
+// Company represents a company as shown by the widget
+type Company struct {
+	ID      int    `db:"id"`
+	Name    string `db:"name"`
+	Slug    string `db:"slug"`
+	LogoURL string `db:"logo_url"`
+}
+
+// Job represents an active opening of a company as shown by the widget
+type Job struct {
+	ID              int       `db:"id"`
+	Title           string    `db:"title"`
+	ExperienceLevel string    `db:"experience_level"`
+	EmploymentType  string    `db:"employment_type"`
+	Location        string    `db:"location"`
+	WorkMode        string    `db:"work_mode"`
+	ApplicationURL  string    `db:"application_url"`
+	CreatedAt       time.Time `db:"created_at"`
+}
+
+// Openings holds a company with its newest active jobs
+type Openings struct {
+	Company *Company
+	Jobs    []*Job
+}
+
+// OpeningsParams defines the parameters to get the openings of a company (repository layer)
+type OpeningsParams struct {
+	Slug  string
+	Limit int
+}
@@ -0,0 +1,86 @@
+package widget
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/company"
+)
+
+// SQL query constants
+const (
+	// Archived companies have no openings to show, they aren't found
+	getCompanyQuery = `
+        SELECT id, name, slug, logo_url
+        FROM companies
+        WHERE slug = $1 AND is_active = true
+    `
+
+	// Only published jobs can be active
+	listJobsQuery = `
+        SELECT id, title, experience_level, employment_type, location, work_mode,
+               application_url, created_at
+        FROM jobs
+        WHERE company_id = $1 AND is_active = true
+        ORDER BY created_at DESC, id DESC
+        LIMIT $2
+    `
+)
+
+// Database interface to support pgxpool and mocks
+type Database interface {
+	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+}
+
+// Repository handles the database queries of the widget.
+type Repository struct {
+	db Database
+}
+
+// NewRepository creates a new Repository instance. The widget tolerates replication lag,
+// db can be the read replica.
+func NewRepository(db Database) *Repository {
+	return &Repository{db: db}
+}
+
+// GetCompany retrieves an active company by its slug.
+func (r *Repository) GetCompany(ctx context.Context, slug string) (*Company, error) {
+	c := &Company{}
+	err := r.db.QueryRow(ctx, getCompanyQuery, slug).Scan(&c.ID, &c.Name, &c.Slug, &c.LogoURL)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &company.NotFoundError{Slug: slug}
+		}
+		return nil, fmt.Errorf("failed to get company: %w", err)
+	}
+	return c, nil
+}
+
+// ListJobs retrieves the newest active jobs of a company, up to limit.
+func (r *Repository) ListJobs(ctx context.Context, companyID, limit int) ([]*Job, error) {
+	rows, err := r.db.Query(ctx, listJobsQuery, companyID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list company jobs: %w", err)
+	}
+	defer rows.Close()
+
+	var jobs []*Job
+	for rows.Next() {
+		job := &Job{}
+		if err = rows.Scan(&job.ID, &job.Title, &job.ExperienceLevel, &job.EmploymentType, &job.Location,
+			&job.WorkMode, &job.ApplicationURL, &job.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan job row: %w", err)
+		}
+		jobs = append(jobs, job)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating job rows: %w", err)
+	}
+
+	return jobs, nil
+}
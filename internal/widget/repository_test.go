@@ -0,0 +1,112 @@
+package widget
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/company"
+)
+
+func TestRepository_GetCompany(t *testing.T) {
+	t.Parallel()
+
+	mockDB, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	mockDB.ExpectQuery(regexp.QuoteMeta(getCompanyQuery)).
+		WithArgs("tech-corp").
+		WillReturnRows(pgxmock.NewRows([]string{"id", "name", "slug", "logo_url"}).
+			AddRow(3, "Tech Corp", "tech-corp", "https://techcorp.com/logo.png"))
+	mockDB.ExpectQuery(regexp.QuoteMeta(getCompanyQuery)).
+		WithArgs("archived-corp").
+		WillReturnError(pgx.ErrNoRows)
+
+	repo := NewRepository(mockDB)
+
+	c, err := repo.GetCompany(context.Background(), "tech-corp")
+	require.NoError(t, err)
+	assert.Equal(t, &Company{ID: 3, Name: "Tech Corp", Slug: "tech-corp", LogoURL: "https://techcorp.com/logo.png"}, c)
+
+	_, err = repo.GetCompany(context.Background(), "archived-corp")
+	assert.True(t, company.IsNotFound(err))
+
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
+
+func TestRepository_ListJobs(t *testing.T) {
+	t.Parallel()
+	createdAt := time.Date(2024, 1, 15, 6, 0, 0, 0, time.UTC)
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		checkResults func(t *testing.T, jobs []*Job, err error)
+	}{
+		{
+			name: "jobs found",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(listJobsQuery)).
+					WithArgs(3, 10).
+					WillReturnRows(pgxmock.NewRows([]string{
+						"id", "title", "experience_level", "employment_type", "location", "work_mode",
+						"application_url", "created_at",
+					}).AddRow(42, "Senior Go Developer", "Senior", "Full-time", "San José", "Hybrid",
+						"https://techcorp.com/careers/go", createdAt))
+			},
+			checkResults: func(t *testing.T, jobs []*Job, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, []*Job{{
+					ID:              42,
+					Title:           "Senior Go Developer",
+					ExperienceLevel: "Senior",
+					EmploymentType:  "Full-time",
+					Location:        "San José",
+					WorkMode:        "Hybrid",
+					ApplicationURL:  "https://techcorp.com/careers/go",
+					CreatedAt:       createdAt,
+				}}, jobs)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery(regexp.QuoteMeta(listJobsQuery)).
+					WithArgs(3, 10).
+					WillReturnError(dbError)
+			},
+			checkResults: func(t *testing.T, _ []*Job, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			tt.mockSetup(mockDB)
+
+			jobs, err := NewRepository(mockDB).ListJobs(context.Background(), 3, 10)
+			tt.checkResults(t, jobs, err)
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
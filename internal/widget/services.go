@@ -0,0 +1,76 @@
+package widget
+
+import (
+	"context"
+	"time"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/cache"
+)
+
+// Constants for the openings of the widget
+const (
+	DefaultLimit = 10
+	MaxLimit     = 50
+
+	// CacheTTL is how long the openings of a company are served from memory. Careers pages
+	// request them on every visit.
+	CacheTTL = 5 * time.Minute
+)
+
+// DataRepository interface to query the companies and their active jobs.
+type DataRepository interface {
+	GetCompany(ctx context.Context, slug string) (*Company, error)
+	ListJobs(ctx context.Context, companyID, limit int) ([]*Job, error)
+}
+
+// WidgetService holds the business logic for the embeddable openings of the companies.
+type WidgetService struct {
+	repo  DataRepository
+	cache *cache.Cache[OpeningsParams, *Openings]
+}
+
+// NewWidgetService creates a new instance of WidgetService
+func NewWidgetService(repo DataRepository) *WidgetService {
+	return &WidgetService{
+		repo:  repo,
+		cache: cache.New[OpeningsParams, *Openings](CacheTTL),
+	}
+}
+
+// Openings returns the newest active jobs of a company. An unset limit takes its default and
+// out of range values are clamped. Results are cached for CacheTTL.
+func (s *WidgetService) Openings(ctx context.Context, params OpeningsParams) (*Openings, error) {
+	if params.Limit <= 0 {
+		params.Limit = DefaultLimit
+	}
+	params.Limit = min(params.Limit, MaxLimit)
+
+	return s.cache.GetOrLoad(ctx, params, func(ctx context.Context) (*Openings, error) {
+		company, err := s.repo.GetCompany(ctx, params.Slug)
+		if err != nil {
+			return nil, err
+		}
+
+		jobs, err := s.repo.ListJobs(ctx, company.ID, params.Limit)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Openings{Company: company, Jobs: jobs}, nil
+	})
+}
+
+// InvalidateCache drops the cached openings, so the next requests reload them
+func (s *WidgetService) InvalidateCache() {
+	s.cache.Clear()
+}
+
+// CacheStats returns the counters of the cache
+func (s *WidgetService) CacheStats() cache.Stats {
+	return s.cache.Stats()
+}
+
+// PurgeCache removes the expired cache entries and returns how many were removed
+func (s *WidgetService) PurgeCache() int {
+	return s.cache.Purge()
+}
@@ -0,0 +1,110 @@
+package widget
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/company"
+)
+
+func TestWidgetService_Openings(t *testing.T) {
+	t.Parallel()
+	techCorp := &Company{ID: 3, Name: "Tech Corp", Slug: "tech-corp"}
+	jobs := []*Job{{ID: 42, Title: "Senior Go Developer", CreatedAt: time.Date(2024, 1, 15, 6, 0, 0, 0, time.UTC)}}
+	dbError := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		params       OpeningsParams
+		mockSetup    func(repo *MockDataRepository)
+		checkResults func(t *testing.T, openings *Openings, err error)
+	}{
+		{
+			name:   "default limit",
+			params: OpeningsParams{Slug: "tech-corp"},
+			mockSetup: func(repo *MockDataRepository) {
+				repo.EXPECT().GetCompany(context.Background(), "tech-corp").Return(techCorp, nil).Once()
+				repo.EXPECT().ListJobs(context.Background(), 3, DefaultLimit).Return(jobs, nil).Once()
+			},
+			checkResults: func(t *testing.T, openings *Openings, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Equal(t, &Openings{Company: techCorp, Jobs: jobs}, openings)
+			},
+		},
+		{
+			name:   "limit clamped",
+			params: OpeningsParams{Slug: "tech-corp", Limit: 500},
+			mockSetup: func(repo *MockDataRepository) {
+				repo.EXPECT().GetCompany(context.Background(), "tech-corp").Return(techCorp, nil).Once()
+				repo.EXPECT().ListJobs(context.Background(), 3, MaxLimit).Return(nil, nil).Once()
+			},
+			checkResults: func(t *testing.T, openings *Openings, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				assert.Empty(t, openings.Jobs)
+			},
+		},
+		{
+			name:   "company not found",
+			params: OpeningsParams{Slug: "ghost"},
+			mockSetup: func(repo *MockDataRepository) {
+				repo.EXPECT().GetCompany(context.Background(), "ghost").
+					Return(nil, &company.NotFoundError{Slug: "ghost"}).Once()
+			},
+			checkResults: func(t *testing.T, _ *Openings, err error) {
+				t.Helper()
+				assert.True(t, company.IsNotFound(err))
+			},
+		},
+		{
+			name:   "database error",
+			params: OpeningsParams{Slug: "tech-corp"},
+			mockSetup: func(repo *MockDataRepository) {
+				repo.EXPECT().GetCompany(context.Background(), "tech-corp").Return(techCorp, nil).Once()
+				repo.EXPECT().ListJobs(context.Background(), 3, DefaultLimit).Return(nil, dbError).Once()
+			},
+			checkResults: func(t *testing.T, _ *Openings, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, dbError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := NewMockDataRepository(t)
+			tt.mockSetup(repo)
+
+			openings, err := NewWidgetService(repo).Openings(context.Background(), tt.params)
+			tt.checkResults(t, openings, err)
+		})
+	}
+}
+
+func TestWidgetService_OpeningsCache(t *testing.T) {
+	t.Parallel()
+	techCorp := &Company{ID: 3, Name: "Tech Corp", Slug: "tech-corp"}
+
+	repo := NewMockDataRepository(t)
+	repo.EXPECT().GetCompany(context.Background(), "tech-corp").Return(techCorp, nil).Twice()
+	repo.EXPECT().ListJobs(context.Background(), 3, DefaultLimit).Return(nil, nil).Twice()
+
+	service := NewWidgetService(repo)
+	params := OpeningsParams{Slug: "tech-corp"}
+
+	// The second request is served from the cache, the one after the invalidation reloads
+	for range 2 {
+		_, err := service.Openings(context.Background(), params)
+		require.NoError(t, err)
+	}
+	service.InvalidateCache()
+	_, err := service.Openings(context.Background(), params)
+	require.NoError(t, err)
+}
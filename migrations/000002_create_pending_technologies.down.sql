@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_pending_technologies_occurrences;
+
+DROP TABLE IF EXISTS pending_technologies;
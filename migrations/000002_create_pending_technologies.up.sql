@@ -0,0 +1,13 @@
+-- Pending Technologies Table
+-- Technology names found in job postings that don't match any technology or alias yet.
+-- They are reviewed by an admin and approved into technologies (or as an alias of one).
+CREATE TABLE pending_technologies (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL UNIQUE,
+    occurrences INT NOT NULL DEFAULT 1,
+    first_seen_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    last_seen_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Pending Technologies Indexes
+CREATE INDEX idx_pending_technologies_occurrences ON pending_technologies(occurrences DESC);
@@ -0,0 +1,10 @@
+ALTER TABLE pending_technologies
+    DROP COLUMN IF EXISTS suggestion_score,
+    DROP COLUMN IF EXISTS suggested_technology_id;
+
+DROP INDEX IF EXISTS idx_technology_aliases_compact_alias;
+DROP INDEX IF EXISTS idx_technology_aliases_alias_trgm;
+DROP INDEX IF EXISTS idx_technologies_compact_name;
+DROP INDEX IF EXISTS idx_technologies_name_trgm;
+
+DROP EXTENSION IF EXISTS pg_trgm;
@@ -0,0 +1,16 @@
+-- Trigram similarity is used to suggest technologies for names that don't match exactly
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Technologies Indexes
+CREATE INDEX idx_technologies_name_trgm ON technologies USING GIN (name gin_trgm_ops);
+CREATE INDEX idx_technologies_compact_name ON technologies ((regexp_replace(name, '[^a-z0-9+#]', '', 'g')));
+
+-- Technology Aliases Indexes
+CREATE INDEX idx_technology_aliases_alias_trgm ON technology_aliases USING GIN (alias gin_trgm_ops);
+CREATE INDEX idx_technology_aliases_compact_alias
+    ON technology_aliases ((regexp_replace(alias, '[^a-z0-9+#]', '', 'g')));
+
+-- Pending technologies keep the closest known technology found for them, to help the review
+ALTER TABLE pending_technologies
+    ADD COLUMN suggested_technology_id INT REFERENCES technologies(id) ON DELETE SET NULL,
+    ADD COLUMN suggestion_score REAL;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_job_function_assignments_function_id;
+
+DROP TABLE IF EXISTS job_function_assignments;
+DROP TABLE IF EXISTS job_functions;
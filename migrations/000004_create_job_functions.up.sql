@@ -0,0 +1,34 @@
+-- Job Functions Table (role taxonomy used to segment the board)
+CREATE TABLE job_functions (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(50) NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Job Function Assignments Junction Table
+CREATE TABLE job_function_assignments (
+    id SERIAL PRIMARY KEY,
+    job_id INT NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    function_id INT NOT NULL REFERENCES job_functions(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE(job_id, function_id)
+);
+
+-- Job Function Assignments Indexes
+CREATE INDEX idx_job_function_assignments_function_id ON job_function_assignments(function_id);
+
+-- Initial taxonomy
+INSERT INTO job_functions (name) VALUES
+    ('Backend'),
+    ('Frontend'),
+    ('Full Stack'),
+    ('Mobile'),
+    ('DevOps'),
+    ('Data'),
+    ('QA'),
+    ('Security'),
+    ('Embedded'),
+    ('Design'),
+    ('Product'),
+    ('Management'),
+    ('Support');
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_companies_slug;
+
+ALTER TABLE companies DROP COLUMN IF EXISTS slug;
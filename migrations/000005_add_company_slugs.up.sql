@@ -0,0 +1,23 @@
+-- URL slugs identify companies in public routes instead of their IDs or exact names
+ALTER TABLE companies ADD COLUMN slug VARCHAR(255);
+
+-- Backfill existing companies, suffixing colliding slugs with a counter
+WITH base AS (
+    SELECT id,
+           COALESCE(NULLIF(TRIM(BOTH '-' FROM regexp_replace(
+               translate(lower(name), 'áàäâãéèëêíìïîóòöôõúùüûñç', 'aaaaaeeeeiiiiooooouuuunc'),
+               '[^a-z0-9]+', '-', 'g')), ''), 'company') AS slug
+    FROM companies
+), numbered AS (
+    SELECT id, slug, ROW_NUMBER() OVER (PARTITION BY slug ORDER BY id) AS n
+    FROM base
+)
+UPDATE companies c
+SET slug = CASE WHEN numbered.n = 1 THEN numbered.slug ELSE numbered.slug || '-' || numbered.n END
+FROM numbered
+WHERE c.id = numbered.id;
+
+ALTER TABLE companies ALTER COLUMN slug SET NOT NULL;
+
+-- Companies Indexes
+CREATE UNIQUE INDEX idx_companies_slug ON companies(slug);
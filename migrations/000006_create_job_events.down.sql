@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_job_events_job_id_created_at;
+DROP INDEX IF EXISTS idx_job_events_created_at;
+
+DROP TABLE IF EXISTS job_events;
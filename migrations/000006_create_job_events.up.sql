@@ -0,0 +1,11 @@
+-- Job Events Table, one row per job view or application click
+CREATE TABLE job_events (
+    id BIGSERIAL PRIMARY KEY,
+    job_id INT NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    event_type VARCHAR(20) NOT NULL CHECK (event_type IN ('view', 'apply_click')),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Job Events Indexes
+CREATE INDEX idx_job_events_created_at ON job_events(created_at);
+CREATE INDEX idx_job_events_job_id_created_at ON job_events(job_id, created_at);
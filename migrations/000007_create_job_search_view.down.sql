@@ -0,0 +1 @@
+DROP MATERIALIZED VIEW IF EXISTS job_search_view;
@@ -0,0 +1,27 @@
+-- Denormalized view of the active jobs read by the job search, so a search doesn't join
+-- companies and technologies on every request. It is refreshed after each ingest and periodically.
+CREATE MATERIALIZED VIEW job_search_view AS
+SELECT
+    j.id, j.company_id, j.title, j.description, j.experience_level, j.employment_type,
+    j.location, j.work_mode, j.application_url, j.is_active, j.signature, j.search_vector,
+    j.created_at, j.updated_at,
+    c.name AS company_name,
+    c.slug AS company_slug,
+    c.logo_url AS company_logo_url,
+    COALESCE(
+        array_agg(t.name ORDER BY t.name) FILTER (WHERE t.id IS NOT NULL),
+        '{}'
+    ) AS technologies
+FROM jobs j
+JOIN companies c ON j.company_id = c.id
+LEFT JOIN job_technologies jt ON jt.job_id = j.id
+LEFT JOIN technologies t ON jt.technology_id = t.id
+WHERE j.is_active = true
+GROUP BY j.id, c.id;
+
+-- Job Search View Indexes
+-- The unique index is required to refresh the view concurrently
+CREATE UNIQUE INDEX idx_job_search_view_id ON job_search_view(id);
+CREATE INDEX idx_job_search_view_search_vector ON job_search_view USING GIN (search_vector);
+CREATE INDEX idx_job_search_view_created_at ON job_search_view(created_at);
+CREATE INDEX idx_job_search_view_technologies ON job_search_view USING GIN (technologies);
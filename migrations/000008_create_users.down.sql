@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_bookmarks_user_id_created_at;
+DROP TABLE IF EXISTS bookmarks;
+
+DROP INDEX IF EXISTS idx_user_sessions_user_id;
+DROP TABLE IF EXISTS user_sessions;
+
+DROP INDEX IF EXISTS idx_users_email;
+DROP TABLE IF EXISTS users;
@@ -0,0 +1,13 @@
+-- Applications Table, jobs users applied to and how their application is going
+CREATE TABLE applications (
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    job_id INT NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'applied'
+        CHECK (status IN ('applied', 'interviewing', 'rejected', 'offer')),
+    notes TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, job_id)
+);
+
+CREATE INDEX idx_applications_user_id_updated_at ON applications(user_id, updated_at);
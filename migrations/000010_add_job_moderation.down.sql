@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_jobs_status_created_at;
+
+ALTER TABLE jobs
+    DROP CONSTRAINT IF EXISTS jobs_unpublished_inactive,
+    DROP COLUMN IF EXISTS reviewed_at,
+    DROP COLUMN IF EXISTS rejection_reason,
+    DROP COLUMN IF EXISTS status;
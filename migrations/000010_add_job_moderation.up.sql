@@ -0,0 +1,10 @@
+-- Moderation status of jobs. Jobs from less trusted sources are created as pending and are only
+-- published, and activated, once an admin approves them.
+ALTER TABLE jobs
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'published'
+        CHECK (status IN ('pending', 'published', 'rejected')),
+    ADD COLUMN rejection_reason TEXT NOT NULL DEFAULT '',
+    ADD COLUMN reviewed_at TIMESTAMP,
+    ADD CONSTRAINT jobs_unpublished_inactive CHECK (status = 'published' OR NOT is_active);
+
+CREATE INDEX idx_jobs_status_created_at ON jobs(status, created_at);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_webhook_deliveries_dead;
+DROP INDEX IF EXISTS idx_webhook_deliveries_pending;
+DROP TABLE IF EXISTS webhook_deliveries;
+
+DROP TABLE IF EXISTS webhook_subscriptions;
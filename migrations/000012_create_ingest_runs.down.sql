@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_ingest_run_companies_company_id;
+DROP INDEX IF EXISTS idx_ingest_runs_started_at;
+DROP TABLE IF EXISTS ingest_run_companies;
+
+DROP TABLE IF EXISTS ingest_runs;
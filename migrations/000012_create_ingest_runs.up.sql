@@ -0,0 +1,24 @@
+-- Ingest Runs Table, one row per scraper run reported through the ingest API
+CREATE TABLE ingest_runs (
+    id SERIAL PRIMARY KEY,
+    source VARCHAR(100) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'running' CHECK (status IN ('running', 'succeeded', 'failed')),
+    error TEXT NOT NULL DEFAULT '',
+    started_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    finished_at TIMESTAMP
+);
+
+-- Ingest Run Companies Table, the scrape status of each company during a run
+CREATE TABLE ingest_run_companies (
+    run_id INT NOT NULL REFERENCES ingest_runs(id) ON DELETE CASCADE,
+    company_id INT NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('succeeded', 'failed')),
+    jobs_found INT NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    reported_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (run_id, company_id)
+);
+
+-- Ingest Indexes
+CREATE INDEX idx_ingest_runs_started_at ON ingest_runs(started_at);
+CREATE INDEX idx_ingest_run_companies_company_id ON ingest_run_companies(company_id, reported_at);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_link_checks_checked_at;
+DROP TABLE IF EXISTS link_checks;
@@ -0,0 +1,20 @@
+-- Link Checks Table, the last validation of the application URL of each job and the logo URL of each company
+CREATE TABLE link_checks (
+    id SERIAL PRIMARY KEY,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('application_url', 'logo_url')),
+    -- Job ID for application URLs, company ID for logo URLs
+    target_id INT NOT NULL,
+    url TEXT NOT NULL,
+    ok BOOLEAN NOT NULL,
+    -- Zero when the request failed
+    status_code INT NOT NULL DEFAULT 0,
+    content_type VARCHAR(255) NOT NULL DEFAULT '',
+    error TEXT NOT NULL DEFAULT '',
+    -- Consecutive checks of the same URL answered with 404 or 410
+    not_found_count INT NOT NULL DEFAULT 0,
+    checked_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (kind, target_id)
+);
+
+-- Link Check Indexes
+CREATE INDEX idx_link_checks_checked_at ON link_checks(checked_at);
@@ -0,0 +1,44 @@
+-- Language of each job, detected at ingestion. The search vector of a job is built with the
+-- dictionary of its language, so Spanish postings are stemmed as Spanish.
+DROP MATERIALIZED VIEW IF EXISTS job_search_view;
+DROP INDEX IF EXISTS idx_jobs_search_vector;
+ALTER TABLE jobs DROP COLUMN search_vector;
+
+ALTER TABLE jobs ADD COLUMN language VARCHAR(2) NOT NULL DEFAULT 'en' CHECK (language IN ('en', 'es'));
+ALTER TABLE jobs ADD COLUMN search_vector tsvector
+    GENERATED ALWAYS AS (
+        setweight(to_tsvector(
+            CASE WHEN language = 'es' THEN 'spanish'::regconfig ELSE 'english'::regconfig END,
+            coalesce(title, '')), 'A') ||
+        setweight(to_tsvector(
+            CASE WHEN language = 'es' THEN 'spanish'::regconfig ELSE 'english'::regconfig END,
+            coalesce(description, '')), 'B')
+    ) STORED;
+
+CREATE INDEX idx_jobs_search_vector ON jobs USING GIN (search_vector);
+CREATE INDEX idx_jobs_language ON jobs(language);
+
+CREATE MATERIALIZED VIEW job_search_view AS
+SELECT
+    j.id, j.company_id, j.title, j.description, j.experience_level, j.employment_type,
+    j.location, j.work_mode, j.application_url, j.is_active, j.signature, j.language, j.search_vector,
+    j.created_at, j.updated_at,
+    c.name AS company_name,
+    c.slug AS company_slug,
+    c.logo_url AS company_logo_url,
+    COALESCE(
+        array_agg(t.name ORDER BY t.name) FILTER (WHERE t.id IS NOT NULL),
+        '{}'
+    ) AS technologies
+FROM jobs j
+JOIN companies c ON j.company_id = c.id
+LEFT JOIN job_technologies jt ON jt.job_id = j.id
+LEFT JOIN technologies t ON jt.technology_id = t.id
+WHERE j.is_active = true
+GROUP BY j.id, c.id;
+
+CREATE UNIQUE INDEX idx_job_search_view_id ON job_search_view(id);
+CREATE INDEX idx_job_search_view_search_vector ON job_search_view USING GIN (search_vector);
+CREATE INDEX idx_job_search_view_created_at ON job_search_view(created_at);
+CREATE INDEX idx_job_search_view_technologies ON job_search_view USING GIN (technologies);
+CREATE INDEX idx_job_search_view_language ON job_search_view(language);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_jobs_location_id;
+ALTER TABLE jobs DROP COLUMN IF EXISTS location_id;
+DROP TABLE IF EXISTS locations;
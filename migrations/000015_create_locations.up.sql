@@ -0,0 +1,32 @@
+-- Locations Table, the places jobs are located in. Region is the value searched by the job board
+-- ('Costa Rica' or 'LATAM'); country, province and city are empty when a posting doesn't name them.
+CREATE TABLE locations (
+    id SERIAL PRIMARY KEY,
+    region VARCHAR(50) NOT NULL CHECK (region IN ('Costa Rica', 'LATAM')),
+    country VARCHAR(100) NOT NULL DEFAULT '',
+    province VARCHAR(100) NOT NULL DEFAULT '',
+    city VARCHAR(100) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (region, country, province, city)
+);
+
+INSERT INTO locations (region, country, province) VALUES
+    ('Costa Rica', 'Costa Rica', ''),
+    ('Costa Rica', 'Costa Rica', 'San José'),
+    ('Costa Rica', 'Costa Rica', 'Alajuela'),
+    ('Costa Rica', 'Costa Rica', 'Cartago'),
+    ('Costa Rica', 'Costa Rica', 'Heredia'),
+    ('Costa Rica', 'Costa Rica', 'Guanacaste'),
+    ('Costa Rica', 'Costa Rica', 'Puntarenas'),
+    ('Costa Rica', 'Costa Rica', 'Limón'),
+    ('LATAM', '', '');
+
+ALTER TABLE jobs ADD COLUMN location_id INT REFERENCES locations(id);
+CREATE INDEX idx_jobs_location_id ON jobs(location_id);
+
+-- Migrate the existing values: the regions, and the free-text values naming a province of Costa Rica
+UPDATE jobs j
+SET location_id = l.id, location = l.region
+FROM locations l
+WHERE translate(lower(trim(j.location)), 'áéíóú', 'aeiou') =
+      translate(lower(CASE WHEN l.province <> '' THEN l.province ELSE l.region END), 'áéíóú', 'aeiou');
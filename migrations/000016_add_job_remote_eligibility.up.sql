@@ -0,0 +1,40 @@
+-- Where remote applicants can be based and the timezones they must work in, since "Remote"
+-- alone doesn't tell. Timezones are a range of UTC offsets in hours, both bounds or none set.
+DROP MATERIALIZED VIEW IF EXISTS job_search_view;
+
+ALTER TABLE jobs ADD COLUMN remote_eligibility VARCHAR(20)
+    CHECK (remote_eligibility IN ('CR only', 'LATAM only', 'Worldwide'));
+ALTER TABLE jobs ADD COLUMN utc_offset_min SMALLINT CHECK (utc_offset_min BETWEEN -12 AND 14);
+ALTER TABLE jobs ADD COLUMN utc_offset_max SMALLINT CHECK (utc_offset_max BETWEEN -12 AND 14);
+ALTER TABLE jobs ADD CONSTRAINT jobs_utc_offset_range CHECK (
+    (utc_offset_min IS NULL) = (utc_offset_max IS NULL) AND utc_offset_min <= utc_offset_max
+);
+
+CREATE INDEX idx_jobs_remote_eligibility ON jobs(remote_eligibility);
+
+CREATE MATERIALIZED VIEW job_search_view AS
+SELECT
+    j.id, j.company_id, j.title, j.description, j.experience_level, j.employment_type,
+    j.location, j.work_mode, j.application_url, j.is_active, j.signature, j.language,
+    j.remote_eligibility, j.utc_offset_min, j.utc_offset_max, j.search_vector,
+    j.created_at, j.updated_at,
+    c.name AS company_name,
+    c.slug AS company_slug,
+    c.logo_url AS company_logo_url,
+    COALESCE(
+        array_agg(t.name ORDER BY t.name) FILTER (WHERE t.id IS NOT NULL),
+        '{}'
+    ) AS technologies
+FROM jobs j
+JOIN companies c ON j.company_id = c.id
+LEFT JOIN job_technologies jt ON jt.job_id = j.id
+LEFT JOIN technologies t ON jt.technology_id = t.id
+WHERE j.is_active = true
+GROUP BY j.id, c.id;
+
+CREATE UNIQUE INDEX idx_job_search_view_id ON job_search_view(id);
+CREATE INDEX idx_job_search_view_search_vector ON job_search_view USING GIN (search_vector);
+CREATE INDEX idx_job_search_view_created_at ON job_search_view(created_at);
+CREATE INDEX idx_job_search_view_technologies ON job_search_view USING GIN (technologies);
+CREATE INDEX idx_job_search_view_language ON job_search_view(language);
+CREATE INDEX idx_job_search_view_remote_eligibility ON job_search_view(remote_eligibility);
@@ -0,0 +1,34 @@
+DROP MATERIALIZED VIEW IF EXISTS job_search_view;
+
+CREATE MATERIALIZED VIEW job_search_view AS
+SELECT
+    j.id, j.company_id, j.title, j.description, j.experience_level, j.employment_type,
+    j.location, j.work_mode, j.application_url, j.is_active, j.signature, j.language,
+    j.remote_eligibility, j.utc_offset_min, j.utc_offset_max, j.search_vector,
+    j.created_at, j.updated_at,
+    c.name AS company_name,
+    c.slug AS company_slug,
+    c.logo_url AS company_logo_url,
+    COALESCE(
+        array_agg(t.name ORDER BY t.name) FILTER (WHERE t.id IS NOT NULL),
+        '{}'
+    ) AS technologies
+FROM jobs j
+JOIN companies c ON j.company_id = c.id
+LEFT JOIN job_technologies jt ON jt.job_id = j.id
+LEFT JOIN technologies t ON jt.technology_id = t.id
+WHERE j.is_active = true
+GROUP BY j.id, c.id;
+
+CREATE UNIQUE INDEX idx_job_search_view_id ON job_search_view(id);
+CREATE INDEX idx_job_search_view_search_vector ON job_search_view USING GIN (search_vector);
+CREATE INDEX idx_job_search_view_created_at ON job_search_view(created_at);
+CREATE INDEX idx_job_search_view_technologies ON job_search_view USING GIN (technologies);
+CREATE INDEX idx_job_search_view_language ON job_search_view(language);
+CREATE INDEX idx_job_search_view_remote_eligibility ON job_search_view(remote_eligibility);
+
+DROP INDEX IF EXISTS idx_job_benefits_benefit_id;
+DROP INDEX IF EXISTS idx_benefits_aliases;
+
+DROP TABLE IF EXISTS job_benefits;
+DROP TABLE IF EXISTS benefits;
@@ -0,0 +1,77 @@
+-- Benefits Table (perks offered with jobs). Names of the job data are matched by their slug or by
+-- one of the aliases, which hold the slugs of other ways postings name the benefit.
+CREATE TABLE benefits (
+    id SERIAL PRIMARY KEY,
+    slug VARCHAR(50) NOT NULL UNIQUE,
+    name VARCHAR(50) NOT NULL,
+    aliases TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Job Benefits Junction Table
+CREATE TABLE job_benefits (
+    id SERIAL PRIMARY KEY,
+    job_id INT NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    benefit_id INT NOT NULL REFERENCES benefits(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE(job_id, benefit_id)
+);
+
+CREATE INDEX idx_benefits_aliases ON benefits USING GIN (aliases);
+CREATE INDEX idx_job_benefits_benefit_id ON job_benefits(benefit_id);
+
+-- Initial taxonomy
+INSERT INTO benefits (slug, name, aliases) VALUES
+    ('health-insurance', 'Health insurance',
+        '{private-health-insurance,medical-insurance,seguro-medico,seguro-medico-privado,seguro-de-gastos-medicos}'),
+    ('life-insurance', 'Life insurance', '{seguro-de-vida}'),
+    ('stock-options', 'Stock options', '{equity,esop,rsu,rsus,opciones-de-acciones}'),
+    ('education-budget', 'Education budget',
+        '{learning-budget,training-budget,certifications,presupuesto-de-capacitacion,capacitaciones}'),
+    ('english-classes', 'English classes', '{clases-de-ingles}'),
+    ('performance-bonus', 'Performance bonus', '{bonus,bonuses,annual-bonus,bono,bono-por-desempeno}'),
+    ('flexible-hours', 'Flexible hours', '{flexible-schedule,horario-flexible}'),
+    ('extra-vacation-days', 'Extra vacation days', '{extra-pto,additional-pto,dias-de-vacaciones-adicionales}'),
+    ('parental-leave', 'Parental leave', '{extended-parental-leave,maternity-leave,paternity-leave}'),
+    ('home-office-stipend', 'Home office stipend',
+        '{remote-work-stipend,equipment-allowance,internet-allowance,subsidio-de-internet}'),
+    ('wellness-program', 'Wellness program', '{gym-membership,gym,wellness,gimnasio}'),
+    ('meal-allowance', 'Meal allowance', '{free-meals,food-allowance,almuerzo,subsidio-de-alimentacion}'),
+    ('solidarity-association', 'Solidarity association', '{asociacion-solidarista,solidarista}');
+
+-- The search view lists the benefit slugs of each job, so the search can filter on them
+DROP MATERIALIZED VIEW IF EXISTS job_search_view;
+
+CREATE MATERIALIZED VIEW job_search_view AS
+SELECT
+    j.id, j.company_id, j.title, j.description, j.experience_level, j.employment_type,
+    j.location, j.work_mode, j.application_url, j.is_active, j.signature, j.language,
+    j.remote_eligibility, j.utc_offset_min, j.utc_offset_max, j.search_vector,
+    j.created_at, j.updated_at,
+    c.name AS company_name,
+    c.slug AS company_slug,
+    c.logo_url AS company_logo_url,
+    COALESCE(
+        array_agg(t.name ORDER BY t.name) FILTER (WHERE t.id IS NOT NULL),
+        '{}'
+    ) AS technologies,
+    ARRAY(
+        SELECT b.slug FROM job_benefits jb
+        JOIN benefits b ON jb.benefit_id = b.id
+        WHERE jb.job_id = j.id
+        ORDER BY b.slug
+    ) AS benefits
+FROM jobs j
+JOIN companies c ON j.company_id = c.id
+LEFT JOIN job_technologies jt ON jt.job_id = j.id
+LEFT JOIN technologies t ON jt.technology_id = t.id
+WHERE j.is_active = true
+GROUP BY j.id, c.id;
+
+CREATE UNIQUE INDEX idx_job_search_view_id ON job_search_view(id);
+CREATE INDEX idx_job_search_view_search_vector ON job_search_view USING GIN (search_vector);
+CREATE INDEX idx_job_search_view_created_at ON job_search_view(created_at);
+CREATE INDEX idx_job_search_view_technologies ON job_search_view USING GIN (technologies);
+CREATE INDEX idx_job_search_view_language ON job_search_view(language);
+CREATE INDEX idx_job_search_view_remote_eligibility ON job_search_view(remote_eligibility);
+CREATE INDEX idx_job_search_view_benefits ON job_search_view USING GIN (benefits);
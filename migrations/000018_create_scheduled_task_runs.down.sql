@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_scheduled_task_runs_started_at;
+DROP INDEX IF EXISTS idx_scheduled_task_runs_task_started_at;
+DROP TABLE IF EXISTS scheduled_task_runs;
@@ -0,0 +1,13 @@
+-- Scheduled Task Runs Table, the run history of the background tasks of the server
+CREATE TABLE scheduled_task_runs (
+    id BIGSERIAL PRIMARY KEY,
+    task VARCHAR(100) NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('succeeded', 'failed')),
+    error TEXT NOT NULL DEFAULT '',
+    started_at TIMESTAMP NOT NULL,
+    finished_at TIMESTAMP NOT NULL
+);
+
+-- Scheduled Task Run Indexes
+CREATE INDEX idx_scheduled_task_runs_task_started_at ON scheduled_task_runs(task, started_at DESC);
+CREATE INDEX idx_scheduled_task_runs_started_at ON scheduled_task_runs(started_at DESC);
@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS companies_notify_changes ON companies;
+DROP TRIGGER IF EXISTS job_technologies_notify_changes ON job_technologies;
+DROP TRIGGER IF EXISTS jobs_notify_changes ON jobs;
+DROP FUNCTION IF EXISTS notify_job_changes();
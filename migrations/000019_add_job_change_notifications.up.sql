@@ -0,0 +1,20 @@
+-- Notifies the servers of changes to jobs and companies, so they drop their cached results. Statement
+-- level triggers with identical payloads are sent once per transaction, even for bulk imports.
+CREATE OR REPLACE FUNCTION notify_job_changes() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('job_changes', TG_TABLE_NAME || ':' || TG_OP);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER jobs_notify_changes
+AFTER INSERT OR UPDATE OR DELETE ON jobs
+FOR EACH STATEMENT EXECUTE FUNCTION notify_job_changes();
+
+CREATE TRIGGER job_technologies_notify_changes
+AFTER INSERT OR UPDATE OR DELETE ON job_technologies
+FOR EACH STATEMENT EXECUTE FUNCTION notify_job_changes();
+
+CREATE TRIGGER companies_notify_changes
+AFTER INSERT OR UPDATE OR DELETE ON companies
+FOR EACH STATEMENT EXECUTE FUNCTION notify_job_changes();
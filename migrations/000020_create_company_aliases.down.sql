@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_company_aliases_company_id;
+
+DROP TABLE IF EXISTS company_aliases;
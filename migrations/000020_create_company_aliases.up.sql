@@ -0,0 +1,10 @@
+-- Company Aliases Table (former or alternative names of companies, e.g. after a rebrand or an
+-- acquisition). Job data naming a company by one of them is assigned to the company.
+CREATE TABLE company_aliases (
+    id SERIAL PRIMARY KEY,
+    company_id INT NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+    alias VARCHAR(255) NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_company_aliases_company_id ON company_aliases(company_id);
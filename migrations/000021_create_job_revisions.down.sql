@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS jobs_save_revision ON jobs;
+DROP FUNCTION IF EXISTS save_job_revision();
+DROP TABLE IF EXISTS job_revisions;
@@ -0,0 +1,47 @@
+-- Job Revisions Table, the previous versions of the content of jobs. A row is written each time
+-- the content of a job changes, so changes can be audited and analysed.
+CREATE TABLE job_revisions (
+    id BIGSERIAL PRIMARY KEY,
+    job_id INT NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    title VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL,
+    experience_level VARCHAR(50) NOT NULL,
+    employment_type VARCHAR(50) NOT NULL,
+    location VARCHAR(50) NOT NULL,
+    work_mode VARCHAR(20) NOT NULL,
+    application_url VARCHAR(255) NOT NULL,
+    remote_eligibility VARCHAR(20),
+    utc_offset_min SMALLINT,
+    utc_offset_max SMALLINT,
+    -- When the version was written, and when it was replaced by the next one
+    valid_from TIMESTAMP NOT NULL,
+    replaced_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_job_revisions_job_id_replaced_at ON job_revisions(job_id, replaced_at);
+
+-- Saves the previous version of a job when its content changes. Changes of the status, activation
+-- or signature alone aren't revisions.
+CREATE OR REPLACE FUNCTION save_job_revision() RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO job_revisions (
+        job_id, title, description, experience_level, employment_type, location, work_mode,
+        application_url, remote_eligibility, utc_offset_min, utc_offset_max, valid_from
+    ) VALUES (
+        OLD.id, OLD.title, OLD.description, OLD.experience_level, OLD.employment_type, OLD.location,
+        OLD.work_mode, OLD.application_url, OLD.remote_eligibility, OLD.utc_offset_min, OLD.utc_offset_max,
+        OLD.updated_at
+    );
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER jobs_save_revision
+AFTER UPDATE ON jobs
+FOR EACH ROW
+WHEN ((OLD.title, OLD.description, OLD.experience_level, OLD.employment_type, OLD.location, OLD.work_mode,
+       OLD.application_url, OLD.remote_eligibility, OLD.utc_offset_min, OLD.utc_offset_max)
+      IS DISTINCT FROM
+      (NEW.title, NEW.description, NEW.experience_level, NEW.employment_type, NEW.location, NEW.work_mode,
+       NEW.application_url, NEW.remote_eligibility, NEW.utc_offset_min, NEW.utc_offset_max))
+EXECUTE FUNCTION save_job_revision();
@@ -0,0 +1,23 @@
+-- Search Synonyms Table (lowercase terms of search queries and the phrases they stand for, e.g.
+-- "qa" for "quality assurance"). Searches also match the jobs containing the synonyms of their
+-- terms, technology aliases are synonyms of their technologies too.
+CREATE TABLE search_synonyms (
+    id SERIAL PRIMARY KEY,
+    term VARCHAR(100) NOT NULL UNIQUE,
+    synonym VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO search_synonyms (term, synonym) VALUES
+    ('qa', 'quality assurance'),
+    ('sre', 'site reliability engineer'),
+    ('ml', 'machine learning'),
+    ('ai', 'artificial intelligence'),
+    ('ui', 'user interface'),
+    ('ux', 'user experience'),
+    ('dba', 'database administrator'),
+    ('pm', 'project manager'),
+    ('ba', 'business analyst'),
+    ('frontend', 'front end'),
+    ('backend', 'back end'),
+    ('fullstack', 'full stack');
@@ -0,0 +1,9 @@
+DROP TRIGGER IF EXISTS technologies_record_event ON technologies;
+DROP FUNCTION IF EXISTS record_technology_event();
+
+DROP TRIGGER IF EXISTS jobs_record_event ON jobs;
+DROP FUNCTION IF EXISTS record_job_event();
+
+DROP INDEX IF EXISTS idx_outbox_published_at;
+DROP INDEX IF EXISTS idx_outbox_pending;
+DROP TABLE IF EXISTS outbox;
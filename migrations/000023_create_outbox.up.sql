@@ -0,0 +1,67 @@
+-- Outbox Table, the domain events recorded by triggers in the same transaction as the change they
+-- describe. The relay of the server publishes them to their subscribers, so no event is lost when a
+-- process stops between a change and its notification.
+CREATE TABLE outbox (
+    id BIGSERIAL PRIMARY KEY,
+    event_type VARCHAR(50) NOT NULL,
+    aggregate_id INT NOT NULL,
+    -- The changed row, as it was once changed
+    payload JSONB NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    published_at TIMESTAMP
+);
+
+-- Outbox Indexes
+CREATE INDEX idx_outbox_pending ON outbox(next_attempt_at) WHERE published_at IS NULL;
+CREATE INDEX idx_outbox_published_at ON outbox(published_at) WHERE published_at IS NOT NULL;
+
+-- Records the job lifecycle events: job.created when a job becomes visible, created as published,
+-- approved or reactivated, job.updated when the content of a visible job changes and job.deactivated
+-- when a visible job is taken down
+CREATE OR REPLACE FUNCTION record_job_event() RETURNS TRIGGER AS $$
+DECLARE
+    job_event VARCHAR(50);
+BEGIN
+    IF NEW.status = 'published' AND NEW.is_active IS TRUE THEN
+        IF TG_OP = 'INSERT' OR OLD.status <> 'published' OR OLD.is_active IS NOT TRUE THEN
+            job_event := 'job.created';
+        ELSIF (OLD.title, OLD.description, OLD.experience_level, OLD.employment_type, OLD.location,
+               OLD.location_id, OLD.work_mode, OLD.application_url, OLD.language, OLD.remote_eligibility,
+               OLD.utc_offset_min, OLD.utc_offset_max)
+              IS DISTINCT FROM
+              (NEW.title, NEW.description, NEW.experience_level, NEW.employment_type, NEW.location,
+               NEW.location_id, NEW.work_mode, NEW.application_url, NEW.language, NEW.remote_eligibility,
+               NEW.utc_offset_min, NEW.utc_offset_max) THEN
+            job_event := 'job.updated';
+        END IF;
+    ELSIF TG_OP = 'UPDATE' AND OLD.status = 'published' AND OLD.is_active IS TRUE THEN
+        job_event := 'job.deactivated';
+    END IF;
+
+    IF job_event IS NOT NULL THEN
+        INSERT INTO outbox (event_type, aggregate_id, payload)
+        VALUES (job_event, NEW.id, to_jsonb(NEW) - 'search_vector');
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER jobs_record_event
+AFTER INSERT OR UPDATE ON jobs
+FOR EACH ROW EXECUTE FUNCTION record_job_event();
+
+-- Records technology.added when a technology is created
+CREATE OR REPLACE FUNCTION record_technology_event() RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO outbox (event_type, aggregate_id, payload)
+    VALUES ('technology.added', NEW.id, to_jsonb(NEW));
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER technologies_record_event
+AFTER INSERT ON technologies
+FOR EACH ROW EXECUTE FUNCTION record_technology_event();
@@ -0,0 +1,6 @@
+-- The description of each job as it was scraped. The description column holds it normalized to HTML
+-- and sanitized, the only version served. Existing descriptions are kept as raw and sanitized by
+-- `titoctl jobs sanitize-descriptions`; jobs stored without a raw description have it empty.
+ALTER TABLE jobs ADD COLUMN raw_description TEXT NOT NULL DEFAULT '';
+
+UPDATE jobs SET raw_description = description;
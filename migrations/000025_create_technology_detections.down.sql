@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS technology_detections;
+ALTER TABLE job_technologies DROP COLUMN IF EXISTS auto_detected;
@@ -0,0 +1,20 @@
+-- Job technologies found in the job descriptions rather than given by the scrapers
+ALTER TABLE job_technologies ADD COLUMN auto_detected BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Technology Detections Table (technologies found by name or alias in a job description that
+-- the job isn't associated with). Confident detections are accepted right away, the others wait
+-- for an admin review. Reviewed detections are kept, so they aren't proposed again.
+CREATE TABLE technology_detections (
+    id SERIAL PRIMARY KEY,
+    job_id INT NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    technology_id INT NOT NULL REFERENCES technologies(id) ON DELETE CASCADE,
+    term VARCHAR(100) NOT NULL,
+    occurrences INT NOT NULL,
+    confidence REAL NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'accepted', 'rejected')),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    reviewed_at TIMESTAMP,
+    UNIQUE(job_id, technology_id)
+);
+
+CREATE INDEX idx_technology_detections_pending ON technology_detections(confidence DESC) WHERE status = 'pending';
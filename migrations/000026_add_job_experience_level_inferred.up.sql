@@ -0,0 +1,2 @@
+-- Experience levels missing or unknown in the job data are inferred from the title and description
+ALTER TABLE jobs ADD COLUMN experience_level_inferred BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_company_members_user_id;
+DROP TABLE IF EXISTS company_members;
+
+DROP INDEX IF EXISTS idx_company_claims_user_id_created_at;
+DROP TABLE IF EXISTS company_claims;
+
+ALTER TABLE companies DROP COLUMN IF EXISTS email_domain;
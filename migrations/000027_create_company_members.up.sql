@@ -0,0 +1,32 @@
+-- Email domain of the staff of a company, e.g. techcorp.com. Users claim a company by verifying
+-- an address of this domain, companies without one can't be claimed.
+ALTER TABLE companies ADD COLUMN email_domain VARCHAR(255);
+
+-- Company Claims Table, requests of users to join a company. Only the SHA-256 hash of the code
+-- emailed to the claimed address is stored.
+CREATE TABLE company_claims (
+    id SERIAL PRIMARY KEY,
+    company_id INT NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    email VARCHAR(255) NOT NULL,
+    code_hash CHAR(64) NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP NOT NULL,
+    verified_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_company_claims_user_id_created_at ON company_claims(user_id, created_at);
+
+-- Company Members Table, users managing a company with the scopes of what they can manage
+CREATE TABLE company_members (
+    company_id INT NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    email VARCHAR(255) NOT NULL,
+    scopes TEXT[] NOT NULL CHECK (scopes <@ ARRAY['jobs', 'members']::TEXT[]),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (company_id, user_id)
+);
+
+CREATE INDEX idx_company_members_user_id ON company_members(user_id);
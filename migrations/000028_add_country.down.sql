@@ -0,0 +1,42 @@
+DROP MATERIALIZED VIEW IF EXISTS job_search_view;
+
+CREATE MATERIALIZED VIEW job_search_view AS
+SELECT
+    j.id, j.company_id, j.title, j.description, j.experience_level, j.employment_type,
+    j.location, j.work_mode, j.application_url, j.is_active, j.signature, j.language,
+    j.remote_eligibility, j.utc_offset_min, j.utc_offset_max, j.search_vector,
+    j.created_at, j.updated_at,
+    c.name AS company_name,
+    c.slug AS company_slug,
+    c.logo_url AS company_logo_url,
+    COALESCE(
+        array_agg(t.name ORDER BY t.name) FILTER (WHERE t.id IS NOT NULL),
+        '{}'
+    ) AS technologies,
+    ARRAY(
+        SELECT b.slug FROM job_benefits jb
+        JOIN benefits b ON jb.benefit_id = b.id
+        WHERE jb.job_id = j.id
+        ORDER BY b.slug
+    ) AS benefits
+FROM jobs j
+JOIN companies c ON j.company_id = c.id
+LEFT JOIN job_technologies jt ON jt.job_id = j.id
+LEFT JOIN technologies t ON jt.technology_id = t.id
+WHERE j.is_active = true
+GROUP BY j.id, c.id;
+
+CREATE UNIQUE INDEX idx_job_search_view_id ON job_search_view(id);
+CREATE INDEX idx_job_search_view_search_vector ON job_search_view USING GIN (search_vector);
+CREATE INDEX idx_job_search_view_created_at ON job_search_view(created_at);
+CREATE INDEX idx_job_search_view_technologies ON job_search_view USING GIN (technologies);
+CREATE INDEX idx_job_search_view_language ON job_search_view(language);
+CREATE INDEX idx_job_search_view_remote_eligibility ON job_search_view(remote_eligibility);
+CREATE INDEX idx_job_search_view_benefits ON job_search_view USING GIN (benefits);
+
+DROP TRIGGER IF EXISTS jobs_set_country ON jobs;
+DROP FUNCTION IF EXISTS set_job_country();
+
+DROP INDEX IF EXISTS idx_jobs_country;
+ALTER TABLE jobs DROP COLUMN IF EXISTS country;
+ALTER TABLE companies DROP COLUMN IF EXISTS country;
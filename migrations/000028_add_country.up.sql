@@ -0,0 +1,60 @@
+-- Countries of the companies and of their jobs, ISO 3166-1 alpha-2 codes, so the board can serve other
+-- markets than Costa Rica, where it started
+ALTER TABLE companies ADD COLUMN country CHAR(2) NOT NULL DEFAULT 'CR';
+
+ALTER TABLE jobs ADD COLUMN country CHAR(2);
+UPDATE jobs j SET country = c.country FROM companies c WHERE j.company_id = c.id;
+ALTER TABLE jobs ALTER COLUMN country SET NOT NULL;
+CREATE INDEX idx_jobs_country ON jobs(country);
+
+-- Jobs stored without a country are in the country of their company
+CREATE OR REPLACE FUNCTION set_job_country() RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.country IS NULL THEN
+        SELECT country INTO NEW.country FROM companies WHERE id = NEW.company_id;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER jobs_set_country
+BEFORE INSERT ON jobs
+FOR EACH ROW EXECUTE FUNCTION set_job_country();
+
+-- The search view holds the country of each job, so the search is scoped to a country
+DROP MATERIALIZED VIEW IF EXISTS job_search_view;
+
+CREATE MATERIALIZED VIEW job_search_view AS
+SELECT
+    j.id, j.company_id, j.title, j.description, j.experience_level, j.employment_type,
+    j.location, j.work_mode, j.application_url, j.is_active, j.signature, j.language,
+    j.remote_eligibility, j.utc_offset_min, j.utc_offset_max, j.country, j.search_vector,
+    j.created_at, j.updated_at,
+    c.name AS company_name,
+    c.slug AS company_slug,
+    c.logo_url AS company_logo_url,
+    COALESCE(
+        array_agg(t.name ORDER BY t.name) FILTER (WHERE t.id IS NOT NULL),
+        '{}'
+    ) AS technologies,
+    ARRAY(
+        SELECT b.slug FROM job_benefits jb
+        JOIN benefits b ON jb.benefit_id = b.id
+        WHERE jb.job_id = j.id
+        ORDER BY b.slug
+    ) AS benefits
+FROM jobs j
+JOIN companies c ON j.company_id = c.id
+LEFT JOIN job_technologies jt ON jt.job_id = j.id
+LEFT JOIN technologies t ON jt.technology_id = t.id
+WHERE j.is_active = true
+GROUP BY j.id, c.id;
+
+CREATE UNIQUE INDEX idx_job_search_view_id ON job_search_view(id);
+CREATE INDEX idx_job_search_view_search_vector ON job_search_view USING GIN (search_vector);
+CREATE INDEX idx_job_search_view_created_at ON job_search_view(created_at);
+CREATE INDEX idx_job_search_view_technologies ON job_search_view USING GIN (technologies);
+CREATE INDEX idx_job_search_view_language ON job_search_view(language);
+CREATE INDEX idx_job_search_view_remote_eligibility ON job_search_view(remote_eligibility);
+CREATE INDEX idx_job_search_view_benefits ON job_search_view USING GIN (benefits);
+CREATE INDEX idx_job_search_view_country ON job_search_view(country);
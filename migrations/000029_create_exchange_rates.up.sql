@@ -0,0 +1,10 @@
+-- Exchange Rates Table, the daily rates of the currencies salaries are posted in, as units per
+-- US dollar, so salaries in mixed currencies can be compared
+CREATE TABLE exchange_rates (
+    currency CHAR(3) PRIMARY KEY,
+    per_usd NUMERIC(18, 6) NOT NULL CHECK (per_usd > 0),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Starting rates, replaced by the first run of the fx-rates task
+INSERT INTO exchange_rates (currency, per_usd) VALUES ('USD', 1), ('CRC', 505);
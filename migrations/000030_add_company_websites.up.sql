@@ -0,0 +1,3 @@
+-- Website of the companies, e.g. https://www.techcorp.com. New companies sharing its domain with an
+-- existing one are reported as possible duplicates.
+ALTER TABLE companies ADD COLUMN website_url VARCHAR(255);
@@ -0,0 +1 @@
+ALTER TABLE technologies DROP COLUMN IF EXISTS archived_at;
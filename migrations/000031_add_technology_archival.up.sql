@@ -0,0 +1,3 @@
+-- When a technology was archived for not being asked for by any active job in a while. Archived
+-- technologies keep their job associations, they are only left out of the search suggestions.
+ALTER TABLE technologies ADD COLUMN archived_at TIMESTAMP;
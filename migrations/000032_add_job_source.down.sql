@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_jobs_source;
+DROP INDEX IF EXISTS idx_jobs_source_job_id;
+
+ALTER TABLE jobs DROP CONSTRAINT IF EXISTS jobs_source_job_id_source;
+ALTER TABLE jobs DROP COLUMN IF EXISTS source_job_id;
+ALTER TABLE jobs DROP COLUMN IF EXISTS source;
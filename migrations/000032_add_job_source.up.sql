@@ -0,0 +1,9 @@
+-- Where a job was scraped from and its ID there, e.g. linkedin and the LinkedIn job ID. Jobs stored
+-- before then have none. A posting scraped again from the same source is the same job.
+ALTER TABLE jobs ADD COLUMN source VARCHAR(20)
+    CHECK (source IN ('linkedin', 'greenhouse', 'company_site', 'employer_portal'));
+ALTER TABLE jobs ADD COLUMN source_job_id VARCHAR(255);
+ALTER TABLE jobs ADD CONSTRAINT jobs_source_job_id_source CHECK (source_job_id IS NULL OR source IS NOT NULL);
+
+CREATE UNIQUE INDEX idx_jobs_source_job_id ON jobs(source, source_job_id) WHERE source_job_id IS NOT NULL;
+CREATE INDEX idx_jobs_source ON jobs(source);
@@ -0,0 +1,69 @@
+DROP MATERIALIZED VIEW IF EXISTS job_search_view;
+
+CREATE MATERIALIZED VIEW job_search_view AS
+SELECT
+    j.id, j.company_id, j.title, j.description, j.experience_level, j.employment_type,
+    j.location, j.work_mode, j.application_url, j.is_active, j.signature, j.language,
+    j.remote_eligibility, j.utc_offset_min, j.utc_offset_max, j.country, j.search_vector,
+    j.created_at, j.updated_at,
+    c.name AS company_name,
+    c.slug AS company_slug,
+    c.logo_url AS company_logo_url,
+    COALESCE(
+        array_agg(t.name ORDER BY t.name) FILTER (WHERE t.id IS NOT NULL),
+        '{}'
+    ) AS technologies,
+    ARRAY(
+        SELECT b.slug FROM job_benefits jb
+        JOIN benefits b ON jb.benefit_id = b.id
+        WHERE jb.job_id = j.id
+        ORDER BY b.slug
+    ) AS benefits
+FROM jobs j
+JOIN companies c ON j.company_id = c.id
+LEFT JOIN job_technologies jt ON jt.job_id = j.id
+LEFT JOIN technologies t ON jt.technology_id = t.id
+WHERE j.is_active = true
+GROUP BY j.id, c.id;
+
+CREATE UNIQUE INDEX idx_job_search_view_id ON job_search_view(id);
+CREATE INDEX idx_job_search_view_search_vector ON job_search_view USING GIN (search_vector);
+CREATE INDEX idx_job_search_view_created_at ON job_search_view(created_at);
+CREATE INDEX idx_job_search_view_technologies ON job_search_view USING GIN (technologies);
+CREATE INDEX idx_job_search_view_language ON job_search_view(language);
+CREATE INDEX idx_job_search_view_remote_eligibility ON job_search_view(remote_eligibility);
+CREATE INDEX idx_job_search_view_benefits ON job_search_view USING GIN (benefits);
+CREATE INDEX idx_job_search_view_country ON job_search_view(country);
+
+CREATE OR REPLACE FUNCTION record_job_event() RETURNS TRIGGER AS $$
+DECLARE
+    job_event VARCHAR(50);
+BEGIN
+    IF NEW.status = 'published' AND NEW.is_active IS TRUE THEN
+        IF TG_OP = 'INSERT' OR OLD.status <> 'published' OR OLD.is_active IS NOT TRUE THEN
+            job_event := 'job.created';
+        ELSIF (OLD.title, OLD.description, OLD.experience_level, OLD.employment_type, OLD.location,
+               OLD.location_id, OLD.work_mode, OLD.application_url, OLD.language, OLD.remote_eligibility,
+               OLD.utc_offset_min, OLD.utc_offset_max)
+              IS DISTINCT FROM
+              (NEW.title, NEW.description, NEW.experience_level, NEW.employment_type, NEW.location,
+               NEW.location_id, NEW.work_mode, NEW.application_url, NEW.language, NEW.remote_eligibility,
+               NEW.utc_offset_min, NEW.utc_offset_max) THEN
+            job_event := 'job.updated';
+        END IF;
+    ELSIF TG_OP = 'UPDATE' AND OLD.status = 'published' AND OLD.is_active IS TRUE THEN
+        job_event := 'job.deactivated';
+    END IF;
+
+    IF job_event IS NOT NULL THEN
+        INSERT INTO outbox (event_type, aggregate_id, payload)
+        VALUES (job_event, NEW.id, to_jsonb(NEW) - 'search_vector');
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP INDEX IF EXISTS idx_jobs_canonical_job_id;
+
+ALTER TABLE jobs DROP CONSTRAINT IF EXISTS jobs_canonical_job_id_self;
+ALTER TABLE jobs DROP COLUMN IF EXISTS canonical_job_id;
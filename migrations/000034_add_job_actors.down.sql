@@ -0,0 +1,19 @@
+CREATE OR REPLACE FUNCTION save_job_revision() RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO job_revisions (
+        job_id, title, description, experience_level, employment_type, location, work_mode,
+        application_url, remote_eligibility, utc_offset_min, utc_offset_max, valid_from
+    ) VALUES (
+        OLD.id, OLD.title, OLD.description, OLD.experience_level, OLD.employment_type, OLD.location,
+        OLD.work_mode, OLD.application_url, OLD.remote_eligibility, OLD.utc_offset_min, OLD.utc_offset_max,
+        OLD.updated_at
+    );
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE job_revisions DROP COLUMN IF EXISTS replaced_by;
+ALTER TABLE job_revisions DROP COLUMN IF EXISTS written_by;
+
+ALTER TABLE jobs DROP COLUMN IF EXISTS reviewed_by;
+ALTER TABLE jobs DROP COLUMN IF EXISTS updated_by;
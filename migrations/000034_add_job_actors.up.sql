@@ -0,0 +1,23 @@
+-- Who last changed a job and who last reviewed it, as kind:id, e.g. user:42 or api_key:2bb80d537b1d.
+-- Changes made before then, or by an unknown actor, are recorded with an empty actor.
+ALTER TABLE jobs ADD COLUMN updated_by VARCHAR(100) NOT NULL DEFAULT '';
+ALTER TABLE jobs ADD COLUMN reviewed_by VARCHAR(100) NOT NULL DEFAULT '';
+
+-- Who wrote each previous version of a job, and who replaced it with the next one
+ALTER TABLE job_revisions ADD COLUMN written_by VARCHAR(100) NOT NULL DEFAULT '';
+ALTER TABLE job_revisions ADD COLUMN replaced_by VARCHAR(100) NOT NULL DEFAULT '';
+
+CREATE OR REPLACE FUNCTION save_job_revision() RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO job_revisions (
+        job_id, title, description, experience_level, employment_type, location, work_mode,
+        application_url, remote_eligibility, utc_offset_min, utc_offset_max, valid_from,
+        written_by, replaced_by
+    ) VALUES (
+        OLD.id, OLD.title, OLD.description, OLD.experience_level, OLD.employment_type, OLD.location,
+        OLD.work_mode, OLD.application_url, OLD.remote_eligibility, OLD.utc_offset_min, OLD.utc_offset_max,
+        OLD.updated_at, OLD.updated_by, NEW.updated_by
+    );
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
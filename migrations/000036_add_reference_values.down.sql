@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS reference_values_notify_changes ON reference_values;
+DROP FUNCTION IF EXISTS notify_reference_changes();
+DROP TABLE IF EXISTS reference_values;
@@ -0,0 +1,41 @@
+-- Values of the job attributes the requests are validated against, so a new location or work mode
+-- only takes an INSERT. Position orders the values of a kind, e.g. the experience levels by seniority.
+CREATE TABLE reference_values (
+    kind       VARCHAR(50) NOT NULL,
+    value      VARCHAR(100) NOT NULL,
+    position   INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (kind, value)
+);
+
+INSERT INTO reference_values (kind, value, position) VALUES
+    ('experience_level', 'Entry-level', 1),
+    ('experience_level', 'Junior', 2),
+    ('experience_level', 'Mid-level', 3),
+    ('experience_level', 'Senior', 4),
+    ('experience_level', 'Lead', 5),
+    ('experience_level', 'Principal', 6),
+    ('experience_level', 'Executive', 7),
+    ('employment_type', 'Full-time', 1),
+    ('employment_type', 'Part-time', 2),
+    ('employment_type', 'Contract', 3),
+    ('employment_type', 'Freelance', 4),
+    ('employment_type', 'Temporary', 5),
+    ('employment_type', 'Internship', 6),
+    ('location', 'Costa Rica', 1),
+    ('location', 'LATAM', 2),
+    ('work_mode', 'Remote', 1),
+    ('work_mode', 'Hybrid', 2),
+    ('work_mode', 'Onsite', 3);
+
+-- Notifies the servers of changes to the reference values, so they reload their snapshot
+CREATE OR REPLACE FUNCTION notify_reference_changes() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('reference_changes', TG_OP);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER reference_values_notify_changes
+AFTER INSERT OR UPDATE OR DELETE ON reference_values
+FOR EACH STATEMENT EXECUTE FUNCTION notify_reference_changes();
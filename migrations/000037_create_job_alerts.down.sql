@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_job_alerts_unsubscribe_token;
+DROP INDEX IF EXISTS idx_job_alerts_user_id;
+DROP TABLE IF EXISTS job_alerts;
@@ -0,0 +1,26 @@
+-- Job Alerts Table, saved searches whose new jobs are emailed to their user. Daily and weekly
+-- alerts are grouped in a digest, instant ones are sent as soon as jobs are found, and none is
+-- sent during the quiet hours of the alert, in its timezone. Jobs published after last_sent_at
+-- are the new ones. The unsubscribe token is the secret of the unsubscribe link of the emails.
+CREATE TABLE job_alerts (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    query VARCHAR(255) NOT NULL,
+    experience_level VARCHAR(50),
+    employment_type VARCHAR(50),
+    location VARCHAR(50),
+    work_mode VARCHAR(50),
+    frequency VARCHAR(10) NOT NULL DEFAULT 'daily' CHECK (frequency IN ('instant', 'daily', 'weekly')),
+    timezone VARCHAR(64) NOT NULL DEFAULT 'America/Costa_Rica',
+    quiet_hours_start SMALLINT CHECK (quiet_hours_start BETWEEN 0 AND 23),
+    quiet_hours_end SMALLINT CHECK (quiet_hours_end BETWEEN 0 AND 23),
+    unsubscribe_token CHAR(64) NOT NULL,
+    last_sent_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    unsubscribed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CHECK ((quiet_hours_start IS NULL) = (quiet_hours_end IS NULL))
+);
+
+CREATE INDEX idx_job_alerts_user_id ON job_alerts(user_id);
+CREATE UNIQUE INDEX idx_job_alerts_unsubscribe_token ON job_alerts(unsubscribe_token);
@@ -0,0 +1,12 @@
+-- Daily Statistics Table, snapshots of key aggregates taken once a day by the scheduler, so the
+-- trend charts read a row per day instead of scanning the jobs table. The key is empty for the
+-- metrics of the whole country and the technology name or the company slug of the others.
+CREATE TABLE stats_daily (
+    day DATE NOT NULL,
+    country CHAR(2) NOT NULL,
+    metric VARCHAR(50) NOT NULL,
+    key VARCHAR(255) NOT NULL DEFAULT '',
+    value INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (country, metric, key, day)
+);
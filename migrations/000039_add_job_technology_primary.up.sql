@@ -0,0 +1,51 @@
+-- Primary technologies are the ones a job is built around, e.g. Go for a Go developer. The search
+-- view holds their names and aliases in lowercase, so the relevance ranking promotes the jobs whose
+-- primary technologies are searched for by the weight configured with SEARCH_RANK_PRIMARY_TECH_WEIGHT.
+ALTER TABLE job_technologies ADD COLUMN is_primary BOOLEAN NOT NULL DEFAULT FALSE;
+
+DROP MATERIALIZED VIEW IF EXISTS job_search_view;
+
+CREATE MATERIALIZED VIEW job_search_view AS
+SELECT
+    j.id, j.company_id, j.title, j.description, j.experience_level, j.employment_type,
+    j.location, j.work_mode, j.application_url, j.is_active, j.signature, j.language,
+    j.remote_eligibility, j.utc_offset_min, j.utc_offset_max, j.country, j.search_vector,
+    j.is_featured, j.created_at, j.updated_at,
+    c.name AS company_name,
+    c.slug AS company_slug,
+    c.logo_url AS company_logo_url,
+    COALESCE(
+        array_agg(t.name ORDER BY t.name) FILTER (WHERE t.id IS NOT NULL),
+        '{}'
+    ) AS technologies,
+    ARRAY(
+        SELECT b.slug FROM job_benefits jb
+        JOIN benefits b ON jb.benefit_id = b.id
+        WHERE jb.job_id = j.id
+        ORDER BY b.slug
+    ) AS benefits,
+    ARRAY(
+        SELECT lower(pt.name) FROM job_technologies pjt
+        JOIN technologies pt ON pjt.technology_id = pt.id
+        WHERE pjt.job_id = j.id AND pjt.is_primary
+        UNION
+        SELECT lower(pta.alias) FROM job_technologies pjt
+        JOIN technology_aliases pta ON pjt.technology_id = pta.technology_id
+        WHERE pjt.job_id = j.id AND pjt.is_primary
+    ) AS primary_technologies
+FROM jobs j
+JOIN companies c ON j.company_id = c.id
+LEFT JOIN job_technologies jt ON jt.job_id = j.id
+LEFT JOIN technologies t ON jt.technology_id = t.id
+WHERE j.is_active = true AND j.canonical_job_id IS NULL
+GROUP BY j.id, c.id;
+
+CREATE UNIQUE INDEX idx_job_search_view_id ON job_search_view(id);
+CREATE INDEX idx_job_search_view_search_vector ON job_search_view USING GIN (search_vector);
+CREATE INDEX idx_job_search_view_created_at ON job_search_view(created_at);
+CREATE INDEX idx_job_search_view_technologies ON job_search_view USING GIN (technologies);
+CREATE INDEX idx_job_search_view_language ON job_search_view(language);
+CREATE INDEX idx_job_search_view_remote_eligibility ON job_search_view(remote_eligibility);
+CREATE INDEX idx_job_search_view_benefits ON job_search_view USING GIN (benefits);
+CREATE INDEX idx_job_search_view_country ON job_search_view(country);
+CREATE INDEX idx_job_search_view_primary_technologies ON job_search_view USING GIN (primary_technologies);
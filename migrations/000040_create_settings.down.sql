@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS settings_notify_changes ON settings;
+DROP FUNCTION IF EXISTS notify_setting_changes();
+DROP TABLE IF EXISTS setting_changes;
+DROP TABLE IF EXISTS settings;
@@ -0,0 +1,32 @@
+-- Values of the settings changed by the admins while the servers run, overriding the ones of the
+-- environment, e.g. the log level. The servers apply them on startup and when they change.
+CREATE TABLE settings (
+    name       VARCHAR(100) PRIMARY KEY,
+    value      VARCHAR(255) NOT NULL,
+    updated_by VARCHAR(100) NOT NULL DEFAULT '',
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Audit of the setting changes, who changed which setting from and to which value
+CREATE TABLE setting_changes (
+    id         BIGSERIAL PRIMARY KEY,
+    name       VARCHAR(100) NOT NULL,
+    old_value  VARCHAR(255) NOT NULL,
+    new_value  VARCHAR(255) NOT NULL,
+    changed_by VARCHAR(100) NOT NULL DEFAULT '',
+    changed_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_setting_changes_changed_at ON setting_changes(changed_at DESC);
+
+-- Notifies the servers of changes to the settings, so they apply them
+CREATE OR REPLACE FUNCTION notify_setting_changes() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('setting_changes', TG_OP);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER settings_notify_changes
+AFTER INSERT OR UPDATE OR DELETE ON settings
+FOR EACH STATEMENT EXECUTE FUNCTION notify_setting_changes();
@@ -0,0 +1,8 @@
+-- Last successful run of the job populator for each source of the job data, the jobs without a
+-- source under ''. With --since-last-run the populator skips the jobs scraped before it started.
+CREATE TABLE populator_runs (
+    source         VARCHAR(100) PRIMARY KEY,
+    started_at     TIMESTAMP NOT NULL,
+    finished_at    TIMESTAMP NOT NULL DEFAULT NOW(),
+    jobs_processed INT NOT NULL DEFAULT 0
+);
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS blocked_networks_notify_changes ON blocked_networks;
+DROP FUNCTION IF EXISTS notify_blocklist_changes();
+DROP TABLE IF EXISTS blocked_networks;
@@ -0,0 +1,23 @@
+-- Networks blocked by the admins from the public job search, e.g. the ranges of the scrapers
+-- copying the board. A single address is stored as a /32 or /128 network.
+CREATE TABLE blocked_networks (
+    id         SERIAL PRIMARY KEY,
+    cidr       CIDR NOT NULL UNIQUE,
+    reason     VARCHAR(255) NOT NULL DEFAULT '',
+    created_by VARCHAR(100) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    -- When the network is unblocked, NULL while it stays blocked until removed
+    expires_at TIMESTAMP NULL
+);
+
+-- Notifies the servers of changes to the blocked networks, so they reload their snapshot
+CREATE OR REPLACE FUNCTION notify_blocklist_changes() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('blocklist_changes', TG_OP);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER blocked_networks_notify_changes
+AFTER INSERT OR UPDATE OR DELETE ON blocked_networks
+FOR EACH STATEMENT EXECUTE FUNCTION notify_blocklist_changes();
@@ -0,0 +1,15 @@
+-- Accounts at OAuth providers (Google, GitHub) users log in with, linked to the user of their
+-- verified email. The users created by an OAuth login have an empty password hash, so they can
+-- only log in with their linked accounts.
+CREATE TABLE user_identities (
+    provider   VARCHAR(20) NOT NULL,
+    -- ID of the account at the provider, which doesn't change when its email does
+    subject    VARCHAR(255) NOT NULL,
+    user_id    INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    -- Email of the account when it was linked
+    email      VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (provider, subject)
+);
+
+CREATE INDEX idx_user_identities_user_id ON user_identities(user_id);
@@ -0,0 +1,8 @@
+-- One-time codes of the OAuth logins, handed to the frontend in the redirect back from the
+-- callback and exchanged for a session. Only the SHA-256 hash of the code is stored.
+CREATE TABLE oauth_login_codes (
+    code_hash CHAR(64) PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1 @@
+ALTER TABLE populator_runs DROP COLUMN IF EXISTS last_scraped_at;
@@ -0,0 +1,4 @@
+-- Latest scrape time of the jobs of the last successful run of each source. With --since-last-run the
+-- populator skips the jobs scraped before it, comparing scrape times with scrape times so the clock of
+-- the populator doesn't matter. Runs recorded before have none, their next run processes every job.
+ALTER TABLE populator_runs ADD COLUMN last_scraped_at TIMESTAMP;
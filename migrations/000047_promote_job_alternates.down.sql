@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS jobs_promote_alternate ON jobs;
+DROP FUNCTION IF EXISTS promote_job_alternate();
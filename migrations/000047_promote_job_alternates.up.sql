@@ -0,0 +1,29 @@
+-- A canonical job taken down hands its group over to its oldest active alternate, which is published in
+-- its place, and the other alternates point to the promoted job from then on. Every way a job is taken
+-- down goes through is_active, so the promotion doesn't depend on which one did it.
+CREATE OR REPLACE FUNCTION promote_job_alternate() RETURNS TRIGGER AS $$
+DECLARE
+    promoted_id INTEGER;
+BEGIN
+    SELECT id INTO promoted_id
+    FROM jobs
+    WHERE canonical_job_id = NEW.id AND is_active AND status = 'published'
+    ORDER BY created_at, id
+    LIMIT 1;
+
+    IF promoted_id IS NOT NULL THEN
+        UPDATE jobs
+        SET canonical_job_id = NULL, updated_by = NEW.updated_by, updated_at = NOW()
+        WHERE id = promoted_id;
+
+        UPDATE jobs SET canonical_job_id = promoted_id WHERE canonical_job_id = NEW.id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER jobs_promote_alternate
+AFTER UPDATE OF is_active ON jobs
+FOR EACH ROW
+WHEN (OLD.is_active IS TRUE AND NEW.is_active IS NOT TRUE AND NEW.canonical_job_id IS NULL)
+EXECUTE FUNCTION promote_job_alternate();
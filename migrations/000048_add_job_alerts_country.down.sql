@@ -0,0 +1 @@
+ALTER TABLE job_alerts DROP COLUMN IF EXISTS country;
@@ -0,0 +1,3 @@
+-- Alerts are scoped to the country they were created for, like the job search, so they only email
+-- the jobs of that country. The alerts created before are in the default country of the board.
+ALTER TABLE job_alerts ADD COLUMN country CHAR(2) NOT NULL DEFAULT 'CR';
@@ -0,0 +1,303 @@
+// Package client is a Go client of the job board API, for internal tools and scrapers. It covers
+// the job search, similar jobs, companies, technology statistics and the ingest API, and retries
+// the requests failing with a transient error with exponential backoff.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Defaults for the client
+const (
+	DefaultBaseURL    = "http://localhost:8080"
+	DefaultAPIVersion = "v1"
+	DefaultTimeout    = 30 * time.Second
+	DefaultMaxRetries = 3
+	DefaultBaseDelay  = 200 * time.Millisecond
+	DefaultMaxDelay   = 5 * time.Second
+
+	// apiKeyHeader carries the API key of the ingest and admin routes
+	apiKeyHeader = "X-API-Key"
+	// maxErrorBodySize bounds how much of a response that isn't an error envelope is kept
+	maxErrorBodySize = 512
+)
+
+// Config configures a Client
+type Config struct {
+	// BaseURL is the URL the API is served from, without the /api/<version> path
+	BaseURL string
+	// APIVersion is the version of the API called, e.g. "v1"
+	APIVersion string
+	// APIKey is sent with every request, required by the ingest routes. Optional.
+	APIKey string
+	// HTTPClient sends the requests. Optional, a client with DefaultTimeout is used when nil.
+	HTTPClient *http.Client
+	// MaxRetries is the number of retries of a failed request, 0 uses the default and a negative
+	// value disables retries
+	MaxRetries int
+	// BaseDelay is the wait before the first retry, doubled on every following retry
+	BaseDelay time.Duration
+	// MaxDelay caps the wait between retries
+	MaxDelay time.Duration
+	// UserAgent identifies the tool calling the API. Optional.
+	UserAgent string
+}
+
+// Client calls the job board API
+type Client struct {
+	baseURL    string
+	apiKey     string
+	httpClient *http.Client
+	maxRetries int
+	baseDelay  time.Duration
+	maxDelay   time.Duration
+	userAgent  string
+	sleep      func(ctx context.Context, d time.Duration) error
+}
+
+// New creates a new instance of Client
+func New(cfg Config) *Client {
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = DefaultBaseURL
+	}
+	if cfg.APIVersion == "" {
+		cfg.APIVersion = DefaultAPIVersion
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{Timeout: DefaultTimeout}
+	}
+	if cfg.MaxRetries == 0 {
+		cfg.MaxRetries = DefaultMaxRetries
+	}
+	if cfg.BaseDelay <= 0 {
+		cfg.BaseDelay = DefaultBaseDelay
+	}
+	if cfg.MaxDelay <= 0 {
+		cfg.MaxDelay = DefaultMaxDelay
+	}
+
+	return &Client{
+		baseURL:    strings.TrimRight(cfg.BaseURL, "/") + "/api/" + cfg.APIVersion,
+		apiKey:     cfg.APIKey,
+		httpClient: cfg.HTTPClient,
+		maxRetries: max(cfg.MaxRetries, 0),
+		baseDelay:  cfg.BaseDelay,
+		maxDelay:   cfg.MaxDelay,
+		userAgent:  cfg.UserAgent,
+		sleep:      sleep,
+	}
+}
+
+// SearchJobs searches the active jobs
+func (c *Client) SearchJobs(ctx context.Context, req *SearchRequest) (*SearchResponse, error) {
+	var resp SearchResponse
+	if err := c.do(ctx, http.MethodGet, "/jobs", encodeQuery(req), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SimilarJobs lists the active jobs most similar to the job with the given ID
+func (c *Client) SimilarJobs(ctx context.Context, jobID int, req *SimilarRequest) (*SimilarJobListResponse, error) {
+	var resp SimilarJobListResponse
+	path := "/jobs/" + strconv.Itoa(jobID) + "/similar"
+	if err := c.do(ctx, http.MethodGet, path, encodeQuery(req), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetCompany gets the public profile of a company by its slug
+func (c *Client) GetCompany(ctx context.Context, slug string) (*CompanyResponse, error) {
+	var resp CompanyResponse
+	if err := c.do(ctx, http.MethodGet, "/companies/"+url.PathEscape(slug), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetCompanyTechnologies gets the technology stack of a company by its slug
+func (c *Client) GetCompanyTechnologies(ctx context.Context, slug string) (*TechnologyStackResponse, error) {
+	var resp TechnologyStackResponse
+	path := "/companies/" + url.PathEscape(slug) + "/technologies"
+	if err := c.do(ctx, http.MethodGet, path, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// TechnologyStats lists the technologies most used by the jobs
+func (c *Client) TechnologyStats(ctx context.Context, req *TechnologyStatsRequest) (*TechnologyStatsResponse, error) {
+	var resp TechnologyStatsResponse
+	if err := c.do(ctx, http.MethodGet, "/stats/technologies", encodeQuery(req), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// StartIngestRun registers a scraper run. It is not retried, a retry could register the run twice.
+func (c *Client) StartIngestRun(ctx context.Context, req *StartRunRequest) (*RunResponse, error) {
+	var resp RunResponse
+	if err := c.do(ctx, http.MethodPost, "/ingest/runs", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ReportIngestCompany reports the scrape status of a company in a run
+func (c *Client) ReportIngestCompany(
+	ctx context.Context, runID int, req *CompanyReportRequest,
+) (*CompanyReportResponse, error) {
+	var resp CompanyReportResponse
+	path := "/ingest/runs/" + strconv.Itoa(runID) + "/companies"
+	if err := c.do(ctx, http.MethodPut, path, nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CloseIngestRun closes a scraper run with its final status
+func (c *Client) CloseIngestRun(ctx context.Context, runID int, req *CloseRunRequest) (*RunResponse, error) {
+	var resp RunResponse
+	path := "/ingest/runs/" + strconv.Itoa(runID) + "/close"
+	if err := c.do(ctx, http.MethodPost, path, nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// do sends a request and decodes the JSON response into out. Idempotent requests failing with a
+// transient error are retried.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, out any) error {
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = json.Marshal(body); err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+	}
+
+	endpoint := c.baseURL + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	retries := 0
+	if isIdempotent(method) {
+		retries = c.maxRetries
+	}
+
+	delay := c.baseDelay
+	for attempt := 0; ; attempt++ {
+		retryAfter, err := c.send(ctx, method, endpoint, payload, out)
+		if err == nil || attempt >= retries || !isTransient(err) {
+			return err
+		}
+
+		wait := delay
+		if retryAfter > 0 {
+			wait = min(retryAfter, c.maxDelay)
+		}
+		if sleepErr := c.sleep(ctx, wait); sleepErr != nil {
+			return err
+		}
+		delay = min(delay*2, c.maxDelay)
+	}
+}
+
+// send sends a request once. It returns the delay asked by a Retry-After header along with errors.
+func (c *Client) send(ctx context.Context, method, endpoint string, payload []byte, out any) (time.Duration, error) {
+	var body io.Reader = http.NoBody
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.apiKey != "" {
+		req.Header.Set(apiKeyHeader, c.apiKey)
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("%s %s: %w", method, endpoint, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return parseRetryAfter(resp.Header.Get("Retry-After")), newAPIError(resp)
+	}
+
+	if out == nil {
+		return 0, nil
+	}
+	if err = json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return 0, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return 0, nil
+}
+
+// isIdempotent reports whether a request can be sent again without side effects
+func isIdempotent(method string) bool {
+	return method == http.MethodGet || method == http.MethodPut || method == http.MethodDelete
+}
+
+// isTransient reports whether a request failing with err may succeed when sent again
+func isTransient(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+
+	// Network errors, the request may not have reached the server
+	return true
+}
+
+// parseRetryAfter returns the delay of a Retry-After header in seconds, 0 when missing or a date
+func parseRetryAfter(value string) time.Duration {
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// sleep waits for d or until ctx is canceled
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,187 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestClient creates a client calling handler, without waiting between retries
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c := New(Config{BaseURL: server.URL, APIKey: "secret", UserAgent: "test-scraper/1.0"})
+	c.sleep = func(context.Context, time.Duration) error { return nil }
+	return c
+}
+
+// writeJSON answers a request with a JSON body
+func writeJSON(t *testing.T, w http.ResponseWriter, status int, body any) {
+	t.Helper()
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	require.NoError(t, json.NewEncoder(w).Encode(body))
+}
+
+func TestClient_SearchJobs(t *testing.T) {
+	t.Parallel()
+	utcOffset := -6
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/jobs", r.URL.Path)
+		assert.Equal(t, "q=golang&utc_offset=-6&work_mode=Remote", r.URL.RawQuery)
+		assert.Equal(t, "secret", r.Header.Get("X-API-Key"))
+		assert.Equal(t, "test-scraper/1.0", r.Header.Get("User-Agent"))
+		writeJSON(t, w, http.StatusOK, map[string]any{
+			"data":       []map[string]any{{"job_id": 42, "title": "Go Developer", "company_slug": "tech-corp"}},
+			"pagination": map[string]any{"total": 1, "limit": 20, "offset": 0, "has_more": false},
+		})
+	})
+
+	resp, err := c.SearchJobs(context.Background(),
+		&SearchRequest{Query: "golang", WorkMode: "Remote", UTCOffset: &utcOffset})
+	require.NoError(t, err)
+	require.Len(t, resp.Data, 1)
+	assert.Equal(t, 42, resp.Data[0].ID)
+	assert.Equal(t, "tech-corp", resp.Data[0].CompanySlug)
+	assert.Equal(t, 1, resp.Pagination.Total)
+}
+
+func TestClient_Retries(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		statuses     []int
+		call         func(c *Client) error
+		wantRequests int32
+		checkResults func(t *testing.T, err error)
+	}{
+		{
+			name:     "transient failures retried until success",
+			statuses: []int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK},
+			call: func(c *Client) error {
+				_, err := c.GetCompany(context.Background(), "tech-corp")
+				return err
+			},
+			wantRequests: 3,
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "retries exhausted",
+			statuses: []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable,
+				http.StatusServiceUnavailable, http.StatusServiceUnavailable},
+			call: func(c *Client) error {
+				_, err := c.GetCompany(context.Background(), "tech-corp")
+				return err
+			},
+			wantRequests: 4,
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				var apiErr *APIError
+				require.ErrorAs(t, err, &apiErr)
+				assert.Equal(t, http.StatusServiceUnavailable, apiErr.StatusCode)
+				assert.Equal(t, CodeUnavailable, apiErr.Code)
+			},
+		},
+		{
+			name:     "not found not retried",
+			statuses: []int{http.StatusNotFound},
+			call: func(c *Client) error {
+				_, err := c.GetCompanyTechnologies(context.Background(), "unknown")
+				return err
+			},
+			wantRequests: 1,
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				assert.True(t, IsNotFound(err))
+				assert.EqualError(t, err, "api responded with status 404: NOT_FOUND: Resource not found")
+			},
+		},
+		{
+			name:     "run registration not retried",
+			statuses: []int{http.StatusServiceUnavailable, http.StatusCreated},
+			call: func(c *Client) error {
+				_, err := c.StartIngestRun(context.Background(), &StartRunRequest{Source: "careers-scraper"})
+				return err
+			},
+			wantRequests: 1,
+			checkResults: func(t *testing.T, err error) {
+				t.Helper()
+				require.Error(t, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var requests atomic.Int32
+
+			c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+				status := tt.statuses[requests.Add(1)-1]
+				switch status {
+				case http.StatusOK, http.StatusCreated:
+					writeJSON(t, w, status, map[string]any{"slug": "tech-corp"})
+				case http.StatusNotFound:
+					writeJSON(t, w, status, map[string]any{
+						"error": map[string]any{"code": CodeNotFound, "message": "Resource not found"},
+					})
+				default:
+					writeJSON(t, w, status, map[string]any{
+						"error": map[string]any{"code": CodeUnavailable, "message": "Service unavailable"},
+					})
+				}
+			})
+
+			err := tt.call(c)
+			tt.checkResults(t, err)
+			assert.Equal(t, tt.wantRequests, requests.Load())
+		})
+	}
+}
+
+func TestClient_ReportIngestCompany(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/api/v1/ingest/runs/15/companies", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var body CompanyReportRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, CompanyReportRequest{Company: "Tech Corp", Status: "succeeded", JobsFound: 12}, body)
+		writeJSON(t, w, http.StatusOK, map[string]any{"run_id": 15, "company_name": "Tech Corp", "status": "succeeded"})
+	})
+
+	resp, err := c.ReportIngestCompany(context.Background(), 15,
+		&CompanyReportRequest{Company: "Tech Corp", Status: "succeeded", JobsFound: 12})
+	require.NoError(t, err)
+	assert.Equal(t, "Tech Corp", resp.CompanyName)
+	assert.Equal(t, 15, resp.RunID)
+}
+
+func TestClient_ErrorWithoutEnvelope(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "upstream connect error", http.StatusBadRequest)
+	})
+
+	_, err := c.TechnologyStats(context.Background(), &TechnologyStatsRequest{Limit: 10})
+	assert.True(t, IsValidation(err))
+	assert.EqualError(t, err, "api responded with status 400: upstream connect error")
+}
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+)
+
+// Error codes of the API error envelope, see ErrorDetails
+const (
+	CodeNotFound       = httpservice.ErrCodeNotFound
+	CodeInvalidRequest = httpservice.ErrCodeInvalidRequest
+	CodeValidation     = httpservice.ErrCodeValidationError
+	CodeUnauthorized   = httpservice.ErrCodeUnauthorized
+	CodeConflict       = httpservice.ErrCodeConflict
+	CodeUnavailable    = httpservice.ErrCodeUnavailable
+	CodeTimeout        = httpservice.ErrCodeTimeout
+)
+
+// APIError is a response of the API with an error status
+type APIError struct {
+	StatusCode int
+	ErrorDetails
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("api responded with status %d", e.StatusCode)
+	switch {
+	case e.Code != "":
+		msg += fmt.Sprintf(": %s: %s", e.Code, e.Message)
+	case e.Message != "":
+		msg += ": " + e.Message
+	}
+	if len(e.Details) > 0 {
+		msg += " (" + strings.Join(e.Details, ", ") + ")"
+	}
+	return msg
+}
+
+// newAPIError reads the error envelope of resp. Bodies that aren't an envelope, e.g. from a
+// proxy, are kept as the message.
+func newAPIError(resp *http.Response) *APIError {
+	apiErr := &APIError{StatusCode: resp.StatusCode}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+
+	var envelope struct {
+		Error ErrorDetails `json:"error"`
+	}
+	if err := json.Unmarshal(body, &envelope); err == nil && envelope.Error.Code != "" {
+		apiErr.ErrorDetails = envelope.Error
+	} else {
+		apiErr.Message = strings.TrimSpace(string(body))
+	}
+	return apiErr
+}
+
+// IsNotFound checks if an error is a not found error of the API
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// IsValidation checks if an error is the API rejecting the parameters of a request
+func IsValidation(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest
+}
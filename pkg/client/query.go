@@ -0,0 +1,46 @@
+package client
+
+import (
+	"net/url"
+	"reflect"
+	"strconv"
+)
+
+// encodeQuery encodes the fields of a request struct with a form tag as query parameters, the
+// way the server binds them. Zero values are left out so the server applies its defaults.
+func encodeQuery(req any) url.Values {
+	query := url.Values{}
+	v := reflect.ValueOf(req)
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return query
+		}
+		v = v.Elem()
+	}
+
+	t := v.Type()
+	for i := range t.NumField() {
+		name := t.Field(i).Tag.Get("form")
+		field := v.Field(i)
+		if name == "" || name == "-" || field.IsZero() {
+			continue
+		}
+		if field.Kind() == reflect.Pointer {
+			field = field.Elem()
+		}
+
+		switch field.Kind() {
+		case reflect.String:
+			query.Set(name, field.String())
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			query.Set(name, strconv.FormatInt(field.Int(), 10))
+		case reflect.Bool:
+			query.Set(name, strconv.FormatBool(field.Bool()))
+		case reflect.Float32, reflect.Float64:
+			query.Set(name, strconv.FormatFloat(field.Float(), 'f', -1, 64))
+		default:
+			// Not used by the request DTOs
+		}
+	}
+	return query
+}
@@ -0,0 +1,49 @@
+package client
+
+import (
+	"github.com/rodruizronald/ticos-in-tech/internal/company"
+	"github.com/rodruizronald/ticos-in-tech/internal/httpservice"
+	"github.com/rodruizronald/ticos-in-tech/internal/ingest"
+	"github.com/rodruizronald/ticos-in-tech/internal/jobs"
+	"github.com/rodruizronald/ticos-in-tech/internal/stats"
+)
+
+// The request and response types are the DTOs of the server modules, so they never drift from the
+// API. The aliases make them usable outside of this module.
+
+// Jobs
+type (
+	SearchRequest          = jobs.SearchRequest
+	SearchResponse         = jobs.SearchResponse
+	JobResponse            = jobs.JobResponse
+	TechnologyResponse     = jobs.TechnologyResponse
+	PaginationDetails      = jobs.PaginationDetails
+	SimilarRequest         = jobs.SimilarRequest
+	SimilarJobResponse     = jobs.SimilarJobResponse
+	SimilarJobListResponse = jobs.SimilarJobListResponse
+)
+
+// Companies
+type (
+	CompanyResponse         = company.CompanyResponse
+	TechnologyCountResponse = company.TechnologyCountResponse
+	TechnologyStackResponse = company.TechnologyStackResponse
+)
+
+// Technologies
+type (
+	TechnologyStatsRequest  = stats.TechnologyStatsRequest
+	TechnologyStatsResponse = stats.TechnologyStatsResponse
+)
+
+// Ingest
+type (
+	StartRunRequest       = ingest.StartRunRequest
+	RunResponse           = ingest.RunResponse
+	CompanyReportRequest  = ingest.CompanyReportRequest
+	CompanyReportResponse = ingest.CompanyReportResponse
+	CloseRunRequest       = ingest.CloseRunRequest
+)
+
+// ErrorDetails is the error envelope of failed requests
+type ErrorDetails = httpservice.ErrorDetails
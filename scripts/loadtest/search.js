@@ -0,0 +1,88 @@
+// Load test of the job search, GET /api/v1/jobs, with a mix of the searches made on the board.
+//
+// Run against a server holding a realistic dataset (see the benchmarks section of the README):
+//
+//   k6 run scripts/loadtest/search.js
+//   k6 run -e BASE_URL=https://staging.example.com -e RATE=100 -e DURATION=5m scripts/loadtest/search.js
+//
+// The run fails when the latency or error thresholds below are crossed.
+import http from 'k6/http';
+import { check } from 'k6';
+
+const BASE_URL = __ENV.BASE_URL || 'http://localhost:8080';
+const RATE = parseInt(__ENV.RATE || '50', 10);
+const DURATION = __ENV.DURATION || '2m';
+
+export const options = {
+  scenarios: {
+    search: {
+      executor: 'constant-arrival-rate',
+      rate: RATE,
+      timeUnit: '1s',
+      duration: DURATION,
+      preAllocatedVUs: RATE,
+      maxVUs: RATE * 4,
+    },
+  },
+  thresholds: {
+    http_req_failed: ['rate<0.01'],
+    'http_req_duration{kind:query}': ['p(95)<150', 'p(99)<300'],
+    'http_req_duration{kind:filters}': ['p(95)<200', 'p(99)<400'],
+    'http_req_duration{kind:page}': ['p(95)<250', 'p(99)<500'],
+  },
+};
+
+const terms = [
+  'developer', 'engineer', 'backend', 'frontend', 'golang', 'python', 'java', 'react',
+  'data', 'devops', 'qa', 'mobile', 'full stack', 'desarrollador', 'ingeniero', 'kubernetes architect',
+];
+const experienceLevels = ['Entry-level', 'Junior', 'Mid-level', 'Senior', 'Lead'];
+const workModes = ['Remote', 'Hybrid', 'Onsite'];
+const locations = ['Costa Rica', 'LATAM'];
+const benefits = ['health-insurance', 'stock-options', 'education-budget', 'flexible-hours'];
+
+// Share of the searches of each kind, out of 100: most visitors type a query, a third narrow it
+// with filters and few page deep into the results
+const mix = [
+  { kind: 'query', weight: 55, params: () => ({}) },
+  {
+    kind: 'filters',
+    weight: 30,
+    params: () => ({
+      experience_level: pick(experienceLevels),
+      work_mode: pick(workModes),
+      location: pick(locations),
+      ...(Math.random() < 0.3 ? { benefits: pick(benefits) } : {}),
+    }),
+  },
+  { kind: 'page', weight: 10, params: () => ({ offset: 20 * (1 + Math.floor(Math.random() * 10)) }) },
+  { kind: 'query', weight: 5, params: () => ({ highlight: 'true' }) },
+];
+
+function pick(values) {
+  return values[Math.floor(Math.random() * values.length)];
+}
+
+function searchOf(roll) {
+  let total = 0;
+  for (const search of mix) {
+    total += search.weight;
+    if (roll < total) {
+      return search;
+    }
+  }
+  return mix[0];
+}
+
+export default function () {
+  const search = searchOf(Math.random() * 100);
+  const params = { q: pick(terms), limit: '20', ...search.params() };
+  const query = Object.entries(params)
+    .map(([name, value]) => `${name}=${encodeURIComponent(value)}`)
+    .join('&');
+
+  const res = http.get(`${BASE_URL}/api/v1/jobs?${query}`, {
+    tags: { kind: search.kind, name: `jobs-${search.kind}` },
+  });
+  check(res, { 'status is 200': (r) => r.status === 200 });
+}